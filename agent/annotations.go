@@ -0,0 +1,42 @@
+package agent
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// parseOutputAnnotations extracts the trailing JSON object of a check output,
+// which can span several lines, and returns the remaining output along with
+// the annotations it provides. The values which aren't strings are kept JSON
+// encoded. The output is returned as is if it doesn't end with a JSON object.
+func parseOutputAnnotations(output string) (string, map[string]string) {
+	trimmed := strings.TrimRight(output, " \t\r\n")
+	if !strings.HasSuffix(trimmed, "}") {
+		return output, nil
+	}
+
+	lines := strings.Split(trimmed, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if !strings.HasPrefix(strings.TrimSpace(lines[i]), "{") {
+			continue
+		}
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(strings.Join(lines[i:], "\n")), &fields); err != nil {
+			continue
+		}
+
+		annotations := make(map[string]string, len(fields))
+		for key, value := range fields {
+			var s string
+			if err := json.Unmarshal(value, &s); err != nil {
+				s = string(value)
+			}
+			annotations[key] = s
+		}
+
+		return strings.TrimRight(strings.Join(lines[:i], "\n"), " \t\r\n"), annotations
+	}
+
+	return output, nil
+}
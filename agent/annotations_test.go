@@ -0,0 +1,64 @@
+package agent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOutputAnnotations(t *testing.T) {
+	testCases := []struct {
+		name        string
+		output      string
+		text        string
+		annotations map[string]string
+	}{
+		{
+			name:   "no annotations",
+			output: "CPU OK\n",
+			text:   "CPU OK\n",
+		},
+		{
+			name:   "invalid json",
+			output: "CPU OK\n{runbook}\n",
+			text:   "CPU OK\n{runbook}\n",
+		},
+		{
+			name:   "single line",
+			output: "CPU CRITICAL\n{\"runbook\": \"https://wiki/cpu\"}\n",
+			text:   "CPU CRITICAL",
+			annotations: map[string]string{
+				"runbook": "https://wiki/cpu",
+			},
+		},
+		{
+			name: "multiple lines",
+			output: "CPU CRITICAL\nload is high\n" +
+				"{\n" +
+				"  \"runbook\": \"https://wiki/cpu\",\n" +
+				"  \"cores\": 4,\n" +
+				"  \"links\": {\"graph\": \"https://graphs/cpu\"}\n" +
+				"}\n",
+			text: "CPU CRITICAL\nload is high",
+			annotations: map[string]string{
+				"runbook": "https://wiki/cpu",
+				"cores":   "4",
+				"links":   `{"graph": "https://graphs/cpu"}`,
+			},
+		},
+		{
+			name:        "annotations only",
+			output:      "{\"team\": \"ops\"}",
+			text:        "",
+			annotations: map[string]string{"team": "ops"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			text, annotations := parseOutputAnnotations(tc.output)
+			assert.Equal(t, tc.text, text)
+			assert.Equal(t, tc.annotations, annotations)
+		})
+	}
+}
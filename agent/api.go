@@ -35,6 +35,7 @@ func newServer(a *Agent) *http.Server {
 func registerRoutes(a *Agent, r *mux.Router) {
 	r.HandleFunc("/events", addEvent(a)).Methods(http.MethodPost)
 	r.HandleFunc("/healthz", healthz(a.conn)).Methods(http.MethodGet)
+	r.HandleFunc("/checks/{name}/result", checkResult(a.results)).Methods(http.MethodGet)
 }
 
 // healthz returns an OK status if the agent is up and connected to a backend.
@@ -50,6 +51,22 @@ func healthz(conn transport.Transport) http.HandlerFunc {
 	}
 }
 
+// checkResult returns the event of the last execution of the check by the
+// agent, or a not found status if the check was not executed yet.
+func checkResult(results *checkResults) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+		event := results.get(name)
+		if event == nil {
+			http.Error(w, fmt.Sprintf("no result for check %q", name), http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(event)
+	}
+}
+
 // addEvent accepts an event and send it to the backend over the event channel
 func addEvent(a *Agent) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -12,6 +12,7 @@ import (
 	"github.com/sensu/sensu-go/testing/mocktransport"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAddEvent(t *testing.T) {
@@ -105,3 +106,30 @@ func TestHealthz(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckResult(t *testing.T) {
+	agent := NewAgent(NewConfig())
+	router := mux.NewRouter()
+	registerRoutes(agent, router)
+
+	// No result until the check is executed
+	r, err := http.NewRequest("GET", "/checks/check_foo/result", nil)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	event := types.FixtureEvent("foo", "check_foo")
+	event.Check.Status = 2
+	agent.results.record(event)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	var result types.Event
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(t, "check_foo", result.Check.Name)
+	assert.Equal(t, int32(2), result.Check.Status)
+}
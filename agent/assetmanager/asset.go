@@ -31,6 +31,10 @@ const (
 type RuntimeAsset struct {
 	path  string
 	asset *types.Asset
+
+	// client downloads the asset, a client with the default transport is
+	// used if nil
+	client *http.Client
 }
 
 // NewRuntimeAsset given asset and pathPrefix return new managed asset
@@ -106,7 +110,10 @@ func (d *RuntimeAsset) awaitLock() (*lockfile.Lockfile, error) {
 
 func (d *RuntimeAsset) fetch() (*http.Response, error) {
 	// GET asset w/ timeout
-	netClient := &http.Client{Timeout: fetchTimeout}
+	netClient := d.client
+	if netClient == nil {
+		netClient = &http.Client{Timeout: fetchTimeout}
+	}
 	r, err := netClient.Get(d.asset.URL)
 	if err != nil {
 		return r, fmt.Errorf("error fetching asset: %s", err.Error())
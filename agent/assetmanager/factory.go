@@ -1,6 +1,10 @@
 package assetmanager
 
-import "github.com/sensu/sensu-go/types"
+import (
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
 
 // AssetFactory helps instantiate new runtime assets & sets w/ given contextual
 // details.
@@ -10,11 +14,17 @@ type AssetFactory struct {
 
 	// CacheDir is the directory where assets are stored
 	CacheDir string
+
+	// Client downloads the assets, a client with the default transport is
+	// used if nil
+	Client *http.Client
 }
 
 // NewAsset returns a new RuntimeAsset given an asset
 func (factory AssetFactory) NewAsset(asset *types.Asset) *RuntimeAsset {
-	return NewRuntimeAsset(asset, factory.CacheDir)
+	runtimeAsset := NewRuntimeAsset(asset, factory.CacheDir)
+	runtimeAsset.client = factory.Client
+	return runtimeAsset
 }
 
 // NewAssetSet returns a new RuntimeAsset given an asset
@@ -1,6 +1,8 @@
 package assetmanager
 
 import (
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -56,6 +58,16 @@ func (mngrPtr *Manager) SetCacheDir(baseDir string) {
 	mngrPtr.store.Clear()
 }
 
+// SetProxy sets the function returning the proxy the assets are downloaded
+// through, as the Proxy of http.Transport.
+func (mngrPtr *Manager) SetProxy(proxy func(*http.Request) (*url.URL, error)) {
+	mngrPtr.factory.Client = &http.Client{
+		Timeout:   fetchTimeout,
+		Transport: &http.Transport{Proxy: proxy},
+	}
+	mngrPtr.store.Clear()
+}
+
 // Reset clears all knownAssets and env from state, this forces the agent to
 // recompute the next time a check is run.
 //
@@ -2,6 +2,8 @@ package assetmanager
 
 import (
 	"io/ioutil"
+	"net/http"
+	"net/url"
 	"os"
 	"testing"
 
@@ -61,6 +63,22 @@ func (suite *ManagerTestSuite) TestReset() {
 	suite.Empty(suite.manager.store.assets, "clears existing assets from store")
 }
 
+func (suite *ManagerTestSuite) TestSetProxy() {
+	asset := NewRuntimeAsset(types.FixtureAsset("asset"), "")
+	suite.manager.store.assets["123"] = asset
+
+	proxyURL, _ := url.Parse("http://proxy:3128")
+	suite.manager.SetProxy(http.ProxyURL(proxyURL))
+	suite.Empty(suite.manager.store.assets, "clears existing assets from store")
+
+	runtimeAsset := suite.manager.factory.NewAsset(types.FixtureAsset("asset"))
+	suite.Require().NotNil(runtimeAsset.client)
+	req, _ := http.NewRequest(http.MethodGet, "http://assets.example.com", nil)
+	reqProxyURL, err := runtimeAsset.client.Transport.(*http.Transport).Proxy(req)
+	suite.NoError(err)
+	suite.Equal(proxyURL, reqProxyURL)
+}
+
 func (suite *ManagerTestSuite) TestRegisterSet() {
 	assets := []types.Asset{*types.FixtureAsset("asset")}
 	assetSet := suite.manager.RegisterSet(assets)
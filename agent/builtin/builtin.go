@@ -0,0 +1,156 @@
+// Package builtin provides the checks embedded in the agent, selected with
+// the builtin:// command scheme, e.g. builtin://disk?path=/var&critical=95.
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sensu/sensu-go/command"
+)
+
+// Scheme is the prefix of the commands of the builtin checks.
+const Scheme = "builtin://"
+
+const (
+	statusOK = iota
+	statusWarning
+	statusCritical
+	statusUnknown
+)
+
+var statusNames = []string{"OK", "WARNING", "CRITICAL", "UNKNOWN"}
+
+// A check determines the status of a service given its parameters, and
+// describes it with a Nagios plugin output.
+type check func(ctx context.Context, params url.Values) (status int, output string, err error)
+
+var checks = map[string]check{
+	"cpu":     checkCPU,
+	"memory":  checkMemory,
+	"disk":    checkDisk,
+	"process": checkProcess,
+	"tcp":     checkTCP,
+	"http":    checkHTTP,
+	"icmp":    checkICMP,
+}
+
+// Names returns the names of the builtin checks.
+func Names() []string {
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsBuiltin returns whether the command selects a builtin check.
+func IsBuiltin(command string) bool {
+	return strings.HasPrefix(strings.TrimSpace(command), Scheme)
+}
+
+// Execute runs the builtin check selected by the command of the execution,
+// within its timeout, and records its output and status like
+// command.ExecuteCommand does. A check which can't determine the status of its
+// service, e.g. given invalid parameters, has an unknown status.
+func Execute(ctx context.Context, execution *command.Execution) (*command.Execution, error) {
+	started := time.Now()
+	defer func() {
+		execution.Duration = time.Since(started).Seconds()
+	}()
+
+	u, err := url.Parse(strings.TrimSpace(execution.Command))
+	if err != nil {
+		execution.Status = statusUnknown
+		return execution, fmt.Errorf("invalid builtin check command: %s", err)
+	}
+
+	run, ok := checks[u.Host]
+	if !ok {
+		execution.Status = statusUnknown
+		return execution, fmt.Errorf(
+			"unknown builtin check %q, must be one of %s", u.Host, strings.Join(Names(), ", "),
+		)
+	}
+
+	if execution.Timeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(execution.Timeout)*time.Second)
+		defer cancel()
+	}
+
+	status, output, err := run(ctx, u.Query())
+	if ctx.Err() == context.DeadlineExceeded {
+		execution.Output = command.TimeoutOutput
+		execution.Status = command.TimeoutExitStatus
+		return execution, nil
+	}
+	if err != nil {
+		status = statusUnknown
+		output = err.Error()
+	}
+
+	execution.Output = fmt.Sprintf("%s %s: %s\n", strings.ToUpper(u.Host), statusNames[status], output)
+	execution.Status = status
+	return execution, nil
+}
+
+// thresholds are the warning and critical levels of a value, as percentages.
+type thresholds struct {
+	warning  float64
+	critical float64
+}
+
+// getThresholds returns the warning and critical thresholds of the
+// parameters, or the given defaults.
+func getThresholds(params url.Values, warning, critical float64) (thresholds, error) {
+	t := thresholds{warning: warning, critical: critical}
+	var err error
+	if v := params.Get("warning"); v != "" {
+		if t.warning, err = strconv.ParseFloat(v, 64); err != nil {
+			return t, fmt.Errorf("invalid warning threshold %q", v)
+		}
+	}
+	if v := params.Get("critical"); v != "" {
+		if t.critical, err = strconv.ParseFloat(v, 64); err != nil {
+			return t, fmt.Errorf("invalid critical threshold %q", v)
+		}
+	}
+	return t, nil
+}
+
+// status returns the status of the value given the thresholds.
+func (t thresholds) status(value float64) int {
+	switch {
+	case value >= t.critical:
+		return statusCritical
+	case value >= t.warning:
+		return statusWarning
+	default:
+		return statusOK
+	}
+}
+
+// perfdata returns the Nagios performance data of a percentage.
+func (t thresholds) perfdata(label string, value float64) string {
+	return fmt.Sprintf("%s=%.2f%%;%g;%g;0;100", label, value, t.warning, t.critical)
+}
+
+// getDuration returns the duration of the parameter, or the given default.
+func getDuration(params url.Values, key string, def time.Duration) (time.Duration, error) {
+	v := params.Get(key)
+	if v == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", key, v)
+	}
+	return d, nil
+}
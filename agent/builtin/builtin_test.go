@@ -0,0 +1,108 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sensu/sensu-go/command"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func execute(t *testing.T, cmd string) *command.Execution {
+	ex, err := Execute(context.Background(), &command.Execution{Command: cmd})
+	require.NoError(t, err)
+	return ex
+}
+
+func TestIsBuiltin(t *testing.T) {
+	assert.True(t, IsBuiltin("builtin://cpu"))
+	assert.True(t, IsBuiltin(" builtin://disk?path=/"))
+	assert.False(t, IsBuiltin("check-cpu.rb"))
+}
+
+func TestExecuteUnknown(t *testing.T) {
+	ex, err := Execute(context.Background(), &command.Execution{Command: "builtin://swap"})
+	assert.Error(t, err)
+	assert.Equal(t, statusUnknown, ex.Status)
+}
+
+func TestExecuteSystemChecks(t *testing.T) {
+	testCases := []struct {
+		command string
+		status  int
+		prefix  string
+	}{
+		{"builtin://cpu?interval=10ms&warning=101&critical=101", statusOK, "CPU OK: "},
+		{"builtin://memory?warning=0&critical=101", statusWarning, "MEMORY WARNING: "},
+		{"builtin://disk?critical=0", statusCritical, "DISK CRITICAL: "},
+		{"builtin://disk?critical=high", statusUnknown, "DISK UNKNOWN: invalid critical threshold"},
+		{"builtin://process", statusUnknown, "PROCESS UNKNOWN: missing process name"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.command, func(t *testing.T) {
+			ex := execute(t, tc.command)
+			assert.Equal(t, tc.status, ex.Status)
+			assert.Contains(t, ex.Output, tc.prefix)
+		})
+	}
+}
+
+func TestCheckProcess(t *testing.T) {
+	name := filepath.Base(os.Args[0])
+	ex := execute(t, "builtin://process?name="+name)
+	assert.Equal(t, statusOK, ex.Status, ex.Output)
+
+	ex = execute(t, "builtin://process?min=2&name="+name)
+	assert.Equal(t, statusCritical, ex.Status, ex.Output)
+}
+
+func TestCheckTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+
+	ex := execute(t, "builtin://tcp?address="+address)
+	assert.Equal(t, statusOK, ex.Status, ex.Output)
+
+	require.NoError(t, listener.Close())
+	ex = execute(t, "builtin://tcp?address="+address)
+	assert.Equal(t, statusCritical, ex.Status, ex.Output)
+}
+
+func TestCheckHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ex := execute(t, "builtin://http?url="+server.URL)
+	assert.Equal(t, statusOK, ex.Status, ex.Output)
+	assert.Contains(t, ex.Output, "responded 200")
+
+	ex = execute(t, fmt.Sprintf("builtin://http?url=%s/missing", server.URL))
+	assert.Equal(t, statusCritical, ex.Status, ex.Output)
+
+	ex = execute(t, fmt.Sprintf("builtin://http?status=404&url=%s/missing", server.URL))
+	assert.Equal(t, statusOK, ex.Status, ex.Output)
+}
+
+func TestCheckICMP(t *testing.T) {
+	ex := execute(t, "builtin://icmp?timeout=2s&host=127.0.0.1")
+	if ex.Status == statusUnknown {
+		t.Skipf("icmp sockets are not available: %s", ex.Output)
+	}
+	assert.Equal(t, statusOK, ex.Status, ex.Output)
+
+	ex = execute(t, "builtin://icmp?host=invalid.invalid")
+	assert.Equal(t, statusCritical, ex.Status, ex.Output)
+}
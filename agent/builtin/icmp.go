@@ -0,0 +1,103 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+const (
+	protocolICMP     = 1
+	protocolIPv6ICMP = 58
+)
+
+// checkICMP checks that the host parameter replies to an ICMP echo request.
+// Unprivileged datagram sockets are used where the system allows them, and raw
+// sockets otherwise.
+func checkICMP(ctx context.Context, params url.Values) (int, string, error) {
+	host := params.Get("host")
+	if host == "" {
+		return 0, "", errors.New("missing host")
+	}
+	timeout, err := getDuration(params, "timeout", defaultNetworkTimeout)
+	if err != nil {
+		return 0, "", err
+	}
+
+	addr, err := net.ResolveIPAddr("ip", host)
+	if err != nil {
+		return statusCritical, fmt.Sprintf("could not resolve %s: %s", host, err), nil
+	}
+
+	network, rawNetwork, protocol := "udp4", "ip4:icmp", protocolICMP
+	var requestType, replyType icmp.Type = ipv4.ICMPTypeEcho, ipv4.ICMPTypeEchoReply
+	if addr.IP.To4() == nil {
+		network, rawNetwork, protocol = "udp6", "ip6:ipv6-icmp", protocolIPv6ICMP
+		requestType, replyType = ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply
+	}
+
+	var dst net.Addr = &net.UDPAddr{IP: addr.IP, Zone: addr.Zone}
+	raw := false
+	conn, err := icmp.ListenPacket(network, "")
+	if err != nil {
+		dst, raw = addr, true
+		if conn, err = icmp.ListenPacket(rawNetwork, ""); err != nil {
+			return 0, "", fmt.Errorf("could not open an icmp socket: %s", err)
+		}
+	}
+	defer func() { _ = conn.Close() }()
+
+	deadline := time.Now().Add(timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return 0, "", err
+	}
+
+	id, seq := os.Getpid()&0xffff, 1
+	request, err := (&icmp.Message{
+		Type: requestType,
+		Body: &icmp.Echo{ID: id, Seq: seq, Data: []byte("sensu")},
+	}).Marshal(nil)
+	if err != nil {
+		return 0, "", err
+	}
+
+	started := time.Now()
+	if _, err := conn.WriteTo(request, dst); err != nil {
+		return statusCritical, fmt.Sprintf("could not ping %s: %s", host, err), nil
+	}
+
+	buf := make([]byte, 1500)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			return statusCritical, fmt.Sprintf("no reply from %s: %s", host, err), nil
+		}
+
+		reply, err := icmp.ParseMessage(protocol, buf[:n])
+		if err != nil || reply.Type != replyType {
+			continue
+		}
+		// The kernel rewrites the ID of the datagram sockets, so only raw
+		// sockets receive replies of other processes.
+		echo, ok := reply.Body.(*icmp.Echo)
+		if !ok || echo.Seq != seq || (raw && echo.ID != id) {
+			continue
+		}
+
+		elapsed := time.Since(started).Seconds()
+		return statusOK, fmt.Sprintf(
+			"%s replied in %.3fs | time=%.6fs;;;0", host, elapsed, elapsed,
+		), nil
+	}
+}
@@ -0,0 +1,83 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// defaultNetworkTimeout is the timeout of the network checks, unless set by
+// their timeout parameter.
+const defaultNetworkTimeout = 10 * time.Second
+
+// checkTCP checks that a TCP connection can be established to the address
+// parameter, as host:port.
+func checkTCP(ctx context.Context, params url.Values) (int, string, error) {
+	address := params.Get("address")
+	if address == "" {
+		return 0, "", errors.New("missing address")
+	}
+	timeout, err := getDuration(params, "timeout", defaultNetworkTimeout)
+	if err != nil {
+		return 0, "", err
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	started := time.Now()
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return statusCritical, fmt.Sprintf("could not connect to %s: %s", address, err), nil
+	}
+	elapsed := time.Since(started).Seconds()
+	_ = conn.Close()
+
+	return statusOK, fmt.Sprintf(
+		"connected to %s in %.3fs | time=%.6fs;;;0", address, elapsed, elapsed,
+	), nil
+}
+
+// checkHTTP checks that a GET request to the url parameter responds with the
+// status parameter, or any status below 400 by default.
+func checkHTTP(ctx context.Context, params url.Values) (int, string, error) {
+	target := params.Get("url")
+	if target == "" {
+		return 0, "", errors.New("missing url")
+	}
+	timeout, err := getDuration(params, "timeout", defaultNetworkTimeout)
+	if err != nil {
+		return 0, "", err
+	}
+	expected := 0
+	if v := params.Get("status"); v != "" {
+		if expected, err = strconv.Atoi(v); err != nil {
+			return 0, "", fmt.Errorf("invalid status %q", v)
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return 0, "", err
+	}
+
+	client := &http.Client{Timeout: timeout}
+	started := time.Now()
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return statusCritical, fmt.Sprintf("could not request %s: %s", target, err), nil
+	}
+	elapsed := time.Since(started).Seconds()
+	_ = resp.Body.Close()
+
+	status := statusOK
+	if (expected != 0 && resp.StatusCode != expected) || (expected == 0 && resp.StatusCode >= 400) {
+		status = statusCritical
+	}
+	return status, fmt.Sprintf(
+		"%s responded %d in %.3fs | time=%.6fs;;;0", target, resp.StatusCode, elapsed, elapsed,
+	), nil
+}
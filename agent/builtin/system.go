@@ -0,0 +1,122 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/disk"
+	"github.com/shirou/gopsutil/mem"
+	"github.com/shirou/gopsutil/process"
+)
+
+// checkCPU checks the CPU usage of the system, sampled over the interval
+// parameter (1s by default).
+func checkCPU(ctx context.Context, params url.Values) (int, string, error) {
+	t, err := getThresholds(params, 80, 90)
+	if err != nil {
+		return 0, "", err
+	}
+	interval, err := getDuration(params, "interval", time.Second)
+	if err != nil {
+		return 0, "", err
+	}
+
+	percents, err := cpu.Percent(interval, false)
+	if err != nil {
+		return 0, "", err
+	}
+	if len(percents) == 0 {
+		return 0, "", errors.New("could not determine the cpu usage")
+	}
+
+	used := percents[0]
+	return t.status(used), fmt.Sprintf("%.2f%% used | %s", used, t.perfdata("cpu", used)), nil
+}
+
+// checkMemory checks the memory usage of the system.
+func checkMemory(ctx context.Context, params url.Values) (int, string, error) {
+	t, err := getThresholds(params, 80, 90)
+	if err != nil {
+		return 0, "", err
+	}
+
+	stat, err := mem.VirtualMemory()
+	if err != nil {
+		return 0, "", err
+	}
+
+	used := stat.UsedPercent
+	return t.status(used), fmt.Sprintf(
+		"%.2f%% used of %d bytes | %s", used, stat.Total, t.perfdata("memory", used),
+	), nil
+}
+
+// checkDisk checks the usage of the filesystem of the path parameter (/ by
+// default).
+func checkDisk(ctx context.Context, params url.Values) (int, string, error) {
+	t, err := getThresholds(params, 80, 90)
+	if err != nil {
+		return 0, "", err
+	}
+	path := params.Get("path")
+	if path == "" {
+		path = "/"
+	}
+
+	stat, err := disk.Usage(path)
+	if err != nil {
+		return 0, "", err
+	}
+
+	used := stat.UsedPercent
+	return t.status(used), fmt.Sprintf(
+		"%.2f%% of %s used | %s", used, path, t.perfdata("disk", used),
+	), nil
+}
+
+// checkProcess checks that at least the min parameter (1 by default) of
+// processes named after the name parameter are running.
+func checkProcess(ctx context.Context, params url.Values) (int, string, error) {
+	name := params.Get("name")
+	if name == "" {
+		return 0, "", errors.New("missing process name")
+	}
+	min := 1
+	if v := params.Get("min"); v != "" {
+		var err error
+		if min, err = strconv.Atoi(v); err != nil {
+			return 0, "", fmt.Errorf("invalid min %q", v)
+		}
+	}
+
+	pids, err := process.Pids()
+	if err != nil {
+		return 0, "", err
+	}
+
+	count := 0
+	for _, pid := range pids {
+		if ctx.Err() != nil {
+			return 0, "", ctx.Err()
+		}
+		p, err := process.NewProcess(pid)
+		if err != nil {
+			// The process exited in the meantime
+			continue
+		}
+		if n, err := p.Name(); err == nil && n == name {
+			count++
+		}
+	}
+
+	status := statusOK
+	if count < min {
+		status = statusCritical
+	}
+	return status, fmt.Sprintf("%d %s processes running | processes=%d;;%d;0", count, name, count, min), nil
+}
@@ -5,12 +5,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
+	"os"
 	"time"
 
+	"github.com/sensu/sensu-go/agent/assetmanager"
+	"github.com/sensu/sensu-go/agent/builtin"
 	"github.com/sensu/sensu-go/command"
 	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
 	"github.com/sensu/sensu-go/types/dynamic"
+	"github.com/sensu/sensu-go/util/tracing"
+	"go.opencensus.io/trace"
 )
 
 // TODO(greg): At some point, we're going to need max parallelism.
@@ -18,10 +24,30 @@ func (a *Agent) handleCheck(payload []byte) error {
 	request := &types.CheckRequest{}
 	if err := json.Unmarshal(payload, request); err != nil {
 		return err
-	} else if request == nil {
+	} else if request == nil || request.Config == nil {
 		return errors.New("given check configuration appears invalid")
 	}
 
+	// The check requests published on the bus by anyone without the signing
+	// key of the organization are not executed
+	if a.verifier != nil {
+		if err := a.verifier.Verify(request); err != nil {
+			return fmt.Errorf("rejecting check request %s: %s", request.Config.Name, err)
+		}
+	}
+
+	// The check is executed at the interval, and with the overrides, of the
+	// agent's entity
+	if !a.applyCheckOverride(request) {
+		return nil
+	}
+
+	return a.scheduleCheck(request)
+}
+
+// scheduleCheck executes the requested check, unless its previous execution
+// is still in progress.
+func (a *Agent) scheduleCheck(request *types.CheckRequest) error {
 	// only schedule check execution if its not already in progress
 	// ** check hooks are part of a checks execution
 	a.inProgressMu.Lock()
@@ -37,7 +63,18 @@ func (a *Agent) handleCheck(payload []byte) error {
 			return nil
 		}
 
-		go a.executeCheck(request)
+		if !a.startExecution() {
+			return fmt.Errorf("agent shutting down, not executing check: %s", request.Config.Name)
+		}
+
+		go func() {
+			defer a.executions.Done()
+			if request.SplayWindow > 0 {
+				a.executeSplayedCheck(request)
+			} else {
+				a.executeCheck(request)
+			}
+		}()
 	} else {
 		return fmt.Errorf("check execution still in progress: %s", request.Config.Name)
 	}
@@ -45,6 +82,30 @@ func (a *Agent) handleCheck(payload []byte) error {
 	return nil
 }
 
+// executeSplayedCheck executes the check once the splay offset of the agent
+// has elapsed, so the subscribers of the check don't all execute it at once.
+func (a *Agent) executeSplayedCheck(request *types.CheckRequest) {
+	offset := splayOffset(a.config.AgentID, request)
+	logger.Debugf("delaying execution of check %s by %s", request.Config.Name, offset)
+
+	select {
+	case <-a.stopping:
+	case <-a.draining:
+		logger.Debugf("agent shutting down, not executing check %s", request.Config.Name)
+	case <-time.After(offset):
+		a.executeCheck(request)
+	}
+}
+
+// splayOffset returns the delay before executing the requested check. It is
+// derived from the agent ID and the check name, so every agent executes a
+// given check at a consistent point of the splay window.
+func splayOffset(agentID string, request *types.CheckRequest) time.Duration {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(agentID + "/" + request.Config.Name))
+	return time.Duration(h.Sum32()%request.SplayWindow) * time.Millisecond
+}
+
 func (a *Agent) executeCheck(request *types.CheckRequest) {
 	a.inProgressMu.Lock()
 	a.inProgress[request.Config.Name] = request.Config
@@ -59,23 +120,50 @@ func (a *Agent) executeCheck(request *types.CheckRequest) {
 	checkAssets := request.Assets
 	checkHooks := request.Hooks
 
+	ctx, span := trace.StartSpan(context.Background(), "agent.executeCheck")
+	defer span.End()
+	span.AddAttributes(trace.StringAttribute("check", checkConfig.Name))
+
 	// Instantiate Event
+	executed := time.Now()
 	check := types.NewCheck(checkConfig)
-	check.Executed = time.Now().Unix()
+	check.Executed = executed.Unix()
+	check.Issued = request.Issued / 1000
+	check.RequestID = request.ID
 	event := &types.Event{
 		Check: check,
+		Timestamps: &types.EventTimestamps{
+			Issued:   request.Issued,
+			Executed: types.UnixMilli(executed),
+		},
 	}
 
 	// Ensure that the asset manager is aware of all the assets required to
-	// execute the given check.
-	assets := a.assetManager.RegisterSet(checkAssets)
+	// execute the given check. The minimal profile has no asset manager.
+	var assets *assetmanager.RuntimeAssetSet
+	if a.assetManager != nil {
+		assets = a.assetManager.RegisterSet(checkAssets)
+	} else if len(checkAssets) > 0 {
+		a.sendFailure(event, errors.New("runtime assets are disabled by the minimal agent profile"))
+		return
+	} else {
+		assets = assetmanager.NewRuntimeAssetSet(nil, os.Environ())
+	}
 
 	// Inject the dependenices into PATH, LD_LIBRARY_PATH & CPATH so that they are
 	// availabe when when the command is executed.
 	ex := &command.Execution{
-		Env:     assets.Env(),
-		Command: checkConfig.Command,
-		Timeout: int(checkConfig.Timeout),
+		Env:         assets.Env(),
+		Command:     checkConfig.Command,
+		Direct:      checkConfig.ExecType == types.CheckExecTypeDirect,
+		Shell:       checkConfig.Shell,
+		RunAs:       checkConfig.RunAs,
+		Dir:         checkConfig.WorkingDirectory,
+		CPULimit:    checkConfig.CPULimit,
+		MemoryLimit: checkConfig.MemoryLimit,
+		Nice:        int(checkConfig.Nice),
+		Timeout:     int(checkConfig.Timeout),
+		OutputLimit: a.config.OutputLimit,
 	}
 
 	// If stdin is true, add JSON event data to command execution.
@@ -94,22 +182,55 @@ func (a *Agent) executeCheck(request *types.CheckRequest) {
 		return
 	}
 
-	if _, err := command.ExecuteCommand(context.Background(), ex); err != nil {
+	// The builtin checks are run by the agent itself.
+	execute := command.ExecuteCommand
+	if builtin.IsBuiltin(checkConfig.Command) {
+		execute = builtin.Execute
+	}
+
+	if _, err := execute(ctx, ex); err != nil {
+		tracing.SetError(span, err)
 		event.Check.Output = err.Error()
 	} else {
 		event.Check.Output = ex.Output
 	}
+	if ex.OutputTruncated {
+		logger.WithField("check", checkConfig.Name).Warningf("check output truncated to %d bytes", ex.OutputLimit)
+	}
 
 	event.Check.Duration = ex.Duration
+	event.Check.CPUTime = ex.CPUTime
+	event.Check.MaxRSS = ex.MaxRSS
 	event.Check.Status = int32(ex.Status)
 
+	// The annotations of the event are provided by a JSON object at the end
+	// of the output.
+	if checkConfig.OutputAnnotations {
+		event.Check.Output, event.Check.Annotations = parseOutputAnnotations(event.Check.Output)
+	}
+
+	// Nagios plugins provide their performance data and long output within
+	// their output, and any unexpected exit code is unknown.
+	if checkConfig.Nagios {
+		event.Check.Status = int32(nagiosStatus(ex.Status))
+		output, longOutput, perfdata := parseNagiosOutput(event.Check.Output)
+		event.Check.Output = output
+		event.Check.LongOutput = longOutput
+		if points := parseNagiosPerfdata(perfdata, time.Unix(event.Check.Executed, 0).UnixNano()); len(points) > 0 {
+			event.Metrics = &types.Metrics{Handlers: []string{}, Points: points}
+		}
+	}
+	span.AddAttributes(trace.Int64Attribute("status", int64(event.Check.Status)))
+
 	event.Entity = a.getAgentEntity()
 	event.Timestamp = time.Now().Unix()
 
 	if len(checkHooks) != 0 {
-		event.Hooks = a.ExecuteHooks(request, ex.Status)
+		event.Hooks = a.ExecuteHooks(request, int(event.Check.Status))
 	}
 
+	event.SetSentTimestamp()
+	a.results.record(event)
 	msg, err := json.Marshal(event)
 	if err != nil {
 		logger.Error("error marshaling check result: ", err.Error())
@@ -145,7 +266,12 @@ func (a *Agent) prepareCheck(cfg *types.CheckConfig) bool {
 	}
 
 	// Substitute tokens within the check configuration with the synthesized
-	// entity
+	// entity, except in the links, evaluated with the event by the backend
+	runbookURL, dashboardURL := cfg.RunbookURL, cfg.DashboardURL
+	defer func() {
+		cfg.RunbookURL, cfg.DashboardURL = runbookURL, dashboardURL
+	}()
+	cfg.RunbookURL, cfg.DashboardURL = "", ""
 	checkBytes, err := TokenSubstitution(synthesizedEntity, cfg)
 	if err != nil {
 		a.sendFailure(event, err)
@@ -168,6 +294,8 @@ func (a *Agent) sendFailure(event *types.Event, err error) {
 	event.Check.Status = 3
 	event.Entity = a.getAgentEntity()
 	event.Timestamp = time.Now().Unix()
+	event.SetSentTimestamp()
+	a.results.record(event)
 
 	if msg, err := json.Marshal(event); err != nil {
 		logger.Error("error marshaling check failure: ", err.Error())
@@ -1,14 +1,18 @@
 package agent
 
 import (
+	"crypto/ecdsa"
 	"encoding/json"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/sensu/sensu-go/signing"
 	"github.com/sensu/sensu-go/testing/testutil"
 	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var binDir = filepath.Join("..", "bin")
@@ -45,6 +49,39 @@ func TestHandleCheck(t *testing.T) {
 	assert.NoError(agent.handleCheck(payload))
 }
 
+func TestHandleCheckSignature(t *testing.T) {
+	checkConfig := types.FixtureCheckConfig("check")
+	checkConfig.Command = testutil.CommandPath(filepath.Join(toolsDir, "true"))
+	request := &types.CheckRequest{Config: checkConfig, Issued: types.UnixMilli(time.Now())}
+
+	key, err := signing.GenerateKey()
+	require.NoError(t, err)
+
+	agent := NewAgent(NewConfig())
+	agent.sendq = make(chan *transport.Message, 5)
+	agent.verifier = signing.NewVerifier(&key.PublicKey)
+
+	// The unsigned requests are rejected
+	payload, err := json.Marshal(request)
+	require.NoError(t, err)
+	err = agent.handleCheck(payload)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), signing.ErrMissingSignature.Error())
+
+	// As are the requests signed with another key
+	other, err := signing.GenerateKey()
+	require.NoError(t, err)
+	require.NoError(t, signing.NewKeyring(map[string]*ecdsa.PrivateKey{"default": other}).Sign(request))
+	payload, err = json.Marshal(request)
+	require.NoError(t, err)
+	assert.Error(t, agent.handleCheck(payload))
+
+	require.NoError(t, signing.NewKeyring(map[string]*ecdsa.PrivateKey{"default": key}).Sign(request))
+	payload, err = json.Marshal(request)
+	require.NoError(t, err)
+	assert.NoError(t, agent.handleCheck(payload))
+}
+
 func TestExecuteCheck(t *testing.T) {
 	assert := assert.New(t)
 
@@ -96,6 +133,36 @@ func TestExecuteCheck(t *testing.T) {
 	assert.EqualValues(int32(2), event.Check.Status)
 }
 
+func TestExecuteCheckMinimalProfile(t *testing.T) {
+	config := NewConfig()
+	config.Profile = ProfileMinimal
+	agent := NewAgent(config)
+	require.Nil(t, agent.assetManager)
+	assert.Equal(t, MinimalMessageBufferSize, cap(agent.sendq))
+
+	ch := make(chan *transport.Message, 1)
+	agent.sendq = ch
+
+	// The checks without runtime assets are executed
+	checkConfig := types.FixtureCheckConfig("check")
+	checkConfig.Command = "builtin://memory"
+	checkConfig.Timeout = 10
+	agent.executeCheck(&types.CheckRequest{Config: checkConfig})
+
+	event := &types.Event{}
+	require.NoError(t, json.Unmarshal((<-ch).Payload, event))
+	assert.Contains(t, event.Check.Output, "MEMORY")
+
+	// The others fail
+	request := &types.CheckRequest{Config: checkConfig, Assets: []types.Asset{*types.FixtureAsset("asset")}}
+	agent.executeCheck(request)
+
+	event = &types.Event{}
+	require.NoError(t, json.Unmarshal((<-ch).Payload, event))
+	assert.Equal(t, int32(3), event.Check.Status)
+	assert.Contains(t, event.Check.Output, "runtime assets are disabled")
+}
+
 func TestPrepareCheck(t *testing.T) {
 	assert := assert.New(t)
 
@@ -111,3 +178,18 @@ func TestPrepareCheck(t *testing.T) {
 	check.Interval = 60
 	assert.True(agent.prepareCheck(check))
 }
+
+func TestSplayOffset(t *testing.T) {
+	assert := assert.New(t)
+
+	request := &types.CheckRequest{
+		Config:      types.FixtureCheckConfig("check"),
+		SplayWindow: 9000,
+	}
+
+	offset := splayOffset("agent1", request)
+	assert.True(offset >= 0 && offset < 9*time.Second)
+
+	// The offset of an agent is consistent between executions
+	assert.Equal(offset, splayOffset("agent1", request))
+}
@@ -2,13 +2,25 @@ package agent
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/sensu/sensu-go/system"
 	"github.com/sensu/sensu-go/types"
 	"github.com/sensu/sensu-go/types/dynamic"
 )
 
+// getAgentEntity returns the entity of the agent. The entity returned must not
+// be modified, see updateAgentEntity.
 func (a *Agent) getAgentEntity() *types.Entity {
+	a.entityMu.Lock()
+	defer a.entityMu.Unlock()
+
+	return a.agentEntity()
+}
+
+// agentEntity returns the entity of the agent, creating it on the first call.
+// The caller must hold entityMu.
+func (a *Agent) agentEntity() *types.Entity {
 	if a.entity == nil {
 		e := &types.Entity{
 			Class:            types.EntityAgentClass,
@@ -16,6 +28,7 @@ func (a *Agent) getAgentEntity() *types.Entity {
 			Environment:      a.config.Environment,
 			ID:               a.config.AgentID,
 			KeepaliveTimeout: a.config.KeepaliveTimeout,
+			Labels:           a.config.Labels,
 			Organization:     a.config.Organization,
 			Redact:           a.config.Redact,
 			Subscriptions:    a.config.Subscriptions,
@@ -46,12 +59,33 @@ func (a *Agent) getAgentEntity() *types.Entity {
 			e.System = s
 		}
 
+		// The labels and annotations pushed by the backend take precedence
+		// over the configured ones
+		patch, err := a.loadMetadataPatch()
+		if err != nil {
+			logger.WithError(err).Error("error loading the entity metadata")
+		} else if patch != nil {
+			patch.Apply(e)
+		}
+
 		a.entity = e
 	}
 
 	return a.entity
 }
 
+// updateAgentEntity applies the given changes to a copy of the agent entity,
+// which then replaces it. The entities previously returned by getAgentEntity
+// are left untouched, since they may be read by other goroutines.
+func (a *Agent) updateAgentEntity(update func(*types.Entity)) {
+	a.entityMu.Lock()
+	defer a.entityMu.Unlock()
+
+	entity := *a.agentEntity()
+	update(&entity)
+	a.entity = &entity
+}
+
 // getEntities receives an event and verifies if we have a proxy entity, so it
 // can be added as the source, and ensures that the event uses the agent's
 // entity
@@ -69,3 +103,78 @@ func (a *Agent) getEntities(event *types.Event) {
 	// backend
 	event.Entity = a.getAgentEntity()
 }
+
+// keepaliveFacts maps the system facts which can be included in the partial
+// keepalives to the functions copying them.
+var keepaliveFacts = map[string]func(dst, src *types.System){
+	"arch":             func(dst, src *types.System) { dst.Arch = src.Arch },
+	"hostname":         func(dst, src *types.System) { dst.Hostname = src.Hostname },
+	"network":          func(dst, src *types.System) { dst.Network = src.Network },
+	"os":               func(dst, src *types.System) { dst.OS = src.OS },
+	"platform":         func(dst, src *types.System) { dst.Platform = src.Platform },
+	"platform_family":  func(dst, src *types.System) { dst.PlatformFamily = src.PlatformFamily },
+	"platform_version": func(dst, src *types.System) { dst.PlatformVersion = src.PlatformVersion },
+}
+
+// ValidateKeepaliveFacts returns an error if any of the system facts can't be
+// included in the keepalives.
+func ValidateKeepaliveFacts(facts []string) error {
+	for _, fact := range facts {
+		if _, ok := keepaliveFacts[fact]; !ok {
+			return fmt.Errorf("unknown system fact %q", fact)
+		}
+	}
+	return nil
+}
+
+// getKeepaliveEntity returns the entity of the next keepalive. The full agent
+// entity is provided every KeepaliveSyncInterval keepalives, otherwise a
+// partial entity only providing the selected system facts and custom
+// attributes is returned.
+func (a *Agent) getKeepaliveEntity() *types.Entity {
+	entity := a.getAgentEntity()
+
+	interval := a.config.KeepaliveSyncInterval
+	sync := interval <= 1 || a.keepalives%interval == 0
+	a.keepalives++
+	if sync {
+		return entity
+	}
+
+	partial := &types.Entity{
+		Class:            entity.Class,
+		Deregister:       entity.Deregister,
+		Deregistration:   entity.Deregistration,
+		Environment:      entity.Environment,
+		ID:               entity.ID,
+		KeepaliveTimeout: entity.KeepaliveTimeout,
+		Labels:           entity.Labels,
+		Annotations:      entity.Annotations,
+		Organization:     entity.Organization,
+		Partial:          true,
+		Redact:           entity.Redact,
+		Subscriptions:    entity.Subscriptions,
+		User:             entity.User,
+	}
+
+	for _, fact := range a.config.KeepaliveFacts {
+		if copyFact, ok := keepaliveFacts[fact]; ok {
+			copyFact(&partial.System, &entity.System)
+		}
+	}
+
+	if len(a.config.KeepaliveAttributes) > 0 {
+		var attrMap map[string]json.RawMessage
+		if err := json.Unmarshal(a.config.ExtendedAttributes, &attrMap); err == nil {
+			selected := make(map[string]json.RawMessage, len(a.config.KeepaliveAttributes))
+			for _, name := range a.config.KeepaliveAttributes {
+				if value, ok := attrMap[name]; ok {
+					selected[name] = value
+				}
+			}
+			partial.ExtendedAttributes, _ = json.Marshal(selected)
+		}
+	}
+
+	return partial
+}
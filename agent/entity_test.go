@@ -97,3 +97,43 @@ func TestGetEntities(t *testing.T) {
 		})
 	}
 }
+
+func TestGetKeepaliveEntity(t *testing.T) {
+	agent := &Agent{
+		config: &Config{
+			AgentID:               "foo",
+			ExtendedAttributes:    []byte(`{"team":"ops","rack":12}`),
+			KeepaliveAttributes:   []string{"team"},
+			KeepaliveFacts:        []string{"hostname"},
+			KeepaliveSyncInterval: 3,
+			Subscriptions:         []string{"linux"},
+		},
+	}
+	agent.getAgentEntity().System = types.System{Hostname: "foo.local", OS: "linux"}
+
+	// The first keepalive provides the full entity
+	entity := agent.getKeepaliveEntity()
+	assert.False(t, entity.Partial)
+	assert.Equal(t, "linux", entity.System.OS)
+
+	// The following ones only provide the selected facts and attributes
+	for i := 0; i < 2; i++ {
+		entity = agent.getKeepaliveEntity()
+		assert.True(t, entity.Partial)
+		assert.Equal(t, "foo", entity.ID)
+		assert.Equal(t, []string{"linux"}, entity.Subscriptions)
+		assert.Equal(t, "foo.local", entity.System.Hostname)
+		assert.Empty(t, entity.System.OS)
+		assert.JSONEq(t, `{"team":"ops"}`, string(entity.ExtendedAttributes))
+	}
+
+	// Until the next full synchronization
+	entity = agent.getKeepaliveEntity()
+	assert.False(t, entity.Partial)
+}
+
+func TestValidateKeepaliveFacts(t *testing.T) {
+	assert.NoError(t, ValidateKeepaliveFacts(nil))
+	assert.NoError(t, ValidateKeepaliveFacts([]string{"hostname", "network"}))
+	assert.Error(t, ValidateKeepaliveFacts([]string{"hostname", "uptime"}))
+}
@@ -1,9 +1,11 @@
 package agent
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
+	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
 	"github.com/sensu/sensu-go/types/v1"
 )
@@ -52,6 +54,23 @@ func prepareEvent(a *Agent, event *types.Event) error {
 	return event.Entity.Validate()
 }
 
+// sendEvent prepares the event received by a listener of the agent and sends
+// it to the backend.
+func (a *Agent) sendEvent(event *types.Event) error {
+	if err := prepareEvent(a, event); err != nil {
+		return err
+	}
+	event.SetSentTimestamp()
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	a.sendMessage(transport.MessageTypeEvent, payload)
+	return nil
+}
+
 // translateToEvent accepts a 1.x compatible check result
 // and attempts to translate it to a 2.x event
 func translateToEvent(a *Agent, result v1.CheckResult, event *types.Event) error {
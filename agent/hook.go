@@ -49,8 +49,9 @@ func (a *Agent) executeHook(hookConfig *types.HookConfig) *types.Hook {
 
 	// Instantiate the execution command
 	ex := &command.Execution{
-		Command: hookConfig.Command,
-		Timeout: int(hookConfig.Timeout),
+		Command:     hookConfig.Command,
+		Timeout:     int(hookConfig.Timeout),
+		OutputLimit: a.config.OutputLimit,
 	}
 
 	// If stdin is true, add JSON event data to command execution.
@@ -68,6 +69,9 @@ func (a *Agent) executeHook(hookConfig *types.HookConfig) *types.Hook {
 	} else {
 		hook.Output = ex.Output
 	}
+	if ex.OutputTruncated {
+		logger.WithField("hook", hookConfig.Name).Warningf("hook output truncated to %d bytes", ex.OutputLimit)
+	}
 
 	hook.Duration = ex.Duration
 	hook.Status = int32(ex.Status)
@@ -0,0 +1,85 @@
+package agent
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/types"
+)
+
+// metadataFile is the file of the cache directory keeping the changes of the
+// labels and annotations of the entity pushed by the backend, so that they
+// survive the restarts of the agent.
+const metadataFile = "entity_metadata.json"
+
+// handleMetadataPatch applies the changes of the labels and annotations of the
+// entity pushed by the backend, which are then included in the next events and
+// keepalives.
+func (a *Agent) handleMetadataPatch(payload []byte) error {
+	patch := &types.EntityMetadataPatch{}
+	if err := json.Unmarshal(payload, patch); err != nil {
+		return err
+	}
+	if err := patch.Validate(); err != nil {
+		return err
+	}
+
+	a.updateAgentEntity(patch.Apply)
+	entity := a.getAgentEntity()
+	logger.WithFields(logrus.Fields{
+		"labels":      entity.Labels,
+		"annotations": entity.Annotations,
+	}).Info("agent entity metadata changed")
+
+	return a.saveMetadataPatch(patch)
+}
+
+// loadMetadataPatch returns the changes of the labels and annotations pushed
+// by the backend so far, or nil if there's none.
+func (a *Agent) loadMetadataPatch() (*types.EntityMetadataPatch, error) {
+	b, err := ioutil.ReadFile(filepath.Join(a.config.CacheDir, metadataFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	patch := &types.EntityMetadataPatch{}
+	if err := json.Unmarshal(b, patch); err != nil {
+		return nil, err
+	}
+	return patch, nil
+}
+
+// saveMetadataPatch merges the given changes with the ones pushed by the
+// backend so far, and writes them to the cache directory.
+func (a *Agent) saveMetadataPatch(patch *types.EntityMetadataPatch) error {
+	merged, err := a.loadMetadataPatch()
+	if err != nil {
+		logger.WithError(err).Warn("discarding the invalid entity metadata of the cache")
+		merged = nil
+	}
+	if merged == nil {
+		merged = &types.EntityMetadataPatch{}
+	}
+	merged.Merge(patch)
+
+	b, err := json.Marshal(merged)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(a.config.CacheDir, 0700); err != nil {
+		return err
+	}
+
+	// The file is replaced atomically so that a crash can't truncate it
+	path := filepath.Join(a.config.CacheDir, metadataFile)
+	if err := ioutil.WriteFile(path+".tmp", b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
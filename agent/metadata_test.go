@@ -0,0 +1,49 @@
+package agent
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleMetadataPatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sensu-agent")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	cfg := NewConfig()
+	cfg.AgentID = "foo"
+	cfg.CacheDir = dir
+	cfg.Labels = map[string]string{"team": "dev", "region": "us-west-2"}
+	agent := NewAgent(cfg)
+
+	payload, err := json.Marshal(&types.EntityMetadataPatch{
+		Labels:      map[string]string{"team": "ops"},
+		Annotations: map[string]string{"owner": "alice"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, agent.handleMetadataPatch(payload))
+
+	payload, err = json.Marshal(&types.EntityMetadataPatch{RemoveLabels: []string{"region"}})
+	require.NoError(t, err)
+	require.NoError(t, agent.handleMetadataPatch(payload))
+
+	entity := agent.getAgentEntity()
+	assert.Equal(t, map[string]string{"team": "ops"}, entity.Labels)
+	assert.Equal(t, map[string]string{"owner": "alice"}, entity.Annotations)
+
+	// The changes survive the restarts of the agent
+	restarted := NewAgent(cfg)
+	entity = restarted.getAgentEntity()
+	assert.Equal(t, map[string]string{"team": "ops"}, entity.Labels)
+	assert.Equal(t, map[string]string{"owner": "alice"}, entity.Annotations)
+
+	// Invalid patches are rejected
+	assert.Error(t, agent.handleMetadataPatch([]byte("{}")))
+	assert.Error(t, agent.handleMetadataPatch([]byte("labels")))
+}
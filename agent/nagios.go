@@ -0,0 +1,120 @@
+package agent
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// nagiosUnknownStatus is the status of a Nagios plugin which could not
+// determine the state of the service it checks. Nagios treats any exit code
+// outside of 0 to 3 as unknown.
+const nagiosUnknownStatus = 3
+
+// nagiosStatus maps the exit code of a Nagios plugin to a check status.
+func nagiosStatus(status int) int {
+	if status < 0 || status > nagiosUnknownStatus {
+		return nagiosUnknownStatus
+	}
+	return status
+}
+
+// parseNagiosOutput splits the output of a Nagios plugin into its text output,
+// its long output and its performance data, following the plugin output
+// format:
+//
+//	TEXT OUTPUT | OPTIONAL PERFDATA
+//	LONG TEXT LINE 1
+//	LONG TEXT LINE 2 | PERFDATA LINE 2
+//	PERFDATA LINE 3
+func parseNagiosOutput(output string) (text, longOutput string, perfdata []string) {
+	lines := strings.Split(strings.TrimRight(output, "\r\n"), "\n")
+
+	text = lines[0]
+	if i := strings.Index(text, "|"); i >= 0 {
+		perfdata = append(perfdata, text[i+1:])
+		text = text[:i]
+	}
+	text = strings.TrimSpace(text)
+
+	var long []string
+	inPerfdata := false
+	for _, line := range lines[1:] {
+		if inPerfdata {
+			perfdata = append(perfdata, line)
+			continue
+		}
+		if i := strings.Index(line, "|"); i >= 0 {
+			perfdata = append(perfdata, line[i+1:])
+			line = line[:i]
+			inPerfdata = true
+		}
+		long = append(long, strings.TrimRight(line, "\r"))
+	}
+	longOutput = strings.TrimSpace(strings.Join(long, "\n"))
+
+	return text, longOutput, perfdata
+}
+
+// parseNagiosPerfdata extracts the metric points of the performance data of a
+// Nagios plugin, in the format 'label'=value[UOM];[warn];[crit];[min];[max].
+// Malformed or undetermined values are skipped.
+func parseNagiosPerfdata(perfdata []string, timestamp int64) []*types.MetricPoint {
+	points := []*types.MetricPoint{}
+
+	for _, data := range perfdata {
+		for _, field := range splitNagiosPerfdata(data) {
+			i := strings.LastIndex(field, "=")
+			if i <= 0 {
+				continue
+			}
+
+			label := strings.Trim(field[:i], "'")
+			value := strings.SplitN(field[i+1:], ";", 2)[0]
+			value = strings.TrimRight(value, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ%")
+
+			v, err := strconv.ParseFloat(value, 64)
+			if label == "" || err != nil {
+				continue
+			}
+
+			points = append(points, &types.MetricPoint{
+				Name:      label,
+				Value:     v,
+				Timestamp: timestamp,
+				Tags:      []*types.MetricTag{},
+			})
+		}
+	}
+
+	return points
+}
+
+// splitNagiosPerfdata splits the performance data on whitespace, except within
+// single-quoted labels.
+func splitNagiosPerfdata(perfdata string) []string {
+	var fields []string
+	var field []rune
+	quoted := false
+
+	for _, r := range perfdata {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+			field = append(field, r)
+		case !quoted && (r == ' ' || r == '\t' || r == '\r'):
+			if len(field) > 0 {
+				fields = append(fields, string(field))
+				field = field[:0]
+			}
+		default:
+			field = append(field, r)
+		}
+	}
+	if len(field) > 0 {
+		fields = append(fields, string(field))
+	}
+
+	return fields
+}
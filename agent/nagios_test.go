@@ -0,0 +1,81 @@
+package agent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNagiosStatus(t *testing.T) {
+	assert.Equal(t, 0, nagiosStatus(0))
+	assert.Equal(t, 2, nagiosStatus(2))
+	assert.Equal(t, 3, nagiosStatus(3))
+	assert.Equal(t, 3, nagiosStatus(127))
+	assert.Equal(t, 3, nagiosStatus(-1))
+}
+
+func TestParseNagiosOutput(t *testing.T) {
+	testCases := []struct {
+		name       string
+		output     string
+		text       string
+		longOutput string
+		perfdata   []string
+	}{
+		{
+			name:   "text only",
+			output: "DISK OK\n",
+			text:   "DISK OK",
+		},
+		{
+			name:     "text and perfdata",
+			output:   "DISK OK - free space: / 3326 MB (56%); | /=2643MB;5948;5958;0;5968\n",
+			text:     "DISK OK - free space: / 3326 MB (56%);",
+			perfdata: []string{" /=2643MB;5948;5958;0;5968"},
+		},
+		{
+			name: "long output and multiline perfdata",
+			output: "DISK OK - free space: / 3326 MB (56%); | /=2643MB;5948;5958;0;5968\n" +
+				"/ 15272 MB (77%);\n" +
+				"/boot 68 MB (69%); | /boot=68MB;88;93;0;98\n" +
+				"/home=69357MB;253404;253409;0;253414\n",
+			text:       "DISK OK - free space: / 3326 MB (56%);",
+			longOutput: "/ 15272 MB (77%);\n/boot 68 MB (69%);",
+			perfdata: []string{
+				" /=2643MB;5948;5958;0;5968",
+				" /boot=68MB;88;93;0;98",
+				"/home=69357MB;253404;253409;0;253414",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			text, longOutput, perfdata := parseNagiosOutput(tc.output)
+			assert.Equal(t, tc.text, text)
+			assert.Equal(t, tc.longOutput, longOutput)
+			assert.Equal(t, tc.perfdata, perfdata)
+		})
+	}
+}
+
+func TestParseNagiosPerfdata(t *testing.T) {
+	perfdata := []string{
+		"time=0.006s;;;0.000 'free space'=56%;20;10 size=5968MB",
+		"undetermined=U invalid load=1.5",
+	}
+
+	points := parseNagiosPerfdata(perfdata, 42)
+	require.Len(t, points, 4)
+
+	assert.Equal(t, "time", points[0].Name)
+	assert.Equal(t, 0.006, points[0].Value)
+	assert.Equal(t, int64(42), points[0].Timestamp)
+	assert.Equal(t, "free space", points[1].Name)
+	assert.Equal(t, float64(56), points[1].Value)
+	assert.Equal(t, "size", points[2].Name)
+	assert.Equal(t, float64(5968), points[2].Value)
+	assert.Equal(t, "load", points[3].Name)
+	assert.Equal(t, 1.5, points[3].Value)
+}
@@ -0,0 +1,39 @@
+package agent
+
+import "github.com/sensu/sensu-go/types"
+
+// applyCheckOverride merges the requested check with its override for the
+// agent's entity, if any, and determines if the entity executes the request.
+// The check is scheduled at the shortest interval of its overrides, so the
+// requests issued before the interval of the entity elapsed are skipped,
+// unless they are adhoc. The proxy checks are merged with the overrides of
+// their proxy entity by the scheduler.
+func (a *Agent) applyCheckOverride(request *types.CheckRequest) bool {
+	check := request.Config
+	if check.ProxyEntityID != "" || len(check.Overrides) == 0 {
+		return true
+	}
+
+	interval := check.Interval
+	if override := check.OverrideFor(a.getAgentEntity()); override != nil {
+		if override.Disabled {
+			logger.Debugf("check %s is disabled for this entity", check.Name)
+			return false
+		}
+		request.Config = check.WithOverride(override)
+		interval = request.Config.Interval
+	}
+
+	if request.Adhoc || interval <= check.SchedulingInterval() {
+		return true
+	}
+
+	a.inProgressMu.Lock()
+	defer a.inProgressMu.Unlock()
+	if !check.IntervalDue(interval, a.lastIssued[check.Name], request.Issued) {
+		logger.Debugf("check %s is not due at the interval of this entity", check.Name)
+		return false
+	}
+	a.lastIssued[check.Name] = request.Issued
+	return true
+}
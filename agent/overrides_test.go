@@ -0,0 +1,59 @@
+package agent
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyCheckOverride(t *testing.T) {
+	cfg := NewConfig()
+	cfg.AgentID = "web01"
+	cfg.Labels = map[string]string{"tier": "web"}
+	agent := NewAgent(cfg)
+
+	newRequest := func(issued int64, overrides ...types.CheckOverride) *types.CheckRequest {
+		request := types.FixtureCheckRequest("check")
+		request.Config.Interval = 60
+		request.Config.Timeout = 30
+		request.Config.Overrides = overrides
+		request.Issued = issued
+		return request
+	}
+
+	// The checks without overrides are left untouched
+	request := newRequest(1000000)
+	assert.True(t, agent.applyCheckOverride(request))
+	assert.Equal(t, uint32(30), request.Config.Timeout)
+
+	// The overrides of other entities don't apply, but the check is scheduled
+	// at their shorter interval
+	request = newRequest(1000000, types.CheckOverride{Entity: "web02", Interval: 30})
+	assert.True(t, agent.applyCheckOverride(request))
+	request = newRequest(1030000, types.CheckOverride{Entity: "web02", Interval: 30})
+	assert.False(t, agent.applyCheckOverride(request))
+	request = newRequest(1060000, types.CheckOverride{Entity: "web02", Interval: 30})
+	assert.True(t, agent.applyCheckOverride(request))
+
+	// The override of the entity is merged
+	override := types.CheckOverride{Labels: map[string]string{"tier": "web"}, Timeout: 5}
+	request = newRequest(2000000, override)
+	assert.True(t, agent.applyCheckOverride(request))
+	assert.Equal(t, uint32(5), request.Config.Timeout)
+	assert.Empty(t, request.Config.Overrides)
+
+	// The adhoc requests are executed regardless of the interval
+	request = newRequest(1070000, types.CheckOverride{Entity: "web02", Interval: 30})
+	request.Adhoc = true
+	assert.True(t, agent.applyCheckOverride(request))
+
+	// The disabled checks aren't executed
+	request = newRequest(3000000, types.CheckOverride{Entity: "web01", Disabled: true})
+	assert.False(t, agent.applyCheckOverride(request))
+
+	// The proxy checks are merged by the scheduler
+	request = newRequest(3000000, types.CheckOverride{Entity: "web01", Disabled: true})
+	request.Config.ProxyEntityID = "router01"
+	assert.True(t, agent.applyCheckOverride(request))
+}
@@ -0,0 +1,38 @@
+package agent
+
+import (
+	"sync"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// checkResults retains the event of the last execution of each check by the
+// agent, so node-local tooling can query it through the agent API without
+// going through the backend.
+type checkResults struct {
+	mu     sync.RWMutex
+	events map[string]*types.Event
+}
+
+func newCheckResults() *checkResults {
+	return &checkResults{events: make(map[string]*types.Event)}
+}
+
+// record retains the event as the last result of its check.
+func (r *checkResults) record(event *types.Event) {
+	if !event.HasCheck() {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events[event.Check.Name] = event
+}
+
+// get returns the last result of the named check, or nil if the check was not
+// executed yet.
+func (r *checkResults) get(name string) *types.Event {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.events[name]
+}
@@ -0,0 +1,37 @@
+package agent
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckResults(t *testing.T) {
+	results := newCheckResults()
+	assert.Nil(t, results.get("check_foo"))
+
+	first := types.FixtureEvent("foo", "check_foo")
+	results.record(first)
+	second := types.FixtureEvent("foo", "check_foo")
+	results.record(second)
+	assert.Equal(t, second, results.get("check_foo"))
+
+	// Events without a check are not results
+	results.record(&types.Event{Entity: types.FixtureEntity("foo")})
+	assert.Nil(t, results.get(""))
+}
+
+func TestSendFailureRecordsResult(t *testing.T) {
+	agent := NewAgent(NewConfig())
+	event := &types.Event{Check: types.FixtureCheck("check_foo")}
+
+	agent.sendFailure(event, errors.New("could not install the assets"))
+
+	result := agent.results.get("check_foo")
+	require.NotNil(t, result)
+	assert.Equal(t, int32(3), result.Check.Status)
+	assert.Equal(t, "could not install the assets", result.Check.Output)
+}
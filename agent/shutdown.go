@@ -0,0 +1,120 @@
+package agent
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/transport"
+	"github.com/sensu/sensu-go/types"
+)
+
+// Shutdown gracefully shuts down the agent. The check requests received from
+// then on are rejected, and the checks in progress are given until the
+// shutdown timeout to complete. The agents of ephemeral entities then ask the
+// backend to deregister them, and the queued messages are flushed before the
+// agent is stopped. The agent is only shut down once, however many times it's
+// called.
+func (a *Agent) Shutdown() {
+	a.shutdownOnce.Do(a.shutdown)
+}
+
+func (a *Agent) shutdown() {
+	a.inProgressMu.Lock()
+	close(a.draining)
+	a.inProgressMu.Unlock()
+
+	logger.Info("shutting down, waiting for the checks in progress")
+	timeout := time.Duration(a.config.ShutdownTimeout) * time.Second
+	if !waitTimeout(a.executions, timeout) {
+		logger.Warn("shutdown timeout reached, abandoning the checks in progress")
+	}
+
+	if a.config.Deregister {
+		if err := a.sendDeregistration(); err != nil {
+			logger.WithError(err).Error("failed sending deregistration")
+		}
+	}
+
+	if !a.flush(FlushTimeout) {
+		logger.Warn("flush timeout reached, discarding the queued messages")
+	}
+
+	a.Stop()
+}
+
+// startExecution registers a check execution, unless the agent is shutting
+// down.
+func (a *Agent) startExecution() bool {
+	a.inProgressMu.Lock()
+	defer a.inProgressMu.Unlock()
+
+	select {
+	case <-a.draining:
+		return false
+	default:
+	}
+
+	a.executions.Add(1)
+	return true
+}
+
+// sendDeregistration queues the message asking the backend to deregister the
+// agent's entity.
+func (a *Agent) sendDeregistration() error {
+	logger.Info("sending deregistration")
+	event := &types.Event{
+		Entity:    a.getAgentEntity(),
+		Timestamp: time.Now().Unix(),
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	msg := &transport.Message{
+		Type:    transport.MessageTypeDeregister,
+		Payload: payload,
+	}
+	select {
+	case a.sendq <- msg:
+		return nil
+	case <-time.After(FlushTimeout):
+		return errors.New("send queue is full")
+	}
+}
+
+// flush waits until the queued messages are sent, or the timeout elapses. It
+// returns false if some messages are still queued.
+func (a *Agent) flush(timeout time.Duration) bool {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	deadline := time.After(timeout)
+
+	for len(a.sendq) > 0 {
+		select {
+		case <-ticker.C:
+		case <-deadline:
+			return false
+		}
+	}
+	return true
+}
+
+// waitTimeout waits for the wait group, or until the timeout elapses. It
+// returns false if the timeout elapsed first.
+func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
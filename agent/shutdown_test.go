@@ -0,0 +1,66 @@
+package agent
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/transport"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShutdown(t *testing.T) {
+	config := NewConfig()
+	config.Deregister = true
+	config.ShutdownTimeout = 5
+	agent := NewAgent(config)
+
+	// Stand in for the send pump
+	var sent []*transport.Message
+	var mu sync.Mutex
+	go func() {
+		for msg := range agent.sendq {
+			mu.Lock()
+			sent = append(sent, msg)
+			mu.Unlock()
+		}
+	}()
+
+	// A check in progress sends its result before completing
+	require.True(t, agent.startExecution())
+	go func() {
+		defer agent.executions.Done()
+		time.Sleep(50 * time.Millisecond)
+		agent.sendMessage(transport.MessageTypeEvent, []byte("{}"))
+	}()
+
+	agent.Shutdown()
+
+	// No check is executed from then on
+	assert.False(t, agent.startExecution())
+
+	// The agent is only shut down once
+	agent.Shutdown()
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, sent, 2)
+	assert.Equal(t, transport.MessageTypeEvent, sent[0].Type)
+	assert.Equal(t, transport.MessageTypeDeregister, sent[1].Type)
+
+	event := &types.Event{}
+	require.NoError(t, json.Unmarshal(sent[1].Payload, event))
+	assert.Equal(t, config.AgentID, event.Entity.ID)
+}
+
+func TestWaitTimeout(t *testing.T) {
+	var wg sync.WaitGroup
+	assert.True(t, waitTimeout(&wg, time.Second))
+
+	wg.Add(1)
+	assert.False(t, waitTimeout(&wg, 10*time.Millisecond))
+	wg.Done()
+}
@@ -0,0 +1,75 @@
+package agent
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/sensu/sensu-go/snmp"
+	"github.com/sensu/sensu-go/types"
+)
+
+// SNMPTrapConfig contains the SNMP trap receiver configuration
+type SNMPTrapConfig struct {
+	Host string
+	// Port is the UDP port the traps are received on. Default: 0, the
+	// receiver is disabled
+	Port int
+	// Communities are the community strings of the traps accepted. All the
+	// traps are accepted if empty
+	Communities []string
+	// MappingsFile is the path of a JSON file translating the traps into
+	// events
+	MappingsFile string
+}
+
+// createTrapListener starts receiving SNMP traps, which are sent to the
+// backend as events of proxy entities.
+func (a *Agent) createTrapListener() (string, error) {
+	cfg := a.config.SNMPTrap
+
+	var mappings []snmp.Mapping
+	if cfg.MappingsFile != "" {
+		var err error
+		if mappings, err = snmp.LoadMappings(cfg.MappingsFile); err != nil {
+			return "", err
+		}
+	}
+
+	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return "", err
+	}
+	logger.Infof("starting SNMP trap listener on %s", addr)
+
+	receiver := &snmp.Receiver{
+		Communities: cfg.Communities,
+		Mappings:    mappings,
+		Handler:     a.handleTrap,
+	}
+
+	a.wg.Add(1)
+	go func() {
+		<-a.stopping
+		if err := conn.Close(); err != nil {
+			logger.Debug(err)
+		}
+	}()
+	go func() {
+		defer a.wg.Done()
+		_ = receiver.Serve(conn)
+	}()
+
+	return conn.LocalAddr().String(), nil
+}
+
+// handleTrap sends the event translated from an SNMP trap to the backend.
+func (a *Agent) handleTrap(event *types.Event) {
+	// The events belong to the namespace of the agent, whatever the mapping
+	event.Check.Organization = a.config.Organization
+	event.Check.Environment = a.config.Environment
+
+	if err := a.sendEvent(event); err != nil {
+		logger.WithError(err).Error("invalid event translated from SNMP trap")
+	}
+}
@@ -0,0 +1,48 @@
+package agent
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// linkDown trap of switch01, sent with the public community
+const linkDownTrap = "305702010104067075626c6963a74a020101020100020100303f300e06082b0601020101030043020100" +
+	"3017060a2b06010603010104010006092b0601060301010503301406082b0601020101050004087377697463683031"
+
+func TestHandleSNMPTraps(t *testing.T) {
+	cfg := NewConfig()
+	cfg.SNMPTrap.Host = "127.0.0.1"
+	cfg.Organization = "acme"
+	ta := NewAgent(cfg)
+
+	addr, err := ta.createTrapListener()
+	require.NoError(t, err)
+
+	client, err := net.Dial("udp", addr)
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	packet, err := hex.DecodeString(linkDownTrap)
+	require.NoError(t, err)
+	_, err = client.Write(packet)
+	require.NoError(t, err)
+
+	msg := <-ta.sendq
+	assert.Equal(t, "event", msg.Type)
+
+	var event types.Event
+	require.NoError(t, json.Unmarshal(msg.Payload, &event))
+	assert.Equal(t, cfg.AgentID, event.Entity.ID)
+	assert.Equal(t, "127.0.0.1", event.Check.ProxyEntityID)
+	assert.Equal(t, "snmp_trap", event.Check.Name)
+	assert.Equal(t, "acme", event.Check.Organization)
+	assert.Contains(t, event.Check.Output, "1.3.6.1.2.1.1.5.0 = switch01")
+
+	ta.Stop()
+}
@@ -0,0 +1,129 @@
+package agent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/robfig/cron"
+	"github.com/sensu/sensu-go/transport"
+	"github.com/sensu/sensu-go/types"
+)
+
+// standaloneCheckExtensions are the extensions of the standalone check
+// definition files.
+var standaloneCheckExtensions = map[string]bool{
+	".json": true,
+	".yaml": true,
+	".yml":  true,
+}
+
+// loadStandaloneChecks reads the standalone checks defined in the JSON or
+// YAML files of the checks directory, one check per file, in the namespace of
+// the agent. No checks are returned if the agent has no checks directory.
+func (a *Agent) loadStandaloneChecks() ([]*types.CheckConfig, error) {
+	if a.config.ChecksDir == "" {
+		return nil, nil
+	}
+
+	files, err := ioutil.ReadDir(a.config.ChecksDir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the checks directory: %s", err)
+	}
+
+	var checks []*types.CheckConfig
+	names := map[string]string{}
+	for _, file := range files {
+		ext := strings.ToLower(filepath.Ext(file.Name()))
+		if file.IsDir() || !standaloneCheckExtensions[ext] {
+			continue
+		}
+
+		path := filepath.Join(a.config.ChecksDir, file.Name())
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		check := &types.CheckConfig{}
+		if err := yaml.Unmarshal(b, check); err != nil {
+			return nil, fmt.Errorf("could not parse the check of %s: %s", path, err)
+		}
+		check.Organization = a.config.Organization
+		check.Environment = a.config.Environment
+		check.Standalone = true
+
+		if err := check.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid check in %s: %s", path, err)
+		}
+		if other, ok := names[check.Name]; ok {
+			return nil, fmt.Errorf("the check %s is defined by both %s and %s", check.Name, other, path)
+		}
+		names[check.Name] = path
+
+		checks = append(checks, check)
+	}
+
+	return checks, nil
+}
+
+// sendStandaloneChecks sends the definitions of the standalone checks to the
+// backend.
+func (a *Agent) sendStandaloneChecks(checks []*types.CheckConfig) error {
+	payload, err := json.Marshal(checks)
+	if err != nil {
+		return err
+	}
+
+	a.sendMessage(transport.MessageTypeStandaloneChecks, payload)
+	return nil
+}
+
+// scheduleStandaloneChecks executes each standalone check at its interval,
+// or according to its cron schedule, until the agent is stopped.
+func (a *Agent) scheduleStandaloneChecks(checks []*types.CheckConfig) {
+	for _, check := range checks {
+		schedule, err := standaloneSchedule(check)
+		if err != nil {
+			logger.WithError(err).WithField("check", check.Name).Error("could not schedule standalone check")
+			continue
+		}
+		go a.scheduleStandaloneCheck(check, schedule)
+	}
+}
+
+func (a *Agent) scheduleStandaloneCheck(check *types.CheckConfig, schedule cron.Schedule) {
+	for {
+		timer := time.NewTimer(time.Until(schedule.Next(time.Now())))
+		select {
+		case <-a.stopping:
+			timer.Stop()
+			return
+		case <-a.draining:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		request := &types.CheckRequest{
+			Config: check,
+			Issued: types.UnixMilli(time.Now()),
+		}
+		if err := a.scheduleCheck(request); err != nil {
+			logger.WithError(err).Warn("could not execute standalone check")
+		}
+	}
+}
+
+// standaloneSchedule returns the schedule of a standalone check, given by
+// its interval or cron string.
+func standaloneSchedule(check *types.CheckConfig) (cron.Schedule, error) {
+	if check.Cron != "" {
+		return cron.ParseStandard(check.Cron)
+	}
+	return cron.Every(time.Duration(check.Interval) * time.Second), nil
+}
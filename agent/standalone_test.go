@@ -0,0 +1,92 @@
+package agent
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeCheckFiles(t *testing.T, files map[string]string) (string, func()) {
+	dir, err := ioutil.TempDir("", "checks")
+	require.NoError(t, err)
+	for name, content := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	return dir, func() { _ = os.RemoveAll(dir) }
+}
+
+func TestLoadStandaloneChecks(t *testing.T) {
+	dir, cleanup := writeCheckFiles(t, map[string]string{
+		"disk.yaml":  "name: disk\ncommand: check-disk -w 80\ninterval: 60\n",
+		"load.json":  `{"name": "load", "command": "check-load", "cron": "*/5 * * * *"}`,
+		"README.txt": "not a check",
+	})
+	defer cleanup()
+
+	cfg := NewConfig()
+	cfg.ChecksDir = dir
+	cfg.Organization = "acme"
+	ta := NewAgent(cfg)
+
+	checks, err := ta.loadStandaloneChecks()
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+	assert.Equal(t, "disk", checks[0].Name)
+	assert.Equal(t, uint32(60), checks[0].Interval)
+	assert.Equal(t, "load", checks[1].Name)
+	for _, check := range checks {
+		assert.True(t, check.Standalone)
+		assert.Equal(t, "acme", check.Organization)
+		assert.Equal(t, "default", check.Environment)
+	}
+
+	// The agents without checks directory have no standalone checks
+	ta = NewAgent(NewConfig())
+	checks, err = ta.loadStandaloneChecks()
+	require.NoError(t, err)
+	assert.Empty(t, checks)
+}
+
+func TestLoadStandaloneChecksErrors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		files map[string]string
+	}{
+		{"invalid check", map[string]string{"disk.yaml": "name: disk\ncommand: check-disk\n"}},
+		{"backend executor", map[string]string{"disk.yaml": "name: disk\ncommand: check-disk\ninterval: 60\nexecutor: backend\n"}},
+		{"duplicate name", map[string]string{
+			"disk.yaml": "name: disk\ncommand: check-disk\ninterval: 60\n",
+			"disk.json": `{"name": "disk", "command": "check-disk", "interval": 30}`,
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir, cleanup := writeCheckFiles(t, tc.files)
+			defer cleanup()
+
+			cfg := NewConfig()
+			cfg.ChecksDir = dir
+			_, err := NewAgent(cfg).loadStandaloneChecks()
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestStandaloneSchedule(t *testing.T) {
+	now := time.Date(2018, 6, 1, 12, 2, 0, 0, time.UTC)
+
+	schedule, err := standaloneSchedule(&types.CheckConfig{Interval: 30})
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(30*time.Second), schedule.Next(now))
+
+	schedule, err = standaloneSchedule(&types.CheckConfig{Cron: "*/5 * * * *"})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2018, 6, 1, 12, 5, 0, 0, time.UTC), schedule.Next(now))
+}
@@ -0,0 +1,178 @@
+package agent
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/sensu/sensu-go/system"
+	"github.com/sensu/sensu-go/types"
+)
+
+// subscriptionFuncs returns the functions available to the dynamic
+// subscriptions. The running processes are only listed once per evaluation.
+func subscriptionFuncs() template.FuncMap {
+	var processes []string
+	var listed bool
+
+	return template.FuncMap{
+		// process returns true if a process with the given name is running
+		"process": func(name string) (bool, error) {
+			if !listed {
+				names, err := system.ProcessNames()
+				if err != nil {
+					return false, err
+				}
+				processes, listed = names, true
+			}
+			for _, p := range processes {
+				if p == name {
+					return true, nil
+				}
+			}
+			return false, nil
+		},
+	}
+}
+
+// parseDynamicSubscription parses a dynamic subscription template.
+func parseDynamicSubscription(text string) (*template.Template, error) {
+	return template.New("subscription").Funcs(subscriptionFuncs()).Option("missingkey=error").Parse(text)
+}
+
+// ValidateDynamicSubscriptions returns an error if any of the dynamic
+// subscriptions isn't a valid template.
+func ValidateDynamicSubscriptions(templates []string) error {
+	for _, text := range templates {
+		if _, err := parseDynamicSubscription(text); err != nil {
+			return fmt.Errorf("invalid dynamic subscription %q: %s", text, err)
+		}
+	}
+	return nil
+}
+
+// evalDynamicSubscriptions evaluates the dynamic subscription templates over
+// the system facts, e.g. {{ .OS }} or {{ if process "nginx" }}nginx{{ end }}.
+// Each template can result in zero, one or several subscriptions, separated by
+// commas or whitespaces.
+func evalDynamicSubscriptions(templates []string, facts types.System) ([]string, error) {
+	funcs := subscriptionFuncs()
+	var subscriptions []string
+
+	for _, text := range templates {
+		tmpl, err := parseDynamicSubscription(text)
+		if err != nil {
+			return nil, err
+		}
+
+		var buf bytes.Buffer
+		if err := tmpl.Funcs(funcs).Execute(&buf, facts); err != nil {
+			return nil, fmt.Errorf("could not evaluate dynamic subscription %q: %s", text, err)
+		}
+
+		subscriptions = append(subscriptions, splitSubscriptions(buf.String())...)
+	}
+
+	return subscriptions, nil
+}
+
+// readSubscriptionsFile reads the subscriptions listed in a file, one per line.
+// Empty lines and lines starting with # are ignored.
+func readSubscriptionsFile(path string) ([]string, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var subscriptions []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		subscriptions = append(subscriptions, line)
+	}
+
+	return subscriptions, scanner.Err()
+}
+
+func splitSubscriptions(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+}
+
+// computeSubscriptions returns the subscriptions of the agent, which are the
+// static subscriptions followed by the ones listed in the subscriptions file
+// and the ones resulting from the dynamic subscriptions, without duplicates.
+func (a *Agent) computeSubscriptions() ([]string, error) {
+	subscriptions := append([]string{}, a.config.Subscriptions...)
+
+	if a.config.SubscriptionsFile != "" {
+		subs, err := readSubscriptionsFile(a.config.SubscriptionsFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read the subscriptions file: %s", err)
+		}
+		subscriptions = append(subscriptions, subs...)
+	}
+
+	if len(a.config.DynamicSubscriptions) > 0 {
+		facts, err := system.Info()
+		if err != nil {
+			return nil, fmt.Errorf("could not gather the system facts: %s", err)
+		}
+		subs, err := evalDynamicSubscriptions(a.config.DynamicSubscriptions, facts)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subs...)
+	}
+
+	seen := make(map[string]bool, len(subscriptions))
+	result := make([]string, 0, len(subscriptions))
+	for _, sub := range subscriptions {
+		if !seen[sub] {
+			seen[sub] = true
+			result = append(result, sub)
+		}
+	}
+
+	return result, nil
+}
+
+// refreshSubscriptions computes the subscriptions of the agent again, and
+// updates its entity with them. The backend updates the subscriptions of the
+// agent session once it receives the next keepalive.
+func (a *Agent) refreshSubscriptions() error {
+	subscriptions, err := a.computeSubscriptions()
+	if err != nil {
+		return err
+	}
+
+	if !equalSubscriptions(a.getAgentEntity().Subscriptions, subscriptions) {
+		logger.WithField("subscriptions", subscriptions).Info("agent subscriptions changed")
+		a.updateAgentEntity(func(entity *types.Entity) {
+			entity.Subscriptions = subscriptions
+		})
+	}
+
+	return nil
+}
+
+func equalSubscriptions(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
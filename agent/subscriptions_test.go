@@ -0,0 +1,76 @@
+package agent
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDynamicSubscriptions(t *testing.T) {
+	assert.NoError(t, ValidateDynamicSubscriptions([]string{"{{ .OS }}", `{{ if process "nginx" }}nginx{{ end }}`}))
+	assert.Error(t, ValidateDynamicSubscriptions([]string{"{{ .OS "}))
+	assert.Error(t, ValidateDynamicSubscriptions([]string{`{{ if unknown "nginx" }}nginx{{ end }}`}))
+}
+
+func TestEvalDynamicSubscriptions(t *testing.T) {
+	facts := types.System{OS: "linux", Platform: "ubuntu", PlatformFamily: "debian"}
+
+	subs, err := evalDynamicSubscriptions([]string{
+		"{{ .OS }}",
+		"{{ .Platform }}, {{ .PlatformFamily }}",
+		`{{ if eq .OS "windows" }}windows{{ end }}`,
+		`{{ if process "sensu-go-missing-process" }}missing{{ end }}`,
+	}, facts)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"linux", "ubuntu", "debian"}, subs)
+
+	_, err = evalDynamicSubscriptions([]string{"{{ .Unknown }}"}, facts)
+	assert.Error(t, err)
+}
+
+func TestReadSubscriptionsFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sensu-agent")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "subscriptions")
+	require.NoError(t, ioutil.WriteFile(path, []byte("# managed by puppet\nlinux\n\n  web  \n"), 0644))
+
+	subs, err := readSubscriptionsFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"linux", "web"}, subs)
+
+	_, err = readSubscriptionsFile(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestComputeSubscriptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sensu-agent")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "subscriptions")
+	require.NoError(t, ioutil.WriteFile(path, []byte("linux\nweb\n"), 0644))
+
+	cfg := NewConfig()
+	cfg.Subscriptions = []string{"web"}
+	cfg.SubscriptionsFile = path
+	cfg.DynamicSubscriptions = []string{"{{ .Arch }}"}
+	agent := NewAgent(cfg)
+
+	subs, err := agent.computeSubscriptions()
+	require.NoError(t, err)
+	require.Len(t, subs, 3)
+	assert.Equal(t, []string{"web", "linux"}, subs[:2])
+	assert.NotEmpty(t, subs[2])
+
+	// The entity is updated when the subscriptions change
+	require.NoError(t, ioutil.WriteFile(path, []byte("db\n"), 0644))
+	require.NoError(t, agent.refreshSubscriptions())
+	assert.Equal(t, []string{"web", "db", subs[2]}, agent.getAgentEntity().Subscriptions)
+}
@@ -0,0 +1,85 @@
+package agent
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/sensu/sensu-go/syslog"
+	"github.com/sensu/sensu-go/types"
+)
+
+// SyslogConfig contains the syslog listener configuration
+type SyslogConfig struct {
+	Host string
+	// Port is the UDP and TCP port the syslog messages are received on.
+	// Default: 0, the listener is disabled
+	Port int
+	// PatternsFile is the path of a JSON file translating the messages into
+	// events
+	PatternsFile string
+}
+
+// createSyslogListeners starts receiving syslog messages over UDP and TCP.
+// The messages matching the patterns are sent to the backend as events.
+func (a *Agent) createSyslogListeners() (string, string, error) {
+	cfg := a.config.Syslog
+
+	var patterns []syslog.Pattern
+	if cfg.PatternsFile != "" {
+		var err error
+		if patterns, err = syslog.LoadPatterns(cfg.PatternsFile); err != nil {
+			return "", "", err
+		}
+	}
+
+	listener := &syslog.Listener{
+		Patterns: patterns,
+		Handler:  a.handleSyslogEvent,
+	}
+
+	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+	udpConn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return "", "", err
+	}
+	tcpListen, err := net.Listen("tcp", addr)
+	if err != nil {
+		_ = udpConn.Close()
+		return "", "", err
+	}
+	logger.Infof("starting syslog listeners on %s", addr)
+
+	a.wg.Add(2)
+	go func() {
+		<-a.stopping
+		if err := udpConn.Close(); err != nil {
+			logger.Debug(err)
+		}
+		if err := tcpListen.Close(); err != nil {
+			logger.Debug(err)
+		}
+	}()
+	go func() {
+		defer a.wg.Done()
+		_ = listener.Serve(udpConn)
+	}()
+	go func() {
+		defer a.wg.Done()
+		_ = listener.ServeTCP(tcpListen)
+	}()
+
+	return udpConn.LocalAddr().String(), tcpListen.Addr().String(), nil
+}
+
+// handleSyslogEvent sends the event translated from a syslog message to the
+// backend.
+func (a *Agent) handleSyslogEvent(event *types.Event) {
+	// The messages of the agent's host are events of its own entity
+	if event.Entity.ID == a.config.AgentID {
+		event.Check.ProxyEntityID = ""
+	}
+
+	if err := a.sendEvent(event); err != nil {
+		logger.WithError(err).Error("invalid event translated from syslog message")
+	}
+}
@@ -0,0 +1,67 @@
+package agent
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleSyslogMessages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sensu-agent")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	patterns := filepath.Join(dir, "patterns.json")
+	content := `[{"check": "disk_errors", "match": "I/O error on (?P<device>\\w+)"}]`
+	require.NoError(t, ioutil.WriteFile(patterns, []byte(content), 0644))
+
+	cfg := NewConfig()
+	cfg.Syslog.Host = "127.0.0.1"
+	cfg.Syslog.PatternsFile = patterns
+	ta := NewAgent(cfg)
+
+	udpAddr, tcpAddr, err := ta.createSyslogListeners()
+	require.NoError(t, err)
+
+	udpClient, err := net.Dial("udp", udpAddr)
+	require.NoError(t, err)
+	defer func() { _ = udpClient.Close() }()
+
+	// Messages not matching any pattern are discarded
+	_, err = udpClient.Write([]byte("<14>1 - db01 cron - - - job done"))
+	require.NoError(t, err)
+	_, err = udpClient.Write([]byte("<11>1 - db01 kernel - - - I/O error on sda"))
+	require.NoError(t, err)
+
+	tcpClient, err := net.Dial("tcp", tcpAddr)
+	require.NoError(t, err)
+	_, err = tcpClient.Write([]byte("<11>Jun  5 22:14:15 " + cfg.AgentID + " kernel: I/O error on sdb\n"))
+	require.NoError(t, err)
+	require.NoError(t, tcpClient.Close())
+
+	proxies := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		msg := <-ta.sendq
+		assert.Equal(t, "event", msg.Type)
+
+		var event types.Event
+		require.NoError(t, json.Unmarshal(msg.Payload, &event))
+		assert.Equal(t, cfg.AgentID, event.Entity.ID)
+		assert.Equal(t, "disk_errors", event.Check.Name)
+		assert.Equal(t, int32(2), event.Check.Status)
+		assert.Equal(t, "default", event.Check.Organization)
+		proxies[event.Check.ProxyEntityID] = true
+	}
+
+	// The messages of the agent's host are events of its entity
+	assert.Equal(t, map[string]bool{"db01": true, "": true}, proxies)
+
+	ta.Stop()
+}
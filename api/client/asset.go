@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const assetsPath = "/assets"
+
+// ListAssets lists the assets of the namespace of the client.
+func (c *Client) ListAssets(ctx context.Context, opts *ListOptions) ([]types.Asset, error) {
+	assets := []types.Asset{}
+	err := c.list(ctx, assetsPath, opts, &assets)
+	return assets, err
+}
+
+// GetAsset returns the asset with the given name.
+func (c *Client) GetAsset(ctx context.Context, name string) (*types.Asset, error) {
+	asset := &types.Asset{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(assetsPath, name), nil, asset); err != nil {
+		return nil, err
+	}
+	return asset, nil
+}
+
+// CreateAsset creates the asset.
+func (c *Client) CreateAsset(ctx context.Context, asset *types.Asset) error {
+	return c.do(ctx, http.MethodPost, assetsPath, asset, nil)
+}
+
+// UpdateAsset updates the asset.
+func (c *Client) UpdateAsset(ctx context.Context, asset *types.Asset) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(assetsPath, asset.Name), asset, nil)
+}
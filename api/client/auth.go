@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// Authenticate obtains new tokens with the credentials of the user.
+func (c *Client) Authenticate(ctx context.Context, username, password string) error {
+	tokens := &types.Tokens{}
+	if err := c.send(ctx, http.MethodGet, "/auth", "", nil, tokens, url.UserPassword(username, password)); err != nil {
+		return err
+	}
+	if err := tokens.Validate(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setTokens(tokens)
+	return nil
+}
+
+// Logout revokes the refresh token of the client, which is no longer
+// authenticated.
+func (c *Client) Logout(ctx context.Context) error {
+	tokens := c.Tokens()
+	if tokens == nil {
+		return nil
+	}
+
+	body := map[string]string{"refresh_token": tokens.Refresh}
+	if err := c.do(ctx, http.MethodPost, "/auth/logout", body, nil); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokens = nil
+	return nil
+}
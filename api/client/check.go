@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const checksPath = "/checks"
+
+// ListChecks lists the checks of the namespace of the client.
+func (c *Client) ListChecks(ctx context.Context, opts *ListOptions) ([]types.CheckConfig, error) {
+	checks := []types.CheckConfig{}
+	err := c.list(ctx, checksPath, opts, &checks)
+	return checks, err
+}
+
+// GetCheck returns the check with the given name.
+func (c *Client) GetCheck(ctx context.Context, name string) (*types.CheckConfig, error) {
+	check := &types.CheckConfig{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(checksPath, name), nil, check); err != nil {
+		return nil, err
+	}
+	return check, nil
+}
+
+// CreateCheck creates the check.
+func (c *Client) CreateCheck(ctx context.Context, check *types.CheckConfig) error {
+	return c.do(ctx, http.MethodPost, checksPath, check, nil)
+}
+
+// UpdateCheck updates the check.
+func (c *Client) UpdateCheck(ctx context.Context, check *types.CheckConfig) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(checksPath, check.Name), check, nil)
+}
+
+// DeleteCheck deletes the check with the given name.
+func (c *Client) DeleteCheck(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(checksPath, name), nil, nil)
+}
+
+// ExecuteCheck requests the execution of a check by the agents of its
+// subscriptions, or of the subscriptions of the request if any.
+func (c *Client) ExecuteCheck(ctx context.Context, req *types.AdhocRequest) error {
+	return c.do(ctx, http.MethodPost, resourcePath(checksPath, req.Name, "execute"), req, nil)
+}
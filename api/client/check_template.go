@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const checkTemplatesPath = "/checktemplates"
+
+// ListCheckTemplates lists the check templates of the namespace of the client.
+func (c *Client) ListCheckTemplates(ctx context.Context, opts *ListOptions) ([]types.CheckTemplate, error) {
+	tmpls := []types.CheckTemplate{}
+	err := c.list(ctx, checkTemplatesPath, opts, &tmpls)
+	return tmpls, err
+}
+
+// GetCheckTemplate returns the check template with the given name.
+func (c *Client) GetCheckTemplate(ctx context.Context, name string) (*types.CheckTemplate, error) {
+	tmpl := &types.CheckTemplate{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(checkTemplatesPath, name), nil, tmpl); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// CreateCheckTemplate creates the check template.
+func (c *Client) CreateCheckTemplate(ctx context.Context, tmpl *types.CheckTemplate) error {
+	return c.do(ctx, http.MethodPost, checkTemplatesPath, tmpl, nil)
+}
+
+// UpdateCheckTemplate updates the check template.
+func (c *Client) UpdateCheckTemplate(ctx context.Context, tmpl *types.CheckTemplate) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(checkTemplatesPath, tmpl.Name), tmpl, nil)
+}
+
+// DeleteCheckTemplate deletes the check template with the given name.
+func (c *Client) DeleteCheckTemplate(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(checkTemplatesPath, name), nil, nil)
+}
+
+// RenderCheckTemplate returns the checks generated by the check template with
+// the given name.
+func (c *Client) RenderCheckTemplate(ctx context.Context, name string) ([]types.CheckConfig, error) {
+	checks := []types.CheckConfig{}
+	err := c.do(ctx, http.MethodGet, resourcePath(checkTemplatesPath, name, "checks"), nil, &checks)
+	return checks, err
+}
@@ -0,0 +1,210 @@
+// Package client is a Go client for the Sensu REST API.
+//
+//	c := client.New(client.Config{URL: "http://localhost:8080"})
+//	if err := c.Authenticate(ctx, "admin", "P@ssw0rd!"); err != nil {
+//	  return err
+//	}
+//	checks, err := c.ListChecks(ctx, nil)
+//
+// The access token is refreshed as it expires, and the organization and
+// environment of the client are passed along with every request reading
+// resources.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// DefaultOrganization is the organization of the clients configured
+	// without one
+	DefaultOrganization = "default"
+
+	// DefaultEnvironment is the environment of the clients configured without
+	// one
+	DefaultEnvironment = "default"
+)
+
+// Config configures a client.
+type Config struct {
+	// URL is the URL of the API, e.g. http://localhost:8080.
+	URL string
+
+	// Organization and Environment are the namespace of the resources read,
+	// DefaultOrganization and DefaultEnvironment if empty.
+	Organization string
+	Environment  string
+
+	// Tokens authenticate the requests, e.g. the tokens saved from a previous
+	// session. They can also be obtained with Authenticate.
+	Tokens *types.Tokens
+
+	// OnTokens is called with the new tokens every time they are refreshed,
+	// so they can be saved.
+	OnTokens func(*types.Tokens)
+
+	// HTTPClient sends the requests, http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+// Client sends requests to the Sensu API. It is safe for concurrent use.
+type Client struct {
+	config Config
+
+	mu     sync.Mutex
+	tokens *types.Tokens
+}
+
+// New returns a client configured by the given config.
+func New(config Config) *Client {
+	if config.Organization == "" {
+		config.Organization = DefaultOrganization
+	}
+	if config.Environment == "" {
+		config.Environment = DefaultEnvironment
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+	config.URL = strings.TrimSuffix(config.URL, "/")
+
+	return &Client{config: config, tokens: config.Tokens}
+}
+
+// Tokens returns the tokens currently used by the client, or nil if it is not
+// authenticated.
+func (c *Client) Tokens() *types.Tokens {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.tokens
+}
+
+// resourcePath joins the escaped parts of a resource path.
+func resourcePath(base string, parts ...string) string {
+	escaped := []string{base}
+	for _, part := range parts {
+		escaped = append(escaped, url.PathEscape(part))
+	}
+	return path.Join(escaped...)
+}
+
+// do sends a request with the JSON encoding of the body, if not nil, and
+// decodes the response in the result, if not nil.
+func (c *Client) do(ctx context.Context, method, path string, body, result interface{}) error {
+	token, err := c.accessToken(ctx)
+	if err != nil {
+		return err
+	}
+	return c.send(ctx, method, path, token, body, result, nil)
+}
+
+// send a request authenticated by the token, or by the basic credentials of
+// the user if not nil.
+func (c *Client) send(ctx context.Context, method, path, token string, body, result interface{}, user *url.Userinfo) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, c.config.URL+path, reader)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	// The created and replaced resources carry their own namespace
+	if method != http.MethodPost && method != http.MethodPut {
+		query := req.URL.Query()
+		query.Set("org", c.config.Organization)
+		query.Set("env", c.config.Environment)
+		req.URL.RawQuery = query.Encode()
+	}
+
+	if user != nil {
+		password, _ := user.Password()
+		req.SetBasicAuth(user.Username(), password)
+	} else if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	res, err := c.config.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode >= 400 {
+		return newError(res.StatusCode, b)
+	}
+
+	if result == nil || res.StatusCode == http.StatusNoContent || len(b) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(b, result); err != nil {
+		return fmt.Errorf("unable to decode the response of %s %s: %s", method, path, err)
+	}
+	return nil
+}
+
+// accessToken returns the access token of the client, refreshed first if it
+// has expired.
+func (c *Client) accessToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.tokens == nil {
+		return "", nil
+	}
+	if time.Unix(c.tokens.ExpiresAt, 0).After(time.Now()) {
+		return c.tokens.Access, nil
+	}
+	if c.tokens.Refresh == "" {
+		return "", &Error{Message: "the access token has expired", StatusCode: http.StatusUnauthorized}
+	}
+
+	tokens := &types.Tokens{}
+	body := map[string]string{"refresh_token": c.tokens.Refresh}
+	if err := c.send(ctx, http.MethodPost, "/auth/token", c.tokens.Access, body, tokens, nil); err != nil {
+		return "", fmt.Errorf("unable to refresh the access token: %s", err)
+	}
+	if err := tokens.Validate(); err != nil {
+		return "", err
+	}
+
+	c.setTokens(tokens)
+	return tokens.Access, nil
+}
+
+// setTokens replaces the tokens of the client, its mutex must be held.
+func (c *Client) setTokens(tokens *types.Tokens) {
+	c.tokens = tokens
+	if c.config.OnTokens != nil && tokens != nil {
+		c.config.OnTokens(tokens)
+	}
+}
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTokens(access string, ttl time.Duration) *types.Tokens {
+	return &types.Tokens{Access: access, Refresh: "refresh", ExpiresAt: time.Now().Add(ttl).Unix()}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestAuthentication(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth", func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "admin" || password != "P@ssw0rd!" {
+			writeJSON(w, http.StatusUnauthorized, map[string]interface{}{"error": "bad credentials"})
+			return
+		}
+		// The first token is already expired
+		writeJSON(w, http.StatusOK, newTokens("expired", -time.Minute))
+	})
+	mux.HandleFunc("/auth/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer expired", r.Header.Get("Authorization"))
+		body := map[string]string{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "refresh", body["refresh_token"])
+		writeJSON(w, http.StatusOK, newTokens("fresh", time.Hour))
+	})
+	mux.HandleFunc("/checks", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer fresh", r.Header.Get("Authorization"))
+		assert.Equal(t, "acme", r.URL.Query().Get("org"))
+		assert.Equal(t, "default", r.URL.Query().Get("env"))
+		writeJSON(w, http.StatusOK, []*types.CheckConfig{types.FixtureCheckConfig("check_cpu")})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var saved *types.Tokens
+	c := New(Config{
+		URL:          server.URL + "/",
+		Organization: "acme",
+		OnTokens:     func(tokens *types.Tokens) { saved = tokens },
+	})
+	ctx := context.Background()
+
+	err := c.Authenticate(ctx, "admin", "hunter2")
+	assert.True(t, IsUnauthorized(err))
+	assert.Equal(t, "bad credentials", err.Error())
+
+	require.NoError(t, c.Authenticate(ctx, "admin", "P@ssw0rd!"))
+	assert.Equal(t, "expired", saved.Access)
+
+	// The expired token is refreshed before the request
+	checks, err := c.ListChecks(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.Equal(t, "check_cpu", checks[0].Name)
+	assert.Equal(t, "fresh", saved.Access)
+	assert.Equal(t, "fresh", c.Tokens().Access)
+
+	// The token can't be refreshed without refresh token
+	c = New(Config{URL: server.URL, Tokens: &types.Tokens{Access: "expired"}})
+	_, err = c.ListChecks(ctx, nil)
+	assert.True(t, IsUnauthorized(err))
+}
+
+func TestResources(t *testing.T) {
+	handler := types.FixtureHandler("slack")
+	mux := http.NewServeMux()
+	mux.HandleFunc("/handlers", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Empty(t, r.URL.Query().Get("org"))
+		created := types.Handler{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+		assert.Equal(t, handler.Name, created.Name)
+		writeJSON(w, http.StatusOK, created)
+	})
+	mux.HandleFunc("/handlers/slack", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, handler)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/handlers/pagerduty", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{"error": "not found", "code": 5})
+	})
+	mux.HandleFunc("/rbac/organizations/acme/environments/prod", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, types.FixtureEnvironment("prod"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := New(Config{URL: server.URL, Tokens: newTokens("token", time.Hour)})
+	ctx := context.Background()
+
+	require.NoError(t, c.CreateHandler(ctx, handler))
+
+	found, err := c.GetHandler(ctx, "slack")
+	require.NoError(t, err)
+	assert.Equal(t, handler.Command, found.Command)
+
+	_, err = c.GetHandler(ctx, "pagerduty")
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, uint32(5), err.(*Error).Code)
+
+	require.NoError(t, c.DeleteHandler(ctx, "slack"))
+
+	env, err := c.GetEnvironment(ctx, "acme", "prod")
+	require.NoError(t, err)
+	assert.Equal(t, "prod", env.Name)
+}
+
+func TestListOptions(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/events", func(w http.ResponseWriter, r *http.Request) {
+		events := []*types.Event{}
+		for _, check := range []string{"a", "b", "c", "d", "e"} {
+			events = append(events, types.FixtureEvent("web01", check))
+		}
+		writeJSON(w, http.StatusOK, events)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := New(Config{URL: server.URL})
+	ctx := context.Background()
+
+	checks := []string{}
+	for opts := (&ListOptions{Limit: 2}); opts != nil; {
+		events, err := c.ListEvents(ctx, opts)
+		require.NoError(t, err)
+		for _, event := range events {
+			checks = append(checks, event.Check.Name)
+		}
+		opts = opts.Next(len(events))
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, checks)
+
+	events, err := c.ListEvents(ctx, &ListOptions{Offset: 10})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	events, err = c.ListEvents(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, events, 5)
+}
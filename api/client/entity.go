@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const entitiesPath = "/entities"
+
+// ListEntities lists the entitys of the namespace of the client.
+func (c *Client) ListEntities(ctx context.Context, opts *ListOptions) ([]types.Entity, error) {
+	entitys := []types.Entity{}
+	err := c.list(ctx, entitiesPath, opts, &entitys)
+	return entitys, err
+}
+
+// GetEntity returns the entity with the given id.
+func (c *Client) GetEntity(ctx context.Context, id string) (*types.Entity, error) {
+	entity := &types.Entity{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(entitiesPath, id), nil, entity); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// UpdateEntity updates the entity.
+func (c *Client) UpdateEntity(ctx context.Context, entity *types.Entity) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(entitiesPath, entity.ID), entity, nil)
+}
+
+// DeleteEntity deletes the entity with the given id.
+func (c *Client) DeleteEntity(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(entitiesPath, id), nil, nil)
+}
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const entityGroupsPath = "/entitygroups"
+
+// ListEntityGroups lists the entity groups of the namespace of the client.
+func (c *Client) ListEntityGroups(ctx context.Context, opts *ListOptions) ([]types.EntityGroup, error) {
+	groups := []types.EntityGroup{}
+	err := c.list(ctx, entityGroupsPath, opts, &groups)
+	return groups, err
+}
+
+// GetEntityGroup returns the entity group with the given name.
+func (c *Client) GetEntityGroup(ctx context.Context, name string) (*types.EntityGroup, error) {
+	group := &types.EntityGroup{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(entityGroupsPath, name), nil, group); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// CreateEntityGroup creates the entity group.
+func (c *Client) CreateEntityGroup(ctx context.Context, group *types.EntityGroup) error {
+	return c.do(ctx, http.MethodPost, entityGroupsPath, group, nil)
+}
+
+// UpdateEntityGroup updates the entity group.
+func (c *Client) UpdateEntityGroup(ctx context.Context, group *types.EntityGroup) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(entityGroupsPath, group.Name), group, nil)
+}
+
+// DeleteEntityGroup deletes the entity group with the given name.
+func (c *Client) DeleteEntityGroup(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(entityGroupsPath, name), nil, nil)
+}
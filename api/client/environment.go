@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// environmentsPath returns the path of the environments of an organization,
+// or of one of them.
+func environmentsPath(org string, env ...string) string {
+	return resourcePath(organizationsPath, append([]string{org, "environments"}, env...)...)
+}
+
+// ListEnvironments lists the environments of the organization.
+func (c *Client) ListEnvironments(ctx context.Context, org string, opts *ListOptions) ([]types.Environment, error) {
+	envs := []types.Environment{}
+	err := c.list(ctx, environmentsPath(org), opts, &envs)
+	return envs, err
+}
+
+// GetEnvironment returns the environment of the organization with the given
+// name.
+func (c *Client) GetEnvironment(ctx context.Context, org, name string) (*types.Environment, error) {
+	env := &types.Environment{}
+	if err := c.do(ctx, http.MethodGet, environmentsPath(org, name), nil, env); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+// CreateEnvironment creates the environment in its organization.
+func (c *Client) CreateEnvironment(ctx context.Context, env *types.Environment) error {
+	return c.do(ctx, http.MethodPost, environmentsPath(env.Organization), env, nil)
+}
+
+// UpdateEnvironment updates the environment.
+func (c *Client) UpdateEnvironment(ctx context.Context, env *types.Environment) error {
+	return c.do(ctx, http.MethodPatch, environmentsPath(env.Organization, env.Name), env, nil)
+}
+
+// DeleteEnvironment deletes the environment of the organization with the
+// given name.
+func (c *Client) DeleteEnvironment(ctx context.Context, org, name string) error {
+	return c.do(ctx, http.MethodDelete, environmentsPath(org, name), nil, nil)
+}
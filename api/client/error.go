@@ -0,0 +1,64 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// Error is an error returned by the API.
+type Error struct {
+	// Message describes the error.
+	Message string `json:"error"`
+
+	// Code is the code of the error, as defined by the API.
+	Code uint32 `json:"code,omitempty"`
+
+	// Fields are the invalid fields of a resource that did not pass
+	// validation.
+	Fields types.ValidationErrors `json:"fields,omitempty"`
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"-"`
+}
+
+func newError(statusCode int, body []byte) *Error {
+	err := &Error{}
+	if json.Unmarshal(body, err) != nil || err.Message == "" {
+		err.Message = strings.TrimSpace(string(body))
+	}
+	if err.Message == "" {
+		err.Message = http.StatusText(statusCode)
+	}
+	err.StatusCode = statusCode
+	return err
+}
+
+// Error returns the error message, followed by the invalid fields if any.
+func (e *Error) Error() string {
+	if len(e.Fields) == 0 {
+		return e.Message
+	}
+
+	lines := []string{e.Message}
+	for _, field := range e.Fields {
+		lines = append(lines, fmt.Sprintf("%s: %s", field.Field, field.Message))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// IsNotFound returns true if the error was caused by a missing resource.
+func IsNotFound(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e.StatusCode == http.StatusNotFound
+}
+
+// IsUnauthorized returns true if the request was not authenticated, or not
+// authorized.
+func IsUnauthorized(err error) bool {
+	e, ok := err.(*Error)
+	return ok && (e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden)
+}
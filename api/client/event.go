@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const eventsPath = "/events"
+
+// ListEvents lists the events of the namespace of the client.
+func (c *Client) ListEvents(ctx context.Context, opts *ListOptions) ([]types.Event, error) {
+	events := []types.Event{}
+	err := c.list(ctx, eventsPath, opts, &events)
+	return events, err
+}
+
+// ListEntityEvents lists the events of the entity with the given id.
+func (c *Client) ListEntityEvents(ctx context.Context, entity string, opts *ListOptions) ([]types.Event, error) {
+	events := []types.Event{}
+	err := c.list(ctx, resourcePath(eventsPath, entity), opts, &events)
+	return events, err
+}
+
+// GetEvent returns the event of the given entity and check.
+func (c *Client) GetEvent(ctx context.Context, entity, check string) (*types.Event, error) {
+	event := &types.Event{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(eventsPath, entity, check), nil, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// CreateEvent publishes the event, to be processed as if it was sent by an
+// agent.
+func (c *Client) CreateEvent(ctx context.Context, event *types.Event) error {
+	return c.do(ctx, http.MethodPost, eventsPath, event, nil)
+}
+
+// DeleteEvent deletes the event of the given entity and check.
+func (c *Client) DeleteEvent(ctx context.Context, entity, check string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(eventsPath, entity, check), nil, nil)
+}
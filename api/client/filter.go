@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const filtersPath = "/filters"
+
+// ListFilters lists the event filters of the namespace of the client.
+func (c *Client) ListFilters(ctx context.Context, opts *ListOptions) ([]types.EventFilter, error) {
+	filters := []types.EventFilter{}
+	err := c.list(ctx, filtersPath, opts, &filters)
+	return filters, err
+}
+
+// GetFilter returns the event filter with the given name.
+func (c *Client) GetFilter(ctx context.Context, name string) (*types.EventFilter, error) {
+	filter := &types.EventFilter{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(filtersPath, name), nil, filter); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// CreateFilter creates the event filter.
+func (c *Client) CreateFilter(ctx context.Context, filter *types.EventFilter) error {
+	return c.do(ctx, http.MethodPost, filtersPath, filter, nil)
+}
+
+// UpdateFilter updates the event filter.
+func (c *Client) UpdateFilter(ctx context.Context, filter *types.EventFilter) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(filtersPath, filter.Name), filter, nil)
+}
+
+// DeleteFilter deletes the event filter with the given name.
+func (c *Client) DeleteFilter(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(filtersPath, name), nil, nil)
+}
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const handlersPath = "/handlers"
+
+// ListHandlers lists the handlers of the namespace of the client.
+func (c *Client) ListHandlers(ctx context.Context, opts *ListOptions) ([]types.Handler, error) {
+	handlers := []types.Handler{}
+	err := c.list(ctx, handlersPath, opts, &handlers)
+	return handlers, err
+}
+
+// GetHandler returns the handler with the given name.
+func (c *Client) GetHandler(ctx context.Context, name string) (*types.Handler, error) {
+	handler := &types.Handler{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(handlersPath, name), nil, handler); err != nil {
+		return nil, err
+	}
+	return handler, nil
+}
+
+// CreateHandler creates the handler.
+func (c *Client) CreateHandler(ctx context.Context, handler *types.Handler) error {
+	return c.do(ctx, http.MethodPost, handlersPath, handler, nil)
+}
+
+// UpdateHandler updates the handler.
+func (c *Client) UpdateHandler(ctx context.Context, handler *types.Handler) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(handlersPath, handler.Name), handler, nil)
+}
+
+// DeleteHandler deletes the handler with the given name.
+func (c *Client) DeleteHandler(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(handlersPath, name), nil, nil)
+}
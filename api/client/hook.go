@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const hooksPath = "/hooks"
+
+// ListHooks lists the hooks of the namespace of the client.
+func (c *Client) ListHooks(ctx context.Context, opts *ListOptions) ([]types.HookConfig, error) {
+	hooks := []types.HookConfig{}
+	err := c.list(ctx, hooksPath, opts, &hooks)
+	return hooks, err
+}
+
+// GetHook returns the hook with the given name.
+func (c *Client) GetHook(ctx context.Context, name string) (*types.HookConfig, error) {
+	hook := &types.HookConfig{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(hooksPath, name), nil, hook); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+// CreateHook creates the hook.
+func (c *Client) CreateHook(ctx context.Context, hook *types.HookConfig) error {
+	return c.do(ctx, http.MethodPost, hooksPath, hook, nil)
+}
+
+// UpdateHook updates the hook.
+func (c *Client) UpdateHook(ctx context.Context, hook *types.HookConfig) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(hooksPath, hook.Name), hook, nil)
+}
+
+// DeleteHook deletes the hook with the given name.
+func (c *Client) DeleteHook(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(hooksPath, name), nil, nil)
+}
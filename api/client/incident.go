@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const incidentsPath = "/incidents"
+
+// ListIncidents lists the incidents of the namespace of the client.
+func (c *Client) ListIncidents(ctx context.Context, opts *ListOptions) ([]types.Incident, error) {
+	incidents := []types.Incident{}
+	err := c.list(ctx, incidentsPath, opts, &incidents)
+	return incidents, err
+}
+
+// GetIncident returns the incident with the given id.
+func (c *Client) GetIncident(ctx context.Context, id string) (*types.Incident, error) {
+	incident := &types.Incident{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(incidentsPath, id), nil, incident); err != nil {
+		return nil, err
+	}
+	return incident, nil
+}
+
+// UpdateIncident updates the incident.
+func (c *Client) UpdateIncident(ctx context.Context, incident *types.Incident) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(incidentsPath, incident.ID), incident, nil)
+}
+
+// DeleteIncident deletes the incident with the given id.
+func (c *Client) DeleteIncident(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(incidentsPath, id), nil, nil)
+}
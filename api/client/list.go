@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+)
+
+// ListOptions selects a page of the resources listed. The API responds with
+// every resource, the pages are selected by the client.
+type ListOptions struct {
+	// Limit is the maximum number of resources listed, all of them if zero.
+	Limit int
+
+	// Offset is the number of resources skipped.
+	Offset int
+}
+
+// page reduces the slice pointed to by list to the page selected by the
+// options.
+func (o *ListOptions) page(list interface{}) {
+	if o == nil {
+		return
+	}
+
+	v := reflect.ValueOf(list).Elem()
+	start, end := o.Offset, v.Len()
+	if start > end {
+		start = end
+	}
+	if o.Limit > 0 && start+o.Limit < end {
+		end = start + o.Limit
+	}
+	v.Set(v.Slice(start, end))
+}
+
+// Next returns the options selecting the page following the given number of
+// resources, or nil if they were the last ones.
+func (o *ListOptions) Next(listed int) *ListOptions {
+	if o == nil || o.Limit == 0 || listed < o.Limit {
+		return nil
+	}
+	return &ListOptions{Limit: o.Limit, Offset: o.Offset + listed}
+}
+
+// list lists the resources at the path in the slice pointed to by result.
+func (c *Client) list(ctx context.Context, path string, opts *ListOptions, result interface{}) error {
+	if err := c.do(ctx, http.MethodGet, path, nil, result); err != nil {
+		return err
+	}
+	opts.page(result)
+	return nil
+}
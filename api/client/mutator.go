@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const mutatorsPath = "/mutators"
+
+// ListMutators lists the mutators of the namespace of the client.
+func (c *Client) ListMutators(ctx context.Context, opts *ListOptions) ([]types.Mutator, error) {
+	mutators := []types.Mutator{}
+	err := c.list(ctx, mutatorsPath, opts, &mutators)
+	return mutators, err
+}
+
+// GetMutator returns the mutator with the given name.
+func (c *Client) GetMutator(ctx context.Context, name string) (*types.Mutator, error) {
+	mutator := &types.Mutator{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(mutatorsPath, name), nil, mutator); err != nil {
+		return nil, err
+	}
+	return mutator, nil
+}
+
+// CreateMutator creates the mutator.
+func (c *Client) CreateMutator(ctx context.Context, mutator *types.Mutator) error {
+	return c.do(ctx, http.MethodPost, mutatorsPath, mutator, nil)
+}
+
+// UpdateMutator updates the mutator.
+func (c *Client) UpdateMutator(ctx context.Context, mutator *types.Mutator) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(mutatorsPath, mutator.Name), mutator, nil)
+}
+
+// DeleteMutator deletes the mutator with the given name.
+func (c *Client) DeleteMutator(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(mutatorsPath, name), nil, nil)
+}
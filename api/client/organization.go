@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const organizationsPath = "/rbac/organizations"
+
+// ListOrganizations lists the organizations.
+func (c *Client) ListOrganizations(ctx context.Context, opts *ListOptions) ([]types.Organization, error) {
+	orgs := []types.Organization{}
+	err := c.list(ctx, organizationsPath, opts, &orgs)
+	return orgs, err
+}
+
+// GetOrganization returns the organization with the given name.
+func (c *Client) GetOrganization(ctx context.Context, name string) (*types.Organization, error) {
+	org := &types.Organization{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(organizationsPath, name), nil, org); err != nil {
+		return nil, err
+	}
+	return org, nil
+}
+
+// CreateOrganization creates the organization.
+func (c *Client) CreateOrganization(ctx context.Context, org *types.Organization) error {
+	return c.do(ctx, http.MethodPost, organizationsPath, org, nil)
+}
+
+// UpdateOrganization updates the organization.
+func (c *Client) UpdateOrganization(ctx context.Context, org *types.Organization) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(organizationsPath, org.Name), org, nil)
+}
+
+// DeleteOrganization deletes the organization with the given name.
+func (c *Client) DeleteOrganization(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(organizationsPath, name), nil, nil)
+}
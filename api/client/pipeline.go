@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const pipelinesPath = "/pipelines"
+
+// ListPipelines lists the pipelines of the namespace of the client.
+func (c *Client) ListPipelines(ctx context.Context, opts *ListOptions) ([]types.Pipeline, error) {
+	pipelines := []types.Pipeline{}
+	err := c.list(ctx, pipelinesPath, opts, &pipelines)
+	return pipelines, err
+}
+
+// GetPipeline returns the pipeline with the given name.
+func (c *Client) GetPipeline(ctx context.Context, name string) (*types.Pipeline, error) {
+	pipeline := &types.Pipeline{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(pipelinesPath, name), nil, pipeline); err != nil {
+		return nil, err
+	}
+	return pipeline, nil
+}
+
+// CreatePipeline creates the pipeline.
+func (c *Client) CreatePipeline(ctx context.Context, pipeline *types.Pipeline) error {
+	return c.do(ctx, http.MethodPost, pipelinesPath, pipeline, nil)
+}
+
+// UpdatePipeline updates the pipeline.
+func (c *Client) UpdatePipeline(ctx context.Context, pipeline *types.Pipeline) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(pipelinesPath, pipeline.Name), pipeline, nil)
+}
+
+// DeletePipeline deletes the pipeline with the given name.
+func (c *Client) DeletePipeline(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(pipelinesPath, name), nil, nil)
+}
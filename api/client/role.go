@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const rolesPath = "/rbac/roles"
+
+// ListRoles lists the roles.
+func (c *Client) ListRoles(ctx context.Context, opts *ListOptions) ([]types.Role, error) {
+	roles := []types.Role{}
+	err := c.list(ctx, rolesPath, opts, &roles)
+	return roles, err
+}
+
+// GetRole returns the role with the given name.
+func (c *Client) GetRole(ctx context.Context, name string) (*types.Role, error) {
+	role := &types.Role{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(rolesPath, name), nil, role); err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// CreateRole creates the role.
+func (c *Client) CreateRole(ctx context.Context, role *types.Role) error {
+	return c.do(ctx, http.MethodPost, rolesPath, role, nil)
+}
+
+// UpdateRole updates the role.
+func (c *Client) UpdateRole(ctx context.Context, role *types.Role) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(rolesPath, role.Name), role, nil)
+}
+
+// DeleteRole deletes the role with the given name.
+func (c *Client) DeleteRole(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(rolesPath, name), nil, nil)
+}
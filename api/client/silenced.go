@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const silencedPath = "/silenced"
+
+// ListSilenced lists the silenced entries of the namespace of the client.
+func (c *Client) ListSilenced(ctx context.Context, opts *ListOptions) ([]types.Silenced, error) {
+	silencedList := []types.Silenced{}
+	err := c.list(ctx, silencedPath, opts, &silencedList)
+	return silencedList, err
+}
+
+// GetSilenced returns the silenced entry with the given id.
+func (c *Client) GetSilenced(ctx context.Context, id string) (*types.Silenced, error) {
+	silenced := &types.Silenced{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(silencedPath, id), nil, silenced); err != nil {
+		return nil, err
+	}
+	return silenced, nil
+}
+
+// CreateSilenced creates the silenced entry.
+func (c *Client) CreateSilenced(ctx context.Context, silenced *types.Silenced) error {
+	return c.do(ctx, http.MethodPost, silencedPath, silenced, nil)
+}
+
+// UpdateSilenced updates the silenced entry.
+func (c *Client) UpdateSilenced(ctx context.Context, silenced *types.Silenced) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(silencedPath, silenced.ID), silenced, nil)
+}
+
+// DeleteSilenced deletes the silenced entry with the given id.
+func (c *Client) DeleteSilenced(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(silencedPath, id), nil, nil)
+}
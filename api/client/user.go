@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const usersPath = "/rbac/users"
+
+// ListUsers lists the users.
+func (c *Client) ListUsers(ctx context.Context, opts *ListOptions) ([]types.User, error) {
+	users := []types.User{}
+	err := c.list(ctx, usersPath, opts, &users)
+	return users, err
+}
+
+// GetUser returns the user with the given username.
+func (c *Client) GetUser(ctx context.Context, username string) (*types.User, error) {
+	user := &types.User{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(usersPath, username), nil, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// CreateUser creates the user.
+func (c *Client) CreateUser(ctx context.Context, user *types.User) error {
+	return c.do(ctx, http.MethodPost, usersPath, user, nil)
+}
+
+// UpdateUser updates the user.
+func (c *Client) UpdateUser(ctx context.Context, user *types.User) error {
+	return c.do(ctx, http.MethodPatch, resourcePath(usersPath, user.Username), user, nil)
+}
+
+// DeleteUser deletes the user with the given username.
+func (c *Client) DeleteUser(ctx context.Context, username string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(usersPath, username), nil, nil)
+}
+
+// GetUserPreferences returns the preferences of the user with the given
+// username, which are empty if the user has none.
+func (c *Client) GetUserPreferences(ctx context.Context, username string) (*types.UserPreferences, error) {
+	preferences := &types.UserPreferences{}
+	if err := c.do(ctx, http.MethodGet, resourcePath(usersPath, username, "preferences"), nil, preferences); err != nil {
+		return nil, err
+	}
+	return preferences, nil
+}
+
+// UpdateUserPreferences replaces the preferences of the user.
+func (c *Client) UpdateUserPreferences(ctx context.Context, preferences *types.UserPreferences) error {
+	return c.do(ctx, http.MethodPut, resourcePath(usersPath, preferences.Username, "preferences"), preferences, nil)
+}
+
+// DeleteUserPreferences resets the preferences of the user with the given
+// username.
+func (c *Client) DeleteUserPreferences(ctx context.Context, username string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath(usersPath, username, "preferences"), nil, nil)
+}
+
+// CreateUserToken mints a short-lived access token for the user, scoped to
+// the permissions of the request.
+func (c *Client) CreateUserToken(ctx context.Context, username string, req *types.ScopedTokenRequest) (*types.Tokens, error) {
+	tokens := &types.Tokens{}
+	if err := c.do(ctx, http.MethodPost, resourcePath(usersPath, username, "tokens"), req, tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
@@ -2,6 +2,7 @@ package agentd
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net/http"
@@ -42,6 +43,22 @@ type Agentd struct {
 	Port       int
 	MessageBus messaging.MessageBus
 	TLS        *types.TLSOptions
+
+	// TLSConfig is the configuration of the TLS listener, which serves the
+	// agents over plain WebSockets when nil
+	TLSConfig *tls.Config
+
+	// MaxMessageSize is the maximum size of the messages received from the
+	// agents, in bytes, the larger ones being rejected. Unlimited if 0.
+	MaxMessageSize int64
+
+	// MQTTBrokerURL is the URL of the MQTT broker relaying the messages of the
+	// agents connected to it, none if empty
+	MQTTBrokerURL string
+
+	// Name is the name of the backend, recorded in the connection status of
+	// its agents
+	Name string
 }
 
 // Start Agentd.
@@ -72,8 +89,9 @@ func (a *Agentd) Start() error {
 	go func() {
 		defer a.wg.Done()
 		var err error
-		if a.TLS != nil {
-			err = a.httpServer.ListenAndServeTLS(a.TLS.CertFile, a.TLS.KeyFile)
+		if a.TLSConfig != nil {
+			a.httpServer.TLSConfig = a.TLSConfig
+			err = a.httpServer.ListenAndServeTLS("", "")
 		} else {
 			err = a.httpServer.ListenAndServe()
 		}
@@ -82,6 +100,15 @@ func (a *Agentd) Start() error {
 		}
 	}()
 
+	if a.MQTTBrokerURL != "" {
+		bridge := newMQTTBridge(a.Name, a.MQTTBrokerURL, a.TLS, a.MessageBus, a.Store)
+		a.wg.Add(1)
+		go func() {
+			defer a.wg.Done()
+			bridge.run(a.stopping)
+		}()
+	}
+
 	return nil
 }
 
@@ -120,17 +147,10 @@ func (a *Agentd) webSocketHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	cfg := SessionConfig{
-		AgentID:       r.Header.Get(transport.HeaderKeyAgentID),
-		Environment:   r.Header.Get(transport.HeaderKeyEnvironment),
-		Organization:  r.Header.Get(transport.HeaderKeyOrganization),
-		User:          r.Header.Get(transport.HeaderKeyUser),
-		Subscriptions: strings.Split(r.Header.Get(transport.HeaderKeySubscriptions), ","),
-	}
-
-	cfg.Subscriptions = addEntitySubscription(cfg.AgentID, cfg.Subscriptions)
-
-	session, err := NewSession(cfg, transport.NewTransport(conn), a.MessageBus, a.Store)
+	cfg := newSessionConfig(r.Header)
+	cfg.Backend = a.Name
+	cfg.Transport = types.EntityStatusTransportWebSocket
+	session, err := NewSession(cfg, transport.NewLimitedTransport(conn, a.MaxMessageSize), a.MessageBus, a.Store)
 	if err != nil {
 		logger.Error("failed to create session: ", err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -144,3 +164,19 @@ func (a *Agentd) webSocketHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// newSessionConfig returns the configuration of the session of an agent, given
+// its transport headers.
+func newSessionConfig(header http.Header) SessionConfig {
+	cfg := SessionConfig{
+		AgentID:       header.Get(transport.HeaderKeyAgentID),
+		Environment:   header.Get(transport.HeaderKeyEnvironment),
+		Organization:  header.Get(transport.HeaderKeyOrganization),
+		User:          header.Get(transport.HeaderKeyUser),
+		Subscriptions: strings.Split(header.Get(transport.HeaderKeySubscriptions), ","),
+		AgentVersion:  header.Get(transport.HeaderKeyAgentVersion),
+	}
+
+	cfg.Subscriptions = addEntitySubscription(cfg.AgentID, cfg.Subscriptions)
+	return cfg
+}
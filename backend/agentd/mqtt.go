@@ -0,0 +1,276 @@
+package agentd
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/transport"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// mqttReconnectDelay is the delay before the bridge reconnects to the
+	// broker after losing its connection
+	mqttReconnectDelay = 5 * time.Second
+
+	// mqttSessionBufferSize is the number of messages queued for each session
+	// before the messages of the agent are dropped
+	mqttSessionBufferSize = 100
+)
+
+// mqttPublisher publishes the messages of the sessions to the broker.
+type mqttPublisher interface {
+	Publish(topic string, payload []byte, retain bool) error
+}
+
+// An mqttBridge relays the messages of the agents connected to an MQTT broker
+// to sessions, as if they were connected to agentd. The broker is trusted to
+// authenticate the agents and to restrict them to their own topics.
+type mqttBridge struct {
+	name  string
+	url   string
+	tls   *types.TLSOptions
+	bus   messaging.MessageBus
+	store Store
+
+	publisher mqttPublisher
+	mutex     *sync.RWMutex
+
+	// sessions are the sessions of the agents connected to the broker, by
+	// organization, environment and agent ID. They're only accessed by the
+	// goroutine relaying the messages.
+	sessions map[string]*mqttSession
+}
+
+type mqttSession struct {
+	session *Session
+	conn    *mqttSessionTransport
+}
+
+func newMQTTBridge(name, url string, tls *types.TLSOptions, bus messaging.MessageBus, store Store) *mqttBridge {
+	return &mqttBridge{
+		name:     name,
+		url:      url,
+		tls:      tls,
+		bus:      bus,
+		store:    store,
+		mutex:    &sync.RWMutex{},
+		sessions: make(map[string]*mqttSession),
+	}
+}
+
+// run relays the messages of the agents until stopping is closed, connecting
+// to the broker again when the connection is lost.
+func (b *mqttBridge) run(stopping <-chan struct{}) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		logger.WithError(err).Error("could not start the MQTT bridge")
+		return
+	}
+	opts := transport.MQTTOptions{ClientID: "sensu-backend-" + id.String()}
+	filter := transport.MQTTAgentTopic("+", "+", "+", transport.MQTTUpstream, "+")
+
+	for {
+		client, err := transport.DialMQTT(b.url, b.tls, opts)
+		if err == nil {
+			if err = client.Subscribe(filter); err != nil {
+				_ = client.Close()
+			}
+		}
+		if err == nil {
+			logger.Info("connected to the MQTT broker: ", b.url)
+			err = b.relay(client, stopping)
+		}
+
+		select {
+		case <-stopping:
+			return
+		default:
+		}
+		logger.WithError(err).Errorf("MQTT bridge disconnected, reconnecting in %s", mqttReconnectDelay)
+
+		select {
+		case <-stopping:
+			return
+		case <-time.After(mqttReconnectDelay):
+		}
+	}
+}
+
+// relay relays the messages received by the client until its connection is
+// lost or stopping is closed. The sessions are then stopped.
+func (b *mqttBridge) relay(client *transport.MQTTClient, stopping <-chan struct{}) error {
+	b.setPublisher(client)
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-stopping:
+			_ = client.Close()
+		case <-done:
+		}
+	}()
+
+	defer func() {
+		for key := range b.sessions {
+			b.disconnect(key)
+		}
+		_ = client.Close()
+	}()
+
+	for {
+		topic, payload, err := client.ReadMessage()
+		if err != nil {
+			return err
+		}
+		b.handleMessage(topic, payload)
+	}
+}
+
+func (b *mqttBridge) setPublisher(publisher mqttPublisher) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.publisher = publisher
+}
+
+func (b *mqttBridge) publish(topic string, payload []byte) error {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.publisher.Publish(topic, payload, false)
+}
+
+// handleMessage handles a message published by an agent. Its presence starts
+// or stops its session, and its other messages are relayed to its session.
+func (b *mqttBridge) handleMessage(topic string, payload []byte) {
+	org, env, agentID, stream, msgType, err := transport.ParseMQTTAgentTopic(topic)
+	if err != nil || stream != transport.MQTTUpstream {
+		return
+	}
+	key := path.Join(org, env, agentID)
+
+	if msgType == transport.MessageTypePresence {
+		b.disconnect(key)
+		if len(payload) > 0 {
+			b.connect(key, org, env, agentID, payload)
+		}
+		return
+	}
+
+	s, ok := b.sessions[key]
+	if !ok {
+		logger.WithField("agent", agentID).Debug("message of a disconnected MQTT agent ignored")
+		return
+	}
+	select {
+	case s.conn.in <- &transport.Message{Type: msgType, Payload: payload}:
+	default:
+		logger.WithField("agent", agentID).Warn("MQTT session queue full, message dropped")
+	}
+}
+
+// connect starts the session of an agent, given its presence message.
+func (b *mqttBridge) connect(key, org, env, agentID string, presence []byte) {
+	header := http.Header{}
+	if err := json.Unmarshal(presence, &header); err != nil {
+		logger.WithError(err).WithField("agent", agentID).Error("invalid MQTT agent presence")
+		return
+	}
+
+	// The topic is authoritative, since the agents are restricted to theirs
+	header.Set(transport.HeaderKeyOrganization, org)
+	header.Set(transport.HeaderKeyEnvironment, env)
+	header.Set(transport.HeaderKeyAgentID, agentID)
+
+	conn := &mqttSessionTransport{
+		bridge:    b,
+		org:       org,
+		env:       env,
+		agentID:   agentID,
+		in:        make(chan *transport.Message, mqttSessionBufferSize),
+		closing:   make(chan struct{}),
+		closeOnce: &sync.Once{},
+	}
+	cfg := newSessionConfig(header)
+	cfg.Backend = b.name
+	cfg.Transport = types.EntityStatusTransportMQTT
+	session, err := NewSession(cfg, conn, b.bus, b.store)
+	if err != nil {
+		logger.WithError(err).WithField("agent", agentID).Error("failed to create session")
+		return
+	}
+	if err := session.Start(); err != nil {
+		logger.WithError(err).WithField("agent", agentID).Error("failed to start session")
+		return
+	}
+	b.sessions[key] = &mqttSession{session: session, conn: conn}
+}
+
+// disconnect stops the session of an agent, if it's connected.
+func (b *mqttBridge) disconnect(key string) {
+	s, ok := b.sessions[key]
+	if !ok {
+		return
+	}
+	delete(b.sessions, key)
+	_ = s.conn.Close()
+	s.session.Stop()
+}
+
+// An mqttSessionTransport is the Transport of the session of an agent
+// connected to the broker.
+type mqttSessionTransport struct {
+	bridge  *mqttBridge
+	org     string
+	env     string
+	agentID string
+
+	in        chan *transport.Message
+	closing   chan struct{}
+	closeOnce *sync.Once
+}
+
+// Closed returns true if the agent disconnected.
+func (t *mqttSessionTransport) Closed() bool {
+	select {
+	case <-t.closing:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close closes the transport, the agent remains connected to the broker.
+func (t *mqttSessionTransport) Close() error {
+	t.closeOnce.Do(func() {
+		close(t.closing)
+	})
+	return nil
+}
+
+// Send publishes a message to the agent.
+func (t *mqttSessionTransport) Send(m *transport.Message) error {
+	if t.Closed() {
+		return transport.ClosedError{Message: "the agent disconnected from the MQTT broker"}
+	}
+	topic := transport.MQTTAgentTopic(t.org, t.env, t.agentID, transport.MQTTDownstream, m.Type)
+	if err := t.bridge.publish(topic, m.Payload); err != nil {
+		return transport.ConnectionError{Message: err.Error()}
+	}
+	return nil
+}
+
+// Receive blocks until the agent publishes a message.
+func (t *mqttSessionTransport) Receive() (*transport.Message, error) {
+	select {
+	case m := <-t.in:
+		return m, nil
+	case <-t.closing:
+		return nil, transport.ClosedError{Message: "the agent disconnected from the MQTT broker"}
+	}
+}
@@ -0,0 +1,102 @@
+package agentd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/transport"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testPublisher struct {
+	published chan string
+}
+
+func (p *testPublisher) Publish(topic string, payload []byte, retain bool) error {
+	p.published <- topic
+	return nil
+}
+
+func TestMQTTBridge(t *testing.T) {
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	defer func() { _ = bus.Stop() }()
+
+	keepalives := make(chan interface{}, 1)
+	require.NoError(t, bus.Subscribe(messaging.TopicKeepalive, t.Name(), keepalives))
+
+	st := &mockstore.MockStore{}
+	st.On("GetEnvironment", mock.Anything, "org", "env").Return(&types.Environment{}, nil)
+	var status types.EntityStatus
+	setStatus := func(args mock.Arguments) {
+		status = *args.Get(1).(*types.EntityStatus)
+	}
+	st.On("UpdateEntityStatus", mock.Anything, mock.AnythingOfType("*types.EntityStatus")).Return(nil).Run(setStatus)
+	st.On("DisconnectEntityStatus", mock.Anything, mock.AnythingOfType("*types.EntityStatus")).Return(nil).Run(setStatus)
+
+	publisher := &testPublisher{published: make(chan string, 1)}
+	bridge := newMQTTBridge("backend1", "", nil, bus, st)
+	bridge.setPublisher(publisher)
+
+	ringHas := func(sub string) bool {
+		ring := st.GetRing("subscription", messaging.SubscriptionTopic("org", "env", sub))
+		agent, err := ring.Peek(context.Background())
+		return err == nil && agent == "agent1"
+	}
+
+	// The presence of the agent starts its session, the organization,
+	// environment and ID being those of its topic
+	header := http.Header{}
+	header.Set(transport.HeaderKeyAgentID, "agent2")
+	header.Set(transport.HeaderKeyOrganization, "org")
+	header.Set(transport.HeaderKeyEnvironment, "env")
+	header.Set(transport.HeaderKeySubscriptions, "linux")
+	presence, err := json.Marshal(header)
+	require.NoError(t, err)
+	bridge.handleMessage("sensu/org/env/agent1/up/presence", presence)
+	require.Len(t, bridge.sessions, 1)
+	assert.True(t, ringHas("linux"))
+	assert.True(t, status.Connected)
+	assert.Equal(t, "backend1", status.Backend)
+	assert.Equal(t, types.EntityStatusTransportMQTT, status.Transport)
+
+	// The messages of the agent are relayed to its session
+	entity := types.FixtureEntity("agent1")
+	entity.Subscriptions = []string{"linux"}
+	keepalive, err := json.Marshal(&types.Event{Entity: entity, Timestamp: time.Now().Unix()})
+	require.NoError(t, err)
+	bridge.handleMessage("sensu/org/env/agent1/up/keepalive", keepalive)
+	select {
+	case msg := <-keepalives:
+		assert.Equal(t, "agent1", msg.(*types.Event).Entity.ID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("keepalive not relayed")
+	}
+
+	// The messages of the session are published to the agent
+	require.NoError(t, bus.Publish(messaging.SubscriptionTopic("org", "env", "linux"), types.FixtureCheckRequest("check")))
+	select {
+	case topic := <-publisher.published:
+		assert.Equal(t, "sensu/org/env/agent1/down/check_request", topic)
+	case <-time.After(5 * time.Second):
+		t.Fatal("check request not published")
+	}
+
+	// The cleared presence stops the session
+	bridge.handleMessage("sensu/org/env/agent1/up/presence", nil)
+	assert.Empty(t, bridge.sessions)
+	assert.False(t, ringHas("linux"))
+	assert.False(t, status.Connected)
+
+	// The messages of the disconnected agents are ignored
+	bridge.handleMessage("sensu/org/env/agent1/up/keepalive", keepalive)
+	assert.Empty(t, keepalives)
+}
@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/Sirupsen/logrus"
 	"github.com/google/uuid"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
@@ -15,8 +17,17 @@ import (
 	"github.com/sensu/sensu-go/types"
 )
 
+var (
+	// publishRetryInterval is how long a session waits before publishing
+	// again a message dropped by the message bus because of backpressure. It
+	// is doubled after every attempt, up to maxPublishRetryInterval.
+	publishRetryInterval    = 100 * time.Millisecond
+	maxPublishRetryInterval = 5 * time.Second
+)
+
 // SessionStore specifies the storage requirements of the Session.
 type SessionStore interface {
+	store.CheckConfigStore
 	store.EntityStore
 	store.EnvironmentStore
 	types.RingGetter
@@ -39,12 +50,22 @@ type Session struct {
 	sendq        chan *transport.Message
 	checkChannel chan interface{}
 	bus          messaging.MessageBus
+
+	// subscriptionsMu guards the subscriptions of the session config, which
+	// are updated by the keepalives of the agent
+	subscriptionsMu sync.Mutex
+
+	// status is the connection status of the agent, only accessed by the
+	// goroutine receiving its messages once the session is started
+	status *types.EntityStatus
 }
 
 func newSessionHandler(s *Session) *handler.MessageHandler {
 	handler := handler.NewMessageHandler()
 	handler.AddHandler(transport.MessageTypeKeepalive, s.handleKeepalive)
 	handler.AddHandler(transport.MessageTypeEvent, s.handleEvent)
+	handler.AddHandler(transport.MessageTypeDeregister, s.handleDeregister)
+	handler.AddHandler(transport.MessageTypeStandaloneChecks, s.handleStandaloneChecks)
 
 	return handler
 }
@@ -57,6 +78,14 @@ type SessionConfig struct {
 	AgentID       string
 	User          string
 	Subscriptions []string
+
+	// AgentVersion is the version of the agent, if it sent it
+	AgentVersion string
+
+	// Backend is the name of the backend of the session, and Transport the
+	// transport of the agent connection, as recorded in its status
+	Backend   string
+	Transport string
 }
 
 // NewSession creates a new Session object given the triple of a transport
@@ -100,6 +129,9 @@ func (s *Session) receiveMessages(out chan *transport.Message) {
 			case transport.ConnectionError, transport.ClosedError:
 				logger.Error("recv error: ", err.Error())
 				return
+			case transport.MessageTooLargeError:
+				logger.WithField("agent", s.cfg.AgentID).Warn("message rejected: ", err.Error())
+				continue
 			default:
 				logger.Error("recv error: ", err.Error())
 				continue
@@ -144,19 +176,25 @@ func (s *Session) subPump() {
 	for {
 		select {
 		case c := <-s.checkChannel:
-			request, ok := c.(*types.CheckRequest)
-			if !ok {
+			var msgType string
+			switch c.(type) {
+			case *types.CheckRequest:
+				msgType = types.CheckRequestType
+			case *types.EntityMetadataPatch:
+				msgType = types.EntityMetadataPatchType
+			default:
 				logger.Errorf("session received non-config over check channel")
 				continue
 			}
 
-			configBytes, err := json.Marshal(request)
+			configBytes, err := json.Marshal(c)
 			if err != nil {
-				logger.WithError(err).Error("session failed to serialize check request")
+				logger.WithError(err).Errorf("session failed to serialize %s", msgType)
+				continue
 			}
 
 			msg := &transport.Message{
-				Type:    types.CheckRequestType,
+				Type:    msgType,
 				Payload: configBytes,
 			}
 			s.sendq <- msg
@@ -197,29 +235,145 @@ func (s *Session) sendPump() {
 // 3. Start subscription pump
 // 5. Ensure bus unsubscribe when the session shuts down.
 func (s *Session) Start() error {
+	s.status = &types.EntityStatus{
+		EntityID:     s.cfg.AgentID,
+		Organization: s.cfg.Organization,
+		Environment:  s.cfg.Environment,
+		Connected:    true,
+		Backend:      s.cfg.Backend,
+		Session:      s.ID,
+		Transport:    s.cfg.Transport,
+		AgentVersion: s.cfg.AgentVersion,
+		ConnectedAt:  time.Now().Unix(),
+	}
+	connected := *s.status
+
 	s.wg = &sync.WaitGroup{}
 	s.wg.Add(3)
 	go s.sendPump()
 	go s.recvPump()
 	go s.subPump()
 
-	org, env := s.cfg.Organization, s.cfg.Environment
-	agentID := s.cfg.AgentID
+	s.subscriptionsMu.Lock()
+	for _, sub := range s.cfg.Subscriptions {
+		if err := s.subscribe(sub); err != nil {
+			s.subscriptionsMu.Unlock()
+			return err
+		}
+	}
+	s.subscriptionsMu.Unlock()
+
+	s.updateStatus(&connected)
+
+	return nil
+}
+
+// updateStatus stores the connection status of the agent. Errors are only
+// logged, the status being informational.
+func (s *Session) updateStatus(status *types.EntityStatus) {
+	if err := s.store.UpdateEntityStatus(context.TODO(), status); err != nil {
+		logger.WithError(err).WithField("agent", s.cfg.AgentID).Error("error updating agent status")
+	}
+}
+
+// disconnectStatus marks the agent as disconnected in its status, unless it
+// connected again in another session meanwhile. The last keepalive received,
+// only kept in memory so far, is recorded as well.
+func (s *Session) disconnectStatus() {
+	if s.status == nil {
+		return
+	}
+
+	status := *s.status
+	status.Connected = false
+	status.DisconnectedAt = time.Now().Unix()
+	if err := s.store.DisconnectEntityStatus(context.TODO(), &status); err != nil {
+		logger.WithError(err).WithField("agent", s.cfg.AgentID).Error("error updating agent status")
+	}
+}
+
+func (s *Session) subscribe(sub string) error {
+	topic := messaging.SubscriptionTopic(s.cfg.Organization, s.cfg.Environment, sub)
+	logger.Debugf("Subscribing to topic %q", topic)
+	if err := s.bus.Subscribe(topic, s.cfg.AgentID, s.checkChannel); err != nil {
+		logger.WithError(err).Error("error starting subscription")
+		return err
+	}
+	ring := s.store.GetRing("subscription", topic)
+	if err := ring.Add(context.TODO(), s.cfg.AgentID); err != nil {
+		logger.WithError(err).Errorf(
+			"error adding agent %q to ring", s.cfg.AgentID)
+		return err
+	}
+	return nil
+}
+
+// unsubscribe unsubscribes the session from the topic of a subscription, and
+// removes the agent from the ring of the subscription.
+func (s *Session) unsubscribe(sub string) error {
+	topic := messaging.SubscriptionTopic(s.cfg.Organization, s.cfg.Environment, sub)
+	logger.Debugf("Unsubscribing from topic %q", topic)
+	if err := s.bus.Unsubscribe(topic, s.cfg.AgentID); err != nil {
+		return err
+	}
+	ring := s.store.GetRing("subscription", topic)
+	if err := ring.Remove(context.TODO(), s.cfg.AgentID); err != nil {
+		logger.WithError(err).Errorf(
+			"error removing agent %q from ring", s.cfg.AgentID)
+	}
+	return nil
+}
 
+// updateSubscriptions subscribes the session to the subscriptions it's
+// missing, and unsubscribes it from the ones it no longer has, so that the
+// agents can change their subscriptions without reconnecting.
+func (s *Session) updateSubscriptions(subscriptions []string) error {
+	s.subscriptionsMu.Lock()
+	defer s.subscriptionsMu.Unlock()
+
+	current := make(map[string]bool, len(s.cfg.Subscriptions))
 	for _, sub := range s.cfg.Subscriptions {
-		topic := messaging.SubscriptionTopic(org, env, sub)
-		logger.Debugf("Subscribing to topic %q", topic)
-		if err := s.bus.Subscribe(topic, agentID, s.checkChannel); err != nil {
-			logger.WithError(err).Error("error starting subscription")
+		current[sub] = true
+	}
+	wanted := make(map[string]bool, len(subscriptions))
+	for _, sub := range subscriptions {
+		wanted[sub] = true
+	}
+	if len(current) == len(wanted) {
+		changed := false
+		for sub := range wanted {
+			if !current[sub] {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			return nil
+		}
+	}
+
+	logger.Infof("agent subscriptions changed: id=%s subscriptions=%s", s.cfg.AgentID, subscriptions)
+
+	updated := make([]string, 0, len(wanted))
+	for _, sub := range s.cfg.Subscriptions {
+		if wanted[sub] {
+			updated = append(updated, sub)
+			continue
+		}
+		if err := s.unsubscribe(sub); err != nil {
 			return err
 		}
-		ring := s.store.GetRing("subscription", topic)
-		if err := ring.Add(context.TODO(), agentID); err != nil {
-			logger.WithError(err).Errorf(
-				"error adding agent %q to ring", s.cfg.AgentID)
+	}
+	for sub := range wanted {
+		if current[sub] {
+			continue
+		}
+		if err := s.subscribe(sub); err != nil {
 			return err
 		}
+		updated = append(updated, sub)
 	}
+	s.cfg.Subscriptions = updated
 
 	return nil
 }
@@ -230,26 +384,19 @@ func (s *Session) Stop() {
 	close(s.stopping)
 	s.wg.Wait()
 
-	org, env := s.cfg.Organization, s.cfg.Environment
-	agentID := s.cfg.AgentID
-
+	s.subscriptionsMu.Lock()
 	for _, sub := range s.cfg.Subscriptions {
-		topic := messaging.SubscriptionTopic(org, env, sub)
-		logger.Debugf("Unsubscribing from topic %q", topic)
-		if err := s.bus.Unsubscribe(topic, agentID); err != nil {
+		if err := s.unsubscribe(sub); err != nil {
 			// Bus has stopped running already, no need for further unsubscribe
 			// attempts.
 			logger.Debug(err)
 			break
 		}
-		ring := s.store.GetRing("subscription", topic)
-		if err := ring.Remove(context.TODO(), s.cfg.AgentID); err != nil {
-			// Try to remove as many entries as possible, so don't return early
-			logger.WithError(err).Errorf(
-				"error removing agent %q from ring", s.cfg.AgentID)
-		}
 	}
+	s.subscriptionsMu.Unlock()
 	close(s.checkChannel)
+
+	s.disconnectStatus()
 }
 
 func (s *Session) handleKeepalive(payload []byte) error {
@@ -270,7 +417,78 @@ func (s *Session) handleKeepalive(payload []byte) error {
 
 	keepalive.Entity.Subscriptions = addEntitySubscription(keepalive.Entity.ID, keepalive.Entity.Subscriptions)
 
-	return s.bus.Publish(messaging.TopicKeepalive, keepalive)
+	// The subscriptions of the agent may have been refreshed since it connected
+	if keepalive.Entity.ID == s.cfg.AgentID {
+		if err := s.updateSubscriptions(keepalive.Entity.Subscriptions); err != nil {
+			return err
+		}
+		// The status is only stored when it changes, i.e. when the agent
+		// connects or disconnects, rather than on every keepalive
+		s.status.LastKeepalive = keepalive.Timestamp
+	}
+
+	return s.publish(messaging.TopicKeepalive, keepalive)
+}
+
+// handleDeregister relays the deregistration requested by a gracefully shut
+// down agent. Agents can only deregister their own entity.
+func (s *Session) handleDeregister(payload []byte) error {
+	event := &types.Event{}
+	if err := json.Unmarshal(payload, event); err != nil {
+		return err
+	}
+
+	if event.Entity == nil {
+		return errors.New("deregistration does not contain an entity")
+	}
+
+	if event.Entity.ID != s.cfg.AgentID {
+		return fmt.Errorf("agent %s cannot deregister the entity %s", s.cfg.AgentID, event.Entity.ID)
+	}
+	event.Entity.Organization = s.cfg.Organization
+	event.Entity.Environment = s.cfg.Environment
+
+	return s.publish(messaging.TopicDeregistration, event.Entity)
+}
+
+// handleStandaloneChecks stores the definitions of the standalone checks
+// scheduled by the agent, in its organization and environment. The checks
+// scheduled by the backend are never replaced by standalone checks.
+func (s *Session) handleStandaloneChecks(payload []byte) error {
+	var checks []*types.CheckConfig
+	if err := json.Unmarshal(payload, &checks); err != nil {
+		return err
+	}
+
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, s.cfg.Organization)
+	ctx = context.WithValue(ctx, types.EnvironmentKey, s.cfg.Environment)
+
+	for _, check := range checks {
+		check.Organization = s.cfg.Organization
+		check.Environment = s.cfg.Environment
+		check.Standalone = true
+
+		fields := logrus.Fields{"agent": s.cfg.AgentID, "check": check.Name}
+		if err := check.Validate(); err != nil {
+			logger.WithFields(fields).WithError(err).Error("invalid standalone check")
+			continue
+		}
+
+		existing, err := s.store.GetCheckConfigByName(ctx, check.Name)
+		if err != nil {
+			return err
+		}
+		if existing != nil && !existing.Standalone {
+			logger.WithFields(fields).Error("a check scheduled by the backend has the name of the standalone check")
+			continue
+		}
+
+		if err := s.store.UpdateCheckConfig(ctx, check); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (s *Session) handleEvent(payload []byte) error {
@@ -294,5 +512,31 @@ func (s *Session) handleEvent(payload []byte) error {
 	// Add the entity subscription to the subscriptions of this entity
 	event.Entity.Subscriptions = addEntitySubscription(event.Entity.ID, event.Entity.Subscriptions)
 
-	return s.bus.Publish(messaging.TopicEventRaw, event)
+	return s.publish(messaging.TopicEventRaw, event)
+}
+
+// publish publishes the message to the topic, publishing it again while the
+// message bus applies backpressure, until the session stops. The messages of
+// the agent are not received meanwhile, which slows the agent down rather
+// than dropping its events.
+func (s *Session) publish(topic string, msg interface{}) error {
+	interval := publishRetryInterval
+	for {
+		err := s.bus.Publish(topic, msg)
+		if err != messaging.ErrBackpressure {
+			return err
+		}
+
+		logger.WithField("agent", s.cfg.AgentID).Warnf("the backend is busy, publishing to %s again in %s", topic, interval)
+		select {
+		case <-s.stopping:
+			return err
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > maxPublishRetryInterval {
+			interval = maxPublishRetryInterval
+		}
+	}
 }
@@ -1,8 +1,11 @@
 package agentd
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/testing/mockstore"
@@ -99,3 +102,255 @@ func TestBadSessionConfig(t *testing.T) {
 	assert.Nil(t, session)
 	assert.Error(t, err)
 }
+
+func TestSessionKeepaliveUpdatesSubscriptions(t *testing.T) {
+	conn := &testTransport{
+		sendCh: make(chan *transport.Message, 10),
+	}
+
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	defer func() { _ = bus.Stop() }()
+
+	st := &mockstore.MockStore{}
+	st.On("GetEnvironment", mock.Anything, "org", "env").Return(&types.Environment{}, nil)
+	st.On("UpdateEntityStatus", mock.Anything, mock.AnythingOfType("*types.EntityStatus")).Return(nil)
+
+	cfg := SessionConfig{
+		AgentID:       t.Name(),
+		Organization:  "org",
+		Environment:   "env",
+		Subscriptions: addEntitySubscription(t.Name(), []string{"linux"}),
+	}
+	session, err := NewSession(cfg, conn, bus, st)
+	require.NoError(t, err)
+	require.NoError(t, session.Start())
+
+	ringHas := func(sub string) bool {
+		ring := st.GetRing("subscription", messaging.SubscriptionTopic("org", "env", sub))
+		agent, err := ring.Peek(context.Background())
+		return err == nil && agent == t.Name()
+	}
+	assert.True(t, ringHas("linux"))
+
+	entity := types.FixtureEntity(t.Name())
+	entity.Subscriptions = []string{"linux", "nginx"}
+	payload, err := json.Marshal(&types.Event{Entity: entity, Timestamp: time.Now().Unix()})
+	require.NoError(t, err)
+	require.NoError(t, session.handleKeepalive(payload))
+	assert.True(t, ringHas("linux"))
+	assert.True(t, ringHas("nginx"))
+
+	entity.Subscriptions = []string{"nginx"}
+	payload, err = json.Marshal(&types.Event{Entity: entity, Timestamp: time.Now().Unix()})
+	require.NoError(t, err)
+	require.NoError(t, session.handleKeepalive(payload))
+	assert.False(t, ringHas("linux"))
+	assert.True(t, ringHas("nginx"))
+	assert.Contains(t, session.cfg.Subscriptions, types.GetEntitySubscription(t.Name()))
+}
+
+func TestSessionDeregister(t *testing.T) {
+	conn := &testTransport{
+		sendCh: make(chan *transport.Message, 10),
+	}
+
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	defer func() { _ = bus.Stop() }()
+
+	deregistrations := make(chan interface{}, 1)
+	require.NoError(t, bus.Subscribe(messaging.TopicDeregistration, t.Name(), deregistrations))
+
+	st := &mockstore.MockStore{}
+	st.On("GetEnvironment", mock.Anything, "org", "env").Return(&types.Environment{}, nil)
+
+	cfg := SessionConfig{
+		AgentID:      "agent1",
+		Organization: "org",
+		Environment:  "env",
+	}
+	session, err := NewSession(cfg, conn, bus, st)
+	require.NoError(t, err)
+
+	// Agents cannot deregister other entities
+	payload, err := json.Marshal(&types.Event{Entity: types.FixtureEntity("agent2")})
+	require.NoError(t, err)
+	assert.Error(t, session.handleDeregister(payload))
+
+	payload, err = json.Marshal(&types.Event{Entity: types.FixtureEntity("agent1")})
+	require.NoError(t, err)
+	require.NoError(t, session.handleDeregister(payload))
+
+	entity := (<-deregistrations).(*types.Entity)
+	assert.Equal(t, "agent1", entity.ID)
+	assert.Equal(t, "org", entity.Organization)
+	assert.Equal(t, "env", entity.Environment)
+}
+
+func TestSessionStandaloneChecks(t *testing.T) {
+	conn := &testTransport{
+		sendCh: make(chan *transport.Message, 10),
+	}
+
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	defer func() { _ = bus.Stop() }()
+
+	backendCheck := types.FixtureCheckConfig("backend-check")
+	st := &mockstore.MockStore{}
+	st.On("GetEnvironment", mock.Anything, "org", "env").Return(&types.Environment{}, nil)
+	st.On("GetCheckConfigByName", mock.Anything, "disk").Return((*types.CheckConfig)(nil), nil)
+	st.On("GetCheckConfigByName", mock.Anything, "backend-check").Return(backendCheck, nil)
+	st.On("UpdateCheckConfig", mock.Anything, mock.AnythingOfType("*types.CheckConfig")).Return(nil)
+
+	cfg := SessionConfig{
+		AgentID:      "agent1",
+		Organization: "org",
+		Environment:  "env",
+	}
+	session, err := NewSession(cfg, conn, bus, st)
+	require.NoError(t, err)
+
+	disk := &types.CheckConfig{Name: "disk", Command: "check-disk", Interval: 60}
+	invalid := &types.CheckConfig{Name: "invalid", Command: "true"}
+	conflicting := &types.CheckConfig{Name: "backend-check", Command: "true", Interval: 60}
+	payload, err := json.Marshal([]*types.CheckConfig{disk, invalid, conflicting})
+	require.NoError(t, err)
+	require.NoError(t, session.handleStandaloneChecks(payload))
+
+	// Only the valid check, which doesn't replace a backend check, is stored
+	st.AssertNumberOfCalls(t, "UpdateCheckConfig", 1)
+	var stored *types.CheckConfig
+	for _, call := range st.Calls {
+		if call.Method == "UpdateCheckConfig" {
+			stored = call.Arguments.Get(1).(*types.CheckConfig)
+		}
+	}
+	require.NotNil(t, stored)
+	assert.Equal(t, "disk", stored.Name)
+	assert.Equal(t, "org", stored.Organization)
+	assert.Equal(t, "env", stored.Environment)
+	assert.True(t, stored.Standalone)
+}
+
+func TestSessionStatus(t *testing.T) {
+	conn := &testTransport{
+		sendCh: make(chan *transport.Message, 10),
+	}
+
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	defer func() { _ = bus.Stop() }()
+
+	var statuses []types.EntityStatus
+	st := &mockstore.MockStore{}
+	st.On("GetEnvironment", mock.Anything, "org", "env").Return(&types.Environment{}, nil)
+	st.On("UpdateEntityStatus", mock.Anything, mock.AnythingOfType("*types.EntityStatus")).Return(nil).Run(func(args mock.Arguments) {
+		statuses = append(statuses, *args.Get(1).(*types.EntityStatus))
+	})
+
+	cfg := SessionConfig{
+		AgentID:      "agent1",
+		Organization: "org",
+		Environment:  "env",
+		AgentVersion: "2.0.0",
+		Backend:      "backend1",
+		Transport:    types.EntityStatusTransportWebSocket,
+	}
+	session, err := NewSession(cfg, conn, bus, st)
+	require.NoError(t, err)
+	require.NoError(t, session.Start())
+
+	require.Len(t, statuses, 1)
+	status := statuses[0]
+	assert.NoError(t, status.Validate())
+	assert.True(t, status.Connected)
+	assert.Equal(t, session.ID, status.Session)
+	assert.Equal(t, "backend1", status.Backend)
+	assert.Equal(t, "2.0.0", status.AgentVersion)
+	assert.NotZero(t, status.ConnectedAt)
+
+	// The keepalives of the agent don't change its stored status
+	payload, err := json.Marshal(&types.Event{Entity: types.FixtureEntity("agent1"), Timestamp: 1522799116})
+	require.NoError(t, err)
+	require.NoError(t, session.handleKeepalive(payload))
+	require.Len(t, statuses, 1)
+
+	// The last keepalive is recorded once the agent disconnects
+	var disconnected types.EntityStatus
+	st.On("DisconnectEntityStatus", mock.Anything, mock.AnythingOfType("*types.EntityStatus")).Return(nil).Run(func(args mock.Arguments) {
+		disconnected = *args.Get(1).(*types.EntityStatus)
+	})
+	session.disconnectStatus()
+	assert.False(t, disconnected.Connected)
+	assert.NotZero(t, disconnected.DisconnectedAt)
+	assert.Equal(t, session.ID, disconnected.Session)
+	assert.Equal(t, int64(1522799116), disconnected.LastKeepalive)
+}
+
+func TestSessionRelaysEntityMetadataPatch(t *testing.T) {
+	conn := &testTransport{
+		sendCh: make(chan *transport.Message, 10),
+	}
+
+	st := &mockstore.MockStore{}
+	st.On("GetEnvironment", mock.Anything, "org", "env").Return(&types.Environment{}, nil)
+
+	cfg := SessionConfig{
+		AgentID:      t.Name(),
+		Organization: "org",
+		Environment:  "env",
+	}
+	session, err := NewSession(cfg, conn, &messaging.WizardBus{}, st)
+	require.NoError(t, err)
+
+	session.wg.Add(1)
+	go session.subPump()
+	defer func() {
+		close(session.stopping)
+		session.wg.Wait()
+	}()
+
+	patch := &types.EntityMetadataPatch{Labels: map[string]string{"team": "ops"}}
+	session.checkChannel <- patch
+
+	msg := <-session.sendq
+	assert.Equal(t, types.EntityMetadataPatchType, msg.Type)
+
+	var received types.EntityMetadataPatch
+	require.NoError(t, json.Unmarshal(msg.Payload, &received))
+	assert.Equal(t, *patch, received)
+}
+
+// busyBus drops the first messages published, as if its subscribers were busy.
+type busyBus struct {
+	messaging.MessageBus
+	busy      int
+	published []interface{}
+}
+
+func (b *busyBus) Publish(topic string, msg interface{}) error {
+	if b.busy > 0 {
+		b.busy--
+		return messaging.ErrBackpressure
+	}
+	b.published = append(b.published, msg)
+	return nil
+}
+
+func TestSessionPublishBackpressure(t *testing.T) {
+	publishRetryInterval = time.Millisecond
+	bus := &busyBus{busy: 2}
+	session := &Session{bus: bus, stopping: make(chan struct{})}
+
+	// The messages dropped because of backpressure are published again
+	event := types.FixtureEvent("entity1", "check1")
+	require.NoError(t, session.publish(messaging.TopicEventRaw, event))
+	assert.Equal(t, []interface{}{event}, bus.published)
+
+	// The message is dropped once the session stops
+	bus.busy = 1
+	close(session.stopping)
+	assert.Equal(t, messaging.ErrBackpressure, session.publish(messaging.TopicEventRaw, event))
+}
@@ -89,17 +89,10 @@ func (a AssetController) Create(ctx context.Context, newAsset types.Asset) error
 		return NewErrorf(PermissionDenied)
 	}
 
-	// Validate
-	if err := newAsset.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
-	}
-
-	// Persist
-	if err := a.Store.UpdateAsset(ctx, &newAsset); err != nil {
-		return NewError(InternalErr, err)
-	}
-
-	return nil
+	// Validate and persist
+	return persistResource(ctx, &newAsset, func(ctx context.Context) error {
+		return a.Store.UpdateAsset(ctx, &newAsset)
+	})
 }
 
 // Update validates and persists changes to a resource if viewer has access.
@@ -124,15 +117,8 @@ func (a AssetController) Update(ctx context.Context, given types.Asset) error {
 	// Copy
 	copyFields(asset, &given, assetUpdateFields...)
 
-	// Validate
-	if err := asset.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
-	}
-
-	// Persist Changes
-	if serr := a.Store.UpdateAsset(ctx, asset); serr != nil {
-		return NewError(InternalErr, serr)
-	}
-
-	return nil
+	// Validate and persist
+	return persistResource(ctx, asset, func(ctx context.Context) error {
+		return a.Store.UpdateAsset(ctx, asset)
+	})
 }
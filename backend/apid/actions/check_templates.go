@@ -0,0 +1,190 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var checkTemplateUpdateFields = []string{
+	"Description",
+	"Check",
+	"Variables",
+	"Parameters",
+}
+
+// CheckTemplateController allows querying check templates in bulk or by name,
+// and the checks they generate.
+type CheckTemplateController struct {
+	Store  store.CheckTemplateStore
+	Policy authorization.CheckTemplatePolicy
+}
+
+// NewCheckTemplateController creates a new CheckTemplateController backed by store.
+func NewCheckTemplateController(store store.CheckTemplateStore) CheckTemplateController {
+	return CheckTemplateController{
+		Store:  store,
+		Policy: authorization.CheckTemplates,
+	}
+}
+
+// Create creates a new CheckTemplate resource.
+// It returns non-nil error if the new check template is invalid, update permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c CheckTemplateController) Create(ctx context.Context, tmpl types.CheckTemplate) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &tmpl)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	if g, err := c.Store.GetCheckTemplateByName(ctx, tmpl.Name); err != nil {
+		return NewError(InternalErr, err)
+	} else if g != nil {
+		return NewErrorf(AlreadyExistsErr, tmpl.Name)
+	}
+
+	// Verify permissions
+	if ok := policy.CanCreate(&tmpl); !ok {
+		return NewErrorf(PermissionDenied, "create")
+	}
+
+	// Validate and persist
+	return persistResource(ctx, &tmpl, func(ctx context.Context) error {
+		return c.Store.UpdateCheckTemplate(ctx, &tmpl)
+	})
+}
+
+// Update updates a check template.
+// It returns non-nil error if the new check template is invalid, create permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c CheckTemplateController) Update(ctx context.Context, delta types.CheckTemplate) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &delta)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	tmpl, err := c.Store.GetCheckTemplateByName(ctx, delta.Name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	} else if tmpl == nil {
+		return NewErrorf(NotFound, delta.Name)
+	}
+
+	// Verify viewer can make change
+	if ok := policy.CanUpdate(tmpl); !ok {
+		return NewErrorf(PermissionDenied, "update")
+	}
+
+	// Update
+	if err := tmpl.Update(&delta, checkTemplateUpdateFields...); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	// Validate and persist
+	return persistResource(ctx, tmpl, func(ctx context.Context) error {
+		return c.Store.UpdateCheckTemplate(ctx, tmpl)
+	})
+}
+
+// Query returns resources available to the viewer filter by given params.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c CheckTemplateController) Query(ctx context.Context) ([]*types.CheckTemplate, error) {
+	policy := c.Policy.WithContext(ctx)
+
+	// Fetch from store
+	tmpls, err := c.Store.GetCheckTemplates(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	result := make([]*types.CheckTemplate, 0, len(tmpls))
+
+	// Filter out those resources the viewer does not have access to view.
+	for _, g := range tmpls {
+		if ok := policy.CanRead(g); ok {
+			result = append(result, g)
+		}
+	}
+
+	return result, nil
+}
+
+// Destroy destroys the named CheckTemplate.
+// It returns non-nil error if the params are invalid, delete permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c CheckTemplateController) Destroy(ctx context.Context, name string) error {
+	policy := c.Policy.WithContext(ctx)
+
+	// Verify permissions
+	if ok := policy.CanDelete(); !ok {
+		return NewErrorf(PermissionDenied, "delete")
+	}
+
+	// Validate parameters
+	if name == "" {
+		return NewErrorf(InvalidArgument, "name is undefined")
+	}
+
+	// Fetch from store
+	tmpl, err := c.Store.GetCheckTemplateByName(ctx, name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	}
+	if tmpl == nil {
+		return NewErrorf(NotFound, name)
+	}
+
+	// Remove from store
+	if err := c.Store.DeleteCheckTemplateByName(ctx, tmpl.Name); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Find returns resource associated with given parameters if available to the
+// viewer.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c CheckTemplateController) Find(ctx context.Context, name string) (*types.CheckTemplate, error) {
+	result, err := c.Store.GetCheckTemplateByName(ctx, name)
+	if err != nil {
+		return nil, NewErrorf(InternalErr, err)
+	}
+
+	if result == nil {
+		return nil, NewErrorf(NotFound)
+	}
+
+	policy := c.Policy.WithContext(ctx)
+
+	if !policy.CanRead(result) {
+		return nil, NewErrorf(NotFound)
+	}
+
+	return result, nil
+}
+
+// Checks returns the checks generated by the named check template, if it is
+// available to the viewer.
+func (c CheckTemplateController) Checks(ctx context.Context, name string) ([]*types.CheckConfig, error) {
+	tmpl, err := c.Find(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	checks, err := tmpl.Render()
+	if err != nil {
+		return nil, NewError(InvalidArgument, err)
+	}
+
+	return checks, nil
+}
@@ -0,0 +1,142 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCheckTemplateController(t *testing.T) {
+	assert := assert.New(t)
+
+	store := &mockstore.MockStore{}
+	ctl := NewCheckTemplateController(store)
+	assert.NotNil(ctl)
+	assert.Equal(store, ctl.Store)
+	assert.NotNil(ctl.Policy)
+}
+
+func TestCheckTemplateCreate(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeCheckTemplate, types.RulePermCreate),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeCheckTemplate, types.RulePermRead),
+		),
+	)
+
+	badTemplate := types.FixtureCheckTemplate("bad")
+	badTemplate.Name = "!@#!#$@#^$%&$%&$&$%&%^*%&(%@###"
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		argument        *types.CheckTemplate
+		fetchResult     *types.CheckTemplate
+		fetchErr        error
+		createErr       error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:        "Created",
+			ctx:         defaultCtx,
+			argument:    types.FixtureCheckTemplate("http"),
+			expectedErr: false,
+		},
+		{
+			name:            "Already Exists",
+			ctx:             defaultCtx,
+			argument:        types.FixtureCheckTemplate("http"),
+			fetchResult:     types.FixtureCheckTemplate("http"),
+			expectedErr:     true,
+			expectedErrCode: AlreadyExistsErr,
+		},
+		{
+			name:            "Store Err on Fetch",
+			ctx:             defaultCtx,
+			argument:        types.FixtureCheckTemplate("http"),
+			fetchErr:        errors.New("nein"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			argument:        types.FixtureCheckTemplate("http"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Validation Error",
+			ctx:             defaultCtx,
+			argument:        badTemplate,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		store := &mockstore.MockStore{}
+		ctl := NewCheckTemplateController(store)
+
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			store.On("GetCheckTemplateByName", mock.Anything, mock.Anything).
+				Return(test.fetchResult, test.fetchErr)
+
+			store.On("UpdateCheckTemplate", mock.Anything, mock.Anything).Return(test.createErr)
+
+			err := ctl.Create(test.ctx, *test.argument)
+
+			if test.expectedErr {
+				if cerr, ok := err.(Error); ok {
+					assert.Equal(test.expectedErrCode, cerr.Code)
+				} else {
+					assert.Error(err)
+					assert.FailNow("Not of type 'Error'")
+				}
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestCheckTemplateChecks(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeCheckTemplate, types.RulePermRead),
+		),
+	)
+
+	store := &mockstore.MockStore{}
+	store.On("GetCheckTemplateByName", mock.Anything, "http").Return(types.FixtureCheckTemplate("http"), nil)
+	ctl := NewCheckTemplateController(store)
+
+	checks, err := ctl.Checks(ctx, "http")
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+	assert.Equal(t, "check-http-web01", checks[0].Name)
+	assert.Equal(t, "check-http-web02", checks[1].Name)
+
+	var nilTemplate *types.CheckTemplate
+	store.On("GetCheckTemplateByName", mock.Anything, "missing").Return(nilTemplate, nil)
+	_, err = ctl.Checks(ctx, "missing")
+	require.Error(t, err)
+	assert.Equal(t, NotFound, err.(Error).Code)
+}
@@ -29,6 +29,23 @@ var checkConfigUpdateFields = []string{
 	"Timeout",
 	"Ttl",
 	"ProxyRequests",
+	"OutputAnnotations",
+	"MetricRetention",
+	"ExecType",
+	"Shell",
+	"RunAs",
+	"WorkingDirectory",
+	"CPULimit",
+	"MemoryLimit",
+	"Nice",
+	"Executor",
+	"CatchUp",
+	"RunbookURL",
+	"DashboardURL",
+	"AnomalyDetection",
+	"AnomalyThreshold",
+	"AnomalyWindow",
+	"Contacts",
 }
 
 var (
@@ -38,6 +55,7 @@ var (
 // CheckStore contains storage and queue info for Checks.
 type CheckStore interface {
 	store.CheckConfigStore
+	store.ClusterConfigStore
 	queue.Get
 }
 
@@ -46,6 +64,9 @@ type CheckController struct {
 	Store      CheckStore
 	Policy     authorization.CheckPolicy
 	checkQueue queue.Interface
+
+	// Tombstones keeps the deleted checks during the undo window
+	Tombstones *Tombstones
 }
 
 // NewCheckController returns new CheckController
@@ -114,17 +135,19 @@ func (a CheckController) Create(ctx context.Context, newCheck types.CheckConfig)
 		return NewErrorf(PermissionDenied)
 	}
 
-	// Validate
-	if err := newCheck.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
-	}
-
-	// Persist
-	if err := a.Store.UpdateCheckConfig(ctx, &newCheck); err != nil {
-		return NewError(InternalErr, err)
+	// Apply the default timeout of the organization
+	if newCheck.Timeout == 0 {
+		config, err := store.GetEffectiveClusterConfig(ctx, a.Store, newCheck.Organization)
+		if err != nil {
+			return NewError(InternalErr, err)
+		}
+		newCheck.Timeout = config.DefaultCheckTimeout
 	}
 
-	return nil
+	// Validate and persist
+	return persistResource(ctx, &newCheck, func(ctx context.Context) error {
+		return a.Store.UpdateCheckConfig(ctx, &newCheck)
+	})
 }
 
 // Update validates and persists changes to a resource if viewer has access.
@@ -149,17 +172,10 @@ func (a CheckController) Update(ctx context.Context, given types.CheckConfig) er
 	// Copy
 	copyFields(check, &given, checkConfigUpdateFields...)
 
-	// Validate
-	if err := check.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
-	}
-
-	// Persist Changes
-	if serr := a.Store.UpdateCheckConfig(ctx, check); serr != nil {
-		return NewError(InternalErr, serr)
-	}
-
-	return nil
+	// Validate and persist
+	return persistResource(ctx, check, func(ctx context.Context) error {
+		return a.Store.UpdateCheckConfig(ctx, check)
+	})
 }
 
 // Destroy removes a resource if viewer has access.
@@ -179,6 +195,11 @@ func (a CheckController) Destroy(ctx context.Context, name string) error {
 		return NewErrorf(NotFound)
 	}
 
+	// Keep it for the undo window
+	if err := a.Tombstones.bury(ctx, types.TombstoneKindCheck, result.Name, result); err != nil {
+		return NewError(InternalErr, err)
+	}
+
 	// Remove from store
 	if err := a.Store.DeleteCheckConfigByName(ctx, result.Name); err != nil {
 		return NewError(InternalErr, err)
@@ -11,6 +11,7 @@ import (
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewCheckController(t *testing.T) {
@@ -269,6 +270,12 @@ func TestCheckCreate(t *testing.T) {
 			store.
 				On("UpdateCheckConfig", mock.Anything, mock.Anything).
 				Return(tc.createErr)
+			store.
+				On("GetClusterConfig", mock.Anything).
+				Return(&types.ClusterConfig{}, nil)
+			store.
+				On("GetOrganizationConfig", mock.Anything, mock.Anything).
+				Return(&types.ClusterConfig{}, nil)
 
 			// Exec Query
 			err := actions.Create(tc.ctx, *tc.argument)
@@ -288,6 +295,40 @@ func TestCheckCreate(t *testing.T) {
 	}
 }
 
+func TestCheckCreateDefaultTimeout(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeCheck, types.RulePermCreate),
+		),
+	)
+
+	store := &mockstore.MockStore{}
+	store.On("NewQueue", mock.Anything, mock.Anything).Return(&mockqueue.MockQueue{})
+	store.On("GetCheckConfigByName", mock.Anything, mock.Anything).Return((*types.CheckConfig)(nil), nil)
+	store.On("GetClusterConfig", mock.Anything).Return(types.FixtureClusterConfig(), nil)
+	store.On("GetOrganizationConfig", mock.Anything, "default").Return(&types.ClusterConfig{}, nil).Once()
+	store.On("UpdateCheckConfig", mock.Anything, mock.Anything).Return(nil)
+	actions := NewCheckController(store)
+
+	// The default timeout is applied to the checks without a timeout
+	require.NoError(t, actions.Create(ctx, *types.FixtureCheckConfig("check1")))
+	check := store.Calls[len(store.Calls)-1].Arguments.Get(1).(*types.CheckConfig)
+	assert.Equal(t, uint32(30), check.Timeout)
+
+	// The default timeout of the organization overrides the cluster-wide one
+	store.On("GetOrganizationConfig", mock.Anything, "default").Return(&types.ClusterConfig{DefaultCheckTimeout: 20}, nil)
+	require.NoError(t, actions.Create(ctx, *types.FixtureCheckConfig("check3")))
+	check = store.Calls[len(store.Calls)-1].Arguments.Get(1).(*types.CheckConfig)
+	assert.Equal(t, uint32(20), check.Timeout)
+
+	withTimeout := types.FixtureCheckConfig("check2")
+	withTimeout.Timeout = 10
+	require.NoError(t, actions.Create(ctx, *withTimeout))
+	check = store.Calls[len(store.Calls)-1].Arguments.Get(1).(*types.CheckConfig)
+	assert.Equal(t, uint32(10), check.Timeout)
+}
+
 func TestCheckUpdate(t *testing.T) {
 	defaultCtx := testutil.NewContext(
 		testutil.ContextWithOrgEnv("default", "default"),
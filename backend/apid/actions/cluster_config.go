@@ -0,0 +1,89 @@
+package actions
+
+import (
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"golang.org/x/net/context"
+)
+
+// ClusterConfigController exposes the cluster-wide defaults.
+type ClusterConfigController struct {
+	Store  store.ClusterConfigStore
+	Policy authorization.ClusterConfigPolicy
+}
+
+// NewClusterConfigController returns new ClusterConfigController
+func NewClusterConfigController(store store.ClusterConfigStore) ClusterConfigController {
+	return ClusterConfigController{
+		Store:  store,
+		Policy: authorization.ClusterConfig,
+	}
+}
+
+// Find returns the cluster-wide defaults.
+func (a ClusterConfigController) Find(ctx context.Context) (*types.ClusterConfig, error) {
+	abilities := a.Policy.WithContext(ctx)
+	if !abilities.CanRead() {
+		return nil, NewErrorf(PermissionDenied)
+	}
+
+	config, err := a.Store.GetClusterConfig(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	return config, nil
+}
+
+// Update replaces the cluster-wide defaults.
+func (a ClusterConfigController) Update(ctx context.Context, config types.ClusterConfig) error {
+	abilities := a.Policy.WithContext(ctx)
+	if !abilities.CanUpdate() {
+		return NewErrorf(PermissionDenied)
+	}
+
+	if err := config.Validate(); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	if err := a.Store.UpdateClusterConfig(ctx, &config); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// FindOrganization returns the defaults of an organization, which override the
+// cluster-wide defaults.
+func (a ClusterConfigController) FindOrganization(ctx context.Context, org string) (*types.ClusterConfig, error) {
+	abilities := a.Policy.WithOrganization(ctx, org)
+	if !abilities.CanRead() {
+		return nil, NewErrorf(PermissionDenied)
+	}
+
+	config, err := a.Store.GetOrganizationConfig(ctx, org)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	return config, nil
+}
+
+// UpdateOrganization replaces the defaults of an organization.
+func (a ClusterConfigController) UpdateOrganization(ctx context.Context, org string, config types.ClusterConfig) error {
+	abilities := a.Policy.WithOrganization(ctx, org)
+	if !abilities.CanUpdate() {
+		return NewErrorf(PermissionDenied)
+	}
+
+	if err := config.Validate(); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	if err := a.Store.UpdateOrganizationConfig(ctx, org, &config); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterConfigFind(t *testing.T) {
+	store := &mockstore.MockStore{}
+	controller := NewClusterConfigController(store)
+	store.On("GetClusterConfig", mock.Anything).Return(types.FixtureClusterConfig(), nil)
+
+	ctx := testutil.NewContext(testutil.ContextWithPerms(types.RuleTypeClusterConfig, types.RulePermRead))
+	config, err := controller.Find(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default"}, config.DefaultHandlers)
+
+	// The defaults are shared by all organizations, so access to a single one
+	// is not enough
+	rule := types.FixtureRule("acme", "*")
+	ctx = testutil.NewContext(testutil.ContextWithRules(*rule))
+	_, err = controller.Find(ctx)
+	assertActionErr(t, err, PermissionDenied)
+}
+
+func TestClusterConfigUpdate(t *testing.T) {
+	store := &mockstore.MockStore{}
+	controller := NewClusterConfigController(store)
+	store.On("UpdateClusterConfig", mock.Anything, types.FixtureClusterConfig()).Return(nil).Once()
+	store.On("UpdateClusterConfig", mock.Anything, &types.ClusterConfig{}).Return(errors.New("error"))
+
+	ctx := testutil.NewContext(testutil.ContextWithPerms(types.RuleTypeClusterConfig, types.RulePermUpdate))
+	assert.NoError(t, controller.Update(ctx, *types.FixtureClusterConfig()))
+	assertActionErr(t, controller.Update(ctx, types.ClusterConfig{}), InternalErr)
+	assertActionErr(t, controller.Update(ctx, types.ClusterConfig{OutputNormalization: []string{"("}}), InvalidArgument)
+
+	ctx = testutil.NewContext(testutil.ContextWithPerms(types.RuleTypeClusterConfig, types.RulePermRead))
+	assertActionErr(t, controller.Update(ctx, *types.FixtureClusterConfig()), PermissionDenied)
+}
+
+func TestClusterConfigOrganization(t *testing.T) {
+	store := &mockstore.MockStore{}
+	controller := NewClusterConfigController(store)
+	config := &types.ClusterConfig{DefaultHandlers: []string{"slack"}}
+	store.On("GetOrganizationConfig", mock.Anything, "acme").Return(config, nil)
+	store.On("UpdateOrganizationConfig", mock.Anything, "acme", config).Return(nil)
+
+	// The defaults of an organization are managed with access to it
+	rule := types.FixtureRule("acme", "*")
+	ctx := testutil.NewContext(testutil.ContextWithRules(*rule))
+	result, err := controller.FindOrganization(ctx, "acme")
+	require.NoError(t, err)
+	assert.Equal(t, config, result)
+	assert.NoError(t, controller.UpdateOrganization(ctx, "acme", *config))
+	assertActionErr(t, controller.UpdateOrganization(ctx, "acme", types.ClusterConfig{OutputNormalization: []string{"("}}), InvalidArgument)
+
+	_, err = controller.FindOrganization(ctx, "other")
+	assertActionErr(t, err, PermissionDenied)
+	assertActionErr(t, controller.UpdateOrganization(ctx, "other", *config), PermissionDenied)
+}
@@ -4,19 +4,27 @@ import (
 	"context"
 
 	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/util/query"
 )
 
 // entityUpdateFields whitelists fields allowed to be updated for Entities
 var entityUpdateFields = []string{
 	"Subscriptions",
+	"Labels",
+	"Annotations",
 }
 
 // EntityController exposes actions in which a viewer can perform.
 type EntityController struct {
 	Store  store.EntityStore
 	Policy authorization.EntityPolicy
+
+	// Bus is used to push the changes of the metadata of the entities to
+	// their agents
+	Bus messaging.MessageBus
 }
 
 // NewEntityController returns new EntityController
@@ -70,6 +78,25 @@ func (c EntityController) Find(ctx context.Context, id string) (*types.Entity, e
 	return nil, NewErrorf(NotFound)
 }
 
+// FindStatus returns the connection status of the agent of the entity with
+// the given id, if the entity is available to the viewer. The entities without
+// agent, or whose agent never connected, have no status.
+func (c EntityController) FindStatus(ctx context.Context, id string) (*types.EntityStatus, error) {
+	entity, err := c.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, serr := c.Store.GetEntityStatus(ctx, entity.ID)
+	if serr != nil {
+		return nil, NewError(InternalErr, serr)
+	} else if result == nil {
+		return nil, NewErrorf(NotFound)
+	}
+
+	return result, nil
+}
+
 // Query returns resources available to the viewer.
 func (c EntityController) Query(ctx context.Context) ([]*types.Entity, error) {
 	// Fetch from store
@@ -90,6 +117,67 @@ func (c EntityController) Query(ctx context.Context) ([]*types.Entity, error) {
 	return results, nil
 }
 
+// Select returns the resources available to the viewer which satisfy the
+// given query expression. The entity is fetched directly when the query
+// requires a specific ID, and the entities are looked up in an index of the
+// store when it requires a specific class, subscription or label.
+func (c EntityController) Select(ctx context.Context, expression string) ([]*types.Entity, error) {
+	q, err := query.Parse(expression, nil)
+	if err != nil {
+		return nil, NewError(InvalidArgument, err)
+	}
+
+	var results []*types.Entity
+	if id, ok := q.Equals("id"); ok {
+		result, serr := c.Store.GetEntityByID(ctx, id)
+		if serr != nil {
+			return nil, NewError(InternalErr, serr)
+		}
+		abilities := c.Policy.WithContext(ctx)
+		if result != nil && abilities.CanRead(result) {
+			results = append(results, result)
+		}
+	} else if index, value, ok := entityIndexLookup(q); ok {
+		results, err = c.Store.GetEntitiesByIndex(ctx, index, value)
+		if err != nil {
+			return nil, NewError(InternalErr, err)
+		}
+		abilities := c.Policy.WithContext(ctx)
+		for i := 0; i < len(results); i++ {
+			if !abilities.CanRead(results[i]) {
+				results = append(results[:i], results[i+1:]...)
+				i--
+			}
+		}
+	} else if results, err = c.Query(ctx); err != nil {
+		return nil, err
+	}
+
+	selected := make([]*types.Entity, 0, len(results))
+	for _, result := range results {
+		if q.Match(result) {
+			selected = append(selected, result)
+		}
+	}
+
+	return selected, nil
+}
+
+// entityIndexLookup returns the index of the store, and the value, the
+// entities satisfying the query can be looked up with, if any.
+func entityIndexLookup(q *query.Query) (store.EntityIndex, string, bool) {
+	if class, ok := q.Equals("class"); ok {
+		return store.EntityIndexClass, class, true
+	}
+	if subscription, ok := q.Contains("subscriptions"); ok {
+		return store.EntityIndexSubscription, subscription, true
+	}
+	if key, value, ok := q.EqualsKey("labels"); ok {
+		return store.EntityIndexLabel, store.LabelIndexValue(key, value), true
+	}
+	return "", "", false
+}
+
 // Update validates and persists changes to a resource if viewer has access.
 func (c EntityController) Update(ctx context.Context, given types.Entity) error {
 	// Adjust context
@@ -112,15 +200,49 @@ func (c EntityController) Update(ctx context.Context, given types.Entity) error
 	// Copy
 	copyFields(entity, &given, entityUpdateFields...)
 
-	// Validate
-	if err := entity.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
+	// Validate and persist
+	return persistResource(ctx, entity, func(ctx context.Context) error {
+		return c.Store.UpdateEntity(ctx, entity)
+	})
+}
+
+// PatchMetadata changes the labels and annotations of an entity if viewer has
+// access. The changes are pushed to the agent of the entity, which includes
+// them in its next events and keepalives.
+func (c EntityController) PatchMetadata(ctx context.Context, id string, patch types.EntityMetadataPatch) (*types.Entity, error) {
+	abilities := c.Policy.WithContext(ctx)
+
+	if err := patch.Validate(); err != nil {
+		return nil, NewError(InvalidArgument, err)
 	}
 
-	// Persist Changes
-	if serr := c.Store.UpdateEntity(ctx, entity); serr != nil {
-		return NewError(InternalErr, serr)
+	// Find existing entity
+	entity, err := c.Store.GetEntityByID(ctx, id)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	} else if entity == nil {
+		return nil, NewErrorf(NotFound)
 	}
 
-	return nil
+	// Verify viewer can make change
+	if yes := abilities.CanUpdate(entity); !yes {
+		return nil, NewErrorf(PermissionDenied)
+	}
+
+	patch.Apply(entity)
+	if err := c.Store.UpdateEntity(ctx, entity); err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	// The agent would otherwise replace the metadata with its own on its next
+	// keepalive
+	if entity.Class == types.EntityAgentClass && c.Bus != nil {
+		sub := types.GetEntitySubscription(entity.ID)
+		topic := messaging.SubscriptionTopic(entity.Organization, entity.Environment, sub)
+		if err := c.Bus.Publish(topic, &patch); err != nil {
+			return nil, NewError(InternalErr, err)
+		}
+	}
+
+	return entity, nil
 }
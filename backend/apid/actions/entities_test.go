@@ -5,6 +5,9 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockbus"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/testing/testutil"
 	"github.com/sensu/sensu-go/types"
@@ -200,6 +203,89 @@ func TestEntityFind(t *testing.T) {
 	}
 }
 
+func TestEntityFindStatus(t *testing.T) {
+	readCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEntity, types.RulePermRead),
+		),
+	)
+	createCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEntity, types.RulePermCreate),
+		),
+	)
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		entity          *types.Entity
+		status          *types.EntityStatus
+		statusErr       error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:   "found",
+			ctx:    readCtx,
+			entity: types.FixtureEntity("entity1"),
+			status: types.FixtureEntityStatus("entity1"),
+		},
+		{
+			name:            "entity not found",
+			ctx:             readCtx,
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "no status",
+			ctx:             readCtx,
+			entity:          types.FixtureEntity("entity1"),
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "store err",
+			ctx:             readCtx,
+			entity:          types.FixtureEntity("entity1"),
+			statusErr:       errors.New("dunno"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "no read permission",
+			ctx:             createCtx,
+			entity:          types.FixtureEntity("entity1"),
+			status:          types.FixtureEntityStatus("entity1"),
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		store := &mockstore.MockStore{}
+		actions := NewEntityController(store)
+
+		t.Run(tc.name, func(t *testing.T) {
+			store.On("GetEntityByID", tc.ctx, "entity1").Return(tc.entity, nil)
+			store.On("GetEntityStatus", tc.ctx, "entity1").Return(tc.status, tc.statusErr)
+
+			result, err := actions.FindStatus(tc.ctx, "entity1")
+			if tc.expectedErr {
+				inferErr, ok := err.(Error)
+				if assert.True(t, ok) {
+					assert.Equal(t, tc.expectedErrCode, inferErr.Code)
+				}
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.status, result)
+		})
+	}
+}
+
 func TestEntityQuery(t *testing.T) {
 	defaultCtx := testutil.NewContext(
 		testutil.ContextWithOrgEnv("default", "default"),
@@ -277,6 +363,91 @@ func TestEntityQuery(t *testing.T) {
 	}
 }
 
+func TestEntitySelect(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEntity, types.RulePermRead),
+		),
+	)
+
+	linux := types.FixtureEntity("entity1")
+	linux.System.OS = "linux"
+	windows := types.FixtureEntity("entity2")
+	windows.System.OS = "windows"
+
+	store := &mockstore.MockStore{}
+	actions := NewEntityController(store)
+	store.On("GetEntities", ctx).Return([]*types.Entity{linux, windows}, nil)
+	store.On("GetEntityByID", ctx, "entity2").Return(windows, nil)
+	store.On("GetEntityByID", ctx, "entity3").Return((*types.Entity)(nil), nil)
+
+	results, err := actions.Select(ctx, `system.os == "linux"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.Entity{linux}, results)
+
+	// The ID constraint is pushed down to the store
+	results, err = actions.Select(ctx, `id == "entity2" && system.os == "windows"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.Entity{windows}, results)
+	store.AssertCalled(t, "GetEntityByID", ctx, "entity2")
+
+	results, err = actions.Select(ctx, `id == "entity3"`)
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+
+	_, err = actions.Select(ctx, "system.os ==")
+	assert.Equal(t, InvalidArgument, err.(Error).Code)
+}
+
+func TestEntitySelectByIndex(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEntity, types.RulePermRead),
+		),
+	)
+
+	proxy := types.FixtureEntity("entity1")
+	proxy.Class = "proxy"
+	proxy.System.OS = "linux"
+	proxy.Labels = map[string]string{"region": "us-west-2"}
+	other := types.FixtureEntity("entity2")
+	other.Class = "proxy"
+	other.System.OS = "windows"
+	label := store.LabelIndexValue("region", "us-west-2")
+
+	st := &mockstore.MockStore{}
+	actions := NewEntityController(st)
+	st.On("GetEntitiesByIndex", ctx, store.EntityIndexClass, "proxy").Return([]*types.Entity{proxy, other}, nil)
+	st.On("GetEntitiesByIndex", ctx, store.EntityIndexSubscription, "linux").Return([]*types.Entity{proxy, other}, nil)
+	st.On("GetEntitiesByIndex", ctx, store.EntityIndexLabel, label).Return([]*types.Entity{proxy}, nil)
+	st.On("GetEntitiesByIndex", ctx, store.EntityIndexClass, "error").Return([]*types.Entity{}, errors.New("error"))
+
+	results, err := actions.Select(ctx, `class == "proxy" && system.os == "linux"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.Entity{proxy}, results)
+
+	results, err = actions.Select(ctx, `"linux" in subscriptions`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.Entity{proxy, other}, results)
+
+	results, err = actions.Select(ctx, `labels.region == "us-west-2"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.Entity{proxy}, results)
+	st.AssertNotCalled(t, "GetEntities", mock.Anything)
+
+	_, err = actions.Select(ctx, `class == "error"`)
+	assert.Equal(t, InternalErr, err.(Error).Code)
+
+	// The entities the viewer can't read are not selected
+	noAccess := testutil.NewContext(testutil.ContextWithOrgEnv("default", "default"))
+	st.On("GetEntitiesByIndex", noAccess, store.EntityIndexClass, "proxy").Return([]*types.Entity{proxy}, nil)
+	results, err = actions.Select(noAccess, `class == "proxy"`)
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+}
+
 func TestEntityUpdate(t *testing.T) {
 	defaultCtx := testutil.NewContext(
 		testutil.ContextWithOrgEnv("default", "default"),
@@ -387,3 +558,138 @@ func TestEntityUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestEntityPatchMetadata(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEntity, types.RulePermUpdate),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEntity, types.RulePermRead),
+		),
+	)
+
+	patch := types.EntityMetadataPatch{
+		Labels:       map[string]string{"team": "ops"},
+		RemoveLabels: []string{"region"},
+		Annotations:  map[string]string{"owner": "alice"},
+	}
+	topic := messaging.SubscriptionTopic("default", "default", "entity:foo")
+	agentEntity := func() *types.Entity {
+		entity := types.FixtureEntity("foo")
+		entity.Class = types.EntityAgentClass
+		return entity
+	}
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		patch           types.EntityMetadataPatch
+		fetchResult     *types.Entity
+		fetchErr        error
+		updateErr       error
+		publishErr      error
+		expectedPublish bool
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:            "Patched",
+			ctx:             defaultCtx,
+			patch:           patch,
+			fetchResult:     agentEntity(),
+			expectedPublish: true,
+		},
+		{
+			name:        "Not an agent entity",
+			ctx:         defaultCtx,
+			patch:       patch,
+			fetchResult: types.FixtureEntity("foo"),
+		},
+		{
+			name:            "Empty patch",
+			ctx:             defaultCtx,
+			fetchResult:     agentEntity(),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Does not exist",
+			ctx:             defaultCtx,
+			patch:           patch,
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "No permission to update",
+			ctx:             wrongPermsCtx,
+			patch:           patch,
+			fetchResult:     agentEntity(),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Store err on fetch",
+			ctx:             defaultCtx,
+			patch:           patch,
+			fetchErr:        errors.New("dunno"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "Store err on update",
+			ctx:             defaultCtx,
+			patch:           patch,
+			fetchResult:     agentEntity(),
+			updateErr:       errors.New("dunno"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "Bus err",
+			ctx:             defaultCtx,
+			patch:           patch,
+			fetchResult:     agentEntity(),
+			publishErr:      errors.New("dunno"),
+			expectedPublish: true,
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+			store := &mockstore.MockStore{}
+			bus := &mockbus.MockBus{}
+			actions := NewEntityController(store)
+			actions.Bus = bus
+
+			store.On("GetEntityByID", tc.ctx, "foo").Return(tc.fetchResult, tc.fetchErr)
+			store.On("UpdateEntity", tc.ctx, mock.Anything).Return(tc.updateErr)
+			bus.On("Publish", topic, mock.Anything).Return(tc.publishErr)
+
+			entity, err := actions.PatchMetadata(tc.ctx, "foo", tc.patch)
+			if tc.expectedErr {
+				inferErr, ok := err.(Error)
+				if assert.True(ok) {
+					assert.Equal(tc.expectedErrCode, inferErr.Code)
+				}
+			} else {
+				assert.NoError(err)
+				assert.Equal(map[string]string{"team": "ops"}, entity.Labels)
+				assert.Equal(map[string]string{"owner": "alice"}, entity.Annotations)
+			}
+
+			if tc.expectedPublish {
+				bus.AssertCalled(t, "Publish", topic, &tc.patch)
+			} else {
+				bus.AssertNotCalled(t, "Publish", topic, mock.Anything)
+			}
+		})
+	}
+}
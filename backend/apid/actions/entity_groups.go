@@ -0,0 +1,196 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var entityGroupUpdateFields = []string{
+	"Description",
+	"Selector",
+	"Members",
+}
+
+// EntityGroupStore specifies the storage requirements of the
+// EntityGroupController.
+type EntityGroupStore interface {
+	store.EntityGroupStore
+	store.EntityStore
+}
+
+// EntityGroupController allows querying entity groups in bulk or by name,
+// and the entities which belong to them.
+type EntityGroupController struct {
+	Store  EntityGroupStore
+	Policy authorization.EntityGroupPolicy
+}
+
+// NewEntityGroupController creates a new EntityGroupController backed by store.
+func NewEntityGroupController(store EntityGroupStore) EntityGroupController {
+	return EntityGroupController{
+		Store:  store,
+		Policy: authorization.EntityGroups,
+	}
+}
+
+// Create creates a new EntityGroup resource.
+// It returns non-nil error if the new entity group is invalid, update permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c EntityGroupController) Create(ctx context.Context, group types.EntityGroup) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &group)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	if g, err := c.Store.GetEntityGroupByName(ctx, group.Name); err != nil {
+		return NewError(InternalErr, err)
+	} else if g != nil {
+		return NewErrorf(AlreadyExistsErr, group.Name)
+	}
+
+	// Verify permissions
+	if ok := policy.CanCreate(&group); !ok {
+		return NewErrorf(PermissionDenied, "create")
+	}
+
+	// Validate and persist
+	return persistResource(ctx, &group, func(ctx context.Context) error {
+		return c.Store.UpdateEntityGroup(ctx, &group)
+	})
+}
+
+// Update updates an entity group.
+// It returns non-nil error if the new entity group is invalid, create permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c EntityGroupController) Update(ctx context.Context, delta types.EntityGroup) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &delta)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	group, err := c.Store.GetEntityGroupByName(ctx, delta.Name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	} else if group == nil {
+		return NewErrorf(NotFound, delta.Name)
+	}
+
+	// Verify viewer can make change
+	if ok := policy.CanUpdate(group); !ok {
+		return NewErrorf(PermissionDenied, "update")
+	}
+
+	// Update
+	if err := group.Update(&delta, entityGroupUpdateFields...); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	// Validate and persist
+	return persistResource(ctx, group, func(ctx context.Context) error {
+		return c.Store.UpdateEntityGroup(ctx, group)
+	})
+}
+
+// Query returns resources available to the viewer filter by given params.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c EntityGroupController) Query(ctx context.Context) ([]*types.EntityGroup, error) {
+	policy := c.Policy.WithContext(ctx)
+
+	// Fetch from store
+	groups, err := c.Store.GetEntityGroups(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	result := make([]*types.EntityGroup, 0, len(groups))
+
+	// Filter out those resources the viewer does not have access to view.
+	for _, g := range groups {
+		if ok := policy.CanRead(g); ok {
+			result = append(result, g)
+		}
+	}
+
+	return result, nil
+}
+
+// Destroy destroys the named EntityGroup.
+// It returns non-nil error if the params are invalid, delete permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c EntityGroupController) Destroy(ctx context.Context, name string) error {
+	policy := c.Policy.WithContext(ctx)
+
+	// Verify permissions
+	if ok := policy.CanDelete(); !ok {
+		return NewErrorf(PermissionDenied, "delete")
+	}
+
+	// Validate parameters
+	if name == "" {
+		return NewErrorf(InvalidArgument, "name is undefined")
+	}
+
+	// Fetch from store
+	group, err := c.Store.GetEntityGroupByName(ctx, name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	}
+	if group == nil {
+		return NewErrorf(NotFound, name)
+	}
+
+	// Remove from store
+	if err := c.Store.DeleteEntityGroupByName(ctx, group.Name); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Find returns resource associated with given parameters if available to the
+// viewer.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c EntityGroupController) Find(ctx context.Context, name string) (*types.EntityGroup, error) {
+	result, err := c.Store.GetEntityGroupByName(ctx, name)
+	if err != nil {
+		return nil, NewErrorf(InternalErr, err)
+	}
+
+	if result == nil {
+		return nil, NewErrorf(NotFound)
+	}
+
+	policy := c.Policy.WithContext(ctx)
+
+	if !policy.CanRead(result) {
+		return nil, NewErrorf(NotFound)
+	}
+
+	return result, nil
+}
+
+// Entities returns the entities belonging to the named entity group, which
+// are available to the viewer.
+func (c EntityGroupController) Entities(ctx context.Context, name string) ([]*types.Entity, error) {
+	group, err := c.Find(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := NewEntityController(c.Store).Query(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return group.Filter(entities), nil
+}
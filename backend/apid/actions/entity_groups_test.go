@@ -0,0 +1,151 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEntityGroupController(t *testing.T) {
+	assert := assert.New(t)
+
+	store := &mockstore.MockStore{}
+	ctl := NewEntityGroupController(store)
+	assert.NotNil(ctl)
+	assert.Equal(store, ctl.Store)
+	assert.NotNil(ctl.Policy)
+}
+
+func TestEntityGroupCreate(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEntityGroup, types.RulePermCreate),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEntityGroup, types.RulePermRead),
+		),
+	)
+
+	badGroup := types.FixtureEntityGroup("bad")
+	badGroup.Name = "!@#!#$@#^$%&$%&$&$%&%^*%&(%@###"
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		argument        *types.EntityGroup
+		fetchResult     *types.EntityGroup
+		fetchErr        error
+		createErr       error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:        "Created",
+			ctx:         defaultCtx,
+			argument:    types.FixtureEntityGroup("web"),
+			expectedErr: false,
+		},
+		{
+			name:            "Already Exists",
+			ctx:             defaultCtx,
+			argument:        types.FixtureEntityGroup("web"),
+			fetchResult:     types.FixtureEntityGroup("web"),
+			expectedErr:     true,
+			expectedErrCode: AlreadyExistsErr,
+		},
+		{
+			name:            "Store Err on Fetch",
+			ctx:             defaultCtx,
+			argument:        types.FixtureEntityGroup("web"),
+			fetchErr:        errors.New("nein"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			argument:        types.FixtureEntityGroup("web"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Validation Error",
+			ctx:             defaultCtx,
+			argument:        badGroup,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		store := &mockstore.MockStore{}
+		ctl := NewEntityGroupController(store)
+
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			store.On("GetEntityGroupByName", mock.Anything, mock.Anything).
+				Return(test.fetchResult, test.fetchErr)
+
+			store.On("UpdateEntityGroup", mock.Anything, mock.Anything).Return(test.createErr)
+
+			err := ctl.Create(test.ctx, *test.argument)
+
+			if test.expectedErr {
+				if cerr, ok := err.(Error); ok {
+					assert.Equal(test.expectedErrCode, cerr.Code)
+				} else {
+					assert.Error(err)
+					assert.FailNow("Not of type 'Error'")
+				}
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestEntityGroupEntities(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEntityGroup, types.RulePermRead),
+			types.FixtureRuleWithPerms(types.RuleTypeEntity, types.RulePermRead),
+		),
+	)
+
+	web := types.FixtureEntity("web")
+	web.Labels = map[string]string{"role": "web"}
+	db := types.FixtureEntity("db")
+	db.Labels = map[string]string{"role": "db"}
+	cache := types.FixtureEntity("cache")
+
+	group := types.FixtureEntityGroup("web")
+	group.Members = []string{"cache"}
+
+	store := &mockstore.MockStore{}
+	store.On("GetEntityGroupByName", mock.Anything, "web").Return(group, nil)
+	store.On("GetEntities", mock.Anything).Return([]*types.Entity{web, db, cache}, nil)
+	ctl := NewEntityGroupController(store)
+
+	entities, err := ctl.Entities(ctx, "web")
+	require.NoError(t, err)
+	assert.Equal(t, []*types.Entity{web, cache}, entities)
+
+	var nilGroup *types.EntityGroup
+	store.On("GetEntityGroupByName", mock.Anything, "missing").Return(nilGroup, nil)
+	_, err = ctl.Entities(ctx, "missing")
+	require.Error(t, err)
+	assert.Equal(t, NotFound, err.(Error).Code)
+}
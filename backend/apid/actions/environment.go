@@ -10,6 +10,10 @@ import (
 
 var envUpdateFields = []string{
 	"Description",
+	"Labels",
+	"Annotations",
+	"TimeZone",
+	"BusinessHours",
 }
 
 // EnvironmentController allows querying Environments in bulk or by name.
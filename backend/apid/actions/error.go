@@ -1,6 +1,10 @@
 package actions
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/sensu/sensu-go/types"
+)
 
 //
 // Following defines error type w/ error codes. Helpful for
@@ -57,6 +61,9 @@ type Error struct {
 	// Message is a developer / operator friendly message briefly describing what
 	// occurred.
 	Message string
+	// Fields are the validation errors of the fields of the resource, if the
+	// error was caused by its validation.
+	Fields types.ValidationErrors
 }
 
 // Error method implements error interface
@@ -64,9 +71,11 @@ func (err Error) Error() string {
 	return fmt.Sprintf("error: code = %d desc = %s", err.Code, err.Message)
 }
 
-// NewError returns a new Error given existing error and code.
+// NewError returns a new Error given existing error and code. The validation
+// errors of the fields of a resource are preserved.
 func NewError(code ErrCode, err error) Error {
-	return Error{Code: code, Message: err.Error()}
+	fields, _ := err.(types.ValidationErrors)
+	return Error{Code: code, Message: err.Error(), Fields: fields}
 }
 
 // NewErrorf returns a new Error given message and code.
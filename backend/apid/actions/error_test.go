@@ -0,0 +1,21 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewError(t *testing.T) {
+	err := NewError(InternalErr, errors.New("etcd is down"))
+	assert.Equal(t, "etcd is down", err.Message)
+	assert.Empty(t, err.Fields)
+
+	var fields types.ValidationErrors
+	fields.Add("url", types.ValidationRequired, "URL cannot be empty")
+	err = NewError(InvalidArgument, fields)
+	assert.Equal(t, "URL cannot be empty", err.Message)
+	assert.Equal(t, fields, err.Fields)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/util/query"
 	"golang.org/x/net/context"
 )
 
@@ -15,6 +16,11 @@ var eventUpdateFields = []string{
 	"Check",
 }
 
+// eventQueryAliases are the shorthands available in the event queries
+var eventQueryAliases = map[string][]string{
+	"status": {"check", "status"},
+}
+
 // EventController expose actions in which a viewer can perform.
 type EventController struct {
 	Store  store.EventStore
@@ -65,6 +71,36 @@ func (a EventController) Query(ctx context.Context, entityID, checkName string)
 	return results, nil
 }
 
+// Select returns the resources available to the viewer which satisfy the
+// given query expression. Only the events of an entity, or of an entity and a
+// check, are fetched when the query requires them.
+func (a EventController) Select(ctx context.Context, expression string) ([]*types.Event, error) {
+	q, err := query.Parse(expression, eventQueryAliases)
+	if err != nil {
+		return nil, NewError(InvalidArgument, err)
+	}
+
+	var checkName string
+	entityID, ok := q.Equals("entity", "id")
+	if ok {
+		checkName, _ = q.Equals("check", "name")
+	}
+
+	results, err := a.Query(ctx, entityID, checkName)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make([]*types.Event, 0, len(results))
+	for _, result := range results {
+		if q.Match(result) {
+			selected = append(selected, result)
+		}
+	}
+
+	return selected, nil
+}
+
 // Find returns resource associated with given parameters if available to the
 // viewer.
 func (a EventController) Find(ctx context.Context, entity, check string) (*types.Event, error) {
@@ -183,3 +219,63 @@ func (a EventController) Create(ctx context.Context, event types.Event) error {
 
 	return nil
 }
+
+// Replay sends the stored events selected by the query of the request through
+// the event handlers again. The events are published straight to pipelined,
+// on a topic the other daemons don't subscribe to, so that their state and
+// incidents aren't altered, and returned.
+func (a EventController) Replay(ctx context.Context, replay types.EventReplay) ([]*types.Event, error) {
+	if err := replay.Validate(); err != nil {
+		return nil, NewError(InvalidArgument, err)
+	}
+
+	var events []*types.Event
+	var err error
+	if replay.Query != "" {
+		events, err = a.Select(ctx, replay.Query)
+	} else {
+		events, err = a.Query(ctx, "", "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Replaying the events runs their handlers again, which requires the
+	// permission to update them
+	policy := a.Policy.WithContext(ctx)
+	for _, event := range events {
+		if !policy.CanUpdate(event) {
+			return nil, NewErrorf(PermissionDenied, "replay")
+		}
+	}
+
+	if replay.DryRun {
+		return events, nil
+	}
+
+	for i, event := range events {
+		if len(replay.Handlers) > 0 {
+			setEventHandlers(event, replay.Handlers)
+		}
+		if err := a.Bus.Publish(messaging.TopicEventReplay, event); err != nil {
+			return nil, NewErrorf(InternalErr, "replayed %d of %d events: %s", i, len(events), err)
+		}
+	}
+
+	return events, nil
+}
+
+// setEventHandlers replaces the handlers and pipelines of the event with the
+// given handlers, so that they are run once.
+func setEventHandlers(event *types.Event, handlers []string) {
+	if event.HasCheck() {
+		event.Check.Handlers = handlers
+		event.Check.Pipelines = nil
+	}
+	if event.HasMetrics() {
+		event.Metrics.Handlers = nil
+		if !event.HasCheck() {
+			event.Metrics.Handlers = handlers
+		}
+	}
+}
@@ -117,6 +117,42 @@ func TestEventQuery(t *testing.T) {
 	}
 }
 
+func TestEventSelect(t *testing.T) {
+	ctx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead),
+	))
+
+	failing := types.FixtureEvent("entity1", "check1")
+	failing.Check.Status = 2
+	passing := types.FixtureEvent("entity1", "check2")
+	events := []*types.Event{failing, passing}
+
+	store := &mockstore.MockStore{}
+	eventController := NewEventController(store, &mockbus.MockBus{})
+	store.On("GetEvents", ctx).Return(events, nil)
+	store.On("GetEventsByEntity", ctx, "entity1").Return(events, nil)
+	store.On("GetEventByEntityCheck", ctx, "entity1", "check1").Return(failing, nil)
+
+	results, err := eventController.Select(ctx, "status != 0")
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.Event{failing}, results)
+	store.AssertCalled(t, "GetEvents", ctx)
+
+	// The entity and check constraints are pushed down to the store
+	results, err = eventController.Select(ctx, `entity.id == "entity1" && check.status == 0`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.Event{passing}, results)
+	store.AssertCalled(t, "GetEventsByEntity", ctx, "entity1")
+
+	results, err = eventController.Select(ctx, `entity.id == "entity1" && check.name == "check1"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.Event{failing}, results)
+	store.AssertCalled(t, "GetEventByEntityCheck", ctx, "entity1", "check1")
+
+	_, err = eventController.Select(ctx, "status !=")
+	assert.Equal(t, InvalidArgument, err.(Error).Code)
+}
+
 func TestEventFind(t *testing.T) {
 	defaultCtx := testutil.NewContext(testutil.ContextWithRules(
 		types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead),
@@ -506,3 +542,119 @@ func TestEventCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestEventReplay(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead, types.RulePermUpdate),
+		),
+	)
+	readOnlyCtx := testutil.NewContext(
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead),
+		),
+	)
+
+	failing := types.FixtureEvent("entity1", "check1")
+	failing.Check.Status = 2
+
+	testCases := []struct {
+		name             string
+		ctx              context.Context
+		replay           types.EventReplay
+		storedEvents     []*types.Event
+		busErr           error
+		expectedErr      bool
+		expectedErrCode  ErrCode
+		expectedReplayed []string
+		expectedHandlers []string
+	}{
+		{
+			name:             "All events",
+			ctx:              defaultCtx,
+			storedEvents:     []*types.Event{failing, types.FixtureEvent("entity2", "check1")},
+			expectedReplayed: []string{"entity1", "entity2"},
+			expectedHandlers: []string{},
+		},
+		{
+			name:             "Selected events through given handlers",
+			ctx:              defaultCtx,
+			replay:           types.EventReplay{Query: "status != 0", Handlers: []string{"influxdb"}},
+			storedEvents:     []*types.Event{failing, types.FixtureEvent("entity2", "check1")},
+			expectedReplayed: []string{"entity1"},
+			expectedHandlers: []string{"influxdb"},
+		},
+		{
+			name:             "Dry run",
+			ctx:              defaultCtx,
+			replay:           types.EventReplay{DryRun: true},
+			storedEvents:     []*types.Event{failing},
+			expectedReplayed: []string{"entity1"},
+		},
+		{
+			name:            "Invalid handler",
+			ctx:             defaultCtx,
+			replay:          types.EventReplay{Handlers: []string{"influx db"}},
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Invalid query",
+			ctx:             defaultCtx,
+			replay:          types.EventReplay{Query: "status !="},
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "No Permission",
+			ctx:             readOnlyCtx,
+			storedEvents:    []*types.Event{failing},
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Message Bus Error",
+			ctx:             defaultCtx,
+			storedEvents:    []*types.Event{failing},
+			busErr:          errors.New("subscriber is busy"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		store := &mockstore.MockStore{}
+		bus := &mockbus.MockBus{}
+		actions := NewEventController(store, bus)
+
+		t.Run(tc.name, func(t *testing.T) {
+			store.On("GetEvents", tc.ctx).Return(tc.storedEvents, nil)
+			bus.On("Publish", mock.Anything, mock.Anything).Return(tc.busErr)
+
+			events, err := actions.Replay(tc.ctx, tc.replay)
+			if tc.expectedErr {
+				inferErr, ok := err.(Error)
+				if assert.True(t, ok, "Given was not of type 'Error'") {
+					assert.Equal(t, tc.expectedErrCode, inferErr.Code)
+				}
+				return
+			}
+			assert.NoError(t, err)
+
+			var replayed []string
+			for _, event := range events {
+				replayed = append(replayed, event.Entity.ID)
+			}
+			assert.Equal(t, tc.expectedReplayed, replayed)
+
+			if tc.replay.DryRun {
+				bus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+				return
+			}
+			for _, event := range events {
+				bus.AssertCalled(t, "Publish", "sensu:event-replay", event)
+				assert.Equal(t, tc.expectedHandlers, event.Check.Handlers)
+			}
+		})
+	}
+}
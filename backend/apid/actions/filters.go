@@ -48,17 +48,10 @@ func (c EventFilterController) Create(ctx context.Context, filter types.EventFil
 		return NewErrorf(PermissionDenied, "create")
 	}
 
-	// Validate
-	if err := filter.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
-	}
-
-	// Persist
-	if err := c.Store.UpdateEventFilter(ctx, &filter); err != nil {
-		return NewError(InternalErr, err)
-	}
-
-	return nil
+	// Validate and persist
+	return persistResource(ctx, &filter, func(ctx context.Context) error {
+		return c.Store.UpdateEventFilter(ctx, &filter)
+	})
 }
 
 // Update updates a Filter.
@@ -88,17 +81,10 @@ func (c EventFilterController) Update(ctx context.Context, delta types.EventFilt
 		return NewError(InternalErr, err)
 	}
 
-	// Validate
-	if err := filter.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
-	}
-
-	// Persist
-	if err := c.Store.UpdateEventFilter(ctx, filter); err != nil {
-		return NewError(InternalErr, err)
-	}
-
-	return nil
+	// Validate and persist
+	return persistResource(ctx, filter, func(ctx context.Context) error {
+		return c.Store.UpdateEventFilter(ctx, filter)
+	})
 }
 
 // Query returns resources available to the viewer filter by given params.
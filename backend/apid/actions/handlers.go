@@ -16,12 +16,16 @@ var updateFields = []string{
 	"Command",
 	"Handlers",
 	"Socket",
+	"RuntimeAssets",
 }
 
 // HandlerController exposes actions available for handlers
 type HandlerController struct {
 	Store  store.HandlerStore
 	Policy authorization.HandlerPolicy
+
+	// Tombstones keeps the deleted handlers during the undo window
+	Tombstones *Tombstones
 }
 
 // NewHandlerController creates a new HandlerController backed by store.
@@ -53,17 +57,10 @@ func (c HandlerController) Create(ctx context.Context, handler types.Handler) er
 		return NewErrorf(PermissionDenied, "create")
 	}
 
-	// Validate
-	if err := handler.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
-	}
-
-	// Persist
-	if err := c.Store.UpdateHandler(ctx, &handler); err != nil {
-		return NewError(InternalErr, err)
-	}
-
-	return nil
+	// Validate and persist
+	return persistResource(ctx, &handler, func(ctx context.Context) error {
+		return c.Store.UpdateHandler(ctx, &handler)
+	})
 }
 
 // Destroy removes a resource if viewer has access.
@@ -83,6 +80,11 @@ func (c HandlerController) Destroy(ctx context.Context, name string) error {
 		return NewErrorf(NotFound)
 	}
 
+	// Keep it for the undo window
+	if err := c.Tombstones.bury(ctx, types.TombstoneKindHandler, result.Name, result); err != nil {
+		return NewError(InternalErr, err)
+	}
+
 	// Remove from store
 	if err := c.Store.DeleteHandlerByName(ctx, result.Name); err != nil {
 		return NewError(InternalErr, err)
@@ -151,15 +153,8 @@ func (c HandlerController) Update(ctx context.Context, newHandler types.Handler)
 	// Copy
 	copyFields(handler, &newHandler, updateFields...)
 
-	// Validate
-	if err := handler.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
-	}
-
-	// Persist Changes
-	if serr := c.Store.UpdateHandler(ctx, handler); serr != nil {
-		return NewError(InternalErr, serr)
-	}
-
-	return nil
+	// Validate and persist
+	return persistResource(ctx, handler, func(ctx context.Context) error {
+		return c.Store.UpdateHandler(ctx, handler)
+	})
 }
@@ -84,17 +84,10 @@ func (a HookController) Create(ctx context.Context, newHook types.HookConfig) er
 		return NewErrorf(PermissionDenied)
 	}
 
-	// Validate
-	if err := newHook.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
-	}
-
-	// Persist
-	if err := a.Store.UpdateHookConfig(ctx, &newHook); err != nil {
-		return NewError(InternalErr, err)
-	}
-
-	return nil
+	// Validate and persist
+	return persistResource(ctx, &newHook, func(ctx context.Context) error {
+		return a.Store.UpdateHookConfig(ctx, &newHook)
+	})
 }
 
 // Update validates and persists changes to a resource if viewer has access.
@@ -119,17 +112,10 @@ func (a HookController) Update(ctx context.Context, given types.HookConfig) erro
 	// Copy
 	copyFields(hook, &given, hookConfigUpdateFields...)
 
-	// Validate
-	if err := hook.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
-	}
-
-	// Persist Changes
-	if serr := a.Store.UpdateHookConfig(ctx, hook); serr != nil {
-		return NewError(InternalErr, serr)
-	}
-
-	return nil
+	// Validate and persist
+	return persistResource(ctx, hook, func(ctx context.Context) error {
+		return a.Store.UpdateHookConfig(ctx, hook)
+	})
 }
 
 // Destroy removes a resource if viewer has access.
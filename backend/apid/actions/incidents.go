@@ -0,0 +1,163 @@
+package actions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var incidentUpdateFields = []string{
+	"State",
+}
+
+// errIncidentResolved is returned when updating a resolved incident, which is
+// final.
+var errIncidentResolved = errors.New("incident is resolved")
+
+// IncidentController allows querying incidents in bulk or by ID, and
+// acknowledging them. Incidents are opened and resolved by the backend as it
+// correlates the events.
+type IncidentController struct {
+	Store  store.IncidentStore
+	Policy authorization.IncidentPolicy
+}
+
+// NewIncidentController creates a new IncidentController backed by store.
+func NewIncidentController(store store.IncidentStore) IncidentController {
+	return IncidentController{
+		Store:  store,
+		Policy: authorization.Incidents,
+	}
+}
+
+// Update updates the state of an incident, which can only be open or
+// acknowledged.
+// It returns non-nil error if the new state is invalid, update permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c IncidentController) Update(ctx context.Context, delta types.Incident) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &delta)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	incident, err := c.Store.GetIncidentByID(ctx, delta.ID)
+	if err != nil {
+		return NewError(InternalErr, err)
+	} else if incident == nil {
+		return NewErrorf(NotFound, delta.ID)
+	}
+
+	// Verify viewer can make change
+	if ok := policy.CanUpdate(incident); !ok {
+		return NewErrorf(PermissionDenied, "update")
+	}
+
+	// Resolved incidents are final, and only the backend resolves incidents
+	if incident.IsResolved() {
+		return NewError(InvalidArgument, errIncidentResolved)
+	}
+	if delta.State != types.IncidentOpen && delta.State != types.IncidentAcknowledged {
+		return NewErrorf(InvalidArgument, "incident state must be open or acknowledged")
+	}
+
+	// Update the stored incident, unless the backend resolved it meanwhile
+	err = c.Store.UpdateIncidentWith(ctx, incident.ID, func(stored *types.Incident) error {
+		if stored.IsResolved() {
+			return errIncidentResolved
+		}
+		return stored.Update(&delta, incidentUpdateFields...)
+	})
+	if err == errIncidentResolved {
+		return NewError(InvalidArgument, err)
+	} else if err != nil {
+		return NewError(InternalErr, err)
+	}
+	return nil
+}
+
+// Query returns resources available to the viewer filter by given params.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c IncidentController) Query(ctx context.Context) ([]*types.Incident, error) {
+	policy := c.Policy.WithContext(ctx)
+
+	// Fetch from store
+	incidents, err := c.Store.GetIncidents(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	result := make([]*types.Incident, 0, len(incidents))
+
+	// Filter out those resources the viewer does not have access to view.
+	for _, i := range incidents {
+		if ok := policy.CanRead(i); ok {
+			result = append(result, i)
+		}
+	}
+
+	return result, nil
+}
+
+// Destroy destroys the incident with the given ID.
+// It returns non-nil error if the params are invalid, delete permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c IncidentController) Destroy(ctx context.Context, id string) error {
+	policy := c.Policy.WithContext(ctx)
+
+	// Verify permissions
+	if ok := policy.CanDelete(); !ok {
+		return NewErrorf(PermissionDenied, "delete")
+	}
+
+	// Validate parameters
+	if id == "" {
+		return NewErrorf(InvalidArgument, "id is undefined")
+	}
+
+	// Fetch from store
+	incident, err := c.Store.GetIncidentByID(ctx, id)
+	if err != nil {
+		return NewError(InternalErr, err)
+	}
+	if incident == nil {
+		return NewErrorf(NotFound, id)
+	}
+
+	// Remove from store
+	if err := c.Store.DeleteIncidentByID(ctx, incident.ID); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Find returns resource associated with given parameters if available to the
+// viewer.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c IncidentController) Find(ctx context.Context, id string) (*types.Incident, error) {
+	result, err := c.Store.GetIncidentByID(ctx, id)
+	if err != nil {
+		return nil, NewErrorf(InternalErr, err)
+	}
+
+	if result == nil {
+		return nil, NewErrorf(NotFound)
+	}
+
+	policy := c.Policy.WithContext(ctx)
+
+	if !policy.CanRead(result) {
+		return nil, NewErrorf(NotFound)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,141 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewIncidentController(t *testing.T) {
+	assert := assert.New(t)
+
+	store := &mockstore.MockStore{}
+	ctl := NewIncidentController(store)
+	assert.NotNil(ctl)
+	assert.Equal(store, ctl.Store)
+	assert.NotNil(ctl.Policy)
+}
+
+func TestIncidentUpdate(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeIncident, types.RulePermUpdate),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeIncident, types.RulePermRead),
+		),
+	)
+
+	resolved := types.FixtureIncident("abc", "check_cpu")
+	resolved.State = types.IncidentResolved
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		state           string
+		fetchResult     *types.Incident
+		fetchErr        error
+		updateErr       error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:        "Acknowledged",
+			ctx:         defaultCtx,
+			state:       types.IncidentAcknowledged,
+			fetchResult: types.FixtureIncident("abc", "check_cpu"),
+		},
+		{
+			name:            "Not Found",
+			ctx:             defaultCtx,
+			state:           types.IncidentAcknowledged,
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "Store Err on Fetch",
+			ctx:             defaultCtx,
+			state:           types.IncidentAcknowledged,
+			fetchErr:        errors.New("nein"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			state:           types.IncidentAcknowledged,
+			fetchResult:     types.FixtureIncident("abc", "check_cpu"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Resolved By Viewer",
+			ctx:             defaultCtx,
+			state:           types.IncidentResolved,
+			fetchResult:     types.FixtureIncident("abc", "check_cpu"),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Already Resolved",
+			ctx:             defaultCtx,
+			state:           types.IncidentOpen,
+			fetchResult:     resolved,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Store Err on Update",
+			ctx:             defaultCtx,
+			state:           types.IncidentAcknowledged,
+			fetchResult:     types.FixtureIncident("abc", "check_cpu"),
+			updateErr:       errors.New("nein"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+	}
+
+	for _, test := range tests {
+		store := &mockstore.MockStore{}
+		ctl := NewIncidentController(store)
+
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			store.On("GetIncidentByID", mock.Anything, "abc").
+				Return(test.fetchResult, test.fetchErr)
+
+			store.On("UpdateIncidentWith", mock.Anything, "abc", mock.Anything).
+				Return(test.updateErr).
+				Run(func(args mock.Arguments) {
+					update := args.Get(2).(func(*types.Incident) error)
+					_ = update(test.fetchResult)
+				})
+
+			delta := types.Incident{ID: "abc", State: test.state}
+			err := ctl.Update(test.ctx, delta)
+
+			if test.expectedErr {
+				if cerr, ok := err.(Error); ok {
+					assert.Equal(test.expectedErrCode, cerr.Code)
+				} else {
+					assert.Error(err)
+					assert.FailNow("Not of type 'Error'")
+				}
+			} else {
+				assert.NoError(err)
+				assert.Equal(types.IncidentAcknowledged, test.fetchResult.State)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ var mutatorUpdateFields = []string{
 	"Command",
 	"Timeout",
 	"EnvVars",
+	"RuntimeAssets",
 }
 
 // MutatorController allows querying mutators in bulk or by name.
@@ -49,17 +50,10 @@ func (c MutatorController) Create(ctx context.Context, mut types.Mutator) error
 		return NewErrorf(PermissionDenied, "create")
 	}
 
-	// Validate
-	if err := mut.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
-	}
-
-	// Persist
-	if err := c.Store.UpdateMutator(ctx, &mut); err != nil {
-		return NewError(InternalErr, err)
-	}
-
-	return nil
+	// Validate and persist
+	return persistResource(ctx, &mut, func(ctx context.Context) error {
+		return c.Store.UpdateMutator(ctx, &mut)
+	})
 }
 
 // Update updates a mutator.
@@ -89,17 +83,10 @@ func (c MutatorController) Update(ctx context.Context, delta types.Mutator) erro
 		return NewError(InternalErr, err)
 	}
 
-	// Validate
-	if err := mut.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
-	}
-
-	// Persist
-	if err := c.Store.UpdateMutator(ctx, mut); err != nil {
-		return NewError(InternalErr, err)
-	}
-
-	return nil
+	// Validate and persist
+	return persistResource(ctx, mut, func(ctx context.Context) error {
+		return c.Store.UpdateMutator(ctx, mut)
+	})
 }
 
 // Query returns resources available to the viewer filter by given params.
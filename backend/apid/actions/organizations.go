@@ -7,6 +7,12 @@ import (
 	"golang.org/x/net/context"
 )
 
+var orgUpdateFields = []string{
+	"Description",
+	"Labels",
+	"Annotations",
+}
+
 // OrganizationsController defines the fields required for this controller.
 type OrganizationsController struct {
 	Store  store.OrganizationStore
@@ -105,6 +111,11 @@ func (a OrganizationsController) Update(ctx context.Context, given types.Organiz
 		return NewErrorf(PermissionDenied)
 	}
 
+	// Update
+	if err := org.Update(&given, orgUpdateFields...); err != nil {
+		return NewError(InternalErr, err)
+	}
+
 	// Validate
 	if err := org.Validate(); err != nil {
 		return NewError(InvalidArgument, err)
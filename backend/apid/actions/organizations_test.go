@@ -383,6 +383,33 @@ func TestOrganizationsUpdate(t *testing.T) {
 	}
 }
 
+func TestOrganizationsUpdateFields(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeOrganization, types.RulePermUpdate),
+		),
+	)
+
+	store := &mockstore.MockStore{}
+	actions := NewOrganizationsController(store)
+
+	given := types.FixtureOrganization("org1")
+	given.Description = "ops"
+	given.Labels = map[string]string{"team": "ops"}
+	given.Annotations = map[string]string{"runbook": "https://wiki"}
+
+	store.
+		On("GetOrganizationByName", mock.Anything, "org1").
+		Return(types.FixtureOrganization("org1"), nil)
+	store.
+		On("UpdateOrganization", mock.Anything, given).
+		Return(nil)
+
+	assert.NoError(t, actions.Update(ctx, *given))
+	store.AssertExpectations(t)
+}
+
 func TestOrganizationsDestroy(t *testing.T) {
 	defaultCtx := testutil.NewContext(
 		testutil.ContextWithOrgEnv("default", "default"),
@@ -0,0 +1,170 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var pipelineUpdateFields = []string{
+	"Workflows",
+}
+
+// PipelineController allows querying pipelines in bulk or by name.
+type PipelineController struct {
+	Store  store.PipelineStore
+	Policy authorization.PipelinePolicy
+}
+
+// NewPipelineController creates a new PipelineController backed by store.
+func NewPipelineController(store store.PipelineStore) PipelineController {
+	return PipelineController{
+		Store:  store,
+		Policy: authorization.Pipelines,
+	}
+}
+
+// Create creates a new Pipeline resource.
+// It returns non-nil error if the new pipeline is invalid, update permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c PipelineController) Create(ctx context.Context, pipeline types.Pipeline) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &pipeline)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	if g, err := c.Store.GetPipelineByName(ctx, pipeline.Name); err != nil {
+		return NewError(InternalErr, err)
+	} else if g != nil {
+		return NewErrorf(AlreadyExistsErr, pipeline.Name)
+	}
+
+	// Verify permissions
+	if ok := policy.CanCreate(&pipeline); !ok {
+		return NewErrorf(PermissionDenied, "create")
+	}
+
+	// Validate and persist
+	return persistResource(ctx, &pipeline, func(ctx context.Context) error {
+		return c.Store.UpdatePipeline(ctx, &pipeline)
+	})
+}
+
+// Update updates a pipeline.
+// It returns non-nil error if the new pipeline is invalid, create permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c PipelineController) Update(ctx context.Context, delta types.Pipeline) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &delta)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	pipeline, err := c.Store.GetPipelineByName(ctx, delta.Name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	} else if pipeline == nil {
+		return NewErrorf(NotFound, delta.Name)
+	}
+
+	// Verify viewer can make change
+	if ok := policy.CanUpdate(pipeline); !ok {
+		return NewErrorf(PermissionDenied, "update")
+	}
+
+	// Update
+	if err := pipeline.Update(&delta, pipelineUpdateFields...); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	// Validate and persist
+	return persistResource(ctx, pipeline, func(ctx context.Context) error {
+		return c.Store.UpdatePipeline(ctx, pipeline)
+	})
+}
+
+// Query returns resources available to the viewer filter by given params.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c PipelineController) Query(ctx context.Context) ([]*types.Pipeline, error) {
+	policy := c.Policy.WithContext(ctx)
+
+	// Fetch from store
+	pipelines, err := c.Store.GetPipelines(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	result := make([]*types.Pipeline, 0, len(pipelines))
+
+	// Filter out those resources the viewer does not have access to view.
+	for _, g := range pipelines {
+		if ok := policy.CanRead(g); ok {
+			result = append(result, g)
+		}
+	}
+
+	return result, nil
+}
+
+// Destroy destroys the named Pipeline.
+// It returns non-nil error if the params are invalid, delete permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c PipelineController) Destroy(ctx context.Context, name string) error {
+	policy := c.Policy.WithContext(ctx)
+
+	// Verify permissions
+	if ok := policy.CanDelete(); !ok {
+		return NewErrorf(PermissionDenied, "delete")
+	}
+
+	// Validate parameters
+	if name == "" {
+		return NewErrorf(InvalidArgument, "name is undefined")
+	}
+
+	// Fetch from store
+	pipeline, err := c.Store.GetPipelineByName(ctx, name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	}
+	if pipeline == nil {
+		return NewErrorf(NotFound, name)
+	}
+
+	// Remove from store
+	if err := c.Store.DeletePipelineByName(ctx, pipeline.Name); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Find returns resource associated with given parameters if available to the
+// viewer.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c PipelineController) Find(ctx context.Context, name string) (*types.Pipeline, error) {
+	result, err := c.Store.GetPipelineByName(ctx, name)
+	if err != nil {
+		return nil, NewErrorf(InternalErr, err)
+	}
+
+	if result == nil {
+		return nil, NewErrorf(NotFound)
+	}
+
+	policy := c.Policy.WithContext(ctx)
+
+	if !policy.CanRead(result) {
+		return nil, NewErrorf(NotFound)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,143 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPipelineController(t *testing.T) {
+	assert := assert.New(t)
+
+	store := &mockstore.MockStore{}
+	ctl := NewPipelineController(store)
+	assert.NotNil(ctl)
+	assert.Equal(store, ctl.Store)
+	assert.NotNil(ctl.Policy)
+}
+
+func TestPipelineCreate(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypePipeline, types.RulePermCreate),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypePipeline, types.RulePermRead),
+		),
+	)
+
+	badPipeline := types.FixturePipeline("bad")
+	badPipeline.Name = "!@#!#$@#^$%&$%&$&$%&%^*%&(%@###"
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		argument        *types.Pipeline
+		fetchResult     *types.Pipeline
+		fetchErr        error
+		createErr       error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:        "Created",
+			ctx:         defaultCtx,
+			argument:    types.FixturePipeline("alerts"),
+			expectedErr: false,
+		},
+		{
+			name:            "Already Exists",
+			ctx:             defaultCtx,
+			argument:        types.FixturePipeline("alerts"),
+			fetchResult:     types.FixturePipeline("alerts"),
+			expectedErr:     true,
+			expectedErrCode: AlreadyExistsErr,
+		},
+		{
+			name:            "Store Err on Fetch",
+			ctx:             defaultCtx,
+			argument:        types.FixturePipeline("alerts"),
+			fetchErr:        errors.New("nein"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			argument:        types.FixturePipeline("alerts"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Validation Error",
+			ctx:             defaultCtx,
+			argument:        badPipeline,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		store := &mockstore.MockStore{}
+		ctl := NewPipelineController(store)
+
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			store.On("GetPipelineByName", mock.Anything, mock.Anything).
+				Return(test.fetchResult, test.fetchErr)
+
+			store.On("UpdatePipeline", mock.Anything, mock.Anything).Return(test.createErr)
+
+			err := ctl.Create(test.ctx, *test.argument)
+
+			if test.expectedErr {
+				if cerr, ok := err.(Error); ok {
+					assert.Equal(test.expectedErrCode, cerr.Code)
+				} else {
+					assert.Error(err)
+					assert.FailNow("Not of type 'Error'")
+				}
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestPipelineUpdate(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypePipeline, types.RulePermUpdate),
+		),
+	)
+
+	store := &mockstore.MockStore{}
+	store.On("GetPipelineByName", mock.Anything, "alerts").Return(types.FixturePipeline("alerts"), nil)
+	store.On("UpdatePipeline", mock.Anything).Return(nil)
+	ctl := NewPipelineController(store)
+
+	delta := types.FixturePipeline("alerts")
+	delta.Workflows[0].Handler = "pagerduty"
+	require.NoError(t, ctl.Update(ctx, *delta))
+	updated := store.Calls[1].Arguments.Get(0).(*types.Pipeline)
+	assert.Equal(t, "pagerduty", updated.Workflows[0].Handler)
+
+	var nilPipeline *types.Pipeline
+	store.On("GetPipelineByName", mock.Anything, "missing").Return(nilPipeline, nil)
+	err := ctl.Update(ctx, *types.FixturePipeline("missing"))
+	require.Error(t, err)
+	assert.Equal(t, NotFound, err.(Error).Code)
+}
@@ -0,0 +1,209 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var roleBindingUpdateFields = []string{
+	"Role",
+	"Users",
+	"Groups",
+}
+
+// RoleBindingStore specifies the storage requirements of the
+// RoleBindingController.
+type RoleBindingStore interface {
+	store.RoleBindingStore
+	store.RBACStore
+}
+
+// RoleBindingController allows querying role bindings in bulk or by name.
+type RoleBindingController struct {
+	Store  RoleBindingStore
+	Policy authorization.RoleBindingPolicy
+}
+
+// NewRoleBindingController creates a new RoleBindingController backed by
+// store.
+func NewRoleBindingController(store RoleBindingStore) RoleBindingController {
+	return RoleBindingController{
+		Store:  store,
+		Policy: authorization.RoleBindings,
+	}
+}
+
+// Create creates a new RoleBinding resource.
+// It returns non-nil error if the new role binding is invalid, its role does
+// not exist or grants more than the permissions of the viewer, create
+// permissions do not exist, or an internal error occurs while updating the
+// underlying Store.
+func (c RoleBindingController) Create(ctx context.Context, binding types.RoleBinding) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &binding)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	if b, err := c.Store.GetRoleBindingByName(ctx, binding.Name); err != nil {
+		return NewError(InternalErr, err)
+	} else if b != nil {
+		return NewErrorf(AlreadyExistsErr, binding.Name)
+	}
+
+	// Verify permissions
+	if ok := policy.CanCreate(&binding); !ok {
+		return NewErrorf(PermissionDenied, "create")
+	}
+
+	if err := c.validateRole(ctx, &binding); err != nil {
+		return err
+	}
+
+	// Validate and persist
+	return persistResource(ctx, &binding, func(ctx context.Context) error {
+		return c.Store.UpdateRoleBinding(ctx, &binding)
+	})
+}
+
+// Update updates a role binding.
+// It returns non-nil error if the new role binding is invalid, its role does
+// not exist or grants more than the permissions of the viewer, update
+// permissions do not exist, or an internal error occurs while updating the
+// underlying Store.
+func (c RoleBindingController) Update(ctx context.Context, delta types.RoleBinding) error {
+	// Adjust context
+	ctx = addOrgEnvToContext(ctx, &delta)
+	policy := c.Policy.WithContext(ctx)
+
+	// Check for existing
+	binding, err := c.Store.GetRoleBindingByName(ctx, delta.Name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	} else if binding == nil {
+		return NewErrorf(NotFound, delta.Name)
+	}
+
+	// Verify viewer can make change
+	if ok := policy.CanUpdate(binding); !ok {
+		return NewErrorf(PermissionDenied, "update")
+	}
+
+	// Update
+	if err := binding.Update(&delta, roleBindingUpdateFields...); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	if err := c.validateRole(ctx, binding); err != nil {
+		return err
+	}
+
+	// Validate and persist
+	return persistResource(ctx, binding, func(ctx context.Context) error {
+		return c.Store.UpdateRoleBinding(ctx, binding)
+	})
+}
+
+// Query returns resources available to the viewer.
+// It returns non-nil error if read permissions do not exist, or an internal
+// error occurs while reading the underlying Store.
+func (c RoleBindingController) Query(ctx context.Context) ([]*types.RoleBinding, error) {
+	policy := c.Policy.WithContext(ctx)
+
+	// Fetch from store
+	bindings, err := c.Store.GetRoleBindings(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	result := make([]*types.RoleBinding, 0, len(bindings))
+
+	// Filter out those resources the viewer does not have access to view.
+	for _, b := range bindings {
+		if ok := policy.CanRead(b); ok {
+			result = append(result, b)
+		}
+	}
+
+	return result, nil
+}
+
+// Destroy destroys the named RoleBinding.
+// It returns non-nil error if the params are invalid, delete permissions
+// do not exist, or an internal error occurs while updating the underlying
+// Store.
+func (c RoleBindingController) Destroy(ctx context.Context, name string) error {
+	policy := c.Policy.WithContext(ctx)
+
+	// Verify permissions
+	if ok := policy.CanDelete(); !ok {
+		return NewErrorf(PermissionDenied, "delete")
+	}
+
+	// Validate parameters
+	if name == "" {
+		return NewErrorf(InvalidArgument, "name is undefined")
+	}
+
+	// Fetch from store
+	binding, err := c.Store.GetRoleBindingByName(ctx, name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	}
+	if binding == nil {
+		return NewErrorf(NotFound, name)
+	}
+
+	// Remove from store
+	if err := c.Store.DeleteRoleBindingByName(ctx, binding.Name); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Find returns resource associated with given parameters if available to the
+// viewer.
+// It returns non-nil error if the params are invalid, read permissions
+// do not exist, or an internal error occurs while reading the underlying
+// Store.
+func (c RoleBindingController) Find(ctx context.Context, name string) (*types.RoleBinding, error) {
+	result, err := c.Store.GetRoleBindingByName(ctx, name)
+	if err != nil {
+		return nil, NewErrorf(InternalErr, err)
+	}
+
+	if result == nil {
+		return nil, NewErrorf(NotFound)
+	}
+
+	policy := c.Policy.WithContext(ctx)
+
+	if !policy.CanRead(result) {
+		return nil, NewErrorf(NotFound)
+	}
+
+	return result, nil
+}
+
+// validateRole returns an error if the role of the binding does not exist, or
+// if it grants permissions the actor does not already hold. Otherwise, anyone
+// allowed to manage role bindings could grant themselves any role.
+func (c RoleBindingController) validateRole(ctx context.Context, binding *types.RoleBinding) error {
+	role, err := c.Store.GetRoleByName(ctx, binding.Role)
+	if err != nil {
+		return NewError(InternalErr, err)
+	} else if role == nil {
+		return NewErrorf(InvalidArgument, "role "+binding.Role+" does not exist")
+	}
+
+	actor := authorization.ExtractValueFromContext(ctx).Actor
+	for _, rule := range binding.Grant(role) {
+		if !actor.Grants(rule) {
+			return NewErrorf(PermissionDenied, "role %s grants permissions the user does not hold", binding.Role)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewRoleBindingController(t *testing.T) {
+	assert := assert.New(t)
+
+	store := &mockstore.MockStore{}
+	ctl := NewRoleBindingController(store)
+	assert.NotNil(ctl)
+	assert.Equal(store, ctl.Store)
+	assert.NotNil(ctl.Policy)
+}
+
+func TestRoleBindingCreate(t *testing.T) {
+	defaultCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			*types.FixtureRule("default", "default"),
+		),
+	)
+	escalationCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeRoleBinding, types.RulePermCreate),
+		),
+	)
+	wrongPermsCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeRoleBinding, types.RulePermRead),
+		),
+	)
+
+	badBinding := types.FixtureRoleBinding("bad", "operator")
+	badBinding.Users = nil
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		argument        *types.RoleBinding
+		fetchResult     *types.RoleBinding
+		fetchErr        error
+		role            *types.Role
+		createErr       error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:        "Created",
+			ctx:         defaultCtx,
+			argument:    types.FixtureRoleBinding("dev-operators", "operator"),
+			role:        types.FixtureRole("operator", "*", "*"),
+			expectedErr: false,
+		},
+		{
+			name:            "Already Exists",
+			ctx:             defaultCtx,
+			argument:        types.FixtureRoleBinding("dev-operators", "operator"),
+			fetchResult:     types.FixtureRoleBinding("dev-operators", "operator"),
+			role:            types.FixtureRole("operator", "*", "*"),
+			expectedErr:     true,
+			expectedErrCode: AlreadyExistsErr,
+		},
+		{
+			name:            "Store Err on Fetch",
+			ctx:             defaultCtx,
+			argument:        types.FixtureRoleBinding("dev-operators", "operator"),
+			fetchErr:        errors.New("nein"),
+			role:            types.FixtureRole("operator", "*", "*"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+		{
+			name:            "No Permission",
+			ctx:             wrongPermsCtx,
+			argument:        types.FixtureRoleBinding("dev-operators", "operator"),
+			role:            types.FixtureRole("operator", "*", "*"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Escalation",
+			ctx:             escalationCtx,
+			argument:        types.FixtureRoleBinding("dev-operators", "operator"),
+			role:            types.FixtureRole("operator", "*", "*"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Missing Role",
+			ctx:             defaultCtx,
+			argument:        types.FixtureRoleBinding("dev-operators", "operator"),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Validation Error",
+			ctx:             defaultCtx,
+			argument:        badBinding,
+			role:            types.FixtureRole("operator", "*", "*"),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		store := &mockstore.MockStore{}
+		ctl := NewRoleBindingController(store)
+
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			store.On("GetRoleBindingByName", mock.Anything, mock.Anything).
+				Return(test.fetchResult, test.fetchErr)
+			store.On("GetRoleByName", mock.Anything, mock.Anything).
+				Return(test.role, nil)
+
+			store.On("UpdateRoleBinding", mock.Anything, mock.Anything).Return(test.createErr)
+
+			err := ctl.Create(test.ctx, *test.argument)
+
+			if test.expectedErr {
+				if cerr, ok := err.(Error); ok {
+					assert.Equal(test.expectedErrCode, cerr.Code)
+				} else {
+					assert.Error(err)
+					assert.FailNow("Not of type 'Error'")
+				}
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestRoleBindingUpdate(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			*types.FixtureRule("default", "default"),
+		),
+	)
+
+	store := &mockstore.MockStore{}
+	store.On("GetRoleBindingByName", mock.Anything, "dev-operators").
+		Return(types.FixtureRoleBinding("dev-operators", "operator"), nil)
+	store.On("GetRoleByName", mock.Anything, "admin").
+		Return(types.FixtureRole("admin", "*", "*"), nil)
+	store.On("UpdateRoleBinding", mock.Anything, mock.Anything).Return(nil)
+	ctl := NewRoleBindingController(store)
+
+	delta := types.FixtureRoleBinding("dev-operators", "admin")
+	delta.Users = []string{"bar"}
+	assert.NoError(t, ctl.Update(ctx, *delta))
+
+	updated := store.Calls[len(store.Calls)-1].Arguments.Get(1).(*types.RoleBinding)
+	assert.Equal(t, "admin", updated.Role)
+	assert.Equal(t, []string{"bar"}, updated.Users)
+}
+
+func TestRoleBindingUpdateEscalation(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeRoleBinding, types.RulePermUpdate),
+		),
+	)
+
+	store := &mockstore.MockStore{}
+	store.On("GetRoleBindingByName", mock.Anything, "dev-operators").
+		Return(types.FixtureRoleBinding("dev-operators", "operator"), nil)
+	store.On("GetRoleByName", mock.Anything, "admin").
+		Return(types.FixtureRole("admin", "*", "*"), nil)
+	ctl := NewRoleBindingController(store)
+
+	delta := types.FixtureRoleBinding("dev-operators", "admin")
+	err := ctl.Update(ctx, *delta)
+	assert.Equal(t, PermissionDenied, err.(Error).Code)
+	store.AssertNotCalled(t, "UpdateRoleBinding", mock.Anything, mock.Anything)
+}
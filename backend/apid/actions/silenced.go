@@ -19,6 +19,9 @@ var silencedUpdateFields = []string{
 type SilencedController struct {
 	Store  store.SilencedStore
 	Policy authorization.SilencedPolicy
+
+	// Tombstones keeps the deleted silenced entries during the undo window
+	Tombstones *Tombstones
 }
 
 // NewSilencedController returns new SilencedController
@@ -143,17 +146,10 @@ func (a SilencedController) Update(ctx context.Context, given types.Silenced) er
 	// Copy
 	copyFields(silence, &given, silencedUpdateFields...)
 
-	// Validate
-	if err := silence.Validate(); err != nil {
-		return NewError(InvalidArgument, err)
-	}
-
-	// Persist Changes
-	if serr := a.Store.UpdateSilencedEntry(ctx, silence); serr != nil {
-		return NewError(InternalErr, serr)
-	}
-
-	return nil
+	// Validate and persist
+	return persistResource(ctx, silence, func(ctx context.Context) error {
+		return a.Store.UpdateSilencedEntry(ctx, silence)
+	})
 }
 
 // Destroy removes a resource if viewer has access.
@@ -180,6 +176,19 @@ func (a SilencedController) Destroy(ctx context.Context, params QueryParams) err
 		}
 	}
 
+	// Keep it for the undo window
+	if a.Tombstones.enabled() {
+		silenced, err := a.Store.GetSilencedEntryByID(ctx, id)
+		if err != nil {
+			return NewError(InternalErr, err)
+		}
+		if silenced != nil {
+			if err := a.Tombstones.bury(ctx, types.TombstoneKindSilenced, silenced.ID, silenced); err != nil {
+				return NewError(InternalErr, err)
+			}
+		}
+	}
+
 	if err := a.Store.DeleteSilencedEntryByID(ctx, id); err != nil {
 		return NewError(InternalErr, err)
 	}
@@ -0,0 +1,69 @@
+package actions
+
+import (
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/tessend"
+	"github.com/sensu/sensu-go/types"
+	"golang.org/x/net/context"
+)
+
+// TessenController exposes the configuration of the anonymous usage
+// reporting, and the data it reports.
+type TessenController struct {
+	Store  store.Store
+	Policy authorization.TessenPolicy
+}
+
+// NewTessenController returns new TessenController
+func NewTessenController(store store.Store) TessenController {
+	return TessenController{
+		Store:  store,
+		Policy: authorization.Tessen,
+	}
+}
+
+// Find returns the Tessen configuration.
+func (a TessenController) Find(ctx context.Context) (*types.TessenConfig, error) {
+	abilities := a.Policy.WithContext(ctx)
+	if !abilities.CanRead() {
+		return nil, NewErrorf(PermissionDenied)
+	}
+
+	config, err := a.Store.GetTessenConfig(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	return config, nil
+}
+
+// Update replaces the Tessen configuration.
+func (a TessenController) Update(ctx context.Context, config types.TessenConfig) error {
+	abilities := a.Policy.WithContext(ctx)
+	if !abilities.CanUpdate() {
+		return NewErrorf(PermissionDenied)
+	}
+
+	if err := a.Store.UpdateTessenConfig(ctx, &config); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Preview returns the data reported when the cluster opts in, whether it did
+// or not.
+func (a TessenController) Preview(ctx context.Context) (*types.TessenData, error) {
+	abilities := a.Policy.WithContext(ctx)
+	if !abilities.CanRead() {
+		return nil, NewErrorf(PermissionDenied)
+	}
+
+	data, err := tessend.Collect(ctx, a.Store)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,79 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTessenFind(t *testing.T) {
+	store := &mockstore.MockStore{}
+	controller := NewTessenController(store)
+	store.On("GetTessenConfig", mock.Anything).Return(types.FixtureTessenConfig(true), nil)
+
+	ctx := testutil.NewContext(testutil.ContextWithPerms(types.RuleTypeTessen, types.RulePermRead))
+	config, err := controller.Find(ctx)
+	require.NoError(t, err)
+	assert.True(t, config.OptIn)
+
+	// The configuration is shared by all organizations, so access to a single
+	// one is not enough
+	rule := types.FixtureRule("acme", "*")
+	ctx = testutil.NewContext(testutil.ContextWithRules(*rule))
+	_, err = controller.Find(ctx)
+	assertActionErr(t, err, PermissionDenied)
+}
+
+func TestTessenUpdate(t *testing.T) {
+	store := &mockstore.MockStore{}
+	controller := NewTessenController(store)
+	store.On("UpdateTessenConfig", mock.Anything, types.FixtureTessenConfig(true)).Return(nil).Once()
+	store.On("UpdateTessenConfig", mock.Anything, types.FixtureTessenConfig(false)).Return(errors.New("error"))
+
+	ctx := testutil.NewContext(testutil.ContextWithPerms(types.RuleTypeTessen, types.RulePermUpdate))
+	assert.NoError(t, controller.Update(ctx, *types.FixtureTessenConfig(true)))
+	assertActionErr(t, controller.Update(ctx, *types.FixtureTessenConfig(false)), InternalErr)
+
+	ctx = testutil.NewContext(testutil.ContextWithPerms(types.RuleTypeTessen, types.RulePermRead))
+	assertActionErr(t, controller.Update(ctx, *types.FixtureTessenConfig(true)), PermissionDenied)
+}
+
+func TestTessenPreview(t *testing.T) {
+	store := &mockstore.MockStore{}
+	controller := NewTessenController(store)
+	store.On("GetClusterID", mock.Anything).Return("cafe", nil)
+	store.On("GetAssets", mock.Anything).Return([]*types.Asset{}, nil)
+	store.On("GetCheckConfigs", mock.Anything).Return([]*types.CheckConfig{}, nil)
+	store.On("GetEntities", mock.Anything).Return([]*types.Entity{}, nil)
+	store.On("GetEnvironments", mock.Anything, "*").Return([]*types.Environment{}, nil)
+	store.On("GetEventFilters", mock.Anything).Return([]*types.EventFilter{}, nil)
+	store.On("GetHandlers", mock.Anything).Return([]*types.Handler{}, nil)
+	store.On("GetHookConfigs", mock.Anything).Return([]*types.HookConfig{}, nil)
+	store.On("GetMutators", mock.Anything).Return([]*types.Mutator{}, nil)
+	store.On("GetOrganizations", mock.Anything).Return([]*types.Organization{}, nil)
+	store.On("GetSilencedEntries", mock.Anything).Return([]*types.Silenced{}, nil)
+	store.On("GetUsers").Return([]*types.User{}, nil)
+
+	ctx := testutil.NewContext(testutil.ContextWithPerms(types.RuleTypeTessen, types.RulePermRead))
+	data, err := controller.Preview(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "cafe", data.ClusterID)
+	assert.Contains(t, data.Resources, types.RuleTypeCheck)
+
+	ctx = testutil.NewContext(testutil.ContextWithNoAccess)
+	_, err = controller.Preview(ctx)
+	assertActionErr(t, err, PermissionDenied)
+}
+
+func assertActionErr(t *testing.T, err error, code ErrCode) {
+	require.Error(t, err)
+	actionErr, ok := err.(Error)
+	require.True(t, ok)
+	assert.Equal(t, code, actionErr.Code)
+}
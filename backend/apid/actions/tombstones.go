@@ -0,0 +1,219 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// Tombstones keeps the checks, handlers and silenced entries deleted through
+// the API as tombstones during the undo window, so that they can be restored.
+type Tombstones struct {
+	Store store.TombstoneStore
+
+	// Window is the number of seconds the deleted resources are kept, none
+	// being kept if it's zero
+	Window int64
+}
+
+// enabled returns whether the deleted resources are kept.
+func (t *Tombstones) enabled() bool {
+	return t != nil && t.Window > 0
+}
+
+// bury keeps the deleted resource of the given kind and name as a tombstone,
+// unless the tombstones are disabled.
+func (t *Tombstones) bury(ctx context.Context, kind, name string, resource types.MultitenantResource) error {
+	if !t.enabled() {
+		return nil
+	}
+
+	resourceBytes, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	tombstone := &types.Tombstone{
+		Kind:         kind,
+		Name:         name,
+		Organization: resource.GetOrganization(),
+		Environment:  resource.GetEnvironment(),
+		DeletedAt:    now,
+		ExpireAt:     now + t.Window,
+		Resource:     resourceBytes,
+	}
+	if actor, ok := ctx.Value(types.AuthorizationActorKey).(authorization.Actor); ok {
+		tombstone.DeletedBy = actor.Name
+	}
+
+	return t.Store.CreateTombstone(ctx, tombstone, t.Window)
+}
+
+// TombstoneStore contains the storage of the tombstones and of the resources
+// they restore.
+type TombstoneStore interface {
+	store.CheckConfigStore
+	store.HandlerStore
+	store.SilencedStore
+	store.TombstoneStore
+}
+
+// TombstoneController exposes the actions on the tombstones of the deleted
+// resources.
+type TombstoneController struct {
+	Store TombstoneStore
+}
+
+// NewTombstoneController returns new TombstoneController
+func NewTombstoneController(store TombstoneStore) TombstoneController {
+	return TombstoneController{
+		Store: store,
+	}
+}
+
+// Query returns the tombstones of the resources the viewer can read.
+func (c TombstoneController) Query(ctx context.Context) ([]*types.Tombstone, error) {
+	// Fetch from store
+	results, serr := c.Store.GetTombstones(ctx)
+	if serr != nil {
+		return nil, NewError(InternalErr, serr)
+	}
+
+	// Filter out those resources the viewer does not have access to view.
+	resources := []*types.Tombstone{}
+	for _, tombstone := range results {
+		if canReadTombstone(ctx, tombstone) {
+			resources = append(resources, tombstone)
+		}
+	}
+
+	return resources, nil
+}
+
+// Restore creates the deleted resource of the given kind and name again, if
+// its tombstone didn't expire yet and the viewer can create it, and deletes
+// the tombstone. The resource must not have been created again since.
+func (c TombstoneController) Restore(ctx context.Context, kind, name string) (*types.Tombstone, error) {
+	if err := types.ValidateTombstoneKind(kind); err != nil {
+		return nil, NewError(InvalidArgument, err)
+	}
+
+	// Fetch from store
+	tombstone, serr := c.Store.GetTombstone(ctx, kind, name)
+	if serr != nil {
+		return nil, NewError(InternalErr, serr)
+	} else if tombstone == nil || !canReadTombstone(ctx, tombstone) {
+		return nil, NewErrorf(NotFound)
+	}
+
+	var err error
+	switch kind {
+	case types.TombstoneKindCheck:
+		err = c.restoreCheck(ctx, tombstone)
+	case types.TombstoneKindHandler:
+		err = c.restoreHandler(ctx, tombstone)
+	case types.TombstoneKindSilenced:
+		err = c.restoreSilenced(ctx, tombstone)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Store.DeleteTombstone(ctx, kind, name); err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	return tombstone, nil
+}
+
+func (c TombstoneController) restoreCheck(ctx context.Context, tombstone *types.Tombstone) error {
+	check := &types.CheckConfig{}
+	if err := json.Unmarshal(tombstone.Resource, check); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	abilities := authorization.Checks.WithContext(ctx)
+	if yes := abilities.CanCreate(check); !yes {
+		return NewErrorf(PermissionDenied)
+	}
+
+	if existing, err := c.Store.GetCheckConfigByName(ctx, check.Name); err != nil {
+		return NewError(InternalErr, err)
+	} else if existing != nil {
+		return NewErrorf(AlreadyExistsErr)
+	}
+
+	if err := c.Store.UpdateCheckConfig(ctx, check); err != nil {
+		return NewError(InternalErr, err)
+	}
+	return nil
+}
+
+func (c TombstoneController) restoreHandler(ctx context.Context, tombstone *types.Tombstone) error {
+	handler := &types.Handler{}
+	if err := json.Unmarshal(tombstone.Resource, handler); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	abilities := authorization.Handlers.WithContext(ctx)
+	if yes := abilities.CanCreate(handler); !yes {
+		return NewErrorf(PermissionDenied)
+	}
+
+	if existing, err := c.Store.GetHandlerByName(ctx, handler.Name); err != nil {
+		return NewError(InternalErr, err)
+	} else if existing != nil {
+		return NewErrorf(AlreadyExistsErr)
+	}
+
+	if err := c.Store.UpdateHandler(ctx, handler); err != nil {
+		return NewError(InternalErr, err)
+	}
+	return nil
+}
+
+func (c TombstoneController) restoreSilenced(ctx context.Context, tombstone *types.Tombstone) error {
+	silenced := &types.Silenced{}
+	if err := json.Unmarshal(tombstone.Resource, silenced); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	abilities := authorization.Silenced.WithContext(ctx)
+	if yes := abilities.CanCreate(silenced); !yes {
+		return NewErrorf(PermissionDenied)
+	}
+
+	if existing, err := c.Store.GetSilencedEntryByID(ctx, silenced.ID); err != nil {
+		return NewError(InternalErr, err)
+	} else if existing != nil {
+		return NewErrorf(AlreadyExistsErr)
+	}
+
+	if err := c.Store.UpdateSilencedEntry(ctx, silenced); err != nil {
+		return NewError(InternalErr, err)
+	}
+	return nil
+}
+
+// canReadTombstone returns whether the viewer can read the resources of the
+// kind of the tombstone, in its organization and environment.
+func canReadTombstone(ctx context.Context, tombstone *types.Tombstone) bool {
+	org, env := tombstone.Organization, tombstone.Environment
+	switch tombstone.Kind {
+	case types.TombstoneKindCheck:
+		abilities := authorization.Checks.WithContext(ctx)
+		return abilities.CanRead(&types.CheckConfig{Organization: org, Environment: env})
+	case types.TombstoneKindHandler:
+		abilities := authorization.Handlers.WithContext(ctx)
+		return abilities.CanRead(&types.Handler{Organization: org, Environment: env})
+	case types.TombstoneKindSilenced:
+		abilities := authorization.Silenced.WithContext(ctx)
+		return abilities.CanRead(&types.Silenced{Organization: org, Environment: env})
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store/memory"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTombstoneTestStore returns a memory store with the default organization
+// and environment.
+func newTombstoneTestStore(t *testing.T) *memory.Store {
+	st := memory.NewStore()
+	ctx := context.Background()
+	require.NoError(t, st.UpdateOrganization(ctx, types.FixtureOrganization("default")))
+	require.NoError(t, st.UpdateEnvironment(ctx, types.FixtureEnvironment("default")))
+	return st
+}
+
+func TestTombstonesRestore(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeCheck, types.RulePermRead, types.RulePermCreate, types.RulePermDelete),
+			types.FixtureRuleWithPerms(types.RuleTypeHandler, types.RulePermRead, types.RulePermCreate, types.RulePermDelete),
+			types.FixtureRuleWithPerms(types.RuleTypeSilenced, types.RulePermRead, types.RulePermCreate, types.RulePermDelete),
+		),
+	)
+	st := newTombstoneTestStore(t)
+	tombstones := &Tombstones{Store: st, Window: 60}
+
+	check := types.FixtureCheckConfig("check1")
+	require.NoError(t, st.UpdateCheckConfig(ctx, check))
+	checks := NewCheckController(st)
+	checks.Tombstones = tombstones
+	require.NoError(t, checks.Destroy(ctx, "check1"))
+
+	handler := types.FixtureHandler("handler1")
+	require.NoError(t, st.UpdateHandler(ctx, handler))
+	handlers := NewHandlerController(st)
+	handlers.Tombstones = tombstones
+	require.NoError(t, handlers.Destroy(ctx, "handler1"))
+
+	silenced := types.FixtureSilenced("*:check1")
+	silenced.Organization = "default"
+	silenced.Environment = "default"
+	require.NoError(t, st.UpdateSilencedEntry(ctx, silenced))
+	silences := NewSilencedController(st)
+	silences.Tombstones = tombstones
+	require.NoError(t, silences.Destroy(ctx, QueryParams{"id": "*:check1"}))
+
+	controller := NewTombstoneController(st)
+	results, err := controller.Query(ctx)
+	require.NoError(t, err)
+	assert.Len(t, results, 3)
+
+	tombstone, err := controller.Restore(ctx, types.TombstoneKindCheck, "check1")
+	require.NoError(t, err)
+	assert.Equal(t, "check1", tombstone.Name)
+	restoredCheck, err := st.GetCheckConfigByName(ctx, "check1")
+	require.NoError(t, err)
+	require.NotNil(t, restoredCheck)
+	assert.Equal(t, check.Command, restoredCheck.Command)
+
+	_, err = controller.Restore(ctx, types.TombstoneKindHandler, "handler1")
+	require.NoError(t, err)
+	restoredHandler, err := st.GetHandlerByName(ctx, "handler1")
+	require.NoError(t, err)
+	assert.NotNil(t, restoredHandler)
+
+	_, err = controller.Restore(ctx, types.TombstoneKindSilenced, "*:check1")
+	require.NoError(t, err)
+	restoredSilenced, err := st.GetSilencedEntryByID(ctx, "*:check1")
+	require.NoError(t, err)
+	assert.NotNil(t, restoredSilenced)
+
+	// The tombstones are deleted once restored
+	results, err = controller.Query(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	_, err = controller.Restore(ctx, types.TombstoneKindCheck, "check1")
+	assert.Equal(t, NotFound, err.(Error).Code)
+
+	// A resource created again since can't be restored
+	require.NoError(t, checks.Destroy(ctx, "check1"))
+	require.NoError(t, st.UpdateCheckConfig(ctx, check))
+	_, err = controller.Restore(ctx, types.TombstoneKindCheck, "check1")
+	assert.Equal(t, AlreadyExistsErr, err.(Error).Code)
+
+	_, err = controller.Restore(ctx, "entity", "entity1")
+	assert.Equal(t, InvalidArgument, err.(Error).Code)
+}
+
+func TestTombstonesPermissions(t *testing.T) {
+	deleteCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeCheck, types.RulePermDelete),
+		),
+	)
+	readCtx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeCheck, types.RulePermRead),
+		),
+	)
+	st := newTombstoneTestStore(t)
+
+	require.NoError(t, st.UpdateCheckConfig(deleteCtx, types.FixtureCheckConfig("check1")))
+	checks := NewCheckController(st)
+	checks.Tombstones = &Tombstones{Store: st, Window: 60}
+	require.NoError(t, checks.Destroy(deleteCtx, "check1"))
+
+	controller := NewTombstoneController(st)
+
+	// The tombstones of the resources the viewer can't read are hidden
+	results, err := controller.Query(deleteCtx)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	results, err = controller.Query(readCtx)
+	require.NoError(t, err)
+	assert.Len(t, results, 1)
+
+	_, err = controller.Restore(readCtx, types.TombstoneKindCheck, "check1")
+	assert.Equal(t, PermissionDenied, err.(Error).Code)
+}
+
+func TestTombstonesDisabled(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeCheck, types.RulePermRead, types.RulePermDelete),
+		),
+	)
+	st := newTombstoneTestStore(t)
+
+	require.NoError(t, st.UpdateCheckConfig(ctx, types.FixtureCheckConfig("check1")))
+	checks := NewCheckController(st)
+	checks.Tombstones = &Tombstones{Store: st}
+	require.NoError(t, checks.Destroy(ctx, "check1"))
+
+	results, err := NewTombstoneController(st).Query(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
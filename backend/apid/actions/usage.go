@@ -0,0 +1,83 @@
+package actions
+
+import (
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/usage"
+	"github.com/sensu/sensu-go/types"
+	"golang.org/x/net/context"
+)
+
+// UsageController exposes the event throughput of the checks the viewer has
+// access to.
+type UsageController struct {
+	Tracker *usage.Tracker
+	Policy  authorization.EventPolicy
+}
+
+// NewUsageController returns new UsageController
+func NewUsageController(tracker *usage.Tracker) UsageController {
+	return UsageController{
+		Tracker: tracker,
+		Policy:  authorization.Events,
+	}
+}
+
+// Query returns at most limit usage records, sorted in descending order of
+// the given criteria, of the checks whose events the viewer can read.
+func (a UsageController) Query(ctx context.Context, limit int, sortBy string) ([]types.Usage, error) {
+	results := []types.Usage{}
+	if a.Tracker != nil {
+		results = a.Tracker.Usage()
+	}
+
+	// Filter out those resources the viewer does not have access to view.
+	abilities := a.Policy.WithContext(ctx)
+	for i := 0; i < len(results); i++ {
+		if !abilities.CanReadIn(results[i].Organization, results[i].Environment) {
+			results = append(results[:i], results[i+1:]...)
+			i--
+		}
+	}
+
+	results, err := usage.Top(results, limit, sortBy)
+	if err != nil {
+		return nil, NewError(InvalidArgument, err)
+	}
+
+	return results, nil
+}
+
+// QueryResources returns at most limit resource usage records, sorted in
+// descending order of the given criteria, of the checks and handlers the
+// viewer can read the events of. They are filtered by kind, i.e.
+// usage.KindCheck or usage.KindHandler, unless it's empty.
+func (a UsageController) QueryResources(ctx context.Context, limit int, sortBy, kind string) ([]types.ResourceUsage, error) {
+	switch kind {
+	case "", usage.KindCheck, usage.KindHandler:
+	default:
+		return nil, NewErrorf(InvalidArgument, "invalid kind %q", kind)
+	}
+
+	results := []types.ResourceUsage{}
+	if a.Tracker != nil {
+		results = a.Tracker.Resources()
+	}
+
+	// Filter out those resources the viewer does not have access to view,
+	// and those of another kind.
+	abilities := a.Policy.WithContext(ctx)
+	for i := 0; i < len(results); i++ {
+		if (kind != "" && results[i].Kind != kind) ||
+			!abilities.CanReadIn(results[i].Organization, results[i].Environment) {
+			results = append(results[:i], results[i+1:]...)
+			i--
+		}
+	}
+
+	results, err := usage.TopResources(results, limit, sortBy)
+	if err != nil {
+		return nil, NewError(InvalidArgument, err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,82 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/usage"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageQuery(t *testing.T) {
+	tracker := usage.NewTracker()
+	tracker.Record("acme", "prod", "check1", 10)
+	tracker.Record("acme", "prod", "check1", 10)
+	tracker.Record("acme", "prod", "check2", 10)
+	tracker.Record("other", "prod", "check1", 10)
+
+	rule := types.FixtureRule("acme", "*")
+	rule.Type = types.RuleTypeEvent
+	ctx := testutil.NewContext(testutil.ContextWithRules(*rule))
+
+	controller := NewUsageController(tracker)
+
+	// Only the usage of the accessible organization is returned
+	results, err := controller.Query(ctx, 0, usage.SortByEvents)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "check1", results[0].Check)
+	assert.Equal(t, uint64(2), results[0].Events)
+
+	results, err = controller.Query(ctx, 1, usage.SortByEvents)
+	require.NoError(t, err)
+	assert.Len(t, results, 1)
+
+	// No access at all
+	ctx = testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermCreate),
+	))
+	results, err = controller.Query(ctx, 0, usage.SortByEvents)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	// Invalid sort criteria
+	_, err = controller.Query(ctx, 0, "foo")
+	assert.Error(t, err)
+	actionErr, ok := err.(Error)
+	require.True(t, ok)
+	assert.Equal(t, InvalidArgument, actionErr.Code)
+}
+
+func TestUsageQueryResources(t *testing.T) {
+	tracker := usage.NewTracker()
+	tracker.RecordExecution("acme", "prod", usage.KindCheck, "check1", 1, 2, 100)
+	tracker.RecordExecution("acme", "prod", usage.KindHandler, "handler1", 2, 2, 100)
+	tracker.RecordExecution("other", "prod", usage.KindCheck, "check1", 3, 2, 100)
+
+	rule := types.FixtureRule("acme", "*")
+	rule.Type = types.RuleTypeEvent
+	ctx := testutil.NewContext(testutil.ContextWithRules(*rule))
+
+	controller := NewUsageController(tracker)
+
+	// Only the usage of the accessible organization is returned
+	results, err := controller.QueryResources(ctx, 0, usage.SortByCPU, "")
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "handler1", results[0].Name)
+
+	// Filtered by kind
+	results, err = controller.QueryResources(ctx, 0, usage.SortByCPU, usage.KindCheck)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "check1", results[0].Name)
+
+	// Invalid kind and sort criteria
+	_, err = controller.QueryResources(ctx, 0, usage.SortByCPU, "foo")
+	assert.Error(t, err)
+	_, err = controller.QueryResources(ctx, 0, "foo", "")
+	assert.Error(t, err)
+}
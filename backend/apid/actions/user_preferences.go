@@ -0,0 +1,101 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// UserPreferencesController exposes the preferences of the users, which each
+// user can manage for their own account.
+type UserPreferencesController struct {
+	Store interface {
+		store.UserStore
+		store.UserPreferencesStore
+	}
+	Policy authorization.UserPolicy
+}
+
+// NewUserPreferencesController returns new UserPreferencesController
+func NewUserPreferencesController(store store.Store) UserPreferencesController {
+	return UserPreferencesController{
+		Store:  store,
+		Policy: authorization.Users,
+	}
+}
+
+// Find returns the preferences of the given user if available to the viewer.
+// The preferences of a user who has none are empty.
+func (c UserPreferencesController) Find(ctx context.Context, username string) (*types.UserPreferences, error) {
+	abilities := c.Policy.WithContext(ctx)
+	if !abilities.CanReadPreferences(&types.UserPreferences{Username: username}) {
+		return nil, NewErrorf(NotFound)
+	}
+
+	if err := c.findUser(ctx, username); err != nil {
+		return nil, err
+	}
+
+	preferences, err := c.Store.GetUserPreferences(ctx, username)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+	if preferences == nil {
+		preferences = &types.UserPreferences{Username: username}
+	}
+
+	return preferences, nil
+}
+
+// Update replaces the preferences of a user if the viewer has access.
+func (c UserPreferencesController) Update(ctx context.Context, preferences types.UserPreferences) error {
+	abilities := c.Policy.WithContext(ctx)
+	if !abilities.CanUpdatePreferences(&preferences) {
+		return NewErrorf(PermissionDenied)
+	}
+
+	if err := preferences.Validate(); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	if err := c.findUser(ctx, preferences.Username); err != nil {
+		return err
+	}
+
+	if err := c.Store.UpdateUserPreferences(ctx, &preferences); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// Destroy resets the preferences of a user if the viewer has access.
+func (c UserPreferencesController) Destroy(ctx context.Context, username string) error {
+	abilities := c.Policy.WithContext(ctx)
+	if !abilities.CanUpdatePreferences(&types.UserPreferences{Username: username}) {
+		return NewErrorf(PermissionDenied)
+	}
+
+	if err := c.findUser(ctx, username); err != nil {
+		return err
+	}
+
+	if err := c.Store.DeleteUserPreferences(ctx, username); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
+
+// findUser returns a NotFound error if the user doesn't exist.
+func (c UserPreferencesController) findUser(ctx context.Context, username string) error {
+	user, err := c.Store.GetUser(ctx, username)
+	if err != nil {
+		return NewError(InternalErr, err)
+	} else if user == nil {
+		return NewErrorf(NotFound)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserPreferencesFind(t *testing.T) {
+	selfCtx := testutil.NewContext(testutil.ContextWithActor("foo"))
+	otherCtx := testutil.NewContext(testutil.ContextWithActor("bar"))
+	adminCtx := testutil.NewContext(testutil.ContextWithActor("admin",
+		types.FixtureRuleWithPerms(types.RuleTypeUser, types.RulePermRead),
+	))
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		username        string
+		storedUser      *types.User
+		storedPrefs     *types.UserPreferences
+		storeErr        error
+		expectedPrefs   *types.UserPreferences
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:          "Own preferences",
+			ctx:           selfCtx,
+			username:      "foo",
+			storedUser:    types.FixtureUser("foo"),
+			storedPrefs:   types.FixtureUserPreferences("foo"),
+			expectedPrefs: types.FixtureUserPreferences("foo"),
+		},
+		{
+			name:          "No preferences",
+			ctx:           selfCtx,
+			username:      "foo",
+			storedUser:    types.FixtureUser("foo"),
+			expectedPrefs: &types.UserPreferences{Username: "foo"},
+		},
+		{
+			name:          "Preferences of another user with read access",
+			ctx:           adminCtx,
+			username:      "foo",
+			storedUser:    types.FixtureUser("foo"),
+			storedPrefs:   types.FixtureUserPreferences("foo"),
+			expectedPrefs: types.FixtureUserPreferences("foo"),
+		},
+		{
+			name:            "Preferences of another user",
+			ctx:             otherCtx,
+			username:        "foo",
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "Missing user",
+			ctx:             selfCtx,
+			username:        "foo",
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "Store error",
+			ctx:             selfCtx,
+			username:        "foo",
+			storedUser:      types.FixtureUser("foo"),
+			storeErr:        errors.New("error"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			store.On("GetUser", mock.Anything, tc.username).Return(tc.storedUser, nil)
+			store.On("GetUserPreferences", mock.Anything, tc.username).Return(tc.storedPrefs, tc.storeErr)
+			ctl := NewUserPreferencesController(store)
+
+			preferences, err := ctl.Find(tc.ctx, tc.username)
+			if tc.expectedErr {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErrCode, err.(Error).Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPrefs, preferences)
+		})
+	}
+}
+
+func TestUserPreferencesUpdate(t *testing.T) {
+	selfCtx := testutil.NewContext(testutil.ContextWithActor("foo"))
+	otherCtx := testutil.NewContext(testutil.ContextWithActor("bar",
+		types.FixtureRuleWithPerms(types.RuleTypeUser, types.RulePermRead),
+	))
+
+	invalid := types.FixtureUserPreferences("foo")
+	invalid.SavedSearches[0].Filter = ""
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		argument        *types.UserPreferences
+		storedUser      *types.User
+		updateErr       error
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:       "Updated",
+			ctx:        selfCtx,
+			argument:   types.FixtureUserPreferences("foo"),
+			storedUser: types.FixtureUser("foo"),
+		},
+		{
+			name:            "Preferences of another user",
+			ctx:             otherCtx,
+			argument:        types.FixtureUserPreferences("foo"),
+			storedUser:      types.FixtureUser("foo"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Invalid preferences",
+			ctx:             selfCtx,
+			argument:        invalid,
+			storedUser:      types.FixtureUser("foo"),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Missing user",
+			ctx:             selfCtx,
+			argument:        types.FixtureUserPreferences("foo"),
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "Store error",
+			ctx:             selfCtx,
+			argument:        types.FixtureUserPreferences("foo"),
+			storedUser:      types.FixtureUser("foo"),
+			updateErr:       errors.New("error"),
+			expectedErr:     true,
+			expectedErrCode: InternalErr,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			store.On("GetUser", mock.Anything, "foo").Return(tc.storedUser, nil)
+			store.On("UpdateUserPreferences", mock.Anything).Return(tc.updateErr)
+			ctl := NewUserPreferencesController(store)
+
+			err := ctl.Update(tc.ctx, *tc.argument)
+			if tc.expectedErr {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErrCode, err.(Error).Code)
+				return
+			}
+			require.NoError(t, err)
+			store.AssertCalled(t, "UpdateUserPreferences", tc.argument)
+		})
+	}
+}
+
+func TestUserPreferencesDestroy(t *testing.T) {
+	store := &mockstore.MockStore{}
+	store.On("GetUser", mock.Anything, "foo").Return(types.FixtureUser("foo"), nil)
+	store.On("DeleteUserPreferences", mock.Anything, "foo").Return(nil)
+	ctl := NewUserPreferencesController(store)
+
+	ctx := testutil.NewContext(testutil.ContextWithActor("bar"))
+	assert.Error(t, ctl.Destroy(ctx, "foo"))
+	store.AssertNotCalled(t, "DeleteUserPreferences", mock.Anything, "foo")
+
+	ctx = testutil.NewContext(testutil.ContextWithActor("foo"))
+	assert.NoError(t, ctl.Destroy(ctx, "foo"))
+	store.AssertCalled(t, "DeleteUserPreferences", mock.Anything, "foo")
+}
@@ -0,0 +1,81 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// UserTokensStore specifies the storage requirements of the
+// UserTokensController.
+type UserTokensStore interface {
+	store.UserStore
+	store.TokenStore
+}
+
+// UserTokensController mints short-lived access tokens, scoped to a subset of
+// the permissions of the user who requests them.
+type UserTokensController struct {
+	Store  UserTokensStore
+	Policy authorization.UserPolicy
+}
+
+// NewUserTokensController returns new UserTokensController
+func NewUserTokensController(store UserTokensStore) UserTokensController {
+	return UserTokensController{
+		Store:  store,
+		Policy: authorization.Users,
+	}
+}
+
+// Create mints a token for the user, which only grants the permissions of the
+// request granted to the user, until it expires. Users can only mint tokens
+// for themselves, and can't mint tokens while impersonating another user.
+func (c UserTokensController) Create(ctx context.Context, username string, req types.ScopedTokenRequest) (*types.Tokens, error) {
+	user, err := c.Store.GetUser(ctx, username)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	} else if user == nil || user.Disabled {
+		return nil, NewErrorf(NotFound)
+	}
+
+	abilities := c.Policy.WithContext(ctx)
+	if !abilities.CanCreateToken(user) {
+		return nil, NewErrorf(PermissionDenied)
+	}
+
+	if claims := jwt.GetClaimsFromContext(ctx); claims != nil && claims.Subject != username {
+		return nil, NewErrorf(PermissionDenied, "tokens can't be minted for an impersonated user")
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, NewError(InvalidArgument, err)
+	}
+
+	// The token can't grant more than the permissions of the user
+	actor := abilities.Context().Actor
+	for _, rule := range req.Rules {
+		if !actor.Grants(rule) {
+			return nil, NewErrorf(PermissionDenied, "the rules of the token must be granted to the user")
+		}
+	}
+
+	token, tokenString, err := jwt.ScopedAccessToken(username, user.Groups, req.Rules, req.Duration())
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+	claims, _ := token.Claims.(*types.Claims)
+
+	// Store the token in the access list, until it expires
+	if err := c.Store.CreateToken(claims); err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	return &types.Tokens{
+		Access:    tokenString,
+		ExpiresAt: claims.ExpiresAt,
+	}, nil
+}
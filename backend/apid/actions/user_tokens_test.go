@@ -0,0 +1,134 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserTokensCreate(t *testing.T) {
+	readEvents := types.Rule{
+		Type:         types.RuleTypeEvent,
+		Organization: "default",
+		Environment:  "default",
+		Permissions:  []string{types.RulePermRead},
+	}
+	deleteEvents := readEvents
+	deleteEvents.Permissions = []string{types.RulePermDelete}
+
+	selfCtx := testutil.NewContext(testutil.ContextWithActor("foo",
+		types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead),
+	))
+	otherCtx := testutil.NewContext(testutil.ContextWithActor("bar", *types.FixtureRule("*", "*")))
+	scopedCtx := context.WithValue(context.Background(), types.AuthorizationActorKey, authorization.Actor{
+		Name:  "foo",
+		Rules: []types.Rule{types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead)},
+		Scope: []types.Rule{readEvents},
+	})
+	impersonatingCtx := context.WithValue(selfCtx, types.ClaimsKey, &types.Claims{
+		StandardClaims: jwt.StandardClaims{Subject: "bar"},
+	})
+
+	disabled := types.FixtureUser("foo")
+	disabled.Disabled = true
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		req             types.ScopedTokenRequest
+		storedUser      *types.User
+		expectedErr     bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:       "Created",
+			ctx:        selfCtx,
+			req:        types.ScopedTokenRequest{Rules: []types.Rule{readEvents}, TTL: 600},
+			storedUser: types.FixtureUser("foo"),
+		},
+		{
+			name:            "Token of another user",
+			ctx:             otherCtx,
+			req:             types.ScopedTokenRequest{Rules: []types.Rule{readEvents}},
+			storedUser:      types.FixtureUser("foo"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Minted with a scoped token",
+			ctx:             scopedCtx,
+			req:             types.ScopedTokenRequest{Rules: []types.Rule{readEvents}},
+			storedUser:      types.FixtureUser("foo"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Impersonated user",
+			ctx:             impersonatingCtx,
+			req:             types.ScopedTokenRequest{Rules: []types.Rule{readEvents}},
+			storedUser:      types.FixtureUser("foo"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Permissions not granted to the user",
+			ctx:             selfCtx,
+			req:             types.ScopedTokenRequest{Rules: []types.Rule{deleteEvents}},
+			storedUser:      types.FixtureUser("foo"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
+		{
+			name:            "Invalid request",
+			ctx:             selfCtx,
+			req:             types.ScopedTokenRequest{},
+			storedUser:      types.FixtureUser("foo"),
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Disabled user",
+			ctx:             selfCtx,
+			req:             types.ScopedTokenRequest{Rules: []types.Rule{readEvents}},
+			storedUser:      disabled,
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+		{
+			name:            "Missing user",
+			ctx:             selfCtx,
+			req:             types.ScopedTokenRequest{Rules: []types.Rule{readEvents}},
+			expectedErr:     true,
+			expectedErrCode: NotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			store.On("GetUser", mock.Anything, "foo").Return(tc.storedUser, nil)
+			store.On("CreateToken", mock.Anything).Return(nil)
+			ctl := NewUserTokensController(store)
+
+			tokens, err := ctl.Create(tc.ctx, "foo", tc.req)
+			if tc.expectedErr {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErrCode, err.(Error).Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, tokens.Access)
+			assert.Empty(t, tokens.Refresh)
+			assert.NotZero(t, tokens.ExpiresAt)
+			store.AssertCalled(t, "CreateToken", mock.Anything)
+		})
+	}
+}
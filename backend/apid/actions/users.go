@@ -139,6 +139,14 @@ func (a UserController) Update(ctx context.Context, given types.User) error {
 		}
 	}
 
+	// Copy new groups, if given
+	if given.Groups != nil {
+		user.Groups = given.Groups
+		if yes := abilities.CanUpdate(user); !yes {
+			return NewErrorf(PermissionDenied)
+		}
+	}
+
 	// Persist Changes
 	return a.updateUser(ctx, user)
 }
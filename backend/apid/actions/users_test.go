@@ -365,6 +365,14 @@ func TestUserUpdate(t *testing.T) {
 			fetchResult: types.FixtureUser("user1"),
 			expectedErr: false,
 		},
+		{
+			name:            "Cannot Set Own Groups",
+			ctx:             testutil.NewContext(testutil.ContextWithActor("user1")),
+			argument:        &types.User{Username: "user1", Groups: []string{"admins"}},
+			fetchResult:     types.FixtureUser("user1"),
+			expectedErr:     true,
+			expectedErrCode: PermissionDenied,
+		},
 		{
 			name:            "Validation Error",
 			ctx:             defaultCtx,
@@ -22,3 +22,21 @@ func copyFields(target interface{}, source interface{}, fields ...string) {
 		t.FieldByName(f).Set(s.FieldByName(f))
 	}
 }
+
+// persistResource validates a resource and stores it with the given update
+// function, wrapping their errors in the matching action errors.
+func persistResource(
+	ctx context.Context,
+	record types.Resource,
+	update func(context.Context) error,
+) error {
+	if err := record.Validate(); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	if err := update(ctx); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
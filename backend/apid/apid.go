@@ -2,6 +2,7 @@ package apid
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,10 +15,13 @@ import (
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/apid/middlewares"
 	"github.com/sensu/sensu-go/backend/apid/routers"
+	"github.com/sensu/sensu-go/backend/federation"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/queue"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/usage"
 	"github.com/sensu/sensu-go/types"
+	"go.opencensus.io/plugin/ochttp"
 )
 
 // QueueStore contains store and queue interfaces.
@@ -36,10 +40,45 @@ type APId struct {
 	MessageBus messaging.MessageBus
 
 	BackendStatus func() types.StatusMap
+	StoreHealthy  func() bool
 	Host          string
 	Port          int
 	Store         QueueStore
-	TLS           *types.TLSOptions
+	Usage         *usage.Tracker
+
+	// TLSConfig is the configuration of the TLS listener, which serves the
+	// API over plain http when nil
+	TLSConfig *tls.Config
+
+	// ReadOnly indicates that the API is served by a read-only replica, which
+	// rejects the write requests
+	ReadOnly bool
+
+	// Draining reports whether the backend is shutting down, in which case
+	// the requests are rejected and the clients told to retry them after
+	// DrainRetryAfter
+	Draining        func() bool
+	DrainRetryAfter time.Duration
+
+	// Federation is the gateway to the remote clusters aggregated by the
+	// GraphQL API, if any
+	Federation *federation.Gateway
+
+	// AnonymousUser is the user the unauthenticated requests are made as, in
+	// the development mode. They are rejected if it's empty
+	AnonymousUser string
+
+	// TombstoneWindow is the number of seconds the checks, handlers and
+	// silenced entries deleted through the API can be restored, none being
+	// kept if it's zero
+	TombstoneWindow int64
+
+	// GraphQL configures the queries served by the GraphQL API
+	GraphQL routers.GraphQLOptions
+
+	// Slack configures the Slack slash command endpoint, which is not served
+	// if nil
+	Slack *routers.SlackOptions
 }
 
 func notFoundHandler(w http.ResponseWriter, req *http.Request) {
@@ -70,11 +109,20 @@ func (a *APId) Start() error {
 	router.NotFoundHandler = http.HandlerFunc(notFoundHandler)
 	registerUnauthenticatedResources(router, a.BackendStatus)
 	registerAuthenticationResources(router, a.Store)
-	registerRestrictedResources(router, a.Store, a.MessageBus)
+	if a.Slack != nil {
+		registerSlackResources(router, a.Store, a.MessageBus, a.StoreHealthy, a.ReadOnly, a.Slack)
+	}
+	registerRestrictedResources(router, a.Store, a.MessageBus, a.StoreHealthy, a.ReadOnly, a.Usage, a.Federation, a.AnonymousUser, a.TombstoneWindow, a.GraphQL)
+
+	// Every request is rejected while the backend is shutting down
+	handler := middlewares.Draining{
+		Draining:   a.Draining,
+		RetryAfter: a.DrainRetryAfter,
+	}.Then(router)
 
 	a.httpServer = &http.Server{
 		Addr:         fmt.Sprintf("%s:%d", a.Host, a.Port),
-		Handler:      router,
+		Handler:      &ochttp.Handler{Handler: handler},
 		WriteTimeout: 15 * time.Second,
 		ReadTimeout:  15 * time.Second,
 	}
@@ -85,8 +133,9 @@ func (a *APId) Start() error {
 	go func() {
 		defer a.wg.Done()
 		var err error
-		if a.TLS != nil {
-			err = a.httpServer.ListenAndServeTLS(a.TLS.CertFile, a.TLS.KeyFile)
+		if a.TLSConfig != nil {
+			a.httpServer.TLSConfig = a.TLSConfig
+			err = a.httpServer.ListenAndServeTLS("", "")
 		} else {
 			err = a.httpServer.ListenAndServe()
 		}
@@ -135,10 +184,13 @@ func registerUnauthenticatedResources(
 	mountRouters(
 		NewSubrouter(
 			router.NewRoute(),
+			middlewares.RequestID{},
 			middlewares.SimpleLogger{},
 			middlewares.LimitRequest{},
 		),
 		routers.NewStatusRouter(bStatus),
+		routers.NewMetricsRouter(),
+		routers.NewOpenAPIRouter(router),
 	)
 }
 
@@ -146,38 +198,89 @@ func registerAuthenticationResources(router *mux.Router, store store.Store) {
 	mountRouters(
 		NewSubrouter(
 			router.NewRoute(),
+			middlewares.RequestID{},
 			middlewares.SimpleLogger{},
 			middlewares.RefreshToken{},
 			middlewares.LimitRequest{},
 		),
 		routers.NewAuthenticationRouter(store),
 	)
+
+	// The dashboard sessions are authenticated by a cookie rather than by the
+	// tokens provided to the authentication routes
+	mountRouters(
+		NewSubrouter(
+			router.NewRoute(),
+			middlewares.RequestID{},
+			middlewares.SimpleLogger{},
+			middlewares.LimitRequest{},
+		),
+		routers.NewSessionRouter(store),
+	)
 }
 
-func registerRestrictedResources(router *mux.Router, store QueueStore, bus messaging.MessageBus) {
+// registerSlackResources mounts the Slack slash command endpoint, whose
+// requests are authenticated by their signature rather than by a token.
+func registerSlackResources(router *mux.Router, store QueueStore, bus messaging.MessageBus, storeHealthy func() bool, readOnly bool, slack *routers.SlackOptions) {
+	mountRouters(
+		NewSubrouter(
+			router.NewRoute(),
+			middlewares.RequestID{},
+			middlewares.SimpleLogger{},
+			middlewares.ReadOnly{StoreHealthy: storeHealthy, Replica: readOnly},
+			middlewares.Environment{Store: store},
+			middlewares.SlackAuthentication{
+				SigningSecret: slack.SigningSecret,
+				Users:         slack.Users,
+				Store:         store,
+			},
+			middlewares.Authorization{Store: store},
+			middlewares.LimitRequest{},
+		),
+		routers.NewSlackRouter(store, bus),
+	)
+}
+
+func registerRestrictedResources(router *mux.Router, st QueueStore, bus messaging.MessageBus, storeHealthy func() bool, readOnly bool, tracker *usage.Tracker, gateway *federation.Gateway, anonymousUser string, tombstoneWindow int64, graphQL routers.GraphQLOptions) {
+	// The cached GraphQL responses are dropped on the writes through the API
+	graphQLCache := routers.NewGraphQLCache(routers.DefaultGraphQLCacheTTL)
+	store := routers.NewGraphQLCacheStore(st, graphQLCache)
+	tombstones := &actions.Tombstones{Store: store, Window: tombstoneWindow}
+
 	mountRouters(
 		NewSubrouter(
 			router.NewRoute(),
+			middlewares.RequestID{},
 			middlewares.SimpleLogger{},
+			middlewares.ReadOnly{StoreHealthy: storeHealthy, Replica: readOnly},
 			middlewares.Environment{Store: store},
-			middlewares.Authentication{},
-			middlewares.AllowList{Store: store},
+			middlewares.Authentication{Anonymous: anonymousUser},
+			middlewares.AllowList{Store: store, Anonymous: anonymousUser},
 			middlewares.Authorization{Store: store},
 			middlewares.LimitRequest{},
 		),
 		routers.NewAssetRouter(store),
-		routers.NewChecksRouter(store),
-		routers.NewEntitiesRouter(store),
+		routers.NewChecksRouter(store, tombstones),
+		routers.NewCheckTemplatesRouter(store),
+		routers.NewClusterConfigRouter(store),
+		routers.NewEntitiesRouter(store, bus),
+		routers.NewEntityGroupsRouter(store),
 		routers.NewEnvironmentsRouter(store),
 		routers.NewEventFiltersRouter(store),
 		routers.NewEventsRouter(store, bus),
-		routers.NewGraphQLRouter(store),
-		routers.NewHandlersRouter(store),
+		routers.NewGraphQLRouter(store, graphQLCache, gateway, graphQL),
+		routers.NewHandlersRouter(store, tombstones),
 		routers.NewHooksRouter(store),
+		routers.NewIncidentsRouter(store),
 		routers.NewMutatorsRouter(store),
 		routers.NewOrganizationsRouter(store),
+		routers.NewPipelinesRouter(store),
 		routers.NewRolesRouter(store),
-		routers.NewSilencedRouter(store),
+		routers.NewRoleBindingsRouter(store),
+		routers.NewSilencedRouter(store, tombstones),
+		routers.NewTessenRouter(store),
+		routers.NewTombstonesRouter(store),
+		routers.NewUsageRouter(tracker),
 		routers.NewUsersRouter(store),
 	)
 }
@@ -0,0 +1,66 @@
+package graphql
+
+import (
+	"github.com/sensu/sensu-go/backend/apid/graphql/relay"
+	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/federation"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/types"
+)
+
+var _ schema.ClusterFieldResolvers = (*clusterImpl)(nil)
+
+//
+// Implement ClusterFieldResolvers
+//
+
+type clusterImpl struct {
+	schema.ClusterAliases
+	gateway *federation.Gateway
+}
+
+func newClusterImpl(gateway *federation.Gateway) *clusterImpl {
+	return &clusterImpl{gateway: gateway}
+}
+
+// Events implements response to request for 'events' field.
+func (r *clusterImpl) Events(p schema.ClusterEventsFieldResolverParams) (interface{}, error) {
+	cluster := p.Source.(federation.Cluster)
+	records, err := r.gateway.Events(p.Context, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	// The remote events are queried by the service account of the federation,
+	// filter out those the viewer does not have access to view locally.
+	abilities := authorization.Events.WithContext(p.Context)
+	events := make([]*types.Event, 0, len(records))
+	for _, event := range records {
+		if abilities.CanRead(event) {
+			events = append(events, event)
+		}
+	}
+
+	filteredEvents, err := filterEvents(events, p.Args.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	info := relay.NewArrayConnectionInfo(
+		0, len(filteredEvents),
+		p.Args.First, p.Args.Last, p.Args.Before, p.Args.After,
+	)
+
+	edges := make([]*relay.Edge, info.End-info.Begin)
+	for i, r := range filteredEvents[info.Begin:info.End] {
+		edges[i] = relay.NewArrayConnectionEdge(r, i)
+	}
+	return relay.NewArrayConnection(edges, info), nil
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*clusterImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(federation.Cluster)
+	return ok
+}
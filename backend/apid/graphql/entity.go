@@ -1,6 +1,8 @@
 package graphql
 
 import (
+	"context"
+
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/apid/graphql/globalid"
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
@@ -10,6 +12,7 @@ import (
 )
 
 var _ schema.EntityFieldResolvers = (*entityImpl)(nil)
+var _ schema.EntityStatusFieldResolvers = (*entityStatusImpl)(nil)
 var _ schema.SystemFieldResolvers = (*systemImpl)(nil)
 var _ schema.NetworkFieldResolvers = (*networkImpl)(nil)
 var _ schema.NetworkInterfaceFieldResolvers = (*networkInterfaceImpl)(nil)
@@ -21,12 +24,14 @@ var _ schema.DeregistrationFieldResolvers = (*deregistrationImpl)(nil)
 
 type entityImpl struct {
 	schema.EntityAliases
-	userCtrl actions.UserController
+	entityCtrl actions.EntityController
+	userCtrl   actions.UserController
 }
 
 func newEntityImpl(store store.Store) *entityImpl {
+	entityCtrl := actions.NewEntityController(store)
 	userCtrl := actions.NewUserController(store)
-	return &entityImpl{userCtrl: userCtrl}
+	return &entityImpl{entityCtrl: entityCtrl, userCtrl: userCtrl}
 }
 
 // ID implements response to request for 'id' field.
@@ -58,12 +63,35 @@ func (r *entityImpl) Author(p graphql.ResolveParams) (interface{}, error) {
 	return handleControllerResults(user, err)
 }
 
+// Status implements response to request for 'status' field.
+func (r *entityImpl) Status(p graphql.ResolveParams) (interface{}, error) {
+	entity := p.Source.(*types.Entity)
+	ctx := context.WithValue(p.Context, types.OrganizationKey, entity.Organization)
+	ctx = context.WithValue(ctx, types.EnvironmentKey, entity.Environment)
+	status, err := r.entityCtrl.FindStatus(ctx, entity.ID)
+	return handleControllerResults(status, err)
+}
+
 // IsTypeOf is used to determine if a given value is associated with the type
 func (*entityImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
 	_, ok := s.(*types.Entity)
 	return ok
 }
 
+//
+// Implement EntityStatusFieldResolvers
+//
+
+type entityStatusImpl struct {
+	schema.EntityStatusAliases
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*entityStatusImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(*types.EntityStatus)
+	return ok
+}
+
 //
 // Implement SystemFieldResolvers
 //
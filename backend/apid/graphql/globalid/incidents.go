@@ -0,0 +1,23 @@
+package globalid
+
+import "github.com/sensu/sensu-go/types"
+
+//
+// Incidents
+//
+
+var incidentName = "incidents"
+
+// IncidentTranslator global ID resource
+var IncidentTranslator = commonTranslator{
+	name:       incidentName,
+	encodeFunc: standardEncoder(incidentName, "ID"),
+	decodeFunc: standardDecoder,
+	isResponsibleFunc: func(record interface{}) bool {
+		_, ok := record.(*types.Incident)
+		return ok
+	},
+}
+
+// Register incident encoder/decoder
+func init() { registerTranslator(IncidentTranslator) }
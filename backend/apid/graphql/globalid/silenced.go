@@ -0,0 +1,48 @@
+package globalid
+
+import (
+	"encoding/base64"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+//
+// Silenced
+//
+
+const silencedName = "silenced"
+
+// SilencedComponents adds methods to easily access unique elements of
+// silenced entries.
+type SilencedComponents struct{ StandardComponents }
+
+// newSilencedComponents instantiates new SilencedComponents composite.
+func newSilencedComponents(components StandardComponents) Components {
+	return SilencedComponents{components}
+}
+
+// SilencedID returns the ID of the silenced entry (subscription:checkname),
+// which is encoded since it contains the separator of the global ID.
+func (n SilencedComponents) SilencedID() string {
+	id, _ := base64.URLEncoding.DecodeString(n.uniqueComponent)
+	return string(id)
+}
+
+// SilencedTranslator global ID resource
+var SilencedTranslator = commonTranslator{
+	name:       silencedName,
+	decodeFunc: newSilencedComponents,
+	encodeFunc: func(record interface{}) Components {
+		silenced := record.(*types.Silenced)
+		components := newComponentsWith(silencedName, base64.URLEncoding.EncodeToString([]byte(silenced.ID)))
+		addMultitenantFields(&components, silenced)
+		return components
+	},
+	isResponsibleFunc: func(record interface{}) bool {
+		_, ok := record.(*types.Silenced)
+		return ok
+	},
+}
+
+// Register silenced encoder/decoder
+func init() { registerTranslator(SilencedTranslator) }
@@ -0,0 +1,28 @@
+package globalid
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSilencedTranslator(t *testing.T) {
+	assert := assert.New(t)
+
+	silenced := types.FixtureSilenced("*:check-cpu")
+	silenced.Organization = "default"
+	silenced.Environment = "default"
+	gid := SilencedTranslator.EncodeToString(silenced)
+
+	components, err := Decode(gid)
+	require.NoError(t, err)
+	assert.Equal("silenced", components.Resource())
+	assert.Equal("default", components.Organization())
+	assert.Equal("default", components.Environment())
+
+	silencedComponents, ok := components.(SilencedComponents)
+	require.True(t, ok)
+	assert.Equal("*:check-cpu", silencedComponents.SilencedID())
+}
@@ -72,7 +72,7 @@ func (r *handlerImpl) Handlers(p graphql.ResolveParams) (interface{}, error) {
 
 // IsTypeOf is used to determine if a given value is associated with the type
 func (*handlerImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
-	_, ok := s.(*types.Entity)
+	_, ok := s.(*types.Handler)
 	return ok
 }
 
@@ -0,0 +1,99 @@
+package graphql
+
+import (
+	"time"
+
+	"github.com/sensu/sensu-go/backend/apid/graphql/globalid"
+	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/types"
+)
+
+var _ schema.IncidentFieldResolvers = (*incidentImpl)(nil)
+var _ schema.IncidentEventFieldResolvers = (*incidentEventImpl)(nil)
+
+//
+// Implement IncidentFieldResolvers
+//
+
+type incidentImpl struct {
+	schema.IncidentAliases
+}
+
+// ID implements response to request for 'id' field.
+func (*incidentImpl) ID(p graphql.ResolveParams) (interface{}, error) {
+	return globalid.IncidentTranslator.EncodeToString(p.Source), nil
+}
+
+// Namespace implements response to request for 'namespace' field.
+func (*incidentImpl) Namespace(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source, nil
+}
+
+// StoreID implements response to request for 'storeId' field.
+func (*incidentImpl) StoreID(p graphql.ResolveParams) (string, error) {
+	incident := p.Source.(*types.Incident)
+	return incident.ID, nil
+}
+
+// Status implements response to request for 'status' field.
+func (*incidentImpl) Status(p graphql.ResolveParams) (int, error) {
+	incident := p.Source.(*types.Incident)
+	return int(incident.Status), nil
+}
+
+// Events implements response to request for 'events' field.
+func (*incidentImpl) Events(p graphql.ResolveParams) (interface{}, error) {
+	incident := p.Source.(*types.Incident)
+	return incident.Events, nil
+}
+
+// StartedAt implements response to request for 'startedAt' field.
+func (*incidentImpl) StartedAt(p graphql.ResolveParams) (time.Time, error) {
+	incident := p.Source.(*types.Incident)
+	return time.Unix(incident.StartedAt, 0), nil
+}
+
+// UpdatedAt implements response to request for 'updatedAt' field.
+func (*incidentImpl) UpdatedAt(p graphql.ResolveParams) (time.Time, error) {
+	incident := p.Source.(*types.Incident)
+	return time.Unix(incident.UpdatedAt, 0), nil
+}
+
+// ResolvedAt implements response to request for 'resolvedAt' field.
+func (*incidentImpl) ResolvedAt(p graphql.ResolveParams) (time.Time, error) {
+	incident := p.Source.(*types.Incident)
+	return time.Unix(incident.ResolvedAt, 0), nil
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*incidentImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(*types.Incident)
+	return ok
+}
+
+//
+// Implement IncidentEventFieldResolvers
+//
+
+type incidentEventImpl struct {
+	schema.IncidentEventAliases
+}
+
+// Status implements response to request for 'status' field.
+func (*incidentEventImpl) Status(p graphql.ResolveParams) (int, error) {
+	event := p.Source.(types.IncidentEvent)
+	return int(event.Status), nil
+}
+
+// Timestamp implements response to request for 'timestamp' field.
+func (*incidentEventImpl) Timestamp(p graphql.ResolveParams) (time.Time, error) {
+	event := p.Source.(types.IncidentEvent)
+	return time.Unix(event.Timestamp, 0), nil
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*incidentEventImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(types.IncidentEvent)
+	return ok
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/apid/graphql/globalid"
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/authorization"
 	"github.com/sensu/sensu-go/graphql"
 	"github.com/sensu/sensu-go/types"
 )
@@ -15,12 +16,14 @@ var _ schema.MutationFieldResolvers = (*mutationsImpl)(nil)
 //
 
 type mutationsImpl struct {
-	checkController actions.CheckController
+	checkController       actions.CheckController
+	preferencesController actions.UserPreferencesController
 }
 
 func newMutationImpl(store QueueStore) *mutationsImpl {
 	return &mutationsImpl{
-		checkController: actions.NewCheckController(store),
+		checkController:       actions.NewCheckController(store),
+		preferencesController: actions.NewUserPreferencesController(store),
 	}
 }
 
@@ -99,6 +102,49 @@ func copyCheckInputs(r *types.CheckConfig, ins *schema.CheckConfigInputs) {
 	r.Publish = ins.Publish
 }
 
+//
+// Implement preferences mutations
+//
+
+// UpdatePreferences implements response to request for the
+// 'updatePreferences' field.
+func (r *mutationsImpl) UpdatePreferences(p schema.MutationUpdatePreferencesFieldResolverParams) (interface{}, error) {
+	inputs := p.Args.Input
+	actor := p.Context.Value(types.AuthorizationActorKey).(authorization.Actor)
+
+	preferences := types.UserPreferences{
+		Username:            actor.Name,
+		DefaultOrganization: inputs.DefaultOrganization,
+		DefaultEnvironment:  inputs.DefaultEnvironment,
+	}
+	for _, search := range inputs.SavedSearches {
+		preferences.SavedSearches = append(preferences.SavedSearches, types.SavedSearch{
+			Name:         search.Name,
+			Filter:       search.Filter,
+			Organization: search.Organization,
+			Environment:  search.Environment,
+		})
+	}
+	for _, layout := range inputs.TableLayouts {
+		preferences.TableLayouts = append(preferences.TableLayouts, types.TableLayout{
+			Table:   layout.Table,
+			Columns: layout.Columns,
+		})
+	}
+
+	if err := r.preferencesController.Update(p.Context, preferences); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"clientMutationId": inputs.ClientMutationID,
+		"preferences":      &preferences,
+	}, nil
+}
+
+type preferencesMutationPayload struct {
+	schema.UpdatePreferencesPayloadAliases
+}
+
 type checkMutationPayload struct {
 	schema.CreateCheckPayloadAliases
 }
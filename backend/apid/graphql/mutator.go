@@ -1,6 +1,7 @@
 package graphql
 
 import (
+	"github.com/sensu/sensu-go/backend/apid/graphql/globalid"
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
 	"github.com/sensu/sensu-go/graphql"
 	"github.com/sensu/sensu-go/types"
@@ -16,6 +17,16 @@ type mutatorImpl struct {
 	schema.MutatorAliases
 }
 
+// ID implements response to request for 'id' field.
+func (*mutatorImpl) ID(p graphql.ResolveParams) (interface{}, error) {
+	return globalid.MutatorTranslator.EncodeToString(p.Source), nil
+}
+
+// Namespace implements response to request for 'namespace' field.
+func (*mutatorImpl) Namespace(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source, nil
+}
+
 // IsTypeOf is used to determine if a given value is associated with the type
 func (*mutatorImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
 	_, ok := s.(*types.Mutator)
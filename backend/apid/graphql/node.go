@@ -26,10 +26,15 @@ func newNodeResolver(store QueueStore) *nodeResolver {
 	registerAssetNodeResolver(register, store)
 	registerCheckNodeResolver(register, store)
 	registerEntityNodeResolver(register, store)
+	registerEnvironmentNodeResolver(register, store)
+	registerEventNodeResolver(register, store)
 	registerHandlerNodeResolver(register, store)
 	registerHookNodeResolver(register, store)
+	registerIncidentNodeResolver(register, store)
 	registerMutatorNodeResolver(register, store)
+	registerOrganizationNodeResolver(register, store)
 	registerRoleNodeResolver(register, store)
+	registerSilencedNodeResolver(register, store)
 	registerUserNodeResolver(register, store)
 
 	return &nodeResolver{register}
@@ -137,6 +142,55 @@ func (f *entityNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, err
 	return handleControllerResults(record, err)
 }
 
+// environments
+
+type environmentNodeResolver struct {
+	controller actions.EnvironmentController
+}
+
+func registerEnvironmentNodeResolver(register relay.NodeRegister, store store.EnvironmentStore) {
+	controller := actions.NewEnvironmentController(store)
+	resolver := &environmentNodeResolver{controller}
+	register.RegisterResolver(relay.NodeResolver{
+		ObjectType: schema.EnvironmentType,
+		Translator: globalid.EnvironmentTranslator,
+		Resolve:    resolver.fetch,
+	})
+}
+
+func (f *environmentNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, error) {
+	ctx := setContextFromComponents(p.Context, p.IDComponents)
+	record, err := f.controller.Find(ctx, p.IDComponents.Organization(), p.IDComponents.UniqueComponent())
+	return handleControllerResults(record, err)
+}
+
+// events
+
+type eventNodeResolver struct {
+	controller actions.EventController
+}
+
+func registerEventNodeResolver(register relay.NodeRegister, store store.EventStore) {
+	// Events are only read, so no message bus is required
+	controller := actions.NewEventController(store, nil)
+	resolver := &eventNodeResolver{controller}
+	register.RegisterResolver(relay.NodeResolver{
+		ObjectType: schema.EventType,
+		Translator: globalid.EventTranslator,
+		Resolve:    resolver.fetch,
+	})
+}
+
+func (f *eventNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, error) {
+	ctx := setContextFromComponents(p.Context, p.IDComponents)
+	components, ok := p.IDComponents.(globalid.EventComponents)
+	if !ok {
+		return nil, errors.New("given global ID is not an event's")
+	}
+	record, err := f.controller.Find(ctx, components.EntityName(), components.CheckName())
+	return handleControllerResults(record, err)
+}
+
 // handlers
 
 type handlerNodeResolver struct {
@@ -181,6 +235,28 @@ func (f *hookNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, error
 	return handleControllerResults(record, err)
 }
 
+// incidents
+
+type incidentNodeResolver struct {
+	controller actions.IncidentController
+}
+
+func registerIncidentNodeResolver(register relay.NodeRegister, store store.IncidentStore) {
+	controller := actions.NewIncidentController(store)
+	resolver := &incidentNodeResolver{controller}
+	register.RegisterResolver(relay.NodeResolver{
+		ObjectType: schema.IncidentType,
+		Translator: globalid.IncidentTranslator,
+		Resolve:    resolver.fetch,
+	})
+}
+
+func (f *incidentNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, error) {
+	ctx := setContextFromComponents(p.Context, p.IDComponents)
+	record, err := f.controller.Find(ctx, p.IDComponents.UniqueComponent())
+	return handleControllerResults(record, err)
+}
+
 // mutators
 
 type mutatorNodeResolver struct {
@@ -203,6 +279,27 @@ func (f *mutatorNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, er
 	return handleControllerResults(record, err)
 }
 
+// organizations
+
+type organizationNodeResolver struct {
+	controller actions.OrganizationsController
+}
+
+func registerOrganizationNodeResolver(register relay.NodeRegister, store store.OrganizationStore) {
+	controller := actions.NewOrganizationsController(store)
+	resolver := &organizationNodeResolver{controller}
+	register.RegisterResolver(relay.NodeResolver{
+		ObjectType: schema.OrganizationType,
+		Translator: globalid.OrganizationTranslator,
+		Resolve:    resolver.fetch,
+	})
+}
+
+func (f *organizationNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, error) {
+	record, err := f.controller.Find(p.Context, p.IDComponents.UniqueComponent())
+	return handleControllerResults(record, err)
+}
+
 // roles
 
 type roleNodeResolver struct {
@@ -225,6 +322,32 @@ func (f *roleNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, error
 	return handleControllerResults(record, err)
 }
 
+// silenced
+
+type silencedNodeResolver struct {
+	controller actions.SilencedController
+}
+
+func registerSilencedNodeResolver(register relay.NodeRegister, store store.SilencedStore) {
+	controller := actions.NewSilencedController(store)
+	resolver := &silencedNodeResolver{controller}
+	register.RegisterResolver(relay.NodeResolver{
+		ObjectType: schema.SilencedType,
+		Translator: globalid.SilencedTranslator,
+		Resolve:    resolver.fetch,
+	})
+}
+
+func (f *silencedNodeResolver) fetch(p relay.NodeResolverParams) (interface{}, error) {
+	ctx := setContextFromComponents(p.Context, p.IDComponents)
+	components, ok := p.IDComponents.(globalid.SilencedComponents)
+	if !ok {
+		return nil, errors.New("given global ID is not a silenced entry's")
+	}
+	record, err := f.controller.Find(ctx, components.SilencedID())
+	return handleControllerResults(record, err)
+}
+
 // user
 
 type userNodeResolver struct {
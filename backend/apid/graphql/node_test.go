@@ -0,0 +1,63 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/apid/graphql/globalid"
+	"github.com/sensu/sensu-go/testing/mockqueue"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeResolvers(t *testing.T) {
+	store := &mockstore.MockStore{}
+	store.On("NewQueue", mock.Anything, mock.Anything).Return(&mockqueue.MockQueue{})
+
+	event := types.FixtureEvent("entity1", "check-cpu")
+	store.On("GetEventByEntityCheck", mock.Anything, "entity1", "check-cpu").Return(event, nil)
+	handler := types.FixtureHandler("slack")
+	store.On("GetHandlerByName", mock.Anything, "slack").Return(handler, nil)
+	hook := types.FixtureHookConfig("restart")
+	store.On("GetHookConfigByName", mock.Anything, "restart").Return(hook, nil)
+	mutator := types.FixtureMutator("jq")
+	store.On("GetMutatorByName", mock.Anything, "jq").Return(mutator, nil)
+	org := types.FixtureOrganization("acme")
+	store.On("GetOrganizationByName", mock.Anything, "acme").Return(org, nil)
+	silenced := types.FixtureSilenced("*:check-cpu")
+	silenced.Organization = "default"
+	silenced.Environment = "default"
+	store.On("GetSilencedEntryByID", mock.Anything, "*:check-cpu").Return(silenced, nil)
+
+	svc, err := NewService(ServiceConfig{Store: store})
+	require.NoError(t, err)
+	ctx := testutil.NewContext(testutil.ContextWithFullAccess)
+
+	testCases := []struct {
+		name   string
+		record interface{}
+		id     string
+	}{
+		{"event", event, globalid.EventTranslator.EncodeToString(event)},
+		{"handler", handler, globalid.HandlerTranslator.EncodeToString(handler)},
+		{"hook", hook, globalid.HookTranslator.EncodeToString(hook)},
+		{"mutator", mutator, globalid.MutatorTranslator.EncodeToString(mutator)},
+		{"organization", org, globalid.OrganizationTranslator.EncodeToString(org)},
+		{"silenced", silenced, globalid.SilencedTranslator.EncodeToString(silenced)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			query := `query($id: ID!) { node(id: $id) { id } }`
+			result := svc.Do(ctx, query, map[string]interface{}{"id": tc.id})
+			require.Empty(t, result.Errors)
+
+			data := result.Data.(map[string]interface{})
+			node, ok := data["node"].(map[string]interface{})
+			require.True(t, ok, "node not found")
+			assert.Equal(t, tc.id, node["id"])
+		})
+	}
+}
@@ -80,6 +80,12 @@ type EntityAuthorFieldResolver interface {
 	Author(p graphql.ResolveParams) (interface{}, error)
 }
 
+// EntityStatusFieldResolver implement to resolve requests for the Entity's status field.
+type EntityStatusFieldResolver interface {
+	// Status implements response to request for status field.
+	Status(p graphql.ResolveParams) (interface{}, error)
+}
+
 //
 // EntityFieldResolvers represents a collection of methods whose products represent the
 // response values of the 'Entity' type.
@@ -154,6 +160,7 @@ type EntityFieldResolvers interface {
 	EntityKeepaliveTimeoutFieldResolver
 	EntityAuthorIDFieldResolver
 	EntityAuthorFieldResolver
+	EntityStatusFieldResolver
 }
 
 // EntityAliases implements all methods on EntityFieldResolvers interface by using reflection to
@@ -282,6 +289,12 @@ func (_ EntityAliases) Author(p graphql.ResolveParams) (interface{}, error) {
 	return val, err
 }
 
+// Status implements response to request for 'status' field.
+func (_ EntityAliases) Status(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 /*
 EntityType Entity is the Entity supplying the event. The default Entity for any
 Event is the running Agent process--if the Event is sent by an Agent.
@@ -376,6 +389,13 @@ func _ObjTypeEntityAuthorHandler(impl interface{}) graphql1.FieldResolveFn {
 	}
 }
 
+func _ObjTypeEntityStatusHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityStatusFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Status(p)
+	}
+}
+
 func _ObjectTypeEntityConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "Entity is the Entity supplying the event. The default Entity for any\nEvent is the running Agent process--if the Event is sent by an Agent.",
@@ -450,6 +470,13 @@ func _ObjectTypeEntityConfigFn() graphql1.ObjectConfig {
 				Name:              "namespace",
 				Type:              graphql1.NewNonNull(graphql.OutputType("Namespace")),
 			},
+			"status": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Status of the connection of the agent of the entity to the backends.",
+				Name:              "status",
+				Type:              graphql.OutputType("EntityStatus"),
+			},
 			"subscriptions": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -465,7 +492,8 @@ func _ObjectTypeEntityConfigFn() graphql1.ObjectConfig {
 				Type:              graphql.OutputType("System"),
 			},
 		},
-		Interfaces: []*graphql1.Interface{},
+		Interfaces: []*graphql1.Interface{
+			graphql.Interface("Node")},
 		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
 			// NOTE:
 			// Panic by default. Intent is that when Service is invoked, values of
@@ -492,11 +520,362 @@ var _ObjectTypeEntityDesc = graphql.ObjectDesc{
 		"lastSeen":         _ObjTypeEntityLastSeenHandler,
 		"name":             _ObjTypeEntityNameHandler,
 		"namespace":        _ObjTypeEntityNamespaceHandler,
+		"status":           _ObjTypeEntityStatusHandler,
 		"subscriptions":    _ObjTypeEntitySubscriptionsHandler,
 		"system":           _ObjTypeEntitySystemHandler,
 	},
 }
 
+// EntityStatusConnectedFieldResolver implement to resolve requests for the EntityStatus's connected field.
+type EntityStatusConnectedFieldResolver interface {
+	// Connected implements response to request for connected field.
+	Connected(p graphql.ResolveParams) (bool, error)
+}
+
+// EntityStatusBackendFieldResolver implement to resolve requests for the EntityStatus's backend field.
+type EntityStatusBackendFieldResolver interface {
+	// Backend implements response to request for backend field.
+	Backend(p graphql.ResolveParams) (string, error)
+}
+
+// EntityStatusTransportFieldResolver implement to resolve requests for the EntityStatus's transport field.
+type EntityStatusTransportFieldResolver interface {
+	// Transport implements response to request for transport field.
+	Transport(p graphql.ResolveParams) (string, error)
+}
+
+// EntityStatusAgentVersionFieldResolver implement to resolve requests for the EntityStatus's agentVersion field.
+type EntityStatusAgentVersionFieldResolver interface {
+	// AgentVersion implements response to request for agentVersion field.
+	AgentVersion(p graphql.ResolveParams) (string, error)
+}
+
+// EntityStatusConnectedAtFieldResolver implement to resolve requests for the EntityStatus's connectedAt field.
+type EntityStatusConnectedAtFieldResolver interface {
+	// ConnectedAt implements response to request for connectedAt field.
+	ConnectedAt(p graphql.ResolveParams) (int, error)
+}
+
+// EntityStatusDisconnectedAtFieldResolver implement to resolve requests for the EntityStatus's disconnectedAt field.
+type EntityStatusDisconnectedAtFieldResolver interface {
+	// DisconnectedAt implements response to request for disconnectedAt field.
+	DisconnectedAt(p graphql.ResolveParams) (int, error)
+}
+
+// EntityStatusLastKeepaliveFieldResolver implement to resolve requests for the EntityStatus's lastKeepalive field.
+type EntityStatusLastKeepaliveFieldResolver interface {
+	// LastKeepalive implements response to request for lastKeepalive field.
+	LastKeepalive(p graphql.ResolveParams) (int, error)
+}
+
+//
+// EntityStatusFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'EntityStatus' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type EntityStatusFieldResolvers interface {
+	EntityStatusConnectedFieldResolver
+	EntityStatusBackendFieldResolver
+	EntityStatusTransportFieldResolver
+	EntityStatusAgentVersionFieldResolver
+	EntityStatusConnectedAtFieldResolver
+	EntityStatusDisconnectedAtFieldResolver
+	EntityStatusLastKeepaliveFieldResolver
+}
+
+// EntityStatusAliases implements all methods on EntityStatusFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type EntityStatusAliases struct{}
+
+// Connected implements response to request for 'connected' field.
+func (_ EntityStatusAliases) Connected(p graphql.ResolveParams) (bool, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := val.(bool)
+	return ret, err
+}
+
+// Backend implements response to request for 'backend' field.
+func (_ EntityStatusAliases) Backend(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// Transport implements response to request for 'transport' field.
+func (_ EntityStatusAliases) Transport(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// AgentVersion implements response to request for 'agentVersion' field.
+func (_ EntityStatusAliases) AgentVersion(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// ConnectedAt implements response to request for 'connectedAt' field.
+func (_ EntityStatusAliases) ConnectedAt(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := graphql1.Int.ParseValue(val).(int)
+	return ret, err
+}
+
+// DisconnectedAt implements response to request for 'disconnectedAt' field.
+func (_ EntityStatusAliases) DisconnectedAt(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := graphql1.Int.ParseValue(val).(int)
+	return ret, err
+}
+
+// LastKeepalive implements response to request for 'lastKeepalive' field.
+func (_ EntityStatusAliases) LastKeepalive(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := graphql1.Int.ParseValue(val).(int)
+	return ret, err
+}
+
+/*
+EntityStatusType EntityStatus is the status of the connection of the agent of an entity to
+the backends, telling an agent which is down from an agent disconnected from
+a given backend.
+*/
+var EntityStatusType = graphql.NewType("EntityStatus", graphql.ObjectKind)
+
+// RegisterEntityStatus registers EntityStatus object type with given service.
+func RegisterEntityStatus(svc *graphql.Service, impl EntityStatusFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeEntityStatusDesc, impl)
+}
+func _ObjTypeEntityStatusConnectedHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityStatusConnectedFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Connected(p)
+	}
+}
+
+func _ObjTypeEntityStatusBackendHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityStatusBackendFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Backend(p)
+	}
+}
+
+func _ObjTypeEntityStatusTransportHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityStatusTransportFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Transport(p)
+	}
+}
+
+func _ObjTypeEntityStatusAgentVersionHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityStatusAgentVersionFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.AgentVersion(p)
+	}
+}
+
+func _ObjTypeEntityStatusConnectedAtHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityStatusConnectedAtFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ConnectedAt(p)
+	}
+}
+
+func _ObjTypeEntityStatusDisconnectedAtHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityStatusDisconnectedAtFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.DisconnectedAt(p)
+	}
+}
+
+func _ObjTypeEntityStatusLastKeepaliveHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EntityStatusLastKeepaliveFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.LastKeepalive(p)
+	}
+}
+
+func _ObjectTypeEntityStatusConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "EntityStatus is the status of the connection of the agent of an entity to\nthe backends, telling an agent which is down from an agent disconnected from\na given backend.",
+		Fields: graphql1.Fields{
+			"agentVersion": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Version of the agent.",
+				Name:              "agentVersion",
+				Type:              graphql1.String,
+			},
+			"backend": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Name of the backend the agent is, or was last, connected to.",
+				Name:              "backend",
+				Type:              graphql1.String,
+			},
+			"connected": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Whether the agent is connected to a backend.",
+				Name:              "connected",
+				Type:              graphql1.NewNonNull(graphql1.Boolean),
+			},
+			"connectedAt": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Time in seconds since the Epoch at which the agent connected.",
+				Name:              "connectedAt",
+				Type:              graphql1.Int,
+			},
+			"disconnectedAt": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Time in seconds since the Epoch at which the agent disconnected.",
+				Name:              "disconnectedAt",
+				Type:              graphql1.Int,
+			},
+			"lastKeepalive": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Time in seconds since the Epoch of the last keepalive of the agent, once it's disconnected.",
+				Name:              "lastKeepalive",
+				Type:              graphql1.Int,
+			},
+			"transport": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Transport of the agent connection, websocket or mqtt.",
+				Name:              "transport",
+				Type:              graphql1.String,
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see EntityStatusFieldResolvers.")
+		},
+		Name: "EntityStatus",
+	}
+}
+
+// describe EntityStatus's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeEntityStatusDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeEntityStatusConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"agentVersion":   _ObjTypeEntityStatusAgentVersionHandler,
+		"backend":        _ObjTypeEntityStatusBackendHandler,
+		"connected":      _ObjTypeEntityStatusConnectedHandler,
+		"connectedAt":    _ObjTypeEntityStatusConnectedAtHandler,
+		"disconnectedAt": _ObjTypeEntityStatusDisconnectedAtHandler,
+		"lastKeepalive":  _ObjTypeEntityStatusLastKeepaliveHandler,
+		"transport":      _ObjTypeEntityStatusTransportHandler,
+	},
+}
+
 // EntityConnectionEdgesFieldResolver implement to resolve requests for the EntityConnection's edges field.
 type EntityConnectionEdgesFieldResolver interface {
 	// Edges implements response to request for edges field.
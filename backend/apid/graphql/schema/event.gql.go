@@ -379,7 +379,8 @@ func _ObjectTypeEventConfigFn() graphql1.ObjectConfig {
 				Type:              graphql1.NewNonNull(graphql1.DateTime),
 			},
 		},
-		Interfaces: []*graphql1.Interface{},
+		Interfaces: []*graphql1.Interface{
+			graphql.Interface("Node")},
 		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
 			// NOTE:
 			// Panic by default. Intent is that when Service is invoked, values of
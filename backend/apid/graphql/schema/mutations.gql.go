@@ -60,6 +60,23 @@ type MutationDeleteCheckFieldResolver interface {
 	DeleteCheck(p MutationDeleteCheckFieldResolverParams) (interface{}, error)
 }
 
+// MutationUpdatePreferencesFieldResolverArgs contains arguments provided to updatePreferences when selected
+type MutationUpdatePreferencesFieldResolverArgs struct {
+	Input *UpdatePreferencesInput // Input - self descriptive
+}
+
+// MutationUpdatePreferencesFieldResolverParams contains contextual info to resolve updatePreferences field
+type MutationUpdatePreferencesFieldResolverParams struct {
+	graphql.ResolveParams
+	Args MutationUpdatePreferencesFieldResolverArgs
+}
+
+// MutationUpdatePreferencesFieldResolver implement to resolve requests for the Mutation's updatePreferences field.
+type MutationUpdatePreferencesFieldResolver interface {
+	// UpdatePreferences implements response to request for updatePreferences field.
+	UpdatePreferences(p MutationUpdatePreferencesFieldResolverParams) (interface{}, error)
+}
+
 //
 // MutationFieldResolvers represents a collection of methods whose products represent the
 // response values of the 'Mutation' type.
@@ -125,6 +142,7 @@ type MutationFieldResolvers interface {
 	MutationCreateCheckFieldResolver
 	MutationUpdateCheckFieldResolver
 	MutationDeleteCheckFieldResolver
+	MutationUpdatePreferencesFieldResolver
 }
 
 // MutationAliases implements all methods on MutationFieldResolvers interface by using reflection to
@@ -192,6 +210,12 @@ func (_ MutationAliases) DeleteCheck(p MutationDeleteCheckFieldResolverParams) (
 	return val, err
 }
 
+// UpdatePreferences implements response to request for 'updatePreferences' field.
+func (_ MutationAliases) UpdatePreferences(p MutationUpdatePreferencesFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // MutationType The root query for implementing GraphQL mutations.
 var MutationType = graphql.NewType("Mutation", graphql.ObjectKind)
 
@@ -238,6 +262,19 @@ func _ObjTypeMutationDeleteCheckHandler(impl interface{}) graphql1.FieldResolveF
 	}
 }
 
+func _ObjTypeMutationUpdatePreferencesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(MutationUpdatePreferencesFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := MutationUpdatePreferencesFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.UpdatePreferences(frp)
+	}
+}
+
 func _ObjectTypeMutationConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "The root query for implementing GraphQL mutations.",
@@ -272,6 +309,16 @@ func _ObjectTypeMutationConfigFn() graphql1.ObjectConfig {
 				Name:              "updateCheck",
 				Type:              graphql.OutputType("UpdateCheckPayload"),
 			},
+			"updatePreferences": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{"input": &graphql1.ArgumentConfig{
+					Description: "self descriptive",
+					Type:        graphql1.NewNonNull(graphql.InputType("UpdatePreferencesInput")),
+				}},
+				DeprecationReason: "",
+				Description:       "Replaces the preferences of the viewer.",
+				Name:              "updatePreferences",
+				Type:              graphql.OutputType("UpdatePreferencesPayload"),
+			},
 		},
 		Interfaces: []*graphql1.Interface{},
 		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
@@ -290,9 +337,10 @@ func _ObjectTypeMutationConfigFn() graphql1.ObjectConfig {
 var _ObjectTypeMutationDesc = graphql.ObjectDesc{
 	Config: _ObjectTypeMutationConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
-		"createCheck": _ObjTypeMutationCreateCheckHandler,
-		"deleteCheck": _ObjTypeMutationDeleteCheckHandler,
-		"updateCheck": _ObjTypeMutationUpdateCheckHandler,
+		"createCheck":       _ObjTypeMutationCreateCheckHandler,
+		"deleteCheck":       _ObjTypeMutationDeleteCheckHandler,
+		"updateCheck":       _ObjTypeMutationUpdateCheckHandler,
+		"updatePreferences": _ObjTypeMutationUpdatePreferencesHandler,
 	},
 }
 
@@ -1136,3 +1184,338 @@ var _ObjectTypeUpdateCheckPayloadDesc = graphql.ObjectDesc{
 		"clientMutationId": _ObjTypeUpdateCheckPayloadClientMutationIDHandler,
 	},
 }
+
+// SavedSearchInput self descriptive
+type SavedSearchInput struct {
+	// Name - self descriptive
+	Name string
+	// Filter - self descriptive
+	Filter string
+	// Organization - self descriptive
+	Organization string
+	// Environment - self descriptive
+	Environment string
+}
+
+// SavedSearchInputType self descriptive
+var SavedSearchInputType = graphql.NewType("SavedSearchInput", graphql.InputKind)
+
+// RegisterSavedSearchInput registers SavedSearchInput object type with given service.
+func RegisterSavedSearchInput(svc *graphql.Service) {
+	svc.RegisterInput(_InputTypeSavedSearchInputDesc)
+}
+func _InputTypeSavedSearchInputConfigFn() graphql1.InputObjectConfig {
+	return graphql1.InputObjectConfig{
+		Description: "self descriptive",
+		Fields: graphql1.InputObjectConfigFieldMap{
+			"environment": &graphql1.InputObjectFieldConfig{
+				Description: "self descriptive",
+				Type:        graphql1.String,
+			},
+			"filter": &graphql1.InputObjectFieldConfig{
+				Description: "self descriptive",
+				Type:        graphql1.NewNonNull(graphql1.String),
+			},
+			"name": &graphql1.InputObjectFieldConfig{
+				Description: "self descriptive",
+				Type:        graphql1.NewNonNull(graphql1.String),
+			},
+			"organization": &graphql1.InputObjectFieldConfig{
+				Description: "self descriptive",
+				Type:        graphql1.String,
+			},
+		},
+		Name: "SavedSearchInput",
+	}
+}
+
+// describe SavedSearchInput's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _InputTypeSavedSearchInputDesc = graphql.InputDesc{Config: _InputTypeSavedSearchInputConfigFn}
+
+// TableLayoutInput self descriptive
+type TableLayoutInput struct {
+	// Table - self descriptive
+	Table string
+	// Columns - self descriptive
+	Columns []string
+}
+
+// TableLayoutInputType self descriptive
+var TableLayoutInputType = graphql.NewType("TableLayoutInput", graphql.InputKind)
+
+// RegisterTableLayoutInput registers TableLayoutInput object type with given service.
+func RegisterTableLayoutInput(svc *graphql.Service) {
+	svc.RegisterInput(_InputTypeTableLayoutInputDesc)
+}
+func _InputTypeTableLayoutInputConfigFn() graphql1.InputObjectConfig {
+	return graphql1.InputObjectConfig{
+		Description: "self descriptive",
+		Fields: graphql1.InputObjectConfigFieldMap{
+			"columns": &graphql1.InputObjectFieldConfig{
+				Description: "self descriptive",
+				Type:        graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql1.String))),
+			},
+			"table": &graphql1.InputObjectFieldConfig{
+				Description: "self descriptive",
+				Type:        graphql1.NewNonNull(graphql1.String),
+			},
+		},
+		Name: "TableLayoutInput",
+	}
+}
+
+// describe TableLayoutInput's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _InputTypeTableLayoutInputDesc = graphql.InputDesc{Config: _InputTypeTableLayoutInputConfigFn}
+
+// UpdatePreferencesInput self descriptive
+type UpdatePreferencesInput struct {
+	// ClientMutationID - A unique identifier for the client performing the mutation.
+	ClientMutationID string
+	// DefaultOrganization - The organization selected when the viewer logs in.
+	DefaultOrganization string
+	// DefaultEnvironment - The environment selected when the viewer logs in.
+	DefaultEnvironment string
+	// SavedSearches - The event filters saved by the viewer.
+	SavedSearches []*SavedSearchInput
+	// TableLayouts - The columns shown by the tables of the dashboard.
+	TableLayouts []*TableLayoutInput
+}
+
+// UpdatePreferencesInputType self descriptive
+var UpdatePreferencesInputType = graphql.NewType("UpdatePreferencesInput", graphql.InputKind)
+
+// RegisterUpdatePreferencesInput registers UpdatePreferencesInput object type with given service.
+func RegisterUpdatePreferencesInput(svc *graphql.Service) {
+	svc.RegisterInput(_InputTypeUpdatePreferencesInputDesc)
+}
+func _InputTypeUpdatePreferencesInputConfigFn() graphql1.InputObjectConfig {
+	return graphql1.InputObjectConfig{
+		Description: "self descriptive",
+		Fields: graphql1.InputObjectConfigFieldMap{
+			"clientMutationId": &graphql1.InputObjectFieldConfig{
+				Description: "A unique identifier for the client performing the mutation.",
+				Type:        graphql1.String,
+			},
+			"defaultEnvironment": &graphql1.InputObjectFieldConfig{
+				Description: "The environment selected when the viewer logs in.",
+				Type:        graphql1.String,
+			},
+			"defaultOrganization": &graphql1.InputObjectFieldConfig{
+				Description: "The organization selected when the viewer logs in.",
+				Type:        graphql1.String,
+			},
+			"savedSearches": &graphql1.InputObjectFieldConfig{
+				Description: "The event filters saved by the viewer.",
+				Type:        graphql1.NewList(graphql1.NewNonNull(graphql.InputType("SavedSearchInput"))),
+			},
+			"tableLayouts": &graphql1.InputObjectFieldConfig{
+				Description: "The columns shown by the tables of the dashboard.",
+				Type:        graphql1.NewList(graphql1.NewNonNull(graphql.InputType("TableLayoutInput"))),
+			},
+		},
+		Name: "UpdatePreferencesInput",
+	}
+}
+
+// describe UpdatePreferencesInput's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _InputTypeUpdatePreferencesInputDesc = graphql.InputDesc{Config: _InputTypeUpdatePreferencesInputConfigFn}
+
+// UpdatePreferencesPayloadClientMutationIDFieldResolver implement to resolve requests for the UpdatePreferencesPayload's clientMutationId field.
+type UpdatePreferencesPayloadClientMutationIDFieldResolver interface {
+	// ClientMutationID implements response to request for clientMutationId field.
+	ClientMutationID(p graphql.ResolveParams) (string, error)
+}
+
+// UpdatePreferencesPayloadPreferencesFieldResolver implement to resolve requests for the UpdatePreferencesPayload's preferences field.
+type UpdatePreferencesPayloadPreferencesFieldResolver interface {
+	// Preferences implements response to request for preferences field.
+	Preferences(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// UpdatePreferencesPayloadFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'UpdatePreferencesPayload' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type UpdatePreferencesPayloadFieldResolvers interface {
+	UpdatePreferencesPayloadClientMutationIDFieldResolver
+	UpdatePreferencesPayloadPreferencesFieldResolver
+}
+
+// UpdatePreferencesPayloadAliases implements all methods on UpdatePreferencesPayloadFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type UpdatePreferencesPayloadAliases struct{}
+
+// ClientMutationID implements response to request for 'clientMutationId' field.
+func (_ UpdatePreferencesPayloadAliases) ClientMutationID(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// Preferences implements response to request for 'preferences' field.
+func (_ UpdatePreferencesPayloadAliases) Preferences(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// UpdatePreferencesPayloadType self descriptive
+var UpdatePreferencesPayloadType = graphql.NewType("UpdatePreferencesPayload", graphql.ObjectKind)
+
+// RegisterUpdatePreferencesPayload registers UpdatePreferencesPayload object type with given service.
+func RegisterUpdatePreferencesPayload(svc *graphql.Service, impl UpdatePreferencesPayloadFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeUpdatePreferencesPayloadDesc, impl)
+}
+func _ObjTypeUpdatePreferencesPayloadClientMutationIDHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(UpdatePreferencesPayloadClientMutationIDFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ClientMutationID(p)
+	}
+}
+
+func _ObjTypeUpdatePreferencesPayloadPreferencesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(UpdatePreferencesPayloadPreferencesFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Preferences(p)
+	}
+}
+
+func _ObjectTypeUpdatePreferencesPayloadConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "self descriptive",
+		Fields: graphql1.Fields{
+			"clientMutationId": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "A unique identifier for the client performing the mutation.",
+				Name:              "clientMutationId",
+				Type:              graphql1.String,
+			},
+			"preferences": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The updated preferences.",
+				Name:              "preferences",
+				Type:              graphql1.NewNonNull(graphql.OutputType("UserPreferences")),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see UpdatePreferencesPayloadFieldResolvers.")
+		},
+		Name: "UpdatePreferencesPayload",
+	}
+}
+
+// describe UpdatePreferencesPayload's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeUpdatePreferencesPayloadDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeUpdatePreferencesPayloadConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"clientMutationId": _ObjTypeUpdatePreferencesPayloadClientMutationIDHandler,
+		"preferences":      _ObjTypeUpdatePreferencesPayloadPreferencesHandler,
+	},
+}
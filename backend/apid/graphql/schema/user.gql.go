@@ -8,6 +8,12 @@ import (
 	graphql "github.com/sensu/sensu-go/graphql"
 )
 
+// UserIDFieldResolver implement to resolve requests for the User's id field.
+type UserIDFieldResolver interface {
+	// ID implements response to request for id field.
+	ID(p graphql.ResolveParams) (interface{}, error)
+}
+
 // UserUsernameFieldResolver implement to resolve requests for the User's username field.
 type UserUsernameFieldResolver interface {
 	// Username implements response to request for username field.
@@ -94,6 +100,7 @@ type UserHasPasswordFieldResolver interface {
 //   }
 //
 type UserFieldResolvers interface {
+	UserIDFieldResolver
 	UserUsernameFieldResolver
 	UserRolesFieldResolver
 	UserDisabledFieldResolver
@@ -147,6 +154,12 @@ type UserFieldResolvers interface {
 //
 type UserAliases struct{}
 
+// ID implements response to request for 'id' field.
+func (_ UserAliases) ID(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // Username implements response to request for 'username' field.
 func (_ UserAliases) Username(p graphql.ResolveParams) (string, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -181,6 +194,13 @@ var UserType = graphql.NewType("User", graphql.ObjectKind)
 func RegisterUser(svc *graphql.Service, impl UserFieldResolvers) {
 	svc.RegisterObject(_ObjectTypeUserDesc, impl)
 }
+func _ObjTypeUserIDHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(UserIDFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.ID(p)
+	}
+}
+
 func _ObjTypeUserUsernameHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(UserUsernameFieldResolver)
 	return func(p graphql1.ResolveParams) (interface{}, error) {
@@ -227,6 +247,13 @@ func _ObjectTypeUserConfigFn() graphql1.ObjectConfig {
 				Name:              "hasPassword",
 				Type:              graphql1.NewNonNull(graphql1.Boolean),
 			},
+			"id": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The globally unique identifier of the record",
+				Name:              "id",
+				Type:              graphql1.NewNonNull(graphql1.ID),
+			},
 			"roles": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -242,7 +269,8 @@ func _ObjectTypeUserConfigFn() graphql1.ObjectConfig {
 				Type:              graphql1.NewNonNull(graphql1.String),
 			},
 		},
-		Interfaces: []*graphql1.Interface{},
+		Interfaces: []*graphql1.Interface{
+			graphql.Interface("Node")},
 		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
 			// NOTE:
 			// Panic by default. Intent is that when Service is invoked, values of
@@ -261,7 +289,728 @@ var _ObjectTypeUserDesc = graphql.ObjectDesc{
 	FieldHandlers: map[string]graphql.FieldHandler{
 		"disabled":    _ObjTypeUserDisabledHandler,
 		"hasPassword": _ObjTypeUserHasPasswordHandler,
+		"id":          _ObjTypeUserIDHandler,
 		"roles":       _ObjTypeUserRolesHandler,
 		"username":    _ObjTypeUserUsernameHandler,
 	},
 }
+
+// UserPreferencesDefaultOrganizationFieldResolver implement to resolve requests for the UserPreferences's defaultOrganization field.
+type UserPreferencesDefaultOrganizationFieldResolver interface {
+	// DefaultOrganization implements response to request for defaultOrganization field.
+	DefaultOrganization(p graphql.ResolveParams) (string, error)
+}
+
+// UserPreferencesDefaultEnvironmentFieldResolver implement to resolve requests for the UserPreferences's defaultEnvironment field.
+type UserPreferencesDefaultEnvironmentFieldResolver interface {
+	// DefaultEnvironment implements response to request for defaultEnvironment field.
+	DefaultEnvironment(p graphql.ResolveParams) (string, error)
+}
+
+// UserPreferencesSavedSearchesFieldResolver implement to resolve requests for the UserPreferences's savedSearches field.
+type UserPreferencesSavedSearchesFieldResolver interface {
+	// SavedSearches implements response to request for savedSearches field.
+	SavedSearches(p graphql.ResolveParams) (interface{}, error)
+}
+
+// UserPreferencesTableLayoutsFieldResolver implement to resolve requests for the UserPreferences's tableLayouts field.
+type UserPreferencesTableLayoutsFieldResolver interface {
+	// TableLayouts implements response to request for tableLayouts field.
+	TableLayouts(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// UserPreferencesFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'UserPreferences' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type UserPreferencesFieldResolvers interface {
+	UserPreferencesDefaultOrganizationFieldResolver
+	UserPreferencesDefaultEnvironmentFieldResolver
+	UserPreferencesSavedSearchesFieldResolver
+	UserPreferencesTableLayoutsFieldResolver
+}
+
+// UserPreferencesAliases implements all methods on UserPreferencesFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type UserPreferencesAliases struct{}
+
+// DefaultOrganization implements response to request for 'defaultOrganization' field.
+func (_ UserPreferencesAliases) DefaultOrganization(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// DefaultEnvironment implements response to request for 'defaultEnvironment' field.
+func (_ UserPreferencesAliases) DefaultEnvironment(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// SavedSearches implements response to request for 'savedSearches' field.
+func (_ UserPreferencesAliases) SavedSearches(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// TableLayouts implements response to request for 'tableLayouts' field.
+func (_ UserPreferencesAliases) TableLayouts(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+/*
+UserPreferencesType UserPreferences are the customizations of a user, persisted by the backend so
+that they follow the user across the dashboard and sensuctl.
+*/
+var UserPreferencesType = graphql.NewType("UserPreferences", graphql.ObjectKind)
+
+// RegisterUserPreferences registers UserPreferences object type with given service.
+func RegisterUserPreferences(svc *graphql.Service, impl UserPreferencesFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeUserPreferencesDesc, impl)
+}
+func _ObjTypeUserPreferencesDefaultOrganizationHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(UserPreferencesDefaultOrganizationFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.DefaultOrganization(p)
+	}
+}
+
+func _ObjTypeUserPreferencesDefaultEnvironmentHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(UserPreferencesDefaultEnvironmentFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.DefaultEnvironment(p)
+	}
+}
+
+func _ObjTypeUserPreferencesSavedSearchesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(UserPreferencesSavedSearchesFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.SavedSearches(p)
+	}
+}
+
+func _ObjTypeUserPreferencesTableLayoutsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(UserPreferencesTableLayoutsFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.TableLayouts(p)
+	}
+}
+
+func _ObjectTypeUserPreferencesConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "UserPreferences are the customizations of a user, persisted by the backend so\nthat they follow the user across the dashboard and sensuctl.",
+		Fields: graphql1.Fields{
+			"defaultEnvironment": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The environment selected when the user logs in.",
+				Name:              "defaultEnvironment",
+				Type:              graphql1.String,
+			},
+			"defaultOrganization": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The organization selected when the user logs in.",
+				Name:              "defaultOrganization",
+				Type:              graphql1.String,
+			},
+			"savedSearches": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The event filters saved by the user.",
+				Name:              "savedSearches",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("SavedSearch")))),
+			},
+			"tableLayouts": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The columns shown by the tables of the dashboard.",
+				Name:              "tableLayouts",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("TableLayout")))),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see UserPreferencesFieldResolvers.")
+		},
+		Name: "UserPreferences",
+	}
+}
+
+// describe UserPreferences's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeUserPreferencesDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeUserPreferencesConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"defaultEnvironment":  _ObjTypeUserPreferencesDefaultEnvironmentHandler,
+		"defaultOrganization": _ObjTypeUserPreferencesDefaultOrganizationHandler,
+		"savedSearches":       _ObjTypeUserPreferencesSavedSearchesHandler,
+		"tableLayouts":        _ObjTypeUserPreferencesTableLayoutsHandler,
+	},
+}
+
+// SavedSearchNameFieldResolver implement to resolve requests for the SavedSearch's name field.
+type SavedSearchNameFieldResolver interface {
+	// Name implements response to request for name field.
+	Name(p graphql.ResolveParams) (string, error)
+}
+
+// SavedSearchFilterFieldResolver implement to resolve requests for the SavedSearch's filter field.
+type SavedSearchFilterFieldResolver interface {
+	// Filter implements response to request for filter field.
+	Filter(p graphql.ResolveParams) (string, error)
+}
+
+// SavedSearchOrganizationFieldResolver implement to resolve requests for the SavedSearch's organization field.
+type SavedSearchOrganizationFieldResolver interface {
+	// Organization implements response to request for organization field.
+	Organization(p graphql.ResolveParams) (string, error)
+}
+
+// SavedSearchEnvironmentFieldResolver implement to resolve requests for the SavedSearch's environment field.
+type SavedSearchEnvironmentFieldResolver interface {
+	// Environment implements response to request for environment field.
+	Environment(p graphql.ResolveParams) (string, error)
+}
+
+//
+// SavedSearchFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'SavedSearch' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type SavedSearchFieldResolvers interface {
+	SavedSearchNameFieldResolver
+	SavedSearchFilterFieldResolver
+	SavedSearchOrganizationFieldResolver
+	SavedSearchEnvironmentFieldResolver
+}
+
+// SavedSearchAliases implements all methods on SavedSearchFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type SavedSearchAliases struct{}
+
+// Name implements response to request for 'name' field.
+func (_ SavedSearchAliases) Name(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// Filter implements response to request for 'filter' field.
+func (_ SavedSearchAliases) Filter(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// Organization implements response to request for 'organization' field.
+func (_ SavedSearchAliases) Organization(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// Environment implements response to request for 'environment' field.
+func (_ SavedSearchAliases) Environment(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// SavedSearchType SavedSearch is a named event filter.
+var SavedSearchType = graphql.NewType("SavedSearch", graphql.ObjectKind)
+
+// RegisterSavedSearch registers SavedSearch object type with given service.
+func RegisterSavedSearch(svc *graphql.Service, impl SavedSearchFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeSavedSearchDesc, impl)
+}
+func _ObjTypeSavedSearchNameHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SavedSearchNameFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Name(p)
+	}
+}
+
+func _ObjTypeSavedSearchFilterHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SavedSearchFilterFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Filter(p)
+	}
+}
+
+func _ObjTypeSavedSearchOrganizationHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SavedSearchOrganizationFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Organization(p)
+	}
+}
+
+func _ObjTypeSavedSearchEnvironmentHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SavedSearchEnvironmentFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Environment(p)
+	}
+}
+
+func _ObjectTypeSavedSearchConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "SavedSearch is a named event filter.",
+		Fields: graphql1.Fields{
+			"environment": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The environment searched, all the environments if empty.",
+				Name:              "environment",
+				Type:              graphql1.String,
+			},
+			"filter": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The expression selecting the events, e.g. event.Check.Status != 0.",
+				Name:              "filter",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"name": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "name",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"organization": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The organization searched, all the organizations if empty.",
+				Name:              "organization",
+				Type:              graphql1.String,
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see SavedSearchFieldResolvers.")
+		},
+		Name: "SavedSearch",
+	}
+}
+
+// describe SavedSearch's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeSavedSearchDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeSavedSearchConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"environment":  _ObjTypeSavedSearchEnvironmentHandler,
+		"filter":       _ObjTypeSavedSearchFilterHandler,
+		"name":         _ObjTypeSavedSearchNameHandler,
+		"organization": _ObjTypeSavedSearchOrganizationHandler,
+	},
+}
+
+// TableLayoutTableFieldResolver implement to resolve requests for the TableLayout's table field.
+type TableLayoutTableFieldResolver interface {
+	// Table implements response to request for table field.
+	Table(p graphql.ResolveParams) (string, error)
+}
+
+// TableLayoutColumnsFieldResolver implement to resolve requests for the TableLayout's columns field.
+type TableLayoutColumnsFieldResolver interface {
+	// Columns implements response to request for columns field.
+	Columns(p graphql.ResolveParams) ([]string, error)
+}
+
+//
+// TableLayoutFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'TableLayout' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type TableLayoutFieldResolvers interface {
+	TableLayoutTableFieldResolver
+	TableLayoutColumnsFieldResolver
+}
+
+// TableLayoutAliases implements all methods on TableLayoutFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type TableLayoutAliases struct{}
+
+// Table implements response to request for 'table' field.
+func (_ TableLayoutAliases) Table(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// Columns implements response to request for 'columns' field.
+func (_ TableLayoutAliases) Columns(p graphql.ResolveParams) ([]string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := val.([]string)
+	return ret, err
+}
+
+// TableLayoutType TableLayout is the layout of a table of the dashboard.
+var TableLayoutType = graphql.NewType("TableLayout", graphql.ObjectKind)
+
+// RegisterTableLayout registers TableLayout object type with given service.
+func RegisterTableLayout(svc *graphql.Service, impl TableLayoutFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeTableLayoutDesc, impl)
+}
+func _ObjTypeTableLayoutTableHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(TableLayoutTableFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Table(p)
+	}
+}
+
+func _ObjTypeTableLayoutColumnsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(TableLayoutColumnsFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Columns(p)
+	}
+}
+
+func _ObjectTypeTableLayoutConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "TableLayout is the layout of a table of the dashboard.",
+		Fields: graphql1.Fields{
+			"columns": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The columns shown, in order.",
+				Name:              "columns",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql1.String))),
+			},
+			"table": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The name of the table, e.g. events.",
+				Name:              "table",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see TableLayoutFieldResolvers.")
+		},
+		Name: "TableLayout",
+	}
+}
+
+// describe TableLayout's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeTableLayoutDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeTableLayoutConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"columns": _ObjTypeTableLayoutColumnsHandler,
+		"table":   _ObjTypeTableLayoutTableHandler,
+	},
+}
@@ -14,6 +14,7 @@ type ViewerEntitiesFieldResolverArgs struct {
 	Last   int    // Last - self descriptive
 	Before string // Before - self descriptive
 	After  string // After - self descriptive
+	Query  string // Query - self descriptive
 }
 
 // ViewerEntitiesFieldResolverParams contains contextual info to resolve entities field
@@ -55,6 +56,7 @@ type ViewerEventsFieldResolverArgs struct {
 	Before string // Before - self descriptive
 	After  string // After - self descriptive
 	Filter string // Filter - self descriptive
+	Query  string // Query - self descriptive
 }
 
 // ViewerEventsFieldResolverParams contains contextual info to resolve events field
@@ -69,6 +71,43 @@ type ViewerEventsFieldResolver interface {
 	Events(p ViewerEventsFieldResolverParams) (interface{}, error)
 }
 
+// ViewerIncidentsFieldResolverArgs contains arguments provided to incidents when selected
+type ViewerIncidentsFieldResolverArgs struct {
+	First  int    // First - self descriptive
+	Last   int    // Last - self descriptive
+	Before string // Before - self descriptive
+	After  string // After - self descriptive
+}
+
+// ViewerIncidentsFieldResolverParams contains contextual info to resolve incidents field
+type ViewerIncidentsFieldResolverParams struct {
+	graphql.ResolveParams
+	Args ViewerIncidentsFieldResolverArgs
+}
+
+// ViewerIncidentsFieldResolver implement to resolve requests for the Viewer's incidents field.
+type ViewerIncidentsFieldResolver interface {
+	// Incidents implements response to request for incidents field.
+	Incidents(p ViewerIncidentsFieldResolverParams) (interface{}, error)
+}
+
+// ViewerClustersFieldResolverArgs contains arguments provided to clusters when selected
+type ViewerClustersFieldResolverArgs struct {
+	Name string // Name - self descriptive
+}
+
+// ViewerClustersFieldResolverParams contains contextual info to resolve clusters field
+type ViewerClustersFieldResolverParams struct {
+	graphql.ResolveParams
+	Args ViewerClustersFieldResolverArgs
+}
+
+// ViewerClustersFieldResolver implement to resolve requests for the Viewer's clusters field.
+type ViewerClustersFieldResolver interface {
+	// Clusters implements response to request for clusters field.
+	Clusters(p ViewerClustersFieldResolverParams) (interface{}, error)
+}
+
 // ViewerOrganizationsFieldResolver implement to resolve requests for the Viewer's organizations field.
 type ViewerOrganizationsFieldResolver interface {
 	// Organizations implements response to request for organizations field.
@@ -81,6 +120,12 @@ type ViewerUserFieldResolver interface {
 	User(p graphql.ResolveParams) (interface{}, error)
 }
 
+// ViewerPreferencesFieldResolver implement to resolve requests for the Viewer's preferences field.
+type ViewerPreferencesFieldResolver interface {
+	// Preferences implements response to request for preferences field.
+	Preferences(p graphql.ResolveParams) (interface{}, error)
+}
+
 //
 // ViewerFieldResolvers represents a collection of methods whose products represent the
 // response values of the 'Viewer' type.
@@ -146,8 +191,11 @@ type ViewerFieldResolvers interface {
 	ViewerEntitiesFieldResolver
 	ViewerChecksFieldResolver
 	ViewerEventsFieldResolver
+	ViewerIncidentsFieldResolver
+	ViewerClustersFieldResolver
 	ViewerOrganizationsFieldResolver
 	ViewerUserFieldResolver
+	ViewerPreferencesFieldResolver
 }
 
 // ViewerAliases implements all methods on ViewerFieldResolvers interface by using reflection to
@@ -215,6 +263,18 @@ func (_ ViewerAliases) Events(p ViewerEventsFieldResolverParams) (interface{}, e
 	return val, err
 }
 
+// Incidents implements response to request for 'incidents' field.
+func (_ ViewerAliases) Incidents(p ViewerIncidentsFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Clusters implements response to request for 'clusters' field.
+func (_ ViewerAliases) Clusters(p ViewerClustersFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // Organizations implements response to request for 'organizations' field.
 func (_ ViewerAliases) Organizations(p graphql.ResolveParams) (interface{}, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -227,6 +287,12 @@ func (_ ViewerAliases) User(p graphql.ResolveParams) (interface{}, error) {
 	return val, err
 }
 
+// Preferences implements response to request for 'preferences' field.
+func (_ ViewerAliases) Preferences(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // ViewerType Describes a viewer of the system; generally an authenticated user.
 var ViewerType = graphql.NewType("Viewer", graphql.ObjectKind)
 
@@ -273,6 +339,32 @@ func _ObjTypeViewerEventsHandler(impl interface{}) graphql1.FieldResolveFn {
 	}
 }
 
+func _ObjTypeViewerIncidentsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ViewerIncidentsFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := ViewerIncidentsFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.Incidents(frp)
+	}
+}
+
+func _ObjTypeViewerClustersHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ViewerClustersFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := ViewerClustersFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.Clusters(frp)
+	}
+}
+
 func _ObjTypeViewerOrganizationsHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(ViewerOrganizationsFieldResolver)
 	return func(p graphql1.ResolveParams) (interface{}, error) {
@@ -287,6 +379,13 @@ func _ObjTypeViewerUserHandler(impl interface{}) graphql1.FieldResolveFn {
 	}
 }
 
+func _ObjTypeViewerPreferencesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ViewerPreferencesFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Preferences(p)
+	}
+}
+
 func _ObjectTypeViewerConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "Describes a viewer of the system; generally an authenticated user.",
@@ -317,6 +416,16 @@ func _ObjectTypeViewerConfigFn() graphql1.ObjectConfig {
 				Name:              "checks",
 				Type:              graphql.OutputType("CheckConfigConnection"),
 			},
+			"clusters": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{"name": &graphql1.ArgumentConfig{
+					Description: "self descriptive",
+					Type:        graphql1.String,
+				}},
+				DeprecationReason: "",
+				Description:       "Remote clusters of the federation of the backend, or only the cluster with\nthe given name.",
+				Name:              "clusters",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("Cluster")))),
+			},
 			"entities": &graphql1.Field{
 				Args: graphql1.FieldConfigArgument{
 					"after": &graphql1.ArgumentConfig{
@@ -337,9 +446,13 @@ func _ObjectTypeViewerConfigFn() graphql1.ObjectConfig {
 						Description:  "self descriptive",
 						Type:         graphql1.Int,
 					},
+					"query": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql1.String,
+					},
 				},
 				DeprecationReason: "",
-				Description:       "All entities the viewer has access to view.",
+				Description:       "All entities the viewer has access to view. The query expression selects\nthe entities, e.g. system.os == \"linux\".",
 				Name:              "entities",
 				Type:              graphql.OutputType("EntityConnection"),
 			},
@@ -367,12 +480,42 @@ func _ObjectTypeViewerConfigFn() graphql1.ObjectConfig {
 						Description:  "self descriptive",
 						Type:         graphql1.Int,
 					},
+					"query": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql1.String,
+					},
 				},
 				DeprecationReason: "",
-				Description:       "All events the viewer has access to view.",
+				Description:       "All events the viewer has access to view. The query expression selects the\nevents, e.g. status != 0 && entity.system.os == \"linux\".",
 				Name:              "events",
 				Type:              graphql.OutputType("EventConnection"),
 			},
+			"incidents": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{
+					"after": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql1.String,
+					},
+					"before": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql1.String,
+					},
+					"first": &graphql1.ArgumentConfig{
+						DefaultValue: 10,
+						Description:  "self descriptive",
+						Type:         graphql1.Int,
+					},
+					"last": &graphql1.ArgumentConfig{
+						DefaultValue: 10,
+						Description:  "self descriptive",
+						Type:         graphql1.Int,
+					},
+				},
+				DeprecationReason: "",
+				Description:       "All incidents the viewer has access to view.",
+				Name:              "incidents",
+				Type:              graphql.OutputType("IncidentConnection"),
+			},
 			"organizations": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -380,6 +523,13 @@ func _ObjectTypeViewerConfigFn() graphql1.ObjectConfig {
 				Name:              "organizations",
 				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("Organization")))),
 			},
+			"preferences": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Preferences of the user account associated with the viewer.",
+				Name:              "preferences",
+				Type:              graphql.OutputType("UserPreferences"),
+			},
 			"user": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -406,9 +556,12 @@ var _ObjectTypeViewerDesc = graphql.ObjectDesc{
 	Config: _ObjectTypeViewerConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
 		"checks":        _ObjTypeViewerChecksHandler,
+		"clusters":      _ObjTypeViewerClustersHandler,
 		"entities":      _ObjTypeViewerEntitiesHandler,
 		"events":        _ObjTypeViewerEventsHandler,
+		"incidents":     _ObjTypeViewerIncidentsHandler,
 		"organizations": _ObjTypeViewerOrganizationsHandler,
+		"preferences":   _ObjTypeViewerPreferencesHandler,
 		"user":          _ObjTypeViewerUserHandler,
 	},
 }
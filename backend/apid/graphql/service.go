@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/federation"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/graphql"
 )
@@ -10,6 +11,12 @@ import (
 type ServiceConfig struct {
 	Store QueueStore
 	Bus   messaging.MessageBus
+
+	// Federation is the gateway to the remote clusters, if any
+	Federation *federation.Gateway
+
+	// DisableIntrospection rejects the queries of the schema
+	DisableIntrospection bool
 }
 
 // NewService instantiates new GraphQL service
@@ -20,6 +27,7 @@ func NewService(cfg ServiceConfig) (*graphql.Service, error) {
 
 	// Register types
 	schema.RegisterAsset(svc, &assetImpl{})
+	schema.RegisterCluster(svc, newClusterImpl(cfg.Federation))
 	schema.RegisterDeleteRecordInput(svc)
 	schema.RegisterDeleteRecordPayload(svc, &deleteRecordPayload{})
 	schema.RegisterEnvironment(svc, newEnvImpl(store))
@@ -34,7 +42,8 @@ func NewService(cfg ServiceConfig) (*graphql.Service, error) {
 	schema.RegisterNamespaceInput(svc)
 	schema.RegisterOrganization(svc, newOrgImpl(store))
 	schema.RegisterPageInfo(svc, &pageInfoImpl{})
-	schema.RegisterViewer(svc, newViewerImpl(store, cfg.Bus))
+	schema.RegisterSilenced(svc, &silencedImpl{})
+	schema.RegisterViewer(svc, newViewerImpl(store, cfg.Bus, cfg.Federation))
 	schema.RegisterSchema(svc)
 
 	// Register check types
@@ -53,6 +62,7 @@ func NewService(cfg ServiceConfig) (*graphql.Service, error) {
 	schema.RegisterEntity(svc, newEntityImpl(store))
 	schema.RegisterEntityConnection(svc, &schema.EntityConnectionAliases{})
 	schema.RegisterEntityEdge(svc, &schema.EntityEdgeAliases{})
+	schema.RegisterEntityStatus(svc, &entityStatusImpl{})
 	schema.RegisterDeregistration(svc, &deregistrationImpl{})
 	schema.RegisterNetwork(svc, &networkImpl{})
 	schema.RegisterNetworkInterface(svc, &networkInterfaceImpl{})
@@ -68,6 +78,12 @@ func NewService(cfg ServiceConfig) (*graphql.Service, error) {
 	schema.RegisterHookConfig(svc, &hookCfgImpl{})
 	schema.RegisterHookList(svc, &hookListImpl{})
 
+	// Register incident types
+	schema.RegisterIncident(svc, &incidentImpl{})
+	schema.RegisterIncidentConnection(svc, &schema.IncidentConnectionAliases{})
+	schema.RegisterIncidentEdge(svc, &schema.IncidentEdgeAliases{})
+	schema.RegisterIncidentEvent(svc, &incidentEventImpl{})
+
 	// Register time window
 	schema.RegisterTimeWindowDays(svc, &timeWindowDaysImpl{})
 	schema.RegisterTimeWindowWhen(svc, &timeWindowWhenImpl{})
@@ -79,6 +95,17 @@ func NewService(cfg ServiceConfig) (*graphql.Service, error) {
 	schema.RegisterRuleResource(svc)
 	schema.RegisterRulePermission(svc)
 	schema.RegisterUser(svc, &userImpl{})
+	schema.RegisterUserPreferences(svc, &userPreferencesImpl{})
+	schema.RegisterSavedSearch(svc, &savedSearchImpl{})
+	schema.RegisterTableLayout(svc, &tableLayoutImpl{})
+	schema.RegisterSavedSearchInput(svc)
+	schema.RegisterTableLayoutInput(svc)
+	schema.RegisterUpdatePreferencesInput(svc)
+	schema.RegisterUpdatePreferencesPayload(svc, &preferencesMutationPayload{})
+
+	if cfg.DisableIntrospection {
+		svc.DisableIntrospection()
+	}
 
 	err := svc.Regenerate()
 	return svc, err
@@ -17,3 +17,23 @@ func TestNewServiceSmokeTest(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, svc)
 }
+
+func TestNewServiceDisableIntrospection(t *testing.T) {
+	store := &mockstore.MockStore{}
+	store.On("NewQueue", mock.Anything, mock.Anything).Return(&mockqueue.MockQueue{})
+
+	svc, err := NewService(ServiceConfig{Store: store})
+	require.NoError(t, err)
+	_, errs := svc.Parse("{ __schema { queryType { name } } }")
+	assert.Empty(t, errs)
+
+	svc, err = NewService(ServiceConfig{Store: store, DisableIntrospection: true})
+	require.NoError(t, err)
+	_, errs = svc.Parse("{ __schema { queryType { name } } }")
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Message, "introspection is disabled")
+	_, errs = svc.Parse("{ __type(name: \"Query\") { name } }")
+	assert.Len(t, errs, 1)
+	_, errs = svc.Parse("{ viewer { __typename } }")
+	assert.Empty(t, errs)
+}
@@ -0,0 +1,54 @@
+package graphql
+
+import (
+	"time"
+
+	"github.com/sensu/sensu-go/backend/apid/graphql/globalid"
+	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/types"
+)
+
+var _ schema.SilencedFieldResolvers = (*silencedImpl)(nil)
+
+//
+// Implement SilencedFieldResolvers
+//
+
+type silencedImpl struct {
+	schema.SilencedAliases
+}
+
+// ID implements response to request for 'id' field.
+func (*silencedImpl) ID(p graphql.ResolveParams) (interface{}, error) {
+	return globalid.SilencedTranslator.EncodeToString(p.Source), nil
+}
+
+// Namespace implements response to request for 'namespace' field.
+func (*silencedImpl) Namespace(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source, nil
+}
+
+// StoreID implements response to request for 'storeId' field.
+func (*silencedImpl) StoreID(p graphql.ResolveParams) (string, error) {
+	silenced := p.Source.(*types.Silenced)
+	return silenced.ID, nil
+}
+
+// Expire implements response to request for 'expire' field.
+func (*silencedImpl) Expire(p graphql.ResolveParams) (int, error) {
+	silenced := p.Source.(*types.Silenced)
+	return int(silenced.Expire), nil
+}
+
+// Begin implements response to request for 'begin' field.
+func (*silencedImpl) Begin(p graphql.ResolveParams) (time.Time, error) {
+	silenced := p.Source.(*types.Silenced)
+	return time.Unix(silenced.Begin, 0), nil
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*silencedImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(*types.Silenced)
+	return ok
+}
@@ -33,3 +33,69 @@ func (*userImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
 	_, ok := s.(*types.User)
 	return ok
 }
+
+//
+// Implement UserPreferencesFieldResolvers
+//
+
+var _ schema.UserPreferencesFieldResolvers = (*userPreferencesImpl)(nil)
+
+type userPreferencesImpl struct {
+	schema.UserPreferencesAliases
+}
+
+// SavedSearches implements response to request for 'savedSearches' field.
+func (*userPreferencesImpl) SavedSearches(p graphql.ResolveParams) (interface{}, error) {
+	preferences := p.Source.(*types.UserPreferences)
+	return append([]types.SavedSearch{}, preferences.SavedSearches...), nil
+}
+
+// TableLayouts implements response to request for 'tableLayouts' field.
+func (*userPreferencesImpl) TableLayouts(p graphql.ResolveParams) (interface{}, error) {
+	preferences := p.Source.(*types.UserPreferences)
+	return append([]types.TableLayout{}, preferences.TableLayouts...), nil
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*userPreferencesImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(*types.UserPreferences)
+	return ok
+}
+
+//
+// Implement SavedSearchFieldResolvers
+//
+
+var _ schema.SavedSearchFieldResolvers = (*savedSearchImpl)(nil)
+
+type savedSearchImpl struct {
+	schema.SavedSearchAliases
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*savedSearchImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(types.SavedSearch)
+	return ok
+}
+
+//
+// Implement TableLayoutFieldResolvers
+//
+
+var _ schema.TableLayoutFieldResolvers = (*tableLayoutImpl)(nil)
+
+type tableLayoutImpl struct {
+	schema.TableLayoutAliases
+}
+
+// Columns implements response to request for 'columns' field.
+func (*tableLayoutImpl) Columns(p graphql.ResolveParams) ([]string, error) {
+	layout := p.Source.(types.TableLayout)
+	return append([]string{}, layout.Columns...), nil
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (*tableLayoutImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(types.TableLayout)
+	return ok
+}
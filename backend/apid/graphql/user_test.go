@@ -0,0 +1,57 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockqueue"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewerPreferences(t *testing.T) {
+	store := &mockstore.MockStore{}
+	store.On("NewQueue", mock.Anything, mock.Anything).Return(&mockqueue.MockQueue{})
+	store.On("GetUser", mock.Anything, "foo").Return(types.FixtureUser("foo"), nil)
+	var nilPreferences *types.UserPreferences
+	store.On("GetUserPreferences", mock.Anything, "foo").Return(nilPreferences, nil)
+	store.On("UpdateUserPreferences", mock.Anything).Return(nil)
+
+	svc, err := NewService(ServiceConfig{Store: store})
+	require.NoError(t, err)
+	ctx := testutil.NewContext(testutil.ContextWithActor("foo"))
+
+	// The viewer without preferences gets empty ones
+	result := svc.Do(ctx, `{ viewer { preferences { defaultOrganization savedSearches { name } } } }`, nil)
+	require.Empty(t, result.Errors)
+	viewer := result.Data.(map[string]interface{})["viewer"].(map[string]interface{})
+	preferences := viewer["preferences"].(map[string]interface{})
+	assert.Equal(t, "", preferences["defaultOrganization"])
+	assert.Empty(t, preferences["savedSearches"])
+
+	mutation := `mutation {
+		updatePreferences(input: {
+			defaultOrganization: "acme",
+			savedSearches: [{name: "failing", filter: "event.Check.Status != 0"}],
+			tableLayouts: [{table: "events", columns: ["entity", "check"]}]
+		}) {
+			preferences { defaultOrganization savedSearches { name filter } tableLayouts { columns } }
+		}
+	}`
+	result = svc.Do(ctx, mutation, nil)
+	require.Empty(t, result.Errors)
+	payload := result.Data.(map[string]interface{})["updatePreferences"].(map[string]interface{})
+	preferences = payload["preferences"].(map[string]interface{})
+	assert.Equal(t, "acme", preferences["defaultOrganization"])
+	require.Len(t, preferences["savedSearches"], 1)
+
+	store.AssertCalled(t, "UpdateUserPreferences", &types.UserPreferences{
+		Username:            "foo",
+		DefaultOrganization: "acme",
+		SavedSearches:       []types.SavedSearch{{Name: "failing", Filter: "event.Check.Status != 0"}},
+		TableLayouts:        []types.TableLayout{{Table: "events", Columns: []string{"entity", "check"}}},
+	})
+}
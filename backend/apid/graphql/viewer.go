@@ -5,6 +5,7 @@ import (
 	"github.com/sensu/sensu-go/backend/apid/graphql/relay"
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
 	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/federation"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/graphql"
 	"github.com/sensu/sensu-go/types"
@@ -18,26 +19,38 @@ var _ schema.ViewerFieldResolvers = (*viewerImpl)(nil)
 //
 
 type viewerImpl struct {
-	checksCtrl actions.CheckController
-	entityCtrl actions.EntityController
-	eventsCtrl actions.EventController
-	usersCtrl  actions.UserController
-	orgsCtrl   actions.OrganizationsController
+	checksCtrl    actions.CheckController
+	entityCtrl    actions.EntityController
+	eventsCtrl    actions.EventController
+	incidentsCtrl actions.IncidentController
+	usersCtrl     actions.UserController
+	prefsCtrl     actions.UserPreferencesController
+	orgsCtrl      actions.OrganizationsController
+	federation    *federation.Gateway
 }
 
-func newViewerImpl(store QueueStore, bus messaging.MessageBus) *viewerImpl {
+func newViewerImpl(store QueueStore, bus messaging.MessageBus, gateway *federation.Gateway) *viewerImpl {
 	return &viewerImpl{
-		checksCtrl: actions.NewCheckController(store),
-		entityCtrl: actions.NewEntityController(store),
-		eventsCtrl: actions.NewEventController(store, bus),
-		usersCtrl:  actions.NewUserController(store),
-		orgsCtrl:   actions.NewOrganizationsController(store),
+		checksCtrl:    actions.NewCheckController(store),
+		entityCtrl:    actions.NewEntityController(store),
+		eventsCtrl:    actions.NewEventController(store, bus),
+		incidentsCtrl: actions.NewIncidentController(store),
+		usersCtrl:     actions.NewUserController(store),
+		prefsCtrl:     actions.NewUserPreferencesController(store),
+		orgsCtrl:      actions.NewOrganizationsController(store),
+		federation:    gateway,
 	}
 }
 
 // Entities implements response to request for 'entities' field.
 func (r *viewerImpl) Entities(p schema.ViewerEntitiesFieldResolverParams) (interface{}, error) {
-	records, err := r.entityCtrl.Query(p.Context)
+	var records []*types.Entity
+	var err error
+	if p.Args.Query != "" {
+		records, err = r.entityCtrl.Select(p.Context, p.Args.Query)
+	} else {
+		records, err = r.entityCtrl.Query(p.Context)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -75,24 +88,20 @@ func (r *viewerImpl) Checks(p schema.ViewerChecksFieldResolverParams) (interface
 
 // Events implements response to request for 'events' field.
 func (r *viewerImpl) Events(p schema.ViewerEventsFieldResolverParams) (interface{}, error) {
-	records, err := r.eventsCtrl.Query(p.Context, "", "")
+	var records []*types.Event
+	var err error
+	if p.Args.Query != "" {
+		records, err = r.eventsCtrl.Select(p.Context, p.Args.Query)
+	} else {
+		records, err = r.eventsCtrl.Query(p.Context, "", "")
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	var filteredEvents []*types.Event
-	filter := p.Args.Filter
-	if len(filter) > 0 {
-		for _, event := range records {
-			args := map[string]interface{}{"event": event}
-			if matched, err := eval.Evaluate(filter, args); err != nil {
-				return nil, err
-			} else if matched {
-				filteredEvents = append(filteredEvents, event)
-			}
-		}
-	} else {
-		filteredEvents = records
+	filteredEvents, err := filterEvents(records, p.Args.Filter)
+	if err != nil {
+		return nil, err
 	}
 
 	info := relay.NewArrayConnectionInfo(
@@ -107,6 +116,55 @@ func (r *viewerImpl) Events(p schema.ViewerEventsFieldResolverParams) (interface
 	return relay.NewArrayConnection(edges, info), nil
 }
 
+// filterEvents returns the events matching the filter expression, all of them
+// if it's empty.
+func filterEvents(records []*types.Event, filter string) ([]*types.Event, error) {
+	if len(filter) == 0 {
+		return records, nil
+	}
+
+	var filteredEvents []*types.Event
+	for _, event := range records {
+		args := map[string]interface{}{"event": event}
+		if matched, err := eval.Evaluate(filter, args); err != nil {
+			return nil, err
+		} else if matched {
+			filteredEvents = append(filteredEvents, event)
+		}
+	}
+	return filteredEvents, nil
+}
+
+// Incidents implements response to request for 'incidents' field.
+func (r *viewerImpl) Incidents(p schema.ViewerIncidentsFieldResolverParams) (interface{}, error) {
+	records, err := r.incidentsCtrl.Query(p.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	info := relay.NewArrayConnectionInfo(
+		0, len(records),
+		p.Args.First, p.Args.Last, p.Args.Before, p.Args.After,
+	)
+
+	edges := make([]*relay.Edge, info.End-info.Begin)
+	for i, r := range records[info.Begin:info.End] {
+		edges[i] = relay.NewArrayConnectionEdge(r, i)
+	}
+	return relay.NewArrayConnection(edges, info), nil
+}
+
+// Clusters implements response to request for 'clusters' field.
+func (r *viewerImpl) Clusters(p schema.ViewerClustersFieldResolverParams) (interface{}, error) {
+	clusters := []federation.Cluster{}
+	for _, cluster := range r.federation.Clusters() {
+		if p.Args.Name == "" || p.Args.Name == cluster.Name {
+			clusters = append(clusters, cluster)
+		}
+	}
+	return clusters, nil
+}
+
 // Organizations implements response to request for 'organizations' field.
 func (r *viewerImpl) Organizations(p graphql.ResolveParams) (interface{}, error) {
 	return r.orgsCtrl.Query(p.Context)
@@ -118,3 +176,10 @@ func (r *viewerImpl) User(p graphql.ResolveParams) (interface{}, error) {
 	actor := ctx.Value(types.AuthorizationActorKey).(authorization.Actor)
 	return r.usersCtrl.Find(ctx, actor.Name)
 }
+
+// Preferences implements response to request for 'preferences' field.
+func (r *viewerImpl) Preferences(p graphql.ResolveParams) (interface{}, error) {
+	ctx := p.Context
+	actor := ctx.Value(types.AuthorizationActorKey).(authorization.Actor)
+	return r.prefsCtrl.Find(ctx, actor.Name)
+}
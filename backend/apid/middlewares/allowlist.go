@@ -10,6 +10,10 @@ import (
 // AllowList verifies that the access token provided is authorized
 type AllowList struct {
 	Store store.Store
+
+	// Anonymous is the user of the unauthenticated requests, whose claims
+	// are not backed by a token
+	Anonymous string
 }
 
 // Then ...
@@ -21,6 +25,12 @@ func (m AllowList) Then(next http.Handler) http.Handler {
 			return
 		}
 
+		// The requests made as the anonymous user have no token
+		if m.Anonymous != "" && claims.Subject == m.Anonymous && claims.Id == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
 		// Validate that the JWT is authorized
 		if _, err := m.Store.GetToken(claims.Subject, claims.Id); err != nil {
 			logger.WithField(
@@ -13,7 +13,7 @@ import (
 
 func TestAllowList(t *testing.T) {
 	// Create a token
-	token, tokenString, _ := jwt.AccessToken("foo")
+	token, tokenString, _ := jwt.AccessToken("foo", nil)
 	claims, _ := jwt.GetClaims(token)
 
 	store := &mockstore.MockStore{}
@@ -36,7 +36,7 @@ func TestAllowList(t *testing.T) {
 
 func TestMissingTokenFromAllowList(t *testing.T) {
 	// Create a token
-	token, tokenString, _ := jwt.AccessToken("foo")
+	token, tokenString, _ := jwt.AccessToken("foo", nil)
 	claims, _ := jwt.GetClaims(token)
 
 	store := &mockstore.MockStore{}
@@ -71,3 +71,18 @@ func TestAllowListNoTokenIntoContext(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
 }
+
+func TestAllowListAnonymous(t *testing.T) {
+	store := &mockstore.MockStore{}
+
+	auth := Authentication{Anonymous: "admin"}
+	allow := AllowList{Store: store, Anonymous: "admin"}
+	server := httptest.NewServer(auth.Then(allow.Then(testHandler())))
+	defer server.Close()
+
+	// The anonymous requests have no token to verify
+	res, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	store.AssertNotCalled(t, "GetToken")
+}
@@ -17,7 +17,11 @@ type AuthStore interface {
 }
 
 // Authentication is a HTTP middleware that enforces authentication
-type Authentication struct{}
+type Authentication struct {
+	// Anonymous is the user the requests without a token are made as, in the
+	// development mode of the backend. They are rejected if it's empty
+	Anonymous string
+}
 
 // Then middleware
 func (a Authentication) Then(next http.Handler) http.Handler {
@@ -32,7 +36,21 @@ func (a Authentication) Then(next http.Handler) http.Handler {
 			}
 
 			// Set the claims into the request context
-			ctx := jwt.SetClaimsIntoContext(r, token.Claims.(*types.Claims))
+			claims := token.Claims.(*types.Claims)
+			ctx := jwt.SetClaimsIntoContext(r, claims)
+			setRequestUser(ctx, claims.Subject)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+
+		if a.Anonymous != "" {
+			// The anonymous claims have no token identifier, since no token
+			// was issued
+			claims := &types.Claims{}
+			claims.Subject = a.Anonymous
+			ctx := jwt.SetClaimsIntoContext(r, claims)
+			setRequestUser(ctx, a.Anonymous)
 
 			next.ServeHTTP(w, r.WithContext(ctx))
 			return
@@ -53,7 +71,7 @@ func BasicAuthentication(next http.Handler, store AuthStore) http.Handler {
 		}
 
 		// Authenticate against the provider
-		_, err := store.AuthenticateUser(r.Context(), username, password)
+		user, err := store.AuthenticateUser(r.Context(), username, password)
 		if err != nil {
 			logger.WithField(
 				"user", username,
@@ -62,8 +80,9 @@ func BasicAuthentication(next http.Handler, store AuthStore) http.Handler {
 			return
 		}
 		// TODO: eventually break out authroization details in context from jwt claims; in this method they are too tightly bound
-		claims, _ := jwt.NewClaims(username)
+		claims, _ := jwt.NewClaims(username, user.Groups)
 		ctx := jwt.SetClaimsIntoContext(r, claims)
+		setRequestUser(ctx, username)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
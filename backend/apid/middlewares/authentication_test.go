@@ -21,13 +21,29 @@ func TestMiddlewareNoCredentials(t *testing.T) {
 	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
 }
 
+func TestMiddlewareAnonymous(t *testing.T) {
+	mware := Authentication{Anonymous: "admin"}
+	server := httptest.NewServer(mware.Then(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims := jwt.GetClaimsFromContext(r.Context())
+		if claims == nil || claims.Subject != "admin" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})))
+	defer server.Close()
+
+	// The request without credentials is made as the anonymous user
+	res, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
 func TestMiddlewareJWT(t *testing.T) {
 	mware := Authentication{}
 	server := httptest.NewServer(mware.Then(testHandler()))
 	defer server.Close()
 
 	// Valid JWT
-	_, tokenString, _ := jwt.AccessToken("foo")
+	_, tokenString, _ := jwt.AccessToken("foo", nil)
 
 	client := &http.Client{}
 	req, _ := http.NewRequest("GET", server.URL, nil)
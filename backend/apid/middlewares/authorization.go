@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/Sirupsen/logrus"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/sensu/sensu-go/backend/authorization"
 	"github.com/sensu/sensu-go/backend/store"
@@ -15,6 +16,10 @@ type Authorization struct {
 	Store store.Store
 }
 
+// ImpersonateUserHeader is the header of the requests made on behalf of
+// another user, by a user allowed to impersonate them.
+const ImpersonateUserHeader = "Sensu-Impersonate-User"
+
 // Then middleware
 func (a Authorization) Then(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -31,6 +36,17 @@ func (a Authorization) Then(next http.Handler) http.Handler {
 			return
 		}
 
+		// The role bindings of all organizations and environments grant the
+		// rules of their role to their users and the members of their groups,
+		// scoped to their environment
+		bindingsCtx := context.WithValue(ctx, types.OrganizationKey, "*")
+		bindingsCtx = context.WithValue(bindingsCtx, types.EnvironmentKey, "*")
+		bindings, err := a.Store.GetRoleBindings(bindingsCtx)
+		if err != nil {
+			http.Error(w, "Error fetching role bindings from store", http.StatusInternalServerError)
+			return
+		}
+
 		user, err := a.Store.GetUser(ctx, claims.StandardClaims.Subject)
 		if err != nil {
 			http.Error(w, "Error fetching user from store", http.StatusInternalServerError)
@@ -40,24 +56,93 @@ func (a Authorization) Then(next http.Handler) http.Handler {
 			return
 		}
 
-		userRules := []types.Rule{}
-		for _, userRoleName := range user.Roles {
-			// TODO: (JK) we're not protecting against cases where a
-			// userRoleName doesn't actually have a corresponding role
-			for _, role := range roles {
-				if userRoleName == role.Name {
-					userRules = append(userRules, role.Rules...)
-					break
-				}
-			}
+		actor := authorization.Actor{
+			Name:   claims.Subject,
+			Rules:  userRules(user, roles, bindings),
+			Groups: user.Groups,
+			Scope:  claims.Scope,
 		}
+		ctx = context.WithValue(ctx, types.AuthorizationActorKey, actor)
 
-		actor := authorization.Actor{
-			Name:  claims.Subject,
-			Rules: userRules,
+		// The decisions of the external authorizer are cached for the life of
+		// the request, e.g. while filtering the resources listed
+		ctx = authorization.WithDecisionsCache(ctx)
+
+		// The request is made as the impersonated user, if the actor is allowed
+		// to impersonate them
+		if name := r.Header.Get(ImpersonateUserHeader); name != "" {
+			impersonated, err := a.Store.GetUser(ctx, name)
+			if err != nil {
+				http.Error(w, "Error fetching user from store", http.StatusInternalServerError)
+				return
+			}
+
+			var impersonatedRules []types.Rule
+			if impersonated != nil {
+				impersonatedRules = userRules(impersonated, roles, bindings)
+			}
+
+			policy := authorization.Users.WithContext(ctx)
+			if impersonated == nil || !policy.CanImpersonate(impersonated, impersonatedRules) {
+				logger.WithFields(logrus.Fields{
+					"user":              claims.Subject,
+					"impersonated_user": name,
+				}).Warn("user impersonation denied")
+				http.Error(w, "Not authorized to impersonate the user", http.StatusForbidden)
+				return
+			}
+
+			// Record the impersonation, so the requests can be audited
+			logger.WithFields(logrus.Fields{
+				"user":              claims.Subject,
+				"impersonated_user": impersonated.Username,
+				"request_id":        types.RequestIDFromContext(ctx),
+				"path":              r.URL.Path,
+				"method":            r.Method,
+			}).Info("request made impersonating a user")
+			setRequestImpersonatedUser(ctx, impersonated.Username)
+
+			actor = authorization.Actor{
+				Name:   impersonated.Username,
+				Rules:  impersonatedRules,
+				Groups: impersonated.Groups,
+				Scope:  claims.Scope,
+			}
+			ctx = context.WithValue(ctx, types.AuthorizationActorKey, actor)
 		}
 
-		ctx = context.WithValue(ctx, types.AuthorizationActorKey, actor)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// userRules returns the rules granted to the user by its roles and by the
+// role bindings of the user and its groups. The groups of the stored user are
+// used rather than those of the claims, so that removing a user from a group
+// takes effect on the tokens already issued.
+func userRules(user *types.User, roles []*types.Role, bindings []*types.RoleBinding) []types.Rule {
+	rules := []types.Rule{}
+	for _, userRoleName := range user.Roles {
+		// TODO: (JK) we're not protecting against cases where a
+		// userRoleName doesn't actually have a corresponding role
+		for _, role := range roles {
+			if userRoleName == role.Name {
+				rules = append(rules, role.Rules...)
+				break
+			}
+		}
+	}
+
+	for _, binding := range bindings {
+		if !binding.Binds(user.Username, user.Groups) {
+			continue
+		}
+		for _, role := range roles {
+			if binding.Role == role.Name {
+				rules = append(rules, binding.Grant(role)...)
+				break
+			}
+		}
+	}
+
+	return rules
+}
@@ -3,6 +3,7 @@ package middlewares
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	jwt "github.com/dgrijalva/jwt-go"
@@ -74,6 +75,7 @@ func TestAuthorization(t *testing.T) {
 	store := &mockstore.MockStore{}
 	store.On("GetUser", mock.Anything, mock.Anything).Return(user, nil).Once()
 	store.On("GetRoles", mock.Anything).Return(roles, nil).Once()
+	store.On("GetRoleBindings", mock.Anything).Return([]*types.RoleBinding{}, nil).Once()
 
 	// create a mock http request w/user context
 	req, _ := http.NewRequest("GET", "/foo", nil)
@@ -91,3 +93,255 @@ func TestAuthorization(t *testing.T) {
 
 	assert.Equal(want, got)
 }
+
+func TestAuthorizationRoleBindings(t *testing.T) {
+	assert := assert.New(t)
+
+	user := &types.User{Username: "sensu", Password: "passw0rd"}
+
+	claims := types.Claims{
+		StandardClaims: jwt.StandardClaims{
+			Subject: user.Username,
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &claims)
+
+	roles := []*types.Role{
+		{
+			Name: "operator",
+			Rules: []types.Rule{{
+				Type:         types.RuleTypeCheck,
+				Organization: "*",
+				Environment:  "*",
+				Permissions:  types.RuleAllPerms,
+			}},
+		},
+	}
+
+	dev := types.FixtureRoleBinding("dev-operators", "operator")
+	dev.Users = []string{"sensu"}
+	dev.SetNamespace("acme", "dev")
+	prod := types.FixtureRoleBinding("prod-operators", "operator")
+	prod.Users = []string{"admin"}
+	prod.SetNamespace("acme", "prod")
+
+	store := &mockstore.MockStore{}
+	store.On("GetUser", mock.Anything, mock.Anything).Return(user, nil).Once()
+	store.On("GetRoles", mock.Anything).Return(roles, nil).Once()
+	store.On("GetRoleBindings", mock.Anything).Return([]*types.RoleBinding{dev, prod}, nil).Once()
+
+	req, _ := http.NewRequest("GET", "/foo", nil)
+	ctx := sensujwt.SetClaimsIntoContext(req, token.Claims.(*types.Claims))
+
+	next := TestHandler{}
+	mware := Authorization{Store: store}
+	handler := mware.Then(&next)
+	handler.ServeHTTP(TestResponseWriter{}, req.WithContext(ctx))
+
+	// Only the binding of the user grants the role, in its environment
+	want := authorization.Actor{Name: "sensu", Rules: []types.Rule{{
+		Type:         types.RuleTypeCheck,
+		Organization: "acme",
+		Environment:  "dev",
+		Permissions:  types.RuleAllPerms,
+	}}}
+	got := next.reqCtx.Value(types.AuthorizationActorKey)
+
+	assert.Equal(want, got)
+}
+
+func TestAuthorizationRoleBindingGroups(t *testing.T) {
+	role := types.FixtureRole("operator", "*", "*")
+	binding := types.FixtureRoleBinding("ops-operators", "operator")
+	binding.Users = nil
+	binding.Groups = []string{"ops"}
+
+	testCases := []struct {
+		name        string
+		userGroups  []string
+		claimGroups []string
+		want        []types.Rule
+	}{
+		{"member of the group", []string{"ops"}, nil, binding.Grant(role)},
+		{"removed from the group", nil, []string{"ops"}, []types.Rule{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			user := &types.User{Username: "sensu", Password: "passw0rd", Groups: tc.userGroups}
+
+			claims := types.Claims{
+				StandardClaims: jwt.StandardClaims{
+					Subject: user.Username,
+				},
+				Groups: tc.claimGroups,
+			}
+
+			token := jwt.NewWithClaims(jwt.SigningMethodHS256, &claims)
+
+			store := &mockstore.MockStore{}
+			store.On("GetUser", mock.Anything, mock.Anything).Return(user, nil).Once()
+			store.On("GetRoles", mock.Anything).Return([]*types.Role{role}, nil).Once()
+			store.On("GetRoleBindings", mock.Anything).Return([]*types.RoleBinding{binding}, nil).Once()
+
+			req, _ := http.NewRequest("GET", "/foo", nil)
+			ctx := sensujwt.SetClaimsIntoContext(req, token.Claims.(*types.Claims))
+
+			next := TestHandler{}
+			mware := Authorization{Store: store}
+			handler := mware.Then(&next)
+			handler.ServeHTTP(TestResponseWriter{}, req.WithContext(ctx))
+
+			// The groups of the stored user grant the role, not those of the
+			// token
+			want := authorization.Actor{Name: "sensu", Rules: tc.want, Groups: tc.userGroups}
+			got := next.reqCtx.Value(types.AuthorizationActorKey)
+
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestAuthorizationScope(t *testing.T) {
+	user := &types.User{Username: "sensu", Password: "passw0rd", Roles: []string{"admin"}}
+	role := types.FixtureRole("admin", "*", "*")
+	scope := []types.Rule{types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead)}
+
+	claims := types.Claims{
+		StandardClaims: jwt.StandardClaims{
+			Subject: user.Username,
+		},
+		Scope: scope,
+	}
+
+	store := &mockstore.MockStore{}
+	store.On("GetUser", mock.Anything, mock.Anything).Return(user, nil).Once()
+	store.On("GetRoles", mock.Anything).Return([]*types.Role{role}, nil).Once()
+	store.On("GetRoleBindings", mock.Anything).Return([]*types.RoleBinding{}, nil).Once()
+
+	req, _ := http.NewRequest("GET", "/foo", nil)
+	ctx := sensujwt.SetClaimsIntoContext(req, &claims)
+
+	next := TestHandler{}
+	mware := Authorization{Store: store}
+	handler := mware.Then(&next)
+	handler.ServeHTTP(TestResponseWriter{}, req.WithContext(ctx))
+
+	// The scope of the token restricts the rules of the user
+	want := authorization.Actor{Name: "sensu", Rules: role.Rules, Scope: scope}
+	got := next.reqCtx.Value(types.AuthorizationActorKey)
+
+	assert.Equal(t, want, got)
+}
+
+func TestAuthorizationImpersonation(t *testing.T) {
+	admin := &types.User{Username: "admin", Roles: []string{"admin"}}
+	operator := &types.User{Username: "operator", Roles: []string{"operator"}}
+	disabled := &types.User{Username: "disabled", Roles: []string{"operator"}, Disabled: true}
+	support := &types.User{Username: "support", Roles: []string{"support"}}
+
+	roles := []*types.Role{
+		{
+			Name: "admin",
+			Rules: []types.Rule{
+				types.FixtureRuleWithPerms(types.RuleTypeUser, types.RulePermImpersonate),
+			},
+		},
+		{
+			Name: "operator",
+			Rules: []types.Rule{
+				types.FixtureRuleWithPerms(types.RuleTypeCheck, types.RulePermRead),
+			},
+		},
+		{
+			Name: "support",
+			Rules: []types.Rule{
+				{Type: types.RuleTypeUser, Organization: "default", Environment: "dev", Permissions: []string{types.RulePermImpersonate}},
+				types.FixtureRuleWithPerms(types.RuleTypeCheck, types.RulePermRead),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		user          *types.User
+		impersonated  string
+		expectedCode  int
+		expectedActor string
+	}{
+		{
+			name:          "impersonated",
+			user:          admin,
+			impersonated:  "operator",
+			expectedCode:  http.StatusOK,
+			expectedActor: "operator",
+		},
+		{
+			name:         "not allowed",
+			user:         operator,
+			impersonated: "admin",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:          "impersonated with the rules of the user",
+			user:          support,
+			impersonated:  "operator",
+			expectedCode:  http.StatusOK,
+			expectedActor: "operator",
+		},
+		{
+			name:         "more privileged user",
+			user:         support,
+			impersonated: "admin",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "missing user",
+			user:         admin,
+			impersonated: "missing",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "disabled user",
+			user:         admin,
+			impersonated: "disabled",
+			expectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var nilUser *types.User
+			store := &mockstore.MockStore{}
+			store.On("GetRoles", mock.Anything).Return(roles, nil)
+			store.On("GetRoleBindings", mock.Anything).Return([]*types.RoleBinding{}, nil)
+			store.On("GetUser", mock.Anything, tc.user.Username).Return(tc.user, nil)
+			store.On("GetUser", mock.Anything, "operator").Return(operator, nil)
+			store.On("GetUser", mock.Anything, "admin").Return(admin, nil)
+			store.On("GetUser", mock.Anything, "disabled").Return(disabled, nil)
+			store.On("GetUser", mock.Anything, "missing").Return(nilUser, nil)
+
+			claims := &types.Claims{StandardClaims: jwt.StandardClaims{Subject: tc.user.Username}}
+			req := httptest.NewRequest("GET", "/checks", nil)
+			req.Header.Set(ImpersonateUserHeader, tc.impersonated)
+			ctx := context.WithValue(req.Context(), types.OrganizationKey, "default")
+			ctx = context.WithValue(ctx, types.EnvironmentKey, "dev")
+			ctx = sensujwt.SetClaimsIntoContext(req.WithContext(ctx), claims)
+
+			next := &TestHandler{}
+			handler := Authorization{Store: store}.Then(next)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req.WithContext(ctx))
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+			if tc.expectedActor == "" {
+				assert.Nil(t, next.reqCtx)
+				return
+			}
+			actor := next.reqCtx.Value(types.AuthorizationActorKey).(authorization.Actor)
+			assert.Equal(t, tc.expectedActor, actor.Name)
+			assert.Equal(t, roles[1].Rules, actor.Rules)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package middlewares
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Draining is a HTTP middleware rejecting the requests while the backend is
+// shutting down, so the clients retry them against another backend.
+type Draining struct {
+	// Draining reports whether the backend is shutting down. The backend is
+	// considered running when nil.
+	Draining func() bool
+
+	// RetryAfter is the delay after which the clients are told to retry.
+	RetryAfter time.Duration
+}
+
+// Then middleware
+func (m Draining) Then(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m.Draining == nil || !m.Draining() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if m.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(m.RetryAfter.Seconds())))
+		}
+		http.Error(w, "Backend shutting down", http.StatusServiceUnavailable)
+	})
+}
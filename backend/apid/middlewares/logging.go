@@ -2,15 +2,43 @@ package middlewares
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"net"
 	"net/http"
 	"time"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/types"
 )
 
-// SimpleLogger log request path and duration
+type requestUserKey struct{}
+
+// requestUser holds the name of the user that made a request, once it is known
+// to the authentication middlewares further down the stack, and the name of
+// the user it impersonates, if any.
+type requestUser struct {
+	name         string
+	impersonated string
+}
+
+// setRequestUser records the user that made the request, so that it can be
+// logged by SimpleLogger.
+func setRequestUser(ctx context.Context, name string) {
+	if user, ok := ctx.Value(requestUserKey{}).(*requestUser); ok {
+		user.name = name
+	}
+}
+
+// setRequestImpersonatedUser records the user impersonated by the request, so
+// that it can be logged by SimpleLogger.
+func setRequestImpersonatedUser(ctx context.Context, name string) {
+	if user, ok := ctx.Value(requestUserKey{}).(*requestUser); ok {
+		user.impersonated = name
+	}
+}
+
+// SimpleLogger log request path, duration, user and ID
 type SimpleLogger struct{}
 
 // Then middleware
@@ -21,8 +49,10 @@ func (m SimpleLogger) Then(next http.Handler) http.Handler {
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
+		user := &requestUser{}
+		ctx := context.WithValue(r.Context(), requestUserKey{}, user)
 		writerWithCapture := makeResponseWriterWithCapture(w)
-		next.ServeHTTP(writerWithCapture, r)
+		next.ServeHTTP(writerWithCapture, r.WithContext(ctx))
 
 		duration := float64(time.Since(start)) / float64(time.Millisecond)
 		logEntry := logger.WithFields(logrus.Fields{
@@ -32,6 +62,15 @@ func (m SimpleLogger) Then(next http.Handler) http.Handler {
 			"path":     r.URL.Path,
 			"method":   r.Method,
 		})
+		if id := types.RequestIDFromContext(ctx); id != "" {
+			logEntry = logEntry.WithField("request_id", id)
+		}
+		if user.name != "" {
+			logEntry = logEntry.WithField("user", user.name)
+		}
+		if user.impersonated != "" {
+			logEntry = logEntry.WithField("impersonated_user", user.impersonated)
+		}
 		logEntry.Info("request completed")
 	})
 }
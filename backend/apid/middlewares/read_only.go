@@ -0,0 +1,58 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// ReadOnly is a HTTP middleware that degrades the API to read-only requests
+// while the store is unavailable. Write requests are rejected, and read
+// requests are allowed to be served from possibly stale data.
+type ReadOnly struct {
+	// StoreHealthy reports whether the store is available. The store is
+	// considered available when nil.
+	StoreHealthy func() bool
+
+	// Replica indicates that the API is served by a read-only replica, which
+	// always rejects write requests. The GraphQL queries are still allowed,
+	// but their mutations are rejected.
+	Replica bool
+}
+
+// Then middleware
+func (m ReadOnly) Then(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m.Replica {
+			m.serveReplica(next, w, r)
+			return
+		}
+
+		if m.StoreHealthy == nil || m.StoreHealthy() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			ctx := context.WithValue(r.Context(), types.StaleReadsKey, true)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		default:
+			logger.WithField("path", r.URL.Path).Warn("store unavailable, rejecting write request")
+			http.Error(w, "Store unavailable, the API is read-only", http.StatusServiceUnavailable)
+		}
+	})
+}
+
+func (m ReadOnly) serveReplica(next http.Handler, w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet, r.Method == http.MethodHead, r.Method == http.MethodOptions:
+		next.ServeHTTP(w, r)
+	case r.Method == http.MethodPost && r.URL.Path == "/graphql":
+		ctx := context.WithValue(r.Context(), types.ReadOnlyKey, true)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	default:
+		http.Error(w, "The API of this replica is read-only", http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,74 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadOnly(t *testing.T) {
+	tests := []struct {
+		name          string
+		healthy       bool
+		method        string
+		expectedCode  int
+		expectedStale bool
+	}{
+		{"healthy read", true, http.MethodGet, http.StatusOK, false},
+		{"healthy write", true, http.MethodPut, http.StatusOK, false},
+		{"degraded read", false, http.MethodGet, http.StatusOK, true},
+		{"degraded write", false, http.MethodPost, http.StatusServiceUnavailable, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var stale bool
+			mware := ReadOnly{StoreHealthy: func() bool { return tc.healthy }}
+			handler := mware.Then(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				stale, _ = r.Context().Value(types.StaleReadsKey).(bool)
+			}))
+
+			req, _ := http.NewRequest(tc.method, "/checks", nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+			assert.Equal(t, tc.expectedStale, stale)
+		})
+	}
+}
+
+func TestReadOnlyReplica(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		path             string
+		expectedCode     int
+		expectedReadOnly bool
+	}{
+		{"read", http.MethodGet, "/checks", http.StatusOK, false},
+		{"write", http.MethodPut, "/checks/check1", http.StatusMethodNotAllowed, false},
+		{"delete", http.MethodDelete, "/checks/check1", http.StatusMethodNotAllowed, false},
+		{"graphql", http.MethodPost, "/graphql", http.StatusOK, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var readOnly bool
+			mware := ReadOnly{StoreHealthy: func() bool { return true }, Replica: true}
+			handler := mware.Then(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				readOnly, _ = r.Context().Value(types.ReadOnlyKey).(bool)
+			}))
+
+			req, _ := http.NewRequest(tc.method, tc.path, nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+			assert.Equal(t, tc.expectedReadOnly, readOnly)
+		})
+	}
+}
@@ -44,7 +44,7 @@ func TestRefreshTokenNoRefreshToken(t *testing.T) {
 	server := httptest.NewServer(mware.Then(testHandler()))
 	defer server.Close()
 
-	_, tokenString, _ := jwt.AccessToken("foo")
+	_, tokenString, _ := jwt.AccessToken("foo", nil)
 
 	req, _ := http.NewRequest(http.MethodPost, server.URL, nil)
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tokenString))
@@ -66,7 +66,7 @@ func TestRefreshTokenInvalidRefreshToken(t *testing.T) {
 	server := httptest.NewServer(mware.Then(testHandler()))
 	defer server.Close()
 
-	_, tokenString, _ := jwt.AccessToken("foo")
+	_, tokenString, _ := jwt.AccessToken("foo", nil)
 	refreshTokenString := "foobar"
 	body := &types.Tokens{Refresh: refreshTokenString}
 	payload, _ := json.Marshal(body)
@@ -84,8 +84,8 @@ func TestRefreshTokenMismatchingSub(t *testing.T) {
 	server := httptest.NewServer(mware.Then(testHandler()))
 	defer server.Close()
 
-	_, tokenString, _ := jwt.AccessToken("foo")
-	_, refreshTokenString, _ := jwt.RefreshToken("bar")
+	_, tokenString, _ := jwt.AccessToken("foo", nil)
+	_, refreshTokenString, _ := jwt.RefreshToken("bar", nil)
 	body := &types.Tokens{Refresh: refreshTokenString}
 	payload, _ := json.Marshal(body)
 
@@ -117,8 +117,8 @@ func TestRefreshTokenSuccess(t *testing.T) {
 	))
 	defer server.Close()
 
-	_, tokenString, _ := jwt.AccessToken("foo")
-	_, refreshTokenString, _ := jwt.RefreshToken("foo")
+	_, tokenString, _ := jwt.AccessToken("foo", nil)
+	_, refreshTokenString, _ := jwt.RefreshToken("foo", nil)
 	body := &types.Tokens{Refresh: refreshTokenString}
 	payload, _ := json.Marshal(body)
 
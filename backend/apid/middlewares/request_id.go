@@ -0,0 +1,38 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// RequestIDHeader is the header used to return the ID assigned to a
+	// request, so that client reports can be cross-referenced with the logs.
+	RequestIDHeader = "X-Request-Id"
+
+	// maxRequestIDLength is the maximum length of a request ID given by a
+	// client that is reused as is.
+	maxRequestIDLength = 128
+)
+
+// RequestID is a HTTP middleware that assigns an ID to every request. The ID
+// is stored in the request context and returned in the X-Request-Id response
+// header. An ID given by the client in the same header is reused.
+type RequestID struct{}
+
+// Then middleware
+func (RequestID) Then(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if id == "" || len(id) > maxRequestIDLength {
+			id = uuid.New().String()
+		}
+
+		w.Header().Set(RequestIDHeader, id)
+		ctx := context.WithValue(r.Context(), types.RequestIDKey, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,48 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     string
+		expectedID string
+	}{
+		{name: "generated", header: ""},
+		{name: "given by client", header: "abc123", expectedID: "abc123"},
+		{name: "too long", header: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var ctxID string
+			handler := RequestID{}.Then(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctxID = types.RequestIDFromContext(r.Context())
+			}))
+
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			if tc.header != "" {
+				req.Header.Set(RequestIDHeader, tc.header)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			id := w.Header().Get(RequestIDHeader)
+			assert.NotEmpty(t, id)
+			assert.Equal(t, id, ctxID)
+			if tc.expectedID != "" {
+				assert.Equal(t, tc.expectedID, id)
+			} else {
+				assert.NotEqual(t, tc.header, id)
+			}
+		})
+	}
+}
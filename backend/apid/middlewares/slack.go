@@ -0,0 +1,119 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/types"
+)
+
+// slackMaxRequestAge is the maximum age of the Slack requests, the older ones
+// being rejected so that they can't be replayed.
+const slackMaxRequestAge = 5 * time.Minute
+
+// SlackUserStore provides the users the Slack users are mapped to.
+type SlackUserStore interface {
+	GetUser(ctx context.Context, username string) (*types.User, error)
+}
+
+// SlackAuthentication is an HTTP middleware that authenticates the requests
+// of Slack, verified with the signing secret of the Slack app, as the Sensu
+// user the Slack user is mapped to. It must run before LimitRequest, which
+// consumes the body the signature is computed from.
+type SlackAuthentication struct {
+	SigningSecret string
+
+	// Users maps the Slack user IDs to the Sensu usernames
+	Users map[string]string
+
+	Store SlackUserStore
+}
+
+// Then middleware
+func (s SlackAuthentication) Then(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, MaxBytesLimit))
+		if err != nil {
+			http.Error(w, "Request exceeded max length", http.StatusRequestEntityTooLarge)
+			return
+		}
+		if !s.verify(r.Header, body, time.Now()) {
+			logger.Warn("invalid slack request signature")
+			http.Error(w, "Invalid signature", http.StatusUnauthorized)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		// The Slack users which aren't mapped are told so, since Slack only
+		// reports a generic error otherwise
+		values, _ := url.ParseQuery(string(body))
+		username, ok := s.Users[values.Get("user_id")]
+		if !ok {
+			logger.WithField("slack_user", values.Get("user_id")).Warn("slack user not mapped to a sensu user")
+			writeSlackMessage(w, "Your Slack user is not mapped to a Sensu user.")
+			return
+		}
+
+		user, err := s.Store.GetUser(r.Context(), username)
+		if err != nil {
+			http.Error(w, "Error fetching user from store", http.StatusInternalServerError)
+			return
+		}
+		if user == nil || user.Disabled {
+			writeSlackMessage(w, "Your Sensu user does not exist or is disabled.")
+			return
+		}
+
+		claims, err := jwt.NewClaims(user.Username, user.Groups)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		ctx := jwt.SetClaimsIntoContext(r, claims)
+		setRequestUser(ctx, user.Username)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// verify determines if the body was signed with the signing secret, by a
+// recent request. See https://api.slack.com/authentication/verifying-requests-from-slack
+func (s SlackAuthentication) verify(header http.Header, body []byte, now time.Time) bool {
+	if s.SigningSecret == "" {
+		return false
+	}
+
+	timestamp := header.Get("X-Slack-Request-Timestamp")
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if age := now.Sub(time.Unix(seconds, 0)); age > slackMaxRequestAge || age < -slackMaxRequestAge {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(s.SigningSecret))
+	_, _ = mac.Write([]byte("v0:" + timestamp + ":"))
+	_, _ = mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(header.Get("X-Slack-Signature")))
+}
+
+// writeSlackMessage responds with a message only shown to the Slack user.
+func writeSlackMessage(w http.ResponseWriter, text string) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"response_type": "ephemeral",
+		"text":          text,
+	})
+}
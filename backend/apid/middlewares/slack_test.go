@@ -0,0 +1,107 @@
+package middlewares
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type slackTestStore map[string]*types.User
+
+func (s slackTestStore) GetUser(ctx context.Context, username string) (*types.User, error) {
+	return s[username], nil
+}
+
+func slackRequest(secret, userID string, timestamp time.Time) *http.Request {
+	body := url.Values{"user_id": {userID}, "text": {"status"}}.Encode()
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte("v0:" + ts + ":" + body))
+
+	req := httptest.NewRequest(http.MethodPost, "/slack/commands", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Slack-Request-Timestamp", ts)
+	req.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+func TestSlackAuthentication(t *testing.T) {
+	disabled := types.FixtureUser("bob")
+	disabled.Disabled = true
+	mware := SlackAuthentication{
+		SigningSecret: "secret",
+		Users:         map[string]string{"U1": "foo", "U2": "bob", "U3": "ghost"},
+		Store:         slackTestStore{"foo": types.FixtureUser("foo"), "bob": disabled},
+	}
+	handler := mware.Then(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims := jwt.GetClaimsFromContext(r.Context())
+		if claims == nil || claims.Subject != "foo" || r.PostFormValue("text") != "status" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+
+	tests := []struct {
+		name     string
+		req      *http.Request
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "valid signature",
+			req:      slackRequest("secret", "U1", time.Now()),
+			wantCode: http.StatusOK,
+			wantBody: "ok",
+		},
+		{
+			name:     "invalid signature",
+			req:      slackRequest("other", "U1", time.Now()),
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "stale request",
+			req:      slackRequest("secret", "U1", time.Now().Add(-10*time.Minute)),
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "unmapped user",
+			req:      slackRequest("secret", "U4", time.Now()),
+			wantCode: http.StatusOK,
+			wantBody: "not mapped to a Sensu user",
+		},
+		{
+			name:     "disabled user",
+			req:      slackRequest("secret", "U2", time.Now()),
+			wantCode: http.StatusOK,
+			wantBody: "does not exist or is disabled",
+		},
+		{
+			name:     "missing user",
+			req:      slackRequest("secret", "U3", time.Now()),
+			wantCode: http.StatusOK,
+			wantBody: "does not exist or is disabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, tt.req)
+			require.Equal(t, tt.wantCode, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.wantBody)
+		})
+	}
+}
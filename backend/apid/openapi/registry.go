@@ -0,0 +1,217 @@
+package openapi
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/gorilla/mux"
+)
+
+const jsonContentType = "application/json"
+
+// Route describes the payloads of an operation, whose path and method are
+// those of the route it is registered for.
+type Route struct {
+	// Summary is a short description of the operation.
+	Summary string
+
+	// Request is a value of the type of the request body, or nil if the
+	// operation has no body.
+	Request interface{}
+
+	// Response is a value of the type of the response body, or nil if the
+	// operation responds with no content.
+	Response interface{}
+}
+
+// Registry holds the descriptions of the routes of the API, keyed by method
+// and path template. It is safe for concurrent use.
+type Registry struct {
+	mu     sync.RWMutex
+	routes map[string]Route
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{routes: make(map[string]Route)}
+}
+
+// Describe registers the description of the operation at the given path
+// template for each of the methods.
+func (r *Registry) Describe(path string, route Route, methods ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, method := range methods {
+		r.routes[method+" "+path] = route
+	}
+}
+
+func (r *Registry) lookup(method, path string) (Route, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	route, ok := r.routes[method+" "+path]
+	return route, ok
+}
+
+// Generate returns the document of the routes of the router. The payloads of
+// the routes that were not described are documented as arbitrary JSON.
+func (r *Registry) Generate(router *mux.Router, info Info) (*Document, error) {
+	doc := &Document{
+		OpenAPI:    Version,
+		Info:       info,
+		Paths:      make(map[string]*PathItem),
+		Components: Components{Schemas: Schemas{}},
+	}
+	schemas := Schemas(doc.Components.Schemas)
+
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			// Subrouters without a path of their own
+			return nil
+		}
+		methods, err := route.GetMethods()
+		if err != nil || len(methods) == 0 {
+			// Routes matching any method, e.g. the not found handler
+			return nil
+		}
+
+		item, ok := doc.Paths[documentPath(path)]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[documentPath(path)] = item
+		}
+
+		for _, method := range methods {
+			op := item.operation(method)
+			if op == nil {
+				continue
+			}
+			if *op != nil {
+				// Only the first route registered is ever matched
+				continue
+			}
+			description, ok := r.lookup(method, path)
+			if !ok {
+				description = Route{Response: map[string]interface{}{}}
+			}
+			*op = newOperation(schemas, method, path, description)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+func newOperation(schemas Schemas, method, path string, route Route) *Operation {
+	op := &Operation{
+		OperationID: operationID(method, path),
+		Summary:     route.Summary,
+		Responses:   make(map[string]*Response),
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 0 && segments[0] != "" {
+		op.Tags = []string{segments[0]}
+	}
+	for _, segment := range segments {
+		if name, ok := pathVariable(segment); ok {
+			op.Parameters = append(op.Parameters, Parameter{
+				Name:     name,
+				In:       "path",
+				Required: true,
+				Schema:   &Schema{Type: "string"},
+			})
+		}
+	}
+
+	if route.Request != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]MediaType{
+				jsonContentType: {Schema: schemas.SchemaOf(route.Request)},
+			},
+		}
+	}
+
+	if route.Response != nil {
+		op.Responses["200"] = &Response{
+			Description: "OK",
+			Content: map[string]MediaType{
+				jsonContentType: {Schema: schemas.SchemaOf(route.Response)},
+			},
+		}
+	} else {
+		op.Responses["204"] = &Response{Description: "No Content"}
+	}
+	op.Responses["default"] = &Response{
+		Description: "Error",
+		Content: map[string]MediaType{
+			jsonContentType: {Schema: errorSchema},
+		},
+	}
+
+	return op
+}
+
+// errorSchema is the schema of the errors returned by the API
+var errorSchema = &Schema{
+	Type: "object",
+	Properties: map[string]*Schema{
+		"error":  {Type: "string"},
+		"code":   {Type: "integer", Format: "int32"},
+		"fields": {Type: "object", AdditionalProperties: &Schema{Type: "string"}},
+	},
+}
+
+// pathVariable returns the name of the variable of a path segment, without
+// its pattern, e.g. "id" for "{id:.+}".
+func pathVariable(segment string) (string, bool) {
+	if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") {
+		return "", false
+	}
+	name := segment[1 : len(segment)-1]
+	if i := strings.Index(name, ":"); i >= 0 {
+		name = name[:i]
+	}
+	return name, true
+}
+
+// documentPath strips the patterns of the variables of a path template.
+func documentPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if name, ok := pathVariable(segment); ok {
+			segments[i] = "{" + name + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// operationID derives a unique identifier from the method and path, e.g.
+// "getChecksId" for GET /checks/{id}.
+func operationID(method, path string) string {
+	id := strings.ToLower(method)
+	for _, segment := range strings.Split(path, "/") {
+		if name, ok := pathVariable(segment); ok {
+			segment = name
+		}
+		for _, word := range strings.FieldsFunc(segment, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			id += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return id
+}
+
+// SliceOf returns an empty slice of the type of the value, to describe the
+// responses listing resources.
+func SliceOf(v interface{}) interface{} {
+	return reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(v)), 0, 0).Interface()
+}
@@ -0,0 +1,80 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type widget struct {
+	Name     string            `json:"name"`
+	Size     int64             `json:"size,omitempty"`
+	Labels   map[string]string `json:"labels"`
+	Parts    []*widget         `json:"parts"`
+	Data     json.RawMessage   `json:"data"`
+	Internal string            `json:"-"`
+	private  string
+	embedded
+}
+
+type embedded struct {
+	Color string `json:"color"`
+}
+
+func TestSchemaOf(t *testing.T) {
+	schemas := Schemas{}
+	schema := schemas.SchemaOf([]widget{})
+	assert.Equal(t, "array", schema.Type)
+	assert.Equal(t, "#/components/schemas/widget", schema.Items.Ref)
+
+	require.Contains(t, schemas, "widget")
+	w := schemas["widget"]
+	assert.Equal(t, &Schema{Type: "string"}, w.Properties["name"])
+	assert.Equal(t, &Schema{Type: "integer", Format: "int64"}, w.Properties["size"])
+	assert.Equal(t, &Schema{Type: "string"}, w.Properties["labels"].AdditionalProperties)
+	assert.Equal(t, "#/components/schemas/widget", w.Properties["parts"].Items.Ref)
+	assert.Equal(t, &Schema{}, w.Properties["data"])
+	assert.Contains(t, w.Properties, "color")
+	assert.Len(t, w.Properties, 6)
+}
+
+func TestGenerate(t *testing.T) {
+	noop := func(http.ResponseWriter, *http.Request) {}
+	router := mux.NewRouter()
+	router.HandleFunc("/widgets", noop).Methods(http.MethodGet)
+	router.HandleFunc("/widgets/{id:.+}", noop).Methods(http.MethodPut, http.MethodPatch)
+	router.HandleFunc("/widgets/{id:.+}", noop).Methods(http.MethodDelete)
+	router.HandleFunc("/ping", noop)
+
+	registry := NewRegistry()
+	registry.Describe("/widgets", Route{Summary: "list widgets", Response: SliceOf(widget{})}, http.MethodGet)
+	registry.Describe("/widgets/{id:.+}", Route{Request: widget{}, Response: widget{}}, http.MethodPut, http.MethodPatch)
+
+	doc, err := registry.Generate(router, Info{Title: "widgets", Version: "1.0.0"})
+	require.NoError(t, err)
+	assert.Len(t, doc.Paths, 2)
+
+	list := doc.Paths["/widgets"].Get
+	require.NotNil(t, list)
+	assert.Equal(t, "list widgets", list.Summary)
+	assert.Equal(t, []string{"widgets"}, list.Tags)
+	assert.Empty(t, list.Parameters)
+	assert.Equal(t, "array", list.Responses["200"].Content[jsonContentType].Schema.Type)
+
+	item := doc.Paths["/widgets/{id}"]
+	require.NotNil(t, item)
+	require.NotNil(t, item.Patch)
+	assert.Equal(t, "patchWidgetsId", item.Patch.OperationID)
+	assert.Equal(t, []Parameter{{Name: "id", In: "path", Required: true, Schema: &Schema{Type: "string"}}}, item.Put.Parameters)
+	assert.Equal(t, "#/components/schemas/widget", item.Put.RequestBody.Content[jsonContentType].Schema.Ref)
+
+	// Undescribed routes respond with arbitrary JSON
+	require.NotNil(t, item.Delete)
+	assert.Nil(t, item.Delete.RequestBody)
+	assert.Equal(t, "object", item.Delete.Responses["200"].Content[jsonContentType].Schema.Type)
+	assert.Contains(t, doc.Components.Schemas, "widget")
+}
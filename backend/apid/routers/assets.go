@@ -24,7 +24,7 @@ func NewAssetRouter(store store.AssetStore) *AssetsRouter {
 
 // Mount the AssetsRouter to a parent Router
 func (r *AssetsRouter) Mount(parent *mux.Router) {
-	routes := resourceRoute{router: parent, pathPrefix: "/assets"}
+	routes := resourceRoute{router: parent, pathPrefix: "/assets", resource: types.Asset{}}
 	routes.index(r.list)
 	routes.show(r.find)
 	routes.create(r.create)
@@ -48,7 +48,7 @@ func (a *AuthenticationRouter) login(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Create the token and a signed version
-	token, tokenString, err := jwt.AccessToken(user.Username)
+	token, tokenString, err := jwt.AccessToken(user.Username, user.Groups)
 	if err != nil {
 		err = fmt.Errorf("could not issue an access token: %s", err.Error())
 		logger.WithField("user", username).Error(err)
@@ -65,7 +65,7 @@ func (a *AuthenticationRouter) login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	refreshToken, refreshTokenString, err := jwt.RefreshToken(user.Username)
+	refreshToken, refreshTokenString, err := jwt.RefreshToken(user.Username, user.Groups)
 	if err != nil {
 		err = fmt.Errorf("could not issue a refresh token: %s", err.Error())
 		logger.WithField("user", username).Error(err)
@@ -182,6 +182,20 @@ func (a *AuthenticationRouter) token(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Reload the user, so that the new access token isn't issued to a disabled
+	// user, nor with groups the user was removed from
+	user, err := a.store.GetUser(r.Context(), refreshClaims.Subject)
+	if err != nil {
+		err = fmt.Errorf("could not retrieve the user: %s", err.Error())
+		logger.WithField("user", refreshClaims.Subject).Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	} else if user == nil || user.Disabled {
+		logger.WithField("user", refreshClaims.Subject).Error("the user of the refresh token does not exist or is disabled")
+		http.Error(w, "Request unauthorized", http.StatusUnauthorized)
+		return
+	}
+
 	// Remove the old access token from the access list
 	if err := a.store.DeleteTokens(accessClaims.Subject, []string{accessClaims.Id}); err != nil {
 		err = fmt.Errorf("could not remove the access token from the access list: %s", err.Error())
@@ -191,7 +205,7 @@ func (a *AuthenticationRouter) token(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Issue a new access token
-	accessToken, accessTokenString, err := jwt.AccessToken(refreshClaims.Subject)
+	accessToken, accessTokenString, err := jwt.AccessToken(user.Username, user.Groups)
 	if err != nil {
 		err = fmt.Errorf("could not issue a new access token: %s", err.Error())
 		logger.WithField("user", refreshClaims.Subject).Error(err)
@@ -81,8 +81,8 @@ func TestLogoutNotWhitelisted(t *testing.T) {
 		mock.AnythingOfType("[]string"),
 	).Return(fmt.Errorf("error"))
 
-	_, tokenString, _ := jwt.AccessToken("foo")
-	_, refreshTokenString, _ := jwt.RefreshToken("foo")
+	_, tokenString, _ := jwt.AccessToken("foo", nil)
+	_, refreshTokenString, _ := jwt.RefreshToken("foo", nil)
 	body := &types.Tokens{Refresh: refreshTokenString}
 	payload, _ := json.Marshal(body)
 
@@ -104,8 +104,8 @@ func TestLogoutSuccess(t *testing.T) {
 		mock.AnythingOfType("[]string"),
 	).Return(nil)
 
-	_, tokenString, _ := jwt.AccessToken("foo")
-	_, refreshTokenString, _ := jwt.RefreshToken("foo")
+	_, tokenString, _ := jwt.AccessToken("foo", nil)
+	_, refreshTokenString, _ := jwt.RefreshToken("foo", nil)
 	body := &types.Tokens{Refresh: refreshTokenString}
 	payload, _ := json.Marshal(body)
 
@@ -127,8 +127,8 @@ func TestTokenRefreshTokenNotWhitelisted(t *testing.T) {
 		mock.AnythingOfType("string"),
 	).Return(&types.Claims{}, fmt.Errorf("error"))
 
-	_, tokenString, _ := jwt.AccessToken("foo")
-	_, refreshTokenString, _ := jwt.RefreshToken("foo")
+	_, tokenString, _ := jwt.AccessToken("foo", nil)
+	_, refreshTokenString, _ := jwt.RefreshToken("foo", nil)
 	body := &types.Tokens{Refresh: refreshTokenString}
 	payload, _ := json.Marshal(body)
 
@@ -155,9 +155,10 @@ func TestTokenCannotWhitelistAccessToken(t *testing.T) {
 		mock.AnythingOfType("string"),
 		mock.AnythingOfType("string"),
 	).Return(&types.Claims{}, nil)
+	store.On("GetUser", mock.Anything, "foo").Return(types.FixtureUser("foo"), nil)
 
-	_, tokenString, _ := jwt.AccessToken("foo")
-	_, refreshTokenString, _ := jwt.RefreshToken("foo")
+	_, tokenString, _ := jwt.AccessToken("foo", nil)
+	_, refreshTokenString, _ := jwt.RefreshToken("foo", nil)
 	body := &types.Tokens{Refresh: refreshTokenString}
 	payload, _ := json.Marshal(body)
 
@@ -184,9 +185,10 @@ func TestTokenSuccess(t *testing.T) {
 		mock.AnythingOfType("string"),
 		mock.AnythingOfType("string"),
 	).Return(&types.Claims{}, nil)
+	store.On("GetUser", mock.Anything, "foo").Return(types.FixtureUser("foo"), nil)
 
-	_, tokenString, _ := jwt.AccessToken("foo")
-	_, refreshTokenString, _ := jwt.RefreshToken("foo")
+	_, tokenString, _ := jwt.AccessToken("foo", nil)
+	_, refreshTokenString, _ := jwt.RefreshToken("foo", nil)
 	body := &types.Tokens{Refresh: refreshTokenString}
 	payload, _ := json.Marshal(body)
 
@@ -208,6 +210,34 @@ func TestTokenSuccess(t *testing.T) {
 	assert.NotEmpty(t, response.Refresh)
 }
 
+func TestTokenDisabledUser(t *testing.T) {
+	store := &mockstore.MockStore{}
+	a := &AuthenticationRouter{store}
+
+	user := types.FixtureUser("foo")
+	user.Disabled = true
+
+	// Mock calls to the store
+	store.On(
+		"GetToken",
+		mock.AnythingOfType("string"),
+		mock.AnythingOfType("string"),
+	).Return(&types.Claims{}, nil)
+	store.On("GetUser", mock.Anything, "foo").Return(user, nil)
+
+	_, tokenString, _ := jwt.AccessToken("foo", nil)
+	_, refreshTokenString, _ := jwt.RefreshToken("foo", nil)
+	body := &types.Tokens{Refresh: refreshTokenString}
+	payload, _ := json.Marshal(body)
+
+	req, _ := http.NewRequest(http.MethodPost, "/auth/token", bytes.NewBuffer(payload))
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tokenString))
+	res := processRequestWithRefreshToken(a, req)
+
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	store.AssertNotCalled(t, "CreateToken", mock.Anything)
+}
+
 func processRequestWithRefreshToken(
 	router Router,
 	req *http.Request,
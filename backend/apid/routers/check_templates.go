@@ -0,0 +1,88 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// CheckTemplatesRouter handles /checktemplates requests.
+type CheckTemplatesRouter struct {
+	controller actions.CheckTemplateController
+}
+
+// NewCheckTemplatesRouter creates a new CheckTemplatesRouter.
+func NewCheckTemplatesRouter(store store.CheckTemplateStore) *CheckTemplatesRouter {
+	return &CheckTemplatesRouter{
+		controller: actions.NewCheckTemplateController(store),
+	}
+}
+
+// Mount the CheckTemplatesRouter to a parent Router
+func (r *CheckTemplatesRouter) Mount(parent *mux.Router) {
+	routes := resourceRoute{router: parent, pathPrefix: "/checktemplates", resource: types.CheckTemplate{}}
+	routes.index(r.list)
+	routes.show(r.find)
+	routes.create(r.create)
+	routes.update(r.update)
+	routes.destroy(r.destroy)
+	routes.path("{id}/checks", r.checks).Methods(http.MethodGet)
+	routes.describe("{id}/checks", openapi.Route{Response: []types.CheckConfig{}}, http.MethodGet)
+}
+
+func (r *CheckTemplatesRouter) list(req *http.Request) (interface{}, error) {
+	return r.controller.Query(req.Context())
+}
+
+func (r *CheckTemplatesRouter) find(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	return r.controller.Find(req.Context(), id)
+}
+
+func (r *CheckTemplatesRouter) checks(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	return r.controller.Checks(req.Context(), id)
+}
+
+func (r *CheckTemplatesRouter) create(req *http.Request) (interface{}, error) {
+	tmpl := types.CheckTemplate{}
+	if err := unmarshalBody(req, &tmpl); err != nil {
+		return nil, err
+	}
+
+	err := r.controller.Create(req.Context(), tmpl)
+	return tmpl, err
+}
+
+func (r *CheckTemplatesRouter) update(req *http.Request) (interface{}, error) {
+	tmpl := types.CheckTemplate{}
+	if err := unmarshalBody(req, &tmpl); err != nil {
+		return nil, err
+	}
+
+	err := r.controller.Update(req.Context(), tmpl)
+	return tmpl, err
+}
+
+func (r *CheckTemplatesRouter) destroy(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	name, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	err = r.controller.Destroy(req.Context(), name)
+	return nil, err
+}
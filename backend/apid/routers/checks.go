@@ -17,15 +17,17 @@ type ChecksRouter struct {
 }
 
 // NewChecksRouter instantiates new router for controlling check resources
-func NewChecksRouter(store queueStore) *ChecksRouter {
+func NewChecksRouter(store queueStore, tombstones *actions.Tombstones) *ChecksRouter {
+	controller := actions.NewCheckController(store)
+	controller.Tombstones = tombstones
 	return &ChecksRouter{
-		controller: actions.NewCheckController(store),
+		controller: controller,
 	}
 }
 
 // Mount the ChecksRouter to a parent Router
 func (r *ChecksRouter) Mount(parent *mux.Router) {
-	routes := resourceRoute{router: parent, pathPrefix: "/checks"}
+	routes := resourceRoute{router: parent, pathPrefix: "/checks", resource: types.CheckConfig{}}
 	routes.index(r.list)
 	routes.show(r.find)
 	routes.create(r.create)
@@ -0,0 +1,69 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// ClusterConfigRouter handles requests for /cluster/config, and
+// /cluster/config/{organization} for the defaults of an organization
+type ClusterConfigRouter struct {
+	controller actions.ClusterConfigController
+}
+
+// NewClusterConfigRouter instantiates new router for the cluster-wide defaults
+func NewClusterConfigRouter(store store.ClusterConfigStore) *ClusterConfigRouter {
+	return &ClusterConfigRouter{
+		controller: actions.NewClusterConfigController(store),
+	}
+}
+
+// Mount the ClusterConfigRouter to a parent Router
+func (r *ClusterConfigRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/cluster/config", actionHandler(r.find)).Methods(http.MethodGet)
+	parent.HandleFunc("/cluster/config", actionHandler(r.update)).Methods(http.MethodPut)
+	parent.HandleFunc("/cluster/config/{organization}", actionHandler(r.findOrganization)).Methods(http.MethodGet)
+	parent.HandleFunc("/cluster/config/{organization}", actionHandler(r.updateOrganization)).Methods(http.MethodPut)
+}
+
+func (r *ClusterConfigRouter) find(req *http.Request) (interface{}, error) {
+	return r.controller.Find(req.Context())
+}
+
+func (r *ClusterConfigRouter) update(req *http.Request) (interface{}, error) {
+	config := types.ClusterConfig{}
+	if err := unmarshalBody(req, &config); err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	err := r.controller.Update(req.Context(), config)
+	return config, err
+}
+
+func (r *ClusterConfigRouter) findOrganization(req *http.Request) (interface{}, error) {
+	org, err := url.PathUnescape(mux.Vars(req)["organization"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	return r.controller.FindOrganization(req.Context(), org)
+}
+
+func (r *ClusterConfigRouter) updateOrganization(req *http.Request) (interface{}, error) {
+	org, err := url.PathUnescape(mux.Vars(req)["organization"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	config := types.ClusterConfig{}
+	if err := unmarshalBody(req, &config); err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	err = r.controller.UpdateOrganization(req.Context(), org, config)
+	return config, err
+}
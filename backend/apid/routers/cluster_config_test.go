@@ -0,0 +1,67 @@
+package routers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHttpApiClusterConfig(t *testing.T) {
+	ctx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeClusterConfig, types.RulePermRead, types.RulePermUpdate),
+	))
+
+	store := &mockstore.MockStore{}
+	store.On("GetClusterConfig", mock.Anything).Return(&types.ClusterConfig{}, nil)
+	store.On("UpdateClusterConfig", mock.Anything, types.FixtureClusterConfig()).Return(nil)
+	store.On("GetOrganizationConfig", mock.Anything, "acme").Return(&types.ClusterConfig{KeepaliveTimeout: 30}, nil)
+	store.On("UpdateOrganizationConfig", mock.Anything, "acme", types.FixtureClusterConfig()).Return(nil)
+
+	router := mux.NewRouter()
+	NewClusterConfigRouter(store).Mount(router)
+
+	req := httptest.NewRequest(http.MethodGet, "/cluster/config", nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	config := types.ClusterConfig{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &config))
+	assert.Empty(t, config.DefaultHandlers)
+
+	body, _ := json.Marshal(types.FixtureClusterConfig())
+	req = httptest.NewRequest(http.MethodPut, "/cluster/config", bytes.NewReader(body))
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	store.AssertCalled(t, "UpdateClusterConfig", mock.Anything, types.FixtureClusterConfig())
+
+	req = httptest.NewRequest(http.MethodPut, "/cluster/config", bytes.NewReader([]byte("foo")))
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	// The defaults of an organization
+	req = httptest.NewRequest(http.MethodGet, "/cluster/config/acme", nil)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &config))
+	assert.Equal(t, uint32(30), config.KeepaliveTimeout)
+
+	req = httptest.NewRequest(http.MethodPut, "/cluster/config/acme", bytes.NewReader(body))
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	store.AssertCalled(t, "UpdateOrganizationConfig", mock.Anything, "acme", types.FixtureClusterConfig())
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
+	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -16,19 +18,25 @@ type EntitiesRouter struct {
 }
 
 // NewEntitiesRouter instantiates new router for controlling entities resources
-func NewEntitiesRouter(store store.EntityStore) *EntitiesRouter {
+func NewEntitiesRouter(store store.EntityStore, bus messaging.MessageBus) *EntitiesRouter {
+	controller := actions.NewEntityController(store)
+	controller.Bus = bus
 	return &EntitiesRouter{
-		controller: actions.NewEntityController(store),
+		controller: controller,
 	}
 }
 
 // Mount the EntitiesRouter to a parent Router
 func (r *EntitiesRouter) Mount(parent *mux.Router) {
-	routes := resourceRoute{router: parent, pathPrefix: "/entities"}
+	routes := resourceRoute{router: parent, pathPrefix: "/entities", resource: types.Entity{}}
 	routes.destroy(r.destroy)
 	routes.index(r.list)
 	routes.show(r.find)
 	routes.update(r.update)
+	routes.path("{id}/status", r.status).Methods(http.MethodGet)
+	routes.path("{id}/metadata", r.patchMetadata).Methods(http.MethodPatch)
+	routes.describe("{id}/status", openapi.Route{Response: types.EntityStatus{}}, http.MethodGet)
+	routes.describe("{id}/metadata", openapi.Route{Request: types.EntityMetadataPatch{}, Response: types.Entity{}}, http.MethodPatch)
 }
 
 func (r *EntitiesRouter) destroy(req *http.Request) (interface{}, error) {
@@ -51,7 +59,19 @@ func (r *EntitiesRouter) find(req *http.Request) (interface{}, error) {
 	return record, err
 }
 
+func (r *EntitiesRouter) status(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	return r.controller.FindStatus(req.Context(), id)
+}
+
 func (r *EntitiesRouter) list(req *http.Request) (interface{}, error) {
+	if q := req.URL.Query().Get("query"); q != "" {
+		return r.controller.Select(req.Context(), q)
+	}
 	records, err := r.controller.Query(req.Context())
 	return records, err
 }
@@ -65,3 +85,18 @@ func (r *EntitiesRouter) update(req *http.Request) (interface{}, error) {
 	err := r.controller.Update(req.Context(), entity)
 	return entity, err
 }
+
+func (r *EntitiesRouter) patchMetadata(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	patch := types.EntityMetadataPatch{}
+	if err := unmarshalBody(req, &patch); err != nil {
+		return nil, err
+	}
+
+	return r.controller.PatchMetadata(req.Context(), id, patch)
+}
@@ -0,0 +1,85 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/types"
+)
+
+// EntityGroupsRouter handles /entitygroups requests.
+type EntityGroupsRouter struct {
+	controller actions.EntityGroupController
+}
+
+// NewEntityGroupsRouter creates a new EntityGroupsRouter.
+func NewEntityGroupsRouter(store actions.EntityGroupStore) *EntityGroupsRouter {
+	return &EntityGroupsRouter{
+		controller: actions.NewEntityGroupController(store),
+	}
+}
+
+// Mount the EntityGroupsRouter to a parent Router
+func (r *EntityGroupsRouter) Mount(parent *mux.Router) {
+	routes := resourceRoute{router: parent, pathPrefix: "/entitygroups", resource: types.EntityGroup{}}
+	routes.index(r.list)
+	routes.show(r.find)
+	routes.create(r.create)
+	routes.update(r.update)
+	routes.destroy(r.destroy)
+	routes.path("{id}/entities", r.entities).Methods(http.MethodGet)
+}
+
+func (r *EntityGroupsRouter) list(req *http.Request) (interface{}, error) {
+	return r.controller.Query(req.Context())
+}
+
+func (r *EntityGroupsRouter) find(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	return r.controller.Find(req.Context(), id)
+}
+
+func (r *EntityGroupsRouter) entities(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	return r.controller.Entities(req.Context(), id)
+}
+
+func (r *EntityGroupsRouter) create(req *http.Request) (interface{}, error) {
+	group := types.EntityGroup{}
+	if err := unmarshalBody(req, &group); err != nil {
+		return nil, err
+	}
+
+	err := r.controller.Create(req.Context(), group)
+	return group, err
+}
+
+func (r *EntityGroupsRouter) update(req *http.Request) (interface{}, error) {
+	group := types.EntityGroup{}
+	if err := unmarshalBody(req, &group); err != nil {
+		return nil, err
+	}
+
+	err := r.controller.Update(req.Context(), group)
+	return group, err
+}
+
+func (r *EntityGroupsRouter) destroy(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	name, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	err = r.controller.Destroy(req.Context(), name)
+	return nil, err
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -30,6 +31,12 @@ func (r *EnvironmentsRouter) Mount(parent *mux.Router) {
 	routes.path("{organization}/environments", r.create).Methods(http.MethodPost)
 	routes.path("{organization}/environments/{environment}", r.update).Methods(http.MethodPatch)
 	routes.path("{organization}/environments/{environment}", r.destroy).Methods(http.MethodDelete)
+
+	routes.describe("{organization}/environments", openapi.Route{Response: []types.Environment{}}, http.MethodGet)
+	routes.describe("{organization}/environments/{environment}", openapi.Route{Response: types.Environment{}}, http.MethodGet)
+	routes.describe("{organization}/environments", openapi.Route{Request: types.Environment{}, Response: types.Environment{}}, http.MethodPost)
+	routes.describe("{organization}/environments/{environment}", openapi.Route{Request: types.Environment{}, Response: types.Environment{}}, http.MethodPatch)
+	routes.describe("{organization}/environments/{environment}", openapi.Route{}, http.MethodDelete)
 }
 
 func (r *EnvironmentsRouter) list(req *http.Request) (interface{}, error) {
@@ -1,11 +1,16 @@
 package routers
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"mime"
 	"net/http"
 	"net/url"
 
 	"github.com/gorilla/mux"
 	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
@@ -25,15 +30,26 @@ func NewEventsRouter(store store.EventStore, bus messaging.MessageBus) *EventsRo
 
 // Mount the EventsRouter to a parent Router
 func (r *EventsRouter) Mount(parent *mux.Router) {
-	routes := resourceRoute{router: parent, pathPrefix: "/events"}
+	routes := resourceRoute{router: parent, pathPrefix: "/events", resource: types.Event{}}
 	routes.index(r.list)
+	routes.path("cloudevents", r.createCloudEvent).Methods(http.MethodPost)
+	routes.path("replay", r.replay).Methods(http.MethodPost)
 	routes.path("{entity}", r.listByEntity).Methods(http.MethodGet)
 	routes.path("{entity}/{check}", r.find).Methods(http.MethodGet)
 	routes.path("{entity}/{check}", r.destroy).Methods(http.MethodDelete)
+
+	routes.describe("cloudevents", openapi.Route{Request: types.CloudEvent{}, Response: types.Event{}}, http.MethodPost)
+	routes.describe("replay", openapi.Route{Request: types.EventReplay{}, Response: []types.Event{}}, http.MethodPost)
+	routes.describe("{entity}", openapi.Route{Response: []types.Event{}}, http.MethodGet)
+	routes.describe("{entity}/{check}", openapi.Route{Response: types.Event{}}, http.MethodGet)
+	routes.describe("{entity}/{check}", openapi.Route{}, http.MethodDelete)
 	routes.create(r.create)
 }
 
 func (r *EventsRouter) list(req *http.Request) (interface{}, error) {
+	if q := req.URL.Query().Get("query"); q != "" {
+		return r.controller.Select(req.Context(), q)
+	}
 	records, err := r.controller.Query(req.Context(), "", "")
 	return records, err
 }
@@ -69,3 +85,74 @@ func (r *EventsRouter) create(req *http.Request) (interface{}, error) {
 	err := r.controller.Create(req.Context(), event)
 	return event, err
 }
+
+// createCloudEvent creates the event carried by a CloudEvent, sent either in
+// the structured or in the binary content mode.
+func (r *EventsRouter) createCloudEvent(req *http.Request) (interface{}, error) {
+	cloudEvent, err := readCloudEvent(req)
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	event, err := cloudEvent.Event()
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	setEventNamespace(req.Context(), event)
+
+	err = r.controller.Create(req.Context(), *event)
+	return event, err
+}
+
+// replay sends the stored events selected by the request through the event
+// handlers again.
+func (r *EventsRouter) replay(req *http.Request) (interface{}, error) {
+	replay := types.EventReplay{}
+	if err := unmarshalBody(req, &replay); err != nil {
+		return nil, err
+	}
+
+	return r.controller.Replay(req.Context(), replay)
+}
+
+// readCloudEvent reads the CloudEvent of the request. The attributes are
+// provided by the ce- headers in the binary content mode, and the body is the
+// data.
+func readCloudEvent(req *http.Request) (*types.CloudEvent, error) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if mediaType == types.CloudEventsContentType {
+		cloudEvent := &types.CloudEvent{}
+		return cloudEvent, json.Unmarshal(body, cloudEvent)
+	}
+
+	return &types.CloudEvent{
+		SpecVersion:     req.Header.Get("ce-specversion"),
+		ID:              req.Header.Get("ce-id"),
+		Source:          req.Header.Get("ce-source"),
+		Type:            req.Header.Get("ce-type"),
+		Subject:         req.Header.Get("ce-subject"),
+		Time:            req.Header.Get("ce-time"),
+		DataContentType: req.Header.Get("Content-Type"),
+		Data:            body,
+	}, nil
+}
+
+// setEventNamespace sets the namespace of the entity and check of the event
+// to the namespace of the request, unless they have one.
+func setEventNamespace(ctx context.Context, event *types.Event) {
+	org, _ := ctx.Value(types.OrganizationKey).(string)
+	env, _ := ctx.Value(types.EnvironmentKey).(string)
+
+	if event.Entity != nil && event.Entity.Organization == "" && event.Entity.Environment == "" {
+		event.Entity.SetNamespace(org, env)
+	}
+	if event.Check != nil && event.Check.Organization == "" && event.Check.Environment == "" {
+		event.Check.Organization = org
+		event.Check.Environment = env
+	}
+}
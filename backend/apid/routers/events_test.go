@@ -1,7 +1,123 @@
 package routers
 
-import "testing"
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
 
 func TestHttpApiChecksGet(t *testing.T) {
 
 }
+
+func TestHttpApiEventsCloudEvent(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermCreate, types.RulePermUpdate),
+		),
+	)
+
+	store := &mockstore.MockStore{}
+	store.On("GetEventByEntityCheck", mock.Anything, "db01", "disk_full").Return((*types.Event)(nil), nil)
+
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	events := make(chan interface{}, 2)
+	require.NoError(t, bus.Subscribe(messaging.TopicEventRaw, "test", events))
+
+	router := mux.NewRouter()
+	NewEventsRouter(store, bus).Mount(router)
+
+	data := `{"entity": {"id": "db01", "class": "proxy"}, "check": {"status": 2, "interval": 60}}`
+
+	// Structured content mode
+	cloudEvent := types.CloudEvent{
+		SpecVersion: types.CloudEventsSpecVersion,
+		ID:          "1",
+		Source:      "/producer",
+		Type:        "com.example.alert",
+		Subject:     "disk_full",
+		Data:        json.RawMessage(data),
+	}
+	body, _ := json.Marshal(cloudEvent)
+	req := httptest.NewRequest(http.MethodPost, "/events/cloudevents", bytes.NewReader(body))
+	req.Header.Set("Content-Type", types.CloudEventsContentType+"; charset=utf-8")
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	event := (<-events).(*types.Event)
+	assert.Equal(t, "db01", event.Entity.ID)
+	assert.Equal(t, "default", event.Entity.Organization)
+	assert.Equal(t, "disk_full", event.Check.Name)
+	assert.Equal(t, "default", event.Check.Environment)
+
+	// Binary content mode
+	req = httptest.NewRequest(http.MethodPost, "/events/cloudevents", bytes.NewReader([]byte(data)))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("ce-specversion", types.CloudEventsSpecVersion)
+	req.Header.Set("ce-id", "2")
+	req.Header.Set("ce-source", "/producer")
+	req.Header.Set("ce-type", "com.example.alert")
+	req.Header.Set("ce-subject", "disk_full")
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, int32(2), (<-events).(*types.Event).Check.Status)
+
+	// Missing attributes
+	req = httptest.NewRequest(http.MethodPost, "/events/cloudevents", bytes.NewReader([]byte(data)))
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestHttpApiEventsReplay(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead, types.RulePermUpdate),
+		),
+	)
+
+	failing := types.FixtureEvent("db01", "disk_full")
+	failing.Check.Status = 2
+	store := &mockstore.MockStore{}
+	store.On("GetEvents", mock.Anything).Return([]*types.Event{failing, types.FixtureEvent("db02", "disk_full")}, nil)
+
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	events := make(chan interface{}, 2)
+	require.NoError(t, bus.Subscribe(messaging.TopicEvent, "test", events))
+
+	router := mux.NewRouter()
+	NewEventsRouter(store, bus).Mount(router)
+
+	body := `{"query": "status != 0", "handlers": ["influxdb"]}`
+	req := httptest.NewRequest(http.MethodPost, "/events/replay", bytes.NewReader([]byte(body)))
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	var replayed []types.Event
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &replayed))
+	require.Len(t, replayed, 1)
+	assert.Equal(t, "db01", replayed[0].Entity.ID)
+
+	event := (<-events).(*types.Event)
+	assert.Equal(t, "db01", event.Entity.ID)
+	assert.Equal(t, []string{"influxdb"}, event.Check.Handlers)
+	assert.Empty(t, events)
+}
@@ -24,7 +24,7 @@ func NewEventFiltersRouter(store store.EventFilterStore) *EventFiltersRouter {
 
 // Mount the EventFiltersRouter to a parent Router
 func (r *EventFiltersRouter) Mount(parent *mux.Router) {
-	routes := resourceRoute{router: parent, pathPrefix: "/filters"}
+	routes := resourceRoute{router: parent, pathPrefix: "/filters", resource: types.EventFilter{}}
 	routes.index(r.list)
 	routes.show(r.find)
 	routes.create(r.create)
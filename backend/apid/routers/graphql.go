@@ -6,25 +6,48 @@ import (
 	"net/http"
 
 	"github.com/gorilla/mux"
+	graphqlgo "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/sensu/sensu-go/backend/apid/actions"
 	graphql "github.com/sensu/sensu-go/backend/apid/graphql"
+	"github.com/sensu/sensu-go/backend/federation"
 	graphqlservice "github.com/sensu/sensu-go/graphql"
 	"github.com/sensu/sensu-go/types"
 )
 
+// GraphQLOptions configures the queries served by the GraphQL router
+type GraphQLOptions struct {
+	// DisableIntrospection rejects the queries of the schema, e.g. in
+	// production
+	DisableIntrospection bool
+
+	// PersistedQueries are the queries which can be requested by their hash,
+	// which isn't supported if it's nil
+	PersistedQueries *PersistedQueries
+}
+
 // GraphQLRouter handles requests for /events
 type GraphQLRouter struct {
-	service *graphqlservice.Service
+	service   *graphqlservice.Service
+	cache     *GraphQLCache
+	persisted *PersistedQueries
 }
 
-// NewGraphQLRouter instantiates new events controller
-func NewGraphQLRouter(store queueStore) *GraphQLRouter {
+// NewGraphQLRouter instantiates new events controller. The responses of the
+// expensive queries are cached in the given cache, unless it's nil. The
+// remote clusters of the federation are queried through the given gateway,
+// which may be nil.
+func NewGraphQLRouter(store queueStore, cache *GraphQLCache, gateway *federation.Gateway, opts GraphQLOptions) *GraphQLRouter {
 	service, err := graphql.NewService(graphql.ServiceConfig{
-		Store: store,
+		Store:                store,
+		Federation:           gateway,
+		DisableIntrospection: opts.DisableIntrospection,
 	})
 	if err != nil {
 		logger.WithError(err).Panic("unable to configure graphql service")
 	}
-	return &GraphQLRouter{service}
+	return &GraphQLRouter{service: service, cache: cache, persisted: opts.PersistedQueries}
 }
 
 // Mount the GraphQLRouter to a parent Router
@@ -49,13 +72,100 @@ func (r *GraphQLRouter) query(req *http.Request) (interface{}, error) {
 	query, _ := rBody["query"].(string)
 	queryVars, _ := rBody["variables"].(map[string]interface{})
 
+	// Parse the query, or look up the persisted query requested
+	query, doc, errs, err := r.parse(query, persistedQueryParam(rBody))
+	if err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		return &graphqlgo.Result{Errors: errs}, nil
+	}
+
+	// Read-only replicas only serve the queries
+	if readOnly, _ := ctx.Value(types.ReadOnlyKey).(bool); readOnly && hasMutation(doc) {
+		return nil, actions.NewErrorf(actions.PermissionDenied, "mutations are not allowed on a read-only replica")
+	}
+
+	// Serve the expensive queries from the cache when possible
+	var key string
+	var cached bool
+	var generation uint64
+	if r.cache != nil {
+		key, cached = graphQLCacheKey(ctx, query, doc, queryVars)
+		if cached {
+			var result interface{}
+			var ok bool
+			if result, generation, ok = r.cache.get(key); ok {
+				return result, nil
+			}
+		}
+	}
+
 	// Execute given query
-	result := r.service.Do(ctx, query, queryVars)
+	result := r.service.Execute(ctx, doc, queryVars)
 	if len(result.Errors) > 0 {
 		logger.
 			WithField("errors", result.Errors).
 			Errorf("error(s) occurred while executing GraphQL operation")
+	} else if cached {
+		r.cache.set(key, result, generation)
 	}
 
 	return result, nil
 }
+
+// parse returns the document of the given query, or of the persisted query
+// of the given hash, along with the query. The queries requested by their
+// hash only are reported as not found, following the convention of the Apollo
+// clients, so that the client sends the query to persist it.
+func (r *GraphQLRouter) parse(query, hash string) (string, *ast.Document, []gqlerrors.FormattedError, error) {
+	if r.persisted == nil {
+		if query == "" && hash != "" {
+			return "", nil, queryErrors("PersistedQueryNotSupported"), nil
+		}
+		doc, errs := r.service.Parse(query)
+		return query, doc, errs, nil
+	}
+
+	if hash == "" {
+		if !r.persisted.Only {
+			doc, errs := r.service.Parse(query)
+			return query, doc, errs, nil
+		}
+		hash = persistedQueryHash(query)
+	} else if query != "" && persistedQueryHash(query) != hash {
+		return "", nil, nil, actions.NewErrorf(actions.InvalidArgument, "the query does not match the persisted query hash")
+	}
+
+	persisted, ok := r.persisted.get(hash)
+	if !ok {
+		if query == "" {
+			return "", nil, queryErrors("PersistedQueryNotFound"), nil
+		}
+		if r.persisted.Only {
+			return "", nil, nil, actions.NewErrorf(actions.PermissionDenied, "only the persisted queries are allowed")
+		}
+		persisted = r.persisted.persist(hash, query)
+	}
+
+	doc, errs := persisted.document(r.service)
+	return persisted.query, doc, errs, nil
+}
+
+func queryErrors(message string) []gqlerrors.FormattedError {
+	return []gqlerrors.FormattedError{{Message: message}}
+}
+
+// hasMutation determines if the given GraphQL document contains a mutation.
+func hasMutation(doc *ast.Document) bool {
+	if doc == nil {
+		return false
+	}
+
+	for _, def := range doc.Definitions {
+		if op, ok := def.(*ast.OperationDefinition); ok && op.Operation == ast.OperationTypeMutation {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,214 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// DefaultGraphQLCacheTTL is how long the responses of the expensive
+	// GraphQL queries are cached
+	DefaultGraphQLCacheTTL = 5 * time.Second
+
+	// graphQLCacheSize is the maximum number of cached responses
+	graphQLCacheSize = 1000
+)
+
+// graphQLCachedFields are the fields whose resolution reads all the events or
+// entities of the store, e.g. to count them, and whose queries are cached.
+var graphQLCachedFields = map[string]bool{
+	"entities": true,
+	"events":   true,
+}
+
+type graphQLCacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// GraphQLCache caches the responses of the expensive GraphQL queries of the
+// dashboard, by query, variables and user, for a short time. The cached
+// responses are dropped as soon as the events, entities or silenced entries
+// are written through a GraphQLCacheStore.
+type GraphQLCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]graphQLCacheEntry
+	now     func() time.Time
+
+	// generation is incremented by each invalidation, so that the responses
+	// computed before are not cached
+	generation uint64
+}
+
+// NewGraphQLCache returns a cache of the GraphQL responses kept for ttl.
+func NewGraphQLCache(ttl time.Duration) *GraphQLCache {
+	return &GraphQLCache{
+		ttl:     ttl,
+		entries: map[string]graphQLCacheEntry{},
+		now:     time.Now,
+	}
+}
+
+// Invalidate drops all the cached responses.
+func (c *GraphQLCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string]graphQLCacheEntry{}
+	c.generation++
+}
+
+// get returns the cached response of the key, if any, and the current
+// generation of the cache.
+func (c *GraphQLCache) get(key string) (interface{}, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !c.now().Before(entry.expires) {
+		return nil, c.generation, false
+	}
+	return entry.value, c.generation, true
+}
+
+// set caches the response of the key, unless the cache was invalidated since
+// the given generation.
+func (c *GraphQLCache) set(key string, value interface{}, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	now := c.now()
+	if len(c.entries) >= graphQLCacheSize {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+		// The responses aren't cached until some expire
+		if len(c.entries) >= graphQLCacheSize {
+			return
+		}
+	}
+	c.entries[key] = graphQLCacheEntry{value: value, expires: now.Add(c.ttl)}
+}
+
+// graphQLCacheKey returns the key of the response to the query, of the given
+// document, with the given variables for the user of the context, or false if
+// the query isn't cached. The queries of the scoped tokens aren't cached, as
+// the responses cached for their user would bypass their scope.
+func graphQLCacheKey(ctx context.Context, query string, doc *ast.Document, vars map[string]interface{}) (string, bool) {
+	actor, ok := ctx.Value(types.AuthorizationActorKey).(authorization.Actor)
+	if !ok || actor.Scoped() || !isCachedQuery(doc) {
+		return "", false
+	}
+	// The keys of the variables are sorted by their encoding
+	b, err := json.Marshal(vars)
+	if err != nil {
+		return "", false
+	}
+	return actor.Name + "\x00" + query + "\x00" + string(b), true
+}
+
+// isCachedQuery determines if the given GraphQL document is a query selecting
+// any of the cached fields, directly or through its fragments.
+func isCachedQuery(doc *ast.Document) bool {
+	if doc == nil {
+		return false
+	}
+
+	cached := false
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.OperationDefinition:
+			if def.Operation != ast.OperationTypeQuery {
+				return false
+			}
+			cached = cached || selectsCachedField(def.SelectionSet)
+		case *ast.FragmentDefinition:
+			cached = cached || selectsCachedField(def.SelectionSet)
+		}
+	}
+	return cached
+}
+
+func selectsCachedField(set *ast.SelectionSet) bool {
+	if set == nil {
+		return false
+	}
+	for _, selection := range set.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if graphQLCachedFields[selection.Name.Value] || selectsCachedField(selection.SelectionSet) {
+				return true
+			}
+		case *ast.InlineFragment:
+			if selectsCachedField(selection.SelectionSet) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GraphQLCacheStore invalidates its GraphQL cache on the writes of the
+// events, entities and silenced entries through the API. The events written
+// by the agents expire with the cached responses.
+type GraphQLCacheStore struct {
+	queueStore
+	Cache *GraphQLCache
+}
+
+// NewGraphQLCacheStore returns a store invalidating the cache on the writes
+// through the given store.
+func NewGraphQLCacheStore(store queueStore, cache *GraphQLCache) *GraphQLCacheStore {
+	return &GraphQLCacheStore{queueStore: store, Cache: cache}
+}
+
+// DeleteEntity invalidates the cache once the entity is deleted.
+func (s *GraphQLCacheStore) DeleteEntity(ctx context.Context, entity *types.Entity) error {
+	defer s.Cache.Invalidate()
+	return s.queueStore.DeleteEntity(ctx, entity)
+}
+
+// DeleteEntityByID invalidates the cache once the entity is deleted.
+func (s *GraphQLCacheStore) DeleteEntityByID(ctx context.Context, id string) error {
+	defer s.Cache.Invalidate()
+	return s.queueStore.DeleteEntityByID(ctx, id)
+}
+
+// UpdateEntity invalidates the cache once the entity is updated.
+func (s *GraphQLCacheStore) UpdateEntity(ctx context.Context, entity *types.Entity) error {
+	defer s.Cache.Invalidate()
+	return s.queueStore.UpdateEntity(ctx, entity)
+}
+
+// DeleteEventByEntityCheck invalidates the cache once the event is deleted.
+func (s *GraphQLCacheStore) DeleteEventByEntityCheck(ctx context.Context, entity, check string) error {
+	defer s.Cache.Invalidate()
+	return s.queueStore.DeleteEventByEntityCheck(ctx, entity, check)
+}
+
+// UpdateEvent invalidates the cache once the event is updated.
+func (s *GraphQLCacheStore) UpdateEvent(ctx context.Context, event *types.Event) error {
+	defer s.Cache.Invalidate()
+	return s.queueStore.UpdateEvent(ctx, event)
+}
+
+// DeleteSilencedEntryByID invalidates the cache once the entry is deleted.
+func (s *GraphQLCacheStore) DeleteSilencedEntryByID(ctx context.Context, id string) error {
+	defer s.Cache.Invalidate()
+	return s.queueStore.DeleteSilencedEntryByID(ctx, id)
+}
+
+// UpdateSilencedEntry invalidates the cache once the entry is updated.
+func (s *GraphQLCacheStore) UpdateSilencedEntry(ctx context.Context, entry *types.Silenced) error {
+	defer s.Cache.Invalidate()
+	return s.queueStore.UpdateSilencedEntry(ctx, entry)
+}
@@ -0,0 +1,99 @@
+package routers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsCachedQuery(t *testing.T) {
+	assert.True(t, isCachedQuery(parseQuery("{ viewer { events(first: 1) { totalCount } } }")))
+	assert.True(t, isCachedQuery(parseQuery("query EventsPageQuery { viewer { ...EventsContainer_viewer } }\nfragment EventsContainer_viewer on Viewer { events { edges { node { id } } } }")))
+	assert.True(t, isCachedQuery(parseQuery("{ viewer { ... on Viewer { entities { totalCount } } } }")))
+	assert.False(t, isCachedQuery(parseQuery("{ viewer { user { username } } }")))
+	assert.False(t, isCachedQuery(parseQuery("query A { viewer { events { totalCount } } }\nmutation B { deleteCheck(input: {id: \"abc\"}) { deletedId } }")))
+	assert.False(t, isCachedQuery(parseQuery("{ invalid")))
+}
+
+func TestGraphQLCacheKey(t *testing.T) {
+	query := "{ viewer { events { totalCount } } }"
+	vars := map[string]interface{}{"filter": "status != 0", "first": 10}
+
+	_, ok := graphQLCacheKey(context.Background(), query, parseQuery(query), vars)
+	assert.False(t, ok)
+
+	ctx := context.WithValue(context.Background(), types.AuthorizationActorKey, authorization.Actor{Name: "alice"})
+	alice, ok := graphQLCacheKey(ctx, query, parseQuery(query), vars)
+	require.True(t, ok)
+	again, _ := graphQLCacheKey(ctx, query, parseQuery(query), map[string]interface{}{"first": 10, "filter": "status != 0"})
+	assert.Equal(t, alice, again)
+
+	ctx = context.WithValue(context.Background(), types.AuthorizationActorKey, authorization.Actor{Name: "bob"})
+	bob, ok := graphQLCacheKey(ctx, query, parseQuery(query), vars)
+	require.True(t, ok)
+	assert.NotEqual(t, alice, bob)
+
+	_, ok = graphQLCacheKey(ctx, "{ viewer { user { username } } }", parseQuery("{ viewer { user { username } } }"), nil)
+	assert.False(t, ok)
+
+	// The queries of the scoped tokens aren't cached
+	ctx = context.WithValue(context.Background(), types.AuthorizationActorKey, authorization.Actor{
+		Name:  "alice",
+		Scope: []types.Rule{types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead)},
+	})
+	_, ok = graphQLCacheKey(ctx, query, parseQuery(query), vars)
+	assert.False(t, ok)
+}
+
+func TestGraphQLCache(t *testing.T) {
+	now := time.Now()
+	cache := NewGraphQLCache(5 * time.Second)
+	cache.now = func() time.Time { return now }
+
+	_, generation, ok := cache.get("key")
+	require.False(t, ok)
+	cache.set("key", "result", generation)
+	result, _, ok := cache.get("key")
+	require.True(t, ok)
+	assert.Equal(t, "result", result)
+
+	// The responses expire
+	now = now.Add(5 * time.Second)
+	_, generation, ok = cache.get("key")
+	assert.False(t, ok)
+
+	// The responses computed before an invalidation are not cached
+	cache.Invalidate()
+	cache.set("key", "stale", generation)
+	_, _, ok = cache.get("key")
+	assert.False(t, ok)
+}
+
+func TestGraphQLCacheStore(t *testing.T) {
+	st := &mockstore.MockStore{}
+	st.On("UpdateEvent", mock.Anything, mock.Anything).Return(nil)
+	st.On("GetEvents", mock.Anything).Return([]*types.Event{}, nil)
+
+	cache := NewGraphQLCache(time.Minute)
+	cacheStore := NewGraphQLCacheStore(st, cache)
+
+	_, generation, _ := cache.get("key")
+	cache.set("key", "result", generation)
+
+	// Reads leave the cache alone
+	_, err := cacheStore.GetEvents(context.Background())
+	require.NoError(t, err)
+	_, _, ok := cache.get("key")
+	assert.True(t, ok)
+
+	require.NoError(t, cacheStore.UpdateEvent(context.Background(), types.FixtureEvent("entity1", "check1")))
+	_, _, ok = cache.get("key")
+	assert.False(t, ok)
+}
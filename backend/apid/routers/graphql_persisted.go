@@ -0,0 +1,137 @@
+package routers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	graphqlservice "github.com/sensu/sensu-go/graphql"
+)
+
+// maxPersistedQueries is the maximum number of queries persisted by the
+// clients, in addition to the ones loaded from a manifest
+const maxPersistedQueries = 1000
+
+// PersistedQueries are the GraphQL queries the clients can request by the
+// SHA-256 hash of their document, as the dashboard does, rather than by
+// sending the document. Each document is parsed and validated once.
+type PersistedQueries struct {
+	// Only rejects the queries which aren't persisted, e.g. to only serve the
+	// queries of the dashboard. The clients can't persist new queries then.
+	Only bool
+
+	mu        sync.RWMutex
+	queries   map[string]*persistedQuery
+	persisted int
+}
+
+type persistedQuery struct {
+	query string
+
+	once sync.Once
+	doc  *ast.Document
+	errs []gqlerrors.FormattedError
+}
+
+// NewPersistedQueries returns an empty set of persisted queries, to which the
+// clients add their queries as they request them.
+func NewPersistedQueries() *PersistedQueries {
+	return &PersistedQueries{queries: map[string]*persistedQuery{}}
+}
+
+// LoadPersistedQueries returns the persisted queries of the manifest at the
+// given path, a JSON object of the queries by their hash, e.g. as written by
+// the dashboard build.
+func LoadPersistedQueries(path string) (*PersistedQueries, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the persisted queries: %s", err)
+	}
+
+	var manifest map[string]string
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return nil, fmt.Errorf("could not read the persisted queries of %s: %s", path, err)
+	}
+
+	queries := NewPersistedQueries()
+	for hash, query := range manifest {
+		if persistedQueryHash(query) != hash {
+			return nil, fmt.Errorf("the persisted query %s of %s does not match its hash", hash, path)
+		}
+		queries.queries[hash] = &persistedQuery{query: query}
+	}
+	return queries, nil
+}
+
+// Add persists the given query and returns its hash.
+func (p *PersistedQueries) Add(query string) string {
+	hash := persistedQueryHash(query)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.queries[hash]; !ok {
+		p.queries[hash] = &persistedQuery{query: query}
+	}
+	return hash
+}
+
+// Len returns the number of persisted queries.
+func (p *PersistedQueries) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.queries)
+}
+
+// get returns the persisted query of the given hash, if any.
+func (p *PersistedQueries) get(hash string) (*persistedQuery, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	query, ok := p.queries[hash]
+	return query, ok
+}
+
+// persist persists the query of the given hash requested by a client. The
+// query isn't kept once the clients persisted maxPersistedQueries, but can
+// still be executed.
+func (p *PersistedQueries) persist(hash, query string) *persistedQuery {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if q, ok := p.queries[hash]; ok {
+		return q
+	}
+	q := &persistedQuery{query: query}
+	if p.persisted < maxPersistedQueries {
+		p.queries[hash] = q
+		p.persisted++
+	}
+	return q
+}
+
+// document returns the parsed document of the query, which is only parsed
+// and validated by the first call.
+func (q *persistedQuery) document(service *graphqlservice.Service) (*ast.Document, []gqlerrors.FormattedError) {
+	q.once.Do(func() {
+		q.doc, q.errs = service.Parse(q.query)
+	})
+	return q.doc, q.errs
+}
+
+// persistedQueryHash returns the hex encoded SHA-256 hash of the query.
+func persistedQueryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+// persistedQueryParam returns the hash of the persisted query requested, if
+// any, following the convention of the Apollo clients:
+// {"extensions": {"persistedQuery": {"version": 1, "sha256Hash": "..."}}}
+func persistedQueryParam(body map[string]interface{}) string {
+	extensions, _ := body["extensions"].(map[string]interface{})
+	persisted, _ := extensions["persistedQuery"].(map[string]interface{})
+	hash, _ := persisted["sha256Hash"].(string)
+	return hash
+}
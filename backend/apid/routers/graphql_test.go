@@ -0,0 +1,165 @@
+package routers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	graphqlgo "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/testing/mockqueue"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// parseQuery returns the document of the query, or nil if it's invalid.
+func parseQuery(query string) *ast.Document {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return nil
+	}
+	return doc
+}
+
+func newTestGraphQLRouter(opts GraphQLOptions) *GraphQLRouter {
+	store := &mockstore.MockStore{}
+	store.On("NewQueue", mock.Anything, mock.Anything).Return(&mockqueue.MockQueue{})
+	return NewGraphQLRouter(store, nil, nil, opts)
+}
+
+func graphQLRequest(t *testing.T, router *GraphQLRouter, query, hash string) (*graphqlgo.Result, error) {
+	body := map[string]interface{}{}
+	if query != "" {
+		body["query"] = query
+	}
+	if hash != "" {
+		body["extensions"] = map[string]interface{}{
+			"persistedQuery": map[string]interface{}{"version": 1, "sha256Hash": hash},
+		}
+	}
+	b, err := json.Marshal(body)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(b))
+	require.NoError(t, err)
+
+	result, err := router.query(req)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*graphqlgo.Result), nil
+}
+
+func TestHasMutation(t *testing.T) {
+	assert.False(t, hasMutation(parseQuery("{ viewer { user { username } } }")))
+	assert.False(t, hasMutation(parseQuery("query Viewer { viewer { user { username } } }")))
+	assert.True(t, hasMutation(parseQuery("mutation { deleteCheck(input: {id: \"abc\"}) { deletedId } }")))
+	assert.True(t, hasMutation(parseQuery("query A { viewer { user { username } } }\nmutation B { deleteCheck(input: {id: \"abc\"}) { deletedId } }")))
+	assert.False(t, hasMutation(parseQuery("{ invalid")))
+}
+
+func TestGraphQLRouterPersistedQueries(t *testing.T) {
+	router := newTestGraphQLRouter(GraphQLOptions{PersistedQueries: NewPersistedQueries()})
+	query := "{ __typename }"
+	hash := persistedQueryHash(query)
+
+	// The client sends the query once it's not found
+	result, err := graphQLRequest(t, router, "", hash)
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "PersistedQueryNotFound", result.Errors[0].Message)
+
+	result, err = graphQLRequest(t, router, query, hash)
+	require.NoError(t, err)
+	assert.Empty(t, result.Errors)
+
+	result, err = graphQLRequest(t, router, "", hash)
+	require.NoError(t, err)
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]interface{}{"__typename": "Query"}, result.Data)
+
+	// The hash must match the query
+	_, err = graphQLRequest(t, router, "{ viewer { __typename } }", hash)
+	code, _ := actions.StatusFromError(err)
+	assert.Equal(t, actions.InvalidArgument, code)
+
+	// The queries not persisted are still served
+	result, err = graphQLRequest(t, router, "{ viewer { __typename } }", "")
+	require.NoError(t, err)
+	assert.Empty(t, result.Errors)
+}
+
+func TestGraphQLRouterPersistedQueriesOnly(t *testing.T) {
+	queries := NewPersistedQueries()
+	queries.Only = true
+	hash := queries.Add("{ __typename }")
+	router := newTestGraphQLRouter(GraphQLOptions{PersistedQueries: queries})
+
+	result, err := graphQLRequest(t, router, "", hash)
+	require.NoError(t, err)
+	assert.Empty(t, result.Errors)
+
+	result, err = graphQLRequest(t, router, "{ __typename }", "")
+	require.NoError(t, err)
+	assert.Empty(t, result.Errors)
+
+	query := "{ viewer { __typename } }"
+	_, err = graphQLRequest(t, router, query, "")
+	code, _ := actions.StatusFromError(err)
+	assert.Equal(t, actions.PermissionDenied, code)
+	_, err = graphQLRequest(t, router, query, persistedQueryHash(query))
+	code, _ = actions.StatusFromError(err)
+	assert.Equal(t, actions.PermissionDenied, code)
+	assert.Equal(t, 1, queries.Len())
+}
+
+func TestGraphQLRouterPersistedQueriesNotSupported(t *testing.T) {
+	router := newTestGraphQLRouter(GraphQLOptions{})
+
+	result, err := graphQLRequest(t, router, "", persistedQueryHash("{ __typename }"))
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "PersistedQueryNotSupported", result.Errors[0].Message)
+}
+
+func TestGraphQLRouterDisableIntrospection(t *testing.T) {
+	router := newTestGraphQLRouter(GraphQLOptions{DisableIntrospection: true})
+
+	result, err := graphQLRequest(t, router, "{ __schema { queryType { name } } }", "")
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 1)
+	assert.Contains(t, result.Errors[0].Message, "introspection is disabled")
+	assert.Nil(t, result.Data)
+}
+
+func TestLoadPersistedQueries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sensu")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	query := "{ __typename }"
+	path := filepath.Join(dir, "persisted-queries.json")
+	b, _ := json.Marshal(map[string]string{persistedQueryHash(query): query})
+	require.NoError(t, ioutil.WriteFile(path, b, 0644))
+
+	queries, err := LoadPersistedQueries(path)
+	require.NoError(t, err)
+	assert.Equal(t, 1, queries.Len())
+	_, ok := queries.get(persistedQueryHash(query))
+	assert.True(t, ok)
+
+	b, _ = json.Marshal(map[string]string{persistedQueryHash(query): "{ viewer { __typename } }"})
+	require.NoError(t, ioutil.WriteFile(path, b, 0644))
+	_, err = LoadPersistedQueries(path)
+	assert.Error(t, err)
+
+	_, err = LoadPersistedQueries(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
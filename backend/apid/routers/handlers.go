@@ -16,15 +16,17 @@ type HandlersRouter struct {
 }
 
 // NewHandlersRouter instantiates new router for controlling handler resources
-func NewHandlersRouter(store store.HandlerStore) *HandlersRouter {
+func NewHandlersRouter(store store.HandlerStore, tombstones *actions.Tombstones) *HandlersRouter {
+	controller := actions.NewHandlerController(store)
+	controller.Tombstones = tombstones
 	return &HandlersRouter{
-		controller: actions.NewHandlerController(store),
+		controller: controller,
 	}
 }
 
 // Mount the HandlersRouter to a parent Router
 func (r *HandlersRouter) Mount(parent *mux.Router) {
-	routes := resourceRoute{router: parent, pathPrefix: "/handlers"}
+	routes := resourceRoute{router: parent, pathPrefix: "/handlers", resource: types.Handler{}}
 	routes.create(r.create)
 	routes.destroy(r.destroy)
 	routes.index(r.list)
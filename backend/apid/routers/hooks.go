@@ -24,7 +24,7 @@ func NewHooksRouter(store store.HookConfigStore) *HooksRouter {
 
 // Mount the HooksRouter to a parent Router
 func (r *HooksRouter) Mount(parent *mux.Router) {
-	routes := resourceRoute{router: parent, pathPrefix: "/hooks"}
+	routes := resourceRoute{router: parent, pathPrefix: "/hooks", resource: types.HookConfig{}}
 	routes.index(r.list)
 	routes.show(r.find)
 	routes.create(r.create)
@@ -0,0 +1,71 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// IncidentsRouter handles /incidents requests.
+type IncidentsRouter struct {
+	controller actions.IncidentController
+}
+
+// NewIncidentsRouter creates a new IncidentsRouter.
+func NewIncidentsRouter(store store.IncidentStore) *IncidentsRouter {
+	return &IncidentsRouter{
+		controller: actions.NewIncidentController(store),
+	}
+}
+
+// Mount the IncidentsRouter to a parent Router
+func (r *IncidentsRouter) Mount(parent *mux.Router) {
+	routes := resourceRoute{router: parent, pathPrefix: "/incidents", resource: types.Incident{}}
+	routes.index(r.list)
+	routes.show(r.find)
+	routes.update(r.update)
+	routes.destroy(r.destroy)
+}
+
+func (r *IncidentsRouter) list(req *http.Request) (interface{}, error) {
+	return r.controller.Query(req.Context())
+}
+
+func (r *IncidentsRouter) find(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	return r.controller.Find(req.Context(), id)
+}
+
+func (r *IncidentsRouter) update(req *http.Request) (interface{}, error) {
+	incident := types.Incident{}
+	if err := unmarshalBody(req, &incident); err != nil {
+		return nil, err
+	}
+
+	id, err := url.PathUnescape(mux.Vars(req)["id"])
+	if err != nil {
+		return nil, err
+	}
+	incident.ID = id
+
+	err = r.controller.Update(req.Context(), incident)
+	return incident, err
+}
+
+func (r *IncidentsRouter) destroy(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	err = r.controller.Destroy(req.Context(), id)
+	return nil, err
+}
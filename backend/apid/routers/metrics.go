@@ -0,0 +1,25 @@
+package routers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// MetricsRouter handles requests for /metrics, exposing the Prometheus metrics
+// of the backend, e.g. the queue lengths of eventd and pipelined and the
+// messages dropped by the message bus.
+type MetricsRouter struct {
+	handler http.Handler
+}
+
+// NewMetricsRouter instantiates new router for the Prometheus metrics
+func NewMetricsRouter() *MetricsRouter {
+	return &MetricsRouter{handler: promhttp.Handler()}
+}
+
+// Mount the MetricsRouter to a parent Router
+func (r *MetricsRouter) Mount(parent *mux.Router) {
+	parent.Handle("/metrics", r.handler).Methods(http.MethodGet)
+}
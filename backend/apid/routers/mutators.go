@@ -24,7 +24,7 @@ func NewMutatorsRouter(store store.MutatorStore) *MutatorsRouter {
 
 // Mount the MutatorsRouter to a parent Router
 func (r *MutatorsRouter) Mount(parent *mux.Router) {
-	routes := resourceRoute{router: parent, pathPrefix: "/mutators"}
+	routes := resourceRoute{router: parent, pathPrefix: "/mutators", resource: types.Mutator{}}
 	routes.index(r.list)
 	routes.show(r.find)
 	routes.create(r.create)
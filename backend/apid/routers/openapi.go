@@ -0,0 +1,34 @@
+package routers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
+	"github.com/sensu/sensu-go/version"
+)
+
+// OpenAPIRouter handles requests for /api/openapi.json
+type OpenAPIRouter struct {
+	root *mux.Router
+}
+
+// NewOpenAPIRouter instantiates new router documenting the routes of the root
+// router
+func NewOpenAPIRouter(root *mux.Router) *OpenAPIRouter {
+	return &OpenAPIRouter{root: root}
+}
+
+// Mount the OpenAPIRouter to a parent Router
+func (r *OpenAPIRouter) Mount(parent *mux.Router) {
+	handleAction(parent, "/api/openapi.json", r.document).Methods(http.MethodGet)
+}
+
+// document generates the OpenAPI document of the API, on every request since
+// the routes are all mounted after this one.
+func (r *OpenAPIRouter) document(req *http.Request) (interface{}, error) {
+	return operations.Generate(r.root, openapi.Info{
+		Title:   "Sensu API",
+		Version: version.Semver(),
+	})
+}
@@ -0,0 +1,53 @@
+package routers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHttpApiOpenAPI(t *testing.T) {
+	store := &mockstore.MockStore{}
+	router := mux.NewRouter()
+	NewOpenAPIRouter(router).Mount(router)
+	NewHandlersRouter(store, nil).Mount(router)
+	NewEventsRouter(store, nil).Mount(router)
+	NewRolesRouter(store).Mount(router)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/openapi.json", nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	doc := openapi.Document{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &doc))
+	assert.Equal(t, openapi.Version, doc.OpenAPI)
+
+	// The conventional routes are described by the resource
+	require.Contains(t, doc.Paths, "/handlers/{id}")
+	show := doc.Paths["/handlers/{id}"].Get
+	require.NotNil(t, show)
+	assert.Equal(t, "getHandlersId", show.OperationID)
+	assert.Equal(t, "#/components/schemas/Handler", show.Responses["200"].Content["application/json"].Schema.Ref)
+	require.NotNil(t, doc.Paths["/handlers/{id}"].Delete)
+	assert.Contains(t, doc.Paths["/handlers/{id}"].Delete.Responses, "204")
+	assert.Contains(t, doc.Components.Schemas, "Handler")
+
+	// The custom routes are described explicitly
+	cloudEvents := doc.Paths["/events/cloudevents"].Post
+	require.NotNil(t, cloudEvents)
+	assert.Equal(t, "#/components/schemas/CloudEvent", cloudEvents.RequestBody.Content["application/json"].Schema.Ref)
+
+	// Or documented as arbitrary JSON
+	rules := doc.Paths["/rbac/roles/{id}/rules/{type}"].Put
+	require.NotNil(t, rules)
+	assert.Len(t, rules.Parameters, 2)
+	assert.Contains(t, rules.Responses, "200")
+}
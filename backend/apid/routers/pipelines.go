@@ -0,0 +1,76 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// PipelinesRouter handles /pipelines requests.
+type PipelinesRouter struct {
+	controller actions.PipelineController
+}
+
+// NewPipelinesRouter creates a new PipelinesRouter.
+func NewPipelinesRouter(store store.PipelineStore) *PipelinesRouter {
+	return &PipelinesRouter{
+		controller: actions.NewPipelineController(store),
+	}
+}
+
+// Mount the PipelinesRouter to a parent Router
+func (r *PipelinesRouter) Mount(parent *mux.Router) {
+	routes := resourceRoute{router: parent, pathPrefix: "/pipelines", resource: types.Pipeline{}}
+	routes.index(r.list)
+	routes.show(r.find)
+	routes.create(r.create)
+	routes.update(r.update)
+	routes.destroy(r.destroy)
+}
+
+func (r *PipelinesRouter) list(req *http.Request) (interface{}, error) {
+	return r.controller.Query(req.Context())
+}
+
+func (r *PipelinesRouter) find(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	return r.controller.Find(req.Context(), id)
+}
+
+func (r *PipelinesRouter) create(req *http.Request) (interface{}, error) {
+	pipeline := types.Pipeline{}
+	if err := unmarshalBody(req, &pipeline); err != nil {
+		return nil, err
+	}
+
+	err := r.controller.Create(req.Context(), pipeline)
+	return pipeline, err
+}
+
+func (r *PipelinesRouter) update(req *http.Request) (interface{}, error) {
+	pipeline := types.Pipeline{}
+	if err := unmarshalBody(req, &pipeline); err != nil {
+		return nil, err
+	}
+
+	err := r.controller.Update(req.Context(), pipeline)
+	return pipeline, err
+}
+
+func (r *PipelinesRouter) destroy(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	name, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	err = r.controller.Destroy(req.Context(), name)
+	return nil, err
+}
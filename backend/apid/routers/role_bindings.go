@@ -0,0 +1,75 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/types"
+)
+
+// RoleBindingsRouter handles /rolebindings requests.
+type RoleBindingsRouter struct {
+	controller actions.RoleBindingController
+}
+
+// NewRoleBindingsRouter creates a new RoleBindingsRouter.
+func NewRoleBindingsRouter(store actions.RoleBindingStore) *RoleBindingsRouter {
+	return &RoleBindingsRouter{
+		controller: actions.NewRoleBindingController(store),
+	}
+}
+
+// Mount the RoleBindingsRouter to a parent Router
+func (r *RoleBindingsRouter) Mount(parent *mux.Router) {
+	routes := resourceRoute{router: parent, pathPrefix: "/rolebindings", resource: types.RoleBinding{}}
+	routes.index(r.list)
+	routes.show(r.find)
+	routes.create(r.create)
+	routes.update(r.update)
+	routes.destroy(r.destroy)
+}
+
+func (r *RoleBindingsRouter) list(req *http.Request) (interface{}, error) {
+	return r.controller.Query(req.Context())
+}
+
+func (r *RoleBindingsRouter) find(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	return r.controller.Find(req.Context(), id)
+}
+
+func (r *RoleBindingsRouter) create(req *http.Request) (interface{}, error) {
+	binding := types.RoleBinding{}
+	if err := unmarshalBody(req, &binding); err != nil {
+		return nil, err
+	}
+
+	err := r.controller.Create(req.Context(), binding)
+	return binding, err
+}
+
+func (r *RoleBindingsRouter) update(req *http.Request) (interface{}, error) {
+	binding := types.RoleBinding{}
+	if err := unmarshalBody(req, &binding); err != nil {
+		return nil, err
+	}
+
+	err := r.controller.Update(req.Context(), binding)
+	return binding, err
+}
+
+func (r *RoleBindingsRouter) destroy(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	name, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	err = r.controller.Destroy(req.Context(), name)
+	return nil, err
+}
@@ -24,7 +24,7 @@ func NewRolesRouter(store store.RBACStore) *RolesRouter {
 
 // Mount the RolesRouter to a parent Router
 func (r *RolesRouter) Mount(parent *mux.Router) {
-	routes := resourceRoute{router: parent, pathPrefix: "/rbac/roles"}
+	routes := resourceRoute{router: parent, pathPrefix: "/rbac/roles", resource: types.Role{}}
 	routes.index(r.list)
 	routes.show(r.find)
 	routes.create(r.create)
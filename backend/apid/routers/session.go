@@ -0,0 +1,261 @@
+package routers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// RefreshTokenCookie is the name of the cookie holding the refresh token of
+	// a dashboard session
+	RefreshTokenCookie = "sensu_refresh_token"
+
+	// CSRFTokenHeader is the header holding the CSRF token of the requests
+	// authenticated by the refresh token cookie
+	CSRFTokenHeader = "X-CSRF-Token"
+
+	// sessionPath is the path of the session endpoints, the refresh token
+	// cookie is only sent along requests to these
+	sessionPath = "/auth/session"
+)
+
+// SessionRouter handles the dashboard sessions, whose refresh token is stored
+// in an HttpOnly cookie so it can't be read by scripts running in the browser.
+type SessionRouter struct {
+	store store.Store
+}
+
+// NewSessionRouter instantiates new router.
+func NewSessionRouter(store store.Store) *SessionRouter {
+	return &SessionRouter{store: store}
+}
+
+// Mount the session routes on given mux.Router.
+func (s *SessionRouter) Mount(r *mux.Router) {
+	r.HandleFunc(sessionPath, s.login).Methods(http.MethodPost)
+	r.HandleFunc(sessionPath, s.logout).Methods(http.MethodDelete)
+	r.HandleFunc(sessionPath+"/csrf", s.csrf).Methods(http.MethodGet)
+	r.HandleFunc(sessionPath+"/token", s.token).Methods(http.MethodPost)
+}
+
+// login authenticates the user with the credentials provided in the
+// Authorization header, and opens a new session
+func (s *SessionRouter) login(w http.ResponseWriter, r *http.Request) {
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		http.Error(w, "Request unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	user, err := s.store.AuthenticateUser(r.Context(), username, password)
+	if err != nil {
+		logger.WithField(
+			"user", username,
+		).Errorf("invalid username and/or password: %s", err.Error())
+		http.Error(w, "Request unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	refreshToken, refreshTokenString, err := jwt.RefreshToken(user.Username, user.Groups)
+	if err != nil {
+		err = fmt.Errorf("could not issue a refresh token: %s", err.Error())
+		logger.WithField("user", username).Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	refreshClaims, err := jwt.GetClaims(refreshToken)
+	if err != nil {
+		err = fmt.Errorf("could not get the refresh token claims: %s", err.Error())
+		logger.WithField("user", username).Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = s.store.CreateToken(refreshClaims); err != nil {
+		err = fmt.Errorf("could not add the refresh token to the access list: %s", err.Error())
+		logger.WithField("user", username).Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     RefreshTokenCookie,
+		Value:    refreshTokenString,
+		Path:     sessionPath,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+	})
+
+	s.issueAccessToken(w, user, refreshClaims)
+}
+
+// logout closes the session of the refresh token cookie
+func (s *SessionRouter) logout(w http.ResponseWriter, r *http.Request) {
+	refreshClaims, ok := s.sessionClaims(w, r)
+	if !ok {
+		return
+	}
+
+	// Also revoke the access token of the session, if provided
+	tokensToRemove := []string{refreshClaims.Id}
+	if accessToken, err := jwt.ValidateExpiredToken(jwt.ExtractBearerToken(r)); err == nil {
+		if accessClaims, err := jwt.GetClaims(accessToken); err == nil && accessClaims.Subject == refreshClaims.Subject {
+			tokensToRemove = append(tokensToRemove, accessClaims.Id)
+		}
+	}
+
+	if err := s.store.DeleteTokens(refreshClaims.Subject, tokensToRemove); err != nil {
+		err = fmt.Errorf("could not remove the session tokens from the access list: %s", err.Error())
+		logger.WithField("user", refreshClaims.Subject).Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     RefreshTokenCookie,
+		Path:     sessionPath,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+	})
+}
+
+// csrf returns the CSRF token of the session, e.g. after a page reload
+func (s *SessionRouter) csrf(w http.ResponseWriter, r *http.Request) {
+	refreshClaims, ok := s.refreshClaims(w, r)
+	if !ok {
+		return
+	}
+
+	writeSession(w, &types.Session{CSRFToken: jwt.CSRFToken(refreshClaims)})
+}
+
+// token issues a new access token for the session, so the dashboard can renew
+// its access token without prompting the user for credentials
+func (s *SessionRouter) token(w http.ResponseWriter, r *http.Request) {
+	refreshClaims, ok := s.sessionClaims(w, r)
+	if !ok {
+		return
+	}
+
+	// Reload the user, so that the new access token isn't issued to a disabled
+	// user, nor with groups the user was removed from
+	user, err := s.store.GetUser(r.Context(), refreshClaims.Subject)
+	if err != nil {
+		err = fmt.Errorf("could not retrieve the user: %s", err.Error())
+		logger.WithField("user", refreshClaims.Subject).Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	} else if user == nil || user.Disabled {
+		logger.WithField("user", refreshClaims.Subject).Error("the user of the session does not exist or is disabled")
+		http.Error(w, "Request unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	s.issueAccessToken(w, user, refreshClaims)
+}
+
+// refreshClaims retrieves and validates the refresh token cookie. It writes
+// an error to the response if the session is invalid.
+func (s *SessionRouter) refreshClaims(w http.ResponseWriter, r *http.Request) (*types.Claims, bool) {
+	cookie, err := r.Cookie(RefreshTokenCookie)
+	if err != nil {
+		http.Error(w, "Request unauthorized", http.StatusUnauthorized)
+		return nil, false
+	}
+
+	refreshToken, err := jwt.ValidateToken(cookie.Value)
+	if err != nil {
+		logger.Errorf("refresh token is invalid: %s", err.Error())
+		http.Error(w, "Request unauthorized", http.StatusUnauthorized)
+		return nil, false
+	}
+
+	refreshClaims, err := jwt.GetClaims(refreshToken)
+	if err != nil {
+		logger.Errorf("could not parse the refresh token claims: %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	// Make sure the refresh token is authorized in the access list
+	if _, err := s.store.GetToken(refreshClaims.Subject, refreshClaims.Id); err != nil {
+		logger.WithField(
+			"user", refreshClaims.Subject,
+		).Errorf("the refresh token is not authorized: %s", err.Error())
+		http.Error(w, "Request unauthorized", http.StatusUnauthorized)
+		return nil, false
+	}
+
+	return refreshClaims, true
+}
+
+// sessionClaims retrieves the refresh token cookie like refreshClaims, and
+// additionally verifies the CSRF token of the request.
+func (s *SessionRouter) sessionClaims(w http.ResponseWriter, r *http.Request) (*types.Claims, bool) {
+	refreshClaims, ok := s.refreshClaims(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	if !jwt.ValidateCSRFToken(refreshClaims, r.Header.Get(CSRFTokenHeader)) {
+		logger.WithField("user", refreshClaims.Subject).Error("invalid CSRF token")
+		http.Error(w, "invalid CSRF token", http.StatusForbidden)
+		return nil, false
+	}
+
+	return refreshClaims, true
+}
+
+// issueAccessToken writes a session with a new access token of the user to
+// the response
+func (s *SessionRouter) issueAccessToken(w http.ResponseWriter, user *types.User, refreshClaims *types.Claims) {
+	accessToken, accessTokenString, err := jwt.AccessToken(user.Username, user.Groups)
+	if err != nil {
+		err = fmt.Errorf("could not issue an access token: %s", err.Error())
+		logger.WithField("user", refreshClaims.Subject).Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	accessClaims, err := jwt.GetClaims(accessToken)
+	if err != nil {
+		err = fmt.Errorf("could not get the access token claims: %s", err.Error())
+		logger.WithField("user", refreshClaims.Subject).Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = s.store.CreateToken(accessClaims); err != nil {
+		err = fmt.Errorf("could not add the access token to the access list: %s", err.Error())
+		logger.WithField("user", refreshClaims.Subject).Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeSession(w, &types.Session{
+		Access:    accessTokenString,
+		ExpiresAt: accessClaims.ExpiresAt,
+		CSRFToken: jwt.CSRFToken(refreshClaims),
+	})
+}
+
+func writeSession(w http.ResponseWriter, session *types.Session) {
+	resBytes, err := json.Marshal(session)
+	if err != nil {
+		err = fmt.Errorf("could not not marshal response: %s", err.Error())
+		logger.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(resBytes)
+}
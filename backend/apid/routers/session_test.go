@@ -0,0 +1,171 @@
+package routers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func refreshTokenCookie(res *httptest.ResponseRecorder) *http.Cookie {
+	for _, cookie := range res.Result().Cookies() {
+		if cookie.Name == RefreshTokenCookie {
+			return cookie
+		}
+	}
+	return nil
+}
+
+func TestSessionLoginInvalidCredentials(t *testing.T) {
+	store := &mockstore.MockStore{}
+	s := NewSessionRouter(store)
+
+	store.
+		On("AuthenticateUser", mock.Anything, "foo", "P@ssw0rd!").
+		Return((*types.User)(nil), fmt.Errorf("error"))
+
+	req, _ := http.NewRequest(http.MethodPost, "/auth/session", nil)
+	req.SetBasicAuth("foo", "P@ssw0rd!")
+
+	res := processRequest(s, req)
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	assert.Nil(t, refreshTokenCookie(res))
+}
+
+func TestSessionLogin(t *testing.T) {
+	store := &mockstore.MockStore{}
+	s := NewSessionRouter(store)
+
+	store.On("CreateToken", mock.AnythingOfType("*types.Claims")).Return(nil)
+	store.
+		On("AuthenticateUser", mock.Anything, "foo", "P@ssw0rd!").
+		Return(types.FixtureUser("foo"), nil)
+
+	req, _ := http.NewRequest(http.MethodPost, "/auth/session", nil)
+	req.SetBasicAuth("foo", "P@ssw0rd!")
+
+	res := processRequest(s, req)
+	require.Equal(t, http.StatusOK, res.Code)
+
+	cookie := refreshTokenCookie(res)
+	require.NotNil(t, cookie)
+	assert.True(t, cookie.HttpOnly)
+	assert.Equal(t, "/auth/session", cookie.Path)
+
+	// The refresh token is only provided in the cookie
+	session := &types.Session{}
+	require.NoError(t, json.Unmarshal(res.Body.Bytes(), session))
+	assert.NotEmpty(t, session.Access)
+	assert.NotZero(t, session.ExpiresAt)
+	assert.NotContains(t, res.Body.String(), cookie.Value)
+
+	refreshToken, err := jwt.ValidateToken(cookie.Value)
+	require.NoError(t, err)
+	claims, _ := jwt.GetClaims(refreshToken)
+	assert.Equal(t, jwt.CSRFToken(claims), session.CSRFToken)
+}
+
+func TestSessionToken(t *testing.T) {
+	_, refreshTokenString, _ := jwt.RefreshToken("foo", nil)
+	refreshToken, _ := jwt.ValidateToken(refreshTokenString)
+	claims, _ := jwt.GetClaims(refreshToken)
+
+	testCases := []struct {
+		name      string
+		cookie    bool
+		csrfToken string
+		storeErr  error
+		want      int
+	}{
+		{"no cookie", false, jwt.CSRFToken(claims), nil, http.StatusUnauthorized},
+		{"no csrf token", true, "", nil, http.StatusForbidden},
+		{"invalid csrf token", true, "foo", nil, http.StatusForbidden},
+		{"revoked refresh token", true, jwt.CSRFToken(claims), fmt.Errorf("error"), http.StatusUnauthorized},
+		{"renewed", true, jwt.CSRFToken(claims), nil, http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			s := NewSessionRouter(store)
+
+			store.On("GetToken", "foo", claims.Id).Return(claims, tc.storeErr)
+			store.On("GetUser", mock.Anything, "foo").Return(types.FixtureUser("foo"), nil)
+			store.On("CreateToken", mock.AnythingOfType("*types.Claims")).Return(nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/auth/session/token", nil)
+			if tc.cookie {
+				req.AddCookie(&http.Cookie{Name: RefreshTokenCookie, Value: refreshTokenString})
+			}
+			req.Header.Set(CSRFTokenHeader, tc.csrfToken)
+
+			res := processRequest(s, req)
+			assert.Equal(t, tc.want, res.Code)
+
+			if tc.want == http.StatusOK {
+				session := &types.Session{}
+				require.NoError(t, json.Unmarshal(res.Body.Bytes(), session))
+				assert.NotEmpty(t, session.Access)
+				store.AssertCalled(t, "CreateToken", mock.AnythingOfType("*types.Claims"))
+			}
+		})
+	}
+}
+
+func TestSessionCSRF(t *testing.T) {
+	store := &mockstore.MockStore{}
+	s := NewSessionRouter(store)
+
+	_, refreshTokenString, _ := jwt.RefreshToken("foo", nil)
+	refreshToken, _ := jwt.ValidateToken(refreshTokenString)
+	claims, _ := jwt.GetClaims(refreshToken)
+	store.On("GetToken", "foo", claims.Id).Return(claims, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/auth/session/csrf", nil)
+	req.AddCookie(&http.Cookie{Name: RefreshTokenCookie, Value: refreshTokenString})
+
+	res := processRequest(s, req)
+	require.Equal(t, http.StatusOK, res.Code)
+
+	session := &types.Session{}
+	require.NoError(t, json.Unmarshal(res.Body.Bytes(), session))
+	assert.Empty(t, session.Access)
+	assert.Equal(t, jwt.CSRFToken(claims), session.CSRFToken)
+}
+
+func TestSessionLogout(t *testing.T) {
+	store := &mockstore.MockStore{}
+	s := NewSessionRouter(store)
+
+	_, refreshTokenString, _ := jwt.RefreshToken("foo", nil)
+	refreshToken, _ := jwt.ValidateToken(refreshTokenString)
+	refreshClaims, _ := jwt.GetClaims(refreshToken)
+	accessToken, accessTokenString, _ := jwt.AccessToken("foo", nil)
+	accessClaims, _ := jwt.GetClaims(accessToken)
+
+	store.On("GetToken", "foo", refreshClaims.Id).Return(refreshClaims, nil)
+	store.On("DeleteTokens", "foo", []string{refreshClaims.Id, accessClaims.Id}).Return(nil)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/auth/session", nil)
+	req.AddCookie(&http.Cookie{Name: RefreshTokenCookie, Value: refreshTokenString})
+	req.Header.Set(CSRFTokenHeader, jwt.CSRFToken(refreshClaims))
+	req.Header.Set("Authorization", "Bearer "+accessTokenString)
+
+	res := processRequest(s, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	store.AssertExpectations(t)
+
+	// The cookie is cleared
+	cookie := refreshTokenCookie(res)
+	require.NotNil(t, cookie)
+	assert.Empty(t, cookie.Value)
+	assert.True(t, cookie.MaxAge < 0)
+}
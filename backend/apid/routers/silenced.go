@@ -16,15 +16,17 @@ type SilencedRouter struct {
 }
 
 // NewSilencedRouter instantiates new router for controlling user resources
-func NewSilencedRouter(store store.Store) *SilencedRouter {
+func NewSilencedRouter(store store.Store, tombstones *actions.Tombstones) *SilencedRouter {
+	controller := actions.NewSilencedController(store)
+	controller.Tombstones = tombstones
 	return &SilencedRouter{
-		controller: actions.NewSilencedController(store),
+		controller: controller,
 	}
 }
 
 // Mount the SilencedRouter to a parent Router
 func (r *SilencedRouter) Mount(parent *mux.Router) {
-	routes := resourceRoute{router: parent, pathPrefix: "/silenced"}
+	routes := resourceRoute{router: parent, pathPrefix: "/silenced", resource: types.Silenced{}}
 	routes.index(r.list)
 	routes.show(r.find)
 	routes.create(r.create)
@@ -0,0 +1,260 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// SlackCommandsPath is the path of the Slack slash command endpoint, which
+// is given the organization and environment of the command in the org and
+// env query parameters.
+const SlackCommandsPath = "/slack/commands"
+
+// slackStatusLimit is the maximum number of failing events listed by the
+// status command.
+const slackStatusLimit = 10
+
+const slackUsage = "Usage:\n" +
+	"`silence [SUBSCRIPTION:]CHECK [DURATION] [REASON]` silences a check, e.g. `silence linux:disk 2h maintenance`\n" +
+	"`ack INCIDENT` acknowledges an incident\n" +
+	"`status [ENTITY]` lists the failing events"
+
+// SlackOptions configures the Slack slash command endpoint.
+type SlackOptions struct {
+	// SigningSecret is the signing secret of the Slack app, which the
+	// requests are verified with
+	SigningSecret string `json:"signing_secret"`
+
+	// Users maps the Slack user IDs to the Sensu usernames the commands are
+	// authorized as
+	Users map[string]string `json:"users"`
+}
+
+// LoadSlackOptions returns the Slack options of the JSON file at the given
+// path.
+func LoadSlackOptions(path string) (*SlackOptions, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the slack configuration: %s", err)
+	}
+
+	var options SlackOptions
+	if err := json.Unmarshal(b, &options); err != nil {
+		return nil, fmt.Errorf("could not read the slack configuration of %s: %s", path, err)
+	}
+	if options.SigningSecret == "" {
+		return nil, fmt.Errorf("no signing secret in the slack configuration of %s", path)
+	}
+	return &options, nil
+}
+
+// slackMessage is the response to a slash command. The messages of the
+// "in_channel" type are shown to the whole channel, the "ephemeral" ones only
+// to the user.
+type slackMessage struct {
+	ResponseType string `json:"response_type"`
+	Text         string `json:"text"`
+}
+
+// SlackRouter handles the Slack slash commands, which silence checks,
+// acknowledge incidents and report the failing events. The commands are
+// authorized as the Sensu user mapped to the Slack user.
+type SlackRouter struct {
+	events    actions.EventController
+	incidents actions.IncidentController
+	silenced  actions.SilencedController
+}
+
+// NewSlackRouter instantiates new router for the Slack slash commands.
+func NewSlackRouter(store store.Store, bus messaging.MessageBus) *SlackRouter {
+	return &SlackRouter{
+		events:    actions.NewEventController(store, bus),
+		incidents: actions.NewIncidentController(store),
+		silenced:  actions.NewSilencedController(store),
+	}
+}
+
+// Mount the SlackRouter to a parent Router
+func (r *SlackRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc(SlackCommandsPath, r.command).Methods(http.MethodPost)
+	operations.Describe(SlackCommandsPath, openapi.Route{
+		Summary:  "Run a Slack slash command",
+		Response: slackMessage{},
+	}, http.MethodPost)
+}
+
+func (r *SlackRouter) command(w http.ResponseWriter, req *http.Request) {
+	args := strings.Fields(req.PostFormValue("text"))
+	if len(args) == 0 {
+		respondWith(w, slackMessage{ResponseType: "ephemeral", Text: slackUsage})
+		return
+	}
+
+	ctx := req.Context()
+	var msg slackMessage
+	var err error
+	switch args[0] {
+	case "silence":
+		msg, err = r.silence(ctx, args[1:])
+	case "ack":
+		msg, err = r.ack(ctx, args[1:])
+	case "status":
+		msg, err = r.status(ctx, args[1:])
+	default:
+		msg = slackMessage{ResponseType: "ephemeral", Text: slackUsage}
+	}
+
+	if err != nil {
+		if actionErr, ok := err.(actions.Error); ok && actionErr.Message != "" {
+			err = errors.New(actionErr.Message)
+		}
+		msg = slackMessage{ResponseType: "ephemeral", Text: "Error: " + err.Error()}
+	}
+	respondWith(w, msg)
+}
+
+// silence silences a check, until the given duration elapses, if any.
+func (r *SlackRouter) silence(ctx context.Context, args []string) (slackMessage, error) {
+	if len(args) == 0 {
+		return slackMessage{}, errors.New("no check to silence given")
+	}
+
+	silenced := types.Silenced{Check: args[0]}
+	if strings.Contains(args[0], ":") {
+		subscription, check, err := types.ParseSilencedID(args[0])
+		if err != nil {
+			return slackMessage{}, err
+		}
+		silenced = types.Silenced{Subscription: subscription, Check: check}
+	}
+	silenced.Organization, silenced.Environment = contextNamespace(ctx)
+
+	args = args[1:]
+	var duration time.Duration
+	if len(args) > 0 {
+		if d, err := time.ParseDuration(args[0]); err == nil {
+			if d <= 0 {
+				return slackMessage{}, errors.New("the duration must be positive")
+			}
+			duration = d
+			silenced.Expire = int64(d.Seconds())
+			args = args[1:]
+		}
+	}
+	silenced.Reason = strings.Join(args, " ")
+
+	if err := r.silenced.Create(ctx, silenced); err != nil {
+		return slackMessage{}, err
+	}
+
+	id, _ := types.SilencedID(silenced.Subscription, silenced.Check)
+	text := fmt.Sprintf("%s silenced %s", slackUser(ctx), id)
+	if duration > 0 {
+		text += " for " + duration.String()
+	}
+	if silenced.Reason != "" {
+		text += ": " + silenced.Reason
+	}
+	return slackMessage{ResponseType: "in_channel", Text: text}, nil
+}
+
+// ack acknowledges an incident.
+func (r *SlackRouter) ack(ctx context.Context, args []string) (slackMessage, error) {
+	if len(args) != 1 {
+		return slackMessage{}, errors.New("expected the ID of the incident to acknowledge")
+	}
+
+	incident := types.Incident{ID: args[0], State: types.IncidentAcknowledged}
+	incident.Organization, incident.Environment = contextNamespace(ctx)
+	if err := r.incidents.Update(ctx, incident); err != nil {
+		return slackMessage{}, err
+	}
+
+	text := fmt.Sprintf("%s acknowledged incident %s", slackUser(ctx), incident.ID)
+	return slackMessage{ResponseType: "in_channel", Text: text}, nil
+}
+
+// status lists the failing events, of the given entity if any, the most
+// severe first.
+func (r *SlackRouter) status(ctx context.Context, args []string) (slackMessage, error) {
+	if len(args) > 1 {
+		return slackMessage{}, errors.New("expected at most one entity")
+	}
+	var entity string
+	if len(args) == 1 {
+		entity = args[0]
+	}
+
+	events, err := r.events.Query(ctx, entity, "")
+	if err != nil {
+		return slackMessage{}, err
+	}
+
+	var failing []*types.Event
+	for _, event := range events {
+		if event.HasCheck() && event.Check.Status != 0 {
+			failing = append(failing, event)
+		}
+	}
+	sort.Slice(failing, func(i, j int) bool {
+		if failing[i].Check.Status != failing[j].Check.Status {
+			return failing[i].Check.Status > failing[j].Check.Status
+		}
+		if failing[i].Entity.ID != failing[j].Entity.ID {
+			return failing[i].Entity.ID < failing[j].Entity.ID
+		}
+		return failing[i].Check.Name < failing[j].Check.Name
+	})
+
+	lines := []string{fmt.Sprintf("%d events, %d failing", len(events), len(failing))}
+	for i, event := range failing {
+		if i == slackStatusLimit {
+			lines = append(lines, fmt.Sprintf("and %d more", len(failing)-slackStatusLimit))
+			break
+		}
+		output := strings.TrimSpace(strings.SplitN(event.Check.Output, "\n", 2)[0])
+		line := fmt.Sprintf("• %s/%s (status %d) %s", event.Entity.ID, event.Check.Name, event.Check.Status, output)
+		lines = append(lines, line+slackLinks(event.Check))
+	}
+	return slackMessage{ResponseType: "ephemeral", Text: strings.Join(lines, "\n")}, nil
+}
+
+// slackLinks formats the runbook and dashboard links of the check, if any.
+func slackLinks(check *types.Check) string {
+	var links string
+	if check.RunbookURL != "" {
+		links += fmt.Sprintf(" <%s|runbook>", check.RunbookURL)
+	}
+	if check.DashboardURL != "" {
+		links += fmt.Sprintf(" <%s|dashboard>", check.DashboardURL)
+	}
+	return links
+}
+
+// slackUser returns the Sensu user the command is authorized as.
+func slackUser(ctx context.Context) string {
+	actor, _ := ctx.Value(types.AuthorizationActorKey).(authorization.Actor)
+	return actor.Name
+}
+
+// contextNamespace returns the organization and environment of the request.
+func contextNamespace(ctx context.Context) (string, string) {
+	org, _ := ctx.Value(types.OrganizationKey).(string)
+	env, _ := ctx.Value(types.EnvironmentKey).(string)
+	return org, env
+}
@@ -0,0 +1,116 @@
+package routers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func runSlackCommand(t *testing.T, store *mockstore.MockStore, text string, rules ...types.Rule) slackMessage {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithActor("alice", rules...),
+	)
+	form := url.Values{"text": {text}, "user_id": {"U123"}}
+	req := httptest.NewRequest(http.MethodPost, SlackCommandsPath, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	router := &SlackRouter{
+		events:    actions.NewEventController(store, nil),
+		incidents: actions.NewIncidentController(store),
+		silenced:  actions.NewSilencedController(store),
+	}
+	rr := httptest.NewRecorder()
+	router.command(rr, req.WithContext(ctx))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var msg slackMessage
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&msg))
+	return msg
+}
+
+func TestSlackUsage(t *testing.T) {
+	store := &mockstore.MockStore{}
+	for _, text := range []string{"", "help", "unknown"} {
+		msg := runSlackCommand(t, store, text)
+		assert.Equal(t, "ephemeral", msg.ResponseType)
+		assert.Contains(t, msg.Text, "Usage")
+	}
+}
+
+func TestSlackSilence(t *testing.T) {
+	store := &mockstore.MockStore{}
+	var nilSilenced *types.Silenced
+	store.On("GetSilencedEntryByID", mock.Anything, "linux:disk").Return(nilSilenced, nil)
+	store.On("UpdateSilencedEntry", mock.Anything, mock.MatchedBy(func(s *types.Silenced) bool {
+		return s.ID == "linux:disk" && s.Expire == 7200 && s.Reason == "disk maintenance" && s.Creator == "alice"
+	})).Return(nil)
+
+	rule := types.FixtureRuleWithPerms(types.RuleTypeSilenced, types.RulePermCreate)
+	msg := runSlackCommand(t, store, "silence linux:disk 2h disk maintenance", rule)
+	assert.Equal(t, "in_channel", msg.ResponseType)
+	assert.Equal(t, "alice silenced linux:disk for 2h0m0s: disk maintenance", msg.Text)
+	store.AssertExpectations(t)
+
+	// The user must be allowed to silence the check
+	store.On("GetSilencedEntryByID", mock.Anything, "*:disk").Return(nilSilenced, nil)
+	msg = runSlackCommand(t, store, "silence disk")
+	assert.Equal(t, "ephemeral", msg.ResponseType)
+	assert.Contains(t, msg.Text, "Error")
+
+	msg = runSlackCommand(t, store, "silence", rule)
+	assert.Contains(t, msg.Text, "Error")
+}
+
+func TestSlackAck(t *testing.T) {
+	store := &mockstore.MockStore{}
+	incident := types.FixtureIncident("incident1", "check")
+	store.On("GetIncidentByID", mock.Anything, "incident1").Return(incident, nil)
+	store.On("UpdateIncident", mock.Anything).Return(nil)
+
+	rule := types.FixtureRuleWithPerms(types.RuleTypeIncident, types.RulePermUpdate)
+	msg := runSlackCommand(t, store, "ack incident1", rule)
+	assert.Equal(t, "in_channel", msg.ResponseType)
+	assert.Equal(t, "alice acknowledged incident incident1", msg.Text)
+	assert.Equal(t, types.IncidentAcknowledged, incident.State)
+
+	msg = runSlackCommand(t, store, "ack", rule)
+	assert.Contains(t, msg.Text, "Error")
+}
+
+func TestSlackStatus(t *testing.T) {
+	store := &mockstore.MockStore{}
+	passing := types.FixtureEvent("entity1", "check1")
+	warning := types.FixtureEvent("entity1", "check2")
+	warning.Check.Status = 1
+	warning.Check.Output = "disk 85% full\nmore details"
+	critical := types.FixtureEvent("entity2", "check1")
+	critical.Check.Status = 2
+	critical.Check.RunbookURL = "https://wiki/check1"
+	store.On("GetEvents", mock.Anything).Return([]*types.Event{passing, warning, critical}, nil)
+
+	rule := types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead)
+	msg := runSlackCommand(t, store, "status", rule)
+	assert.Equal(t, "ephemeral", msg.ResponseType)
+	lines := strings.Split(msg.Text, "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, "3 events, 2 failing", lines[0])
+	assert.Contains(t, lines[1], "entity2/check1 (status 2)")
+	assert.Contains(t, lines[1], "<https://wiki/check1|runbook>")
+	assert.Contains(t, lines[2], "entity1/check2 (status 1) disk 85% full")
+
+	// The events the user can't read are not reported
+	msg = runSlackCommand(t, store, "status")
+	assert.Equal(t, "0 events, 0 failing", msg.Text)
+}
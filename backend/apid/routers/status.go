@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -29,10 +30,13 @@ func (r *StatusRouter) info(req *http.Request) (interface{}, error) {
 	return r.status(), nil
 }
 
+// health responds with the status of every backend component, and a 503 status
+// code if any of them is unhealthy.
 func (r *StatusRouter) health(w http.ResponseWriter, _ *http.Request) {
-	if !r.status().Healthy() {
-		http.Error(w, "", http.StatusServiceUnavailable)
-		return
+	status := r.status()
+	w.Header().Set("Content-Type", "application/json")
+	if !status.Healthy() {
+		w.WriteHeader(http.StatusServiceUnavailable)
 	}
-	// Implicitly returns 200
+	_ = json.NewEncoder(w).Encode(status)
 }
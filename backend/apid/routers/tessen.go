@@ -0,0 +1,47 @@
+package routers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// TessenRouter handles requests for /tessen
+type TessenRouter struct {
+	controller actions.TessenController
+}
+
+// NewTessenRouter instantiates new router for the anonymous usage reporting
+func NewTessenRouter(store store.Store) *TessenRouter {
+	return &TessenRouter{
+		controller: actions.NewTessenController(store),
+	}
+}
+
+// Mount the TessenRouter to a parent Router
+func (r *TessenRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/tessen", actionHandler(r.find)).Methods(http.MethodGet)
+	parent.HandleFunc("/tessen", actionHandler(r.update)).Methods(http.MethodPut)
+	parent.HandleFunc("/tessen/preview", actionHandler(r.preview)).Methods(http.MethodGet)
+}
+
+func (r *TessenRouter) find(req *http.Request) (interface{}, error) {
+	return r.controller.Find(req.Context())
+}
+
+func (r *TessenRouter) update(req *http.Request) (interface{}, error) {
+	config := types.TessenConfig{}
+	if err := unmarshalBody(req, &config); err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	err := r.controller.Update(req.Context(), config)
+	return config, err
+}
+
+func (r *TessenRouter) preview(req *http.Request) (interface{}, error) {
+	return r.controller.Preview(req.Context())
+}
@@ -0,0 +1,51 @@
+package routers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHttpApiTessen(t *testing.T) {
+	ctx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeTessen, types.RulePermRead, types.RulePermUpdate),
+	))
+
+	store := &mockstore.MockStore{}
+	store.On("GetTessenConfig", mock.Anything).Return(types.FixtureTessenConfig(false), nil)
+	store.On("UpdateTessenConfig", mock.Anything, types.FixtureTessenConfig(true)).Return(nil)
+
+	router := mux.NewRouter()
+	NewTessenRouter(store).Mount(router)
+
+	req := httptest.NewRequest(http.MethodGet, "/tessen", nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	config := types.TessenConfig{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &config))
+	assert.False(t, config.OptIn)
+
+	body, _ := json.Marshal(types.FixtureTessenConfig(true))
+	req = httptest.NewRequest(http.MethodPut, "/tessen", bytes.NewReader(body))
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	store.AssertCalled(t, "UpdateTessenConfig", mock.Anything, types.FixtureTessenConfig(true))
+
+	req = httptest.NewRequest(http.MethodPut, "/tessen", bytes.NewReader([]byte("foo")))
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
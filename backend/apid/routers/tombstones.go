@@ -0,0 +1,45 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
+	"github.com/sensu/sensu-go/types"
+)
+
+// TombstonesRouter handles requests for /tombstones
+type TombstonesRouter struct {
+	controller actions.TombstoneController
+}
+
+// NewTombstonesRouter instantiates new router for restoring the deleted
+// resources
+func NewTombstonesRouter(store actions.TombstoneStore) *TombstonesRouter {
+	return &TombstonesRouter{
+		controller: actions.NewTombstoneController(store),
+	}
+}
+
+// Mount the TombstonesRouter to a parent Router
+func (r *TombstonesRouter) Mount(parent *mux.Router) {
+	routes := resourceRoute{router: parent, pathPrefix: "/tombstones", resource: types.Tombstone{}}
+	routes.index(r.list)
+	routes.path("{kind}/{name}/restore", r.restore).Methods(http.MethodPost)
+	routes.describe("{kind}/{name}/restore", openapi.Route{Response: types.Tombstone{}}, http.MethodPost)
+}
+
+func (r *TombstonesRouter) list(req *http.Request) (interface{}, error) {
+	return r.controller.Query(req.Context())
+}
+
+func (r *TombstonesRouter) restore(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	name, err := url.PathUnescape(params["name"])
+	if err != nil {
+		return nil, err
+	}
+	return r.controller.Restore(req.Context(), params["kind"], name)
+}
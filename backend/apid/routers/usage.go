@@ -0,0 +1,66 @@
+package routers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/usage"
+)
+
+// defaultUsageLimit is the number of usage records returned when no limit is
+// given.
+const defaultUsageLimit = 10
+
+// UsageRouter handles requests for /metrics/usage and /metrics/resources
+type UsageRouter struct {
+	controller actions.UsageController
+}
+
+// NewUsageRouter instantiates new router for the event throughput usage
+func NewUsageRouter(tracker *usage.Tracker) *UsageRouter {
+	return &UsageRouter{
+		controller: actions.NewUsageController(tracker),
+	}
+}
+
+// Mount the UsageRouter to a parent Router
+func (r *UsageRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/metrics/usage", actionHandler(r.list)).Methods(http.MethodGet)
+	parent.HandleFunc("/metrics/resources", actionHandler(r.listResources)).Methods(http.MethodGet)
+}
+
+func (r *UsageRouter) list(req *http.Request) (interface{}, error) {
+	query := req.URL.Query()
+
+	limit, err := usageLimit(query.Get("limit"))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.controller.Query(req.Context(), limit, query.Get("sort"))
+}
+
+func (r *UsageRouter) listResources(req *http.Request) (interface{}, error) {
+	query := req.URL.Query()
+
+	limit, err := usageLimit(query.Get("limit"))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.controller.QueryResources(req.Context(), limit, query.Get("sort"), query.Get("kind"))
+}
+
+// usageLimit parses the limit of a usage query, defaultUsageLimit if empty.
+func usageLimit(l string) (int, error) {
+	if l == "" {
+		return defaultUsageLimit, nil
+	}
+	limit, err := strconv.Atoi(l)
+	if err != nil || limit < 0 {
+		return 0, actions.NewErrorf(actions.InvalidArgument, "invalid limit %q", l)
+	}
+	return limit, nil
+}
@@ -0,0 +1,72 @@
+package routers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/usage"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHttpApiUsageGet(t *testing.T) {
+	ctx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead),
+	))
+
+	tracker := usage.NewTracker()
+	tracker.Record("default", "default", "check1", 100)
+	tracker.Record("default", "default", "check2", 10)
+	tracker.Record("default", "default", "check2", 10)
+
+	router := mux.NewRouter()
+	NewUsageRouter(tracker).Mount(router)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics/usage?limit=1&sort=bytes", nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var records []types.Usage
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &records))
+	require.Len(t, records, 1)
+	assert.Equal(t, "check1", records[0].Check)
+
+	req = httptest.NewRequest(http.MethodGet, "/metrics/usage?limit=foo", nil)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestHttpApiResourceUsageGet(t *testing.T) {
+	ctx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead),
+	))
+
+	tracker := usage.NewTracker()
+	tracker.RecordExecution("default", "default", usage.KindCheck, "check1", 1, 1, 100)
+	tracker.RecordExecution("default", "default", usage.KindHandler, "handler1", 1, 1, 200)
+
+	router := mux.NewRouter()
+	NewUsageRouter(tracker).Mount(router)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics/resources?limit=1&sort=rss", nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var records []types.ResourceUsage
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &records))
+	require.Len(t, records, 1)
+	assert.Equal(t, "handler1", records[0].Name)
+
+	req = httptest.NewRequest(http.MethodGet, "/metrics/resources?kind=foo", nil)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req.WithContext(ctx))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
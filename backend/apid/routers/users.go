@@ -6,25 +6,30 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
 // UsersRouter handles requests for /users
 type UsersRouter struct {
-	controller actions.UserController
+	controller  actions.UserController
+	preferences actions.UserPreferencesController
+	tokens      actions.UserTokensController
 }
 
 // NewUsersRouter instantiates new router for controlling user resources
 func NewUsersRouter(store store.Store) *UsersRouter {
 	return &UsersRouter{
-		controller: actions.NewUserController(store),
+		controller:  actions.NewUserController(store),
+		preferences: actions.NewUserPreferencesController(store),
+		tokens:      actions.NewUserTokensController(store),
 	}
 }
 
 // Mount the UsersRouter to a parent Router
 func (r *UsersRouter) Mount(parent *mux.Router) {
-	routes := resourceRoute{router: parent, pathPrefix: "/rbac/users"}
+	routes := resourceRoute{router: parent, pathPrefix: "/rbac/users", resource: types.User{}}
 	routes.index(r.list)
 	routes.show(r.find)
 	routes.create(r.create)
@@ -35,6 +40,14 @@ func (r *UsersRouter) Mount(parent *mux.Router) {
 	routes.path("{id}/reinstate", r.reinstate).Methods(http.MethodPut)
 	routes.path("{id}/roles/{role}", r.addRole).Methods(http.MethodPut)
 	routes.path("{id}/roles/{role}", r.removeRole).Methods(http.MethodDelete)
+	routes.path("{id}/preferences", r.findPreferences).Methods(http.MethodGet)
+	routes.path("{id}/preferences", r.updatePreferences).Methods(http.MethodPut)
+	routes.path("{id}/preferences", r.destroyPreferences).Methods(http.MethodDelete)
+	routes.describe("{id}/preferences", openapi.Route{Response: types.UserPreferences{}}, http.MethodGet)
+	routes.describe("{id}/preferences", openapi.Route{Request: types.UserPreferences{}, Response: types.UserPreferences{}}, http.MethodPut)
+	routes.describe("{id}/preferences", openapi.Route{}, http.MethodDelete)
+	routes.path("{id}/tokens", r.createToken).Methods(http.MethodPost)
+	routes.describe("{id}/tokens", openapi.Route{Request: types.ScopedTokenRequest{}, Response: types.Tokens{}}, http.MethodPost)
 
 	// TODO: Remove?
 	routes.path("{id}/password", r.updatePassword).Methods(http.MethodPut)
@@ -154,3 +167,54 @@ func (r *UsersRouter) removeRole(req *http.Request) (interface{}, error) {
 	err = r.controller.RemoveRole(req.Context(), id, role)
 	return nil, err
 }
+
+func (r *UsersRouter) findPreferences(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	return r.preferences.Find(req.Context(), id)
+}
+
+func (r *UsersRouter) updatePreferences(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	preferences := types.UserPreferences{}
+	if err := unmarshalBody(req, &preferences); err != nil {
+		return nil, err
+	}
+	preferences.Username = id
+
+	err = r.preferences.Update(req.Context(), preferences)
+	return preferences, err
+}
+
+func (r *UsersRouter) destroyPreferences(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	err = r.preferences.Destroy(req.Context(), id)
+	return nil, err
+}
+
+func (r *UsersRouter) createToken(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	tokenRequest := types.ScopedTokenRequest{}
+	if err := unmarshalBody(req, &tokenRequest); err != nil {
+		return nil, err
+	}
+
+	return r.tokens.Create(req.Context(), id, tokenRequest)
+}
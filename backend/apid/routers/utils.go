@@ -8,18 +8,27 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
 	"github.com/sensu/sensu-go/backend/queue"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/util/tracing"
+	"go.opencensus.io/trace"
 )
 
+// operations describes the routes mounted by the routers in the OpenAPI
+// document.
+var operations = openapi.NewRegistry()
+
 type queueStore interface {
 	store.Store
 	queue.Get
 }
 
 type errorBody struct {
-	Message string `json:"error"`
-	Code    uint32 `json:"code"`
+	Message string                 `json:"error"`
+	Code    uint32                 `json:"code"`
+	Fields  types.ValidationErrors `json:"fields,omitempty"`
 }
 
 // respondWith given writer and resource, marshal to JSON and write response.
@@ -59,6 +68,7 @@ func writeError(w http.ResponseWriter, err error) {
 	if ok {
 		errBody.Message = actionErr.Message
 		errBody.Code = uint32(actionErr.Code)
+		errBody.Fields = actionErr.Fields
 		st = HTTPStatusFromCode(actionErr.Code)
 	} else {
 		errBody.Message = err.Error()
@@ -127,7 +137,15 @@ func HTTPStatusFromCode(code actions.ErrCode) int {
 //
 func actionHandler(action actionHandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		records, err := action(r)
+		ctx, span := trace.StartSpan(r.Context(), "action")
+		if route := mux.CurrentRoute(r); route != nil {
+			if tpl, err := route.GetPathTemplate(); err == nil {
+				span.AddAttributes(trace.StringAttribute("route", tpl))
+			}
+		}
+		records, err := action(r.WithContext(ctx))
+		tracing.SetError(span, err)
+		span.End()
 		if err != nil {
 			writeError(w, err)
 			return
@@ -150,28 +168,47 @@ type actionHandlerFunc func(r *http.Request) (interface{}, error)
 //   routes.destroy(myCreateAction) // given action is mounted at DELETE /checks/:id
 //   routes.path("{id}/publish", publishAction).Methods(http.MethodDelete) // when you need something customer
 //
+// When a resource is given, e.g. types.CheckConfig{}, the conventional routes
+// are described in the OpenAPI document with its schema.
+//
 type resourceRoute struct {
 	router     *mux.Router
 	pathPrefix string
+	resource   interface{}
 }
 
 func (r *resourceRoute) index(fn actionHandlerFunc) *mux.Route {
+	if r.resource != nil {
+		r.describe("", openapi.Route{Response: openapi.SliceOf(r.resource)}, http.MethodGet)
+	}
 	return r.path("", fn).Methods(http.MethodGet)
 }
 
 func (r *resourceRoute) show(fn actionHandlerFunc) *mux.Route {
+	if r.resource != nil {
+		r.describe("{id}", openapi.Route{Response: r.resource}, http.MethodGet)
+	}
 	return r.path("{id}", fn).Methods(http.MethodGet)
 }
 
 func (r *resourceRoute) create(fn actionHandlerFunc) *mux.Route {
+	if r.resource != nil {
+		r.describe("", openapi.Route{Request: r.resource, Response: r.resource}, http.MethodPost)
+	}
 	return r.path("", fn).Methods(http.MethodPost)
 }
 
 func (r *resourceRoute) update(fn actionHandlerFunc) *mux.Route {
+	if r.resource != nil {
+		r.describe("{id}", openapi.Route{Request: r.resource, Response: r.resource}, http.MethodPut, http.MethodPatch)
+	}
 	return r.path("{id}", fn).Methods(http.MethodPut, http.MethodPatch)
 }
 
 func (r *resourceRoute) destroy(fn actionHandlerFunc) *mux.Route {
+	if r.resource != nil {
+		r.describe("{id}", openapi.Route{}, http.MethodDelete)
+	}
 	return r.path("{id}", fn).Methods(http.MethodDelete)
 }
 
@@ -180,6 +217,11 @@ func (r *resourceRoute) path(p string, fn actionHandlerFunc) *mux.Route {
 	return handleAction(r.router, fullPath, fn)
 }
 
+// describe documents the payloads of a route in the OpenAPI document.
+func (r *resourceRoute) describe(p string, route openapi.Route, methods ...string) {
+	operations.Describe(path.Join(r.pathPrefix, p), route, methods...)
+}
+
 func handleAction(router *mux.Router, path string, fn actionHandlerFunc) *mux.Route {
 	return router.HandleFunc(path, actionHandler(fn))
 }
@@ -0,0 +1,32 @@
+package routers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteError(t *testing.T) {
+	rr := httptest.NewRecorder()
+	writeError(rr, errors.New("boom"))
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.NotContains(t, rr.Body.String(), "fields")
+
+	var fields types.ValidationErrors
+	fields.Add("url", types.ValidationRequired, "URL cannot be empty")
+	rr = httptest.NewRecorder()
+	writeError(rr, actions.NewError(actions.InvalidArgument, fields))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	body := errorBody{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+	assert.Equal(t, "URL cannot be empty", body.Message)
+	assert.Equal(t, fields, body.Fields)
+}
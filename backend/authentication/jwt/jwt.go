@@ -2,6 +2,8 @@ package jwt
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"net/http"
@@ -19,10 +21,10 @@ var (
 	secret            []byte
 )
 
-// AccessToken creates a new access token and returns it in both JWT and
-// signed format, along with any error
-func AccessToken(username string) (*jwt.Token, string, error) {
-	claims, err := NewClaims(username)
+// AccessToken creates a new access token for the user and its groups and
+// returns it in both JWT and signed format, along with any error
+func AccessToken(username string, groups []string) (*jwt.Token, string, error) {
+	claims, err := NewClaims(username, groups)
 	if err != nil {
 		return nil, "", err
 	}
@@ -38,8 +40,30 @@ func AccessToken(username string) (*jwt.Token, string, error) {
 	return token, tokenString, nil
 }
 
-// NewClaims creates new claim based on username
-func NewClaims(username string) (*types.Claims, error) {
+// ScopedAccessToken creates a new access token for the user and its groups,
+// restricted to the permissions granted by the scope rules and valid for the
+// given duration, and returns it in both JWT and signed format
+func ScopedAccessToken(username string, groups []string, scope []types.Rule, ttl time.Duration) (*jwt.Token, string, error) {
+	claims, err := NewClaims(username, groups)
+	if err != nil {
+		return nil, "", err
+	}
+	claims.ExpiresAt = time.Now().Add(ttl).Unix()
+	claims.Scope = scope
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	// Sign the token as a string using the secret
+	tokenString, err := token.SignedString(secret)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return token, tokenString, nil
+}
+
+// NewClaims creates new claim based on username and the groups of the user
+func NewClaims(username string, groups []string) (*types.Claims, error) {
 	// Create a unique identifier for the token
 	jti, err := utilbytes.Random(16)
 	if err != nil {
@@ -52,10 +76,25 @@ func NewClaims(username string) (*types.Claims, error) {
 			Id:        hex.EncodeToString(jti),
 			Subject:   username,
 		},
+		Groups: groups,
 	}
 	return &claims, nil
 }
 
+// CSRFToken returns the CSRF token bound to a refresh token, which must
+// accompany any request authenticated by a refresh token cookie
+func CSRFToken(refreshClaims *types.Claims) string {
+	mac := hmac.New(sha256.New, secret)
+	fmt.Fprintf(mac, "csrf:%s:%s", refreshClaims.Subject, refreshClaims.Id)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ValidateCSRFToken verifies that the provided CSRF token is bound to the
+// given refresh token
+func ValidateCSRFToken(refreshClaims *types.Claims, token string) bool {
+	return hmac.Equal([]byte(CSRFToken(refreshClaims)), []byte(token))
+}
+
 // GetClaims returns the claims from a token
 func GetClaims(token *jwt.Token) (*types.Claims, error) {
 	if claims, ok := token.Claims.(*types.Claims); ok {
@@ -133,8 +172,9 @@ func parseToken(tokenString string) (*jwt.Token, error) {
 	})
 }
 
-// RefreshToken returns a refresh token for a specific user
-func RefreshToken(username string) (*jwt.Token, string, error) {
+// RefreshToken returns a refresh token for a specific user, which carries its
+// groups so the access tokens it refreshes keep them
+func RefreshToken(username string, groups []string) (*jwt.Token, string, error) {
 	// Create a unique identifier for the token
 	jti, err := utilbytes.Random(16)
 	if err != nil {
@@ -146,6 +186,7 @@ func RefreshToken(username string) (*jwt.Token, string, error) {
 			Id:      hex.EncodeToString(jti),
 			Subject: username,
 		},
+		Groups: groups,
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &claims)
@@ -15,7 +15,7 @@ func TestAccessToken(t *testing.T) {
 	secret = []byte("foobar")
 	username := "foo"
 
-	_, tokenString, err := AccessToken(username)
+	_, tokenString, err := AccessToken(username, nil)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, tokenString)
 
@@ -29,9 +29,39 @@ func TestAccessToken(t *testing.T) {
 	assert.NotZero(t, claims.ExpiresAt)
 }
 
+func TestAccessTokenGroups(t *testing.T) {
+	secret = []byte("foobar")
+
+	_, tokenString, err := AccessToken("foo", []string{"ops", "sre"})
+	assert.NoError(t, err)
+
+	token, err := ValidateToken(tokenString)
+	assert.NoError(t, err)
+
+	claims, _ := token.Claims.(*types.Claims)
+	assert.Equal(t, []string{"ops", "sre"}, claims.Groups)
+}
+
+func TestScopedAccessToken(t *testing.T) {
+	secret = []byte("foobar")
+	scope := []types.Rule{types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead)}
+
+	_, tokenString, err := ScopedAccessToken("foo", []string{"ops"}, scope, 2*time.Hour)
+	assert.NoError(t, err)
+
+	token, err := ValidateToken(tokenString)
+	assert.NoError(t, err)
+
+	claims, _ := token.Claims.(*types.Claims)
+	assert.Equal(t, "foo", claims.Subject)
+	assert.Equal(t, []string{"ops"}, claims.Groups)
+	assert.Equal(t, scope, claims.Scope)
+	assert.InDelta(t, time.Now().Add(2*time.Hour).Unix(), claims.ExpiresAt, 5)
+}
+
 func TestClaimsContext(t *testing.T) {
 	username := "foo"
-	token, _, _ := AccessToken(username)
+	token, _, _ := AccessToken(username, nil)
 
 	r, _ := http.NewRequest("GET", "/foo", nil)
 
@@ -42,7 +72,7 @@ func TestClaimsContext(t *testing.T) {
 
 func TestGetClaims(t *testing.T) {
 	username := "foo"
-	token, _, _ := AccessToken(username)
+	token, _, _ := AccessToken(username, nil)
 
 	_, err := GetClaims(token)
 	assert.NoError(t, err)
@@ -57,7 +87,7 @@ func TestExtractBearerToken(t *testing.T) {
 
 	// Valid bearer token
 	r, _ = http.NewRequest("GET", "/foo", nil)
-	_, tokenString, _ := AccessToken("foo")
+	_, tokenString, _ := AccessToken("foo", nil)
 	r.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tokenString))
 	token = ExtractBearerToken(r)
 
@@ -99,7 +129,7 @@ func TestInitSecretEtcdError(t *testing.T) {
 func TestRefreshToken(t *testing.T) {
 	secret = []byte("foobar")
 	username := "foo"
-	_, tokenString, err := RefreshToken(username)
+	_, tokenString, err := RefreshToken(username, nil)
 	assert.NoError(t, err)
 
 	token, err := ValidateToken(tokenString)
@@ -115,7 +145,7 @@ func TestValidateTokenError(t *testing.T) {
 	// Create an expired token
 	defaultExpiration = time.Second * time.Duration(1)
 	username := "foo"
-	_, tokenString, _ := AccessToken(username)
+	_, tokenString, _ := AccessToken(username, nil)
 
 	// Wait for the token to expire
 	time.Sleep(time.Second * 2)
@@ -132,7 +162,7 @@ func TestValidateExpiredToken(t *testing.T) {
 	// Create an expired token
 	defaultExpiration = time.Second * time.Duration(1)
 	username := "foo"
-	_, tokenString, _ := AccessToken(username)
+	_, tokenString, _ := AccessToken(username, nil)
 
 	// Wait for the token to expire
 	time.Sleep(time.Second * 2)
@@ -146,7 +176,7 @@ func TestValidateExpiredToken(t *testing.T) {
 func TestValidateExpiredTokenActive(t *testing.T) {
 	username := "foo"
 
-	_, tokenString, err := AccessToken(username)
+	_, tokenString, err := AccessToken(username, nil)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, tokenString)
 
@@ -160,7 +190,7 @@ func TestValidateExpiredTokenInvalid(t *testing.T) {
 	secret = []byte("foobar")
 	defaultExpiration = time.Second * time.Duration(1)
 	username := "foo"
-	_, tokenString, _ := AccessToken(username)
+	_, tokenString, _ := AccessToken(username, nil)
 
 	// Wait for the token to expire
 	time.Sleep(time.Second * 2)
@@ -174,3 +204,19 @@ func TestValidateExpiredTokenInvalid(t *testing.T) {
 	// Set back the default value
 	defaultExpiration = time.Minute * time.Duration(15)
 }
+
+func TestCSRFToken(t *testing.T) {
+	secret = []byte("foobar")
+	token, _, _ := RefreshToken("foo", nil)
+	claims, _ := GetClaims(token)
+
+	csrfToken := CSRFToken(claims)
+	assert.NotEmpty(t, csrfToken)
+	assert.True(t, ValidateCSRFToken(claims, csrfToken))
+	assert.False(t, ValidateCSRFToken(claims, ""))
+
+	// The CSRF token is bound to a single refresh token
+	otherToken, _, _ := RefreshToken("foo", nil)
+	otherClaims, _ := GetClaims(otherToken)
+	assert.False(t, ValidateCSRFToken(otherClaims, csrfToken))
+}
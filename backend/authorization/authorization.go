@@ -2,6 +2,7 @@ package authorization
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/Sirupsen/logrus"
 	"github.com/sensu/sensu-go/types"
@@ -33,10 +34,70 @@ func matchesRuleOrganization(rule types.Rule, organization string) bool {
 }
 
 // CanAccessResource will verify whether or not a user has permission to perform
-// an action, for a resource, within an organization
+// an action, for a resource, within an organization. When an external
+// authorizer is registered, it must also allow the request.
 func CanAccessResource(actor Actor, org, env, resource, action string) bool {
+	return canAccess(actor, nil, Request{
+		Organization: org,
+		Environment:  env,
+		Resource:     resource,
+		Action:       action,
+	})
+}
+
+// canAccess verifies whether the actor has permission to perform the action of
+// the request, whose decisions by the external authorizer are cached in d
+// unless it's nil.
+func canAccess(actor Actor, d *decisions, req Request) bool {
+	org, env, resource, action := req.Organization, req.Environment, req.Resource, req.Action
+	fields := logrus.Fields{
+		"action":   action,
+		"actor":    actor,
+		"env":      env,
+		"org":      org,
+		"resource": resource,
+	}
+	if req.Name != "" {
+		fields["name"] = req.Name
+	}
+
+	if !rulesAllow(actor.Rules, org, env, resource, action) {
+		logrus.WithFields(fields).Info("request to resource not allowed")
+		return false
+	}
+
+	// The scope of the token of the actor must also grant the permission
+	if actor.Scoped() && !rulesAllow(actor.Scope, org, env, resource, action) {
+		logrus.WithFields(fields).Info("request to resource not allowed by token scope")
+		return false
+	}
+
+	authorizer := getAuthorizer()
+	if authorizer == nil {
+		return true
+	}
+
+	req.Actor = actor.Name
+	req.Groups = actor.Groups
+	req.Time = time.Now()
+	allowed, err := d.authorize(authorizer, req)
+	if err != nil {
+		// Fail closed, the policies can't be enforced
+		logrus.WithFields(fields).WithError(err).Error("external authorization failed")
+		return false
+	}
+	if !allowed {
+		logrus.WithFields(fields).Info("request to resource denied by external authorizer")
+	}
+
+	return allowed
+}
+
+// rulesAllow returns true if one of the rules grants the permission to
+// perform an action, for a resource, within an organization
+func rulesAllow(rules []types.Rule, org, env, resource, action string) bool {
 	// TODO: Reject irrelevant rules?
-	for _, rule := range actor.Rules {
+	for _, rule := range rules {
 		if !matchesRuleType(rule, resource) {
 			continue
 		}
@@ -51,14 +112,6 @@ func CanAccessResource(actor Actor, org, env, resource, action string) bool {
 		}
 	}
 
-	logrus.WithFields(logrus.Fields{
-		"action":   action,
-		"actor":    actor,
-		"env":      env,
-		"org":      org,
-		"resource": resource,
-	}).Info("request to resource not allowed")
-
 	return false
 }
 
@@ -1,11 +1,14 @@
 package authorization
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestHasPermission(t *testing.T) {
@@ -126,3 +129,154 @@ func TestCanAccessResource(t *testing.T) {
 		})
 	}
 }
+
+func TestCanAccessResourceScope(t *testing.T) {
+	actor := Actor{
+		Name:  "bob",
+		Rules: []types.Rule{*types.FixtureRule("sensu", "*")},
+		Scope: []types.Rule{types.FixtureRuleWithPerms(types.RuleTypeEvent, types.RulePermRead)},
+	}
+
+	assert.True(t, CanAccessResource(actor, "sensu", "dev", types.RuleTypeEvent, types.RulePermRead))
+	assert.False(t, CanAccessResource(actor, "sensu", "dev", types.RuleTypeEvent, types.RulePermDelete))
+	assert.False(t, CanAccessResource(actor, "sensu", "dev", types.RuleTypeCheck, types.RulePermRead))
+
+	// The scope doesn't grant permissions the rules of the actor don't
+	assert.False(t, CanAccessResource(actor, "acme", "dev", types.RuleTypeEvent, types.RulePermRead))
+}
+
+func TestActorGrants(t *testing.T) {
+	actor := Actor{
+		Name: "bob",
+		Rules: []types.Rule{
+			{Type: "*", Organization: "sensu", Environment: "*", Permissions: []string{types.RulePermRead}},
+			{Type: types.RuleTypeCheck, Organization: "sensu", Environment: "dev", Permissions: []string{types.RulePermUpdate}},
+		},
+	}
+
+	testCases := []struct {
+		name string
+		rule types.Rule
+		want bool
+	}{
+		{"read events", types.Rule{Type: types.RuleTypeEvent, Organization: "sensu", Environment: "prod", Permissions: []string{types.RulePermRead}}, true},
+		{"read all", types.Rule{Type: "*", Organization: "sensu", Environment: "*", Permissions: []string{types.RulePermRead}}, true},
+		{"read other org", types.Rule{Type: types.RuleTypeEvent, Organization: "*", Environment: "*", Permissions: []string{types.RulePermRead}}, false},
+		{"update checks", types.Rule{Type: types.RuleTypeCheck, Organization: "sensu", Environment: "dev", Permissions: []string{types.RulePermRead, types.RulePermUpdate}}, true},
+		{"update checks of all envs", types.Rule{Type: types.RuleTypeCheck, Organization: "sensu", Environment: "*", Permissions: []string{types.RulePermUpdate}}, false},
+		{"delete events", types.Rule{Type: types.RuleTypeEvent, Organization: "sensu", Environment: "dev", Permissions: []string{types.RulePermDelete}}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, actor.Grants(tc.rule))
+		})
+	}
+}
+
+func TestActorGrantsScoped(t *testing.T) {
+	actor := Actor{
+		Name:  "bob",
+		Rules: []types.Rule{*types.FixtureRule("*", "*")},
+		Scope: []types.Rule{
+			{Type: types.RuleTypeEvent, Organization: "sensu", Environment: "dev", Permissions: []string{types.RulePermRead}},
+		},
+	}
+
+	assert.True(t, actor.Grants(types.Rule{Type: types.RuleTypeEvent, Organization: "sensu", Environment: "dev", Permissions: []string{types.RulePermRead}}))
+	assert.False(t, actor.Grants(types.Rule{Type: types.RuleTypeEvent, Organization: "sensu", Environment: "dev", Permissions: []string{types.RulePermDelete}}))
+}
+
+type fakeAuthorizer struct {
+	allowed bool
+	err     error
+	req     *Request
+	calls   int
+}
+
+func (a *fakeAuthorizer) Authorize(req Request) (bool, error) {
+	a.req = &req
+	a.calls++
+	return a.allowed, a.err
+}
+
+func TestCanAccessResourceAuthorizer(t *testing.T) {
+	actor := Actor{
+		Name: "bob",
+		Rules: []types.Rule{
+			{
+				Type:         "entities",
+				Organization: "sensu",
+				Environment:  "dev",
+				Permissions:  []string{types.RulePermRead},
+			},
+		},
+	}
+
+	authorizer := &fakeAuthorizer{}
+	SetAuthorizer(authorizer)
+	defer SetAuthorizer(nil)
+
+	// The external authorizer can't grant permissions not given by the rules
+	authorizer.allowed = true
+	assert.False(t, CanAccessResource(actor, "sensu", "dev", "entities", types.RulePermDelete))
+	assert.Nil(t, authorizer.req)
+
+	assert.True(t, CanAccessResource(actor, "sensu", "dev", "entities", types.RulePermRead))
+	require.NotNil(t, authorizer.req)
+	assert.Equal(t, "bob", authorizer.req.Actor)
+	assert.Equal(t, "entities", authorizer.req.Resource)
+	assert.Equal(t, types.RulePermRead, authorizer.req.Action)
+
+	// The external authorizer can restrict the permissions
+	authorizer.allowed = false
+	assert.False(t, CanAccessResource(actor, "sensu", "dev", "entities", types.RulePermRead))
+
+	// The request is denied when no decision can be made
+	authorizer.allowed = true
+	authorizer.err = errors.New("error")
+	assert.False(t, CanAccessResource(actor, "sensu", "dev", "entities", types.RulePermRead))
+}
+
+func TestCanAccessResourceDecisionsCache(t *testing.T) {
+	actor := Actor{
+		Name:   "bob",
+		Groups: []string{"ops"},
+		Rules:  []types.Rule{types.FixtureRuleWithPerms(types.RuleTypeEntity, types.RulePermRead)},
+	}
+	ctx := context.WithValue(context.Background(), types.AuthorizationActorKey, actor)
+	policy := Entities.WithContext(WithDecisionsCache(ctx))
+
+	authorizer := &fakeAuthorizer{allowed: true}
+	SetAuthorizer(authorizer)
+	defer SetAuthorizer(nil)
+
+	// The name and the labels of the resource, and the groups of the actor,
+	// are given to the authorizer
+	entity := types.FixtureEntity("entity1")
+	entity.Labels = map[string]string{"region": "us-west-1"}
+	assert.True(t, policy.CanRead(entity))
+	require.NotNil(t, authorizer.req)
+	assert.Equal(t, "entity1", authorizer.req.Name)
+	assert.Equal(t, entity.Labels, authorizer.req.Labels)
+	assert.Equal(t, []string{"ops"}, authorizer.req.Groups)
+
+	// The decisions are cached for the identical requests
+	assert.True(t, policy.CanRead(entity))
+	assert.Equal(t, 1, authorizer.calls)
+	assert.True(t, policy.CanRead(types.FixtureEntity("entity2")))
+	assert.Equal(t, 2, authorizer.calls)
+
+	// Including the failed ones, which are denied
+	authorizer.err = errors.New("error")
+	entity3 := types.FixtureEntity("entity3")
+	assert.False(t, policy.CanRead(entity3))
+	assert.False(t, policy.CanRead(entity3))
+	assert.Equal(t, 3, authorizer.calls)
+
+	// The decisions aren't cached without a cache in the context
+	authorizer.err = nil
+	policy = Entities.WithContext(ctx)
+	assert.True(t, policy.CanRead(entity))
+	assert.True(t, policy.CanRead(entity))
+	assert.Equal(t, 5, authorizer.calls)
+}
@@ -0,0 +1,113 @@
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Request describes an action an actor attempts to perform on a resource. It
+// is the input of the external authorization decisions.
+type Request struct {
+	// Actor is the name of the user performing the action
+	Actor string `json:"actor"`
+
+	// Groups are the groups the actor is a member of
+	Groups []string `json:"groups"`
+
+	// Organization and Environment are the namespace of the resource
+	Organization string `json:"organization"`
+	Environment  string `json:"environment"`
+
+	// Resource is the type of the resource, e.g. checks
+	Resource string `json:"resource"`
+
+	// Name and Labels are the name and the labels of the resource, when the
+	// action is performed on a single resource
+	Name   string            `json:"name,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Action is the permission required, e.g. read
+	Action string `json:"action"`
+
+	// Time is the time at which the request was made
+	Time time.Time `json:"time"`
+}
+
+// Authorizer makes authorization decisions beyond the built-in rule model, so
+// that policies such as time-of-day restrictions can be expressed. It's only
+// consulted for requests the rules of the actor already allow, hence it can
+// only restrict access further.
+type Authorizer interface {
+	Authorize(req Request) (bool, error)
+}
+
+var (
+	authorizerMu sync.RWMutex
+	authorizer   Authorizer
+)
+
+// SetAuthorizer registers the external authorizer consulted by
+// CanAccessResource. A nil authorizer restores the built-in rule model only.
+func SetAuthorizer(a Authorizer) {
+	authorizerMu.Lock()
+	defer authorizerMu.Unlock()
+	authorizer = a
+}
+
+func getAuthorizer() Authorizer {
+	authorizerMu.RLock()
+	defer authorizerMu.RUnlock()
+	return authorizer
+}
+
+// decisionsKey is the context key of the cache of the authorization decisions.
+type decisionsKey struct{}
+
+// decisions caches the decisions of the external authorizer, by request
+// without its time.
+type decisions struct {
+	mu      sync.Mutex
+	allowed map[string]bool
+}
+
+// WithDecisionsCache returns a context whose policies cache the decisions of
+// the external authorizer, which is only queried once for the identical
+// requests made with it, e.g. while filtering the resources listed by an API
+// request. The context must not outlive the request, so that the changes of
+// the external policies take effect.
+func WithDecisionsCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, decisionsKey{}, &decisions{allowed: map[string]bool{}})
+}
+
+func decisionsFromContext(ctx context.Context) *decisions {
+	d, _ := ctx.Value(decisionsKey{}).(*decisions)
+	return d
+}
+
+// authorize returns the decision of the authorizer for the request, cached
+// if the cache isn't nil. The errors are cached as denials, so that an
+// unavailable authorizer doesn't delay every decision.
+func (d *decisions) authorize(a Authorizer, req Request) (bool, error) {
+	if d == nil {
+		return a.Authorize(req)
+	}
+
+	keyReq := req
+	keyReq.Time = time.Time{}
+	b, err := json.Marshal(keyReq)
+	if err != nil {
+		return false, err
+	}
+	key := string(b)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if allowed, ok := d.allowed[key]; ok {
+		return allowed, nil
+	}
+	allowed, err := a.Authorize(req)
+	d.allowed[key] = allowed && err == nil
+	return allowed, err
+}
@@ -0,0 +1,56 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// CheckTemplates is global instance of CheckTemplatePolicy
+var CheckTemplates = CheckTemplatePolicy{}
+
+// CheckTemplatePolicy ...
+type CheckTemplatePolicy struct {
+	context Context
+}
+
+// Resource this policy is associated with
+func (p *CheckTemplatePolicy) Resource() string {
+	return types.RuleTypeCheckTemplate
+}
+
+// Context info this instance of the policy is associated with
+func (p *CheckTemplatePolicy) Context() Context {
+	return p.context
+}
+
+// WithContext returns new policy populated with rules & organization.
+func (p CheckTemplatePolicy) WithContext(ctx context.Context) CheckTemplatePolicy { // nolint
+	p.context = ExtractValueFromContext(ctx)
+	return p
+}
+
+// CanList returns true if actor has read access to resource.
+func (p *CheckTemplatePolicy) CanList() bool {
+	return canPerform(p, types.RulePermRead)
+}
+
+// CanRead returns true if actor has read access to resource.
+func (p *CheckTemplatePolicy) CanRead(tmpl *types.CheckTemplate) bool {
+	return canPerformOn(p, tmpl.Organization, tmpl.Environment, types.RulePermRead)
+}
+
+// CanCreate returns true if actor has access to create.
+func (p *CheckTemplatePolicy) CanCreate(tmpl *types.CheckTemplate) bool {
+	return canPerformOn(p, tmpl.Organization, tmpl.Environment, types.RulePermCreate)
+}
+
+// CanUpdate returns true if actor has access to update.
+func (p *CheckTemplatePolicy) CanUpdate(tmpl *types.CheckTemplate) bool {
+	return canPerformOn(p, tmpl.Organization, tmpl.Environment, types.RulePermUpdate)
+}
+
+// CanDelete returns true if actor has access to delete.
+func (p *CheckTemplatePolicy) CanDelete() bool {
+	return canPerform(p, types.RulePermDelete)
+}
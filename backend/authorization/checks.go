@@ -37,17 +37,17 @@ func (p *CheckPolicy) CanList() bool {
 
 // CanRead returns true if actor has read access to resource.
 func (p *CheckPolicy) CanRead(check *types.CheckConfig) bool {
-	return canPerformOn(p, check.Organization, check.Environment, types.RulePermRead)
+	return canPerformOnResource(p, check.Organization, check.Environment, check.Name, nil, types.RulePermRead)
 }
 
 // CanCreate returns true if actor has access to create.
 func (p *CheckPolicy) CanCreate(check *types.CheckConfig) bool {
-	return canPerformOn(p, check.Organization, check.Environment, types.RulePermCreate)
+	return canPerformOnResource(p, check.Organization, check.Environment, check.Name, nil, types.RulePermCreate)
 }
 
 // CanUpdate returns true if actor has access to update.
 func (p *CheckPolicy) CanUpdate(check *types.CheckConfig) bool {
-	return canPerformOn(p, check.Organization, check.Environment, types.RulePermUpdate)
+	return canPerformOnResource(p, check.Organization, check.Environment, check.Name, nil, types.RulePermUpdate)
 }
 
 // CanDelete returns true if actor has access to delete.
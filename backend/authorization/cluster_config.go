@@ -0,0 +1,56 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// ClusterConfig is global instance of ClusterConfigPolicy
+var ClusterConfig = ClusterConfigPolicy{}
+
+// ClusterConfigPolicy ...
+type ClusterConfigPolicy struct {
+	context Context
+}
+
+// Resource this policy is associated with
+func (p *ClusterConfigPolicy) Resource() string {
+	return types.RuleTypeClusterConfig
+}
+
+// Context info this instance of the policy is associated with
+func (p *ClusterConfigPolicy) Context() Context {
+	return p.context
+}
+
+// WithContext returns new policy populated with rules & organization. The
+// cluster-wide defaults are shared by all organizations and environments, so
+// the policy applies to all of them.
+func (p ClusterConfigPolicy) WithContext(ctx context.Context) ClusterConfigPolicy { // nolint
+	p.context = ExtractValueFromContext(ctx)
+	p.context.Organization = "*"
+	p.context.Environment = "*"
+
+	return p
+}
+
+// WithOrganization returns new policy populated with rules, which applies to
+// the defaults of the given organization in all of its environments.
+func (p ClusterConfigPolicy) WithOrganization(ctx context.Context, org string) ClusterConfigPolicy { // nolint
+	p.context = ExtractValueFromContext(ctx)
+	p.context.Organization = org
+	p.context.Environment = "*"
+
+	return p
+}
+
+// CanRead returns true if actor has read access to resource.
+func (p *ClusterConfigPolicy) CanRead() bool {
+	return canPerform(p, types.RulePermRead)
+}
+
+// CanUpdate returns true if actor has access to update.
+func (p *ClusterConfigPolicy) CanUpdate() bool {
+	return canPerform(p, types.RulePermUpdate)
+}
@@ -37,17 +37,17 @@ func (p *EntityPolicy) CanList() bool {
 
 // CanRead returns true if actor has read access to resource.
 func (p *EntityPolicy) CanRead(entity *types.Entity) bool {
-	return canPerformOn(p, entity.Organization, entity.Environment, types.RulePermRead)
+	return canPerformOnResource(p, entity.Organization, entity.Environment, entity.ID, entity.Labels, types.RulePermRead)
 }
 
 // CanCreate returns true if actor has access to create.
 func (p *EntityPolicy) CanCreate(entity *types.Entity) bool {
-	return canPerformOn(p, entity.Organization, entity.Environment, types.RulePermCreate)
+	return canPerformOnResource(p, entity.Organization, entity.Environment, entity.ID, entity.Labels, types.RulePermCreate)
 }
 
 // CanUpdate returns true if actor has access to update.
 func (p *EntityPolicy) CanUpdate(entity *types.Entity) bool {
-	return canPerformOn(p, entity.Organization, entity.Environment, types.RulePermUpdate)
+	return canPerformOnResource(p, entity.Organization, entity.Environment, entity.ID, entity.Labels, types.RulePermUpdate)
 }
 
 // CanDelete returns true if actor has access to delete.
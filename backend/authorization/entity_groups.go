@@ -0,0 +1,56 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// EntityGroups is global instance of EntityGroupPolicy
+var EntityGroups = EntityGroupPolicy{}
+
+// EntityGroupPolicy ...
+type EntityGroupPolicy struct {
+	context Context
+}
+
+// Resource this policy is associated with
+func (p *EntityGroupPolicy) Resource() string {
+	return types.RuleTypeEntityGroup
+}
+
+// Context info this instance of the policy is associated with
+func (p *EntityGroupPolicy) Context() Context {
+	return p.context
+}
+
+// WithContext returns new policy populated with rules & organization.
+func (p EntityGroupPolicy) WithContext(ctx context.Context) EntityGroupPolicy { // nolint
+	p.context = ExtractValueFromContext(ctx)
+	return p
+}
+
+// CanList returns true if actor has read access to resource.
+func (p *EntityGroupPolicy) CanList() bool {
+	return canPerform(p, types.RulePermRead)
+}
+
+// CanRead returns true if actor has read access to resource.
+func (p *EntityGroupPolicy) CanRead(group *types.EntityGroup) bool {
+	return canPerformOn(p, group.Organization, group.Environment, types.RulePermRead)
+}
+
+// CanCreate returns true if actor has access to create.
+func (p *EntityGroupPolicy) CanCreate(group *types.EntityGroup) bool {
+	return canPerformOn(p, group.Organization, group.Environment, types.RulePermCreate)
+}
+
+// CanUpdate returns true if actor has access to update.
+func (p *EntityGroupPolicy) CanUpdate(group *types.EntityGroup) bool {
+	return canPerformOn(p, group.Organization, group.Environment, types.RulePermUpdate)
+}
+
+// CanDelete returns true if actor has access to delete.
+func (p *EntityGroupPolicy) CanDelete() bool {
+	return canPerform(p, types.RulePermDelete)
+}
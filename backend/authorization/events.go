@@ -37,17 +37,33 @@ func (p *EventPolicy) CanList() bool {
 
 // CanRead returns true if actor has read access to resource.
 func (p *EventPolicy) CanRead(event *types.Event) bool {
-	return canPerformOn(p, event.Entity.Organization, event.Entity.Environment, types.RulePermRead)
+	return canPerformOnEvent(p, event, types.RulePermRead)
+}
+
+// CanReadIn returns true if actor has read access to the events of the given
+// organization and environment.
+func (p *EventPolicy) CanReadIn(organization, environment string) bool {
+	return canPerformOn(p, organization, environment, types.RulePermRead)
 }
 
 // CanCreate returns true if actor has access to create.
 func (p *EventPolicy) CanCreate(event *types.Event) bool {
-	return canPerformOn(p, event.Entity.Organization, event.Entity.Environment, types.RulePermCreate)
+	return canPerformOnEvent(p, event, types.RulePermCreate)
 }
 
 // CanUpdate returns true if actor has access to update.
 func (p *EventPolicy) CanUpdate(event *types.Event) bool {
-	return canPerformOn(p, event.Entity.Organization, event.Entity.Environment, types.RulePermUpdate)
+	return canPerformOnEvent(p, event, types.RulePermUpdate)
+}
+
+// canPerformOnEvent returns true if the actor can perform the action on the
+// event, named after its check, with the labels of its entity.
+func canPerformOnEvent(p *EventPolicy, event *types.Event, action string) bool {
+	var name string
+	if event.HasCheck() {
+		name = event.Check.Name
+	}
+	return canPerformOnResource(p, event.Entity.Organization, event.Entity.Environment, name, event.Entity.Labels, action)
 }
 
 // CanDelete returns true if actor has access to delete.
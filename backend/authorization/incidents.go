@@ -0,0 +1,56 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// Incidents is global instance of IncidentPolicy
+var Incidents = IncidentPolicy{}
+
+// IncidentPolicy ...
+type IncidentPolicy struct {
+	context Context
+}
+
+// Resource this policy is associated with
+func (p *IncidentPolicy) Resource() string {
+	return types.RuleTypeIncident
+}
+
+// Context info this instance of the policy is associated with
+func (p *IncidentPolicy) Context() Context {
+	return p.context
+}
+
+// WithContext returns new policy populated with rules & organization.
+func (p IncidentPolicy) WithContext(ctx context.Context) IncidentPolicy { // nolint
+	p.context = ExtractValueFromContext(ctx)
+	return p
+}
+
+// CanList returns true if actor has read access to resource.
+func (p *IncidentPolicy) CanList() bool {
+	return canPerform(p, types.RulePermRead)
+}
+
+// CanRead returns true if actor has read access to resource.
+func (p *IncidentPolicy) CanRead(incident *types.Incident) bool {
+	return canPerformOn(p, incident.Organization, incident.Environment, types.RulePermRead)
+}
+
+// CanCreate returns true if actor has access to create.
+func (p *IncidentPolicy) CanCreate(incident *types.Incident) bool {
+	return canPerformOn(p, incident.Organization, incident.Environment, types.RulePermCreate)
+}
+
+// CanUpdate returns true if actor has access to update.
+func (p *IncidentPolicy) CanUpdate(incident *types.Incident) bool {
+	return canPerformOn(p, incident.Organization, incident.Environment, types.RulePermUpdate)
+}
+
+// CanDelete returns true if actor has access to delete.
+func (p *IncidentPolicy) CanDelete() bool {
+	return canPerform(p, types.RulePermDelete)
+}
@@ -0,0 +1,83 @@
+// Package opa provides an authorizer delegating the authorization decisions
+// to an Open Policy Agent server.
+package opa
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+)
+
+// DefaultTimeout is the default timeout of the authorization decisions
+const DefaultTimeout = 5 * time.Second
+
+// Authorizer queries a decision document of the OPA data API, e.g.
+// http://localhost:8181/v1/data/sensu/authz/allow, with the authorization
+// request as input. The request is allowed when the document is true, and
+// denied when it's false or undefined.
+type Authorizer struct {
+	// URL is the URL of the decision document
+	URL string
+
+	client *http.Client
+}
+
+// New returns an authorizer querying the decision document at the given URL.
+func New(url string, timeout time.Duration) *Authorizer {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Authorizer{
+		URL:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+type dataRequest struct {
+	Input authorization.Request `json:"input"`
+}
+
+type dataResponse struct {
+	Result *bool `json:"result"`
+}
+
+// Authorize implements authorization.Authorizer
+func (a *Authorizer) Authorize(r authorization.Request) (bool, error) {
+	body, err := json.Marshal(dataRequest{Input: r})
+	if err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, a.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+
+	var decision dataResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decision); err != nil {
+		return false, fmt.Errorf("invalid decision: %s", err)
+	}
+
+	// An undefined decision document has no result
+	if decision.Result == nil {
+		return false, nil
+	}
+
+	return *decision.Result, nil
+}
@@ -0,0 +1,64 @@
+package opa
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorize(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   int
+		body     string
+		expected bool
+		wantErr  bool
+	}{
+		{"allowed", http.StatusOK, `{"result": true}`, true, false},
+		{"denied", http.StatusOK, `{"result": false}`, false, false},
+		{"undefined", http.StatusOK, `{}`, false, false},
+		{"invalid document", http.StatusOK, `{"result": "yes"}`, false, true},
+		{"server error", http.StatusInternalServerError, `{}`, false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var input authorization.Request
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					Input authorization.Request `json:"input"`
+				}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				input = body.Input
+
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			req := authorization.Request{
+				Actor:        "bob",
+				Organization: "default",
+				Environment:  "default",
+				Resource:     "checks",
+				Action:       "read",
+				Time:         time.Now().UTC().Round(time.Second),
+			}
+
+			allowed, err := New(server.URL, 0).Authorize(req)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, allowed)
+			assert.Equal(t, req, input)
+		})
+	}
+}
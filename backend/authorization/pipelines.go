@@ -0,0 +1,56 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// Pipelines is global instance of PipelinePolicy
+var Pipelines = PipelinePolicy{}
+
+// PipelinePolicy ...
+type PipelinePolicy struct {
+	context Context
+}
+
+// Resource this policy is associated with
+func (p *PipelinePolicy) Resource() string {
+	return types.RuleTypePipeline
+}
+
+// Context info this instance of the policy is associated with
+func (p *PipelinePolicy) Context() Context {
+	return p.context
+}
+
+// WithContext returns new policy populated with rules & organization.
+func (p PipelinePolicy) WithContext(ctx context.Context) PipelinePolicy { // nolint
+	p.context = ExtractValueFromContext(ctx)
+	return p
+}
+
+// CanList returns true if actor has read access to resource.
+func (p *PipelinePolicy) CanList() bool {
+	return canPerform(p, types.RulePermRead)
+}
+
+// CanRead returns true if actor has read access to resource.
+func (p *PipelinePolicy) CanRead(pipeline *types.Pipeline) bool {
+	return canPerformOn(p, pipeline.Organization, pipeline.Environment, types.RulePermRead)
+}
+
+// CanCreate returns true if actor has access to create.
+func (p *PipelinePolicy) CanCreate(pipeline *types.Pipeline) bool {
+	return canPerformOn(p, pipeline.Organization, pipeline.Environment, types.RulePermCreate)
+}
+
+// CanUpdate returns true if actor has access to update.
+func (p *PipelinePolicy) CanUpdate(pipeline *types.Pipeline) bool {
+	return canPerformOn(p, pipeline.Organization, pipeline.Environment, types.RulePermUpdate)
+}
+
+// CanDelete returns true if actor has access to delete.
+func (p *PipelinePolicy) CanDelete() bool {
+	return canPerform(p, types.RulePermDelete)
+}
@@ -11,6 +11,54 @@ import (
 type Actor struct {
 	Name  string
 	Rules []types.Rule
+
+	// Groups are the groups the actor is a member of
+	Groups []string
+
+	// Scope restricts the rules of the actor to the permissions it grants,
+	// when the actor authenticated with a scoped token
+	Scope []types.Rule
+}
+
+// Scoped returns true if the actor authenticated with a scoped token.
+func (a Actor) Scoped() bool {
+	return len(a.Scope) > 0
+}
+
+// Grants returns true if every permission of the rule is granted to the actor
+// by one of its rules, for all of the resources, organizations and
+// environments the rule matches. The scope of the token of the actor, if any,
+// must also grant the rule.
+func (a Actor) Grants(rule types.Rule) bool {
+	if !rulesGrant(a.Rules, rule) {
+		return false
+	}
+	return !a.Scoped() || rulesGrant(a.Scope, rule)
+}
+
+// rulesGrant returns true if every permission of the rule is granted by one of
+// the rules.
+func rulesGrant(rules []types.Rule, rule types.Rule) bool {
+	for _, permission := range rule.Permissions {
+		granted := false
+		for _, r := range rules {
+			if covers(r.Type, rule.Type) && covers(r.Organization, rule.Organization) &&
+				covers(r.Environment, rule.Environment) && hasPermission(r, permission) {
+				granted = true
+				break
+			}
+		}
+		if !granted {
+			return false
+		}
+	}
+	return true
+}
+
+// covers returns true if the value of a rule field matches at least all of
+// the values matched by other.
+func covers(value, other string) bool {
+	return value == "*" || value == other
 }
 
 // Context holds the organization the action is associated with and the user
@@ -19,6 +67,9 @@ type Context struct {
 	Actor        Actor
 	Environment  string
 	Organization string
+
+	// decisions caches the decisions of the external authorizer, if any
+	decisions *decisions
 }
 
 // ExtractValueFromContext extracts authorization details from a context
@@ -37,6 +88,8 @@ func ExtractValueFromContext(ctx context.Context) Context {
 		context.Actor = actor
 	}
 
+	context.decisions = decisionsFromContext(ctx)
+
 	return context
 }
 
@@ -56,11 +109,18 @@ func canPerform(policy Policy, action string) bool {
 }
 
 func canPerformOn(policy Policy, organization, environment, action string) bool {
-	return CanAccessResource(
-		policy.Context().Actor,
-		organization,
-		environment,
-		policy.Resource(),
-		action,
-	)
+	return canPerformOnResource(policy, organization, environment, "", nil, action)
+}
+
+// canPerformOnResource returns true if the actor can perform the action on the
+// resource of the given name and labels.
+func canPerformOnResource(policy Policy, organization, environment, name string, labels map[string]string, action string) bool {
+	return canAccess(policy.Context().Actor, policy.Context().decisions, Request{
+		Organization: organization,
+		Environment:  environment,
+		Resource:     policy.Resource(),
+		Name:         name,
+		Labels:       labels,
+		Action:       action,
+	})
 }
@@ -0,0 +1,56 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// RoleBindings is global instance of RoleBindingPolicy
+var RoleBindings = RoleBindingPolicy{}
+
+// RoleBindingPolicy ...
+type RoleBindingPolicy struct {
+	context Context
+}
+
+// Resource this policy is associated with
+func (p *RoleBindingPolicy) Resource() string {
+	return types.RuleTypeRoleBinding
+}
+
+// Context info this instance of the policy is associated with
+func (p *RoleBindingPolicy) Context() Context {
+	return p.context
+}
+
+// WithContext returns new policy populated with rules & organization.
+func (p RoleBindingPolicy) WithContext(ctx context.Context) RoleBindingPolicy { // nolint
+	p.context = ExtractValueFromContext(ctx)
+	return p
+}
+
+// CanList returns true if actor has read access to resource.
+func (p *RoleBindingPolicy) CanList() bool {
+	return canPerform(p, types.RulePermRead)
+}
+
+// CanRead returns true if actor has read access to resource.
+func (p *RoleBindingPolicy) CanRead(binding *types.RoleBinding) bool {
+	return canPerformOn(p, binding.Organization, binding.Environment, types.RulePermRead)
+}
+
+// CanCreate returns true if actor has access to create.
+func (p *RoleBindingPolicy) CanCreate(binding *types.RoleBinding) bool {
+	return canPerformOn(p, binding.Organization, binding.Environment, types.RulePermCreate)
+}
+
+// CanUpdate returns true if actor has access to update.
+func (p *RoleBindingPolicy) CanUpdate(binding *types.RoleBinding) bool {
+	return canPerformOn(p, binding.Organization, binding.Environment, types.RulePermUpdate)
+}
+
+// CanDelete returns true if actor has access to delete.
+func (p *RoleBindingPolicy) CanDelete() bool {
+	return canPerform(p, types.RulePermDelete)
+}
@@ -0,0 +1,46 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// Tessen is global instance of TessenPolicy
+var Tessen = TessenPolicy{}
+
+// TessenPolicy ...
+type TessenPolicy struct {
+	context Context
+}
+
+// Resource this policy is associated with
+func (p *TessenPolicy) Resource() string {
+	return types.RuleTypeTessen
+}
+
+// Context info this instance of the policy is associated with
+func (p *TessenPolicy) Context() Context {
+	return p.context
+}
+
+// WithContext returns new policy populated with rules & organization. The
+// Tessen configuration is shared by the whole cluster, so the policy applies
+// to all organizations and environments.
+func (p TessenPolicy) WithContext(ctx context.Context) TessenPolicy { // nolint
+	p.context = ExtractValueFromContext(ctx)
+	p.context.Organization = "*"
+	p.context.Environment = "*"
+
+	return p
+}
+
+// CanRead returns true if actor has read access to resource.
+func (p *TessenPolicy) CanRead() bool {
+	return canPerform(p, types.RulePermRead)
+}
+
+// CanUpdate returns true if actor has access to update.
+func (p *TessenPolicy) CanUpdate() bool {
+	return canPerform(p, types.RulePermUpdate)
+}
@@ -40,7 +40,7 @@ func (p *UserPolicy) CanList() bool {
 // CanRead returns true if actor has read access to resource.
 func (p *UserPolicy) CanRead(user *types.User) bool {
 	// Allow users to see their account
-	if p.context.Actor.Name == user.Username {
+	if p.isActor(user.Username) {
 		return true
 	}
 
@@ -57,10 +57,36 @@ func (p *UserPolicy) CanUpdate(_ *types.User) bool {
 	return canPerform(p, types.RulePermUpdate)
 }
 
+// CanImpersonate returns true if actor can make requests as the user, who is
+// granted the given rules. Unless the actor can impersonate the users of all
+// the organizations and environments, it must already hold every rule of the
+// user, so that impersonating them doesn't escalate its privileges.
+func (p *UserPolicy) CanImpersonate(user *types.User, rules []types.Rule) bool {
+	if user.Disabled || !canPerform(p, types.RulePermImpersonate) {
+		return false
+	}
+
+	actor := p.context.Actor
+	if actor.Grants(types.Rule{
+		Type:         types.RuleTypeUser,
+		Organization: "*",
+		Environment:  "*",
+		Permissions:  []string{types.RulePermImpersonate},
+	}) {
+		return true
+	}
+	for _, rule := range rules {
+		if !actor.Grants(rule) {
+			return false
+		}
+	}
+	return true
+}
+
 // CanChangePassword returns true if actor has access to update.
 func (p *UserPolicy) CanChangePassword(user *types.User) bool {
 	// Allow users to change their password
-	if p.context.Actor.Name == user.Username {
+	if p.isActor(user.Username) {
 		return true
 	}
 
@@ -70,9 +96,43 @@ func (p *UserPolicy) CanChangePassword(user *types.User) bool {
 // CanDelete returns true if actor has access to delete.
 func (p *UserPolicy) CanDelete(user *types.User) bool {
 	// Allow users to delete their own account
-	if p.context.Actor.Name == user.Username {
+	if p.isActor(user.Username) {
 		return true
 	}
 
 	return canPerform(p, types.RulePermDelete)
 }
+
+// CanReadPreferences returns true if actor has access to read the preferences
+// of the user.
+func (p *UserPolicy) CanReadPreferences(preferences *types.UserPreferences) bool {
+	// Allow users to see their preferences
+	if p.isActor(preferences.Username) {
+		return true
+	}
+
+	return canPerform(p, types.RulePermRead)
+}
+
+// CanUpdatePreferences returns true if actor has access to update the
+// preferences of the user.
+func (p *UserPolicy) CanUpdatePreferences(preferences *types.UserPreferences) bool {
+	// Allow users to change their preferences
+	if p.isActor(preferences.Username) {
+		return true
+	}
+
+	return canPerform(p, types.RulePermUpdate)
+}
+
+// CanCreateToken returns true if actor can mint a scoped token for the user.
+// Users can only mint tokens for themselves, and not with a scoped token.
+func (p *UserPolicy) CanCreateToken(user *types.User) bool {
+	return p.isActor(user.Username)
+}
+
+// isActor returns true if the actor is the user, with all of its permissions.
+// The scoped tokens of users don't grant them access to their own account.
+func (p *UserPolicy) isActor(username string) bool {
+	return p.context.Actor.Name == username && !p.context.Actor.Scoped()
+}
@@ -1,23 +1,52 @@
 package backend
 
 import (
+	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
+	"net"
+	"os"
+	"path/filepath"
 	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
 
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/agent"
+	"github.com/sensu/sensu-go/agent/assetmanager"
 	"github.com/sensu/sensu-go/backend/agentd"
 	"github.com/sensu/sensu-go/backend/apid"
+	"github.com/sensu/sensu-go/backend/apid/routers"
 	"github.com/sensu/sensu-go/backend/daemon"
 	"github.com/sensu/sensu-go/backend/dashboardd"
+	"github.com/sensu/sensu-go/backend/encryption"
 	"github.com/sensu/sensu-go/backend/etcd"
 	"github.com/sensu/sensu-go/backend/eventd"
+	"github.com/sensu/sensu-go/backend/federation"
+	"github.com/sensu/sensu-go/backend/incidentd"
 	"github.com/sensu/sensu-go/backend/keepalived"
+	"github.com/sensu/sensu-go/backend/leader"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/migration"
 	"github.com/sensu/sensu-go/backend/pipelined"
+	"github.com/sensu/sensu-go/backend/queue"
 	"github.com/sensu/sensu-go/backend/schedulerd"
 	"github.com/sensu/sensu-go/backend/seeds"
+	"github.com/sensu/sensu-go/backend/shardd"
+	"github.com/sensu/sensu-go/backend/store"
 	etcdstore "github.com/sensu/sensu-go/backend/store/etcd"
+	"github.com/sensu/sensu-go/backend/store/memory"
+	"github.com/sensu/sensu-go/backend/tessend"
+	"github.com/sensu/sensu-go/backend/trapd"
+	"github.com/sensu/sensu-go/backend/usage"
+	"github.com/sensu/sensu-go/backend/webhookd"
+	"github.com/sensu/sensu-go/command"
+	"github.com/sensu/sensu-go/signing"
+	"github.com/sensu/sensu-go/snmp"
+	"github.com/sensu/sensu-go/system"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -30,6 +59,14 @@ const (
 
 	// DefaultEtcdPeerURL is the default URL to listen for Etcd peers (single-node cluster only)
 	DefaultEtcdPeerURL = "http://127.0.0.1:2380"
+
+	// DefaultPipelinedShardingAddress is the default address the events
+	// forwarded by the other backends are received on, when their handling is
+	// sharded
+	DefaultPipelinedShardingAddress = "[::]:8082"
+
+	// storeHealthInterval is the interval at which the store health is checked
+	storeHealthInterval = 5 * time.Second
 )
 
 // Config specifies a Backend configuration.
@@ -37,21 +74,135 @@ type Config struct {
 	// Backend Configuration
 	StateDir string
 
-	// Agentd Configuration
-	AgentHost string
-	AgentPort int
+	// ShutdownTimeout is the time, in seconds, given to pipelined to handle
+	// the queued events when the backend shuts down. The API clients are told
+	// to retry their requests after this delay meanwhile.
+	ShutdownTimeout int
+
+	// ReadOnlyReplica only runs apid and dashboardd against the shared store,
+	// rejecting the write requests, so the read traffic can be scaled
+	// separately from the events processing
+	ReadOnlyReplica bool
+
+	// Dev runs the backend against an in-memory store instead of etcd, for
+	// development purposes. Its resources are lost when it stops. The API
+	// requests without credentials are made as the admin user, so the API and
+	// the dashboard proxying it only listen on the loopback interface, and an
+	// agent embedded in the backend executes a demo check.
+	Dev bool
+
+	// GCPercent is the garbage collection target percentage, as set by the
+	// GOGC environment variable, which is used if 0
+	GCPercent int
 
-	// Apid Configuration
-	APIHost string
-	APIPort int
+	// MemoryBallast is the size, in MB, of a never used allocation raising the
+	// heap size at which the garbage collections are triggered, so that bursts
+	// of events don't cause back to back collections
+	MemoryBallast int
+
+	// Agentd Configuration, the messages of the agents larger than
+	// MaxEventSize bytes are rejected, unless it's 0. The agents connected to
+	// the MQTT broker at MQTTBrokerURL are bridged if it's set. The agent
+	// listener uses the certificate of the backend unless AgentCertFile and
+	// AgentKeyFile are set.
+	AgentHost     string
+	AgentPort     int
+	AgentCertFile string
+	AgentKeyFile  string
+	MaxEventSize  int64
+	MQTTBrokerURL string
+
+	// Apid Configuration, the checks, handlers and silenced entries deleted
+	// through the API can be restored for TombstoneWindow seconds, unless
+	// it's 0
+	APIHost         string
+	APIPort         int
+	TombstoneWindow int64
+
+	// GraphQL API Configuration, the introspection queries are rejected if
+	// GraphQLDisableIntrospection is set. The queries can be requested by
+	// their hash, either persisted by the clients or loaded from the
+	// GraphQLPersistedQueries manifest, and only these are served if
+	// GraphQLPersistedQueriesOnly is set.
+	GraphQLDisableIntrospection bool
+	GraphQLPersistedQueries     string
+	GraphQLPersistedQueriesOnly bool
+
+	// SlackConfig is the path of the configuration of the Slack slash command
+	// endpoint, its signing secret and the mapping of the Slack users to the
+	// Sensu users. The endpoint is not served if it's empty.
+	SlackConfig string
 
 	// Dashboardd Configuration
-	DashboardDir  string
-	DashboardHost string
-	DashboardPort int
+	DashboardDir      string
+	DashboardHost     string
+	DashboardPort     int
+	DashboardCertFile string
+	DashboardKeyFile  string
+
+	// Eventd Configuration
+	EventdWorkers    int
+	EventdBufferSize int
+
+	// ClockSkewThreshold is the clock skew, in seconds, between an agent and
+	// the backend above which a warning event is emitted
+	ClockSkewThreshold int
+
+	// Keepalived Configuration
+	KeepaliveStormThreshold int
+
+	// Schedulerd Configuration, the check requests are signed with the keys
+	// of their organization found in CheckSigningKeysDir, if set
+	CheckSigningKeysDir string
 
 	// Pipelined Configuration
 	DeregistrationHandler string
+	PipelinedWorkers      int
+	PipelinedBufferSize   int
+	PipelinedOutputLimit  int
+	PipelinedStreamOutput bool
+
+	// The handling of the events is sharded by entity and check across the
+	// backends sharing the same etcd cluster if PipelinedSharding is set. The
+	// events are forwarded to the PipelinedShardingAddress of the backend
+	// owning them, which the other backends reach at its
+	// PipelinedShardingURL
+	PipelinedSharding        bool
+	PipelinedShardingAddress string
+	PipelinedShardingURL     string
+
+	// The pipe handlers run in a sandbox if PipelinedSandbox is set: their
+	// environment is restricted to PATH and the PipelinedSandboxEnv variables,
+	// and they run as PipelinedSandboxUser, with a read-only filesystem except
+	// for PipelinedSandboxWritablePaths, or the compiled seccomp profile, if
+	// set
+	PipelinedSandbox               bool
+	PipelinedSandboxUser           string
+	PipelinedSandboxReadOnly       bool
+	PipelinedSandboxWritablePaths  []string
+	PipelinedSandboxSeccompProfile string
+	PipelinedSandboxEnv            []string
+
+	// Trapd Configuration, the SNMP trap receiver is disabled if the port is 0
+	SNMPTrapHost        string
+	SNMPTrapPort        int
+	SNMPTrapCommunities []string
+	SNMPTrapMappings    string
+
+	// Webhookd Configuration, the path of a JSON file listing the webhooks
+	// notified of the changes of resources, none if empty
+	ResourceWebhooks string
+
+	// Federation Configuration, the remote clusters given as name=url are
+	// queried by the GraphQL API with the credentials of a service account
+	FederationClusters []string
+	FederationUsername string
+	FederationPassword string
+
+	// Encrypter encrypts the output of the events and the extended attributes
+	// of the entities stored in etcd, which are stored in plain text if it's
+	// nil
+	Encrypter *encryption.Encrypter
 
 	// Etcd configuration
 	EtcdInitialAdvertisePeerURL string
@@ -81,7 +232,33 @@ type Backend struct {
 	dashboardd daemon.Daemon
 	eventd     daemon.Daemon
 	pipelined  daemon.Daemon
+	shardd     daemon.Daemon
+	shardPeers daemon.Daemon
+	incidentd  daemon.Daemon
 	keepalived daemon.Daemon
+	tessend    daemon.Daemon
+	trapd      daemon.Daemon
+	webhookd   daemon.Daemon
+
+	// devAgent is the agent embedded in the backend in the development mode
+	devAgent *agent.Agent
+
+	// storeHealth is 1 when the last store health check succeeded
+	storeHealth int32
+
+	// draining is 1 once the backend started shutting down
+	draining int32
+
+	// ballast is the memory ballast, which is never read nor written so that
+	// it's not resident
+	ballast []byte
+
+	// The configurations of the TLS listeners, nil when served in plain text,
+	// and the reloaders of their certificates
+	apiTLSConfig       *tls.Config
+	agentTLSConfig     *tls.Config
+	dashboardTLSConfig *tls.Config
+	certificates       []*certificateReloader
 }
 
 // NewBackend will, given a Config, create an initialized Backend and return a
@@ -121,6 +298,24 @@ func NewBackend(config *Config) (*Backend, error) {
 		config.AgentPort = 8081
 	}
 
+	if config.PipelinedShardingAddress == "" {
+		config.PipelinedShardingAddress = DefaultPipelinedShardingAddress
+	}
+
+	if config.Dev {
+		apiHost, err := devHost("API", config.APIHost)
+		if err != nil {
+			return nil, err
+		}
+		config.APIHost = apiHost
+
+		dashboardHost, err := devHost("dashboard", config.DashboardHost)
+		if err != nil {
+			return nil, err
+		}
+		config.DashboardHost = dashboardHost
+	}
+
 	// Check for TLS config and load certs if present
 	var (
 		tlsConfig *tls.Config
@@ -162,13 +357,27 @@ func NewBackend(config *Config) (*Backend, error) {
 		}
 	}
 
-	e, err := etcd.NewEtcd(cfg)
-	if err != nil {
-		return nil, fmt.Errorf("error starting etcd: %s", err.Error())
+	if err := b.configureListenersTLS(); err != nil {
+		return nil, err
+	}
+
+	// The development mode runs without etcd, which would otherwise create
+	// the state directory
+	if config.Dev {
+		if err := os.MkdirAll(config.StateDir, 0700); err != nil {
+			return nil, fmt.Errorf("could not create the state directory: %s", err)
+		}
+	} else {
+		e, err := etcd.NewEtcd(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("error starting etcd: %s", err.Error())
+		}
+		b.etcd = e
 	}
-	b.etcd = e
 
-	b.messageBus = &messaging.WizardBus{}
+	b.messageBus = &messaging.WizardBus{
+		BackpressureTimeout: messaging.DefaultBackpressureTimeout,
+	}
 
 	return b, nil
 }
@@ -186,6 +395,11 @@ type stopGroup []daemonStopper
 
 func (s stopGroup) Stop() (err error) {
 	for _, stopper := range s {
+		// The daemons which weren't started, e.g. by read-only replicas, are
+		// skipped
+		if stopper.stopper == nil {
+			continue
+		}
 		logger.Info("shutting down %s", stopper.Name)
 		e := stopper.Stop()
 		if err == nil {
@@ -220,110 +434,138 @@ func (e errGroup) Err() <-chan error {
 // Run starts all of the Backend server's event loops and sets up the HTTP
 // server.
 func (b *Backend) Run() (derr error) {
+	b.tuneMemory()
+
 	if err := b.messageBus.Start(); err != nil {
 		return err
 	}
 
-	// Right now, instantiating a new Etcd will start etcd. If we change that
-	// s.t. Etcd has its own Start() method, conforming to Daemon, then we will
-	// want to make sure that we aren't calling NewClient before starting it,
-	// I think. That might return a connection error.
-	st, err := etcdstore.NewStore(b.etcd)
+	st, err := b.newStore()
 	if err != nil {
 		return err
 	}
 
-	// Seed initial data
-	err = seeds.SeedInitialData(st)
-	if err != nil {
-		return err
-	}
+	// Track the event throughput of every tenant, it is shared by eventd and
+	// apid so it outlives their restarts
+	usageTracker := usage.NewTracker()
 
-	b.schedulerd = &schedulerd.Schedulerd{
-		MessageBus: b.messageBus,
-		Store:      st,
+	// Reload the certificates of the listeners when their files change
+	for _, reloader := range b.certificates {
+		if err := reloader.Watch(b.shutdownChan); err != nil {
+			logger.WithError(err).Error("could not watch the certificate files")
+		}
 	}
-	err = b.schedulerd.Start()
-	if err != nil {
-		return err
+
+	// The remote clusters of the federation are queried by apid
+	var gateway *federation.Gateway
+	if len(b.Config.FederationClusters) > 0 {
+		clusters, err := federation.ParseClusters(b.Config.FederationClusters)
+		if err != nil {
+			return err
+		}
+		gateway = federation.NewGateway(clusters, b.Config.FederationUsername, b.Config.FederationPassword, nil)
 	}
 
-	b.pipelined = &pipelined.Pipelined{
-		Store:      st,
-		MessageBus: b.messageBus,
+	// The persisted GraphQL queries are kept across the restarts of apid
+	persistedQueries := routers.NewPersistedQueries()
+	if path := b.Config.GraphQLPersistedQueries; path != "" {
+		if persistedQueries, err = routers.LoadPersistedQueries(path); err != nil {
+			return err
+		}
+		logger.WithField("queries", persistedQueries.Len()).Info("loaded the persisted graphql queries")
 	}
-	if err := b.pipelined.Start(); err != nil {
-		return err
+	persistedQueries.Only = b.Config.GraphQLPersistedQueriesOnly
+
+	var slack *routers.SlackOptions
+	if path := b.Config.SlackConfig; path != "" {
+		if slack, err = routers.LoadSlackOptions(path); err != nil {
+			return err
+		}
 	}
 
 	// TLS config gets passed down here
-	b.apid = &apid.APId{
-		Store:         st,
-		Host:          b.Config.APIHost,
-		Port:          b.Config.APIPort,
-		BackendStatus: b.Status,
-		TLS:           b.Config.TLS,
-		MessageBus:    b.messageBus,
-	}
+	b.apid = daemon.Supervise("apid", func() daemon.Daemon {
+		return &apid.APId{
+			Store:         st,
+			Host:          b.Config.APIHost,
+			Port:          b.Config.APIPort,
+			BackendStatus: b.Status,
+			StoreHealthy:  b.storeHealthy,
+			TLSConfig:     b.apiTLSConfig,
+			MessageBus:    b.messageBus,
+			Usage:         usageTracker,
+			ReadOnly:      b.Config.ReadOnlyReplica,
+			Federation:    gateway,
+			AnonymousUser: b.anonymousUser(),
 
-	if err := b.apid.Start(); err != nil {
-		return err
-	}
+			TombstoneWindow: b.Config.TombstoneWindow,
+			GraphQL: routers.GraphQLOptions{
+				DisableIntrospection: b.Config.GraphQLDisableIntrospection,
+				PersistedQueries:     persistedQueries,
+			},
+			Slack: slack,
 
-	b.agentd = &agentd.Agentd{
-		Store:      st,
-		Host:       b.Config.AgentHost,
-		Port:       b.Config.AgentPort,
-		MessageBus: b.messageBus,
-		TLS:        b.Config.TLS,
-	}
-	if err := b.agentd.Start(); err != nil {
+			Draining:        b.isDraining,
+			DrainRetryAfter: b.shutdownTimeout(),
+		}
+	})
+	if err := b.apid.Start(); err != nil {
 		return err
 	}
 
-	b.dashboardd = &dashboardd.Dashboardd{
-		BackendStatus: b.Status,
-		Config: dashboardd.Config{
-			Dir:  b.Config.DashboardDir,
-			Host: b.Config.DashboardHost,
-			Port: b.Config.DashboardPort,
-			TLS:  b.Config.TLS,
-		},
-	}
+	b.dashboardd = daemon.Supervise("dashboardd", func() daemon.Daemon {
+		return &dashboardd.Dashboardd{
+			BackendStatus: b.Status,
+			Config: dashboardd.Config{
+				Dir:       b.Config.DashboardDir,
+				Host:      b.Config.DashboardHost,
+				Port:      b.Config.DashboardPort,
+				TLSConfig: b.dashboardTLSConfig,
+				APIURL:    b.apiURL(),
+				APITLS:    b.Config.TLS,
+			},
+		}
+	})
 	if err := b.dashboardd.Start(); err != nil {
 		return err
 	}
 
-	b.eventd = &eventd.Eventd{
-		Store:      st,
-		MessageBus: b.messageBus,
-	}
-	if err := b.eventd.Start(); err != nil {
-		return err
+	// The read-only replicas leave the seeding of the store, the scheduling
+	// of the checks and the events processing to the other backends
+	var leaderClient *clientv3.Client
+	if b.Config.ReadOnlyReplica {
+		logger.Info("running as a read-only replica, events are not processed")
+	} else {
+		if b.etcd != nil {
+			leaderClient, err = b.etcd.NewClient()
+			if err != nil {
+				return err
+			}
+		}
+		if err := b.startProcessing(st, leaderClient, usageTracker); err != nil {
+			return err
+		}
 	}
 
-	b.keepalived = &keepalived.Keepalived{
-		Store:                 st,
-		MessageBus:            b.messageBus,
-		DeregistrationHandler: b.Config.DeregistrationHandler,
-	}
-	if err := b.keepalived.Start(); err != nil {
-		return err
+	if b.Config.Dev {
+		if err := seedDevResources(st, b.Config.StateDir); err != nil {
+			return err
+		}
+		if b.devAgent, err = b.startDevAgent(); err != nil {
+			return err
+		}
 	}
 
 	eg := errGroup{
 		out: make(chan error),
-		errors: []errorer{
-			b.apid,
-			b.agentd,
-			b.schedulerd,
-			b.etcd,
-			b.messageBus,
-			b.pipelined,
-			b.dashboardd,
-			b.eventd,
-			b.keepalived,
-		},
+		// The other daemons are supervised and restarted when they fail
+		errors: []errorer{b.messageBus},
+	}
+	if b.etcd != nil {
+		eg.errors = append(eg.errors, b.etcd)
+	}
+	if b.shardPeers != nil {
+		eg.errors = append(eg.errors, b.shardPeers)
 	}
 	eg.Go()
 
@@ -334,33 +576,58 @@ func (b *Backend) Run() (derr error) {
 		logger.Info("backend shutting down")
 	}
 
-	logger.Info("shutting down etcd")
+	// The API rejects the requests from then on, while the agents are
+	// disconnected and the events in flight are drained
+	atomic.StoreInt32(&b.draining, 1)
+
 	defer func() {
 		if err := recover(); err != nil {
 			trace := string(debug.Stack())
 			logger.Errorf("panic in %s", trace)
 			logger.Errorf("recovering from panic due to error %s, shutting down etcd", err)
 		}
+		if b.etcd == nil {
+			return
+		}
+		logger.Info("shutting down etcd")
 		err := b.etcd.Shutdown()
 		if derr == nil {
 			derr = err
 		}
 	}()
 
+	if b.devAgent != nil {
+		logger.Info("shutting down the embedded agent")
+		b.devAgent.Stop()
+	}
+
 	sg := stopGroup{
-		// stop allowing API connections
-		{Name: "apid", stopper: b.apid},
-		// stop allowing dashboard connections
-		{Name: "dashboardd", stopper: b.dashboardd},
 		// disconnect all agents and don't allow any more to connect.
 		{Name: "agentd", stopper: b.agentd},
 		// stop scheduling checks.
 		{Name: "schedulerd", stopper: b.schedulerd},
+		// stop monitoring keepalives.
+		{Name: "keepalived", stopper: b.keepalived},
+		// stop reporting anonymous usage data.
+		{Name: "tessend", stopper: b.tessend},
+		// stop receiving SNMP traps.
+		{Name: "trapd", stopper: b.trapd},
+		// stop notifying the resource webhooks.
+		{Name: "webhookd", stopper: b.webhookd},
 		// Shutting down eventd will cause it to drain events to the bus
 		{Name: "eventd", stopper: b.eventd},
-		// Once events have been drained from eventd, pipelined can finish
-		// processing events.
+		// Once events have been drained from eventd, shardd can route them,
+		{Name: "shardd", stopper: b.shardd},
+		// and stop receiving the events of the other backends,
+		{Name: "shard peers", stopper: b.shardPeers},
+		// and pipelined can finish processing events.
 		{Name: "pipelined", stopper: b.pipelined},
+		// and incidentd can finish correlating them.
+		{Name: "incidentd", stopper: b.incidentd},
+		// stop allowing API connections, which were rejected while draining
+		{Name: "apid", stopper: b.apid},
+		// stop allowing dashboard connections
+		{Name: "dashboardd", stopper: b.dashboardd},
 		// finally shutdown the message bus once all other components have stopped
 		// using it.
 		{Name: "message bus", stopper: b.messageBus},
@@ -372,6 +639,17 @@ func (b *Backend) Run() (derr error) {
 		}
 	}
 
+	// Let another backend take over the scheduling of checks right away
+	if leaderClient != nil {
+		logger.Info("resigning from cluster leadership")
+		if err := leader.Resign(); err != nil {
+			logger.WithError(err).Error("error resigning from cluster leadership")
+		}
+		if err := leaderClient.Close(); err != nil {
+			logger.WithError(err).Debug("error closing leader election client")
+		}
+	}
+
 	// we allow inErrChan to leak to avoid panics from other
 	// goroutines writing errors to either after shutdown has been initiated.
 	close(b.done)
@@ -379,33 +657,616 @@ func (b *Backend) Run() (derr error) {
 	return derr
 }
 
+// startProcessing seeds the store, campaigns for the cluster leadership with
+// the given client, and starts the daemons processing the events. The backend
+// is the leader if the client is nil, in the development mode.
+func (b *Backend) startProcessing(st backendStore, leaderClient *clientv3.Client, usageTracker *usage.Tracker) error {
+	// Seed initial data
+	if err := seeds.SeedInitialData(st); err != nil {
+		return err
+	}
+
+	// Campaign for the cluster leadership, so that the checks are scheduled
+	// by a single backend when several share the same etcd cluster
+	if leaderClient == nil {
+		leader.Override()
+	} else if err := leader.Initialize(leaderClient); err != nil {
+		return fmt.Errorf("error initializing leader election: %s", err)
+	}
+
+	clusterConfig := store.NewClusterConfigCache(st)
+	b.watchUntilShutdown(clusterConfig.Watch)
+	namespaces := store.NewNamespaceCache(st)
+	b.watchUntilShutdown(namespaces.Watch)
+
+	var signer *signing.Keyring
+	if b.Config.CheckSigningKeysDir != "" {
+		keyring, err := signing.LoadKeyring(b.Config.CheckSigningKeysDir)
+		if err != nil {
+			return err
+		}
+		signer = keyring
+	}
+
+	b.schedulerd = daemon.Supervise("schedulerd", func() daemon.Daemon {
+		return &schedulerd.Schedulerd{
+			MessageBus: b.messageBus,
+			Store:      st,
+			Signer:     signer,
+		}
+	})
+	if err := b.schedulerd.Start(); err != nil {
+		return err
+	}
+
+	// The runtime assets of handlers and mutators are cached in the state
+	// directory, and shared by the restarts of pipelined
+	assetManager := assetmanager.New(filepath.Join(b.Config.StateDir, "cache"), backendEntity())
+
+	// The events are routed by shardd to the backend owning them when their
+	// handling is sharded, which requires an etcd cluster
+	eventTopic := messaging.TopicEvent
+	if b.Config.PipelinedSharding {
+		if leaderClient == nil {
+			logger.Warn("the events handling can't be sharded without etcd, handling all the events")
+		} else {
+			eventTopic = messaging.TopicEventShard
+		}
+	}
+
+	b.pipelined = daemon.Supervise("pipelined", func() daemon.Daemon {
+		return &pipelined.Pipelined{
+			Store:        st,
+			MessageBus:   b.messageBus,
+			Topic:        eventTopic,
+			WorkerCount:  b.Config.PipelinedWorkers,
+			BufferSize:   b.Config.PipelinedBufferSize,
+			OutputLimit:  b.Config.PipelinedOutputLimit,
+			StreamOutput: b.Config.PipelinedStreamOutput,
+			AssetManager: assetManager,
+			DrainTimeout: b.shutdownTimeout(),
+
+			HandlerSandbox:    b.handlerSandbox(),
+			HandlerSandboxEnv: b.Config.PipelinedSandboxEnv,
+			Usage:             usageTracker,
+			ClusterConfig:     clusterConfig,
+		}
+	})
+	if err := b.pipelined.Start(); err != nil {
+		return err
+	}
+
+	if eventTopic == messaging.TopicEventShard {
+		peers, err := b.startShardPeers(leaderClient)
+		if err != nil {
+			return err
+		}
+		b.shardPeers = peers
+
+		b.shardd = daemon.Supervise("shardd", func() daemon.Daemon {
+			return &shardd.Shardd{
+				MessageBus: b.messageBus,
+				Peers:      peers,
+				Queues:     st,
+				Membership: shardd.NewEtcdMembership(leaderClient, "pipelined", peers.ID),
+				BufferSize: b.Config.PipelinedBufferSize,
+			}
+		})
+		if err := b.shardd.Start(); err != nil {
+			return err
+		}
+	}
+
+	b.incidentd = daemon.Supervise("incidentd", func() daemon.Daemon {
+		return &incidentd.Incidentd{
+			Store:      st,
+			MessageBus: b.messageBus,
+		}
+	})
+	if err := b.incidentd.Start(); err != nil {
+		return err
+	}
+
+	b.agentd = daemon.Supervise("agentd", func() daemon.Daemon {
+		return &agentd.Agentd{
+			Store:      st,
+			Host:       b.Config.AgentHost,
+			Port:       b.Config.AgentPort,
+			MessageBus: b.messageBus,
+			TLS:        b.Config.TLS,
+			TLSConfig:  b.agentTLSConfig,
+
+			MaxMessageSize: b.Config.MaxEventSize,
+			MQTTBrokerURL:  b.Config.MQTTBrokerURL,
+			Name:           b.Config.EtcdName,
+		}
+	})
+	if err := b.agentd.Start(); err != nil {
+		return err
+	}
+
+	b.eventd = daemon.Supervise("eventd", func() daemon.Daemon {
+		return &eventd.Eventd{
+			Store:              st,
+			MessageBus:         b.messageBus,
+			HandlerCount:       b.Config.EventdWorkers,
+			BufferSize:         b.Config.EventdBufferSize,
+			Usage:              usageTracker,
+			ClockSkewThreshold: time.Duration(b.Config.ClockSkewThreshold) * time.Second,
+			Namespaces:         namespaces,
+		}
+	})
+	if err := b.eventd.Start(); err != nil {
+		return err
+	}
+
+	b.keepalived = daemon.Supervise("keepalived", func() daemon.Daemon {
+		return &keepalived.Keepalived{
+			Store:                 st,
+			MessageBus:            b.messageBus,
+			DeregistrationHandler: b.Config.DeregistrationHandler,
+			StormThreshold:        b.Config.KeepaliveStormThreshold,
+			ClusterConfig:         clusterConfig,
+		}
+	})
+	if err := b.keepalived.Start(); err != nil {
+		return err
+	}
+
+	b.tessend = daemon.Supervise("tessend", func() daemon.Daemon {
+		return &tessend.Tessend{
+			Store: st,
+		}
+	})
+	if err := b.tessend.Start(); err != nil {
+		return err
+	}
+
+	if b.Config.SNMPTrapPort != 0 {
+		var mappings []snmp.Mapping
+		if b.Config.SNMPTrapMappings != "" {
+			var err error
+			if mappings, err = snmp.LoadMappings(b.Config.SNMPTrapMappings); err != nil {
+				return err
+			}
+		}
+
+		b.trapd = daemon.Supervise("trapd", func() daemon.Daemon {
+			return &trapd.Trapd{
+				Store:       st,
+				MessageBus:  b.messageBus,
+				Host:        b.Config.SNMPTrapHost,
+				Port:        b.Config.SNMPTrapPort,
+				Communities: b.Config.SNMPTrapCommunities,
+				Mappings:    mappings,
+			}
+		})
+		if err := b.trapd.Start(); err != nil {
+			return err
+		}
+	}
+
+	if b.Config.ResourceWebhooks != "" {
+		webhooks, err := webhookd.LoadWebhooks(b.Config.ResourceWebhooks)
+		if err != nil {
+			return err
+		}
+
+		b.webhookd = daemon.Supervise("webhookd", func() daemon.Daemon {
+			return &webhookd.Webhookd{
+				Store:    st,
+				Webhooks: webhooks,
+			}
+		})
+		if err := b.webhookd.Start(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Migration performs the migration of data inside the store
 func (b *Backend) Migration() error {
+	if b.etcd == nil {
+		return errDevStore
+	}
+
 	_, err := etcdstore.NewStore(b.etcd)
 	if err != nil {
 		return err
 	}
 
+	client, err := b.etcd.NewClient()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
 	logger.Infof("starting migration on the store with URL '%s'", b.etcd.LoopbackURL())
-	migration.Run(b.etcd.LoopbackURL())
+	return migration.Upgrade(context.Background(), client)
+}
+
+// Initialize seeds the store with the default organization, environment,
+// roles and users, using the given credentials. It returns
+// seeds.ErrAlreadyInitialized if the store was already seeded.
+func (b *Backend) Initialize(config seeds.Config) error {
+	if b.etcd == nil {
+		return errDevStore
+	}
+
+	st, err := etcdstore.NewStore(b.etcd)
+	if err != nil {
+		return err
+	}
+
+	if err := b.checkSchemaVersion(); err != nil {
+		return err
+	}
+
+	logger.Infof("initializing the store with URL '%s'", b.etcd.LoopbackURL())
+	return seeds.Initialize(st, config)
+}
+
+// handlerSandbox returns the sandbox of the pipe handlers, nil if they aren't
+// sandboxed.
+func (b *Backend) handlerSandbox() *command.Sandbox {
+	if !b.Config.PipelinedSandbox {
+		return nil
+	}
+	return &command.Sandbox{
+		User:           b.Config.PipelinedSandboxUser,
+		ReadOnly:       b.Config.PipelinedSandboxReadOnly,
+		WritablePaths:  b.Config.PipelinedSandboxWritablePaths,
+		SeccompProfile: b.Config.PipelinedSandboxSeccompProfile,
+	}
+}
+
+// anonymousUser returns the user the unauthenticated API requests are made as,
+// which is the admin in the development mode only.
+func (b *Backend) anonymousUser() string {
+	if !b.Config.Dev {
+		return ""
+	}
+	return seeds.DefaultConfig().AdminUsername
+}
+
+// backendStore is the store of the daemons, with its queues and rings.
+type backendStore interface {
+	store.Store
+	queue.Get
+	types.RingGetter
+}
+
+// errDevStore is returned by the commands which can't run against the
+// in-memory store of the development mode, since it's lost when they exit.
+var errDevStore = errors.New("the store of the development mode is in memory and can't be modified offline")
+
+// newStore returns the in-memory store in the development mode, or the etcd
+// store otherwise, which is then monitored.
+func (b *Backend) newStore() (backendStore, error) {
+	if b.etcd == nil {
+		logger.Warn("running in development mode, the store is in memory and will be lost on shutdown")
+		b.setStoreHealthy(true)
+		return memory.NewStore(), nil
+	}
+
+	// Right now, instantiating a new Etcd will start etcd. If we change that
+	// s.t. Etcd has its own Start() method, conforming to Daemon, then we will
+	// want to make sure that we aren't calling NewClient before starting it,
+	// I think. That might return a connection error.
+	st, err := etcdstore.NewStore(b.etcd)
+	if err != nil {
+		return nil, err
+	}
+	st.SetEncrypter(b.Config.Encrypter)
+
+	// Record the schema version of new stores, and refuse to start on the
+	// stores which need to be upgraded, whose layout the daemons can't read
+	if err := b.checkSchemaVersion(); err != nil {
+		return nil, err
+	}
+
+	// Keep track of the store health, so that apid can fall back to serving
+	// read-only requests while it is unavailable
+	b.monitorStoreHealth()
+
+	return st, nil
+}
+
+// checkSchemaVersion initializes the schema version of new stores, and returns
+// an error if the store schema is older than the one of this version of the
+// backend.
+func (b *Backend) checkSchemaVersion() error {
+	client, err := b.etcd.NewClient()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	ctx := context.Background()
+	if err := migration.Initialize(ctx, client); err != nil {
+		return err
+	}
+
+	version, err := migration.SchemaVersion(ctx, client)
+	if err != nil {
+		return err
+	}
+	if latest := migration.LatestVersion(); version < latest {
+		return fmt.Errorf(
+			"the store schema is at version %d instead of %d, run sensu-backend upgrade first",
+			version,
+			latest,
+		)
+	}
+
 	return nil
 }
 
 // Status returns a map of component name to boolean healthy indicator.
 func (b *Backend) Status() types.StatusMap {
 	sm := map[string]bool{
-		"store":       b.etcd.Healthy(),
+		"store":       b.storeHealthy(),
 		"message_bus": b.messageBus.Status() == nil,
-		"schedulerd":  b.schedulerd.Status() == nil,
-		"pipelined":   b.pipelined.Status() == nil,
-		"eventd":      b.eventd.Status() == nil,
-		"agentd":      b.agentd.Status() == nil,
-		"apid":        b.apid.Status() == nil,
+	}
+
+	// Only the daemons which were started are reported
+	daemons := map[string]daemon.Daemon{
+		"schedulerd": b.schedulerd,
+		"pipelined":  b.pipelined,
+		"eventd":     b.eventd,
+		"incidentd":  b.incidentd,
+		"agentd":     b.agentd,
+		"apid":       b.apid,
+		"keepalived": b.keepalived,
+		"tessend":    b.tessend,
+		"trapd":      b.trapd,
+		"webhookd":   b.webhookd,
+	}
+	for name, d := range daemons {
+		if d != nil {
+			sm[name] = d.Status() == nil
+		}
 	}
 
 	return sm
 }
 
+// startShardPeers starts the message bus over which the events are forwarded
+// to the backends owning them, when their handling is sharded.
+func (b *Backend) startShardPeers(client *clientv3.Client) (*shardd.PeerBus, error) {
+	id, err := b.shardingURL()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	token, err := shardd.NewEtcdMembership(client, "pipelined", id).Token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving the token of the event handling shards: %s", err)
+	}
+
+	peers := &shardd.PeerBus{
+		ID:        id,
+		Address:   b.Config.PipelinedShardingAddress,
+		Token:     token,
+		TLSConfig: b.apiTLSConfig,
+	}
+	if b.Config.TLS != nil {
+		if peers.ClientTLSConfig, err = b.Config.TLS.ToTLSConfig(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := peers.Start(); err != nil {
+		return nil, err
+	}
+	return peers, nil
+}
+
+// shardingURL returns the URL at which the other backends reach the shards
+// listener of this backend.
+func (b *Backend) shardingURL() (string, error) {
+	if b.Config.PipelinedShardingURL != "" {
+		return strings.TrimSuffix(b.Config.PipelinedShardingURL, "/"), nil
+	}
+
+	host, port, err := net.SplitHostPort(b.Config.PipelinedShardingAddress)
+	if err != nil {
+		return "", fmt.Errorf("invalid address of the shards listener: %s", err)
+	}
+
+	// The listener listens on every interface when its host is unspecified
+	switch host {
+	case "", "::", "0.0.0.0":
+		if host, err = os.Hostname(); err != nil {
+			return "", err
+		}
+	}
+
+	scheme := "http"
+	if b.Config.TLS != nil {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(host, port)), nil
+}
+
+// watchUntilShutdown runs the watch of a cache, e.g. the one of the defaults,
+// until the backend is shut down.
+func (b *Backend) watchUntilShutdown(watch func(context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-b.shutdownChan
+		cancel()
+	}()
+	go watch(ctx)
+}
+
+// monitorStoreHealth periodically checks the health of the store until the
+// backend is shut down.
+func (b *Backend) monitorStoreHealth() {
+	b.setStoreHealthy(b.etcd.Healthy())
+
+	go func() {
+		ticker := time.NewTicker(storeHealthInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-b.shutdownChan:
+				return
+			case <-ticker.C:
+				healthy := b.etcd.Healthy()
+				if healthy != b.storeHealthy() {
+					if healthy {
+						logger.Info("store is available again")
+					} else {
+						logger.Warn("store is unavailable, the API is now read-only")
+					}
+				}
+				b.setStoreHealthy(healthy)
+			}
+		}
+	}()
+}
+
+// isDraining returns whether the backend started shutting down.
+// tuneMemory applies the garbage collection target and allocates the memory
+// ballast.
+func (b *Backend) tuneMemory() {
+	if b.Config.GCPercent != 0 {
+		debug.SetGCPercent(b.Config.GCPercent)
+		logger.Infof("garbage collection target set to %d%%", b.Config.GCPercent)
+	}
+	if b.Config.MemoryBallast > 0 {
+		b.ballast = make([]byte, b.Config.MemoryBallast<<20)
+		logger.Infof("allocated a memory ballast of %d MB", b.Config.MemoryBallast)
+	}
+}
+
+func (b *Backend) isDraining() bool {
+	return atomic.LoadInt32(&b.draining) == 1
+}
+
+// shutdownTimeout returns the time given to pipelined to handle the queued
+// events when the backend shuts down.
+func (b *Backend) shutdownTimeout() time.Duration {
+	return time.Duration(b.Config.ShutdownTimeout) * time.Second
+}
+
+func (b *Backend) setStoreHealthy(healthy bool) {
+	var v int32
+	if healthy {
+		v = 1
+	}
+	atomic.StoreInt32(&b.storeHealth, v)
+}
+
+// storeHealthy returns the result of the last store health check.
+func (b *Backend) storeHealthy() bool {
+	return atomic.LoadInt32(&b.storeHealth) == 1
+}
+
+// apiURL returns the url at which the local apid can be reached.
+func (b *Backend) apiURL() string {
+	scheme := "http"
+	if b.Config.TLS != nil {
+		scheme = "https"
+	}
+
+	// apid listens on every interface when its host is unspecified
+	host := b.Config.APIHost
+	switch host {
+	case "", "[::]", "::", "0.0.0.0":
+		host = "127.0.0.1"
+	}
+
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(strings.Trim(host, "[]"), strconv.Itoa(b.Config.APIPort)))
+}
+
+// backendEntity returns the entity describing the system of the backend, which
+// the filters of the runtime assets of handlers and mutators are evaluated
+// against.
+func backendEntity() *types.Entity {
+	entity := &types.Entity{Class: types.EntityBackendClass}
+
+	info, err := system.Info()
+	if err != nil {
+		logger.WithError(err).Error("error getting system info")
+	}
+	entity.System = info
+	entity.ID = info.Hostname
+
+	return entity
+}
+
+// dashboardTLS returns the TLS options of the dashboard, which uses its own
+// certificate if one is configured, or the one of the backend otherwise.
+func (b *Backend) dashboardTLS() *types.TLSOptions {
+	return b.listenerTLS(b.Config.DashboardCertFile, b.Config.DashboardKeyFile)
+}
+
+// agentTLS returns the TLS options of the agent listener, which uses its own
+// certificate if one is configured, or the one of the backend otherwise.
+func (b *Backend) agentTLS() *types.TLSOptions {
+	return b.listenerTLS(b.Config.AgentCertFile, b.Config.AgentKeyFile)
+}
+
+// listenerTLS returns the TLS options of a listener with its own certificate,
+// which keeps the TLS version and cipher suites of the backend, or the TLS
+// options of the backend if either file is empty.
+func (b *Backend) listenerTLS(certFile, keyFile string) *types.TLSOptions {
+	if certFile == "" || keyFile == "" {
+		return b.Config.TLS
+	}
+	opts := &types.TLSOptions{
+		CertFile: certFile,
+		KeyFile:  keyFile,
+	}
+	if b.Config.TLS != nil {
+		opts.MinVersion = b.Config.TLS.MinVersion
+		opts.CipherSuites = b.Config.TLS.CipherSuites
+	}
+	return opts
+}
+
+// configureListenersTLS loads the certificates of the API, agent and
+// dashboard listeners.
+func (b *Backend) configureListenersTLS() error {
+	listeners := []struct {
+		opts   *types.TLSOptions
+		config **tls.Config
+	}{
+		{b.Config.TLS, &b.apiTLSConfig},
+		{b.agentTLS(), &b.agentTLSConfig},
+		{b.dashboardTLS(), &b.dashboardTLSConfig},
+	}
+	for _, listener := range listeners {
+		if listener.opts == nil {
+			continue
+		}
+		config, reloader, err := listenerTLSConfig(listener.opts)
+		if err != nil {
+			return err
+		}
+		*listener.config = config
+		b.certificates = append(b.certificates, reloader)
+	}
+	return nil
+}
+
+// ReloadCertificates reloads the certificates of the TLS listeners from their
+// files, which are also reloaded whenever they change.
+func (b *Backend) ReloadCertificates() error {
+	for _, reloader := range b.certificates {
+		if err := reloader.Reload(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Stop the Backend cleanly.
 func (b *Backend) Stop() {
 	close(b.shutdownChan)
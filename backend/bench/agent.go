@@ -0,0 +1,116 @@
+package bench
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sensu/sensu-go/transport"
+	"github.com/sensu/sensu-go/types"
+)
+
+// keepaliveInterval is the interval of the keepalives of the agents, which is
+// the default interval of real agents
+const keepaliveInterval = 20 * time.Second
+
+// agent is a simulated agent, connected to the backend like a real one.
+type agent struct {
+	conn   transport.Transport
+	entity *types.Entity
+}
+
+// connect connects the simulated agent of the given index to the backend.
+func connect(cfg Config, index int) (*agent, error) {
+	entity := &types.Entity{
+		ID:               fmt.Sprintf("%s%d", EntityPrefix, index),
+		Class:            types.EntityAgentClass,
+		Subscriptions:    []string{},
+		KeepaliveTimeout: 120,
+		Organization:     cfg.Organization,
+		Environment:      cfg.Environment,
+	}
+
+	credentials := base64.StdEncoding.EncodeToString([]byte(cfg.User + ":" + cfg.Password))
+	header := http.Header{}
+	header.Set(transport.HeaderKeyAgentID, entity.ID)
+	header.Set(transport.HeaderKeyEnvironment, entity.Environment)
+	header.Set(transport.HeaderKeyOrganization, entity.Organization)
+	header.Set(transport.HeaderKeyUser, cfg.User)
+	header.Set(transport.HeaderKeySubscriptions, "")
+	header.Set("Authorization", "Basic "+credentials)
+
+	conn, err := transport.Connect(cfg.BackendURL, cfg.TLS, header)
+	if err != nil {
+		return nil, err
+	}
+
+	// Drain the messages sent by the backend, so the connection isn't blocked
+	go func() {
+		for {
+			if _, err := conn.Receive(); err != nil {
+				switch err.(type) {
+				case transport.ConnectionError, transport.ClosedError:
+					return
+				}
+			}
+		}
+	}()
+
+	a := &agent{conn: conn, entity: entity}
+	if err := a.send(transport.MessageTypeKeepalive, &types.Event{
+		Timestamp: time.Now().Unix(),
+		Entity:    entity,
+	}); err != nil {
+		a.close()
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// run submits events at the given rate until the context is done, along with
+// the keepalives.
+func (a *agent) run(ctx context.Context, rate float64, r *recorder) {
+	events := time.NewTicker(time.Duration(float64(time.Second) / rate))
+	defer events.Stop()
+	keepalives := time.NewTicker(keepaliveInterval)
+	defer keepalives.Stop()
+
+	for {
+		select {
+		case now := <-events.C:
+			if err := a.send(transport.MessageTypeEvent, newEvent(a.entity, now)); err != nil {
+				logger.WithError(err).Debugf("agent %s could not send an event", a.entity.ID)
+				r.failed()
+				continue
+			}
+			r.sent()
+		case now := <-keepalives.C:
+			if err := a.send(transport.MessageTypeKeepalive, &types.Event{
+				Timestamp: now.Unix(),
+				Entity:    a.entity,
+			}); err != nil {
+				logger.WithError(err).Debugf("agent %s could not send a keepalive", a.entity.ID)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (a *agent) send(msgType string, event *types.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return a.conn.Send(&transport.Message{Type: msgType, Payload: payload})
+}
+
+func (a *agent) close() {
+	if err := a.conn.Close(); err != nil {
+		logger.WithError(err).Debugf("agent %s could not close its connection", a.entity.ID)
+	}
+}
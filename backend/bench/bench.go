@@ -0,0 +1,214 @@
+// Package bench simulates agents submitting events to a backend at a given
+// rate, and measures the latency of their processing, from their submission
+// by the agents to their storage by the backend. It is meant to guide the
+// capacity planning of a cluster and to catch performance regressions.
+package bench
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// CheckName is the name of the check of the events submitted by the
+	// simulated agents
+	CheckName = "sensu-bench"
+
+	// EntityPrefix is the prefix of the IDs of the simulated agents, which are
+	// followed by their index
+	EntityPrefix = "sensu-bench-"
+)
+
+// Config configures a benchmark.
+type Config struct {
+	// BackendURL is the websocket URL agents connect to
+	BackendURL string
+
+	// StoreURL is the client URL of the etcd store of the backend, watched to
+	// measure the latency of the events
+	StoreURL string
+
+	// Agents is the number of simulated agents
+	Agents int
+
+	// Rate is the number of events submitted per second by each agent
+	Rate float64
+
+	// Duration is the duration of the submission of the events
+	Duration time.Duration
+
+	// Drain is the maximum duration to wait for the events still being
+	// processed once the submission is over
+	Drain time.Duration
+
+	// Organization and Environment are the namespace of the agents
+	Organization string
+	Environment  string
+
+	// User and Password are the credentials of the agents
+	User     string
+	Password string
+
+	// TLS configures the connections of the agents, if not nil
+	TLS *types.TLSOptions
+}
+
+// Validate returns an error if the configuration can't be used to run a
+// benchmark.
+func (c Config) Validate() error {
+	if c.BackendURL == "" {
+		return errors.New("the backend URL must be set")
+	}
+	if c.StoreURL == "" {
+		return errors.New("the store URL must be set")
+	}
+	if c.Agents < 1 {
+		return errors.New("the number of agents must be at least 1")
+	}
+	if c.Rate <= 0 {
+		return errors.New("the rate must be greater than 0")
+	}
+	if c.Duration <= 0 {
+		return errors.New("the duration must be greater than 0")
+	}
+	return nil
+}
+
+// Run runs a benchmark, and returns its report once the submission of the
+// events is over and they were processed, or the drain duration elapsed.
+func Run(ctx context.Context, cfg Config) (*Report, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{cfg.StoreURL},
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = client.Close() }()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Watch the events before any is submitted
+	recorder := newRecorder()
+	prefix := store.NewKeyBuilder("events").Build(cfg.Organization, cfg.Environment) + "/"
+	watch := client.Watch(ctx, prefix, clientv3.WithPrefix())
+	watchDone := make(chan struct{})
+	go func() {
+		defer close(watchDone)
+		for resp := range watch {
+			for _, ev := range resp.Events {
+				if ev.Type != clientv3.EventTypePut {
+					continue
+				}
+				if id, latency, ok := eventLatency(ev.Kv.Value, time.Now()); ok {
+					recorder.processed(id, latency)
+				}
+			}
+		}
+	}()
+
+	agents := make([]*agent, 0, cfg.Agents)
+	for i := 0; i < cfg.Agents; i++ {
+		a, err := connect(cfg, i)
+		if err != nil {
+			for _, a := range agents {
+				a.close()
+			}
+			return nil, fmt.Errorf("could not connect agent %d: %s", i, err)
+		}
+		agents = append(agents, a)
+	}
+	logger.Infof("%d agents connected", len(agents))
+
+	start := time.Now()
+	submitCtx, stopSubmit := context.WithTimeout(ctx, cfg.Duration)
+	defer stopSubmit()
+
+	var wg sync.WaitGroup
+	for _, a := range agents {
+		wg.Add(1)
+		go func(a *agent) {
+			defer wg.Done()
+			a.run(submitCtx, cfg.Rate, recorder)
+		}(a)
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	// Wait for the events still being processed
+	deadline := time.After(cfg.Drain)
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+DRAIN:
+	for !recorder.drained() {
+		select {
+		case <-ticker.C:
+		case <-deadline:
+			break DRAIN
+		case <-ctx.Done():
+			break DRAIN
+		}
+	}
+
+	for _, a := range agents {
+		a.close()
+	}
+	cancel()
+	<-watchDone
+
+	return recorder.report(elapsed), nil
+}
+
+// newEvent returns the event submitted by an agent at the given time, which
+// carries its submission time in its output.
+func newEvent(entity *types.Entity, now time.Time) *types.Event {
+	return &types.Event{
+		Timestamp: now.Unix(),
+		Entity:    entity,
+		Check: &types.Check{
+			Name:         CheckName,
+			Interval:     60,
+			Executed:     now.Unix(),
+			Issued:       now.Unix(),
+			Output:       strconv.FormatInt(now.UnixNano(), 10),
+			Organization: entity.Organization,
+			Environment:  entity.Environment,
+		},
+	}
+}
+
+// eventLatency returns the identifier and the latency of a stored event
+// submitted by an agent, which is the duration between its submission and
+// now. It returns false if the event was not submitted by an agent.
+func eventLatency(value []byte, now time.Time) (string, time.Duration, bool) {
+	event := &types.Event{}
+	if err := store.Decode(value, event); err != nil {
+		return "", 0, false
+	}
+	if event.Entity == nil || !strings.HasPrefix(event.Entity.ID, EntityPrefix) {
+		return "", 0, false
+	}
+	if event.Check == nil || event.Check.Name != CheckName {
+		return "", 0, false
+	}
+	submitted, err := strconv.ParseInt(event.Check.Output, 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	id := event.Entity.ID + "/" + event.Check.Output
+	return id, now.Sub(time.Unix(0, submitted)), true
+}
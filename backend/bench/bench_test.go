@@ -0,0 +1,90 @@
+package bench
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	cfg := Config{
+		BackendURL: "ws://127.0.0.1:8081",
+		StoreURL:   "http://127.0.0.1:2379",
+		Agents:     10,
+		Rate:       0.5,
+		Duration:   time.Minute,
+	}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Agents = 0
+	assert.Error(t, cfg.Validate())
+	cfg.Agents = 10
+
+	cfg.Rate = 0
+	assert.Error(t, cfg.Validate())
+	cfg.Rate = 0.5
+
+	cfg.StoreURL = ""
+	assert.Error(t, cfg.Validate())
+}
+
+func TestEventLatency(t *testing.T) {
+	entity := types.FixtureEntity(EntityPrefix + "0")
+	submitted := time.Now()
+	event := newEvent(entity, submitted)
+	require.NoError(t, event.Validate())
+
+	value, err := store.Encode(event)
+	require.NoError(t, err)
+	id, latency, ok := eventLatency(value, submitted.Add(25*time.Millisecond))
+	assert.True(t, ok)
+	assert.Equal(t, 25*time.Millisecond, latency)
+	assert.Contains(t, id, entity.ID)
+
+	// The events of the other entities and checks are ignored
+	event.Check.Name = "check-cpu"
+	value, err = store.Encode(event)
+	require.NoError(t, err)
+	_, _, ok = eventLatency(value, submitted)
+	assert.False(t, ok)
+
+	value, err = store.Encode(newEvent(types.FixtureEntity("entity1"), submitted))
+	require.NoError(t, err)
+	_, _, ok = eventLatency(value, submitted)
+	assert.False(t, ok)
+}
+
+func TestReport(t *testing.T) {
+	r := newRecorder()
+	for i := 100; i > 0; i-- {
+		r.sent()
+		r.processed(strconv.Itoa(i), time.Duration(i)*time.Millisecond)
+	}
+
+	// The events stored several times are recorded once
+	r.processed("1", time.Second)
+	r.sent()
+	r.failed()
+	assert.False(t, r.drained())
+
+	report := r.report(10 * time.Second)
+	assert.Equal(t, 101, report.Sent)
+	assert.Equal(t, 1, report.Failed)
+	assert.Equal(t, 100, report.Processed())
+	assert.Equal(t, 10.0, report.Throughput())
+	assert.Equal(t, 50*time.Millisecond, report.Percentile(50))
+	assert.Equal(t, 99*time.Millisecond, report.Percentile(99))
+	assert.Equal(t, 100*time.Millisecond, report.Percentile(100))
+	assert.Equal(t, time.Duration(0), (&Report{}).Percentile(50))
+
+	var buf bytes.Buffer
+	_, err := report.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "latency p99:      99ms")
+}
@@ -0,0 +1,142 @@
+package bench
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Report is the result of a benchmark.
+type Report struct {
+	// Sent is the number of events submitted by the agents
+	Sent int
+
+	// Failed is the number of events the agents could not submit
+	Failed int
+
+	// Duration is the duration of the submission of the events
+	Duration time.Duration
+
+	// Latencies are the latencies of the processed events, sorted
+	Latencies []time.Duration
+}
+
+// Processed returns the number of events processed by the backend.
+func (r *Report) Processed() int {
+	return len(r.Latencies)
+}
+
+// Throughput returns the number of events processed per second during the
+// submission.
+func (r *Report) Throughput() float64 {
+	if r.Duration <= 0 {
+		return 0
+	}
+	return float64(r.Processed()) / r.Duration.Seconds()
+}
+
+// Percentile returns the latency under which the given percentage of the
+// processed events were, using the nearest-rank method.
+func (r *Report) Percentile(p float64) time.Duration {
+	if len(r.Latencies) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(r.Latencies))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(r.Latencies) {
+		rank = len(r.Latencies)
+	}
+	return r.Latencies[rank-1]
+}
+
+// WriteTo writes a summary of the report to w.
+func (r *Report) WriteTo(w io.Writer) (int64, error) {
+	n, err := fmt.Fprintf(w,
+		"events sent:      %d\n"+
+			"events failed:    %d\n"+
+			"events processed: %d\n"+
+			"duration:         %s\n"+
+			"throughput:       %.1f events/s\n"+
+			"latency p50:      %s\n"+
+			"latency p90:      %s\n"+
+			"latency p99:      %s\n"+
+			"latency max:      %s\n",
+		r.Sent,
+		r.Failed,
+		r.Processed(),
+		r.Duration,
+		r.Throughput(),
+		r.Percentile(50),
+		r.Percentile(90),
+		r.Percentile(99),
+		r.Percentile(100),
+	)
+	return int64(n), err
+}
+
+// recorder records the events of a benchmark, concurrently.
+type recorder struct {
+	mu        sync.Mutex
+	sentN     int
+	failedN   int
+	latencies []time.Duration
+
+	// seen holds the identifiers of the processed events, since the backend
+	// may store an event several times
+	seen map[string]struct{}
+}
+
+func newRecorder() *recorder {
+	return &recorder{seen: make(map[string]struct{})}
+}
+
+func (r *recorder) sent() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sentN++
+}
+
+func (r *recorder) failed() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failedN++
+}
+
+// processed records the latency of an event the first time it's stored.
+func (r *recorder) processed(id string, latency time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.seen[id]; ok {
+		return
+	}
+	r.seen[id] = struct{}{}
+	r.latencies = append(r.latencies, latency)
+}
+
+// drained returns true if every event sent was processed.
+func (r *recorder) drained() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.latencies) >= r.sentN
+}
+
+func (r *recorder) report(duration time.Duration) *Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	latencies := make([]time.Duration, len(r.latencies))
+	copy(latencies, r.latencies)
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	return &Report{
+		Sent:      r.sentN,
+		Failed:    r.failedN,
+		Duration:  duration,
+		Latencies: latencies,
+	}
+}
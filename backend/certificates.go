@@ -0,0 +1,123 @@
+package backend
+
+import (
+	"crypto/tls"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/sensu/sensu-go/types"
+)
+
+// certificateReloader serves the certificate of a TLS listener, and reloads it
+// from its files when they change, so the certificates can be renewed without
+// restarting the backend.
+type certificateReloader struct {
+	certFile string
+	keyFile  string
+
+	mutex sync.RWMutex
+	cert  *tls.Certificate
+}
+
+// newCertificateReloader loads the certificate of certFile and keyFile.
+func newCertificateReloader(certFile, keyFile string) (*certificateReloader, error) {
+	r := &certificateReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload loads the certificate from its files again. The previous certificate
+// is kept if they can't be loaded, e.g. while they are being replaced.
+func (r *certificateReloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.cert = &cert
+	return nil
+}
+
+// GetCertificate returns the current certificate, it is meant to be used as
+// the GetCertificate function of a tls.Config.
+func (r *certificateReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.cert, nil
+}
+
+// Watch reloads the certificate when its files are written, created or
+// renamed until stop is closed. The directories of the files are watched,
+// rather than the files, since they are usually replaced rather than written.
+func (r *certificateReloader) Watch(stop <-chan struct{}) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	files := map[string]bool{}
+	for _, file := range []string{r.certFile, r.keyFile} {
+		file = filepath.Clean(file)
+		files[file] = true
+		if err := watcher.Add(filepath.Dir(file)); err != nil {
+			_ = watcher.Close()
+			return err
+		}
+	}
+
+	go func() {
+		defer func() { _ = watcher.Close() }()
+		for {
+			select {
+			case <-stop:
+				return
+			case event := <-watcher.Events:
+				if !files[filepath.Clean(event.Name)] || event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+					continue
+				}
+				if err := r.Reload(); err != nil {
+					logger.WithError(err).WithField("file", event.Name).Warn("could not reload the certificate")
+					continue
+				}
+				logger.WithField("file", event.Name).Info("certificate reloaded")
+			case err := <-watcher.Errors:
+				logger.WithError(err).Error("error watching the certificate files")
+			}
+		}
+	}()
+
+	return nil
+}
+
+// listenerTLSConfig returns the configuration of a TLS listener serving the
+// certificate of the options, along with the reloader of that certificate.
+func listenerTLSConfig(opts *types.TLSOptions) (*tls.Config, *certificateReloader, error) {
+	minVersion, err := opts.TLSMinVersion()
+	if err != nil {
+		return nil, nil, err
+	}
+	cipherSuites, err := opts.TLSCipherSuites()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reloader, err := newCertificateReloader(opts.CertFile, opts.KeyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &tls.Config{
+		GetCertificate:           reloader.GetCertificate,
+		MinVersion:               minVersion,
+		CipherSuites:             cipherSuites,
+		PreferServerCipherSuites: true,
+	}, reloader, nil
+}
@@ -0,0 +1,106 @@
+package backend
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCertificate writes a self-signed certificate for commonName to
+// certFile and keyFile.
+func writeCertificate(t *testing.T, certFile, keyFile, commonName string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	require.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+}
+
+func commonName(t *testing.T, r *certificateReloader) string {
+	cert, err := r.GetCertificate(nil)
+	require.NoError(t, err)
+	parsed, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	return parsed.Subject.CommonName
+}
+
+func TestCertificateReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "certificates")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	certFile := filepath.Join(dir, "backend.pem")
+	keyFile := filepath.Join(dir, "backend-key.pem")
+	writeCertificate(t, certFile, keyFile, "first")
+
+	reloader, err := newCertificateReloader(certFile, keyFile)
+	require.NoError(t, err)
+	assert.Equal(t, "first", commonName(t, reloader))
+
+	// The certificate is kept when its files are invalid
+	require.NoError(t, ioutil.WriteFile(certFile, []byte("invalid"), 0600))
+	assert.Error(t, reloader.Reload())
+	assert.Equal(t, "first", commonName(t, reloader))
+
+	writeCertificate(t, certFile, keyFile, "second")
+	require.NoError(t, reloader.Reload())
+	assert.Equal(t, "second", commonName(t, reloader))
+
+	// The certificate is reloaded when its files change
+	stop := make(chan struct{})
+	defer close(stop)
+	require.NoError(t, reloader.Watch(stop))
+	writeCertificate(t, certFile, keyFile, "third")
+	for i := 0; i < 500 && commonName(t, reloader) != "third"; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, "third", commonName(t, reloader))
+}
+
+func TestListenerTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "certificates")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	certFile := filepath.Join(dir, "backend.pem")
+	keyFile := filepath.Join(dir, "backend-key.pem")
+	writeCertificate(t, certFile, keyFile, "backend")
+
+	config, _, err := listenerTLSConfig(&types.TLSOptions{
+		CertFile:     certFile,
+		KeyFile:      keyFile,
+		MinVersion:   "1.2",
+		CipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS12), config.MinVersion)
+	assert.Equal(t, []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256}, config.CipherSuites)
+
+	_, _, err = listenerTLSConfig(&types.TLSOptions{CertFile: certFile, KeyFile: keyFile, MinVersion: "1.4"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/bench"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagBenchBackendURL   = "backend-url"
+	flagBenchStoreURL     = "store-url"
+	flagBenchAgents       = "agents"
+	flagBenchRate         = "rate"
+	flagBenchDuration     = "duration"
+	flagBenchDrain        = "drain"
+	flagBenchOrganization = "organization"
+	flagBenchEnvironment  = "environment"
+	flagBenchUser         = "user"
+	flagBenchPassword     = "password"
+)
+
+// newBenchCommand creates the command simulating agents submitting events to
+// a running backend, which reports the latency of their processing.
+func newBenchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bench",
+		Short: "measure the event throughput and latency of a running backend",
+		Long: "Simulate agents submitting events to a running backend at a given rate, and\n" +
+			"report the latency percentiles of their processing, from their submission\n" +
+			"to their storage, which is observed by watching the store of the backend.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := bench.Config{}
+			cfg.BackendURL, _ = cmd.Flags().GetString(flagBenchBackendURL)
+			cfg.StoreURL, _ = cmd.Flags().GetString(flagBenchStoreURL)
+			cfg.Agents, _ = cmd.Flags().GetInt(flagBenchAgents)
+			cfg.Rate, _ = cmd.Flags().GetFloat64(flagBenchRate)
+			cfg.Duration, _ = cmd.Flags().GetDuration(flagBenchDuration)
+			cfg.Drain, _ = cmd.Flags().GetDuration(flagBenchDrain)
+			cfg.Organization, _ = cmd.Flags().GetString(flagBenchOrganization)
+			cfg.Environment, _ = cmd.Flags().GetString(flagBenchEnvironment)
+			cfg.User, _ = cmd.Flags().GetString(flagBenchUser)
+			cfg.Password, _ = cmd.Flags().GetString(flagBenchPassword)
+
+			trustedCAFile, _ := cmd.Flags().GetString(flagTrustedCAFile)
+			insecureSkipTLSVerify, _ := cmd.Flags().GetBool(flagInsecureSkipTLSVerify)
+			if trustedCAFile != "" || insecureSkipTLSVerify {
+				cfg.TLS = &types.TLSOptions{
+					TrustedCAFile:      trustedCAFile,
+					InsecureSkipVerify: insecureSkipTLSVerify,
+				}
+			}
+
+			// Stop the benchmark early, still reporting its results, on interrupt
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				cancel()
+			}()
+
+			report, err := bench.Run(ctx, cfg)
+			if err != nil {
+				return err
+			}
+			_, err = report.WriteTo(cmd.OutOrStdout())
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagBenchBackendURL, "ws://127.0.0.1:8081", "websocket URL of the backend the agents connect to")
+	cmd.Flags().String(flagBenchStoreURL, "http://127.0.0.1:2379", "client URL of the store of the backend, watched to measure the latency")
+	cmd.Flags().Int(flagBenchAgents, 100, "number of simulated agents")
+	cmd.Flags().Float64(flagBenchRate, 1, "number of events submitted per second by each agent")
+	cmd.Flags().Duration(flagBenchDuration, time.Minute, "duration of the submission of the events")
+	cmd.Flags().Duration(flagBenchDrain, 30*time.Second, "maximum duration to wait for the events still being processed")
+	cmd.Flags().String(flagBenchOrganization, "default", "organization of the agents")
+	cmd.Flags().String(flagBenchEnvironment, "default", "environment of the agents")
+	cmd.Flags().String(flagBenchUser, "agent", "username of the agents")
+	cmd.Flags().String(flagBenchPassword, "P@ssw0rd!", "password of the agents")
+	cmd.Flags().String(flagTrustedCAFile, "", "TLS CA certificate bundle in PEM format used to connect to the backend")
+	cmd.Flags().Bool(flagInsecureSkipTLSVerify, false, "skip TLS verification (not recommended!)")
+
+	return cmd
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"errors"
+	"os"
+
+	"github.com/sensu/sensu-go/backend/seeds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagAdminUsername = "admin-username"
+	flagAdminPassword = "admin-password"
+	flagAgentUsername = "agent-username"
+	flagAgentPassword = "agent-password"
+
+	envAdminPassword = "SENSU_BACKEND_ADMIN_PASSWORD"
+	envAgentPassword = "SENSU_BACKEND_AGENT_PASSWORD"
+)
+
+// newInitCommand creates the command seeding the store with the default
+// organization, environment, roles and users. It accepts the same flags and
+// configuration file as the start command, so the embedded store is started
+// the same way.
+func newInitCommand() *cobra.Command {
+	cmd := newStartCommand()
+	cmd.Use = "init"
+	cmd.Short = "initialize the store with the default resources and credentials"
+
+	defaults := seeds.DefaultConfig()
+	cmd.Flags().String(flagAdminUsername, defaults.AdminUsername, "username of the admin user")
+	cmd.Flags().String(flagAdminPassword, "", "password of the admin user, defaults to $"+envAdminPassword)
+	cmd.Flags().String(flagAgentUsername, defaults.AgentUsername, "username of the agent user")
+	cmd.Flags().String(flagAgentPassword, "", "password of the agent user, defaults to $"+envAgentPassword)
+
+	start := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		config, err := initConfig(cmd)
+		if err != nil {
+			return err
+		}
+		if err := config.Validate(); err != nil {
+			return err
+		}
+		return start(cmd, []string{"init"})
+	}
+
+	return cmd
+}
+
+// initConfig returns the credentials of the default users given to the init
+// command. The passwords fall back to the environment when their flag is
+// empty, so they don't need to appear in the process list.
+func initConfig(cmd *cobra.Command) (seeds.Config, error) {
+	config := seeds.DefaultConfig()
+	config.AdminUsername, _ = cmd.Flags().GetString(flagAdminUsername)
+	config.AgentUsername, _ = cmd.Flags().GetString(flagAgentUsername)
+
+	config.AdminPassword, _ = cmd.Flags().GetString(flagAdminPassword)
+	if config.AdminPassword == "" {
+		config.AdminPassword = os.Getenv(envAdminPassword)
+	}
+	if config.AdminPassword == "" {
+		return config, errors.New("the admin password must be provided with --" +
+			flagAdminPassword + " or $" + envAdminPassword)
+	}
+
+	if password, _ := cmd.Flags().GetString(flagAgentPassword); password != "" {
+		config.AgentPassword = password
+	} else if password := os.Getenv(envAgentPassword); password != "" {
+		config.AgentPassword = password
+	}
+
+	return config, nil
+}
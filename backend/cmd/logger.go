@@ -5,8 +5,3 @@ import "github.com/Sirupsen/logrus"
 var logger = logrus.WithFields(logrus.Fields{
 	"component": "cmd",
 })
-
-func init() {
-	logrus.SetLevel(logrus.DebugLevel)
-	logrus.SetFormatter(&logrus.JSONFormatter{})
-}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// newUpgradeCommand creates the command applying the store schema migrations.
+// It accepts the same flags and configuration file as the start command, so
+// the embedded store is started the same way.
+func newUpgradeCommand() *cobra.Command {
+	cmd := newStartCommand()
+	cmd.Use = "upgrade"
+	cmd.Short = "upgrade the store schema to the current version"
+
+	start := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return start(cmd, []string{"migration"})
+	}
+
+	return cmd
+}
@@ -0,0 +1,236 @@
+package daemon
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/cenkalti/backoff"
+)
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "daemon",
+})
+
+const (
+	// DefaultMaxRestartInterval is the longest a supervised daemon waits
+	// between two restart attempts.
+	DefaultMaxRestartInterval = time.Minute
+
+	// DefaultStableAfter is how long a restarted daemon must run before its
+	// restart backoff is reset.
+	DefaultStableAfter = time.Minute
+)
+
+// ErrRestarting is returned by the Status method of a supervised daemon that
+// crashed and is waiting to be restarted.
+var ErrRestarting = errors.New("daemon is restarting")
+
+// Factory returns a new, unstarted instance of a daemon.
+type Factory func() Daemon
+
+// Supervised is a Daemon that restarts the daemon it wraps, with an
+// exponential backoff, whenever it reports a terminal error. A new instance
+// of the daemon is obtained from the factory for every restart.
+type Supervised struct {
+	// Name identifies the daemon in logs
+	Name string
+
+	// MaxRestartInterval is the longest the supervisor waits between two
+	// restart attempts. Defaults to DefaultMaxRestartInterval.
+	MaxRestartInterval time.Duration
+
+	// StableAfter is how long a restarted daemon must run before its restart
+	// backoff is reset. Defaults to DefaultStableAfter.
+	StableAfter time.Duration
+
+	factory Factory
+
+	mu       sync.Mutex
+	current  Daemon
+	restarts int
+	stopping chan struct{}
+	wg       sync.WaitGroup
+	errChan  chan error
+}
+
+// Supervise returns a Supervised daemon for the daemons returned by factory.
+func Supervise(name string, factory Factory) *Supervised {
+	return &Supervised{
+		Name:    name,
+		factory: factory,
+		errChan: make(chan error),
+	}
+}
+
+// Start starts the supervised daemon. An error is returned if the first
+// instance of the daemon fails to start, in which case it is not restarted.
+func (s *Supervised) Start() error {
+	d := s.factory()
+	if err := d.Start(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.current = d
+	s.stopping = make(chan struct{})
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go s.supervise(d)
+	return nil
+}
+
+// Stop stops the supervised daemon and prevents any further restart.
+func (s *Supervised) Stop() error {
+	s.mu.Lock()
+	if s.stopping == nil {
+		s.mu.Unlock()
+		return nil
+	}
+	close(s.stopping)
+	s.stopping = nil
+	d := s.current
+	s.current = nil
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	if d == nil {
+		return nil
+	}
+	return d.Stop()
+}
+
+// Status returns the status of the running instance of the daemon, or
+// ErrRestarting if it is waiting to be restarted.
+func (s *Supervised) Status() error {
+	s.mu.Lock()
+	d := s.current
+	s.mu.Unlock()
+
+	if d == nil {
+		return ErrRestarting
+	}
+	return d.Status()
+}
+
+// Err returns a channel that never receives, since terminal errors of the
+// supervised daemon are handled by restarting it.
+func (s *Supervised) Err() <-chan error {
+	return s.errChan
+}
+
+// Restarts returns the number of times the daemon was restarted.
+func (s *Supervised) Restarts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.restarts
+}
+
+func (s *Supervised) newBackOff() *backoff.ExponentialBackOff {
+	b := backoff.NewExponentialBackOff()
+	b.MaxInterval = s.MaxRestartInterval
+	if b.MaxInterval == 0 {
+		b.MaxInterval = DefaultMaxRestartInterval
+	}
+	// Never give up on restarting the daemon
+	b.MaxElapsedTime = 0
+	b.Reset()
+	return b
+}
+
+func (s *Supervised) stableAfter() time.Duration {
+	if s.StableAfter == 0 {
+		return DefaultStableAfter
+	}
+	return s.StableAfter
+}
+
+// supervise watches the given running daemon and restarts it until the
+// supervised daemon is stopped.
+func (s *Supervised) supervise(d Daemon) {
+	defer s.wg.Done()
+
+	s.mu.Lock()
+	stopping := s.stopping
+	s.mu.Unlock()
+
+	b := s.newBackOff()
+	started := time.Now()
+
+	for {
+		var err error
+		select {
+		case <-stopping:
+			return
+		case err = <-d.Err():
+		}
+
+		s.mu.Lock()
+		if s.stopping != stopping {
+			// Stop was called concurrently and takes care of this instance
+			s.mu.Unlock()
+			return
+		}
+		s.current = nil
+		s.mu.Unlock()
+
+		logger.WithError(err).WithField("daemon", s.Name).Error("daemon crashed")
+		// Release whatever the crashed instance may still hold
+		if err := d.Stop(); err != nil {
+			logger.WithError(err).WithField("daemon", s.Name).Debug("error stopping crashed daemon")
+		}
+
+		if time.Since(started) > s.stableAfter() {
+			b.Reset()
+		}
+
+		d = s.restart(b, stopping)
+		if d == nil {
+			return
+		}
+		started = time.Now()
+	}
+}
+
+// restart starts new instances of the daemon, waiting between attempts,
+// until one starts successfully. It returns nil if the supervised daemon is
+// stopped in the meantime.
+func (s *Supervised) restart(b *backoff.ExponentialBackOff, stopping chan struct{}) Daemon {
+	for {
+		wait := b.NextBackOff()
+		logger.WithField("daemon", s.Name).Infof("restarting daemon in %s", wait)
+
+		select {
+		case <-stopping:
+			return nil
+		case <-time.After(wait):
+		}
+
+		d := s.factory()
+		if err := d.Start(); err != nil {
+			logger.WithError(err).WithField("daemon", s.Name).Error("failed to restart daemon")
+			continue
+		}
+
+		s.mu.Lock()
+		if s.stopping != stopping {
+			// The supervised daemon was stopped while the new instance was
+			// starting
+			s.mu.Unlock()
+			_ = d.Stop()
+			return nil
+		}
+		s.current = d
+		s.restarts++
+		restarts := s.restarts
+		s.mu.Unlock()
+
+		logger.WithFields(logrus.Fields{
+			"daemon":   s.Name,
+			"restarts": restarts,
+		}).Info("daemon restarted")
+		return d
+	}
+}
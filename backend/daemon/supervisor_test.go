@@ -0,0 +1,86 @@
+package daemon
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDaemon struct {
+	startErr error
+	errChan  chan error
+	stopped  bool
+	mu       sync.Mutex
+}
+
+func (d *fakeDaemon) Start() error {
+	return d.startErr
+}
+
+func (d *fakeDaemon) Stop() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.stopped = true
+	return nil
+}
+
+func (d *fakeDaemon) Status() error {
+	return nil
+}
+
+func (d *fakeDaemon) Err() <-chan error {
+	return d.errChan
+}
+
+func (d *fakeDaemon) isStopped() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.stopped
+}
+
+func TestSupervisedStartError(t *testing.T) {
+	s := Supervise("test", func() Daemon {
+		return &fakeDaemon{startErr: errors.New("boom"), errChan: make(chan error, 1)}
+	})
+	assert.Error(t, s.Start())
+	assert.NoError(t, s.Stop())
+}
+
+func TestSupervisedRestart(t *testing.T) {
+	var mu sync.Mutex
+	var instances []*fakeDaemon
+	s := Supervise("test", func() Daemon {
+		mu.Lock()
+		defer mu.Unlock()
+		d := &fakeDaemon{errChan: make(chan error, 1)}
+		instances = append(instances, d)
+		return d
+	})
+	s.MaxRestartInterval = 10 * time.Millisecond
+
+	require.NoError(t, s.Start())
+	assert.NoError(t, s.Status())
+
+	mu.Lock()
+	first := instances[0]
+	mu.Unlock()
+	first.errChan <- errors.New("crash")
+
+	for i := 0; i < 1000 && s.Restarts() == 0; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	require.Equal(t, 1, s.Restarts())
+	assert.True(t, first.isStopped())
+	assert.NoError(t, s.Status())
+
+	require.NoError(t, s.Stop())
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, instances, 2)
+	assert.True(t, instances[1].isStopped())
+	assert.Equal(t, ErrRestarting, s.Status())
+}
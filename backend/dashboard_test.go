@@ -0,0 +1,53 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIURL(t *testing.T) {
+	testCases := []struct {
+		host string
+		tls  bool
+		want string
+	}{
+		{"[::]", false, "http://127.0.0.1:8080"},
+		{"0.0.0.0", true, "https://127.0.0.1:8080"},
+		{"10.0.0.1", false, "http://10.0.0.1:8080"},
+		{"[::1]", false, "http://[::1]:8080"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.host, func(t *testing.T) {
+			b := &Backend{Config: &Config{APIHost: tc.host, APIPort: 8080}}
+			if tc.tls {
+				b.Config.TLS = &types.TLSOptions{}
+			}
+			assert.Equal(t, tc.want, b.apiURL())
+		})
+	}
+}
+
+func TestDashboardTLS(t *testing.T) {
+	backendTLS := &types.TLSOptions{CertFile: "backend.pem", KeyFile: "backend-key.pem"}
+	b := &Backend{Config: &Config{TLS: backendTLS}}
+	assert.Equal(t, backendTLS, b.dashboardTLS())
+
+	b.Config.DashboardCertFile = "dashboard.pem"
+	b.Config.DashboardKeyFile = "dashboard-key.pem"
+	assert.Equal(t, &types.TLSOptions{CertFile: "dashboard.pem", KeyFile: "dashboard-key.pem"}, b.dashboardTLS())
+}
+
+func TestAgentTLS(t *testing.T) {
+	backendTLS := &types.TLSOptions{CertFile: "backend.pem", KeyFile: "backend-key.pem", MinVersion: "1.2"}
+	b := &Backend{Config: &Config{TLS: backendTLS}}
+	assert.Equal(t, backendTLS, b.agentTLS())
+
+	// The agent listener keeps the TLS version of the backend
+	b.Config.AgentCertFile = "agent.pem"
+	b.Config.AgentKeyFile = "agent-key.pem"
+	assert.Equal(t, &types.TLSOptions{CertFile: "agent.pem", KeyFile: "agent-key.pem", MinVersion: "1.2"}, b.agentTLS())
+	assert.Equal(t, backendTLS, b.dashboardTLS())
+}
@@ -1,10 +1,12 @@
 package dashboardd
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"path"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -15,7 +17,7 @@ import (
 )
 
 const (
-	// API represents the Sensu API url
+	// API represents the default Sensu API url
 	API = "http://127.0.0.1:8080"
 )
 
@@ -24,7 +26,17 @@ type Config struct {
 	Dir  string
 	Host string
 	Port int
-	TLS  *types.TLSOptions
+
+	// TLSConfig is the configuration of the TLS listener, which serves the
+	// dashboard over plain http when nil
+	TLSConfig *tls.Config
+
+	// APIURL is the url of the Sensu API proxied by the dashboard, API is used
+	// if empty
+	APIURL string
+
+	// APITLS is used to connect to the Sensu API over https
+	APITLS *types.TLSOptions
 }
 
 // Dashboardd represents the dashboard daemon
@@ -55,7 +67,10 @@ func (d *Dashboardd) Start() error {
 
 	d.errChan = make(chan error, 1)
 
-	router := httpRouter(d)
+	router, err := httpRouter(d)
+	if err != nil {
+		return err
+	}
 
 	d.httpServer = &http.Server{
 		Addr:         fmt.Sprintf("%s:%d", d.Host, d.Port),
@@ -70,9 +85,9 @@ func (d *Dashboardd) Start() error {
 	go func() {
 		defer d.wg.Done()
 		var err error
-		TLS := d.Config.TLS
-		if TLS != nil {
-			err = d.httpServer.ListenAndServeTLS(TLS.CertFile, TLS.KeyFile)
+		if d.Config.TLSConfig != nil {
+			d.httpServer.TLSConfig = d.Config.TLSConfig
+			err = d.httpServer.ListenAndServeTLS("", "")
 		} else {
 			err = d.httpServer.ListenAndServe()
 		}
@@ -113,26 +128,65 @@ func (d *Dashboardd) Err() <-chan error {
 	return d.errChan
 }
 
-func httpRouter(d *Dashboardd) *mux.Router {
+func httpRouter(d *Dashboardd) (*mux.Router, error) {
 	r := mux.NewRouter()
 
 	// API gateway to Sensu API
-	target, err := url.Parse(API)
+	proxy, err := apiProxy(d.Config)
 	if err != nil {
-		logger.Fatal(err)
+		return nil, err
 	}
 
-	r.Handle("/events", httputil.NewSingleHostReverseProxy(target))
-	r.Handle("/entities", httputil.NewSingleHostReverseProxy(target))
-
-	// Serve static content
+	// Serve static content, and proxy any other path to the Sensu API so the
+	// web UI can query GraphQL and the REST API from the same origin
+	var fs http.FileSystem = HTTP
 	if d.Dir != "" {
-		r.PathPrefix("/").Handler(noCacheHandler(http.FileServer(http.Dir(d.Dir))))
-	} else {
-		r.PathPrefix("/").Handler(noCacheHandler(http.FileServer(HTTP)))
+		fs = http.Dir(d.Dir)
+	}
+	r.PathPrefix("/").Handler(staticHandler(fs, noCacheHandler(http.FileServer(fs)), proxy))
+
+	return r, nil
+}
+
+// staticHandler serves the paths found in the file system with next, and
+// passes the others to the api handler.
+func staticHandler(fs http.FileSystem, next, api http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f, err := fs.Open(path.Clean("/" + r.URL.Path))
+		if err != nil {
+			api.ServeHTTP(w, r)
+			return
+		}
+		_ = f.Close()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// apiProxy returns a reverse proxy to the Sensu API.
+func apiProxy(config Config) (*httputil.ReverseProxy, error) {
+	apiURL := config.APIURL
+	if apiURL == "" {
+		apiURL = API
+	}
+	target, err := url.Parse(apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid api url %q: %s", apiURL, err)
+	}
+
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	if target.Scheme == "https" && config.APITLS != nil {
+		tlsConfig, err := config.APITLS.ToTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		proxy.Transport = &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			TLSClientConfig:     tlsConfig,
+			TLSHandshakeTimeout: 10 * time.Second,
+		}
 	}
 
-	return r
+	return proxy, nil
 }
 
 // noCacheHandler sets the proper headers to prevent any sort of caching for the
@@ -7,32 +7,57 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDashboardRouter(t *testing.T) {
-	assert := assert.New(t)
-	dashboard := Dashboardd{}
-	router := httpRouter(&dashboard)
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "api %s", r.URL.Path)
+	}))
+	defer api.Close()
+
+	dashboard := Dashboardd{Config: Config{APIURL: api.URL}}
+	router, err := httpRouter(&dashboard)
+	require.NoError(t, err)
 
 	testCases := []struct {
-		path string
-		want uint32
+		path    string
+		proxied bool
 	}{
-		{"/", http.StatusOK},
-		{"/events", http.StatusOK},
-		{"/entities", http.StatusOK},
-		{"/index.html", http.StatusOK},
-		{"/manifest.json", http.StatusOK},
+		{"/", false},
+		{"/static/css/main.777f3b60.css", false},
+		{"/manifest.json", false},
+		{"/events", true},
+		{"/entities/foo", true},
+		{"/graphql", true},
+		{"/rbac/users", true},
+		{"/checktemplates", true},
+		{"/entitygroups/foo", true},
+		{"/incidents", true},
+		{"/pipelines", true},
+		{"/rolebindings", true},
+		{"/tombstones", true},
 	}
 
 	for _, tc := range testCases {
 		t.Run(fmt.Sprintf("path %s", tc.path), func(t *testing.T) {
-			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			req, _ := http.NewRequest(http.MethodGet, tc.path, nil)
 			res := httptest.NewRecorder()
 			router.ServeHTTP(res, req)
 
-			assert.Equal(http.StatusOK, res.Code)
-			assert.NotEmpty(res.Body)
+			assert.Equal(t, http.StatusOK, res.Code)
+			if tc.proxied {
+				assert.Equal(t, "api "+tc.path, res.Body.String())
+			} else {
+				assert.NotEmpty(t, res.Body.String())
+				assert.NotContains(t, res.Body.String(), "api ")
+			}
 		})
 	}
 }
+
+func TestDashboardRouterInvalidAPIURL(t *testing.T) {
+	dashboard := Dashboardd{Config: Config{APIURL: "http://[::1"}}
+	_, err := httpRouter(&dashboard)
+	assert.Error(t, err)
+}
@@ -0,0 +1,113 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/sensu/sensu-go/agent"
+	"github.com/sensu/sensu-go/backend/seeds"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// devSubscription is the subscription of the embedded agent of the development
+// mode, which the demo check is published to.
+const devSubscription = "dev"
+
+// seedDevResources creates the demo resources of the development mode, so that
+// the events of the embedded agent flow through the whole pipeline: a check
+// executed by the agent every 30 seconds, and a handler appending the events
+// to a file of the state directory.
+func seedDevResources(st store.Store, stateDir string) error {
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, "default")
+	ctx = context.WithValue(ctx, types.EnvironmentKey, "default")
+
+	handler := &types.Handler{
+		Name:         "dev-events",
+		Type:         types.HandlerPipeType,
+		Command:      fmt.Sprintf("cat >> %s", filepath.Join(stateDir, "dev-events.log")),
+		Organization: "default",
+		Environment:  "default",
+	}
+	if err := st.UpdateHandler(ctx, handler); err != nil {
+		return fmt.Errorf("could not create the demo handler: %s", err)
+	}
+
+	check := &types.CheckConfig{
+		Name:          "dev-hello",
+		Command:       "echo hello from the sensu development mode",
+		Interval:      30,
+		Subscriptions: []string{devSubscription},
+		Handlers:      []string{handler.Name},
+		Publish:       true,
+		Organization:  "default",
+		Environment:   "default",
+	}
+	if err := st.UpdateCheckConfig(ctx, check); err != nil {
+		return fmt.Errorf("could not create the demo check: %s", err)
+	}
+
+	return nil
+}
+
+// startDevAgent starts the agent embedded in the backend in the development
+// mode, connected to agentd over the loopback interface.
+func (b *Backend) startDevAgent() (*agent.Agent, error) {
+	credentials := seeds.DefaultConfig()
+
+	cfg := agent.NewConfig()
+	cfg.BackendURLs = []string{b.agentURL()}
+	cfg.CacheDir = filepath.Join(b.Config.StateDir, "agent")
+	cfg.Subscriptions = []string{devSubscription}
+	cfg.User = credentials.AgentUsername
+	cfg.Password = credentials.AgentPassword
+	if b.agentTLS() != nil {
+		// The certificate of agentd isn't issued for the loopback address
+		cfg.TLS = &types.TLSOptions{InsecureSkipVerify: true}
+	}
+
+	devAgent := agent.NewAgent(cfg)
+	if err := devAgent.Run(); err != nil {
+		return nil, fmt.Errorf("could not start the embedded agent: %s", err)
+	}
+	return devAgent, nil
+}
+
+// agentURL returns the URL of agentd reached over the loopback interface.
+func (b *Backend) agentURL() string {
+	scheme := "ws"
+	if b.agentTLS() != nil {
+		scheme = "wss"
+	}
+
+	// agentd listens on every interface when its host is unspecified
+	host := b.Config.AgentHost
+	switch host {
+	case "", "[::]", "::", "0.0.0.0":
+		host = "127.0.0.1"
+	}
+
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(strings.Trim(host, "[]"), strconv.Itoa(b.Config.AgentPort)))
+}
+
+// devHost returns the host the given listener, the API or the dashboard which
+// proxies the API, listens on in the development mode. Since the API requests
+// without credentials are made as the admin user, they only listen on the
+// loopback interface, which an unspecified host defaults to, and any other
+// host is refused.
+func devHost(listener, host string) (string, error) {
+	switch host {
+	case "", "[::]", "::", "0.0.0.0":
+		return "127.0.0.1", nil
+	case "localhost":
+		return host, nil
+	}
+	if ip := net.ParseIP(strings.Trim(host, "[]")); ip == nil || !ip.IsLoopback() {
+		return "", fmt.Errorf("the %s can't listen on %s in the development mode, only on a loopback address", listener, host)
+	}
+	return host, nil
+}
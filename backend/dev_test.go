@@ -0,0 +1,67 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/seeds"
+	"github.com/sensu/sensu-go/backend/store/memory"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeedDevResources(t *testing.T) {
+	st := memory.NewStore()
+	require.NoError(t, seeds.SeedInitialData(st))
+	require.NoError(t, seedDevResources(st, t.Name()))
+
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, "default")
+	ctx = context.WithValue(ctx, types.EnvironmentKey, "default")
+
+	// The demo check is executed by the embedded agent
+	check, err := st.GetCheckConfigByName(ctx, "dev-hello")
+	require.NoError(t, err)
+	require.NotNil(t, check)
+	assert.Equal(t, []string{devSubscription}, check.Subscriptions)
+
+	handler, err := st.GetHandlerByName(ctx, check.Handlers[0])
+	require.NoError(t, err)
+	assert.NotNil(t, handler)
+}
+
+func TestAgentURL(t *testing.T) {
+	b := &Backend{Config: &Config{AgentHost: "[::]", AgentPort: 8081}}
+	assert.Equal(t, "ws://127.0.0.1:8081", b.agentURL())
+
+	b.Config.AgentHost = "10.0.0.1"
+	b.Config.TLS = &types.TLSOptions{CertFile: "cert.pem", KeyFile: "key.pem"}
+	assert.Equal(t, "wss://10.0.0.1:8081", b.agentURL())
+}
+
+func TestAnonymousUser(t *testing.T) {
+	b := &Backend{Config: &Config{}}
+	assert.Empty(t, b.anonymousUser())
+
+	b.Config.Dev = true
+	assert.Equal(t, "admin", b.anonymousUser())
+}
+
+func TestDevHost(t *testing.T) {
+	for _, host := range []string{"", "[::]", "0.0.0.0"} {
+		got, err := devHost("API", host)
+		require.NoError(t, err)
+		assert.Equal(t, "127.0.0.1", got)
+	}
+
+	for _, host := range []string{"localhost", "127.0.0.1", "[::1]"} {
+		got, err := devHost("API", host)
+		require.NoError(t, err)
+		assert.Equal(t, host, got)
+	}
+
+	for _, host := range []string{"10.0.0.1", "[2001:db8::1]", "example.com"} {
+		_, err := devHost("API", host)
+		assert.Error(t, err, host)
+	}
+}
@@ -0,0 +1,177 @@
+// Package encryption provides the envelope encryption of the values stored by
+// the backend. The values are encrypted with a data key, which is itself
+// encrypted, or wrapped, by a key encryption key held by a KeyProvider, so
+// the key encryption key never has to be stored alongside the values.
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Prefix is the prefix of the encrypted values, which distinguishes them from
+// the values stored in plain text.
+const Prefix = "sensu:encrypted:v1:"
+
+// dataKeySize is the size of the data keys, for AES-256
+const dataKeySize = 32
+
+// A KeyProvider wraps and unwraps the data keys with its key encryption key.
+type KeyProvider interface {
+	// WrapKey encrypts a data key
+	WrapKey(key []byte) ([]byte, error)
+
+	// UnwrapKey decrypts a data key wrapped by WrapKey
+	UnwrapKey(wrapped []byte) ([]byte, error)
+}
+
+// An Encrypter encrypts values with a data key generated when it is created,
+// and decrypts the values encrypted with any data key wrapped by its provider.
+// An encrypted value holds its wrapped data key, so the data keys can be
+// rotated by restarting the backend, and the key encryption key by rewrapping
+// the data keys with the provider.
+type Encrypter struct {
+	provider KeyProvider
+	aead     cipher.AEAD
+	wrapped  []byte
+
+	// keys are the data keys unwrapped by the provider, by wrapped key
+	keys  map[string]cipher.AEAD
+	mutex sync.RWMutex
+}
+
+// New returns an Encrypter with a new data key wrapped by the provider.
+func New(provider KeyProvider) (*Encrypter, error) {
+	key := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapped, err := provider.WrapKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("could not wrap the data key: %s", err)
+	}
+	if len(wrapped) > 0xffff {
+		return nil, errors.New("the wrapped data key is too large")
+	}
+
+	return &Encrypter{
+		provider: provider,
+		aead:     aead,
+		wrapped:  wrapped,
+		keys:     map[string]cipher.AEAD{string(wrapped): aead},
+	}, nil
+}
+
+// IsEncrypted returns true if the value was encrypted by an Encrypter.
+func IsEncrypted(value []byte) bool {
+	return bytes.HasPrefix(value, []byte(Prefix))
+}
+
+// Encrypt encrypts the value. The result is the Prefix followed by the base64
+// encoding of the length of the wrapped data key, the wrapped data key, the
+// nonce and the sealed value, so it can be stored in a string.
+func (e *Encrypter) Encrypt(value []byte) ([]byte, error) {
+	nonce := make([]byte, e.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	envelope := make([]byte, 2, 2+len(e.wrapped)+len(nonce)+len(value)+e.aead.Overhead())
+	binary.BigEndian.PutUint16(envelope, uint16(len(e.wrapped)))
+	envelope = append(envelope, e.wrapped...)
+	envelope = append(envelope, nonce...)
+	envelope = e.aead.Seal(envelope, nonce, value, nil)
+
+	result := make([]byte, len(Prefix)+base64.StdEncoding.EncodedLen(len(envelope)))
+	copy(result, Prefix)
+	base64.StdEncoding.Encode(result[len(Prefix):], envelope)
+	return result, nil
+}
+
+// Decrypt decrypts a value encrypted by Encrypt. Values which are not
+// encrypted are returned as is.
+func (e *Encrypter) Decrypt(value []byte) ([]byte, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	envelope := make([]byte, base64.StdEncoding.DecodedLen(len(value)-len(Prefix)))
+	n, err := base64.StdEncoding.Decode(envelope, value[len(Prefix):])
+	if err != nil {
+		return nil, fmt.Errorf("invalid encrypted value: %s", err)
+	}
+	envelope = envelope[:n]
+
+	if len(envelope) < 2 {
+		return nil, errors.New("invalid encrypted value: truncated")
+	}
+	keyLen := int(binary.BigEndian.Uint16(envelope))
+	envelope = envelope[2:]
+	if len(envelope) < keyLen {
+		return nil, errors.New("invalid encrypted value: truncated")
+	}
+	wrapped, envelope := envelope[:keyLen], envelope[keyLen:]
+
+	aead, err := e.dataKey(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	if len(envelope) < aead.NonceSize() {
+		return nil, errors.New("invalid encrypted value: truncated")
+	}
+	nonce, sealed := envelope[:aead.NonceSize()], envelope[aead.NonceSize():]
+
+	result, err := aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt the value: %s", err)
+	}
+	return result, nil
+}
+
+// dataKey returns the cipher of a wrapped data key, unwrapping it with the
+// provider the first time it is used.
+func (e *Encrypter) dataKey(wrapped []byte) (cipher.AEAD, error) {
+	e.mutex.RLock()
+	aead, ok := e.keys[string(wrapped)]
+	e.mutex.RUnlock()
+	if ok {
+		return aead, nil
+	}
+
+	key, err := e.provider.UnwrapKey(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("could not unwrap the data key: %s", err)
+	}
+	aead, err = newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mutex.Lock()
+	e.keys[string(wrapped)] = aead
+	e.mutex.Unlock()
+	return aead, nil
+}
+
+// newAEAD returns the AES-GCM cipher of the key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,125 @@
+package encryption
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFileKeyProvider(t *testing.T, content []byte) *FileKeyProvider {
+	dir, err := ioutil.TempDir("", "encryption")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "key")
+	require.NoError(t, ioutil.WriteFile(path, content, 0600))
+	provider, err := NewFileKeyProvider(path)
+	require.NoError(t, err)
+	return provider
+}
+
+func TestEncrypter(t *testing.T) {
+	key := []byte(strings.Repeat("k", 32))
+	provider := newFileKeyProvider(t, key)
+
+	encrypter, err := New(provider)
+	require.NoError(t, err)
+
+	encrypted, err := encrypter.Encrypt([]byte("CRITICAL: disk full"))
+	require.NoError(t, err)
+	assert.True(t, IsEncrypted(encrypted))
+	assert.NotContains(t, string(encrypted), "disk full")
+
+	decrypted, err := encrypter.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "CRITICAL: disk full", string(decrypted))
+
+	// The values in plain text are returned as is
+	decrypted, err = encrypter.Decrypt([]byte("OK"))
+	require.NoError(t, err)
+	assert.Equal(t, "OK", string(decrypted))
+
+	// Another encrypter, with its own data key, decrypts the value with the
+	// same key encryption key, given as base64
+	other, err := New(newFileKeyProvider(t, []byte(base64.StdEncoding.EncodeToString(key)+"\n")))
+	require.NoError(t, err)
+	decrypted, err = other.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "CRITICAL: disk full", string(decrypted))
+
+	// But not with another key encryption key
+	other, err = New(newFileKeyProvider(t, []byte(strings.Repeat("x", 32))))
+	require.NoError(t, err)
+	_, err = other.Decrypt(encrypted)
+	assert.Error(t, err)
+
+	// Tampered values are rejected
+	tampered := append([]byte{}, encrypted...)
+	tampered[len(tampered)-5]++
+	_, err = encrypter.Decrypt(tampered)
+	assert.Error(t, err)
+}
+
+func TestFileKeyProviderInvalidKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "encryption")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "key")
+	require.NoError(t, ioutil.WriteFile(path, []byte("short"), 0600))
+	_, err = NewFileKeyProvider(path)
+	assert.Error(t, err)
+}
+
+func TestVaultKeyProvider(t *testing.T) {
+	// The test server "encrypts" the keys by encoding them
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "s.token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+
+		var req vaultRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		var resp vaultResponse
+		switch r.URL.Path {
+		case "/v1/transit/encrypt/sensu":
+			resp.Data.Ciphertext = "vault:v1:" + req.Plaintext
+		case "/v1/transit/decrypt/sensu":
+			resp.Data.Plaintext = strings.TrimPrefix(req.Ciphertext, "vault:v1:")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	provider := NewVaultKeyProvider(server.URL+"/", "/transit/", "sensu", "s.token")
+	encrypter, err := New(provider)
+	require.NoError(t, err)
+	assert.Contains(t, string(encrypter.wrapped), "vault:v1:")
+
+	encrypted, err := encrypter.Encrypt([]byte("output"))
+	require.NoError(t, err)
+
+	// A new encrypter unwraps the data key with Vault
+	other, err := New(provider)
+	require.NoError(t, err)
+	decrypted, err := other.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "output", string(decrypted))
+
+	provider.Token = "invalid"
+	_, err = New(provider)
+	assert.EqualError(t, err, "could not wrap the data key: transit encrypt failed with status 403 Forbidden: permission denied")
+}
@@ -0,0 +1,59 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// FileKeyProvider wraps the data keys with a key encryption key read from a
+// file, using AES-256-GCM.
+type FileKeyProvider struct {
+	aead cipher.AEAD
+}
+
+// NewFileKeyProvider returns a provider using the key encryption key of the
+// file at path, which holds either 32 raw bytes or their base64 encoding.
+func NewFileKeyProvider(path string) (*FileKeyProvider, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key := content
+	if len(key) != dataKeySize {
+		key, err = base64.StdEncoding.DecodeString(string(bytes.TrimSpace(content)))
+		if err != nil || len(key) != dataKeySize {
+			return nil, fmt.Errorf("the key file %s must hold a 32 bytes key, raw or base64 encoded", path)
+		}
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &FileKeyProvider{aead: aead}, nil
+}
+
+// WrapKey implements KeyProvider
+func (p *FileKeyProvider) WrapKey(key []byte) ([]byte, error) {
+	nonce := make([]byte, p.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return p.aead.Seal(nonce, nonce, key, nil), nil
+}
+
+// UnwrapKey implements KeyProvider
+func (p *FileKeyProvider) UnwrapKey(wrapped []byte) ([]byte, error) {
+	if len(wrapped) < p.aead.NonceSize() {
+		return nil, errors.New("invalid wrapped key")
+	}
+	nonce, sealed := wrapped[:p.aead.NonceSize()], wrapped[p.aead.NonceSize():]
+	return p.aead.Open(nil, nonce, sealed, nil)
+}
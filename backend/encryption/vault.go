@@ -0,0 +1,105 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultVaultTimeout is the default timeout of the requests to Vault
+const DefaultVaultTimeout = 5 * time.Second
+
+// VaultKeyProvider wraps the data keys with a key of the transit secrets
+// engine of a Vault server, so the key encryption key never leaves Vault.
+type VaultKeyProvider struct {
+	// Address is the address of the Vault server, e.g. https://vault:8200
+	Address string
+
+	// Mount is the path where the transit secrets engine is mounted
+	Mount string
+
+	// Key is the name of the transit key
+	Key string
+
+	// Token is the Vault token, which must allow the encrypt and decrypt
+	// operations of the key
+	Token string
+
+	client *http.Client
+}
+
+// NewVaultKeyProvider returns a provider using the transit key mounted at
+// mount, e.g. transit, of the Vault server at address.
+func NewVaultKeyProvider(address, mount, key, token string) *VaultKeyProvider {
+	return &VaultKeyProvider{
+		Address: strings.TrimSuffix(address, "/"),
+		Mount:   strings.Trim(mount, "/"),
+		Key:     key,
+		Token:   token,
+		client:  &http.Client{Timeout: DefaultVaultTimeout},
+	}
+}
+
+type vaultRequest struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+type vaultResponse struct {
+	Data   vaultRequest `json:"data"`
+	Errors []string     `json:"errors"`
+}
+
+// WrapKey implements KeyProvider
+func (p *VaultKeyProvider) WrapKey(key []byte) ([]byte, error) {
+	resp, err := p.do("encrypt", vaultRequest{Plaintext: base64.StdEncoding.EncodeToString(key)})
+	if err != nil {
+		return nil, err
+	}
+	return []byte(resp.Ciphertext), nil
+}
+
+// UnwrapKey implements KeyProvider
+func (p *VaultKeyProvider) UnwrapKey(wrapped []byte) ([]byte, error) {
+	resp, err := p.do("decrypt", vaultRequest{Ciphertext: string(wrapped)})
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Plaintext)
+}
+
+// do performs an operation of the transit key.
+func (p *VaultKeyProvider) do(operation string, body vaultRequest) (*vaultRequest, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("%s/v1/%s/%s/%s", p.Address, p.Mount, operation, url.PathEscape(p.Key))
+	req, err := http.NewRequest(http.MethodPost, u, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", p.Token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result vaultResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("invalid Vault response: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("transit %s failed with status %s: %s", operation, resp.Status, strings.Join(result.Errors, ", "))
+	}
+	return &result.Data, nil
+}
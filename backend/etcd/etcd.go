@@ -272,6 +272,7 @@ func (e *Etcd) Healthy() bool {
 	if err != nil {
 		return false
 	}
+	defer func() { _ = client.Close() }()
 	mapi := clientv3.NewMaintenance(client)
 	// TODO(greg): what can we do with the response? are there some operational
 	// parameters that are useful?
@@ -0,0 +1,146 @@
+package eventd
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sensu/sensu-go/types"
+	utilstrings "github.com/sensu/sensu-go/util/strings"
+)
+
+// minAnomalySamples is the number of samples of a metric required before its
+// points are scored, unless the anomaly window of the check is shorter.
+const minAnomalySamples = 10
+
+// detectAnomalies updates the rolling statistics of the metric points of the
+// event, starting from the baselines of the previous event of its check, and
+// annotates the event with the points which deviate from them beyond the
+// anomaly threshold of the check. The points are scored against the
+// statistics preceding them.
+func detectAnomalies(event *types.Event, baselines []types.MetricBaseline) {
+	if event.Check.AnomalyDetection == "" {
+		event.Check.Baselines = nil
+		return
+	}
+
+	// The baselines are kept as is until the check reports metrics again
+	event.Check.Baselines = baselines
+	if !event.HasMetrics() || len(event.Metrics.Points) == 0 {
+		return
+	}
+
+	window := anomalyWindow(event.Check)
+	threshold := event.Check.AnomalyThreshold
+	if threshold == 0 {
+		threshold = types.DefaultAnomalyThreshold
+	}
+	minSamples := uint32(minAnomalySamples)
+	if window < minSamples {
+		minSamples = window
+	}
+
+	stats := make(map[string]types.MetricBaseline, len(baselines))
+	for _, baseline := range baselines {
+		stats[baseline.Key] = baseline
+	}
+
+	var anomalies []string
+	var maxScore float64
+	for _, point := range event.Metrics.Points {
+		if point == nil || math.IsNaN(point.Value) || math.IsInf(point.Value, 0) {
+			continue
+		}
+
+		key := metricKey(point)
+		baseline := stats[key]
+		baseline.Key = key
+
+		if baseline.Samples >= minSamples {
+			if score := zScore(baseline, point.Value); score > threshold {
+				if !utilstrings.InArray(key, anomalies) {
+					anomalies = append(anomalies, key)
+				}
+				maxScore = math.Max(maxScore, score)
+			}
+		}
+
+		stats[key] = updateBaseline(baseline, point.Value, event.Check.AnomalyDetection, window)
+	}
+
+	event.Check.Baselines = make([]types.MetricBaseline, 0, len(stats))
+	for _, baseline := range stats {
+		event.Check.Baselines = append(event.Check.Baselines, baseline)
+	}
+	sort.Slice(event.Check.Baselines, func(i, j int) bool {
+		return event.Check.Baselines[i].Key < event.Check.Baselines[j].Key
+	})
+
+	if len(anomalies) == 0 {
+		return
+	}
+
+	if event.Annotations == nil {
+		event.Annotations = make(map[string]string)
+	}
+	event.Annotations[types.AnomalyAnnotation] = "true"
+	event.Annotations[types.AnomalyMetricsAnnotation] = strings.Join(anomalies, ",")
+	event.Annotations[types.AnomalyScoreAnnotation] = strconv.FormatFloat(maxScore, 'f', 2, 64)
+}
+
+// anomalyWindow returns the number of samples the rolling statistics of the
+// metric points of the check are computed over.
+func anomalyWindow(check *types.Check) uint32 {
+	if check.AnomalyWindow == 0 {
+		return types.DefaultAnomalyWindow
+	}
+	return check.AnomalyWindow
+}
+
+// metricKey identifies the metric of a point by its name, followed by its
+// tags sorted by name, e.g. cpu.usage;core=0;host=web.
+func metricKey(point *types.MetricPoint) string {
+	tags := make([]string, 0, len(point.Tags))
+	for _, tag := range point.Tags {
+		if tag != nil {
+			tags = append(tags, tag.Name+"="+tag.Value)
+		}
+	}
+	sort.Strings(tags)
+	return strings.Join(append([]string{point.Name}, tags...), ";")
+}
+
+// zScore returns the number of standard deviations between value and the
+// mean of the baseline. A metric which never varied has no deviation to
+// compare against, so none of its values is scored.
+func zScore(baseline types.MetricBaseline, value float64) float64 {
+	if baseline.Variance <= 0 {
+		return 0
+	}
+	return math.Abs(value-baseline.Mean) / math.Sqrt(baseline.Variance)
+}
+
+// updateBaseline adds value to the rolling mean and variance of the baseline.
+// With the zscore detection, every sample weighs the same until the window is
+// full, after which the oldest samples gradually fade out, while with the
+// ewma detection the weight of the samples decays exponentially from the
+// start.
+func updateBaseline(baseline types.MetricBaseline, value float64, detection string, window uint32) types.MetricBaseline {
+	if baseline.Samples < window {
+		baseline.Samples++
+	}
+
+	var alpha float64
+	if detection == types.AnomalyDetectionEWMA && baseline.Samples > 1 {
+		alpha = 2 / (float64(window) + 1)
+	} else {
+		alpha = 1 / float64(baseline.Samples)
+	}
+
+	diff := value - baseline.Mean
+	incr := alpha * diff
+	baseline.Mean += incr
+	baseline.Variance = (1 - alpha) * (baseline.Variance + diff*incr)
+	return baseline
+}
@@ -0,0 +1,168 @@
+package eventd
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// anomalyEvent returns an event of a check detecting anomalies with the given
+// algorithm, carrying a single point of the cpu metric.
+func anomalyEvent(detection string, value float64) *types.Event {
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.AnomalyDetection = detection
+	event.Metrics = &types.Metrics{
+		Points: []*types.MetricPoint{
+			{
+				Name:  "cpu",
+				Value: value,
+				Tags: []*types.MetricTag{
+					{Name: "host", Value: "web"},
+					{Name: "core", Value: "0"},
+				},
+			},
+		},
+	}
+	return event
+}
+
+// feedAnomalies runs the anomaly detection over events with the given values,
+// each starting from the baselines of the previous one, and returns the last
+// event.
+func feedAnomalies(detection string, values ...float64) *types.Event {
+	var event *types.Event
+	var baselines []types.MetricBaseline
+	for _, value := range values {
+		event = anomalyEvent(detection, value)
+		detectAnomalies(event, baselines)
+		baselines = event.Check.Baselines
+	}
+	return event
+}
+
+func TestMetricKey(t *testing.T) {
+	point := &types.MetricPoint{
+		Name: "cpu",
+		Tags: []*types.MetricTag{
+			{Name: "host", Value: "web"},
+			nil,
+			{Name: "core", Value: "0"},
+		},
+	}
+	assert.Equal(t, "cpu;core=0;host=web", metricKey(point))
+	assert.Equal(t, "mem", metricKey(&types.MetricPoint{Name: "mem"}))
+}
+
+func TestUpdateBaseline(t *testing.T) {
+	var baseline types.MetricBaseline
+	for _, value := range []float64{2, 4, 4, 4, 5, 5, 7, 9} {
+		baseline = updateBaseline(baseline, value, types.AnomalyDetectionZScore, 30)
+	}
+
+	// Until the window is full, the statistics are the ones of all samples
+	assert.Equal(t, uint32(8), baseline.Samples)
+	assert.InDelta(t, 5, baseline.Mean, 1e-9)
+	assert.InDelta(t, 4, baseline.Variance, 1e-9)
+
+	// The number of samples is capped by the window
+	for i := 0; i < 50; i++ {
+		baseline = updateBaseline(baseline, 5, types.AnomalyDetectionEWMA, 30)
+	}
+	assert.Equal(t, uint32(30), baseline.Samples)
+	assert.InDelta(t, 5, baseline.Mean, 1e-3)
+}
+
+func TestDetectAnomalies(t *testing.T) {
+	steady := []float64{10, 11, 9, 10, 12, 8, 10, 11, 9, 10, 11, 9}
+
+	testCases := []struct {
+		name      string
+		detection string
+		values    []float64
+		anomalous bool
+	}{
+		{
+			name:      "steady metric",
+			detection: types.AnomalyDetectionZScore,
+			values:    append(steady, 11),
+			anomalous: false,
+		},
+		{
+			name:      "spike with zscore",
+			detection: types.AnomalyDetectionZScore,
+			values:    append(steady, 30),
+			anomalous: true,
+		},
+		{
+			name:      "spike with ewma",
+			detection: types.AnomalyDetectionEWMA,
+			values:    append(steady, 30),
+			anomalous: true,
+		},
+		{
+			name:      "too few samples",
+			detection: types.AnomalyDetectionZScore,
+			values:    []float64{10, 11, 9, 30},
+			anomalous: false,
+		},
+		{
+			name:      "detection disabled",
+			detection: "",
+			values:    append(steady, 30),
+			anomalous: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Copy the values, the cases share the backing array of steady
+			values := append([]float64{}, tc.values...)
+			event := feedAnomalies(tc.detection, values...)
+			assert.Equal(t, tc.anomalous, event.IsAnomalous())
+			if tc.anomalous {
+				assert.Equal(t, "cpu;core=0;host=web", event.Annotations[types.AnomalyMetricsAnnotation])
+				assert.NotEmpty(t, event.Annotations[types.AnomalyScoreAnnotation])
+			}
+			if tc.detection == "" {
+				assert.Empty(t, event.Check.Baselines)
+			} else {
+				require.Len(t, event.Check.Baselines, 1)
+				assert.Equal(t, uint32(len(values)), event.Check.Baselines[0].Samples)
+			}
+		})
+	}
+}
+
+func TestDetectAnomaliesThreshold(t *testing.T) {
+	var baselines []types.MetricBaseline
+	for _, value := range []float64{10, 11, 9, 10, 12, 8, 10, 11, 9, 10, 11, 9} {
+		event := anomalyEvent(types.AnomalyDetectionZScore, value)
+		detectAnomalies(event, baselines)
+		baselines = event.Check.Baselines
+	}
+
+	// A point 3 standard deviations away is anomalous with a lower threshold
+	deviation := math.Sqrt(baselines[0].Variance)
+	event := anomalyEvent(types.AnomalyDetectionZScore, baselines[0].Mean+3*deviation)
+	detectAnomalies(event, baselines)
+	assert.False(t, event.IsAnomalous())
+
+	event = anomalyEvent(types.AnomalyDetectionZScore, baselines[0].Mean+3*deviation)
+	event.Check.AnomalyThreshold = 2
+	detectAnomalies(event, baselines)
+	assert.True(t, event.IsAnomalous())
+	assert.Equal(t, "3.00", event.Annotations[types.AnomalyScoreAnnotation])
+}
+
+func TestDetectAnomaliesWithoutMetrics(t *testing.T) {
+	baselines := []types.MetricBaseline{{Key: "cpu", Mean: 10, Variance: 1, Samples: 20}}
+
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.AnomalyDetection = types.AnomalyDetectionZScore
+	detectAnomalies(event, baselines)
+	assert.False(t, event.IsAnomalous())
+	assert.Equal(t, baselines, event.Check.Baselines)
+}
@@ -0,0 +1,114 @@
+package eventd
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// DefaultClockSkewThreshold is the default clock skew between the agents
+	// and the backend above which a warning event is emitted
+	DefaultClockSkewThreshold = 30 * time.Second
+
+	// ClockSkewCheckName is the name of the check of the events warning about
+	// the clock skew of an agent
+	ClockSkewCheckName = "clock_skew"
+
+	// ClockSkewHandlerName is the name of the handler of the events warning
+	// about the clock skew of an agent
+	ClockSkewHandlerName = "clock_skew"
+)
+
+// recordProcessed stamps the time the event is processed and, if it was sent
+// by an agent, the clock skew between the backend and the agent.
+func recordProcessed(event *types.Event, now time.Time) {
+	if event.Timestamps == nil {
+		event.Timestamps = &types.EventTimestamps{}
+	}
+	event.Timestamps.Processed = types.UnixMilli(now)
+	if event.Timestamps.Sent > 0 {
+		event.ClockSkew = event.Timestamps.Processed - event.Timestamps.Sent
+	}
+}
+
+// handleClockSkew emits a warning event for the agent entity of the event
+// when its clock skew exceeds the threshold, and resolves it once the skew
+// is back under the threshold.
+func (e *Eventd) handleClockSkew(ctx context.Context, event *types.Event) error {
+	if event.Timestamps == nil || event.Timestamps.Sent == 0 || event.Entity.Class != types.EntityAgentClass {
+		return nil
+	}
+
+	skew := time.Duration(event.ClockSkew) * time.Millisecond
+	skewed := skew > e.ClockSkewThreshold || skew < -e.ClockSkewThreshold
+	key := path.Join(event.Entity.Organization, event.Entity.Environment, event.Entity.ID)
+
+	e.mu.Lock()
+	changed := e.skewed[key] != skewed
+	if skewed {
+		e.skewed[key] = true
+	} else {
+		delete(e.skewed, key)
+	}
+	e.mu.Unlock()
+
+	if !changed {
+		return nil
+	}
+
+	skewEvent := newClockSkewEvent(event, skew, skewed)
+	if skewed {
+		logger.WithFields(logrus.Fields{
+			"entity":     event.Entity.ID,
+			"clock_skew": skew.String(),
+		}).Warn("the clock of the agent is skewed")
+	}
+
+	if err := e.Store.UpdateEvent(ctx, skewEvent); err != nil {
+		return err
+	}
+	return e.MessageBus.Publish(messaging.TopicEvent, skewEvent)
+}
+
+// newClockSkewEvent returns the event warning about the clock skew of the
+// agent entity of event, or resolving it.
+func newClockSkewEvent(event *types.Event, skew time.Duration, skewed bool) *types.Event {
+	interval := event.Check.Interval
+	if interval == 0 {
+		interval = 60
+	}
+
+	now := time.Now()
+	check := &types.Check{
+		Name:         ClockSkewCheckName,
+		Interval:     interval,
+		Handlers:     []string{ClockSkewHandlerName},
+		Environment:  event.Entity.Environment,
+		Organization: event.Entity.Organization,
+		Executed:     now.Unix(),
+	}
+
+	direction := "behind"
+	if skew < 0 {
+		direction = "ahead of"
+		skew = -skew
+	}
+	check.Output = fmt.Sprintf("The clock of the agent is %s %s the backend", skew, direction)
+	if skewed {
+		check.Status = 1
+	}
+
+	return &types.Event{
+		Timestamp:  now.Unix(),
+		Entity:     event.Entity,
+		Check:      check,
+		Timestamps: &types.EventTimestamps{Processed: types.UnixMilli(now)},
+		ClockSkew:  event.ClockSkew,
+	}
+}
@@ -0,0 +1,77 @@
+package eventd
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordProcessed(t *testing.T) {
+	now := time.Now()
+
+	// Events which weren't sent by an agent have no clock skew
+	event := types.FixtureEvent("entity", "check")
+	recordProcessed(event, now)
+	require.NotNil(t, event.Timestamps)
+	assert.Equal(t, types.UnixMilli(now), event.Timestamps.Processed)
+	assert.Equal(t, int64(0), event.ClockSkew)
+
+	event.Timestamps.Sent = types.UnixMilli(now.Add(1500 * time.Millisecond))
+	recordProcessed(event, now)
+	assert.Equal(t, int64(-1500), event.ClockSkew)
+}
+
+func TestHandleClockSkew(t *testing.T) {
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	events := make(chan interface{}, 10)
+	require.NoError(t, bus.Subscribe(messaging.TopicEvent, "test", events))
+
+	mockStore := &mockstore.MockStore{}
+	mockStore.On("UpdateEvent", mock.AnythingOfType("*types.Event")).Return(nil)
+	e := &Eventd{
+		Store:              mockStore,
+		MessageBus:         bus,
+		ClockSkewThreshold: time.Minute,
+		skewed:             map[string]bool{},
+	}
+	e.mu = new(sync.Mutex)
+
+	event := types.FixtureEvent("entity", "check")
+	event.Entity.Class = types.EntityAgentClass
+	event.Timestamps = &types.EventTimestamps{Sent: 1}
+	ctx := context.Background()
+
+	// The warning is emitted once, when the skew exceeds the threshold
+	event.ClockSkew = int64(2 * time.Minute / time.Millisecond)
+	require.NoError(t, e.handleClockSkew(ctx, event))
+	require.NoError(t, e.handleClockSkew(ctx, event))
+	require.Len(t, events, 1)
+	warning := (<-events).(*types.Event)
+	assert.Equal(t, ClockSkewCheckName, warning.Check.Name)
+	assert.Equal(t, int32(1), warning.Check.Status)
+	assert.Equal(t, "The clock of the agent is 2m0s behind the backend", warning.Check.Output)
+	require.NoError(t, warning.Validate())
+
+	// The warning is resolved once the skew is back under the threshold
+	event.ClockSkew = -1000
+	require.NoError(t, e.handleClockSkew(ctx, event))
+	require.Len(t, events, 1)
+	resolution := (<-events).(*types.Event)
+	assert.Equal(t, int32(0), resolution.Check.Status)
+	assert.Equal(t, "The clock of the agent is 1s ahead of the backend", resolution.Check.Output)
+
+	// Proxy entities are ignored
+	event.Entity.Class = types.EntityProxyClass
+	event.ClockSkew = int64(2 * time.Minute / time.Millisecond)
+	require.NoError(t, e.handleClockSkew(ctx, event))
+	assert.Len(t, events, 0)
+}
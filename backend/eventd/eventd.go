@@ -11,6 +11,7 @@ import (
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/monitor"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/usage"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -18,6 +19,17 @@ const (
 	// ComponentName identifies Eventd as the component/daemon implemented in this
 	// package.
 	ComponentName = "eventd"
+
+	// DefaultHandlerCount is the default number of workers handling events
+	DefaultHandlerCount = 10
+
+	// DefaultBufferSize is the default number of events queued for the
+	// workers before publishers are slowed down
+	DefaultBufferSize = 100
+
+	// DefaultDeduplicationWindow is the default duration during which the
+	// results of a check request published more than once are deduplicated
+	DefaultDeduplicationWindow = 5 * time.Minute
 )
 
 var (
@@ -31,11 +43,30 @@ type Eventd struct {
 	Store          store.Store
 	MessageBus     messaging.MessageBus
 	HandlerCount   int
+	BufferSize     int
 	MonitorFactory monitor.FactoryFunc
 
+	// Usage, when set, tracks the throughput of the events handled
+	Usage *usage.Tracker
+
+	// DeduplicationWindow is the duration during which the results of a
+	// check request published more than once, e.g. after the agent
+	// reconnected, are discarded
+	DeduplicationWindow time.Duration
+
+	// ClockSkewThreshold is the clock skew between an agent and the backend
+	// above which a warning event is emitted for the agent
+	ClockSkewThreshold time.Duration
+
+	// Namespaces caches the organizations and environments whose metadata
+	// is inherited by the events. They are fetched from the Store for every
+	// event if it is not set.
+	Namespaces *store.NamespaceCache
+
 	eventChan    chan interface{}
 	errChan      chan error
 	monitors     map[string]monitor.Interface
+	skewed       map[string]bool
 	mu           *sync.Mutex
 	shutdownChan chan struct{}
 	wg           *sync.WaitGroup
@@ -52,7 +83,19 @@ func (e *Eventd) Start() error {
 	}
 
 	if e.HandlerCount == 0 {
-		e.HandlerCount = 10
+		e.HandlerCount = DefaultHandlerCount
+	}
+
+	if e.BufferSize == 0 {
+		e.BufferSize = DefaultBufferSize
+	}
+
+	if e.DeduplicationWindow == 0 {
+		e.DeduplicationWindow = DefaultDeduplicationWindow
+	}
+
+	if e.ClockSkewThreshold == 0 {
+		e.ClockSkewThreshold = DefaultClockSkewThreshold
 	}
 
 	if e.MonitorFactory == nil {
@@ -63,8 +106,11 @@ func (e *Eventd) Start() error {
 
 	e.errChan = make(chan error, 1)
 	e.shutdownChan = make(chan struct{}, 1)
+	e.mu = &sync.Mutex{}
+	e.monitors = map[string]monitor.Interface{}
+	e.skewed = map[string]bool{}
 
-	ch := make(chan interface{}, 100)
+	ch := make(chan interface{}, e.BufferSize)
 	e.eventChan = ch
 
 	err := e.MessageBus.Subscribe(messaging.TopicEventRaw, ComponentName, ch)
@@ -76,11 +122,18 @@ func (e *Eventd) Start() error {
 	e.wg.Add(e.HandlerCount)
 	e.startHandlers()
 
-	e.mu = &sync.Mutex{}
-	e.monitors = map[string]monitor.Interface{}
 	return nil
 }
 
+// namespaces returns the cache of the organizations and environments, or an
+// unwatched one fetching them from the store if it is not set.
+func (e *Eventd) namespaces() *store.NamespaceCache {
+	if e.Namespaces == nil {
+		return store.NewNamespaceCache(e.Store)
+	}
+	return e.Namespaces
+}
+
 func (e *Eventd) startHandlers() {
 	for i := 0; i < e.HandlerCount; i++ {
 		go func() {
@@ -116,6 +169,7 @@ func (e *Eventd) startHandlers() {
 						return
 					}
 
+					queueLength.Set(float64(len(e.eventChan)))
 					if err := e.handleMessage(msg); err != nil {
 						logger.Errorf("eventd - error handling event: %s", err.Error())
 					}
@@ -140,6 +194,25 @@ func (e *Eventd) handleMessage(msg interface{}) error {
 	if err := event.Validate(); err != nil {
 		return err
 	}
+	recordProcessed(event, time.Now())
+
+	if e.Usage != nil {
+		e.Usage.Record(event.Entity.Organization, event.Entity.Environment, event.Check.Name, event.Size())
+
+		// The events of the keepalives and of the checks not executed by
+		// the agents, e.g. the metrics, have no duration
+		if event.Check.Duration > 0 {
+			e.Usage.RecordExecution(
+				event.Entity.Organization,
+				event.Entity.Environment,
+				usage.KindCheck,
+				event.Check.Name,
+				event.Check.CPUTime,
+				event.Check.Duration,
+				event.Check.MaxRSS,
+			)
+		}
+	}
 
 	ctx := context.WithValue(context.Background(), types.OrganizationKey, event.Entity.Organization)
 	ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
@@ -157,28 +230,63 @@ func (e *Eventd) handleMessage(msg interface{}) error {
 			return errors.New("invalid previous event")
 		}
 
+		if e.isDuplicate(event, prevEvent) {
+			logger.WithFields(logrus.Fields{
+				"entity":     event.Entity.ID,
+				"check":      event.Check.Name,
+				"request_id": event.Check.RequestID,
+			}).Debug("discarding duplicate check result")
+			return nil
+		}
+
 		event.Check.MergeWith(prevEvent.Check)
 	}
 
+	if err := e.handleClockSkew(ctx, event); err != nil {
+		logger.WithError(err).Error("error handling the clock skew of the agent")
+	}
+
 	// Calculate percent state change for this check's history
 	event.Check.TotalStateChange = totalStateChange(event)
 
 	// Determine the check's state
 	state(event)
 
+	// Flag the anomalous metric points, against the rolling statistics of
+	// the previous events of the check
+	var baselines []types.MetricBaseline
+	if prevEvent != nil {
+		baselines = prevEvent.Check.Baselines
+	}
+	detectAnomalies(event, baselines)
+
 	// Add any silenced subscriptions to the event
 	err = getSilenced(ctx, event, e.Store)
 	if err != nil {
 		return err
 	}
 
+	// Add the labels and annotations of the organization and environment
+	err = getInheritedMetadata(ctx, event, e.namespaces())
+	if err != nil {
+		return err
+	}
+
+	// Notify the contacts of the check along with the ones of the event
+	addCheckContacts(event)
+
+	// Evaluate the links of the check, which may refer to the inherited labels
+	if err := event.RenderLinks(); err != nil {
+		logger.WithError(err).WithField("check", event.Check.Name).Warn("could not render the links of the check")
+	}
+
 	// Handle expire on resolve silenced entries
 	err = handleExpireOnResolveEntries(ctx, event, e.Store)
 	if err != nil {
 		return err
 	}
 
-	err = e.Store.UpdateEvent(ctx, event)
+	err = e.updateEvent(ctx, event)
 	if err != nil {
 		return err
 	}
@@ -201,7 +309,7 @@ func (e *Eventd) handleMessage(msg interface{}) error {
 		return mon.HandleUpdate(event)
 	}
 
-	err = e.Store.UpdateEvent(ctx, event)
+	err = e.updateEvent(ctx, event)
 	if err != nil {
 		return err
 	}
@@ -209,12 +317,23 @@ func (e *Eventd) handleMessage(msg interface{}) error {
 	return e.MessageBus.Publish(messaging.TopicEvent, event)
 }
 
+// isDuplicate determines if the event is the result of the same check request
+// as the previous event, received within the deduplication window.
+func (e *Eventd) isDuplicate(event, prevEvent *types.Event) bool {
+	if event.Check.RequestID == "" || event.Check.RequestID != prevEvent.Check.RequestID {
+		return false
+	}
+
+	elapsed := time.Duration(event.Timestamp-prevEvent.Timestamp) * time.Second
+	return elapsed < e.DeduplicationWindow
+}
+
 // HandleUpdate updates the event in the store and publishes it to TopicEvent.
 func (e *Eventd) HandleUpdate(event *types.Event) error {
 	ctx := context.WithValue(context.Background(), types.OrganizationKey, event.Entity.Organization)
 	ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
 
-	err := e.Store.UpdateEvent(ctx, event)
+	err := e.updateEvent(ctx, event)
 	if err != nil {
 		return err
 	}
@@ -222,12 +341,39 @@ func (e *Eventd) HandleUpdate(event *types.Event) error {
 	return e.MessageBus.Publish(messaging.TopicEvent, event)
 }
 
+// updateEvent persists the event, keeping only the metric points allowed by
+// the metric retention policy of its check. The event itself, which is
+// published to the handlers, is left untouched.
+func (e *Eventd) updateEvent(ctx context.Context, event *types.Event) error {
+	if !event.HasMetrics() || event.Check == nil {
+		return e.Store.UpdateEvent(ctx, event)
+	}
+
+	retained := event.Metrics.Retain(event.Check.MetricRetention)
+	if retained == event.Metrics {
+		return e.Store.UpdateEvent(ctx, event)
+	}
+
+	stored := *event
+	stored.Metrics = retained
+	return e.Store.UpdateEvent(ctx, &stored)
+}
+
 // HandleFailure creates a check event with a warn status and publishes it to
-// TopicEvent.
+// TopicEvent, unless the check has been paused since the event.
 func (e *Eventd) HandleFailure(entity *types.Entity, event *types.Event) error {
 	ctx := context.WithValue(context.Background(), types.OrganizationKey, entity.Organization)
 	ctx = context.WithValue(ctx, types.EnvironmentKey, entity.Environment)
 
+	paused, err := e.isCheckPaused(ctx, event.Check.Name)
+	if err != nil {
+		return err
+	}
+	if paused {
+		logger.Debugf("check %s is not published, its ttl is not enforced", event.Check.Name)
+		return nil
+	}
+
 	failedCheckEvent, err := e.createFailedCheckEvent(ctx, event)
 	if err != nil {
 		return err
@@ -240,6 +386,17 @@ func (e *Eventd) HandleFailure(entity *types.Entity, event *types.Event) error {
 	return e.MessageBus.Publish(messaging.TopicEvent, failedCheckEvent)
 }
 
+// isCheckPaused determines if the check of the given name no longer publishes
+// check requests, in which case no result is expected. The standalone checks
+// are scheduled by the agents, regardless of their publish flag.
+func (e *Eventd) isCheckPaused(ctx context.Context, name string) (bool, error) {
+	check, err := e.Store.GetCheckConfigByName(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	return check != nil && !check.Publish && !check.Standalone, nil
+}
+
 func (e *Eventd) createFailedCheckEvent(ctx context.Context, event *types.Event) (*types.Event, error) {
 	lastCheckResult, err := e.Store.GetEventByEntityCheck(
 		ctx, event.Entity.ID, event.Check.Name,
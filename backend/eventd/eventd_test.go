@@ -1,12 +1,14 @@
 package eventd
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/monitor"
+	"github.com/sensu/sensu-go/backend/usage"
 	"github.com/sensu/sensu-go/testing/mockmonitor"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/types"
@@ -24,6 +26,7 @@ func TestEventHandling(t *testing.T) {
 		Store:        mockStore,
 		MessageBus:   bus,
 		HandlerCount: 5,
+		Usage:        usage.NewTracker(),
 	}
 
 	err := e.Start()
@@ -39,6 +42,9 @@ func TestEventHandling(t *testing.T) {
 	require.NoError(t, bus.Publish(messaging.TopicEventRaw, badEvent))
 
 	event := types.FixtureEvent("entity", "check")
+	event.Check.CPUTime = 0.5
+	event.Check.MaxRSS = 1024
+	event.Check.RunbookURL = "https://wiki/{{ .Entity.ID }}/{{ .Check.Name }}"
 
 	var nilEvent *types.Event
 	// no previous event.
@@ -59,6 +65,20 @@ func TestEventHandling(t *testing.T) {
 		"GetSilencedEntriesByCheckName",
 		mock.Anything,
 	).Return([]*types.Silenced{}, nil)
+	mockStore.On("GetEntityGroups", mock.Anything).Return([]*types.EntityGroup{}, nil)
+
+	// No inherited metadata
+	mockStore.On(
+		"GetOrganizationByName",
+		mock.Anything,
+		"default",
+	).Return(types.FixtureOrganization("default"), nil)
+	mockStore.On(
+		"GetEnvironment",
+		mock.Anything,
+		"default",
+		"default",
+	).Return(types.FixtureEnvironment("default"), nil)
 
 	require.NoError(t, bus.Publish(messaging.TopicEventRaw, event))
 
@@ -70,6 +90,22 @@ func TestEventHandling(t *testing.T) {
 	// Make sure the event has been marked with the proper state
 	assert.Equal(t, types.EventPassingState, event.Check.State)
 	assert.Equal(t, event.Timestamp, event.Check.LastOK)
+
+	// The links of the check are rendered with the event
+	assert.Equal(t, "https://wiki/entity/check", event.Check.RunbookURL)
+
+	// Only the valid event is accounted for
+	records := e.Usage.Usage()
+	require.Len(t, records, 1)
+	assert.Equal(t, "check", records[0].Check)
+	assert.Equal(t, uint64(1), records[0].Events)
+
+	// The resources used by the check execution are accounted for
+	resources := e.Usage.Resources()
+	require.Len(t, resources, 1)
+	assert.Equal(t, usage.KindCheck, resources[0].Kind)
+	assert.Equal(t, 0.5, resources[0].CPUTime)
+	assert.Equal(t, uint64(1024), resources[0].MaxRSS)
 }
 
 func TestEventMonitor(t *testing.T) {
@@ -116,6 +152,20 @@ func TestEventMonitor(t *testing.T) {
 		"GetSilencedEntriesByCheckName",
 		mock.Anything,
 	).Return([]*types.Silenced{}, nil)
+	mockStore.On("GetEntityGroups", mock.Anything).Return([]*types.EntityGroup{}, nil)
+
+	// No inherited metadata
+	mockStore.On(
+		"GetOrganizationByName",
+		mock.Anything,
+		"default",
+	).Return(types.FixtureOrganization("default"), nil)
+	mockStore.On(
+		"GetEnvironment",
+		mock.Anything,
+		"default",
+		"default",
+	).Return(types.FixtureEnvironment("default"), nil)
 
 	require.NoError(t, bus.Publish(messaging.TopicEventRaw, event))
 
@@ -126,3 +176,73 @@ func TestEventMonitor(t *testing.T) {
 	// Make sure the event has been marked with the proper state
 	assert.Equal(t, types.EventPassingState, event.Check.State)
 }
+
+func TestUpdateEventMetricRetention(t *testing.T) {
+	mockStore := &mockstore.MockStore{}
+	e := &Eventd{Store: mockStore}
+
+	event := types.FixtureEvent("entity", "check")
+	event.Metrics = types.FixtureMetrics()
+	event.Check.MetricRetention = types.MetricRetentionNone
+
+	mockStore.On("UpdateEvent", mock.MatchedBy(func(stored *types.Event) bool {
+		return stored != event && len(stored.Metrics.Points) == 0
+	})).Return(nil)
+
+	require.NoError(t, e.updateEvent(context.Background(), event))
+	mockStore.AssertExpectations(t)
+
+	// The handlers still receive the metric points
+	assert.Len(t, event.Metrics.Points, 1)
+}
+
+func TestEventDeduplication(t *testing.T) {
+	e := &Eventd{DeduplicationWindow: time.Minute}
+
+	prevEvent := types.FixtureEvent("entity", "check")
+	prevEvent.Check.RequestID = "abc"
+	event := types.FixtureEvent("entity", "check")
+	event.Timestamp = prevEvent.Timestamp + 10
+
+	// Results without a request ID are never duplicates
+	assert.False(t, e.isDuplicate(event, prevEvent))
+
+	// Results of a different request aren't duplicates
+	event.Check.RequestID = "def"
+	assert.False(t, e.isDuplicate(event, prevEvent))
+
+	// Results of the same request within the window are duplicates
+	event.Check.RequestID = "abc"
+	assert.True(t, e.isDuplicate(event, prevEvent))
+
+	// Unless the window has elapsed
+	event.Timestamp = prevEvent.Timestamp + 60
+	assert.False(t, e.isDuplicate(event, prevEvent))
+}
+
+func TestHandleFailurePausedCheck(t *testing.T) {
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+
+	mockStore := &mockstore.MockStore{}
+	e := &Eventd{
+		Store:      mockStore,
+		MessageBus: bus,
+	}
+
+	event := types.FixtureEvent("entity", "check")
+	check := types.FixtureCheckConfig("check")
+	check.Publish = false
+	mockStore.On("GetCheckConfigByName", mock.Anything, "check").Return(check, nil)
+
+	// No failure event is created for a paused check
+	require.NoError(t, e.HandleFailure(event.Entity, event))
+	mockStore.AssertNotCalled(t, "GetEventByEntityCheck", mock.Anything, mock.Anything, mock.Anything)
+
+	// The standalone checks are still monitored
+	check.Standalone = true
+	mockStore.On("GetEventByEntityCheck", mock.Anything, "entity", "check").Return(event, nil)
+	mockStore.On("UpdateEvent", mock.AnythingOfType("*types.Event")).Return(nil)
+	require.NoError(t, e.HandleFailure(event.Entity, event))
+	assert.Equal(t, int32(1), event.Check.Status)
+}
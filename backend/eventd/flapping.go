@@ -34,21 +34,25 @@ func state(event *types.Event) {
 // totalStateChange calculates the total state change percentage for the
 // history, which is later used for check state flap detection.
 func totalStateChange(event *types.Event) uint32 {
-	if len(event.Check.History) < 21 {
+	if len(event.Check.History) < types.DefaultHistoryRetention {
 		return 0
 	}
 
+	// Only the latest executions are considered when the check retains a
+	// longer history
+	history := event.Check.History[len(event.Check.History)-types.DefaultHistoryRetention:]
+
 	stateChanges := 0.00
 	changeWeight := 0.80
-	previousStatus := event.Check.History[0].Status
+	previousStatus := history[0].Status
 
-	for i := 0; i < len(event.Check.History); i++ {
-		if event.Check.History[i].Status != previousStatus {
+	for i := 0; i < len(history); i++ {
+		if history[i].Status != previousStatus {
 			stateChanges += changeWeight
 		}
 
 		changeWeight += 0.02
-		previousStatus = event.Check.History[i].Status
+		previousStatus = history[i].Status
 	}
 
 	return uint32(float32(stateChanges) / 20 * 100)
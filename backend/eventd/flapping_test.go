@@ -169,6 +169,18 @@ func TestTotalStateChange(t *testing.T) {
 			},
 			34,
 		},
+		{
+			"and ignores the older check results of a longer history",
+			&types.Event{
+				Check: &types.Check{
+					History: append(
+						[]types.CheckHistory{{Status: 2}, {Status: 1}, {Status: 2}},
+						fictionalHistory()...,
+					),
+				},
+			},
+			34,
+		},
 	}
 
 	for _, tc := range testCases {
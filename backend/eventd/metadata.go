@@ -0,0 +1,65 @@
+package eventd
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	stringsutil "github.com/sensu/sensu-go/util/strings"
+)
+
+// getInheritedMetadata adds to the event the labels and annotations of its
+// organization and environment. The metadata of the environment takes
+// precedence over the one of the organization, while the metadata already
+// carried by the event takes precedence over both.
+func getInheritedMetadata(ctx context.Context, event *types.Event, namespaces *store.NamespaceCache) error {
+	org, err := namespaces.GetOrganization(ctx, event.Entity.Organization)
+	if err != nil {
+		return err
+	}
+
+	env, err := namespaces.GetEnvironment(ctx, event.Entity.Organization, event.Entity.Environment)
+	if err != nil {
+		return err
+	}
+
+	var labels, annotations []map[string]string
+	if org != nil {
+		labels = append(labels, org.Labels)
+		annotations = append(annotations, org.Annotations)
+	}
+	if env != nil {
+		labels = append(labels, env.Labels)
+		annotations = append(annotations, env.Annotations)
+	}
+
+	event.Labels = mergeMetadata(append(labels, event.Labels)...)
+	event.Annotations = mergeMetadata(append(annotations, event.Annotations)...)
+
+	return nil
+}
+
+// addCheckContacts adds to the contacts of the event the ones of its check
+// which it doesn't carry yet.
+func addCheckContacts(event *types.Event) {
+	for _, contact := range event.Check.Contacts {
+		if !stringsutil.InArray(contact, event.Contacts) {
+			event.Contacts = append(event.Contacts, contact)
+		}
+	}
+}
+
+// mergeMetadata merges the given key-value pairs, the last ones taking
+// precedence. The result is nil if there's no pair to merge.
+func mergeMetadata(maps ...map[string]string) map[string]string {
+	var result map[string]string
+	for _, m := range maps {
+		for key, value := range m {
+			if result == nil {
+				result = make(map[string]string)
+			}
+			result[key] = value
+		}
+	}
+	return result
+}
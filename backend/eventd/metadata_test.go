@@ -0,0 +1,61 @@
+package eventd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetInheritedMetadata(t *testing.T) {
+	org := types.FixtureOrganization("default")
+	org.Labels = map[string]string{"team": "ops", "tier": "1"}
+	org.Annotations = map[string]string{"runbook": "https://wiki/ops"}
+	env := types.FixtureEnvironment("default")
+	env.Labels = map[string]string{"tier": "2"}
+
+	st := &mockstore.MockStore{}
+	st.On("GetOrganizationByName", mock.Anything, "default").Return(org, nil)
+	st.On("GetEnvironment", mock.Anything, "default", "default").Return(env, nil)
+
+	event := types.FixtureEvent("entity", "check")
+	event.Annotations = map[string]string{"runbook": "https://wiki/check"}
+
+	require.NoError(t, getInheritedMetadata(context.Background(), event, store.NewNamespaceCache(st)))
+	assert.Equal(t, map[string]string{"team": "ops", "tier": "2"}, event.Labels)
+	assert.Equal(t, map[string]string{"runbook": "https://wiki/check"}, event.Annotations)
+
+	// The organization and environment metadata are left untouched
+	assert.Equal(t, "1", org.Labels["tier"])
+}
+
+func TestGetInheritedMetadataMissing(t *testing.T) {
+	var nilOrg *types.Organization
+	var nilEnv *types.Environment
+	st := &mockstore.MockStore{}
+	st.On("GetOrganizationByName", mock.Anything, "default").Return(nilOrg, nil)
+	st.On("GetEnvironment", mock.Anything, "default", "default").Return(nilEnv, nil).Once()
+
+	event := types.FixtureEvent("entity", "check")
+	require.NoError(t, getInheritedMetadata(context.Background(), event, store.NewNamespaceCache(st)))
+	assert.Nil(t, event.Labels)
+	assert.Nil(t, event.Annotations)
+
+	st.On("GetEnvironment", mock.Anything, "default", "default").Return(nilEnv, errors.New("error"))
+	assert.Error(t, getInheritedMetadata(context.Background(), event, store.NewNamespaceCache(st)))
+}
+
+func TestAddCheckContacts(t *testing.T) {
+	event := types.FixtureEvent("entity1", "check1")
+	event.Contacts = []string{"alice"}
+	event.Check.Contacts = []string{"bob", "alice"}
+
+	addCheckContacts(event)
+	assert.Equal(t, []string{"alice", "bob"}, event.Contacts)
+}
@@ -0,0 +1,12 @@
+package eventd
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var queueLength = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "sensu_eventd_queue_length",
+	Help: "Number of events waiting to be handled by eventd.",
+})
+
+func init() {
+	prometheus.MustRegister(queueLength)
+}
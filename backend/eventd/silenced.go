@@ -20,8 +20,9 @@ func addToSilencedBy(id string, ids []string) []string {
 }
 
 // getSilenced retrieves all silenced entries for a given event, using the
-// entity subscription, the check subscription and the check name while
-// supporting wildcard silenced entries (e.g. subscription:*)
+// entity subscription, the subscriptions of the entity groups of the entity,
+// the check subscription and the check name while supporting wildcard
+// silenced entries (e.g. subscription:*)
 func getSilenced(ctx context.Context, event *types.Event, s store.Store) error {
 	entries := []*types.Silenced{}
 
@@ -33,6 +34,19 @@ func getSilenced(ctx context.Context, event *types.Event, s store.Store) error {
 	}
 	entries = append(entries, results...)
 
+	// Retrieve silenced entries using the subscriptions of the entity groups
+	groupSubscriptions, err := getEntityGroupSubscriptions(ctx, event.Entity, s)
+	if err != nil {
+		return err
+	}
+	for _, value := range groupSubscriptions {
+		results, err = s.GetSilencedEntriesBySubscription(ctx, value)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, results...)
+	}
+
 	// Retrieve silenced entries using the check subscriptions
 	for _, value := range event.Check.Subscriptions {
 		results, err = s.GetSilencedEntriesBySubscription(ctx, value)
@@ -50,7 +64,7 @@ func getSilenced(ctx context.Context, event *types.Event, s store.Store) error {
 	entries = append(entries, results...)
 
 	// Determine which entries silence this event
-	silencedIDs := silencedBy(event, entries)
+	silencedIDs := silencedBy(event, entries, groupSubscriptions...)
 
 	// Add to the event all silenced entries ID that actually silence it
 	event.Silenced = silencedIDs
@@ -58,9 +72,27 @@ func getSilenced(ctx context.Context, event *types.Event, s store.Store) error {
 	return nil
 }
 
+// getEntityGroupSubscriptions returns the subscriptions of the entity groups
+// the entity belongs to (e.g. group:webservers).
+func getEntityGroupSubscriptions(ctx context.Context, entity *types.Entity, s store.EntityGroupStore) ([]string, error) {
+	groups, err := s.GetEntityGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var subscriptions []string
+	for _, group := range groups {
+		if group.Matches(entity) {
+			subscriptions = append(subscriptions, types.GetEntityGroupSubscription(group.Name))
+		}
+	}
+	return subscriptions, nil
+}
+
 // silencedBy determines which of the given silenced entries silenced a given
-// event and return a list of silenced entry IDs
-func silencedBy(event *types.Event, silencedEntries []*types.Silenced) []string {
+// event and return a list of silenced entry IDs. The event's entity belongs
+// to the entity groups of the given subscriptions.
+func silencedBy(event *types.Event, silencedEntries []*types.Silenced, groupSubscriptions ...string) []string {
 	silencedBy := []string{}
 
 	// Loop through every silenced entries in order to determine if it applies to
@@ -86,6 +118,14 @@ func silencedBy(event *types.Event, silencedEntries []*types.Silenced) []string
 			continue
 		}
 
+		// Is this event silenced by one of the entity groups of its entity?
+		// (e.g. group:webservers:* or group:webservers:check_cpu)
+		for _, group := range groupSubscriptions {
+			if (entry.ID == fmt.Sprintf("%s:*", group) || entry.ID == fmt.Sprintf("%s:%s", group, event.Check.Name)) && entry.StartSilence(time.Now().Unix()) {
+				silencedBy = addToSilencedBy(entry.ID, silencedBy)
+			}
+		}
+
 		for _, subscription := range event.Check.Subscriptions {
 			// Make sure the entity is subscribed to this specific subscription
 			if !stringsutil.InArray(subscription, event.Entity.Subscriptions) {
@@ -16,6 +16,7 @@ func TestGetSilenced(t *testing.T) {
 		event                 *types.Event
 		silencedSubscriptions []*types.Silenced
 		silencedChecks        []*types.Silenced
+		entityGroups          []*types.EntityGroup
 		expectedEntries       []string
 	}{
 		{
@@ -27,6 +28,25 @@ func TestGetSilenced(t *testing.T) {
 			},
 			expectedEntries: []string{"entity:foo:check_cpu"},
 		},
+		{
+			name:  "Silenced by an entity group",
+			event: types.FixtureEvent("foo", "check_cpu"),
+			silencedSubscriptions: []*types.Silenced{
+				types.FixtureSilenced("group:webservers:*"),
+				types.FixtureSilenced("group:databases:*"),
+			},
+			silencedChecks: []*types.Silenced{},
+			entityGroups: []*types.EntityGroup{
+				{
+					Name:         "webservers",
+					Members:      []string{"foo"},
+					Organization: "default",
+					Environment:  "default",
+				},
+				types.FixtureEntityGroup("databases"),
+			},
+			expectedEntries: []string{"group:webservers:*"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -45,6 +65,11 @@ func TestGetSilenced(t *testing.T) {
 				mock.Anything,
 			).Return(tc.silencedChecks, nil)
 
+			mockStore.On(
+				"GetEntityGroups",
+				mock.Anything,
+			).Return(tc.entityGroups, nil)
+
 			result := getSilenced(ctx, tc.event, mockStore)
 			assert.Nil(t, result)
 			assert.Equal(t, tc.expectedEntries, tc.event.Silenced)
@@ -0,0 +1,184 @@
+// Package federation aggregates the views of regional Sensu clusters. The
+// backend queries the APIs of the remote clusters of its federation with the
+// credentials of a service account of theirs.
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// DefaultTimeout is the timeout of the requests to the remote clusters
+const DefaultTimeout = 10 * time.Second
+
+// Cluster is a remote Sensu cluster of the federation.
+type Cluster struct {
+	// Name of the cluster, e.g. its region
+	Name string `json:"name"`
+
+	// URL of the API of the cluster
+	URL string `json:"url"`
+}
+
+// ParseClusters parses the clusters of a federation given as name=url, e.g.
+// us-east=https://sensu.us-east.example.com:8080.
+func ParseClusters(specs []string) ([]Cluster, error) {
+	clusters := make([]Cluster, 0, len(specs))
+	names := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		parts := strings.SplitN(spec, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid federated cluster %q, expected name=url", spec)
+		}
+		name, apiURL := parts[0], strings.TrimSuffix(parts[1], "/")
+		if u, err := url.Parse(apiURL); err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid URL of the federated cluster %s: %q", name, parts[1])
+		}
+		if names[name] {
+			return nil, fmt.Errorf("duplicate federated cluster %s", name)
+		}
+		names[name] = true
+		clusters = append(clusters, Cluster{Name: name, URL: apiURL})
+	}
+	return clusters, nil
+}
+
+// Gateway queries the APIs of the clusters of the federation.
+type Gateway struct {
+	clusters []Cluster
+	username string
+	password string
+	client   *http.Client
+
+	mu     sync.Mutex
+	tokens map[string]*types.Tokens
+}
+
+// NewGateway returns a gateway to the given clusters, authenticated with the
+// username and password of a service account existing in each of them. The
+// requests are made with the given client, or with a client timing out after
+// DefaultTimeout if nil.
+func NewGateway(clusters []Cluster, username, password string, client *http.Client) *Gateway {
+	if client == nil {
+		client = &http.Client{Timeout: DefaultTimeout}
+	}
+	return &Gateway{
+		clusters: clusters,
+		username: username,
+		password: password,
+		client:   client,
+		tokens:   make(map[string]*types.Tokens, len(clusters)),
+	}
+}
+
+// Clusters returns the clusters of the federation. A nil gateway has none.
+func (g *Gateway) Clusters() []Cluster {
+	if g == nil {
+		return nil
+	}
+	return g.clusters
+}
+
+// Events returns all the events of the cluster, in all the organizations and
+// environments the service account has access to.
+func (g *Gateway) Events(ctx context.Context, cluster Cluster) ([]*types.Event, error) {
+	events := []*types.Event{}
+	if err := g.get(ctx, cluster, "/events?org=*&env=*", &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// get decodes the response to the GET request of the path of the cluster API
+// into v. The request is retried once with new tokens if the access token
+// was rejected.
+func (g *Gateway) get(ctx context.Context, cluster Cluster, path string, v interface{}) error {
+	for attempt := 0; ; attempt++ {
+		tokens, err := g.accessToken(ctx, cluster)
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequest(http.MethodGet, cluster.URL+path, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Authorization", "Bearer "+tokens.Access)
+
+		resp, err := g.client.Do(req.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("could not query the cluster %s: %s", cluster.Name, err)
+		}
+
+		if resp.StatusCode == http.StatusUnauthorized && attempt == 0 {
+			_ = resp.Body.Close()
+			g.forget(cluster, tokens)
+			continue
+		}
+
+		err = decode(cluster, resp, v)
+		_ = resp.Body.Close()
+		return err
+	}
+}
+
+// accessToken returns the tokens of the service account in the cluster,
+// logging in if it has none or if they expired.
+func (g *Gateway) accessToken(ctx context.Context, cluster Cluster) (*types.Tokens, error) {
+	g.mu.Lock()
+	tokens, ok := g.tokens[cluster.Name]
+	g.mu.Unlock()
+	if ok && time.Unix(tokens.ExpiresAt, 0).After(time.Now()) {
+		return tokens, nil
+	}
+
+	req, err := http.NewRequest(http.MethodGet, cluster.URL+"/auth", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(g.username, g.password)
+
+	resp, err := g.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("could not log in to the cluster %s: %s", cluster.Name, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	tokens = &types.Tokens{}
+	if err := decode(cluster, resp, tokens); err != nil {
+		return nil, err
+	}
+
+	g.mu.Lock()
+	g.tokens[cluster.Name] = tokens
+	g.mu.Unlock()
+	return tokens, nil
+}
+
+// forget drops the tokens of the cluster, unless they were already replaced.
+func (g *Gateway) forget(cluster Cluster, tokens *types.Tokens) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.tokens[cluster.Name] == tokens {
+		delete(g.tokens, cluster.Name)
+	}
+}
+
+func decode(cluster Cluster, resp *http.Response, v interface{}) error {
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("the cluster %s returned the error: %s", cluster.Name, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("invalid response from the cluster %s: %s", cluster.Name, err)
+	}
+	return nil
+}
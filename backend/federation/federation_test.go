@@ -0,0 +1,91 @@
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClusters(t *testing.T) {
+	clusters, err := ParseClusters([]string{"us-east=https://sensu.us-east.example.com:8080/", "eu-west=http://10.0.0.1:8080"})
+	require.NoError(t, err)
+	assert.Equal(t, []Cluster{
+		{Name: "us-east", URL: "https://sensu.us-east.example.com:8080"},
+		{Name: "eu-west", URL: "http://10.0.0.1:8080"},
+	}, clusters)
+
+	for _, specs := range [][]string{
+		{"us-east"},
+		{"=https://sensu.example.com"},
+		{"us-east=sensu.example.com"},
+		{"us-east=https://a.example.com", "us-east=https://b.example.com"},
+	} {
+		_, err := ParseClusters(specs)
+		assert.Error(t, err, specs)
+	}
+}
+
+func TestGatewayEvents(t *testing.T) {
+	logins := 0
+	access := "token1"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth":
+			username, password, ok := r.BasicAuth()
+			if !ok || username != "federation" || password != "P@ssw0rd!" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			logins++
+			_ = json.NewEncoder(w).Encode(types.Tokens{Access: access, ExpiresAt: time.Now().Add(time.Hour).Unix()})
+		case "/events":
+			if r.Header.Get("Authorization") != "Bearer "+access {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			assert.Equal(t, "*", r.URL.Query().Get("org"))
+			assert.Equal(t, "*", r.URL.Query().Get("env"))
+			_ = json.NewEncoder(w).Encode([]*types.Event{types.FixtureEvent("entity1", "check1")})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cluster := Cluster{Name: "us-east", URL: server.URL}
+	gateway := NewGateway([]Cluster{cluster}, "federation", "P@ssw0rd!", nil)
+	assert.Equal(t, []Cluster{cluster}, gateway.Clusters())
+
+	events, err := gateway.Events(context.Background(), cluster)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "entity1", events[0].Entity.ID)
+
+	// The tokens are reused
+	_, err = gateway.Events(context.Background(), cluster)
+	require.NoError(t, err)
+	assert.Equal(t, 1, logins)
+
+	// The gateway logs in again once its token is rejected
+	access = "token2"
+	_, err = gateway.Events(context.Background(), cluster)
+	require.NoError(t, err)
+	assert.Equal(t, 2, logins)
+
+	// Invalid credentials
+	gateway = NewGateway([]Cluster{cluster}, "federation", "wrong", nil)
+	_, err = gateway.Events(context.Background(), cluster)
+	assert.Error(t, err)
+}
+
+func TestNilGateway(t *testing.T) {
+	var gateway *Gateway
+	assert.Empty(t, gateway.Clusters())
+}
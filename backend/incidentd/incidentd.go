@@ -0,0 +1,275 @@
+// Package incidentd correlates the events handled by the backend into
+// incidents.
+//
+// Two failing events are related when they come from the same check on
+// different entities, or from the same entity for different checks, and were
+// received within the correlation window. The related events are grouped into
+// an incident, which is resolved once all its events passed.
+//
+// An incident is opened by the backend which received the related failing
+// events, as it keeps the failing events it received within the correlation
+// window. Once open, the failing events received by any backend join it.
+package incidentd
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/google/uuid"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// ComponentName identifies Incidentd as the component/daemon implemented
+	// in this package.
+	ComponentName = "incidentd"
+
+	// DefaultWindow is the default duration within which failing events
+	// are correlated.
+	DefaultWindow = 5 * time.Minute
+
+	// DefaultBufferSize is the default number of events queued for
+	// correlation before publishers are slowed down.
+	DefaultBufferSize = 100
+)
+
+// Store specifies the storage requirements of Incidentd.
+type Store interface {
+	store.IncidentStore
+}
+
+// Incidentd is the daemon correlating the events into incidents. The events
+// are correlated one at a time, so the incidents are never updated
+// concurrently by the same backend.
+type Incidentd struct {
+	Store      Store
+	MessageBus messaging.MessageBus
+
+	// Window is the duration within which failing events are correlated.
+	// Defaults to DefaultWindow.
+	Window time.Duration
+
+	// BufferSize is the number of events queued for correlation. Defaults to
+	// DefaultBufferSize.
+	BufferSize int
+
+	eventChan chan interface{}
+	errChan   chan error
+	wg        *sync.WaitGroup
+
+	// failures are the failing events received within the correlation window,
+	// by namespace and then by entity and check. They are only accessed by
+	// the correlation loop.
+	failures map[string]map[string]*types.Event
+}
+
+// Start incidentd, subscribing to the events which were stored.
+func (i *Incidentd) Start() error {
+	if i.Store == nil {
+		return errors.New("no store found")
+	}
+
+	if i.MessageBus == nil {
+		return errors.New("no message bus found")
+	}
+
+	if i.Window == 0 {
+		i.Window = DefaultWindow
+	}
+
+	if i.BufferSize == 0 {
+		i.BufferSize = DefaultBufferSize
+	}
+
+	i.eventChan = make(chan interface{}, i.BufferSize)
+	i.errChan = make(chan error, 1)
+	i.wg = &sync.WaitGroup{}
+	i.failures = map[string]map[string]*types.Event{}
+
+	if err := i.MessageBus.Subscribe(messaging.TopicEvent, ComponentName, i.eventChan); err != nil {
+		return err
+	}
+
+	i.wg.Add(1)
+	go i.correlateLoop()
+
+	return nil
+}
+
+// Stop incidentd. The queued events are correlated before it returns.
+func (i *Incidentd) Stop() error {
+	err := i.MessageBus.Unsubscribe(messaging.TopicEvent, ComponentName)
+	close(i.eventChan)
+	i.wg.Wait()
+	close(i.errChan)
+	return err
+}
+
+// Status returns an error if incidentd is unhealthy.
+func (i *Incidentd) Status() error {
+	return nil
+}
+
+// Err returns a channel to listen for terminal errors on.
+func (i *Incidentd) Err() <-chan error {
+	return i.errChan
+}
+
+func (i *Incidentd) correlateLoop() {
+	defer i.wg.Done()
+
+	for msg := range i.eventChan {
+		event, ok := msg.(*types.Event)
+		if !ok || event.Entity == nil || event.Check == nil {
+			continue
+		}
+
+		if err := i.correlate(event); err != nil {
+			logger.WithError(err).WithFields(logrus.Fields{
+				"entity": event.Entity.ID,
+				"check":  event.Check.Name,
+			}).Error("error correlating event")
+		}
+	}
+}
+
+// correlate records the event in the incidents already grouping it. A failing
+// event which isn't grouped yet is added to the first unresolved incident it
+// correlates with, or else opens a new incident with the failing events it is
+// related to.
+func (i *Incidentd) correlate(event *types.Event) error {
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, event.Entity.Organization)
+	ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
+
+	related := i.recordFailure(event)
+
+	unresolved, err := i.Store.GetUnresolvedIncidents(ctx)
+	if err != nil {
+		return err
+	}
+
+	recorded := false
+	for _, incident := range unresolved {
+		if incident.Contains(event.Entity.ID, event.Check.Name) {
+			if err := i.record(ctx, incident.ID, event); err != nil {
+				return err
+			}
+			recorded = true
+		}
+	}
+	if recorded || event.Check.Status == 0 {
+		return nil
+	}
+
+	for _, incident := range unresolved {
+		if incident.Correlates(event) && i.within(incident.UpdatedAt, event.Timestamp) {
+			return i.record(ctx, incident.ID, event)
+		}
+	}
+
+	incident := i.newIncident(event, related)
+	if incident == nil {
+		return nil
+	}
+	return i.Store.UpdateIncident(ctx, incident)
+}
+
+// record records the event in the stored incident, which is read again if it
+// was concurrently modified, e.g. by another backend.
+func (i *Incidentd) record(ctx context.Context, id string, event *types.Event) error {
+	return i.Store.UpdateIncidentWith(ctx, id, func(incident *types.Incident) error {
+		incident.Record(event)
+		return nil
+	})
+}
+
+// recordFailure keeps the event if it is failing, or forgets its previous
+// failure otherwise, and returns the other failing events of its namespace
+// received within the correlation window of the event.
+func (i *Incidentd) recordFailure(event *types.Event) []*types.Event {
+	namespace := event.Entity.Organization + "/" + event.Entity.Environment
+	failures, ok := i.failures[namespace]
+	if !ok {
+		failures = map[string]*types.Event{}
+		i.failures[namespace] = failures
+	}
+
+	key := event.Entity.ID + "/" + event.Check.Name
+	if event.Check.Status == 0 {
+		delete(failures, key)
+	} else {
+		failures[key] = event
+	}
+
+	related := []*types.Event{}
+	for k, e := range failures {
+		if !i.within(e.Timestamp, event.Timestamp) {
+			// The failures older than the window won't be correlated anymore
+			if e.Timestamp < event.Timestamp {
+				delete(failures, k)
+			}
+			continue
+		}
+		if k != key {
+			related = append(related, e)
+		}
+	}
+	if len(failures) == 0 {
+		delete(i.failures, namespace)
+	}
+	return related
+}
+
+// newIncident returns a new incident grouping the event with the given
+// failing events it is related to, first by check and then by entity. The
+// incident is nil if the event isn't related to any of them.
+func (i *Incidentd) newIncident(event *types.Event, failures []*types.Event) *types.Incident {
+	correlations := []struct {
+		correlation string
+		key         string
+	}{
+		{types.IncidentCorrelationCheck, event.Check.Name},
+		{types.IncidentCorrelationEntity, event.Entity.ID},
+	}
+
+	for _, c := range correlations {
+		incident := &types.Incident{
+			ID:           uuid.New().String(),
+			Correlation:  c.correlation,
+			Key:          c.key,
+			State:        types.IncidentOpen,
+			Events:       []types.IncidentEvent{},
+			Organization: event.Entity.Organization,
+			Environment:  event.Entity.Environment,
+		}
+
+		for _, e := range failures {
+			if incident.Correlates(e) {
+				incident.Record(e)
+			}
+		}
+
+		if len(incident.Events) > 0 {
+			incident.Record(event)
+			incident.StartedAt = event.Timestamp
+			return incident
+		}
+	}
+
+	return nil
+}
+
+// within returns whether the given timestamps are within the correlation
+// window of each other.
+func (i *Incidentd) within(a, b int64) bool {
+	elapsed := time.Duration(a-b) * time.Second
+	if elapsed < 0 {
+		elapsed = -elapsed
+	}
+	return elapsed <= i.Window
+}
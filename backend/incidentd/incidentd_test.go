@@ -0,0 +1,133 @@
+package incidentd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func failingEvent(entity, check string) *types.Event {
+	event := types.FixtureEvent(entity, check)
+	event.Check.Status = 2
+	return event
+}
+
+func newIncidentd(store *mockstore.MockStore) (*Incidentd, *[]*types.Incident) {
+	var updated []*types.Incident
+	store.On("UpdateIncident", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		updated = append(updated, args.Get(0).(*types.Incident))
+	})
+	return &Incidentd{
+		Store:    store,
+		Window:   DefaultWindow,
+		failures: map[string]map[string]*types.Event{},
+	}, &updated
+}
+
+// storeIncidents mocks the unresolved incidents of the store, which are
+// updated in place.
+func storeIncidents(store *mockstore.MockStore, incidents ...*types.Incident) *[]string {
+	var recorded []string
+	store.On("GetUnresolvedIncidents", mock.Anything).Return(incidents, nil)
+	store.On("UpdateIncidentWith", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		for _, incident := range incidents {
+			if incident.ID == args.String(1) {
+				recorded = append(recorded, incident.ID)
+				_ = args.Get(2).(func(*types.Incident) error)(incident)
+			}
+		}
+	})
+	return &recorded
+}
+
+func TestCorrelateOpensIncident(t *testing.T) {
+	old := failingEvent("entity3", "check_cpu")
+	old.Timestamp = time.Now().Add(-time.Hour).Unix()
+
+	store := &mockstore.MockStore{}
+	storeIncidents(store)
+	i, updated := newIncidentd(store)
+
+	for _, event := range []*types.Event{
+		failingEvent("entity1", "check_cpu"),
+		types.FixtureEvent("entity2", "check_cpu"),
+		old,
+		failingEvent("entity4", "check_disk"),
+	} {
+		require.NoError(t, i.correlate(event))
+	}
+	require.Empty(t, *updated)
+
+	require.NoError(t, i.correlate(failingEvent("entity4", "check_cpu")))
+	require.Len(t, *updated, 1)
+
+	// The failing events of the same check within the window are grouped
+	incident := (*updated)[0]
+	assert.NoError(t, incident.Validate())
+	assert.Equal(t, types.IncidentCorrelationCheck, incident.Correlation)
+	assert.Equal(t, "check_cpu", incident.Key)
+	assert.Equal(t, types.IncidentOpen, incident.State)
+	assert.Len(t, incident.Events, 2)
+	assert.True(t, incident.Contains("entity1", "check_cpu"))
+	assert.True(t, incident.Contains("entity4", "check_cpu"))
+
+	// The failures older than the window are forgotten
+	assert.Len(t, i.failures["default/default"], 3)
+}
+
+func TestCorrelateByEntity(t *testing.T) {
+	store := &mockstore.MockStore{}
+	storeIncidents(store)
+	i, updated := newIncidentd(store)
+
+	require.NoError(t, i.correlate(failingEvent("entity1", "check_disk")))
+	require.NoError(t, i.correlate(failingEvent("entity1", "check_cpu")))
+	require.Len(t, *updated, 1)
+	assert.Equal(t, types.IncidentCorrelationEntity, (*updated)[0].Correlation)
+	assert.Equal(t, "entity1", (*updated)[0].Key)
+}
+
+func TestCorrelateUnrelatedEvent(t *testing.T) {
+	store := &mockstore.MockStore{}
+	storeIncidents(store)
+	i, updated := newIncidentd(store)
+
+	// A lone failing event does not open an incident
+	require.NoError(t, i.correlate(failingEvent("entity2", "check_disk")))
+	require.NoError(t, i.correlate(failingEvent("entity1", "check_cpu")))
+	assert.Empty(t, *updated)
+
+	// Neither does a passing event
+	require.NoError(t, i.correlate(types.FixtureEvent("entity2", "check_cpu")))
+	assert.Empty(t, *updated)
+
+	// Nor a failure which passed since
+	require.NoError(t, i.correlate(types.FixtureEvent("entity1", "check_cpu")))
+	require.NoError(t, i.correlate(failingEvent("entity3", "check_cpu")))
+	assert.Empty(t, *updated)
+}
+
+func TestCorrelateExistingIncident(t *testing.T) {
+	incident := types.FixtureIncident("abc", "check_cpu")
+
+	store := &mockstore.MockStore{}
+	recorded := storeIncidents(store, incident)
+	i, updated := newIncidentd(store)
+
+	// A related failing event joins the unresolved incident
+	require.NoError(t, i.correlate(failingEvent("entity3", "check_cpu")))
+	assert.Empty(t, *updated)
+	assert.Equal(t, []string{"abc"}, *recorded)
+	assert.Len(t, incident.Events, 3)
+
+	// The incident is resolved once all its events passed
+	for _, entity := range []string{"entity1", "entity2", "entity3"} {
+		require.NoError(t, i.correlate(types.FixtureEvent(entity, "check_cpu")))
+	}
+	assert.Equal(t, types.IncidentResolved, incident.State)
+}
@@ -0,0 +1,7 @@
+package incidentd
+
+import "github.com/Sirupsen/logrus"
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": ComponentName,
+})
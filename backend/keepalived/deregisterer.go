@@ -33,6 +33,10 @@ func (adapterPtr *Deregistration) Deregister(entity *types.Entity) error {
 		return fmt.Errorf("error deleting entity in store: %s", err.Error())
 	}
 
+	if err := adapterPtr.Store.DeleteKeepalive(ctx, entity); err != nil {
+		return fmt.Errorf("error deleting entity keepalive: %s", err.Error())
+	}
+
 	events, err := adapterPtr.Store.GetEventsByEntity(ctx, entity.ID)
 	if err != nil {
 		return fmt.Errorf("error fetching events for entity: %s", err.Error())
@@ -30,6 +30,7 @@ func TestDeregister(t *testing.T) {
 	mockStore.On("GetEventsByEntity", mock.Anything, entity.ID).Return([]*types.Event{event}, nil)
 	mockStore.On("DeleteEventByEntityCheck", mock.Anything, entity.ID, check.Name).Return(nil)
 	mockStore.On("DeleteEntity", mock.Anything, entity).Return(nil)
+	mockStore.On("DeleteKeepalive", mock.Anything, entity).Return(nil)
 
 	mockBus.On("Publish", mock.AnythingOfType("string"), mock.Anything).Return(nil)
 
@@ -57,6 +58,7 @@ func TestDeregistrationHandler(t *testing.T) {
 	mockStore.On("GetEventsByEntity", mock.Anything, entity.ID).Return([]*types.Event{}, nil)
 	mockStore.On("DeleteEventByEntityCheck", mock.Anything, entity.ID, check.Name).Return(nil)
 	mockStore.On("DeleteEntity", mock.Anything, entity).Return(nil)
+	mockStore.On("DeleteKeepalive", mock.Anything, entity).Return(nil)
 
 	mockBus.On("Publish", messaging.TopicEvent, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
 		event := args[1].(*types.Event)
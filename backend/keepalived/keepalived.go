@@ -2,12 +2,13 @@ package keepalived
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"math/rand"
 	"sync"
 	"time"
 
 	"github.com/sensu/sensu-go/backend/messaging"
-	"github.com/sensu/sensu-go/backend/monitor"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -21,6 +22,9 @@ const (
 	// valid for.
 	DefaultKeepaliveTimeout = 120 // seconds
 
+	// DefaultSweepInterval is how often the expired keepalives are looked for.
+	DefaultSweepInterval = time.Second
+
 	// KeepaliveCheckName is the name of the check that is created when a
 	// keepalive timeout occurs.
 	KeepaliveCheckName = "keepalive"
@@ -40,18 +44,44 @@ const (
 
 // Keepalived is responsible for monitoring keepalive events and recording
 // keepalives for entities.
+//
+// The keepalive deadlines of the entities are kept in the store rather than in
+// memory, so their monitoring survives restarts. Every backend looks for
+// expired keepalives, and each one is handled by the single backend that
+// claims it.
 type Keepalived struct {
 	MessageBus            messaging.MessageBus
 	HandlerCount          int
 	Store                 store.Store
 	DeregistrationHandler string
-	MonitorFactory        monitor.FactoryFunc
 
-	mu            *sync.Mutex
-	monitors      map[string]monitor.Interface
-	wg            *sync.WaitGroup
-	keepaliveChan chan interface{}
-	errChan       chan error
+	// SweepInterval is how often the expired keepalives are looked for.
+	// Defaults to DefaultSweepInterval.
+	SweepInterval time.Duration
+
+	// StormThreshold is the number of keepalive failure events published for
+	// individual entities per StormWindow, above which the failures are
+	// published as summaries per subscription. Defaults to
+	// DefaultStormThreshold.
+	StormThreshold int
+
+	// StormWindow is the duration of the storm windows. Defaults to
+	// DefaultStormWindow.
+	StormWindow time.Duration
+
+	// ClusterConfig caches the defaults of the organizations. The defaults
+	// are fetched from the Store for every keepalive if it is not set.
+	ClusterConfig *store.ClusterConfigCache
+
+	stormWindowStart time.Time
+	stormFailures    int
+	summaries        map[string]*keepaliveSummary
+
+	wg                 *sync.WaitGroup
+	keepaliveChan      chan interface{}
+	deregistrationChan chan interface{}
+	stopping           chan struct{}
+	errChan            chan error
 }
 
 // Start starts the daemon, returning an error if preconditions for startup
@@ -65,31 +95,34 @@ func (k *Keepalived) Start() error {
 		return errors.New("no keepalive store found")
 	}
 
-	if k.MonitorFactory == nil {
-		k.MonitorFactory = func(entity *types.Entity, event *types.Event, t time.Duration, updateHandler monitor.UpdateHandler, failureHandler monitor.FailureHandler) monitor.Interface {
-			return monitor.New(entity, event, t, updateHandler, failureHandler)
-		}
-	}
-
 	k.keepaliveChan = make(chan interface{}, 10)
 	err := k.MessageBus.Subscribe(messaging.TopicKeepalive, "keepalived", k.keepaliveChan)
 	if err != nil {
 		return err
 	}
 
+	k.deregistrationChan = make(chan interface{}, 10)
+	err = k.MessageBus.Subscribe(messaging.TopicDeregistration, "keepalived", k.deregistrationChan)
+	if err != nil {
+		return err
+	}
+
 	if k.HandlerCount == 0 {
 		k.HandlerCount = DefaultHandlerCount
 	}
 
-	k.mu = &sync.Mutex{}
-	k.monitors = map[string]monitor.Interface{}
-	if err := k.initFromStore(); err != nil {
-		return err
+	if k.SweepInterval == 0 {
+		k.SweepInterval = DefaultSweepInterval
 	}
 
+	k.initStorm()
+
+	k.stopping = make(chan struct{})
+	k.wg = &sync.WaitGroup{}
+
 	k.startWorkers()
 
-	k.startMonitorSweeper()
+	k.startSweeper()
 
 	k.errChan = make(chan error, 1)
 	return nil
@@ -98,12 +131,15 @@ func (k *Keepalived) Start() error {
 // Stop stops the daemon, returning an error if one was encountered during
 // shutdown.
 func (k *Keepalived) Stop() error {
+	close(k.stopping)
+	// Unsubscribe before closing the channel, so the bus does not send on it
+	err := k.MessageBus.Unsubscribe(messaging.TopicKeepalive, "keepalived")
 	close(k.keepaliveChan)
-	k.wg.Wait()
-	for _, monitor := range k.monitors {
-		go monitor.Stop()
+	if uerr := k.MessageBus.Unsubscribe(messaging.TopicDeregistration, "keepalived"); err == nil {
+		err = uerr
 	}
-	err := k.MessageBus.Unsubscribe(messaging.TopicKeepalive, "keepalived")
+	close(k.deregistrationChan)
+	k.wg.Wait()
 	close(k.errChan)
 	return err
 }
@@ -119,65 +155,59 @@ func (k *Keepalived) Err() <-chan error {
 	return k.errChan
 }
 
-func (k *Keepalived) initFromStore() error {
-	// For which clients were we previously alerting?
-	keepalives, err := k.Store.GetFailingKeepalives(context.TODO())
-	if err != nil {
-		return err
+func (k *Keepalived) startWorkers() {
+	k.wg.Add(k.HandlerCount + 1)
+
+	for i := 0; i < k.HandlerCount; i++ {
+		go k.processKeepalives()
 	}
 
-	for _, keepalive := range keepalives {
-		entityCtx := context.WithValue(context.TODO(), types.OrganizationKey, keepalive.Organization)
-		entityCtx = context.WithValue(entityCtx, types.EnvironmentKey, keepalive.Environment)
-		event, err := k.Store.GetEventByEntityCheck(entityCtx, keepalive.EntityID, "keepalive")
-		if err != nil {
-			return err
-		}
+	go k.processDeregistrations()
+}
 
-		// if there's no event, the entity was deregistered/deleted.
-		if event == nil {
-			continue
-		}
+// processDeregistrations deregisters the entities whose agent asked to be
+// deregistered when gracefully shut down.
+func (k *Keepalived) processDeregistrations() {
+	defer k.wg.Done()
 
-		// if another backend picked it up, it will be passing.
-		if event.Check.Status == 0 {
+	for msg := range k.deregistrationChan {
+		entity, ok := msg.(*types.Entity)
+		if !ok {
+			logger.Error("keepalived received non-Entity on deregistration channel")
 			continue
 		}
 
-		// Recreate the monitor with a time offset calculated from the keepalive
-		// entry timestamp minus the current time.
-		d := time.Duration(int64(keepalive.Time) - time.Now().Unix())
-
-		if d < 0 {
-			d = 0
+		if err := k.handleDeregistration(entity); err != nil {
+			logger.WithError(err).WithField("entity", entity.ID).Error("error deregistering entity")
 		}
-		monitor := k.MonitorFactory(event.Entity, nil, d, k, k)
-		k.monitors[keepalive.EntityID] = monitor
 	}
-
-	return nil
 }
 
-func (k *Keepalived) startWorkers() {
-	k.wg = &sync.WaitGroup{}
-	k.wg.Add(k.HandlerCount)
+// handleDeregistration deregisters the stored entity, if it's ephemeral.
+func (k *Keepalived) handleDeregistration(entity *types.Entity) error {
+	ctx := types.SetContextFromResource(context.Background(), entity)
+	stored, err := k.Store.GetEntityByID(ctx, entity.ID)
+	if err != nil {
+		return err
+	}
 
-	for i := 0; i < k.HandlerCount; i++ {
-		go k.processKeepalives()
+	// The entity was already deregistered, or isn't ephemeral
+	if stored == nil || !stored.Deregister {
+		return nil
+	}
+
+	deregisterer := &Deregistration{
+		Store:      k.Store,
+		MessageBus: k.MessageBus,
 	}
+	return deregisterer.Deregister(stored)
 }
 
 func (k *Keepalived) processKeepalives() {
 	defer k.wg.Done()
 
-	var (
-		mon   monitor.Interface
-		event *types.Event
-		ok    bool
-	)
-
 	for msg := range k.keepaliveChan {
-		event, ok = msg.(*types.Event)
+		event, ok := msg.(*types.Event)
 		if !ok {
 			logger.Error("keepalived received non-Event on keepalive channel")
 			continue
@@ -194,27 +224,18 @@ func (k *Keepalived) processKeepalives() {
 			continue
 		}
 
-		if err := k.handleEntityRegistration(entity); err != nil {
-			logger.WithError(err).Error("error handling entity registration")
+		if entity.Partial {
+			if err := k.mergeStoredEntity(entity); err != nil {
+				logger.WithError(err).Error("error merging partial keepalive entity")
+				continue
+			}
 		}
 
-		k.mu.Lock()
-		mon, ok = k.monitors[entity.ID]
-		timeout := time.Duration(entity.KeepaliveTimeout) * time.Second
-		// create an entity monitor if it doesn't exist in the monitor map
-		if !ok || mon.IsStopped() {
-			mon = k.MonitorFactory(entity, nil, timeout, k, k)
-			k.monitors[entity.ID] = mon
-		}
-		// stop the running monitor and reset it in the monitor map with new timeout
-		if mon.GetTimeout() != timeout {
-			mon.Stop()
-			mon = k.MonitorFactory(entity, nil, timeout, k, k)
-			k.monitors[entity.ID] = mon
+		if err := k.handleEntityRegistration(entity); err != nil {
+			logger.WithError(err).Error("error handling entity registration")
 		}
-		k.mu.Unlock()
 
-		if err := mon.HandleUpdate(event); err != nil {
+		if err := k.HandleUpdate(event); err != nil {
 			logger.WithError(err).Error("error monitoring entity")
 		}
 	}
@@ -240,24 +261,148 @@ func (k *Keepalived) handleEntityRegistration(entity *types.Entity) error {
 	return err
 }
 
-// startMonitorSweeper spins off into oblivion if Keepalived is stopped until
-// the monitors map is empty, and then the goroutine stops.
-func (k *Keepalived) startMonitorSweeper() {
+// mergeStoredEntity completes the partial entity of a keepalive with the system
+// facts and the extended attributes of the stored entity, which it doesn't
+// provide.
+func (k *Keepalived) mergeStoredEntity(entity *types.Entity) error {
+	ctx := types.SetContextFromResource(context.Background(), entity)
+	stored, err := k.Store.GetEntityByID(ctx, entity.ID)
+	if err != nil {
+		return err
+	}
+	entity.Partial = false
+
+	if stored == nil {
+		return nil
+	}
+
+	mergeSystem(&entity.System, &stored.System)
+
+	attributes, err := mergeExtendedAttributes(entity.ExtendedAttributes, stored.ExtendedAttributes)
+	if err != nil {
+		return err
+	}
+	entity.ExtendedAttributes = attributes
+
+	return nil
+}
+
+// mergeSystem sets the system facts which aren't provided by dst to the ones
+// of src.
+func mergeSystem(dst, src *types.System) {
+	if dst.Arch == "" {
+		dst.Arch = src.Arch
+	}
+	if dst.Hostname == "" {
+		dst.Hostname = src.Hostname
+	}
+	if len(dst.Network.Interfaces) == 0 {
+		dst.Network = src.Network
+	}
+	if dst.OS == "" {
+		dst.OS = src.OS
+	}
+	if dst.Platform == "" {
+		dst.Platform = src.Platform
+	}
+	if dst.PlatformFamily == "" {
+		dst.PlatformFamily = src.PlatformFamily
+	}
+	if dst.PlatformVersion == "" {
+		dst.PlatformVersion = src.PlatformVersion
+	}
+}
+
+// mergeExtendedAttributes returns the extended attributes of src, overridden
+// by the ones of dst.
+func mergeExtendedAttributes(dst, src []byte) ([]byte, error) {
+	if len(src) == 0 {
+		return dst, nil
+	}
+	if len(dst) == 0 {
+		return src, nil
+	}
+
+	var merged, overrides map[string]json.RawMessage
+	if err := json.Unmarshal(src, &merged); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(dst, &overrides); err != nil {
+		return nil, err
+	}
+	if merged == nil {
+		merged = make(map[string]json.RawMessage, len(overrides))
+	}
+	for name, value := range overrides {
+		merged[name] = value
+	}
+
+	return json.Marshal(merged)
+}
+
+// startSweeper periodically handles the expired keepalives until Keepalived
+// is stopped.
+func (k *Keepalived) startSweeper() {
+	k.wg.Add(1)
 	go func() {
-		timer := time.NewTimer(10 * time.Minute)
+		defer k.wg.Done()
+		ticker := time.NewTicker(k.SweepInterval)
+		defer ticker.Stop()
 		for {
-			<-timer.C
-			for key, monitor := range k.monitors {
-				if monitor.IsStopped() {
-					k.mu.Lock()
-					delete(k.monitors, key)
-					k.mu.Unlock()
-				}
+			select {
+			case <-k.stopping:
+				return
+			case <-ticker.C:
+				k.sweep()
 			}
 		}
 	}()
 }
 
+// sweep handles the failure of the entities whose keepalive expired. Since
+// every backend sweeps, they go through the expired keepalives in a random
+// order, so they mostly claim different ones.
+func (k *Keepalived) sweep() {
+	ctx := context.Background()
+	keepalives, err := k.Store.GetExpiredKeepalives(ctx, time.Now().Unix())
+	if err != nil {
+		logger.WithError(err).Error("error getting expired keepalives")
+		return
+	}
+
+	entities := []*types.Entity{}
+	for _, i := range rand.Perm(len(keepalives)) {
+		keepalive := keepalives[i]
+		claimed, err := k.Store.ClaimExpiredKeepalive(ctx, keepalive)
+		if err != nil {
+			logger.WithError(err).Error("error claiming expired keepalive")
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		entity, err := k.getExpiredEntity(keepalive)
+		if err != nil {
+			logger.WithError(err).WithField("entity", keepalive.EntityID).Error("error handling expired keepalive")
+			continue
+		}
+
+		// if there's no entity, it was deregistered/deleted.
+		if entity != nil {
+			entities = append(entities, entity)
+		}
+	}
+
+	k.handleFailures(entities, time.Now())
+}
+
+func (k *Keepalived) getExpiredEntity(keepalive *types.KeepaliveRecord) (*types.Entity, error) {
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, keepalive.Organization)
+	ctx = context.WithValue(ctx, types.EnvironmentKey, keepalive.Environment)
+	return k.Store.GetEntityByID(ctx, keepalive.EntityID)
+}
+
 func createKeepaliveEvent(entity *types.Entity) *types.Event {
 	keepaliveCheck := &types.Check{
 		Name:         KeepaliveCheckName,
@@ -294,13 +439,18 @@ func createRegistrationEvent(entity *types.Entity) *types.Event {
 	return registrationEvent
 }
 
-// HandleUpdate sets the entity's last seen time and publishes an OK check event
-// to the message bus.
+// HandleUpdate postpones the entity's keepalive expiration, sets its last seen
+// time and publishes an OK check event to the message bus.
 func (k *Keepalived) HandleUpdate(e *types.Event) error {
 	entity := e.Entity
 
 	ctx := types.SetContextFromResource(context.Background(), entity)
-	if err := k.Store.DeleteFailingKeepalive(ctx, e.Entity); err != nil {
+	if entity.KeepaliveTimeout == 0 {
+		entity.KeepaliveTimeout = k.defaultKeepaliveTimeout(ctx)
+	}
+
+	expiration := time.Now().Unix() + int64(entity.KeepaliveTimeout)
+	if err := k.Store.UpdateKeepalive(ctx, entity, expiration); err != nil {
 		return err
 	}
 
@@ -315,8 +465,34 @@ func (k *Keepalived) HandleUpdate(e *types.Event) error {
 	return k.MessageBus.Publish(messaging.TopicEventRaw, event)
 }
 
+// defaultKeepaliveTimeout returns the keepalive timeout of the entities without
+// one in the organization of the context, or DefaultKeepaliveTimeout if none
+// was configured.
+func (k *Keepalived) defaultKeepaliveTimeout(ctx context.Context) uint32 {
+	config, err := k.clusterConfig().Get(ctx)
+	if err != nil {
+		logger.WithError(err).Error("could not retrieve the default keepalive timeout")
+		return DefaultKeepaliveTimeout
+	}
+
+	if config.KeepaliveTimeout == 0 {
+		return DefaultKeepaliveTimeout
+	}
+	return config.KeepaliveTimeout
+}
+
+// clusterConfig returns the cache of the defaults, or an unwatched one fetching
+// the defaults from the store if it is not set.
+func (k *Keepalived) clusterConfig() *store.ClusterConfigCache {
+	if k.ClusterConfig == nil {
+		return store.NewClusterConfigCache(k.Store)
+	}
+	return k.ClusterConfig
+}
+
 // HandleFailure checks if the entity should be deregistered, and emits a
-// keepalive event if the entity is still valid.
+// keepalive event if the entity is still valid. The event is emitted again
+// every keepalive timeout until the entity sends a keepalive.
 func (k *Keepalived) HandleFailure(entity *types.Entity, _ *types.Event) error {
 	// Note, we don't need to use the event parameter here as we're
 	// constructing new one instead.
@@ -338,6 +514,6 @@ func (k *Keepalived) HandleFailure(entity *types.Entity, _ *types.Event) error {
 		return err
 	}
 
-	timeout := time.Now().Unix() + int64(entity.KeepaliveTimeout)
-	return k.Store.UpdateFailingKeepalive(ctx, entity, timeout)
+	expiration := time.Now().Unix() + int64(entity.KeepaliveTimeout)
+	return k.Store.UpdateKeepalive(ctx, entity, expiration)
 }
@@ -2,11 +2,8 @@ package keepalived
 
 import (
 	"testing"
-	"time"
 
 	"github.com/sensu/sensu-go/backend/messaging"
-	"github.com/sensu/sensu-go/backend/monitor"
-	"github.com/sensu/sensu-go/testing/mockmonitor"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
@@ -42,12 +39,6 @@ func (suite *KeepalivedTestSuite) SetupTest() {
 		MessageBus: suite.MessageBus,
 	}
 
-	keepalived.MonitorFactory = func(*types.Entity, *types.Event, time.Duration, monitor.UpdateHandler, monitor.FailureHandler) monitor.Interface {
-		mon := &mockmonitor.MockMonitor{}
-		mon.On("HandleUpdate", mock.Anything).Return(nil)
-		return mon
-	}
-
 	suite.Keepalived = keepalived
 }
 
@@ -57,121 +48,93 @@ func (suite *KeepalivedTestSuite) AfterTest() {
 }
 
 func (suite *KeepalivedTestSuite) TestStartStop() {
-	failingEvent := func(e *types.Event) *types.Event {
-		e.Check.Status = 1
-		return e
-	}
+	k := &Keepalived{}
+	suite.Error(k.Start())
 
-	tt := []struct {
-		name     string
-		records  []*types.KeepaliveRecord
-		events   []*types.Event
-		monitors int
-	}{
-		{
-			name:     "No Keepalives",
-			records:  nil,
-			events:   nil,
-			monitors: 0,
-		},
-		{
-			name: "Passing Keepalives",
-			records: []*types.KeepaliveRecord{
-				{
-					EntityID:     "entity1",
-					Organization: "org",
-					Time:         0,
-				},
-				{
-					EntityID:     "entity2",
-					Organization: "org",
-					Time:         0,
-				},
-			},
-			events: []*types.Event{
-				types.FixtureEvent("entity1", "keepalive"),
-				types.FixtureEvent("entity2", "keepalive"),
-			},
-			monitors: 0,
-		},
-		{
-			name: "Failing Keepalives",
-			records: []*types.KeepaliveRecord{
-				{
-					EntityID:     "entity1",
-					Organization: "org",
-					Time:         0,
-				},
-				{
-					EntityID:     "entity2",
-					Organization: "org",
-					Time:         0,
-				},
-			},
-			events: []*types.Event{
-				failingEvent(types.FixtureEvent("entity1", "keepalive")),
-				failingEvent(types.FixtureEvent("entity2", "keepalive")),
-			},
-			monitors: 2,
-		},
-	}
-
-	t := suite.T()
-	for _, tc := range tt {
-		t.Run(tc.name, func(t *testing.T) {
-			k := &Keepalived{}
-			suite.Error(k.Start())
-
-			k.MessageBus = suite.MessageBus
-			suite.Error(k.Start())
+	k.MessageBus = suite.MessageBus
+	suite.Error(k.Start())
 
-			store := &mockstore.MockStore{}
-			store.On("GetFailingKeepalives", mock.Anything).Return(tc.records, nil)
-			for _, event := range tc.events {
-				store.On("GetEventByEntityCheck", mock.Anything, event.Entity.ID, "keepalive").Return(event, nil)
-				if event.Check.Status != 0 {
-					store.On("UpdateFailingKeepalive", mock.Anything, event.Entity, mock.AnythingOfType("int64")).Return(nil)
-				}
-			}
-
-			k.Store = store
-			suite.NoError(k.Start())
-			suite.NotNil(k.MonitorFactory, "*Keepalived.Start() ensures there is a MonitorFactory")
+	k.Store = suite.Store
+	suite.NoError(k.Start())
+	suite.Equal(DefaultSweepInterval, k.SweepInterval)
 
-			suite.NoError(k.Status())
-
-			var err error
-			select {
-			case err = <-k.Err():
-			default:
-			}
-			suite.NoError(err)
+	suite.NoError(k.Status())
 
-			suite.NoError(k.Stop())
-
-			suite.Equal(tc.monitors, len(k.monitors))
-		})
+	var err error
+	select {
+	case err = <-k.Err():
+	default:
 	}
+	suite.NoError(err)
 
+	suite.NoError(k.Stop())
 }
 
 func (suite *KeepalivedTestSuite) TestEventProcessing() {
-	suite.Store.On("GetFailingKeepalives", mock.Anything).Return([]*types.KeepaliveRecord{}, nil)
-	mon := &mockmonitor.MockMonitor{}
-	mon.On("HandleUpdate", mock.Anything).Return(nil)
-	suite.Keepalived.MonitorFactory = func(entity *types.Entity, event *types.Event, t time.Duration, updateHandler monitor.UpdateHandler, failureHandler monitor.FailureHandler) monitor.Interface {
-		return mon
-	}
 	suite.NoError(suite.Keepalived.Start())
 	event := types.FixtureEvent("entity", "keepalive")
 	event.Check.Status = 1
 
 	suite.Store.On("UpdateEntity", mock.Anything, event.Entity).Return(nil)
-	suite.Store.On("DeleteFailingKeepalive", mock.Anything, event.Entity).Return(nil)
+	suite.Store.On("UpdateKeepalive", mock.Anything, event.Entity, mock.AnythingOfType("int64")).Return(nil)
 
 	suite.Keepalived.keepaliveChan <- event
 	suite.NoError(suite.Keepalived.Stop())
-	mon.AssertCalled(suite.T(), "HandleUpdate", event)
+	suite.Store.AssertCalled(suite.T(), "UpdateKeepalive", mock.Anything, event.Entity, mock.AnythingOfType("int64"))
+}
+
+func (suite *KeepalivedTestSuite) TestDefaultKeepaliveTimeout() {
+	event := types.FixtureEvent("entity", "keepalive")
+	event.Entity.KeepaliveTimeout = 0
+
+	suite.Store.On("GetClusterConfig", mock.Anything).Return(types.FixtureClusterConfig(), nil)
+	suite.Store.On("GetOrganizationConfig", mock.Anything, event.Entity.Organization).Return(&types.ClusterConfig{}, nil)
+	suite.Store.On("UpdateEntity", mock.Anything, event.Entity).Return(nil)
+	suite.Store.On("UpdateKeepalive", mock.Anything, event.Entity, mock.AnythingOfType("int64")).Return(nil)
+
+	suite.NoError(suite.Keepalived.HandleUpdate(event))
+	suite.Equal(uint32(60), event.Entity.KeepaliveTimeout)
+
+	// The keepalive timeout of the organization overrides the cluster-wide one
+	event = types.FixtureEvent("entity", "keepalive")
+	event.Entity.KeepaliveTimeout = 0
+	event.Entity.Organization = "acme"
+	suite.Store.On("GetOrganizationConfig", mock.Anything, "acme").Return(&types.ClusterConfig{KeepaliveTimeout: 30}, nil)
+	suite.Store.On("UpdateEntity", mock.Anything, event.Entity).Return(nil)
+	suite.Store.On("UpdateKeepalive", mock.Anything, event.Entity, mock.AnythingOfType("int64")).Return(nil)
+
+	suite.NoError(suite.Keepalived.HandleUpdate(event))
+	suite.Equal(uint32(30), event.Entity.KeepaliveTimeout)
+}
+
+func (suite *KeepalivedTestSuite) TestSweep() {
+	entity1 := types.FixtureEntity("entity1")
+	entity2 := types.FixtureEntity("entity2")
+	records := []*types.KeepaliveRecord{
+		types.NewKeepaliveRecord(entity1, 0),
+		types.NewKeepaliveRecord(entity2, 0),
+		types.NewKeepaliveRecord(types.FixtureEntity("deleted"), 0),
+	}
+
+	suite.Store.On("GetExpiredKeepalives", mock.Anything, mock.AnythingOfType("int64")).Return(records, nil)
+	// entity2 was claimed by another backend
+	suite.Store.On("ClaimExpiredKeepalive", mock.Anything, records[0]).Return(true, nil)
+	suite.Store.On("ClaimExpiredKeepalive", mock.Anything, records[1]).Return(false, nil)
+	suite.Store.On("ClaimExpiredKeepalive", mock.Anything, records[2]).Return(true, nil)
+	suite.Store.On("GetEntityByID", mock.Anything, "entity1").Return(entity1, nil)
+	suite.Store.On("GetEntityByID", mock.Anything, "deleted").Return((*types.Entity)(nil), nil)
+	suite.Store.On("UpdateKeepalive", mock.Anything, entity1, mock.AnythingOfType("int64")).Return(nil)
+
+	eventChan := make(chan interface{}, 10)
+	suite.NoError(suite.MessageBus.Subscribe(messaging.TopicEventRaw, "test", eventChan))
+
+	suite.Keepalived.sweep()
+
+	suite.Require().Len(eventChan, 1)
+	event := (<-eventChan).(*types.Event)
+	suite.Equal("entity1", event.Entity.ID)
+	suite.Equal(int32(1), event.Check.Status)
+	suite.Store.AssertNotCalled(suite.T(), "GetEntityByID", mock.Anything, "entity2")
 }
 
 func TestKeepalivedSuite(t *testing.T) {
@@ -235,3 +198,52 @@ func TestProcessRegistration(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeStoredEntity(t *testing.T) {
+	store := &mockstore.MockStore{}
+	keepalived := &Keepalived{Store: store}
+
+	storeEntity := types.FixtureEntity("agent1")
+	storeEntity.System = types.System{Hostname: "agent1", OS: "linux", Arch: "amd64"}
+	storeEntity.ExtendedAttributes = []byte(`{"team":"dev","rack":12}`)
+	store.On("GetEntityByID", mock.Anything, "agent1").Return(storeEntity, nil)
+
+	entity := types.FixtureEntity("agent1")
+	entity.Partial = true
+	entity.System = types.System{Hostname: "agent1.local"}
+	entity.ExtendedAttributes = []byte(`{"team":"ops"}`)
+
+	require.NoError(t, keepalived.mergeStoredEntity(entity))
+	assert.False(t, entity.Partial)
+	assert.Equal(t, "agent1.local", entity.System.Hostname)
+	assert.Equal(t, "linux", entity.System.OS)
+	assert.Equal(t, "amd64", entity.System.Arch)
+	assert.JSONEq(t, `{"team":"ops","rack":12}`, string(entity.ExtendedAttributes))
+}
+
+func TestHandleDeregistration(t *testing.T) {
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	defer func() { _ = bus.Stop() }()
+
+	store := &mockstore.MockStore{}
+	keepalived := &Keepalived{Store: store, MessageBus: bus}
+
+	ephemeral := types.FixtureEntity("ephemeral")
+	ephemeral.Deregister = true
+	store.On("GetEntityByID", mock.Anything, "ephemeral").Return(ephemeral, nil)
+	store.On("GetEntityByID", mock.Anything, "permanent").Return(types.FixtureEntity("permanent"), nil)
+	store.On("GetEntityByID", mock.Anything, "deleted").Return((*types.Entity)(nil), nil)
+	store.On("DeleteEntity", mock.Anything, ephemeral).Return(nil)
+	store.On("DeleteKeepalive", mock.Anything, ephemeral).Return(nil)
+	store.On("GetEventsByEntity", mock.Anything, "ephemeral").Return([]*types.Event{}, nil)
+
+	// Only the ephemeral entities are deregistered
+	require.NoError(t, keepalived.handleDeregistration(types.FixtureEntity("permanent")))
+	require.NoError(t, keepalived.handleDeregistration(types.FixtureEntity("deleted")))
+	store.AssertNotCalled(t, "DeleteEntity", mock.Anything, mock.Anything)
+
+	require.NoError(t, keepalived.handleDeregistration(types.FixtureEntity("ephemeral")))
+	store.AssertCalled(t, "DeleteEntity", mock.Anything, ephemeral)
+	store.AssertCalled(t, "DeleteKeepalive", mock.Anything, ephemeral)
+}
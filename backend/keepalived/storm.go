@@ -0,0 +1,236 @@
+package keepalived
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// DefaultStormThreshold is the default number of keepalive failure events
+	// published for individual entities per storm window.
+	DefaultStormThreshold = 100
+
+	// DefaultStormWindow is the default duration of the storm windows.
+	DefaultStormWindow = time.Minute
+
+	// KeepaliveSummaryEntityID is the ID of the proxy entity of the summaries
+	// of the keepalive failures, published during keepalive storms.
+	KeepaliveSummaryEntityID = "keepalives"
+
+	// maxSummaryEntities is the maximum number of entities listed in the output
+	// of a summary
+	maxSummaryEntities = 10
+)
+
+// A keepaliveSummary aggregates the keepalive failures of the entities of a
+// subscription during a storm.
+type keepaliveSummary struct {
+	organization string
+	environment  string
+	subscription string
+
+	// entities are the IDs of the entities which failed since the summary was
+	// raised
+	entities map[string]struct{}
+
+	// raised is true once the summary was published
+	raised bool
+
+	// published is true if the summary was published since its last change
+	published bool
+
+	// until is when the summary is resolved if none of its entities failed
+	// again by then
+	until time.Time
+}
+
+// initStorm sets the defaults of the storm protection.
+func (k *Keepalived) initStorm() {
+	if k.StormThreshold == 0 {
+		k.StormThreshold = DefaultStormThreshold
+	}
+	if k.StormWindow == 0 {
+		k.StormWindow = DefaultStormWindow
+	}
+	if k.summaries == nil {
+		k.summaries = make(map[string]*keepaliveSummary)
+	}
+}
+
+// handleFailures handles the keepalive failures of the entities found by a
+// sweep. They're published individually as long as there are fewer than
+// StormThreshold per storm window, otherwise they're aggregated in a summary
+// per subscription, published at most once per window, so a network partition
+// does not flood the pipeline with events. The summaries are resolved once
+// their entities stop failing.
+func (k *Keepalived) handleFailures(entities []*types.Entity, now time.Time) {
+	k.initStorm()
+
+	if now.Sub(k.stormWindowStart) >= k.StormWindow {
+		k.stormWindowStart = now
+		k.stormFailures = 0
+		k.publishSummaries(false)
+	}
+
+	if len(k.summaries) == 0 && k.stormFailures+len(entities) <= k.StormThreshold {
+		for _, entity := range entities {
+			if err := k.HandleFailure(entity, nil); err != nil {
+				logger.WithError(err).WithField("entity", entity.ID).Error("error handling keepalive failure")
+			}
+		}
+		k.stormFailures += len(entities)
+		k.resolveSummaries(now)
+		return
+	}
+
+	if len(entities) > 0 && len(k.summaries) == 0 {
+		logger.Warnf("keepalive storm: more than %d keepalive failures in %s, publishing summaries per subscription", k.StormThreshold, k.StormWindow)
+	}
+
+	for _, entity := range entities {
+		if err := k.summarizeFailure(entity, now); err != nil {
+			logger.WithError(err).WithField("entity", entity.ID).Error("error handling keepalive failure")
+		}
+	}
+
+	// The new summaries are published right away, the others once per window
+	k.publishSummaries(true)
+	k.resolveSummaries(now)
+}
+
+// summarizeFailure adds the failure of the entity to the summaries of its
+// subscriptions, instead of publishing its keepalive event.
+func (k *Keepalived) summarizeFailure(entity *types.Entity, now time.Time) error {
+	if entity.Deregister {
+		return k.HandleFailure(entity, nil)
+	}
+
+	subscriptions := []string{}
+	for _, subscription := range entity.Subscriptions {
+		if !strings.HasPrefix(subscription, "entity:") {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	if len(subscriptions) == 0 {
+		subscriptions = append(subscriptions, "")
+	}
+
+	until := now.Add(time.Duration(entity.KeepaliveTimeout)*time.Second + k.StormWindow)
+	for _, subscription := range subscriptions {
+		key := entity.Organization + "/" + entity.Environment + "/" + subscription
+		summary, ok := k.summaries[key]
+		if !ok {
+			summary = &keepaliveSummary{
+				organization: entity.Organization,
+				environment:  entity.Environment,
+				subscription: subscription,
+				entities:     make(map[string]struct{}),
+			}
+			k.summaries[key] = summary
+		}
+		if _, ok := summary.entities[entity.ID]; !ok {
+			summary.entities[entity.ID] = struct{}{}
+			summary.published = false
+		}
+		if until.After(summary.until) {
+			summary.until = until
+		}
+	}
+
+	// The entity fails again once its keepalive expires again
+	ctx := types.SetContextFromResource(context.Background(), entity)
+	expiration := now.Unix() + int64(entity.KeepaliveTimeout)
+	return k.Store.UpdateKeepalive(ctx, entity, expiration)
+}
+
+// publishSummaries publishes the summaries which changed since they were last
+// published, or only the new ones.
+func (k *Keepalived) publishSummaries(onlyNew bool) {
+	for _, summary := range k.summaries {
+		if summary.published || (onlyNew && summary.raised) {
+			continue
+		}
+		k.publishSummary(summary, 1)
+		summary.raised = true
+		summary.published = true
+	}
+}
+
+// resolveSummaries publishes the resolution of the summaries whose entities
+// stopped failing.
+func (k *Keepalived) resolveSummaries(now time.Time) {
+	for key, summary := range k.summaries {
+		if now.Before(summary.until) {
+			continue
+		}
+		k.publishSummary(summary, 0)
+		delete(k.summaries, key)
+	}
+}
+
+// publishSummary publishes the event of a summary with the given status.
+func (k *Keepalived) publishSummary(summary *keepaliveSummary, status int32) {
+	if err := k.MessageBus.Publish(messaging.TopicEventRaw, createSummaryEvent(summary, status, k.StormWindow)); err != nil {
+		logger.WithError(err).Error("error publishing keepalive summary")
+	}
+}
+
+// createSummaryEvent returns the event of a summary, whose check is named
+// after its subscription.
+func createSummaryEvent(summary *keepaliveSummary, status int32, window time.Duration) *types.Event {
+	name := KeepaliveCheckName
+	if summary.subscription != "" {
+		name += "-" + strings.Replace(summary.subscription, ":", "_", -1)
+	}
+
+	ids := make([]string, 0, len(summary.entities))
+	for id := range summary.entities {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var output string
+	if status == 0 {
+		output = "the entities resumed sending keepalives"
+	} else {
+		listed := ids
+		if len(listed) > maxSummaryEntities {
+			listed = listed[:maxSummaryEntities]
+		}
+		output = fmt.Sprintf("%d entities failed to send keepalives: %s", len(ids), strings.Join(listed, ", "))
+		if len(ids) > len(listed) {
+			output += fmt.Sprintf(" and %d more", len(ids)-len(listed))
+		}
+	}
+	if summary.subscription != "" {
+		output = fmt.Sprintf("subscription %s: %s", summary.subscription, output)
+	}
+
+	entity := &types.Entity{
+		ID:            KeepaliveSummaryEntityID,
+		Class:         types.EntityProxyClass,
+		Subscriptions: []string{},
+		Organization:  summary.organization,
+		Environment:   summary.environment,
+	}
+
+	return &types.Event{
+		Timestamp: time.Now().Unix(),
+		Entity:    entity,
+		Check: &types.Check{
+			Name:         name,
+			Interval:     uint32(window / time.Second),
+			Handlers:     []string{KeepaliveHandlerName},
+			Output:       output,
+			Status:       status,
+			Organization: summary.organization,
+			Environment:  summary.environment,
+		},
+	}
+}
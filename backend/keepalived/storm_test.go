@@ -0,0 +1,128 @@
+package keepalived
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newStormEntities(subscription string, n int) []*types.Entity {
+	entities := make([]*types.Entity, n)
+	for i := range entities {
+		entity := types.FixtureEntity(fmt.Sprintf("%s-%d", subscription, i))
+		entity.Subscriptions = []string{subscription, "entity:" + entity.ID}
+		entities[i] = entity
+	}
+	return entities
+}
+
+func drainEvents(ch chan interface{}) []*types.Event {
+	events := []*types.Event{}
+	for len(ch) > 0 {
+		events = append(events, (<-ch).(*types.Event))
+	}
+	return events
+}
+
+func TestKeepaliveStorm(t *testing.T) {
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	defer bus.Stop()
+
+	eventChan := make(chan interface{}, 100)
+	require.NoError(t, bus.Subscribe(messaging.TopicEventRaw, "test", eventChan))
+
+	st := &mockstore.MockStore{}
+	st.On("UpdateKeepalive", mock.Anything, mock.Anything, mock.AnythingOfType("int64")).Return(nil)
+
+	k := &Keepalived{
+		Store:          st,
+		MessageBus:     bus,
+		StormThreshold: 5,
+		StormWindow:    time.Minute,
+	}
+	now := time.Unix(1000, 0)
+
+	// Under the threshold, the failures are published individually
+	k.handleFailures(newStormEntities("web", 3), now)
+	events := drainEvents(eventChan)
+	require.Len(t, events, 3)
+	assert.Equal(t, KeepaliveCheckName, events[0].Check.Name)
+
+	// Above it, they're summarized per subscription
+	entities := append(newStormEntities("db", 12), newStormEntities("linux", 2)...)
+	k.handleFailures(entities, now.Add(time.Second))
+	events = drainEvents(eventChan)
+	require.Len(t, events, 2)
+	outputs := map[string]string{}
+	for _, event := range events {
+		assert.Equal(t, KeepaliveSummaryEntityID, event.Entity.ID)
+		assert.Equal(t, int32(1), event.Check.Status)
+		assert.Equal(t, []string{KeepaliveHandlerName}, event.Check.Handlers)
+		outputs[event.Check.Name] = event.Check.Output
+	}
+	assert.Contains(t, outputs["keepalive-db"], "12 entities failed to send keepalives")
+	assert.Contains(t, outputs["keepalive-db"], "and 2 more")
+	assert.Contains(t, outputs["keepalive-linux"], "2 entities failed to send keepalives")
+
+	// During the storm, the summaries are only updated once per window
+	k.handleFailures(newStormEntities("db", 13)[12:], now.Add(2*time.Second))
+	assert.Empty(t, drainEvents(eventChan))
+
+	k.handleFailures(nil, now.Add(time.Minute+time.Second))
+	events = drainEvents(eventChan)
+	require.Len(t, events, 1)
+	assert.Equal(t, "keepalive-db", events[0].Check.Name)
+	assert.Contains(t, events[0].Check.Output, "13 entities failed to send keepalives")
+
+	// The summaries are resolved once their entities stop failing, and the
+	// failures are then published individually again
+	k.handleFailures(nil, now.Add(4*time.Minute))
+	events = drainEvents(eventChan)
+	require.Len(t, events, 2)
+	for _, event := range events {
+		assert.Equal(t, int32(0), event.Check.Status)
+	}
+
+	k.handleFailures(newStormEntities("web", 1), now.Add(5*time.Minute))
+	events = drainEvents(eventChan)
+	require.Len(t, events, 1)
+	assert.Equal(t, "web-0", events[0].Entity.ID)
+}
+
+func TestKeepaliveStormDeregistration(t *testing.T) {
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	defer bus.Stop()
+
+	eventChan := make(chan interface{}, 100)
+	require.NoError(t, bus.Subscribe(messaging.TopicEventRaw, "test", eventChan))
+
+	entities := newStormEntities("web", 3)
+	entities[0].Deregister = true
+
+	st := &mockstore.MockStore{}
+	st.On("UpdateKeepalive", mock.Anything, mock.Anything, mock.AnythingOfType("int64")).Return(nil)
+	st.On("DeleteEntity", mock.Anything, entities[0]).Return(nil)
+	st.On("DeleteKeepalive", mock.Anything, entities[0]).Return(nil)
+	st.On("GetEventsByEntity", mock.Anything, entities[0].ID).Return([]*types.Event{}, nil)
+
+	k := &Keepalived{
+		Store:          st,
+		MessageBus:     bus,
+		StormThreshold: 1,
+	}
+	k.handleFailures(entities, time.Now())
+
+	st.AssertCalled(t, "DeleteEntity", mock.Anything, entities[0])
+	events := drainEvents(eventChan)
+	require.Len(t, events, 1)
+	assert.Contains(t, events[0].Check.Output, "2 entities failed to send keepalives: web-1, web-2")
+}
@@ -16,22 +16,36 @@ import (
 // concurrent with the next elected leader's.
 //
 // If this node is not the leader, Do will block until it is elected. This can
-// be terminated by calling Resign.
+// be terminated by calling Resign, or by cancelling ctx, in which case Do
+// returns the error of ctx. The context of f is also cancelled with ctx.
 //
 // Do can lead to etcd leader elections, which may also fail. These failures
 // will be returned as errors.
 //
 // Do will run f in its own goroutine after coordinating the work, but blocks
 // as if the function was being executed synchronously.
-func Do(f func(context.Context) error) error {
+func Do(ctx context.Context, f func(context.Context) error) error {
 	if override {
-		return f(context.Background())
+		return f(ctx)
 	}
 	if super == nil {
 		// Init not called.
 		return ErrNotInitialized
 	}
-	work := newWork(f)
-	super.Exec(work)
+	work := newWork(func(workCtx context.Context) error {
+		workCtx, cancel := context.WithCancel(workCtx)
+		defer cancel()
+		go func() {
+			select {
+			case <-ctx.Done():
+				cancel()
+			case <-workCtx.Done():
+			}
+		}()
+		return f(workCtx)
+	})
+	if err := super.Exec(ctx, work); err != nil {
+		return err
+	}
 	return work.Err()
 }
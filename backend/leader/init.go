@@ -18,6 +18,10 @@ var (
 	ErrNotInitialized = errors.New("package not initialized")
 )
 
+// sessionTTL is the time to live, in seconds, of the leader's etcd session.
+// Another node is elected at most this long after the leader goes away.
+const sessionTTL = 10
+
 var (
 	pkgMu    sync.Mutex
 	session  *concurrency.Session
@@ -38,7 +42,7 @@ func Initialize(c *clientv3.Client) error {
 	defer pkgMu.Unlock()
 	override = false
 	var err error
-	session, err = concurrency.NewSession(c)
+	session, err = concurrency.NewSession(c, concurrency.WithTTL(sessionTTL))
 	if err != nil {
 		return err
 	}
@@ -29,7 +29,7 @@ func TestDo(t *testing.T) {
 		return nil
 	}
 
-	require.NoError(t, Do(f))
+	require.NoError(t, Do(context.Background(), f))
 	require.True(t, workPerformed)
 
 	// Test that work is cancelled after resigning
@@ -45,7 +45,7 @@ func TestDo(t *testing.T) {
 	}
 
 	go func() {
-		Do(f)
+		Do(context.Background(), f)
 	}()
 
 	workWg.Wait()
@@ -89,8 +89,8 @@ func TestLeaderContention(t *testing.T) {
 	w1 := newWork(f)
 	w2 := newWork(g)
 
-	s1.Exec(w1)
-	go s2.Exec(w2)
+	s1.Exec(context.Background(), w1)
+	go s2.Exec(context.Background(), w2)
 
 	require.NoError(t, w1.Err())
 	require.True(t, workPerformed)
@@ -116,7 +116,7 @@ func TestLeaderContention(t *testing.T) {
 
 	w3 := newWork(h)
 
-	s1.Exec(w3)
+	s1.Exec(context.Background(), w3)
 
 	// simulate a loss of leadership during ongoing work
 	s1.isFollower <- struct{}{}
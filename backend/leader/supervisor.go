@@ -170,9 +170,16 @@ func (s *supervisor) Stop() error {
 	return s.election.Resign(context.Background())
 }
 
-// Exec sends the work its given to the work channel.
-func (s *supervisor) Exec(w *work) {
-	s.work <- w
+// Exec sends the work its given to the work channel. It returns the error of
+// ctx if it is cancelled before the work is accepted, i.e. before the
+// supervisor attains leadership.
+func (s *supervisor) Exec(ctx context.Context, w *work) error {
+	select {
+	case s.work <- w:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // WaitLeader blocks until the supervisor has attained leadership.
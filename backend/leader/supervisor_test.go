@@ -0,0 +1,17 @@
+package leader
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecCancelled(t *testing.T) {
+	// Nothing accepts the work while the supervisor isn't the leader
+	s := &supervisor{work: make(chan *work)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, s.Exec(ctx, newWork(nil)))
+}
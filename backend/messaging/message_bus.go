@@ -11,9 +11,22 @@ const (
 	// normalized by eventd.
 	TopicEvent = "sensu:event"
 
+	// TopicEventShard is the topic for the events of the shard of this
+	// backend, routed by shardd when the handling of the events is sharded
+	// across the backends.
+	TopicEventShard = "sensu:event-shard"
+
+	// TopicEventReplay is the topic for the stored events replayed through
+	// their handlers, which are only handled by pipelined.
+	TopicEventReplay = "sensu:event-replay"
+
 	// TopicKeepalive is the topic for keepalive events.
 	TopicKeepalive = "sensu:keepalive"
 
+	// TopicDeregistration is the topic for the entities whose agent asked to
+	// be deregistered when gracefully shut down.
+	TopicDeregistration = "sensu:deregistration"
+
 	// TopicEventRaw is the Session -> Eventd channel -- for raw events directly
 	// from agents, subscribe to this.
 	TopicEventRaw = "sensu:event-raw"
@@ -52,6 +65,12 @@ type MessageBus interface {
 	Publish(topic string, message interface{}) error
 }
 
+// ShardTopic returns the topic of the events forwarded by shardd to the given
+// member of the event handling shards.
+func ShardTopic(member string) string {
+	return fmt.Sprintf("%s:%s", TopicEventShard, member)
+}
+
 // SubscriptionTopic is a helper to determine the proper topic name for a
 // subscription based on the organization
 func SubscriptionTopic(org, env, sub string) string {
@@ -0,0 +1,32 @@
+package messaging
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var droppedMessages = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "sensu_bus_dropped_messages_total",
+		Help: "Number of messages dropped by the message bus because a subscriber was not ready to receive them.",
+	},
+	[]string{"topic"},
+)
+
+func init() {
+	prometheus.MustRegister(droppedMessages)
+}
+
+// metricTopic returns the topic label of a metric, grouping the check
+// subscription topics, and those of the event handling shards, together to
+// bound the number of label values.
+func metricTopic(topic string) string {
+	if strings.HasPrefix(topic, TopicSubscriptions) {
+		return TopicSubscriptions
+	}
+	if strings.HasPrefix(topic, TopicEventShard) {
+		return TopicEventShard
+	}
+	return topic
+}
@@ -2,10 +2,35 @@ package messaging
 
 import (
 	"errors"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
+// DefaultBackpressureTimeout is the default time a publisher of events waits
+// for a busy subscriber.
+const DefaultBackpressureTimeout = time.Second
+
+// ErrBackpressure is returned by Publish when an event was dropped because a
+// subscriber did not accept it within the backpressure timeout.
+var ErrBackpressure = errors.New("subscriber is busy, message dropped")
+
+// backpressureTopics are the topics for which publishers wait for busy
+// subscribers, instead of dropping messages right away.
+var backpressureTopics = map[string]bool{
+	TopicEvent:       true,
+	TopicEventRaw:    true,
+	TopicEventReplay: true,
+}
+
+// appliesBackpressure returns true if the publishers of the topic wait for its
+// busy subscribers, which is the case of the event topics, including those of
+// the event handling shards.
+func appliesBackpressure(topic string) bool {
+	return backpressureTopics[topic] || strings.HasPrefix(topic, TopicEventShard)
+}
+
 // WizardBus is an in-memory message bus.
 //
 // For every topic, WizardBus creates a new goroutine responsible for fanning
@@ -14,7 +39,17 @@ import (
 // around a particular topic type. Care should be taken not to send multiple
 // message types over a single topic, however, as we do not want to introduce
 // a dependency on reflection to determine the type of the received interface{}.
+//
+// Publishing to the event topics applies backpressure: the publisher waits up
+// to BackpressureTimeout for a busy subscriber to accept the event, which
+// slows down the agent sessions that produce events during a burst instead of
+// queueing them without bounds.
 type WizardBus struct {
+	// BackpressureTimeout is how long Publish waits for a busy subscriber of
+	// an event topic. Messages to busy subscribers are dropped right away
+	// when zero.
+	BackpressureTimeout time.Duration
+
 	running *atomic.Value
 	mutex   *sync.RWMutex
 	errchan chan error
@@ -126,18 +161,33 @@ func (b *WizardBus) Unsubscribe(topic string, consumer string) error {
 }
 
 // Publish publishes a message to a topic. If the topic does not
-// exist, this is a noop.
+// exist, this is a noop. ErrBackpressure is returned if a message published to
+// an event topic was dropped.
 func (b *WizardBus) Publish(topic string, msg interface{}) error {
 	if !b.running.Load().(bool) {
 		return errors.New("bus no longer running")
 	}
 
 	b.mutex.RLock()
-	defer b.mutex.RUnlock()
+	wTopic, ok := b.topics[topic]
+	b.mutex.RUnlock()
+	if !ok {
+		return nil
+	}
 
-	if wTopic, ok := b.topics[topic]; ok {
-		wTopic.Send(msg)
+	var timeout time.Duration
+	if appliesBackpressure(topic) {
+		timeout = b.BackpressureTimeout
 	}
 
+	dropped := wTopic.Send(msg, timeout)
+	if dropped == 0 {
+		return nil
+	}
+
+	droppedMessages.WithLabelValues(metricTopic(topic)).Add(float64(dropped))
+	if appliesBackpressure(topic) {
+		return ErrBackpressure
+	}
 	return nil
 }
@@ -92,3 +92,32 @@ func BenchmarkSubscribe(b *testing.B) {
 		_ = bus.Stop()
 	}
 }
+
+func TestWizardBusBackpressure(t *testing.T) {
+	b := &WizardBus{BackpressureTimeout: 10 * time.Millisecond}
+	require.NoError(t, b.Start())
+	defer func() { _ = b.Stop() }()
+
+	ch := make(chan interface{}, 1)
+	require.NoError(t, b.Subscribe(TopicEventRaw, "eventd", ch))
+	require.NoError(t, b.Subscribe("topic", "consumer", ch))
+
+	// The first event is buffered
+	assert.NoError(t, b.Publish(TopicEventRaw, "event1"))
+
+	// The subscriber is busy, the second event is dropped after the timeout
+	start := time.Now()
+	assert.Equal(t, ErrBackpressure, b.Publish(TopicEventRaw, "event2"))
+	assert.True(t, time.Since(start) >= 10*time.Millisecond)
+
+	// Messages to other topics are dropped right away, without error
+	assert.NoError(t, b.Publish("topic", "message"))
+
+	// The publisher waits for the subscriber to be ready
+	go func() {
+		time.Sleep(time.Millisecond)
+		<-ch
+	}()
+	b.BackpressureTimeout = time.Second
+	assert.NoError(t, b.Publish(TopicEventRaw, "event3"))
+}
@@ -1,6 +1,9 @@
 package messaging
 
-import "sync"
+import (
+	"sync"
+	"time"
+)
 
 // WizardTopic encapsulates state around a WizardBus topic and its
 // consumer channel bindings.
@@ -9,19 +12,37 @@ type WizardTopic struct {
 	Bindings map[string]chan<- interface{}
 }
 
-// Send a message to all subscribers to this topic.
-func (wTopic *WizardTopic) Send(msg interface{}) {
+// Send a message to all subscribers to this topic. A subscriber that is not
+// ready to receive the message is given up to timeout to accept it, after
+// which the message is dropped for that subscriber. The number of subscribers
+// the message was dropped for is returned.
+func (wTopic *WizardTopic) Send(msg interface{}, timeout time.Duration) int {
 	wTopic.RLock()
+	defer wTopic.RUnlock()
 
+	dropped := 0
 	for _, ch := range wTopic.Bindings {
 		select {
 		case ch <- msg:
+			continue
 		default:
+		}
+
+		if timeout <= 0 {
+			dropped++
 			continue
 		}
+
+		timer := time.NewTimer(timeout)
+		select {
+		case ch <- msg:
+		case <-timer.C:
+			dropped++
+		}
+		timer.Stop()
 	}
 
-	wTopic.RUnlock()
+	return dropped
 }
 
 // Subscribe a channel, identified by a consumer name, to this topic.
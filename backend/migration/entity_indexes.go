@@ -0,0 +1,40 @@
+package migration
+
+import (
+	"context"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// entityIndexes adds the entries of the secondary indexes of the existing
+// entities, which are maintained by the store when the entities are updated.
+func entityIndexes(ctx context.Context, client *clientv3.Client) error {
+	key := store.NewKeyBuilder("entities").Build()
+	resp, err := client.Get(ctx, key+"/", clientv3.WithPrefix())
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range resp.Kvs {
+		entity := &types.Entity{}
+		if err := store.Decode(kv.Value, entity); err != nil {
+			logger.WithError(err).Info("error decoding entity: ", string(kv.Key))
+			continue
+		}
+
+		// Don't index the entity if it was updated in the meantime, since the
+		// store indexed it then
+		ops := []clientv3.Op{}
+		for _, indexKey := range store.EntityIndexKeys(entity) {
+			ops = append(ops, clientv3.OpPut(indexKey, string(kv.Key)))
+		}
+		cmp := clientv3.Compare(clientv3.ModRevision(string(kv.Key)), "=", kv.ModRevision)
+		if _, err := client.Txn(ctx).If(cmp).Then(ops...).Commit(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,11 +2,10 @@ package migration
 
 import (
 	"context"
-	"encoding/json"
 	"strings"
-	"time"
 
 	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -14,28 +13,17 @@ import (
 // breaking change introduced in https://github.com/sensu/sensu-go/pull/574,
 // which effectively prevent users to update their environments because the new
 // organization attribute is required.
-func environments(storeURL string) {
-	logger.Info("running environments migration")
-
-	client, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{storeURL},
-		DialTimeout: 5 * time.Second,
-	})
-
-	if err != nil {
-		logger.Fatal(err)
-	}
-
-	envsResponse, err := client.Get(context.Background(), "/sensu.io/environments", clientv3.WithPrefix())
+func environments(ctx context.Context, client *clientv3.Client) error {
+	envsResponse, err := client.Get(ctx, "/sensu.io/environments", clientv3.WithPrefix())
 	if err != nil {
-		logger.Fatal(err)
+		return err
 	}
 
 	for _, kv := range envsResponse.Kvs {
 		envBytes := kv.Value
 		env := &types.Environment{}
-		if err := json.Unmarshal(envBytes, env); err != nil {
-			logger.WithError(err).Info("error unmarshaling environment: ")
+		if err := store.Decode(envBytes, env); err != nil {
+			logger.WithError(err).Info("error decoding environment: ")
 			continue
 		}
 
@@ -47,13 +35,12 @@ func environments(storeURL string) {
 			}
 
 			env.Organization = pathParts[3]
-			envBytes, _ := json.Marshal(env)
-			_, err := client.Put(context.Background(), string(kv.Key), string(envBytes))
-			if err != nil {
-				logger.WithError(err).Info("error updating environment in store: ")
+			envBytes, _ := store.Encode(env)
+			if _, err := client.Put(ctx, string(kv.Key), string(envBytes)); err != nil {
+				return err
 			}
 		}
 	}
 
-	logger.Info("migration of environment completed")
+	return nil
 }
@@ -0,0 +1,75 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// keepalives moves the keepalive records stored as JSON per entity by previous
+// versions, under keepalives/:member/:org/:env/:id where member is the name of
+// the etcd member of the backend which monitored them, to the keepalive
+// deadlines swept by every backend, so their monitoring resumes once upgraded.
+func keepalives(ctx context.Context, client *clientv3.Client) error {
+	keepalivesPath := store.NewKeyBuilder("keepalives").Build()
+	resp, err := client.Get(ctx, keepalivesPath+"/", clientv3.WithPrefix())
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range resp.Kvs {
+		parts := strings.Split(strings.TrimPrefix(string(kv.Key), keepalivesPath+"/"), "/")
+		if parts[0] == "deadlines" || parts[0] == "entities" || len(parts) != 4 {
+			// The deadlines and their index are already migrated
+			continue
+		}
+
+		keepalive := &types.KeepaliveRecord{}
+		if err := json.Unmarshal(kv.Value, keepalive); err != nil {
+			logger.WithError(err).Info("error decoding keepalive: ", string(kv.Key))
+			continue
+		}
+		value, err := store.Encode(keepalive)
+		if err != nil {
+			return err
+		}
+
+		deadlinePath := path.Join(
+			keepalivesPath,
+			"deadlines",
+			fmt.Sprintf("%020d", keepalive.Time),
+			keepalive.Organization,
+			keepalive.Environment,
+			keepalive.EntityID,
+		)
+		indexPath := path.Join(
+			keepalivesPath,
+			"entities",
+			keepalive.Organization,
+			keepalive.Environment,
+			keepalive.EntityID,
+		)
+
+		// Don't replace the deadline of an entity which sent a keepalive to an
+		// upgraded backend in the meantime
+		cmp := clientv3.Compare(clientv3.CreateRevision(indexPath), "=", 0)
+		_, err = client.Txn(ctx).If(cmp).Then(
+			clientv3.OpPut(deadlinePath, string(value)),
+			clientv3.OpPut(indexPath, deadlinePath),
+			clientv3.OpDelete(string(kv.Key)),
+		).Else(
+			clientv3.OpDelete(string(kv.Key)),
+		).Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
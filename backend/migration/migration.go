@@ -1,12 +1,128 @@
 package migration
 
-import "github.com/Sirupsen/logrus"
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+)
 
 var logger = logrus.WithFields(logrus.Fields{
 	"component": "migration",
 })
 
-// Run lauches the migration process
-func Run(storeURL string) {
-	environments(storeURL)
+// Migration is an upgrade of the store schema. Migrations must be idempotent,
+// since an interrupted upgrade applies its last migration again.
+type Migration struct {
+	// Version is the schema version of the store once migrated
+	Version int
+
+	// Description describes the changes of the migration
+	Description string
+
+	// Migrate applies the migration to the store
+	Migrate func(ctx context.Context, client *clientv3.Client) error
+}
+
+// Migrations are the migrations of the store schema, ordered by version. New
+// migrations must be appended with the next version.
+var Migrations = []Migration{
+	{
+		Version:     1,
+		Description: "add the organization of environments",
+		Migrate:     environments,
+	},
+	{
+		Version:     2,
+		Description: "store resources in the protobuf wire format",
+		Migrate:     protobuf,
+	},
+	{
+		Version:     3,
+		Description: "index the entities by class, subscription and label",
+		Migrate:     entityIndexes,
+	},
+	{
+		Version:     4,
+		Description: "move the keepalives to the deadlines swept by every backend",
+		Migrate:     keepalives,
+	},
+}
+
+// schemaVersionKey is the key of the schema version of the store
+var schemaVersionKey = store.NewKeyBuilder("schema").Build("version")
+
+// LatestVersion returns the schema version of the store once every migration
+// is applied.
+func LatestVersion() int {
+	if len(Migrations) == 0 {
+		return 0
+	}
+	return Migrations[len(Migrations)-1].Version
+}
+
+// SchemaVersion returns the schema version of the store, which is 0 if it was
+// never upgraded.
+func SchemaVersion(ctx context.Context, client *clientv3.Client) (int, error) {
+	resp, err := client.Get(ctx, schemaVersionKey)
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Kvs) == 0 {
+		return 0, nil
+	}
+
+	version, err := strconv.Atoi(string(resp.Kvs[0].Value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid store schema version: %s", err)
+	}
+	return version, nil
+}
+
+// Initialize records the latest schema version in a new store, which has no
+// data to migrate, so that it is never upgraded. It does nothing if the store
+// already holds data.
+func Initialize(ctx context.Context, client *clientv3.Client) error {
+	resp, err := client.Get(ctx, store.Root, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return err
+	}
+	if resp.Count > 0 {
+		return nil
+	}
+
+	_, err = client.Put(ctx, schemaVersionKey, strconv.Itoa(LatestVersion()))
+	return err
+}
+
+// Upgrade applies, in order, the migrations of a version greater than the
+// schema version of the store, and records the new version after each of
+// them.
+func Upgrade(ctx context.Context, client *clientv3.Client) error {
+	version, err := SchemaVersion(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range Migrations {
+		if migration.Version <= version {
+			continue
+		}
+
+		logger.Infof("running migration %d: %s", migration.Version, migration.Description)
+		if err := migration.Migrate(ctx, client); err != nil {
+			return fmt.Errorf("migration %d failed: %s", migration.Version, err)
+		}
+
+		if _, err := client.Put(ctx, schemaVersionKey, strconv.Itoa(migration.Version)); err != nil {
+			return err
+		}
+		version = migration.Version
+	}
+
+	logger.Infof("store schema is at version %d", version)
+	return nil
 }
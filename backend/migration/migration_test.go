@@ -0,0 +1,105 @@
+// +build integration,!race
+
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitialize(t *testing.T) {
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+	client, err := e.NewClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// New stores are at the latest version
+	require.NoError(t, Initialize(ctx, client))
+	version, err := SchemaVersion(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, LatestVersion(), version)
+}
+
+func TestUpgrade(t *testing.T) {
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+	client, err := e.NewClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Store a handler as JSON, like the first versions did
+	handler := types.FixtureHandler("handler1")
+	handlerBytes, err := json.Marshal(handler)
+	require.NoError(t, err)
+	key := store.NewKeyBuilder("handlers").WithResource(handler).Build(handler.Name)
+	_, err = client.Put(ctx, key, string(handlerBytes))
+	require.NoError(t, err)
+
+	// Store an entity without its index entries
+	entity := types.FixtureEntity("entity1")
+	entityBytes, err := json.Marshal(entity)
+	require.NoError(t, err)
+	entityKey := store.NewKeyBuilder("entities").WithResource(entity).Build(entity.ID)
+	_, err = client.Put(ctx, entityKey, string(entityBytes))
+	require.NoError(t, err)
+
+	// Store a keepalive per entity as JSON, under the name of the etcd member
+	// of the backend monitoring it, like the previous versions did
+	keepalive := types.NewKeepaliveRecord(entity, 1522799116)
+	keepaliveBytes, err := json.Marshal(keepalive)
+	require.NoError(t, err)
+	keepaliveKey := store.NewKeyBuilder("keepalives").Build("backend1", entity.Organization, entity.Environment, entity.ID)
+	_, err = client.Put(ctx, keepaliveKey, string(keepaliveBytes))
+	require.NoError(t, err)
+
+	// Stores holding data are not initialized, since they need to be upgraded
+	require.NoError(t, Initialize(ctx, client))
+	version, err := SchemaVersion(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, 0, version)
+
+	// Upgrading twice has the same result
+	for i := 0; i < 2; i++ {
+		require.NoError(t, Upgrade(ctx, client))
+
+		version, err = SchemaVersion(ctx, client)
+		require.NoError(t, err)
+		assert.Equal(t, LatestVersion(), version)
+
+		resp, err := client.Get(ctx, key)
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+		assert.False(t, store.IsJSON(resp.Kvs[0].Value))
+
+		result := &types.Handler{}
+		require.NoError(t, store.Decode(resp.Kvs[0].Value, result))
+		assert.Equal(t, handler, result)
+
+		indexKey := store.EntityIndexKey(store.EntityIndexSubscription, "linux", entity)
+		resp, err = client.Get(ctx, indexKey)
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+		assert.Equal(t, entityKey, string(resp.Kvs[0].Value))
+
+		keepalivesKey := store.NewKeyBuilder("keepalives").Build()
+		resp, err = client.Get(ctx, keepaliveKey)
+		require.NoError(t, err)
+		assert.Empty(t, resp.Kvs)
+		resp, err = client.Get(ctx, keepalivesKey+"/entities/default/default/entity1")
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+		deadlineKey := keepalivesKey + "/deadlines/00000000001522799116/default/default/entity1"
+		assert.Equal(t, deadlineKey, string(resp.Kvs[0].Value))
+		resp, err = client.Get(ctx, deadlineKey)
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+	}
+}
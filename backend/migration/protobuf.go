@@ -0,0 +1,73 @@
+package migration
+
+import (
+	"context"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// protobufResources are the key prefixes of the resources stored in the
+// protobuf wire format, and their constructors.
+var protobufResources = map[string]func() store.ProtoMessage{
+	"assets":        func() store.ProtoMessage { return &types.Asset{} },
+	"checks":        func() store.ProtoMessage { return &types.CheckConfig{} },
+	"entities":      func() store.ProtoMessage { return &types.Entity{} },
+	"environments":  func() store.ProtoMessage { return &types.Environment{} },
+	"errors":        func() store.ProtoMessage { return &types.Error{} },
+	"event-filters": func() store.ProtoMessage { return &types.EventFilter{} },
+	"events":        func() store.ProtoMessage { return &types.Event{} },
+	"handlers":      func() store.ProtoMessage { return &types.Handler{} },
+	"hooks":         func() store.ProtoMessage { return &types.HookConfig{} },
+	"mutators":      func() store.ProtoMessage { return &types.Mutator{} },
+	"organizations": func() store.ProtoMessage { return &types.Organization{} },
+	"roles":         func() store.ProtoMessage { return &types.Role{} },
+	"silenced":      func() store.ProtoMessage { return &types.Silenced{} },
+	"users":         func() store.ProtoMessage { return &types.User{} },
+}
+
+// protobuf performs a migration of the resources stored as JSON by previous
+// versions to the protobuf wire format. The store reads both formats, so the
+// values which can't be migrated are left as is.
+func protobuf(ctx context.Context, client *clientv3.Client) error {
+	for prefix, newResource := range protobufResources {
+		key := store.NewKeyBuilder(prefix).Build()
+		resp, err := client.Get(ctx, key+"/", clientv3.WithPrefix())
+		if err != nil {
+			return err
+		}
+
+		for _, kv := range resp.Kvs {
+			if !store.IsJSON(kv.Value) {
+				continue
+			}
+
+			resource := newResource()
+			if err := store.Decode(kv.Value, resource); err != nil {
+				logger.WithError(err).Info("error decoding resource: ", string(kv.Key))
+				continue
+			}
+
+			value, err := store.Encode(resource)
+			if err != nil {
+				logger.WithError(err).Info("error encoding resource: ", string(kv.Key))
+				continue
+			}
+
+			// Keep the lease of the value, and don't overwrite it if it was
+			// updated in the meantime
+			var opts []clientv3.OpOption
+			if kv.Lease != 0 {
+				opts = append(opts, clientv3.WithLease(clientv3.LeaseID(kv.Lease)))
+			}
+			cmp := clientv3.Compare(clientv3.ModRevision(string(kv.Key)), "=", kv.ModRevision)
+			req := clientv3.OpPut(string(kv.Key), string(value), opts...)
+			if _, err := client.Txn(ctx).If(cmp).Then(req).Commit(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package pipelined
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// assetsEnv installs the runtime assets required by a handler or mutator, and
+// returns the environment of its command, with the assets injected into PATH,
+// LD_LIBRARY_PATH & CPATH and followed by its own environment variables.
+func (p *Pipelined) assetsEnv(ctx context.Context, assetNames []string, envVars []string) ([]string, error) {
+	if len(assetNames) == 0 || p.AssetManager == nil {
+		return envVars, nil
+	}
+
+	allAssets, err := p.Store.GetAssets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the runtime assets: %s", err)
+	}
+
+	var assets []types.Asset
+	for _, asset := range allAssets {
+		if assetIsRelevant(asset, assetNames) {
+			assets = append(assets, *asset)
+		}
+	}
+
+	set := p.AssetManager.RegisterSet(assets)
+	if err := set.InstallAll(); err != nil {
+		return nil, fmt.Errorf("error installing dependencies: %s", err)
+	}
+
+	env := append([]string{}, set.Env()...)
+	return append(env, envVars...), nil
+}
+
+// assetIsRelevant returns true if the asset is one of the given runtime
+// assets, matching their names as schedulerd does for checks.
+func assetIsRelevant(asset *types.Asset, assetNames []string) bool {
+	for _, assetName := range assetNames {
+		if strings.HasPrefix(asset.Name, assetName) {
+			return true
+		}
+	}
+
+	return false
+}
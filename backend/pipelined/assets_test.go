@@ -0,0 +1,52 @@
+package pipelined
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/agent/assetmanager"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelinedAssetsEnv(t *testing.T) {
+	store := &mockstore.MockStore{}
+	entity := types.FixtureEntity("backend")
+	p := &Pipelined{
+		Store:        store,
+		AssetManager: assetmanager.New(t.Name(), entity),
+	}
+	ctx := context.Background()
+	envVars := []string{"FOO=bar"}
+
+	// Without runtime assets, the environment is left as is
+	env, err := p.assetsEnv(ctx, nil, envVars)
+	require.NoError(t, err)
+	assert.Equal(t, envVars, env)
+
+	// Assets which aren't relevant to the backend aren't installed
+	asset := types.FixtureAsset("ruby")
+	asset.Filters = []string{"entity.ID == 'agent'"}
+	store.On("GetAssets", mock.Anything).Return([]*types.Asset{asset}, nil).Once()
+
+	env, err = p.assetsEnv(ctx, []string{"ruby"}, envVars)
+	require.NoError(t, err)
+	assert.Equal(t, "FOO=bar", env[len(env)-1])
+
+	// The runtime assets must be retrieved
+	store.On("GetAssets", mock.Anything).Return([]*types.Asset{}, errors.New("error")).Once()
+	_, err = p.assetsEnv(ctx, []string{"ruby"}, envVars)
+	assert.Error(t, err)
+}
+
+func TestAssetIsRelevant(t *testing.T) {
+	asset := types.FixtureAsset("ruby-2-4-2")
+
+	assert.True(t, assetIsRelevant(asset, []string{"jq", "ruby"}))
+	assert.False(t, assetIsRelevant(asset, []string{"jq"}))
+	assert.False(t, assetIsRelevant(asset, nil))
+}
@@ -0,0 +1,54 @@
+package pipelined
+
+import (
+	"context"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/types"
+)
+
+// limitContacts counts the execution of the handler for each contact of the
+// event, and returns the event with the contacts which did not exceed the
+// contact rate limit of the handler. A nil event is returned when all the
+// contacts of the event exceeded it. The contacts whose executions can't be
+// counted are kept, so the failures of the store don't prevent notifications.
+func (p *Pipelined) limitContacts(handler *types.Handler, event *types.Event) *types.Event {
+	if handler.ContactRateLimit == 0 || len(event.Contacts) == 0 {
+		return event
+	}
+
+	ctx := types.SetContextFromResource(context.Background(), event.Entity)
+	window := handler.ContactWindow()
+
+	contacts := make([]string, 0, len(event.Contacts))
+	for _, contact := range event.Contacts {
+		count, err := p.Store.IncrementContactCounter(ctx, handler.Name, contact, window)
+		if err != nil {
+			logger.WithError(err).WithField("contact", contact).Warning("could not count the notifications of the contact")
+			contacts = append(contacts, contact)
+			continue
+		}
+
+		if count > int64(handler.ContactRateLimit) {
+			logger.WithFields(logrus.Fields{
+				"handler": handler.Name,
+				"contact": contact,
+			}).Debug("contact rate limit exceeded")
+			continue
+		}
+		contacts = append(contacts, contact)
+	}
+
+	if len(contacts) == 0 {
+		return nil
+	}
+	if len(contacts) == len(event.Contacts) {
+		return event
+	}
+
+	// The event is shared by the handlers, each of them limiting its own
+	// contacts
+	limited := *event
+	limited.Contacts = contacts
+	return &limited
+}
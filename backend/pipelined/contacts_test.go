@@ -0,0 +1,73 @@
+package pipelined
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// counterStore keeps the contact counters in memory.
+type counterStore struct {
+	*mockstore.MockStore
+	counts map[string]int64
+}
+
+func (s *counterStore) IncrementContactCounter(ctx context.Context, handler, contact string, window time.Duration) (int64, error) {
+	key := handler + "/" + contact
+	s.counts[key]++
+	return s.counts[key], nil
+}
+
+func TestLimitContacts(t *testing.T) {
+	store := &counterStore{MockStore: &mockstore.MockStore{}, counts: map[string]int64{}}
+	p := &Pipelined{Store: store}
+
+	handler := types.FixtureHandler("pagerduty")
+	handler.ContactRateLimit = 2
+	event := types.FixtureEvent("entity1", "check1")
+	event.Contacts = []string{"alice", "bob"}
+
+	assert.Equal(t, event, p.limitContacts(handler, event))
+	store.counts["pagerduty/bob"] = 2
+
+	// The contacts beyond the limit are removed from a copy of the event
+	limited := p.limitContacts(handler, event)
+	require.NotNil(t, limited)
+	assert.Equal(t, []string{"alice"}, limited.Contacts)
+	assert.Equal(t, []string{"alice", "bob"}, event.Contacts)
+
+	// The event isn't handled once all its contacts exceeded the limit
+	assert.Nil(t, p.limitContacts(handler, event))
+
+	// The contacts are counted per handler
+	slack := types.FixtureHandler("slack")
+	slack.ContactRateLimit = 2
+	assert.Equal(t, event, p.limitContacts(slack, event))
+
+	// Events without contacts and handlers without limit are left untouched
+	unlimited := types.FixtureHandler("email")
+	assert.Equal(t, event, p.limitContacts(unlimited, event))
+	event.Contacts = nil
+	assert.Equal(t, event, p.limitContacts(handler, event))
+}
+
+func TestLimitContactsStoreError(t *testing.T) {
+	store := &mockstore.MockStore{}
+	p := &Pipelined{Store: store}
+	store.On("IncrementContactCounter", mock.Anything, "pagerduty", "alice", time.Hour).Return(int64(0), errors.New("error"))
+
+	handler := types.FixtureHandler("pagerduty")
+	handler.ContactRateLimit = 1
+	event := types.FixtureEvent("entity1", "check1")
+	event.Contacts = []string{"alice"}
+
+	// The contacts are notified when they can't be counted
+	assert.Equal(t, event, p.limitContacts(handler, event))
+}
@@ -3,6 +3,10 @@ package pipelined
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Sirupsen/logrus"
@@ -21,10 +25,17 @@ func evaluateEventFilterStatement(event *types.Event, statement string) bool {
 	return result
 }
 
-// Returns true if the event should be filtered.
-func evaluateEventFilter(event *types.Event, filter *types.EventFilter) bool {
+// Returns true if the event should be filtered. The time windows of the filter
+// are evaluated in the environment, which can be nil.
+func evaluateEventFilter(event *types.Event, filter *types.EventFilter, env *types.Environment) bool {
 	if filter.When != nil {
-		inWindows, err := filter.When.InWindows(time.Now().UTC())
+		when, err := env.TimeWindows(filter.When)
+		if err != nil {
+			logger.WithField("filter", filter.Name).Error(err)
+			return false
+		}
+
+		inWindows, err := when.InWindows(time.Now().UTC())
 		if err != nil {
 			logger.WithField("filter", filter.Name).Error(err)
 			return false
@@ -76,6 +87,11 @@ func evaluateEventFilter(event *types.Event, filter *types.EventFilter) bool {
 // filterEvent filters a Sensu event, determining if it will continue
 // through the Sensu pipeline.
 func (p *Pipelined) filterEvent(handler *types.Handler, event *types.Event) bool {
+	// Filter the event if the handler does not handle its severity.
+	if !handler.HandlesSeverity(event) {
+		return true
+	}
+
 	// Iterate through all event filters, the event is filtered if
 	// a filter returns true.
 	for _, filterName := range handler.Filters {
@@ -107,6 +123,26 @@ func (p *Pipelined) filterEvent(handler *types.Handler, event *types.Event) bool
 			continue
 		}
 
+		// Do not filter the event if some of its metric points are
+		// anomalous.
+		if filterName == "is_anomaly" {
+			if !event.IsAnomalous() {
+				return true
+			}
+
+			continue
+		}
+
+		// Do not filter the event if its status or output changed since the
+		// last event handled.
+		if filterName == "output_changed" {
+			if !p.outputChanged(handler, event) {
+				return true
+			}
+
+			continue
+		}
+
 		// Retrieve the filter from the store with its name
 		ctx := types.SetContextFromResource(context.Background(), event.Entity)
 		filter, err := p.Store.GetEventFilterByName(ctx, filterName)
@@ -115,10 +151,21 @@ func (p *Pipelined) filterEvent(handler *types.Handler, event *types.Event) bool
 			return false
 		}
 
+		// The time windows of the filter are evaluated in the environment of
+		// the event, e.g. in its time zone
+		var env *types.Environment
+		if filter.When != nil {
+			env, err = p.Store.GetEnvironment(ctx, event.Entity.Organization, event.Entity.Environment)
+			if err != nil {
+				logger.WithError(err).Warning("could not retrieve the environment of the event")
+				return false
+			}
+		}
+
 		// Evaluated the filter, evaluating each of its
 		// statements against the event. The event is rejected
 		// if the product of all statements is true.
-		filtered := evaluateEventFilter(event, filter)
+		filtered := evaluateEventFilter(event, filter, env)
 		if filtered {
 			return true
 		}
@@ -126,3 +173,58 @@ func (p *Pipelined) filterEvent(handler *types.Handler, event *types.Event) bool
 
 	return false
 }
+
+// outputChanged returns true if the status or the normalized output of the
+// check differ from the ones of the last event of the entity and check handled
+// by the handler. The digest of the handled events is kept in the store, so the
+// repeated events are detected across the backends and their restarts.
+func (p *Pipelined) outputChanged(handler *types.Handler, event *types.Event) bool {
+	if !event.HasCheck() {
+		return true
+	}
+
+	ctx := types.SetContextFromResource(context.Background(), event.Entity)
+
+	prevDigest, err := p.Store.GetEventDigest(ctx, handler.Name, event.Entity.ID, event.Check.Name)
+	if err != nil {
+		logger.WithError(err).Warning("could not compare the event to the previous one")
+		return true
+	}
+
+	return prevDigest != p.eventDigest(ctx, event)
+}
+
+// updateEventDigest records the digest of the event handled by the handler, if
+// the handler only handles the events whose output changed.
+func (p *Pipelined) updateEventDigest(handler *types.Handler, event *types.Event) {
+	if !event.HasCheck() || !hasFilter(handler, "output_changed") {
+		return
+	}
+
+	ctx := types.SetContextFromResource(context.Background(), event.Entity)
+	digest := p.eventDigest(ctx, event)
+	if err := p.Store.UpdateEventDigest(ctx, handler.Name, event.Entity.ID, event.Check.Name, digest); err != nil {
+		logger.WithError(err).Warning("could not record the digest of the event")
+	}
+}
+
+// eventDigest returns the digest of the status and the normalized output of the
+// check of the event.
+func (p *Pipelined) eventDigest(ctx context.Context, event *types.Event) string {
+	output, err := p.clusterConfig().NormalizeOutput(ctx, event.Check.Output)
+	if err != nil {
+		logger.WithError(err).Warning("could not retrieve the output normalization")
+	}
+
+	sum := sha256.Sum256([]byte(strconv.Itoa(int(event.Check.Status)) + "\n" + strings.TrimSpace(output)))
+	return hex.EncodeToString(sum[:])
+}
+
+func hasFilter(handler *types.Handler, name string) bool {
+	for _, filterName := range handler.Filters {
+		if filterName == name {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,8 @@
 package pipelined
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPipelinedFilter(t *testing.T) {
@@ -191,10 +194,97 @@ func TestPipelinedFilter(t *testing.T) {
 	}
 }
 
+func TestPipelinedSeverityFilter(t *testing.T) {
+	p := &Pipelined{}
+
+	testCases := []struct {
+		name       string
+		status     int32
+		history    []types.CheckHistory
+		metrics    bool
+		severities []string
+		expected   bool
+	}{
+		{
+			name:     "No Severities",
+			status:   1,
+			expected: false,
+		},
+		{
+			name:       "Handled Severity",
+			status:     2,
+			severities: []string{"critical"},
+			expected:   false,
+		},
+		{
+			name:       "Unhandled Severity",
+			status:     1,
+			severities: []string{"critical"},
+			expected:   true,
+		},
+		{
+			name:       "Unknown Severity",
+			status:     127,
+			severities: []string{"critical", "unknown"},
+			expected:   false,
+		},
+		{
+			name:   "Resolution Of Handled Severity",
+			status: 0,
+			history: []types.CheckHistory{
+				types.CheckHistory{Status: 2},
+			},
+			severities: []string{"critical"},
+			expected:   false,
+		},
+		{
+			name:   "Resolution Of Unhandled Severity",
+			status: 0,
+			history: []types.CheckHistory{
+				types.CheckHistory{Status: 1},
+			},
+			severities: []string{"critical"},
+			expected:   true,
+		},
+		{
+			name:       "Metrics Only",
+			metrics:    true,
+			severities: []string{"critical"},
+			expected:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		handler := &types.Handler{
+			Type:       "pipe",
+			Command:    "cat",
+			Severities: tc.severities,
+		}
+
+		t.Run(tc.name, func(t *testing.T) {
+			event := &types.Event{
+				Check: &types.Check{
+					Status:  tc.status,
+					History: tc.history,
+				},
+				Entity: types.FixtureEntity("entity1"),
+			}
+			if tc.metrics {
+				event.Check = nil
+				event.Metrics = &types.Metrics{}
+			}
+
+			filtered := p.filterEvent(handler, event)
+			assert.Equal(t, tc.expected, filtered)
+		})
+	}
+}
+
 func TestPipelinedWhenFilter(t *testing.T) {
 	p := &Pipelined{}
 	store := &mockstore.MockStore{}
 	p.Store = store
+	store.On("GetEnvironment", mock.Anything, "default", "default").Return(types.FixtureEnvironment("default"), nil)
 
 	event := &types.Event{
 		Check: &types.Check{
@@ -281,3 +371,131 @@ func TestPipelinedWhenFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestPipelinedBusinessHoursFilter(t *testing.T) {
+	store := &mockstore.MockStore{}
+	p := &Pipelined{Store: store}
+
+	// The business hours are expressed in the time zone of the environment
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	now := time.Now().In(loc)
+
+	env := types.FixtureEnvironment("default")
+	env.TimeZone = "Asia/Tokyo"
+	env.BusinessHours = &types.TimeWindowWhen{
+		Days: types.TimeWindowDays{
+			All: []*types.TimeWindowTimeRange{{
+				Begin: now.Add(-time.Minute).Format("03:04PM"),
+				End:   now.Add(time.Minute).Format("03:04PM"),
+			}},
+		},
+	}
+	store.On("GetEnvironment", mock.Anything, "default", "default").Return(env, nil)
+
+	filter := &types.EventFilter{
+		Name:   "business_hours",
+		Action: types.EventFilterActionAllow,
+		When:   &types.TimeWindowWhen{BusinessHours: true},
+	}
+	store.On("GetEventFilterByName", mock.Anything, "business_hours").Return(filter, nil)
+
+	handler := types.FixtureHandler("slack")
+	handler.Filters = []string{"business_hours"}
+	event := types.FixtureEvent("entity1", "check1")
+	assert.False(t, p.filterEvent(handler, event))
+
+	env.BusinessHours.Days.All[0].Begin = now.Add(10 * time.Minute).Format("03:04PM")
+	env.BusinessHours.Days.All[0].End = now.Add(20 * time.Minute).Format("03:04PM")
+	assert.True(t, p.filterEvent(handler, event))
+}
+
+// digestStore keeps the event digests in memory.
+type digestStore struct {
+	*mockstore.MockStore
+	digests map[string]string
+}
+
+func (s *digestStore) GetEventDigest(ctx context.Context, handler, entity, check string) (string, error) {
+	return s.digests[handler+"/"+entity+"/"+check], nil
+}
+
+func (s *digestStore) UpdateEventDigest(ctx context.Context, handler, entity, check, digest string) error {
+	s.digests[handler+"/"+entity+"/"+check] = digest
+	return nil
+}
+
+func TestPipelinedOutputChangedFilter(t *testing.T) {
+	store := &digestStore{MockStore: &mockstore.MockStore{}, digests: map[string]string{}}
+	p := &Pipelined{Store: store}
+
+	config := &types.ClusterConfig{OutputNormalization: []string{`\d+ms`}}
+	store.On("GetClusterConfig", mock.Anything).Return(config, nil)
+	store.On("GetOrganizationConfig", mock.Anything, mock.Anything).Return(&types.ClusterConfig{}, nil)
+
+	handler := types.FixtureHandler("slack")
+	handler.Filters = []string{"output_changed"}
+	event := types.FixtureEvent("entity1", "check1")
+
+	// handle filters the event and records its digest, as if it was handled
+	handle := func(handler *types.Handler) bool {
+		if p.filterEvent(handler, event) {
+			return false
+		}
+		p.updateEventDigest(handler, event)
+		return true
+	}
+
+	event.Check.Output = "OK: 12ms"
+	assert.True(t, handle(handler))
+
+	// Identical events are filtered, once normalized
+	event.Check.Output = "OK: 25ms\n"
+	assert.False(t, handle(handler))
+
+	// The events are compared per handler
+	pagerduty := types.FixtureHandler("pagerduty")
+	pagerduty.Filters = []string{"output_changed"}
+	assert.True(t, handle(pagerduty))
+
+	// The events are compared to the last one handled
+	event.Check.Status = 2
+	assert.False(t, p.filterEvent(handler, event))
+	assert.True(t, handle(handler))
+
+	event.Check.Output = "CRITICAL: 30ms"
+	assert.True(t, handle(handler))
+	assert.False(t, handle(handler))
+
+	// Events without a check are never filtered
+	assert.False(t, p.filterEvent(handler, &types.Event{Entity: event.Entity, Metrics: &types.Metrics{}}))
+}
+
+func TestPipelinedOutputChangedFilterStoreError(t *testing.T) {
+	store := &mockstore.MockStore{}
+	p := &Pipelined{Store: store}
+
+	store.On("GetClusterConfig", mock.Anything).Return(&types.ClusterConfig{}, nil)
+	store.On("GetOrganizationConfig", mock.Anything, mock.Anything).Return(&types.ClusterConfig{}, nil)
+	store.On("GetEventDigest", mock.Anything, "slack", "entity1", "check1").Return("", errors.New("error"))
+
+	handler := types.FixtureHandler("slack")
+	handler.Filters = []string{"output_changed"}
+	event := types.FixtureEvent("entity1", "check1")
+
+	// Events are not filtered if they can't be compared
+	assert.False(t, p.filterEvent(handler, event))
+	assert.False(t, p.filterEvent(handler, event))
+}
+
+func TestPipelinedAnomalyFilter(t *testing.T) {
+	p := &Pipelined{}
+
+	handler := types.FixtureHandler("slack")
+	handler.Filters = []string{"is_anomaly"}
+	event := types.FixtureEvent("entity1", "check1")
+	assert.True(t, p.filterEvent(handler, event))
+
+	event.Annotations = map[string]string{types.AnomalyAnnotation: "true"}
+	assert.False(t, p.filterEvent(handler, event))
+}
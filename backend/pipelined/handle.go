@@ -2,21 +2,33 @@
 package pipelined
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/backend/usage"
 	"github.com/sensu/sensu-go/command"
 	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/util/tracing"
+	"go.opencensus.io/trace"
 )
 
 const (
 	// DefaultSocketTimeout specifies the default socket dial
 	// timeout in seconds for TCP and UDP handlers.
 	DefaultSocketTimeout uint32 = 60
+
+	// DefaultWebhookTimeout specifies the default request timeout in seconds
+	// for webhook handlers.
+	DefaultWebhookTimeout uint32 = 60
 )
 
 // handleEvent takes a Sensu event through a Sensu pipeline, filters
@@ -27,10 +39,27 @@ func (p *Pipelined) handleEvent(event *types.Event) error {
 	ctx := context.WithValue(context.Background(), types.OrganizationKey, event.Entity.Organization)
 	ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
 
+	ctx, span := trace.StartSpan(ctx, "pipelined.handleEvent")
+	defer span.End()
+	span.AddAttributes(
+		trace.StringAttribute("entity", event.Entity.ID),
+		trace.StringAttribute("organization", event.Entity.Organization),
+		trace.StringAttribute("environment", event.Entity.Environment),
+	)
+	if event.HasCheck() {
+		span.AddAttributes(trace.StringAttribute("check", event.Check.Name))
+	}
+
 	var handlerList []string
 
 	if event.HasCheck() {
 		handlerList = append(handlerList, event.Check.Handlers...)
+
+		// Checks without handlers nor pipelines use the default handlers of
+		// their organization
+		if len(event.Check.Handlers) == 0 && len(event.Check.Pipelines) == 0 {
+			handlerList = append(handlerList, p.defaultHandlers(ctx)...)
+		}
 	}
 
 	if event.HasMetrics() {
@@ -44,41 +73,128 @@ func (p *Pipelined) handleEvent(event *types.Event) error {
 	}
 
 	for _, handler := range handlers {
-		filtered := p.filterEvent(handler, event)
+		if err := p.processHandler(ctx, handler, event); err != nil {
+			return err
+		}
+	}
 
-		if filtered {
+	if event.HasCheck() {
+		for _, name := range event.Check.Pipelines {
+			if err := p.runPipeline(ctx, name, event); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// processHandler filters the event, mutates it and sends it to the handler.
+// Only an unknown handler type is returned as an error, the other errors
+// being logged.
+func (p *Pipelined) processHandler(ctx context.Context, handler *types.Handler, event *types.Event) error {
+	filtered := p.filterEvent(handler, event)
+
+	if filtered {
+		logger.WithFields(logrus.Fields{
+			"check":        event.Check.Name,
+			"entity":       event.Entity.ID,
+			"organization": event.Entity.Organization,
+			"environment":  event.Entity.Environment,
+		}).Debug("event filtered")
+		return nil
+	}
+
+	if p.throttle != nil {
+		if err := p.throttle.allow(handler, event); err != nil {
 			logger.WithFields(logrus.Fields{
+				"handler":      handler.Name,
 				"check":        event.Check.Name,
 				"entity":       event.Entity.ID,
 				"organization": event.Entity.Organization,
 				"environment":  event.Entity.Environment,
-			}).Debug("event filtered")
-			continue
+			}).WithError(err).Debug("event not handled")
+			return nil
 		}
+	}
+
+	limited := p.limitContacts(handler, event)
+	if limited == nil {
+		logger.WithFields(logrus.Fields{
+			"handler":      handler.Name,
+			"check":        event.Check.Name,
+			"entity":       event.Entity.ID,
+			"organization": event.Entity.Organization,
+			"environment":  event.Entity.Environment,
+		}).Debug("event not handled, all its contacts exceeded their rate limit")
+		return nil
+	}
+	event = limited
+
+	eventData, err := p.mutateEvent(handler, event)
 
-		eventData, err := p.mutateEvent(handler, event)
+	if err != nil {
+		return nil
+	}
+
+	logger.Debugf("sending event: %s to handler: %s", eventData, handler.Name)
+
+	_, handlerSpan := trace.StartSpan(ctx, "pipelined.handler")
+	defer handlerSpan.End()
+	handlerSpan.AddAttributes(
+		trace.StringAttribute("handler", handler.Name),
+		trace.StringAttribute("type", handler.Type),
+	)
+
+	err = p.executeHandler(handler, event, eventData)
+	if err == errUnknownHandlerType {
+		return err
+	}
+	if err != nil {
+		logger.Error(err)
+		tracing.SetError(handlerSpan, err)
+		p.publishHandlerFailure(handler, event, err)
+		return nil
+	}
 
+	p.updateEventDigest(handler, event)
+
+	return nil
+}
+
+// sendToHandler executes the handler once with the event data, according to
+// its type.
+func (p *Pipelined) sendToHandler(handler *types.Handler, event *types.Event, eventData []byte) error {
+	switch handler.Type {
+	case "pipe":
+		result, err := p.pipeHandler(handler, eventData)
 		if err != nil {
-			continue
+			return err
+		}
+		if result.Status != 0 {
+			return fmt.Errorf("pipelined failed to execute event pipe handler %s: exit status %d", handler.Name, result.Status)
 		}
+		return nil
+	case "tcp", "udp":
+		_, err := p.socketHandler(handler, eventData)
+		return err
+	case "webhook":
+		return p.webhookHandler(handler, event, eventData)
+	}
 
-		logger.Debugf("sending event: %s to handler: %s", eventData, handler.Name)
+	return errUnknownHandlerType
+}
 
-		switch handler.Type {
-		case "pipe":
-			if _, err := p.pipeHandler(handler, eventData); err != nil {
-				logger.Error(err)
-			}
-		case "tcp", "udp":
-			if _, err := p.socketHandler(handler, eventData); err != nil {
-				logger.Error(err)
-			}
-		default:
-			return errors.New("unknown handler type")
-		}
+// defaultHandlers returns the default handlers of the organization of the
+// context. No handlers are returned if they cannot be retrieved.
+func (p *Pipelined) defaultHandlers(ctx context.Context) []string {
+	config, err := p.clusterConfig().Get(ctx)
+	if err != nil {
+		logger.WithError(err).Error("could not retrieve the default handlers")
+		return nil
 	}
 
-	return nil
+	return config.DefaultHandlers
 }
 
 // expandHandlers turns a list of Sensu handler names into a list of
@@ -129,13 +245,33 @@ func (p *Pipelined) expandHandlers(ctx context.Context, handlers []string, level
 // pipeHandler fork/executes a child process for a Sensu pipe handler
 // command and writes the mutated eventData to it via STDIN.
 func (p *Pipelined) pipeHandler(handler *types.Handler, eventData []byte) (*command.Execution, error) {
+	ctx := types.SetContextFromResource(context.Background(), handler)
+	env, err := p.assetsEnv(ctx, handler.RuntimeAssets, handler.EnvVars)
+	if err != nil {
+		logger.Error("pipelined failed to execute event pipe handler: ", err.Error())
+		return nil, err
+	}
+
 	handlerExec := &command.Execution{}
 
 	handlerExec.Command = handler.Command
 	handlerExec.Timeout = int(handler.Timeout)
-	handlerExec.Env = handler.EnvVars
+	handlerExec.Env = env
+
+	if p.HandlerSandbox != nil {
+		handlerExec.Sandbox = p.HandlerSandbox
+		handlerExec.Env = p.sandboxEnv(handler, env)
+	}
 
 	handlerExec.Input = string(eventData[:])
+	handlerExec.OutputLimit = p.OutputLimit
+
+	if p.StreamOutput {
+		handlerLogger := logger.WithField("handler", handler.Name)
+		handlerExec.OutputStream = func(line string) {
+			handlerLogger.WithField("output", line).Debug("pipe handler output")
+		}
+	}
 
 	result, err := command.ExecuteCommand(context.Background(), handlerExec)
 
@@ -143,11 +279,65 @@ func (p *Pipelined) pipeHandler(handler *types.Handler, eventData []byte) (*comm
 		logger.Error("pipelined failed to execute event pipe handler: ", err.Error())
 	} else {
 		logger.Infof("pipelined executed event pipe handler: status=%x output=%s", result.Status, result.Output)
+		if result.OutputTruncated {
+			logger.WithField("handler", handler.Name).Warningf("pipe handler output truncated to %d bytes", p.OutputLimit)
+		}
+		p.recordExecution(handler, result)
 	}
 
 	return result, err
 }
 
+// recordExecution accounts the resources used by an execution of a pipe
+// handler.
+func (p *Pipelined) recordExecution(handler *types.Handler, result *command.Execution) {
+	handlerCPUSeconds.WithLabelValues(handler.Organization, handler.Environment, handler.Name).Add(result.CPUTime)
+
+	if p.Usage != nil {
+		p.Usage.RecordExecution(
+			handler.Organization,
+			handler.Environment,
+			usage.KindHandler,
+			handler.Name,
+			result.CPUTime,
+			result.Duration,
+			result.MaxRSS,
+		)
+	}
+}
+
+// sandboxEnv restricts the environment of a sandboxed pipe handler, inherited
+// from the backend, to PATH, the variables injected by its runtime assets, the
+// allowed variables and its own variables.
+func (p *Pipelined) sandboxEnv(handler *types.Handler, env []string) []string {
+	// The environment of the backend is only included along with the runtime
+	// assets, see assetsEnv
+	if len(handler.RuntimeAssets) == 0 || p.AssetManager == nil {
+		env = append(os.Environ(), handler.EnvVars...)
+	}
+
+	allowed := map[string]bool{"PATH": true, "LD_LIBRARY_PATH": true, "CPATH": true}
+	for _, name := range p.HandlerSandboxEnv {
+		allowed[name] = true
+	}
+	for _, v := range handler.EnvVars {
+		allowed[strings.SplitN(v, "=", 2)[0]] = true
+	}
+
+	restricted := []string{}
+	for _, v := range env {
+		if allowed[strings.SplitN(v, "=", 2)[0]] {
+			restricted = append(restricted, v)
+		}
+	}
+
+	// An empty environment would be inherited
+	if len(restricted) == 0 {
+		restricted = append(restricted, "PATH=")
+	}
+	return restricted
+}
+
 // socketHandler creates either a TCP or UDP client to write eventData
 // to a socket. The provided handler Type determines the protocol.
 func (p *Pipelined) socketHandler(handler *types.Handler, eventData []byte) (conn net.Conn, err error) {
@@ -175,13 +365,48 @@ func (p *Pipelined) socketHandler(handler *types.Handler, eventData []byte) (con
 		}
 	}()
 
-	bytes, err := conn.Write(eventData)
+	if err = conn.SetDeadline(time.Now().Add(timeoutDuration)); err != nil {
+		return conn, err
+	}
 
+	bytes, err := conn.Write(eventData)
 	if err != nil {
-		logger.Errorf("pipelined failed to execute event %s handler: %v", protocol, err.Error())
-	} else {
-		logger.Debugf("pipelined executed event %s handler: bytes=%v", protocol, bytes)
+		return conn, fmt.Errorf("pipelined failed to execute event %s handler: %s", protocol, err)
 	}
 
+	logger.Debugf("pipelined executed event %s handler: bytes=%v", protocol, bytes)
 	return conn, nil
 }
+
+// webhookHandler posts eventData to the URL of a Sensu webhook handler. The
+// data is wrapped in a CloudEvent if the handler uses the cloudevents format.
+func (p *Pipelined) webhookHandler(handler *types.Handler, event *types.Event, eventData []byte) error {
+	contentType := "application/json"
+	if handler.Format == types.HandlerFormatCloudEvents {
+		var err error
+		eventData, err = json.Marshal(types.NewCloudEvent(event, eventData))
+		if err != nil {
+			return err
+		}
+		contentType = types.CloudEventsContentType
+	}
+
+	timeout := handler.Timeout
+	if timeout == 0 {
+		timeout = DefaultWebhookTimeout
+	}
+	client := &http.Client{Timeout: time.Duration(timeout) * time.Second}
+
+	resp, err := client.Post(handler.URL, contentType, bytes.NewReader(eventData))
+	if err != nil {
+		return fmt.Errorf("pipelined failed to execute event webhook handler: %s", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("pipelined failed to execute event webhook handler: %s responded with %s", handler.URL, resp.Status)
+	}
+
+	logger.Debugf("pipelined executed event webhook handler: status=%s", resp.Status)
+	return nil
+}
@@ -4,13 +4,18 @@ package pipelined
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/sensu/sensu-go/backend/usage"
+	"github.com/sensu/sensu-go/command"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
@@ -52,6 +57,9 @@ func TestPipelinedHandleEvent(t *testing.T) {
 		Check:  check,
 	}
 
+	store.On("GetClusterConfig", mock.Anything).Return(&types.ClusterConfig{}, nil)
+	store.On("GetOrganizationConfig", mock.Anything, entity.Organization).Return(&types.ClusterConfig{}, nil)
+
 	// Currently fire and forget. You may choose to return a map
 	// of handler execution information in the future, don't know
 	// how useful this would be.
@@ -62,6 +70,24 @@ func TestPipelinedHandleEvent(t *testing.T) {
 	assert.NoError(t, p.handleEvent(event))
 }
 
+func TestPipelinedDefaultHandlers(t *testing.T) {
+	p := &Pipelined{}
+
+	store := &mockstore.MockStore{}
+	p.Store = store
+
+	store.On("GetClusterConfig", mock.Anything).Return(types.FixtureClusterConfig(), nil).Twice()
+	assert.Equal(t, []string{"default"}, p.defaultHandlers(context.Background()))
+
+	// The default handlers of the organization override the cluster-wide ones
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, "acme")
+	store.On("GetOrganizationConfig", mock.Anything, "acme").Return(&types.ClusterConfig{DefaultHandlers: []string{"slack"}}, nil).Once()
+	assert.Equal(t, []string{"slack"}, p.defaultHandlers(ctx))
+
+	store.On("GetClusterConfig", mock.Anything).Return((*types.ClusterConfig)(nil), errors.New("error"))
+	assert.Empty(t, p.defaultHandlers(context.Background()))
+}
+
 func TestPipelinedExpandHandlers(t *testing.T) {
 	p := &Pipelined{}
 	store := &mockstore.MockStore{}
@@ -108,7 +134,7 @@ func TestPipelinedExpandHandlers(t *testing.T) {
 }
 
 func TestPipelinedPipeHandler(t *testing.T) {
-	p := &Pipelined{}
+	p := &Pipelined{Usage: usage.NewTracker()}
 
 	handler := types.FakeHandlerCommand("cat")
 	handler.Type = "pipe"
@@ -121,6 +147,54 @@ func TestPipelinedPipeHandler(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, string(eventData[:]), handlerExec.Output)
 	assert.Equal(t, 0, handlerExec.Status)
+
+	// The resources used by the handler execution are accounted for
+	resources := p.Usage.Resources()
+	require.Len(t, resources, 1)
+	assert.Equal(t, usage.KindHandler, resources[0].Kind)
+	assert.Equal(t, uint64(1), resources[0].Executions)
+}
+
+func TestPipelinedPipeHandlerOutputLimit(t *testing.T) {
+	p := &Pipelined{OutputLimit: 5, StreamOutput: true}
+
+	handler := types.FakeHandlerCommand("cat")
+	handler.Type = "pipe"
+
+	handlerExec, err := p.pipeHandler(handler, []byte("line 1\nline 2\n"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "line ", handlerExec.Output)
+	assert.True(t, handlerExec.OutputTruncated)
+	assert.Equal(t, 0, handlerExec.Status)
+}
+
+func TestPipelinedPipeHandlerSandboxEnv(t *testing.T) {
+	require.NoError(t, os.Setenv("SENSU_TEST_ALLOWED", "allowed"))
+	require.NoError(t, os.Setenv("SENSU_TEST_SECRET", "secret"))
+	defer func() {
+		_ = os.Unsetenv("SENSU_TEST_ALLOWED")
+		_ = os.Unsetenv("SENSU_TEST_SECRET")
+	}()
+
+	p := &Pipelined{
+		HandlerSandbox:    &command.Sandbox{},
+		HandlerSandboxEnv: []string{"SENSU_TEST_ALLOWED"},
+	}
+
+	handler := &types.Handler{
+		Type:    "pipe",
+		Command: "env",
+		EnvVars: []string{"SENSU_TEST_HANDLER=handler"},
+	}
+
+	// The backend variables are not passed unless allowed
+	handlerExec, err := p.pipeHandler(handler, []byte{})
+	require.NoError(t, err)
+	assert.Contains(t, handlerExec.Output, "SENSU_TEST_ALLOWED=allowed")
+	assert.Contains(t, handlerExec.Output, "SENSU_TEST_HANDLER=handler")
+	assert.Contains(t, handlerExec.Output, "PATH=")
+	assert.NotContains(t, handlerExec.Output, "SENSU_TEST_SECRET")
 }
 
 func TestPipelinedTcpHandler(t *testing.T) {
@@ -226,3 +300,42 @@ func TestPipelinedUdpHandler(t *testing.T) {
 	assert.NoError(t, err)
 	<-done
 }
+
+func TestPipelinedWebhookHandler(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests <- r
+		bodies <- body
+		if strings.HasSuffix(r.URL.Path, "/fail") {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	p := &Pipelined{}
+	event := types.FixtureEvent("entity1", "check1")
+	eventData, _ := json.Marshal(event)
+
+	handler := types.FixtureWebhookHandler("webhook", server.URL+"/events")
+	require.NoError(t, p.webhookHandler(handler, event, eventData))
+	r := <-requests
+	assert.Equal(t, http.MethodPost, r.Method)
+	assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+	assert.Equal(t, eventData, <-bodies)
+
+	// The event data is wrapped in a CloudEvent
+	handler.Format = types.HandlerFormatCloudEvents
+	require.NoError(t, p.webhookHandler(handler, event, eventData))
+	r = <-requests
+	assert.Equal(t, types.CloudEventsContentType, r.Header.Get("Content-Type"))
+	var c types.CloudEvent
+	require.NoError(t, json.Unmarshal(<-bodies, &c))
+	assert.Equal(t, types.CloudEventType, c.Type)
+	assert.Equal(t, "check1", c.Subject)
+	assert.JSONEq(t, string(eventData), string(c.Data))
+
+	handler.URL = server.URL + "/fail"
+	assert.Error(t, p.webhookHandler(handler, event, eventData))
+}
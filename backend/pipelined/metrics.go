@@ -0,0 +1,18 @@
+package pipelined
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var queueLength = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "sensu_pipelined_queue_length",
+	Help: "Number of events waiting to be handled by pipelined.",
+})
+
+var handlerCPUSeconds = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "sensu_pipelined_handler_cpu_seconds_total",
+	Help: "CPU time used by the executions of the pipe handlers.",
+}, []string{"organization", "environment", "handler"})
+
+func init() {
+	prometheus.MustRegister(queueLength)
+	prometheus.MustRegister(handlerCPUSeconds)
+}
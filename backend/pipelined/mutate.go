@@ -14,7 +14,7 @@ import (
 // format (byte slice) to be provided to a Sensu event handler.
 func (p *Pipelined) mutateEvent(handler *types.Handler, event *types.Event) ([]byte, error) {
 	if handler.Mutator == "" {
-		eventData, err := p.jsonMutator(event)
+		eventData, err := p.encodeEvent(handler, event)
 
 		if err != nil {
 			logger.Error("pipelined failed to mutate an event: ", err.Error())
@@ -43,7 +43,14 @@ func (p *Pipelined) mutateEvent(handler *types.Handler, event *types.Event) ([]b
 		return nil, err
 	}
 
-	eventData, err := p.pipeMutator(mutator, event)
+	eventData, err := p.encodeEvent(handler, event)
+
+	if err != nil {
+		logger.Error("pipelined failed to mutate an event: ", err.Error())
+		return nil, err
+	}
+
+	eventData, err = p.pipeMutator(mutator, eventData)
 
 	if err != nil {
 		logger.Error("pipelined failed to mutate an event: ", err.Error())
@@ -53,6 +60,16 @@ func (p *Pipelined) mutateEvent(handler *types.Handler, event *types.Event) ([]b
 	return eventData, nil
 }
 
+// encodeEvent produces the JSON encoding of the Sensu event expected by
+// the handler, i.e. in the Sensu 1.x format for legacy handlers.
+func (p *Pipelined) encodeEvent(handler *types.Handler, event *types.Event) ([]byte, error) {
+	if handler.Legacy {
+		return p.legacyMutator(event)
+	}
+
+	return p.jsonMutator(event)
+}
+
 // jsonMutator produces the JSON encoding of the Sensu event. This
 // mutator is used when a Sensu handler does not specify one.
 func (p *Pipelined) jsonMutator(event *types.Event) ([]byte, error) {
@@ -65,6 +82,19 @@ func (p *Pipelined) jsonMutator(event *types.Event) ([]byte, error) {
 	return eventData, nil
 }
 
+// legacyMutator produces the JSON encoding of the Sensu event in the
+// Sensu 1.x format, with client and check keys. This mutator is used
+// instead of the JSON mutator for legacy handlers.
+func (p *Pipelined) legacyMutator(event *types.Event) ([]byte, error) {
+	legacyEvent, err := event.LegacyEvent()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(legacyEvent)
+}
+
 // onlyCheckOutputMutator returns only the check output from the Sensu
 // event. This mutator is considered to be "built-in" (1.x parity), it
 // is most commonly used by tcp/udp handlers (e.g. influxdb). This
@@ -78,18 +108,18 @@ func (p *Pipelined) onlyCheckOutputMutator(event *types.Event) []byte {
 // command, writes the JSON encoding of the Sensu event to it via
 // STDIN, and captures the command output (STDOUT/ERR) to be used as
 // the mutated event data for a Sensu event handler.
-func (p *Pipelined) pipeMutator(mutator *types.Mutator, event *types.Event) ([]byte, error) {
+func (p *Pipelined) pipeMutator(mutator *types.Mutator, eventData []byte) ([]byte, error) {
+	ctx := types.SetContextFromResource(context.Background(), mutator)
+	env, err := p.assetsEnv(ctx, mutator.RuntimeAssets, mutator.EnvVars)
+	if err != nil {
+		return nil, err
+	}
+
 	mutatorExec := &command.Execution{}
 
 	mutatorExec.Command = mutator.Command
 	mutatorExec.Timeout = int(mutator.Timeout)
-	mutatorExec.Env = mutator.EnvVars
-
-	eventData, err := json.Marshal(event)
-
-	if err != nil {
-		return nil, err
-	}
+	mutatorExec.Env = env
 
 	mutatorExec.Input = string(eventData[:])
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestHelperMutatorProcess(t *testing.T) {
@@ -57,6 +58,31 @@ func TestPipelinedJsonMutator(t *testing.T) {
 	assert.Equal(t, expected, output)
 }
 
+func TestPipelinedLegacyMutate(t *testing.T) {
+	p := &Pipelined{}
+
+	handler := types.FakeHandlerCommand("cat")
+	handler.Type = "pipe"
+	handler.Legacy = true
+
+	event := types.FixtureEvent("entity1", "check1")
+
+	eventData, err := p.mutateEvent(handler, event)
+	require.NoError(t, err)
+
+	legacyEvent := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(eventData, &legacyEvent))
+	assert.Contains(t, legacyEvent, "client")
+	assert.Contains(t, legacyEvent, "check")
+	assert.NotContains(t, legacyEvent, "entity")
+
+	// Events without a check can't be translated
+	event.Check = nil
+	event.Metrics = &types.Metrics{}
+	_, err = p.mutateEvent(handler, event)
+	assert.Error(t, err)
+}
+
 func TestPipelinedOnlyCheckOutputMutator(t *testing.T) {
 	p := &Pipelined{}
 
@@ -95,11 +121,10 @@ func TestPipelinedPipeMutator(t *testing.T) {
 	mutator := types.FakeMutatorCommand("cat")
 
 	event := &types.Event{}
-
-	output, err := p.pipeMutator(mutator, event)
-
 	expected, _ := json.Marshal(event)
 
+	output, err := p.pipeMutator(mutator, expected)
+
 	assert.NoError(t, err)
 	assert.Equal(t, expected, output)
 }
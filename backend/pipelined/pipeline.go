@@ -0,0 +1,49 @@
+package pipelined
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// runPipeline takes the event through the workflows of the named pipeline,
+// in order. Like the handlers of the checks, a pipeline which can't be
+// retrieved is only logged.
+func (p *Pipelined) runPipeline(ctx context.Context, name string, event *types.Event) error {
+	pipeline, err := p.Store.GetPipelineByName(ctx, name)
+	if pipeline == nil {
+		if err != nil {
+			logger.Error("pipelined failed to retrieve a pipeline: ", err.Error())
+		} else {
+			logger.Error("pipelined failed to retrieve a pipeline: name= ", name)
+		}
+		return nil
+	}
+
+	for _, workflow := range pipeline.Workflows {
+		handlers, err := p.expandHandlers(ctx, []string{workflow.Handler}, 1)
+		if err != nil {
+			return err
+		}
+
+		for _, handler := range handlers {
+			if err := p.processHandler(ctx, workflowHandler(workflow, handler), event); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// workflowHandler returns a copy of the handler applying the filters of the
+// workflow along with its own, and the mutator of the workflow, if any,
+// instead of its own.
+func workflowHandler(workflow types.PipelineWorkflow, handler *types.Handler) *types.Handler {
+	h := *handler
+	h.Filters = append(append([]string{}, workflow.Filters...), handler.Filters...)
+	if workflow.Mutator != "" {
+		h.Mutator = workflow.Mutator
+	}
+	return &h
+}
@@ -0,0 +1,68 @@
+package pipelined
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowHandler(t *testing.T) {
+	handler := types.FixtureHandler("slack")
+	handler.Filters = []string{"not_silenced"}
+	handler.Mutator = "mutator1"
+
+	workflow := types.PipelineWorkflow{Name: "incidents", Filters: []string{"is_incident"}, Handler: "slack"}
+	h := workflowHandler(workflow, handler)
+	assert.Equal(t, []string{"is_incident", "not_silenced"}, h.Filters)
+	assert.Equal(t, "mutator1", h.Mutator)
+
+	workflow.Mutator = "only_check_output"
+	h = workflowHandler(workflow, handler)
+	assert.Equal(t, "only_check_output", h.Mutator)
+
+	// The handler is left untouched
+	assert.Equal(t, []string{"not_silenced"}, handler.Filters)
+	assert.Equal(t, "mutator1", handler.Mutator)
+}
+
+func TestPipelinedHandleEventPipelines(t *testing.T) {
+	bodies := make(chan []byte, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies <- body
+	}))
+	defer server.Close()
+
+	pipeline := types.FixturePipeline("alerts")
+	pipeline.Workflows[0].Mutator = "only_check_output"
+	pipeline.Workflows[0].Handler = "webhook"
+
+	store := &mockstore.MockStore{}
+	store.On("GetPipelineByName", mock.Anything, "alerts").Return(pipeline, nil)
+	store.On("GetPipelineByName", mock.Anything, "missing").Return((*types.Pipeline)(nil), nil)
+	store.On("GetHandlerByName", mock.Anything, "webhook").Return(types.FixtureWebhookHandler("webhook", server.URL), nil)
+	p := &Pipelined{Store: store}
+
+	// The checks referencing pipelines don't use the default handlers
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.Handlers = nil
+	event.Check.Pipelines = []string{"missing", "alerts"}
+	event.Check.Output = "CRITICAL"
+	event.Check.Status = 2
+	require.NoError(t, p.handleEvent(event))
+	require.Len(t, bodies, 1)
+	assert.Equal(t, "CRITICAL", string(<-bodies))
+	store.AssertNotCalled(t, "GetClusterConfig", mock.Anything)
+
+	// The events are filtered by the filters of the workflow
+	event.Check.Status = 0
+	require.NoError(t, p.handleEvent(event))
+	assert.Len(t, bodies, 0)
+}
@@ -5,16 +5,24 @@ import (
 	"errors"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/sensu/sensu-go/agent/assetmanager"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/usage"
+	"github.com/sensu/sensu-go/command"
 	"github.com/sensu/sensu-go/types"
 )
 
 const (
 	// PipelineCount specifies how many pipelines (goroutines) are
-	// in action.
+	// in action by default.
 	PipelineCount int = 10
+
+	// DefaultBufferSize is the default number of events queued for the
+	// pipelines before publishers are slowed down.
+	DefaultBufferSize = 100
 )
 
 // Pipelined handles incoming Sensu events and puts them through a
@@ -27,13 +35,59 @@ type Pipelined struct {
 	wg        *sync.WaitGroup
 	errChan   chan error
 	eventChan chan interface{}
+	throttle  *throttle
 
 	Store      store.Store
 	MessageBus messaging.MessageBus
+
+	// Topic is the message bus topic of the events handled, e.g. the topic
+	// of the shard of the backend. Defaults to messaging.TopicEvent.
+	Topic string
+
+	// WorkerCount is the number of pipelines handling events concurrently.
+	// Defaults to PipelineCount.
+	WorkerCount int
+
+	// BufferSize is the number of events queued for the pipelines. Defaults
+	// to DefaultBufferSize.
+	BufferSize int
+
+	// OutputLimit is the maximum number of bytes of output captured for each
+	// handler execution. The output is not limited if zero.
+	OutputLimit int
+
+	// StreamOutput enables the logging of each line of the handlers output,
+	// at the debug level, as the handlers write it.
+	StreamOutput bool
+
+	// AssetManager installs the runtime assets of handlers and mutators. The
+	// runtime assets are ignored if it is not set.
+	AssetManager *assetmanager.Manager
+
+	// DrainTimeout is the maximum time given to the pipelines to handle the
+	// queued events when pipelined is stopped. The queued events are
+	// discarded right away if zero.
+	DrainTimeout time.Duration
+
+	// HandlerSandbox constrains the execution of the pipe handlers, which
+	// otherwise run with the privileges and the environment of the backend.
+	HandlerSandbox *command.Sandbox
+
+	// HandlerSandboxEnv lists the environment variables of the backend passed
+	// to the sandboxed pipe handlers, besides PATH and their own variables.
+	HandlerSandboxEnv []string
+
+	// Usage, when set, accounts the resources used by the pipe handlers
+	Usage *usage.Tracker
+
+	// ClusterConfig caches the defaults of the organizations. The defaults
+	// are fetched from the Store for every event if it is not set.
+	ClusterConfig *store.ClusterConfigCache
 }
 
-// Start pipelined, subscribing to the "event" message bus topic to
-// pass Sensu events to the pipelines for handling (goroutines).
+// Start pipelined, subscribing to the "event" message bus topic, or its
+// Topic, and to the replayed events topic, to pass Sensu events to the
+// pipelines for handling (goroutines).
 func (p *Pipelined) Start() error {
 	if p.Store == nil {
 		return errors.New("no store found")
@@ -49,29 +103,85 @@ func (p *Pipelined) Start() error {
 
 	p.errChan = make(chan error, 1)
 
-	p.eventChan = make(chan interface{}, 100)
+	if p.WorkerCount == 0 {
+		p.WorkerCount = PipelineCount
+	}
+
+	if p.BufferSize == 0 {
+		p.BufferSize = DefaultBufferSize
+	}
+
+	if p.Topic == "" {
+		p.Topic = messaging.TopicEvent
+	}
+
+	p.eventChan = make(chan interface{}, p.BufferSize)
+	p.throttle = newThrottle()
 
-	if err := p.MessageBus.Subscribe(messaging.TopicEvent, "pipelined", p.eventChan); err != nil {
+	if err := p.MessageBus.Subscribe(p.Topic, "pipelined", p.eventChan); err != nil {
+		return err
+	}
+	if err := p.MessageBus.Subscribe(messaging.TopicEventReplay, "pipelined", p.eventChan); err != nil {
+		_ = p.MessageBus.Unsubscribe(p.Topic, "pipelined")
 		return err
 	}
 
-	p.createPipelines(PipelineCount, p.eventChan)
+	p.createPipelines(p.WorkerCount, p.eventChan)
 
 	return nil
 }
 
-// Stop pipelined.
+// clusterConfig returns the cache of the defaults, or an unwatched
+// one fetching the defaults from the store if it is not set.
+func (p *Pipelined) clusterConfig() *store.ClusterConfigCache {
+	if p.ClusterConfig == nil {
+		return store.NewClusterConfigCache(p.Store)
+	}
+	return p.ClusterConfig
+}
+
+// Stop pipelined. No more events are received, and the queued ones are
+// drained until the drain timeout elapses.
 func (p *Pipelined) Stop() error {
+	err := p.MessageBus.Unsubscribe(p.Topic, "pipelined")
+	if replayErr := p.MessageBus.Unsubscribe(messaging.TopicEventReplay, "pipelined"); err == nil {
+		err = replayErr
+	}
+	if !p.drain() {
+		logger.WithField("events", len(p.eventChan)).Warn("drain timeout reached, discarding the queued events")
+	}
+
 	p.running.Store(false)
 	close(p.stopping)
 	p.wg.Wait()
 	close(p.errChan)
-	err := p.MessageBus.Unsubscribe(messaging.TopicEvent, "pipelined")
 	close(p.eventChan)
 
 	return err
 }
 
+// drain waits until the pipelines have picked up all the queued events, or
+// the drain timeout elapses. It returns false if some events are still
+// queued.
+func (p *Pipelined) drain() bool {
+	if len(p.eventChan) == 0 {
+		return true
+	}
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	deadline := time.After(p.DrainTimeout)
+
+	for len(p.eventChan) > 0 {
+		select {
+		case <-ticker.C:
+		case <-deadline:
+			return false
+		}
+	}
+	return true
+}
+
 // Status returns an error if pipelined is unhealthy.
 func (p *Pipelined) Status() error {
 	return nil
@@ -95,6 +205,7 @@ func (p *Pipelined) createPipelines(count int, channel chan interface{}) {
 				case <-p.stopping:
 					return
 				case msg := <-channel:
+					queueLength.Set(float64(len(channel)))
 					event, ok := msg.(*types.Event)
 					if !ok {
 						continue
@@ -4,11 +4,14 @@ package pipelined
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/testing/mockbus"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,3 +47,38 @@ func TestPipelined(t *testing.T) {
 
 	assert.NoError(t, p.Stop())
 }
+
+func TestPipelinedReplayTopic(t *testing.T) {
+	bus := &mockbus.MockBus{}
+	bus.On("Subscribe", mock.Anything, "pipelined", mock.Anything).Return(nil)
+	bus.On("Unsubscribe", mock.Anything, "pipelined").Return(nil)
+	p := &Pipelined{Store: &mockstore.MockStore{}, MessageBus: bus, Topic: messaging.TopicEventShard}
+
+	// The replayed events are handled along with the events of the topic
+	require.NoError(t, p.Start())
+	bus.AssertCalled(t, "Subscribe", messaging.TopicEventShard, "pipelined", mock.Anything)
+	bus.AssertCalled(t, "Subscribe", messaging.TopicEventReplay, "pipelined", mock.Anything)
+
+	require.NoError(t, p.Stop())
+	bus.AssertCalled(t, "Unsubscribe", messaging.TopicEventShard, "pipelined")
+	bus.AssertCalled(t, "Unsubscribe", messaging.TopicEventReplay, "pipelined")
+}
+
+func TestPipelinedDrain(t *testing.T) {
+	p := &Pipelined{eventChan: make(chan interface{}, 1)}
+
+	// Nothing to drain
+	assert.True(t, p.drain())
+
+	// The queued events are discarded without a drain timeout
+	p.eventChan <- types.FixtureEvent("entity1", "check1")
+	assert.False(t, p.drain())
+
+	// The queued events are picked up before the drain timeout
+	p.DrainTimeout = time.Second
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		<-p.eventChan
+	}()
+	assert.True(t, p.drain())
+}
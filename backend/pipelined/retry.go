@@ -0,0 +1,103 @@
+package pipelined
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// HandlerFailureCheckName is the name of the check of the events emitted
+	// when a handler fails
+	HandlerFailureCheckName = "handler_failure"
+
+	// HandlerFailureHandlerName is the name of the handler of the events
+	// emitted when a handler fails, so they can be routed like any other event
+	HandlerFailureHandlerName = "handler_failure"
+)
+
+var errUnknownHandlerType = errors.New("unknown handler type")
+
+// executeHandler executes the handler with the event data and, while it
+// fails, executes it again up to its number of retries, waiting for its
+// backoff in between.
+func (p *Pipelined) executeHandler(handler *types.Handler, event *types.Event, eventData []byte) error {
+	for attempt := uint32(0); ; attempt++ {
+		err := p.sendToHandler(handler, event, eventData)
+		if err == nil || err == errUnknownHandlerType {
+			return err
+		}
+		if attempt >= handler.Retries {
+			return fmt.Errorf("%s (%d attempts)", err, attempt+1)
+		}
+
+		backoff := retryBackoff(handler, attempt)
+		logger.WithFields(logrus.Fields{
+			"handler": handler.Name,
+			"attempt": attempt + 1,
+			"backoff": backoff.String(),
+		}).WithError(err).Warn("handler failed, retrying")
+
+		select {
+		case <-time.After(backoff):
+		case <-p.stopping:
+			return err
+		}
+	}
+}
+
+// retryBackoff returns the time waited before the given retry of the
+// handler, doubled for each retry.
+func retryBackoff(handler *types.Handler, attempt uint32) time.Duration {
+	return time.Duration(handler.RetryBackoff) * time.Second << attempt
+}
+
+// publishHandlerFailure publishes an event reporting the failure of the
+// handler to handle the event, for eventd to store it and route it to the
+// handler_failure handler. The failures to handle those events are only
+// logged, to avoid loops.
+func (p *Pipelined) publishHandlerFailure(handler *types.Handler, event *types.Event, err error) {
+	if p.MessageBus == nil || event.Entity == nil {
+		return
+	}
+	if event.HasCheck() && event.Check.Name == HandlerFailureCheckName {
+		return
+	}
+
+	if err := p.MessageBus.Publish(messaging.TopicEventRaw, newHandlerFailureEvent(handler, event, err)); err != nil {
+		logger.WithError(err).Error("could not publish the handler failure event")
+	}
+}
+
+// newHandlerFailureEvent returns the event reporting the failure of the
+// handler to handle the event.
+func newHandlerFailureEvent(handler *types.Handler, event *types.Event, err error) *types.Event {
+	var interval uint32 = 60
+	output := fmt.Sprintf("handler %s failed: %s", handler.Name, err)
+	if event.HasCheck() {
+		if event.Check.Interval > 0 {
+			interval = event.Check.Interval
+		}
+		output = fmt.Sprintf("handler %s failed to handle the event of check %s: %s", handler.Name, event.Check.Name, err)
+	}
+
+	now := time.Now()
+	return &types.Event{
+		Timestamp: now.Unix(),
+		Entity:    event.Entity,
+		Check: &types.Check{
+			Name:         HandlerFailureCheckName,
+			Interval:     interval,
+			Handlers:     []string{HandlerFailureHandlerName},
+			Status:       2,
+			Output:       output,
+			Environment:  event.Entity.Environment,
+			Organization: event.Entity.Organization,
+			Executed:     now.Unix(),
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package pipelined
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	handler := types.FixtureHandler("handler")
+	assert.Equal(t, time.Duration(0), retryBackoff(handler, 2))
+
+	handler.RetryBackoff = 2
+	assert.Equal(t, 2*time.Second, retryBackoff(handler, 0))
+	assert.Equal(t, 4*time.Second, retryBackoff(handler, 1))
+	assert.Equal(t, 16*time.Second, retryBackoff(handler, 3))
+}
+
+func TestExecuteHandlerRetries(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first two requests fail
+		if atomic.AddInt32(&requests, 1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	p := &Pipelined{}
+	event := types.FixtureEvent("entity1", "check1")
+	eventData, _ := json.Marshal(event)
+	handler := types.FixtureWebhookHandler("webhook", server.URL)
+
+	handler.Retries = 1
+	err := p.executeHandler(handler, event, eventData)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "(2 attempts)")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	atomic.StoreInt32(&requests, 0)
+	handler.Retries = 2
+	require.NoError(t, p.executeHandler(handler, event, eventData))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	handler.Type = "grpc"
+	assert.Equal(t, errUnknownHandlerType, p.executeHandler(handler, event, eventData))
+}
+
+func TestPublishHandlerFailure(t *testing.T) {
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	events := make(chan interface{}, 10)
+	require.NoError(t, bus.Subscribe(messaging.TopicEventRaw, "test", events))
+
+	p := &Pipelined{MessageBus: bus}
+	handler := types.FixtureHandler("slack")
+	event := types.FixtureEvent("entity1", "check1")
+
+	p.publishHandlerFailure(handler, event, assert.AnError)
+	require.Len(t, events, 1)
+	failure := (<-events).(*types.Event)
+	require.NoError(t, failure.Validate())
+	assert.Equal(t, HandlerFailureCheckName, failure.Check.Name)
+	assert.Equal(t, []string{HandlerFailureHandlerName}, failure.Check.Handlers)
+	assert.Equal(t, int32(2), failure.Check.Status)
+	assert.Contains(t, failure.Check.Output, "handler slack failed to handle the event of check check1")
+	assert.Equal(t, "entity1", failure.Entity.ID)
+
+	// The failures to handle the failure events are not reported
+	p.publishHandlerFailure(handler, failure, assert.AnError)
+	assert.Len(t, events, 0)
+}
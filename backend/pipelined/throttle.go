@@ -0,0 +1,114 @@
+package pipelined
+
+import (
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"golang.org/x/time/rate"
+)
+
+// throttle decides whether a handler is executed for an event, according to
+// the rate limit and the deduplication window of the handler.
+type throttle struct {
+	mu       sync.Mutex
+	limiters map[string]*handlerLimiter
+	lastGC   time.Time
+
+	// handled maps the events handled to the end of their deduplication
+	// window
+	handled map[string]time.Time
+
+	// now returns the current time, it can be overridden in tests
+	now func() time.Time
+}
+
+// handlerLimiter is the rate limiter of a handler, along with the limit it was
+// created for so it can be replaced when the handler is updated.
+type handlerLimiter struct {
+	limit   uint32
+	limiter *rate.Limiter
+}
+
+func newThrottle() *throttle {
+	return &throttle{
+		limiters: map[string]*handlerLimiter{},
+		handled:  map[string]time.Time{},
+		now:      time.Now,
+	}
+}
+
+// allow returns an error describing why the event must not be handled by the
+// handler, or nil if it can be.
+func (t *throttle) allow(handler *types.Handler, event *types.Event) error {
+	if handler.RateLimit == 0 && handler.DedupWindow == 0 {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	handlerKey := path.Join(handler.Organization, handler.Environment, handler.Name)
+
+	var key string
+	if handler.DedupWindow > 0 {
+		t.gc(now)
+		key = dedupKey(handlerKey, event)
+		if until, ok := t.handled[key]; ok && now.Before(until) {
+			return fmt.Errorf("identical event already handled in the last %ds", handler.DedupWindow)
+		}
+	}
+
+	if handler.RateLimit > 0 {
+		l, ok := t.limiters[handlerKey]
+		if !ok || l.limit != handler.RateLimit {
+			l = &handlerLimiter{
+				limit:   handler.RateLimit,
+				limiter: rate.NewLimiter(rate.Every(time.Minute/time.Duration(handler.RateLimit)), int(handler.RateLimit)),
+			}
+			t.limiters[handlerKey] = l
+		}
+		if !l.limiter.AllowN(now, 1) {
+			return fmt.Errorf("rate limit of %d executions per minute exceeded", handler.RateLimit)
+		}
+	}
+
+	if key != "" {
+		t.handled[key] = now.Add(time.Duration(handler.DedupWindow) * time.Second)
+	}
+
+	return nil
+}
+
+// gc forgets the events whose deduplication window is over. It runs at most
+// once a minute.
+func (t *throttle) gc(now time.Time) {
+	if now.Sub(t.lastGC) < time.Minute {
+		return
+	}
+	t.lastGC = now
+
+	for key, until := range t.handled {
+		if !now.Before(until) {
+			delete(t.handled, key)
+		}
+	}
+}
+
+// dedupKey identifies the events that are considered identical for a handler.
+func dedupKey(handlerKey string, event *types.Event) string {
+	key := handlerKey
+	if event.Entity != nil {
+		key = path.Join(key, event.Entity.ID)
+	}
+	if event.HasCheck() {
+		key = fmt.Sprintf("%s/%s/%d", key, event.Check.Name, event.Check.Status)
+	}
+	if event.HasMetrics() {
+		key += "/metrics"
+	}
+	return key
+}
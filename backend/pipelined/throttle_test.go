@@ -0,0 +1,61 @@
+package pipelined
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThrottleUnlimited(t *testing.T) {
+	th := newThrottle()
+	handler := types.FixtureHandler("handler")
+	event := types.FixtureEvent("entity", "check")
+
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, th.allow(handler, event))
+	}
+}
+
+func TestThrottleRateLimit(t *testing.T) {
+	now := time.Now()
+	th := newThrottle()
+	th.now = func() time.Time { return now }
+
+	handler := types.FixtureHandler("handler")
+	handler.RateLimit = 2
+	event := types.FixtureEvent("entity", "check")
+
+	assert.NoError(t, th.allow(handler, event))
+	assert.NoError(t, th.allow(handler, event))
+	assert.Error(t, th.allow(handler, event))
+
+	// A token is added every 30 seconds
+	now = now.Add(30 * time.Second)
+	assert.NoError(t, th.allow(handler, event))
+	assert.Error(t, th.allow(handler, event))
+}
+
+func TestThrottleDedupWindow(t *testing.T) {
+	now := time.Now()
+	th := newThrottle()
+	th.now = func() time.Time { return now }
+
+	handler := types.FixtureHandler("handler")
+	handler.DedupWindow = 10
+	event := types.FixtureEvent("entity", "check")
+
+	assert.NoError(t, th.allow(handler, event))
+	assert.Error(t, th.allow(handler, event))
+
+	// Events from another entity or with another status are not identical
+	assert.NoError(t, th.allow(handler, types.FixtureEvent("other", "check")))
+	failing := types.FixtureEvent("entity", "check")
+	failing.Check.Status = 2
+	assert.NoError(t, th.allow(handler, failing))
+
+	// Identical events are handled again once the window is over
+	now = now.Add(10 * time.Second)
+	assert.NoError(t, th.allow(handler, event))
+}
@@ -0,0 +1,101 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryGetter provides in-memory queues. The queues with the same name are
+// shared by the callers of NewQueue, like the etcd queues, but they are not
+// durable nor shared by several backends.
+type MemoryGetter struct {
+	mu     sync.Mutex
+	queues map[string]*MemoryQueue
+}
+
+// NewMemoryGetter returns a new MemoryGetter.
+func NewMemoryGetter() *MemoryGetter {
+	return &MemoryGetter{queues: make(map[string]*MemoryQueue)}
+}
+
+// NewQueue returns the in-memory queue with the given name.
+func (g *MemoryGetter) NewQueue(name string) Interface {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	q, ok := g.queues[name]
+	if !ok {
+		q = NewMemoryQueue()
+		g.queues[name] = q
+	}
+	return q
+}
+
+// MemoryQueue is a FIFO queue in memory. Unlike the etcd queue, the items
+// are not tracked once dequeued, so the items which are neither acked nor
+// nacked are lost.
+type MemoryQueue struct {
+	mu    sync.Mutex
+	items []string
+
+	// ready is closed, and replaced, when an item is enqueued
+	ready chan struct{}
+}
+
+// NewMemoryQueue returns a new, empty MemoryQueue.
+func NewMemoryQueue() *MemoryQueue {
+	return &MemoryQueue{ready: make(chan struct{})}
+}
+
+// Enqueue adds a new value to the queue. It returns an error if the context
+// is canceled.
+func (q *MemoryQueue) Enqueue(ctx context.Context, value string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.items = append(q.items, value)
+	close(q.ready)
+	q.ready = make(chan struct{})
+
+	return nil
+}
+
+// Dequeue gets a value from the queue, waiting for one to be enqueued if it's
+// empty. It returns an error if the context is cancelled, or the deadline
+// exceeded.
+func (q *MemoryQueue) Dequeue(ctx context.Context) (*Item, error) {
+	for {
+		q.mu.Lock()
+		if len(q.items) > 0 {
+			value := q.items[0]
+			q.items = q.items[1:]
+			q.mu.Unlock()
+
+			return &Item{
+				Value:     value,
+				Timestamp: time.Now().UnixNano(),
+				memory:    q,
+			}, nil
+		}
+		ready := q.ready
+		q.mu.Unlock()
+
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Empty returns true if the queue holds no item. The items dequeued are not
+// tracked, so the queue is empty even if they are neither acked nor nacked.
+func (q *MemoryQueue) Empty(ctx context.Context) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items) == 0, nil
+}
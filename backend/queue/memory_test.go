@@ -0,0 +1,57 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryQueue(t *testing.T) {
+	getter := NewMemoryGetter()
+	queue := getter.NewQueue("test")
+	ctx := context.Background()
+
+	require.NoError(t, queue.Enqueue(ctx, "foo"))
+	require.NoError(t, queue.Enqueue(ctx, "bar"))
+
+	// The queues with the same name are shared
+	item, err := getter.NewQueue("test").Dequeue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", item.Value)
+
+	// A nacked item is enqueued again
+	require.NoError(t, item.Nack(ctx))
+	item, err = queue.Dequeue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "bar", item.Value)
+	require.NoError(t, item.Ack(ctx))
+	item, err = queue.Dequeue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", item.Value)
+	require.NoError(t, item.Ack(ctx))
+
+	empty, err := queue.Empty(ctx)
+	require.NoError(t, err)
+	assert.True(t, empty)
+}
+
+func TestMemoryQueueDequeueWaits(t *testing.T) {
+	queue := NewMemoryQueue()
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_ = queue.Enqueue(context.Background(), "foo")
+	}()
+
+	item, err := queue.Dequeue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "foo", item.Value)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = queue.Dequeue(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
@@ -28,6 +28,10 @@ var (
 type Interface interface {
 	Enqueue(ctx context.Context, value string) error
 	Dequeue(ctx context.Context) (*Item, error)
+
+	// Empty returns true if the queue holds no item, including the items in
+	// flight, which are returned to the queue unless they are acked.
+	Empty(ctx context.Context) (bool, error)
 }
 
 // Get interface provides access to a queue.
@@ -78,6 +82,7 @@ type Item struct {
 	Revision  int64
 	Timestamp int64
 	queue     *Queue
+	memory    *MemoryQueue
 	once      sync.Once
 	mu        *sync.Mutex
 	cancel    context.CancelFunc
@@ -88,9 +93,13 @@ type Item struct {
 func (i *Item) Ack(ctx context.Context) error {
 	var err error
 	i.once.Do(func() {
+		if i.memory != nil {
+			// The in-memory items are forgotten once dequeued
+			return
+		}
 		i.mu.Lock()
 		delCmp := clientv3.Compare(clientv3.ModRevision(i.Key), "=", i.Revision)
-		delReq := clientv3.OpDelete(i.Value)
+		delReq := clientv3.OpDelete(i.Key)
 		_, err = i.queue.kv.Txn(ctx).If(delCmp).Then(delReq).Commit()
 		i.mu.Unlock()
 		i.cancel()
@@ -103,6 +112,10 @@ func (i *Item) Ack(ctx context.Context) error {
 func (i *Item) Nack(ctx context.Context) error {
 	var err error
 	i.once.Do(func() {
+		if i.memory != nil {
+			err = i.memory.Enqueue(ctx, i.Value)
+			return
+		}
 		i.mu.Lock()
 		err = i.queue.swapLane(ctx, i.Key, i.Revision, i.Value, i.queue.work)
 		i.mu.Unlock()
@@ -134,14 +147,16 @@ func (i *Item) keepalive(ctx context.Context) {
 				putReq := clientv3.OpPut(updateKey, i.Value)
 				delReq := clientv3.OpDelete(i.Key)
 
-				_, err = i.queue.kv.Txn(ctx).If(putCmp, delCmp).Then(putReq, delReq).Commit()
+				response, err := i.queue.kv.Txn(ctx).If(putCmp, delCmp).Then(putReq, delReq).Commit()
 
 				if err != nil {
 					// log error
 					logger.WithError(err).Error("error updating item keepalive timestamp")
+				} else if response.Succeeded {
+					// The item is acked or nacked with its new key
+					i.Key = updateKey
+					i.Revision = response.Header.Revision
 				}
-
-				i.Key = updateKey
 				i.mu.Unlock()
 			case <-ctx.Done():
 				return
@@ -255,6 +270,24 @@ func (q *Queue) Dequeue(ctx context.Context) (*Item, error) {
 	return q.Dequeue(ctx)
 }
 
+// Empty returns true if neither the work lane nor the in-flight lane of the
+// queue hold an item.
+func (q *Queue) Empty(ctx context.Context) (bool, error) {
+	response, err := q.kv.Txn(ctx).Then(
+		clientv3.OpGet(q.work, clientv3.WithPrefix(), clientv3.WithCountOnly()),
+		clientv3.OpGet(q.inFlight, clientv3.WithPrefix(), clientv3.WithCountOnly()),
+	).Commit()
+	if err != nil {
+		return false, err
+	}
+	for _, r := range response.Responses {
+		if r.GetResponseRange().Count > 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func (q *Queue) getItemTimestamp(key []byte) (time.Time, error) {
 	binaryTimestamp := key[len(key)-8:]
 
@@ -261,3 +261,35 @@ func TestNackExpired(t *testing.T) {
 
 	require.Equal(t, "test item", item.Value)
 }
+
+func TestEmpty(t *testing.T) {
+	t.Parallel()
+
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+	client, err := e.NewClient()
+	require.NoError(t, err)
+
+	queue := New("testempty", client)
+	empty, err := queue.Empty(context.Background())
+	require.NoError(t, err)
+	assert.True(t, empty)
+
+	require.NoError(t, queue.Enqueue(context.Background(), "test item"))
+	empty, err = queue.Empty(context.Background())
+	require.NoError(t, err)
+	assert.False(t, empty)
+
+	// The items in flight are still in the queue, until they are acked
+	item, err := queue.Dequeue(context.Background())
+	require.NoError(t, err)
+	time.Sleep(1500 * time.Millisecond)
+	empty, err = queue.Empty(context.Background())
+	require.NoError(t, err)
+	assert.False(t, empty)
+
+	require.NoError(t, item.Ack(context.Background()))
+	empty, err = queue.Empty(context.Background())
+	require.NoError(t, err)
+	assert.True(t, empty)
+}
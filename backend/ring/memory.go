@@ -0,0 +1,107 @@
+package ring
+
+import (
+	"context"
+	"path"
+	"sync"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// MemoryGetter is an in-memory implementation of Getter. The rings with the
+// same path are shared by the callers of GetRing, and all their items are
+// owned by the single backend using them.
+type MemoryGetter struct {
+	mu    sync.Mutex
+	rings map[string]*MemoryRing
+}
+
+// NewMemoryGetter returns a new MemoryGetter.
+func NewMemoryGetter() *MemoryGetter {
+	return &MemoryGetter{rings: make(map[string]*MemoryRing)}
+}
+
+// GetRing gets a named Ring.
+func (g *MemoryGetter) GetRing(p ...string) types.Ring {
+	name := path.Join(p...)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	r, ok := g.rings[name]
+	if !ok {
+		r = &MemoryRing{}
+		g.rings[name] = r
+	}
+	return r
+}
+
+// MemoryRing is a ring of values in memory.
+type MemoryRing struct {
+	mu     sync.Mutex
+	values []string
+}
+
+// Add adds a value at the tail of the ring, or moves it there if it's already
+// in the ring.
+func (r *MemoryRing) Add(ctx context.Context, value string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.remove(value)
+	r.values = append(r.values, value)
+	return nil
+}
+
+// Remove removes a value from the ring.
+func (r *MemoryRing) Remove(ctx context.Context, value string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.remove(value)
+	return nil
+}
+
+// Next returns the next item in the Ring and advances the iteration. If the
+// Ring is empty, then Next returns an empty string and ErrEmptyRing.
+func (r *MemoryRing) Next(ctx context.Context) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.values) == 0 {
+		return "", ErrEmptyRing
+	}
+	value := r.values[0]
+	r.values = append(r.values[1:], value)
+	return value, nil
+}
+
+// Peek returns the next item in the Ring without advancing the iteration. If
+// the Ring is empty, then Peek returns an empty string and ErrEmptyRing.
+func (r *MemoryRing) Peek(ctx context.Context) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.values) == 0 {
+		return "", ErrEmptyRing
+	}
+	return r.values[0], nil
+}
+
+// remove removes the value from the ring, the caller holding the lock.
+func (r *MemoryRing) remove(value string) {
+	for i, v := range r.values {
+		if v == value {
+			r.values = append(r.values[:i], r.values[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package ring
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryRing(t *testing.T) {
+	ctx := context.Background()
+	ring := NewMemoryGetter().GetRing("test")
+
+	_, err := ring.Next(ctx)
+	assert.Equal(t, ErrEmptyRing, err)
+
+	require.NoError(t, ring.Add(ctx, "foo"))
+	require.NoError(t, ring.Add(ctx, "bar"))
+	require.NoError(t, ring.Add(ctx, "baz"))
+	require.NoError(t, ring.Remove(ctx, "bar"))
+
+	value, err := ring.Peek(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", value)
+
+	for _, expected := range []string{"foo", "baz", "foo"} {
+		value, err := ring.Next(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, expected, value)
+	}
+}
@@ -0,0 +1,90 @@
+package schedulerd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sensu/sensu-go/agent/builtin"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/command"
+	"github.com/sensu/sensu-go/types"
+)
+
+// executeOnBackend executes the check in the background, instead of publishing
+// check requests to its subscriptions, and publishes its event for its proxy
+// entity.
+func executeOnBackend(bus messaging.MessageBus, st store.EntityStore, check *types.CheckConfig) {
+	go func() {
+		event, err := runBackendCheck(context.Background(), st, check)
+		if err != nil {
+			logger.WithError(err).WithField("check", check.Name).Error("error executing check on the backend")
+			return
+		}
+
+		if err := bus.Publish(messaging.TopicEventRaw, event); err != nil {
+			logger.WithError(err).Error("error publishing check event")
+		}
+	}()
+}
+
+// runBackendCheck executes the builtin command of the check, and returns its
+// event for its proxy entity.
+func runBackendCheck(ctx context.Context, st store.EntityStore, config *types.CheckConfig) (*types.Event, error) {
+	ctx = context.WithValue(ctx, types.OrganizationKey, config.Organization)
+	ctx = context.WithValue(ctx, types.EnvironmentKey, config.Environment)
+
+	entity, err := getBackendProxyEntity(ctx, st, config)
+	if err != nil {
+		return nil, err
+	}
+
+	check := types.NewCheck(config)
+	check.Executed = time.Now().Unix()
+	check.RequestID = uuid.New().String()
+
+	ex := &command.Execution{
+		Command: config.Command,
+		Timeout: int(config.Timeout),
+	}
+	if _, err := builtin.Execute(ctx, ex); err != nil {
+		check.Output = err.Error()
+	} else {
+		check.Output = ex.Output
+	}
+	check.Status = int32(ex.Status)
+	check.Duration = ex.Duration
+
+	return &types.Event{
+		Entity:    entity,
+		Check:     check,
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+// getBackendProxyEntity returns the proxy entity of the check, which is
+// created if it doesn't exist yet.
+func getBackendProxyEntity(ctx context.Context, st store.EntityStore, check *types.CheckConfig) (*types.Entity, error) {
+	entity, err := st.GetEntityByID(ctx, check.ProxyEntityID)
+	if err != nil {
+		return nil, fmt.Errorf("could not query the store for a proxy entity: %s", err)
+	}
+	if entity != nil {
+		return entity, nil
+	}
+
+	entity = &types.Entity{
+		ID:            check.ProxyEntityID,
+		Class:         types.EntityProxyClass,
+		Environment:   check.Environment,
+		Organization:  check.Organization,
+		Subscriptions: []string{types.GetEntitySubscription(check.ProxyEntityID)},
+	}
+	if err := st.UpdateEntity(ctx, entity); err != nil {
+		return nil, fmt.Errorf("could not create a proxy entity: %s", err)
+	}
+
+	return entity, nil
+}
@@ -0,0 +1,96 @@
+package schedulerd
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/signing"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunBackendCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	check := types.FixtureCheckConfig("website")
+	check.Executor = types.CheckExecutorBackend
+	check.ProxyEntityID = "website"
+	check.Command = "builtin://http?url=" + server.URL
+
+	var nilEntity *types.Entity
+	st := &mockstore.MockStore{}
+	st.On("GetEntityByID", mock.Anything, "website").Return(nilEntity, nil)
+	st.On("UpdateEntity", mock.Anything, mock.MatchedBy(func(e *types.Entity) bool {
+		return e.ID == "website" && e.Class == types.EntityProxyClass
+	})).Return(nil)
+
+	event, err := runBackendCheck(context.Background(), st, check)
+	require.NoError(t, err)
+	st.AssertExpectations(t)
+
+	assert.Equal(t, "website", event.Entity.ID)
+	assert.Equal(t, []string{"entity:website"}, event.Entity.Subscriptions)
+	assert.Equal(t, "website", event.Check.Name)
+	assert.Equal(t, int32(0), event.Check.Status)
+	assert.Contains(t, event.Check.Output, "HTTP OK")
+	assert.NotEmpty(t, event.Check.RequestID)
+	assert.NoError(t, event.Validate())
+}
+
+func TestCheckExecutorBackendCheck(t *testing.T) {
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	defer func() { _ = bus.Stop() }()
+
+	events := make(chan interface{}, 1)
+	require.NoError(t, bus.Subscribe(messaging.TopicEventRaw, "test", events))
+
+	check := types.FixtureCheckConfig("website")
+	check.Executor = types.CheckExecutorBackend
+	check.ProxyEntityID = "website"
+	check.Command = "builtin://tcp?timeout=1s&address=127.0.0.1:1"
+
+	entity := types.FixtureEntity("website")
+	st := &mockstore.MockStore{}
+	st.On("GetEntityByID", mock.Anything, "website").Return(entity, nil)
+
+	executor := NewCheckExecutor(bus, st, nil, "default", "default", nil)
+	require.NoError(t, executor.execute(check))
+
+	event, ok := (<-events).(*types.Event)
+	require.True(t, ok)
+	assert.Equal(t, entity, event.Entity)
+	assert.Equal(t, int32(2), event.Check.Status)
+}
+
+func TestCheckExecutorSignsRequests(t *testing.T) {
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	defer func() { _ = bus.Stop() }()
+
+	check := types.FixtureCheckConfig("check1")
+	check.Subscriptions = []string{"linux"}
+	requests := make(chan interface{}, 1)
+	topic := messaging.SubscriptionTopic(check.Organization, check.Environment, "linux")
+	require.NoError(t, bus.Subscribe(topic, "test", requests))
+
+	key, err := signing.GenerateKey()
+	require.NoError(t, err)
+	keyring := signing.NewKeyring(map[string]*ecdsa.PrivateKey{check.Organization: key})
+
+	executor := NewCheckExecutor(bus, &mockstore.MockStore{}, nil, "default", "default", keyring)
+	executor.setState(&SchedulerState{})
+	require.NoError(t, executor.execute(check))
+
+	request, ok := (<-requests).(*types.CheckRequest)
+	require.True(t, ok)
+	assert.NoError(t, signing.NewVerifier(&key.PublicKey).Verify(request))
+}
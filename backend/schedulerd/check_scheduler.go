@@ -7,6 +7,8 @@ import (
 
 	"github.com/Sirupsen/logrus"
 	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/signing"
 	"github.com/sensu/sensu-go/types"
 	sensutime "github.com/sensu/sensu-go/util/time"
 )
@@ -22,7 +24,9 @@ type CheckScheduler struct {
 
 	StateManager *StateManager
 	MessageBus   messaging.MessageBus
+	EntityStore  store.EntityStore
 	WaitGroup    *sync.WaitGroup
+	Signer       *signing.Keyring
 
 	logger *logrus.Entry
 
@@ -51,7 +55,7 @@ func (s *CheckScheduler) Start() error {
 			timer = NewIntervalTimer(s.CheckName, uint(s.CheckInterval))
 		}
 
-		executor := NewCheckExecutor(s.MessageBus, newRoundRobinScheduler(s.ctx, s.MessageBus, s.ringGetter), s.CheckOrg, s.CheckEnv)
+		executor := NewCheckExecutor(s.MessageBus, s.EntityStore, newRoundRobinScheduler(s.ctx, s.MessageBus, s.ringGetter), s.CheckOrg, s.CheckEnv, s.Signer)
 
 		// TODO(greg): Refactor this part to make the code more easily tested.
 		timer.Start()
@@ -79,7 +83,7 @@ func (s *CheckScheduler) Start() error {
 					// Update the CheckScheduler with current check state and last cron state
 					s.LastCronState = check.Cron
 					s.CheckCron = check.Cron
-					s.CheckInterval = check.Interval
+					s.CheckInterval = check.SchedulingInterval()
 					timer.Stop()
 					goto toggle
 				}
@@ -87,8 +91,22 @@ func (s *CheckScheduler) Start() error {
 				// Update the CheckScheduler with the last cron state
 				s.LastCronState = check.Cron
 
+				// The timer may have expired before the next execution, or after
+				// missed ones
+				timer.SetCatchUp(check.CatchUp)
+				if !timer.Due() {
+					continue
+				}
+
 				if subdue := check.GetSubdue(); subdue != nil {
-					isSubdued, err := sensutime.InWindows(time.Now(), *subdue)
+					// The subdue is evaluated in the environment of the check, e.g.
+					// in its time zone
+					env := state.GetEnvironment(s.CheckOrg, s.CheckEnv)
+					isSubdued := false
+					when, err := env.TimeWindows(subdue)
+					if err == nil {
+						isSubdued, err = sensutime.InWindows(time.Now(), *when)
+					}
 					if err == nil && isSubdued {
 						// Check is subdued at this time
 						s.logger.Debug("check is not scheduled to be executed")
@@ -100,14 +118,14 @@ func (s *CheckScheduler) Start() error {
 					if err != nil || isSubdued {
 						// Reset the timer so the check is scheduled again for the next
 						// interval, since it might no longer be subdued
-						timer.SetDuration(check.Cron, uint(check.Interval))
+						timer.SetDuration(check.Cron, uint(check.SchedulingInterval()))
 						timer.Next()
 						continue
 					}
 				}
 
 				// Reset timer
-				timer.SetDuration(check.Cron, uint(check.Interval))
+				timer.SetDuration(check.Cron, uint(check.SchedulingInterval()))
 				timer.Next()
 
 				// Point executor to lastest copy of the scheduler state
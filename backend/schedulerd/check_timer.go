@@ -6,27 +6,43 @@ import (
 	"time"
 
 	"github.com/robfig/cron"
+	"github.com/sensu/sensu-go/types"
 )
 
+// maxCronWait is the longest a cron timer waits before looking at the wall
+// clock again, so that its changes, e.g. NTP adjustments, are noticed.
+const maxCronWait = time.Minute
+
 // A CheckTimer handles starting and stopping timers for a given check
 type CheckTimer interface {
 	// C channel emits events when timer's duration has reached 0
 	C() <-chan time.Time
 	// SetDuration updates the interval in which timers are set
 	SetDuration(string, uint)
+	// SetCatchUp updates the policy applied to missed executions
+	SetCatchUp(string)
 	// Start sets up a new timer
 	Start()
+	// Due returns true if the check must be executed after C emitted. If not,
+	// the timer is reset to wait for the next execution.
+	Due() bool
 	// Next reset's timer using interval
 	Next()
 	// Stop ends the timer
 	Stop() bool
 }
 
-// A IntervalTimer handles starting a stopping timers for a given check
+// A IntervalTimer handles starting a stopping timers for a given check. Its
+// executions are scheduled on a grid of intervals measured with the monotonic
+// clock, so they don't drift and aren't affected by changes of the wall clock.
 type IntervalTimer struct {
+	name     string
 	interval time.Duration
 	splay    uint64
+	catchUp  string
+	next     time.Time
 	timer    *time.Timer
+	now      func() time.Time
 }
 
 // NewIntervalTimer establishes new check timer given a name & an initial interval
@@ -36,7 +52,7 @@ func NewIntervalTimer(name string, interval uint) *IntervalTimer {
 	sum := md5.Sum([]byte(name))
 	splay := binary.LittleEndian.Uint64(sum[:])
 
-	timer := &IntervalTimer{splay: splay}
+	timer := &IntervalTimer{name: name, splay: splay, now: time.Now}
 	timer.SetDuration("", interval)
 	return timer
 }
@@ -51,15 +67,51 @@ func (timerPtr *IntervalTimer) SetDuration(cron string, interval uint) {
 	timerPtr.interval = time.Duration(time.Second * time.Duration(interval))
 }
 
+// SetCatchUp updates the policy applied to missed executions
+func (timerPtr *IntervalTimer) SetCatchUp(catchUp string) {
+	timerPtr.catchUp = catchUp
+}
+
 // Start sets up a new timer
 func (timerPtr *IntervalTimer) Start() {
-	initOffset := timerPtr.calcInitialOffset()
-	timerPtr.timer = time.NewTimer(initOffset)
+	now := timerPtr.now()
+	timerPtr.next = now.Add(timerPtr.calcInitialOffset())
+	timerPtr.timer = time.NewTimer(timerPtr.next.Sub(now))
+}
+
+// Due returns true if the check must be executed. The executions missed by
+// more than an interval, e.g. because the process was paused, are skipped
+// unless the catch up policy is to run once.
+func (timerPtr *IntervalTimer) Due() bool {
+	now := timerPtr.now()
+	if now.Before(timerPtr.next) {
+		timerPtr.timer.Reset(timerPtr.next.Sub(now))
+		return false
+	}
+
+	missed := now.Sub(timerPtr.next) / timerPtr.interval
+	if missed == 0 {
+		return true
+	}
+
+	logger.WithField("check", timerPtr.name).Warnf("missed %d executions", missed)
+	if timerPtr.catchUp == types.CheckCatchUpRunOnce {
+		return true
+	}
+	timerPtr.next = timerPtr.next.Add((missed + 1) * timerPtr.interval)
+	timerPtr.timer.Reset(timerPtr.next.Sub(now))
+	return false
 }
 
 // Next reset's timer using interval
 func (timerPtr *IntervalTimer) Next() {
-	timerPtr.timer.Reset(timerPtr.interval)
+	now := timerPtr.now()
+	timerPtr.next = timerPtr.next.Add(timerPtr.interval)
+	if !timerPtr.next.After(now) {
+		// Skip the executions which already passed, e.g. after catching up
+		timerPtr.next = timerPtr.next.Add((now.Sub(timerPtr.next)/timerPtr.interval + 1) * timerPtr.interval)
+	}
+	timerPtr.timer.Reset(timerPtr.next.Sub(now))
 }
 
 // Stop ends the timer
@@ -69,27 +121,33 @@ func (timerPtr *IntervalTimer) Stop() bool {
 
 // Calculate the first execution time using splay & interval
 func (timerPtr *IntervalTimer) calcInitialOffset() time.Duration {
-	now := uint64(time.Now().UnixNano())
+	now := uint64(timerPtr.now().UnixNano())
 	offset := (timerPtr.splay - now) % uint64(timerPtr.interval)
 	return time.Duration(offset) / time.Nanosecond
 }
 
-// A CronTimer handles starting and stopping timers for a given check
+// A CronTimer handles starting and stopping timers for a given check. Its
+// executions are scheduled by the wall clock, which it looks at regularly so
+// that its changes are noticed.
 type CronTimer struct {
-	next  time.Duration
-	timer *time.Timer
+	name     string
+	schedule cron.Schedule
+	catchUp  string
+	next     time.Time
+	timer    *time.Timer
+	now      func() time.Time
 }
 
 // NewCronTimer establishes new check timer given a name & an initial interval
 func NewCronTimer(name string, cronStr string) *CronTimer {
-	diff, err := NextCronTime(time.Now(), cronStr)
+	schedule, err := cron.ParseStandard(cronStr)
 	// we shouldn't hit this error because we've already validated the cron string
 	// but log and exit cleanly to revert to the interval timer
 	if err != nil {
 		logger.WithError(err).Error("invalid cron, reverting to interval")
 		return nil
 	}
-	timer := &CronTimer{next: diff}
+	timer := &CronTimer{name: name, schedule: schedule, now: time.Now}
 	return timer
 }
 
@@ -100,24 +158,67 @@ func (timerPtr *CronTimer) C() <-chan time.Time {
 
 // SetDuration updates the interval in which timers are set
 func (timerPtr *CronTimer) SetDuration(cronStr string, interval uint) {
-	diff, err := NextCronTime(time.Now(), cronStr)
+	schedule, err := cron.ParseStandard(cronStr)
 	// we shouldn't hit this error because we've already validated the cron string
 	// but log and exit cleanly to revert to the interval timer
 	if err != nil {
 		logger.WithError(err).Error("invalid cron, reverting to interval")
 		return
 	}
-	timerPtr.next = diff
+	timerPtr.schedule = schedule
+}
+
+// SetCatchUp updates the policy applied to missed executions
+func (timerPtr *CronTimer) SetCatchUp(catchUp string) {
+	timerPtr.catchUp = catchUp
 }
 
 // Start sets up a new timer
 func (timerPtr *CronTimer) Start() {
-	timerPtr.timer = time.NewTimer(timerPtr.next)
+	now := timerPtr.wallNow()
+	timerPtr.next = timerPtr.schedule.Next(now)
+	timerPtr.timer = time.NewTimer(timerPtr.wait(now))
+}
+
+// Due returns true if the check must be executed. The executions missed,
+// e.g. because the wall clock jumped forward, are skipped unless the catch up
+// policy is to run once. The schedule follows the wall clock when it goes
+// back.
+func (timerPtr *CronTimer) Due() bool {
+	now := timerPtr.wallNow()
+	if now.Before(timerPtr.next) {
+		if next := timerPtr.schedule.Next(now); next.Before(timerPtr.next) {
+			logger.WithField("check", timerPtr.name).Warn("clock went back, rescheduling")
+			timerPtr.next = next
+		}
+		timerPtr.timer.Reset(timerPtr.wait(now))
+		return false
+	}
+
+	if following := timerPtr.schedule.Next(timerPtr.next); now.Before(following) {
+		return true
+	}
+
+	logger.WithField("check", timerPtr.name).Warnf("missed executions since %s", timerPtr.next)
+	if timerPtr.catchUp == types.CheckCatchUpRunOnce {
+		return true
+	}
+	timerPtr.next = timerPtr.schedule.Next(now)
+	timerPtr.timer.Reset(timerPtr.wait(now))
+	return false
 }
 
 // Next reset's timer using interval
 func (timerPtr *CronTimer) Next() {
-	timerPtr.timer.Reset(timerPtr.next)
+	now := timerPtr.wallNow()
+
+	// Never execute the check twice for the same schedule
+	from := now
+	if from.Before(timerPtr.next) {
+		from = timerPtr.next
+	}
+	timerPtr.next = timerPtr.schedule.Next(from)
+	timerPtr.timer.Reset(timerPtr.wait(now))
 }
 
 // Stop ends the timer
@@ -125,6 +226,25 @@ func (timerPtr *CronTimer) Stop() bool {
 	return timerPtr.timer.Stop()
 }
 
+// wallNow returns the current time without its monotonic clock reading, so
+// that durations are measured with the wall clock.
+func (timerPtr *CronTimer) wallNow() time.Time {
+	return timerPtr.now().Round(0)
+}
+
+// wait returns how long the timer waits before looking at the wall clock
+// again.
+func (timerPtr *CronTimer) wait(now time.Time) time.Duration {
+	wait := timerPtr.next.Sub(now)
+	if wait > maxCronWait {
+		wait = maxCronWait
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	return wait
+}
+
 // NextCronTime calculates how much time is between the current time and the
 // time indidcated by the cron string
 func NextCronTime(now time.Time, cronStr string) (time.Duration, error) {
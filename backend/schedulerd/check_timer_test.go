@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -69,3 +70,86 @@ func TestStopCronTimer(t *testing.T) {
 	result := timer.Stop()
 	assert.True(t, result)
 }
+
+// fakeClock is a wall clock the tests can set, e.g. to simulate its jumps.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func TestIntervalTimerSchedule(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2018, 3, 11, 12, 0, 0, 0, time.UTC)}
+	timer := NewIntervalTimer("check1", 10)
+	timer.now = clock.Now
+	timer.Start()
+	defer timer.Stop()
+
+	first := timer.next
+	assert.True(t, first.After(clock.now))
+	assert.True(t, first.Before(clock.now.Add(10*time.Second)))
+
+	// The timer is reset to wait for the next execution when it expires early
+	assert.False(t, timer.Due())
+
+	// The executions don't drift with the time taken by the previous ones
+	clock.now = first.Add(2 * time.Second)
+	assert.True(t, timer.Due())
+	timer.Next()
+	assert.Equal(t, first.Add(10*time.Second), timer.next)
+
+	// The missed executions are skipped by default
+	clock.now = first.Add(45 * time.Second)
+	assert.False(t, timer.Due())
+	assert.Equal(t, first.Add(50*time.Second), timer.next)
+
+	// Or executed once
+	timer.SetCatchUp(types.CheckCatchUpRunOnce)
+	clock.now = first.Add(85 * time.Second)
+	assert.True(t, timer.Due())
+	timer.Next()
+	assert.Equal(t, first.Add(90*time.Second), timer.next)
+}
+
+func TestCronTimerClockChanges(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2018, 3, 11, 12, 0, 30, 0, time.UTC)}
+	timer := NewCronTimer("check1", "* * * * *")
+	timer.now = clock.Now
+	timer.Start()
+	defer timer.Stop()
+
+	minute := func(hour, min int) time.Time {
+		return time.Date(2018, 3, 11, hour, min, 0, 0, time.UTC)
+	}
+	assert.Equal(t, minute(12, 1), timer.next)
+
+	clock.now = minute(12, 1).Add(5 * time.Millisecond)
+	assert.True(t, timer.Due())
+	timer.Next()
+	assert.Equal(t, minute(12, 2), timer.next)
+
+	// The clock jumps forward, the missed executions are skipped by default
+	clock.now = minute(12, 10).Add(30 * time.Second)
+	assert.False(t, timer.Due())
+	assert.Equal(t, minute(12, 11), timer.next)
+
+	// Or executed once
+	timer.SetCatchUp(types.CheckCatchUpRunOnce)
+	clock.now = minute(12, 20).Add(30 * time.Second)
+	assert.True(t, timer.Due())
+	timer.Next()
+	assert.Equal(t, minute(12, 21), timer.next)
+
+	// The clock goes back, the schedule follows it
+	clock.now = minute(11, 0).Add(10 * time.Second)
+	assert.False(t, timer.Due())
+	assert.Equal(t, minute(11, 1), timer.next)
+
+	// The timer looks at the wall clock regularly
+	timer.SetDuration("0 0 * * *", 0)
+	timer.Next()
+	assert.Equal(t, time.Date(2018, 3, 12, 0, 0, 0, 0, time.UTC), timer.next)
+	assert.Equal(t, maxCronWait, timer.wait(clock.now))
+}
@@ -7,8 +7,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/queue"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/signing"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -19,34 +22,46 @@ var (
 // Executor executes scheduled or adhoc checks
 type Executor interface {
 	processCheck(ctx context.Context, check *types.CheckConfig) error
-	getEntities(ctx context.Context) ([]*types.Entity, error)
+	getEntities(ctx context.Context, proxyRequests *types.ProxyRequests) ([]*types.Entity, error)
 	publishProxyCheckRequests(entities []*types.Entity, check *types.CheckConfig) error
 	execute(check *types.CheckConfig) error
 	buildRequest(check *types.CheckConfig) *types.CheckRequest
 	setState(state *SchedulerState)
+	due(check *types.CheckConfig, entity string, interval uint32) bool
 }
 
 // CheckExecutor executes scheduled checks in the check scheduler
 type CheckExecutor struct {
 	bus          messaging.MessageBus
+	store        store.EntityStore
 	state        *SchedulerState
 	roundRobin   *roundRobinScheduler
 	organization string
 	environment  string
+	signer       *signing.Keyring
+
+	// issued is the time, in milliseconds, of the last request published for
+	// each proxy entity with an interval override
+	issued map[string]int64
 }
 
 // NewCheckExecutor creates a new check executor
-func NewCheckExecutor(bus messaging.MessageBus, roundRobin *roundRobinScheduler, org string, env string) *CheckExecutor {
-	return &CheckExecutor{bus: bus, roundRobin: roundRobin, organization: org, environment: env}
+func NewCheckExecutor(bus messaging.MessageBus, st store.EntityStore, roundRobin *roundRobinScheduler, org string, env string, signer *signing.Keyring) *CheckExecutor {
+	return &CheckExecutor{bus: bus, store: st, roundRobin: roundRobin, organization: org, environment: env, signer: signer}
 }
 
 // ProcessCheck processes a check by publishing its proxy requests (if any)
 // and publishing the check itself
 func (c *CheckExecutor) processCheck(ctx context.Context, check *types.CheckConfig) error {
+	// The checks which don't publish check requests are paused, and their
+	// proxy entities aren't even looked up
+	if !check.Publish {
+		return nil
+	}
 	return processCheck(ctx, c, check)
 }
 
-func (c *CheckExecutor) getEntities(ctx context.Context) ([]*types.Entity, error) {
+func (c *CheckExecutor) getEntities(ctx context.Context, proxyRequests *types.ProxyRequests) ([]*types.Entity, error) {
 	return c.state.GetEntitiesInNamespace(c.organization, c.environment), nil
 }
 
@@ -60,8 +75,17 @@ func (c *CheckExecutor) execute(check *types.CheckConfig) error {
 		return nil
 	}
 
-	var err error
+	if check.Executor == types.CheckExecutorBackend {
+		executeOnBackend(c.bus, c.store, check)
+		return nil
+	}
+
 	request := c.buildRequest(check)
+	if err := c.signer.Sign(request); err != nil {
+		return fmt.Errorf("could not sign the check request: %s", err)
+	}
+
+	var err error
 
 	for _, sub := range check.Subscriptions {
 		org, env := check.Organization, check.Environment
@@ -91,6 +115,8 @@ func (c *CheckExecutor) execute(check *types.CheckConfig) error {
 func (c *CheckExecutor) buildRequest(check *types.CheckConfig) *types.CheckRequest {
 	request := &types.CheckRequest{}
 	request.Config = check
+	request.ID = uuid.New().String()
+	request.Issued = types.UnixMilli(time.Now())
 
 	// Guard against iterating over assets if there are no assets associated with
 	// the check in the first place.
@@ -116,6 +142,14 @@ func (c *CheckExecutor) buildRequest(check *types.CheckConfig) *types.CheckReque
 		}
 	}
 
+	if check.Splay {
+		window, err := calculateSplayWindow(check, time.Now())
+		if err != nil {
+			logger.WithError(err).Error("error calculating check splay window")
+		}
+		request.SplayWindow = uint32(window / time.Millisecond)
+	}
+
 	return request
 }
 
@@ -145,6 +179,21 @@ func (c *CheckExecutor) setState(state *SchedulerState) {
 	c.state = state
 }
 
+// due determines if the proxy entity is due to execute the check at the given
+// interval, longer than the interval the check is scheduled at, given the
+// last request published for the entity.
+func (c *CheckExecutor) due(check *types.CheckConfig, entity string, interval uint32) bool {
+	if c.issued == nil {
+		c.issued = map[string]int64{}
+	}
+	now := types.UnixMilli(time.Now())
+	if !check.IntervalDue(interval, c.issued[entity], now) {
+		return false
+	}
+	c.issued[entity] = now
+	return true
+}
+
 // AdhocRequestExecutor takes new check requests from the adhoc queue and runs
 // them
 type AdhocRequestExecutor struct {
@@ -154,10 +203,11 @@ type AdhocRequestExecutor struct {
 	ctx            context.Context
 	cancel         context.CancelFunc
 	listenQueueErr chan error
+	signer         *signing.Keyring
 }
 
 // NewAdhocRequestExecutor returns a new AdhocRequestExecutor.
-func NewAdhocRequestExecutor(ctx context.Context, store Store, bus messaging.MessageBus) *AdhocRequestExecutor {
+func NewAdhocRequestExecutor(ctx context.Context, store Store, bus messaging.MessageBus, signer *signing.Keyring) *AdhocRequestExecutor {
 	ctx, cancel := context.WithCancel(ctx)
 	executor := &AdhocRequestExecutor{
 		adhocQueue: store.NewQueue(adhocQueueName),
@@ -165,6 +215,7 @@ func NewAdhocRequestExecutor(ctx context.Context, store Store, bus messaging.Mes
 		bus:        bus,
 		ctx:        ctx,
 		cancel:     cancel,
+		signer:     signer,
 	}
 	go executor.listenQueue(ctx)
 	return executor
@@ -217,7 +268,12 @@ func (a *AdhocRequestExecutor) processCheck(ctx context.Context, check *types.Ch
 	return processCheck(ctx, a, check)
 }
 
-func (a *AdhocRequestExecutor) getEntities(ctx context.Context) ([]*types.Entity, error) {
+// getEntities gets the entities which may match the proxy requests, which are
+// looked up in an index of the store when the entity attributes allow it.
+func (a *AdhocRequestExecutor) getEntities(ctx context.Context, proxyRequests *types.ProxyRequests) ([]*types.Entity, error) {
+	if index, value, ok := proxyEntityIndexLookup(proxyRequests); ok {
+		return a.store.GetEntitiesByIndex(ctx, index, value)
+	}
 	return a.store.GetEntities(ctx)
 }
 
@@ -226,8 +282,17 @@ func (a *AdhocRequestExecutor) publishProxyCheckRequests(entities []*types.Entit
 }
 
 func (a *AdhocRequestExecutor) execute(check *types.CheckConfig) error {
+	if check.Executor == types.CheckExecutorBackend {
+		executeOnBackend(a.bus, a.store, check)
+		return nil
+	}
+
 	request := a.buildRequest(check)
 	request.Config = check
+	if err := a.signer.Sign(request); err != nil {
+		return fmt.Errorf("could not sign the check request: %s", err)
+	}
+
 	var err error
 	for _, sub := range check.Subscriptions {
 		topic := messaging.SubscriptionTopic(check.Organization, check.Environment, sub)
@@ -242,11 +307,17 @@ func (a *AdhocRequestExecutor) execute(check *types.CheckConfig) error {
 }
 
 func (a *AdhocRequestExecutor) buildRequest(check *types.CheckConfig) *types.CheckRequest {
-	return &types.CheckRequest{}
+	return &types.CheckRequest{ID: uuid.New().String(), Issued: types.UnixMilli(time.Now()), Adhoc: true}
 }
 
 func (a *AdhocRequestExecutor) setState(state *SchedulerState) {}
 
+// due always returns true, since the adhoc requests are executed regardless
+// of the interval of the check.
+func (a *AdhocRequestExecutor) due(check *types.CheckConfig, entity string, interval uint32) bool {
+	return true
+}
+
 func publishProxyCheckRequests(e Executor, entities []*types.Entity, check *types.CheckConfig) error {
 	var err error
 	splay := float64(0)
@@ -263,6 +334,20 @@ func publishProxyCheckRequests(e Executor, entities []*types.Entity, check *type
 		if err != nil {
 			return err
 		}
+
+		// The overrides of the check for the proxy entities are applied by the
+		// scheduler, since no agent executes the check as these entities
+		if override := substitutedCheck.OverrideFor(entity); override != nil {
+			if override.Disabled {
+				logger.Debugf("check %s is disabled for entity %s", check.Name, entity.ID)
+				continue
+			}
+			substitutedCheck = substitutedCheck.WithOverride(override)
+		}
+		if interval := substitutedCheck.Interval; interval > check.SchedulingInterval() && !e.due(check, entity.ID, interval) {
+			continue
+		}
+
 		if err := e.execute(substitutedCheck); err != nil {
 			return err
 		}
@@ -273,7 +358,7 @@ func publishProxyCheckRequests(e Executor, entities []*types.Entity, check *type
 func processCheck(ctx context.Context, executor Executor, check *types.CheckConfig) error {
 	if check.ProxyRequests != nil {
 		// get entities by namespace
-		entities, err := executor.getEntities(ctx)
+		entities, err := executor.getEntities(ctx, check.ProxyRequests)
 		if err != nil {
 			return err
 		}
@@ -21,7 +21,7 @@ func TestAdhocExecutor(t *testing.T) {
 		assert.FailNow(t, err.Error())
 	}
 	bus := &messaging.WizardBus{}
-	newAdhocExec := NewAdhocRequestExecutor(context.Background(), store, bus)
+	newAdhocExec := NewAdhocRequestExecutor(context.Background(), store, bus, nil)
 	defer newAdhocExec.Stop()
 	assert.NoError(t, newAdhocExec.bus.Start())
 
@@ -203,6 +203,8 @@ func TestCheckBuildRequestInterval(t *testing.T) {
 	request := scheduler.exec.buildRequest(check)
 	assert.NotNil(request)
 	assert.NotNil(request.Config)
+	assert.NotEmpty(request.ID)
+	assert.NotZero(request.Issued)
 	assert.NotNil(request.Assets)
 	assert.NotEmpty(request.Assets)
 	assert.Len(request.Assets, 1)
@@ -210,13 +212,22 @@ func TestCheckBuildRequestInterval(t *testing.T) {
 	assert.NotEmpty(request.Hooks)
 	assert.Len(request.Hooks, 1)
 
+	// Every request is uniquely identified
+	id := request.ID
 	check.RuntimeAssets = []string{}
 	check.CheckHooks = []types.HookList{}
 	request = scheduler.exec.buildRequest(check)
 	assert.NotNil(request)
+	assert.NotEqual(id, request.ID)
 	assert.NotNil(request.Config)
 	assert.Empty(request.Assets)
 	assert.Empty(request.Hooks)
+	assert.Zero(request.SplayWindow)
+
+	check.Splay = true
+	check.SplayCoverage = 50
+	request = scheduler.exec.buildRequest(check)
+	assert.Equal(uint32(check.Interval*1000/2), request.SplayWindow)
 
 	assert.NoError(scheduler.msgBus.Stop())
 }
@@ -6,11 +6,36 @@ import (
 	"time"
 
 	"github.com/sensu/sensu-go/agent"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 	"github.com/sensu/sensu-go/types/dynamic"
 	"github.com/sensu/sensu-go/util/eval"
+	"github.com/sensu/sensu-go/util/query"
 )
 
+// proxyEntityIndexLookup returns the index of the store, and the value, the
+// entities which may match the proxy requests can be looked up with, if one of
+// the entity attributes requires a specific class or subscription. The
+// entities still have to be matched against the entity attributes.
+func proxyEntityIndexLookup(proxyRequests *types.ProxyRequests) (store.EntityIndex, string, bool) {
+	if proxyRequests == nil {
+		return "", "", false
+	}
+	for _, expression := range proxyRequests.EntityAttributes {
+		q, err := query.Parse(expression, nil)
+		if err != nil {
+			continue
+		}
+		if class, ok := q.Equals("entity", "Class"); ok {
+			return store.EntityIndexClass, class, true
+		}
+		if subscription, ok := q.Contains("entity", "Subscriptions"); ok {
+			return store.EntityIndexSubscription, subscription, true
+		}
+	}
+	return "", "", false
+}
+
 // matchEntities matches the provided list of entities to the entity attributes
 // configured in the proxy request
 func matchEntities(entities []*types.Entity, proxyRequest *types.ProxyRequests) []*types.Entity {
@@ -52,8 +77,10 @@ func substituteProxyEntityTokens(entity *types.Entity, check *types.CheckConfig)
 	}
 
 	// Substitute tokens within the check configuration with the synthesized
-	// entity
-	checkBytes, err := agent.TokenSubstitution(synthesizedEntity, check)
+	// entity, except in the links, evaluated with the event by eventd
+	unlinked := *check
+	unlinked.RunbookURL, unlinked.DashboardURL = "", ""
+	checkBytes, err := agent.TokenSubstitution(synthesizedEntity, &unlinked)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +95,8 @@ func substituteProxyEntityTokens(entity *types.Entity, check *types.CheckConfig)
 	}
 
 	substitutedCheck.ProxyEntityID = entity.ID
+	substitutedCheck.RunbookURL = check.RunbookURL
+	substitutedCheck.DashboardURL = check.DashboardURL
 	return substitutedCheck, nil
 }
 
@@ -75,7 +104,7 @@ func substituteProxyEntityTokens(entity *types.Entity, check *types.CheckConfig)
 // requests to each individual entity (based on a configurable splay %)
 func calculateSplayInterval(check *types.CheckConfig, numEntities float64) (float64, error) {
 	var err error
-	next := time.Duration(time.Second * time.Duration(check.Interval))
+	next := time.Duration(time.Second * time.Duration(check.SchedulingInterval()))
 	if check.Cron != "" {
 		if next, err = NextCronTime(time.Now(), check.Cron); err != nil {
 			return 0, err
@@ -88,3 +117,20 @@ func calculateSplayInterval(check *types.CheckConfig, numEntities float64) (floa
 	splay := next.Seconds() * (splayCoverage / 100.0) / numEntities
 	return splay, nil
 }
+
+// calculateSplayWindow calculates the window of time over which the
+// subscribers of a check spread its execution (based on a configurable splay %)
+func calculateSplayWindow(check *types.CheckConfig, now time.Time) (time.Duration, error) {
+	var err error
+	next := time.Duration(time.Second * time.Duration(check.SchedulingInterval()))
+	if check.Cron != "" {
+		if next, err = NextCronTime(now, check.Cron); err != nil {
+			return 0, err
+		}
+	}
+	splayCoverage := check.SplayCoverage
+	if splayCoverage == 0 {
+		splayCoverage = types.DefaultSplayCoverage
+	}
+	return next * time.Duration(splayCoverage) / 100, nil
+}
@@ -3,9 +3,14 @@ package schedulerd
 import (
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMatchEntities(t *testing.T) {
@@ -127,6 +132,30 @@ func TestMatchEntities(t *testing.T) {
 	}
 }
 
+func TestProxyEntityIndexLookup(t *testing.T) {
+	tests := []struct {
+		entityAttributes []string
+		index            store.EntityIndex
+		value            string
+		ok               bool
+	}{
+		{[]string{`entity.Class == "proxy"`}, store.EntityIndexClass, "proxy", true},
+		{[]string{`entity.ID =~ "^web"`, `"web" in entity.Subscriptions`}, store.EntityIndexSubscription, "web", true},
+		{[]string{`entity.Class == "proxy" || entity.Class == "host"`}, "", "", false},
+		{[]string{`entity.ID == "entity1"`}, "", "", false},
+		{nil, "", "", false},
+	}
+	for _, tt := range tests {
+		index, value, ok := proxyEntityIndexLookup(&types.ProxyRequests{EntityAttributes: tt.entityAttributes})
+		assert.Equal(t, tt.ok, ok)
+		assert.Equal(t, tt.index, index)
+		assert.Equal(t, tt.value, value)
+	}
+
+	_, _, ok := proxyEntityIndexLookup(nil)
+	assert.False(t, ok)
+}
+
 func TestSplayCalculation(t *testing.T) {
 	t.Parallel()
 
@@ -163,6 +192,40 @@ func TestSplayCalculation(t *testing.T) {
 	assert.Nil(err)
 }
 
+func TestSplayWindowCalculation(t *testing.T) {
+	t.Parallel()
+
+	assert := assert.New(t)
+
+	check := types.FixtureCheckConfig("check1")
+	now := time.Date(2018, 1, 1, 0, 0, 30, 0, time.UTC)
+
+	// 10s * 90% = 9s
+	check.Interval = 10
+	window, err := calculateSplayWindow(check, now)
+	assert.Equal(9*time.Second, window)
+	assert.Nil(err)
+
+	// 20s * 50% = 10s
+	check.Interval = 20
+	check.SplayCoverage = 50
+	window, err = calculateSplayWindow(check, now)
+	assert.Equal(10*time.Second, window)
+	assert.Nil(err)
+
+	// invalid cron string
+	check.Cron = "invalid"
+	window, err = calculateSplayWindow(check, now)
+	assert.Equal(time.Duration(0), window)
+	assert.NotNil(err)
+
+	// 30s until the next minute * 50% = 15s
+	check.Cron = "* * * * *"
+	window, err = calculateSplayWindow(check, now)
+	assert.Equal(15*time.Second, window)
+	assert.Nil(err)
+}
+
 func TestSubstituteProxyEntityTokens(t *testing.T) {
 	t.Parallel()
 
@@ -178,4 +241,62 @@ func TestSubstituteProxyEntityTokens(t *testing.T) {
 		assert.FailNow(err.Error())
 	}
 	assert.Equal(entity.ID, substitutedProxyEntityTokens.ProxyEntityID)
+
+	// The links are evaluated with the events rather than the entity
+	check.RunbookURL = "https://wiki/{{ .Check.Name }}"
+	substitutedProxyEntityTokens, err = substituteProxyEntityTokens(entity, check)
+	if err != nil {
+		assert.FailNow(err.Error())
+	}
+	assert.Equal(check.RunbookURL, substitutedProxyEntityTokens.RunbookURL)
+}
+
+func TestPublishProxyCheckRequestsOverrides(t *testing.T) {
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	defer func() { _ = bus.Stop() }()
+
+	check := types.FixtureCheckConfig("check1")
+	check.Subscriptions = []string{"proxies"}
+	check.ProxyRequests = types.FixtureProxyRequests(false)
+	check.Overrides = []types.CheckOverride{
+		{Entity: "entity2", Disabled: true},
+		{Labels: map[string]string{"tier": "db"}, Interval: 120, Timeout: 5},
+	}
+	requests := make(chan interface{}, 10)
+	topic := messaging.SubscriptionTopic(check.Organization, check.Environment, "proxies")
+	require.NoError(t, bus.Subscribe(topic, "test", requests))
+
+	entity3 := types.FixtureEntity("entity3")
+	entity3.Labels = map[string]string{"tier": "db"}
+	entities := []*types.Entity{types.FixtureEntity("entity1"), types.FixtureEntity("entity2"), entity3}
+
+	executor := NewCheckExecutor(bus, &mockstore.MockStore{}, nil, "default", "default", nil)
+	executor.setState(&SchedulerState{})
+	received := func() map[string]*types.CheckConfig {
+		configs := map[string]*types.CheckConfig{}
+		for {
+			select {
+			case msg := <-requests:
+				request := msg.(*types.CheckRequest)
+				configs[request.Config.ProxyEntityID] = request.Config
+			case <-time.After(100 * time.Millisecond):
+				return configs
+			}
+		}
+	}
+
+	require.NoError(t, publishProxyCheckRequests(executor, entities, check))
+	configs := received()
+	require.Len(t, configs, 2)
+	assert.Equal(t, uint32(60), configs["entity1"].Interval)
+	assert.Equal(t, uint32(120), configs["entity3"].Interval)
+	assert.Equal(t, uint32(5), configs["entity3"].Timeout)
+	assert.Empty(t, configs["entity3"].Overrides)
+
+	// The entity with a longer interval isn't due yet
+	require.NoError(t, publishProxyCheckRequests(executor, entities, check))
+	configs = received()
+	require.Len(t, configs, 1)
+	assert.Contains(t, configs, "entity1")
 }
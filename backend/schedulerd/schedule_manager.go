@@ -5,6 +5,7 @@ import (
 	"sync/atomic"
 
 	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/signing"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -20,22 +21,26 @@ type ScheduleManager struct {
 }
 
 // NewScheduleManager creates a new ScheduleManager.
-func NewScheduleManager(msgBus messaging.MessageBus, stateMngr *StateManager, rg types.RingGetter) *ScheduleManager {
+func NewScheduleManager(msgBus messaging.MessageBus, stateMngr *StateManager, st Store, signer *signing.Keyring) *ScheduleManager {
 	wg := &sync.WaitGroup{}
 	stopped := &atomic.Value{}
+	// Checks are not scheduled until the manager is started
+	stopped.Store(true)
 
 	newSchedulerFn := func(check *types.CheckConfig) *CheckScheduler {
 		return &CheckScheduler{
 			CheckName:     check.Name,
 			CheckEnv:      check.Environment,
 			CheckOrg:      check.Organization,
-			CheckInterval: check.Interval,
+			CheckInterval: check.SchedulingInterval(),
 			CheckCron:     check.Cron,
 			LastCronState: check.Cron,
 			MessageBus:    msgBus,
+			EntityStore:   st,
 			WaitGroup:     wg,
 			StateManager:  stateMngr,
-			ringGetter:    rg,
+			Signer:        signer,
+			ringGetter:    st,
 		}
 	}
 
@@ -91,16 +96,24 @@ func (mngrPtr *ScheduleManager) Run(check *types.CheckConfig) error {
 	return nil
 }
 
-// Start ...
-func (mngrPtr *ScheduleManager) Start() {
+// Start starts scheduling the checks of the given state, and any check added
+// afterwards.
+func (mngrPtr *ScheduleManager) Start(state *SchedulerState) {
 	logger.Info("starting scheduler manager")
 	mngrPtr.stopped.Store(false)
+
+	for _, check := range state.checks {
+		if err := mngrPtr.Run(check); err != nil {
+			logger.Error(err)
+		}
+	}
 }
 
 // Stop closes all the schedulers
 func (mngrPtr *ScheduleManager) Stop() {
 	// Await any pending updates before shutting down
 	mngrPtr.mutex.Lock()
+	defer mngrPtr.mutex.Unlock()
 	mngrPtr.stopped.Store(true)
 
 	for n, scheduler := range mngrPtr.items {
@@ -3,9 +3,12 @@ package schedulerd
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/sensu/sensu-go/backend/leader"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/queue"
+	"github.com/sensu/sensu-go/signing"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -16,17 +19,30 @@ type Store interface {
 	queue.Get
 }
 
+// leaderRetryInterval is how long schedulerd waits before campaigning again
+// for leadership after an error.
+var leaderRetryInterval = 5 * time.Second
+
 // Schedulerd handles scheduling check requests for each check's
 // configured interval and publishing to the message bus.
+//
+// When several backends share the same store, only the cluster leader
+// schedules checks, so that each check is scheduled exactly once. Another
+// backend takes over as soon as the leader loses its leadership.
 type Schedulerd struct {
 	Store      Store
 	MessageBus messaging.MessageBus
 
+	// Signer signs the check requests published to the agents, if set
+	Signer *signing.Keyring
+
 	stateManager         *StateManager
 	schedulerManager     *ScheduleManager
 	adhocRequestExecutor *AdhocRequestExecutor
 
-	errChan chan error
+	errChan  chan error
+	stopping chan struct{}
+	cancel   context.CancelFunc
 }
 
 // Start the Scheduler daemon.
@@ -45,23 +61,73 @@ func (s *Schedulerd) Start() error {
 	s.stateManager = NewStateManager(s.Store)
 
 	// Check Schedulers
-	s.schedulerManager = NewScheduleManager(s.MessageBus, s.stateManager, s.Store)
+	s.schedulerManager = NewScheduleManager(s.MessageBus, s.stateManager, s.Store, s.Signer)
 
 	// Adhoc Request Executor
-	s.adhocRequestExecutor = NewAdhocRequestExecutor(ctx, s.Store, s.MessageBus)
+	s.adhocRequestExecutor = NewAdhocRequestExecutor(ctx, s.Store, s.MessageBus, s.Signer)
 
 	// Sync
 	s.errChan = make(chan error, 1)
+	s.stopping = make(chan struct{})
 
 	// Start
-	s.schedulerManager.Start()
 	s.stateManager.Start(ctx)
+	leaderCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	go s.lead(leaderCtx)
+
+	return nil
+}
+
+// lead campaigns for the cluster leadership and schedules checks whenever
+// this backend holds it, until the daemon is stopped and ctx is cancelled.
+func (s *Schedulerd) lead(ctx context.Context) {
+	for {
+		err := leader.Do(ctx, s.schedule)
+
+		select {
+		case <-s.stopping:
+			return
+		default:
+		}
+
+		if err != nil {
+			logger.WithError(err).Error("error campaigning for leadership")
+			select {
+			case <-s.stopping:
+				return
+			case <-time.After(leaderRetryInterval):
+			}
+		}
+	}
+}
+
+// schedule schedules checks until the leadership is lost or the daemon is
+// stopped.
+func (s *Schedulerd) schedule(ctx context.Context) error {
+	select {
+	case <-s.stopping:
+		return nil
+	default:
+	}
+
+	logger.Info("scheduling checks as the cluster leader")
+	s.schedulerManager.Start(s.stateManager.State())
 
+	select {
+	case <-ctx.Done():
+		logger.Info("no longer the cluster leader, unscheduling checks")
+	case <-s.stopping:
+	}
+
+	s.schedulerManager.Stop()
 	return nil
 }
 
 // Stop the scheduler daemon.
 func (s *Schedulerd) Stop() error {
+	close(s.stopping)
+	s.cancel()
 	err := s.stateManager.Stop()
 	s.schedulerManager.Stop()
 	s.adhocRequestExecutor.Stop()
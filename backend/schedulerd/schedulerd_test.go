@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/sensu/sensu-go/backend/leader"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store/etcd/testutil"
 	"github.com/sensu/sensu-go/types"
@@ -13,6 +14,9 @@ import (
 )
 
 func TestSchedulerd(t *testing.T) {
+	// Schedule checks as if this was the cluster leader
+	leader.Override()
+
 	// Setup wizard bus
 	bus := &messaging.WizardBus{}
 	if berr := bus.Start(); berr != nil {
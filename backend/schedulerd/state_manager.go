@@ -14,7 +14,9 @@ import (
 type StateManagerStore interface {
 	store.AssetStore
 	store.CheckConfigStore
+	store.CheckTemplateStore
 	store.EntityStore
+	store.EnvironmentStore
 	store.HookConfigStore
 }
 
@@ -45,8 +47,9 @@ func NewStateManager(store StateManagerStore) *StateManager {
 	manager.synchronizer = NewSynchronizeStateScheduler(
 		SynchronizeMinInterval,
 		&SynchronizeChecks{
-			Store:    store,
-			OnUpdate: manager.updateChecks,
+			Store:     store,
+			Templates: store,
+			OnUpdate:  manager.updateChecks,
 		},
 		&SynchronizeAssets{
 			Store:    store,
@@ -60,6 +63,10 @@ func NewStateManager(store StateManagerStore) *StateManager {
 			Store:    store,
 			OnUpdate: manager.updateEntities,
 		},
+		&SynchronizeEnvironments{
+			Store:    store,
+			OnUpdate: manager.updateEnvironments,
+		},
 	)
 
 	return manager
@@ -120,6 +127,12 @@ func (mngrPtr *StateManager) updateEntities(entities []*types.Entity) {
 	})
 }
 
+func (mngrPtr *StateManager) updateEnvironments(environments []*types.Environment) {
+	mngrPtr.updateState(func(state *SchedulerState) {
+		state.SetEnvironments(environments)
+	})
+}
+
 func (mngrPtr *StateManager) updateState(updateFn func(newState *SchedulerState)) {
 	// Lock to avoid competing updates
 	mngrPtr.mutex.Lock()
@@ -157,6 +170,8 @@ type SchedulerState struct {
 	assets   map[string]map[string]*types.Asset
 	hooks    map[string]map[string]*types.HookConfig
 	entities map[string]map[string]*types.Entity
+
+	environments map[string]*types.Environment
 }
 
 // GetCheck returns check given name and organization
@@ -193,6 +208,12 @@ func (statePtr *SchedulerState) GetEntitiesInNamespace(org string, env string) (
 	return
 }
 
+// GetEnvironment returns the environment given its organization and name, or
+// nil if it is unknown
+func (statePtr *SchedulerState) GetEnvironment(org, env string) *types.Environment {
+	return statePtr.environments[concatUniqueKey(org, env)]
+}
+
 // SetChecks overwrites current set of checks w/ given
 func (statePtr *SchedulerState) SetChecks(checks []*types.CheckConfig) {
 	statePtr.checks = make(map[string]*types.CheckConfig)
@@ -225,6 +246,14 @@ func (statePtr *SchedulerState) SetEntities(entities []*types.Entity) {
 	}
 }
 
+// SetEnvironments overwrites current set of environments w/ given
+func (statePtr *SchedulerState) SetEnvironments(environments []*types.Environment) {
+	statePtr.environments = make(map[string]*types.Environment, len(environments))
+	for _, env := range environments {
+		statePtr.environments[concatUniqueKey(env.Organization, env.Name)] = env
+	}
+}
+
 func (statePtr *SchedulerState) addCheck(check *types.CheckConfig) {
 	key := concatUniqueKey(check.Name, check.Organization, check.Environment)
 	statePtr.checks[key] = check
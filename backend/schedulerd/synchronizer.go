@@ -2,10 +2,12 @@ package schedulerd
 
 import (
 	"context"
+	"path"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/Sirupsen/logrus"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -19,16 +21,74 @@ type ResourceSync interface {
 type SynchronizeChecks struct {
 	Store    store.CheckConfigStore
 	OnUpdate func([]*types.CheckConfig)
+
+	// Templates are the check templates whose generated checks are added to
+	// the checks of the store, if not nil.
+	Templates store.CheckTemplateStore
 }
 
 // Sync fetches results from the store and passes them up w/ given handler
 func (syncPtr *SynchronizeChecks) Sync(ctx context.Context) error {
 	results, err := syncPtr.Store.GetCheckConfigs(ctx)
-	if err == nil {
-		syncPtr.OnUpdate(results)
+	if err != nil {
+		return err
 	}
 
-	return err
+	if syncPtr.Templates != nil {
+		templates, err := syncPtr.Templates.GetCheckTemplates(ctx)
+		if err != nil {
+			return err
+		}
+		results = appendTemplatedChecks(results, templates)
+	}
+
+	syncPtr.OnUpdate(withoutStandaloneChecks(results))
+	return nil
+}
+
+// withoutStandaloneChecks removes the standalone checks, which are scheduled
+// by the agents defining them, from the checks.
+func withoutStandaloneChecks(checks []*types.CheckConfig) []*types.CheckConfig {
+	scheduled := make([]*types.CheckConfig, 0, len(checks))
+	for _, check := range checks {
+		if !check.Standalone {
+			scheduled = append(scheduled, check)
+		}
+	}
+	return scheduled
+}
+
+// appendTemplatedChecks appends the checks generated by the templates to the
+// checks. A generated check never replaces a check with the same name in its
+// organization and environment.
+func appendTemplatedChecks(checks []*types.CheckConfig, templates []*types.CheckTemplate) []*types.CheckConfig {
+	names := make(map[string]bool, len(checks))
+	for _, check := range checks {
+		names[path.Join(check.Organization, check.Environment, check.Name)] = true
+	}
+
+	for _, tmpl := range templates {
+		rendered, err := tmpl.Render()
+		if err != nil {
+			logger.WithError(err).WithField("check_template", tmpl.Name).Error("unable to render check template")
+			continue
+		}
+
+		for _, check := range rendered {
+			key := path.Join(check.Organization, check.Environment, check.Name)
+			if names[key] {
+				logger.WithFields(logrus.Fields{
+					"check_template": tmpl.Name,
+					"check":          check.Name,
+				}).Warn("a check with the same name exists, ignoring templated check")
+				continue
+			}
+			names[key] = true
+			checks = append(checks, check)
+		}
+	}
+
+	return checks
 }
 
 // SynchronizeAssets fetches assets from the store and bubbles up results
@@ -63,6 +123,23 @@ func (syncPtr *SynchronizeHooks) Sync(ctx context.Context) error {
 	return err
 }
 
+// SynchronizeEnvironments fetches the environments of all the organizations
+// from the store and bubbles up results
+type SynchronizeEnvironments struct {
+	Store    store.EnvironmentStore
+	OnUpdate func([]*types.Environment)
+}
+
+// Sync fetches results from the store and passes them up w/ given handler
+func (syncPtr *SynchronizeEnvironments) Sync(ctx context.Context) error {
+	results, err := syncPtr.Store.GetEnvironments(ctx, "*")
+	if err == nil {
+		syncPtr.OnUpdate(results)
+	}
+
+	return err
+}
+
 // SynchronizeEntities fetches entities from the store and bubbles up results
 type SynchronizeEntities struct {
 	Store    store.EntityStore
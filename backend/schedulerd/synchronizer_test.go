@@ -28,6 +28,48 @@ func TestSynchronizeChecks(t *testing.T) {
 	require.NoError(t, sync.Sync(context.Background()))
 }
 
+func TestSynchronizeChecksWithTemplates(t *testing.T) {
+	check := types.FixtureCheckConfig("check-http-web01")
+	tmpl := types.FixtureCheckTemplate("http")
+	store := &mockstore.MockStore{}
+	store.On("GetCheckConfigs", mock.Anything).Return([]*types.CheckConfig{check}, nil)
+	store.On("GetCheckTemplates", mock.Anything).Return([]*types.CheckTemplate{tmpl}, nil)
+
+	var checks []*types.CheckConfig
+	sync := SynchronizeChecks{
+		Store:     store,
+		Templates: store,
+		OnUpdate: func(res []*types.CheckConfig) {
+			checks = res
+		},
+	}
+	require.NoError(t, sync.Sync(context.Background()))
+
+	// The templated check named like an existing check is ignored
+	require.Len(t, checks, 2)
+	assert.Equal(t, check, checks[0])
+	assert.Equal(t, "check-http-web02", checks[1].Name)
+	assert.Equal(t, "check-http.rb -u http://web02:8080", checks[1].Command)
+}
+
+func TestSynchronizeChecksWithoutStandalone(t *testing.T) {
+	check := types.FixtureCheckConfig("check1")
+	standalone := types.FixtureCheckConfig("check2")
+	standalone.Standalone = true
+	store := &mockstore.MockStore{}
+	store.On("GetCheckConfigs", mock.Anything).Return([]*types.CheckConfig{check, standalone}, nil)
+
+	var checks []*types.CheckConfig
+	sync := SynchronizeChecks{
+		Store: store,
+		OnUpdate: func(res []*types.CheckConfig) {
+			checks = res
+		},
+	}
+	require.NoError(t, sync.Sync(context.Background()))
+	assert.Equal(t, []*types.CheckConfig{check}, checks)
+}
+
 func TestSynchronizeAssets(t *testing.T) {
 	assert := assert.New(t)
 
@@ -79,6 +121,22 @@ func TestSynchronizeEntities(t *testing.T) {
 	require.NoError(t, sync.Sync(context.Background()))
 }
 
+func TestSynchronizeEnvironments(t *testing.T) {
+	env := types.FixtureEnvironment("prod")
+	env.TimeZone = "Europe/Paris"
+	store := &mockstore.MockStore{}
+	store.On("GetEnvironments", mock.Anything, "*").Return([]*types.Environment{env}, nil)
+
+	state := &SchedulerState{}
+	sync := SynchronizeEnvironments{
+		Store:    store,
+		OnUpdate: state.SetEnvironments,
+	}
+	require.NoError(t, sync.Sync(context.Background()))
+	assert.Equal(t, env, state.GetEnvironment("default", "prod"))
+	assert.Nil(t, state.GetEnvironment("default", "dev"))
+}
+
 func TestSyncScheduler(t *testing.T) {
 	assert := assert.New(t)
 
@@ -2,15 +2,75 @@ package seeds
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
+// ErrAlreadyInitialized is returned by Initialize when the store has already
+// been seeded.
+var ErrAlreadyInitialized = errors.New("store already initialized")
+
+// Config holds the credentials of the default users created when the store is
+// seeded.
+type Config struct {
+	AdminUsername string
+	AdminPassword string
+	AgentUsername string
+	AgentPassword string
+}
+
+// DefaultConfig returns the credentials of the default users used when the
+// backend is started without having been initialized.
+func DefaultConfig() Config {
+	return Config{
+		AdminUsername: "admin",
+		AdminPassword: "P@ssw0rd!",
+		AgentUsername: "agent",
+		AgentPassword: "P@ssw0rd!",
+	}
+}
+
+// Validate returns an error if the credentials of the default users are
+// invalid.
+func (c Config) Validate() error {
+	users := []*types.User{
+		{Username: c.AdminUsername, Password: c.AdminPassword},
+		{Username: c.AgentUsername, Password: c.AgentPassword},
+	}
+	for _, user := range users {
+		if err := user.Validate(); err != nil {
+			return err
+		}
+		if err := user.ValidatePassword(); err != nil {
+			return fmt.Errorf("%s %s", user.Username, err)
+		}
+	}
+
+	return nil
+}
+
 // SeedInitialData will seed a store with initial data. This method is
 // idempotent and can be safely run every time the backend starts.
-func SeedInitialData(store store.Store) (err error) {
+func SeedInitialData(store store.Store) error {
+	err := Initialize(store, DefaultConfig())
+	if err == ErrAlreadyInitialized {
+		return nil
+	}
+	return err
+}
+
+// Initialize seeds a store with the initial data, creating the default users
+// with the given credentials. ErrAlreadyInitialized is returned if the store
+// has already been seeded.
+func Initialize(store store.Store, config Config) (err error) {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
 	initializer, _ := store.NewInitializer()
 	logger := logger.WithField("component", "backend.seeds")
 
@@ -35,24 +95,29 @@ func SeedInitialData(store store.Store) (err error) {
 	if initialized, err := initializer.IsInitialized(); err != nil {
 		return err
 	} else if initialized {
-		return nil
+		return ErrAlreadyInitialized
 	}
 	logger.Info("seeding etcd store w/ intial data")
 
-	// Set default role
+	// Set default roles
 	if err := setupAdminRole(store); err != nil {
 		logger.WithError(err).Error("unable to setup admin role")
 		return err
 	}
 
+	if err := setupAgentRole(store); err != nil {
+		logger.WithError(err).Error("unable to setup agent role")
+		return err
+	}
+
 	// Default user
-	if err := setupDefaultUser(store); err != nil {
+	if err := setupDefaultUser(store, config.AdminUsername, config.AdminPassword); err != nil {
 		logger.WithError(err).Error("unable to setup admin user")
 		return err
 	}
 
 	// Default Agent user
-	if err := setupDefaultAgentUser(store); err != nil {
+	if err := setupDefaultAgentUser(store, config.AgentUsername, config.AgentPassword); err != nil {
 		logger.WithError(err).Error("unable to setup agent user")
 		return err
 	}
@@ -78,12 +143,44 @@ func setupAdminRole(store store.Store) error {
 		context.Background(),
 		&types.Role{
 			Name: "admin",
-			Rules: []types.Rule{{
-				Type:         "*",
-				Environment:  "*",
-				Organization: "*",
-				Permissions:  types.RuleAllPerms,
-			}},
+			Rules: []types.Rule{
+				{
+					Type:         "*",
+					Environment:  "*",
+					Organization: "*",
+					Permissions:  types.RuleAllPerms,
+				},
+				{
+					Type:         types.RuleTypeUser,
+					Environment:  "*",
+					Organization: "*",
+					Permissions:  []string{types.RulePermImpersonate},
+				},
+			},
+		},
+	)
+}
+
+func setupAgentRole(store store.Store) error {
+	perms := []string{types.RulePermCreate, types.RulePermRead, types.RulePermUpdate}
+	return store.UpdateRole(
+		context.Background(),
+		&types.Role{
+			Name: "agent",
+			Rules: []types.Rule{
+				{
+					Type:         types.RuleTypeEntity,
+					Environment:  "*",
+					Organization: "*",
+					Permissions:  perms,
+				},
+				{
+					Type:         types.RuleTypeEvent,
+					Environment:  "*",
+					Organization: "*",
+					Permissions:  perms,
+				},
+			},
 		},
 	)
 }
@@ -107,22 +204,22 @@ func setupDefaultOrganization(store store.Store) error {
 		})
 }
 
-func setupDefaultUser(store store.Store) error {
+func setupDefaultUser(store store.Store, username, password string) error {
 	// Set default user
 	admin := &types.User{
-		Username: "admin",
-		Password: "P@ssw0rd!",
+		Username: username,
+		Password: password,
 		Roles:    []string{"admin"},
 	}
 
 	return store.CreateUser(admin)
 }
 
-func setupDefaultAgentUser(store store.Store) error {
+func setupDefaultAgentUser(store store.Store, username, password string) error {
 	// default agent user/pass
 	agent := &types.User{
-		Username: "agent",
-		Password: "P@ssw0rd!",
+		Username: username,
+		Password: password,
 		Roles:    []string{"agent"},
 	}
 
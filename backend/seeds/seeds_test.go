@@ -44,3 +44,45 @@ func TestSeedInitialData(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, defaultEnv, "default environment should be present after seed process")
 }
+
+func TestInitialize(t *testing.T) {
+	ctx := context.Background()
+	st, serr := testutil.NewStoreInstance()
+	if serr != nil {
+		assert.FailNow(t, serr.Error())
+	}
+	defer st.Teardown()
+
+	config := Config{
+		AdminUsername: "root",
+		AdminPassword: "secret-password",
+		AgentUsername: "sensu-agent",
+		AgentPassword: "agent-password",
+	}
+
+	// The credentials must be valid
+	invalid := config
+	invalid.AdminPassword = "short"
+	assert.Error(t, Initialize(st, invalid))
+
+	require.NoError(t, Initialize(st, config))
+	assert.Equal(t, ErrAlreadyInitialized, Initialize(st, config))
+
+	// Starting the backend afterwards doesn't seed the default users
+	require.NoError(t, SeedInitialData(st))
+
+	admin, err := st.AuthenticateUser(ctx, "root", "secret-password")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"admin"}, admin.Roles)
+
+	_, err = st.AuthenticateUser(ctx, "sensu-agent", "agent-password")
+	require.NoError(t, err)
+
+	defaultAdmin, err := st.GetUser(ctx, "admin")
+	require.NoError(t, err)
+	assert.Nil(t, defaultAdmin)
+
+	agentRole, err := st.GetRoleByName(ctx, "agent")
+	require.NoError(t, err)
+	assert.NotNil(t, agentRole)
+}
@@ -0,0 +1,69 @@
+package shardd
+
+import (
+	"hash/crc32"
+	"path"
+	"sort"
+	"strconv"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// replicas is the number of points of each member on the hash ring, which
+// spread the keys evenly across the members.
+const replicas = 64
+
+// hashRing maps the keys to the members by consistent hashing, so that only
+// the keys of a member joining or leaving are moved to another member.
+type hashRing struct {
+	hashes  []uint32
+	members map[uint32]string
+}
+
+// newHashRing returns the hash ring of the given members. The members are
+// sorted so that every backend builds the same ring.
+func newHashRing(members []string) *hashRing {
+	sorted := append([]string(nil), members...)
+	sort.Strings(sorted)
+
+	r := &hashRing{members: make(map[uint32]string, len(sorted)*replicas)}
+	for _, member := range sorted {
+		for i := 0; i < replicas; i++ {
+			hash := crc32.ChecksumIEEE([]byte(strconv.Itoa(i) + member))
+			if _, ok := r.members[hash]; ok {
+				continue
+			}
+			r.hashes = append(r.hashes, hash)
+			r.members[hash] = member
+		}
+	}
+	sort.Slice(r.hashes, func(i, j int) bool { return r.hashes[i] < r.hashes[j] })
+	return r
+}
+
+// owner returns the member owning the given key, or an empty string if the
+// ring has no members.
+func (r *hashRing) owner(key string) string {
+	if len(r.hashes) == 0 {
+		return ""
+	}
+	hash := crc32.ChecksumIEEE([]byte(key))
+	i := sort.Search(len(r.hashes), func(i int) bool { return r.hashes[i] >= hash })
+	if i == len(r.hashes) {
+		i = 0
+	}
+	return r.members[r.hashes[i]]
+}
+
+// eventKey returns the key of the event on the hash ring, so that the events
+// of an entity and a check are always handled by the same backend.
+func eventKey(event *types.Event) string {
+	var entity, check string
+	if event.Entity != nil {
+		entity = path.Join(event.Entity.Organization, event.Entity.Environment, event.Entity.ID)
+	}
+	if event.Check != nil {
+		check = event.Check.Name
+	}
+	return path.Join(entity, check)
+}
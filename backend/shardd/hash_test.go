@@ -0,0 +1,48 @@
+package shardd
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashRingOwner(t *testing.T) {
+	assert.Equal(t, "", newHashRing(nil).owner("key"))
+	assert.Equal(t, "a", newHashRing([]string{"a"}).owner("key"))
+
+	// The ring doesn't depend on the order of the members
+	ring := newHashRing([]string{"a", "b", "c"})
+	other := newHashRing([]string{"c", "a", "b"})
+	counts := map[string]int{}
+	for i := 0; i < 3000; i++ {
+		key := fmt.Sprintf("key%d", i)
+		assert.Equal(t, ring.owner(key), other.owner(key))
+		counts[ring.owner(key)]++
+	}
+	for _, member := range []string{"a", "b", "c"} {
+		assert.True(t, counts[member] > 500, "member %s owns %d keys", member, counts[member])
+	}
+}
+
+func TestHashRingRebalance(t *testing.T) {
+	ring := newHashRing([]string{"a", "b", "c"})
+	rebalanced := newHashRing([]string{"a", "b"})
+
+	// Only the keys of the member which left are moved
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("key%d", i)
+		if owner := ring.owner(key); owner != "c" {
+			assert.Equal(t, owner, rebalanced.owner(key))
+		}
+	}
+}
+
+func TestEventKey(t *testing.T) {
+	event := types.FixtureEvent("entity", "check")
+	assert.Equal(t, "default/default/entity/check", eventKey(event))
+
+	event.Check = nil
+	assert.Equal(t, "default/default/entity", eventKey(event))
+}
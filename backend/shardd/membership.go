@@ -0,0 +1,227 @@
+package shardd
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/concurrency"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// memberTTL is the time to live, in seconds, of the membership of a backend.
+// The events of a backend which went away are moved to the other backends
+// at most this long after.
+const memberTTL = 10
+
+var membersKeyBuilder = store.NewKeyBuilder("shards")
+
+// Membership tracks the backends sharing the handling of the events.
+type Membership interface {
+	// ID returns the ID of this backend among the members.
+	ID() string
+
+	// Join adds this backend to the members, until it leaves.
+	Join(ctx context.Context) error
+
+	// Leave removes this backend from the members.
+	Leave() error
+
+	// Watch returns the IDs of the members, sorted, each time they change,
+	// starting with the current members. The channel is closed once the
+	// context is canceled.
+	Watch(ctx context.Context) <-chan []string
+}
+
+// EtcdMembership tracks the members in etcd, where each member keeps a key
+// alive with its lease.
+type EtcdMembership struct {
+	client *clientv3.Client
+	prefix string
+	token  string
+	id     string
+
+	mu      sync.Mutex
+	session *concurrency.Session
+}
+
+// NewEtcdMembership returns the membership of the given name, of which this
+// backend is a member with the given ID, e.g. the URL at which the other
+// members reach it. The ID must be unique among the members.
+func NewEtcdMembership(client *clientv3.Client, name, id string) *EtcdMembership {
+	return &EtcdMembership{
+		client: client,
+		prefix: membersKeyBuilder.Build(name, "members") + "/",
+		token:  membersKeyBuilder.Build(name, "token"),
+		id:     id,
+	}
+}
+
+// ID returns the ID of this backend among the members.
+func (m *EtcdMembership) ID() string {
+	return m.id
+}
+
+// Token returns the secret shared by the members, which authenticates the
+// events they forward to each other. The token is created by the first member
+// asking for it.
+func (m *EtcdMembership) Token(ctx context.Context) (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	cmp := clientv3.Compare(clientv3.CreateRevision(m.token), "=", 0)
+	put := clientv3.OpPut(m.token, hex.EncodeToString(secret))
+	get := clientv3.OpGet(m.token)
+	resp, err := m.client.Txn(ctx).If(cmp).Then(put).Else(get).Commit()
+	if err != nil {
+		return "", err
+	}
+	if resp.Succeeded {
+		return hex.EncodeToString(secret), nil
+	}
+
+	kvs := resp.Responses[0].GetResponseRange().Kvs
+	if len(kvs) == 0 {
+		return "", errors.New("the token of the members was deleted")
+	}
+	return string(kvs[0].Value), nil
+}
+
+// Join adds this backend to the members, until it leaves. The membership is
+// renewed if its lease expires, e.g. when etcd could not be reached for a
+// while, until the context is canceled.
+func (m *EtcdMembership) Join(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.session != nil {
+		return errors.New("already a member")
+	}
+
+	session, err := m.join(ctx)
+	if err != nil {
+		return err
+	}
+	m.session = session
+
+	go m.rejoin(ctx, session)
+	return nil
+}
+
+// join puts the key of this backend among the members, with the lease of a
+// new session.
+func (m *EtcdMembership) join(ctx context.Context) (*concurrency.Session, error) {
+	session, err := concurrency.NewSession(m.client, concurrency.WithTTL(memberTTL))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := m.client.Put(ctx, m.prefix+m.id, m.id, clientv3.WithLease(session.Lease())); err != nil {
+		_ = session.Close()
+		return nil, err
+	}
+	return session, nil
+}
+
+// rejoin joins the members again with a new session each time the session of
+// this backend is done, unless it left.
+func (m *EtcdMembership) rejoin(ctx context.Context, session *concurrency.Session) {
+	for {
+		select {
+		case <-session.Done():
+		case <-ctx.Done():
+			return
+		}
+
+		for {
+			m.mu.Lock()
+			if m.session != session {
+				// The backend left
+				m.mu.Unlock()
+				return
+			}
+			next, err := m.join(ctx)
+			if err == nil {
+				m.session = next
+			}
+			m.mu.Unlock()
+
+			if err == nil {
+				logger.WithField("member", m.id).Warn("the membership expired, joined the members again")
+				session = next
+				break
+			}
+
+			logger.WithError(err).Error("could not join the members again")
+			select {
+			case <-time.After(retryInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// Leave removes this backend from the members right away, by revoking its
+// lease.
+func (m *EtcdMembership) Leave() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.session == nil {
+		return nil
+	}
+	err := m.session.Close()
+	m.session = nil
+	return err
+}
+
+// Watch returns the IDs of the members each time they change, starting with
+// the current members.
+func (m *EtcdMembership) Watch(ctx context.Context) <-chan []string {
+	ch := make(chan []string, 1)
+	go func() {
+		defer close(ch)
+		for ctx.Err() == nil {
+			resp, err := m.client.Get(ctx, m.prefix, clientv3.WithPrefix())
+			if err != nil {
+				logger.WithError(err).Error("could not get the members")
+				select {
+				case <-time.After(retryInterval):
+				case <-ctx.Done():
+				}
+				continue
+			}
+
+			select {
+			case ch <- memberIDs(resp.Kvs):
+			case <-ctx.Done():
+				return
+			}
+
+			// The members are read again on the next change
+			watchCtx, cancel := context.WithCancel(ctx)
+			watcher := m.client.Watch(watchCtx, m.prefix, clientv3.WithPrefix(), clientv3.WithRev(resp.Header.Revision+1))
+			select {
+			case <-watcher:
+			case <-ctx.Done():
+			}
+			cancel()
+		}
+	}()
+	return ch
+}
+
+func memberIDs(kvs []*mvccpb.KeyValue) []string {
+	ids := make([]string, 0, len(kvs))
+	for _, kv := range kvs {
+		ids = append(ids, string(kv.Value))
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,79 @@
+// +build integration,!race
+
+package shardd
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func nextMembers(t *testing.T, ch <-chan []string) []string {
+	select {
+	case members := <-ch:
+		return members
+	case <-time.After(5 * time.Second):
+		t.Fatal("the members did not change")
+	}
+	return nil
+}
+
+func TestEtcdMembership(t *testing.T) {
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+	client, err := e.NewClient()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := NewEtcdMembership(client, "test", "http://a.example.com:8082")
+	b := NewEtcdMembership(client, "test", "http://b.example.com:8082")
+	require.NoError(t, a.Join(ctx))
+	assert.Error(t, a.Join(ctx))
+
+	updates := a.Watch(ctx)
+	assert.Equal(t, []string{a.ID()}, nextMembers(t, updates))
+
+	require.NoError(t, b.Join(ctx))
+	members := []string{a.ID(), b.ID()}
+	sort.Strings(members)
+	assert.Equal(t, members, nextMembers(t, updates))
+
+	require.NoError(t, b.Leave())
+	assert.Equal(t, []string{a.ID()}, nextMembers(t, updates))
+
+	// A member joins again once its lease expired
+	a.mu.Lock()
+	lease := a.session.Lease()
+	a.mu.Unlock()
+	_, err = client.Revoke(ctx, lease)
+	require.NoError(t, err)
+	assert.Empty(t, nextMembers(t, updates))
+	assert.Equal(t, []string{a.ID()}, nextMembers(t, updates))
+
+	cancel()
+	for range updates {
+	}
+	assert.NoError(t, a.Leave())
+}
+
+func TestEtcdMembershipToken(t *testing.T) {
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+	client, err := e.NewClient()
+	require.NoError(t, err)
+
+	// The members share the same token
+	token, err := NewEtcdMembership(client, "test", "a").Token(context.Background())
+	require.NoError(t, err)
+	assert.NotEmpty(t, token)
+	other, err := NewEtcdMembership(client, "test", "b").Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, token, other)
+}
@@ -0,0 +1,213 @@
+package shardd
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/types"
+)
+
+// peerEventsPath is the path at which the members receive the events forwarded
+// by the other members.
+const peerEventsPath = "/events"
+
+// maxPeerEventSize is the maximum size of an event forwarded by a member.
+const maxPeerEventSize = 10 * 1024 * 1024
+
+// PeerBus is the message bus shared by the members over HTTP, where the ID of
+// each member is the URL at which it listens. The events published to the
+// topic of another member, messaging.ShardTopic, are sent to its URL, and the
+// events received from the other members are published to the topic of this
+// backend. The other topics are only published to this backend.
+type PeerBus struct {
+	// ID of this backend among the members, which is the URL at which the
+	// other members reach its Address.
+	ID string
+
+	// Address is the address the events of the other members are received
+	// on, e.g. ":8082".
+	Address string
+
+	// Token is the secret shared by the members, which authenticates the
+	// events they forward.
+	Token string
+
+	// TLSConfig is the configuration of the TLS listener, which receives the
+	// events over plain http when nil.
+	TLSConfig *tls.Config
+
+	// ClientTLSConfig is the TLS configuration of the requests to the other
+	// members.
+	ClientTLSConfig *tls.Config
+
+	local   *messaging.WizardBus
+	client  *http.Client
+	server  *http.Server
+	errChan chan error
+	wg      *sync.WaitGroup
+}
+
+// Start the bus, receiving the events of the other members.
+func (b *PeerBus) Start() error {
+	if b.ID == "" {
+		return errors.New("no member ID found")
+	}
+	if b.Token == "" {
+		return errors.New("no token found")
+	}
+
+	b.local = &messaging.WizardBus{BackpressureTimeout: messaging.DefaultBackpressureTimeout}
+	if err := b.local.Start(); err != nil {
+		return err
+	}
+
+	b.client = &http.Client{
+		Timeout:   forwardTimeout,
+		Transport: &http.Transport{TLSClientConfig: b.ClientTLSConfig},
+	}
+	b.errChan = make(chan error, 1)
+	b.wg = &sync.WaitGroup{}
+
+	if b.Address == "" {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(peerEventsPath, b)
+	b.server = &http.Server{
+		Addr:         b.Address,
+		Handler:      mux,
+		TLSConfig:    b.TLSConfig,
+		ReadTimeout:  15 * time.Second,
+		WriteTimeout: 15 * time.Second,
+	}
+
+	logger.Info("starting the shards listener on address: ", b.Address)
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		var err error
+		if b.TLSConfig != nil {
+			err = b.server.ListenAndServeTLS("", "")
+		} else {
+			err = b.server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			b.errChan <- fmt.Errorf("failed to start the shards listener: %s", err)
+		}
+	}()
+
+	return nil
+}
+
+// Stop the bus.
+func (b *PeerBus) Stop() error {
+	if b.server != nil {
+		if err := b.server.Shutdown(context.Background()); err != nil {
+			logger.WithError(err).Error("failed to shutdown the shards listener gracefully")
+			_ = b.server.Close()
+		}
+	}
+	b.wg.Wait()
+	close(b.errChan)
+	return b.local.Stop()
+}
+
+// Status returns an error if the bus is unhealthy.
+func (b *PeerBus) Status() error {
+	return b.local.Status()
+}
+
+// Err returns a channel to listen for terminal errors on.
+func (b *PeerBus) Err() <-chan error {
+	return b.errChan
+}
+
+// Subscribe to a topic of this backend.
+func (b *PeerBus) Subscribe(topic string, consumer string, channel chan<- interface{}) error {
+	return b.local.Subscribe(topic, consumer, channel)
+}
+
+// Unsubscribe from a topic of this backend.
+func (b *PeerBus) Unsubscribe(topic string, consumer string) error {
+	return b.local.Unsubscribe(topic, consumer)
+}
+
+// Publish the message to the given topic, sending it to the member whose
+// topic it is. An error is returned if the member did not receive it.
+func (b *PeerBus) Publish(topic string, message interface{}) error {
+	prefix := messaging.ShardTopic("")
+	member := strings.TrimPrefix(topic, prefix)
+	if !strings.HasPrefix(topic, prefix) || member == b.ID {
+		return b.local.Publish(topic, message)
+	}
+
+	event, ok := message.(*types.Event)
+	if !ok {
+		return fmt.Errorf("can't forward a %T to a member", message)
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(member, "/")+peerEventsPath, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+b.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("the member rejected the event: %s", resp.Status)
+	}
+	return nil
+}
+
+// ServeHTTP receives an event forwarded by another member, and publishes it to
+// the topic of this backend.
+func (b *PeerBus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(b.Token)) != 1 {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var event types.Event
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxPeerEventSize)).Decode(&event); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// The event is rejected if this backend is too busy to handle it, so that
+	// the member forwarding it enqueues it instead
+	if err := b.local.Publish(messaging.ShardTopic(b.ID), &event); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,51 @@
+package shardd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerBus(t *testing.T) {
+	var b *PeerBus
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	a := &PeerBus{ID: "http://a.example.com", Token: "secret"}
+	require.NoError(t, a.Start())
+	defer a.Stop()
+	b = &PeerBus{ID: server.URL, Token: "secret"}
+	require.NoError(t, b.Start())
+	defer b.Stop()
+
+	received := make(chan interface{}, 1)
+	require.NoError(t, b.Subscribe(messaging.ShardTopic(b.ID), "test", received))
+
+	// The events published to the topic of a member are sent to it
+	event := types.FixtureEvent("entity1", "check1")
+	require.NoError(t, a.Publish(messaging.ShardTopic(b.ID), event))
+	select {
+	case msg := <-received:
+		assert.Equal(t, event.Entity.ID, msg.(*types.Event).Entity.ID)
+	case <-time.After(time.Second):
+		t.Fatal("the event was not received")
+	}
+
+	// The events of the members which don't share the token are rejected
+	c := &PeerBus{ID: "http://c.example.com", Token: "guess"}
+	require.NoError(t, c.Start())
+	defer c.Stop()
+	assert.Error(t, c.Publish(messaging.ShardTopic(b.ID), event))
+
+	// The events of an unreachable member can't be sent
+	server.Close()
+	assert.Error(t, a.Publish(messaging.ShardTopic(b.ID), event))
+}
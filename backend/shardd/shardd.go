@@ -0,0 +1,370 @@
+// Package shardd shards the handling of the events across the backends
+// sharing the same etcd cluster, so that the handlers throughput scales with
+// the number of backends.
+package shardd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/queue"
+	"github.com/sensu/sensu-go/types"
+	stringsutil "github.com/sensu/sensu-go/util/strings"
+)
+
+// ComponentName identifies Shardd as the component/daemon implemented in
+// this package.
+const ComponentName = "shardd"
+
+// DefaultBufferSize is the default number of events queued for routing
+// before publishers are slowed down.
+const DefaultBufferSize = 100
+
+var (
+	// retryInterval is how long shardd waits before reading the members or
+	// its queue again after an error.
+	retryInterval = time.Second
+
+	// forwardTimeout is the timeout of the forwarding of an event to another
+	// backend, over the message bus or through its queue.
+	forwardTimeout = 5 * time.Second
+
+	// drainPollInterval is how long shardd waits for an item of the queue of
+	// a backend which left, before checking whether its queue is empty.
+	drainPollInterval = 5 * time.Second
+)
+
+// Shardd routes the events published by eventd to the backend owning their
+// entity and check, which publishes them to messaging.TopicEventShard for
+// pipelined. The owners are chosen by consistent hashing among the members,
+// so that only the events of a backend joining or leaving move to another
+// backend. The events are forwarded over the message bus shared by the
+// members, or through the queue of their owner if it can't receive them, and
+// the queue of a backend which left is drained by the backend now owning it.
+type Shardd struct {
+	MessageBus messaging.MessageBus
+	Queues     queue.Get
+	Membership Membership
+
+	// Peers is the message bus shared by the members, over which the events
+	// are forwarded to the topic of their owner, messaging.ShardTopic.
+	// Defaults to MessageBus, which only reaches this backend.
+	Peers messaging.MessageBus
+
+	// BufferSize is the number of events queued for routing. Defaults to
+	// DefaultBufferSize.
+	BufferSize int
+
+	mu      sync.RWMutex
+	members []string
+	ring    *hashRing
+
+	ctx           context.Context
+	cancel        context.CancelFunc
+	eventChan     chan interface{}
+	forwardedChan chan interface{}
+	errChan       chan error
+	wg            *sync.WaitGroup
+}
+
+// Start shardd, joining the members.
+func (s *Shardd) Start() error {
+	if s.MessageBus == nil {
+		return errors.New("no message bus found")
+	}
+	if s.Queues == nil {
+		return errors.New("no queues found")
+	}
+	if s.Membership == nil {
+		return errors.New("no membership found")
+	}
+
+	if s.BufferSize == 0 {
+		s.BufferSize = DefaultBufferSize
+	}
+
+	if s.Peers == nil {
+		s.Peers = s.MessageBus
+	}
+
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.errChan = make(chan error, 1)
+	s.wg = &sync.WaitGroup{}
+
+	if err := s.Membership.Join(s.ctx); err != nil {
+		s.cancel()
+		return err
+	}
+	// The events are handled locally until the members are known
+	s.setMembers([]string{s.Membership.ID()})
+
+	s.forwardedChan = make(chan interface{}, s.BufferSize)
+	if err := s.Peers.Subscribe(messaging.ShardTopic(s.Membership.ID()), ComponentName, s.forwardedChan); err != nil {
+		_ = s.Membership.Leave()
+		s.cancel()
+		return err
+	}
+
+	s.eventChan = make(chan interface{}, s.BufferSize)
+	if err := s.MessageBus.Subscribe(messaging.TopicEvent, ComponentName, s.eventChan); err != nil {
+		_ = s.Peers.Unsubscribe(messaging.ShardTopic(s.Membership.ID()), ComponentName)
+		_ = s.Membership.Leave()
+		s.cancel()
+		return err
+	}
+
+	s.wg.Add(4)
+	go s.watchMembers(s.Membership.Watch(s.ctx))
+	go s.routeEvents()
+	go s.receive()
+	go s.receiveQueued()
+
+	logger.WithField("member", s.Membership.ID()).Info("joined the event handling shards")
+	return nil
+}
+
+// Stop shardd. The events queued for routing are routed, and the events
+// forwarded to this backend are published for pipelined, except those left in
+// its queue, which are drained by the other members once it left.
+func (s *Shardd) Stop() error {
+	err := s.MessageBus.Unsubscribe(messaging.TopicEvent, ComponentName)
+	if unsubErr := s.Peers.Unsubscribe(messaging.ShardTopic(s.Membership.ID()), ComponentName); err == nil {
+		err = unsubErr
+	}
+	s.cancel()
+	s.wg.Wait()
+	if leaveErr := s.Membership.Leave(); err == nil {
+		err = leaveErr
+	}
+	close(s.errChan)
+	return err
+}
+
+// Status returns an error if shardd is unhealthy.
+func (s *Shardd) Status() error {
+	return nil
+}
+
+// Err returns a channel to listen for terminal errors on.
+func (s *Shardd) Err() <-chan error {
+	return s.errChan
+}
+
+// setMembers rebuilds the hash ring with the given members, and returns the
+// previous members.
+func (s *Shardd) setMembers(members []string) []string {
+	ring := newHashRing(members)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	previous := s.members
+	s.members = members
+	s.ring = ring
+	return previous
+}
+
+// owner returns the member owning the given key.
+func (s *Shardd) owner(key string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.ring.owner(key)
+}
+
+// watchMembers rebalances the events as the members change, and drains the
+// queues of the members which left and whose queue is now owned by this
+// backend.
+func (s *Shardd) watchMembers(updates <-chan []string) {
+	defer s.wg.Done()
+	for members := range updates {
+		previous := s.setMembers(members)
+		logger.WithField("members", len(members)).Info("rebalancing the event handling shards")
+
+		for _, member := range previous {
+			if stringsutil.InArray(member, members) || s.owner(member) != s.Membership.ID() {
+				continue
+			}
+			s.wg.Add(1)
+			go s.drain(member)
+		}
+	}
+}
+
+// routeEvents routes the events published by eventd. The events queued when
+// shardd is stopped are still routed.
+func (s *Shardd) routeEvents() {
+	defer s.wg.Done()
+	for {
+		select {
+		case msg := <-s.eventChan:
+			s.routeMessage(msg)
+		case <-s.ctx.Done():
+			for {
+				select {
+				case msg := <-s.eventChan:
+					s.routeMessage(msg)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *Shardd) routeMessage(msg interface{}) {
+	if event, ok := msg.(*types.Event); ok {
+		s.route(event)
+	}
+}
+
+// route forwards the event to the backend owning it, or publishes it for
+// pipelined if this backend owns it. The event is handled locally if it
+// can't be forwarded.
+func (s *Shardd) route(event *types.Event) {
+	owner := s.owner(eventKey(event))
+	if owner != "" && owner != s.Membership.ID() {
+		err := s.forward(owner, event)
+		if err == nil {
+			return
+		}
+		logger.WithError(err).WithField("member", owner).Warn("could not forward the event, handling it locally")
+	}
+	if err := s.MessageBus.Publish(messaging.TopicEventShard, event); err != nil {
+		logger.WithError(err).Error("could not publish the event")
+	}
+}
+
+// forward publishes the event to the topic of the given member, or enqueues
+// it in the queue of the member if the member can't receive it.
+func (s *Shardd) forward(member string, event *types.Event) error {
+	err := s.Peers.Publish(messaging.ShardTopic(member), event)
+	if err == nil {
+		return nil
+	}
+	logger.WithError(err).WithField("member", member).Debug("could not send the event, enqueuing it")
+
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), forwardTimeout)
+	defer cancel()
+	return s.Queues.NewQueue(queueName(member)).Enqueue(ctx, string(b))
+}
+
+// receive publishes the events forwarded to this backend over the message
+// bus for pipelined. The events received when shardd is stopped are still
+// published.
+func (s *Shardd) receive() {
+	defer s.wg.Done()
+	for {
+		select {
+		case msg := <-s.forwardedChan:
+			s.publishMessage(msg)
+		case <-s.ctx.Done():
+			for {
+				select {
+				case msg := <-s.forwardedChan:
+					s.publishMessage(msg)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *Shardd) publishMessage(msg interface{}) {
+	if event, ok := msg.(*types.Event); ok {
+		if err := s.MessageBus.Publish(messaging.TopicEventShard, event); err != nil {
+			logger.WithError(err).Error("could not publish the event")
+		}
+	}
+}
+
+// receiveQueued publishes the events enqueued for this backend for pipelined.
+func (s *Shardd) receiveQueued() {
+	defer s.wg.Done()
+	q := s.Queues.NewQueue(queueName(s.Membership.ID()))
+	for {
+		item, err := q.Dequeue(s.ctx)
+		if err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+			logger.WithError(err).Error("could not receive the forwarded events")
+			select {
+			case <-time.After(retryInterval):
+			case <-s.ctx.Done():
+				return
+			}
+			continue
+		}
+
+		var event types.Event
+		if err := json.Unmarshal([]byte(item.Value), &event); err != nil {
+			logger.WithError(err).Error("discarding an invalid forwarded event")
+		} else if err := s.MessageBus.Publish(messaging.TopicEventShard, &event); err != nil {
+			logger.WithError(err).Error("could not publish the event")
+		}
+		if err := item.Ack(context.Background()); err != nil {
+			logger.WithError(err).Error("could not acknowledge the forwarded event")
+		}
+	}
+}
+
+// drain routes the events left in the queue of the given member, which left,
+// to their new owners, until its queue is empty. The items it dequeued
+// without acking them are drained once they are returned to the queue.
+func (s *Shardd) drain(member string) {
+	defer s.wg.Done()
+	q := s.Queues.NewQueue(queueName(member))
+	drained := 0
+
+	for s.ctx.Err() == nil {
+		ctx, cancel := context.WithTimeout(s.ctx, drainPollInterval)
+		item, err := q.Dequeue(ctx)
+		cancel()
+		if err != nil {
+			if s.ctx.Err() != nil {
+				break
+			}
+			empty, err := q.Empty(s.ctx)
+			if err == nil && empty {
+				break
+			}
+			if err != nil && s.ctx.Err() == nil {
+				logger.WithError(err).WithField("member", member).Error("could not drain the events of a member which left")
+				select {
+				case <-time.After(retryInterval):
+				case <-s.ctx.Done():
+				}
+			}
+			continue
+		}
+
+		var event types.Event
+		if err := json.Unmarshal([]byte(item.Value), &event); err != nil {
+			logger.WithError(err).Error("discarding an invalid forwarded event")
+		} else {
+			s.route(&event)
+			drained++
+		}
+		if err := item.Ack(context.Background()); err != nil {
+			logger.WithError(err).Error("could not acknowledge the forwarded event")
+		}
+	}
+
+	if drained > 0 {
+		logger.WithField("member", member).WithField("events", drained).Info("drained the events of a member which left")
+	}
+}
+
+// queueName returns the name of the queue of the events forwarded to the
+// given member.
+func queueName(member string) string {
+	return path.Join(ComponentName, member)
+}
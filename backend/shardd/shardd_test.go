@@ -0,0 +1,190 @@
+package shardd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/queue"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testMembership is a membership whose members are set by the tests.
+type testMembership struct {
+	id      string
+	updates chan []string
+}
+
+func newTestMembership(id string) *testMembership {
+	return &testMembership{id: id, updates: make(chan []string)}
+}
+
+func (m *testMembership) ID() string                     { return m.id }
+func (m *testMembership) Join(ctx context.Context) error { return nil }
+func (m *testMembership) Leave() error                   { return nil }
+
+func (m *testMembership) Watch(ctx context.Context) <-chan []string {
+	ch := make(chan []string)
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case members := <-m.updates:
+				select {
+				case ch <- members:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// failingBus fails to publish the events forwarded to the members.
+type failingBus struct {
+	*messaging.WizardBus
+}
+
+func (b failingBus) Publish(topic string, message interface{}) error {
+	return errors.New("unreachable member")
+}
+
+func newTestPeers(t *testing.T) *messaging.WizardBus {
+	peers := &messaging.WizardBus{}
+	require.NoError(t, peers.Start())
+	return peers
+}
+
+func newTestShardd(t *testing.T, id string, peers messaging.MessageBus, queues queue.Get) (*Shardd, *testMembership, chan interface{}) {
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+
+	membership := newTestMembership(id)
+	s := &Shardd{
+		MessageBus: bus,
+		Peers:      peers,
+		Queues:     queues,
+		Membership: membership,
+	}
+	require.NoError(t, s.Start())
+
+	handled := make(chan interface{}, 100)
+	require.NoError(t, bus.Subscribe(messaging.TopicEventShard, "test", handled))
+	return s, membership, handled
+}
+
+// setMembers sets the members of the given shardd and waits for its hash
+// ring to be rebuilt.
+func setMembers(t *testing.T, s *Shardd, membership *testMembership, members ...string) {
+	membership.updates <- members
+	for i := 0; i < 100; i++ {
+		s.mu.RLock()
+		n := len(s.members)
+		s.mu.RUnlock()
+		if n == len(members) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("the members were not updated")
+}
+
+func receiveEvents(ch chan interface{}, timeout time.Duration) []*types.Event {
+	var events []*types.Event
+	for {
+		select {
+		case msg := <-ch:
+			events = append(events, msg.(*types.Event))
+		case <-time.After(timeout):
+			return events
+		}
+	}
+}
+
+func TestShardd(t *testing.T) {
+	peers := newTestPeers(t)
+	queues := queue.NewMemoryGetter()
+	a, membershipA, handledA := newTestShardd(t, "a", peers, queues)
+	b, membershipB, handledB := newTestShardd(t, "b", peers, queues)
+	setMembers(t, a, membershipA, "a", "b")
+	setMembers(t, b, membershipB, "a", "b")
+
+	for i := 0; i < 20; i++ {
+		event := types.FixtureEvent(fmt.Sprintf("entity%d", i), "check")
+		require.NoError(t, a.MessageBus.Publish(messaging.TopicEvent, event))
+	}
+
+	eventsA := receiveEvents(handledA, 200*time.Millisecond)
+	eventsB := receiveEvents(handledB, 200*time.Millisecond)
+	assert.Len(t, append(eventsA, eventsB...), 20)
+	assert.NotEmpty(t, eventsA)
+	assert.NotEmpty(t, eventsB)
+
+	// Each event is handled by the backend owning it
+	ring := newHashRing([]string{"a", "b"})
+	for _, event := range eventsA {
+		assert.Equal(t, "a", ring.owner(eventKey(event)))
+	}
+	for _, event := range eventsB {
+		assert.Equal(t, "b", ring.owner(eventKey(event)))
+		assert.Equal(t, "check", event.Check.Name)
+	}
+
+	// The events were forwarded over the bus
+	empty, err := queues.NewQueue(queueName("b")).Empty(context.Background())
+	require.NoError(t, err)
+	assert.True(t, empty)
+
+	assert.NoError(t, a.Stop())
+	assert.NoError(t, b.Stop())
+}
+
+func TestShardForwardQueue(t *testing.T) {
+	peers := failingBus{newTestPeers(t)}
+	queues := queue.NewMemoryGetter()
+	a, membershipA, handledA := newTestShardd(t, "a", peers, queues)
+	b, membershipB, handledB := newTestShardd(t, "b", peers, queues)
+	setMembers(t, a, membershipA, "a", "b")
+	setMembers(t, b, membershipB, "a", "b")
+
+	// The events which can't be sent to their owner are enqueued for it
+	for i := 0; i < 20; i++ {
+		event := types.FixtureEvent(fmt.Sprintf("entity%d", i), "check")
+		require.NoError(t, a.MessageBus.Publish(messaging.TopicEvent, event))
+	}
+
+	eventsA := receiveEvents(handledA, 200*time.Millisecond)
+	eventsB := receiveEvents(handledB, 200*time.Millisecond)
+	assert.Len(t, append(eventsA, eventsB...), 20)
+	assert.NotEmpty(t, eventsB)
+
+	assert.NoError(t, a.Stop())
+	assert.NoError(t, b.Stop())
+}
+
+func TestShardDrain(t *testing.T) {
+	queues := queue.NewMemoryGetter()
+	a, membership, handled := newTestShardd(t, "a", newTestPeers(t), queues)
+	setMembers(t, a, membership, "a", "b")
+
+	// The events forwarded to b, which leaves before receiving them, are
+	// handled by a
+	for i := 0; i < 3; i++ {
+		b, err := json.Marshal(types.FixtureEvent(fmt.Sprintf("entity%d", i), "check"))
+		require.NoError(t, err)
+		require.NoError(t, queues.NewQueue(queueName("b")).Enqueue(context.Background(), string(b)))
+	}
+	setMembers(t, a, membership, "a")
+
+	assert.Len(t, receiveEvents(handled, 200*time.Millisecond), 3)
+	assert.NoError(t, a.Stop())
+}
@@ -0,0 +1,23 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/apid"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusReadOnlyReplica(t *testing.T) {
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	defer func() { _ = bus.Stop() }()
+
+	// The daemons processing the events aren't started by read-only replicas
+	b := &Backend{messageBus: bus, apid: &apid.APId{}}
+	b.setStoreHealthy(true)
+
+	status := b.Status()
+	assert.Equal(t, map[string]bool{"store": true, "message_bus": true, "apid": true}, map[string]bool(status))
+}
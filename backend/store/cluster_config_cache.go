@@ -0,0 +1,150 @@
+package store
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// cacheWatchRetryInterval is how long the caches wait before watching the
+// store again once their watcher was closed.
+var cacheWatchRetryInterval = time.Second
+
+// ClusterConfigCache caches the defaults of each organization, which are the
+// cluster-wide defaults overridden by those of the organization, so they are
+// not fetched from the store for every event or keepalive. The cached defaults
+// are kept up to date while Watch runs, and fetched from the store on every
+// call otherwise.
+type ClusterConfigCache struct {
+	store ClusterConfigStore
+
+	mu         sync.RWMutex
+	entries    map[string]*clusterConfigEntry
+	watching   bool
+	generation int
+}
+
+// clusterConfigEntry holds the defaults of an organization along with their
+// compiled output normalization.
+type clusterConfigEntry struct {
+	config     *types.ClusterConfig
+	normalizer func(string) string
+}
+
+func newClusterConfigEntry(config *types.ClusterConfig) *clusterConfigEntry {
+	return &clusterConfigEntry{config: config, normalizer: config.OutputNormalizer()}
+}
+
+// NewClusterConfigCache returns a cache of the defaults of the store.
+func NewClusterConfigCache(store ClusterConfigStore) *ClusterConfigCache {
+	return &ClusterConfigCache{store: store, entries: map[string]*clusterConfigEntry{}}
+}
+
+// GetEffectiveClusterConfig returns the defaults of the organization, which
+// are the cluster-wide defaults overridden by those of the organization. The
+// cluster-wide defaults are returned if no organization is given.
+func GetEffectiveClusterConfig(ctx context.Context, store ClusterConfigStore, org string) (*types.ClusterConfig, error) {
+	config, err := store.GetClusterConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if org == "" || org == WildcardValue {
+		return config, nil
+	}
+
+	orgConfig, err := store.GetOrganizationConfig(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	return config.Override(orgConfig), nil
+}
+
+// Get returns the defaults of the organization of the context, which must not
+// be modified.
+func (c *ClusterConfigCache) Get(ctx context.Context) (*types.ClusterConfig, error) {
+	entry, err := c.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return entry.config, nil
+}
+
+// NormalizeOutput removes the matches of the output normalization regular
+// expressions of the organization of the context from the check output.
+func (c *ClusterConfigCache) NormalizeOutput(ctx context.Context, output string) (string, error) {
+	entry, err := c.get(ctx)
+	if err != nil {
+		return output, err
+	}
+	return entry.normalizer(output), nil
+}
+
+func (c *ClusterConfigCache) get(ctx context.Context) (*clusterConfigEntry, error) {
+	org := organization(ctx)
+
+	c.mu.RLock()
+	entry, watching, generation := c.entries[org], c.watching, c.generation
+	c.mu.RUnlock()
+
+	if entry != nil {
+		return entry, nil
+	}
+
+	config, err := GetEffectiveClusterConfig(ctx, c.store, org)
+	if err != nil {
+		return nil, err
+	}
+	entry = newClusterConfigEntry(config)
+
+	// Only cache the defaults fetched while they are watched, unless the
+	// watcher invalidated them in the meantime
+	if watching {
+		c.mu.Lock()
+		if c.watching && c.generation == generation {
+			c.entries[org] = entry
+		}
+		c.mu.Unlock()
+	}
+
+	return entry, nil
+}
+
+// invalidate removes the cached defaults of the organization, or those of
+// every organization if none is given, since they all inherit the
+// cluster-wide defaults.
+func (c *ClusterConfigCache) invalidate(org string, watching bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if org == "" {
+		c.entries = map[string]*clusterConfigEntry{}
+	} else {
+		delete(c.entries, org)
+	}
+	c.watching = watching
+	c.generation++
+}
+
+// Watch keeps the cached defaults up to date with the changes of the store,
+// until the context is cancelled. The watcher is restarted if it is closed.
+func (c *ClusterConfigCache) Watch(ctx context.Context) {
+	for {
+		events := c.store.GetClusterConfigWatcher(ctx)
+
+		// The defaults are fetched again, since they may have changed before
+		// the watcher started
+		c.invalidate("", true)
+		for event := range events {
+			c.invalidate(event.Organization, true)
+		}
+		c.invalidate("", false)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(cacheWatchRetryInterval):
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type clusterConfigStore struct {
+	config     *types.ClusterConfig
+	orgConfigs map[string]*types.ClusterConfig
+	gets       int
+	watcher    chan WatchEventClusterConfig
+}
+
+func (s *clusterConfigStore) GetClusterConfig(context.Context) (*types.ClusterConfig, error) {
+	s.gets++
+	return s.config, nil
+}
+
+func (s *clusterConfigStore) UpdateClusterConfig(context.Context, *types.ClusterConfig) error {
+	return nil
+}
+
+func (s *clusterConfigStore) GetOrganizationConfig(_ context.Context, org string) (*types.ClusterConfig, error) {
+	if config, ok := s.orgConfigs[org]; ok {
+		return config, nil
+	}
+	return &types.ClusterConfig{}, nil
+}
+
+func (s *clusterConfigStore) UpdateOrganizationConfig(context.Context, string, *types.ClusterConfig) error {
+	return nil
+}
+
+func (s *clusterConfigStore) GetClusterConfigWatcher(context.Context) <-chan WatchEventClusterConfig {
+	return s.watcher
+}
+
+// send sends the event twice, so the first one was handled once it returns.
+func (s *clusterConfigStore) send(event WatchEventClusterConfig) {
+	s.watcher <- event
+	s.watcher <- event
+}
+
+func TestClusterConfigCache(t *testing.T) {
+	store := &clusterConfigStore{
+		config:     &types.ClusterConfig{DefaultHandlers: []string{"default"}, OutputNormalization: []string{`\d+ms`}},
+		orgConfigs: map[string]*types.ClusterConfig{"acme": {DefaultHandlers: []string{"slack"}}},
+		watcher:    make(chan WatchEventClusterConfig),
+	}
+	cache := NewClusterConfigCache(store)
+	ctx := context.Background()
+	orgCtx := context.WithValue(ctx, types.OrganizationKey, "acme")
+
+	// The defaults are fetched on every call while not watched
+	output, err := cache.NormalizeOutput(ctx, "OK: 12ms")
+	require.NoError(t, err)
+	assert.Equal(t, "OK: ", output)
+	_, err = cache.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, store.gets)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go cache.Watch(ctx)
+
+	// The defaults are fetched once while watched
+	store.send(WatchEventClusterConfig{Action: WatchUpdate, ClusterConfig: store.config})
+	for i := 0; i < 2; i++ {
+		config, err := cache.Get(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, store.config, config)
+	}
+	assert.Equal(t, 3, store.gets)
+
+	// The defaults of an organization override the cluster-wide defaults
+	for i := 0; i < 2; i++ {
+		config, err := cache.Get(orgCtx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"slack"}, config.DefaultHandlers)
+	}
+	assert.Equal(t, 4, store.gets)
+
+	// The changes of the defaults of an organization only invalidate them
+	store.orgConfigs["acme"] = &types.ClusterConfig{}
+	store.send(WatchEventClusterConfig{Action: WatchUpdate, ClusterConfig: store.orgConfigs["acme"], Organization: "acme"})
+	config, err := cache.Get(orgCtx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default"}, config.DefaultHandlers)
+	_, err = cache.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 5, store.gets)
+
+	// The changes of the cluster-wide defaults invalidate every organization
+	store.config = &types.ClusterConfig{}
+	store.send(WatchEventClusterConfig{Action: WatchUpdate, ClusterConfig: store.config})
+	output, err = cache.NormalizeOutput(ctx, "OK: 12ms")
+	require.NoError(t, err)
+	assert.Equal(t, "OK: 12ms", output)
+	config, err = cache.Get(orgCtx)
+	require.NoError(t, err)
+	assert.Empty(t, config.DefaultHandlers)
+	assert.Equal(t, 7, store.gets)
+
+	// The cache is invalidated once the watcher is closed
+	cacheWatchRetryInterval = time.Hour
+	close(store.watcher)
+	for i := 0; i < 100 && store.gets == 7; i++ {
+		time.Sleep(10 * time.Millisecond)
+		_, err = cache.Get(ctx)
+		require.NoError(t, err)
+	}
+	assert.True(t, store.gets > 7)
+}
@@ -0,0 +1,36 @@
+package store
+
+import "encoding/json"
+
+// ProtoMessage is a value which can be encoded in the protobuf wire format,
+// as implemented by the types generated from protobuf definitions.
+type ProtoMessage interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+// Encode encodes a value to be stored. Values implementing ProtoMessage are
+// encoded in the protobuf wire format, and any other value as JSON.
+func Encode(v interface{}) ([]byte, error) {
+	if msg, ok := v.(ProtoMessage); ok {
+		return msg.Marshal()
+	}
+	return json.Marshal(v)
+}
+
+// Decode decodes a stored value into v. Values stored as JSON, including the
+// values of protobuf messages written by previous versions, are decoded as
+// JSON.
+func Decode(data []byte, v interface{}) error {
+	if msg, ok := v.(ProtoMessage); ok && !IsJSON(data) {
+		return msg.Unmarshal(data)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// IsJSON returns true if the stored value is a JSON object. A protobuf message
+// can't start with an opening brace, since it would be the key of a group
+// with the field number 15, and groups aren't supported in proto3.
+func IsJSON(data []byte) bool {
+	return len(data) > 0 && data[0] == '{'
+}
@@ -0,0 +1,56 @@
+package store
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeProtobuf(t *testing.T) {
+	t.Parallel()
+
+	check := types.FixtureCheckConfig("check")
+	check.SetExtendedAttributes([]byte(`{"team":"ops"}`))
+
+	// Empty lists aren't distinguished from unset ones in the wire format
+	check.Handlers = nil
+
+	data, err := Encode(check)
+	require.NoError(t, err)
+	assert.False(t, IsJSON(data))
+
+	result := &types.CheckConfig{}
+	require.NoError(t, Decode(data, result))
+	assert.Equal(t, check, result)
+}
+
+func TestDecodeJSON(t *testing.T) {
+	t.Parallel()
+
+	// Values stored by previous versions are JSON
+	handler := types.FixtureHandler("handler")
+	data, err := json.Marshal(handler)
+	require.NoError(t, err)
+	assert.True(t, IsJSON(data))
+
+	result := &types.Handler{}
+	require.NoError(t, Decode(data, result))
+	assert.Equal(t, handler, result)
+}
+
+func TestEncodeJSON(t *testing.T) {
+	t.Parallel()
+
+	// Values which aren't protobuf messages are stored as JSON
+	config := types.FixtureTessenConfig(true)
+	data, err := Encode(config)
+	require.NoError(t, err)
+	assert.True(t, IsJSON(data))
+
+	result := &types.TessenConfig{}
+	require.NoError(t, Decode(data, result))
+	assert.Equal(t, config, result)
+}
@@ -0,0 +1,94 @@
+package store
+
+import (
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// EntityIndex is a secondary index of the entities, which maps a value of an
+// attribute to the entities having it, so the entities can be looked up by
+// this value without scanning all of them.
+type EntityIndex string
+
+const (
+	// EntityIndexClass indexes the entities by class
+	EntityIndexClass EntityIndex = "class"
+
+	// EntityIndexSubscription indexes the entities by each of their
+	// subscriptions
+	EntityIndexSubscription EntityIndex = "subscription"
+
+	// EntityIndexLabel indexes the entities by each of their labels, the
+	// values being formatted by LabelIndexValue
+	EntityIndexLabel EntityIndex = "label"
+)
+
+// entityIndexesPathPrefix is the prefix of the keys of the entity indexes,
+// which are laid out as /sensu.io/entityindexes/:index/:value/:org/:env/:id.
+const entityIndexesPathPrefix = "entityindexes"
+
+// LabelIndexValue returns the value of a label in EntityIndexLabel.
+func LabelIndexValue(key, value string) string {
+	return key + "=" + value
+}
+
+// EntityIndexKey returns the key of the entry of the index for the entity
+// with the given value.
+func EntityIndexKey(index EntityIndex, value string, entity *types.Entity) string {
+	return entityIndexKeyBuilder(index, value).WithResource(entity).Build(entity.ID)
+}
+
+// EntityIndexPrefix returns the prefix of the keys of the entries of the index
+// with the given value, for the entities of the namespace. The organization
+// and the environment of the namespace can be empty or wildcards.
+func EntityIndexPrefix(index EntityIndex, value string, ns Namespace) string {
+	keys := []string{}
+	if ns.Org != "" && !ns.OrgIsWildcard() {
+		keys = append(keys, ns.Org)
+		if ns.Env != "" && !ns.EnvIsWildcard() {
+			keys = append(keys, ns.Env)
+		}
+	}
+	return entityIndexKeyBuilder(index, value).Build(keys...) + keySeparator
+}
+
+// EntityIndexKeys returns the keys of the entries of all the indexes for the
+// entity, sorted.
+func EntityIndexKeys(entity *types.Entity) []string {
+	keys := []string{}
+	if entity.Class != "" {
+		keys = append(keys, EntityIndexKey(EntityIndexClass, entity.Class, entity))
+	}
+	for _, subscription := range entity.Subscriptions {
+		if subscription == "" {
+			continue
+		}
+		keys = append(keys, EntityIndexKey(EntityIndexSubscription, subscription, entity))
+	}
+	for key, value := range entity.Labels {
+		keys = append(keys, EntityIndexKey(EntityIndexLabel, LabelIndexValue(key, value), entity))
+	}
+
+	sort.Strings(keys)
+
+	// Remove the duplicated subscriptions
+	unique := keys[:0]
+	for i, key := range keys {
+		if i == 0 || key != keys[i-1] {
+			unique = append(unique, key)
+		}
+	}
+	return unique
+}
+
+// entityIndexKeyBuilder returns the key builder of the entries of the index
+// with the given value. The value is escaped so it's a single key segment,
+// including the dots so that "." and ".." are not cleaned from the key.
+func entityIndexKeyBuilder(index EntityIndex, value string) KeyBuilder {
+	segment := strings.Replace(url.PathEscape(value), ".", "%2E", -1)
+	return NewKeyBuilder(path.Join(entityIndexesPathPrefix, string(index), segment))
+}
@@ -2,11 +2,8 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
 
-	"github.com/coreos/etcd/clientv3"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -52,7 +49,7 @@ func (s *Store) GetAssets(ctx context.Context) ([]*types.Asset, error) {
 	assetArray := make([]*types.Asset, len(resp.Kvs))
 	for i, kv := range resp.Kvs {
 		asset := &types.Asset{}
-		err = json.Unmarshal(kv.Value, asset)
+		err = store.Decode(kv.Value, asset)
 		if err != nil {
 			return nil, err
 		}
@@ -78,7 +75,7 @@ func (s *Store) GetAssetByName(ctx context.Context, name string) (*types.Asset,
 
 	assetBytes := resp.Kvs[0].Value
 	asset := &types.Asset{}
-	if err := json.Unmarshal(assetBytes, asset); err != nil {
+	if err := store.Decode(assetBytes, asset); err != nil {
 		return nil, err
 	}
 
@@ -87,28 +84,5 @@ func (s *Store) GetAssetByName(ctx context.Context, name string) (*types.Asset,
 
 // UpdateAsset updates an asset.
 func (s *Store) UpdateAsset(ctx context.Context, asset *types.Asset) error {
-	if err := asset.Validate(); err != nil {
-		return err
-	}
-
-	assetBytes, err := json.Marshal(asset)
-	if err != nil {
-		return err
-	}
-
-	cmp := clientv3.Compare(clientv3.Version(getOrganizationsPath(asset.Organization)), ">", 0)
-	req := clientv3.OpPut(getAssetPath(asset), string(assetBytes))
-	res, err := s.kvc.Txn(ctx).If(cmp).Then(req).Commit()
-	if err != nil {
-		return err
-	}
-	if !res.Succeeded {
-		return fmt.Errorf(
-			"could not create the asset %s in organization %s",
-			asset.Name,
-			asset.Organization,
-		)
-	}
-
-	return nil
+	return updateResource(ctx, s, getAssetPath(asset), asset)
 }
@@ -2,11 +2,8 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
 
-	"github.com/coreos/etcd/clientv3"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -48,14 +45,17 @@ func (s *Store) GetCheckConfigs(ctx context.Context) ([]*types.CheckConfig, erro
 		return []*types.CheckConfig{}, nil
 	}
 
-	checksArray := make([]*types.CheckConfig, len(resp.Kvs))
-	for i, kv := range resp.Kvs {
+	reject := rejectByQueriedEnvironment(ctx)
+	checksArray := make([]*types.CheckConfig, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
 		check := &types.CheckConfig{}
-		err = json.Unmarshal(kv.Value, check)
+		err = store.Decode(kv.Value, check)
 		if err != nil {
 			return nil, err
 		}
-		checksArray[i] = check
+		if !reject(check) {
+			checksArray = append(checksArray, check)
+		}
 	}
 
 	return checksArray, nil
@@ -77,7 +77,7 @@ func (s *Store) GetCheckConfigByName(ctx context.Context, name string) (*types.C
 
 	checkBytes := resp.Kvs[0].Value
 	check := &types.CheckConfig{}
-	if err := json.Unmarshal(checkBytes, check); err != nil {
+	if err := store.Decode(checkBytes, check); err != nil {
 		return nil, err
 	}
 
@@ -86,29 +86,5 @@ func (s *Store) GetCheckConfigByName(ctx context.Context, name string) (*types.C
 
 // UpdateCheckConfig updates a CheckConfig.
 func (s *Store) UpdateCheckConfig(ctx context.Context, check *types.CheckConfig) error {
-	if err := check.Validate(); err != nil {
-		return err
-	}
-
-	checkBytes, err := json.Marshal(check)
-	if err != nil {
-		return err
-	}
-
-	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(check.Organization, check.Environment)), ">", 0)
-	req := clientv3.OpPut(getCheckConfigPath(check), string(checkBytes))
-	res, err := s.kvc.Txn(ctx).If(cmp).Then(req).Commit()
-	if err != nil {
-		return err
-	}
-	if !res.Succeeded {
-		return fmt.Errorf(
-			"could not create the check %s in environment %s/%s",
-			check.Name,
-			check.Organization,
-			check.Environment,
-		)
-	}
-
-	return nil
+	return updateResource(ctx, s, getCheckConfigPath(check), check)
 }
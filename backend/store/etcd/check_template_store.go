@@ -0,0 +1,85 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var (
+	checkTemplatesPathPrefix = "checktemplates"
+	checkTemplateKeyBuilder  = store.NewKeyBuilder(checkTemplatesPathPrefix)
+)
+
+func getCheckTemplatePath(tmpl *types.CheckTemplate) string {
+	return checkTemplateKeyBuilder.WithResource(tmpl).Build(tmpl.Name)
+}
+
+func getCheckTemplatesPath(ctx context.Context, name string) string {
+	return checkTemplateKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteCheckTemplateByName deletes a CheckTemplate by name.
+func (s *Store) DeleteCheckTemplateByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name of check template")
+	}
+
+	_, err := s.kvc.Delete(ctx, getCheckTemplatesPath(ctx, name))
+	return err
+}
+
+// GetCheckTemplates gets the list of check templates for the organization and
+// environment of the context.
+func (s *Store) GetCheckTemplates(ctx context.Context) ([]*types.CheckTemplate, error) {
+	resp, err := query(ctx, s, getCheckTemplatesPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return []*types.CheckTemplate{}, nil
+	}
+
+	reject := rejectByQueriedEnvironment(ctx)
+	tmpls := make([]*types.CheckTemplate, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		tmpl := &types.CheckTemplate{}
+		if err := store.Decode(kv.Value, tmpl); err != nil {
+			return nil, err
+		}
+		if !reject(tmpl) {
+			tmpls = append(tmpls, tmpl)
+		}
+	}
+
+	return tmpls, nil
+}
+
+// GetCheckTemplateByName gets a CheckTemplate by name.
+func (s *Store) GetCheckTemplateByName(ctx context.Context, name string) (*types.CheckTemplate, error) {
+	if name == "" {
+		return nil, errors.New("must specify name of check template")
+	}
+
+	resp, err := s.kvc.Get(ctx, getCheckTemplatesPath(ctx, name))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+
+	tmpl := &types.CheckTemplate{}
+	if err := store.Decode(resp.Kvs[0].Value, tmpl); err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+// UpdateCheckTemplate updates a CheckTemplate.
+func (s *Store) UpdateCheckTemplate(ctx context.Context, tmpl *types.CheckTemplate) error {
+	return updateResource(ctx, s, getCheckTemplatePath(tmpl), tmpl)
+}
@@ -0,0 +1,47 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTemplateStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		tmpl := types.FixtureCheckTemplate("http")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, tmpl.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, tmpl.Environment)
+
+		// We should receive an empty slice if no results were found
+		tmpls, err := store.GetCheckTemplates(ctx)
+		assert.NoError(t, err)
+		assert.NotNil(t, tmpls)
+
+		require.NoError(t, store.UpdateCheckTemplate(ctx, tmpl))
+
+		retrieved, err := store.GetCheckTemplateByName(ctx, "http")
+		require.NoError(t, err)
+		require.NotNil(t, retrieved)
+		assert.Equal(t, tmpl.Parameters, retrieved.Parameters)
+
+		tmpls, err = store.GetCheckTemplates(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(tmpls))
+
+		require.NoError(t, store.DeleteCheckTemplateByName(ctx, "http"))
+		retrieved, err = store.GetCheckTemplateByName(ctx, "http")
+		assert.NoError(t, err)
+		assert.Nil(t, retrieved)
+
+		// Updating a check template in a nonexistent org and env should not work
+		tmpl.Organization = "missing"
+		tmpl.Environment = "missing"
+		assert.Error(t, store.UpdateCheckTemplate(ctx, tmpl))
+	})
+}
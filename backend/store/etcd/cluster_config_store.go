@@ -0,0 +1,89 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	clusterConfigPathPrefix = "cluster"
+)
+
+func getClusterConfigPath() string {
+	return path.Join(EtcdRoot, clusterConfigPathPrefix, "config")
+}
+
+// getOrganizationConfigPath returns the key of the defaults of an
+// organization, under the key of the cluster-wide defaults so they are watched
+// together.
+func getOrganizationConfigPath(org string) string {
+	return path.Join(getClusterConfigPath(), org)
+}
+
+// getClusterConfigOrganization returns the organization of the defaults
+// stored at the key, or an empty string for the cluster-wide defaults.
+func getClusterConfigOrganization(key string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(key, getClusterConfigPath()), "/")
+}
+
+// GetClusterConfig gets the cluster-wide defaults, or empty defaults if none
+// were stored.
+func (s *Store) GetClusterConfig(ctx context.Context) (*types.ClusterConfig, error) {
+	return s.getClusterConfig(ctx, getClusterConfigPath())
+}
+
+// UpdateClusterConfig updates the cluster-wide defaults.
+func (s *Store) UpdateClusterConfig(ctx context.Context, config *types.ClusterConfig) error {
+	return s.updateClusterConfig(ctx, getClusterConfigPath(), config)
+}
+
+// GetOrganizationConfig gets the defaults of an organization, or empty
+// defaults if none were stored.
+func (s *Store) GetOrganizationConfig(ctx context.Context, org string) (*types.ClusterConfig, error) {
+	if org == "" {
+		return nil, errors.New("must specify organization")
+	}
+	return s.getClusterConfig(ctx, getOrganizationConfigPath(org))
+}
+
+// UpdateOrganizationConfig updates the defaults of an organization.
+func (s *Store) UpdateOrganizationConfig(ctx context.Context, org string, config *types.ClusterConfig) error {
+	if org == "" {
+		return errors.New("must specify organization")
+	}
+	return s.updateClusterConfig(ctx, getOrganizationConfigPath(org), config)
+}
+
+func (s *Store) getClusterConfig(ctx context.Context, key string) (*types.ClusterConfig, error) {
+	resp, err := s.kvc.Get(ctx, key, clientv3.WithLimit(1))
+	if err != nil {
+		return nil, err
+	}
+
+	config := &types.ClusterConfig{}
+	if len(resp.Kvs) == 0 {
+		return config, nil
+	}
+
+	if err := store.Decode(resp.Kvs[0].Value, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func (s *Store) updateClusterConfig(ctx context.Context, key string, config *types.ClusterConfig) error {
+	configBytes, err := store.Encode(config)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.kvc.Put(ctx, key, string(configBytes))
+	return err
+}
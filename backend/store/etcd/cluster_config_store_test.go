@@ -0,0 +1,45 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterConfigStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		ctx := context.Background()
+
+		// There are no defaults initially
+		config, err := store.GetClusterConfig(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, config.DefaultHandlers)
+		assert.Zero(t, config.DefaultCheckTimeout)
+
+		err = store.UpdateClusterConfig(ctx, types.FixtureClusterConfig())
+		require.NoError(t, err)
+		config, err = store.GetClusterConfig(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, types.FixtureClusterConfig(), config)
+
+		// The defaults of an organization are stored apart
+		config, err = store.GetOrganizationConfig(ctx, "acme")
+		require.NoError(t, err)
+		assert.Empty(t, config.DefaultHandlers)
+
+		err = store.UpdateOrganizationConfig(ctx, "acme", &types.ClusterConfig{KeepaliveTimeout: 30})
+		require.NoError(t, err)
+		config, err = store.GetOrganizationConfig(ctx, "acme")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(30), config.KeepaliveTimeout)
+		config, err = store.GetClusterConfig(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, types.FixtureClusterConfig(), config)
+	})
+}
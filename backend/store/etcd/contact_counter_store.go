@@ -0,0 +1,84 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+const (
+	contactCountersPathPrefix = "contact-counters"
+
+	// contactCounterRetries is the number of attempts to increment a contact
+	// counter concurrently incremented by other backends
+	contactCounterRetries = 10
+)
+
+var (
+	contactCounterKeyBuilder = store.NewKeyBuilder(contactCountersPathPrefix)
+)
+
+// getContactCounterPath returns the key of the counter of the contact for
+// the window starting at the given Unix time.
+func getContactCounterPath(ctx context.Context, handler, contact string, start int64) string {
+	return contactCounterKeyBuilder.WithContext(ctx).Build(handler, contact, strconv.FormatInt(start, 10))
+}
+
+// IncrementContactCounter increments the number of executions of the handler
+// for the contact during the current window, and returns the new count. The
+// counter of each window expires with it.
+func (s *Store) IncrementContactCounter(ctx context.Context, handler, contact string, window time.Duration) (int64, error) {
+	if handler == "" || contact == "" {
+		return 0, errors.New("must specify handler and contact")
+	}
+
+	seconds := int64(window / time.Second)
+	if seconds <= 0 {
+		return 0, errors.New("the window must be at least one second")
+	}
+	start := time.Now().Unix() / seconds * seconds
+	key := getContactCounterPath(ctx, handler, contact, start)
+
+	for i := 0; i < contactCounterRetries; i++ {
+		resp, err := s.kvc.Get(ctx, key)
+		if err != nil {
+			return 0, err
+		}
+
+		var count int64
+		var op clientv3.Op
+		var cmp clientv3.Cmp
+		if len(resp.Kvs) == 0 {
+			lease, err := s.client.Grant(ctx, start+seconds-time.Now().Unix()+1)
+			if err != nil {
+				return 0, err
+			}
+			count = 1
+			op = clientv3.OpPut(key, "1", clientv3.WithLease(lease.ID))
+			cmp = clientv3.Compare(clientv3.CreateRevision(key), "=", 0)
+		} else {
+			count, err = strconv.ParseInt(string(resp.Kvs[0].Value), 10, 64)
+			if err != nil {
+				return 0, err
+			}
+			count++
+			op = clientv3.OpPut(key, strconv.FormatInt(count, 10), clientv3.WithIgnoreLease())
+			cmp = clientv3.Compare(clientv3.ModRevision(key), "=", resp.Kvs[0].ModRevision)
+		}
+
+		res, err := s.kvc.Txn(ctx).If(cmp).Then(op).Commit()
+		if err != nil {
+			return 0, err
+		}
+		if res.Succeeded {
+			return count, nil
+		}
+	}
+
+	return 0, fmt.Errorf("could not increment the counter of contact %s: concurrently modified", contact)
+}
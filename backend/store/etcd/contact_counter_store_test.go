@@ -0,0 +1,44 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContactCounterStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, "default")
+		ctx = context.WithValue(ctx, types.EnvironmentKey, "default")
+
+		count, err := store.IncrementContactCounter(ctx, "pagerduty", "alice", 24*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		count, err = store.IncrementContactCounter(ctx, "pagerduty", "alice", 24*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		// Counters are kept per handler and contact
+		count, err = store.IncrementContactCounter(ctx, "slack", "alice", 24*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		count, err = store.IncrementContactCounter(ctx, "pagerduty", "bob", 24*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		_, err = store.IncrementContactCounter(ctx, "pagerduty", "", 24*time.Hour)
+		assert.Error(t, err)
+
+		_, err = store.IncrementContactCounter(ctx, "pagerduty", "alice", 0)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,151 @@
+package etcd
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/encryption"
+	"github.com/sensu/sensu-go/types"
+)
+
+// errNoEncrypter is returned when reading an encrypted value without an
+// encrypter to decrypt it.
+var errNoEncrypter = errors.New("the value is encrypted but no encryption key is configured")
+
+// escapedPrefix is the prefix of the values stored in plain text which start
+// like an encrypted or escaped value, e.g. a check output set by an agent, so
+// that they are read as is instead of being decrypted.
+const escapedPrefix = "sensu:escaped:"
+
+// SetEncrypter sets the encrypter of the output of the events and the extended
+// attributes of the entities, before the store is used. They are stored in
+// plain text when nil, and the values stored in plain text are read as is
+// either way.
+func (s *Store) SetEncrypter(e *encryption.Encrypter) {
+	s.encrypter = e
+}
+
+// encryptValue returns the value to store, encrypted if the store has an
+// encrypter, or escaped if it's stored in plain text but starts like an
+// encrypted or escaped value.
+func (s *Store) encryptValue(value []byte) ([]byte, error) {
+	if len(value) == 0 {
+		return value, nil
+	}
+	if s.encrypter != nil {
+		return s.encrypter.Encrypt(value)
+	}
+	if encryption.IsEncrypted(value) || bytes.HasPrefix(value, []byte(escapedPrefix)) {
+		return append([]byte(escapedPrefix), value...), nil
+	}
+	return value, nil
+}
+
+// decryptValue returns the stored value, decrypted or unescaped.
+func (s *Store) decryptValue(value []byte) ([]byte, error) {
+	if bytes.HasPrefix(value, []byte(escapedPrefix)) {
+		return value[len(escapedPrefix):], nil
+	}
+	if !encryption.IsEncrypted(value) {
+		return value, nil
+	}
+	if s.encrypter == nil {
+		return nil, errNoEncrypter
+	}
+	return s.encrypter.Decrypt(value)
+}
+
+// encryptEntity returns a copy of the entity with its extended attributes
+// encrypted, or the entity itself when there is nothing to encrypt.
+func (s *Store) encryptEntity(entity *types.Entity) (*types.Entity, error) {
+	if entity == nil || len(entity.ExtendedAttributes) == 0 {
+		return entity, nil
+	}
+
+	attrs, err := s.encryptValue(entity.ExtendedAttributes)
+	if err != nil {
+		return nil, err
+	}
+	encrypted := *entity
+	encrypted.ExtendedAttributes = attrs
+	return &encrypted, nil
+}
+
+// decryptEntity decrypts the extended attributes of the entity in place.
+func (s *Store) decryptEntity(entity *types.Entity) error {
+	if entity == nil {
+		return nil
+	}
+
+	attrs, err := s.decryptValue(entity.ExtendedAttributes)
+	if err != nil {
+		return err
+	}
+	entity.ExtendedAttributes = attrs
+	return nil
+}
+
+// encryptEvent returns a copy of the event with the output of its check and
+// of the executions of its history, and the extended attributes of its entity
+// encrypted.
+func (s *Store) encryptEvent(event *types.Event) (*types.Event, error) {
+	encrypted := *event
+	entity, err := s.encryptEntity(event.Entity)
+	if err != nil {
+		return nil, err
+	}
+	encrypted.Entity = entity
+
+	if event.Check == nil {
+		return &encrypted, nil
+	}
+
+	check := *event.Check
+	output, err := s.encryptValue([]byte(check.Output))
+	if err != nil {
+		return nil, err
+	}
+	check.Output = string(output)
+
+	if len(check.History) > 0 {
+		check.History = make([]types.CheckHistory, len(event.Check.History))
+		for i, h := range event.Check.History {
+			output, err := s.encryptValue([]byte(h.Output))
+			if err != nil {
+				return nil, err
+			}
+			h.Output = string(output)
+			check.History[i] = h
+		}
+	}
+	encrypted.Check = &check
+
+	return &encrypted, nil
+}
+
+// decryptEvent decrypts the output of the check and of the executions of its
+// history, and the extended attributes of the entity of the event in place.
+func (s *Store) decryptEvent(event *types.Event) error {
+	if err := s.decryptEntity(event.Entity); err != nil {
+		return err
+	}
+
+	if event.Check == nil {
+		return nil
+	}
+
+	output, err := s.decryptValue([]byte(event.Check.Output))
+	if err != nil {
+		return err
+	}
+	event.Check.Output = string(output)
+
+	for i := range event.Check.History {
+		output, err := s.decryptValue([]byte(event.Check.History[i].Output))
+		if err != nil {
+			return err
+		}
+		event.Check.History[i].Output = string(output)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/encryption"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryption(t *testing.T) {
+	dir, err := ioutil.TempDir("", "encryption")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	keyFile := filepath.Join(dir, "key")
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte(strings.Repeat("k", 32)), 0600))
+	provider, err := encryption.NewFileKeyProvider(keyFile)
+	require.NoError(t, err)
+	encrypter, err := encryption.New(provider)
+	require.NoError(t, err)
+
+	testWithEtcd(t, func(s store.Store) {
+		etcdStore := s.(*Store)
+		client := etcdStore.client
+		event := types.FixtureEvent("entity1", "check1")
+		event.Check.Output = "CRITICAL: disk full"
+		event.Check.History[0].Output = "WARNING: disk almost full"
+		event.Check.Handlers = nil
+		event.Entity.ExtendedAttributes = []byte(`{"secret":"hunter2"}`)
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, event.Entity.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
+
+		// The values stored before the encryption is enabled are still read
+		require.NoError(t, s.UpdateEvent(ctx, event))
+
+		etcdStore.SetEncrypter(encrypter)
+
+		stored, err := s.GetEventByEntityCheck(ctx, "entity1", "check1")
+		require.NoError(t, err)
+		assert.Equal(t, "CRITICAL: disk full", stored.Check.Output)
+
+		// The new values are encrypted in etcd
+		require.NoError(t, s.UpdateEvent(ctx, event))
+		require.NoError(t, s.UpdateEntity(ctx, event.Entity))
+
+		resp, err := client.Get(ctx, getEventPath(event))
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+		assert.NotContains(t, string(resp.Kvs[0].Value), "disk full")
+		assert.NotContains(t, string(resp.Kvs[0].Value), "disk almost full")
+		assert.NotContains(t, string(resp.Kvs[0].Value), "hunter2")
+
+		resp, err = client.Get(ctx, getEntityPath(event.Entity))
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+		assert.NotContains(t, string(resp.Kvs[0].Value), "hunter2")
+
+		// And transparently decrypted
+		stored, err = s.GetEventByEntityCheck(ctx, "entity1", "check1")
+		require.NoError(t, err)
+		assert.Equal(t, "CRITICAL: disk full", stored.Check.Output)
+		assert.Equal(t, "WARNING: disk almost full", stored.Check.History[0].Output)
+		assert.Equal(t, event.Entity.ExtendedAttributes, stored.Entity.ExtendedAttributes)
+
+		events, err := s.GetEvents(ctx)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "CRITICAL: disk full", events[0].Check.Output)
+
+		entity, err := s.GetEntityByID(ctx, event.Entity.ID)
+		require.NoError(t, err)
+		assert.Equal(t, event.Entity.ExtendedAttributes, entity.ExtendedAttributes)
+
+		// The encrypted values can't be read without the key
+		etcdStore.SetEncrypter(nil)
+		_, err = s.GetEventByEntityCheck(ctx, "entity1", "check1")
+		assert.Error(t, err)
+
+		// The values stored in plain text which start like an encrypted value
+		// are read as is
+		spoofed := types.FixtureEvent("entity2", "check1")
+		spoofed.Check.Output = encryption.Prefix + "spoofed"
+		spoofed.Check.Handlers = nil
+		require.NoError(t, s.UpdateEvent(ctx, spoofed))
+		stored, err = s.GetEventByEntityCheck(ctx, "entity2", "check1")
+		require.NoError(t, err)
+		assert.Equal(t, spoofed.Check.Output, stored.Check.Output)
+
+		// And the events which can't be decrypted aren't listed, without
+		// failing the listing of the others
+		events, err = s.GetEvents(ctx)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "entity2", events[0].Entity.ID)
+	})
+}
@@ -0,0 +1,85 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var (
+	entityGroupsPathPrefix = "entitygroups"
+	entityGroupKeyBuilder  = store.NewKeyBuilder(entityGroupsPathPrefix)
+)
+
+func getEntityGroupPath(group *types.EntityGroup) string {
+	return entityGroupKeyBuilder.WithResource(group).Build(group.Name)
+}
+
+func getEntityGroupsPath(ctx context.Context, name string) string {
+	return entityGroupKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteEntityGroupByName deletes an EntityGroup by name.
+func (s *Store) DeleteEntityGroupByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name of entity group")
+	}
+
+	_, err := s.kvc.Delete(ctx, getEntityGroupsPath(ctx, name))
+	return err
+}
+
+// GetEntityGroups gets the list of entity groups for the organization and
+// environment of the context.
+func (s *Store) GetEntityGroups(ctx context.Context) ([]*types.EntityGroup, error) {
+	resp, err := query(ctx, s, getEntityGroupsPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return []*types.EntityGroup{}, nil
+	}
+
+	reject := rejectByQueriedEnvironment(ctx)
+	groups := make([]*types.EntityGroup, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		group := &types.EntityGroup{}
+		if err := store.Decode(kv.Value, group); err != nil {
+			return nil, err
+		}
+		if !reject(group) {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups, nil
+}
+
+// GetEntityGroupByName gets an EntityGroup by name.
+func (s *Store) GetEntityGroupByName(ctx context.Context, name string) (*types.EntityGroup, error) {
+	if name == "" {
+		return nil, errors.New("must specify name of entity group")
+	}
+
+	resp, err := s.kvc.Get(ctx, getEntityGroupsPath(ctx, name))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+
+	group := &types.EntityGroup{}
+	if err := store.Decode(resp.Kvs[0].Value, group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// UpdateEntityGroup updates an EntityGroup.
+func (s *Store) UpdateEntityGroup(ctx context.Context, group *types.EntityGroup) error {
+	return updateResource(ctx, s, getEntityGroupPath(group), group)
+}
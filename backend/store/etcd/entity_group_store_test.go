@@ -0,0 +1,47 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityGroupStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		group := types.FixtureEntityGroup("webservers")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, group.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, group.Environment)
+
+		// We should receive an empty slice if no results were found
+		groups, err := store.GetEntityGroups(ctx)
+		assert.NoError(t, err)
+		assert.NotNil(t, groups)
+
+		require.NoError(t, store.UpdateEntityGroup(ctx, group))
+
+		retrieved, err := store.GetEntityGroupByName(ctx, "webservers")
+		require.NoError(t, err)
+		require.NotNil(t, retrieved)
+		assert.Equal(t, group.Selector, retrieved.Selector)
+
+		groups, err = store.GetEntityGroups(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(groups))
+
+		require.NoError(t, store.DeleteEntityGroupByName(ctx, "webservers"))
+		retrieved, err = store.GetEntityGroupByName(ctx, "webservers")
+		assert.NoError(t, err)
+		assert.Nil(t, retrieved)
+
+		// Updating an entity group in a nonexistent org and env should not work
+		group.Organization = "missing"
+		group.Environment = "missing"
+		assert.Error(t, store.UpdateEntityGroup(ctx, group))
+	})
+}
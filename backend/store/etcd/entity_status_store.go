@@ -0,0 +1,113 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	entityStatusesPathPrefix = "entitystatuses"
+
+	// entityStatusRetries is the number of attempts to store the status of a
+	// disconnected agent while it's concurrently modified
+	entityStatusRetries = 3
+)
+
+var (
+	entityStatusKeyBuilder = store.NewKeyBuilder(entityStatusesPathPrefix)
+)
+
+func getEntityStatusPath(status *types.EntityStatus) string {
+	return entityStatusKeyBuilder.WithResource(status).Build(status.EntityID)
+}
+
+func getEntityStatusesPath(ctx context.Context, id string) string {
+	return entityStatusKeyBuilder.WithContext(ctx).Build(id)
+}
+
+// GetEntityStatus gets the connection status of the agent of an entity.
+func (s *Store) GetEntityStatus(ctx context.Context, id string) (*types.EntityStatus, error) {
+	if id == "" {
+		return nil, errors.New("must specify id")
+	}
+
+	resp, err := s.kvc.Get(ctx, getEntityStatusesPath(ctx, id), clientv3.WithLimit(1))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) != 1 {
+		return nil, nil
+	}
+	status := &types.EntityStatus{}
+	if err := store.Decode(resp.Kvs[0].Value, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// UpdateEntityStatus updates the connection status of the agent of an
+// entity.
+func (s *Store) UpdateEntityStatus(ctx context.Context, status *types.EntityStatus) error {
+	if err := status.Validate(); err != nil {
+		return err
+	}
+
+	bytes, err := store.Encode(status)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.kvc.Put(ctx, getEntityStatusPath(status), string(bytes))
+	return err
+}
+
+// DisconnectEntityStatus stores the connection status of a disconnected
+// agent, unless the stored status belongs to another session, i.e. the agent
+// connected again meanwhile.
+func (s *Store) DisconnectEntityStatus(ctx context.Context, status *types.EntityStatus) error {
+	if err := status.Validate(); err != nil {
+		return err
+	}
+
+	bytes, err := store.Encode(status)
+	if err != nil {
+		return err
+	}
+
+	key := getEntityStatusPath(status)
+	for i := 0; i < entityStatusRetries; i++ {
+		resp, err := s.kvc.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		var cmp clientv3.Cmp
+		if len(resp.Kvs) == 0 {
+			cmp = clientv3.Compare(clientv3.CreateRevision(key), "=", 0)
+		} else {
+			current := &types.EntityStatus{}
+			if err := store.Decode(resp.Kvs[0].Value, current); err != nil {
+				return err
+			}
+			if current.Session != status.Session {
+				return nil
+			}
+			cmp = clientv3.Compare(clientv3.ModRevision(key), "=", resp.Kvs[0].ModRevision)
+		}
+
+		res, err := s.kvc.Txn(ctx).If(cmp).Then(clientv3.OpPut(key, string(bytes))).Commit()
+		if err != nil {
+			return err
+		}
+		if res.Succeeded {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("could not update the status of agent %s: concurrently modified", status.EntityID)
+}
@@ -0,0 +1,69 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityStatusStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		entity := types.FixtureEntity("entity")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, entity.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, entity.Environment)
+
+		status, err := store.GetEntityStatus(ctx, entity.ID)
+		require.NoError(t, err)
+		assert.Nil(t, status)
+
+		require.NoError(t, store.UpdateEntity(ctx, entity))
+		require.NoError(t, store.UpdateEntityStatus(ctx, types.FixtureEntityStatus(entity.ID)))
+
+		status, err = store.GetEntityStatus(ctx, entity.ID)
+		require.NoError(t, err)
+		require.NotNil(t, status)
+		assert.Equal(t, types.FixtureEntityStatus(entity.ID), status)
+
+		// The status is deleted along with its entity
+		require.NoError(t, store.DeleteEntity(ctx, entity))
+		status, err = store.GetEntityStatus(ctx, entity.ID)
+		require.NoError(t, err)
+		assert.Nil(t, status)
+
+		assert.Error(t, store.UpdateEntityStatus(ctx, &types.EntityStatus{}))
+	})
+}
+
+func TestDisconnectEntityStatus(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		entity := types.FixtureEntity("entity")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, entity.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, entity.Environment)
+
+		connected := types.FixtureEntityStatus(entity.ID)
+		require.NoError(t, store.UpdateEntityStatus(ctx, connected))
+
+		// The status of another session is left untouched
+		previous := types.FixtureEntityStatus(entity.ID)
+		previous.Session = "previous"
+		previous.Connected = false
+		require.NoError(t, store.DisconnectEntityStatus(ctx, previous))
+		status, err := store.GetEntityStatus(ctx, entity.ID)
+		require.NoError(t, err)
+		assert.Equal(t, connected, status)
+
+		// The status of the same session is updated
+		disconnected := types.FixtureEntityStatus(entity.ID)
+		disconnected.Connected = false
+		require.NoError(t, store.DisconnectEntityStatus(ctx, disconnected))
+		status, err = store.GetEntityStatus(ctx, entity.ID)
+		require.NoError(t, err)
+		assert.Equal(t, disconnected, status)
+	})
+}
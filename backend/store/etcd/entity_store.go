@@ -2,9 +2,9 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/sensu/sensu-go/backend/store"
@@ -13,6 +13,14 @@ import (
 
 const (
 	entityPathPrefix = "entities"
+
+	// entityUpdateRetries is the number of attempts to update an entity, and
+	// its indexes, concurrently modified by another writer
+	entityUpdateRetries = 5
+
+	// entityIndexBatchSize is the maximum number of entities fetched in a
+	// single transaction when reading an index
+	entityIndexBatchSize = 100
 )
 
 var (
@@ -32,8 +40,7 @@ func (s *Store) DeleteEntity(ctx context.Context, e *types.Entity) error {
 	if err := e.Validate(); err != nil {
 		return err
 	}
-	_, err := s.kvc.Delete(ctx, getEntityPath(e))
-	return err
+	return s.deleteEntity(ctx, getEntityPath(e))
 }
 
 // DeleteEntityByID deletes an Entity by its ID.
@@ -42,8 +49,38 @@ func (s *Store) DeleteEntityByID(ctx context.Context, id string) error {
 		return errors.New("must specify id")
 	}
 
-	_, err := s.kvc.Delete(ctx, getEntitiesPath(ctx, id))
-	return err
+	return s.deleteEntity(ctx, getEntitiesPath(ctx, id))
+}
+
+// deleteEntity deletes the entity stored at key along with its index entries
+// and the status of its agent, in a single transaction.
+func (s *Store) deleteEntity(ctx context.Context, key string) error {
+	for i := 0; i < entityUpdateRetries; i++ {
+		prev, rev, err := s.getEntityRevision(ctx, key)
+		if err != nil {
+			return err
+		}
+		if prev == nil {
+			return nil
+		}
+
+		ops := []clientv3.Op{clientv3.OpDelete(key)}
+		for _, indexKey := range store.EntityIndexKeys(prev) {
+			ops = append(ops, clientv3.OpDelete(indexKey))
+		}
+		ops = append(ops, clientv3.OpDelete(entityStatusKeyBuilder.WithResource(prev).Build(prev.ID)))
+
+		cmp := clientv3.Compare(clientv3.ModRevision(key), "=", rev)
+		res, err := s.kvc.Txn(ctx).If(cmp).Then(ops...).Commit()
+		if err != nil {
+			return err
+		}
+		if res.Succeeded {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("could not delete entity %s: concurrently modified", key)
 }
 
 // GetEntityByID gets an Entity by ID.
@@ -60,10 +97,13 @@ func (s *Store) GetEntityByID(ctx context.Context, id string) (*types.Entity, er
 		return nil, nil
 	}
 	entity := &types.Entity{}
-	err = json.Unmarshal(resp.Kvs[0].Value, entity)
+	err = store.Decode(resp.Kvs[0].Value, entity)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.decryptEntity(entity); err != nil {
+		return nil, err
+	}
 	return entity, nil
 }
 
@@ -78,44 +118,167 @@ func (s *Store) GetEntities(ctx context.Context) ([]*types.Entity, error) {
 		return []*types.Entity{}, nil
 	}
 
-	earr := make([]*types.Entity, len(resp.Kvs))
-	for i, kv := range resp.Kvs {
+	reject := rejectByQueriedEnvironment(ctx)
+	earr := make([]*types.Entity, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
 		entity := &types.Entity{}
-		err = json.Unmarshal(kv.Value, entity)
+		err = store.Decode(kv.Value, entity)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.decryptEntity(entity); err != nil {
+			// The other entities are still listed
+			loggerWithContext(ctx).WithError(err).Error("could not decrypt the entity: ", string(kv.Key))
+			continue
+		}
+		if !reject(entity) {
+			earr = append(earr, entity)
+		}
+	}
+
+	return earr, nil
+}
+
+// GetEntitiesByIndex gets the entities having the given value in the index.
+func (s *Store) GetEntitiesByIndex(ctx context.Context, index store.EntityIndex, value string) ([]*types.Entity, error) {
+	ns := store.NewNamespaceFromContext(ctx)
+	resp, err := s.kvc.Get(ctx, store.EntityIndexPrefix(index, value, ns), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	// The entries of the index hold the keys of the entities
+	keys := make([]string, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		keys = append(keys, string(kv.Value))
+	}
+
+	reject := rejectByQueriedEnvironment(ctx)
+	earr := make([]*types.Entity, 0, len(keys))
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > entityIndexBatchSize {
+			n = entityIndexBatchSize
+		}
+		ops := make([]clientv3.Op, 0, n)
+		for _, key := range keys[:n] {
+			ops = append(ops, clientv3.OpGet(key))
+		}
+		keys = keys[n:]
+
+		res, err := s.kvc.Txn(ctx).Then(ops...).Commit()
 		if err != nil {
 			return nil, err
 		}
-		earr[i] = entity
+		for _, r := range res.Responses {
+			for _, kv := range r.GetResponseRange().Kvs {
+				entity := &types.Entity{}
+				if err := store.Decode(kv.Value, entity); err != nil {
+					return nil, err
+				}
+				if err := s.decryptEntity(entity); err != nil {
+					// The other entities are still listed
+					loggerWithContext(ctx).WithError(err).Error("could not decrypt the entity: ", string(kv.Key))
+					continue
+				}
+				if !reject(entity) {
+					earr = append(earr, entity)
+				}
+			}
+		}
 	}
 
 	return earr, nil
 }
 
-// UpdateEntity updates an Entity.
+// UpdateEntity updates an Entity, along with its index entries in a single
+// transaction.
 func (s *Store) UpdateEntity(ctx context.Context, e *types.Entity) error {
 	if err := e.Validate(); err != nil {
 		return err
 	}
 
-	eStr, err := json.Marshal(e)
+	encrypted, err := s.encryptEntity(e)
 	if err != nil {
 		return err
 	}
-
-	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(e.Organization, e.Environment)), ">", 0)
-	req := clientv3.OpPut(getEntityPath(e), string(eStr))
-	res, err := s.kvc.Txn(ctx).If(cmp).Then(req).Commit()
+	bytes, err := store.Encode(encrypted)
 	if err != nil {
 		return err
 	}
-	if !res.Succeeded {
-		return fmt.Errorf(
-			"could not create the entity %s in environment %s/%s",
-			e.ID,
-			e.Organization,
-			e.Environment,
-		)
+
+	key := getEntityPath(e)
+	namespace := getEnvironmentsPath(e.Organization, e.Environment)
+	indexKeys := store.EntityIndexKeys(e)
+
+	for i := 0; i < entityUpdateRetries; i++ {
+		prev, rev, err := s.getEntityRevision(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		ops := []clientv3.Op{clientv3.OpPut(key, string(bytes))}
+		if prev != nil {
+			for _, indexKey := range staleKeys(store.EntityIndexKeys(prev), indexKeys) {
+				ops = append(ops, clientv3.OpDelete(indexKey))
+			}
+		}
+		for _, indexKey := range indexKeys {
+			ops = append(ops, clientv3.OpPut(indexKey, key))
+		}
+
+		// Make sure the entity was not modified since it was read, so the stale
+		// index entries are the right ones
+		res, err := s.kvc.Txn(ctx).If(
+			clientv3.Compare(clientv3.Version(namespace), ">", 0),
+			clientv3.Compare(clientv3.ModRevision(key), "=", rev),
+		).Then(ops...).Else(
+			clientv3.OpGet(namespace, clientv3.WithCountOnly()),
+		).Commit()
+		if err != nil {
+			return err
+		}
+		if res.Succeeded {
+			return nil
+		}
+		if res.Responses[0].GetResponseRange().Count == 0 {
+			return fmt.Errorf(
+				"could not create %s in namespace %s/%s",
+				e.URIPath(),
+				e.Organization,
+				e.Environment,
+			)
+		}
 	}
 
-	return nil
+	return fmt.Errorf("could not update entity %s: concurrently modified", e.ID)
+}
+
+// getEntityRevision returns the entity stored at key and the revision of its
+// last modification, or a nil entity and a zero revision if it does not exist.
+func (s *Store) getEntityRevision(ctx context.Context, key string) (*types.Entity, int64, error) {
+	resp, err := s.kvc.Get(ctx, key)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, 0, nil
+	}
+	entity := &types.Entity{}
+	if err := store.Decode(resp.Kvs[0].Value, entity); err != nil {
+		return nil, 0, err
+	}
+	return entity, resp.Kvs[0].ModRevision, nil
+}
+
+// staleKeys returns the keys of prev which are not in keys, both being sorted.
+func staleKeys(prev, keys []string) []string {
+	stale := []string{}
+	for _, key := range prev {
+		i := sort.SearchStrings(keys, key)
+		if i == len(keys) || keys[i] != key {
+			stale = append(stale, key)
+		}
+	}
+	return stale
 }
@@ -54,3 +54,66 @@ func TestEntityStorage(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestEntityIndexes(t *testing.T) {
+	testWithEtcd(t, func(s store.Store) {
+		entity := types.FixtureEntity("entity")
+		entity.Class = "proxy"
+		entity.Subscriptions = []string{"linux", "web"}
+		entity.Labels = map[string]string{"region": "us-west-2"}
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, entity.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, entity.Environment)
+
+		require.NoError(t, s.UpdateEntity(ctx, entity))
+		require.NoError(t, s.UpdateEntity(ctx, types.FixtureEntity("other")))
+
+		entities, err := s.GetEntitiesByIndex(ctx, store.EntityIndexClass, "proxy")
+		require.NoError(t, err)
+		require.Len(t, entities, 1)
+		assert.Equal(t, "entity", entities[0].ID)
+
+		entities, err = s.GetEntitiesByIndex(ctx, store.EntityIndexSubscription, "web")
+		require.NoError(t, err)
+		assert.Len(t, entities, 1)
+
+		label := store.LabelIndexValue("region", "us-west-2")
+		entities, err = s.GetEntitiesByIndex(ctx, store.EntityIndexLabel, label)
+		require.NoError(t, err)
+		assert.Len(t, entities, 1)
+
+		// Querying all the namespaces
+		wildcard := context.WithValue(ctx, types.OrganizationKey, "*")
+		wildcard = context.WithValue(wildcard, types.EnvironmentKey, "*")
+		entities, err = s.GetEntitiesByIndex(wildcard, store.EntityIndexSubscription, "linux")
+		require.NoError(t, err)
+		assert.Len(t, entities, 2)
+
+		// The stale entries are removed on update
+		entity.Subscriptions = []string{"linux"}
+		require.NoError(t, s.UpdateEntity(ctx, entity))
+		entities, err = s.GetEntitiesByIndex(ctx, store.EntityIndexSubscription, "web")
+		require.NoError(t, err)
+		assert.Len(t, entities, 0)
+		entities, err = s.GetEntitiesByIndex(ctx, store.EntityIndexSubscription, "linux")
+		require.NoError(t, err)
+		assert.Len(t, entities, 2)
+
+		// The entries are removed on delete
+		require.NoError(t, s.DeleteEntityByID(ctx, entity.ID))
+		entities, err = s.GetEntitiesByIndex(ctx, store.EntityIndexClass, "proxy")
+		require.NoError(t, err)
+		assert.Len(t, entities, 0)
+
+		// Values which are not valid key segments are escaped
+		entity.Subscriptions = []string{"..", "a/b"}
+		require.NoError(t, s.UpdateEntity(ctx, entity))
+		for _, sub := range entity.Subscriptions {
+			entities, err = s.GetEntitiesByIndex(ctx, store.EntityIndexSubscription, sub)
+			require.NoError(t, err)
+			assert.Len(t, entities, 1)
+		}
+		entities, err = s.GetEntitiesByIndex(ctx, store.EntityIndexSubscription, "a")
+		require.NoError(t, err)
+		assert.Len(t, entities, 0)
+	})
+}
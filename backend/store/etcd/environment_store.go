@@ -2,7 +2,6 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -120,7 +119,7 @@ func (s *Store) UpdateEnvironment(ctx context.Context, env *types.Environment) e
 		return err
 	}
 
-	bytes, err := json.Marshal(env)
+	bytes, err := store.Encode(env)
 	if err != nil {
 		return err
 	}
@@ -150,7 +149,7 @@ func unmarshalEnvironments(kvs []*mvccpb.KeyValue) ([]*types.Environment, error)
 	for i, kv := range kvs {
 		env := &types.Environment{}
 		s[i] = env
-		if err := json.Unmarshal(kv.Value, env); err != nil {
+		if err := store.Decode(kv.Value, env); err != nil {
 			return nil, err
 		}
 	}
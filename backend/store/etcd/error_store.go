@@ -2,7 +2,6 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -188,7 +187,7 @@ func (s *Store) CreateError(ctx context.Context, perr *types.Error) error {
 	}
 
 	// Marshal
-	perrBytes, err := json.Marshal(perr)
+	perrBytes, err := store.Encode(perr)
 	if err != nil {
 		return err
 	}
@@ -233,7 +232,7 @@ func unmarshalErrorKVs(
 	perrs := make([]*types.Error, 0, len(kvs))
 	for _, kv := range kvs {
 		perr := &types.Error{}
-		if err := json.Unmarshal(kv.Value, perr); err != nil {
+		if err := store.Decode(kv.Value, perr); err != nil {
 			return nil, err
 		}
 
@@ -15,6 +15,8 @@ import (
 func TestErrorStorage(t *testing.T) {
 	testWithEtcd(t, func(store store.Store) {
 		perr := types.FixtureError("name", "ms")
+		// Empty lists aren't distinguished from unset ones once stored
+		perr.Event.Check.Handlers = nil
 		ctx := context.Background()
 		ctx = context.WithValue(ctx, types.OrganizationKey, perr.GetOrganization())
 		ctx = context.WithValue(ctx, types.EnvironmentKey, perr.GetEnvironment())
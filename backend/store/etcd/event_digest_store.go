@@ -0,0 +1,57 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+const (
+	eventDigestsPathPrefix = "event-digests"
+	eventDigestsKeyTTL     = 24 * 60 * 60 // 1 day.
+)
+
+var (
+	eventDigestKeyBuilder = store.NewKeyBuilder(eventDigestsPathPrefix)
+)
+
+func getEventDigestPath(ctx context.Context, handler, entity, check string) string {
+	return eventDigestKeyBuilder.WithContext(ctx).Build(handler, entity, check)
+}
+
+// GetEventDigest gets the digest of the last event of an entity and check
+// handled by the handler.
+func (s *Store) GetEventDigest(ctx context.Context, handler, entity, check string) (string, error) {
+	if handler == "" || entity == "" || check == "" {
+		return "", errors.New("must specify handler, entity and check")
+	}
+
+	resp, err := s.kvc.Get(ctx, getEventDigestPath(ctx, handler, entity, check), clientv3.WithLimit(1))
+	if err != nil {
+		return "", err
+	}
+	if len(resp.Kvs) == 0 {
+		return "", nil
+	}
+
+	return string(resp.Kvs[0].Value), nil
+}
+
+// UpdateEventDigest stores the digest of the last event of an entity and
+// check handled by the handler. The digest expires after eventDigestsKeyTTL,
+// so the digests of the deleted entities and checks don't pile up.
+func (s *Store) UpdateEventDigest(ctx context.Context, handler, entity, check, digest string) error {
+	if handler == "" || entity == "" || check == "" {
+		return errors.New("must specify handler, entity and check")
+	}
+
+	lease, err := s.client.Grant(ctx, eventDigestsKeyTTL)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.kvc.Put(ctx, getEventDigestPath(ctx, handler, entity, check), digest, clientv3.WithLease(lease.ID))
+	return err
+}
@@ -0,0 +1,40 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventDigestStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, "default")
+		ctx = context.WithValue(ctx, types.EnvironmentKey, "default")
+
+		// There is no digest initially
+		digest, err := store.GetEventDigest(ctx, "slack", "entity1", "check-cpu")
+		require.NoError(t, err)
+		assert.Empty(t, digest)
+
+		require.NoError(t, store.UpdateEventDigest(ctx, "slack", "entity1", "check-cpu", "a"))
+		require.NoError(t, store.UpdateEventDigest(ctx, "slack", "entity1", "check-cpu", "b"))
+		digest, err = store.GetEventDigest(ctx, "slack", "entity1", "check-cpu")
+		require.NoError(t, err)
+		assert.Equal(t, "b", digest)
+
+		// Digests are kept per handler
+		digest, err = store.GetEventDigest(ctx, "pagerduty", "entity1", "check-cpu")
+		require.NoError(t, err)
+		assert.Empty(t, digest)
+
+		assert.Error(t, store.UpdateEventDigest(ctx, "", "entity1", "check-cpu", "b"))
+		_, err = store.GetEventDigest(ctx, "", "entity1", "check-cpu")
+		assert.Error(t, err)
+	})
+}
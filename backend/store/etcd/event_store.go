@@ -2,7 +2,6 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"path"
@@ -73,10 +72,15 @@ func (s *Store) GetEvents(ctx context.Context) ([]*types.Event, error) {
 	var eventsArray []*types.Event
 	for _, kv := range resp.Kvs {
 		event := &types.Event{}
-		err = json.Unmarshal(kv.Value, event)
+		err = store.Decode(kv.Value, event)
 		if err != nil {
 			return nil, err
 		}
+		if err := s.decryptEvent(event); err != nil {
+			// The other events are still listed
+			loggerWithContext(ctx).WithError(err).Error("could not decrypt the event: ", string(kv.Key))
+			continue
+		}
 
 		// We need to manually filters the events since the events don't have
 		// their environment at the top level of the struct
@@ -105,14 +109,19 @@ func (s *Store) GetEventsByEntity(ctx context.Context, entityID string) ([]*type
 		return nil, nil
 	}
 
-	eventsArray := make([]*types.Event, len(resp.Kvs))
-	for i, kv := range resp.Kvs {
+	eventsArray := make([]*types.Event, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
 		event := &types.Event{}
-		err = json.Unmarshal(kv.Value, event)
+		err = store.Decode(kv.Value, event)
 		if err != nil {
 			return nil, err
 		}
-		eventsArray[i] = event
+		if err := s.decryptEvent(event); err != nil {
+			// The other events are still listed
+			loggerWithContext(ctx).WithError(err).Error("could not decrypt the event: ", string(kv.Key))
+			continue
+		}
+		eventsArray = append(eventsArray, event)
 	}
 
 	return eventsArray, nil
@@ -134,7 +143,10 @@ func (s *Store) GetEventByEntityCheck(ctx context.Context, entityID, checkID str
 
 	eventBytes := resp.Kvs[0].Value
 	event := &types.Event{}
-	if err := json.Unmarshal(eventBytes, event); err != nil {
+	if err := store.Decode(eventBytes, event); err != nil {
+		return nil, err
+	}
+	if err := s.decryptEvent(event); err != nil {
 		return nil, err
 	}
 
@@ -155,9 +167,14 @@ func (s *Store) UpdateEvent(ctx context.Context, event *types.Event) error {
 		return err
 	}
 
-	// update the history
-	// marshal the new event and store it.
-	eventBytes, err := json.Marshal(event)
+	// enforce the history retention of the check, then marshal the new event
+	// and store it.
+	event.Check.TrimHistory()
+	encrypted, err := s.encryptEvent(event)
+	if err != nil {
+		return err
+	}
+	eventBytes, err := store.Encode(encrypted)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,8 @@ import (
 func TestEventStorage(t *testing.T) {
 	testWithEtcd(t, func(store store.Store) {
 		event := types.FixtureEvent("entity1", "check1")
+		// Empty lists aren't distinguished from unset ones once stored
+		event.Check.Handlers = nil
 		ctx := context.WithValue(context.Background(), types.OrganizationKey, event.Entity.Organization)
 		ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
 
@@ -92,3 +94,36 @@ func TestEventStorage(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestEventStorageHistoryRetention(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		event := types.FixtureEvent("entity1", "check1")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, event.Entity.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
+
+		event.Check.HistoryRetention = 5
+		event.Check.History = nil
+		for i := int64(1); i <= 10; i++ {
+			event.Check.History = append(event.Check.History, types.CheckHistory{
+				Status:   0,
+				Executed: i,
+				Output:   "OK",
+			})
+		}
+		require.NoError(t, store.UpdateEvent(ctx, event))
+
+		// Only the latest executions are stored, without their output
+		newEv, err := store.GetEventByEntityCheck(ctx, "entity1", "check1")
+		require.NoError(t, err)
+		require.Len(t, newEv.Check.History, 5)
+		assert.Equal(t, int64(6), newEv.Check.History[0].Executed)
+		assert.Empty(t, newEv.Check.History[0].Output)
+
+		event.Check.HistoryOutput = true
+		event.Check.History[4].Output = "OK"
+		require.NoError(t, store.UpdateEvent(ctx, event))
+		newEv, err = store.GetEventByEntityCheck(ctx, "entity1", "check1")
+		require.NoError(t, err)
+		assert.Equal(t, "OK", newEv.Check.History[4].Output)
+	})
+}
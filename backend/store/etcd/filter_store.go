@@ -2,11 +2,9 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 
-	"github.com/coreos/etcd/clientv3"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -53,14 +51,17 @@ func (s *Store) GetEventFilters(ctx context.Context) ([]*types.EventFilter, erro
 		return []*types.EventFilter{}, nil
 	}
 
-	filtersArray := make([]*types.EventFilter, len(resp.Kvs))
-	for i, kv := range resp.Kvs {
+	reject := rejectByQueriedEnvironment(ctx)
+	filtersArray := make([]*types.EventFilter, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
 		filter := &types.EventFilter{}
-		err = json.Unmarshal(kv.Value, filter)
+		err = store.Decode(kv.Value, filter)
 		if err != nil {
 			return nil, err
 		}
-		filtersArray[i] = filter
+		if !reject(filter) {
+			filtersArray = append(filtersArray, filter)
+		}
 	}
 
 	return filtersArray, nil
@@ -82,7 +83,7 @@ func (s *Store) GetEventFilterByName(ctx context.Context, name string) (*types.E
 
 	filterBytes := resp.Kvs[0].Value
 	filter := &types.EventFilter{}
-	if err := json.Unmarshal(filterBytes, filter); err != nil {
+	if err := store.Decode(filterBytes, filter); err != nil {
 		return nil, err
 	}
 
@@ -91,29 +92,5 @@ func (s *Store) GetEventFilterByName(ctx context.Context, name string) (*types.E
 
 // UpdateEventFilter updates an EventFilter.
 func (s *Store) UpdateEventFilter(ctx context.Context, filter *types.EventFilter) error {
-	if err := filter.Validate(); err != nil {
-		return err
-	}
-
-	filterBytes, err := json.Marshal(filter)
-	if err != nil {
-		return err
-	}
-
-	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(filter.Organization, filter.Environment)), ">", 0)
-	req := clientv3.OpPut(getEventFilterPath(filter), string(filterBytes))
-	res, err := s.kvc.Txn(ctx).If(cmp).Then(req).Commit()
-	if err != nil {
-		return err
-	}
-	if !res.Succeeded {
-		return fmt.Errorf(
-			"could not create the filter %s in environment %s/%s",
-			filter.Name,
-			filter.Organization,
-			filter.Environment,
-		)
-	}
-
-	return nil
+	return updateResource(ctx, s, getEventFilterPath(filter), filter)
 }
@@ -2,11 +2,8 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
 
-	"github.com/coreos/etcd/clientv3"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -45,14 +42,17 @@ func (s *Store) GetHandlers(ctx context.Context) ([]*types.Handler, error) {
 		return []*types.Handler{}, nil
 	}
 
-	handlersArray := make([]*types.Handler, len(resp.Kvs))
-	for i, kv := range resp.Kvs {
+	reject := rejectByQueriedEnvironment(ctx)
+	handlersArray := make([]*types.Handler, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
 		handler := &types.Handler{}
-		err = json.Unmarshal(kv.Value, handler)
+		err = store.Decode(kv.Value, handler)
 		if err != nil {
 			return nil, err
 		}
-		handlersArray[i] = handler
+		if !reject(handler) {
+			handlersArray = append(handlersArray, handler)
+		}
 	}
 
 	return handlersArray, nil
@@ -74,7 +74,7 @@ func (s *Store) GetHandlerByName(ctx context.Context, name string) (*types.Handl
 
 	handlerBytes := resp.Kvs[0].Value
 	handler := &types.Handler{}
-	if err := json.Unmarshal(handlerBytes, handler); err != nil {
+	if err := store.Decode(handlerBytes, handler); err != nil {
 		return nil, err
 	}
 
@@ -83,29 +83,5 @@ func (s *Store) GetHandlerByName(ctx context.Context, name string) (*types.Handl
 
 // UpdateHandler updates a Handler.
 func (s *Store) UpdateHandler(ctx context.Context, handler *types.Handler) error {
-	if err := handler.Validate(); err != nil {
-		return err
-	}
-
-	handlerBytes, err := json.Marshal(handler)
-	if err != nil {
-		return err
-	}
-
-	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(handler.Organization, handler.Environment)), ">", 0)
-	req := clientv3.OpPut(getHandlerPath(handler), string(handlerBytes))
-	res, err := s.kvc.Txn(ctx).If(cmp).Then(req).Commit()
-	if err != nil {
-		return err
-	}
-	if !res.Succeeded {
-		return fmt.Errorf(
-			"could not create the handler %s in environment %s/%s",
-			handler.Name,
-			handler.Organization,
-			handler.Environment,
-		)
-	}
-
-	return nil
+	return updateResource(ctx, s, getHandlerPath(handler), handler)
 }
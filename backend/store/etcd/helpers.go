@@ -2,9 +2,10 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 
 	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -14,10 +15,10 @@ type getObjectsPath func(context.Context, string) string
 
 // query is a wrapper around etcd Get method, which provides additional support
 // for querying multiple elements accross organizations and environments.
-// N.B. Even if we only query across organizations, we still need to filter the
-// values returned based on their environment afterwards if the objects type
-// doesn't contain the environment at the top level of the object
-func query(ctx context.Context, store *Store, fn getObjectsPath) (*clientv3.GetResponse, error) {
+// N.B. When querying across organizations, the elements of all environments
+// are returned, so they need to be filtered afterwards with
+// rejectByQueriedEnvironment once decoded
+func query(ctx context.Context, s *Store, fn getObjectsPath) (*clientv3.GetResponse, error) {
 	// Support "*" as a wildcard
 	var org, env string
 	if org = organization(ctx); org == "*" {
@@ -35,38 +36,7 @@ func query(ctx context.Context, store *Store, fn getObjectsPath) (*clientv3.GetR
 		ctx = context.WithValue(ctx, types.EnvironmentKey, "")
 	}
 
-	resp, err := store.kvc.Get(ctx, fn(ctx, ""), clientv3.WithPrefix())
-	if err != nil {
-		return resp, err
-	}
-
-	// Return all elements if all environments were requested
-	if env == "" {
-		return resp, nil
-	}
-
-	// Filter elements based on their environment
-	var value map[string]interface{}
-	for i, kv := range resp.Kvs {
-		if err := json.Unmarshal(kv.Value, &value); err != nil {
-			// We are dealing with unexpected data, just return the raw data
-			return resp, nil
-		}
-
-		environment, ok := value["environment"].(string)
-		if !ok {
-			// We are dealing with an unconvential type of objects (e.g. events)
-			// so just return all elements
-			return resp, nil
-		}
-
-		// Make sure we only keep the elements that are member of the specified env
-		if environment != env {
-			resp.Kvs = append(resp.Kvs[:i], resp.Kvs[i+1:]...)
-		}
-	}
-
-	return resp, err
+	return s.kvc.Get(ctx, fn(ctx, ""), clientv3.WithPrefix())
 }
 
 // environment returns the environment name injected in the context
@@ -84,3 +54,38 @@ func organization(ctx context.Context) string {
 	}
 	return ""
 }
+
+// updateResource validates and stores a resource under the given key, as long
+// as its organization, and its environment if it has one, exist.
+func updateResource(ctx context.Context, s *Store, key string, r types.Resource) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	bytes, err := store.Encode(r)
+	if err != nil {
+		return err
+	}
+
+	namespace := getEnvironmentsPath(r.GetOrganization(), r.GetEnvironment())
+	if r.GetEnvironment() == "" {
+		namespace = getOrganizationsPath(r.GetOrganization())
+	}
+
+	cmp := clientv3.Compare(clientv3.Version(namespace), ">", 0)
+	req := clientv3.OpPut(key, string(bytes))
+	res, err := s.kvc.Txn(ctx).If(cmp).Then(req).Commit()
+	if err != nil {
+		return err
+	}
+	if !res.Succeeded {
+		return fmt.Errorf(
+			"could not create %s in namespace %s/%s",
+			r.URIPath(),
+			r.GetOrganization(),
+			r.GetEnvironment(),
+		)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ package etcd
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/sensu/sensu-go/backend/store"
@@ -61,10 +62,14 @@ func TestQuery(t *testing.T) {
 		// Mock a context to query across every single organization
 		ctx = context.WithValue(ctx, types.OrganizationKey, "*")
 
-		// We now have two result given our "wildcard" org
+		// The checks of every environment are returned given our "wildcard"
+		// org, and only those of our env are kept once decoded
 		resp, err = query(ctx, etcd, getCheckConfigsPath)
 		assert.NoError(t, err)
-		assert.Len(t, resp.Kvs, 2)
+		assert.Len(t, resp.Kvs, 3)
+		checks, err := store.GetCheckConfigs(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, checks, 2)
 
 		// Mock a context to query across every single environment of the acme org
 		ctx = context.WithValue(ctx, types.OrganizationKey, "acme")
@@ -85,3 +90,57 @@ func TestQuery(t *testing.T) {
 		assert.Len(t, resp.Kvs, 3)
 	})
 }
+
+func TestUpdateResource(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		etcd := store.(*Store)
+		ctx := context.Background()
+
+		handler := types.FixtureHandler("handler1")
+		require.NoError(t, updateResource(ctx, etcd, getHandlerPath(handler), handler))
+
+		result, err := store.GetHandlerByName(types.SetContextFromResource(ctx, handler), "handler1")
+		require.NoError(t, err)
+		assert.Equal(t, handler, result)
+
+		// Assets only require their organization to exist
+		asset := types.FixtureAsset("asset1")
+		assert.NoError(t, updateResource(ctx, etcd, getAssetPath(asset), asset))
+
+		// The namespace of the resource must exist
+		handler.SetNamespace("acme", "dev")
+		err = updateResource(ctx, etcd, getHandlerPath(handler), handler)
+		assert.EqualError(t, err, "could not create /handlers/handler1 in namespace acme/dev")
+
+		// Invalid resources are not stored
+		handler.SetNamespace("default", "default")
+		handler.Type = ""
+		assert.Error(t, updateResource(ctx, etcd, getHandlerPath(handler), handler))
+	})
+}
+
+func TestReadJSONValues(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		etcd := store.(*Store)
+		ctx := context.Background()
+
+		// Values stored by previous versions are JSON
+		handler := types.FixtureHandler("handler1")
+		handlerBytes, err := json.Marshal(handler)
+		require.NoError(t, err)
+		_, err = etcd.kvc.Put(ctx, getHandlerPath(handler), string(handlerBytes))
+		require.NoError(t, err)
+
+		ctx = types.SetContextFromResource(ctx, handler)
+		result, err := store.GetHandlerByName(ctx, "handler1")
+		require.NoError(t, err)
+		assert.Equal(t, handler, result)
+
+		// They are stored as protobuf once updated
+		require.NoError(t, store.UpdateHandler(ctx, result))
+		resp, err := etcd.kvc.Get(ctx, getHandlerPath(handler))
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+		assert.NotEqual(t, byte('{'), resp.Kvs[0].Value[0])
+	})
+}
@@ -2,11 +2,8 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
 
-	"github.com/coreos/etcd/clientv3"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -48,14 +45,17 @@ func (s *Store) GetHookConfigs(ctx context.Context) ([]*types.HookConfig, error)
 		return []*types.HookConfig{}, nil
 	}
 
-	hooksArray := make([]*types.HookConfig, len(resp.Kvs))
-	for i, kv := range resp.Kvs {
+	reject := rejectByQueriedEnvironment(ctx)
+	hooksArray := make([]*types.HookConfig, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
 		hook := &types.HookConfig{}
-		err = json.Unmarshal(kv.Value, hook)
+		err = store.Decode(kv.Value, hook)
 		if err != nil {
 			return nil, err
 		}
-		hooksArray[i] = hook
+		if !reject(hook) {
+			hooksArray = append(hooksArray, hook)
+		}
 	}
 
 	return hooksArray, nil
@@ -77,7 +77,7 @@ func (s *Store) GetHookConfigByName(ctx context.Context, name string) (*types.Ho
 
 	hookBytes := resp.Kvs[0].Value
 	hook := &types.HookConfig{}
-	if err := json.Unmarshal(hookBytes, hook); err != nil {
+	if err := store.Decode(hookBytes, hook); err != nil {
 		return nil, err
 	}
 
@@ -86,29 +86,5 @@ func (s *Store) GetHookConfigByName(ctx context.Context, name string) (*types.Ho
 
 // UpdateHookConfig updates a HookConfig.
 func (s *Store) UpdateHookConfig(ctx context.Context, hook *types.HookConfig) error {
-	if err := hook.Validate(); err != nil {
-		return err
-	}
-
-	hookBytes, err := json.Marshal(hook)
-	if err != nil {
-		return err
-	}
-
-	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(hook.Organization, hook.Environment)), ">", 0)
-	req := clientv3.OpPut(getHookConfigPath(hook), string(hookBytes))
-	res, err := s.kvc.Txn(ctx).If(cmp).Then(req).Commit()
-	if err != nil {
-		return err
-	}
-	if !res.Succeeded {
-		return fmt.Errorf(
-			"could not create the hook %s in environment %s/%s",
-			hook.Name,
-			hook.Organization,
-			hook.Environment,
-		)
-	}
-
-	return nil
+	return updateResource(ctx, s, getHookConfigPath(hook), hook)
 }
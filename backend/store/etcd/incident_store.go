@@ -0,0 +1,260 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// incidentResolvedTTL is how long, in seconds, the resolved incidents are
+	// kept before they expire.
+	incidentResolvedTTL = 7 * 24 * 60 * 60
+
+	// incidentUpdateRetries is the number of times an incident concurrently
+	// modified is read and updated again.
+	incidentUpdateRetries = 5
+
+	// incidentTxnOps is the maximum number of incidents read in a single
+	// transaction, below the default operations limit of etcd.
+	incidentTxnOps = 128
+)
+
+var (
+	incidentsPathPrefix = "incidents"
+	incidentKeyBuilder  = store.NewKeyBuilder(incidentsPathPrefix)
+
+	// The index of the unresolved incidents maps their ID to their key, so
+	// that they are listed without reading the resolved incidents
+	unresolvedIncidentsPathPrefix = "unresolvedincidents"
+	unresolvedIncidentKeyBuilder  = store.NewKeyBuilder(unresolvedIncidentsPathPrefix)
+)
+
+func getIncidentPath(incident *types.Incident) string {
+	return incidentKeyBuilder.WithResource(incident).Build(incident.ID)
+}
+
+func getIncidentsPath(ctx context.Context, id string) string {
+	return incidentKeyBuilder.WithContext(ctx).Build(id)
+}
+
+func getUnresolvedIncidentPath(incident *types.Incident) string {
+	return unresolvedIncidentKeyBuilder.WithResource(incident).Build(incident.ID)
+}
+
+func getUnresolvedIncidentsPath(ctx context.Context, id string) string {
+	return unresolvedIncidentKeyBuilder.WithContext(ctx).Build(id)
+}
+
+// DeleteIncidentByID deletes an Incident by ID, along with its entry in the
+// index of the unresolved incidents.
+func (s *Store) DeleteIncidentByID(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("must specify ID of incident")
+	}
+
+	_, err := s.kvc.Txn(ctx).Then(
+		clientv3.OpDelete(getIncidentsPath(ctx, id)),
+		clientv3.OpDelete(getUnresolvedIncidentsPath(ctx, id)),
+	).Commit()
+	return err
+}
+
+// GetIncidents gets the list of incidents for the organization and
+// environment of the context.
+func (s *Store) GetIncidents(ctx context.Context) ([]*types.Incident, error) {
+	resp, err := query(ctx, s, getIncidentsPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return []*types.Incident{}, nil
+	}
+
+	reject := rejectByQueriedEnvironment(ctx)
+	incidents := make([]*types.Incident, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		incident := &types.Incident{}
+		if err := store.Decode(kv.Value, incident); err != nil {
+			return nil, err
+		}
+		if !reject(incident) {
+			incidents = append(incidents, incident)
+		}
+	}
+
+	return incidents, nil
+}
+
+// GetIncidentByID gets an Incident by ID.
+func (s *Store) GetIncidentByID(ctx context.Context, id string) (*types.Incident, error) {
+	if id == "" {
+		return nil, errors.New("must specify ID of incident")
+	}
+
+	resp, err := s.kvc.Get(ctx, getIncidentsPath(ctx, id))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+
+	incident := &types.Incident{}
+	if err := store.Decode(resp.Kvs[0].Value, incident); err != nil {
+		return nil, err
+	}
+
+	return incident, nil
+}
+
+// GetUnresolvedIncidents gets the list of the incidents which are not resolved
+// for the organization and environment of the context, through their index.
+func (s *Store) GetUnresolvedIncidents(ctx context.Context) ([]*types.Incident, error) {
+	resp, err := query(ctx, s, getUnresolvedIncidentsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	reject := rejectByQueriedEnvironment(ctx)
+	incidents := []*types.Incident{}
+	for start := 0; start < len(resp.Kvs); start += incidentTxnOps {
+		end := start + incidentTxnOps
+		if end > len(resp.Kvs) {
+			end = len(resp.Kvs)
+		}
+
+		ops := make([]clientv3.Op, 0, end-start)
+		for _, kv := range resp.Kvs[start:end] {
+			ops = append(ops, clientv3.OpGet(string(kv.Value)))
+		}
+		res, err := s.kvc.Txn(ctx).Then(ops...).Commit()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range res.Responses {
+			for _, kv := range r.GetResponseRange().Kvs {
+				incident := &types.Incident{}
+				if err := store.Decode(kv.Value, incident); err != nil {
+					return nil, err
+				}
+				if !incident.IsResolved() && !reject(incident) {
+					incidents = append(incidents, incident)
+				}
+			}
+		}
+	}
+
+	return incidents, nil
+}
+
+// UpdateIncident creates or updates an Incident, and its entry in the index of
+// the unresolved incidents. A resolved incident expires after
+// incidentResolvedTTL.
+func (s *Store) UpdateIncident(ctx context.Context, incident *types.Incident) error {
+	if err := incident.Validate(); err != nil {
+		return err
+	}
+
+	ops, err := s.incidentOps(ctx, incident)
+	if err != nil {
+		return err
+	}
+
+	namespace := getEnvironmentsPath(incident.Organization, incident.Environment)
+	cmp := clientv3.Compare(clientv3.Version(namespace), ">", 0)
+	res, err := s.kvc.Txn(ctx).If(cmp).Then(ops...).Commit()
+	if err != nil {
+		return err
+	}
+	if !res.Succeeded {
+		return fmt.Errorf(
+			"could not create %s in namespace %s/%s",
+			incident.URIPath(),
+			incident.Organization,
+			incident.Environment,
+		)
+	}
+	return nil
+}
+
+// UpdateIncidentWith applies update to the incident stored with the given ID,
+// and stores the result unless the incident was modified in the meantime, in
+// which case it is read and updated again. Nothing is updated if the incident
+// does not exist.
+func (s *Store) UpdateIncidentWith(ctx context.Context, id string, update func(*types.Incident) error) error {
+	if id == "" {
+		return errors.New("must specify ID of incident")
+	}
+
+	key := getIncidentsPath(ctx, id)
+	for i := 0; i < incidentUpdateRetries; i++ {
+		resp, err := s.kvc.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		if len(resp.Kvs) == 0 {
+			return nil
+		}
+
+		incident := &types.Incident{}
+		if err := store.Decode(resp.Kvs[0].Value, incident); err != nil {
+			return err
+		}
+		if err := update(incident); err != nil {
+			return err
+		}
+		if err := incident.Validate(); err != nil {
+			return err
+		}
+
+		ops, err := s.incidentOps(ctx, incident)
+		if err != nil {
+			return err
+		}
+
+		cmp := clientv3.Compare(clientv3.ModRevision(key), "=", resp.Kvs[0].ModRevision)
+		res, err := s.kvc.Txn(ctx).If(cmp).Then(ops...).Commit()
+		if err != nil {
+			return err
+		}
+		if res.Succeeded {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("could not update incident %s: concurrently modified", id)
+}
+
+// incidentOps returns the operations storing the incident, along with its
+// entry in the index of the unresolved incidents, or, once it is resolved,
+// with a lease expiring it and without its index entry.
+func (s *Store) incidentOps(ctx context.Context, incident *types.Incident) ([]clientv3.Op, error) {
+	bytes, err := store.Encode(incident)
+	if err != nil {
+		return nil, err
+	}
+
+	key := getIncidentPath(incident)
+	indexKey := getUnresolvedIncidentPath(incident)
+	if !incident.IsResolved() {
+		return []clientv3.Op{
+			clientv3.OpPut(key, string(bytes)),
+			clientv3.OpPut(indexKey, key),
+		}, nil
+	}
+
+	lease, err := s.client.Grant(ctx, incidentResolvedTTL)
+	if err != nil {
+		return nil, err
+	}
+	return []clientv3.Op{
+		clientv3.OpPut(key, string(bytes), clientv3.WithLease(lease.ID)),
+		clientv3.OpDelete(indexKey),
+	}, nil
+}
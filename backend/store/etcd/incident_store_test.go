@@ -0,0 +1,73 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncidentStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		incident := types.FixtureIncident("abc", "check_cpu")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, incident.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, incident.Environment)
+
+		// We should receive an empty slice if no results were found
+		incidents, err := store.GetIncidents(ctx)
+		assert.NoError(t, err)
+		assert.NotNil(t, incidents)
+
+		require.NoError(t, store.UpdateIncident(ctx, incident))
+
+		retrieved, err := store.GetIncidentByID(ctx, "abc")
+		require.NoError(t, err)
+		require.NotNil(t, retrieved)
+		assert.Equal(t, incident.Events, retrieved.Events)
+
+		incidents, err = store.GetIncidents(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(incidents))
+
+		unresolved, err := store.GetUnresolvedIncidents(ctx)
+		require.NoError(t, err)
+		require.Len(t, unresolved, 1)
+		assert.Equal(t, "abc", unresolved[0].ID)
+
+		// Resolving the incident removes it from the unresolved incidents
+		require.NoError(t, store.UpdateIncidentWith(ctx, "abc", func(incident *types.Incident) error {
+			for _, entity := range []string{"entity1", "entity2"} {
+				incident.Record(types.FixtureEvent(entity, "check_cpu"))
+			}
+			return nil
+		}))
+		retrieved, err = store.GetIncidentByID(ctx, "abc")
+		require.NoError(t, err)
+		assert.True(t, retrieved.IsResolved())
+
+		unresolved, err = store.GetUnresolvedIncidents(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, unresolved)
+
+		// Updating a missing incident does nothing
+		require.NoError(t, store.UpdateIncidentWith(ctx, "missing", func(*types.Incident) error {
+			t.Fatal("missing incident updated")
+			return nil
+		}))
+
+		require.NoError(t, store.DeleteIncidentByID(ctx, "abc"))
+		retrieved, err = store.GetIncidentByID(ctx, "abc")
+		assert.NoError(t, err)
+		assert.Nil(t, retrieved)
+
+		// Updating an incident in a nonexistent org and env should not work
+		incident.Organization = "missing"
+		incident.Environment = "missing"
+		assert.Error(t, store.UpdateIncident(ctx, incident))
+	})
+}
@@ -2,11 +2,11 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"path"
 
 	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -14,19 +14,57 @@ const (
 	keepalivesPathPrefix = "keepalives"
 )
 
-func getKeepalivePath(keepalivesPath string, entity *types.Entity) string {
-	return path.Join(keepalivesPath, entity.Organization, entity.Environment, entity.ID)
+// Keepalive deadlines are stored under a key prefixed by their expiration, so
+// the expired keepalives can be fetched with a single range request, while an
+// index maps each entity to the key of its current deadline.
+func getKeepaliveDeadlinesPath(keepalivesPath string) string {
+	return path.Join(keepalivesPath, "deadlines")
 }
 
-// DeleteFailingKeepalive deletes a failing KeepaliveRecord.
-func (s *Store) DeleteFailingKeepalive(ctx context.Context, entity *types.Entity) error {
-	_, err := s.client.Delete(ctx, getKeepalivePath(s.keepalivesPath, entity))
+func getKeepaliveDeadlinePath(keepalivesPath string, kr *types.KeepaliveRecord) string {
+	return path.Join(
+		getKeepaliveDeadlinesPath(keepalivesPath),
+		formatKeepaliveDeadline(kr.Time),
+		kr.Organization,
+		kr.Environment,
+		kr.EntityID,
+	)
+}
+
+func getKeepaliveIndexPath(keepalivesPath string, entity *types.Entity) string {
+	return path.Join(keepalivesPath, "entities", entity.Organization, entity.Environment, entity.ID)
+}
+
+// formatKeepaliveDeadline pads the deadline so deadline keys sort in
+// chronological order.
+func formatKeepaliveDeadline(t int64) string {
+	return fmt.Sprintf("%020d", t)
+}
+
+// DeleteKeepalive deletes the keepalive deadline of an entity.
+func (s *Store) DeleteKeepalive(ctx context.Context, entity *types.Entity) error {
+	indexPath := getKeepaliveIndexPath(s.keepalivesPath, entity)
+	resp, err := s.client.Get(ctx, indexPath)
+	if err != nil {
+		return err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil
+	}
+
+	_, err = s.kvc.Txn(ctx).Then(
+		clientv3.OpDelete(string(resp.Kvs[0].Value)),
+		clientv3.OpDelete(indexPath),
+	).Commit()
 	return err
 }
 
-// GetFailingKeepalives gets all of the failing KeepaliveRecords.
-func (s *Store) GetFailingKeepalives(ctx context.Context) ([]*types.KeepaliveRecord, error) {
-	resp, err := s.client.Get(ctx, s.keepalivesPath, clientv3.WithPrefix())
+// GetExpiredKeepalives gets the keepalives whose deadline is at or before the
+// given unix timestamp.
+func (s *Store) GetExpiredKeepalives(ctx context.Context, now int64) ([]*types.KeepaliveRecord, error) {
+	deadlinesPath := getKeepaliveDeadlinesPath(s.keepalivesPath) + "/"
+	end := deadlinesPath + formatKeepaliveDeadline(now+1)
+	resp, err := s.client.Get(ctx, deadlinesPath, clientv3.WithRange(end))
 	if err != nil {
 		return nil, err
 	}
@@ -34,11 +72,11 @@ func (s *Store) GetFailingKeepalives(ctx context.Context) ([]*types.KeepaliveRec
 	keepalives := []*types.KeepaliveRecord{}
 	for _, kv := range resp.Kvs {
 		keepalive := &types.KeepaliveRecord{}
-		if err := json.Unmarshal(kv.Value, keepalive); err != nil {
+		if err := store.Decode(kv.Value, keepalive); err != nil {
 			// if we have a problem deserializing a keepalive record, delete that record
 			// ignoring any errors we have along the way.
 			if _, err := s.client.Delete(ctx, string(kv.Key)); err != nil {
-				logger.Debug(err)
+				loggerWithContext(ctx).Debug(err)
 			}
 			continue
 		}
@@ -48,17 +86,51 @@ func (s *Store) GetFailingKeepalives(ctx context.Context) ([]*types.KeepaliveRec
 	return keepalives, nil
 }
 
-// UpdateFailingKeepalive updates a failing KeepaliveRecord.
-func (s *Store) UpdateFailingKeepalive(ctx context.Context, entity *types.Entity, expiration int64) error {
+// ClaimExpiredKeepalive atomically deletes an expired keepalive deadline. It
+// returns true if the deadline was still the current one of its entity, which
+// is the case for a single caller, even across backends.
+func (s *Store) ClaimExpiredKeepalive(ctx context.Context, kr *types.KeepaliveRecord) (bool, error) {
+	deadlinePath := getKeepaliveDeadlinePath(s.keepalivesPath, kr)
+	indexPath := getKeepaliveIndexPath(s.keepalivesPath, &types.Entity{
+		ID:           kr.EntityID,
+		Organization: kr.Organization,
+		Environment:  kr.Environment,
+	})
+
+	cmp := clientv3.Compare(clientv3.Value(indexPath), "=", deadlinePath)
+	res, err := s.kvc.Txn(ctx).If(cmp).Then(
+		clientv3.OpDelete(deadlinePath),
+		clientv3.OpDelete(indexPath),
+	).Else(
+		// The deadline was superseded, or already claimed
+		clientv3.OpDelete(deadlinePath),
+	).Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return res.Succeeded, nil
+}
+
+// UpdateKeepalive sets the keepalive deadline of an entity, replacing its
+// previous deadline if any. The deadline and the index are written in a single
+// transaction; the superseded deadline is left to expire, and is then deleted
+// by ClaimExpiredKeepalive without being claimed.
+func (s *Store) UpdateKeepalive(ctx context.Context, entity *types.Entity, expiration int64) error {
 	kr := types.NewKeepaliveRecord(entity, expiration)
-	krBytes, err := json.Marshal(kr)
+	krBytes, err := store.Encode(kr)
 	if err != nil {
 		return err
 	}
 
+	indexPath := getKeepaliveIndexPath(s.keepalivesPath, entity)
+	deadlinePath := getKeepaliveDeadlinePath(s.keepalivesPath, kr)
+
 	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(entity.Organization, entity.Environment)), ">", 0)
-	req := clientv3.OpPut(getKeepalivePath(s.keepalivesPath, entity), string(krBytes))
-	res, err := s.kvc.Txn(ctx).If(cmp).Then(req).Commit()
+	res, err := s.kvc.Txn(ctx).If(cmp).Then(
+		clientv3.OpPut(deadlinePath, string(krBytes)),
+		clientv3.OpPut(indexPath, deadlinePath),
+	).Commit()
 	if err != nil {
 		return err
 	}
@@ -71,5 +143,5 @@ func (s *Store) UpdateFailingKeepalive(ctx context.Context, entity *types.Entity
 		)
 	}
 
-	return err
+	return nil
 }
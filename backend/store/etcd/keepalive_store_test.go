@@ -9,6 +9,7 @@ import (
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestKeepaliveStorage(t *testing.T) {
@@ -17,17 +18,62 @@ func TestKeepaliveStorage(t *testing.T) {
 		ctx := context.WithValue(context.Background(), types.OrganizationKey, entity.Environment)
 		ctx = context.WithValue(ctx, types.EnvironmentKey, entity.Environment)
 
-		err := store.UpdateFailingKeepalive(ctx, entity, 1)
+		err := store.UpdateKeepalive(ctx, entity, 10)
 		assert.NoError(t, err)
 
-		records, err := store.GetFailingKeepalives(context.Background())
+		// Not expired yet
+		records, err := store.GetExpiredKeepalives(ctx, 9)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(records))
+
+		records, err = store.GetExpiredKeepalives(ctx, 10)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(records))
 
+		// Postponing the keepalive supersedes its previous expiration, which
+		// can't be claimed and is deleted once expired
+		err = store.UpdateKeepalive(ctx, entity, 20)
+		assert.NoError(t, err)
+		records, err = store.GetExpiredKeepalives(ctx, 15)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(records))
+		claimed, err := store.ClaimExpiredKeepalive(ctx, records[0])
+		assert.NoError(t, err)
+		assert.False(t, claimed)
+		records, err = store.GetExpiredKeepalives(ctx, 15)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(records))
+
+		records, err = store.GetExpiredKeepalives(ctx, 20)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(records))
+		assert.Equal(t, "entity", records[0].EntityID)
+		assert.Equal(t, int64(20), records[0].Time)
+
+		// An expired keepalive can only be claimed once
+		claimed, err = store.ClaimExpiredKeepalive(ctx, records[0])
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+		claimed, err = store.ClaimExpiredKeepalive(ctx, records[0])
+		assert.NoError(t, err)
+		assert.False(t, claimed)
+
+		records, err = store.GetExpiredKeepalives(ctx, 30)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(records))
+
+		// Deleted keepalives do not expire
+		err = store.UpdateKeepalive(ctx, entity, 10)
+		assert.NoError(t, err)
+		assert.NoError(t, store.DeleteKeepalive(ctx, entity))
+		records, err = store.GetExpiredKeepalives(ctx, 30)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(records))
+
 		// Updating a keepalive in a nonexistent org and env should not work
 		entity.Organization = "missing"
 		entity.Environment = "missing"
-		err = store.UpdateFailingKeepalive(ctx, entity, 1)
+		err = store.UpdateKeepalive(ctx, entity, 1)
 		assert.Error(t, err)
 	})
 }
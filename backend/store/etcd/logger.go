@@ -1,7 +1,21 @@
 package etcd
 
-import "github.com/Sirupsen/logrus"
+import (
+	"context"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/types"
+)
 
 var logger = logrus.WithFields(logrus.Fields{
 	"component": "store",
 })
+
+// loggerWithContext returns the store logger, with the ID of the API request
+// being served added when ctx carries one.
+func loggerWithContext(ctx context.Context) *logrus.Entry {
+	if id := types.RequestIDFromContext(ctx); id != "" {
+		return logger.WithField("request_id", id)
+	}
+	return logger
+}
@@ -2,11 +2,8 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
 
-	"github.com/coreos/etcd/clientv3"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -45,14 +42,17 @@ func (s *Store) GetMutators(ctx context.Context) ([]*types.Mutator, error) {
 		return []*types.Mutator{}, nil
 	}
 
-	mutatorsArray := make([]*types.Mutator, len(resp.Kvs))
-	for i, kv := range resp.Kvs {
+	reject := rejectByQueriedEnvironment(ctx)
+	mutatorsArray := make([]*types.Mutator, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
 		mutator := &types.Mutator{}
-		err = json.Unmarshal(kv.Value, mutator)
+		err = store.Decode(kv.Value, mutator)
 		if err != nil {
 			return nil, err
 		}
-		mutatorsArray[i] = mutator
+		if !reject(mutator) {
+			mutatorsArray = append(mutatorsArray, mutator)
+		}
 	}
 
 	return mutatorsArray, nil
@@ -74,7 +74,7 @@ func (s *Store) GetMutatorByName(ctx context.Context, name string) (*types.Mutat
 
 	mutatorBytes := resp.Kvs[0].Value
 	mutator := &types.Mutator{}
-	if err := json.Unmarshal(mutatorBytes, mutator); err != nil {
+	if err := store.Decode(mutatorBytes, mutator); err != nil {
 		return nil, err
 	}
 
@@ -83,29 +83,5 @@ func (s *Store) GetMutatorByName(ctx context.Context, name string) (*types.Mutat
 
 // UpdateMutator updates a Mutator.
 func (s *Store) UpdateMutator(ctx context.Context, mutator *types.Mutator) error {
-	if err := mutator.Validate(); err != nil {
-		return err
-	}
-
-	mutatorBytes, err := json.Marshal(mutator)
-	if err != nil {
-		return err
-	}
-
-	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(mutator.Organization, mutator.Environment)), ">", 0)
-	req := clientv3.OpPut(getMutatorPath(mutator), string(mutatorBytes))
-	res, err := s.kvc.Txn(ctx).If(cmp).Then(req).Commit()
-	if err != nil {
-		return err
-	}
-	if !res.Succeeded {
-		return fmt.Errorf(
-			"could not create the mutator %s in environment %s/%s",
-			mutator.Name,
-			mutator.Organization,
-			mutator.Environment,
-		)
-	}
-
-	return nil
+	return updateResource(ctx, s, getMutatorPath(mutator), mutator)
 }
@@ -2,13 +2,13 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"path"
 
 	v3 "github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -114,7 +114,7 @@ func (s *Store) UpdateOrganization(ctx context.Context, org *types.Organization)
 		return err
 	}
 
-	bytes, err := json.Marshal(org)
+	bytes, err := store.Encode(org)
 	if err != nil {
 		return err
 	}
@@ -129,7 +129,7 @@ func unmarshalOrganizations(kvs []*mvccpb.KeyValue) ([]*types.Organization, erro
 	for i, kv := range kvs {
 		org := &types.Organization{}
 		s[i] = org
-		if err := json.Unmarshal(kv.Value, org); err != nil {
+		if err := store.Decode(kv.Value, org); err != nil {
 			return nil, err
 		}
 	}
@@ -0,0 +1,85 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var (
+	pipelinesPathPrefix = "pipelines"
+	pipelineKeyBuilder  = store.NewKeyBuilder(pipelinesPathPrefix)
+)
+
+func getPipelinePath(pipeline *types.Pipeline) string {
+	return pipelineKeyBuilder.WithResource(pipeline).Build(pipeline.Name)
+}
+
+func getPipelinesPath(ctx context.Context, name string) string {
+	return pipelineKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeletePipelineByName deletes a Pipeline by name.
+func (s *Store) DeletePipelineByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name of pipeline")
+	}
+
+	_, err := s.kvc.Delete(ctx, getPipelinesPath(ctx, name))
+	return err
+}
+
+// GetPipelines gets the list of pipelines for the organization and
+// environment of the context.
+func (s *Store) GetPipelines(ctx context.Context) ([]*types.Pipeline, error) {
+	resp, err := query(ctx, s, getPipelinesPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return []*types.Pipeline{}, nil
+	}
+
+	reject := rejectByQueriedEnvironment(ctx)
+	pipelines := make([]*types.Pipeline, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		pipeline := &types.Pipeline{}
+		if err := store.Decode(kv.Value, pipeline); err != nil {
+			return nil, err
+		}
+		if !reject(pipeline) {
+			pipelines = append(pipelines, pipeline)
+		}
+	}
+
+	return pipelines, nil
+}
+
+// GetPipelineByName gets a Pipeline by name.
+func (s *Store) GetPipelineByName(ctx context.Context, name string) (*types.Pipeline, error) {
+	if name == "" {
+		return nil, errors.New("must specify name of pipeline")
+	}
+
+	resp, err := s.kvc.Get(ctx, getPipelinesPath(ctx, name))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+
+	pipeline := &types.Pipeline{}
+	if err := store.Decode(resp.Kvs[0].Value, pipeline); err != nil {
+		return nil, err
+	}
+
+	return pipeline, nil
+}
+
+// UpdatePipeline updates a Pipeline.
+func (s *Store) UpdatePipeline(ctx context.Context, pipeline *types.Pipeline) error {
+	return updateResource(ctx, s, getPipelinePath(pipeline), pipeline)
+}
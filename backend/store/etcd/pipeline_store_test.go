@@ -0,0 +1,47 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		pipeline := types.FixturePipeline("alerts")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, pipeline.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, pipeline.Environment)
+
+		// We should receive an empty slice if no results were found
+		pipelines, err := store.GetPipelines(ctx)
+		assert.NoError(t, err)
+		assert.NotNil(t, pipelines)
+
+		require.NoError(t, store.UpdatePipeline(ctx, pipeline))
+
+		retrieved, err := store.GetPipelineByName(ctx, "alerts")
+		require.NoError(t, err)
+		require.NotNil(t, retrieved)
+		assert.Equal(t, pipeline.Workflows, retrieved.Workflows)
+
+		pipelines, err = store.GetPipelines(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(pipelines))
+
+		require.NoError(t, store.DeletePipelineByName(ctx, "alerts"))
+		retrieved, err = store.GetPipelineByName(ctx, "alerts")
+		assert.NoError(t, err)
+		assert.Nil(t, retrieved)
+
+		// Updating a pipeline in a nonexistent org and env should not work
+		pipeline.Organization = "missing"
+		pipeline.Environment = "missing"
+		assert.Error(t, store.UpdatePipeline(ctx, pipeline))
+	})
+}
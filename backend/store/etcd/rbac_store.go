@@ -2,11 +2,11 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"path"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -53,7 +53,7 @@ func (s *Store) UpdateRole(ctx context.Context, role *types.Role) error {
 		return err
 	}
 
-	roleBytes, err := json.Marshal(role)
+	roleBytes, err := store.Encode(role)
 	if err != nil {
 		return err
 	}
@@ -73,7 +73,7 @@ func unmarshalRole(kvs []*mvccpb.KeyValue) ([]*types.Role, error) {
 	for i, kv := range kvs {
 		role := &types.Role{}
 		rolesArray[i] = role
-		if err := json.Unmarshal(kv.Value, role); err != nil {
+		if err := store.Decode(kv.Value, role); err != nil {
 			return nil, err
 		}
 	}
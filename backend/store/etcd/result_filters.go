@@ -1,6 +1,8 @@
 package etcd
 
 import (
+	"context"
+
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -20,3 +22,14 @@ func rejectByEnvironment(ns store.Namespace) func(types.MultitenantResource) boo
 		return r.GetEnvironment() != ns.Env
 	}
 }
+
+// rejectByQueriedEnvironment returns true if a record returned by query is not
+// part of the environment configured in the context. This is the case when
+// the organization is a wildcard but the environment is not.
+func rejectByQueriedEnvironment(ctx context.Context) func(types.MultitenantResource) bool {
+	ns := store.NewNamespaceFromContext(ctx)
+	if ns.Env == "" {
+		ns.Env = store.WildcardValue
+	}
+	return rejectByEnvironment(ns)
+}
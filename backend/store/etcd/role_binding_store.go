@@ -0,0 +1,85 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var (
+	roleBindingsPathPrefix = "rolebindings"
+	roleBindingKeyBuilder  = store.NewKeyBuilder(roleBindingsPathPrefix)
+)
+
+func getRoleBindingPath(binding *types.RoleBinding) string {
+	return roleBindingKeyBuilder.WithResource(binding).Build(binding.Name)
+}
+
+func getRoleBindingsPath(ctx context.Context, name string) string {
+	return roleBindingKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteRoleBindingByName deletes a role binding by name.
+func (s *Store) DeleteRoleBindingByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name of role binding")
+	}
+
+	_, err := s.kvc.Delete(ctx, getRoleBindingsPath(ctx, name))
+	return err
+}
+
+// GetRoleBindings gets the list of role bindings for the organization and
+// environment of the context.
+func (s *Store) GetRoleBindings(ctx context.Context) ([]*types.RoleBinding, error) {
+	resp, err := query(ctx, s, getRoleBindingsPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return []*types.RoleBinding{}, nil
+	}
+
+	reject := rejectByQueriedEnvironment(ctx)
+	bindings := make([]*types.RoleBinding, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		binding := &types.RoleBinding{}
+		if err := store.Decode(kv.Value, binding); err != nil {
+			return nil, err
+		}
+		if !reject(binding) {
+			bindings = append(bindings, binding)
+		}
+	}
+
+	return bindings, nil
+}
+
+// GetRoleBindingByName gets a role binding by name.
+func (s *Store) GetRoleBindingByName(ctx context.Context, name string) (*types.RoleBinding, error) {
+	if name == "" {
+		return nil, errors.New("must specify name of role binding")
+	}
+
+	resp, err := s.kvc.Get(ctx, getRoleBindingsPath(ctx, name))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+
+	binding := &types.RoleBinding{}
+	if err := store.Decode(resp.Kvs[0].Value, binding); err != nil {
+		return nil, err
+	}
+
+	return binding, nil
+}
+
+// UpdateRoleBinding updates a role binding.
+func (s *Store) UpdateRoleBinding(ctx context.Context, binding *types.RoleBinding) error {
+	return updateResource(ctx, s, getRoleBindingPath(binding), binding)
+}
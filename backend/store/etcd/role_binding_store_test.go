@@ -0,0 +1,47 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleBindingStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		binding := types.FixtureRoleBinding("dev-operators", "operator")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, binding.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, binding.Environment)
+
+		// We should receive an empty slice if no results were found
+		bindings, err := store.GetRoleBindings(ctx)
+		assert.NoError(t, err)
+		assert.NotNil(t, bindings)
+
+		require.NoError(t, store.UpdateRoleBinding(ctx, binding))
+
+		retrieved, err := store.GetRoleBindingByName(ctx, "dev-operators")
+		require.NoError(t, err)
+		require.NotNil(t, retrieved)
+		assert.Equal(t, binding.Users, retrieved.Users)
+
+		bindings, err = store.GetRoleBindings(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(bindings))
+
+		require.NoError(t, store.DeleteRoleBindingByName(ctx, "dev-operators"))
+		retrieved, err = store.GetRoleBindingByName(ctx, "dev-operators")
+		assert.NoError(t, err)
+		assert.Nil(t, retrieved)
+
+		// Updating a role binding in a nonexistent org and env should not work
+		binding.Organization = "missing"
+		binding.Environment = "missing"
+		assert.Error(t, store.UpdateRoleBinding(ctx, binding))
+	})
+}
@@ -2,7 +2,6 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -45,7 +44,7 @@ func (s *Store) GetSilencedEntries(ctx context.Context) ([]*types.Silenced, erro
 	if err != nil {
 		return nil, err
 	}
-	silencedArray, err := s.arraySilencedEntries(resp)
+	silencedArray, err := s.arraySilencedEntries(ctx, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +61,7 @@ func (s *Store) GetSilencedEntriesBySubscription(ctx context.Context, subscripti
 		return nil, err
 	}
 
-	silencedArray, err := s.arraySilencedEntries(resp)
+	silencedArray, err := s.arraySilencedEntries(ctx, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +83,7 @@ func (s *Store) GetSilencedEntriesByCheckName(ctx context.Context, checkName str
 	silencedArray := []*types.Silenced{}
 	for _, kv := range resp.Kvs {
 		silencedEntry := &types.Silenced{}
-		err := json.Unmarshal(kv.Value, silencedEntry)
+		err := store.Decode(kv.Value, silencedEntry)
 		if err != nil {
 			return nil, err
 		}
@@ -106,7 +105,7 @@ func (s *Store) GetSilencedEntryByID(ctx context.Context, id string) (*types.Sil
 	if err != nil {
 		return nil, err
 	}
-	silencedArray, err := s.arraySilencedEntries(resp)
+	silencedArray, err := s.arraySilencedEntries(ctx, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +122,7 @@ func (s *Store) UpdateSilencedEntry(ctx context.Context, silenced *types.Silence
 		return err
 	}
 
-	silencedBytes, err := json.Marshal(silenced)
+	silencedBytes, err := store.Encode(silenced)
 	if err != nil {
 		return err
 	}
@@ -168,26 +167,29 @@ func (s *Store) UpdateSilencedEntry(ctx context.Context, silenced *types.Silence
 	return nil
 }
 
-// arraySilencedEntries is a helper function to unmarshal entries from json and return
+// arraySilencedEntries is a helper function to decode the stored entries and return
 // them as an array
-func (s *Store) arraySilencedEntries(resp *clientv3.GetResponse) ([]*types.Silenced, error) {
+func (s *Store) arraySilencedEntries(ctx context.Context, resp *clientv3.GetResponse) ([]*types.Silenced, error) {
 	if len(resp.Kvs) == 0 {
 		return []*types.Silenced{}, nil
 	}
-	silencedArray := make([]*types.Silenced, len(resp.Kvs))
-	for i, kv := range resp.Kvs {
+	reject := rejectByQueriedEnvironment(ctx)
+	silencedArray := make([]*types.Silenced, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
 		leaseID := clientv3.LeaseID(kv.Lease)
-		ttl, err := s.client.TimeToLive(context.TODO(), leaseID)
+		ttl, err := s.client.TimeToLive(ctx, leaseID)
 		if err != nil {
 			return nil, err
 		}
 		silencedEntry := &types.Silenced{}
-		err = json.Unmarshal(kv.Value, silencedEntry)
+		err = store.Decode(kv.Value, silencedEntry)
 		if err != nil {
 			return nil, err
 		}
 		silencedEntry.Expire = ttl.TTL
-		silencedArray[i] = silencedEntry
+		if !reject(silencedEntry) {
+			silencedArray = append(silencedArray, silencedEntry)
+		}
 	}
 	return silencedArray, nil
 }
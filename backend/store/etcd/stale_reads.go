@@ -0,0 +1,21 @@
+package etcd
+
+import (
+	"context"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/types"
+)
+
+// staleReadsKV serves the reads of requests that allow stale data from the
+// local etcd member, so they can succeed while the cluster has no quorum.
+type staleReadsKV struct {
+	clientv3.KV
+}
+
+func (kv staleReadsKV) Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	if stale, _ := ctx.Value(types.StaleReadsKey).(bool); stale {
+		opts = append(opts, clientv3.WithSerializable())
+	}
+	return kv.KV.Get(ctx, key, opts...)
+}
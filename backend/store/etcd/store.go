@@ -4,6 +4,7 @@ import (
 	"path"
 
 	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/encryption"
 	"github.com/sensu/sensu-go/backend/etcd"
 )
 
@@ -19,6 +20,7 @@ type Store struct {
 	etcd   *etcd.Etcd
 
 	keepalivesPath string
+	encrypter      *encryption.Encrypter
 }
 
 // NewStore creates a new Store.
@@ -28,12 +30,18 @@ func NewStore(e *etcd.Etcd) (*Store, error) {
 		return nil, err
 	}
 
+	// Record a trace span for every KV operation of the store, and allow
+	// stale reads while the store is degraded
+	c.KV = staleReadsKV{KV: tracedKV{KV: c.KV}}
+
 	store := &Store{
 		etcd:   e,
 		client: c,
-		kvc:    clientv3.NewKV(c),
+		kvc:    c.KV,
 	}
 
-	store.keepalivesPath = path.Join(EtcdRoot, keepalivesPathPrefix, store.etcd.Name())
+	// Keepalives are shared by all the backends, so any of them can take
+	// over the monitoring of an entity
+	store.keepalivesPath = path.Join(EtcdRoot, keepalivesPathPrefix)
 	return store, nil
 }
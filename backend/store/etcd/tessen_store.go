@@ -0,0 +1,61 @@
+package etcd
+
+import (
+	"context"
+	"path"
+	"strconv"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	tessenPathPrefix = "tessen"
+)
+
+func getTessenConfigPath() string {
+	return path.Join(EtcdRoot, tessenPathPrefix, "config")
+}
+
+// GetClusterID returns the identifier of the etcd cluster, which is shared by
+// all the backends of the Sensu cluster.
+func (s *Store) GetClusterID(ctx context.Context) (string, error) {
+	resp, err := s.client.Cluster.MemberList(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatUint(resp.Header.ClusterId, 16), nil
+}
+
+// GetTessenConfig gets the Tessen configuration, or the default one if none
+// was stored.
+func (s *Store) GetTessenConfig(ctx context.Context) (*types.TessenConfig, error) {
+	resp, err := s.kvc.Get(ctx, getTessenConfigPath(), clientv3.WithLimit(1))
+	if err != nil {
+		return nil, err
+	}
+
+	config := &types.TessenConfig{}
+	if len(resp.Kvs) == 0 {
+		return config, nil
+	}
+
+	if err := store.Decode(resp.Kvs[0].Value, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// UpdateTessenConfig updates the Tessen configuration.
+func (s *Store) UpdateTessenConfig(ctx context.Context, config *types.TessenConfig) error {
+	configBytes, err := store.Encode(config)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.kvc.Put(ctx, getTessenConfigPath(), string(configBytes))
+	return err
+}
@@ -0,0 +1,34 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTessenStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		ctx := context.Background()
+
+		// Reporting is disabled by default
+		config, err := store.GetTessenConfig(ctx)
+		require.NoError(t, err)
+		assert.False(t, config.OptIn)
+
+		err = store.UpdateTessenConfig(ctx, types.FixtureTessenConfig(true))
+		require.NoError(t, err)
+		config, err = store.GetTessenConfig(ctx)
+		require.NoError(t, err)
+		assert.True(t, config.OptIn)
+
+		clusterID, err := store.GetClusterID(ctx)
+		require.NoError(t, err)
+		assert.NotEmpty(t, clusterID)
+	})
+}
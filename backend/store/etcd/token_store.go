@@ -2,11 +2,12 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -14,14 +15,28 @@ func getTokenPath(subject, id string) string {
 	return fmt.Sprintf("%s/tokens/%s/%s", EtcdRoot, subject, id)
 }
 
-// CreateToken creates a Claims.
+// CreateToken creates a Claims. The claims of the tokens which expire are
+// removed from the access list once they expire.
 func (s *Store) CreateToken(claims *types.Claims) error {
-	bytes, err := json.Marshal(claims)
+	bytes, err := store.Encode(claims)
 	if err != nil {
 		return err
 	}
 
-	_, err = s.kvc.Put(context.TODO(), getTokenPath(claims.Subject, claims.Id), string(bytes))
+	var opts []clientv3.OpOption
+	if claims.ExpiresAt > 0 {
+		ttl := claims.ExpiresAt - time.Now().Unix()
+		if ttl < 1 {
+			ttl = 1
+		}
+		lease, err := s.client.Grant(context.TODO(), ttl)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, clientv3.WithLease(lease.ID))
+	}
+
+	_, err = s.kvc.Put(context.TODO(), getTokenPath(claims.Subject, claims.Id), string(bytes), opts...)
 	return err
 }
 
@@ -60,7 +75,7 @@ func (s *Store) GetToken(subject, id string) (*types.Claims, error) {
 	}
 
 	claims := &types.Claims{}
-	err = json.Unmarshal(resp.Kvs[0].Value, claims)
+	err = store.Decode(resp.Kvs[0].Value, claims)
 	if err != nil {
 		return nil, err
 	}
@@ -13,7 +13,7 @@ import (
 func TestTokensStorage(t *testing.T) {
 	testWithEtcd(t, func(store store.Store) {
 		// Generate a dummy access token
-		token, _, _ := jwt.AccessToken("foo")
+		token, _, _ := jwt.AccessToken("foo", nil)
 		claims, _ := jwt.GetClaims(token)
 
 		// Store the access token
@@ -0,0 +1,119 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	tombstonesPathPrefix = "tombstones"
+)
+
+var (
+	tombstonesKeyBuilder = store.NewKeyBuilder(tombstonesPathPrefix)
+)
+
+func getTombstonePath(ctx context.Context, kind, name string) string {
+	return tombstonesKeyBuilder.WithContext(ctx).Build(kind, name)
+}
+
+func getTombstonesPath(ctx context.Context, _ string) string {
+	return tombstonesKeyBuilder.WithContext(ctx).Build("")
+}
+
+// CreateTombstone creates or replaces the tombstone of a deleted resource,
+// which expires after the given number of seconds.
+func (s *Store) CreateTombstone(ctx context.Context, tombstone *types.Tombstone, ttl int64) error {
+	if err := tombstone.Validate(); err != nil {
+		return err
+	}
+
+	tombstoneBytes, err := store.Encode(tombstone)
+	if err != nil {
+		return err
+	}
+
+	lease, err := s.client.Grant(ctx, ttl)
+	if err != nil {
+		return err
+	}
+
+	cmp := clientv3.Compare(clientv3.Version(getEnvironmentsPath(tombstone.Organization, tombstone.Environment)), ">", 0)
+	req := clientv3.OpPut(getTombstonePath(ctx, tombstone.Kind, tombstone.Name), string(tombstoneBytes), clientv3.WithLease(lease.ID))
+	res, err := s.kvc.Txn(ctx).If(cmp).Then(req).Commit()
+	if err != nil {
+		return err
+	}
+	if !res.Succeeded {
+		return fmt.Errorf(
+			"could not create the tombstone of the %s %s in environment %s/%s",
+			tombstone.Kind,
+			tombstone.Name,
+			tombstone.Organization,
+			tombstone.Environment,
+		)
+	}
+
+	return nil
+}
+
+// DeleteTombstone deletes the tombstone of the resource of the given kind and
+// name.
+func (s *Store) DeleteTombstone(ctx context.Context, kind, name string) error {
+	if kind == "" || name == "" {
+		return errors.New("must specify kind and name")
+	}
+
+	_, err := s.kvc.Delete(ctx, getTombstonePath(ctx, kind, name))
+	return err
+}
+
+// GetTombstone gets the tombstone of the resource of the given kind and name.
+// A nil tombstone is returned if none was found.
+func (s *Store) GetTombstone(ctx context.Context, kind, name string) (*types.Tombstone, error) {
+	if kind == "" || name == "" {
+		return nil, errors.New("must specify kind and name")
+	}
+
+	resp, err := s.kvc.Get(ctx, getTombstonePath(ctx, kind, name), clientv3.WithLimit(1))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+
+	tombstone := &types.Tombstone{}
+	if err := store.Decode(resp.Kvs[0].Value, tombstone); err != nil {
+		return nil, err
+	}
+
+	return tombstone, nil
+}
+
+// GetTombstones gets the tombstones.
+func (s *Store) GetTombstones(ctx context.Context) ([]*types.Tombstone, error) {
+	resp, err := query(ctx, s, getTombstonesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	reject := rejectByQueriedEnvironment(ctx)
+	tombstones := make([]*types.Tombstone, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		tombstone := &types.Tombstone{}
+		if err := store.Decode(kv.Value, tombstone); err != nil {
+			return nil, err
+		}
+		if !reject(tombstone) {
+			tombstones = append(tombstones, tombstone)
+		}
+	}
+
+	return tombstones, nil
+}
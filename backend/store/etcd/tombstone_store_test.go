@@ -0,0 +1,45 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTombstoneStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		tombstone := types.FixtureTombstone("check1")
+		ctx := context.WithValue(context.Background(), types.OrganizationKey, tombstone.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, tombstone.Environment)
+
+		tombstones, err := store.GetTombstones(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, tombstones)
+
+		require.NoError(t, store.CreateTombstone(ctx, tombstone, 60))
+
+		tombstones, err = store.GetTombstones(ctx)
+		require.NoError(t, err)
+		assert.Len(t, tombstones, 1)
+
+		retrieved, err := store.GetTombstone(ctx, types.TombstoneKindCheck, "check1")
+		require.NoError(t, err)
+		require.NotNil(t, retrieved)
+		assert.Equal(t, tombstone.Resource, retrieved.Resource)
+
+		require.NoError(t, store.DeleteTombstone(ctx, types.TombstoneKindCheck, "check1"))
+		retrieved, err = store.GetTombstone(ctx, types.TombstoneKindCheck, "check1")
+		require.NoError(t, err)
+		assert.Nil(t, retrieved)
+
+		// The environment of the tombstone must exist
+		tombstone.Environment = "missing"
+		assert.Error(t, store.CreateTombstone(ctx, tombstone, 60))
+	})
+}
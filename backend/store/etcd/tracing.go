@@ -0,0 +1,84 @@
+package etcd
+
+import (
+	"context"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/util/tracing"
+	"go.opencensus.io/trace"
+)
+
+// tracedKV records a trace span for every operation of the wrapped KV.
+type tracedKV struct {
+	clientv3.KV
+}
+
+func startStoreSpan(ctx context.Context, op, key string) (context.Context, *trace.Span) {
+	ctx, span := trace.StartSpan(ctx, "store."+op)
+	if key != "" {
+		span.AddAttributes(trace.StringAttribute("key", key))
+	}
+	return ctx, span
+}
+
+func (kv tracedKV) Put(ctx context.Context, key, val string, opts ...clientv3.OpOption) (*clientv3.PutResponse, error) {
+	ctx, span := startStoreSpan(ctx, "Put", key)
+	defer span.End()
+	resp, err := kv.KV.Put(ctx, key, val, opts...)
+	tracing.SetError(span, err)
+	return resp, err
+}
+
+func (kv tracedKV) Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	ctx, span := startStoreSpan(ctx, "Get", key)
+	defer span.End()
+	resp, err := kv.KV.Get(ctx, key, opts...)
+	tracing.SetError(span, err)
+	return resp, err
+}
+
+func (kv tracedKV) Delete(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
+	ctx, span := startStoreSpan(ctx, "Delete", key)
+	defer span.End()
+	resp, err := kv.KV.Delete(ctx, key, opts...)
+	tracing.SetError(span, err)
+	return resp, err
+}
+
+func (kv tracedKV) Do(ctx context.Context, op clientv3.Op) (clientv3.OpResponse, error) {
+	ctx, span := startStoreSpan(ctx, "Do", "")
+	defer span.End()
+	resp, err := kv.KV.Do(ctx, op)
+	tracing.SetError(span, err)
+	return resp, err
+}
+
+func (kv tracedKV) Txn(ctx context.Context) clientv3.Txn {
+	return tracedTxn{Txn: kv.KV.Txn(ctx), ctx: ctx}
+}
+
+// tracedTxn records a trace span when the wrapped transaction is committed.
+type tracedTxn struct {
+	clientv3.Txn
+	ctx context.Context
+}
+
+func (t tracedTxn) If(cs ...clientv3.Cmp) clientv3.Txn {
+	return tracedTxn{Txn: t.Txn.If(cs...), ctx: t.ctx}
+}
+
+func (t tracedTxn) Then(ops ...clientv3.Op) clientv3.Txn {
+	return tracedTxn{Txn: t.Txn.Then(ops...), ctx: t.ctx}
+}
+
+func (t tracedTxn) Else(ops ...clientv3.Op) clientv3.Txn {
+	return tracedTxn{Txn: t.Txn.Else(ops...), ctx: t.ctx}
+}
+
+func (t tracedTxn) Commit() (*clientv3.TxnResponse, error) {
+	_, span := startStoreSpan(t.ctx, "Txn", "")
+	defer span.End()
+	resp, err := t.Txn.Commit()
+	tracing.SetError(span, err)
+	return resp, err
+}
@@ -0,0 +1,71 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+func getUserPreferencesPath(username string) string {
+	return fmt.Sprintf("%s/user_preferences/%s", EtcdRoot, username)
+}
+
+// DeleteUserPreferences deletes the preferences of a user.
+func (s *Store) DeleteUserPreferences(ctx context.Context, username string) error {
+	if username == "" {
+		return errors.New("must specify username")
+	}
+
+	_, err := s.kvc.Delete(ctx, getUserPreferencesPath(username))
+	return err
+}
+
+// GetUserPreferences gets the preferences of a user.
+func (s *Store) GetUserPreferences(ctx context.Context, username string) (*types.UserPreferences, error) {
+	if username == "" {
+		return nil, errors.New("must specify username")
+	}
+
+	resp, err := s.kvc.Get(ctx, getUserPreferencesPath(username), clientv3.WithLimit(1))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+
+	preferences := &types.UserPreferences{}
+	if err := store.Decode(resp.Kvs[0].Value, preferences); err != nil {
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+// UpdateUserPreferences updates the preferences of a user, which must exist.
+func (s *Store) UpdateUserPreferences(ctx context.Context, preferences *types.UserPreferences) error {
+	if err := preferences.Validate(); err != nil {
+		return err
+	}
+
+	bytes, err := store.Encode(preferences)
+	if err != nil {
+		return err
+	}
+
+	cmp := clientv3.Compare(clientv3.CreateRevision(getUserPath(preferences.Username)), ">", 0)
+	req := clientv3.OpPut(getUserPreferencesPath(preferences.Username), string(bytes))
+	res, err := s.kvc.Txn(ctx).If(cmp).Then(req).Commit()
+	if err != nil {
+		return err
+	}
+	if !res.Succeeded {
+		return fmt.Errorf("user %s does not exist", preferences.Username)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserPreferencesStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		ctx := context.Background()
+		preferences := types.FixtureUserPreferences("foo")
+
+		// The preferences of a nonexistent user can't be stored
+		assert.Error(t, store.UpdateUserPreferences(ctx, preferences))
+
+		require.NoError(t, store.CreateUser(types.FixtureUser("foo")))
+		retrieved, err := store.GetUserPreferences(ctx, "foo")
+		require.NoError(t, err)
+		assert.Nil(t, retrieved)
+
+		require.NoError(t, store.UpdateUserPreferences(ctx, preferences))
+		retrieved, err = store.GetUserPreferences(ctx, "foo")
+		require.NoError(t, err)
+		assert.Equal(t, preferences, retrieved)
+
+		require.NoError(t, store.DeleteUserPreferences(ctx, "foo"))
+		retrieved, err = store.GetUserPreferences(ctx, "foo")
+		assert.NoError(t, err)
+		assert.Nil(t, retrieved)
+	})
+}
@@ -2,12 +2,12 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -45,7 +45,7 @@ func (s *Store) CreateUser(u *types.User) error {
 	}
 	u.Password = hash
 
-	userBytes, err := json.Marshal(u)
+	userBytes, err := store.Encode(u)
 	if err != nil {
 		return err
 	}
@@ -75,7 +75,7 @@ func (s *Store) DeleteUser(ctx context.Context, user *types.User) error {
 	user.Disabled = true
 
 	// Marshal the user struct
-	userBytes, err := json.Marshal(user)
+	userBytes, err := store.Encode(user)
 	if err != nil {
 		return err
 	}
@@ -100,7 +100,7 @@ func (s *Store) DeleteUser(ctx context.Context, user *types.User) error {
 	}
 
 	if !res.Succeeded {
-		logger.
+		loggerWithContext(ctx).
 			WithField("username", user.Username).
 			Info("given user was not already persisted")
 	}
@@ -119,7 +119,7 @@ func (s *Store) GetUser(ctx context.Context, username string) (*types.User, erro
 	}
 
 	user := &types.User{}
-	err = json.Unmarshal(resp.Kvs[0].Value, user)
+	err = store.Decode(resp.Kvs[0].Value, user)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +158,7 @@ func (s *Store) GetAllUsers() ([]*types.User, error) {
 	usersArray := []*types.User{}
 	for _, kv := range resp.Kvs {
 		user := &types.User{}
-		err = json.Unmarshal(kv.Value, user)
+		err = store.Decode(kv.Value, user)
 		if err != nil {
 			return nil, err
 		}
@@ -178,7 +178,7 @@ func (s *Store) UpdateUser(u *types.User) error {
 	}
 	u.Password = hash
 
-	bytes, err := json.Marshal(u)
+	bytes, err := store.Encode(u)
 	if err != nil {
 		return err
 	}
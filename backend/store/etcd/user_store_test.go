@@ -70,7 +70,7 @@ func TestUserStorage(t *testing.T) {
 		assert.Equal(t, 2, len(users))
 
 		// Generate a token for the bar user
-		token, _, _ := jwt.AccessToken("bar")
+		token, _, _ := jwt.AccessToken("bar", nil)
 		claims, _ := jwt.GetClaims(token)
 		err = store.CreateToken(claims)
 		assert.NoError(t, err)
@@ -2,7 +2,6 @@ package etcd
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/mvcc/mvccpb"
@@ -24,6 +23,15 @@ func getWatcherAction(event *clientv3.Event) store.WatchActionType {
 	return store.WatchUnknown
 }
 
+// getWatchedValue returns the value of the key of the event, or its previous
+// value if it was deleted and the watch was created with WithPrevKV.
+func getWatchedValue(event *clientv3.Event) []byte {
+	if event.Type == mvccpb.DELETE && event.PrevKv != nil {
+		return event.PrevKv.Value
+	}
+	return event.Kv.Value
+}
+
 // GetCheckConfigWatcher returns a channel that emits WatchEventCheckConfig structs notifying
 // the caller that a CheckConfig was updated. If the watcher runs into a terminal error
 // or the context passed is cancelled, then the channel will be closed. The caller must
@@ -33,7 +41,7 @@ func (s *Store) GetCheckConfigWatcher(ctx context.Context) <-chan store.WatchEve
 
 	go func() {
 		watcher := clientv3.NewWatcher(s.client)
-		watcherChan := watcher.Watch(ctx, checkKeyBuilder.Build(""), clientv3.WithPrefix(), clientv3.WithCreatedNotify())
+		watcherChan := watcher.Watch(ctx, checkKeyBuilder.Build(""), clientv3.WithPrefix(), clientv3.WithCreatedNotify(), clientv3.WithPrevKV())
 		defer close(ch)
 
 		var (
@@ -50,7 +58,7 @@ func (s *Store) GetCheckConfigWatcher(ctx context.Context) <-chan store.WatchEve
 				}
 
 				checkConfig = &types.CheckConfig{}
-				if err := json.Unmarshal(event.Kv.Value, checkConfig); err != nil {
+				if err := store.Decode(getWatchedValue(event), checkConfig); err != nil {
 					logger.WithError(err).Error("unable to unmarshal check config from key: ", event.Kv.Key)
 				}
 
@@ -93,7 +101,7 @@ func (s *Store) GetAssetWatcher(ctx context.Context) <-chan store.WatchEventAsse
 				}
 
 				asset = &types.Asset{}
-				if err := json.Unmarshal(event.Kv.Value, asset); err != nil {
+				if err := store.Decode(event.Kv.Value, asset); err != nil {
 					logger.WithError(err).Error("unable to unmarshal check config from key: ", event.Kv.Key)
 				}
 
@@ -136,7 +144,7 @@ func (s *Store) GetHookConfigWatcher(ctx context.Context) <-chan store.WatchEven
 				}
 
 				hookCfg = &types.HookConfig{}
-				if err := json.Unmarshal(event.Kv.Value, hookCfg); err != nil {
+				if err := store.Decode(event.Kv.Value, hookCfg); err != nil {
 					logger.WithError(err).Error("unable to unmarshal check config from key: ", event.Kv.Key)
 				}
 
@@ -152,3 +160,188 @@ func (s *Store) GetHookConfigWatcher(ctx context.Context) <-chan store.WatchEven
 
 	return ch
 }
+
+// GetSilencedWatcher returns a channel that emits WatchEventSilenced structs
+// notifying the caller that a silenced entry was created, updated or deleted,
+// including when it expired. If the watcher runs into a terminal error or the
+// context passed is cancelled, then the channel will be closed. The caller
+// must restart the watcher, if needed.
+func (s *Store) GetSilencedWatcher(ctx context.Context) <-chan store.WatchEventSilenced {
+	ch := make(chan store.WatchEventSilenced)
+
+	go func() {
+		watcher := clientv3.NewWatcher(s.client)
+		watcherChan := watcher.Watch(ctx, silencedKeyBuilder.Build(""), clientv3.WithPrefix(), clientv3.WithCreatedNotify(), clientv3.WithPrevKV())
+		defer close(ch)
+
+		for watchResponse := range watcherChan {
+			for _, event := range watchResponse.Events {
+				action := getWatcherAction(event)
+				if action == store.WatchUnknown {
+					logger.Error("unknown etcd watch action: ", event.Type.String())
+				}
+
+				silenced := &types.Silenced{}
+				if err := store.Decode(getWatchedValue(event), silenced); err != nil {
+					logger.WithError(err).Error("unable to unmarshal silenced entry from key: ", event.Kv.Key)
+				}
+
+				select {
+				case ch <- store.WatchEventSilenced{Action: action, Silenced: silenced}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch
+}
+
+// GetUserWatcher returns a channel that emits WatchEventUser structs notifying
+// the caller that a user was created or updated, the users being disabled
+// rather than deleted. If the watcher runs into a terminal error or the
+// context passed is cancelled, then the channel will be closed. The caller
+// must restart the watcher, if needed.
+func (s *Store) GetUserWatcher(ctx context.Context) <-chan store.WatchEventUser {
+	ch := make(chan store.WatchEventUser)
+
+	go func() {
+		watcher := clientv3.NewWatcher(s.client)
+		watcherChan := watcher.Watch(ctx, getUserPath(""), clientv3.WithPrefix(), clientv3.WithCreatedNotify(), clientv3.WithPrevKV())
+		defer close(ch)
+
+		for watchResponse := range watcherChan {
+			for _, event := range watchResponse.Events {
+				action := getWatcherAction(event)
+				if action == store.WatchUnknown {
+					logger.Error("unknown etcd watch action: ", event.Type.String())
+				}
+
+				user := &types.User{}
+				if err := store.Decode(getWatchedValue(event), user); err != nil {
+					logger.WithError(err).Error("unable to unmarshal user from key: ", event.Kv.Key)
+				}
+
+				select {
+				case ch <- store.WatchEventUser{Action: action, User: user}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch
+}
+
+// GetClusterConfigWatcher returns a channel that emits WatchEventClusterConfig
+// structs notifying the caller that the cluster-wide defaults, or those of an
+// organization, were updated. If the watcher runs into a terminal error or the
+// context passed is cancelled, then the channel will be closed. The caller must
+// restart the watcher, if needed.
+func (s *Store) GetClusterConfigWatcher(ctx context.Context) <-chan store.WatchEventClusterConfig {
+	ch := make(chan store.WatchEventClusterConfig)
+
+	go func() {
+		watcher := clientv3.NewWatcher(s.client)
+		watcherChan := watcher.Watch(ctx, getClusterConfigPath(), clientv3.WithPrefix(), clientv3.WithCreatedNotify())
+		defer close(ch)
+
+		for watchResponse := range watcherChan {
+			for _, event := range watchResponse.Events {
+				action := getWatcherAction(event)
+				if action == store.WatchUnknown {
+					logger.Error("unknown etcd watch action: ", event.Type.String())
+				}
+
+				config := &types.ClusterConfig{}
+				if action != store.WatchDelete {
+					if err := store.Decode(event.Kv.Value, config); err != nil {
+						logger.WithError(err).Error("unable to unmarshal cluster config from key: ", event.Kv.Key)
+					}
+				}
+
+				select {
+				case ch <- store.WatchEventClusterConfig{Action: action, ClusterConfig: config, Organization: getClusterConfigOrganization(string(event.Kv.Key))}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch
+}
+
+// GetOrganizationWatcher returns a channel that emits WatchEventOrganization structs
+// notifying the caller that an organization was created, updated or deleted. If the
+// watcher runs into a terminal error or the context passed is cancelled, then
+// the channel will be closed. The caller must restart the watcher, if needed.
+func (s *Store) GetOrganizationWatcher(ctx context.Context) <-chan store.WatchEventOrganization {
+	ch := make(chan store.WatchEventOrganization)
+
+	go func() {
+		watcher := clientv3.NewWatcher(s.client)
+		watcherChan := watcher.Watch(ctx, getOrganizationsPath("")+"/", clientv3.WithPrefix(), clientv3.WithCreatedNotify(), clientv3.WithPrevKV())
+		defer close(ch)
+
+		for watchResponse := range watcherChan {
+			for _, event := range watchResponse.Events {
+				action := getWatcherAction(event)
+				if action == store.WatchUnknown {
+					logger.Error("unknown etcd watch action: ", event.Type.String())
+				}
+
+				org := &types.Organization{}
+				if err := store.Decode(getWatchedValue(event), org); err != nil {
+					logger.WithError(err).Error("unable to unmarshal organization from key: ", event.Kv.Key)
+				}
+
+				select {
+				case ch <- store.WatchEventOrganization{Action: action, Organization: org}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch
+}
+
+// GetEnvironmentWatcher returns a channel that emits WatchEventEnvironment structs
+// notifying the caller that an environment was created, updated or deleted. If the
+// watcher runs into a terminal error or the context passed is cancelled, then
+// the channel will be closed. The caller must restart the watcher, if needed.
+func (s *Store) GetEnvironmentWatcher(ctx context.Context) <-chan store.WatchEventEnvironment {
+	ch := make(chan store.WatchEventEnvironment)
+
+	go func() {
+		watcher := clientv3.NewWatcher(s.client)
+		watcherChan := watcher.Watch(ctx, environmentKeyBuilder.Build()+"/", clientv3.WithPrefix(), clientv3.WithCreatedNotify(), clientv3.WithPrevKV())
+		defer close(ch)
+
+		for watchResponse := range watcherChan {
+			for _, event := range watchResponse.Events {
+				action := getWatcherAction(event)
+				if action == store.WatchUnknown {
+					logger.Error("unknown etcd watch action: ", event.Type.String())
+				}
+
+				env := &types.Environment{}
+				if err := store.Decode(getWatchedValue(event), env); err != nil {
+					logger.WithError(err).Error("unable to unmarshal environment from key: ", event.Kv.Key)
+				}
+
+				select {
+				case ch <- store.WatchEventEnvironment{Action: action, Environment: env}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch
+}
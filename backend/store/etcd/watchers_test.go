@@ -118,3 +118,63 @@ func TestHookConfigWatcher(t *testing.T) {
 		}
 	})
 }
+
+func TestSilencedWatcher(t *testing.T) {
+	t.Parallel()
+
+	testWithEtcd(t, func(st store.Store) {
+		silenced := types.FixtureSilenced("*:check_cpu")
+		silenced.Organization = "default"
+		silenced.Environment = "default"
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ctx = context.WithValue(ctx, types.OrganizationKey, silenced.Organization)
+		ctx = context.WithValue(ctx, types.EnvironmentKey, silenced.Environment)
+
+		watchChan := st.GetSilencedWatcher(ctx)
+		require.NotNil(t, watchChan)
+
+		require.NoError(t, st.UpdateSilencedEntry(ctx, silenced))
+		require.NoError(t, st.DeleteSilencedEntryByID(ctx, silenced.ID))
+
+		// The deleted entry is decoded from its previous value
+		for _, action := range []store.WatchActionType{store.WatchCreate, store.WatchDelete} {
+			select {
+			case ev := <-watchChan:
+				assert.Equal(t, action, ev.Action)
+				assert.Equal(t, silenced.ID, ev.Silenced.ID)
+			case <-time.After(10 * time.Second):
+				assert.Fail(t, "failed to receive a watch event in 10 seconds")
+			}
+		}
+	})
+}
+
+func TestUserWatcher(t *testing.T) {
+	t.Parallel()
+
+	testWithEtcd(t, func(st store.Store) {
+		user := types.FixtureUser("watched")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		watchChan := st.GetUserWatcher(ctx)
+		require.NotNil(t, watchChan)
+
+		require.NoError(t, st.CreateUser(user))
+		require.NoError(t, st.DeleteUser(ctx, user))
+
+		// The deleted users are disabled
+		for _, action := range []store.WatchActionType{store.WatchCreate, store.WatchUpdate} {
+			select {
+			case ev := <-watchChan:
+				assert.Equal(t, action, ev.Action)
+				assert.Equal(t, user.Username, ev.User.Username)
+			case <-time.After(10 * time.Second):
+				assert.Fail(t, "failed to receive a watch event in 10 seconds")
+			}
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	assetsPathPrefix = "assets"
+)
+
+var (
+	assetKeyBuilder = store.NewKeyBuilder(assetsPathPrefix)
+)
+
+func getAssetPath(asset *types.Asset) string {
+	return assetKeyBuilder.WithResource(asset).Build(asset.Name)
+}
+
+func getAssetsPath(ctx context.Context, name string) string {
+	return assetKeyBuilder.WithOrg(store.NewNamespaceFromContext(ctx).Org).Build(name)
+}
+
+// DeleteAssetByName deletes an asset by name.
+func (s *Store) DeleteAssetByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name")
+	}
+
+	s.deleteResource(getAssetsPath(ctx, name))
+	return nil
+}
+
+// GetAssets fetches all assets from the store
+func (s *Store) GetAssets(ctx context.Context) ([]*types.Asset, error) {
+	// The assets belong to an organization only
+	ctx = context.WithValue(ctx, types.EnvironmentKey, "")
+
+	var assets []*types.Asset
+	err := s.query(ctx, assetKeyBuilder, func(value []byte) error {
+		asset := &types.Asset{}
+		if err := store.Decode(value, asset); err != nil {
+			return err
+		}
+		assets = append(assets, asset)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return assets, nil
+}
+
+// GetAssetByName gets an Asset by name.
+func (s *Store) GetAssetByName(ctx context.Context, name string) (*types.Asset, error) {
+	if name == "" {
+		return nil, errors.New("must specify organization and name")
+	}
+
+	asset := &types.Asset{}
+	if ok, err := s.getResource(getAssetsPath(ctx, name), asset); !ok || err != nil {
+		return nil, err
+	}
+
+	return asset, nil
+}
+
+// UpdateAsset updates an asset.
+func (s *Store) UpdateAsset(ctx context.Context, asset *types.Asset) error {
+	return s.updateResource(getAssetPath(asset), asset)
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	checksPathPrefix = "checks"
+)
+
+var (
+	checkKeyBuilder = store.NewKeyBuilder(checksPathPrefix)
+)
+
+func getCheckConfigPath(check *types.CheckConfig) string {
+	return checkKeyBuilder.WithResource(check).Build(check.Name)
+}
+
+func getCheckConfigsPath(ctx context.Context, name string) string {
+	return checkKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteCheckConfigByName deletes a check configuration by name.
+func (s *Store) DeleteCheckConfigByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name")
+	}
+
+	s.deleteResource(getCheckConfigsPath(ctx, name))
+	return nil
+}
+
+// GetCheckConfigs gets the list of check configurations for the organization and environment
+// of the context.
+func (s *Store) GetCheckConfigs(ctx context.Context) ([]*types.CheckConfig, error) {
+	reject := rejectByQueriedEnvironment(ctx)
+	checks := []*types.CheckConfig{}
+	err := s.query(ctx, checkKeyBuilder, func(value []byte) error {
+		check := &types.CheckConfig{}
+		if err := store.Decode(value, check); err != nil {
+			return err
+		}
+		if !reject(check) {
+			checks = append(checks, check)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
+// GetCheckConfigByName gets a check configuration by name.
+func (s *Store) GetCheckConfigByName(ctx context.Context, name string) (*types.CheckConfig, error) {
+	if name == "" {
+		return nil, errors.New("must specify name")
+	}
+
+	check := &types.CheckConfig{}
+	if ok, err := s.getResource(getCheckConfigsPath(ctx, name), check); !ok || err != nil {
+		return nil, err
+	}
+
+	return check, nil
+}
+
+// UpdateCheckConfig updates a check configuration.
+func (s *Store) UpdateCheckConfig(ctx context.Context, check *types.CheckConfig) error {
+	return s.updateResource(getCheckConfigPath(check), check)
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	checkTemplatesPathPrefix = "checktemplates"
+)
+
+var (
+	checkTemplateKeyBuilder = store.NewKeyBuilder(checkTemplatesPathPrefix)
+)
+
+func getCheckTemplatePath(tmpl *types.CheckTemplate) string {
+	return checkTemplateKeyBuilder.WithResource(tmpl).Build(tmpl.Name)
+}
+
+func getCheckTemplatesPath(ctx context.Context, name string) string {
+	return checkTemplateKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteCheckTemplateByName deletes a check template by name.
+func (s *Store) DeleteCheckTemplateByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name of check template")
+	}
+
+	s.deleteResource(getCheckTemplatesPath(ctx, name))
+	return nil
+}
+
+// GetCheckTemplates gets the list of check templates for the organization and environment
+// of the context.
+func (s *Store) GetCheckTemplates(ctx context.Context) ([]*types.CheckTemplate, error) {
+	reject := rejectByQueriedEnvironment(ctx)
+	checkTemplates := []*types.CheckTemplate{}
+	err := s.query(ctx, checkTemplateKeyBuilder, func(value []byte) error {
+		tmpl := &types.CheckTemplate{}
+		if err := store.Decode(value, tmpl); err != nil {
+			return err
+		}
+		if !reject(tmpl) {
+			checkTemplates = append(checkTemplates, tmpl)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return checkTemplates, nil
+}
+
+// GetCheckTemplateByName gets a check template by name.
+func (s *Store) GetCheckTemplateByName(ctx context.Context, name string) (*types.CheckTemplate, error) {
+	if name == "" {
+		return nil, errors.New("must specify name of check template")
+	}
+
+	tmpl := &types.CheckTemplate{}
+	if ok, err := s.getResource(getCheckTemplatesPath(ctx, name), tmpl); !ok || err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+// UpdateCheckTemplate updates a check template.
+func (s *Store) UpdateCheckTemplate(ctx context.Context, tmpl *types.CheckTemplate) error {
+	return s.updateResource(getCheckTemplatePath(tmpl), tmpl)
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	clusterConfigPathPrefix = "cluster"
+	tessenPathPrefix        = "tessen"
+)
+
+func getClusterConfigPath() string {
+	return path.Join(store.Root, clusterConfigPathPrefix, "config")
+}
+
+// getOrganizationConfigPath returns the key of the defaults of an
+// organization, under the key of the cluster-wide defaults so they are watched
+// together.
+func getOrganizationConfigPath(org string) string {
+	return path.Join(getClusterConfigPath(), org)
+}
+
+// getClusterConfigOrganization returns the organization of the defaults
+// stored at the key, or an empty string for the cluster-wide defaults.
+func getClusterConfigOrganization(key string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(key, getClusterConfigPath()), "/")
+}
+
+func getTessenConfigPath() string {
+	return path.Join(store.Root, tessenPathPrefix, "config")
+}
+
+// GetClusterConfig gets the cluster-wide defaults, or empty defaults if none
+// were stored.
+func (s *Store) GetClusterConfig(ctx context.Context) (*types.ClusterConfig, error) {
+	config := &types.ClusterConfig{}
+	if _, err := s.getResource(getClusterConfigPath(), config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// UpdateClusterConfig updates the cluster-wide defaults.
+func (s *Store) UpdateClusterConfig(ctx context.Context, config *types.ClusterConfig) error {
+	return s.putValue(getClusterConfigPath(), config)
+}
+
+// GetOrganizationConfig gets the defaults of an organization, or empty
+// defaults if none were stored.
+func (s *Store) GetOrganizationConfig(ctx context.Context, org string) (*types.ClusterConfig, error) {
+	if org == "" {
+		return nil, errors.New("must specify organization")
+	}
+
+	config := &types.ClusterConfig{}
+	if _, err := s.getResource(getOrganizationConfigPath(org), config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// UpdateOrganizationConfig updates the defaults of an organization.
+func (s *Store) UpdateOrganizationConfig(ctx context.Context, org string, config *types.ClusterConfig) error {
+	if org == "" {
+		return errors.New("must specify organization")
+	}
+	return s.putValue(getOrganizationConfigPath(org), config)
+}
+
+// GetTessenConfig gets the Tessen configuration, or the default one if none
+// was stored.
+func (s *Store) GetTessenConfig(ctx context.Context) (*types.TessenConfig, error) {
+	config := &types.TessenConfig{}
+	if _, err := s.getResource(getTessenConfigPath(), config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// UpdateTessenConfig updates the Tessen configuration.
+func (s *Store) UpdateTessenConfig(ctx context.Context, config *types.TessenConfig) error {
+	return s.putValue(getTessenConfigPath(), config)
+}
+
+// putValue encodes and stores a value which doesn't belong to a namespace.
+func (s *Store) putValue(key string, v interface{}) error {
+	bytes, err := store.Encode(v)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(key, bytes, 0)
+	return nil
+}
@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+var contactCounterKeyBuilder = store.NewKeyBuilder("contact-counters")
+
+// IncrementContactCounter increments the number of executions of the handler
+// for the contact during the current window, and returns the new count. The
+// counter of each window expires with it.
+func (s *Store) IncrementContactCounter(ctx context.Context, handler, contact string, window time.Duration) (int64, error) {
+	if handler == "" || contact == "" {
+		return 0, errors.New("must specify handler and contact")
+	}
+
+	seconds := int64(window / time.Second)
+	if seconds <= 0 {
+		return 0, errors.New("the window must be at least one second")
+	}
+	now := time.Now()
+	start := now.Unix() / seconds * seconds
+	key := contactCounterKeyBuilder.WithContext(ctx).Build(handler, contact, strconv.FormatInt(start, 10))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var count int64
+	if value, ok := s.get(key); ok {
+		count, _ = strconv.ParseInt(string(value), 10, 64)
+	}
+	count++
+	s.put(key, []byte(strconv.FormatInt(count, 10)), time.Unix(start+seconds, 0).Sub(now))
+
+	return count, nil
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	entityGroupsPathPrefix = "entitygroups"
+)
+
+var (
+	entityGroupKeyBuilder = store.NewKeyBuilder(entityGroupsPathPrefix)
+)
+
+func getEntityGroupPath(group *types.EntityGroup) string {
+	return entityGroupKeyBuilder.WithResource(group).Build(group.Name)
+}
+
+func getEntityGroupsPath(ctx context.Context, name string) string {
+	return entityGroupKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteEntityGroupByName deletes a entity group by name.
+func (s *Store) DeleteEntityGroupByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name of entity group")
+	}
+
+	s.deleteResource(getEntityGroupsPath(ctx, name))
+	return nil
+}
+
+// GetEntityGroups gets the list of entity groups for the organization and environment
+// of the context.
+func (s *Store) GetEntityGroups(ctx context.Context) ([]*types.EntityGroup, error) {
+	reject := rejectByQueriedEnvironment(ctx)
+	entityGroups := []*types.EntityGroup{}
+	err := s.query(ctx, entityGroupKeyBuilder, func(value []byte) error {
+		group := &types.EntityGroup{}
+		if err := store.Decode(value, group); err != nil {
+			return err
+		}
+		if !reject(group) {
+			entityGroups = append(entityGroups, group)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entityGroups, nil
+}
+
+// GetEntityGroupByName gets a entity group by name.
+func (s *Store) GetEntityGroupByName(ctx context.Context, name string) (*types.EntityGroup, error) {
+	if name == "" {
+		return nil, errors.New("must specify name of entity group")
+	}
+
+	group := &types.EntityGroup{}
+	if ok, err := s.getResource(getEntityGroupsPath(ctx, name), group); !ok || err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// UpdateEntityGroup updates a entity group.
+func (s *Store) UpdateEntityGroup(ctx context.Context, group *types.EntityGroup) error {
+	return s.updateResource(getEntityGroupPath(group), group)
+}
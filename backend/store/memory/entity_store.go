@@ -0,0 +1,193 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	entityPathPrefix         = "entities"
+	entityStatusesPathPrefix = "entitystatuses"
+)
+
+var (
+	entityKeyBuilder       = store.NewKeyBuilder(entityPathPrefix)
+	entityStatusKeyBuilder = store.NewKeyBuilder(entityStatusesPathPrefix)
+)
+
+func getEntityPath(entity *types.Entity) string {
+	return entityKeyBuilder.WithResource(entity).Build(entity.ID)
+}
+
+func getEntitiesPath(ctx context.Context, id string) string {
+	return entityKeyBuilder.WithContext(ctx).Build(id)
+}
+
+// DeleteEntity deletes an Entity.
+func (s *Store) DeleteEntity(ctx context.Context, e *types.Entity) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+	s.deleteEntity(getEntityPath(e), entityStatusKeyBuilder.WithResource(e).Build(e.ID))
+	return nil
+}
+
+// DeleteEntityByID deletes an Entity by its ID.
+func (s *Store) DeleteEntityByID(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("must specify id")
+	}
+	s.deleteEntity(getEntitiesPath(ctx, id), getEntityStatusesPath(ctx, id))
+	return nil
+}
+
+// deleteEntity deletes the entity stored at key along with the status of its
+// agent.
+func (s *Store) deleteEntity(key, statusKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.delete(key) {
+		s.delete(statusKey)
+	}
+}
+
+// GetEntityByID gets an Entity by ID.
+func (s *Store) GetEntityByID(ctx context.Context, id string) (*types.Entity, error) {
+	if id == "" {
+		return nil, errors.New("must specify id")
+	}
+
+	entity := &types.Entity{}
+	if ok, err := s.getResource(getEntitiesPath(ctx, id), entity); !ok || err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// GetEntities gets the entities of the organization and environment of the
+// context.
+func (s *Store) GetEntities(ctx context.Context) ([]*types.Entity, error) {
+	return s.getEntities(ctx, func(*types.Entity) bool { return true })
+}
+
+// GetEntitiesByIndex gets the entities having the given value in the index.
+// The entities are not actually indexed in memory, so they are all scanned.
+func (s *Store) GetEntitiesByIndex(ctx context.Context, index store.EntityIndex, value string) ([]*types.Entity, error) {
+	return s.getEntities(ctx, func(entity *types.Entity) bool {
+		switch index {
+		case store.EntityIndexClass:
+			return entity.Class == value
+		case store.EntityIndexSubscription:
+			for _, subscription := range entity.Subscriptions {
+				if subscription == value {
+					return true
+				}
+			}
+		case store.EntityIndexLabel:
+			for k, v := range entity.Labels {
+				if store.LabelIndexValue(k, v) == value {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+// getEntities gets the entities of the organization and environment of the
+// context which match.
+func (s *Store) getEntities(ctx context.Context, match func(*types.Entity) bool) ([]*types.Entity, error) {
+	reject := rejectByQueriedEnvironment(ctx)
+	entities := []*types.Entity{}
+	err := s.query(ctx, entityKeyBuilder, func(value []byte) error {
+		entity := &types.Entity{}
+		if err := store.Decode(value, entity); err != nil {
+			return err
+		}
+		if !reject(entity) && match(entity) {
+			entities = append(entities, entity)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+// UpdateEntity updates an Entity.
+func (s *Store) UpdateEntity(ctx context.Context, e *types.Entity) error {
+	return s.updateResource(getEntityPath(e), e)
+}
+
+func getEntityStatusPath(status *types.EntityStatus) string {
+	return entityStatusKeyBuilder.WithResource(status).Build(status.EntityID)
+}
+
+func getEntityStatusesPath(ctx context.Context, id string) string {
+	return entityStatusKeyBuilder.WithContext(ctx).Build(id)
+}
+
+// GetEntityStatus gets the connection status of the agent of an entity.
+func (s *Store) GetEntityStatus(ctx context.Context, id string) (*types.EntityStatus, error) {
+	if id == "" {
+		return nil, errors.New("must specify id")
+	}
+
+	status := &types.EntityStatus{}
+	if ok, err := s.getResource(getEntityStatusesPath(ctx, id), status); !ok || err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// UpdateEntityStatus updates the connection status of the agent of an
+// entity.
+func (s *Store) UpdateEntityStatus(ctx context.Context, status *types.EntityStatus) error {
+	if err := status.Validate(); err != nil {
+		return err
+	}
+
+	bytes, err := store.Encode(status)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(getEntityStatusPath(status), bytes, 0)
+	return nil
+}
+
+// DisconnectEntityStatus updates the connection status of a disconnected
+// agent, unless the stored status belongs to another session.
+func (s *Store) DisconnectEntityStatus(ctx context.Context, status *types.EntityStatus) error {
+	if err := status.Validate(); err != nil {
+		return err
+	}
+
+	bytes, err := store.Encode(status)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := getEntityStatusPath(status)
+	if value, ok := s.get(key); ok {
+		current := &types.EntityStatus{}
+		if err := store.Decode(value, current); err != nil {
+			return err
+		}
+		if current.Session != status.Session {
+			return nil
+		}
+	}
+	s.put(key, bytes, 0)
+	return nil
+}
@@ -0,0 +1,127 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	environmentsPathPrefix = "environments"
+)
+
+var (
+	environmentKeyBuilder = store.NewKeyBuilder(environmentsPathPrefix)
+)
+
+func getEnvironmentPath(org, env string) string {
+	return environmentKeyBuilder.WithOrg(org).Build(env)
+}
+
+// DeleteEnvironment deletes an environment
+func (s *Store) DeleteEnvironment(ctx context.Context, env *types.Environment) error {
+	if err := env.Validate(); err != nil {
+		return err
+	}
+
+	org := env.Organization
+	ns := store.Namespace{Org: org, Env: env.Name}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Validate whether there are any resources referencing the environment
+	for _, kb := range []store.KeyBuilder{
+		checkKeyBuilder,
+		entityKeyBuilder,
+		assetKeyBuilder,
+		handlerKeyBuilder,
+		mutatorKeyBuilder,
+	} {
+		if s.count(kb.WithNamespace(ns).Build()+"/") > 0 {
+			return errors.New("environment is not empty")
+		}
+	}
+
+	// Validate that there are no roles referencing the environment
+	roles, err := s.getRoles()
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		for _, rule := range role.Rules {
+			if rule.Organization == org && rule.Environment == env.Name {
+				return fmt.Errorf("environment is not empty; role '%s' references it", role.Name)
+			}
+		}
+	}
+
+	if !s.delete(getEnvironmentPath(org, env.Name)) {
+		return fmt.Errorf("environment %s/%s does not exist", org, env.Name)
+	}
+
+	return nil
+}
+
+// GetEnvironment returns a single environment
+func (s *Store) GetEnvironment(ctx context.Context, org, env string) (*types.Environment, error) {
+	environment := &types.Environment{}
+	if ok, err := s.getResource(getEnvironmentPath(org, env), environment); !ok || err != nil {
+		return nil, err
+	}
+
+	return environment, nil
+}
+
+// GetEnvironments returns all Environments.
+func (s *Store) GetEnvironments(ctx context.Context, org string) ([]*types.Environment, error) {
+	// Support "*" as a wildcard
+	if org == store.WildcardValue {
+		org = ""
+	}
+
+	s.mu.RLock()
+	kvs := s.list(getEnvironmentPath(org, "") + "/")
+	s.mu.RUnlock()
+
+	envs := make([]*types.Environment, len(kvs))
+	for i, kv := range kvs {
+		env := &types.Environment{}
+		if err := store.Decode(kv.value, env); err != nil {
+			return nil, err
+		}
+		envs[i] = env
+	}
+
+	return envs, nil
+}
+
+// UpdateEnvironment updates an environment
+func (s *Store) UpdateEnvironment(ctx context.Context, env *types.Environment) error {
+	if err := env.Validate(); err != nil {
+		return err
+	}
+
+	bytes, err := store.Encode(env)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The organization under which we are creating this environment must
+	// exist
+	if _, ok := s.get(getOrganizationPath(env.Organization)); !ok {
+		return fmt.Errorf(
+			"the organization %s does not exist, cannot create the environment %s",
+			env.Organization, env.Name,
+		)
+	}
+
+	s.put(getEnvironmentPath(env.Organization, env.Name), bytes, 0)
+	return nil
+}
@@ -0,0 +1,180 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	errorsPathPrefix = "errors"
+	errorsKeyTTL     = 8 * time.Hour
+)
+
+var (
+	errorsKeyBuilder = store.NewKeyBuilder(errorsPathPrefix)
+)
+
+func errPathFromAllUniqueFields(ns store.Namespace, entity, check, ts string) string {
+	return errorsKeyBuilder.WithNamespace(ns).Build(entity, "check", check, ts)
+}
+
+// DeleteError deletes an error using the given entity, check and timestamp,
+// within the organization and environment stored in ctx.
+func (s *Store) DeleteError(ctx context.Context, entity, check, timestamp string) error {
+	if entity == "" || check == "" || timestamp == "" {
+		return errors.New("must specify entity ID, check name, and timestamp")
+	}
+
+	ns := store.NewNamespaceFromContext(ctx)
+	s.deleteResource(errPathFromAllUniqueFields(ns, entity, check, timestamp))
+	return nil
+}
+
+// DeleteErrorsByEntity deletes all errors associated with the given entity,
+// within the organization and environment stored in ctx.
+func (s *Store) DeleteErrorsByEntity(ctx context.Context, entity string) error {
+	if entity == "" {
+		return errors.New("must specify entity id")
+	}
+
+	ns := store.NewNamespaceFromContext(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deletePrefix(errorsKeyBuilder.WithNamespace(ns).Build(entity) + "/")
+	return nil
+}
+
+// DeleteErrorsByEntityCheck deletes all errors associated with the given
+// entity and check within the organization and environment stored in ctx.
+func (s *Store) DeleteErrorsByEntityCheck(ctx context.Context, entity, check string) error {
+	if entity == "" || check == "" {
+		return errors.New("must specify entity ID and check name")
+	}
+
+	ns := store.NewNamespaceFromContext(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deletePrefix(errorsKeyBuilder.WithNamespace(ns).Build(entity, "check", check) + "/")
+	return nil
+}
+
+// GetError returns error associated with given entity, check and timestamp,
+// in the given ctx's organization and environment.
+func (s *Store) GetError(ctx context.Context, entity, check, timestamp string) (*types.Error, error) {
+	ns := store.NewNamespaceFromContext(ctx)
+	if entity == "" || check == "" || timestamp == "" {
+		return nil, errors.New("must specify entity id, check name, and timestamp")
+	} else if ns.Wildcard() {
+		return nil, errors.New("may not use wildcard to search for record")
+	}
+
+	perr := &types.Error{}
+	if ok, err := s.getResource(errPathFromAllUniqueFields(ns, entity, check, timestamp), perr); !ok || err != nil {
+		return nil, err
+	}
+
+	return perr, nil
+}
+
+// GetErrors returns all errors in the given ctx's organization and
+// environment.
+func (s *Store) GetErrors(ctx context.Context) ([]*types.Error, error) {
+	ns := store.NewNamespaceFromContext(ctx)
+	return s.getErrors(errorsKeyBuilder.WithNamespace(ns).BuildPrefix(), shouldRejectError(ns, "", ""))
+}
+
+// GetErrorsByEntity returns all errors for the given entity within the ctx's
+// organization and environment. A nil slice with no error is returned if none
+// were found.
+func (s *Store) GetErrorsByEntity(ctx context.Context, entity string) ([]*types.Error, error) {
+	return s.GetErrorsByEntityCheck(ctx, entity, "")
+}
+
+// GetErrorsByEntityCheck returns the errors of the given entity and check,
+// within the organization and environment stored in ctx. A nil slice with no
+// error is returned if none were found.
+func (s *Store) GetErrorsByEntityCheck(ctx context.Context, entity, check string) ([]*types.Error, error) {
+	if entity == "" {
+		return nil, errors.New("must specify entity id")
+	}
+
+	ns := store.NewNamespaceFromContext(ctx)
+	prefix := errorsKeyBuilder.WithNamespace(ns).BuildPrefix(entity)
+	return s.getErrors(prefix, shouldRejectError(ns, entity, check))
+}
+
+// CreateError creates or updates a given error.
+func (s *Store) CreateError(ctx context.Context, perr *types.Error) error {
+	bytes, err := store.Encode(perr)
+	if err != nil {
+		return err
+	}
+
+	key := errorsKeyBuilder.WithContext(ctx).Build(
+		perr.Event.Entity.ID,
+		"check",
+		perr.Event.Check.Name,
+		strconv.FormatInt(perr.Timestamp, 10),
+	)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.environmentExists(perr.Event.Entity.Organization, perr.Event.Entity.Environment) {
+		return fmt.Errorf(
+			"could not create the error %s/%s in environment %s/%s",
+			perr.Event.Entity.ID,
+			perr.Event.Check.Name,
+			perr.GetOrganization(),
+			perr.GetEnvironment(),
+		)
+	}
+
+	s.put(key, bytes, errorsKeyTTL)
+	return nil
+}
+
+// getErrors returns the errors under the prefix which are not rejected.
+func (s *Store) getErrors(prefix string, reject func(*types.Error) bool) ([]*types.Error, error) {
+	s.mu.RLock()
+	kvs := s.list(prefix)
+	s.mu.RUnlock()
+
+	perrs := make([]*types.Error, 0, len(kvs))
+	for _, kv := range kvs {
+		perr := &types.Error{}
+		if err := store.Decode(kv.value, perr); err != nil {
+			return nil, err
+		}
+		if !reject(perr) {
+			perrs = append(perrs, perr)
+		}
+	}
+
+	return perrs, nil
+}
+
+// shouldRejectError returns a predicate rejecting the errors which are not in
+// the environment of the namespace, or which don't belong to the entity or
+// the check unless they are empty.
+func shouldRejectError(ns store.Namespace, entity, check string) func(*types.Error) bool {
+	rejectByEnv := rejectByEnvironment(ns)
+	return func(perr *types.Error) bool {
+		if rejectByEnv(perr) {
+			return true
+		}
+		if entity != "" && perr.Event.Entity.ID != entity {
+			return true
+		}
+		if check != "" && perr.Event.Check != nil && perr.Event.Check.Name != check {
+			return true
+		}
+		return false
+	}
+}
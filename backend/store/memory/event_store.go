@@ -0,0 +1,171 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	eventsPathPrefix       = "events"
+	eventDigestsPathPrefix = "event-digests"
+	eventDigestTTL         = 24 * time.Hour
+)
+
+var (
+	eventKeyBuilder       = store.NewKeyBuilder(eventsPathPrefix)
+	eventDigestKeyBuilder = store.NewKeyBuilder(eventDigestsPathPrefix)
+)
+
+func getEventPath(event *types.Event) string {
+	return eventKeyBuilder.WithResource(event.Entity).Build(event.Entity.ID, event.Check.Name)
+}
+
+func getEventWithCheckPath(ctx context.Context, entity, check string) string {
+	return eventKeyBuilder.WithContext(ctx).Build(entity, check)
+}
+
+// DeleteEventByEntityCheck deletes an event by entity ID and check ID.
+func (s *Store) DeleteEventByEntityCheck(ctx context.Context, entityID, checkID string) error {
+	if entityID == "" || checkID == "" {
+		return errors.New("must specify entity and check id")
+	}
+
+	s.deleteResource(getEventWithCheckPath(ctx, entityID, checkID))
+	return nil
+}
+
+// GetEvents returns the events of the organization and environment of the
+// context, which can be wildcards.
+func (s *Store) GetEvents(ctx context.Context) ([]*types.Event, error) {
+	// The events don't have their environment at the top level of the
+	// struct, so they are filtered by the environment of their entity
+	reject := rejectByQueriedEnvironment(ctx)
+	events := []*types.Event{}
+	err := s.query(ctx, eventKeyBuilder, func(value []byte) error {
+		event := &types.Event{}
+		if err := store.Decode(value, event); err != nil {
+			return err
+		}
+		if !reject(event.Entity) {
+			events = append(events, event)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// GetEventsByEntity gets all events matching a given entity ID.
+func (s *Store) GetEventsByEntity(ctx context.Context, entityID string) ([]*types.Event, error) {
+	if entityID == "" {
+		return nil, errors.New("must specify entity id")
+	}
+
+	s.mu.RLock()
+	kvs := s.list(eventKeyBuilder.WithContext(ctx).Build(entityID) + "/")
+	s.mu.RUnlock()
+
+	var events []*types.Event
+	for _, kv := range kvs {
+		event := &types.Event{}
+		if err := store.Decode(kv.value, event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// GetEventByEntityCheck gets an event by entity and check ID.
+func (s *Store) GetEventByEntityCheck(ctx context.Context, entityID, checkID string) (*types.Event, error) {
+	if entityID == "" || checkID == "" {
+		return nil, errors.New("must specify entity and check id")
+	}
+
+	event := &types.Event{}
+	if ok, err := s.getResource(getEventWithCheckPath(ctx, entityID, checkID), event); !ok || err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// UpdateEvent updates an event.
+func (s *Store) UpdateEvent(ctx context.Context, event *types.Event) error {
+	if event.Check == nil {
+		return errors.New("event has no check")
+	}
+
+	if err := event.Check.Validate(); err != nil {
+		return err
+	}
+
+	if err := event.Entity.Validate(); err != nil {
+		return err
+	}
+
+	// enforce the history retention of the check, then marshal the new event
+	// and store it.
+	event.Check.TrimHistory()
+	bytes, err := store.Encode(event)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.environmentExists(event.Entity.Organization, event.Entity.Environment) {
+		return fmt.Errorf(
+			"could not create the event %s/%s in environment %s/%s",
+			event.Entity.ID,
+			event.Check.Name,
+			event.Entity.Organization,
+			event.Entity.Environment,
+		)
+	}
+
+	s.put(getEventPath(event), bytes, 0)
+	return nil
+}
+
+// GetEventDigest gets the digest of the last event of an entity and check
+// handled by the handler.
+func (s *Store) GetEventDigest(ctx context.Context, handler, entity, check string) (string, error) {
+	if handler == "" || entity == "" || check == "" {
+		return "", errors.New("must specify handler, entity and check")
+	}
+
+	key := eventDigestKeyBuilder.WithContext(ctx).Build(handler, entity, check)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	digest, _ := s.get(key)
+
+	return string(digest), nil
+}
+
+// UpdateEventDigest stores the digest of the last event of an entity and
+// check handled by the handler, which expires after a day.
+func (s *Store) UpdateEventDigest(ctx context.Context, handler, entity, check, digest string) error {
+	if handler == "" || entity == "" || check == "" {
+		return errors.New("must specify handler, entity and check")
+	}
+
+	key := eventDigestKeyBuilder.WithContext(ctx).Build(handler, entity, check)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(key, []byte(digest), eventDigestTTL)
+
+	return nil
+}
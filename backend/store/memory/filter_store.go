@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	eventFiltersPathPrefix = "event-filters"
+)
+
+var (
+	eventFilterKeyBuilder = store.NewKeyBuilder(eventFiltersPathPrefix)
+)
+
+func getEventFilterPath(filter *types.EventFilter) string {
+	return eventFilterKeyBuilder.WithResource(filter).Build(filter.Name)
+}
+
+func getEventFiltersPath(ctx context.Context, name string) string {
+	return eventFilterKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteEventFilterByName deletes a filter by name.
+func (s *Store) DeleteEventFilterByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name of filter")
+	}
+
+	s.deleteResource(getEventFiltersPath(ctx, name))
+	return nil
+}
+
+// GetEventFilters gets the list of filters for the organization and environment
+// of the context.
+func (s *Store) GetEventFilters(ctx context.Context) ([]*types.EventFilter, error) {
+	reject := rejectByQueriedEnvironment(ctx)
+	eventFilters := []*types.EventFilter{}
+	err := s.query(ctx, eventFilterKeyBuilder, func(value []byte) error {
+		filter := &types.EventFilter{}
+		if err := store.Decode(value, filter); err != nil {
+			return err
+		}
+		if !reject(filter) {
+			eventFilters = append(eventFilters, filter)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return eventFilters, nil
+}
+
+// GetEventFilterByName gets a filter by name.
+func (s *Store) GetEventFilterByName(ctx context.Context, name string) (*types.EventFilter, error) {
+	if name == "" {
+		return nil, errors.New("must specify name of filter")
+	}
+
+	filter := &types.EventFilter{}
+	if ok, err := s.getResource(getEventFiltersPath(ctx, name), filter); !ok || err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+// UpdateEventFilter updates a filter.
+func (s *Store) UpdateEventFilter(ctx context.Context, filter *types.EventFilter) error {
+	return s.updateResource(getEventFilterPath(filter), filter)
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	handlersPathPrefix = "handlers"
+)
+
+var (
+	handlerKeyBuilder = store.NewKeyBuilder(handlersPathPrefix)
+)
+
+func getHandlerPath(handler *types.Handler) string {
+	return handlerKeyBuilder.WithResource(handler).Build(handler.Name)
+}
+
+func getHandlersPath(ctx context.Context, name string) string {
+	return handlerKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteHandlerByName deletes a handler by name.
+func (s *Store) DeleteHandlerByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name of handler")
+	}
+
+	s.deleteResource(getHandlersPath(ctx, name))
+	return nil
+}
+
+// GetHandlers gets the list of handlers for the organization and environment
+// of the context.
+func (s *Store) GetHandlers(ctx context.Context) ([]*types.Handler, error) {
+	reject := rejectByQueriedEnvironment(ctx)
+	handlers := []*types.Handler{}
+	err := s.query(ctx, handlerKeyBuilder, func(value []byte) error {
+		handler := &types.Handler{}
+		if err := store.Decode(value, handler); err != nil {
+			return err
+		}
+		if !reject(handler) {
+			handlers = append(handlers, handler)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return handlers, nil
+}
+
+// GetHandlerByName gets a handler by name.
+func (s *Store) GetHandlerByName(ctx context.Context, name string) (*types.Handler, error) {
+	if name == "" {
+		return nil, errors.New("must specify name of handler")
+	}
+
+	handler := &types.Handler{}
+	if ok, err := s.getResource(getHandlersPath(ctx, name), handler); !ok || err != nil {
+		return nil, err
+	}
+
+	return handler, nil
+}
+
+// UpdateHandler updates a handler.
+func (s *Store) UpdateHandler(ctx context.Context, handler *types.Handler) error {
+	return s.updateResource(getHandlerPath(handler), handler)
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	hooksPathPrefix = "hooks"
+)
+
+var (
+	hookKeyBuilder = store.NewKeyBuilder(hooksPathPrefix)
+)
+
+func getHookConfigPath(hook *types.HookConfig) string {
+	return hookKeyBuilder.WithResource(hook).Build(hook.Name)
+}
+
+func getHookConfigsPath(ctx context.Context, name string) string {
+	return hookKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteHookConfigByName deletes a hook configuration by name.
+func (s *Store) DeleteHookConfigByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name")
+	}
+
+	s.deleteResource(getHookConfigsPath(ctx, name))
+	return nil
+}
+
+// GetHookConfigs gets the list of hook configurations for the organization and environment
+// of the context.
+func (s *Store) GetHookConfigs(ctx context.Context) ([]*types.HookConfig, error) {
+	reject := rejectByQueriedEnvironment(ctx)
+	hooks := []*types.HookConfig{}
+	err := s.query(ctx, hookKeyBuilder, func(value []byte) error {
+		hook := &types.HookConfig{}
+		if err := store.Decode(value, hook); err != nil {
+			return err
+		}
+		if !reject(hook) {
+			hooks = append(hooks, hook)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hooks, nil
+}
+
+// GetHookConfigByName gets a hook configuration by name.
+func (s *Store) GetHookConfigByName(ctx context.Context, name string) (*types.HookConfig, error) {
+	if name == "" {
+		return nil, errors.New("must specify name")
+	}
+
+	hook := &types.HookConfig{}
+	if ok, err := s.getResource(getHookConfigsPath(ctx, name), hook); !ok || err != nil {
+		return nil, err
+	}
+
+	return hook, nil
+}
+
+// UpdateHookConfig updates a hook configuration.
+func (s *Store) UpdateHookConfig(ctx context.Context, hook *types.HookConfig) error {
+	return s.updateResource(getHookConfigPath(hook), hook)
+}
@@ -0,0 +1,158 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	incidentsPathPrefix = "incidents"
+
+	// incidentResolvedTTL is how long the resolved incidents are kept before
+	// they expire, like in etcd.
+	incidentResolvedTTL = 7 * 24 * time.Hour
+)
+
+var (
+	incidentKeyBuilder = store.NewKeyBuilder(incidentsPathPrefix)
+)
+
+func getIncidentPath(incident *types.Incident) string {
+	return incidentKeyBuilder.WithResource(incident).Build(incident.ID)
+}
+
+func getIncidentsPath(ctx context.Context, id string) string {
+	return incidentKeyBuilder.WithContext(ctx).Build(id)
+}
+
+// DeleteIncidentByID deletes a incident by ID.
+func (s *Store) DeleteIncidentByID(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("must specify ID of incident")
+	}
+
+	s.deleteResource(getIncidentsPath(ctx, id))
+	return nil
+}
+
+// GetIncidents gets the list of incidents for the organization and environment
+// of the context.
+func (s *Store) GetIncidents(ctx context.Context) ([]*types.Incident, error) {
+	reject := rejectByQueriedEnvironment(ctx)
+	incidents := []*types.Incident{}
+	err := s.query(ctx, incidentKeyBuilder, func(value []byte) error {
+		incident := &types.Incident{}
+		if err := store.Decode(value, incident); err != nil {
+			return err
+		}
+		if !reject(incident) {
+			incidents = append(incidents, incident)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return incidents, nil
+}
+
+// GetIncidentByID gets a incident by ID.
+func (s *Store) GetIncidentByID(ctx context.Context, id string) (*types.Incident, error) {
+	if id == "" {
+		return nil, errors.New("must specify ID of incident")
+	}
+
+	incident := &types.Incident{}
+	if ok, err := s.getResource(getIncidentsPath(ctx, id), incident); !ok || err != nil {
+		return nil, err
+	}
+
+	return incident, nil
+}
+
+// GetUnresolvedIncidents gets the list of the incidents which are not resolved
+// for the organization and environment of the context.
+func (s *Store) GetUnresolvedIncidents(ctx context.Context) ([]*types.Incident, error) {
+	incidents, err := s.GetIncidents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unresolved := []*types.Incident{}
+	for _, incident := range incidents {
+		if !incident.IsResolved() {
+			unresolved = append(unresolved, incident)
+		}
+	}
+	return unresolved, nil
+}
+
+// UpdateIncident updates a incident. A resolved incident expires after
+// incidentResolvedTTL.
+func (s *Store) UpdateIncident(ctx context.Context, incident *types.Incident) error {
+	if err := incident.Validate(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.environmentExists(incident.Organization, incident.Environment) {
+		return fmt.Errorf(
+			"could not create %s in namespace %s/%s",
+			incident.URIPath(),
+			incident.Organization,
+			incident.Environment,
+		)
+	}
+	return s.putIncident(incident)
+}
+
+// UpdateIncidentWith applies update to the incident stored with the given ID,
+// and stores the result. Nothing is updated if the incident does not exist.
+func (s *Store) UpdateIncidentWith(ctx context.Context, id string, update func(*types.Incident) error) error {
+	if id == "" {
+		return errors.New("must specify ID of incident")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.get(getIncidentsPath(ctx, id))
+	if !ok {
+		return nil
+	}
+	incident := &types.Incident{}
+	if err := store.Decode(value, incident); err != nil {
+		return err
+	}
+	if err := update(incident); err != nil {
+		return err
+	}
+	if err := incident.Validate(); err != nil {
+		return err
+	}
+	return s.putIncident(incident)
+}
+
+// putIncident stores the incident, which expires once resolved. The caller
+// holds the lock.
+func (s *Store) putIncident(incident *types.Incident) error {
+	bytes, err := store.Encode(incident)
+	if err != nil {
+		return err
+	}
+
+	var ttl time.Duration
+	if incident.IsResolved() {
+		ttl = incidentResolvedTTL
+	}
+	s.put(getIncidentPath(incident), bytes, ttl)
+	return nil
+}
@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// StoreInitializer flags the in-memory store as initialized.
+type StoreInitializer struct {
+	store *Store
+}
+
+// NewInitializer returns a new store initializer
+func (s *Store) NewInitializer() (store.Initializer, error) {
+	return &StoreInitializer{store: s}, nil
+}
+
+// Lock locks the initialization of the store, to avoid competing writes
+func (s *StoreInitializer) Lock() error {
+	s.store.initMu.Lock()
+	return nil
+}
+
+// IsInitialized returns whether the store was flagged as initialized
+func (s *StoreInitializer) IsInitialized() (bool, error) {
+	s.store.mu.RLock()
+	defer s.store.mu.RUnlock()
+	return s.store.initialized, nil
+}
+
+// FlagAsInitialized flags the store as initialized
+func (s *StoreInitializer) FlagAsInitialized() error {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	s.store.initialized = true
+	return nil
+}
+
+// Close unlocks the initialization of the store
+func (s *StoreInitializer) Close() error {
+	s.store.initMu.Unlock()
+	return nil
+}
@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	keepalivesPathPrefix = "keepalives"
+)
+
+// The keepalive deadlines are stored by entity, since there's no range
+// request to optimize in memory.
+func getKeepalivePath(org, env, id string) string {
+	return path.Join(store.Root, keepalivesPathPrefix, org, env, id)
+}
+
+// DeleteKeepalive deletes the keepalive deadline of an entity.
+func (s *Store) DeleteKeepalive(ctx context.Context, entity *types.Entity) error {
+	s.deleteResource(getKeepalivePath(entity.Organization, entity.Environment, entity.ID))
+	return nil
+}
+
+// GetExpiredKeepalives gets the keepalives whose deadline is at or before the
+// given unix timestamp.
+func (s *Store) GetExpiredKeepalives(ctx context.Context, now int64) ([]*types.KeepaliveRecord, error) {
+	s.mu.RLock()
+	kvs := s.list(getKeepalivePath("", "", "") + "/")
+	s.mu.RUnlock()
+
+	keepalives := []*types.KeepaliveRecord{}
+	for _, kv := range kvs {
+		keepalive := &types.KeepaliveRecord{}
+		if err := store.Decode(kv.value, keepalive); err != nil {
+			return nil, err
+		}
+		if keepalive.Time <= now {
+			keepalives = append(keepalives, keepalive)
+		}
+	}
+
+	return keepalives, nil
+}
+
+// ClaimExpiredKeepalive deletes an expired keepalive deadline. It returns true
+// if the deadline was still the current one of its entity, which is the case
+// for a single caller.
+func (s *Store) ClaimExpiredKeepalive(ctx context.Context, kr *types.KeepaliveRecord) (bool, error) {
+	key := getKeepalivePath(kr.Organization, kr.Environment, kr.EntityID)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.get(key)
+	if !ok {
+		return false, nil
+	}
+	current := &types.KeepaliveRecord{}
+	if err := store.Decode(value, current); err != nil {
+		return false, err
+	}
+	if current.Time != kr.Time {
+		// The deadline was superseded
+		return false, nil
+	}
+
+	s.delete(key)
+	return true, nil
+}
+
+// UpdateKeepalive sets the keepalive deadline of an entity, replacing its
+// previous deadline if any.
+func (s *Store) UpdateKeepalive(ctx context.Context, entity *types.Entity, expiration int64) error {
+	bytes, err := store.Encode(types.NewKeepaliveRecord(entity, expiration))
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.environmentExists(entity.Organization, entity.Environment) {
+		return fmt.Errorf(
+			"could not create the keepalive for entity %s in environment %s/%s",
+			entity.ID,
+			entity.Organization,
+			entity.Environment,
+		)
+	}
+
+	s.put(getKeepalivePath(entity.Organization, entity.Environment, entity.ID), bytes, 0)
+	return nil
+}
@@ -0,0 +1,9 @@
+package memory
+
+import (
+	"github.com/Sirupsen/logrus"
+)
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "store",
+})
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	mutatorsPathPrefix = "mutators"
+)
+
+var (
+	mutatorKeyBuilder = store.NewKeyBuilder(mutatorsPathPrefix)
+)
+
+func getMutatorPath(mutator *types.Mutator) string {
+	return mutatorKeyBuilder.WithResource(mutator).Build(mutator.Name)
+}
+
+func getMutatorsPath(ctx context.Context, name string) string {
+	return mutatorKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteMutatorByName deletes a mutator by name.
+func (s *Store) DeleteMutatorByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name of mutator")
+	}
+
+	s.deleteResource(getMutatorsPath(ctx, name))
+	return nil
+}
+
+// GetMutators gets the list of mutators for the organization and environment
+// of the context.
+func (s *Store) GetMutators(ctx context.Context) ([]*types.Mutator, error) {
+	reject := rejectByQueriedEnvironment(ctx)
+	mutators := []*types.Mutator{}
+	err := s.query(ctx, mutatorKeyBuilder, func(value []byte) error {
+		mutator := &types.Mutator{}
+		if err := store.Decode(value, mutator); err != nil {
+			return err
+		}
+		if !reject(mutator) {
+			mutators = append(mutators, mutator)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mutators, nil
+}
+
+// GetMutatorByName gets a mutator by name.
+func (s *Store) GetMutatorByName(ctx context.Context, name string) (*types.Mutator, error) {
+	if name == "" {
+		return nil, errors.New("must specify name of mutator")
+	}
+
+	mutator := &types.Mutator{}
+	if ok, err := s.getResource(getMutatorsPath(ctx, name), mutator); !ok || err != nil {
+		return nil, err
+	}
+
+	return mutator, nil
+}
+
+// UpdateMutator updates a mutator.
+func (s *Store) UpdateMutator(ctx context.Context, mutator *types.Mutator) error {
+	return s.updateResource(getMutatorPath(mutator), mutator)
+}
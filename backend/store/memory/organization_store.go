@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	organizationsPathPrefix = "organizations"
+)
+
+func getOrganizationPath(name string) string {
+	return path.Join(store.Root, organizationsPathPrefix, name)
+}
+
+// DeleteOrganizationByName deletes the organization named *name*
+func (s *Store) DeleteOrganizationByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Validate whether there are any resources referencing the organization
+	for _, kb := range []store.KeyBuilder{
+		checkKeyBuilder,
+		entityKeyBuilder,
+		assetKeyBuilder,
+		handlerKeyBuilder,
+		mutatorKeyBuilder,
+		environmentKeyBuilder,
+	} {
+		if s.count(kb.WithOrg(name).Build()+"/") > 0 {
+			return errors.New("organization is not empty")
+		}
+	}
+
+	// Validate that there are no roles referencing the organization
+	roles, err := s.getRoles()
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		for _, rule := range role.Rules {
+			if rule.Organization == name {
+				return fmt.Errorf("organization is not empty; role '%s' references it", role.Name)
+			}
+		}
+	}
+
+	if !s.delete(getOrganizationPath(name)) {
+		return fmt.Errorf("organization %s does not exist", name)
+	}
+
+	return nil
+}
+
+// GetOrganizationByName returns a single organization named *name*
+func (s *Store) GetOrganizationByName(ctx context.Context, name string) (*types.Organization, error) {
+	org := &types.Organization{}
+	if ok, err := s.getResource(getOrganizationPath(name), org); !ok || err != nil {
+		return nil, err
+	}
+
+	return org, nil
+}
+
+// GetOrganizations returns all organizations
+func (s *Store) GetOrganizations(ctx context.Context) ([]*types.Organization, error) {
+	s.mu.RLock()
+	kvs := s.list(getOrganizationPath("") + "/")
+	s.mu.RUnlock()
+
+	orgs := make([]*types.Organization, len(kvs))
+	for i, kv := range kvs {
+		org := &types.Organization{}
+		if err := store.Decode(kv.value, org); err != nil {
+			return nil, err
+		}
+		orgs[i] = org
+	}
+
+	return orgs, nil
+}
+
+// UpdateOrganization updates an organization with the provided org
+func (s *Store) UpdateOrganization(ctx context.Context, org *types.Organization) error {
+	if err := org.Validate(); err != nil {
+		return err
+	}
+
+	bytes, err := store.Encode(org)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(getOrganizationPath(org.Name), bytes, 0)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	pipelinesPathPrefix = "pipelines"
+)
+
+var (
+	pipelineKeyBuilder = store.NewKeyBuilder(pipelinesPathPrefix)
+)
+
+func getPipelinePath(pipeline *types.Pipeline) string {
+	return pipelineKeyBuilder.WithResource(pipeline).Build(pipeline.Name)
+}
+
+func getPipelinesPath(ctx context.Context, name string) string {
+	return pipelineKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeletePipelineByName deletes a pipeline by name.
+func (s *Store) DeletePipelineByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name of pipeline")
+	}
+
+	s.deleteResource(getPipelinesPath(ctx, name))
+	return nil
+}
+
+// GetPipelines gets the list of pipelines for the organization and environment
+// of the context.
+func (s *Store) GetPipelines(ctx context.Context) ([]*types.Pipeline, error) {
+	reject := rejectByQueriedEnvironment(ctx)
+	pipelines := []*types.Pipeline{}
+	err := s.query(ctx, pipelineKeyBuilder, func(value []byte) error {
+		pipeline := &types.Pipeline{}
+		if err := store.Decode(value, pipeline); err != nil {
+			return err
+		}
+		if !reject(pipeline) {
+			pipelines = append(pipelines, pipeline)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pipelines, nil
+}
+
+// GetPipelineByName gets a pipeline by name.
+func (s *Store) GetPipelineByName(ctx context.Context, name string) (*types.Pipeline, error) {
+	if name == "" {
+		return nil, errors.New("must specify name of pipeline")
+	}
+
+	pipeline := &types.Pipeline{}
+	if ok, err := s.getResource(getPipelinesPath(ctx, name), pipeline); !ok || err != nil {
+		return nil, err
+	}
+
+	return pipeline, nil
+}
+
+// UpdatePipeline updates a pipeline.
+func (s *Store) UpdatePipeline(ctx context.Context, pipeline *types.Pipeline) error {
+	return s.updateResource(getPipelinePath(pipeline), pipeline)
+}
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"path"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	rolePathPrefix = "roles"
+)
+
+func getRolePath(name string) string {
+	return path.Join(store.Root, rolePathPrefix, name)
+}
+
+// GetRoles gets all the roles.
+func (s *Store) GetRoles(ctx context.Context) ([]*types.Role, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.getRoles()
+}
+
+// getRoles gets all the roles, the caller holding the lock.
+func (s *Store) getRoles() ([]*types.Role, error) {
+	kvs := s.list(getRolePath("") + "/")
+	roles := make([]*types.Role, len(kvs))
+	for i, kv := range kvs {
+		role := &types.Role{}
+		if err := store.Decode(kv.value, role); err != nil {
+			return nil, err
+		}
+		roles[i] = role
+	}
+	return roles, nil
+}
+
+// GetRoleByName gets a role by name.
+func (s *Store) GetRoleByName(ctx context.Context, name string) (*types.Role, error) {
+	role := &types.Role{}
+	if ok, err := s.getResource(getRolePath(name), role); !ok || err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// UpdateRole updates a role.
+func (s *Store) UpdateRole(ctx context.Context, role *types.Role) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+	return s.putValue(getRolePath(role.Name), role)
+}
+
+// DeleteRoleByName deletes a role by name.
+func (s *Store) DeleteRoleByName(ctx context.Context, name string) error {
+	s.deleteResource(getRolePath(name))
+	return nil
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	roleBindingsPathPrefix = "rolebindings"
+)
+
+var (
+	roleBindingKeyBuilder = store.NewKeyBuilder(roleBindingsPathPrefix)
+)
+
+func getRoleBindingPath(binding *types.RoleBinding) string {
+	return roleBindingKeyBuilder.WithResource(binding).Build(binding.Name)
+}
+
+func getRoleBindingsPath(ctx context.Context, name string) string {
+	return roleBindingKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteRoleBindingByName deletes a role binding by name.
+func (s *Store) DeleteRoleBindingByName(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("must specify name of role binding")
+	}
+
+	s.deleteResource(getRoleBindingsPath(ctx, name))
+	return nil
+}
+
+// GetRoleBindings gets the list of role bindings for the organization and environment
+// of the context.
+func (s *Store) GetRoleBindings(ctx context.Context) ([]*types.RoleBinding, error) {
+	reject := rejectByQueriedEnvironment(ctx)
+	roleBindings := []*types.RoleBinding{}
+	err := s.query(ctx, roleBindingKeyBuilder, func(value []byte) error {
+		binding := &types.RoleBinding{}
+		if err := store.Decode(value, binding); err != nil {
+			return err
+		}
+		if !reject(binding) {
+			roleBindings = append(roleBindings, binding)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return roleBindings, nil
+}
+
+// GetRoleBindingByName gets a role binding by name.
+func (s *Store) GetRoleBindingByName(ctx context.Context, name string) (*types.RoleBinding, error) {
+	if name == "" {
+		return nil, errors.New("must specify name of role binding")
+	}
+
+	binding := &types.RoleBinding{}
+	if ok, err := s.getResource(getRoleBindingsPath(ctx, name), binding); !ok || err != nil {
+		return nil, err
+	}
+
+	return binding, nil
+}
+
+// UpdateRoleBinding updates a role binding.
+func (s *Store) UpdateRoleBinding(ctx context.Context, binding *types.RoleBinding) error {
+	return s.updateResource(getRoleBindingPath(binding), binding)
+}
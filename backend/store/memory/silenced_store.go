@@ -0,0 +1,157 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	silencedPathPrefix = "silenced"
+)
+
+var (
+	silencedKeyBuilder = store.NewKeyBuilder(silencedPathPrefix)
+)
+
+func getSilencedPath(ctx context.Context, name string) string {
+	return silencedKeyBuilder.WithContext(ctx).Build(name)
+}
+
+// DeleteSilencedEntryByID deletes a silenced entry by its id (subscription +
+// checkname)
+func (s *Store) DeleteSilencedEntryByID(ctx context.Context, silencedID string) error {
+	if silencedID == "" {
+		return errors.New("must specify id")
+	}
+
+	s.deleteResource(getSilencedPath(ctx, silencedID))
+	return nil
+}
+
+// GetSilencedEntries gets all silenced entries.
+func (s *Store) GetSilencedEntries(ctx context.Context) ([]*types.Silenced, error) {
+	return s.getSilencedEntries(ctx, queryPrefix(ctx, silencedKeyBuilder), func(*types.Silenced) bool {
+		return true
+	})
+}
+
+// GetSilencedEntriesBySubscription gets all silenced entries that match a
+// subscription.
+func (s *Store) GetSilencedEntriesBySubscription(ctx context.Context, subscription string) ([]*types.Silenced, error) {
+	if subscription == "" {
+		return nil, errors.New("must specify subscription")
+	}
+	return s.getSilencedEntries(ctx, getSilencedPath(ctx, subscription), func(*types.Silenced) bool {
+		return true
+	})
+}
+
+// GetSilencedEntriesByCheckName gets all silenced entries that match a check
+// name.
+func (s *Store) GetSilencedEntriesByCheckName(ctx context.Context, checkName string) ([]*types.Silenced, error) {
+	if checkName == "" {
+		return nil, errors.New("must specify check name")
+	}
+	return s.getSilencedEntries(ctx, getSilencedPath(ctx, "")+"/", func(entry *types.Silenced) bool {
+		return entry.Check == checkName
+	})
+}
+
+// GetSilencedEntryByID gets a silenced entry by id.
+func (s *Store) GetSilencedEntryByID(ctx context.Context, id string) (*types.Silenced, error) {
+	if id == "" {
+		return nil, errors.New("must specify id")
+	}
+
+	s.mu.RLock()
+	e, ok := s.kv[getSilencedPath(ctx, id)]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+
+	return decodeSilenced(kv{value: e.value, entry: e})
+}
+
+// UpdateSilencedEntry updates a Silenced. An entry which expires is deleted
+// once it expired, its expiration starting from its beginning.
+func (s *Store) UpdateSilencedEntry(ctx context.Context, silenced *types.Silenced) error {
+	if err := silenced.Validate(); err != nil {
+		return err
+	}
+
+	bytes, err := store.Encode(silenced)
+	if err != nil {
+		return err
+	}
+
+	var ttl time.Duration
+	if silenced.Expire > 0 {
+		// Add the time until the beginning to the expiration, unless it is
+		// in the past
+		expire := silenced.Expire
+		if delta := silenced.Begin - time.Now().Unix(); delta > 0 {
+			expire += delta
+		}
+		ttl = time.Duration(expire) * time.Second
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.environmentExists(silenced.Organization, silenced.Environment) {
+		return fmt.Errorf(
+			"could not create the silenced entry %s in environment %s/%s",
+			silenced.ID,
+			silenced.Organization,
+			silenced.Environment,
+		)
+	}
+
+	s.put(getSilencedPath(ctx, silenced.ID), bytes, ttl)
+	return nil
+}
+
+// getSilencedEntries returns the entries under the prefix, in the environment
+// of the context, which match.
+func (s *Store) getSilencedEntries(ctx context.Context, prefix string, match func(*types.Silenced) bool) ([]*types.Silenced, error) {
+	s.mu.RLock()
+	kvs := s.list(prefix)
+	s.mu.RUnlock()
+
+	reject := rejectByQueriedEnvironment(ctx)
+	entries := make([]*types.Silenced, 0, len(kvs))
+	for _, kv := range kvs {
+		entry, err := decodeSilenced(kv)
+		if err != nil {
+			return nil, err
+		}
+		if !reject(entry) && match(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// decodeSilenced decodes a silenced entry, setting its expiration to the
+// number of seconds left before it expires, or -1 if it does not expire, like
+// the TTL of its etcd lease.
+func decodeSilenced(kv kv) (*types.Silenced, error) {
+	entry := &types.Silenced{}
+	if err := store.Decode(kv.value, entry); err != nil {
+		return nil, err
+	}
+
+	entry.Expire = -1
+	if !kv.entry.expires.IsZero() {
+		// Round up, so an entry is never reported as expired before it is
+		entry.Expire = int64(math.Ceil(time.Until(kv.entry.expires).Seconds()))
+	}
+	return entry, nil
+}
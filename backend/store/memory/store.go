@@ -0,0 +1,289 @@
+// Package memory implements the store in memory, without etcd. Its resources
+// are lost when the process exits and they are not shared with other
+// backends, so it's meant for the unit tests and the development mode of the
+// backend.
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sensu/sensu-go/backend/queue"
+	"github.com/sensu/sensu-go/backend/ring"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// Store is an in-memory implementation of the sensu-go/backend/store.Store
+// iface. The resources are encoded like in etcd, under the same keys, so they
+// are copied in and out of the store.
+type Store struct {
+	mu       sync.RWMutex
+	kv       map[string]*entry
+	watchers map[*watcher]struct{}
+
+	// initialized mimics the .initialized key of etcd, initMu being the
+	// initialization lock
+	initMu      sync.Mutex
+	initialized bool
+
+	clusterID string
+	queues    *queue.MemoryGetter
+	rings     *ring.MemoryGetter
+}
+
+// entry is a value of the store, which expires at the given time if its timer
+// is set.
+type entry struct {
+	value   []byte
+	expires time.Time
+	timer   *time.Timer
+}
+
+// kv is a key-value pair returned by list.
+type kv struct {
+	key   string
+	value []byte
+	entry *entry
+}
+
+// NewStore creates a new, empty Store.
+func NewStore() *Store {
+	return &Store{
+		kv:        make(map[string]*entry),
+		watchers:  make(map[*watcher]struct{}),
+		clusterID: uuid.New().String(),
+		queues:    queue.NewMemoryGetter(),
+		rings:     ring.NewMemoryGetter(),
+	}
+}
+
+// NewQueue returns the in-memory queue with the given name.
+func (s *Store) NewQueue(name string) queue.Interface {
+	return s.queues.NewQueue(name)
+}
+
+// GetRing gets the named in-memory ring.
+func (s *Store) GetRing(path ...string) types.Ring {
+	return s.rings.GetRing(path...)
+}
+
+// GetClusterID returns the identifier of the store, generated when it was
+// created.
+func (s *Store) GetClusterID(ctx context.Context) (string, error) {
+	return s.clusterID, nil
+}
+
+// get returns the value of the key, the caller holding the lock.
+func (s *Store) get(key string) ([]byte, bool) {
+	e, ok := s.kv[key]
+	if !ok {
+		return nil, false
+	}
+	return e.value, true
+}
+
+// put sets the value of the key, which expires after ttl unless it's zero,
+// and notifies the watchers. The caller holds the lock.
+func (s *Store) put(key string, value []byte, ttl time.Duration) {
+	prev, ok := s.kv[key]
+	if ok && prev.timer != nil {
+		prev.timer.Stop()
+	}
+
+	e := &entry{value: value}
+	if ttl > 0 {
+		e.expires = time.Now().Add(ttl)
+		e.timer = time.AfterFunc(ttl, func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			// The key could have been set again since
+			if s.kv[key] == e {
+				s.delete(key)
+			}
+		})
+	}
+	s.kv[key] = e
+
+	action := store.WatchCreate
+	if ok {
+		action = store.WatchUpdate
+	}
+	s.notify(key, value, action)
+}
+
+// delete deletes the key, returning false if it did not exist, and notifies
+// the watchers. The caller holds the lock.
+func (s *Store) delete(key string) bool {
+	e, ok := s.kv[key]
+	if !ok {
+		return false
+	}
+	if e.timer != nil {
+		e.timer.Stop()
+	}
+	delete(s.kv, key)
+	s.notify(key, e.value, store.WatchDelete)
+	return true
+}
+
+// deletePrefix deletes the keys under the prefix, and returns how many were
+// deleted. The caller holds the lock.
+func (s *Store) deletePrefix(prefix string) int {
+	deleted := 0
+	for _, kv := range s.list(prefix) {
+		if s.delete(kv.key) {
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// list returns the key-value pairs under the prefix, sorted by key like the
+// range requests of etcd. The caller holds the lock.
+func (s *Store) list(prefix string) []kv {
+	kvs := []kv{}
+	for key, e := range s.kv {
+		if strings.HasPrefix(key, prefix) {
+			kvs = append(kvs, kv{key: key, value: e.value, entry: e})
+		}
+	}
+	sort.Slice(kvs, func(i, j int) bool {
+		return kvs[i].key < kvs[j].key
+	})
+	return kvs
+}
+
+// count returns the number of keys under the prefix. The caller holds the
+// lock.
+func (s *Store) count(prefix string) int {
+	n := 0
+	for key := range s.kv {
+		if strings.HasPrefix(key, prefix) {
+			n++
+		}
+	}
+	return n
+}
+
+// getResource decodes the value of the key into v, and returns false if the
+// key does not exist.
+func (s *Store) getResource(key string, v interface{}) (bool, error) {
+	s.mu.RLock()
+	value, ok := s.get(key)
+	s.mu.RUnlock()
+	if !ok {
+		return false, nil
+	}
+	return true, store.Decode(value, v)
+}
+
+// deleteResource deletes the key.
+func (s *Store) deleteResource(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.delete(key)
+}
+
+// query calls fn with the values of the resources of the kind built by kb, in
+// the organization and environment of the context, which can be wildcards.
+// N.B. When querying across organizations, the resources of all environments
+// are returned, so they need to be filtered afterwards with
+// rejectByQueriedEnvironment once decoded, like in etcd.
+func (s *Store) query(ctx context.Context, kb store.KeyBuilder, fn func([]byte) error) error {
+	s.mu.RLock()
+	kvs := s.list(queryPrefix(ctx, kb))
+	s.mu.RUnlock()
+
+	for _, kv := range kvs {
+		if err := fn(kv.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryPrefix returns the prefix of the keys of the resources of the kind
+// built by kb, in the organization and environment of the context, which can
+// be wildcards.
+func queryPrefix(ctx context.Context, kb store.KeyBuilder) string {
+	ns := store.NewNamespaceFromContext(ctx)
+	if ns.Org == store.WildcardValue {
+		ns.Org = ""
+	}
+	if ns.Env == store.WildcardValue || ns.Org == "" {
+		ns.Env = ""
+	}
+	return kb.WithNamespace(ns).Build() + "/"
+}
+
+// updateResource validates and stores a resource under the given key, as long
+// as its organization, and its environment if it has one, exist.
+func (s *Store) updateResource(key string, r types.Resource) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	bytes, err := store.Encode(r)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	namespace := getEnvironmentPath(r.GetOrganization(), r.GetEnvironment())
+	if r.GetEnvironment() == "" {
+		namespace = getOrganizationPath(r.GetOrganization())
+	}
+	if _, ok := s.get(namespace); !ok {
+		return fmt.Errorf(
+			"could not create %s in namespace %s/%s",
+			r.URIPath(),
+			r.GetOrganization(),
+			r.GetEnvironment(),
+		)
+	}
+
+	s.put(key, bytes, 0)
+	return nil
+}
+
+// environmentExists returns whether the environment exists, the caller
+// holding the lock.
+func (s *Store) environmentExists(org, env string) bool {
+	_, ok := s.get(getEnvironmentPath(org, env))
+	return ok
+}
+
+// rejectByEnvironment returns false if any given record is not part of the
+// environment configured in the namespace. This method is useful when a
+// wildcard was given for the organization but not for the environment.
+func rejectByEnvironment(ns store.Namespace) func(types.MultitenantResource) bool {
+	// If the namespace is a wildcard never reject
+	if ns.EnvIsWildcard() {
+		return func(_ types.MultitenantResource) bool {
+			return false
+		}
+	}
+
+	return func(r types.MultitenantResource) bool {
+		return r.GetEnvironment() != ns.Env
+	}
+}
+
+// rejectByQueriedEnvironment returns true if a record returned by query is not
+// part of the environment configured in the context. This is the case when
+// the organization is a wildcard but the environment is not.
+func rejectByQueriedEnvironment(ctx context.Context) func(types.MultitenantResource) bool {
+	ns := store.NewNamespaceFromContext(ctx)
+	if ns.Env == "" {
+		ns.Env = store.WildcardValue
+	}
+	return rejectByEnvironment(ns)
+}
@@ -0,0 +1,229 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStore returns a store with the default organization and environment.
+func newTestStore(t *testing.T) (*Store, context.Context) {
+	s := NewStore()
+	ctx := context.Background()
+	require.NoError(t, s.UpdateOrganization(ctx, types.FixtureOrganization("default")))
+	require.NoError(t, s.UpdateEnvironment(ctx, types.FixtureEnvironment("default")))
+
+	ctx = context.WithValue(ctx, types.OrganizationKey, "default")
+	ctx = context.WithValue(ctx, types.EnvironmentKey, "default")
+	return s, ctx
+}
+
+func TestCheckConfigStorage(t *testing.T) {
+	s, ctx := newTestStore(t)
+
+	checks, err := s.GetCheckConfigs(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, checks)
+
+	check := types.FixtureCheckConfig("check1")
+	require.NoError(t, s.UpdateCheckConfig(ctx, check))
+
+	retrieved, err := s.GetCheckConfigByName(ctx, "check1")
+	require.NoError(t, err)
+	require.NotNil(t, retrieved)
+	assert.Equal(t, check.Command, retrieved.Command)
+
+	// The checks of every environment are returned with wildcards
+	wildcard := context.WithValue(ctx, types.OrganizationKey, store.WildcardValue)
+	wildcard = context.WithValue(wildcard, types.EnvironmentKey, store.WildcardValue)
+	checks, err = s.GetCheckConfigs(wildcard)
+	require.NoError(t, err)
+	assert.Len(t, checks, 1)
+
+	require.NoError(t, s.DeleteCheckConfigByName(ctx, "check1"))
+	retrieved, err = s.GetCheckConfigByName(ctx, "check1")
+	require.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestUpdateInMissingEnvironment(t *testing.T) {
+	s, ctx := newTestStore(t)
+
+	check := types.FixtureCheckConfig("check1")
+	check.Environment = "missing"
+	assert.Error(t, s.UpdateCheckConfig(ctx, check))
+}
+
+func TestDeleteOrganizationWithResources(t *testing.T) {
+	s, ctx := newTestStore(t)
+
+	require.NoError(t, s.UpdateCheckConfig(ctx, types.FixtureCheckConfig("check1")))
+	assert.Error(t, s.DeleteOrganizationByName(ctx, "default"))
+}
+
+func TestSilencedExpiration(t *testing.T) {
+	s, ctx := newTestStore(t)
+
+	silenced := types.FixtureSilenced("*:check1")
+	silenced.Organization = "default"
+	silenced.Environment = "default"
+	silenced.Expire = 1
+	require.NoError(t, s.UpdateSilencedEntry(ctx, silenced))
+
+	entry, err := s.GetSilencedEntryByID(ctx, silenced.ID)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.True(t, entry.Expire > 0)
+
+	entries, err := s.GetSilencedEntriesByCheckName(ctx, "check1")
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	// The entry is deleted once it expired
+	deadline := time.Now().Add(3 * time.Second)
+	for entry != nil && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+		entry, err = s.GetSilencedEntryByID(ctx, silenced.ID)
+		require.NoError(t, err)
+	}
+	assert.Nil(t, entry)
+}
+
+func TestTombstoneStorage(t *testing.T) {
+	s, ctx := newTestStore(t)
+
+	tombstone := types.FixtureTombstone("check1")
+	require.NoError(t, s.CreateTombstone(ctx, tombstone, 1))
+
+	tombstones, err := s.GetTombstones(ctx)
+	require.NoError(t, err)
+	assert.Len(t, tombstones, 1)
+
+	retrieved, err := s.GetTombstone(ctx, types.TombstoneKindCheck, "check1")
+	require.NoError(t, err)
+	require.NotNil(t, retrieved)
+	assert.Equal(t, tombstone.Resource, retrieved.Resource)
+
+	// The tombstone is deleted once it expired
+	deadline := time.Now().Add(3 * time.Second)
+	for retrieved != nil && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+		retrieved, err = s.GetTombstone(ctx, types.TombstoneKindCheck, "check1")
+		require.NoError(t, err)
+	}
+	assert.Nil(t, retrieved)
+
+	require.NoError(t, s.CreateTombstone(ctx, tombstone, 60))
+	require.NoError(t, s.DeleteTombstone(ctx, types.TombstoneKindCheck, "check1"))
+	retrieved, err = s.GetTombstone(ctx, types.TombstoneKindCheck, "check1")
+	require.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestClaimExpiredKeepalive(t *testing.T) {
+	s, ctx := newTestStore(t)
+
+	entity := types.FixtureEntity("entity1")
+	require.NoError(t, s.UpdateKeepalive(ctx, entity, 10))
+
+	keepalives, err := s.GetExpiredKeepalives(ctx, 20)
+	require.NoError(t, err)
+	require.Len(t, keepalives, 1)
+
+	// Only the first claim of the deadline succeeds
+	claimed, err := s.ClaimExpiredKeepalive(ctx, keepalives[0])
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	claimed, err = s.ClaimExpiredKeepalive(ctx, keepalives[0])
+	require.NoError(t, err)
+	assert.False(t, claimed)
+}
+
+func TestCheckConfigWatcher(t *testing.T) {
+	s, ctx := newTestStore(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ch := s.GetCheckConfigWatcher(ctx)
+
+	check := types.FixtureCheckConfig("check1")
+	require.NoError(t, s.UpdateCheckConfig(ctx, check))
+	require.NoError(t, s.UpdateCheckConfig(ctx, check))
+	require.NoError(t, s.DeleteCheckConfigByName(ctx, "check1"))
+
+	for _, action := range []store.WatchActionType{store.WatchCreate, store.WatchUpdate, store.WatchDelete} {
+		select {
+		case event := <-ch:
+			assert.Equal(t, action, event.Action)
+			assert.Equal(t, "check1", event.CheckConfig.Name)
+		case <-time.After(time.Second):
+			t.Fatal("no watch event received")
+		}
+	}
+
+	cancel()
+	for range ch {
+	}
+}
+
+func TestInitializer(t *testing.T) {
+	s := NewStore()
+
+	initializer, err := s.NewInitializer()
+	require.NoError(t, err)
+	require.NoError(t, initializer.Lock())
+	defer initializer.Close()
+
+	initialized, err := initializer.IsInitialized()
+	require.NoError(t, err)
+	assert.False(t, initialized)
+
+	require.NoError(t, initializer.FlagAsInitialized())
+	initialized, err = initializer.IsInitialized()
+	require.NoError(t, err)
+	assert.True(t, initialized)
+}
+
+func TestUserPreferencesStorage(t *testing.T) {
+	s, ctx := newTestStore(t)
+	preferences := types.FixtureUserPreferences("foo")
+
+	// The preferences of a nonexistent user can't be stored
+	assert.Error(t, s.UpdateUserPreferences(ctx, preferences))
+
+	require.NoError(t, s.CreateUser(types.FixtureUser("foo")))
+	require.NoError(t, s.UpdateUserPreferences(ctx, preferences))
+	retrieved, err := s.GetUserPreferences(ctx, "foo")
+	require.NoError(t, err)
+	assert.Equal(t, preferences, retrieved)
+
+	require.NoError(t, s.DeleteUserPreferences(ctx, "foo"))
+	retrieved, err = s.GetUserPreferences(ctx, "foo")
+	require.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
+
+func TestContactCounterStorage(t *testing.T) {
+	s, ctx := newTestStore(t)
+
+	count, err := s.IncrementContactCounter(ctx, "pagerduty", "alice", 24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = s.IncrementContactCounter(ctx, "pagerduty", "alice", 24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	// Counters are kept per handler and contact
+	count, err = s.IncrementContactCounter(ctx, "slack", "alice", 24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	_, err = s.IncrementContactCounter(ctx, "pagerduty", "alice", 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	jwtSecretKey = "secret"
+)
+
+func getTokenPath(subject, id string) string {
+	return path.Join(store.Root, "tokens", subject, id)
+}
+
+func getAuthenticationPath(id string) string {
+	return path.Join(store.Root, "authentication", id)
+}
+
+// CreateToken creates a Claims.
+func (s *Store) CreateToken(claims *types.Claims) error {
+	return s.putValue(getTokenPath(claims.Subject, claims.Id), claims)
+}
+
+// DeleteTokens deletes multiples tokens, belonging to the same subject.
+func (s *Store) DeleteTokens(subject string, ids []string) error {
+	if subject == "" || len(ids) == 0 {
+		return errors.New("must specify token subject and at least one ID")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range ids {
+		s.delete(getTokenPath(subject, id))
+	}
+
+	return nil
+}
+
+// GetToken gets a Claims.
+func (s *Store) GetToken(subject, id string) (*types.Claims, error) {
+	claims := &types.Claims{}
+	ok, err := s.getResource(getTokenPath(subject, id), claims)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("token %s for %s does not exist", id, subject)
+	}
+
+	return claims, nil
+}
+
+// CreateJWTSecret creates a new JWT secret, unless one already exists.
+func (s *Store) CreateJWTSecret(secret []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.get(getAuthenticationPath(jwtSecretKey)); ok {
+		return fmt.Errorf("a secret already exist")
+	}
+	s.put(getAuthenticationPath(jwtSecretKey), secret, 0)
+
+	return nil
+}
+
+// GetJWTSecret retrieves the JWT signing secret
+func (s *Store) GetJWTSecret() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	secret, ok := s.get(getAuthenticationPath(jwtSecretKey))
+	if !ok {
+		return nil, fmt.Errorf("secret does not exist")
+	}
+
+	return secret, nil
+}
+
+// UpdateJWTSecret replaces the jwt secret with a new one.
+func (s *Store) UpdateJWTSecret(secret []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(getAuthenticationPath(jwtSecretKey), secret, 0)
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	tombstonesPathPrefix = "tombstones"
+)
+
+var (
+	tombstonesKeyBuilder = store.NewKeyBuilder(tombstonesPathPrefix)
+)
+
+func getTombstonePath(ctx context.Context, kind, name string) string {
+	return tombstonesKeyBuilder.WithContext(ctx).Build(kind, name)
+}
+
+// CreateTombstone creates or replaces the tombstone of a deleted resource,
+// which expires after the given number of seconds.
+func (s *Store) CreateTombstone(ctx context.Context, tombstone *types.Tombstone, ttl int64) error {
+	if err := tombstone.Validate(); err != nil {
+		return err
+	}
+
+	bytes, err := store.Encode(tombstone)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.environmentExists(tombstone.Organization, tombstone.Environment) {
+		return fmt.Errorf(
+			"could not create the tombstone of the %s %s in environment %s/%s",
+			tombstone.Kind,
+			tombstone.Name,
+			tombstone.Organization,
+			tombstone.Environment,
+		)
+	}
+
+	s.put(getTombstonePath(ctx, tombstone.Kind, tombstone.Name), bytes, time.Duration(ttl)*time.Second)
+	return nil
+}
+
+// DeleteTombstone deletes the tombstone of the resource of the given kind and
+// name.
+func (s *Store) DeleteTombstone(ctx context.Context, kind, name string) error {
+	if kind == "" || name == "" {
+		return errors.New("must specify kind and name")
+	}
+
+	s.deleteResource(getTombstonePath(ctx, kind, name))
+	return nil
+}
+
+// GetTombstone gets the tombstone of the resource of the given kind and name.
+// A nil tombstone is returned if none was found.
+func (s *Store) GetTombstone(ctx context.Context, kind, name string) (*types.Tombstone, error) {
+	if kind == "" || name == "" {
+		return nil, errors.New("must specify kind and name")
+	}
+
+	tombstone := &types.Tombstone{}
+	if ok, err := s.getResource(getTombstonePath(ctx, kind, name), tombstone); !ok || err != nil {
+		return nil, err
+	}
+
+	return tombstone, nil
+}
+
+// GetTombstones gets the tombstones of the organization and environment of the
+// context.
+func (s *Store) GetTombstones(ctx context.Context) ([]*types.Tombstone, error) {
+	reject := rejectByQueriedEnvironment(ctx)
+	tombstones := []*types.Tombstone{}
+	err := s.query(ctx, tombstonesKeyBuilder, func(value []byte) error {
+		tombstone := &types.Tombstone{}
+		if err := store.Decode(value, tombstone); err != nil {
+			return err
+		}
+		if !reject(tombstone) {
+			tombstones = append(tombstones, tombstone)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tombstones, nil
+}
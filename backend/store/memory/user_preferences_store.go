@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+func getUserPreferencesPath(username string) string {
+	return path.Join(store.Root, "user_preferences", username)
+}
+
+// DeleteUserPreferences deletes the preferences of a user.
+func (s *Store) DeleteUserPreferences(ctx context.Context, username string) error {
+	if username == "" {
+		return errors.New("must specify username")
+	}
+
+	s.deleteResource(getUserPreferencesPath(username))
+	return nil
+}
+
+// GetUserPreferences gets the preferences of a user.
+func (s *Store) GetUserPreferences(ctx context.Context, username string) (*types.UserPreferences, error) {
+	if username == "" {
+		return nil, errors.New("must specify username")
+	}
+
+	preferences := &types.UserPreferences{}
+	if ok, err := s.getResource(getUserPreferencesPath(username), preferences); !ok || err != nil {
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+// UpdateUserPreferences updates the preferences of a user, which must exist.
+func (s *Store) UpdateUserPreferences(ctx context.Context, preferences *types.UserPreferences) error {
+	if err := preferences.Validate(); err != nil {
+		return err
+	}
+
+	bytes, err := store.Encode(preferences)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.get(getUserPath(preferences.Username)); !ok {
+		return fmt.Errorf("user %s does not exist", preferences.Username)
+	}
+	s.put(getUserPreferencesPath(preferences.Username), bytes, 0)
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+func getUserPath(username string) string {
+	return path.Join(store.Root, "users", username)
+}
+
+// AuthenticateUser authenticates a User by username and password.
+func (s *Store) AuthenticateUser(ctx context.Context, username, password string) (*types.User, error) {
+	user, err := s.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	} else if user == nil {
+		return nil, fmt.Errorf("User %s does not exist", username)
+	}
+
+	if user.Disabled {
+		return nil, fmt.Errorf("User %s is disabled", username)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
+		return nil, fmt.Errorf("Wrong password for user %s", username)
+	}
+
+	return user, nil
+}
+
+// CreateUser creates a new user
+func (s *Store) CreateUser(u *types.User) error {
+	bytes, err := encodeUser(u)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.get(getUserPath(u.Username)); ok {
+		return fmt.Errorf("user %s already exists", u.Username)
+	}
+	s.put(getUserPath(u.Username), bytes, 0)
+
+	return nil
+}
+
+// DeleteUser disables a User, and deletes its access tokens.
+func (s *Store) DeleteUser(ctx context.Context, user *types.User) error {
+	user.Disabled = true
+	bytes, err := store.Encode(user)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.get(getUserPath(user.Username)); !ok {
+		logger.WithField("username", user.Username).Info("given user was not already persisted")
+		return nil
+	}
+	s.put(getUserPath(user.Username), bytes, 0)
+	s.deletePrefix(getTokenPath(user.Username, "") + "/")
+
+	return nil
+}
+
+// GetUser gets a User.
+func (s *Store) GetUser(ctx context.Context, username string) (*types.User, error) {
+	user := &types.User{}
+	if ok, err := s.getResource(getUserPath(username), user); !ok || err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// GetUsers retrieves all enabled users
+func (s *Store) GetUsers() ([]*types.User, error) {
+	allUsers, err := s.GetAllUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	var users []*types.User
+	for _, user := range allUsers {
+		if !user.Disabled {
+			users = append(users, user)
+		}
+	}
+
+	return users, nil
+}
+
+// GetAllUsers retrieves all users
+func (s *Store) GetAllUsers() ([]*types.User, error) {
+	s.mu.RLock()
+	kvs := s.list(getUserPath("") + "/")
+	s.mu.RUnlock()
+
+	users := make([]*types.User, len(kvs))
+	for i, kv := range kvs {
+		user := &types.User{}
+		if err := store.Decode(kv.value, user); err != nil {
+			return nil, err
+		}
+		users[i] = user
+	}
+
+	return users, nil
+}
+
+// UpdateUser updates a User.
+func (s *Store) UpdateUser(u *types.User) error {
+	bytes, err := encodeUser(u)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(getUserPath(u.Username), bytes, 0)
+
+	return nil
+}
+
+// encodeUser hashes the password of the user, then encodes it.
+func encodeUser(u *types.User) ([]byte, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	u.Password = string(hash)
+
+	return store.Encode(u)
+}
@@ -0,0 +1,305 @@
+package memory
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// watchEvent is a change of a key of the store.
+type watchEvent struct {
+	key    string
+	value  []byte
+	action store.WatchActionType
+}
+
+// watcher receives the changes of the keys under its prefix. The changes are
+// queued so the store never blocks on a slow watcher, while holding its lock.
+type watcher struct {
+	prefix string
+
+	mu     sync.Mutex
+	events []watchEvent
+	ready  chan struct{}
+}
+
+// notify queues the change of the key for the watchers of its prefix. The
+// caller holds the lock.
+func (s *Store) notify(key string, value []byte, action store.WatchActionType) {
+	for w := range s.watchers {
+		if !strings.HasPrefix(key, w.prefix) {
+			continue
+		}
+		w.mu.Lock()
+		w.events = append(w.events, watchEvent{key: key, value: value, action: action})
+		w.mu.Unlock()
+		select {
+		case w.ready <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// newWatcher registers a watcher of the keys under the prefix.
+func (s *Store) newWatcher(prefix string) *watcher {
+	w := &watcher{prefix: prefix, ready: make(chan struct{}, 1)}
+
+	s.mu.Lock()
+	s.watchers[w] = struct{}{}
+	s.mu.Unlock()
+
+	return w
+}
+
+// watch calls fn with the changes watched by w, in order, until the context
+// is cancelled or fn returns false. The watcher is then unregistered.
+func (s *Store) watch(ctx context.Context, w *watcher, fn func(watchEvent) bool) {
+	defer func() {
+		s.mu.Lock()
+		delete(s.watchers, w)
+		s.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.ready:
+		}
+
+		w.mu.Lock()
+		events := w.events
+		w.events = nil
+		w.mu.Unlock()
+
+		for _, event := range events {
+			if !fn(event) {
+				return
+			}
+		}
+	}
+}
+
+// GetCheckConfigWatcher returns a channel that emits WatchEventCheckConfig structs notifying
+// the caller that a CheckConfig was updated. If the context passed is
+// cancelled, then the channel will be closed.
+func (s *Store) GetCheckConfigWatcher(ctx context.Context) <-chan store.WatchEventCheckConfig {
+	ch := make(chan store.WatchEventCheckConfig)
+
+	w := s.newWatcher(checkKeyBuilder.Build("") + "/")
+
+	go func() {
+		defer close(ch)
+		s.watch(ctx, w, func(event watchEvent) bool {
+			check := &types.CheckConfig{}
+			if err := store.Decode(event.value, check); err != nil {
+				logger.WithError(err).Error("unable to unmarshal check config from key: ", event.key)
+			}
+			select {
+			case ch <- store.WatchEventCheckConfig{Action: event.action, CheckConfig: check}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return ch
+}
+
+// GetAssetWatcher returns a channel that emits WatchEventAsset structs notifying
+// the caller that an Asset was updated. If the context passed is cancelled,
+// then the channel will be closed.
+func (s *Store) GetAssetWatcher(ctx context.Context) <-chan store.WatchEventAsset {
+	ch := make(chan store.WatchEventAsset)
+
+	w := s.newWatcher(assetKeyBuilder.Build("") + "/")
+
+	go func() {
+		defer close(ch)
+		s.watch(ctx, w, func(event watchEvent) bool {
+			asset := &types.Asset{}
+			if err := store.Decode(event.value, asset); err != nil {
+				logger.WithError(err).Error("unable to unmarshal asset from key: ", event.key)
+			}
+			select {
+			case ch <- store.WatchEventAsset{Action: event.action, Asset: asset}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return ch
+}
+
+// GetHookConfigWatcher returns a channel that emits WatchEventHookConfig structs notifying
+// the caller that a HookConfig was updated. If the context passed is
+// cancelled, then the channel will be closed.
+func (s *Store) GetHookConfigWatcher(ctx context.Context) <-chan store.WatchEventHookConfig {
+	ch := make(chan store.WatchEventHookConfig)
+
+	w := s.newWatcher(hookKeyBuilder.Build("") + "/")
+
+	go func() {
+		defer close(ch)
+		s.watch(ctx, w, func(event watchEvent) bool {
+			hook := &types.HookConfig{}
+			if err := store.Decode(event.value, hook); err != nil {
+				logger.WithError(err).Error("unable to unmarshal hook config from key: ", event.key)
+			}
+			select {
+			case ch <- store.WatchEventHookConfig{Action: event.action, HookConfig: hook}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return ch
+}
+
+// GetSilencedWatcher returns a channel that emits WatchEventSilenced structs
+// notifying the caller that a silenced entry was created, updated or deleted,
+// including when it expired. If the context passed is cancelled, then the
+// channel will be closed.
+func (s *Store) GetSilencedWatcher(ctx context.Context) <-chan store.WatchEventSilenced {
+	ch := make(chan store.WatchEventSilenced)
+
+	w := s.newWatcher(silencedKeyBuilder.Build("") + "/")
+
+	go func() {
+		defer close(ch)
+		s.watch(ctx, w, func(event watchEvent) bool {
+			silenced := &types.Silenced{}
+			if err := store.Decode(event.value, silenced); err != nil {
+				logger.WithError(err).Error("unable to unmarshal silenced entry from key: ", event.key)
+			}
+			select {
+			case ch <- store.WatchEventSilenced{Action: event.action, Silenced: silenced}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return ch
+}
+
+// GetUserWatcher returns a channel that emits WatchEventUser structs notifying
+// the caller that a user was created or updated, the users being disabled
+// rather than deleted. If the context passed is cancelled, then the channel
+// will be closed.
+func (s *Store) GetUserWatcher(ctx context.Context) <-chan store.WatchEventUser {
+	ch := make(chan store.WatchEventUser)
+
+	w := s.newWatcher(getUserPath("") + "/")
+
+	go func() {
+		defer close(ch)
+		s.watch(ctx, w, func(event watchEvent) bool {
+			user := &types.User{}
+			if err := store.Decode(event.value, user); err != nil {
+				logger.WithError(err).Error("unable to unmarshal user from key: ", event.key)
+			}
+			select {
+			case ch <- store.WatchEventUser{Action: event.action, User: user}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return ch
+}
+
+// GetClusterConfigWatcher returns a channel that emits WatchEventClusterConfig
+// structs notifying the caller that the cluster-wide defaults, or those of an
+// organization, were updated. If the context passed is cancelled, then the
+// channel will be closed.
+func (s *Store) GetClusterConfigWatcher(ctx context.Context) <-chan store.WatchEventClusterConfig {
+	ch := make(chan store.WatchEventClusterConfig)
+
+	w := s.newWatcher(getClusterConfigPath())
+
+	go func() {
+		defer close(ch)
+		s.watch(ctx, w, func(event watchEvent) bool {
+			config := &types.ClusterConfig{}
+			if event.action != store.WatchDelete {
+				if err := store.Decode(event.value, config); err != nil {
+					logger.WithError(err).Error("unable to unmarshal cluster config from key: ", event.key)
+				}
+			}
+			select {
+			case ch <- store.WatchEventClusterConfig{Action: event.action, ClusterConfig: config, Organization: getClusterConfigOrganization(event.key)}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return ch
+}
+
+// GetOrganizationWatcher returns a channel that emits WatchEventOrganization structs
+// notifying the caller that an organization was created, updated or deleted. If the
+// context passed is cancelled, then the channel will be closed.
+func (s *Store) GetOrganizationWatcher(ctx context.Context) <-chan store.WatchEventOrganization {
+	ch := make(chan store.WatchEventOrganization)
+
+	w := s.newWatcher(getOrganizationPath("") + "/")
+
+	go func() {
+		defer close(ch)
+		s.watch(ctx, w, func(event watchEvent) bool {
+			org := &types.Organization{}
+			if err := store.Decode(event.value, org); err != nil {
+				logger.WithError(err).Error("unable to unmarshal organization from key: ", event.key)
+			}
+			select {
+			case ch <- store.WatchEventOrganization{Action: event.action, Organization: org}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return ch
+}
+
+// GetEnvironmentWatcher returns a channel that emits WatchEventEnvironment structs
+// notifying the caller that an environment was created, updated or deleted. If the
+// context passed is cancelled, then the channel will be closed.
+func (s *Store) GetEnvironmentWatcher(ctx context.Context) <-chan store.WatchEventEnvironment {
+	ch := make(chan store.WatchEventEnvironment)
+
+	w := s.newWatcher(environmentKeyBuilder.Build() + "/")
+
+	go func() {
+		defer close(ch)
+		s.watch(ctx, w, func(event watchEvent) bool {
+			env := &types.Environment{}
+			if err := store.Decode(event.value, env); err != nil {
+				logger.WithError(err).Error("unable to unmarshal environment from key: ", event.key)
+			}
+			select {
+			case ch <- store.WatchEventEnvironment{Action: event.action, Environment: env}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return ch
+}
@@ -0,0 +1,159 @@
+package store
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// NamespaceCacheStore provides the organizations and environments cached by a
+// NamespaceCache.
+type NamespaceCacheStore interface {
+	OrganizationStore
+	EnvironmentStore
+}
+
+// NamespaceCache caches the organizations and environments, so they are not
+// fetched from the store for every event. The cached resources are kept up to
+// date while Watch runs, and fetched from the store on every call otherwise.
+type NamespaceCache struct {
+	store NamespaceCacheStore
+
+	mu         sync.RWMutex
+	orgs       map[string]*types.Organization
+	envs       map[string]*types.Environment
+	watching   bool
+	generation int
+}
+
+// NewNamespaceCache returns a cache of the organizations and environments of
+// the store.
+func NewNamespaceCache(store NamespaceCacheStore) *NamespaceCache {
+	return &NamespaceCache{
+		store: store,
+		orgs:  map[string]*types.Organization{},
+		envs:  map[string]*types.Environment{},
+	}
+}
+
+// GetOrganization returns the organization with the given name, which must not
+// be modified. The result is nil if none was found.
+func (c *NamespaceCache) GetOrganization(ctx context.Context, name string) (*types.Organization, error) {
+	c.mu.RLock()
+	org, ok := c.orgs[name]
+	watching, generation := c.watching, c.generation
+	c.mu.RUnlock()
+
+	if ok {
+		return org, nil
+	}
+
+	org, err := c.store.GetOrganizationByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache(watching, generation, func() { c.orgs[name] = org })
+	return org, nil
+}
+
+// GetEnvironment returns the environment of the given organization, which must
+// not be modified. The result is nil if none was found.
+func (c *NamespaceCache) GetEnvironment(ctx context.Context, org, env string) (*types.Environment, error) {
+	key := path.Join(org, env)
+
+	c.mu.RLock()
+	environment, ok := c.envs[key]
+	watching, generation := c.watching, c.generation
+	c.mu.RUnlock()
+
+	if ok {
+		return environment, nil
+	}
+
+	environment, err := c.store.GetEnvironment(ctx, org, env)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache(watching, generation, func() { c.envs[key] = environment })
+	return environment, nil
+}
+
+// cache calls fn to cache a resource fetched while the resources are watched,
+// unless the watcher invalidated them in the meantime.
+func (c *NamespaceCache) cache(watching bool, generation int, fn func()) {
+	if !watching {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.watching && c.generation == generation {
+		fn()
+	}
+}
+
+// invalidate calls fn to remove cached resources.
+func (c *NamespaceCache) invalidate(fn func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fn()
+	c.generation++
+}
+
+// reset removes every cached resource.
+func (c *NamespaceCache) reset(watching bool) {
+	c.invalidate(func() {
+		c.orgs = map[string]*types.Organization{}
+		c.envs = map[string]*types.Environment{}
+		c.watching = watching
+	})
+}
+
+// Watch keeps the cached resources up to date with the changes of the store,
+// until the context is cancelled. The watchers are restarted if either is
+// closed.
+func (c *NamespaceCache) Watch(ctx context.Context) {
+	for {
+		watchCtx, cancel := context.WithCancel(ctx)
+		orgs := c.store.GetOrganizationWatcher(watchCtx)
+		envs := c.store.GetEnvironmentWatcher(watchCtx)
+
+		// The resources are fetched again, since they may have changed
+		// before the watchers started
+		c.reset(true)
+		c.watch(orgs, envs)
+		cancel()
+		c.reset(false)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(cacheWatchRetryInterval):
+		}
+	}
+}
+
+// watch invalidates the resources modified until either watcher is closed.
+func (c *NamespaceCache) watch(orgs <-chan WatchEventOrganization, envs <-chan WatchEventEnvironment) {
+	for {
+		select {
+		case event, ok := <-orgs:
+			if !ok {
+				return
+			}
+			c.invalidate(func() { delete(c.orgs, event.Organization.Name) })
+		case event, ok := <-envs:
+			if !ok {
+				return
+			}
+			key := path.Join(event.Environment.Organization, event.Environment.Name)
+			c.invalidate(func() { delete(c.envs, key) })
+		}
+	}
+}
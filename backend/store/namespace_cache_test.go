@@ -0,0 +1,100 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type namespaceStore struct {
+	NamespaceCacheStore
+	orgs       map[string]*types.Organization
+	envs       map[string]*types.Environment
+	gets       int
+	orgWatcher chan WatchEventOrganization
+	envWatcher chan WatchEventEnvironment
+}
+
+func (s *namespaceStore) GetOrganizationByName(_ context.Context, name string) (*types.Organization, error) {
+	s.gets++
+	return s.orgs[name], nil
+}
+
+func (s *namespaceStore) GetEnvironment(_ context.Context, org, env string) (*types.Environment, error) {
+	s.gets++
+	return s.envs[org+"/"+env], nil
+}
+
+func (s *namespaceStore) GetOrganizationWatcher(context.Context) <-chan WatchEventOrganization {
+	return s.orgWatcher
+}
+
+func (s *namespaceStore) GetEnvironmentWatcher(context.Context) <-chan WatchEventEnvironment {
+	return s.envWatcher
+}
+
+func TestNamespaceCache(t *testing.T) {
+	org := types.FixtureOrganization("default")
+	env := types.FixtureEnvironment("default")
+	store := &namespaceStore{
+		orgs:       map[string]*types.Organization{"default": org},
+		envs:       map[string]*types.Environment{"default/default": env},
+		orgWatcher: make(chan WatchEventOrganization),
+		envWatcher: make(chan WatchEventEnvironment),
+	}
+	cache := NewNamespaceCache(store)
+	ctx := context.Background()
+
+	// The resources are fetched on every call while not watched
+	for i := 0; i < 2; i++ {
+		_, err := cache.GetOrganization(ctx, "default")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, store.gets)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go cache.Watch(ctx)
+
+	// The resources are fetched once while watched, even if missing. The
+	// events are sent twice, so the first one was handled once sent.
+	store.orgWatcher <- WatchEventOrganization{Action: WatchUpdate, Organization: org}
+	store.orgWatcher <- WatchEventOrganization{Action: WatchUpdate, Organization: org}
+	for i := 0; i < 2; i++ {
+		result, err := cache.GetOrganization(ctx, "default")
+		require.NoError(t, err)
+		assert.Equal(t, org, result)
+		environment, err := cache.GetEnvironment(ctx, "default", "default")
+		require.NoError(t, err)
+		assert.Equal(t, env, environment)
+		environment, err = cache.GetEnvironment(ctx, "default", "missing")
+		require.NoError(t, err)
+		assert.Nil(t, environment)
+	}
+	assert.Equal(t, 5, store.gets)
+
+	// The modified environments are fetched again
+	updated := types.FixtureEnvironment("default")
+	updated.Labels = map[string]string{"tier": "1"}
+	store.envs["default/default"] = updated
+	store.envWatcher <- WatchEventEnvironment{Action: WatchUpdate, Environment: updated}
+	store.envWatcher <- WatchEventEnvironment{Action: WatchUpdate, Environment: updated}
+	environment, err := cache.GetEnvironment(ctx, "default", "default")
+	require.NoError(t, err)
+	assert.Equal(t, updated, environment)
+	_, err = cache.GetOrganization(ctx, "default")
+	require.NoError(t, err)
+	assert.Equal(t, 6, store.gets)
+
+	// The modified organizations are fetched again
+	delete(store.orgs, "default")
+	store.orgWatcher <- WatchEventOrganization{Action: WatchDelete, Organization: org}
+	store.orgWatcher <- WatchEventOrganization{Action: WatchDelete, Organization: org}
+	result, err := cache.GetOrganization(ctx, "default")
+	require.NoError(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, 7, store.gets)
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/sensu/sensu-go/types"
 )
@@ -50,6 +51,29 @@ type WatchEventAsset struct {
 	Action WatchActionType
 }
 
+// A WatchEventClusterConfig contains the modified cluster-wide defaults and
+// the action that occurred during the modification. The organization is set
+// if the defaults of an organization were modified.
+type WatchEventClusterConfig struct {
+	ClusterConfig *types.ClusterConfig
+	Organization  string
+	Action        WatchActionType
+}
+
+// A WatchEventEnvironment contains the modified environment and the action
+// that occurred during the modification.
+type WatchEventEnvironment struct {
+	Environment *types.Environment
+	Action      WatchActionType
+}
+
+// A WatchEventOrganization contains the modified organization and the action
+// that occurred during the modification.
+type WatchEventOrganization struct {
+	Organization *types.Organization
+	Action       WatchActionType
+}
+
 // A WatchEventHookConfig contains the modified asset object and the action that occurred
 // during the modification.
 type WatchEventHookConfig struct {
@@ -57,6 +81,20 @@ type WatchEventHookConfig struct {
 	Action     WatchActionType
 }
 
+// A WatchEventSilenced contains the modified silenced entry and the action that
+// occurred during the modification.
+type WatchEventSilenced struct {
+	Silenced *types.Silenced
+	Action   WatchActionType
+}
+
+// A WatchEventUser contains the modified user and the action that occurred
+// during the modification.
+type WatchEventUser struct {
+	User   *types.User
+	Action WatchActionType
+}
+
 // Store is used to abstract the durable storage used by the Sensu backend
 // processses. Each Sensu resources is represented by its own interface. A
 // MockStore is available in order to mock a store implementation
@@ -70,15 +108,33 @@ type Store interface {
 	// CheckConfigStore provides an interface for managing checks configuration
 	CheckConfigStore
 
+	// CheckTemplateStore provides an interface for managing check templates
+	CheckTemplateStore
+
+	// ClusterConfigStore provides an interface for managing the cluster-wide
+	// defaults
+	ClusterConfigStore
+
+	// ContactCounterStore provides an interface for counting the
+	// notifications of the contacts
+	ContactCounterStore
+
 	// EntityStore provides an interface for managing entities
 	EntityStore
 
+	// EntityGroupStore provides an interface for managing entity groups
+	EntityGroupStore
+
 	// EnvironmentStore provides an interface for managing environments
 	EnvironmentStore
 
 	// ErrorStore provides an interface for managing pipeline errors
 	ErrorStore
 
+	// EventDigestStore provides an interface for managing the digests of the
+	// last events handled
+	EventDigestStore
+
 	// EventStore provides an interface for managing events
 	EventStore
 
@@ -91,6 +147,9 @@ type Store interface {
 	// HookConfigStore provides an interface for managing hooks configuration
 	HookConfigStore
 
+	// IncidentStore provides an interface for managing incidents
+	IncidentStore
+
 	// KeepaliveStore provides an interface for managing entities keepalives
 	KeepaliveStore
 
@@ -100,19 +159,37 @@ type Store interface {
 	// OrganizationStore provides an interface for managing organizations
 	OrganizationStore
 
+	// PipelineStore provides an interface for managing pipelines
+	PipelineStore
+
 	// RBACStore provides an interface for managing RBAC roles and rules
 	RBACStore
 
+	// RoleBindingStore provides an interface for managing role bindings
+	RoleBindingStore
+
 	// SilencedStore provides an interface for managing silenced entries,
 	// consisting of entities, subscriptions and/or checks
 	SilencedStore
 
+	// TessenStore provides an interface for managing the anonymous usage
+	// reporting
+	TessenStore
+
 	// TokenStore provides an interface for managing the JWT access list
 	TokenStore
 
+	// TombstoneStore provides an interface for managing the deleted resources
+	// kept during the undo window
+	TombstoneStore
+
 	// UserStore provides an interface for managing users
 	UserStore
 
+	// UserPreferencesStore provides an interface for managing the preferences
+	// of the users
+	UserPreferencesStore
+
 	// NewInitializer returns the Initializer interfaces, which provides the
 	// required mechanism to verify if a store is initialized
 	NewInitializer() (Initializer, error)
@@ -181,6 +258,50 @@ type CheckConfigStore interface {
 	GetCheckConfigWatcher(ctx context.Context) <-chan WatchEventCheckConfig
 }
 
+// CheckTemplateStore provides methods for managing check templates
+type CheckTemplateStore interface {
+	// DeleteCheckTemplateByName deletes a check template using the given name
+	// and the organization and environment stored in ctx.
+	DeleteCheckTemplateByName(ctx context.Context, name string) error
+
+	// GetCheckTemplates returns all check templates in the given ctx's
+	// organization and environment. A nil slice with no error is returned if
+	// none were found.
+	GetCheckTemplates(ctx context.Context) ([]*types.CheckTemplate, error)
+
+	// GetCheckTemplateByName returns a check template using the given name and
+	// the organization and environment stored in ctx. The resulting check
+	// template is nil if none was found.
+	GetCheckTemplateByName(ctx context.Context, name string) (*types.CheckTemplate, error)
+
+	// UpdateCheckTemplate creates or updates a given check template.
+	UpdateCheckTemplate(ctx context.Context, tmpl *types.CheckTemplate) error
+}
+
+// ClusterConfigStore provides methods for managing the cluster-wide defaults
+type ClusterConfigStore interface {
+	// GetClusterConfig returns the cluster-wide defaults. Empty defaults are
+	// returned if none were stored.
+	GetClusterConfig(ctx context.Context) (*types.ClusterConfig, error)
+
+	// UpdateClusterConfig creates or updates the cluster-wide defaults.
+	UpdateClusterConfig(ctx context.Context, config *types.ClusterConfig) error
+
+	// GetOrganizationConfig returns the defaults of the given organization,
+	// which override the cluster-wide defaults. Empty defaults are returned if
+	// none were stored.
+	GetOrganizationConfig(ctx context.Context, org string) (*types.ClusterConfig, error)
+
+	// UpdateOrganizationConfig creates or updates the defaults of the given
+	// organization.
+	UpdateOrganizationConfig(ctx context.Context, org string, config *types.ClusterConfig) error
+
+	// GetClusterConfigWatcher returns a channel that emits
+	// WatchEventClusterConfig structs notifying the caller that the
+	// cluster-wide defaults, or those of an organization, were updated.
+	GetClusterConfigWatcher(ctx context.Context) <-chan WatchEventClusterConfig
+}
+
 // HookConfigStore provides methods for managing hooks configuration
 type HookConfigStore interface {
 	// DeleteHookConfigByName deletes a hook's configuration using the given name
@@ -216,17 +337,37 @@ type EntityStore interface {
 	// organization and environment stored in ctx.
 	DeleteEntityByID(ctx context.Context, id string) error
 
+	// DisconnectEntityStatus updates the connection status of an agent which
+	// disconnected, unless the stored status belongs to another session of the
+	// agent.
+	DisconnectEntityStatus(ctx context.Context, status *types.EntityStatus) error
+
 	// GetEntities returns all entities in the given ctx's organization and
 	// environment. A nil slice with no error is returned if none were found.
 	GetEntities(ctx context.Context) ([]*types.Entity, error)
 
+	// GetEntitiesByIndex returns the entities in the given ctx's organization
+	// and environment having the given value in the index, without scanning
+	// all the entities. An empty slice with no error is returned if none were
+	// found.
+	GetEntitiesByIndex(ctx context.Context, index EntityIndex, value string) ([]*types.Entity, error)
+
 	// GetEntityByID returns an entity using the given id and the organization
 	// and environment stored in ctx. The resulting entity is nil if none was
 	// found.
 	GetEntityByID(ctx context.Context, id string) (*types.Entity, error)
 
+	// GetEntityStatus returns the connection status of the agent of the
+	// entity with the given id, in the organization and environment stored in
+	// ctx. The resulting status is nil if none was found.
+	GetEntityStatus(ctx context.Context, id string) (*types.EntityStatus, error)
+
 	// UpdateEntity creates or updates a given entity.
 	UpdateEntity(ctx context.Context, entity *types.Entity) error
+
+	// UpdateEntityStatus creates or updates the connection status of the
+	// agent of an entity.
+	UpdateEntityStatus(ctx context.Context, status *types.EntityStatus) error
 }
 
 // EnvironmentStore provides methods for managing environments
@@ -244,6 +385,10 @@ type EnvironmentStore interface {
 
 	// UpdateEnvironment creates or updates a given env.
 	UpdateEnvironment(ctx context.Context, env *types.Environment) error
+
+	// GetEnvironmentWatcher returns a channel that emits WatchEventEnvironment
+	// structs notifying the caller that an environment was updated.
+	GetEnvironmentWatcher(ctx context.Context) <-chan WatchEventEnvironment
 }
 
 // ErrorStore provides methods for managing pipeline errors
@@ -282,6 +427,33 @@ type ErrorStore interface {
 	CreateError(ctx context.Context, error *types.Error) error
 }
 
+// ContactCounterStore provides methods for counting the executions of the
+// handlers for each contact of the events, in order to rate limit their
+// notifications
+type ContactCounterStore interface {
+	// IncrementContactCounter increments the number of executions of the
+	// handler for the contact during the current window of the given
+	// duration, within the organization and environment stored in ctx, and
+	// returns the new count. The windows are aligned on the Unix epoch, each
+	// starting with a count of zero.
+	IncrementContactCounter(ctx context.Context, handler, contact string, window time.Duration) (int64, error)
+}
+
+// EventDigestStore provides methods for managing the digests of the last
+// events handled by the handlers, in order to detect repeated events
+type EventDigestStore interface {
+	// GetEventDigest returns the digest of the last event of the given entity
+	// and check handled by the handler, within the organization and
+	// environment stored in ctx. The digest is empty if none was stored.
+	GetEventDigest(ctx context.Context, handler, entity, check string) (string, error)
+
+	// UpdateEventDigest stores the digest of the last event of the given
+	// entity and check handled by the handler, within the organization and
+	// environment stored in ctx. The digests expire after a day without
+	// events.
+	UpdateEventDigest(ctx context.Context, handler, entity, check, digest string) error
+}
+
 // EventStore provides methods for managing events
 type EventStore interface {
 	// DeleteEventByEntityCheck deletes an event using the given entity and check,
@@ -346,15 +518,70 @@ type HandlerStore interface {
 
 // KeepaliveStore provides methods for managing entities keepalives
 type KeepaliveStore interface {
-	// DeleteFailingKeepalive deletes a failing keepalive record for a given entity.
-	DeleteFailingKeepalive(ctx context.Context, entity *types.Entity) error
+	// ClaimExpiredKeepalive deletes the given expired keepalive and returns
+	// true, unless it was already claimed or superseded by a newer keepalive.
+	ClaimExpiredKeepalive(ctx context.Context, keepalive *types.KeepaliveRecord) (bool, error)
+
+	// DeleteKeepalive deletes the keepalive of a given entity.
+	DeleteKeepalive(ctx context.Context, entity *types.Entity) error
 
-	// GetFailingKeepalives returns a slice of failing keepalives.
-	GetFailingKeepalives(ctx context.Context) ([]*types.KeepaliveRecord, error)
+	// GetExpiredKeepalives returns the keepalives that expired at or before
+	// the given unix timestamp.
+	GetExpiredKeepalives(ctx context.Context, now int64) ([]*types.KeepaliveRecord, error)
 
-	// UpdateFailingKeepalive updates the given entity keepalive with the given expiration
-	// in unix timestamp format
-	UpdateFailingKeepalive(ctx context.Context, entity *types.Entity, expiration int64) error
+	// UpdateKeepalive sets the given entity keepalive to expire at the given
+	// unix timestamp.
+	UpdateKeepalive(ctx context.Context, entity *types.Entity, expiration int64) error
+}
+
+// EntityGroupStore provides methods for managing entity groups
+type EntityGroupStore interface {
+	// DeleteEntityGroupByName deletes an entity group using the given name and
+	// the organization and environment stored in ctx.
+	DeleteEntityGroupByName(ctx context.Context, name string) error
+
+	// GetEntityGroups returns all entity groups in the given ctx's
+	// organization and environment. A nil slice with no error is returned if
+	// none were found.
+	GetEntityGroups(ctx context.Context) ([]*types.EntityGroup, error)
+
+	// GetEntityGroupByName returns an entity group using the given name and
+	// the organization and environment stored in ctx. The resulting entity
+	// group is nil if none was found.
+	GetEntityGroupByName(ctx context.Context, name string) (*types.EntityGroup, error)
+
+	// UpdateEntityGroup creates or updates a given entity group.
+	UpdateEntityGroup(ctx context.Context, group *types.EntityGroup) error
+}
+
+// IncidentStore provides methods for managing incidents
+type IncidentStore interface {
+	// DeleteIncidentByID deletes an incident using the given ID and the
+	// organization and environment stored in ctx.
+	DeleteIncidentByID(ctx context.Context, id string) error
+
+	// GetIncidents returns all incidents in the given ctx's organization and
+	// environment. A nil slice with no error is returned if none were found.
+	GetIncidents(ctx context.Context) ([]*types.Incident, error)
+
+	// GetIncidentByID returns an incident using the given ID and the
+	// organization and environment stored in ctx. The resulting incident is
+	// nil if none was found.
+	GetIncidentByID(ctx context.Context, id string) (*types.Incident, error)
+
+	// GetUnresolvedIncidents returns the incidents which are not resolved in
+	// the given ctx's organization and environment, without reading the
+	// resolved incidents.
+	GetUnresolvedIncidents(ctx context.Context) ([]*types.Incident, error)
+
+	// UpdateIncident creates or updates a given incident. The resolved
+	// incidents expire after a while.
+	UpdateIncident(ctx context.Context, incident *types.Incident) error
+
+	// UpdateIncidentWith atomically updates the incident of the given ID and
+	// the organization and environment stored in ctx, by applying update to
+	// its stored version. Nothing is updated if the incident does not exist.
+	UpdateIncidentWith(ctx context.Context, id string, update func(*types.Incident) error) error
 }
 
 // MutatorStore provides methods for managing events mutators
@@ -391,6 +618,30 @@ type OrganizationStore interface {
 
 	// UpdateOrganization creates or updates a given organization.
 	UpdateOrganization(ctx context.Context, org *types.Organization) error
+
+	// GetOrganizationWatcher returns a channel that emits
+	// WatchEventOrganization structs notifying the caller that an
+	// organization was updated.
+	GetOrganizationWatcher(ctx context.Context) <-chan WatchEventOrganization
+}
+
+// PipelineStore provides methods for managing pipelines
+type PipelineStore interface {
+	// DeletePipelineByName deletes a pipeline using the given name and the
+	// organization and environment stored in ctx.
+	DeletePipelineByName(ctx context.Context, name string) error
+
+	// GetPipelines returns all pipelines in the given ctx's organization and
+	// environment. A nil slice with no error is returned if none were found.
+	GetPipelines(ctx context.Context) ([]*types.Pipeline, error)
+
+	// GetPipelineByName returns a pipeline using the given name and the
+	// organization and environment stored in ctx. The resulting pipeline is
+	// nil if none was found.
+	GetPipelineByName(ctx context.Context, name string) (*types.Pipeline, error)
+
+	// UpdatePipeline creates or updates a given pipeline.
+	UpdatePipeline(ctx context.Context, pipeline *types.Pipeline) error
 }
 
 // RBACStore provides methods for managing RBAC roles and rules
@@ -410,6 +661,26 @@ type RBACStore interface {
 	UpdateRole(ctx context.Context, role *types.Role) error
 }
 
+// RoleBindingStore provides methods for managing role bindings
+type RoleBindingStore interface {
+	// DeleteRoleBindingByName deletes a role binding using the given name and
+	// the organization and environment stored in ctx.
+	DeleteRoleBindingByName(ctx context.Context, name string) error
+
+	// GetRoleBindings returns all role bindings in the given ctx's
+	// organization and environment, which can be wildcards. A nil slice with
+	// no error is returned if none were found.
+	GetRoleBindings(ctx context.Context) ([]*types.RoleBinding, error)
+
+	// GetRoleBindingByName returns a role binding using the given name and the
+	// organization and environment stored in ctx. The resulting role binding
+	// is nil if none was found.
+	GetRoleBindingByName(ctx context.Context, name string) (*types.RoleBinding, error)
+
+	// UpdateRoleBinding creates or updates a given role binding.
+	UpdateRoleBinding(ctx context.Context, binding *types.RoleBinding) error
+}
+
 // SilencedStore provides methods for managing silenced entries,
 // consisting of entities, subscriptions and/or checks
 type SilencedStore interface {
@@ -437,6 +708,25 @@ type SilencedStore interface {
 
 	// UpdateHandler creates or updates a given entry.
 	UpdateSilencedEntry(ctx context.Context, entry *types.Silenced) error
+
+	// GetSilencedWatcher returns a channel that emits WatchEventSilenced
+	// structs notifying the caller that a silenced entry was created, updated
+	// or deleted.
+	GetSilencedWatcher(ctx context.Context) <-chan WatchEventSilenced
+}
+
+// TessenStore provides methods for managing the anonymous usage reporting
+type TessenStore interface {
+	// GetClusterID returns the unique identifier of the cluster.
+	GetClusterID(ctx context.Context) (string, error)
+
+	// GetTessenConfig returns the Tessen configuration. The default
+	// configuration, which opts out of reporting, is returned if none was
+	// stored.
+	GetTessenConfig(ctx context.Context) (*types.TessenConfig, error)
+
+	// UpdateTessenConfig creates or updates the Tessen configuration.
+	UpdateTessenConfig(ctx context.Context, config *types.TessenConfig) error
 }
 
 // TokenStore provides methods for managing the JWT access list
@@ -453,6 +743,27 @@ type TokenStore interface {
 	GetToken(subject, id string) (*types.Claims, error)
 }
 
+// TombstoneStore provides methods for managing the deleted resources kept
+// during the undo window
+type TombstoneStore interface {
+	// CreateTombstone creates or replaces the tombstone of a deleted resource,
+	// which expires after the given number of seconds.
+	CreateTombstone(ctx context.Context, tombstone *types.Tombstone, ttl int64) error
+
+	// DeleteTombstone deletes the tombstone of the resource of the given kind
+	// and name, in the organization and environment of the context.
+	DeleteTombstone(ctx context.Context, kind, name string) error
+
+	// GetTombstone returns the tombstone of the resource of the given kind and
+	// name, in the organization and environment of the context. The result
+	// is nil if none was found.
+	GetTombstone(ctx context.Context, kind, name string) (*types.Tombstone, error)
+
+	// GetTombstones returns the tombstones in the organization and environment
+	// of the context.
+	GetTombstones(ctx context.Context) ([]*types.Tombstone, error)
+}
+
 // UserStore provides methods for managing users
 type UserStore interface {
 	// AuthenticateUser attempts to authenticate a user with the given username
@@ -479,6 +790,24 @@ type UserStore interface {
 
 	// UpdateHandler updates a given user.
 	UpdateUser(user *types.User) error
+
+	// GetUserWatcher returns a channel that emits WatchEventUser structs
+	// notifying the caller that a user was created or updated.
+	GetUserWatcher(ctx context.Context) <-chan WatchEventUser
+}
+
+// UserPreferencesStore provides methods for managing the preferences of the
+// users
+type UserPreferencesStore interface {
+	// DeleteUserPreferences deletes the preferences of the given user.
+	DeleteUserPreferences(ctx context.Context, username string) error
+
+	// GetUserPreferences returns the preferences of the given user, or nil if
+	// the user has none.
+	GetUserPreferences(ctx context.Context, username string) (*types.UserPreferences, error)
+
+	// UpdateUserPreferences creates or updates the preferences of a user.
+	UpdateUserPreferences(ctx context.Context, preferences *types.UserPreferences) error
 }
 
 // Initializer provides methods to verify if a store is initialized
@@ -0,0 +1,90 @@
+package tessend
+
+import (
+	"context"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/version"
+)
+
+// Collect gathers the anonymous usage data of the cluster: its identifier,
+// the version of the backend and the number of resources of each type. This
+// is exactly what is reported when the cluster opts in.
+func Collect(ctx context.Context, s store.Store) (*types.TessenData, error) {
+	clusterID, err := s.GetClusterID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Count the resources of all organizations and environments
+	ctx = context.WithValue(ctx, types.OrganizationKey, "*")
+	ctx = context.WithValue(ctx, types.EnvironmentKey, "*")
+
+	counts := []struct {
+		resource string
+		count    func() (int, error)
+	}{
+		{types.RuleTypeAsset, func() (int, error) {
+			r, err := s.GetAssets(ctx)
+			return len(r), err
+		}},
+		{types.RuleTypeCheck, func() (int, error) {
+			r, err := s.GetCheckConfigs(ctx)
+			return len(r), err
+		}},
+		{types.RuleTypeEntity, func() (int, error) {
+			r, err := s.GetEntities(ctx)
+			return len(r), err
+		}},
+		{types.RuleTypeEnvironment, func() (int, error) {
+			r, err := s.GetEnvironments(ctx, "*")
+			return len(r), err
+		}},
+		{types.RuleTypeEventFilter, func() (int, error) {
+			r, err := s.GetEventFilters(ctx)
+			return len(r), err
+		}},
+		{types.RuleTypeHandler, func() (int, error) {
+			r, err := s.GetHandlers(ctx)
+			return len(r), err
+		}},
+		{types.RuleTypeHook, func() (int, error) {
+			r, err := s.GetHookConfigs(ctx)
+			return len(r), err
+		}},
+		{types.RuleTypeMutator, func() (int, error) {
+			r, err := s.GetMutators(ctx)
+			return len(r), err
+		}},
+		{types.RuleTypeOrganization, func() (int, error) {
+			r, err := s.GetOrganizations(ctx)
+			return len(r), err
+		}},
+		{types.RuleTypeSilenced, func() (int, error) {
+			r, err := s.GetSilencedEntries(ctx)
+			return len(r), err
+		}},
+		{types.RuleTypeUser, func() (int, error) {
+			r, err := s.GetUsers()
+			return len(r), err
+		}},
+	}
+
+	data := &types.TessenData{
+		ClusterID: clusterID,
+		Version:   version.Semver(),
+		Resources: make(map[string]int, len(counts)),
+		Timestamp: time.Now().Unix(),
+	}
+	for _, c := range counts {
+		n, err := c.count()
+		if err != nil {
+			return nil, err
+		}
+		data.Resources[c.resource] = n
+	}
+
+	return data, nil
+}
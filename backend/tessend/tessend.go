@@ -0,0 +1,188 @@
+// Package tessend reports anonymous usage data of the Sensu cluster, when the
+// cluster opted in, so the Sensu developers can learn how it is used.
+//
+// Nothing is ever reported unless the Tessen configuration opts in, and the
+// data that would be reported can be previewed with Collect.
+package tessend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/leader"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+const (
+	// DefaultURL is the url to which the usage data is reported.
+	DefaultURL = "https://tessen.sensu.io/v1/data"
+
+	// DefaultInterval is how often the usage data is reported.
+	DefaultInterval = 24 * time.Hour
+
+	// requestTimeout is the timeout of the requests reporting the usage data
+	requestTimeout = 30 * time.Second
+)
+
+// leaderRetryInterval is how long tessend waits before campaigning again for
+// leadership after an error.
+var leaderRetryInterval = 5 * time.Second
+
+// Tessend is the daemon reporting the anonymous usage data. Only the cluster
+// leader reports, so each cluster is reported once per interval.
+type Tessend struct {
+	Store store.Store
+
+	// URL is the url to which the usage data is reported. Defaults to
+	// DefaultURL.
+	URL string
+
+	// Interval is how often the usage data is reported. Defaults to
+	// DefaultInterval.
+	Interval time.Duration
+
+	client   *http.Client
+	wg       *sync.WaitGroup
+	stopping chan struct{}
+	cancel   context.CancelFunc
+	errChan  chan error
+}
+
+// Start the Tessen daemon.
+func (t *Tessend) Start() error {
+	if t.Store == nil {
+		return errors.New("no store found")
+	}
+
+	if t.URL == "" {
+		t.URL = DefaultURL
+	}
+
+	if t.Interval == 0 {
+		t.Interval = DefaultInterval
+	}
+
+	t.client = &http.Client{Timeout: requestTimeout}
+	t.wg = &sync.WaitGroup{}
+	t.stopping = make(chan struct{})
+	t.errChan = make(chan error, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	t.wg.Add(1)
+	go t.lead(ctx)
+
+	return nil
+}
+
+// Stop the Tessen daemon.
+func (t *Tessend) Stop() error {
+	close(t.stopping)
+	t.cancel()
+	t.wg.Wait()
+	close(t.errChan)
+	return nil
+}
+
+// Status returns an error if tessend is unhealthy.
+func (t *Tessend) Status() error {
+	return nil
+}
+
+// Err returns a channel to listen for terminal errors on.
+func (t *Tessend) Err() <-chan error {
+	return t.errChan
+}
+
+// lead campaigns for the cluster leadership and reports the usage data
+// whenever this backend holds it, until the daemon is stopped and ctx is
+// cancelled, which stops campaigning on followers.
+func (t *Tessend) lead(ctx context.Context) {
+	defer t.wg.Done()
+
+	for {
+		err := leader.Do(ctx, t.reportLoop)
+
+		select {
+		case <-t.stopping:
+			return
+		default:
+		}
+
+		if err != nil {
+			logger.WithError(err).Error("error campaigning for leadership")
+			select {
+			case <-t.stopping:
+				return
+			case <-time.After(leaderRetryInterval):
+			}
+		}
+	}
+}
+
+// reportLoop reports the usage data once per interval, until the leadership
+// is lost or the daemon is stopped.
+func (t *Tessend) reportLoop(ctx context.Context) error {
+	ticker := time.NewTicker(t.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := t.report(ctx); err != nil {
+			logger.WithError(err).Warn("could not report the anonymous usage data")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.stopping:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// report sends the usage data, if the cluster opted in.
+func (t *Tessend) report(ctx context.Context) error {
+	config, err := t.Store.GetTessenConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if !config.OptIn {
+		return nil
+	}
+
+	data, err := Collect(ctx, t.Store)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, t.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+
+	logger.WithField("url", t.URL).Debug("reported the anonymous usage data")
+	return nil
+}
@@ -0,0 +1,102 @@
+package tessend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/leader"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newUsageStore() *mockstore.MockStore {
+	store := &mockstore.MockStore{}
+	store.On("GetClusterID", mock.Anything).Return("cafe", nil)
+	store.On("GetAssets", mock.Anything).Return([]*types.Asset{}, nil)
+	store.On("GetCheckConfigs", mock.Anything).Return([]*types.CheckConfig{
+		types.FixtureCheckConfig("check1"),
+		types.FixtureCheckConfig("check2"),
+	}, nil)
+	store.On("GetEntities", mock.Anything).Return([]*types.Entity{
+		types.FixtureEntity("entity1"),
+	}, nil)
+	store.On("GetEnvironments", mock.Anything, "*").Return([]*types.Environment{}, nil)
+	store.On("GetEventFilters", mock.Anything).Return([]*types.EventFilter{}, nil)
+	store.On("GetHandlers", mock.Anything).Return([]*types.Handler{}, nil)
+	store.On("GetHookConfigs", mock.Anything).Return([]*types.HookConfig{}, nil)
+	store.On("GetMutators", mock.Anything).Return([]*types.Mutator{}, nil)
+	store.On("GetOrganizations", mock.Anything).Return([]*types.Organization{}, nil)
+	store.On("GetSilencedEntries", mock.Anything).Return([]*types.Silenced{}, nil)
+	store.On("GetUsers").Return([]*types.User{types.FixtureUser("foo")}, nil)
+	return store
+}
+
+func TestCollect(t *testing.T) {
+	store := newUsageStore()
+
+	data, err := Collect(context.Background(), store)
+	require.NoError(t, err)
+	assert.Equal(t, "cafe", data.ClusterID)
+	assert.Equal(t, 2, data.Resources[types.RuleTypeCheck])
+	assert.Equal(t, 1, data.Resources[types.RuleTypeEntity])
+	assert.Equal(t, 1, data.Resources[types.RuleTypeUser])
+	assert.Equal(t, 0, data.Resources[types.RuleTypeHandler])
+	assert.NotZero(t, data.Timestamp)
+
+	store = &mockstore.MockStore{}
+	store.On("GetClusterID", mock.Anything).Return("", errors.New("error"))
+	_, err = Collect(context.Background(), store)
+	assert.Error(t, err)
+}
+
+func TestReport(t *testing.T) {
+	leader.Override()
+
+	reports := make(chan *types.TessenData, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := &types.TessenData{}
+		if err := json.NewDecoder(r.Body).Decode(data); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		reports <- data
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		name    string
+		optIn   bool
+		reports bool
+	}{
+		{"opted in", true, true},
+		{"opted out", false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := newUsageStore()
+			store.On("GetTessenConfig", mock.Anything).Return(types.FixtureTessenConfig(tc.optIn), nil)
+
+			tessend := &Tessend{Store: store, URL: server.URL, Interval: time.Hour}
+			require.NoError(t, tessend.Start())
+
+			select {
+			case data := <-reports:
+				assert.True(t, tc.reports, "unexpected report")
+				assert.Equal(t, "cafe", data.ClusterID)
+			case <-time.After(100 * time.Millisecond):
+				assert.False(t, tc.reports, "no report received")
+			}
+
+			assert.NoError(t, tessend.Stop())
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Package trapd receives the SNMP traps sent to the backend and publishes
+// them as events of proxy entities.
+package trapd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/snmp"
+	"github.com/sensu/sensu-go/types"
+)
+
+// ComponentName identifies Trapd as the component/daemon implemented in this
+// package.
+const ComponentName = "trapd"
+
+// Trapd is the daemon receiving SNMP traps.
+type Trapd struct {
+	Store      store.EntityStore
+	MessageBus messaging.MessageBus
+
+	Host string
+	Port int
+
+	// Communities are the community strings of the traps accepted. All the
+	// traps are accepted if empty.
+	Communities []string
+
+	// Mappings translate the traps into events.
+	Mappings []snmp.Mapping
+
+	conn     net.PacketConn
+	stopping chan struct{}
+	errChan  chan error
+	wg       *sync.WaitGroup
+}
+
+// Start trapd, listening for traps on its UDP port.
+func (t *Trapd) Start() error {
+	if t.Store == nil {
+		return errors.New("no store found")
+	}
+
+	if t.MessageBus == nil {
+		return errors.New("no message bus found")
+	}
+
+	addr := fmt.Sprintf("%s:%d", t.Host, t.Port)
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+	t.conn = conn
+
+	t.stopping = make(chan struct{})
+	t.errChan = make(chan error, 1)
+	t.wg = &sync.WaitGroup{}
+
+	receiver := &snmp.Receiver{
+		Communities: t.Communities,
+		Mappings:    t.Mappings,
+		Handler:     t.handleEvent,
+	}
+
+	logger.Info("starting trapd on address: ", conn.LocalAddr().String())
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		err := receiver.Serve(conn)
+		select {
+		case <-t.stopping:
+		default:
+			t.errChan <- err
+		}
+	}()
+
+	return nil
+}
+
+// Stop trapd.
+func (t *Trapd) Stop() error {
+	close(t.stopping)
+	err := t.conn.Close()
+	t.wg.Wait()
+	close(t.errChan)
+	return err
+}
+
+// Status returns an error if trapd is unhealthy.
+func (t *Trapd) Status() error {
+	return nil
+}
+
+// Err returns a channel to listen for terminal errors on.
+func (t *Trapd) Err() <-chan error {
+	return t.errChan
+}
+
+// Addr returns the address trapd listens on.
+func (t *Trapd) Addr() net.Addr {
+	return t.conn.LocalAddr()
+}
+
+// handleEvent publishes the event translated from a trap, after replacing its
+// entity with the proxy entity from the store.
+func (t *Trapd) handleEvent(event *types.Event) {
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, event.Entity.Organization)
+	ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
+
+	entity, err := getProxyEntity(ctx, t.Store, event.Entity)
+	if err != nil {
+		logger.WithError(err).Error("error handling SNMP trap")
+		return
+	}
+	event.Entity = entity
+
+	if err := event.Validate(); err != nil {
+		logger.WithError(err).Error("invalid event translated from SNMP trap")
+		return
+	}
+
+	if err := t.MessageBus.Publish(messaging.TopicEventRaw, event); err != nil {
+		logger.WithError(err).Error("error publishing SNMP trap event")
+	}
+}
+
+// getProxyEntity returns the stored proxy entity, which is created if it
+// doesn't exist yet.
+func getProxyEntity(ctx context.Context, st store.EntityStore, proxy *types.Entity) (*types.Entity, error) {
+	entity, err := st.GetEntityByID(ctx, proxy.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not query the store for a proxy entity: %s", err)
+	}
+	if entity != nil {
+		return entity, nil
+	}
+
+	proxy.Subscriptions = []string{types.GetEntitySubscription(proxy.ID)}
+	if err := st.UpdateEntity(ctx, proxy); err != nil {
+		return nil, fmt.Errorf("could not create a proxy entity: %s", err)
+	}
+
+	return proxy, nil
+}
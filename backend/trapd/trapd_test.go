@@ -0,0 +1,76 @@
+package trapd
+
+import (
+	"encoding/hex"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/snmp"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// linkDown trap of switch01, sent with the public community
+const linkDownTrap = "305702010104067075626c6963a74a020101020100020100303f300e06082b0601020101030043020100" +
+	"3017060a2b06010603010104010006092b0601060301010503301406082b0601020101050004087377697463683031"
+
+func TestTrapd(t *testing.T) {
+	bus := &messaging.WizardBus{}
+	require.NoError(t, bus.Start())
+	events := make(chan interface{}, 1)
+	require.NoError(t, bus.Subscribe(messaging.TopicEventRaw, "test", events))
+
+	st := &mockstore.MockStore{}
+	st.On("GetEntityByID", mock.Anything, "switch01").Return((*types.Entity)(nil), nil)
+	st.On("UpdateEntity", mock.Anything, mock.Anything).Return(nil)
+
+	critical := int32(2)
+	trapd := &Trapd{
+		Store:       st,
+		MessageBus:  bus,
+		Host:        "127.0.0.1",
+		Communities: []string{"public"},
+		Mappings: []snmp.Mapping{
+			{
+				TrapOID:     "1.3.6.1.6.3.1.1.5.3",
+				Check:       "link_down",
+				Status:      &critical,
+				Environment: "network",
+				Varbinds:    map[string]string{"1.3.6.1.2.1.1.5.0": snmp.FieldEntity},
+			},
+		},
+	}
+	require.NoError(t, trapd.Start())
+
+	client, err := net.Dial("udp", trapd.Addr().String())
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	packet, err := hex.DecodeString(linkDownTrap)
+	require.NoError(t, err)
+	_, err = client.Write(packet)
+	require.NoError(t, err)
+
+	select {
+	case msg := <-events:
+		event := msg.(*types.Event)
+		assert.Equal(t, "switch01", event.Entity.ID)
+		assert.Equal(t, types.EntityProxyClass, event.Entity.Class)
+		assert.Equal(t, "network", event.Entity.Environment)
+		assert.Equal(t, []string{"entity:switch01"}, event.Entity.Subscriptions)
+		assert.Equal(t, "link_down", event.Check.Name)
+		assert.Equal(t, int32(2), event.Check.Status)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event published")
+	}
+	st.AssertCalled(t, "UpdateEntity", mock.Anything, mock.Anything)
+
+	require.NoError(t, trapd.Stop())
+	_, ok := <-trapd.Err()
+	assert.False(t, ok)
+}
@@ -0,0 +1,257 @@
+// Package usage tracks the event throughput of every check of every
+// organization and environment, so the noisiest tenants can be identified, and
+// the resources used by the executions of the checks and pipe handlers, so the
+// most expensive plugins can be identified.
+//
+// The usage is tracked in memory, and therefore only reflects the events
+// processed by the local backend since it started.
+package usage
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// SortByEvents sorts usage by number of events processed.
+	SortByEvents = "events"
+
+	// SortByBytes sorts usage by size of the events processed.
+	SortByBytes = "bytes"
+
+	// SortByRate sorts usage by rate of events processed.
+	SortByRate = "rate"
+
+	// rateWindow is the number of seconds over which the rate of events is
+	// computed.
+	rateWindow = 60
+
+	// KindCheck is the kind of the executions of checks, on the agents.
+	KindCheck = "check"
+
+	// KindHandler is the kind of the executions of pipe handlers, on the
+	// backend.
+	KindHandler = "handler"
+
+	// SortByCPU sorts resource usage by total CPU time.
+	SortByCPU = "cpu"
+
+	// SortByWall sorts resource usage by total duration.
+	SortByWall = "wall"
+
+	// SortByRSS sorts resource usage by maximum resident set size.
+	SortByRSS = "rss"
+)
+
+type key struct {
+	organization string
+	environment  string
+	check        string
+}
+
+// counter counts the events of a check.
+type counter struct {
+	events uint64
+	bytes  uint64
+
+	// buckets holds the number of events received during each of the last
+	// seconds, indexed by unix time modulo the rate window
+	buckets [rateWindow]uint64
+
+	// last is the unix time of the most recent bucket
+	last int64
+}
+
+// advance clears the buckets of the seconds elapsed since the last event.
+func (c *counter) advance(now int64) {
+	if now <= c.last {
+		return
+	}
+	elapsed := now - c.last
+	if elapsed > rateWindow {
+		elapsed = rateWindow
+	}
+	for i := int64(1); i <= elapsed; i++ {
+		c.buckets[(c.last+i)%rateWindow] = 0
+	}
+	c.last = now
+}
+
+func (c *counter) rate(now int64) float64 {
+	c.advance(now)
+	var sum uint64
+	for _, n := range c.buckets {
+		sum += n
+	}
+	return float64(sum) / rateWindow
+}
+
+// executionKey identifies a check or a handler.
+type executionKey struct {
+	organization string
+	environment  string
+	kind         string
+	name         string
+}
+
+// executions accumulates the resources used by the executions of a check or
+// a handler.
+type executions struct {
+	count    uint64
+	cpuTime  float64
+	wallTime float64
+	maxRSS   uint64
+}
+
+// Tracker counts the events processed for each check, and the resources used
+// by the executions of each check and handler. It is safe for concurrent use.
+type Tracker struct {
+	mu         sync.Mutex
+	counters   map[key]*counter
+	executions map[executionKey]*executions
+
+	// now returns the current time, it can be overridden in tests
+	now func() time.Time
+}
+
+// NewTracker returns a new Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{
+		counters:   map[key]*counter{},
+		executions: map[executionKey]*executions{},
+		now:        time.Now,
+	}
+}
+
+// Record counts an event of the given size for a check.
+func (t *Tracker) Record(org, env, check string, size int) {
+	k := key{organization: org, environment: env, check: check}
+	now := t.now().Unix()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	c, ok := t.counters[k]
+	if !ok {
+		c = &counter{last: now}
+		t.counters[k] = c
+	}
+	c.advance(now)
+	c.events++
+	c.bytes += uint64(size)
+	c.buckets[now%rateWindow]++
+}
+
+// Usage returns the usage of every check that processed events.
+func (t *Tracker) Usage() []types.Usage {
+	now := t.now().Unix()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	usage := make([]types.Usage, 0, len(t.counters))
+	for k, c := range t.counters {
+		usage = append(usage, types.Usage{
+			Organization:    k.organization,
+			Environment:     k.environment,
+			Check:           k.check,
+			Events:          c.events,
+			Bytes:           c.bytes,
+			EventsPerSecond: c.rate(now),
+		})
+	}
+	return usage
+}
+
+// Top sorts the given usage in descending order of the given criteria, i.e.
+// SortByEvents, SortByBytes or SortByRate, and returns at most the first n
+// elements. All the elements are returned if n is zero.
+func Top(usage []types.Usage, n int, by string) ([]types.Usage, error) {
+	var less func(i, j int) bool
+	switch by {
+	case SortByEvents, "":
+		less = func(i, j int) bool { return usage[i].Events > usage[j].Events }
+	case SortByBytes:
+		less = func(i, j int) bool { return usage[i].Bytes > usage[j].Bytes }
+	case SortByRate:
+		less = func(i, j int) bool { return usage[i].EventsPerSecond > usage[j].EventsPerSecond }
+	default:
+		return nil, fmt.Errorf("invalid sort criteria %q", by)
+	}
+
+	sort.SliceStable(usage, less)
+	if n > 0 && len(usage) > n {
+		usage = usage[:n]
+	}
+	return usage, nil
+}
+
+// RecordExecution accounts the resources used by an execution of a check or a
+// handler, given its kind, i.e. KindCheck or KindHandler, its CPU time and
+// duration in seconds and its maximum resident set size in bytes.
+func (t *Tracker) RecordExecution(org, env, kind, name string, cpuTime, wallTime float64, maxRSS uint64) {
+	k := executionKey{organization: org, environment: env, kind: kind, name: name}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e, ok := t.executions[k]
+	if !ok {
+		e = &executions{}
+		t.executions[k] = e
+	}
+	e.count++
+	e.cpuTime += cpuTime
+	e.wallTime += wallTime
+	if maxRSS > e.maxRSS {
+		e.maxRSS = maxRSS
+	}
+}
+
+// Resources returns the resource usage of every check and handler executed.
+func (t *Tracker) Resources() []types.ResourceUsage {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	usage := make([]types.ResourceUsage, 0, len(t.executions))
+	for k, e := range t.executions {
+		usage = append(usage, types.ResourceUsage{
+			Organization: k.organization,
+			Environment:  k.environment,
+			Kind:         k.kind,
+			Name:         k.name,
+			Executions:   e.count,
+			CPUTime:      e.cpuTime,
+			WallTime:     e.wallTime,
+			MaxRSS:       e.maxRSS,
+		})
+	}
+	return usage
+}
+
+// TopResources sorts the given resource usage in descending order of the
+// given criteria, i.e. SortByCPU, SortByWall or SortByRSS, and returns at most
+// the first n elements. All the elements are returned if n is zero.
+func TopResources(usage []types.ResourceUsage, n int, by string) ([]types.ResourceUsage, error) {
+	var less func(i, j int) bool
+	switch by {
+	case SortByCPU, "":
+		less = func(i, j int) bool { return usage[i].CPUTime > usage[j].CPUTime }
+	case SortByWall:
+		less = func(i, j int) bool { return usage[i].WallTime > usage[j].WallTime }
+	case SortByRSS:
+		less = func(i, j int) bool { return usage[i].MaxRSS > usage[j].MaxRSS }
+	default:
+		return nil, fmt.Errorf("invalid sort criteria %q", by)
+	}
+
+	sort.SliceStable(usage, less)
+	if n > 0 && len(usage) > n {
+		usage = usage[:n]
+	}
+	return usage, nil
+}
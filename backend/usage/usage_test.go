@@ -0,0 +1,129 @@
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker(t *testing.T) {
+	now := time.Unix(1000, 0)
+	tracker := NewTracker()
+	tracker.now = func() time.Time { return now }
+
+	for i := 0; i < 30; i++ {
+		tracker.Record("acme", "prod", "check1", 100)
+	}
+	tracker.Record("acme", "dev", "check1", 1000)
+
+	usage, err := Top(tracker.Usage(), 0, SortByEvents)
+	require.NoError(t, err)
+	require.Len(t, usage, 2)
+	assert.Equal(t, types.Usage{
+		Organization:    "acme",
+		Environment:     "prod",
+		Check:           "check1",
+		Events:          30,
+		Bytes:           3000,
+		EventsPerSecond: 0.5,
+	}, usage[0])
+	assert.Equal(t, "dev", usage[1].Environment)
+
+	// The rate only accounts for the events of the last minute
+	now = now.Add(30 * time.Second)
+	tracker.Record("acme", "prod", "check1", 100)
+	usage, err = Top(tracker.Usage(), 1, SortByEvents)
+	require.NoError(t, err)
+	require.Len(t, usage, 1)
+	assert.Equal(t, uint64(31), usage[0].Events)
+	assert.InDelta(t, 31.0/60, usage[0].EventsPerSecond, 0.001)
+
+	now = now.Add(45 * time.Second)
+	usage, err = Top(tracker.Usage(), 1, SortByEvents)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(31), usage[0].Events)
+	assert.InDelta(t, 1.0/60, usage[0].EventsPerSecond, 0.001)
+
+	now = now.Add(time.Hour)
+	usage, err = Top(tracker.Usage(), 1, SortByEvents)
+	require.NoError(t, err)
+	assert.Equal(t, float64(0), usage[0].EventsPerSecond)
+}
+
+func TestTop(t *testing.T) {
+	usage := []types.Usage{
+		*types.FixtureUsage("check1", 10),
+		*types.FixtureUsage("check2", 30),
+		*types.FixtureUsage("check3", 20),
+	}
+	usage[0].Bytes = 10000
+
+	top, err := Top(usage, 2, SortByEvents)
+	require.NoError(t, err)
+	require.Len(t, top, 2)
+	assert.Equal(t, "check2", top[0].Check)
+	assert.Equal(t, "check3", top[1].Check)
+
+	top, err = Top(usage, 1, SortByBytes)
+	require.NoError(t, err)
+	require.Len(t, top, 1)
+	assert.Equal(t, "check1", top[0].Check)
+
+	top, err = Top(usage, 0, SortByRate)
+	require.NoError(t, err)
+	assert.Len(t, top, 3)
+	assert.Equal(t, "check2", top[0].Check)
+
+	_, err = Top(usage, 0, "foo")
+	assert.Error(t, err)
+}
+
+func TestRecordExecution(t *testing.T) {
+	tracker := NewTracker()
+	tracker.RecordExecution("default", "default", KindCheck, "check1", 0.5, 1, 1000)
+	tracker.RecordExecution("default", "default", KindCheck, "check1", 0.25, 2, 3000)
+	tracker.RecordExecution("default", "default", KindHandler, "check1", 1, 1, 10)
+
+	resources, err := TopResources(tracker.Resources(), 0, SortByCPU)
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+
+	// The checks and handlers with the same name are accounted separately
+	assert.Equal(t, KindHandler, resources[0].Kind)
+	assert.Equal(t, KindCheck, resources[1].Kind)
+	assert.Equal(t, uint64(2), resources[1].Executions)
+	assert.Equal(t, 0.75, resources[1].CPUTime)
+	assert.Equal(t, float64(3), resources[1].WallTime)
+	assert.Equal(t, uint64(3000), resources[1].MaxRSS)
+}
+
+func TestTopResources(t *testing.T) {
+	resources := []types.ResourceUsage{
+		*types.FixtureResourceUsage(KindCheck, "check1", 10),
+		*types.FixtureResourceUsage(KindCheck, "check2", 30),
+		*types.FixtureResourceUsage(KindHandler, "handler1", 20),
+	}
+	resources[0].MaxRSS = 1 << 30
+
+	top, err := TopResources(resources, 2, SortByCPU)
+	require.NoError(t, err)
+	require.Len(t, top, 2)
+	assert.Equal(t, "check2", top[0].Name)
+	assert.Equal(t, "handler1", top[1].Name)
+
+	top, err = TopResources(resources, 1, SortByRSS)
+	require.NoError(t, err)
+	require.Len(t, top, 1)
+	assert.Equal(t, "check1", top[0].Name)
+
+	top, err = TopResources(resources, 0, SortByWall)
+	require.NoError(t, err)
+	assert.Len(t, top, 3)
+	assert.Equal(t, "check2", top[0].Name)
+
+	_, err = TopResources(resources, 0, "foo")
+	assert.Error(t, err)
+}
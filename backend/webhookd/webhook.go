@@ -0,0 +1,140 @@
+package webhookd
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// ResourceChecks are the check configurations
+	ResourceChecks = "checks"
+
+	// ResourceSilenced are the silenced entries
+	ResourceSilenced = "silenced"
+
+	// ResourceUsers are the users
+	ResourceUsers = "users"
+
+	// SignatureHeader is the header carrying the signature of the payloads
+	SignatureHeader = "X-Sensu-Signature"
+
+	// DeliveryHeader is the header carrying the unique identifier of the
+	// notifications
+	DeliveryHeader = "X-Sensu-Delivery"
+)
+
+// Webhook is an endpoint notified of the changes of the resources.
+type Webhook struct {
+	// URL is the URL the notifications are posted to.
+	URL string `json:"url"`
+
+	// Secret is the key of the HMAC-SHA256 signature of the payloads, which
+	// are not signed if empty.
+	Secret string `json:"secret"`
+
+	// Resources are the resources whose changes are notified, all of them if
+	// empty.
+	Resources []string `json:"resources"`
+}
+
+// Validate returns an error if the webhook is invalid.
+func (w *Webhook) Validate() error {
+	u, err := url.Parse(w.URL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.New("url must be an http or https URL")
+	}
+
+	for _, resource := range w.Resources {
+		switch resource {
+		case ResourceChecks, ResourceSilenced, ResourceUsers:
+		default:
+			return fmt.Errorf("unsupported resource %q", resource)
+		}
+	}
+
+	return nil
+}
+
+// Watches returns true if the webhook is notified of the changes of the
+// resource.
+func (w *Webhook) Watches(resource string) bool {
+	if len(w.Resources) == 0 {
+		return true
+	}
+	for _, r := range w.Resources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign returns the signature of the payload, the hex encoded HMAC-SHA256 of
+// the payload keyed by the secret of the webhook, prefixed by "sha256=".
+func (w *Webhook) Sign(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(w.Secret))
+	_, _ = mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// post sends the notification to the webhook.
+func (w *Webhook) post(client *http.Client, n *Notification) error {
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, n.ID)
+	if w.Secret != "" {
+		req.Header.Set(SignatureHeader, w.Sign(payload))
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+	_, _ = ioutil.ReadAll(res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("the webhook responded with status %s", res.Status)
+	}
+	return nil
+}
+
+// LoadWebhooks reads the webhooks from a JSON file.
+func LoadWebhooks(path string) ([]Webhook, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhooks []Webhook
+	if err := json.Unmarshal(b, &webhooks); err != nil {
+		return nil, fmt.Errorf("could not parse the resource webhooks: %s", err)
+	}
+
+	for i := range webhooks {
+		if err := webhooks[i].Validate(); err != nil {
+			return nil, fmt.Errorf("invalid resource webhook %d: %s", i, err)
+		}
+	}
+
+	return webhooks, nil
+}
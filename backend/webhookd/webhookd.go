@@ -0,0 +1,282 @@
+// Package webhookd notifies external endpoints of the creation, update and
+// deletion of resources, e.g. to let a GitOps reconciler detect configuration
+// drift.
+package webhookd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/google/uuid"
+	"github.com/sensu/sensu-go/backend/leader"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// ComponentName identifies Webhookd as the component/daemon implemented in
+// this package.
+const ComponentName = "webhookd"
+
+const (
+	// queueSize is the number of notifications queued for each webhook, the
+	// notifications are dropped while its queue is full.
+	queueSize = 100
+
+	// maxAttempts is the number of times the delivery of a notification is
+	// attempted.
+	maxAttempts = 3
+
+	// deliveryTimeout is the timeout of each delivery attempt.
+	deliveryTimeout = 10 * time.Second
+)
+
+var (
+	// retryInterval is how long webhookd waits before attempting a delivery
+	// again, multiplied by the number of attempts.
+	retryInterval = time.Second
+
+	// leaderRetryInterval is how long webhookd waits before campaigning again
+	// for leadership after an error.
+	leaderRetryInterval = 5 * time.Second
+)
+
+// Notification is the payload posted to the webhooks.
+type Notification struct {
+	// ID uniquely identifies the notification.
+	ID string `json:"id"`
+
+	// Timestamp is the time of the change, in seconds since the epoch.
+	Timestamp int64 `json:"timestamp"`
+
+	// Action is either "create", "update" or "delete".
+	Action string `json:"action"`
+
+	// Resource is the kind of the resource changed, e.g. "checks".
+	Resource string `json:"resource"`
+
+	// Object is the resource changed. It's the deleted resource for the
+	// deletions.
+	Object interface{} `json:"object"`
+}
+
+// Store provides the watchers of the resources notified.
+type Store interface {
+	GetCheckConfigWatcher(ctx context.Context) <-chan store.WatchEventCheckConfig
+	GetSilencedWatcher(ctx context.Context) <-chan store.WatchEventSilenced
+	GetUserWatcher(ctx context.Context) <-chan store.WatchEventUser
+}
+
+// Webhookd is the daemon notifying the webhooks of the changes of resources.
+//
+// When several backends share the same store, only the cluster leader
+// notifies the webhooks, so that each change is notified once.
+type Webhookd struct {
+	Store    Store
+	Webhooks []Webhook
+
+	// Client posts the notifications, a client with a 10 seconds timeout if
+	// nil.
+	Client *http.Client
+
+	queues   []chan *Notification
+	stopping chan struct{}
+	cancel   context.CancelFunc
+	errChan  chan error
+	wg       *sync.WaitGroup
+}
+
+// Start webhookd.
+func (w *Webhookd) Start() error {
+	if w.Store == nil {
+		return errors.New("no store found")
+	}
+
+	for i := range w.Webhooks {
+		if err := w.Webhooks[i].Validate(); err != nil {
+			return fmt.Errorf("invalid resource webhook %d: %s", i, err)
+		}
+	}
+
+	if w.Client == nil {
+		w.Client = &http.Client{Timeout: deliveryTimeout}
+	}
+
+	w.stopping = make(chan struct{})
+	w.errChan = make(chan error, 1)
+	w.wg = &sync.WaitGroup{}
+
+	w.queues = make([]chan *Notification, len(w.Webhooks))
+	for i := range w.Webhooks {
+		w.queues[i] = make(chan *Notification, queueSize)
+		w.wg.Add(1)
+		go w.deliver(&w.Webhooks[i], w.queues[i])
+	}
+
+	logger.Infof("starting webhookd with %d webhooks", len(w.Webhooks))
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	go w.lead(ctx)
+
+	return nil
+}
+
+// Stop webhookd, the notifications not delivered yet are dropped.
+func (w *Webhookd) Stop() error {
+	close(w.stopping)
+	w.cancel()
+	w.wg.Wait()
+	close(w.errChan)
+	return nil
+}
+
+// Status returns an error if webhookd is unhealthy.
+func (w *Webhookd) Status() error {
+	return nil
+}
+
+// Err returns a channel to listen for terminal errors on.
+func (w *Webhookd) Err() <-chan error {
+	return w.errChan
+}
+
+// lead campaigns for the cluster leadership and watches the resources
+// whenever this backend holds it, until the daemon is stopped and ctx is
+// cancelled.
+func (w *Webhookd) lead(ctx context.Context) {
+	for {
+		err := leader.Do(ctx, w.watch)
+
+		select {
+		case <-w.stopping:
+			return
+		default:
+		}
+
+		if err != nil {
+			logger.WithError(err).Error("error watching resources")
+			select {
+			case <-w.stopping:
+				return
+			case <-time.After(leaderRetryInterval):
+			}
+		}
+	}
+}
+
+// watch notifies the changes of the resources until the leadership is lost
+// or the daemon is stopped.
+func (w *Webhookd) watch(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	checks := w.Store.GetCheckConfigWatcher(ctx)
+	silenced := w.Store.GetSilencedWatcher(ctx)
+	users := w.Store.GetUserWatcher(ctx)
+
+	for {
+		select {
+		case <-w.stopping:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-checks:
+			if !ok {
+				return errors.New("the check watcher was closed")
+			}
+			w.notify(event.Action, ResourceChecks, event.CheckConfig)
+		case event, ok := <-silenced:
+			if !ok {
+				return errors.New("the silenced watcher was closed")
+			}
+			w.notify(event.Action, ResourceSilenced, event.Silenced)
+		case event, ok := <-users:
+			if !ok {
+				return errors.New("the user watcher was closed")
+			}
+			// Never disclose the password hash
+			user := *event.User
+			user.Password = ""
+			w.notify(event.Action, ResourceUsers, &user)
+		}
+	}
+}
+
+// notify queues the notification of a change for the webhooks of the
+// resource.
+func (w *Webhookd) notify(action store.WatchActionType, resource string, object interface{}) {
+	var actionName string
+	switch action {
+	case store.WatchCreate:
+		actionName = "create"
+	case store.WatchUpdate:
+		actionName = "update"
+	case store.WatchDelete:
+		actionName = "delete"
+	default:
+		return
+	}
+
+	n := &Notification{
+		ID:        uuid.New().String(),
+		Timestamp: time.Now().Unix(),
+		Action:    actionName,
+		Resource:  resource,
+		Object:    object,
+	}
+
+	for i := range w.Webhooks {
+		if !w.Webhooks[i].Watches(resource) {
+			continue
+		}
+		select {
+		case w.queues[i] <- n:
+		default:
+			logger.WithField("url", w.Webhooks[i].URL).Warn("webhook queue is full, dropping notification")
+		}
+	}
+}
+
+// deliver posts the notifications of the queue to the webhook, in order,
+// until the daemon is stopped.
+func (w *Webhookd) deliver(webhook *Webhook, queue <-chan *Notification) {
+	defer w.wg.Done()
+
+	for {
+		select {
+		case <-w.stopping:
+			return
+		case n := <-queue:
+			w.post(webhook, n)
+		}
+	}
+}
+
+// post delivers a notification to the webhook, retrying after failures.
+func (w *Webhookd) post(webhook *Webhook, n *Notification) {
+	fields := logrus.Fields{
+		"url":      webhook.URL,
+		"resource": n.Resource,
+		"action":   n.Action,
+	}
+
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err = webhook.post(w.Client, n); err == nil {
+			return
+		}
+		if attempt == maxAttempts {
+			break
+		}
+
+		select {
+		case <-w.stopping:
+			return
+		case <-time.After(time.Duration(attempt) * retryInterval):
+		}
+	}
+	logger.WithFields(fields).WithError(err).Error("could not deliver webhook notification")
+}
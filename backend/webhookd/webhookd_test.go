@@ -0,0 +1,150 @@
+package webhookd
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/leader"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type watchStore struct {
+	checks   chan store.WatchEventCheckConfig
+	silenced chan store.WatchEventSilenced
+	users    chan store.WatchEventUser
+}
+
+func newWatchStore() *watchStore {
+	return &watchStore{
+		checks:   make(chan store.WatchEventCheckConfig),
+		silenced: make(chan store.WatchEventSilenced),
+		users:    make(chan store.WatchEventUser),
+	}
+}
+
+func (s *watchStore) GetCheckConfigWatcher(context.Context) <-chan store.WatchEventCheckConfig {
+	return s.checks
+}
+
+func (s *watchStore) GetSilencedWatcher(context.Context) <-chan store.WatchEventSilenced {
+	return s.silenced
+}
+
+func (s *watchStore) GetUserWatcher(context.Context) <-chan store.WatchEventUser {
+	return s.users
+}
+
+type delivery struct {
+	signature    string
+	notification Notification
+	object       map[string]interface{}
+}
+
+func TestWebhookd(t *testing.T) {
+	leader.Override()
+	retryInterval = 10 * time.Millisecond
+
+	deliveries := make(chan delivery, 10)
+	failures := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first delivery fails, and is retried
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		d := delivery{signature: r.Header.Get(SignatureHeader)}
+		assert.NoError(t, json.Unmarshal(body, &d.notification))
+		d.object = d.notification.Object.(map[string]interface{})
+		assert.Equal(t, d.notification.ID, r.Header.Get(DeliveryHeader))
+		assert.Equal(t, (&Webhook{Secret: "s3cr3t"}).Sign(body), d.signature)
+		deliveries <- d
+	}))
+	defer server.Close()
+
+	st := newWatchStore()
+	webhookd := &Webhookd{
+		Store: st,
+		Webhooks: []Webhook{
+			{URL: server.URL, Secret: "s3cr3t", Resources: []string{ResourceChecks, ResourceUsers}},
+		},
+	}
+	require.NoError(t, webhookd.Start())
+	defer func() { assert.NoError(t, webhookd.Stop()) }()
+
+	receive := func() delivery {
+		select {
+		case d := <-deliveries:
+			return d
+		case <-time.After(5 * time.Second):
+			t.Fatal("no notification received")
+		}
+		return delivery{}
+	}
+
+	st.checks <- store.WatchEventCheckConfig{Action: store.WatchCreate, CheckConfig: types.FixtureCheckConfig("check_cpu")}
+	d := receive()
+	assert.Equal(t, "create", d.notification.Action)
+	assert.Equal(t, ResourceChecks, d.notification.Resource)
+	assert.Equal(t, "check_cpu", d.object["name"])
+
+	// The silenced entries are not watched by the webhook
+	st.silenced <- store.WatchEventSilenced{Action: store.WatchDelete, Silenced: types.FixtureSilenced("*:check_cpu")}
+
+	user := types.FixtureUser("alice")
+	user.Password = "$2a$10$hash"
+	st.users <- store.WatchEventUser{Action: store.WatchUpdate, User: user}
+	d = receive()
+	assert.Equal(t, "update", d.notification.Action)
+	assert.Equal(t, ResourceUsers, d.notification.Resource)
+	assert.Equal(t, "alice", d.object["username"])
+	assert.Empty(t, d.object["password"])
+	assert.Equal(t, "$2a$10$hash", user.Password)
+}
+
+func TestWebhookValidate(t *testing.T) {
+	tests := []Webhook{
+		{URL: "ftp://example.com"},
+		{URL: "http://example.com", Resources: []string{"handlers"}},
+	}
+	for _, webhook := range tests {
+		assert.Error(t, webhook.Validate())
+	}
+
+	webhook := Webhook{URL: "https://example.com", Resources: []string{ResourceSilenced}}
+	require.NoError(t, webhook.Validate())
+	assert.True(t, webhook.Watches(ResourceSilenced))
+	assert.False(t, webhook.Watches(ResourceChecks))
+}
+
+func TestLoadWebhooks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhookd")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "webhooks.json")
+	content := `[{"url": "https://gitops.example.com/sensu", "secret": "s3cr3t", "resources": ["checks"]}]`
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+
+	webhooks, err := LoadWebhooks(path)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, "s3cr3t", webhooks[0].Secret)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`[{"url": "gitops"}]`), 0644))
+	_, err = LoadWebhooks(path)
+	assert.Error(t, err)
+}
@@ -20,20 +20,41 @@ type SensuCli struct {
 	Client client.APIClient
 	Logger *logrus.Entry
 	InFile *os.File
+
+	// ClusterClient returns an API client of the remote cluster with the
+	// given name
+	ClusterClient func(name string) (client.APIClient, error)
 }
 
 // New SensuCLI given persistent flags from command
 func New(flags *pflag.FlagSet) *SensuCli {
 	conf := basic.Load(flags)
-	client := client.New(conf)
+	var impersonate string
+	if flags != nil {
+		impersonate, _ = flags.GetString("impersonate")
+	}
+	newClient := func(conf config.Config) client.APIClient {
+		client := client.New(conf)
+		if impersonate != "" {
+			client.Impersonate(impersonate)
+		}
+		return client
+	}
 	logger := logrus.WithFields(logrus.Fields{
 		"component": "cli-client",
 	})
 
 	return &SensuCli{
-		Client: client,
+		Client: newClient(conf),
 		Config: conf,
 		Logger: logger,
 		InFile: os.Stdin,
+		ClusterClient: func(name string) (client.APIClient, error) {
+			clusterConf, err := conf.RemoteCluster(name)
+			if err != nil {
+				return nil, err
+			}
+			return newClient(clusterConf), nil
+		},
 	}
 }
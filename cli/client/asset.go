@@ -18,7 +18,7 @@ func (client *RestClient) ListAssets(org string) ([]types.Asset, error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return assets, fmt.Errorf("%v", res.String())
+		return assets, unmarshalError(res)
 	}
 
 	err = json.Unmarshal(res.Body(), &assets)
@@ -36,7 +36,7 @@ func (client *RestClient) FetchAsset(name string) (*types.Asset, error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return &asset, fmt.Errorf("GET %q: %s", assetPath, res.String())
+		return &asset, unmarshalError(res)
 	}
 
 	err = json.Unmarshal(res.Body(), &asset)
@@ -60,7 +60,7 @@ func (client *RestClient) CreateAsset(asset *types.Asset) error {
 	}
 
 	if res.StatusCode() >= 400 {
-		return fmt.Errorf("%v", res.String())
+		return unmarshalError(res)
 	}
 
 	return nil
@@ -80,7 +80,7 @@ func (client *RestClient) UpdateAsset(asset *types.Asset) (err error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return fmt.Errorf("PATCH %q: %s", assetPath, res.String())
+		return unmarshalError(res)
 	}
 
 	return nil
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// CreateCheckTemplate creates new check template on configured Sensu instance
+func (client *RestClient) CreateCheckTemplate(tmpl *types.CheckTemplate) error {
+	bytes, err := json.Marshal(tmpl)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.R().SetBody(bytes).Post("/checktemplates")
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return unmarshalError(res)
+	}
+
+	return nil
+}
+
+// UpdateCheckTemplate updates given check template on configured Sensu
+// instance
+func (client *RestClient) UpdateCheckTemplate(tmpl *types.CheckTemplate) error {
+	bytes, err := json.Marshal(tmpl)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.R().SetBody(bytes).Patch("/checktemplates/" + url.PathEscape(tmpl.Name))
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return unmarshalError(res)
+	}
+
+	return nil
+}
+
+// DeleteCheckTemplate deletes check template from configured Sensu instance
+func (client *RestClient) DeleteCheckTemplate(tmpl *types.CheckTemplate) error {
+	res, err := client.R().Delete("/checktemplates/" + url.PathEscape(tmpl.Name))
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return unmarshalError(res)
+	}
+
+	return nil
+}
+
+// FetchCheckTemplate fetches a specific check template
+func (client *RestClient) FetchCheckTemplate(name string) (*types.CheckTemplate, error) {
+	var tmpl *types.CheckTemplate
+
+	res, err := client.R().Get("/checktemplates/" + url.PathEscape(name))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &tmpl)
+	return tmpl, err
+}
+
+// ListCheckTemplates fetches all check templates from configured Sensu
+// instance
+func (client *RestClient) ListCheckTemplates(org string) ([]types.CheckTemplate, error) {
+	var tmpls []types.CheckTemplate
+	res, err := client.R().SetQueryParam("org", org).Get("/checktemplates")
+	if err != nil {
+		return tmpls, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return tmpls, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &tmpls)
+	return tmpls, err
+}
+
+// RenderCheckTemplate fetches the checks generated by a specific check
+// template
+func (client *RestClient) RenderCheckTemplate(name string) ([]types.CheckConfig, error) {
+	var checks []types.CheckConfig
+	res, err := client.R().Get("/checktemplates/" + url.PathEscape(name) + "/checks")
+	if err != nil {
+		return checks, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return checks, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &checks)
+	return checks, err
+}
@@ -1,7 +1,6 @@
 package client
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -11,6 +10,10 @@ import (
 	"github.com/sensu/sensu-go/cli/client/config"
 )
 
+// ImpersonateUserHeader is the header of the requests made on behalf of
+// another user.
+const ImpersonateUserHeader = "Sensu-Impersonate-User"
+
 var logger *logrus.Entry
 
 // RestClient wraps resty.Client
@@ -76,7 +79,10 @@ func New(config config.Config) *RestClient {
 		}
 
 		if tokens.Refresh == "" {
-			return errors.New("configured access token has expired")
+			return apiError{
+				Message:    "configured access token has expired",
+				StatusCode: http.StatusUnauthorized,
+			}
 		}
 
 		// Mark the token as expired to prevent an infinite loop in this method
@@ -127,6 +133,12 @@ func (client *RestClient) R() *resty.Request {
 	return request
 }
 
+// Impersonate makes the requests of the client as the given user, which the
+// authenticated user must be allowed to impersonate.
+func (client *RestClient) Impersonate(username string) {
+	client.resty.SetHeader(ImpersonateUserHeader, username)
+}
+
 // Reset client so that it reconfigure on next request
 func (client *RestClient) Reset() {
 	client.configured = false
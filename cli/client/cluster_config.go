@@ -0,0 +1,82 @@
+package client
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// FetchClusterConfig fetches the cluster-wide defaults from Sensu API
+func (client *RestClient) FetchClusterConfig() (*types.ClusterConfig, error) {
+	config := &types.ClusterConfig{}
+
+	res, err := client.R().Get("/cluster/config")
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), config)
+	return config, err
+}
+
+// UpdateClusterConfig updates the cluster-wide defaults on Sensu API
+func (client *RestClient) UpdateClusterConfig(config *types.ClusterConfig) error {
+	bytes, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.R().SetBody(bytes).Put("/cluster/config")
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return unmarshalError(res)
+	}
+
+	return nil
+}
+
+// FetchOrganizationConfig fetches the defaults of an organization from Sensu
+// API
+func (client *RestClient) FetchOrganizationConfig(org string) (*types.ClusterConfig, error) {
+	config := &types.ClusterConfig{}
+
+	res, err := client.R().Get("/cluster/config/" + url.PathEscape(org))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), config)
+	return config, err
+}
+
+// UpdateOrganizationConfig updates the defaults of an organization on Sensu
+// API
+func (client *RestClient) UpdateOrganizationConfig(org string, config *types.ClusterConfig) error {
+	bytes, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.R().SetBody(bytes).Put("/cluster/config/" + url.PathEscape(org))
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return unmarshalError(res)
+	}
+
+	return nil
+}
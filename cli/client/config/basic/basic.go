@@ -13,8 +13,9 @@ import (
 )
 
 const (
-	clusterFilename = "cluster"
-	profileFilename = "profile"
+	clusterFilename  = "cluster"
+	clustersFilename = "clusters"
+	profileFilename  = "profile"
 )
 
 var logger = logrus.WithFields(logrus.Fields{
@@ -25,6 +26,7 @@ var logger = logrus.WithFields(logrus.Fields{
 type Config struct {
 	Cluster
 	Profile
+	Federation
 	path string
 }
 
@@ -34,6 +36,11 @@ type Cluster struct {
 	*types.Tokens
 }
 
+// Federation contains the access information of the remote clusters, by name
+type Federation struct {
+	Clusters map[string]*Cluster `json:"clusters"`
+}
+
 // Profile contains the active configuration
 type Profile struct {
 	Environment  string `json:"environment"`
@@ -70,6 +77,11 @@ func Load(flags *pflag.FlagSet) *Config {
 		logger.Debug(err)
 	}
 
+	// Load the remote clusters config file
+	if err := conf.open(clustersFilename); err != nil {
+		logger.Debug(err)
+	}
+
 	// Override environment
 	if flags != nil {
 		if value := helpers.GetChangedStringValueFlag("environment", flags); value != "" {
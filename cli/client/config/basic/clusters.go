@@ -0,0 +1,80 @@
+package basic
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/sensu/sensu-go/cli/client/config"
+	"github.com/sensu/sensu-go/types"
+)
+
+// RemoteCluster returns the configuration of the remote cluster with the given
+// name, which shares the active profile.
+func (c *Config) RemoteCluster(name string) (config.Config, error) {
+	if _, ok := c.Federation.Clusters[name]; !ok {
+		return nil, fmt.Errorf("cluster %q is not configured", name)
+	}
+	return &clusterConfig{Config: c, name: name}, nil
+}
+
+// RemoteClusters returns the names of the remote clusters
+func (c *Config) RemoteClusters() []string {
+	names := make([]string, 0, len(c.Federation.Clusters))
+	for name := range c.Federation.Clusters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DeleteRemoteCluster removes the remote cluster from the configuration file
+func (c *Config) DeleteRemoteCluster(name string) error {
+	if _, ok := c.Federation.Clusters[name]; !ok {
+		return fmt.Errorf("cluster %q is not configured", name)
+	}
+	delete(c.Federation.Clusters, name)
+
+	return write(c.Federation, filepath.Join(c.path, clustersFilename))
+}
+
+// SaveRemoteCluster saves the API URL and JWT of the remote cluster into a
+// configuration file
+func (c *Config) SaveRemoteCluster(name, url string, tokens *types.Tokens) error {
+	if name == config.LocalCluster || name == config.AllClusters {
+		return fmt.Errorf("the cluster name %q is reserved", name)
+	}
+	if c.Federation.Clusters == nil {
+		c.Federation.Clusters = map[string]*Cluster{}
+	}
+	c.Federation.Clusters[name] = &Cluster{APIUrl: url, Tokens: tokens}
+
+	return write(c.Federation, filepath.Join(c.path, clustersFilename))
+}
+
+// clusterConfig is the configuration of a remote cluster, whose API URL and
+// JWT are saved into the remote clusters configuration file
+type clusterConfig struct {
+	*Config
+	name string
+}
+
+// APIUrl returns the remote cluster API URL
+func (c *clusterConfig) APIUrl() string {
+	return c.Federation.Clusters[c.name].APIUrl
+}
+
+// Tokens returns the remote cluster JWT
+func (c *clusterConfig) Tokens() *types.Tokens {
+	return c.Federation.Clusters[c.name].Tokens
+}
+
+// SaveAPIUrl saves the remote cluster API URL into a configuration file
+func (c *clusterConfig) SaveAPIUrl(url string) error {
+	return c.SaveRemoteCluster(c.name, url, c.Tokens())
+}
+
+// SaveTokens saves the remote cluster JWT into a configuration file
+func (c *clusterConfig) SaveTokens(tokens *types.Tokens) error {
+	return c.SaveRemoteCluster(c.name, c.APIUrl(), tokens)
+}
@@ -0,0 +1,56 @@
+package basic
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusters(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sensu")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	flags := pflag.NewFlagSet("config-dir", pflag.ContinueOnError)
+	flags.String("config-dir", dir, "")
+
+	config := Load(flags)
+	require.NoError(t, config.SaveAPIUrl("http://localhost:8080"))
+	require.NoError(t, config.SaveRemoteCluster("us-east", "https://us-east:8080", &types.Tokens{Access: "foo"}))
+	require.NoError(t, config.SaveRemoteCluster("eu-west", "https://eu-west:8080", &types.Tokens{Access: "bar"}))
+	assert.Error(t, config.SaveRemoteCluster("local", "https://localhost:8080", nil))
+	assert.Error(t, config.SaveRemoteCluster("all", "https://localhost:8080", nil))
+
+	// The remote clusters are loaded from their own file
+	config = Load(flags)
+	assert.Equal(t, []string{"eu-west", "us-east"}, config.RemoteClusters())
+	assert.Equal(t, "http://localhost:8080", config.APIUrl())
+
+	cluster, err := config.RemoteCluster("us-east")
+	require.NoError(t, err)
+	assert.Equal(t, "https://us-east:8080", cluster.APIUrl())
+	assert.Equal(t, "foo", cluster.Tokens().Access)
+
+	// The tokens refreshed by the client of a remote cluster are saved as its
+	// own
+	require.NoError(t, cluster.SaveTokens(&types.Tokens{Access: "baz"}))
+	config = Load(flags)
+	cluster, err = config.RemoteCluster("us-east")
+	require.NoError(t, err)
+	assert.Equal(t, "baz", cluster.Tokens().Access)
+	assert.Nil(t, config.Tokens())
+
+	require.NoError(t, config.DeleteRemoteCluster("us-east"))
+	assert.Error(t, config.DeleteRemoteCluster("us-east"))
+	config = Load(flags)
+	assert.Equal(t, []string{"eu-west"}, config.RemoteClusters())
+	_, err = config.RemoteCluster("us-east")
+	assert.Error(t, err)
+}
@@ -9,6 +9,11 @@ const (
 	DefaultFormat = "tabular"
 	// DefaultOrganization represents the default organization
 	DefaultOrganization = "default"
+	// LocalCluster is the name of the cluster configured by sensuctl configure,
+	// as opposed to the remote clusters of the federation
+	LocalCluster = "local"
+	// AllClusters designates the configured cluster and all the remote ones
+	AllClusters = "all"
 )
 
 // Config represents an abstracted configuration
@@ -23,14 +28,18 @@ type Read interface {
 	Format() string
 	Environment() string
 	Organization() string
+	RemoteCluster(string) (Config, error)
+	RemoteClusters() []string
 	Tokens() *types.Tokens
 }
 
 // Write contains all methods related to setting and writting configuration
 type Write interface {
+	DeleteRemoteCluster(string) error
 	SaveAPIUrl(string) error
 	SaveFormat(string) error
 	SaveEnvironment(string) error
 	SaveOrganization(string) error
+	SaveRemoteCluster(string, string, *types.Tokens) error
 	SaveTokens(*types.Tokens) error
 }
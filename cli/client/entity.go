@@ -2,7 +2,6 @@ package client
 
 import (
 	"encoding/json"
-	"fmt"
 
 	"github.com/sensu/sensu-go/types"
 )
@@ -22,7 +21,7 @@ func (client *RestClient) FetchEntity(ID string) (*types.Entity, error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return entity, fmt.Errorf("%v", res.String())
+		return entity, unmarshalError(res)
 	}
 
 	err = json.Unmarshal(res.Body(), &entity)
@@ -39,7 +38,27 @@ func (client *RestClient) ListEntities(org string) ([]types.Entity, error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return entities, fmt.Errorf("%v", res.String())
+		return entities, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &entities)
+	return entities, err
+}
+
+// QueryEntities fetches the entities matching the given query expression
+func (client *RestClient) QueryEntities(org, query string) ([]types.Entity, error) {
+	var entities []types.Entity
+
+	res, err := client.R().SetQueryParams(map[string]string{
+		"org":   org,
+		"query": query,
+	}).Get("/entities")
+	if err != nil {
+		return entities, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return entities, unmarshalError(res)
 	}
 
 	err = json.Unmarshal(res.Body(), &entities)
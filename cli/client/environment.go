@@ -2,7 +2,6 @@ package client
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/url"
 
@@ -25,7 +24,7 @@ func (client *RestClient) CreateEnvironment(org string, env *types.Environment)
 	}
 
 	if res.StatusCode() >= 400 {
-		return fmt.Errorf("%v", res.String())
+		return unmarshalError(res)
 	}
 
 	return nil
@@ -43,7 +42,7 @@ func (client *RestClient) DeleteEnvironment(org, env string) error {
 	}
 
 	if res.StatusCode() >= 400 {
-		return fmt.Errorf("%v", res.String())
+		return unmarshalError(res)
 	}
 
 	return nil
@@ -61,7 +60,7 @@ func (client *RestClient) ListEnvironments(org string) ([]types.Environment, err
 	}
 
 	if res.StatusCode() >= 400 {
-		return envs, fmt.Errorf("%v", res.String())
+		return envs, unmarshalError(res)
 	}
 
 	err = json.Unmarshal(res.Body(), &envs)
@@ -83,7 +82,7 @@ func (client *RestClient) FetchEnvironment(envName string) (*types.Environment,
 	}
 
 	if res.StatusCode() >= 400 {
-		return env, fmt.Errorf("%v", res.String())
+		return env, unmarshalError(res)
 	}
 
 	err = json.Unmarshal(res.Body(), &env)
@@ -105,7 +104,7 @@ func (client *RestClient) UpdateEnvironment(env *types.Environment) error {
 	}
 
 	if res.StatusCode() >= 400 {
-		return errors.New(res.String())
+		return unmarshalError(res)
 	}
 
 	return nil
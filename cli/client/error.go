@@ -2,17 +2,50 @@ package client
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/go-resty/resty"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// ErrorClassAuth is the class of the authentication and authorization
+	// failures
+	ErrorClassAuth = "auth"
+
+	// ErrorClassNotFound is the class of the errors caused by a missing
+	// resource
+	ErrorClassNotFound = "not_found"
+
+	// ErrorClassValidation is the class of the errors caused by an invalid
+	// resource or argument
+	ErrorClassValidation = "validation"
+
+	// ErrorClassGeneric is the class of any other error
+	ErrorClassGeneric = "error"
 )
 
 type apiError struct {
-	Message string `json:"error"`
-	Code    uint32 `json:"code,omitempty"`
+	Message    string                 `json:"error"`
+	Code       uint32                 `json:"code,omitempty"`
+	Fields     types.ValidationErrors `json:"fields,omitempty"`
+	StatusCode int                    `json:"-"`
 }
 
+// Error returns the error message, or one line per invalid field if the
+// resource did not pass validation.
 func (a apiError) Error() string {
-	return a.Message
+	if len(a.Fields) == 0 {
+		return a.Message
+	}
+
+	lines := make([]string, len(a.Fields))
+	for i, field := range a.Fields {
+		lines[i] = fmt.Sprintf("%s: %s", field.Field, field.Message)
+	}
+	return strings.Join(lines, "\n")
 }
 
 // TODO: Export err type from routers package.
@@ -21,5 +54,38 @@ func unmarshalError(res *resty.Response) error {
 	if err := json.Unmarshal(res.Body(), &apiErr); err != nil {
 		apiErr.Message = string(res.Body())
 	}
+	apiErr.StatusCode = res.StatusCode()
 	return apiErr
 }
+
+// ErrorClass returns the class of the given error, based on the response of
+// the API for the errors it returned.
+func ErrorClass(err error) string {
+	switch err := err.(type) {
+	case apiError:
+		switch err.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return ErrorClassAuth
+		case http.StatusNotFound:
+			return ErrorClassNotFound
+		case http.StatusBadRequest, http.StatusConflict:
+			return ErrorClassValidation
+		}
+	case types.ValidationErrors:
+		return ErrorClassValidation
+	}
+
+	return ErrorClassGeneric
+}
+
+// ErrorFields returns the invalid fields reported by the given error, if any.
+func ErrorFields(err error) types.ValidationErrors {
+	switch err := err.(type) {
+	case apiError:
+		return err.Fields
+	case types.ValidationErrors:
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError(t *testing.T) {
+	err := apiError{Message: "not found"}
+	assert.Equal(t, "not found", err.Error())
+
+	err = apiError{
+		Message: "URL cannot be empty; organization cannot be empty",
+		Fields: types.ValidationErrors{
+			{Field: "url", Code: types.ValidationRequired, Message: "URL cannot be empty"},
+			{Field: "organization", Code: types.ValidationRequired, Message: "organization cannot be empty"},
+		},
+	}
+	assert.Equal(t, "url: URL cannot be empty\norganization: organization cannot be empty", err.Error())
+}
+
+func TestErrorClass(t *testing.T) {
+	testCases := []struct {
+		name  string
+		err   error
+		class string
+	}{
+		{"unauthorized", apiError{StatusCode: 401}, ErrorClassAuth},
+		{"forbidden", apiError{StatusCode: 403}, ErrorClassAuth},
+		{"not found", apiError{StatusCode: 404}, ErrorClassNotFound},
+		{"bad request", apiError{StatusCode: 400}, ErrorClassValidation},
+		{"conflict", apiError{StatusCode: 409}, ErrorClassValidation},
+		{"internal", apiError{StatusCode: 500}, ErrorClassGeneric},
+		{"validation errors", types.ValidationErrors{}, ErrorClassValidation},
+		{"other errors", errors.New("error"), ErrorClassGeneric},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.class, ErrorClass(tc.err))
+		})
+	}
+}
@@ -47,6 +47,26 @@ func (client *RestClient) ListEvents(org string) ([]types.Event, error) {
 	return events, err
 }
 
+// QueryEvents fetches the events matching the given query expression
+func (client *RestClient) QueryEvents(org, query string) ([]types.Event, error) {
+	var events []types.Event
+
+	res, err := client.R().SetQueryParams(map[string]string{
+		"org":   org,
+		"query": query,
+	}).Get("/events")
+	if err != nil {
+		return events, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &events)
+	return events, err
+}
+
 // DeleteEvent deletes an event.
 func (client *RestClient) DeleteEvent(entity, check string) error {
 	res, err := client.R().Delete(eventPath(entity, check))
@@ -81,3 +101,30 @@ func (client *RestClient) ResolveEvent(event *types.Event) error {
 
 	return nil
 }
+
+// ReplayEvents sends the stored events selected by the replay request through
+// the event handlers again, and returns them.
+func (client *RestClient) ReplayEvents(org string, replay *types.EventReplay) ([]types.Event, error) {
+	var events []types.Event
+
+	bytes, err := json.Marshal(replay)
+	if err != nil {
+		return nil, err
+	}
+
+	// The namespace isn't set on the POST requests by default
+	res, err := client.R().SetQueryParams(map[string]string{
+		"org": org,
+		"env": client.config.Environment(),
+	}).SetBody(bytes).Post("/events/replay")
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &events)
+	return events, err
+}
@@ -2,7 +2,6 @@ package client
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/url"
 
@@ -25,7 +24,7 @@ func (client *RestClient) CreateFilter(filter *types.EventFilter) (err error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return fmt.Errorf("%v", res.String())
+		return unmarshalError(res)
 	}
 
 	return nil
@@ -40,7 +39,7 @@ func (client *RestClient) DeleteFilter(filter *types.EventFilter) error {
 	}
 
 	if res.StatusCode() >= 400 {
-		return fmt.Errorf("%v", res.String())
+		return unmarshalError(res)
 	}
 
 	return nil
@@ -56,7 +55,7 @@ func (client *RestClient) FetchFilter(name string) (*types.EventFilter, error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return nil, fmt.Errorf("%v", res.String())
+		return nil, unmarshalError(res)
 	}
 
 	err = json.Unmarshal(res.Body(), &filter)
@@ -72,7 +71,7 @@ func (client *RestClient) ListFilters(org string) ([]types.EventFilter, error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return filters, fmt.Errorf("%v", res.String())
+		return filters, unmarshalError(res)
 	}
 
 	err = json.Unmarshal(res.Body(), &filters)
@@ -91,7 +90,7 @@ func (client *RestClient) UpdateFilter(f *types.EventFilter) error {
 	}
 
 	if resp.StatusCode() >= 400 {
-		err = errors.New(resp.String())
+		err = unmarshalError(resp)
 	}
 
 	return err
@@ -2,7 +2,6 @@ package client
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/url"
 
 	"github.com/sensu/sensu-go/types"
@@ -18,7 +17,7 @@ func (client *RestClient) ListHandlers(org string) ([]types.Handler, error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return handlers, fmt.Errorf("%v", res.String())
+		return handlers, unmarshalError(res)
 	}
 
 	err = json.Unmarshal(res.Body(), &handlers)
@@ -38,7 +37,7 @@ func (client *RestClient) CreateHandler(handler *types.Handler) (err error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return fmt.Errorf("%v", res.String())
+		return unmarshalError(res)
 	}
 
 	return nil
@@ -52,7 +51,7 @@ func (client *RestClient) DeleteHandler(handler *types.Handler) (err error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return fmt.Errorf("%v", res.String())
+		return unmarshalError(res)
 	}
 
 	return nil
@@ -67,7 +66,7 @@ func (client *RestClient) FetchHandler(name string) (*types.Handler, error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return nil, fmt.Errorf("%v", res.String())
+		return nil, unmarshalError(res)
 	}
 
 	err = json.Unmarshal(res.Body(), &handler)
@@ -87,7 +86,7 @@ func (client *RestClient) UpdateHandler(handler *types.Handler) (err error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return fmt.Errorf("%v", res.String())
+		return unmarshalError(res)
 	}
 
 	return nil
@@ -9,6 +9,8 @@ type APIClient interface {
 	AuthenticationAPIClient
 	AssetAPIClient
 	CheckAPIClient
+	CheckTemplateAPIClient
+	ClusterConfigAPIClient
 	EntityAPIClient
 	EnvironmentAPIClient
 	EventAPIClient
@@ -17,9 +19,14 @@ type APIClient interface {
 	HookAPIClient
 	MutatorAPIClient
 	OrganizationAPIClient
+	PipelineAPIClient
 	RoleAPIClient
+	RoleBindingAPIClient
 	UserAPIClient
 	SilencedAPIClient
+	TessenAPIClient
+	TombstoneAPIClient
+	UsageAPIClient
 }
 
 // AuthenticationAPIClient client methods for authenticating
@@ -50,11 +57,22 @@ type CheckAPIClient interface {
 	RemoveCheckHook(check *types.CheckConfig, checkHookType string, hookName string) error
 }
 
+// CheckTemplateAPIClient client methods for check templates
+type CheckTemplateAPIClient interface {
+	CreateCheckTemplate(*types.CheckTemplate) error
+	DeleteCheckTemplate(*types.CheckTemplate) error
+	FetchCheckTemplate(string) (*types.CheckTemplate, error)
+	ListCheckTemplates(string) ([]types.CheckTemplate, error)
+	RenderCheckTemplate(string) ([]types.CheckConfig, error)
+	UpdateCheckTemplate(*types.CheckTemplate) error
+}
+
 // EntityAPIClient client methods for entities
 type EntityAPIClient interface {
 	DeleteEntity(entity *types.Entity) error
 	FetchEntity(ID string) (*types.Entity, error)
 	ListEntities(string) ([]types.Entity, error)
+	QueryEntities(org, query string) ([]types.Entity, error)
 	UpdateEntity(entity *types.Entity) error
 }
 
@@ -80,10 +98,12 @@ type EnvironmentAPIClient interface {
 type EventAPIClient interface {
 	FetchEvent(string, string) (*types.Event, error)
 	ListEvents(string) ([]types.Event, error)
+	QueryEvents(org, query string) ([]types.Event, error)
 
 	// DeleteEvent deletes the event identified by entity, check.
 	DeleteEvent(entity, check string) error
 	ResolveEvent(*types.Event) error
+	ReplayEvents(org string, replay *types.EventReplay) ([]types.Event, error)
 }
 
 // HandlerAPIClient client methods for handlers
@@ -119,15 +139,26 @@ type OrganizationAPIClient interface {
 	FetchOrganization(string) (*types.Organization, error)
 }
 
+// PipelineAPIClient client methods for pipelines
+type PipelineAPIClient interface {
+	CreatePipeline(*types.Pipeline) error
+	DeletePipeline(*types.Pipeline) error
+	FetchPipeline(string) (*types.Pipeline, error)
+	ListPipelines(string) ([]types.Pipeline, error)
+	UpdatePipeline(*types.Pipeline) error
+}
+
 // UserAPIClient client methods for users
 type UserAPIClient interface {
 	AddRoleToUser(string, string) error
 	CreateUser(*types.User) error
 	DisableUser(string) error
+	FetchUserPreferences(string) (*types.UserPreferences, error)
 	ListUsers() ([]types.User, error)
 	ReinstateUser(string) error
 	RemoveRoleFromUser(string, string) error
 	UpdatePassword(string, string) error
+	UpdateUserPreferences(*types.UserPreferences) error
 }
 
 // RoleAPIClient client methods for role
@@ -141,6 +172,13 @@ type RoleAPIClient interface {
 	RemoveRule(role string, ruleType string) error
 }
 
+// RoleBindingAPIClient client methods for role bindings
+type RoleBindingAPIClient interface {
+	CreateRoleBinding(*types.RoleBinding) error
+	DeleteRoleBinding(string) error
+	ListRoleBindings(string) ([]types.RoleBinding, error)
+}
+
 // SilencedAPIClient client methods for silenced
 type SilencedAPIClient interface {
 	// CreateSilenced creates a new silenced entry from its input.
@@ -159,3 +197,51 @@ type SilencedAPIClient interface {
 	// UpdateSilenced updates an existing silenced entry.
 	UpdateSilenced(*types.Silenced) error
 }
+
+// ClusterConfigAPIClient client methods for the cluster-wide defaults
+type ClusterConfigAPIClient interface {
+	// FetchClusterConfig fetches the cluster-wide defaults.
+	FetchClusterConfig() (*types.ClusterConfig, error)
+
+	// UpdateClusterConfig updates the cluster-wide defaults.
+	UpdateClusterConfig(*types.ClusterConfig) error
+
+	// FetchOrganizationConfig fetches the defaults of an organization.
+	FetchOrganizationConfig(string) (*types.ClusterConfig, error)
+
+	// UpdateOrganizationConfig updates the defaults of an organization.
+	UpdateOrganizationConfig(string, *types.ClusterConfig) error
+}
+
+// TessenAPIClient client methods for the anonymous usage reporting
+type TessenAPIClient interface {
+	// FetchTessenConfig fetches the anonymous usage reporting configuration.
+	FetchTessenConfig() (*types.TessenConfig, error)
+
+	// UpdateTessenConfig updates the anonymous usage reporting configuration.
+	UpdateTessenConfig(*types.TessenConfig) error
+
+	// PreviewTessenData fetches the data reported when the cluster opts in.
+	PreviewTessenData() (*types.TessenData, error)
+}
+
+// TombstoneAPIClient client methods for the deleted resources
+type TombstoneAPIClient interface {
+	// ListTombstones fetches the tombstones of the deleted resources.
+	ListTombstones(org string) ([]types.Tombstone, error)
+
+	// RestoreTombstone recreates a deleted resource from its tombstone.
+	RestoreTombstone(kind, name string) (*types.Tombstone, error)
+}
+
+// UsageAPIClient client methods for event throughput usage
+type UsageAPIClient interface {
+	// ListUsage lists at most limit usage records, sorted by the given
+	// criteria, i.e. events, bytes or rate.
+	ListUsage(limit int, sortBy string) ([]types.Usage, error)
+
+	// ListResourceUsage lists at most limit resource usage records, of the
+	// checks or handlers or both if kind is empty, sorted by the given
+	// criteria, i.e. cpu, wall or rss.
+	ListResourceUsage(limit int, sortBy, kind string) ([]types.ResourceUsage, error)
+}
@@ -2,7 +2,6 @@ package client
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/url"
 
 	"github.com/sensu/sensu-go/types"
@@ -18,7 +17,7 @@ func (client *RestClient) ListMutators() ([]types.Mutator, error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return mutators, fmt.Errorf("%v", res.String())
+		return mutators, unmarshalError(res)
 	}
 
 	err = json.Unmarshal(res.Body(), &mutators)
@@ -2,7 +2,6 @@ package client
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/url"
 
 	"github.com/sensu/sensu-go/types"
@@ -24,7 +23,7 @@ func (client *RestClient) CreateOrganization(org *types.Organization) error {
 	}
 
 	if res.StatusCode() >= 400 {
-		return fmt.Errorf("%v", res.String())
+		return unmarshalError(res)
 	}
 
 	return nil
@@ -43,7 +42,7 @@ func (client *RestClient) UpdateOrganization(org *types.Organization) error {
 	}
 
 	if res.StatusCode() >= 400 {
-		return fmt.Errorf("%v", res.String())
+		return unmarshalError(res)
 	}
 
 	return nil
@@ -58,7 +57,7 @@ func (client *RestClient) DeleteOrganization(org string) error {
 	}
 
 	if res.StatusCode() >= 400 {
-		return fmt.Errorf("%v", res.String())
+		return unmarshalError(res)
 	}
 
 	return nil
@@ -74,7 +73,7 @@ func (client *RestClient) ListOrganizations() ([]types.Organization, error) {
 	}
 
 	if res.StatusCode() >= 400 {
-		return orgs, fmt.Errorf("%v", res.String())
+		return orgs, unmarshalError(res)
 	}
 
 	err = json.Unmarshal(res.Body(), &orgs)
@@ -91,7 +90,7 @@ func (client *RestClient) FetchOrganization(orgName string) (*types.Organization
 	}
 
 	if res.StatusCode() >= 400 {
-		return org, fmt.Errorf("%v", res.String())
+		return org, unmarshalError(res)
 	}
 
 	err = json.Unmarshal(res.Body(), &org)
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// CreatePipeline creates new pipeline on configured Sensu instance
+func (client *RestClient) CreatePipeline(pipeline *types.Pipeline) error {
+	bytes, err := json.Marshal(pipeline)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.R().SetBody(bytes).Post("/pipelines")
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return unmarshalError(res)
+	}
+
+	return nil
+}
+
+// UpdatePipeline updates given pipeline on configured Sensu
+// instance
+func (client *RestClient) UpdatePipeline(pipeline *types.Pipeline) error {
+	bytes, err := json.Marshal(pipeline)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.R().SetBody(bytes).Patch("/pipelines/" + url.PathEscape(pipeline.Name))
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return unmarshalError(res)
+	}
+
+	return nil
+}
+
+// DeletePipeline deletes pipeline from configured Sensu instance
+func (client *RestClient) DeletePipeline(pipeline *types.Pipeline) error {
+	res, err := client.R().Delete("/pipelines/" + url.PathEscape(pipeline.Name))
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return unmarshalError(res)
+	}
+
+	return nil
+}
+
+// FetchPipeline fetches a specific pipeline
+func (client *RestClient) FetchPipeline(name string) (*types.Pipeline, error) {
+	var pipeline *types.Pipeline
+
+	res, err := client.R().Get("/pipelines/" + url.PathEscape(name))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &pipeline)
+	return pipeline, err
+}
+
+// ListPipelines fetches all pipelines from configured Sensu
+// instance
+func (client *RestClient) ListPipelines(org string) ([]types.Pipeline, error) {
+	var pipelines []types.Pipeline
+	res, err := client.R().SetQueryParam("org", org).Get("/pipelines")
+	if err != nil {
+		return pipelines, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return pipelines, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &pipelines)
+	return pipelines, err
+}
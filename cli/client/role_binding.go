@@ -0,0 +1,57 @@
+package client
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// CreateRoleBinding creates new role binding on configured Sensu instance
+func (client *RestClient) CreateRoleBinding(binding *types.RoleBinding) error {
+	bytes, err := json.Marshal(binding)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.R().SetBody(bytes).Post("/rolebindings")
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return unmarshalError(res)
+	}
+
+	return nil
+}
+
+// DeleteRoleBinding deletes role binding from configured Sensu instance
+func (client *RestClient) DeleteRoleBinding(name string) error {
+	res, err := client.R().Delete("/rolebindings/" + url.PathEscape(name))
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return unmarshalError(res)
+	}
+
+	return nil
+}
+
+// ListRoleBindings fetches all role bindings from configured Sensu instance
+func (client *RestClient) ListRoleBindings(org string) ([]types.RoleBinding, error) {
+	var bindings []types.RoleBinding
+	res, err := client.R().SetQueryParam("org", org).Get("/rolebindings")
+	if err != nil {
+		return bindings, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return bindings, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &bindings)
+	return bindings, err
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"encoding/json"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// FetchTessenConfig fetches the anonymous usage reporting configuration from
+// Sensu API
+func (client *RestClient) FetchTessenConfig() (*types.TessenConfig, error) {
+	config := &types.TessenConfig{}
+
+	res, err := client.R().Get("/tessen")
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), config)
+	return config, err
+}
+
+// UpdateTessenConfig updates the anonymous usage reporting configuration on
+// Sensu API
+func (client *RestClient) UpdateTessenConfig(config *types.TessenConfig) error {
+	bytes, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.R().SetBody(bytes).Put("/tessen")
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return unmarshalError(res)
+	}
+
+	return nil
+}
+
+// PreviewTessenData fetches the anonymous usage data that is reported when
+// the cluster opts in
+func (client *RestClient) PreviewTessenData() (*types.TessenData, error) {
+	data := &types.TessenData{}
+
+	res, err := client.R().Get("/tessen/preview")
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), data)
+	return data, err
+}
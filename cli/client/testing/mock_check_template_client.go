@@ -0,0 +1,39 @@
+package testing
+
+import "github.com/sensu/sensu-go/types"
+
+// CreateCheckTemplate for use with mock lib
+func (c *MockClient) CreateCheckTemplate(tmpl *types.CheckTemplate) error {
+	args := c.Called(tmpl)
+	return args.Error(0)
+}
+
+// UpdateCheckTemplate for use with mock lib
+func (c *MockClient) UpdateCheckTemplate(tmpl *types.CheckTemplate) error {
+	args := c.Called(tmpl)
+	return args.Error(0)
+}
+
+// DeleteCheckTemplate for use with mock lib
+func (c *MockClient) DeleteCheckTemplate(tmpl *types.CheckTemplate) error {
+	args := c.Called(tmpl)
+	return args.Error(0)
+}
+
+// FetchCheckTemplate for use with mock lib
+func (c *MockClient) FetchCheckTemplate(name string) (*types.CheckTemplate, error) {
+	args := c.Called(name)
+	return args.Get(0).(*types.CheckTemplate), args.Error(1)
+}
+
+// ListCheckTemplates for use with mock lib
+func (c *MockClient) ListCheckTemplates(org string) ([]types.CheckTemplate, error) {
+	args := c.Called(org)
+	return args.Get(0).([]types.CheckTemplate), args.Error(1)
+}
+
+// RenderCheckTemplate for use with mock lib
+func (c *MockClient) RenderCheckTemplate(name string) ([]types.CheckConfig, error) {
+	args := c.Called(name)
+	return args.Get(0).([]types.CheckConfig), args.Error(1)
+}
@@ -0,0 +1,27 @@
+package testing
+
+import "github.com/sensu/sensu-go/types"
+
+// FetchClusterConfig for use with mock lib
+func (c *MockClient) FetchClusterConfig() (*types.ClusterConfig, error) {
+	args := c.Called()
+	return args.Get(0).(*types.ClusterConfig), args.Error(1)
+}
+
+// UpdateClusterConfig for use with mock lib
+func (c *MockClient) UpdateClusterConfig(config *types.ClusterConfig) error {
+	args := c.Called(config)
+	return args.Error(0)
+}
+
+// FetchOrganizationConfig for use with mock lib
+func (c *MockClient) FetchOrganizationConfig(org string) (*types.ClusterConfig, error) {
+	args := c.Called(org)
+	return args.Get(0).(*types.ClusterConfig), args.Error(1)
+}
+
+// UpdateOrganizationConfig for use with mock lib
+func (c *MockClient) UpdateOrganizationConfig(org string, config *types.ClusterConfig) error {
+	args := c.Called(org, config)
+	return args.Error(0)
+}
@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"github.com/sensu/sensu-go/cli/client/config"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/mock"
 )
@@ -19,6 +20,12 @@ func (m *MockConfig) APIUrl() string {
 	return args.String(0)
 }
 
+// DeleteRemoteCluster mocks deleting a remote cluster
+func (m *MockConfig) DeleteRemoteCluster(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
 // Environment mocks the environment config
 func (m *MockConfig) Environment() string {
 	args := m.Called()
@@ -37,6 +44,19 @@ func (m *MockConfig) Organization() string {
 	return args.String(0)
 }
 
+// RemoteCluster mocks the configuration of a remote cluster
+func (m *MockConfig) RemoteCluster(name string) (config.Config, error) {
+	args := m.Called(name)
+	cfg, _ := args.Get(0).(config.Config)
+	return cfg, args.Error(1)
+}
+
+// RemoteClusters mocks the names of the remote clusters
+func (m *MockConfig) RemoteClusters() []string {
+	args := m.Called()
+	return args.Get(0).([]string)
+}
+
 // SaveAPIUrl mocks saving the API URL
 func (m *MockConfig) SaveAPIUrl(url string) error {
 	args := m.Called(url)
@@ -61,6 +81,12 @@ func (m *MockConfig) SaveOrganization(org string) error {
 	return args.Error(0)
 }
 
+// SaveRemoteCluster mocks saving a remote cluster
+func (m *MockConfig) SaveRemoteCluster(name, url string, tokens *types.Tokens) error {
+	args := m.Called(name, url, tokens)
+	return args.Error(0)
+}
+
 // SaveTokens mocks saving the tokens
 func (m *MockConfig) SaveTokens(tokens *types.Tokens) error {
 	args := m.Called(tokens)
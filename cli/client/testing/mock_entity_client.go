@@ -8,6 +8,12 @@ func (c *MockClient) ListEntities(org string) ([]types.Entity, error) {
 	return args.Get(0).([]types.Entity), args.Error(1)
 }
 
+// QueryEntities for use with mock lib
+func (c *MockClient) QueryEntities(org, query string) ([]types.Entity, error) {
+	args := c.Called(org, query)
+	return args.Get(0).([]types.Entity), args.Error(1)
+}
+
 // FetchEntity for use with mock lib
 func (c *MockClient) FetchEntity(ID string) (*types.Entity, error) {
 	args := c.Called(ID)
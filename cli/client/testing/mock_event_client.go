@@ -14,6 +14,12 @@ func (c *MockClient) ListEvents(org string) ([]types.Event, error) {
 	return args.Get(0).([]types.Event), args.Error(1)
 }
 
+// QueryEvents for use with mock lib
+func (c *MockClient) QueryEvents(org, query string) ([]types.Event, error) {
+	args := c.Called(org, query)
+	return args.Get(0).([]types.Event), args.Error(1)
+}
+
 // DeleteEvent for use with mock lib
 func (c *MockClient) DeleteEvent(entity, check string) error {
 	args := c.Called(entity, check)
@@ -25,3 +31,9 @@ func (c *MockClient) ResolveEvent(event *types.Event) error {
 	args := c.Called(event)
 	return args.Error(0)
 }
+
+// ReplayEvents for use with mock lib
+func (c *MockClient) ReplayEvents(org string, replay *types.EventReplay) ([]types.Event, error) {
+	args := c.Called(org, replay)
+	return args.Get(0).([]types.Event), args.Error(1)
+}
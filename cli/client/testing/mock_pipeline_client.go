@@ -0,0 +1,33 @@
+package testing
+
+import "github.com/sensu/sensu-go/types"
+
+// CreatePipeline for use with mock lib
+func (c *MockClient) CreatePipeline(pipeline *types.Pipeline) error {
+	args := c.Called(pipeline)
+	return args.Error(0)
+}
+
+// UpdatePipeline for use with mock lib
+func (c *MockClient) UpdatePipeline(pipeline *types.Pipeline) error {
+	args := c.Called(pipeline)
+	return args.Error(0)
+}
+
+// DeletePipeline for use with mock lib
+func (c *MockClient) DeletePipeline(pipeline *types.Pipeline) error {
+	args := c.Called(pipeline)
+	return args.Error(0)
+}
+
+// FetchPipeline for use with mock lib
+func (c *MockClient) FetchPipeline(name string) (*types.Pipeline, error) {
+	args := c.Called(name)
+	return args.Get(0).(*types.Pipeline), args.Error(1)
+}
+
+// ListPipelines for use with mock lib
+func (c *MockClient) ListPipelines(org string) ([]types.Pipeline, error) {
+	args := c.Called(org)
+	return args.Get(0).([]types.Pipeline), args.Error(1)
+}
@@ -0,0 +1,21 @@
+package testing
+
+import "github.com/sensu/sensu-go/types"
+
+// CreateRoleBinding for use with mock lib
+func (c *MockClient) CreateRoleBinding(binding *types.RoleBinding) error {
+	args := c.Called(binding)
+	return args.Error(0)
+}
+
+// DeleteRoleBinding for use with mock lib
+func (c *MockClient) DeleteRoleBinding(name string) error {
+	args := c.Called(name)
+	return args.Error(0)
+}
+
+// ListRoleBindings for use with mock lib
+func (c *MockClient) ListRoleBindings(org string) ([]types.RoleBinding, error) {
+	args := c.Called(org)
+	return args.Get(0).([]types.RoleBinding), args.Error(1)
+}
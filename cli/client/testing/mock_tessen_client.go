@@ -0,0 +1,21 @@
+package testing
+
+import "github.com/sensu/sensu-go/types"
+
+// FetchTessenConfig for use with mock lib
+func (c *MockClient) FetchTessenConfig() (*types.TessenConfig, error) {
+	args := c.Called()
+	return args.Get(0).(*types.TessenConfig), args.Error(1)
+}
+
+// UpdateTessenConfig for use with mock lib
+func (c *MockClient) UpdateTessenConfig(config *types.TessenConfig) error {
+	args := c.Called(config)
+	return args.Error(0)
+}
+
+// PreviewTessenData for use with mock lib
+func (c *MockClient) PreviewTessenData() (*types.TessenData, error) {
+	args := c.Called()
+	return args.Get(0).(*types.TessenData), args.Error(1)
+}
@@ -0,0 +1,15 @@
+package testing
+
+import "github.com/sensu/sensu-go/types"
+
+// ListTombstones for use with mock lib
+func (c *MockClient) ListTombstones(org string) ([]types.Tombstone, error) {
+	args := c.Called(org)
+	return args.Get(0).([]types.Tombstone), args.Error(1)
+}
+
+// RestoreTombstone for use with mock lib
+func (c *MockClient) RestoreTombstone(kind, name string) (*types.Tombstone, error) {
+	args := c.Called(kind, name)
+	return args.Get(0).(*types.Tombstone), args.Error(1)
+}
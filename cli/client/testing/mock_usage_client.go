@@ -0,0 +1,15 @@
+package testing
+
+import "github.com/sensu/sensu-go/types"
+
+// ListUsage for use with mock lib
+func (c *MockClient) ListUsage(limit int, sortBy string) ([]types.Usage, error) {
+	args := c.Called(limit, sortBy)
+	return args.Get(0).([]types.Usage), args.Error(1)
+}
+
+// ListResourceUsage for use with mock lib
+func (c *MockClient) ListResourceUsage(limit int, sortBy, kind string) ([]types.ResourceUsage, error) {
+	args := c.Called(limit, sortBy, kind)
+	return args.Get(0).([]types.ResourceUsage), args.Error(1)
+}
@@ -43,3 +43,15 @@ func (c *MockClient) UpdatePassword(username, pwd string) error {
 	args := c.Called(username, pwd)
 	return args.Error(0)
 }
+
+// FetchUserPreferences for use with mock lib
+func (c *MockClient) FetchUserPreferences(username string) (*types.UserPreferences, error) {
+	args := c.Called(username)
+	return args.Get(0).(*types.UserPreferences), args.Error(1)
+}
+
+// UpdateUserPreferences for use with mock lib
+func (c *MockClient) UpdateUserPreferences(preferences *types.UserPreferences) error {
+	args := c.Called(preferences)
+	return args.Error(0)
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// ListTombstones fetches the tombstones of the deleted resources from Sensu
+// API
+func (client *RestClient) ListTombstones(org string) ([]types.Tombstone, error) {
+	var tombstones []types.Tombstone
+	res, err := client.R().SetQueryParam("org", org).Get("/tombstones")
+	if err != nil {
+		return tombstones, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return tombstones, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &tombstones)
+	return tombstones, err
+}
+
+// RestoreTombstone recreates the deleted resource of the given kind and name
+// from its tombstone
+func (client *RestClient) RestoreTombstone(kind, name string) (*types.Tombstone, error) {
+	tombstone := &types.Tombstone{}
+
+	// The namespace isn't set on the POST requests by default
+	path := "/tombstones/" + url.PathEscape(kind) + "/" + url.PathEscape(name) + "/restore"
+	res, err := client.R().SetQueryParams(map[string]string{
+		"org": client.config.Organization(),
+		"env": client.config.Environment(),
+	}).Post(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), tombstone)
+	return tombstone, err
+}
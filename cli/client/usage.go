@@ -0,0 +1,59 @@
+package client
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// ListUsage fetches the event throughput usage from Sensu API
+func (client *RestClient) ListUsage(limit int, sortBy string) ([]types.Usage, error) {
+	var usage []types.Usage
+
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(limit))
+	if sortBy != "" {
+		query.Set("sort", sortBy)
+	}
+
+	res, err := client.R().Get("/metrics/usage?" + query.Encode())
+	if err != nil {
+		return usage, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &usage)
+	return usage, err
+}
+
+// ListResourceUsage fetches the resources used by the checks and handlers from
+// Sensu API
+func (client *RestClient) ListResourceUsage(limit int, sortBy, kind string) ([]types.ResourceUsage, error) {
+	var usage []types.ResourceUsage
+
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(limit))
+	if sortBy != "" {
+		query.Set("sort", sortBy)
+	}
+	if kind != "" {
+		query.Set("kind", kind)
+	}
+
+	res, err := client.R().Get("/metrics/resources?" + query.Encode())
+	if err != nil {
+		return usage, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &usage)
+	return usage, err
+}
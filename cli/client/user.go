@@ -119,3 +119,39 @@ func (client *RestClient) UpdatePassword(username, pwd string) error {
 
 	return nil
 }
+
+// FetchUserPreferences fetches the preferences of given user on configured
+// Sensu instance
+func (client *RestClient) FetchUserPreferences(username string) (*types.UserPreferences, error) {
+	var preferences *types.UserPreferences
+
+	res, err := client.R().Get("/rbac/users/" + url.PathEscape(username) + "/preferences")
+	if err != nil {
+		return preferences, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return preferences, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &preferences)
+	return preferences, err
+}
+
+// UpdateUserPreferences replaces the preferences of given user on configured
+// Sensu instance
+func (client *RestClient) UpdateUserPreferences(preferences *types.UserPreferences) error {
+	res, err := client.R().
+		SetBody(preferences).
+		Put("/rbac/users/" + url.PathEscape(preferences.Username) + "/preferences")
+
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() >= 400 {
+		return unmarshalError(res)
+	}
+
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/client/config"
 	"github.com/sensu/sensu-go/cli/commands"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
 	hooks "github.com/sensu/sensu-go/cli/commands/hooks"
 	"github.com/sensu/sensu-go/util/path"
 	"github.com/sensu/sensu-go/version"
@@ -18,13 +20,21 @@ func main() {
 	sensuCli := cli.New(rootCmd.PersistentFlags())
 
 	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
+		if err := hooks.InteractionAllowed(cmd); err != nil {
+			return err
+		}
 		return hooks.ConfigurationPresent(cmd, sensuCli)
 	}
 
 	commands.AddCommands(rootCmd, sensuCli)
 
-	if err := rootCmd.Execute(); err != nil {
-		os.Exit(1)
+	if cmd, err := rootCmd.ExecuteC(); err != nil {
+		format := sensuCli.Config.Format()
+		if f := helpers.GetChangedStringValueFlag(flags.Format, cmd.Flags()); f != "" {
+			format = f
+		}
+		_ = helpers.PrintError(cmd.OutOrStderr(), format, err)
+		os.Exit(helpers.ExitCode(err))
 	}
 }
 
@@ -33,6 +43,8 @@ func configureRootCmd() *cobra.Command {
 		Use:          cli.SensuCmdName,
 		Short:        cli.SensuCmdName + " controls Sensu instances",
 		SilenceUsage: true,
+		// The errors are printed by main, following the requested format
+		SilenceErrors: true,
 		Run: func(cmd *cobra.Command, args []string) {
 			_ = cmd.Help()
 		},
@@ -41,6 +53,11 @@ func configureRootCmd() *cobra.Command {
 	// Templates
 	cmd.SetUsageTemplate(usageTemplate)
 
+	// Invalid flags are usage errors
+	cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return helpers.UsageError(err)
+	})
+
 	// Version command
 	cmd.AddCommand(newVersionCommand())
 
@@ -50,6 +67,8 @@ func configureRootCmd() *cobra.Command {
 	cmd.PersistentFlags().String("cache-dir", path.UserCacheDir("sensuctl"), "path to directory containing cache & temporary files")
 	cmd.PersistentFlags().String("organization", config.DefaultOrganization, "organization in which we perform actions")
 	cmd.PersistentFlags().String("environment", config.DefaultEnvironment, "environment in which we perform actions")
+	cmd.PersistentFlags().Bool(flags.NonInteractive, false, "never prompt for input, commands requiring it fail instead")
+	cmd.PersistentFlags().String("impersonate", "", "make the requests as the given user, which requires the impersonate permission on users")
 
 	return cmd
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/AlecAivazis/survey"
 	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/hooks"
 	"github.com/sensu/sensu-go/types"
 	"github.com/spf13/cobra"
 )
@@ -51,6 +52,10 @@ func UpdateCommand(cli *cli.SensuCli) *cobra.Command {
 			fmt.Fprintln(cmd.OutOrStdout(), "OK")
 			return nil
 		},
+		Annotations: map[string]string{
+			// The resource is updated through prompts
+			hooks.InteractionRequirement: hooks.InteractionRequired,
+		},
 	}
 
 	return cmd
@@ -0,0 +1,160 @@
+// Package bundle provides the commands exporting resources, along with the
+// binaries of their assets, to a single archive which can be carried to and
+// imported in a cluster without internet access.
+//
+// A bundle is a gzipped tarball holding a manifest of the resources, named
+// resources.json, and the binaries of the assets, named after their SHA-512
+// sum under the assets directory.
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sensu/sensu-go/cli/resource"
+)
+
+const (
+	// manifestName is the name of the manifest of the resources of a bundle
+	manifestName = "resources.json"
+
+	// assetsDir is the directory of the asset binaries of a bundle
+	assetsDir = "assets"
+)
+
+// writer writes a bundle.
+type writer struct {
+	gz  *gzip.Writer
+	tar *tar.Writer
+}
+
+func newWriter(w io.Writer) *writer {
+	gz := gzip.NewWriter(w)
+	return &writer{gz: gz, tar: tar.NewWriter(gz)}
+}
+
+// WriteManifest writes the manifest of the resources.
+func (w *writer) WriteManifest(wrappers []resource.Wrapper) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, wrapper := range wrappers {
+		if err := enc.Encode(wrapper); err != nil {
+			return err
+		}
+	}
+	return w.writeFile(manifestName, int64(buf.Len()), &buf)
+}
+
+// WriteAsset writes the binary of the asset with the given SHA-512 sum.
+func (w *writer) WriteAsset(sha512 string, size int64, r io.Reader) error {
+	return w.writeFile(path.Join(assetsDir, sha512), size, r)
+}
+
+func (w *writer) writeFile(name string, size int64, r io.Reader) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    size,
+		ModTime: time.Now(),
+	}
+	if err := w.tar.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := io.Copy(w.tar, r)
+	return err
+}
+
+// Close flushes the bundle.
+func (w *writer) Close() error {
+	if err := w.tar.Close(); err != nil {
+		return err
+	}
+	return w.gz.Close()
+}
+
+// read reads the bundle of r, and extracts its asset binaries to assetDir,
+// which must not be empty if it holds any. It returns the manifest of the
+// resources, and the SHA-512 sums of the assets extracted.
+func read(r io.Reader, assetDir string) ([]resource.Wrapper, map[string]bool, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid bundle: %s", err)
+	}
+	defer func() { _ = gz.Close() }()
+
+	var wrappers []resource.Wrapper
+	assets := map[string]bool{}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("invalid bundle: %s", err)
+		}
+
+		switch dir, name := path.Split(path.Clean(hdr.Name)); {
+		case hdr.Name == manifestName:
+			wrappers, err = resource.Parse(tr)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid manifest: %s", err)
+			}
+		case dir == assetsDir+"/":
+			if assetDir == "" {
+				return nil, nil, errors.New("the bundle holds assets, --asset-dir and --asset-url must be provided")
+			}
+			if err := extractAsset(tr, assetDir, name); err != nil {
+				return nil, nil, err
+			}
+			assets[name] = true
+		}
+	}
+
+	if wrappers == nil {
+		return nil, nil, fmt.Errorf("invalid bundle: %s is missing", manifestName)
+	}
+	return wrappers, assets, nil
+}
+
+// extractAsset writes the binary of the asset with the given SHA-512 sum to
+// dir, and checks that it matches its sum.
+func extractAsset(r io.Reader, dir, sum string) error {
+	if _, err := hex.DecodeString(sum); err != nil || strings.ContainsAny(sum, `/\`) {
+		return fmt.Errorf("invalid asset %q in the bundle", sum)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	name := filepath.Join(dir, sum)
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+
+	h := sha512.New()
+	_, err = io.Copy(io.MultiWriter(f, h), r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && hex.EncodeToString(h.Sum(nil)) != sum {
+		err = fmt.Errorf("the asset %s of the bundle doesn't match its SHA-512 sum", sum)
+	}
+	if err != nil {
+		_ = os.Remove(name)
+		return err
+	}
+	return nil
+}
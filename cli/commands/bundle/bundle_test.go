@@ -0,0 +1,141 @@
+package bundle
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const binary = "#!/bin/sh\necho OK\n"
+
+// newAsset returns an asset whose binary is served by a test server.
+func newAsset(t *testing.T) (*types.Asset, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(binary))
+	}))
+
+	sum := sha512.Sum512([]byte(binary))
+	asset := types.FixtureAsset("check-ok")
+	asset.URL = server.URL + "/check-ok.tar.gz"
+	asset.Sha512 = hex.EncodeToString(sum[:])
+	return asset, server.Close
+}
+
+func TestCommands(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := HelpCommand(cli)
+
+	assert.Regexp(t, "bundle", cmd.Use)
+	assert.Len(t, cmd.Commands(), 2)
+}
+
+func TestExportImport(t *testing.T) {
+	asset, closeServer := newAsset(t)
+	defer closeServer()
+	check := types.FixtureCheckConfig("check-ok")
+	mutator := types.FixtureMutator("mutator")
+
+	exportClient := &client.MockClient{}
+	exportClient.On("ListAssets", "default").Return([]types.Asset{*asset}, nil)
+	exportClient.On("ListChecks", "default").Return([]types.CheckConfig{*check}, nil)
+	exportClient.On("ListMutators").Return([]types.Mutator{*mutator}, nil)
+
+	var buf bytes.Buffer
+	resources, assets, err := export(exportClient, "default", []string{"asset", "mutator", "check"}, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, 3, resources)
+	assert.Equal(t, 1, assets)
+
+	dir, err := ioutil.TempDir("", "bundle")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	wrappers, extracted, err := read(&buf, dir)
+	require.NoError(t, err)
+	require.Len(t, wrappers, 3)
+	assert.Equal(t, "Asset", wrappers[0].Type)
+	assert.Equal(t, map[string]bool{asset.Sha512: true}, extracted)
+	content, err := ioutil.ReadFile(filepath.Join(dir, asset.Sha512))
+	require.NoError(t, err)
+	assert.Equal(t, binary, string(content))
+
+	// The asset is created with the URL of its extracted binary, the existing
+	// check is updated and the existing mutator is kept
+	importClient := &client.MockClient{}
+	importClient.On("ListAssets", "default").Return([]types.Asset{}, nil)
+	importClient.On("ListChecks", "default").Return([]types.CheckConfig{*check}, nil)
+	importClient.On("ListMutators").Return([]types.Mutator{*mutator}, nil)
+	importClient.On("CreateAsset", mock.AnythingOfType("*types.Asset")).Return(nil)
+	importClient.On("UpdateCheck", mock.AnythingOfType("*types.CheckConfig")).Return(nil)
+
+	var out bytes.Buffer
+	require.NoError(t, importResources(importClient, "default", wrappers, extracted, "http://mirror.internal/assets/", &out))
+	created := importClient.Calls[1].Arguments.Get(0).(*types.Asset)
+	assert.Equal(t, "http://mirror.internal/assets/"+asset.Sha512, created.URL)
+	assert.Equal(t, asset.Sha512, created.Sha512)
+	assert.Contains(t, out.String(), "created asset check-ok")
+	assert.Contains(t, out.String(), "skipped existing mutator mutator")
+	assert.Contains(t, out.String(), "updated check check-ok")
+	importClient.AssertNotCalled(t, "CreateMutator", mock.Anything)
+}
+
+func TestExportAssetSumMismatch(t *testing.T) {
+	asset, closeServer := newAsset(t)
+	defer closeServer()
+	asset.Sha512 = "ab12"
+
+	exportClient := &client.MockClient{}
+	exportClient.On("ListAssets", "default").Return([]types.Asset{*asset}, nil)
+
+	_, _, err := export(exportClient, "default", []string{"asset"}, ioutil.Discard)
+	assert.EqualError(t, err, "could not export the asset check-ok: the binary at "+asset.URL+" doesn't match the SHA-512 sum of the asset")
+}
+
+func TestImportCommandAssetFlags(t *testing.T) {
+	asset, closeServer := newAsset(t)
+	defer closeServer()
+
+	exportClient := &client.MockClient{}
+	exportClient.On("ListAssets", "default").Return([]types.Asset{*asset}, nil)
+
+	f, err := ioutil.TempFile("", "bundle")
+	require.NoError(t, err)
+	defer func() { _ = os.Remove(f.Name()) }()
+	_, _, err = export(exportClient, "default", []string{"asset"}, f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	cli := test.NewMockCLI()
+	cmd := ImportCommand(cli)
+	require.NoError(t, cmd.Flags().Set("file", f.Name()))
+	_, err = test.RunCmd(cmd, []string{})
+	assert.EqualError(t, err, "the bundle holds assets, --asset-dir and --asset-url must be provided")
+
+	cmd = ImportCommand(cli)
+	require.NoError(t, cmd.Flags().Set("file", f.Name()))
+	require.NoError(t, cmd.Flags().Set("asset-dir", os.TempDir()))
+	_, err = test.RunCmd(cmd, []string{})
+	assert.EqualError(t, err, "both --asset-dir and --asset-url must be provided")
+}
+
+func TestExportCommandWithoutFile(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := ExportCommand(cli)
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.Error(t, err)
+	assert.Regexp(t, "Usage", out)
+}
@@ -0,0 +1,237 @@
+package bundle
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagFile  = "file"
+	flagTypes = "types"
+
+	// downloadTimeout is the time given to download each asset
+	downloadTimeout = 5 * time.Minute
+)
+
+// exportableTypes are the resource types which can be exported, in the order
+// they are imported, so the resources are created after the ones they
+// reference.
+var exportableTypes = []string{"asset", "filter", "mutator", "handler", "hook", "pipeline", "check", "checktemplate"}
+
+// ExportCommand adds a command that exports resources and the binaries of
+// their assets to a bundle.
+func ExportCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "export",
+		Short:        "export resources and their asset binaries to a bundle",
+		SilenceUsage: true,
+		Example: `  sensuctl bundle export -f sensu-bundle.tar.gz
+  sensuctl bundle export -f checks.tar.gz --types asset,check`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			file, _ := cmd.Flags().GetString(flagFile)
+			if file == "" {
+				_ = cmd.Help()
+				return errors.New("must specify --file")
+			}
+			typeNames, _ := cmd.Flags().GetStringSlice(flagTypes)
+
+			f, err := os.Create(file)
+			if err != nil {
+				return err
+			}
+			resources, assets, err := export(cli.Client, cli.Config.Organization(), typeNames, f)
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				_ = os.Remove(file)
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Exported %d resources and %d assets to %s\n", resources, assets, file)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flagFile, "f", "", "path of the bundle")
+	cmd.Flags().StringSlice(flagTypes, exportableTypes, "resource types exported")
+
+	return cmd
+}
+
+// export writes the bundle of the resources of the given types to w, and
+// returns the number of resources and assets exported.
+func export(c client.APIClient, org string, typeNames []string, w io.Writer) (int, int, error) {
+	var (
+		wrappers []resource.Wrapper
+		assets   []types.Asset
+	)
+	for _, typeName := range typeNames {
+		found, err := listResources(c, org, typeName)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, v := range found {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return 0, 0, err
+			}
+			wrappers = append(wrappers, resource.Wrapper{Type: wrapperType(v), Value: b})
+			if asset, ok := v.(*types.Asset); ok {
+				assets = append(assets, *asset)
+			}
+		}
+	}
+
+	bundle := newWriter(w)
+	if err := bundle.WriteManifest(wrappers); err != nil {
+		return 0, 0, err
+	}
+	for _, asset := range assets {
+		if err := exportAsset(bundle, asset); err != nil {
+			return 0, 0, fmt.Errorf("could not export the asset %s: %s", asset.Name, err)
+		}
+	}
+	if err := bundle.Close(); err != nil {
+		return 0, 0, err
+	}
+
+	return len(wrappers), len(assets), nil
+}
+
+// exportAsset downloads the binary of the asset, checks its SHA-512 sum and
+// writes it to the bundle.
+func exportAsset(bundle *writer, asset types.Asset) error {
+	httpClient := &http.Client{Timeout: downloadTimeout}
+	resp, err := httpClient.Get(asset.URL)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("downloading %s failed with status %s", asset.URL, resp.Status)
+	}
+
+	// The size of the binary must be known before it's written to the bundle
+	tmp, err := ioutil.TempFile("", "sensu-asset")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	h := sha512.New()
+	size, err := io.Copy(io.MultiWriter(tmp, h), resp.Body)
+	if err != nil {
+		return err
+	}
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != asset.Sha512 {
+		return fmt.Errorf("the binary at %s doesn't match the SHA-512 sum of the asset", asset.URL)
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return bundle.WriteAsset(asset.Sha512, size, tmp)
+}
+
+func listResources(c client.APIClient, org, typeName string) ([]interface{}, error) {
+	var resources []interface{}
+
+	switch typeName {
+	case "asset", "assets":
+		assets, err := c.ListAssets(org)
+		for i := range assets {
+			resources = append(resources, &assets[i])
+		}
+		return resources, err
+	case "check", "checks":
+		checks, err := c.ListChecks(org)
+		for i := range checks {
+			resources = append(resources, &checks[i])
+		}
+		return resources, err
+	case "checktemplate", "checktemplates":
+		templates, err := c.ListCheckTemplates(org)
+		for i := range templates {
+			resources = append(resources, &templates[i])
+		}
+		return resources, err
+	case "filter", "filters":
+		filters, err := c.ListFilters(org)
+		for i := range filters {
+			resources = append(resources, &filters[i])
+		}
+		return resources, err
+	case "handler", "handlers":
+		handlers, err := c.ListHandlers(org)
+		for i := range handlers {
+			resources = append(resources, &handlers[i])
+		}
+		return resources, err
+	case "hook", "hooks":
+		hooks, err := c.ListHooks(org)
+		for i := range hooks {
+			resources = append(resources, &hooks[i])
+		}
+		return resources, err
+	case "mutator", "mutators":
+		mutators, err := c.ListMutators()
+		for i := range mutators {
+			resources = append(resources, &mutators[i])
+		}
+		return resources, err
+	case "pipeline", "pipelines":
+		pipelines, err := c.ListPipelines(org)
+		for i := range pipelines {
+			resources = append(resources, &pipelines[i])
+		}
+		return resources, err
+	}
+
+	return nil, fmt.Errorf("resource type %q cannot be exported", typeName)
+}
+
+// wrapperType returns the type of the resource in the manifests.
+func wrapperType(v interface{}) string {
+	switch v.(type) {
+	case *types.Asset:
+		return "Asset"
+	case *types.CheckConfig:
+		return "CheckConfig"
+	case *types.CheckTemplate:
+		return "CheckTemplate"
+	case *types.EventFilter:
+		return "EventFilter"
+	case *types.Handler:
+		return "Handler"
+	case *types.HookConfig:
+		return "HookConfig"
+	case *types.Mutator:
+		return "Mutator"
+	case *types.Pipeline:
+		return "Pipeline"
+	}
+	return fmt.Sprintf("%T", v)
+}
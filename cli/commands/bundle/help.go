@@ -0,0 +1,22 @@
+package bundle
+
+import (
+	"github.com/sensu/sensu-go/cli"
+	"github.com/spf13/cobra"
+)
+
+// HelpCommand defines new parent
+func HelpCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Export and import resources with their asset binaries for air-gapped clusters",
+	}
+
+	// Add sub-commands
+	cmd.AddCommand(
+		ExportCommand(cli),
+		ImportCommand(cli),
+	)
+
+	return cmd
+}
@@ -0,0 +1,199 @@
+package bundle
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagAssetDir = "asset-dir"
+	flagAssetURL = "asset-url"
+)
+
+// ImportCommand adds a command that imports the resources of a bundle, and
+// extracts the binaries of its assets to a directory served to the agents.
+func ImportCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "import",
+		Short:        "import the resources of a bundle and extract its asset binaries",
+		SilenceUsage: true,
+		Example:      `  sensuctl bundle import -f sensu-bundle.tar.gz --asset-dir /var/www/assets --asset-url http://mirror.internal/assets`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			file, _ := cmd.Flags().GetString(flagFile)
+			if file == "" {
+				_ = cmd.Help()
+				return errors.New("must specify --file")
+			}
+			assetDir, _ := cmd.Flags().GetString(flagAssetDir)
+			assetURL, _ := cmd.Flags().GetString(flagAssetURL)
+			if (assetDir == "") != (assetURL == "") {
+				return fmt.Errorf("both --%s and --%s must be provided", flagAssetDir, flagAssetURL)
+			}
+			if assetURL != "" {
+				if u, err := url.Parse(assetURL); err != nil || u.Scheme == "" || u.Host == "" {
+					return fmt.Errorf("invalid asset URL %q", assetURL)
+				}
+			}
+
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = f.Close() }()
+
+			wrappers, assets, err := read(f, assetDir)
+			if err != nil {
+				return err
+			}
+
+			return importResources(cli.Client, cli.Config.Organization(), wrappers, assets, assetURL, cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().StringP(flagFile, "f", "", "path of the bundle")
+	cmd.Flags().String(flagAssetDir, "", "directory the asset binaries are extracted to")
+	cmd.Flags().String(flagAssetURL, "", "URL at which the agents download the asset binaries of --asset-dir")
+
+	return cmd
+}
+
+// importResources creates the resources of the manifest, or updates them if
+// they already exist. The URLs of the assets are rewritten to point to their
+// binaries extracted from the bundle, served at assetURL.
+func importResources(c client.APIClient, org string, wrappers []resource.Wrapper, assets map[string]bool, assetURL string, w io.Writer) error {
+	resources := make([]interface{}, 0, len(wrappers))
+	for _, wrapper := range wrappers {
+		v, err := wrapper.Decode()
+		if err != nil {
+			return err
+		}
+		if kind, _ := describe(v); kind == "" {
+			return fmt.Errorf("%s resources cannot be imported", wrapper.Type)
+		}
+		if asset, ok := v.(*types.Asset); ok {
+			if !assets[asset.Sha512] {
+				return fmt.Errorf("the binary of the asset %s is missing from the bundle", asset.Name)
+			}
+			asset.URL = strings.TrimSuffix(assetURL, "/") + "/" + asset.Sha512
+		}
+		resources = append(resources, v)
+	}
+
+	existing := map[string]map[string]bool{}
+	var failed int
+	for _, v := range resources {
+		kind, name := describe(v)
+		if _, ok := existing[kind]; !ok {
+			found, err := listResources(c, org, kind)
+			if err != nil {
+				return err
+			}
+			existing[kind] = map[string]bool{}
+			for _, r := range found {
+				_, name := describe(r)
+				existing[kind][name] = true
+			}
+		}
+
+		action, err := applyResource(c, v, existing[kind][name])
+		if err != nil {
+			failed++
+			fmt.Fprintf(w, "unable to import %s %s: %s\n", kind, name, err)
+			continue
+		}
+		fmt.Fprintf(w, "%s %s %s\n", action, kind, name)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d resources could not be imported", failed, len(resources))
+	}
+
+	fmt.Fprintln(w, "OK")
+	return nil
+}
+
+// applyResource creates the resource, or updates it if it exists, and returns
+// the action taken.
+func applyResource(c client.APIClient, v interface{}, exists bool) (string, error) {
+	if !exists {
+		switch r := v.(type) {
+		case *types.Asset:
+			return "created", c.CreateAsset(r)
+		case *types.CheckConfig:
+			return "created", c.CreateCheck(r)
+		case *types.CheckTemplate:
+			return "created", c.CreateCheckTemplate(r)
+		case *types.EventFilter:
+			return "created", c.CreateFilter(r)
+		case *types.Handler:
+			return "created", c.CreateHandler(r)
+		case *types.HookConfig:
+			return "created", c.CreateHook(r)
+		case *types.Mutator:
+			return "created", c.CreateMutator(r)
+		case *types.Pipeline:
+			return "created", c.CreatePipeline(r)
+		}
+	} else {
+		switch r := v.(type) {
+		case *types.Asset:
+			return "updated", c.UpdateAsset(r)
+		case *types.CheckConfig:
+			return "updated", c.UpdateCheck(r)
+		case *types.CheckTemplate:
+			return "updated", c.UpdateCheckTemplate(r)
+		case *types.EventFilter:
+			return "updated", c.UpdateFilter(r)
+		case *types.Handler:
+			return "updated", c.UpdateHandler(r)
+		case *types.HookConfig:
+			return "updated", c.UpdateHook(r)
+		case *types.Mutator:
+			// The mutators can't be updated, the existing ones are kept
+			return "skipped existing", nil
+		case *types.Pipeline:
+			return "updated", c.UpdatePipeline(r)
+		}
+	}
+
+	return "", errors.New("importing this type of resource is not supported")
+}
+
+// describe returns the type, as accepted by listResources, and the name of
+// the resource, or empty strings if it can't be imported.
+func describe(v interface{}) (string, string) {
+	switch r := v.(type) {
+	case *types.Asset:
+		return "asset", r.Name
+	case *types.CheckConfig:
+		return "check", r.Name
+	case *types.CheckTemplate:
+		return "checktemplate", r.Name
+	case *types.EventFilter:
+		return "filter", r.Name
+	case *types.Handler:
+		return "handler", r.Name
+	case *types.HookConfig:
+		return "hook", r.Name
+	case *types.Mutator:
+		return "mutator", r.Name
+	case *types.Pipeline:
+		return "pipeline", r.Name
+	}
+	return "", ""
+}
@@ -95,6 +95,29 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd.Flags().String("ttl", "", "time to live in seconds for which a check result is valid")
 	cmd.Flags().String("high-flap-threshold", "", "flap detection high threshold (percent state change) for the check")
 	cmd.Flags().String("low-flap-threshold", "", "flap detection low threshold (percent state change) for the check")
+	cmd.Flags().Bool("splay", false, "spread the execution of the check by its subscribers over its interval")
+	cmd.Flags().String("splay-coverage", splayCoverageDefault, "percentage of the check interval over which executions are spread")
+	cmd.Flags().Bool("nagios", false, "parse the command output and exit code following the Nagios plugin conventions")
+	cmd.Flags().Bool("output-annotations", false, "parse the trailing JSON object of the command output as the event annotations")
+	cmd.Flags().String("metric-retention", "", "metric points persisted with the events: all (default), downsample or none")
+	cmd.Flags().String("exec-type", "", "how the command is executed: shell (default) or direct, without any shell")
+	cmd.Flags().String("shell", "", "shell through which the command is executed, instead of the platform default")
+	cmd.Flags().String("run-as", "", "user as which the command is executed, through sudo")
+	cmd.Flags().String("working-directory", "", "directory in which the command is executed")
+	cmd.Flags().String("cpu-limit", "", "maximum number of CPUs the command may use, e.g. 0.5")
+	cmd.Flags().String("memory-limit", "", "maximum memory, in bytes, the command may use")
+	cmd.Flags().String("nice", "", "scheduling priority of the command, from -20 (highest) to 19 (lowest)")
+	cmd.Flags().String("executor", "", "what executes the check: agent (default) or backend, for builtin http, tcp and icmp commands")
+	cmd.Flags().String("catch-up", "", "what the scheduler does after missed executions: skip (default) or run-once")
+	cmd.Flags().String("history-retention", "", "number of executions kept in the history of the events, 21 by default")
+	cmd.Flags().Bool("history-output", false, "keep the output of each execution in the history of the events")
+	cmd.Flags().String("pipelines", "", "comma separated list of pipelines the events of the check go through")
+	cmd.Flags().String("runbook-url", "", "URL of the runbook of the check, templated with the event, e.g. {{ .Check.Name }}")
+	cmd.Flags().String("dashboard-url", "", "URL of the dashboard of the check, templated with the event, e.g. {{ .Entity.ID }}")
+	cmd.Flags().String("anomaly-detection", "", "algorithm flagging the anomalous metric points of the events: zscore or ewma")
+	cmd.Flags().String("anomaly-threshold", "", "standard deviations from the mean beyond which a metric point is anomalous, 3 by default")
+	cmd.Flags().String("contacts", "", "comma separated list of the people or teams notified of the events")
+	cmd.Flags().String("anomaly-window", "", "number of samples the statistics of the metrics are computed over, 30 by default")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
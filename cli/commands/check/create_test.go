@@ -6,6 +6,7 @@ import (
 
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -89,6 +90,176 @@ func TestCreateCommandRunEClosureWithDepsSTDIN(t *testing.T) {
 	assert.Regexp("OK", out)
 }
 
+func TestCreateCommandRunEClosureWithSplay(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheck", mock.MatchedBy(func(c *types.CheckConfig) bool {
+		return c.Splay && c.SplayCoverage == 50
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "echo 'heyhey'"))
+	require.NoError(t, cmd.Flags().Set("subscriptions", "system"))
+	require.NoError(t, cmd.Flags().Set("interval", "10"))
+	require.NoError(t, cmd.Flags().Set("splay", "true"))
+	require.NoError(t, cmd.Flags().Set("splay-coverage", "50"))
+	out, err := test.RunCmd(cmd, []string{"can-holla"})
+	require.NoError(t, err)
+
+	assert.Regexp("OK", out)
+}
+
+func TestCreateCommandRunEClosureWithNagios(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheck", mock.MatchedBy(func(c *types.CheckConfig) bool {
+		return c.Nagios
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "check_disk -w 20% -c 10%"))
+	require.NoError(t, cmd.Flags().Set("subscriptions", "system"))
+	require.NoError(t, cmd.Flags().Set("interval", "10"))
+	require.NoError(t, cmd.Flags().Set("nagios", "true"))
+	out, err := test.RunCmd(cmd, []string{"check-disk"})
+	require.NoError(t, err)
+
+	assert.Regexp("OK", out)
+}
+
+func TestCreateCommandRunEClosureWithMetricRetention(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheck", mock.MatchedBy(func(c *types.CheckConfig) bool {
+		return c.MetricRetention == types.MetricRetentionDownsample
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "metrics-cpu.rb"))
+	require.NoError(t, cmd.Flags().Set("subscriptions", "system"))
+	require.NoError(t, cmd.Flags().Set("interval", "10"))
+	require.NoError(t, cmd.Flags().Set("metric-retention", "downsample"))
+	out, err := test.RunCmd(cmd, []string{"metrics-cpu"})
+	require.NoError(t, err)
+
+	assert.Regexp("OK", out)
+}
+
+func TestCreateCommandRunEClosureWithExecType(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheck", mock.MatchedBy(func(c *types.CheckConfig) bool {
+		return c.ExecType == types.CheckExecTypeDirect && c.RunAs == "nagios"
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "check_disk -w 20% -c 10%"))
+	require.NoError(t, cmd.Flags().Set("subscriptions", "system"))
+	require.NoError(t, cmd.Flags().Set("interval", "10"))
+	require.NoError(t, cmd.Flags().Set("exec-type", "direct"))
+	require.NoError(t, cmd.Flags().Set("run-as", "nagios"))
+	out, err := test.RunCmd(cmd, []string{"check-disk"})
+	require.NoError(t, err)
+
+	assert.Regexp("OK", out)
+}
+
+func TestCreateCommandRunEClosureWithResourceLimits(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheck", mock.MatchedBy(func(c *types.CheckConfig) bool {
+		return c.WorkingDirectory == "/tmp" && c.CPULimit == 0.5 &&
+			c.MemoryLimit == 268435456 && c.Nice == 10 &&
+			c.CatchUp == types.CheckCatchUpRunOnce
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "check_disk -w 20% -c 10%"))
+	require.NoError(t, cmd.Flags().Set("subscriptions", "system"))
+	require.NoError(t, cmd.Flags().Set("interval", "10"))
+	require.NoError(t, cmd.Flags().Set("working-directory", "/tmp"))
+	require.NoError(t, cmd.Flags().Set("cpu-limit", "0.5"))
+	require.NoError(t, cmd.Flags().Set("memory-limit", "268435456"))
+	require.NoError(t, cmd.Flags().Set("nice", "10"))
+	require.NoError(t, cmd.Flags().Set("catch-up", "run-once"))
+	out, err := test.RunCmd(cmd, []string{"check-disk"})
+	require.NoError(t, err)
+
+	assert.Regexp("OK", out)
+}
+
+func TestCreateCommandRunEClosureWithHistoryRetention(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheck", mock.MatchedBy(func(c *types.CheckConfig) bool {
+		return c.HistoryRetention == 100 && c.HistoryOutput
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "check_disk -w 20% -c 10%"))
+	require.NoError(t, cmd.Flags().Set("subscriptions", "system"))
+	require.NoError(t, cmd.Flags().Set("interval", "10"))
+	require.NoError(t, cmd.Flags().Set("history-retention", "100"))
+	require.NoError(t, cmd.Flags().Set("history-output", "true"))
+	out, err := test.RunCmd(cmd, []string{"check-disk"})
+	require.NoError(t, err)
+
+	assert.Regexp("OK", out)
+}
+
+func TestCreateCommandRunEClosureWithPipelines(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheck", mock.MatchedBy(func(c *types.CheckConfig) bool {
+		return len(c.Pipelines) == 2 && c.Pipelines[0] == "alerts" && c.Pipelines[1] == "metrics"
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "check_disk -w 20% -c 10%"))
+	require.NoError(t, cmd.Flags().Set("subscriptions", "system"))
+	require.NoError(t, cmd.Flags().Set("interval", "10"))
+	require.NoError(t, cmd.Flags().Set("pipelines", "alerts,metrics"))
+	out, err := test.RunCmd(cmd, []string{"check-disk"})
+	require.NoError(t, err)
+
+	assert.Regexp("OK", out)
+}
+
+func TestCreateCommandRunEClosureWithLinks(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheck", mock.MatchedBy(func(c *types.CheckConfig) bool {
+		return c.RunbookURL == "https://wiki/{{ .Check.Name }}" && c.DashboardURL == "https://grafana/d/disk"
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "check_disk -w 20% -c 10%"))
+	require.NoError(t, cmd.Flags().Set("subscriptions", "system"))
+	require.NoError(t, cmd.Flags().Set("interval", "10"))
+	require.NoError(t, cmd.Flags().Set("runbook-url", "https://wiki/{{ .Check.Name }}"))
+	require.NoError(t, cmd.Flags().Set("dashboard-url", "https://grafana/d/disk"))
+	out, err := test.RunCmd(cmd, []string{"check-disk"})
+	require.NoError(t, err)
+
+	assert.Regexp("OK", out)
+}
+
 func TestCreateCommandRunEClosureWithServerErr(t *testing.T) {
 	assert := assert.New(t)
 
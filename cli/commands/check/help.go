@@ -31,6 +31,7 @@ func HelpCommand(cli *cli.SensuCli) *cobra.Command {
 		subcommands.RemoveHighFlapThresholdCommand(cli),
 		// cannot remove interval, use set-cron
 		subcommands.RemoveLowFlapThresholdCommand(cli),
+		subcommands.RemoveOverridesCommand(cli),
 		subcommands.RemoveProxyEntityIDCommand(cli),
 		subcommands.RemoveProxyRequestsCommand(cli),
 		// cannot remove publish, use set-publish
@@ -49,6 +50,7 @@ func HelpCommand(cli *cli.SensuCli) *cobra.Command {
 		subcommands.SetHighFlapThresholdCommand(cli),
 		subcommands.SetIntervalCommand(cli),
 		subcommands.SetLowFlapThresholdCommand(cli),
+		subcommands.SetOverridesCommand(cli),
 		subcommands.SetProxyEntityIDCommand(cli),
 		subcommands.SetProxyRequestsCommand(cli),
 		subcommands.SetPublishCommand(cli),
@@ -13,7 +13,8 @@ import (
 )
 
 const (
-	stdinDefault = "false"
+	stdinDefault         = "false"
+	splayCoverageDefault = "90"
 )
 
 type checkOpts struct {
@@ -33,6 +34,29 @@ type checkOpts struct {
 	TTL               string `survey:"ttl"`
 	HighFlapThreshold string `survey:"high-flap-threshold"`
 	LowFlapThreshold  string `survey:"low-flap-threshold"`
+	Splay             string
+	SplayCoverage     string
+	Nagios            string
+	OutputAnnotations string
+	MetricRetention   string
+	ExecType          string
+	Shell             string
+	RunAs             string
+	WorkingDirectory  string
+	CPULimit          string
+	MemoryLimit       string
+	Nice              string
+	Executor          string
+	CatchUp           string
+	HistoryRetention  string
+	HistoryOutput     string
+	Pipelines         string
+	RunbookURL        string
+	DashboardURL      string
+	AnomalyDetection  string
+	AnomalyThreshold  string
+	AnomalyWindow     string
+	Contacts          string
 }
 
 func newCheckOpts() *checkOpts {
@@ -55,6 +79,29 @@ func (opts *checkOpts) withCheck(check *types.CheckConfig) {
 	opts.Timeout = strconv.Itoa(int(check.Timeout))
 	opts.HighFlapThreshold = strconv.Itoa(int(check.HighFlapThreshold))
 	opts.LowFlapThreshold = strconv.Itoa(int(check.LowFlapThreshold))
+	opts.Splay = strconv.FormatBool(check.Splay)
+	opts.SplayCoverage = strconv.Itoa(int(check.SplayCoverage))
+	opts.Nagios = strconv.FormatBool(check.Nagios)
+	opts.OutputAnnotations = strconv.FormatBool(check.OutputAnnotations)
+	opts.MetricRetention = check.MetricRetention
+	opts.ExecType = check.ExecType
+	opts.Shell = check.Shell
+	opts.RunAs = check.RunAs
+	opts.WorkingDirectory = check.WorkingDirectory
+	opts.CPULimit = strconv.FormatFloat(check.CPULimit, 'f', -1, 64)
+	opts.MemoryLimit = strconv.FormatUint(check.MemoryLimit, 10)
+	opts.Nice = strconv.Itoa(int(check.Nice))
+	opts.Executor = check.Executor
+	opts.CatchUp = check.CatchUp
+	opts.HistoryRetention = strconv.Itoa(int(check.HistoryRetention))
+	opts.HistoryOutput = strconv.FormatBool(check.HistoryOutput)
+	opts.Pipelines = strings.Join(check.Pipelines, ",")
+	opts.RunbookURL = check.RunbookURL
+	opts.DashboardURL = check.DashboardURL
+	opts.AnomalyDetection = check.AnomalyDetection
+	opts.AnomalyThreshold = strconv.FormatFloat(check.AnomalyThreshold, 'f', -1, 64)
+	opts.AnomalyWindow = strconv.Itoa(int(check.AnomalyWindow))
+	opts.Contacts = strings.Join(check.Contacts, ",")
 }
 
 func (opts *checkOpts) withFlags(flags *pflag.FlagSet) {
@@ -72,6 +119,33 @@ func (opts *checkOpts) withFlags(flags *pflag.FlagSet) {
 	opts.TTL, _ = flags.GetString("ttl")
 	opts.HighFlapThreshold, _ = flags.GetString("high-flap-threshold")
 	opts.LowFlapThreshold, _ = flags.GetString("low-flap-threshold")
+	splayBool, _ := flags.GetBool("splay")
+	opts.Splay = strconv.FormatBool(splayBool)
+	opts.SplayCoverage, _ = flags.GetString("splay-coverage")
+	nagiosBool, _ := flags.GetBool("nagios")
+	opts.Nagios = strconv.FormatBool(nagiosBool)
+	outputAnnotationsBool, _ := flags.GetBool("output-annotations")
+	opts.OutputAnnotations = strconv.FormatBool(outputAnnotationsBool)
+	opts.MetricRetention, _ = flags.GetString("metric-retention")
+	opts.ExecType, _ = flags.GetString("exec-type")
+	opts.Shell, _ = flags.GetString("shell")
+	opts.RunAs, _ = flags.GetString("run-as")
+	opts.WorkingDirectory, _ = flags.GetString("working-directory")
+	opts.CPULimit, _ = flags.GetString("cpu-limit")
+	opts.MemoryLimit, _ = flags.GetString("memory-limit")
+	opts.Nice, _ = flags.GetString("nice")
+	opts.Executor, _ = flags.GetString("executor")
+	opts.CatchUp, _ = flags.GetString("catch-up")
+	opts.HistoryRetention, _ = flags.GetString("history-retention")
+	historyOutputBool, _ := flags.GetBool("history-output")
+	opts.HistoryOutput = strconv.FormatBool(historyOutputBool)
+	opts.Pipelines, _ = flags.GetString("pipelines")
+	opts.RunbookURL, _ = flags.GetString("runbook-url")
+	opts.DashboardURL, _ = flags.GetString("dashboard-url")
+	opts.AnomalyDetection, _ = flags.GetString("anomaly-detection")
+	opts.AnomalyThreshold, _ = flags.GetString("anomaly-threshold")
+	opts.AnomalyWindow, _ = flags.GetString("anomaly-window")
+	opts.Contacts, _ = flags.GetString("contacts")
 
 	if org, _ := flags.GetString("organization"); org != "" {
 		opts.Org = org
@@ -238,6 +312,17 @@ func (opts *checkOpts) Copy(check *types.CheckConfig) {
 	ttl, _ := strconv.ParseInt(opts.TTL, 10, 64)
 	highFlap, _ := strconv.ParseUint(opts.HighFlapThreshold, 10, 32)
 	lowFlap, _ := strconv.ParseUint(opts.LowFlapThreshold, 10, 32)
+	splay, _ := strconv.ParseBool(opts.Splay)
+	splayCoverage, _ := strconv.ParseUint(opts.SplayCoverage, 10, 32)
+	nagios, _ := strconv.ParseBool(opts.Nagios)
+	outputAnnotations, _ := strconv.ParseBool(opts.OutputAnnotations)
+	cpuLimit, _ := strconv.ParseFloat(opts.CPULimit, 64)
+	memoryLimit, _ := strconv.ParseUint(opts.MemoryLimit, 10, 64)
+	nice, _ := strconv.ParseInt(opts.Nice, 10, 32)
+	historyRetention, _ := strconv.ParseUint(opts.HistoryRetention, 10, 32)
+	historyOutput, _ := strconv.ParseBool(opts.HistoryOutput)
+	anomalyThreshold, _ := strconv.ParseFloat(opts.AnomalyThreshold, 64)
+	anomalyWindow, _ := strconv.ParseUint(opts.AnomalyWindow, 10, 32)
 
 	check.Name = opts.Name
 	check.Environment = opts.Env
@@ -255,4 +340,27 @@ func (opts *checkOpts) Copy(check *types.CheckConfig) {
 	check.Ttl = int64(ttl)
 	check.HighFlapThreshold = uint32(highFlap)
 	check.LowFlapThreshold = uint32(lowFlap)
+	check.Splay = splay
+	check.SplayCoverage = uint32(splayCoverage)
+	check.Nagios = nagios
+	check.OutputAnnotations = outputAnnotations
+	check.MetricRetention = opts.MetricRetention
+	check.ExecType = opts.ExecType
+	check.Shell = opts.Shell
+	check.RunAs = opts.RunAs
+	check.WorkingDirectory = opts.WorkingDirectory
+	check.CPULimit = cpuLimit
+	check.MemoryLimit = memoryLimit
+	check.Nice = int32(nice)
+	check.Executor = opts.Executor
+	check.CatchUp = opts.CatchUp
+	check.HistoryRetention = uint32(historyRetention)
+	check.HistoryOutput = historyOutput
+	check.Pipelines = helpers.SafeSplitCSV(opts.Pipelines)
+	check.RunbookURL = opts.RunbookURL
+	check.DashboardURL = opts.DashboardURL
+	check.AnomalyDetection = opts.AnomalyDetection
+	check.AnomalyThreshold = anomalyThreshold
+	check.AnomalyWindow = uint32(anomalyWindow)
+	check.Contacts = helpers.SafeSplitCSV(opts.Contacts)
 }
@@ -3,6 +3,7 @@ package check
 import (
 	"errors"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -108,6 +109,38 @@ func printCheckToList(r *types.CheckConfig, writer io.Writer) {
 				Label: "Stdin?",
 				Value: strconv.FormatBool(r.Stdin),
 			},
+			{
+				Label: "Standalone?",
+				Value: strconv.FormatBool(r.Standalone),
+			},
+			{
+				Label: "Overrides",
+				Value: formatOverrides(r.Overrides),
+			},
+			{
+				Label: "Contacts",
+				Value: strings.Join(r.Contacts, ","),
+			},
+			{
+				Label: "Runbook URL",
+				Value: r.RunbookURL,
+			},
+			{
+				Label: "Dashboard URL",
+				Value: r.DashboardURL,
+			},
+			{
+				Label: "Anomaly Detection",
+				Value: r.AnomalyDetection,
+			},
+			{
+				Label: "Anomaly Threshold",
+				Value: strconv.FormatFloat(r.AnomalyThreshold, 'f', -1, 64),
+			},
+			{
+				Label: "Anomaly Window",
+				Value: strconv.Itoa(int(r.AnomalyWindow)),
+			},
 			{
 				Label: "Proxy Entity ID",
 				Value: r.ProxyEntityID,
@@ -125,3 +158,32 @@ func printCheckToList(r *types.CheckConfig, writer io.Writer) {
 
 	list.Print(writer, cfg)
 }
+
+// formatOverrides formats the entity overrides of a check, one per line,
+// e.g. "web01: interval=300 timeout=10".
+func formatOverrides(overrides []types.CheckOverride) string {
+	lines := make([]string, 0, len(overrides))
+	for _, o := range overrides {
+		labels := make([]string, 0, len(o.Labels))
+		for key, value := range o.Labels {
+			labels = append(labels, key+"="+value)
+		}
+		sort.Strings(labels)
+		match := labels
+		if o.Entity != "" {
+			match = append([]string{o.Entity}, labels...)
+		}
+		var fields []string
+		if o.Interval > 0 {
+			fields = append(fields, "interval="+strconv.FormatUint(uint64(o.Interval), 10))
+		}
+		if o.Timeout > 0 {
+			fields = append(fields, "timeout="+strconv.FormatUint(uint64(o.Timeout), 10))
+		}
+		if o.Disabled {
+			fields = append(fields, "disabled")
+		}
+		lines = append(lines, strings.Join(match, ",")+": "+strings.Join(fields, " "))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -74,6 +74,7 @@ func TestShowCommandRunEClosureWithTable(t *testing.T) {
 	assert.Contains(out, "Handlers")
 	assert.Contains(out, "Runtime Assets")
 	assert.Contains(out, "Hooks")
+	assert.Contains(out, "Overrides")
 }
 
 func TestShowCommandRunEClosureWithErr(t *testing.T) {
@@ -90,3 +91,12 @@ func TestShowCommandRunEClosureWithErr(t *testing.T) {
 	assert.Equal("my-err", err.Error())
 	assert.Empty(out)
 }
+
+func TestFormatOverrides(t *testing.T) {
+	overrides := []types.CheckOverride{
+		{Entity: "web01", Interval: 300, Timeout: 10},
+		{Labels: map[string]string{"tier": "db", "region": "us-west"}, Disabled: true},
+	}
+	assert.Equal(t, "web01: interval=300 timeout=10\nregion=us-west,tier=db: disabled", formatOverrides(overrides))
+	assert.Empty(t, formatOverrides(nil))
+}
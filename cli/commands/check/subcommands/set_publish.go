@@ -29,11 +29,11 @@ func SetPublishCommand(cli *cli.SensuCli) *cobra.Command {
 				return err
 			}
 			publish, err := strconv.ParseBool(value)
-			check.Publish = publish
-
 			if err != nil {
 				return err
 			}
+			check.Publish = publish
+
 			if err := check.Validate(); err != nil {
 				return err
 			}
@@ -0,0 +1,83 @@
+package checktemplate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/ghodss/yaml"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// CreateCommand adds command that allows user to create new check templates
+func CreateCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "create",
+		Short:        "create new check templates from file or stdin",
+		SilenceUsage: true,
+		Example:      "  sensuctl check-template create -f http.yaml",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			tmpl, err := readCheckTemplate(cli, cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := tmpl.Validate(); err != nil {
+				return err
+			}
+
+			if err := cli.Client.CreateCheckTemplate(tmpl); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "OK")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("file", "f", "", "check template definition file, in JSON or YAML")
+
+	return cmd
+}
+
+// readCheckTemplate reads the check template definition given by the file
+// flag, or stdin if not set. Its namespace defaults to the one of the CLI.
+func readCheckTemplate(cli *cli.SensuCli, cmd *cobra.Command) (*types.CheckTemplate, error) {
+	var in io.Reader = os.Stdin
+	if filePath, _ := cmd.Flags().GetString("file"); filePath != "" {
+		f, err := os.Open(filePath)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = f.Close() }()
+		in = f
+	}
+
+	b, err := ioutil.ReadAll(in)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := &types.CheckTemplate{}
+	if err := yaml.Unmarshal(b, tmpl); err != nil {
+		return nil, fmt.Errorf("unable to parse the check template: %s", err)
+	}
+
+	if tmpl.Organization == "" {
+		tmpl.Organization = cli.Config.Organization()
+	}
+	if tmpl.Environment == "" {
+		tmpl.Environment = cli.Config.Environment()
+	}
+
+	return tmpl, nil
+}
@@ -0,0 +1,105 @@
+package checktemplate
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const definition = `
+name: http
+check:
+  name: "check-http-{{{ .host }}}"
+  command: "check-http.rb -u http://{{{ .host }}}"
+  interval: 60
+  subscriptions: [web]
+parameters:
+- values: {host: web01}
+- values: {host: web02}
+`
+
+func writeDefinition(t *testing.T, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "checktemplate")
+	require.NoError(t, err)
+	path := filepath.Join(dir, "http.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	return path, func() { _ = os.RemoveAll(dir) }
+}
+
+func TestCreateCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := CreateCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("create", cmd.Use)
+	assert.Regexp("check templates", cmd.Short)
+}
+
+func TestCreateCommandRunEClosureWithFile(t *testing.T) {
+	assert := assert.New(t)
+
+	path, cleanup := writeDefinition(t, definition)
+	defer cleanup()
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheckTemplate", mock.AnythingOfType("*types.CheckTemplate")).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("file", path))
+	out, err := test.RunCmd(cmd, []string{})
+
+	require.NoError(t, err)
+	assert.Regexp("OK", out)
+
+	tmpl := client.Calls[0].Arguments.Get(0).(*types.CheckTemplate)
+	assert.Equal("http", tmpl.Name)
+	assert.Equal("default", tmpl.Organization)
+	assert.Len(tmpl.Parameters, 2)
+}
+
+func TestCreateCommandRunEClosureWithInvalidTemplate(t *testing.T) {
+	assert := assert.New(t)
+
+	path, cleanup := writeDefinition(t, definition+"- values: {port: 8080}\n")
+	defer cleanup()
+
+	cli := test.NewMockCLI()
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("file", path))
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.Empty(out)
+	assert.Error(err)
+}
+
+func TestCreateCommandRunEClosureWithServerErr(t *testing.T) {
+	assert := assert.New(t)
+
+	path, cleanup := writeDefinition(t, definition)
+	defer cleanup()
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheckTemplate", mock.AnythingOfType("*types.CheckTemplate")).Return(errors.New("whoops"))
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("file", path))
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.Empty(out)
+	require.Error(t, err)
+	assert.Equal("whoops", err.Error())
+}
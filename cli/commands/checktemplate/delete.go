@@ -0,0 +1,58 @@
+package checktemplate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// DeleteCommand adds a command that allows user to delete check templates
+func DeleteCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "delete [NAME]",
+		Short:        "delete check templates given name",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// If no name is present print out usage
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			name := args[0]
+
+			if skipConfirm, _ := cmd.Flags().GetBool("skip-confirm"); !skipConfirm {
+				if confirmed := helpers.ConfirmDelete(name); !confirmed {
+					fmt.Fprintln(cmd.OutOrStdout(), "Canceled")
+					return nil
+				}
+			}
+
+			tmpl := &types.CheckTemplate{Name: name}
+
+			if org, _ := cmd.Flags().GetString("organization"); org != "" {
+				tmpl.Organization = org
+			}
+
+			if env, _ := cmd.Flags().GetString("environment"); env != "" {
+				tmpl.Environment = env
+			}
+
+			err := cli.Client.DeleteCheckTemplate(tmpl)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), "OK")
+			return err
+		},
+	}
+
+	_ = cmd.Flags().Bool("skip-confirm", false, "skip interactive confirmation prompt")
+
+	return cmd
+}
@@ -0,0 +1,26 @@
+package checktemplate
+
+import (
+	"github.com/sensu/sensu-go/cli"
+	"github.com/spf13/cobra"
+)
+
+// HelpCommand defines new parent
+func HelpCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-template",
+		Short: "Manage check templates",
+	}
+
+	// Add sub-commands
+	cmd.AddCommand(
+		CreateCommand(cli),
+		DeleteCommand(cli),
+		ListCommand(cli),
+		RenderCommand(cli),
+		ShowCommand(cli),
+		UpdateCommand(cli),
+	)
+
+	return cmd
+}
@@ -0,0 +1,83 @@
+package checktemplate
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// ListCommand defines new list check templates command
+func ListCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "list check templates",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+			org := cli.Config.Organization()
+			if ok, _ := cmd.Flags().GetBool(flags.AllOrgs); ok {
+				org = "*"
+			}
+
+			// Fetch check templates from the API
+			results, err := cli.Client.ListCheckTemplates(org)
+			if err != nil {
+				return err
+			}
+
+			// Print the results based on the user preferences
+			return helpers.Print(cmd, cli.Config.Format(), printToTable, results)
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+	helpers.AddAllOrganization(cmd.Flags())
+
+	return cmd
+}
+
+func printToTable(results interface{}, writer io.Writer) {
+	table := table.New([]*table.Column{
+		{
+			Title:       "Name",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				tmpl, _ := data.(types.CheckTemplate)
+				return tmpl.Name
+			},
+		},
+		{
+			Title: "Check",
+			CellTransformer: func(data interface{}) string {
+				tmpl, _ := data.(types.CheckTemplate)
+				return tmpl.Check.Name
+			},
+		},
+		{
+			Title: "Parameter Sets",
+			CellTransformer: func(data interface{}) string {
+				tmpl, _ := data.(types.CheckTemplate)
+				return strconv.Itoa(len(tmpl.Parameters))
+			},
+		},
+		{
+			Title: "Description",
+			CellTransformer: func(data interface{}) string {
+				tmpl, _ := data.(types.CheckTemplate)
+				return tmpl.Description
+			},
+		},
+	})
+
+	table.Render(writer, results)
+}
@@ -0,0 +1,36 @@
+package checktemplate
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/cli"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCommandRunEClosure(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := newCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("ListCheckTemplates", "default").Return([]types.CheckTemplate{
+		*types.FixtureCheckTemplate("http"),
+	}, nil)
+
+	cmd := ListCommand(cli)
+	out, err := test.RunCmd(cmd, []string{})
+
+	require.NoError(t, err)
+	assert.Contains(out, "check-http-{{{ .host }}}")
+}
+
+func newCLI() *cli.SensuCli {
+	cli := test.NewMockCLI()
+	config := cli.Config.(*client.MockConfig)
+	config.On("Format").Return("json")
+
+	return cli
+}
@@ -0,0 +1,77 @@
+package checktemplate
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// RenderCommand defines a command listing the checks generated by a check
+// template
+func RenderCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "render [NAME]",
+		Short:        "list the checks generated by a check template",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			results, err := cli.Client.RenderCheckTemplate(args[0])
+			if err != nil {
+				return err
+			}
+
+			return helpers.Print(cmd, cli.Config.Format(), printChecksToTable, results)
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func printChecksToTable(results interface{}, writer io.Writer) {
+	table := table.New([]*table.Column{
+		{
+			Title:       "Name",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				check, _ := data.(types.CheckConfig)
+				return check.Name
+			},
+		},
+		{
+			Title: "Command",
+			CellTransformer: func(data interface{}) string {
+				check, _ := data.(types.CheckConfig)
+				return check.Command
+			},
+		},
+		{
+			Title: "Interval",
+			CellTransformer: func(data interface{}) string {
+				check, _ := data.(types.CheckConfig)
+				return strconv.FormatUint(uint64(check.Interval), 10)
+			},
+		},
+		{
+			Title: "Subscriptions",
+			CellTransformer: func(data interface{}) string {
+				check, _ := data.(types.CheckConfig)
+				return strings.Join(check.Subscriptions, ",")
+			},
+		},
+	})
+
+	table.Render(writer, results)
+}
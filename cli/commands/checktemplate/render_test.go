@@ -0,0 +1,47 @@
+package checktemplate
+
+import (
+	"errors"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderCommandRunEClosure(t *testing.T) {
+	assert := assert.New(t)
+
+	checks, err := types.FixtureCheckTemplate("http").Render()
+	require.NoError(t, err)
+
+	cli := newCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("RenderCheckTemplate", "http").Return([]types.CheckConfig{*checks[0], *checks[1]}, nil)
+
+	cmd := RenderCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"http"})
+
+	require.NoError(t, err)
+	assert.Contains(out, "check-http-web01")
+	assert.Contains(out, "check-http-web02")
+}
+
+func TestRenderCommandRunEClosureWithErr(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := newCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("RenderCheckTemplate", "http").Return([]types.CheckConfig{}, errors.New("fire"))
+
+	cmd := RenderCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"http"})
+
+	assert.Empty(out)
+	assert.Error(err)
+
+	_, err = test.RunCmd(cmd, []string{})
+	assert.Error(err)
+}
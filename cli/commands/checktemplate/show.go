@@ -0,0 +1,110 @@
+package checktemplate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/list"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// ShowCommand defines new check template info command
+func ShowCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "info [NAME]",
+		Short:        "show detailed check template information",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			// Fetch check template from API
+			r, err := cli.Client.FetchCheckTemplate(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Determine the format to use to output the data
+			var format string
+			if format = helpers.GetChangedStringValueFlag("format", cmd.Flags()); format == "" {
+				format = cli.Config.Format()
+			}
+
+			if format == "json" {
+				if err := helpers.PrintJSON(r, cmd.OutOrStdout()); err != nil {
+					return err
+				}
+			} else {
+				printCheckTemplateToList(r, cmd.OutOrStdout())
+			}
+
+			return nil
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func printCheckTemplateToList(r *types.CheckTemplate, writer io.Writer) {
+	cfg := &list.Config{
+		Title: r.Name,
+		Rows: []*list.Row{
+			{
+				Label: "Name",
+				Value: r.Name,
+			},
+			{
+				Label: "Description",
+				Value: r.Description,
+			},
+			{
+				Label: "Check",
+				Value: r.Check.Name,
+			},
+			{
+				Label: "Command",
+				Value: r.Check.Command,
+			},
+			{
+				Label: "Variables",
+				Value: formatVariables(r.Variables),
+			},
+			{
+				Label: "Parameter Sets",
+				Value: strconv.Itoa(len(r.Parameters)),
+			},
+			{
+				Label: "Organization",
+				Value: r.Organization,
+			},
+			{
+				Label: "Environment",
+				Value: r.Environment,
+			},
+		},
+	}
+
+	list.Print(writer, cfg)
+}
+
+// formatVariables returns the variables as a sorted, comma separated list of
+// key=value pairs.
+func formatVariables(vars map[string]string) string {
+	pairs := make([]string, 0, len(vars))
+	for k, v := range vars {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
@@ -0,0 +1,45 @@
+package checktemplate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/spf13/cobra"
+)
+
+// UpdateCommand adds command that allows user to replace check templates
+func UpdateCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "update",
+		Short:        "update check templates from file or stdin",
+		SilenceUsage: true,
+		Example:      "  sensuctl check-template update -f http.yaml",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			tmpl, err := readCheckTemplate(cli, cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := tmpl.Validate(); err != nil {
+				return err
+			}
+
+			if err := cli.Client.UpdateCheckTemplate(tmpl); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "OK")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("file", "f", "", "check template definition file, in JSON or YAML")
+
+	return cmd
+}
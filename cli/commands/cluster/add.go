@@ -0,0 +1,63 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/AlecAivazis/survey"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/spf13/cobra"
+)
+
+// AddCommand adds a command that allows user to register a remote cluster
+func AddCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "add [NAME]",
+		Short:        "authenticate to a remote cluster and register it under the given name",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 || args[0] == "" {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+			name := args[0]
+
+			url, _ := cmd.Flags().GetString("url")
+			if url == "" {
+				return errors.New("the URL of the cluster is required")
+			}
+			username, _ := cmd.Flags().GetString("username")
+			password, _ := cmd.Flags().GetString("password")
+			if password == "" {
+				prompt := &survey.Password{Message: "Password:"}
+				if err := survey.AskOne(prompt, &password, nil); err != nil {
+					return err
+				}
+			}
+
+			// Authenticate
+			tokens, err := cli.Client.CreateAccessToken(url, username, password)
+			if err != nil {
+				return fmt.Errorf("unable to authenticate with error: %s", err)
+			} else if tokens == nil {
+				return errors.New("bad username or password")
+			}
+
+			if err := cli.Config.SaveRemoteCluster(name, url, tokens); err != nil {
+				return fmt.Errorf(
+					"unable to write new configuration file with error: %s",
+					err,
+				)
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), "Added")
+			return err
+		},
+	}
+
+	_ = cmd.Flags().String("url", "", "the sensu backend url of the cluster")
+	_ = cmd.Flags().String("username", "", "username")
+	_ = cmd.Flags().String("password", "", "password, prompted for if empty")
+
+	return cmd
+}
@@ -0,0 +1,95 @@
+package cluster
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/cli"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHelpCommand(t *testing.T) {
+	cmd := HelpCommand(newConfiguredCLI())
+	assert.Equal(t, "cluster", cmd.Use)
+	assert.Len(t, cmd.Commands(), 3)
+}
+
+func TestAddCommand(t *testing.T) {
+	cli := newConfiguredCLI()
+	tokens := &types.Tokens{Access: "foo"}
+	apiClient := cli.Client.(*client.MockClient)
+	apiClient.On("CreateAccessToken", "https://us-east:8080", "admin", "P@ssw0rd!").Return(tokens, nil)
+	apiClient.On("CreateAccessToken", "https://us-east:8080", "admin", "wrong").Return((*types.Tokens)(nil), errors.New("unauthorized"))
+	config := cli.Config.(*client.MockConfig)
+	config.On("SaveRemoteCluster", "us-east", "https://us-east:8080", tokens).Return(nil)
+
+	cmd := AddCommand(cli)
+	require.NoError(t, cmd.Flags().Set("url", "https://us-east:8080"))
+	require.NoError(t, cmd.Flags().Set("username", "admin"))
+	require.NoError(t, cmd.Flags().Set("password", "P@ssw0rd!"))
+	out, err := test.RunCmd(cmd, []string{"us-east"})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Added")
+	config.AssertCalled(t, "SaveRemoteCluster", "us-east", "https://us-east:8080", tokens)
+
+	require.NoError(t, cmd.Flags().Set("password", "wrong"))
+	_, err = test.RunCmd(cmd, []string{"us-east"})
+	assert.Error(t, err)
+
+	_, err = test.RunCmd(AddCommand(cli), []string{})
+	assert.Error(t, err)
+
+	// The URL is required
+	_, err = test.RunCmd(AddCommand(cli), []string{"us-east"})
+	assert.Error(t, err)
+}
+
+func TestListCommand(t *testing.T) {
+	cli := newConfiguredCLI()
+	remote := &client.MockConfig{}
+	remote.On("APIUrl").Return("https://us-east:8080")
+	config := cli.Config.(*client.MockConfig)
+	config.On("RemoteClusters").Return([]string{"us-east"})
+	config.On("RemoteCluster", "us-east").Return(remote, nil)
+
+	out, err := test.RunCmd(ListCommand(cli), []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, `"name": "us-east"`)
+	assert.Contains(t, out, `"api-url": "https://us-east:8080"`)
+
+	cmd := ListCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flags.Format, "tabular"))
+	out, err = test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, "URL")
+	assert.Contains(t, out, "us-east")
+}
+
+func TestRemoveCommand(t *testing.T) {
+	cli := newConfiguredCLI()
+	config := cli.Config.(*client.MockConfig)
+	config.On("DeleteRemoteCluster", "us-east").Return(nil)
+	config.On("DeleteRemoteCluster", "eu-west").Return(errors.New("cluster \"eu-west\" is not configured"))
+
+	out, err := test.RunCmd(RemoveCommand(cli), []string{"us-east"})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Removed")
+
+	_, err = test.RunCmd(RemoveCommand(cli), []string{"eu-west"})
+	assert.Error(t, err)
+
+	_, err = test.RunCmd(RemoveCommand(cli), []string{})
+	assert.Error(t, err)
+}
+
+func newConfiguredCLI() *cli.SensuCli {
+	cli := test.NewMockCLI()
+	config := cli.Config.(*client.MockConfig)
+	config.On("Format").Return("json")
+	return cli
+}
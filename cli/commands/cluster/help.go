@@ -0,0 +1,23 @@
+package cluster
+
+import (
+	"github.com/sensu/sensu-go/cli"
+	"github.com/spf13/cobra"
+)
+
+// HelpCommand defines new parent
+func HelpCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cluster",
+		Short: "Manage the remote clusters aggregated by sensuctl",
+	}
+
+	// Add sub-commands
+	cmd.AddCommand(
+		AddCommand(cli),
+		ListCommand(cli),
+		RemoveCommand(cli),
+	)
+
+	return cmd
+}
@@ -0,0 +1,69 @@
+package cluster
+
+import (
+	"errors"
+	"io"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/spf13/cobra"
+)
+
+type cluster struct {
+	Name   string `json:"name"`
+	APIUrl string `json:"api-url"`
+}
+
+// ListCommand defines the cluster list command
+func ListCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "list remote clusters",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			results := []cluster{}
+			for _, name := range cli.Config.RemoteClusters() {
+				conf, err := cli.Config.RemoteCluster(name)
+				if err != nil {
+					return err
+				}
+				results = append(results, cluster{Name: name, APIUrl: conf.APIUrl()})
+			}
+
+			// Print the results based on the user preferences
+			return helpers.Print(cmd, cli.Config.Format(), printToTable, results)
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func printToTable(results interface{}, writer io.Writer) {
+	table := table.New([]*table.Column{
+		{
+			Title:       "Name",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				cluster, _ := data.(cluster)
+				return cluster.Name
+			},
+		},
+		{
+			Title: "URL",
+			CellTransformer: func(data interface{}) string {
+				cluster, _ := data.(cluster)
+				return cluster.APIUrl
+			},
+		},
+	})
+
+	table.Render(writer, results)
+}
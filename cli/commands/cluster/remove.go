@@ -0,0 +1,31 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/spf13/cobra"
+)
+
+// RemoveCommand adds a command that allows user to forget a remote cluster
+func RemoveCommand(cli *cli.SensuCli) *cobra.Command {
+	return &cobra.Command{
+		Use:          "remove [NAME]",
+		Short:        "remove the remote cluster with the given name",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 || args[0] == "" {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			if err := cli.Config.DeleteRemoteCluster(args[0]); err != nil {
+				return err
+			}
+
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), "Removed")
+			return err
+		},
+	}
+}
@@ -3,10 +3,14 @@ package commands
 import (
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/commands/asset"
+	"github.com/sensu/sensu-go/cli/commands/bundle"
 	"github.com/sensu/sensu-go/cli/commands/check"
+	"github.com/sensu/sensu-go/cli/commands/checktemplate"
+	"github.com/sensu/sensu-go/cli/commands/cluster"
 	"github.com/sensu/sensu-go/cli/commands/completion"
 	"github.com/sensu/sensu-go/cli/commands/config"
 	"github.com/sensu/sensu-go/cli/commands/configure"
+	"github.com/sensu/sensu-go/cli/commands/delete"
 	"github.com/sensu/sensu-go/cli/commands/entity"
 	"github.com/sensu/sensu-go/cli/commands/environment"
 	"github.com/sensu/sensu-go/cli/commands/event"
@@ -16,9 +20,15 @@ import (
 	"github.com/sensu/sensu-go/cli/commands/importer"
 	"github.com/sensu/sensu-go/cli/commands/logout"
 	"github.com/sensu/sensu-go/cli/commands/organization"
+	"github.com/sensu/sensu-go/cli/commands/pipeline"
 	"github.com/sensu/sensu-go/cli/commands/role"
+	"github.com/sensu/sensu-go/cli/commands/rolebinding"
 	"github.com/sensu/sensu-go/cli/commands/silenced"
+	"github.com/sensu/sensu-go/cli/commands/tessen"
+	"github.com/sensu/sensu-go/cli/commands/undelete"
+	"github.com/sensu/sensu-go/cli/commands/usage"
 	"github.com/sensu/sensu-go/cli/commands/user"
+	"github.com/sensu/sensu-go/cli/commands/validate"
 	"github.com/spf13/cobra"
 )
 
@@ -29,10 +39,19 @@ func AddCommands(rootCmd *cobra.Command, cli *cli.SensuCli) {
 		completion.Command(rootCmd),
 		logout.Command(cli),
 		importer.ImportCommand(cli),
+		delete.Command(cli),
+		undelete.Command(cli),
+		silenced.SilenceCommand(cli),
+		silenced.UnsilenceCommand(cli),
+		usage.Command(cli),
+		validate.Command(cli),
 
 		// Management Commands
 		asset.HelpCommand(cli),
+		bundle.HelpCommand(cli),
 		check.HelpCommand(cli),
+		checktemplate.HelpCommand(cli),
+		cluster.HelpCommand(cli),
 		config.HelpCommand(cli),
 		entity.HelpCommand(cli),
 		environment.HelpCommand(cli),
@@ -41,9 +60,12 @@ func AddCommands(rootCmd *cobra.Command, cli *cli.SensuCli) {
 		handler.HelpCommand(cli),
 		hook.HelpCommand(cli),
 		organization.HelpCommand(cli),
+		pipeline.HelpCommand(cli),
 		role.HelpCommand(cli),
+		rolebinding.HelpCommand(cli),
 		user.HelpCommand(cli),
 		silenced.HelpCommand(cli),
+		tessen.HelpCommand(cli),
 	)
 
 	for _, cmd := range rootCmd.Commands() {
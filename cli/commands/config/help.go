@@ -9,14 +9,16 @@ import (
 func HelpCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "config",
-		Short: "Modify sensuctl configuration",
+		Short: "Modify sensuctl configuration and the cluster-wide defaults",
 	}
 
 	// Add sub-commands
 	cmd.AddCommand(
+		SetCommand(cli),
 		SetEnvCommand(cli),
 		SetFormatCommand(cli),
 		SetOrgCommand(cli),
+		ViewCommand(cli),
 	)
 
 	return cmd
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// clusterDefaults are the cluster-wide defaults which can be set, and how their
+// value is applied to the cluster configuration
+var clusterDefaults = map[string]func(*types.ClusterConfig, string) error{
+	"default-handlers": func(config *types.ClusterConfig, value string) error {
+		config.DefaultHandlers = helpers.SafeSplitCSV(value)
+		return nil
+	},
+	"default-check-timeout": func(config *types.ClusterConfig, value string) error {
+		timeout, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return errors.New("default-check-timeout must be a number of seconds")
+		}
+		config.DefaultCheckTimeout = uint32(timeout)
+		return nil
+	},
+	"keepalive-timeout": func(config *types.ClusterConfig, value string) error {
+		timeout, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return errors.New("keepalive-timeout must be a number of seconds")
+		}
+		config.KeepaliveTimeout = uint32(timeout)
+		return nil
+	},
+}
+
+// SetCommand sets a cluster-wide default, or a default of an organization
+func SetCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set [KEY] [VALUE]",
+		Short: "Set a cluster-wide default (default-handlers, default-check-timeout or keepalive-timeout)",
+		Long: "Set a cluster-wide default, applied to the resources which omit it:\n" +
+			"  default-handlers       comma separated list of handlers of the checks without handlers\n" +
+			"  default-check-timeout  timeout, in seconds, of the checks created without a timeout\n" +
+			"  keepalive-timeout      keepalive timeout, in seconds, of the entities without one\n\n" +
+			"The defaults of an organization, set with --org, override the cluster-wide defaults.",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			apply, ok := clusterDefaults[args[0]]
+			if !ok {
+				return fmt.Errorf("unknown key %q", args[0])
+			}
+
+			org, _ := cmd.Flags().GetString("org")
+			config, err := fetchConfig(cli, org)
+			if err != nil {
+				return err
+			}
+
+			if err := apply(config, args[1]); err != nil {
+				return err
+			}
+
+			if org != "" {
+				err = cli.Client.UpdateOrganizationConfig(org, config)
+			} else {
+				err = cli.Client.UpdateClusterConfig(config)
+			}
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "OK")
+			return nil
+		},
+	}
+
+	_ = cmd.Flags().StringP("org", "", "", "Name of the organization whose default is set")
+
+	return cmd
+}
+
+// fetchConfig fetches the defaults of the organization, or the cluster-wide
+// defaults if no organization is given.
+func fetchConfig(cli *cli.SensuCli, org string) (*types.ClusterConfig, error) {
+	if org != "" {
+		return cli.Client.FetchOrganizationConfig(org)
+	}
+	return cli.Client.FetchClusterConfig()
+}
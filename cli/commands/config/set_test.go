@@ -0,0 +1,111 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	clienttest "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCommandBadArgs(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := SetCommand(cli)
+
+	// No args...
+	out, err := test.RunCmd(cmd, []string{})
+	assert.NotEmpty(t, out, "output should display help usage")
+	assert.Error(t, err)
+
+	// Unknown key...
+	_, err = test.RunCmd(cmd, []string{"foo", "bar"})
+	assert.Error(t, err)
+}
+
+func TestSetCommandExec(t *testing.T) {
+	testCases := []struct {
+		key   string
+		value string
+		want  *types.ClusterConfig
+	}{
+		{"default-handlers", "slack, pagerduty", &types.ClusterConfig{DefaultHandlers: []string{"slack", "pagerduty"}}},
+		{"default-check-timeout", "30", &types.ClusterConfig{DefaultCheckTimeout: 30}},
+		{"keepalive-timeout", "60", &types.ClusterConfig{KeepaliveTimeout: 60}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.key, func(t *testing.T) {
+			cli := test.NewMockCLI()
+			client := cli.Client.(*clienttest.MockClient)
+			client.On("FetchClusterConfig").Return(&types.ClusterConfig{}, nil)
+			client.On("UpdateClusterConfig", tc.want).Return(nil)
+
+			out, err := test.RunCmd(SetCommand(cli), []string{tc.key, tc.value})
+			require.NoError(t, err)
+			assert.Equal(t, "OK\n", out)
+		})
+	}
+}
+
+func TestSetCommandErrors(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*clienttest.MockClient)
+	client.On("FetchClusterConfig").Return(&types.ClusterConfig{}, nil)
+	client.On("UpdateClusterConfig", mock.Anything).Return(errors.New("error"))
+
+	// Invalid value
+	_, err := test.RunCmd(SetCommand(cli), []string{"default-check-timeout", "soon"})
+	assert.Error(t, err)
+	client.AssertNotCalled(t, "UpdateClusterConfig", mock.Anything)
+
+	// API error
+	_, err = test.RunCmd(SetCommand(cli), []string{"default-check-timeout", "30"})
+	assert.Error(t, err)
+}
+
+func TestViewCommand(t *testing.T) {
+	cli := test.NewMockCLI()
+	config := cli.Config.(*clienttest.MockConfig)
+	config.On("Format").Return("none")
+	client := cli.Client.(*clienttest.MockClient)
+	client.On("FetchClusterConfig").Return(types.FixtureClusterConfig(), nil)
+
+	out, err := test.RunCmd(ViewCommand(cli), []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Default Handlers")
+	assert.Contains(t, out, "default")
+	assert.Contains(t, out, "30")
+}
+
+func TestSetCommandOrganization(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*clienttest.MockClient)
+	client.On("FetchOrganizationConfig", "acme").Return(&types.ClusterConfig{}, nil)
+	client.On("UpdateOrganizationConfig", "acme", &types.ClusterConfig{KeepaliveTimeout: 30}).Return(nil)
+
+	cmd := SetCommand(cli)
+	require.NoError(t, cmd.Flags().Set("org", "acme"))
+	out, err := test.RunCmd(cmd, []string{"keepalive-timeout", "30"})
+	require.NoError(t, err)
+	assert.Equal(t, "OK\n", out)
+	client.AssertNotCalled(t, "UpdateClusterConfig", mock.Anything)
+}
+
+func TestViewCommandOrganization(t *testing.T) {
+	cli := test.NewMockCLI()
+	config := cli.Config.(*clienttest.MockConfig)
+	config.On("Format").Return("none")
+	client := cli.Client.(*clienttest.MockClient)
+	client.On("FetchOrganizationConfig", "acme").Return(&types.ClusterConfig{KeepaliveTimeout: 30}, nil)
+
+	cmd := ViewCommand(cli)
+	require.NoError(t, cmd.Flags().Set("org", "acme"))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, "acme")
+	assert.Contains(t, out, "30")
+}
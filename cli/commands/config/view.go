@@ -0,0 +1,79 @@
+package config
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/list"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// ViewCommand displays the cluster-wide defaults, or those of an organization
+func ViewCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "view",
+		Short:        "Display the cluster-wide defaults, or those of an organization",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			org, _ := cmd.Flags().GetString("org")
+			config, err := fetchConfig(cli, org)
+			if err != nil {
+				return err
+			}
+
+			// Determine the format to use to output the data
+			var format string
+			if format = helpers.GetChangedStringValueFlag("format", cmd.Flags()); format == "" {
+				format = cli.Config.Format()
+			}
+
+			if format == "json" {
+				return helpers.PrintJSON(config, cmd.OutOrStdout())
+			}
+			printClusterConfigToList(config, org, cmd.OutOrStdout())
+			return nil
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+	_ = cmd.Flags().StringP("org", "", "", "Name of the organization whose defaults are displayed")
+
+	return cmd
+}
+
+func printClusterConfigToList(config *types.ClusterConfig, org string, writer io.Writer) {
+	title := "Cluster Defaults"
+	if org != "" {
+		title = "Defaults of " + org
+	}
+
+	cfg := &list.Config{
+		Title: title,
+		Rows: []*list.Row{
+			{
+				Label: "Default Handlers",
+				Value: strings.Join(config.DefaultHandlers, ", "),
+			},
+			{
+				Label: "Default Check Timeout",
+				Value: strconv.FormatUint(uint64(config.DefaultCheckTimeout), 10),
+			},
+			{
+				Label: "Keepalive Timeout",
+				Value: strconv.FormatUint(uint64(config.KeepaliveTimeout), 10),
+			},
+		},
+	}
+
+	list.Print(writer, cfg)
+}
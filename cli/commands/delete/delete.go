@@ -0,0 +1,250 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagFile     = "file"
+	flagSelector = "selector"
+	flagTypes    = "types"
+)
+
+// selectableTypes are the resource types considered when deleting resources
+// by selector, in the order they are deleted.
+var selectableTypes = []string{"check", "entity", "filter", "handler", "hook", "silenced"}
+
+// Command adds a command that deletes the resources described by a manifest,
+// or matching a selector.
+func Command(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "delete",
+		Short:        "delete resources described by a manifest or matching a selector",
+		SilenceUsage: true,
+		Example: `  sensuctl delete -f manifest.yaml
+  cat manifest.json | sensuctl delete -f -
+  sensuctl delete --selector team=ops --types check,handler`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			file, _ := cmd.Flags().GetString(flagFile)
+			selector, _ := cmd.Flags().GetString(flagSelector)
+
+			var (
+				resources []interface{}
+				err       error
+			)
+			switch {
+			case file != "" && selector != "":
+				return errors.New("--file and --selector cannot be used together")
+			case file != "":
+				resources, err = readManifest(cli, file)
+			case selector != "":
+				typeNames, _ := cmd.Flags().GetStringSlice(flagTypes)
+				resources, err = selectResources(cli, selector, typeNames)
+			default:
+				_ = cmd.Help()
+				return errors.New("must specify --file or --selector")
+			}
+			if err != nil {
+				return err
+			}
+
+			if len(resources) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "No resources found")
+				return nil
+			}
+
+			if skipConfirm, _ := cmd.Flags().GetBool("skip-confirm"); !skipConfirm {
+				name := fmt.Sprintf("%d resources", len(resources))
+				if confirmed := helpers.ConfirmDelete(name); !confirmed {
+					fmt.Fprintln(cmd.OutOrStdout(), "Canceled")
+					return nil
+				}
+			}
+
+			return deleteResources(cli.Client, resources, cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().StringP(flagFile, "f", "", "path to a manifest describing the resources to delete, or - for STDIN")
+	cmd.Flags().StringP(flagSelector, "l", "", "delete resources matching the given key=value selector")
+	cmd.Flags().StringSlice(flagTypes, selectableTypes, "resource types considered by --selector")
+	cmd.Flags().Bool("skip-confirm", false, "skip interactive confirmation prompt")
+
+	return cmd
+}
+
+func readManifest(cli *cli.SensuCli, path string) ([]interface{}, error) {
+	var r io.Reader
+	if path == "-" {
+		r = cli.InFile
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = f.Close() }()
+		r = f
+	}
+
+	wrappers, err := resource.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]interface{}, 0, len(wrappers))
+	for _, w := range wrappers {
+		v, err := w.Decode()
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, v)
+	}
+	return resources, nil
+}
+
+func selectResources(cli *cli.SensuCli, s string, typeNames []string) ([]interface{}, error) {
+	selector, err := resource.ParseSelector(s)
+	if err != nil {
+		return nil, err
+	}
+
+	org := cli.Config.Organization()
+	var candidates []interface{}
+	for _, typeName := range typeNames {
+		found, err := listResources(cli.Client, org, typeName)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, found...)
+	}
+
+	var resources []interface{}
+	for _, v := range candidates {
+		matches, err := selector.Matches(v)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			resources = append(resources, v)
+		}
+	}
+	return resources, nil
+}
+
+func listResources(c client.APIClient, org, typeName string) ([]interface{}, error) {
+	var resources []interface{}
+
+	switch typeName {
+	case "check", "checks":
+		checks, err := c.ListChecks(org)
+		for i := range checks {
+			resources = append(resources, &checks[i])
+		}
+		return resources, err
+	case "entity", "entities":
+		entities, err := c.ListEntities(org)
+		for i := range entities {
+			resources = append(resources, &entities[i])
+		}
+		return resources, err
+	case "filter", "filters":
+		filters, err := c.ListFilters(org)
+		for i := range filters {
+			resources = append(resources, &filters[i])
+		}
+		return resources, err
+	case "handler", "handlers":
+		handlers, err := c.ListHandlers(org)
+		for i := range handlers {
+			resources = append(resources, &handlers[i])
+		}
+		return resources, err
+	case "hook", "hooks":
+		hooks, err := c.ListHooks(org)
+		for i := range hooks {
+			resources = append(resources, &hooks[i])
+		}
+		return resources, err
+	case "silenced":
+		silenced, err := c.ListSilenceds(org, "", "")
+		for i := range silenced {
+			resources = append(resources, &silenced[i])
+		}
+		return resources, err
+	}
+
+	return nil, fmt.Errorf("resource type %q cannot be selected", typeName)
+}
+
+func deleteResources(c client.APIClient, resources []interface{}, w io.Writer) error {
+	var failed int
+	for _, v := range resources {
+		name, err := deleteResource(c, v)
+		if err != nil {
+			failed++
+			fmt.Fprintf(w, "unable to delete %s: %s\n", name, err)
+			continue
+		}
+		fmt.Fprintf(w, "deleted %s\n", name)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d resources could not be deleted", failed, len(resources))
+	}
+
+	fmt.Fprintln(w, "OK")
+	return nil
+}
+
+// deleteResource deletes the given resource and returns a human readable
+// description of it.
+func deleteResource(c client.APIClient, v interface{}) (string, error) {
+	switch r := v.(type) {
+	case *types.CheckConfig:
+		return "check " + r.Name, c.DeleteCheck(r)
+	case *types.CheckTemplate:
+		return "check template " + r.Name, c.DeleteCheckTemplate(r)
+	case *types.Entity:
+		return "entity " + r.ID, c.DeleteEntity(r)
+	case *types.Environment:
+		return "environment " + r.Name, c.DeleteEnvironment(r.Organization, r.Name)
+	case *types.EventFilter:
+		return "filter " + r.Name, c.DeleteFilter(r)
+	case *types.Handler:
+		return "handler " + r.Name, c.DeleteHandler(r)
+	case *types.HookConfig:
+		return "hook " + r.Name, c.DeleteHook(r)
+	case *types.Organization:
+		return "organization " + r.Name, c.DeleteOrganization(r.Name)
+	case *types.Pipeline:
+		return "pipeline " + r.Name, c.DeletePipeline(r)
+	case *types.Role:
+		return "role " + r.Name, c.DeleteRole(r.Name)
+	case *types.Silenced:
+		id := r.ID
+		if id == "" {
+			var err error
+			if id, err = types.SilencedID(r.Subscription, r.Check); err != nil {
+				return "silenced entry", err
+			}
+		}
+		return "silenced " + id, c.DeleteSilenced(id)
+	}
+
+	return fmt.Sprintf("%T", v), errors.New("deleting this type of resource is not supported")
+}
@@ -0,0 +1,176 @@
+package delete
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const manifest = `
+type: CheckConfig
+value:
+  name: check_cpu
+---
+type: Handler
+value:
+  name: slack
+---
+type: Silenced
+value:
+  subscription: linux
+  check: check_cpu
+`
+
+func writeManifest(t *testing.T) string {
+	f, err := ioutil.TempFile("", "manifest")
+	require.NoError(t, err)
+	_, err = f.WriteString(manifest)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	return f.Name()
+}
+
+func TestCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := Command(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("delete", cmd.Use)
+	assert.Regexp("manifest", cmd.Short)
+}
+
+func TestCommandRunEClosureWithoutFlags(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := Command(cli)
+	out, err := test.RunCmd(cmd, []string{})
+
+	require.Error(t, err)
+	assert.Regexp(t, "Usage", out)
+}
+
+func TestCommandRunEClosureWithFileAndSelector(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", "manifest.yaml"))
+	require.NoError(t, cmd.Flags().Set("selector", "team=ops"))
+	_, err := test.RunCmd(cmd, []string{})
+
+	require.Error(t, err)
+}
+
+func TestCommandRunEClosureWithFile(t *testing.T) {
+	assert := assert.New(t)
+
+	path := writeManifest(t)
+	defer func() { _ = os.Remove(path) }()
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("DeleteCheck", mock.AnythingOfType("*types.CheckConfig")).Return(nil)
+	client.On("DeleteHandler", mock.AnythingOfType("*types.Handler")).Return(nil)
+	client.On("DeleteSilenced", "linux:check_cpu").Return(nil)
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", path))
+	require.NoError(t, cmd.Flags().Set("skip-confirm", "t"))
+	out, err := test.RunCmd(cmd, []string{})
+
+	require.NoError(t, err)
+	assert.Contains(out, "deleted check check_cpu")
+	assert.Contains(out, "deleted handler slack")
+	assert.Contains(out, "deleted silenced linux:check_cpu")
+	assert.Contains(out, "OK")
+}
+
+func TestCommandRunEClosureWithFileAndServerErr(t *testing.T) {
+	assert := assert.New(t)
+
+	path := writeManifest(t)
+	defer func() { _ = os.Remove(path) }()
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("DeleteCheck", mock.AnythingOfType("*types.CheckConfig")).Return(errors.New("whoops"))
+	client.On("DeleteHandler", mock.AnythingOfType("*types.Handler")).Return(nil)
+	client.On("DeleteSilenced", "linux:check_cpu").Return(nil)
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", path))
+	require.NoError(t, cmd.Flags().Set("skip-confirm", "t"))
+	out, err := test.RunCmd(cmd, []string{})
+
+	require.Error(t, err)
+	assert.Contains(out, "unable to delete check check_cpu: whoops")
+	assert.Contains(out, "deleted handler slack")
+}
+
+func TestCommandRunEClosureWithMissingFile(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", "/this/path/does/not/exist"))
+	_, err := test.RunCmd(cmd, []string{})
+
+	require.Error(t, err)
+}
+
+func TestCommandRunEClosureWithSelector(t *testing.T) {
+	assert := assert.New(t)
+
+	opsCheck := types.FixtureCheckConfig("check_cpu")
+	opsCheck.ExtendedAttributes = []byte(`{"team":"ops"}`)
+	devCheck := types.FixtureCheckConfig("check_mem")
+	devCheck.ExtendedAttributes = []byte(`{"team":"dev"}`)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("ListChecks", "default").Return([]types.CheckConfig{*opsCheck, *devCheck}, nil)
+	client.On("DeleteCheck", mock.MatchedBy(func(c *types.CheckConfig) bool {
+		return c.Name == "check_cpu"
+	})).Return(nil)
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("selector", "team=ops"))
+	require.NoError(t, cmd.Flags().Set("types", "check"))
+	require.NoError(t, cmd.Flags().Set("skip-confirm", "t"))
+	out, err := test.RunCmd(cmd, []string{})
+
+	require.NoError(t, err)
+	assert.Contains(out, "deleted check check_cpu")
+	assert.NotContains(out, "check_mem")
+	client.AssertNumberOfCalls(t, "DeleteCheck", 1)
+}
+
+func TestCommandRunEClosureWithSelectorNoMatches(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("ListHandlers", "default").Return([]types.Handler{*types.FixtureHandler("slack")}, nil)
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("selector", "name=pagerduty"))
+	require.NoError(t, cmd.Flags().Set("types", "handler"))
+	out, err := test.RunCmd(cmd, []string{})
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "No resources found")
+}
+
+func TestCommandRunEClosureWithInvalidType(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("selector", "team=ops"))
+	require.NoError(t, cmd.Flags().Set("types", "user"))
+	_, err := test.RunCmd(cmd, []string{})
+
+	require.Error(t, err)
+}
@@ -31,7 +31,13 @@ func ListCommand(cli *cli.SensuCli) *cobra.Command {
 			}
 
 			// Fetch handlers from API
-			results, err := cli.Client.ListEntities(org)
+			var results []types.Entity
+			var err error
+			if query, _ := cmd.Flags().GetString(flags.Query); query != "" {
+				results, err = cli.Client.QueryEntities(org, query)
+			} else {
+				results, err = cli.Client.ListEntities(org)
+			}
 			if err != nil {
 				return err
 			}
@@ -43,6 +49,7 @@ func ListCommand(cli *cli.SensuCli) *cobra.Command {
 
 	helpers.AddFormatFlag(cmd.Flags())
 	helpers.AddAllOrganization(cmd.Flags())
+	helpers.AddQueryFlag(cmd.Flags(), `system.os == "linux"`)
 
 	return cmd
 }
@@ -62,6 +62,24 @@ func TestListCommandRunEClosureWithAllOrgs(t *testing.T) {
 	assert.Nil(err)
 }
 
+func TestListCommandRunEClosureWithQuery(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := newCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("QueryEntities", mock.Anything, `system.os == "linux"`).Return([]types.Entity{
+		*types.FixtureEntity("name-one"),
+	}, nil)
+
+	cmd := ListCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flags.Query, `system.os == "linux"`))
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.Contains(out, "name-one")
+	assert.Nil(err)
+	client.AssertCalled(t, "QueryEntities", mock.Anything, `system.os == "linux"`)
+}
+
 func TestListCommandRunEClosureWithTable(t *testing.T) {
 	assert := assert.New(t)
 
@@ -47,6 +47,13 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 			env := types.Environment{}
 			opts.Copy(&env)
 
+			labels, annotations, err := helpers.GetMetadataFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			env.Labels = labels
+			env.Annotations = annotations
+
 			if err := env.Validate(); err != nil {
 				if !isInteractive {
 					cmd.SilenceUsage = false
@@ -58,16 +65,18 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 				return err
 			}
 
-			_, err := fmt.Fprintln(cmd.OutOrStdout(), "Created")
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), "Created")
 			return err
 		},
 	}
 
 	_ = cmd.Flags().StringP("description", "", "", "Description of environment")
+	_ = cmd.Flags().StringP("time-zone", "", "", "IANA time zone name of environment, e.g. Europe/Paris")
 	// TODO (Simon): We should be able to use --organization instead but
 	// the environment middleware verifies that the env exists in the given org,
 	// even if we are actually create this env
 	_ = cmd.Flags().StringP("org", "", "", "Name of organization")
+	helpers.AddMetadataFlags(cmd.Flags())
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
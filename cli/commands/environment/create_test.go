@@ -6,6 +6,7 @@ import (
 
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -54,3 +55,32 @@ func TestCreateCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateCommandMetadata(t *testing.T) {
+	cli := test.NewMockCLI()
+
+	config := cli.Config.(*client.MockConfig)
+	config.On("Organization").Return("default")
+
+	client := cli.Client.(*client.MockClient)
+	client.On(
+		"CreateEnvironment",
+		"default",
+		mock.MatchedBy(func(env *types.Environment) bool {
+			return env.Labels["team"] == "ops" && env.Annotations["runbook"] == "https://wiki"
+		}),
+	).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("labels", "team=ops"))
+	require.NoError(t, cmd.Flags().Set("annotations", "runbook=https://wiki"))
+	out, err := test.RunCmd(cmd, []string{"foo"})
+	assert.NoError(t, err)
+	assert.Regexp(t, "Created", out)
+
+	// Invalid pairs are rejected
+	cmd = CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("labels", "team"))
+	_, err = test.RunCmd(cmd, []string{"foo"})
+	assert.Error(t, err)
+}
@@ -10,16 +10,19 @@ type envOpts struct {
 	Description string `survey:"description"`
 	Name        string `survey:"name"`
 	Org         string `survey:"organization"`
+	TimeZone    string `survey:"time-zone"`
 }
 
 func (opts *envOpts) withEnv(env *types.Environment) {
 	opts.Name = env.Name
 	opts.Description = env.Description
 	opts.Org = env.Organization
+	opts.TimeZone = env.TimeZone
 }
 
 func (opts *envOpts) withFlags(flags *pflag.FlagSet) {
 	opts.Description, _ = flags.GetString("description")
+	opts.TimeZone, _ = flags.GetString("time-zone")
 
 	if org, _ := flags.GetString("organization"); org != "" {
 		opts.Org = org
@@ -58,6 +61,14 @@ func (opts *envOpts) administerQuestionnaire(editing bool) error {
 				Default: opts.Description,
 			},
 		},
+		{
+			Name: "time-zone",
+			Prompt: &survey.Input{
+				Message: "Time Zone:",
+				Default: opts.TimeZone,
+				Help:    "IANA time zone name of the environment, e.g. Europe/Paris. UTC if empty.",
+			},
+		},
 	}...)
 
 	return survey.Ask(qs, opts)
@@ -67,4 +78,5 @@ func (opts *envOpts) Copy(env *types.Environment) {
 	env.Description = opts.Description
 	env.Name = opts.Name
 	env.Organization = opts.Org
+	env.TimeZone = opts.TimeZone
 }
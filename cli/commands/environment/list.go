@@ -70,6 +70,13 @@ func printToTable(results interface{}, writer io.Writer) {
 				return env.Description
 			},
 		},
+		{
+			Title: "Time Zone",
+			CellTransformer: func(data interface{}) string {
+				env, _ := data.(types.Environment)
+				return env.TimeZone
+			},
+		},
 	})
 
 	table.Render(writer, results)
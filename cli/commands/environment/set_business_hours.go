@@ -0,0 +1,69 @@
+package environment
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// SetBusinessHoursCommand adds a command that allows a user to set the
+// business hours of an environment
+func SetBusinessHoursCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "set-business-hours [NAME]",
+		Short:        "set business hours of an environment from file or stdin",
+		SilenceUsage: false,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Print usage if we do not receive one argument
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			env, err := cli.Client.FetchEnvironment(args[0])
+			if err != nil {
+				return err
+			}
+
+			filePath, _ := cmd.Flags().GetString("file")
+			var in *os.File
+
+			if len(filePath) > 0 {
+				in, err = os.Open(filePath)
+				if err != nil {
+					return err
+				}
+
+				defer func() { _ = in.Close() }()
+			} else {
+				in = os.Stdin
+			}
+
+			// The business hours are expressed in the time zone of the
+			// environment, unless they specify their own
+			var businessHours types.TimeWindowWhen
+			if err := json.NewDecoder(in).Decode(&businessHours); err != nil {
+				return err
+			}
+			env.BusinessHours = &businessHours
+			if err := env.Validate(); err != nil {
+				return err
+			}
+			if err := cli.Client.UpdateEnvironment(env); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "OK")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("file", "f", "", "Business hours definition file")
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+package environment
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func businessHoursFile(t *testing.T, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "environment")
+	require.NoError(t, err)
+	path := filepath.Join(dir, "business-hours.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	return path, func() { _ = os.RemoveAll(dir) }
+}
+
+func TestSetBusinessHoursCommand(t *testing.T) {
+	path, cleanup := businessHoursFile(t, `{"days":{"monday":[{"begin":"9:00AM","end":"5:00PM"}]}}`)
+	defer cleanup()
+
+	env := types.FixtureEnvironment("prod")
+	env.TimeZone = "Europe/Paris"
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("FetchEnvironment", "prod").Return(env, nil)
+	client.On("UpdateEnvironment", mock.AnythingOfType("*types.Environment")).Return(nil)
+
+	cmd := SetBusinessHoursCommand(cli)
+	require.NoError(t, cmd.Flags().Set("file", path))
+	out, err := test.RunCmd(cmd, []string{"prod"})
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "OK")
+	require.NotNil(t, env.BusinessHours)
+	assert.Equal(t, "9:00AM", env.BusinessHours.Days.Monday[0].Begin)
+}
+
+func TestSetBusinessHoursCommandErrors(t *testing.T) {
+	path, cleanup := businessHoursFile(t, `{"days":{"monday":[{"begin":"9:00","end":"17:00"}]}}`)
+	defer cleanup()
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("FetchEnvironment", "prod").Return(types.FixtureEnvironment("prod"), nil)
+	client.On("FetchEnvironment", "dev").Return(types.FixtureEnvironment("dev"), errors.New("not found"))
+
+	cmd := SetBusinessHoursCommand(cli)
+	_, err := test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+
+	_, err = test.RunCmd(cmd, []string{"dev"})
+	assert.Error(t, err)
+
+	// The business hours are invalid
+	require.NoError(t, cmd.Flags().Set("file", path))
+	_, err = test.RunCmd(cmd, []string{"prod"})
+	assert.Error(t, err)
+}
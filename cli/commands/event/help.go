@@ -17,6 +17,7 @@ func HelpCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd.AddCommand(ShowCommand(cli))
 	cmd.AddCommand(DeleteCommand(cli))
 	cmd.AddCommand(ResolveCommand(cli))
+	cmd.AddCommand(ReplayCommand(cli))
 
 	return cmd
 }
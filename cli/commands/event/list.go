@@ -2,11 +2,14 @@ package event
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"strconv"
 	"time"
 
 	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/client/config"
 	"github.com/sensu/sensu-go/cli/commands/flags"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
 	"github.com/sensu/sensu-go/cli/elements/globals"
@@ -31,8 +34,19 @@ func ListCommand(cli *cli.SensuCli) *cobra.Command {
 				org = "*"
 			}
 
+			query, _ := cmd.Flags().GetString(flags.Query)
+
+			// Aggregate the events of the remote clusters
+			if name, _ := cmd.Flags().GetString(flags.Cluster); name != "" {
+				results, err := listClusterEvents(cmd, cli, name, org, query)
+				if err != nil {
+					return err
+				}
+				return helpers.Print(cmd, cli.Config.Format(), printClusterEventsToTable, results)
+			}
+
 			// Fetch events from API
-			results, err := cli.Client.ListEvents(org)
+			results, err := listEvents(cli.Client, org, query)
 			if err != nil {
 				return err
 			}
@@ -44,57 +58,131 @@ func ListCommand(cli *cli.SensuCli) *cobra.Command {
 
 	helpers.AddFormatFlag(cmd.Flags())
 	helpers.AddAllOrganization(cmd.Flags())
+	helpers.AddClusterFlag(cmd.Flags())
+	helpers.AddQueryFlag(cmd.Flags(), `status != 0 && entity.system.os == "linux"`)
 
 	return cmd
 }
 
+// clusterEvent is an event of one of the clusters aggregated by sensuctl
+type clusterEvent struct {
+	Cluster string      `json:"cluster"`
+	Event   types.Event `json:"event"`
+}
+
+func listEvents(apiClient client.APIClient, org, query string) ([]types.Event, error) {
+	if query != "" {
+		return apiClient.QueryEvents(org, query)
+	}
+	return apiClient.ListEvents(org)
+}
+
+// listClusterEvents fetches the events of the given remote cluster, or of the
+// configured and all the remote clusters. The clusters which can't be reached
+// are reported and skipped in the latter case.
+func listClusterEvents(cmd *cobra.Command, cli *cli.SensuCli, name, org, query string) ([]clusterEvent, error) {
+	names := []string{name}
+	if name == config.AllClusters {
+		names = append([]string{config.LocalCluster}, cli.Config.RemoteClusters()...)
+	}
+
+	results := []clusterEvent{}
+	for _, name := range names {
+		events, err := listRemoteEvents(cli, name, org, query)
+		if err != nil {
+			if len(names) == 1 {
+				return nil, err
+			}
+			fmt.Fprintf(cmd.OutOrStderr(), "Error: cluster %s: %s\n", name, err)
+			continue
+		}
+		for _, event := range events {
+			results = append(results, clusterEvent{Cluster: name, Event: event})
+		}
+	}
+
+	return results, nil
+}
+
+func listRemoteEvents(cli *cli.SensuCli, name, org, query string) ([]types.Event, error) {
+	if name == config.LocalCluster {
+		return listEvents(cli.Client, org, query)
+	}
+	apiClient, err := cli.ClusterClient(name)
+	if err != nil {
+		return nil, err
+	}
+	return listEvents(apiClient, org, query)
+}
+
 func printToTable(results interface{}, writer io.Writer) {
-	table := table.New([]*table.Column{
+	table := table.New(eventColumns(func(data interface{}) types.Event {
+		event, _ := data.(types.Event)
+		return event
+	}))
+
+	table.Render(writer, results)
+}
+
+func printClusterEventsToTable(results interface{}, writer io.Writer) {
+	cluster := &table.Column{
+		Title:       "Cluster",
+		ColumnStyle: table.PrimaryTextStyle,
+		CellTransformer: func(data interface{}) string {
+			event, _ := data.(clusterEvent)
+			return event.Cluster
+		},
+	}
+	columns := eventColumns(func(data interface{}) types.Event {
+		event, _ := data.(clusterEvent)
+		return event.Event
+	})
+	table := table.New(append([]*table.Column{cluster}, columns...))
+
+	table.Render(writer, results)
+}
+
+// eventColumns returns the columns of the events table, whose rows are
+// converted to events by the given function
+func eventColumns(toEvent func(interface{}) types.Event) []*table.Column {
+	return []*table.Column{
 		{
 			Title:       "Entity",
 			ColumnStyle: table.PrimaryTextStyle,
 			CellTransformer: func(data interface{}) string {
-				event, _ := data.(types.Event)
-				return event.Entity.ID
+				return toEvent(data).Entity.ID
 			},
 		},
 		{
 			Title: "Check",
 			CellTransformer: func(data interface{}) string {
-				event, _ := data.(types.Event)
-				return event.Check.Name
+				return toEvent(data).Check.Name
 			},
 		},
 		{
 			Title: "Output",
 			CellTransformer: func(data interface{}) string {
-				event, _ := data.(types.Event)
-				return event.Check.Output
+				return toEvent(data).Check.Output
 			},
 		},
 		{
 			Title: "Status",
 			CellTransformer: func(data interface{}) string {
-				event, _ := data.(types.Event)
-				return strconv.Itoa(int(event.Check.Status))
+				return strconv.Itoa(int(toEvent(data).Check.Status))
 			},
 		},
 		{
 			Title: "Silenced",
 			CellTransformer: func(data interface{}) string {
-				event, _ := data.(types.Event)
-				return globals.BooleanStyleP(len(event.Silenced) > 0)
+				return globals.BooleanStyleP(len(toEvent(data).Silenced) > 0)
 			},
 		},
 		{
 			Title: "Timestamp",
 			CellTransformer: func(data interface{}) string {
-				event, _ := data.(types.Event)
-				time := time.Unix(event.Timestamp, 0)
+				time := time.Unix(toEvent(data).Timestamp, 0)
 				return time.String()
 			},
 		},
-	})
-
-	table.Render(writer, results)
+	}
 }
@@ -2,9 +2,11 @@ package event
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/sensu/sensu-go/cli"
+	sensuclient "github.com/sensu/sensu-go/cli/client"
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	"github.com/sensu/sensu-go/cli/commands/flags"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
@@ -62,6 +64,24 @@ func TestListCommandRunEClosureWithAllOrgs(t *testing.T) {
 	assert.Nil(err)
 }
 
+func TestListCommandRunEClosureWithQuery(t *testing.T) {
+	assert := assert.New(t)
+	cli := newConfiguredCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("QueryEvents", mock.Anything, `status != 0`).Return([]types.Event{
+		*types.FixtureEvent("1", "something"),
+	}, nil)
+
+	cmd := ListCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flags.Format, "json"))
+	require.NoError(t, cmd.Flags().Set(flags.Query, `status != 0`))
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.NotEmpty(out)
+	assert.Nil(err)
+	client.AssertCalled(t, "QueryEvents", mock.Anything, `status != 0`)
+}
+
 func TestListCommandRunEClosureWithTable(t *testing.T) {
 	assert := assert.New(t)
 	cli := newConfiguredCLI()
@@ -99,6 +119,47 @@ func TestListCommandRunEClosureWithErr(t *testing.T) {
 	assert.Equal("fun-msg", err.Error())
 }
 
+func TestListCommandRunEClosureWithClusters(t *testing.T) {
+	cli := newConfiguredCLI()
+	config := cli.Config.(*client.MockConfig)
+	config.On("RemoteClusters").Return([]string{"eu-west", "us-east"})
+	localClient := cli.Client.(*client.MockClient)
+	localClient.On("ListEvents", "default").Return([]types.Event{*types.FixtureEvent("local-entity", "check")}, nil)
+	usEastClient := &client.MockClient{}
+	usEastClient.On("ListEvents", "default").Return([]types.Event{*types.FixtureEvent("us-east-entity", "check")}, nil)
+	cli.ClusterClient = func(name string) (sensuclient.APIClient, error) {
+		if name == "us-east" {
+			return usEastClient, nil
+		}
+		return nil, fmt.Errorf("cluster %q is not configured", name)
+	}
+
+	// The events of a single remote cluster
+	cmd := ListCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flags.Cluster, "us-east"))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, `"cluster": "us-east"`)
+	assert.Contains(t, out, "us-east-entity")
+	assert.NotContains(t, out, "local-entity")
+
+	cmd = ListCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flags.Cluster, "eu-west"))
+	_, err = test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+
+	// The events of all the clusters, the unreachable ones being reported
+	cmd = ListCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flags.Cluster, "all"))
+	require.NoError(t, cmd.Flags().Set(flags.Format, "tabular"))
+	out, err = test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Cluster")
+	assert.Contains(t, out, "local-entity")
+	assert.Contains(t, out, "us-east-entity")
+	assert.Contains(t, out, "Error: cluster eu-west")
+}
+
 func TestListFlags(t *testing.T) {
 	assert := assert.New(t)
 
@@ -110,6 +171,9 @@ func TestListFlags(t *testing.T) {
 
 	flag = cmd.Flag("format")
 	assert.NotNil(flag)
+
+	flag = cmd.Flag("cluster")
+	assert.NotNil(flag)
 }
 
 func newConfiguredCLI() *cli.SensuCli {
@@ -0,0 +1,51 @@
+package event
+
+import (
+	"errors"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// ReplayCommand sends stored events through the event handlers again
+func ReplayCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "replay",
+		Short:        "send the stored events through the event handlers again",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+			org := cli.Config.Organization()
+			if ok, _ := cmd.Flags().GetBool(flags.AllOrgs); ok {
+				org = "*"
+			}
+
+			replay := &types.EventReplay{}
+			replay.Query, _ = cmd.Flags().GetString(flags.Query)
+			replay.Handlers, _ = cmd.Flags().GetStringSlice("handlers")
+			replay.DryRun, _ = cmd.Flags().GetBool("dry-run")
+
+			results, err := cli.Client.ReplayEvents(org, replay)
+			if err != nil {
+				return err
+			}
+
+			// Print the replayed events based on the user preferences
+			return helpers.Print(cmd, cli.Config.Format(), printToTable, results)
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+	helpers.AddAllOrganization(cmd.Flags())
+	helpers.AddQueryFlag(cmd.Flags(), `status != 0 && check.name == "disk"`)
+	cmd.Flags().StringSlice("handlers", nil, "handlers run instead of the handlers and pipelines of the events, e.g. to backfill a TSDB")
+	cmd.Flags().Bool("dry-run", false, "only list the events which would be replayed")
+
+	return cmd
+}
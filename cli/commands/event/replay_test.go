@@ -0,0 +1,45 @@
+package event
+
+import (
+	"errors"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayCommand(t *testing.T) {
+	cli := newConfiguredCLI()
+	replay := &types.EventReplay{Query: "status != 0", Handlers: []string{"influxdb", "slack"}, DryRun: true}
+	client := cli.Client.(*client.MockClient)
+	client.On("ReplayEvents", "default", replay).Return([]types.Event{
+		*types.FixtureEvent("entity1", "disk"),
+	}, nil)
+
+	cmd := ReplayCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flags.Query, "status != 0"))
+	require.NoError(t, cmd.Flags().Set("handlers", "influxdb,slack"))
+	require.NoError(t, cmd.Flags().Set("dry-run", "true"))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, "entity1")
+	client.AssertCalled(t, "ReplayEvents", "default", replay)
+
+	_, err = test.RunCmd(ReplayCommand(cli), []string{"foo"})
+	assert.Error(t, err)
+}
+
+func TestReplayCommandWithAllOrgs(t *testing.T) {
+	cli := newConfiguredCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("ReplayEvents", "*", &types.EventReplay{Handlers: []string{}}).Return([]types.Event{}, errors.New("unauthorized to perform action"))
+
+	cmd := ReplayCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flags.AllOrgs, "true"))
+	_, err := test.RunCmd(cmd, []string{})
+	assert.EqualError(t, err, "unauthorized to perform action")
+}
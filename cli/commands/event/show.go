@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -106,5 +107,18 @@ func printEntityToList(event *types.Event, writer io.Writer) {
 		cfg.Rows = append(cfg.Rows[:len(cfg.Rows)-1], silencedBy, cfg.Rows[len(cfg.Rows)-1])
 	}
 
+	if len(event.Check.Annotations) > 0 {
+		annotations := make([]string, 0, len(event.Check.Annotations))
+		for key, value := range event.Check.Annotations {
+			annotations = append(annotations, key+"="+value)
+		}
+		sort.Strings(annotations)
+		annotationsRow := &list.Row{
+			Label: "Annotations",
+			Value: strings.Join(annotations, ", "),
+		}
+		cfg.Rows = append(cfg.Rows[:len(cfg.Rows)-1], annotationsRow, cfg.Rows[len(cfg.Rows)-1])
+	}
+
 	list.Print(writer, cfg)
 }
@@ -64,6 +64,25 @@ func TestShowCommandRunEClosureWithTable(t *testing.T) {
 	assert.Contains(t, out, "Check")
 }
 
+func TestShowCommandRunEClosureWithAnnotations(t *testing.T) {
+	event := types.FixtureEvent("foo", "check_foo")
+	event.Check.Annotations = map[string]string{"runbook": "https://wiki/foo"}
+
+	cli := test.NewMockCLI()
+	cli.Client.(*client.MockClient).
+		On("FetchEvent", "foo", "check_foo").
+		Return(event, nil)
+	cli.Config.(*client.MockConfig).On("Format").Return("tabular")
+
+	cmd := ShowCommand(cli)
+	require.NoError(t, cmd.Flags().Set("format", "tabular"))
+
+	out, err := test.RunCmd(cmd, []string{"foo", "check_foo"})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Annotations")
+	assert.Contains(t, out, "runbook=https://wiki/foo")
+}
+
 func TestShowCommandRunEClosureWithErr(t *testing.T) {
 	cli := test.NewMockCLI()
 	cli.Client.(*client.MockClient).
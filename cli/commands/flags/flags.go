@@ -1,12 +1,30 @@
 package flags
 
 const (
+	// Annotations is used to specify key=value annotations of a resource
+	Annotations = "annotations"
+
 	// AllOrgs is used to query all resources regardless of their organization
 	AllOrgs = "all-organizations"
 
+	// Cluster is used to query the resources of remote clusters
+	Cluster = "cluster"
+
 	// Format is used to specify the expected output of the command
 	Format = "format"
 
 	// Interactive is used to specify if cli should be interactive
 	Interactive = "interactive"
+
+	// Labels is used to specify key=value labels of a resource
+	Labels = "labels"
+
+	// Query is used to select the resources matching an expression
+	Query = "query"
+
+	// NonInteractive is used to specify that the cli must never prompt the user
+	NonInteractive = "non-interactive"
+
+	// SkipConfirm is used to skip the confirmation prompt of a command
+	SkipConfirm = "skip-confirm"
 )
@@ -65,11 +65,22 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd.Flags().String("command", "", "command to be executed. The event data is passed to the process via STDIN")
 	cmd.Flags().String("filters", "", "comma separated list of filters to use when filtering events for the handler")
 	cmd.Flags().String("handlers", "", "comma separated list of handlers to call using the handler set")
+	cmd.Flags().String("rate-limit", "", "maximum number of executions per minute, 0 for unlimited")
+	cmd.Flags().String("dedup-window", "", "number of seconds during which identical events are only handled once")
+	cmd.Flags().String("contact-rate-limit", "", "maximum number of executions per contact of the events per contact rate window, 0 for unlimited")
+	cmd.Flags().String("contact-rate-window", "", "number of seconds over which the executions are counted per contact, 3600 by default")
+	cmd.Flags().String("retries", "", "number of times the handler is executed again when it fails")
+	cmd.Flags().String("retry-backoff", "", "number of seconds waited before the first retry, doubled for each subsequent retry")
+	cmd.Flags().Bool("legacy", false, "provide the event data in the Sensu 1.x format")
+	cmd.Flags().String("severities", "", "comma separated list of check severities (ok, warning, critical or unknown) of the events to handle")
 	cmd.Flags().StringP("mutator", "m", "", "Sensu event mutator (name) to use to mutate event data for the handler")
+	cmd.Flags().StringP("runtime-assets", "r", "", "comma separated list of assets required to execute the command")
 	cmd.Flags().String("socket-host", "", "host of handler socket")
 	cmd.Flags().String("socket-port", "", "port of handler socket")
 	cmd.Flags().StringP("timeout", "i", "", "execution duration timeout in seconds (hard stop)")
-	cmd.Flags().StringP("type", "t", typeDefault, "type of handler (pipe, tcp, udp, or set)")
+	cmd.Flags().StringP("type", "t", typeDefault, "type of handler (pipe, tcp, udp, webhook, or set)")
+	cmd.Flags().String("url", "", "URL the event data is posted to by a webhook handler")
+	cmd.Flags().String("webhook-format", "", "format of the event data posted by a webhook handler (json or cloudevents)")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
@@ -6,6 +6,7 @@ import (
 
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -70,3 +71,105 @@ func TestCreateCommandRunEClosureWithAPIErr(t *testing.T) {
 	assert.NotNil(err)
 	assert.Equal("nope", err.Error())
 }
+
+func TestCreateCommandRunEClosureWithThrottling(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateHandler", mock.MatchedBy(func(h *types.Handler) bool {
+		return h.RateLimit == 10 && h.DedupWindow == 60
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "notify"))
+	require.NoError(t, cmd.Flags().Set("rate-limit", "10"))
+	require.NoError(t, cmd.Flags().Set("dedup-window", "60"))
+	out, err := test.RunCmd(cmd, []string{"throttled"})
+
+	assert.Regexp(t, "OK", out)
+	assert.NoError(t, err)
+}
+
+func TestCreateCommandRunEClosureWithContactRateLimit(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateHandler", mock.MatchedBy(func(h *types.Handler) bool {
+		return h.ContactRateLimit == 3 && h.ContactRateWindow == 1800
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "page"))
+	require.NoError(t, cmd.Flags().Set("contact-rate-limit", "3"))
+	require.NoError(t, cmd.Flags().Set("contact-rate-window", "1800"))
+	out, err := test.RunCmd(cmd, []string{"pager"})
+
+	assert.Regexp(t, "OK", out)
+	assert.NoError(t, err)
+}
+
+func TestCreateCommandRunEClosureWithRetries(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateHandler", mock.MatchedBy(func(h *types.Handler) bool {
+		return h.Retries == 3 && h.RetryBackoff == 5
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "notify"))
+	require.NoError(t, cmd.Flags().Set("retries", "3"))
+	require.NoError(t, cmd.Flags().Set("retry-backoff", "5"))
+	out, err := test.RunCmd(cmd, []string{"retried"})
+
+	assert.Regexp(t, "OK", out)
+	assert.NoError(t, err)
+}
+
+func TestCreateCommandRunEClosureWithLegacy(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateHandler", mock.MatchedBy(func(h *types.Handler) bool {
+		return h.Legacy
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "handler-mailer.rb"))
+	require.NoError(t, cmd.Flags().Set("legacy", "true"))
+	out, err := test.RunCmd(cmd, []string{"mailer"})
+
+	assert.Regexp(t, "OK", out)
+	assert.NoError(t, err)
+}
+
+func TestCreateCommandRunEClosureWithSeverities(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateHandler", mock.MatchedBy(func(h *types.Handler) bool {
+		return assert.ObjectsAreEqual([]string{"critical", "unknown"}, h.Severities)
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "pagerduty"))
+	require.NoError(t, cmd.Flags().Set("severities", "critical, unknown"))
+	out, err := test.RunCmd(cmd, []string{"pager"})
+
+	assert.Regexp(t, "OK", out)
+	assert.NoError(t, err)
+}
+
+func TestCreateCommandRunEClosureWithWebhook(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateHandler", mock.MatchedBy(func(h *types.Handler) bool {
+		return h.Type == types.HandlerWebhookType &&
+			h.URL == "https://example.com/events" &&
+			h.Format == types.HandlerFormatCloudEvents
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("type", "webhook"))
+	require.NoError(t, cmd.Flags().Set("url", "https://example.com/events"))
+	require.NoError(t, cmd.Flags().Set("webhook-format", "cloudevents"))
+	out, err := test.RunCmd(cmd, []string{"bus"})
+
+	assert.Regexp(t, "OK", out)
+	assert.NoError(t, err)
+}
@@ -77,6 +77,15 @@ func printCheckToList(handler *types.Handler, writer io.Writer) {
 			table.TitleStyle("RUN:"),
 			handler.Command,
 		)
+	case types.HandlerWebhookType:
+		execute = fmt.Sprintf(
+			"%s %s",
+			table.TitleStyle("POST:"),
+			handler.URL,
+		)
+		if handler.Format != "" {
+			execute += fmt.Sprintf(" (%s)", handler.Format)
+		}
 	case types.HandlerSetType:
 		execute = fmt.Sprintf(
 			"%s %s",
@@ -102,6 +111,18 @@ func printCheckToList(handler *types.Handler, writer io.Writer) {
 				Label: "Timeout",
 				Value: strconv.FormatInt(int64(handler.Timeout), 10),
 			},
+			{
+				Label: "Contact Rate Limit",
+				Value: strconv.FormatUint(uint64(handler.ContactRateLimit), 10),
+			},
+			{
+				Label: "Retries",
+				Value: strconv.FormatUint(uint64(handler.Retries), 10),
+			},
+			{
+				Label: "Retry Backoff",
+				Value: strconv.FormatUint(uint64(handler.RetryBackoff), 10),
+			},
 			{
 				Label: "Filters",
 				Value: strings.Join(handler.Filters, ", "),
@@ -118,6 +139,10 @@ func printCheckToList(handler *types.Handler, writer io.Writer) {
 				Label: "Environment Variables",
 				Value: strings.Join(handler.EnvVars, ", "),
 			},
+			{
+				Label: "Runtime Assets",
+				Value: strings.Join(handler.RuntimeAssets, ", "),
+			},
 		},
 	}
 
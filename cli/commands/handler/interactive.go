@@ -11,17 +11,28 @@ import (
 )
 
 type handlerOpts struct {
-	Name       string `survey:"name"`
-	Type       string `survey:"type"`
-	Mutator    string `survey:"mutator"`
-	Command    string `survey:"command"`
-	Timeout    string `survey:"timeout"`
-	Filters    string `survey:"filters"`
-	Handlers   string `survey:"handlers"`
-	SocketHost string `survey:"socketHost"`
-	SocketPort string `survey:"socketPort"`
-	Env        string
-	Org        string
+	Name          string `survey:"name"`
+	Type          string `survey:"type"`
+	Mutator       string `survey:"mutator"`
+	Command       string `survey:"command"`
+	Timeout       string `survey:"timeout"`
+	Filters       string `survey:"filters"`
+	Handlers      string `survey:"handlers"`
+	SocketHost    string `survey:"socketHost"`
+	SocketPort    string `survey:"socketPort"`
+	URL           string `survey:"url"`
+	Format        string `survey:"format"`
+	RateLimit     string
+	DedupWindow   string
+	ContactLimit  string
+	ContactWindow string
+	Retries       string
+	Backoff       string
+	Legacy        bool
+	Severities    string
+	Assets        string `survey:"assets"`
+	Env           string
+	Org           string
 }
 
 const (
@@ -45,6 +56,17 @@ func (opts *handlerOpts) withHandler(handler *types.Handler) {
 	opts.Mutator = handler.Mutator
 	opts.Timeout = strconv.FormatUint(uint64(handler.Timeout), 10)
 	opts.Type = handler.Type
+	opts.RateLimit = strconv.FormatUint(uint64(handler.RateLimit), 10)
+	opts.DedupWindow = strconv.FormatUint(uint64(handler.DedupWindow), 10)
+	opts.ContactLimit = strconv.FormatUint(uint64(handler.ContactRateLimit), 10)
+	opts.ContactWindow = strconv.FormatUint(uint64(handler.ContactRateWindow), 10)
+	opts.Retries = strconv.FormatUint(uint64(handler.Retries), 10)
+	opts.Backoff = strconv.FormatUint(uint64(handler.RetryBackoff), 10)
+	opts.Legacy = handler.Legacy
+	opts.Severities = strings.Join(handler.Severities, ",")
+	opts.Assets = strings.Join(handler.RuntimeAssets, ",")
+	opts.URL = handler.URL
+	opts.Format = handler.Format
 
 	if handler.Socket != nil {
 		opts.SocketHost = handler.Socket.Host
@@ -61,6 +83,17 @@ func (opts *handlerOpts) withFlags(flags *pflag.FlagSet) {
 	opts.SocketPort, _ = flags.GetString("socket-port")
 	opts.Timeout, _ = flags.GetString("timeout")
 	opts.Type, _ = flags.GetString("type")
+	opts.RateLimit, _ = flags.GetString("rate-limit")
+	opts.DedupWindow, _ = flags.GetString("dedup-window")
+	opts.ContactLimit, _ = flags.GetString("contact-rate-limit")
+	opts.ContactWindow, _ = flags.GetString("contact-rate-window")
+	opts.Retries, _ = flags.GetString("retries")
+	opts.Backoff, _ = flags.GetString("retry-backoff")
+	opts.Legacy, _ = flags.GetBool("legacy")
+	opts.Severities, _ = flags.GetString("severities")
+	opts.Assets, _ = flags.GetString("runtime-assets")
+	opts.URL, _ = flags.GetString("url")
+	opts.Format, _ = flags.GetString("webhook-format")
 
 	if org, _ := flags.GetString("organization"); org != "" {
 		opts.Org = org
@@ -85,6 +118,8 @@ func (opts *handlerOpts) administerQuestionnaire(editing bool) error {
 		return opts.queryForSocket()
 	case types.HandlerSetType:
 		return opts.queryForHandlers()
+	case types.HandlerWebhookType:
+		return opts.queryForWebhook()
 	}
 
 	return nil
@@ -148,7 +183,7 @@ func (opts *handlerOpts) queryForBaseParameters(editing bool) error {
 			Name: "type",
 			Prompt: &survey.Select{
 				Message: "Type:",
-				Options: []string{"pipe", "tcp", "udp", "set"},
+				Options: []string{"pipe", "tcp", "udp", "webhook", "set"},
 				Default: opts.Type,
 			},
 			Validate: survey.Required,
@@ -168,6 +203,14 @@ func (opts *handlerOpts) queryForCommand() error {
 			},
 			Validate: survey.Required,
 		},
+		{
+			Name: "assets",
+			Prompt: &survey.Input{
+				Message: "Runtime Assets:",
+				Default: opts.Assets,
+				Help:    "comma separated list of assets required to execute the command",
+			},
+		},
 	}
 
 	return survey.Ask(qs, opts)
@@ -212,6 +255,29 @@ func (opts *handlerOpts) queryForSocket() error {
 	return survey.Ask(qs, opts)
 }
 
+func (opts *handlerOpts) queryForWebhook() error {
+	var qs = []*survey.Question{
+		{
+			Name: "url",
+			Prompt: &survey.Input{
+				Message: "URL:",
+				Default: opts.URL,
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "format",
+			Prompt: &survey.Select{
+				Message: "Format:",
+				Options: []string{types.HandlerFormatJSON, types.HandlerFormatCloudEvents},
+				Default: opts.Format,
+			},
+		},
+	}
+
+	return survey.Ask(qs, opts)
+}
+
 func (opts *handlerOpts) Copy(handler *types.Handler) {
 	handler.Name = opts.Name
 	handler.Environment = opts.Env
@@ -220,6 +286,11 @@ func (opts *handlerOpts) Copy(handler *types.Handler) {
 	handler.Command = opts.Command
 	handler.Mutator = opts.Mutator
 	handler.Type = strings.ToLower(opts.Type)
+	handler.Legacy = opts.Legacy
+	handler.Severities = helpers.SafeSplitCSV(opts.Severities)
+	handler.RuntimeAssets = helpers.SafeSplitCSV(opts.Assets)
+	handler.URL = opts.URL
+	handler.Format = opts.Format
 
 	if len(opts.Timeout) > 0 {
 		t, _ := strconv.ParseUint(opts.Timeout, 10, 32)
@@ -228,6 +299,48 @@ func (opts *handlerOpts) Copy(handler *types.Handler) {
 		handler.Timeout = 0
 	}
 
+	if len(opts.RateLimit) > 0 {
+		r, _ := strconv.ParseUint(opts.RateLimit, 10, 32)
+		handler.RateLimit = uint32(r)
+	} else {
+		handler.RateLimit = 0
+	}
+
+	if len(opts.DedupWindow) > 0 {
+		d, _ := strconv.ParseUint(opts.DedupWindow, 10, 32)
+		handler.DedupWindow = uint32(d)
+	} else {
+		handler.DedupWindow = 0
+	}
+
+	if len(opts.ContactLimit) > 0 {
+		r, _ := strconv.ParseUint(opts.ContactLimit, 10, 32)
+		handler.ContactRateLimit = uint32(r)
+	} else {
+		handler.ContactRateLimit = 0
+	}
+
+	if len(opts.ContactWindow) > 0 {
+		w, _ := strconv.ParseUint(opts.ContactWindow, 10, 32)
+		handler.ContactRateWindow = uint32(w)
+	} else {
+		handler.ContactRateWindow = 0
+	}
+
+	if len(opts.Retries) > 0 {
+		r, _ := strconv.ParseUint(opts.Retries, 10, 32)
+		handler.Retries = uint32(r)
+	} else {
+		handler.Retries = 0
+	}
+
+	if len(opts.Backoff) > 0 {
+		b, _ := strconv.ParseUint(opts.Backoff, 10, 32)
+		handler.RetryBackoff = uint32(b)
+	} else {
+		handler.RetryBackoff = 0
+	}
+
 	if len(opts.SocketHost) > 0 && len(opts.SocketPort) > 0 {
 		p, _ := strconv.ParseUint(opts.SocketPort, 10, 32)
 		handler.Socket = &types.HandlerSocket{
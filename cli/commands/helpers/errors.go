@@ -1,6 +1,109 @@
 package helpers
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// ExitCodeError is the exit code of the commands which failed for any
+	// reason without a dedicated exit code
+	ExitCodeError = 1
+
+	// ExitCodeUsage is the exit code of the commands which were misused, e.g.
+	// given invalid flags
+	ExitCodeUsage = 2
+
+	// ExitCodeAuth is the exit code of the commands which failed to
+	// authenticate or weren't authorized
+	ExitCodeAuth = 3
+
+	// ExitCodeNotFound is the exit code of the commands which failed because
+	// a resource doesn't exist
+	ExitCodeNotFound = 4
+
+	// ExitCodeValidation is the exit code of the commands which failed
+	// because a resource or argument is invalid
+	ExitCodeValidation = 5
+
+	// ErrorClassUsage is the class of the usage errors
+	ErrorClassUsage = "usage"
+)
+
+// ClassError is an error whose class is determined by the command itself
+// rather than by the response of the API.
+type ClassError struct {
+	Class string
+	Err   error
+}
+
+// Error returns the message of the underlying error.
+func (e ClassError) Error() string {
+	return e.Err.Error()
+}
+
+// UsageError returns an error caused by the misuse of a command, e.g. invalid
+// flags or a prompt required in non-interactive mode.
+func UsageError(err error) error {
+	return ClassError{Class: ErrorClassUsage, Err: err}
+}
+
+// ErrorClass returns the class of the error a command failed with.
+func ErrorClass(err error) string {
+	if classErr, ok := err.(ClassError); ok {
+		return classErr.Class
+	}
+	return client.ErrorClass(err)
+}
+
+// ExitCode returns the exit code corresponding to the class of the error a
+// command failed with.
+func ExitCode(err error) int {
+	switch ErrorClass(err) {
+	case ErrorClassUsage:
+		return ExitCodeUsage
+	case client.ErrorClassAuth:
+		return ExitCodeAuth
+	case client.ErrorClassNotFound:
+		return ExitCodeNotFound
+	case client.ErrorClassValidation:
+		return ExitCodeValidation
+	}
+	return ExitCodeError
+}
+
+type errorEnvelope struct {
+	Error errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Class   string                 `json:"class"`
+	Message string                 `json:"message"`
+	Fields  types.ValidationErrors `json:"fields,omitempty"`
+}
+
+// PrintError prints the error a command failed with to the given writer,
+// either as a JSON envelope describing its class, message and invalid fields,
+// or as a human readable message.
+func PrintError(w io.Writer, format string, err error) error {
+	if format != "json" {
+		_, werr := fmt.Fprintln(w, "Error:", err.Error())
+		return werr
+	}
+
+	envelope := errorEnvelope{
+		Error: errorBody{
+			Class:   ErrorClass(err),
+			Message: err.Error(),
+			Fields:  client.ErrorFields(err),
+		},
+	}
+	return PrintJSON(envelope, w)
+}
 
 // JoinErrors joins multiple errors messages. Useful when
 // you want the CLI to display more than one error message.
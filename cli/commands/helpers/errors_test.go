@@ -0,0 +1,38 @@
+package helpers
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, ExitCodeError, ExitCode(errors.New("error")))
+	assert.Equal(t, ExitCodeUsage, ExitCode(UsageError(errors.New("unknown flag"))))
+	assert.Equal(t, ExitCodeAuth, ExitCode(ClassError{Class: client.ErrorClassAuth, Err: errors.New("no token")}))
+	assert.Equal(t, ExitCodeValidation, ExitCode(types.ValidationErrors{}))
+}
+
+func TestPrintError(t *testing.T) {
+	buf := new(bytes.Buffer)
+	require.NoError(t, PrintError(buf, "tabular", errors.New("whoops")))
+	assert.Equal(t, "Error: whoops\n", buf.String())
+
+	var errs types.ValidationErrors
+	errs.Add("name", types.ValidationRequired, "name cannot be empty")
+
+	buf.Reset()
+	require.NoError(t, PrintError(buf, "json", errs))
+	assert.JSONEq(t, `{
+		"error": {
+			"class": "validation",
+			"message": "name cannot be empty",
+			"fields": [{"field": "name", "code": "required", "message": "name cannot be empty"}]
+		}
+	}`, buf.String())
+}
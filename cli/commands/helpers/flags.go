@@ -24,6 +24,41 @@ func AddAllOrganization(flagSet *pflag.FlagSet) {
 	flagSet.Bool(flags.AllOrgs, false, "Include records from all organizations")
 }
 
+// AddClusterFlag adds the '--cluster' flag to the given command
+func AddClusterFlag(flagSet *pflag.FlagSet) {
+	flagSet.String(flags.Cluster, "", `remote cluster to query, or "all" to include the records of the configured and all the remote clusters`)
+}
+
+// AddQueryFlag adds the '--query' flag to the given command
+func AddQueryFlag(flagSet *pflag.FlagSet, example string) {
+	flagSet.String(flags.Query, "", "only include the records matching the expression, e.g. '"+example+"'")
+}
+
+// AddMetadataFlags adds the '--labels' and '--annotations' flags to the given
+// command
+func AddMetadataFlags(flagSet *pflag.FlagSet) {
+	flagSet.String(flags.Labels, "", "comma separated list of key=value labels")
+	flagSet.String(flags.Annotations, "", "comma separated list of key=value annotations")
+}
+
+// GetMetadataFlags returns the labels and annotations provided through the
+// '--labels' and '--annotations' flags
+func GetMetadataFlags(flagSet *pflag.FlagSet) (map[string]string, map[string]string, error) {
+	value, _ := flagSet.GetString(flags.Labels)
+	labels, err := ParseKeyValues(value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid labels: %s", err)
+	}
+
+	value, _ = flagSet.GetString(flags.Annotations)
+	annotations, err := ParseKeyValues(value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid annotations: %s", err)
+	}
+
+	return labels, annotations, nil
+}
+
 // AddInteractiveFlag adds the '--interactive' flag to the given command
 func AddInteractiveFlag(flagSet *pflag.FlagSet) {
 	flagSet.Bool(flags.Interactive, false, "Determines if CLI is in interactive mode")
@@ -65,6 +100,24 @@ func SafeSplitCSV(i string) []string {
 	return []string{}
 }
 
+// ParseKeyValues parses a comma separated list of key=value pairs. The result
+// is nil if the list is empty.
+func ParseKeyValues(i string) (map[string]string, error) {
+	var pairs map[string]string
+	for _, part := range SafeSplitCSV(i) {
+		kv := strings.SplitN(part, "=", 2)
+		key := strings.TrimSpace(kv[0])
+		if len(kv) != 2 || key == "" {
+			return nil, fmt.Errorf("invalid pair %q, expected key=value", part)
+		}
+		if pairs == nil {
+			pairs = make(map[string]string)
+		}
+		pairs[key] = strings.TrimSpace(kv[1])
+	}
+	return pairs, nil
+}
+
 func init() {
 	// Matches same whitespace that the stdlib's unicode or strings packages would
 	// https://golang.org/src/unicode/graphic.go?s=3997:4022#L116
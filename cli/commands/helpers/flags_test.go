@@ -35,3 +35,19 @@ func TestSafeSplitCSV(t *testing.T) {
 	res = SafeSplitCSV("    one ,     \t 🐛 two")
 	assert.Equal(res, []string{"one", "🐛 two"})
 }
+
+func TestParseKeyValues(t *testing.T) {
+	pairs, err := ParseKeyValues("")
+	assert.NoError(t, err)
+	assert.Nil(t, pairs)
+
+	pairs, err = ParseKeyValues("team=ops, url=https://wiki/?a=b")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "ops", "url": "https://wiki/?a=b"}, pairs)
+
+	_, err = ParseKeyValues("team")
+	assert.Error(t, err)
+
+	_, err = ParseKeyValues("=ops")
+	assert.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/hooks"
 	"github.com/spf13/cobra"
 )
 
@@ -55,6 +56,10 @@ func UpdateCommand(cli *cli.SensuCli) *cobra.Command {
 			_, err = fmt.Fprintln(cmd.OutOrStdout(), "OK")
 			return err
 		},
+		Annotations: map[string]string{
+			// The resource is updated through prompts
+			hooks.InteractionRequirement: hooks.InteractionRequired,
+		},
 	}
 
 	return cmd
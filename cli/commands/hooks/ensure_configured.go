@@ -5,6 +5,8 @@ import (
 	"os"
 
 	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
 	"github.com/spf13/cobra"
 )
 
@@ -38,10 +40,13 @@ func ConfigurationPresent(cmd *cobra.Command, cli *cli.SensuCli) error {
 	// Check that both a URL and an access token are present
 	tokens := cli.Config.Tokens()
 	if cli.Config.APIUrl() == "" || tokens == nil || tokens.Access == "" {
-		return fmt.Errorf(
-			"Unable to locate credentials. You can configure credentials by running \"%s configure\"",
-			os.Args[0],
-		)
+		return helpers.ClassError{
+			Class: client.ErrorClassAuth,
+			Err: fmt.Errorf(
+				"Unable to locate credentials. You can configure credentials by running \"%s configure\"",
+				os.Args[0],
+			),
+		}
 	}
 
 	return nil
@@ -0,0 +1,53 @@
+package hooks
+
+import (
+	"fmt"
+
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// InteractionRequirement used to identify the annotation flag for this
+	// handler
+	//
+	// Usage:
+	//
+	//	my_cmd := cobra.Command{
+	//		Use: "update",
+	//		Annotations: map[string]string{
+	//			InteractionRequirement: InteractionRequired,
+	//		}
+	//	}
+	InteractionRequirement = "INTERACTION_REQUIREMENT"
+
+	// InteractionRequired specifies that the command always prompts the user
+	// and therefore can't be used in non-interactive mode
+	InteractionRequired = "YES"
+)
+
+// InteractionAllowed - when the global non-interactive flag is set, func
+// checks that the given command won't prompt the user, so scripts fail right
+// away instead of waiting for an input.
+func InteractionAllowed(cmd *cobra.Command) error {
+	if nonInteractive, _ := cmd.Flags().GetBool(flags.NonInteractive); !nonInteractive {
+		return nil
+	}
+
+	if cmd.Annotations[InteractionRequirement] == InteractionRequired {
+		return helpers.UsageError(fmt.Errorf("%q prompts for input and can't be used with --%s", cmd.CommandPath(), flags.NonInteractive))
+	}
+
+	if interactive, _ := cmd.Flags().GetBool(flags.Interactive); interactive {
+		return helpers.UsageError(fmt.Errorf("--%s can't be used with --%s", flags.Interactive, flags.NonInteractive))
+	}
+
+	if cmd.Flags().Lookup(flags.SkipConfirm) != nil {
+		if skipConfirm, _ := cmd.Flags().GetBool(flags.SkipConfirm); !skipConfirm {
+			return helpers.UsageError(fmt.Errorf("--%s is required with --%s", flags.SkipConfirm, flags.NonInteractive))
+		}
+	}
+
+	return nil
+}
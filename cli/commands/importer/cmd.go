@@ -14,6 +14,7 @@ import (
 )
 
 const (
+	flagsDir     = "dir"
 	flagsLegacy  = "legacy"
 	flagsForce   = "force"
 	flagsVerbose = "verbose"
@@ -48,14 +49,8 @@ func ImportCommand(cli *cli.SensuCli) *cobra.Command {
 				return err
 			}
 
-			var importer *Importer
-			if legacy, _ := cmd.Flags().GetBool(flagsLegacy); legacy {
-				importer = NewSensuV1SettingsImporter(
-					cli.Config.Organization(),
-					cli.Config.Environment(),
-					cli.Client,
-				)
-			} else {
+			legacy, _ := cmd.Flags().GetBool(flagsLegacy)
+			if !legacy {
 				printErrorMsg(
 					cmd.OutOrStderr(),
 					"Only importing of legacy settings are supported at this time.",
@@ -63,32 +58,89 @@ func ImportCommand(cli *cli.SensuCli) *cobra.Command {
 				return errors.New("")
 			}
 
-			importer.AllowWarns, _ = cmd.Flags().GetBool(flagsForce)
-			importer.Debug, _ = cmd.Flags().GetBool(flagsVerbose)
-			importer.report.Out = cmd.OutOrStdout()
+			return runLegacyImporter(cli, cmd, data)
+		},
+	}
 
-			err := importer.Run(data)
-			fmt.Fprintln(cmd.OutOrStdout(), "\n==============================")
+	cmd.AddCommand(LegacyCommand(cli))
 
-			if err != nil {
-				printErrorMsg(cmd.OutOrStderr(), err.Error())
-				return err
+	cmd.Flags().Bool(flagsLegacy, false, "import Sensu V1 settings")
+	cmd.Flags().Bool(flagsForce, false, "attempt to import resources regardless of any wanrings")
+	cmd.Flags().BoolP(flagsVerbose, "v", false, "include debug messages in output")
+
+	return &cmd
+}
+
+// LegacyCommand adds a command that imports Sensu 1.x settings, either from
+// STDIN or from a configuration directory such as /etc/sensu/conf.d.
+func LegacyCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "legacy",
+		Short:         "import Sensu 1.x settings from STDIN or a configuration directory",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			var data map[string]interface{}
+			if dir, _ := cmd.Flags().GetString(flagsDir); dir != "" {
+				var err error
+				if data, err = loadLegacyConfigDir(dir); err != nil {
+					printErrorMsg(cmd.OutOrStderr(), err.Error())
+					return err
+				}
+			} else {
+				stat, _ := cli.InFile.Stat()
+				if stat.Mode()&os.ModeNamedPipe == 0 {
+					_ = cmd.Help() // Print out usage
+					return nil
+				}
+
+				dec := json.NewDecoder(bufio.NewReader(cli.InFile))
+				if err := dec.Decode(&data); err != nil {
+					printErrorMsg(cmd.OutOrStderr(), err.Error())
+					return err
+				}
 			}
 
-			fmt.Fprintln(
-				cmd.OutOrStdout(),
-				globals.SuccessStyle("SUCCESS"),
-				"all resources imported",
-			)
-			return nil
+			return runLegacyImporter(cli, cmd, data)
 		},
 	}
 
-	cmd.Flags().Bool(flagsLegacy, false, "import Sensu V1 settings")
+	cmd.Flags().String(flagsDir, "", "path to a Sensu 1.x configuration directory (e.g. /etc/sensu/conf.d)")
 	cmd.Flags().Bool(flagsForce, false, "attempt to import resources regardless of any wanrings")
 	cmd.Flags().BoolP(flagsVerbose, "v", false, "include debug messages in output")
 
-	return &cmd
+	return cmd
+}
+
+func runLegacyImporter(cli *cli.SensuCli, cmd *cobra.Command, data map[string]interface{}) error {
+	importer := NewSensuV1SettingsImporter(
+		cli.Config.Organization(),
+		cli.Config.Environment(),
+		cli.Client,
+	)
+	importer.AllowWarns, _ = cmd.Flags().GetBool(flagsForce)
+	importer.Debug, _ = cmd.Flags().GetBool(flagsVerbose)
+	importer.report.Out = cmd.OutOrStdout()
+
+	err := importer.Run(data)
+	fmt.Fprintln(cmd.OutOrStdout(), "\n==============================")
+
+	if err != nil {
+		printErrorMsg(cmd.OutOrStderr(), err.Error())
+		return err
+	}
+
+	fmt.Fprintln(
+		cmd.OutOrStdout(),
+		globals.SuccessStyle("SUCCESS"),
+		"all resources imported",
+	)
+	return nil
 }
 
 func printErrorMsg(wr io.Writer, msg string) {
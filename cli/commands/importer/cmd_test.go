@@ -1,11 +1,15 @@
 package importer
 
 import (
+	"io/ioutil"
 	"os"
 	"testing"
 
+	clientmock "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestImportCommand(t *testing.T) {
@@ -101,3 +105,35 @@ func TestImportCommandRunWithLegacyImporterInvalidResources(t *testing.T) {
 	assert.Contains(out, "ERROR")
 	assert.Error(err)
 }
+
+func TestLegacyCommandRunWithDir(t *testing.T) {
+	assert := assert.New(t)
+	cli := test.NewMockCLI()
+	client := cli.Client.(*clientmock.MockClient)
+	client.On("CreateCheck", mock.AnythingOfType("*types.CheckConfig")).Return(nil)
+
+	dir, err := ioutil.TempDir("", "sensu-conf.d")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	writeLegacyConfig(t, dir, "check_cpu.json", `{"checks": {"check_cpu": {"command": "check-cpu.rb", "interval": 60, "subscribers": ["linux"]}}}`)
+
+	cmd := LegacyCommand(cli)
+	require.NoError(t, cmd.Flags().Set("dir", dir))
+
+	out, err := test.RunCmd(cmd, []string{})
+	assert.NoError(err)
+	assert.Contains(out, "SUCCESS")
+	client.AssertCalled(t, "CreateCheck", mock.AnythingOfType("*types.CheckConfig"))
+}
+
+func TestLegacyCommandRunWithMissingDir(t *testing.T) {
+	assert := assert.New(t)
+	cli := test.NewMockCLI()
+
+	cmd := LegacyCommand(cli)
+	require.NoError(t, cmd.Flags().Set("dir", "/this/path/does/not/exist"))
+
+	out, err := test.RunCmd(cmd, []string{})
+	assert.Error(err)
+	assert.Contains(out, "ERROR")
+}
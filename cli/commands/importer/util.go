@@ -1,6 +1,13 @@
 package importer
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+)
 
 func unsupportedAttr(resource, attr string) string {
 	return fmt.Sprintf(
@@ -9,3 +16,96 @@ func unsupportedAttr(resource, attr string) string {
 		attr,
 	)
 }
+
+// loadLegacyConfigDir reads every JSON file found under the given directory,
+// in lexical order, and deep merges them the same way Sensu 1.x does when
+// loading its configuration directory.
+func loadLegacyConfigDir(dir string) (map[string]interface{}, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	var files []string
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && filepath.Ext(path) == ".json" {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	data := map[string]interface{}{}
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+
+		var fileData map[string]interface{}
+		err = json.NewDecoder(f).Decode(&fileData)
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %s: %s", file, err)
+		}
+
+		data = deepMerge(data, fileData)
+	}
+
+	return data, nil
+}
+
+// deepMerge merges src into dst: hashes are merged recursively, arrays are
+// concatenated without duplicates and any other value from src wins.
+func deepMerge(dst, src map[string]interface{}) map[string]interface{} {
+	for key, srcVal := range src {
+		dstVal, ok := dst[key]
+		if !ok {
+			dst[key] = srcVal
+			continue
+		}
+
+		switch s := srcVal.(type) {
+		case map[string]interface{}:
+			if d, ok := dstVal.(map[string]interface{}); ok {
+				dst[key] = deepMerge(d, s)
+				continue
+			}
+		case []interface{}:
+			if d, ok := dstVal.([]interface{}); ok {
+				dst[key] = mergeArrays(d, s)
+				continue
+			}
+		}
+
+		dst[key] = srcVal
+	}
+
+	return dst
+}
+
+func mergeArrays(a, b []interface{}) []interface{} {
+	result := append([]interface{}{}, a...)
+	for _, val := range b {
+		found := false
+		for _, existing := range result {
+			if reflect.DeepEqual(existing, val) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, val)
+		}
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package importer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeLegacyConfig(t *testing.T, dir, name, content string) {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+}
+
+func TestLoadLegacyConfigDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sensu-conf.d")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	writeLegacyConfig(t, dir, "checks/check_cpu.json", `{"checks": {"check_cpu": {"command": "check-cpu.rb", "subscribers": ["linux"]}}}`)
+	writeLegacyConfig(t, dir, "checks/check_cpu_override.json", `{"checks": {"check_cpu": {"interval": 30, "subscribers": ["linux", "web"]}}}`)
+	writeLegacyConfig(t, dir, "handlers.json", `{"handlers": {"slack": {"type": "pipe", "command": "slack.rb"}}}`)
+	writeLegacyConfig(t, dir, "README.md", `not json`)
+
+	data, err := loadLegacyConfigDir(dir)
+	require.NoError(t, err)
+
+	checks := data["checks"].(map[string]interface{})
+	check := checks["check_cpu"].(map[string]interface{})
+	assert.Equal(t, "check-cpu.rb", check["command"])
+	assert.Equal(t, float64(30), check["interval"])
+	assert.Equal(t, []interface{}{"linux", "web"}, check["subscribers"])
+	assert.Contains(t, data["handlers"], "slack")
+}
+
+func TestLoadLegacyConfigDirInvalidJSON(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sensu-conf.d")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	writeLegacyConfig(t, dir, "broken.json", `{"checks": `)
+
+	_, err = loadLegacyConfigDir(dir)
+	assert.Error(t, err)
+}
+
+func TestLoadLegacyConfigDirMissing(t *testing.T) {
+	_, err := loadLegacyConfigDir("/this/path/does/not/exist")
+	assert.Error(t, err)
+}
+
+func TestDeepMerge(t *testing.T) {
+	dst := map[string]interface{}{
+		"a": "one",
+		"b": map[string]interface{}{"c": 1.0, "d": []interface{}{"x"}},
+	}
+	src := map[string]interface{}{
+		"a": "two",
+		"b": map[string]interface{}{"d": []interface{}{"x", "y"}, "e": true},
+	}
+
+	result := deepMerge(dst, src)
+	assert.Equal(t, "two", result["a"])
+	assert.Equal(t, map[string]interface{}{
+		"c": 1.0,
+		"d": []interface{}{"x", "y"},
+		"e": true,
+	}, result["b"])
+}
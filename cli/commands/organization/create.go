@@ -41,6 +41,13 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 			org := types.Organization{}
 			opts.Copy(&org)
 
+			labels, annotations, err := helpers.GetMetadataFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			org.Labels = labels
+			org.Annotations = annotations
+
 			if err := org.Validate(); err != nil {
 				if !isInteractive {
 					cmd.SilenceUsage = false
@@ -58,6 +65,7 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	}
 
 	cmd.Flags().StringP("description", "", "", "Description of organization")
+	helpers.AddMetadataFlags(cmd.Flags())
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
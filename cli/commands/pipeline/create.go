@@ -0,0 +1,83 @@
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/ghodss/yaml"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// CreateCommand adds command that allows user to create new pipelines
+func CreateCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "create",
+		Short:        "create new pipelines from file or stdin",
+		SilenceUsage: true,
+		Example:      "  sensuctl pipeline create -f alerts.yaml",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			pipeline, err := readPipeline(cli, cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := pipeline.Validate(); err != nil {
+				return err
+			}
+
+			if err := cli.Client.CreatePipeline(pipeline); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "OK")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("file", "f", "", "pipeline definition file, in JSON or YAML")
+
+	return cmd
+}
+
+// readPipeline reads the pipeline definition given by the file
+// flag, or stdin if not set. Its namespace defaults to the one of the CLI.
+func readPipeline(cli *cli.SensuCli, cmd *cobra.Command) (*types.Pipeline, error) {
+	var in io.Reader = os.Stdin
+	if filePath, _ := cmd.Flags().GetString("file"); filePath != "" {
+		f, err := os.Open(filePath)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = f.Close() }()
+		in = f
+	}
+
+	b, err := ioutil.ReadAll(in)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := &types.Pipeline{}
+	if err := yaml.Unmarshal(b, pipeline); err != nil {
+		return nil, fmt.Errorf("unable to parse the pipeline: %s", err)
+	}
+
+	if pipeline.Organization == "" {
+		pipeline.Organization = cli.Config.Organization()
+	}
+	if pipeline.Environment == "" {
+		pipeline.Environment = cli.Config.Environment()
+	}
+
+	return pipeline, nil
+}
@@ -0,0 +1,104 @@
+package pipeline
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const definition = `
+name: alerts
+workflows:
+- name: incidents
+  filters: [is_incident]
+  handler: slack
+- name: metrics
+  mutator: only_metrics
+  handler: influxdb
+`
+
+func writeDefinition(t *testing.T, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "pipeline")
+	require.NoError(t, err)
+	path := filepath.Join(dir, "alerts.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	return path, func() { _ = os.RemoveAll(dir) }
+}
+
+func TestCreateCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := CreateCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("create", cmd.Use)
+	assert.Regexp("pipelines", cmd.Short)
+}
+
+func TestCreateCommandRunEClosureWithFile(t *testing.T) {
+	assert := assert.New(t)
+
+	path, cleanup := writeDefinition(t, definition)
+	defer cleanup()
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreatePipeline", mock.AnythingOfType("*types.Pipeline")).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("file", path))
+	out, err := test.RunCmd(cmd, []string{})
+
+	require.NoError(t, err)
+	assert.Regexp("OK", out)
+
+	pipeline := client.Calls[0].Arguments.Get(0).(*types.Pipeline)
+	assert.Equal("alerts", pipeline.Name)
+	assert.Equal("default", pipeline.Organization)
+	assert.Len(pipeline.Workflows, 2)
+}
+
+func TestCreateCommandRunEClosureWithInvalidPipeline(t *testing.T) {
+	assert := assert.New(t)
+
+	path, cleanup := writeDefinition(t, definition+"- name: incomplete\n")
+	defer cleanup()
+
+	cli := test.NewMockCLI()
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("file", path))
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.Empty(out)
+	assert.Error(err)
+}
+
+func TestCreateCommandRunEClosureWithServerErr(t *testing.T) {
+	assert := assert.New(t)
+
+	path, cleanup := writeDefinition(t, definition)
+	defer cleanup()
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreatePipeline", mock.AnythingOfType("*types.Pipeline")).Return(errors.New("whoops"))
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("file", path))
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.Empty(out)
+	require.Error(t, err)
+	assert.Equal("whoops", err.Error())
+}
@@ -0,0 +1,81 @@
+package pipeline
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// ListCommand defines new list pipelines command
+func ListCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "list pipelines",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+			org := cli.Config.Organization()
+			if ok, _ := cmd.Flags().GetBool(flags.AllOrgs); ok {
+				org = "*"
+			}
+
+			// Fetch pipelines from the API
+			results, err := cli.Client.ListPipelines(org)
+			if err != nil {
+				return err
+			}
+
+			// Print the results based on the user preferences
+			return helpers.Print(cmd, cli.Config.Format(), printToTable, results)
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+	helpers.AddAllOrganization(cmd.Flags())
+
+	return cmd
+}
+
+func printToTable(results interface{}, writer io.Writer) {
+	table := table.New([]*table.Column{
+		{
+			Title:       "Name",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				pipeline, _ := data.(types.Pipeline)
+				return pipeline.Name
+			},
+		},
+		{
+			Title: "Workflows",
+			CellTransformer: func(data interface{}) string {
+				pipeline, _ := data.(types.Pipeline)
+				return strconv.Itoa(len(pipeline.Workflows))
+			},
+		},
+		{
+			Title: "Handlers",
+			CellTransformer: func(data interface{}) string {
+				pipeline, _ := data.(types.Pipeline)
+				handlers := make([]string, 0, len(pipeline.Workflows))
+				for _, workflow := range pipeline.Workflows {
+					handlers = append(handlers, workflow.Handler)
+				}
+				return strings.Join(handlers, ",")
+			},
+		},
+	})
+
+	table.Render(writer, results)
+}
@@ -0,0 +1,96 @@
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/list"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// ShowCommand defines new pipeline info command
+func ShowCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "info [NAME]",
+		Short:        "show detailed pipeline information",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			// Fetch pipeline from API
+			r, err := cli.Client.FetchPipeline(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Determine the format to use to output the data
+			var format string
+			if format = helpers.GetChangedStringValueFlag("format", cmd.Flags()); format == "" {
+				format = cli.Config.Format()
+			}
+
+			if format == "json" {
+				if err := helpers.PrintJSON(r, cmd.OutOrStdout()); err != nil {
+					return err
+				}
+			} else {
+				printPipelineToList(r, cmd.OutOrStdout())
+			}
+
+			return nil
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func printPipelineToList(r *types.Pipeline, writer io.Writer) {
+	cfg := &list.Config{
+		Title: r.Name,
+		Rows: []*list.Row{
+			{
+				Label: "Name",
+				Value: r.Name,
+			},
+			{
+				Label: "Workflows",
+				Value: formatWorkflows(r.Workflows),
+			},
+			{
+				Label: "Organization",
+				Value: r.Organization,
+			},
+			{
+				Label: "Environment",
+				Value: r.Environment,
+			},
+		},
+	}
+
+	list.Print(writer, cfg)
+}
+
+// formatWorkflows returns the workflows, one per line, as the chain of
+// filters, mutator and handler the events go through.
+func formatWorkflows(workflows []types.PipelineWorkflow) string {
+	lines := make([]string, 0, len(workflows))
+	for _, workflow := range workflows {
+		steps := append([]string{}, workflow.Filters...)
+		if workflow.Mutator != "" {
+			steps = append(steps, workflow.Mutator)
+		}
+		steps = append(steps, workflow.Handler)
+		lines = append(lines, fmt.Sprintf("%s: %s", workflow.Name, strings.Join(steps, " -> ")))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,45 @@
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/spf13/cobra"
+)
+
+// UpdateCommand adds command that allows user to replace pipelines
+func UpdateCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "update",
+		Short:        "update pipelines from file or stdin",
+		SilenceUsage: true,
+		Example:      "  sensuctl pipeline update -f alerts.yaml",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			pipeline, err := readPipeline(cli, cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := pipeline.Validate(); err != nil {
+				return err
+			}
+
+			if err := cli.Client.UpdatePipeline(pipeline); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "OK")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("file", "f", "", "pipeline definition file, in JSON or YAML")
+
+	return cmd
+}
@@ -87,6 +87,7 @@ func AddRuleCommand(cli *cli.SensuCli) *cobra.Command {
 	_ = cmd.Flags().BoolP("read", "r", false, "read permission")
 	_ = cmd.Flags().BoolP("update", "u", false, "update permission")
 	_ = cmd.Flags().BoolP("delete", "d", false, "delete permission")
+	_ = cmd.Flags().Bool("impersonate", false, "impersonate permission, for the users type")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
@@ -107,6 +108,9 @@ func (opts *ruleOpts) withFlags(flags *pflag.FlagSet) {
 	if delete, _ := flags.GetBool("delete"); delete {
 		opts.Permissions = append(opts.Permissions, "delete")
 	}
+	if impersonate, _ := flags.GetBool("impersonate"); impersonate {
+		opts.Permissions = append(opts.Permissions, "impersonate")
+	}
 
 	if org, _ := flags.GetString("organization"); org != "" {
 		opts.Org = org
@@ -154,7 +158,7 @@ func (opts *ruleOpts) administerQuestionnaire() error {
 			Name: "permissions",
 			Prompt: &survey.MultiSelect{
 				Message: "Permissions:",
-				Options: []string{"create", "read", "update", "delete"},
+				Options: []string{"create", "read", "update", "delete", "impersonate"},
 			},
 		},
 	}
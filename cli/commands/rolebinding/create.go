@@ -0,0 +1,55 @@
+package rolebinding
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// CreateCommand defines new command to create role bindings, which grant a
+// role to users and groups in the organization and environment of the CLI.
+func CreateCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "create [NAME]",
+		Short:        "create new role bindings",
+		SilenceUsage: true,
+		Example:      "  sensuctl role-binding create dev-operators --role operator --user alice --group ops",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			role, _ := cmd.Flags().GetString("role")
+			users, _ := cmd.Flags().GetStringSlice("user")
+			groups, _ := cmd.Flags().GetStringSlice("group")
+			binding := &types.RoleBinding{
+				Name:         args[0],
+				Role:         role,
+				Users:        users,
+				Groups:       groups,
+				Organization: cli.Config.Organization(),
+				Environment:  cli.Config.Environment(),
+			}
+			if err := binding.Validate(); err != nil {
+				return err
+			}
+
+			if err := cli.Client.CreateRoleBinding(binding); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "Created")
+			return nil
+		},
+	}
+
+	_ = cmd.Flags().StringP("role", "r", "", "name of the role granted")
+	_ = cmd.Flags().StringSliceP("user", "u", []string{}, "users granted the role")
+	_ = cmd.Flags().StringSliceP("group", "g", []string{}, "groups granted the role")
+
+	return cmd
+}
@@ -0,0 +1,86 @@
+package rolebinding
+
+import (
+	"errors"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := CreateCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("create", cmd.Use)
+	assert.Regexp("role bindings", cmd.Short)
+}
+
+func TestCreateCommandRunEClosureWithFlags(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateRoleBinding", mock.AnythingOfType("*types.RoleBinding")).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("role", "operator"))
+	require.NoError(t, cmd.Flags().Set("user", "alice"))
+	require.NoError(t, cmd.Flags().Set("user", "bob"))
+	out, err := test.RunCmd(cmd, []string{"dev-operators"})
+
+	require.NoError(t, err)
+	assert.Regexp("Created", out)
+
+	binding := client.Calls[0].Arguments.Get(0).(*types.RoleBinding)
+	assert.Equal("operator", binding.Role)
+	assert.Equal([]string{"alice", "bob"}, binding.Users)
+	assert.Equal("default", binding.Organization)
+	assert.Equal("default", binding.Environment)
+}
+
+func TestCreateCommandRunEClosureWithGroups(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateRoleBinding", mock.AnythingOfType("*types.RoleBinding")).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("role", "operator"))
+	require.NoError(t, cmd.Flags().Set("group", "ops"))
+	_, err := test.RunCmd(cmd, []string{"dev-operators"})
+	require.NoError(t, err)
+
+	binding := client.Calls[0].Arguments.Get(0).(*types.RoleBinding)
+	assert.Empty(t, binding.Users)
+	assert.Equal(t, []string{"ops"}, binding.Groups)
+}
+
+func TestCreateCommandRunEClosureWithoutUsers(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("role", "operator"))
+	_, err := test.RunCmd(cmd, []string{"dev-operators"})
+
+	assert.Error(t, err)
+}
+
+func TestCreateCommandRunEClosureWithServerErr(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateRoleBinding", mock.AnythingOfType("*types.RoleBinding")).Return(errors.New("oh noes"))
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("role", "operator"))
+	require.NoError(t, cmd.Flags().Set("user", "alice"))
+	_, err := test.RunCmd(cmd, []string{"dev-operators"})
+
+	assert.EqualError(t, err, "oh noes")
+}
@@ -0,0 +1,90 @@
+package rolebinding
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// ListCommand defines new list role bindings command
+func ListCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "list role bindings",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+			org := cli.Config.Organization()
+			if ok, _ := cmd.Flags().GetBool(flags.AllOrgs); ok {
+				org = "*"
+			}
+
+			// Fetch role bindings from the API
+			results, err := cli.Client.ListRoleBindings(org)
+			if err != nil {
+				return err
+			}
+
+			// Print the results based on the user preferences
+			return helpers.Print(cmd, cli.Config.Format(), printToTable, results)
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+	helpers.AddAllOrganization(cmd.Flags())
+
+	return cmd
+}
+
+func printToTable(results interface{}, writer io.Writer) {
+	table := table.New([]*table.Column{
+		{
+			Title:       "Name",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				binding, _ := data.(types.RoleBinding)
+				return binding.Name
+			},
+		},
+		{
+			Title: "Role",
+			CellTransformer: func(data interface{}) string {
+				binding, _ := data.(types.RoleBinding)
+				return binding.Role
+			},
+		},
+		{
+			Title: "Users",
+			CellTransformer: func(data interface{}) string {
+				binding, _ := data.(types.RoleBinding)
+				return strings.Join(binding.Users, ",")
+			},
+		},
+		{
+			Title: "Groups",
+			CellTransformer: func(data interface{}) string {
+				binding, _ := data.(types.RoleBinding)
+				return strings.Join(binding.Groups, ",")
+			},
+		},
+		{
+			Title: "Environment",
+			CellTransformer: func(data interface{}) string {
+				binding, _ := data.(types.RoleBinding)
+				return binding.Environment
+			},
+		},
+	})
+
+	table.Render(writer, results)
+}
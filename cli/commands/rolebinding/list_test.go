@@ -0,0 +1,29 @@
+package rolebinding
+
+import (
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCommandRunEClosure(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	config := cli.Config.(*client.MockConfig)
+	config.On("Format").Return("json")
+	client := cli.Client.(*client.MockClient)
+	client.On("ListRoleBindings", "default").Return([]types.RoleBinding{
+		*types.FixtureRoleBinding("dev-operators", "operator"),
+	}, nil)
+
+	cmd := ListCommand(cli)
+	out, err := test.RunCmd(cmd, []string{})
+
+	require.NoError(t, err)
+	assert.Contains(out, "dev-operators")
+}
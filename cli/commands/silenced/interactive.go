@@ -52,23 +52,29 @@ func (o *silencedOpts) Apply(s *types.Silenced) (err error) {
 }
 
 func (o *silencedOpts) withFlags(flags *pflag.FlagSet) (err error) {
-	o.Expire, err = flags.GetString("expire")
-	if err != nil {
+	if err = o.withSilenceFlags(flags); err != nil {
 		return err
 	}
-	o.ExpireOnResolve, err = flags.GetBool("expire-on-resolve")
+	o.Subscription, err = flags.GetString("subscription")
 	if err != nil {
 		return err
 	}
-	o.Reason, err = flags.GetString("reason")
+	o.Check, err = flags.GetString("check")
+	return err
+}
+
+// withSilenceFlags reads the flags shared by every command that creates a
+// silenced entry, leaving the subscription and check untouched.
+func (o *silencedOpts) withSilenceFlags(flags *pflag.FlagSet) (err error) {
+	o.Expire, err = flags.GetString("expire")
 	if err != nil {
 		return err
 	}
-	o.Subscription, err = flags.GetString("subscription")
+	o.ExpireOnResolve, err = flags.GetBool("expire-on-resolve")
 	if err != nil {
 		return err
 	}
-	o.Check, err = flags.GetString("check")
+	o.Reason, err = flags.GetString("reason")
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,98 @@
+package silenced
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// SilenceCommand is a convenience command that silences a subscription and/or
+// check given a silenced entry ID (e.g. entity:web01:check_cpu).
+func SilenceCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "silence [SUBSCRIPTION:CHECK]",
+		Short:        "silence a subscription and/or check",
+		SilenceUsage: true,
+		Example: `  sensuctl silence entity:web01:check_cpu --reason "rebooting"
+  sensuctl silence linux:* --expire 3600
+  sensuctl silence *:check_disk --expire-on-resolve`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			subscription, check, err := types.ParseSilencedID(args[0])
+			if err != nil {
+				return err
+			}
+
+			opts := newSilencedOpts()
+			if err := opts.withSilenceFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			opts.Subscription = subscription
+			opts.Check = check
+			opts.Org = cli.Config.Organization()
+			opts.Env = cli.Config.Environment()
+
+			var silenced types.Silenced
+			if err := opts.Apply(&silenced); err != nil {
+				return err
+			}
+			if err := silenced.Validate(); err != nil {
+				return err
+			}
+			if err := cli.Client.CreateSilenced(&silenced); err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), "OK")
+			return err
+		},
+	}
+
+	_ = cmd.Flags().StringP("reason", "r", "", "reason for the silenced entry")
+	_ = cmd.Flags().BoolP("expire-on-resolve", "x", false, "clear silenced entry on resolution")
+	_ = cmd.Flags().StringP("expire", "e", expireDefault, "expiry in seconds")
+	_ = cmd.Flags().StringP("begin", "b", beginDefault, "silence begin in human readable time (Format: Jan 02 2006 3:04PM MST)")
+
+	return cmd
+}
+
+// UnsilenceCommand is a convenience command that removes the silenced entry
+// with the given ID.
+func UnsilenceCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "unsilence [SUBSCRIPTION:CHECK]",
+		Short:        "remove a silenced entry for a subscription and/or check",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			subscription, check, err := types.ParseSilencedID(args[0])
+			if err != nil {
+				return err
+			}
+			id, err := types.SilencedID(subscription, check)
+			if err != nil {
+				return err
+			}
+
+			if err := cli.Client.DeleteSilenced(id); err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), "OK")
+			return err
+		},
+	}
+
+	return cmd
+}
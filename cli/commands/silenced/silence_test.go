@@ -0,0 +1,105 @@
+package silenced
+
+import (
+	"errors"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSilenceCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := SilenceCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("silence", cmd.Use)
+	assert.Regexp("silence", cmd.Short)
+}
+
+func TestSilenceCommandRunEClosureWithoutID(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := SilenceCommand(cli)
+	out, err := test.RunCmd(cmd, []string{})
+
+	require.Error(t, err)
+	assert.Regexp(t, "Usage", out)
+}
+
+func TestSilenceCommandRunEClosureWithInvalidID(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := SilenceCommand(cli)
+	_, err := test.RunCmd(cmd, []string{"check_cpu"})
+
+	require.Error(t, err)
+}
+
+func TestSilenceCommandRunEClosureWithFlags(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateSilenced", mock.MatchedBy(func(s *types.Silenced) bool {
+		return s.Subscription == "entity:web01" &&
+			s.Check == "check_cpu" &&
+			s.Reason == "rebooting" &&
+			s.Expire == 3600 &&
+			s.ExpireOnResolve
+	})).Return(nil)
+
+	cmd := SilenceCommand(cli)
+	require.NoError(t, cmd.Flags().Set("reason", "rebooting"))
+	require.NoError(t, cmd.Flags().Set("expire", "3600"))
+	require.NoError(t, cmd.Flags().Set("expire-on-resolve", "true"))
+	out, err := test.RunCmd(cmd, []string{"entity:web01:check_cpu"})
+
+	require.NoError(t, err)
+	assert.Regexp("OK", out)
+}
+
+func TestSilenceCommandRunEClosureWithServerErr(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateSilenced", mock.AnythingOfType("*types.Silenced")).Return(errors.New("whoops"))
+
+	cmd := SilenceCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"linux:*"})
+
+	require.Error(t, err)
+	assert.Equal(t, "whoops", err.Error())
+	assert.Empty(t, out)
+}
+
+func TestUnsilenceCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("DeleteSilenced", "entity:web01:check_cpu").Return(nil)
+
+	cmd := UnsilenceCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"entity:web01:check_cpu"})
+
+	require.NoError(t, err)
+	assert.Regexp("OK", out)
+}
+
+func TestUnsilenceCommandRunEClosureWithServerErr(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("DeleteSilenced", "linux:*").Return(errors.New("whoops"))
+
+	cmd := UnsilenceCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"linux:*"})
+
+	require.Error(t, err)
+	assert.Equal(t, "whoops", err.Error())
+	assert.Empty(t, out)
+}
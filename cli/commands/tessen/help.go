@@ -0,0 +1,24 @@
+package tessen
+
+import (
+	"github.com/sensu/sensu-go/cli"
+	"github.com/spf13/cobra"
+)
+
+// HelpCommand defines new parent
+func HelpCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tessen",
+		Short: "Manage the anonymous usage reporting",
+	}
+
+	// Add sub-commands
+	cmd.AddCommand(
+		InfoCommand(cli),
+		OptInCommand(cli),
+		OptOutCommand(cli),
+		PreviewCommand(cli),
+	)
+
+	return cmd
+}
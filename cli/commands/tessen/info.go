@@ -0,0 +1,67 @@
+package tessen
+
+import (
+	"errors"
+	"io"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/list"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// InfoCommand defines new tessen info command
+func InfoCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "info",
+		Short:        "show the anonymous usage reporting configuration",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			config, err := cli.Client.FetchTessenConfig()
+			if err != nil {
+				return err
+			}
+
+			// Determine the format to use to output the data
+			var format string
+			if format = helpers.GetChangedStringValueFlag("format", cmd.Flags()); format == "" {
+				format = cli.Config.Format()
+			}
+
+			if format == "json" {
+				return helpers.PrintJSON(config, cmd.OutOrStdout())
+			}
+			printConfigToList(config, cmd.OutOrStdout())
+			return nil
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func printConfigToList(config *types.TessenConfig, writer io.Writer) {
+	reporting := "disabled"
+	if config.OptIn {
+		reporting = "enabled"
+	}
+
+	cfg := &list.Config{
+		Title: "Tessen",
+		Rows: []*list.Row{
+			{
+				Label: "Reporting",
+				Value: reporting,
+			},
+		},
+	}
+
+	list.Print(writer, cfg)
+}
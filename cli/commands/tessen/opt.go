@@ -0,0 +1,41 @@
+package tessen
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// OptInCommand defines new tessen opt-in command
+func OptInCommand(cli *cli.SensuCli) *cobra.Command {
+	return optCommand(cli, true, "opt-in", "enable the anonymous usage reporting")
+}
+
+// OptOutCommand defines new tessen opt-out command
+func OptOutCommand(cli *cli.SensuCli) *cobra.Command {
+	return optCommand(cli, false, "opt-out", "disable the anonymous usage reporting")
+}
+
+func optCommand(cli *cli.SensuCli, optIn bool, use, short string) *cobra.Command {
+	return &cobra.Command{
+		Use:          use,
+		Short:        short,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			if err := cli.Client.UpdateTessenConfig(&types.TessenConfig{OptIn: optIn}); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "OK")
+			return nil
+		},
+	}
+}
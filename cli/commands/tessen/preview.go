@@ -0,0 +1,32 @@
+package tessen
+
+import (
+	"errors"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/spf13/cobra"
+)
+
+// PreviewCommand defines new tessen preview command
+func PreviewCommand(cli *cli.SensuCli) *cobra.Command {
+	return &cobra.Command{
+		Use:          "preview",
+		Short:        "show the data reported when opted in",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			data, err := cli.Client.PreviewTessenData()
+			if err != nil {
+				return err
+			}
+
+			// The data is always printed as JSON, exactly as it is reported
+			return helpers.PrintJSON(data, cmd.OutOrStdout())
+		},
+	}
+}
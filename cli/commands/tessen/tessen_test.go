@@ -0,0 +1,77 @@
+package tessen
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/cli"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHelpCommand(t *testing.T) {
+	cmd := HelpCommand(newConfiguredCLI())
+	assert.Equal(t, "tessen", cmd.Use)
+	assert.Len(t, cmd.Commands(), 4)
+}
+
+func TestInfoCommand(t *testing.T) {
+	cli := newConfiguredCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("FetchTessenConfig").Return(types.FixtureTessenConfig(true), nil)
+
+	cmd := InfoCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flags.Format, "none"))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Reporting")
+	assert.Contains(t, out, "enabled")
+
+	cmd = InfoCommand(cli)
+	out, err = test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, `"opt_in": true`)
+}
+
+func TestOptCommands(t *testing.T) {
+	cli := newConfiguredCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("UpdateTessenConfig", types.FixtureTessenConfig(true)).Return(nil)
+	client.On("UpdateTessenConfig", types.FixtureTessenConfig(false)).Return(errors.New("my-err"))
+
+	out, err := test.RunCmd(OptInCommand(cli), []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, "OK")
+
+	out, err = test.RunCmd(OptOutCommand(cli), []string{})
+	assert.EqualError(t, err, "my-err")
+	assert.Empty(t, out)
+
+	_, err = test.RunCmd(OptInCommand(cli), []string{"foo"})
+	assert.Error(t, err)
+}
+
+func TestPreviewCommand(t *testing.T) {
+	cli := newConfiguredCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("PreviewTessenData").Return(&types.TessenData{
+		ClusterID: "cafe",
+		Resources: map[string]int{types.RuleTypeCheck: 2},
+	}, nil)
+
+	out, err := test.RunCmd(PreviewCommand(cli), []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, `"cluster_id": "cafe"`)
+	assert.Contains(t, out, `"checks": 2`)
+}
+
+func newConfiguredCLI() *cli.SensuCli {
+	cli := test.NewMockCLI()
+	config := cli.Config.(*client.MockConfig)
+	config.On("Format").Return("json")
+	return cli
+}
@@ -0,0 +1,118 @@
+package undelete
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/commands/timeutil"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+const flagList = "list"
+
+// Command adds a command that restores a deleted check, handler or silenced
+// entry from its tombstone, or lists the tombstones.
+func Command(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "undelete [KIND NAME]",
+		Short:        "restore a recently deleted check, handler or silenced entry",
+		SilenceUsage: true,
+		Example: `  sensuctl undelete --list
+  sensuctl undelete check check_cpu
+  sensuctl undelete silenced entity:web01:check_cpu`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			list, _ := cmd.Flags().GetBool(flagList)
+			if list || len(args) == 0 {
+				if len(args) != 0 {
+					_ = cmd.Help()
+					return errors.New("invalid argument(s) received")
+				}
+				return listTombstones(cli, cmd)
+			}
+
+			if len(args) != 2 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			tombstone, err := cli.Client.RestoreTombstone(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "Restored %s %q\n", tombstone.Kind, tombstone.Name)
+			return err
+		},
+	}
+
+	cmd.Flags().Bool(flagList, false, "list the resources that can be restored")
+	helpers.AddFormatFlag(cmd.Flags())
+	helpers.AddAllOrganization(cmd.Flags())
+
+	return cmd
+}
+
+func listTombstones(cli *cli.SensuCli, cmd *cobra.Command) error {
+	org := cli.Config.Organization()
+	if ok, _ := cmd.Flags().GetBool(flags.AllOrgs); ok {
+		org = "*"
+	}
+
+	// Fetch tombstones from the API
+	results, err := cli.Client.ListTombstones(org)
+	if err != nil {
+		return err
+	}
+
+	// Print the results based on the user preferences
+	return helpers.Print(cmd, cli.Config.Format(), printToTable, results)
+}
+
+func printToTable(results interface{}, writer io.Writer) {
+	table := table.New([]*table.Column{
+		{
+			Title:       "Kind",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				tombstone, _ := data.(types.Tombstone)
+				return tombstone.Kind
+			},
+		},
+		{
+			Title: "Name",
+			CellTransformer: func(data interface{}) string {
+				tombstone, _ := data.(types.Tombstone)
+				return tombstone.Name
+			},
+		},
+		{
+			Title: "Deleted By",
+			CellTransformer: func(data interface{}) string {
+				tombstone, _ := data.(types.Tombstone)
+				return tombstone.DeletedBy
+			},
+		},
+		{
+			Title: "Deleted At",
+			CellTransformer: func(data interface{}) string {
+				tombstone, _ := data.(types.Tombstone)
+				return timeutil.HumanTimestamp(tombstone.DeletedAt)
+			},
+		},
+		{
+			Title: "Expires",
+			CellTransformer: func(data interface{}) string {
+				tombstone, _ := data.(types.Tombstone)
+				return timeutil.HumanTimestamp(tombstone.ExpireAt)
+			},
+		},
+	})
+
+	table.Render(writer, results)
+}
@@ -0,0 +1,88 @@
+package undelete
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/cli"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := newConfiguredCLI()
+	cmd := Command(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("undelete", cmd.Use)
+	assert.Regexp("restore", cmd.Short)
+}
+
+func TestCommandList(t *testing.T) {
+	assert := assert.New(t)
+	cli := newConfiguredCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("ListTombstones", "default").Return([]types.Tombstone{
+		*types.FixtureTombstone("check1"),
+		*types.FixtureTombstone("check2"),
+	}, nil)
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set(flags.Format, "none"))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+
+	assert.Contains(out, "Deleted By")
+	assert.Contains(out, "check1")
+	assert.Contains(out, "check2")
+}
+
+func TestCommandRestore(t *testing.T) {
+	assert := assert.New(t)
+	cli := newConfiguredCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("RestoreTombstone", "check", "check1").Return(types.FixtureTombstone("check1"), nil)
+
+	cmd := Command(cli)
+	out, err := test.RunCmd(cmd, []string{"check", "check1"})
+	require.NoError(t, err)
+
+	assert.Contains(out, "Restored check \"check1\"")
+}
+
+func TestCommandRestoreWithErr(t *testing.T) {
+	assert := assert.New(t)
+	cli := newConfiguredCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("RestoreTombstone", "check", "check1").Return((*types.Tombstone)(nil), errors.New("my-err"))
+
+	cmd := Command(cli)
+	out, err := test.RunCmd(cmd, []string{"check", "check1"})
+
+	assert.NotNil(err)
+	assert.Equal("my-err", err.Error())
+	assert.Empty(out)
+}
+
+func TestCommandInvalidArgs(t *testing.T) {
+	cli := newConfiguredCLI()
+	cmd := Command(cli)
+
+	_, err := test.RunCmd(cmd, []string{"check"})
+	assert.Error(t, err)
+}
+
+func newConfiguredCLI() *cli.SensuCli {
+	cli := test.NewMockCLI()
+	config := cli.Config.(*client.MockConfig)
+	config.On("Format").Return("json")
+	config.On("Organization").Return("default")
+	return cli
+}
@@ -0,0 +1,113 @@
+package usage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// ResourcesCommand defines new command listing the resource usage
+func ResourcesCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "resources",
+		Short:        "list the checks and handlers using the most resources",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			limit, _ := cmd.Flags().GetInt("limit")
+			sortBy, _ := cmd.Flags().GetString("sort")
+			kind, _ := cmd.Flags().GetString("kind")
+
+			// Fetch resource usage from API
+			results, err := cli.Client.ListResourceUsage(limit, sortBy, kind)
+			if err != nil {
+				return err
+			}
+
+			// Print the results based on the user preferences
+			return helpers.Print(cmd, cli.Config.Format(), printResourcesToTable, results)
+		},
+	}
+
+	cmd.Flags().Int("limit", 10, "maximum number of checks and handlers to list, 0 lists them all")
+	cmd.Flags().String("sort", "cpu", "sort by CPU time (cpu), duration (wall) or maximum resident set size (rss)")
+	cmd.Flags().String("kind", "", "only list the checks (check) or the handlers (handler)")
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func printResourcesToTable(results interface{}, writer io.Writer) {
+	table := table.New([]*table.Column{
+		{
+			Title:       "Organization",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				usage, _ := data.(types.ResourceUsage)
+				return usage.Organization
+			},
+		},
+		{
+			Title: "Environment",
+			CellTransformer: func(data interface{}) string {
+				usage, _ := data.(types.ResourceUsage)
+				return usage.Environment
+			},
+		},
+		{
+			Title: "Kind",
+			CellTransformer: func(data interface{}) string {
+				usage, _ := data.(types.ResourceUsage)
+				return usage.Kind
+			},
+		},
+		{
+			Title: "Name",
+			CellTransformer: func(data interface{}) string {
+				usage, _ := data.(types.ResourceUsage)
+				return usage.Name
+			},
+		},
+		{
+			Title: "Executions",
+			CellTransformer: func(data interface{}) string {
+				usage, _ := data.(types.ResourceUsage)
+				return strconv.FormatUint(usage.Executions, 10)
+			},
+		},
+		{
+			Title: "CPU (s)",
+			CellTransformer: func(data interface{}) string {
+				usage, _ := data.(types.ResourceUsage)
+				return fmt.Sprintf("%.2f", usage.CPUTime)
+			},
+		},
+		{
+			Title: "Wall (s)",
+			CellTransformer: func(data interface{}) string {
+				usage, _ := data.(types.ResourceUsage)
+				return fmt.Sprintf("%.2f", usage.WallTime)
+			},
+		},
+		{
+			Title: "Max RSS",
+			CellTransformer: func(data interface{}) string {
+				usage, _ := data.(types.ResourceUsage)
+				return strconv.FormatUint(usage.MaxRSS, 10)
+			},
+		},
+	})
+
+	table.Render(writer, results)
+}
@@ -0,0 +1,61 @@
+package usage
+
+import (
+	"errors"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourcesCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := newConfiguredCLI()
+	cmd := ResourcesCommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("resources", cmd.Use)
+	assert.Regexp("handlers", cmd.Short)
+}
+
+func TestResourcesCommandRunEClosure(t *testing.T) {
+	assert := assert.New(t)
+	cli := newConfiguredCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("ListResourceUsage", 5, "rss", "handler").Return([]types.ResourceUsage{
+		*types.FixtureResourceUsage("handler", "handler1", 10),
+		*types.FixtureResourceUsage("handler", "handler2", 5),
+	}, nil)
+
+	cmd := ResourcesCommand(cli)
+	require.NoError(t, cmd.Flags().Set("limit", "5"))
+	require.NoError(t, cmd.Flags().Set("sort", "rss"))
+	require.NoError(t, cmd.Flags().Set("kind", "handler"))
+	require.NoError(t, cmd.Flags().Set(flags.Format, "none"))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+
+	assert.Contains(out, "Max RSS")
+	assert.Contains(out, "handler1")
+	assert.Contains(out, "handler2")
+}
+
+func TestResourcesCommandRunEClosureWithErr(t *testing.T) {
+	assert := assert.New(t)
+	cli := newConfiguredCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("ListResourceUsage", 10, "cpu", "").Return([]types.ResourceUsage{}, errors.New("my-err"))
+
+	cmd := ResourcesCommand(cli)
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.NotNil(err)
+	assert.Equal("my-err", err.Error())
+	assert.Empty(out)
+}
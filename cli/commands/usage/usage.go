@@ -0,0 +1,99 @@
+package usage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// Command defines new usage command
+func Command(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "usage",
+		Short:        "list the checks with the highest event throughput",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			limit, _ := cmd.Flags().GetInt("limit")
+			sortBy, _ := cmd.Flags().GetString("sort")
+
+			// Fetch usage from API
+			results, err := cli.Client.ListUsage(limit, sortBy)
+			if err != nil {
+				return err
+			}
+
+			// Print the results based on the user preferences
+			return helpers.Print(cmd, cli.Config.Format(), printToTable, results)
+		},
+	}
+
+	cmd.Flags().Int("limit", 10, "maximum number of checks to list, 0 lists them all")
+	cmd.Flags().String("sort", "events", "sort checks by number of events (events), size of events (bytes) or events per second (rate)")
+	helpers.AddFormatFlag(cmd.Flags())
+
+	cmd.AddCommand(ResourcesCommand(cli))
+
+	return cmd
+}
+
+func printToTable(results interface{}, writer io.Writer) {
+	table := table.New([]*table.Column{
+		{
+			Title:       "Organization",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				usage, _ := data.(types.Usage)
+				return usage.Organization
+			},
+		},
+		{
+			Title: "Environment",
+			CellTransformer: func(data interface{}) string {
+				usage, _ := data.(types.Usage)
+				return usage.Environment
+			},
+		},
+		{
+			Title: "Check",
+			CellTransformer: func(data interface{}) string {
+				usage, _ := data.(types.Usage)
+				return usage.Check
+			},
+		},
+		{
+			Title: "Events",
+			CellTransformer: func(data interface{}) string {
+				usage, _ := data.(types.Usage)
+				return strconv.FormatUint(usage.Events, 10)
+			},
+		},
+		{
+			Title: "Bytes",
+			CellTransformer: func(data interface{}) string {
+				usage, _ := data.(types.Usage)
+				return strconv.FormatUint(usage.Bytes, 10)
+			},
+		},
+		{
+			Title: "Events/s",
+			CellTransformer: func(data interface{}) string {
+				usage, _ := data.(types.Usage)
+				return fmt.Sprintf("%.2f", usage.EventsPerSecond)
+			},
+		},
+	})
+
+	table.Render(writer, results)
+}
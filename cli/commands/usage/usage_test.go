@@ -0,0 +1,68 @@
+package usage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/cli"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := newConfiguredCLI()
+	cmd := Command(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("usage", cmd.Use)
+	assert.Regexp("throughput", cmd.Short)
+}
+
+func TestCommandRunEClosure(t *testing.T) {
+	assert := assert.New(t)
+	cli := newConfiguredCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("ListUsage", 5, "bytes").Return([]types.Usage{
+		*types.FixtureUsage("check1", 10),
+		*types.FixtureUsage("check2", 5),
+	}, nil)
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("limit", "5"))
+	require.NoError(t, cmd.Flags().Set("sort", "bytes"))
+	require.NoError(t, cmd.Flags().Set(flags.Format, "none"))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+
+	assert.Contains(out, "Events/s")
+	assert.Contains(out, "check1")
+	assert.Contains(out, "check2")
+}
+
+func TestCommandRunEClosureWithErr(t *testing.T) {
+	assert := assert.New(t)
+	cli := newConfiguredCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("ListUsage", 10, "events").Return([]types.Usage{}, errors.New("my-err"))
+
+	cmd := Command(cli)
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.NotNil(err)
+	assert.Equal("my-err", err.Error())
+	assert.Empty(out)
+}
+
+func newConfiguredCLI() *cli.SensuCli {
+	cli := test.NewMockCLI()
+	config := cli.Config.(*client.MockConfig)
+	config.On("Format").Return("json")
+	return cli
+}
@@ -17,6 +17,7 @@ type createOpts struct {
 	Username string `survey:"username"`
 	Password string `survey:"password"`
 	Roles    string `survey:"roles"`
+	Groups   string `survey:"groups"`
 	Admin    bool
 }
 
@@ -75,6 +76,7 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	_ = cmd.Flags().StringP("password", "p", "", "Password")
 	_ = cmd.Flags().Bool("admin", false, "Give user the administrator role")
 	_ = cmd.Flags().StringP("roles", "r", "", "Comma separated list of roles to assign")
+	_ = cmd.Flags().StringP("groups", "g", "", "Comma separated list of groups of the user")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
@@ -83,6 +85,7 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 func (opts *createOpts) withFlags(flags *pflag.FlagSet) {
 	opts.Password, _ = flags.GetString("password")
 	opts.Roles, _ = flags.GetString("roles")
+	opts.Groups, _ = flags.GetString("groups")
 
 	if isAdmin, _ := flags.GetBool("admin"); isAdmin {
 		opts.Admin = isAdmin
@@ -112,6 +115,12 @@ func (opts *createOpts) administerQuestionnaire() error {
 				Message: "Roles:",
 			},
 		},
+		{
+			Name: "groups",
+			Prompt: &survey.Input{
+				Message: "Groups:",
+			},
+		},
 	}
 
 	return survey.Ask(qs, opts)
@@ -128,5 +137,6 @@ func (opts *createOpts) toUser() *types.User {
 		Username: opts.Username,
 		Password: opts.Password,
 		Roles:    roles,
+		Groups:   helpers.SafeSplitCSV(opts.Groups),
 	}
 }
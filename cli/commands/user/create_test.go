@@ -6,6 +6,7 @@ import (
 
 	clientmock "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -39,6 +40,24 @@ func TestListCommandRunEClosureWithArgs(t *testing.T) {
 	assert.NoError(err)
 }
 
+func TestCreateCommandRunEClosureWithGroups(t *testing.T) {
+	assert := assert.New(t)
+	cli := test.NewMockCLI()
+
+	client := cli.Client.(*clientmock.MockClient)
+	client.On("CreateUser", mock.AnythingOfType("*types.User")).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("password", "b0b"))
+	require.NoError(t, cmd.Flags().Set("groups", "ops,sre"))
+
+	_, err := test.RunCmd(cmd, []string{"bob"})
+	require.NoError(t, err)
+
+	user := client.Calls[0].Arguments.Get(0).(*types.User)
+	assert.Equal([]string{"ops", "sre"}, user.Groups)
+}
+
 func TestListCommandRunEClosureServerErr(t *testing.T) {
 	assert := assert.New(t)
 	cli := test.NewMockCLI()
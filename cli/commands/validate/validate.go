@@ -0,0 +1,143 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/hooks"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/spf13/cobra"
+)
+
+const flagFile = "file"
+
+// manifestExtensions are the extensions of the manifests read from a
+// directory.
+var manifestExtensions = []string{".json", ".yaml", ".yml"}
+
+// Command adds a command that validates the resources of manifests like the
+// API would, without contacting a backend, e.g. in pre-commit hooks or CI
+// pipelines.
+func Command(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "validate",
+		Short:        "validate the resources of manifests without contacting a backend",
+		SilenceUsage: true,
+		Example: `  sensuctl validate -f manifest.yaml
+  sensuctl validate -f manifests/
+  cat manifest.json | sensuctl validate -f -`,
+		Annotations: map[string]string{
+			hooks.ConfigurationRequirement: hooks.ConfigurationNotRequired,
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			file, _ := cmd.Flags().GetString(flagFile)
+			if file == "" {
+				_ = cmd.Help()
+				return errors.New("must specify --file")
+			}
+
+			paths, err := manifestPaths(file)
+			if err != nil {
+				return err
+			}
+
+			org, env := cli.Config.Organization(), cli.Config.Environment()
+			var total, invalid int
+			for _, path := range paths {
+				n, errs := validateManifest(cli, path, org, env)
+				total += n
+				invalid += len(errs)
+				for _, err := range errs {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s: %s\n", path, err)
+				}
+			}
+
+			if invalid > 0 {
+				return fmt.Errorf("%d of %d resources are invalid", invalid, total)
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "OK")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flagFile, "f", "", "path to a manifest, a directory of manifests, or - for STDIN")
+
+	return cmd
+}
+
+// manifestPaths returns the path of the manifest, or the paths of the
+// manifests found in the directory and its subdirectories, in lexical order.
+func manifestPaths(path string) ([]string, error) {
+	if path == "-" {
+		return []string{path}, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var paths []string
+	err = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		ext := strings.ToLower(filepath.Ext(p))
+		for _, e := range manifestExtensions {
+			if ext == e {
+				paths = append(paths, p)
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no manifests found in %s", path)
+	}
+	return paths, nil
+}
+
+// validateManifest validates the resources of the manifest at path, and
+// returns how many it contains along with an error for each invalid one. A
+// manifest which can't be read counts as a single invalid resource.
+func validateManifest(cli *cli.SensuCli, path, org, env string) (int, []error) {
+	var r io.Reader
+	if path == "-" {
+		r = cli.InFile
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return 1, []error{err}
+		}
+		defer func() { _ = f.Close() }()
+		r = f
+	}
+
+	wrappers, err := resource.Parse(r)
+	if err != nil {
+		return 1, []error{err}
+	}
+
+	return len(wrappers), resource.Validate(wrappers, org, env)
+}
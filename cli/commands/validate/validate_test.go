@@ -0,0 +1,116 @@
+package validate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validManifest = `
+type: CheckConfig
+value:
+  name: check_cpu
+  command: check-cpu.rb
+  interval: 60
+  subscriptions: [linux]
+`
+
+const invalidManifest = `
+{"type": "Handler", "value": {"name": "slack", "type": "carrier-pigeon"}}
+{"type": "Handler", "value": {"name": "pagerduty", "type": "pipe", "command": "handler-pagerduty"}}
+`
+
+// writeManifests writes the given manifests, by file name, to a new
+// directory and returns its path.
+func writeManifests(t *testing.T, manifests map[string]string) string {
+	dir, err := ioutil.TempDir("", "manifests")
+	require.NoError(t, err)
+	for name, manifest := range manifests {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(manifest), 0644))
+	}
+	return dir
+}
+
+func TestCommand(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := Command(cli)
+
+	assert.NotNil(t, cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp(t, "validate", cmd.Use)
+	assert.Regexp(t, "without contacting a backend", cmd.Short)
+}
+
+func TestCommandRunEClosureWithoutFlags(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := Command(cli)
+	out, err := test.RunCmd(cmd, []string{})
+
+	require.Error(t, err)
+	assert.Regexp(t, "Usage", out)
+}
+
+func TestCommandRunEClosureWithValidFile(t *testing.T) {
+	dir := writeManifests(t, map[string]string{"check.yaml": validManifest})
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	cli := test.NewMockCLI()
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", filepath.Join(dir, "check.yaml")))
+	out, err := test.RunCmd(cmd, []string{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "OK\n", out)
+}
+
+func TestCommandRunEClosureWithDirectory(t *testing.T) {
+	dir := writeManifests(t, map[string]string{
+		"checks/cpu.yaml":      validManifest,
+		"handlers/slack.json":  invalidManifest,
+		"handlers/broken.yml":  "type: Handler\nvalue: [",
+		"README.md":            "not a manifest",
+		"checks/disk.yaml.bak": "not a manifest either",
+	})
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	cli := test.NewMockCLI()
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", dir))
+	out, err := test.RunCmd(cmd, []string{})
+
+	require.Error(t, err)
+	assert.Equal(t, "2 of 4 resources are invalid", err.Error())
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 2)
+	assert.Regexp(t, `handlers/broken\.yml: `, lines[0])
+	assert.Regexp(t, `handlers/slack\.json: resource #1 \(Handler\): .*type`, lines[1])
+}
+
+func TestCommandRunEClosureWithEmptyDirectory(t *testing.T) {
+	dir := writeManifests(t, map[string]string{"README.md": "nothing to see"})
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	cli := test.NewMockCLI()
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", dir))
+	_, err := test.RunCmd(cmd, []string{})
+
+	require.Error(t, err)
+	assert.Regexp(t, "no manifests found", err.Error())
+}
+
+func TestCommandRunEClosureWithMissingFile(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", "/nonexistent/manifest.yaml"))
+	_, err := test.RunCmd(cmd, []string{})
+
+	assert.Error(t, err)
+}
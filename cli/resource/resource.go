@@ -0,0 +1,164 @@
+// Package resource provides utilities for reading sensuctl resource manifests.
+//
+// A manifest is a series of resources, each wrapped with its type:
+//
+//	{"type": "CheckConfig", "value": {"name": "check_cpu", ...}}
+//
+// Manifests may be written as a stream of JSON objects or as YAML documents
+// separated by "---".
+package resource
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/sensu/sensu-go/types"
+)
+
+// Wrapper is a single manifest entry, associating a resource value with the
+// name of its type.
+type Wrapper struct {
+	// Type is the name of the resource type, e.g. CheckConfig.
+	Type string `json:"type"`
+
+	// Value is the serialized resource.
+	Value json.RawMessage `json:"value"`
+}
+
+var yamlSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+// Parse reads every resource wrapper contained in r.
+func Parse(r io.Reader) ([]Wrapper, error) {
+	b, err := ioutil.ReadAll(bufio.NewReader(r))
+	if err != nil {
+		return nil, err
+	}
+
+	trimmed := bytes.TrimSpace(b)
+	if len(trimmed) == 0 {
+		return nil, errors.New("no resources found")
+	}
+
+	if trimmed[0] == '{' || trimmed[0] == '[' {
+		return parseJSON(trimmed)
+	}
+	return parseYAML(trimmed)
+}
+
+func parseJSON(b []byte) ([]Wrapper, error) {
+	var wrappers []Wrapper
+	dec := json.NewDecoder(bytes.NewReader(b))
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		// A single JSON array of resources is also accepted
+		if bytes.HasPrefix(bytes.TrimSpace(raw), []byte("[")) {
+			var list []Wrapper
+			if err := json.Unmarshal(raw, &list); err != nil {
+				return nil, err
+			}
+			wrappers = append(wrappers, list...)
+			continue
+		}
+
+		var w Wrapper
+		if err := json.Unmarshal(raw, &w); err != nil {
+			return nil, err
+		}
+		wrappers = append(wrappers, w)
+	}
+	return wrappers, validateWrappers(wrappers)
+}
+
+func parseYAML(b []byte) ([]Wrapper, error) {
+	var wrappers []Wrapper
+	for _, doc := range yamlSeparator.Split(string(b), -1) {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		var w Wrapper
+		if err := yaml.Unmarshal([]byte(doc), &w); err != nil {
+			return nil, err
+		}
+		wrappers = append(wrappers, w)
+	}
+	return wrappers, validateWrappers(wrappers)
+}
+
+func validateWrappers(wrappers []Wrapper) error {
+	for i, w := range wrappers {
+		if w.Type == "" {
+			return fmt.Errorf("resource #%d is missing its type", i+1)
+		}
+		if len(w.Value) == 0 {
+			return fmt.Errorf("resource #%d (%s) is missing its value", i+1, w.Type)
+		}
+	}
+	return nil
+}
+
+// Resolve returns a pointer to a new, zero value of the named resource type.
+// Type names are case-insensitive and common aliases (e.g. check, filter) are
+// accepted.
+func Resolve(typeName string) (interface{}, error) {
+	switch strings.ToLower(typeName) {
+	case "asset":
+		return &types.Asset{}, nil
+	case "check", "checkconfig":
+		return &types.CheckConfig{}, nil
+	case "checktemplate":
+		return &types.CheckTemplate{}, nil
+	case "entity":
+		return &types.Entity{}, nil
+	case "entitygroup":
+		return &types.EntityGroup{}, nil
+	case "environment":
+		return &types.Environment{}, nil
+	case "filter", "eventfilter":
+		return &types.EventFilter{}, nil
+	case "handler":
+		return &types.Handler{}, nil
+	case "hook", "hookconfig":
+		return &types.HookConfig{}, nil
+	case "mutator":
+		return &types.Mutator{}, nil
+	case "organization":
+		return &types.Organization{}, nil
+	case "pipeline":
+		return &types.Pipeline{}, nil
+	case "role":
+		return &types.Role{}, nil
+	case "rolebinding":
+		return &types.RoleBinding{}, nil
+	case "silenced":
+		return &types.Silenced{}, nil
+	case "user":
+		return &types.User{}, nil
+	}
+	return nil, fmt.Errorf("unknown resource type %q", typeName)
+}
+
+// Decode resolves the wrapper's type and unmarshals its value into it.
+func (w Wrapper) Decode() (interface{}, error) {
+	v, err := Resolve(w.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(w.Value, v); err != nil {
+		return nil, fmt.Errorf("could not decode %s: %s", w.Type, err)
+	}
+	return v, nil
+}
@@ -0,0 +1,97 @@
+package resource
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJSON(t *testing.T) {
+	input := `
+{"type": "CheckConfig", "value": {"name": "check_cpu", "command": "check-cpu.rb"}}
+{"type": "handler", "value": {"name": "slack", "type": "pipe"}}
+`
+	wrappers, err := Parse(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, wrappers, 2)
+	assert.Equal(t, "CheckConfig", wrappers[0].Type)
+	assert.Equal(t, "handler", wrappers[1].Type)
+}
+
+func TestParseJSONArray(t *testing.T) {
+	input := `[{"type": "CheckConfig", "value": {"name": "check_cpu"}}, {"type": "Hook", "value": {"name": "ps"}}]`
+	wrappers, err := Parse(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, wrappers, 2)
+}
+
+func TestParseYAML(t *testing.T) {
+	input := `
+type: CheckConfig
+value:
+  name: check_cpu
+  command: check-cpu.rb
+  interval: 60
+---
+type: Silenced
+value:
+  subscription: linux
+  check: check_cpu
+`
+	wrappers, err := Parse(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, wrappers, 2)
+
+	v, err := wrappers[0].Decode()
+	require.NoError(t, err)
+	check, ok := v.(*types.CheckConfig)
+	require.True(t, ok)
+	assert.Equal(t, "check_cpu", check.Name)
+	assert.Equal(t, uint32(60), check.Interval)
+
+	v, err = wrappers[1].Decode()
+	require.NoError(t, err)
+	assert.Equal(t, "linux", v.(*types.Silenced).Subscription)
+}
+
+func TestParseErrors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+	}{
+		{"empty", "   "},
+		{"missing type", `{"value": {"name": "foo"}}`},
+		{"missing value", `{"type": "CheckConfig"}`},
+		{"bad json", `{"type": `},
+		{"bad yaml", "type: [CheckConfig\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tc.input))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	v, err := Resolve("checkconfig")
+	require.NoError(t, err)
+	assert.IsType(t, &types.CheckConfig{}, v)
+
+	v, err = Resolve("EventFilter")
+	require.NoError(t, err)
+	assert.IsType(t, &types.EventFilter{}, v)
+
+	_, err = Resolve("nope")
+	assert.Error(t, err)
+}
+
+func TestDecodeUnknownType(t *testing.T) {
+	w := Wrapper{Type: "nope", Value: []byte(`{}`)}
+	_, err := w.Decode()
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Requirement is a single key/value constraint of a Selector.
+type Requirement struct {
+	Key    string
+	Value  string
+	Negate bool
+}
+
+// Selector matches resources against a set of key/value requirements. Keys
+// refer to top-level attributes of the serialized resource, which includes
+// custom attributes.
+type Selector []Requirement
+
+// ParseSelector parses a comma separated list of key=value or key!=value
+// requirements.
+func ParseSelector(s string) (Selector, error) {
+	var selector Selector
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		var req Requirement
+		if i := strings.Index(part, "!="); i > 0 {
+			req = Requirement{Key: part[:i], Value: part[i+2:], Negate: true}
+		} else if i := strings.Index(part, "="); i > 0 {
+			req = Requirement{Key: part[:i], Value: part[i+1:]}
+		} else {
+			return nil, fmt.Errorf("invalid selector requirement %q, expected key=value", part)
+		}
+
+		req.Key = strings.TrimSpace(req.Key)
+		req.Value = strings.TrimSpace(req.Value)
+		selector = append(selector, req)
+	}
+
+	if len(selector) == 0 {
+		return nil, fmt.Errorf("invalid selector %q", s)
+	}
+	return selector, nil
+}
+
+// Matches returns true if the given resource satisfies every requirement of
+// the selector.
+func (s Selector) Matches(v interface{}) (bool, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return false, err
+	}
+
+	var attrs map[string]interface{}
+	if err := json.Unmarshal(b, &attrs); err != nil {
+		return false, err
+	}
+
+	for _, req := range s {
+		value, ok := attrs[req.Key]
+		equal := ok && value != nil && fmt.Sprint(value) == req.Value
+		if equal == req.Negate {
+			return false, nil
+		}
+	}
+	return true, nil
+}
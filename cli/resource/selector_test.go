@@ -0,0 +1,54 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSelector(t *testing.T) {
+	selector, err := ParseSelector("team=ops, env!=prod")
+	require.NoError(t, err)
+	assert.Equal(t, Selector{
+		{Key: "team", Value: "ops"},
+		{Key: "env", Value: "prod", Negate: true},
+	}, selector)
+
+	_, err = ParseSelector("team")
+	assert.Error(t, err)
+
+	_, err = ParseSelector(" , ")
+	assert.Error(t, err)
+}
+
+func TestSelectorMatches(t *testing.T) {
+	check := types.FixtureCheckConfig("check_cpu")
+	check.ExtendedAttributes = []byte(`{"team":"ops"}`)
+
+	testCases := []struct {
+		selector string
+		expected bool
+	}{
+		{"team=ops", true},
+		{"team=dev", false},
+		{"team!=dev", true},
+		{"team!=ops", false},
+		{"name=check_cpu,team=ops", true},
+		{"name=check_mem,team=ops", false},
+		{"interval=60", true},
+		{"missing=value", false},
+		{"missing!=value", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.selector, func(t *testing.T) {
+			selector, err := ParseSelector(tc.selector)
+			require.NoError(t, err)
+			matches, err := selector.Matches(check)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, matches)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package resource
+
+import (
+	"fmt"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// Validate decodes the resources of a manifest and runs the same validation as
+// the API against them, without contacting a backend. The resources which
+// don't specify their organization or environment are validated within org
+// and env, in which the API would create them. An error is returned for each
+// invalid resource.
+func Validate(wrappers []Wrapper, org, env string) []error {
+	var errs []error
+	for i, w := range wrappers {
+		if err := validate(w, org, env); err != nil {
+			errs = append(errs, fmt.Errorf("resource #%d (%s): %s", i+1, w.Type, err))
+		}
+	}
+	return errs
+}
+
+func validate(w Wrapper, org, env string) error {
+	v, err := w.Decode()
+	if err != nil {
+		return err
+	}
+
+	if r, ok := v.(types.Resource); ok {
+		resourceOrg, resourceEnv := r.GetOrganization(), r.GetEnvironment()
+		if resourceOrg == "" {
+			resourceOrg = org
+		}
+		if resourceEnv == "" {
+			resourceEnv = env
+		}
+		r.SetNamespace(resourceOrg, resourceEnv)
+	}
+
+	if r, ok := v.(interface{ Validate() error }); ok {
+		return r.Validate()
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package resource
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	input := `
+type: CheckConfig
+value:
+  name: check_cpu
+  command: check-cpu.rb
+  interval: 60
+  subscriptions: [linux]
+---
+type: CheckConfig
+value:
+  name: check cpu
+  command: check-cpu.rb
+  interval: 60
+---
+type: Handler
+value:
+  name: slack
+  type: pipe
+  command: handler-slack
+  organization: acme
+  environment: prod
+---
+type: Nope
+value:
+  name: nope
+`
+	wrappers, err := Parse(strings.NewReader(input))
+	require.NoError(t, err)
+
+	errs := Validate(wrappers, "default", "default")
+	require.Len(t, errs, 2)
+	assert.Regexp(t, `^resource #2 \(CheckConfig\): .*name`, errs[0].Error())
+	assert.Regexp(t, `^resource #4 \(Nope\): unknown resource type`, errs[1].Error())
+
+	// The resources are validated within the given namespace unless they
+	// specify theirs
+	assert.Len(t, Validate(wrappers[:1], "", ""), 1)
+	assert.Empty(t, Validate(wrappers[2:3], "", ""))
+}
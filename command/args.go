@@ -0,0 +1,78 @@
+package command
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// SplitArgs splits a command into its arguments on whitespace. Single and
+// double quotes group arguments, and a backslash escapes the next character
+// outside of single quotes. No other shell expansion is performed.
+func SplitArgs(command string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, r := range command {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if escaped {
+		return nil, errors.New("unterminated escape in command")
+	}
+	if quote != 0 {
+		return nil, errors.New("unterminated quote in command")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
+
+// shellArgs returns the arguments with which the given shell executes a
+// command passed as the following argument.
+func shellArgs(shell string) []string {
+	name := strings.ToLower(filepath.Base(shell))
+	name = strings.TrimSuffix(name, ".exe")
+
+	switch name {
+	case "cmd":
+		return []string{"/c"}
+	case "powershell", "pwsh":
+		return []string{"-NoProfile", "-NonInteractive", "-Command"}
+	default:
+		return []string{"-c"}
+	}
+}
@@ -0,0 +1,118 @@
+package command
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitArgs(t *testing.T) {
+	testCases := []struct {
+		name    string
+		command string
+		args    []string
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			command: "  ",
+		},
+		{
+			name:    "whitespace",
+			command: " check-disk  -w 80\t-c 90 ",
+			args:    []string{"check-disk", "-w", "80", "-c", "90"},
+		},
+		{
+			name:    "quotes",
+			command: `check-http --url "http://localhost/a b" --match 'it''s "ok"'`,
+			args:    []string{"check-http", "--url", "http://localhost/a b", "--match", `its "ok"`},
+		},
+		{
+			name:    "empty quotes",
+			command: `echo "" ''`,
+			args:    []string{"echo", "", ""},
+		},
+		{
+			name:    "escapes",
+			command: `echo a\ b "c\"d" 'e\f'`,
+			args:    []string{"echo", "a b", `c"d`, `e\f`},
+		},
+		{
+			name:    "no shell expansion",
+			command: "echo $HOME | grep *",
+			args:    []string{"echo", "$HOME", "|", "grep", "*"},
+		},
+		{
+			name:    "unterminated quote",
+			command: `echo "foo`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated escape",
+			command: `echo foo\`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args, err := SplitArgs(tc.command)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.args, args)
+		})
+	}
+}
+
+func TestExecutionCommand(t *testing.T) {
+	ex := &Execution{Command: "echo 'foo bar'", Direct: true}
+	cmd, err := ex.command(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"echo", "foo bar"}, cmd.Args)
+
+	ex = &Execution{Command: "", Direct: true}
+	_, err = ex.command(context.Background())
+	assert.Error(t, err)
+
+	ex = &Execution{Command: "echo $HOME", Shell: "/bin/bash"}
+	cmd, err = ex.command(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/bin/bash", "-c", "echo $HOME"}, cmd.Args)
+
+	ex = &Execution{Command: "Get-Date", Shell: "powershell.exe"}
+	cmd, err = ex.command(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"powershell.exe", "-NoProfile", "-NonInteractive", "-Command", "Get-Date"}, cmd.Args)
+
+	ex = &Execution{Command: "echo foo", Direct: true, RunAs: "nagios"}
+	cmd, err = ex.command(context.Background())
+	if runtime.GOOS == "windows" {
+		assert.Error(t, err)
+		return
+	}
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sudo", "-n", "-u", "nagios", "--", "echo", "foo"}, cmd.Args)
+}
+
+func TestExecuteCommandDirect(t *testing.T) {
+	cat := FakeCommand("cat")
+	cat.Direct = true
+	cat.Input = "bar"
+
+	catExec, err := ExecuteCommand(context.Background(), cat)
+	require.NoError(t, err)
+	assert.Equal(t, "bar", catExec.Output)
+	assert.Equal(t, 0, catExec.Status)
+
+	// Without a shell, a missing command fails to start
+	missing := &Execution{Command: "sensu-missing-command", Direct: true}
+	missingExec, err := ExecuteCommand(context.Background(), missing)
+	assert.Error(t, err)
+	assert.Equal(t, FallbackExitStatus, missingExec.Status)
+}
@@ -2,8 +2,8 @@
 package command
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"os/exec"
 	"strings"
 	"syscall"
@@ -38,6 +38,36 @@ type Execution struct {
 	// Command is the command to be executed.
 	Command string
 
+	// Direct, if set, executes the command without any shell. Its arguments
+	// are split on whitespace, single and double quotes grouping them.
+	Direct bool
+
+	// Shell is the shell through which the command is executed, instead of
+	// the platform default. It's ignored if Direct is set.
+	Shell string
+
+	// RunAs is the user as which the command is executed, through sudo.
+	RunAs string
+
+	// Dir is the working directory of the command. The command runs in the
+	// current directory if empty.
+	Dir string
+
+	// CPULimit is the maximum number of CPUs the command may use. It's not
+	// limited if zero.
+	CPULimit float64
+
+	// MemoryLimit is the maximum memory, in bytes, the command may use. It's
+	// not limited if zero.
+	MemoryLimit uint64
+
+	// Nice is the scheduling priority of the command, from -20 (highest) to
+	// 19 (lowest).
+	Nice int
+
+	// Sandbox constrains the execution of the command, if set.
+	Sandbox *Sandbox
+
 	// Env ...
 	Env []string
 
@@ -51,11 +81,31 @@ type Execution struct {
 	// Combined command execution STDOUT/ERR.
 	Output string
 
+	// OutputLimit is the maximum number of bytes of output captured. The
+	// output beyond it is discarded. The output is not limited if zero.
+	OutputLimit int
+
+	// OutputTruncated indicates whether output was discarded because of the
+	// OutputLimit.
+	OutputTruncated bool
+
+	// OutputStream, if set, is called with each line of the output as the
+	// command writes it, regardless of the OutputLimit.
+	OutputStream func(line string)
+
 	// Command execution exit status.
 	Status int
 
 	// Duration provides command execution time in seconds.
 	Duration float64
+
+	// CPUTime is the CPU time, in seconds, used by the command in user and
+	// system mode.
+	CPUTime float64
+
+	// MaxRSS is the maximum resident set size, in bytes, of the command. It's
+	// not measured on Windows.
+	MaxRSS uint64
 }
 
 // ExecuteCommand executes a system command (fork/exec) with a
@@ -79,19 +129,36 @@ func ExecuteCommand(ctx context.Context, execution *Execution) (*Execution, erro
 	defer timeout()
 
 	// Taken from Sensu-Spawn (Sensu 1.x.x).
-	cmd = Command(ctx, execution.Command)
+	cmd, err := execution.command(ctx)
+	if err != nil {
+		return execution, err
+	}
+
+	cmd.Dir = execution.Dir
 
 	// Set the ENV for the command if it is set
 	if len(execution.Env) > 0 {
 		cmd.Env = execution.Env
 	}
 
+	if execution.Sandbox != nil {
+		release, err := applySandbox(cmd, execution.Sandbox)
+		if err != nil {
+			execution.Status = FallbackExitStatus
+			return execution, err
+		}
+		defer release()
+	}
+
 	// Share an output buffer between STDOUT/ERR, following the
 	// Nagios plugin spec.
-	var output bytes.Buffer
+	output := &outputWriter{
+		limit:  execution.OutputLimit,
+		stream: execution.OutputStream,
+	}
 
-	cmd.Stdout = &output
-	cmd.Stderr = &output
+	cmd.Stdout = output
+	cmd.Stderr = output
 
 	// If Input is specified, write to STDIN.
 	if execution.Input != "" {
@@ -118,15 +185,41 @@ func ExecuteCommand(ctx context.Context, execution *Execution) (*Execution, erro
 	if err := cmd.Start(); err != nil {
 		// Something unexpected happended when attepting to
 		// fork/exec, return immediately.
+		execution.Status = FallbackExitStatus
 		return execution, err
 	}
 
-	err := cmd.Wait()
+	// Enforce the resource limits of the command, which is killed if they
+	// can't be.
+	if execution.limited() {
+		release, err := applyLimits(cmd, execution)
+		if err != nil {
+			if err := cmd.Process.Kill(); err != nil {
+				logger.WithError(err).Error("error when attempting to kill process")
+			}
+			_ = cmd.Wait()
+			if timer != nil {
+				timer.Stop()
+			}
+			execution.Status = FallbackExitStatus
+			return execution, err
+		}
+		defer release()
+	}
+
+	err = cmd.Wait()
 	if timer != nil {
 		timer.Stop()
 	}
 
-	execution.Output = output.String()
+	if state := cmd.ProcessState; state != nil {
+		execution.CPUTime = (state.UserTime() + state.SystemTime()).Seconds()
+		execution.MaxRSS = maxRSS(state)
+	}
+
+	output.flush()
+	execution.Output = output.buf.String()
+	execution.OutputTruncated = output.truncated
 
 	// The command execution timed out if the context was cancelled prematurely
 	if ctx.Err() == context.Canceled {
@@ -152,3 +245,38 @@ func ExecuteCommand(ctx context.Context, execution *Execution) (*Execution, erro
 
 	return execution, nil
 }
+
+// limited returns whether the execution has resource limits to enforce.
+func (e *Execution) limited() bool {
+	return e.CPULimit > 0 || e.MemoryLimit > 0 || e.Nice != 0
+}
+
+// command returns the system command of the execution, according to its exec
+// mode, shell and user.
+func (e *Execution) command(ctx context.Context) (*exec.Cmd, error) {
+	var args []string
+	switch {
+	case e.Direct:
+		var err error
+		if args, err = SplitArgs(e.Command); err != nil {
+			return nil, err
+		}
+		if len(args) == 0 {
+			return nil, errors.New("empty command")
+		}
+	case e.Shell != "":
+		args = append([]string{e.Shell}, shellArgs(e.Shell)...)
+		args = append(args, e.Command)
+	default:
+		args = append(append([]string{}, defaultShell...), e.Command)
+	}
+
+	if e.RunAs != "" {
+		var err error
+		if args, err = runAs(e.RunAs, args); err != nil {
+			return nil, err
+		}
+	}
+
+	return exec.CommandContext(ctx, args[0], args[1:]...), nil
+}
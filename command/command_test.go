@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"runtime"
 	"strings"
 	"testing"
 	"time"
@@ -53,6 +54,10 @@ func TestExecuteCommand(t *testing.T) {
 	assert.Equal(t, "foo\n", echoExec.Output)
 	assert.Equal(t, 0, echoExec.Status)
 	assert.NotEqual(t, 0, echoExec.Duration)
+	assert.NotEqual(t, 0, echoExec.CPUTime)
+	if runtime.GOOS != "windows" {
+		assert.NotEqual(t, uint64(0), echoExec.MaxRSS)
+	}
 
 	// test that input can be passed to a command through stdin
 	cat := FakeCommand("cat")
@@ -102,3 +107,20 @@ func TestExecuteCommand(t *testing.T) {
 	assert.Equal(t, 2, sleepMultipleExec.Status)
 	assert.NotEqual(t, 0, sleepMultipleExec.Duration)
 }
+
+func TestExecuteCommandOutputLimit(t *testing.T) {
+	cat := FakeCommand("cat")
+	cat.Input = "line 1\nline 2\nline 3"
+	cat.OutputLimit = 10
+
+	var lines []string
+	cat.OutputStream = func(line string) {
+		lines = append(lines, line)
+	}
+
+	catExec, catErr := ExecuteCommand(context.Background(), cat)
+	assert.NoError(t, catErr)
+	assert.Equal(t, "line 1\nlin", catExec.Output)
+	assert.True(t, catExec.OutputTruncated)
+	assert.Equal(t, []string{"line 1", "line 2", "line 3"}, lines)
+}
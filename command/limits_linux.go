@@ -0,0 +1,132 @@
+package command
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+
+	"github.com/Sirupsen/logrus"
+)
+
+// CgroupRoot is the mount point of the cgroup filesystem, in which the
+// resource limits of the commands are enforced.
+var CgroupRoot = "/sys/fs/cgroup"
+
+const (
+	// cgroupParent is the cgroup, relative to the root of each hierarchy,
+	// under which a cgroup is created for each limited command.
+	cgroupParent = "sensu-agent"
+
+	// cpuPeriod is the CFS period, in microseconds, over which the CPU quota
+	// of the commands is enforced.
+	cpuPeriod = 100000
+)
+
+// applyLimits enforces the resource limits of the execution on the started
+// command, by adding its process to a new cgroup. Since the process already
+// runs, the children it forks before then are not limited. The returned
+// function removes the cgroup once the command exited.
+func applyLimits(cmd *exec.Cmd, e *Execution) (func(), error) {
+	pid := cmd.Process.Pid
+	if e.Nice != 0 {
+		if err := setNice(pid, e.Nice); err != nil {
+			return nil, err
+		}
+	}
+
+	if e.CPULimit == 0 && e.MemoryLimit == 0 {
+		return func() {}, nil
+	}
+
+	// Each command gets its cgroup in every hierarchy involved: the unified
+	// one with cgroup v2, or the one of each controller with cgroup v1.
+	name := fmt.Sprintf("check-%d", pid)
+	unified := true
+	if _, err := os.Stat(filepath.Join(CgroupRoot, "cgroup.controllers")); err != nil {
+		unified = false
+	}
+
+	groups := map[string]map[string]string{}
+	group := func(controller string) map[string]string {
+		dir := filepath.Join(CgroupRoot, cgroupParent, name)
+		if !unified {
+			dir = filepath.Join(CgroupRoot, controller, cgroupParent, name)
+		}
+		if groups[dir] == nil {
+			groups[dir] = map[string]string{}
+		}
+		return groups[dir]
+	}
+
+	if e.CPULimit > 0 {
+		quota := int64(e.CPULimit * cpuPeriod)
+		if quota < 1000 {
+			quota = 1000
+		}
+		if unified {
+			group("cpu")["cpu.max"] = fmt.Sprintf("%d %d", quota, cpuPeriod)
+		} else {
+			group("cpu")["cpu.cfs_period_us"] = strconv.Itoa(cpuPeriod)
+			group("cpu")["cpu.cfs_quota_us"] = strconv.FormatInt(quota, 10)
+		}
+	}
+
+	if e.MemoryLimit > 0 {
+		limit := strconv.FormatUint(e.MemoryLimit, 10)
+		if unified {
+			group("memory")["memory.max"] = limit
+		} else {
+			group("memory")["memory.limit_in_bytes"] = limit
+		}
+	}
+
+	if unified {
+		// Delegate the controllers to the cgroups of the commands, which may
+		// already be done.
+		_ = writeCgroupFile(CgroupRoot, "cgroup.subtree_control", "+cpu +memory")
+		_ = os.MkdirAll(filepath.Join(CgroupRoot, cgroupParent), 0755)
+		_ = writeCgroupFile(filepath.Join(CgroupRoot, cgroupParent), "cgroup.subtree_control", "+cpu +memory")
+	}
+
+	var dirs []string
+	release := func() {
+		for _, dir := range dirs {
+			if err := os.Remove(dir); err != nil {
+				logrus.WithFields(logrus.Fields{"component": "command"}).
+					WithError(err).Warning("could not remove the command cgroup")
+			}
+		}
+	}
+
+	for dir, files := range groups {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			release()
+			return nil, fmt.Errorf("could not create the command cgroup: %s", err)
+		}
+		dirs = append(dirs, dir)
+
+		for file, value := range files {
+			if err := writeCgroupFile(dir, file, value); err != nil {
+				release()
+				return nil, err
+			}
+		}
+
+		if err := writeCgroupFile(dir, "cgroup.procs", strconv.Itoa(pid)); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
+
+func writeCgroupFile(dir, file, value string) error {
+	if err := ioutil.WriteFile(filepath.Join(dir, file), []byte(value), 0644); err != nil {
+		return fmt.Errorf("could not set the command cgroup %s: %s", file, err)
+	}
+	return nil
+}
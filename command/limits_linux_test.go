@@ -0,0 +1,92 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withCgroupRoot(t *testing.T, unified bool) func() {
+	root, err := ioutil.TempDir("", "cgroup")
+	require.NoError(t, err)
+	if unified {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(root, "cgroup.controllers"), []byte("cpu memory"), 0644))
+	}
+
+	previous := CgroupRoot
+	CgroupRoot = root
+	return func() {
+		CgroupRoot = previous
+		_ = os.RemoveAll(root)
+	}
+}
+
+func readCgroupFile(t *testing.T, path ...string) string {
+	b, err := ioutil.ReadFile(filepath.Join(append([]string{CgroupRoot}, path...)...))
+	require.NoError(t, err)
+	return string(b)
+}
+
+func startSleep(t *testing.T) *exec.Cmd {
+	cmd := exec.CommandContext(context.Background(), "sleep", "10")
+	require.NoError(t, cmd.Start())
+	return cmd
+}
+
+func TestApplyLimitsCgroupV2(t *testing.T) {
+	defer withCgroupRoot(t, true)()
+
+	cmd := startSleep(t)
+	defer func() { _ = cmd.Process.Kill() }()
+
+	_, err := applyLimits(cmd, &Execution{CPULimit: 0.5, MemoryLimit: 1024, Nice: 5})
+	require.NoError(t, err)
+
+	group := fmt.Sprintf("check-%d", cmd.Process.Pid)
+	assert.Equal(t, "50000 100000", readCgroupFile(t, cgroupParent, group, "cpu.max"))
+	assert.Equal(t, "1024", readCgroupFile(t, cgroupParent, group, "memory.max"))
+	assert.Equal(t, strconv.Itoa(cmd.Process.Pid), readCgroupFile(t, cgroupParent, group, "cgroup.procs"))
+	assert.Equal(t, "+cpu +memory", readCgroupFile(t, cgroupParent, "cgroup.subtree_control"))
+}
+
+func TestApplyLimitsCgroupV1(t *testing.T) {
+	defer withCgroupRoot(t, false)()
+
+	cmd := startSleep(t)
+	defer func() { _ = cmd.Process.Kill() }()
+
+	_, err := applyLimits(cmd, &Execution{CPULimit: 2, MemoryLimit: 1024})
+	require.NoError(t, err)
+
+	group := fmt.Sprintf("check-%d", cmd.Process.Pid)
+	pid := strconv.Itoa(cmd.Process.Pid)
+	assert.Equal(t, "200000", readCgroupFile(t, "cpu", cgroupParent, group, "cpu.cfs_quota_us"))
+	assert.Equal(t, "100000", readCgroupFile(t, "cpu", cgroupParent, group, "cpu.cfs_period_us"))
+	assert.Equal(t, pid, readCgroupFile(t, "cpu", cgroupParent, group, "cgroup.procs"))
+	assert.Equal(t, "1024", readCgroupFile(t, "memory", cgroupParent, group, "memory.limit_in_bytes"))
+	assert.Equal(t, pid, readCgroupFile(t, "memory", cgroupParent, group, "cgroup.procs"))
+}
+
+func TestExecuteCommandLimitsError(t *testing.T) {
+	defer withCgroupRoot(t, false)()
+
+	// The cgroups can't be created under a regular file
+	root := filepath.Join(CgroupRoot, "file")
+	require.NoError(t, ioutil.WriteFile(root, nil, 0644))
+	CgroupRoot = root
+
+	ex := FakeCommand("cat")
+	ex.MemoryLimit = 1024
+
+	_, err := ExecuteCommand(context.Background(), ex)
+	assert.Error(t, err)
+	assert.Equal(t, FallbackExitStatus, ex.Status)
+}
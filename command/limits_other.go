@@ -0,0 +1,23 @@
+// +build !linux,!windows
+
+package command
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// applyLimits enforces the resource limits of the execution on the started
+// command. Only the nice level is supported on this platform.
+func applyLimits(cmd *exec.Cmd, e *Execution) (func(), error) {
+	if e.CPULimit > 0 || e.MemoryLimit > 0 {
+		return nil, fmt.Errorf("command cpu and memory limits are not supported on %s", runtime.GOOS)
+	}
+
+	if err := setNice(cmd.Process.Pid, e.Nice); err != nil {
+		return nil, err
+	}
+
+	return func() {}, nil
+}
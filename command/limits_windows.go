@@ -0,0 +1,153 @@
+package command
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	kernel32                     = syscall.NewLazyDLL("kernel32.dll")
+	procCreateJobObject          = kernel32.NewProc("CreateJobObjectW")
+	procSetInformationJobObject  = kernel32.NewProc("SetInformationJobObject")
+	procAssignProcessToJobObject = kernel32.NewProc("AssignProcessToJobObject")
+)
+
+const (
+	jobObjectExtendedLimitInformationClass  = 9
+	jobObjectCPURateControlInformationClass = 15
+
+	jobObjectLimitPriorityClass = 0x00000020
+	jobObjectLimitJobMemory     = 0x00000200
+
+	jobObjectCPURateControlEnable  = 0x1
+	jobObjectCPURateControlHardCap = 0x4
+
+	idlePriorityClass        = 0x00000040
+	belowNormalPriorityClass = 0x00004000
+	aboveNormalPriorityClass = 0x00008000
+	highPriorityClass        = 0x00000080
+
+	processSetQuota  = 0x0100
+	processTerminate = 0x0001
+)
+
+type jobObjectBasicLimitInformation struct {
+	PerProcessUserTimeLimit int64
+	PerJobUserTimeLimit     int64
+	LimitFlags              uint32
+	MinimumWorkingSetSize   uintptr
+	MaximumWorkingSetSize   uintptr
+	ActiveProcessLimit      uint32
+	Affinity                uintptr
+	PriorityClass           uint32
+	SchedulingClass         uint32
+}
+
+type ioCounters struct {
+	ReadOperationCount  uint64
+	WriteOperationCount uint64
+	OtherOperationCount uint64
+	ReadTransferCount   uint64
+	WriteTransferCount  uint64
+	OtherTransferCount  uint64
+}
+
+type jobObjectExtendedLimitInformation struct {
+	BasicLimitInformation jobObjectBasicLimitInformation
+	IoInfo                ioCounters
+	ProcessMemoryLimit    uintptr
+	JobMemoryLimit        uintptr
+	PeakProcessMemoryUsed uintptr
+	PeakJobMemoryUsed     uintptr
+}
+
+type jobObjectCPURateControlInformation struct {
+	ControlFlags uint32
+	CPURate      uint32
+}
+
+// applyLimits enforces the resource limits of the execution on the started
+// command, by assigning its process to a new job object. Since the process
+// already runs, the children it spawns before then are not limited. The
+// returned function closes the job object once the command exited.
+func applyLimits(cmd *exec.Cmd, e *Execution) (func(), error) {
+	job, _, err := procCreateJobObject.Call(0, 0)
+	if job == 0 {
+		return nil, fmt.Errorf("could not create the command job object: %s", err)
+	}
+	release := func() { _ = syscall.CloseHandle(syscall.Handle(job)) }
+
+	var info jobObjectExtendedLimitInformation
+	if e.Nice != 0 {
+		info.BasicLimitInformation.LimitFlags |= jobObjectLimitPriorityClass
+		info.BasicLimitInformation.PriorityClass = priorityClass(e.Nice)
+	}
+	if e.MemoryLimit > 0 {
+		info.BasicLimitInformation.LimitFlags |= jobObjectLimitJobMemory
+		info.JobMemoryLimit = uintptr(e.MemoryLimit)
+	}
+	if info.BasicLimitInformation.LimitFlags != 0 {
+		if err := setJobInformation(job, jobObjectExtendedLimitInformationClass, unsafe.Pointer(&info), unsafe.Sizeof(info)); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	if e.CPULimit > 0 {
+		// The CPU rate is the share of the cycles of all the CPUs, in
+		// hundredths of percent.
+		rate := uint32(e.CPULimit / float64(runtime.NumCPU()) * 10000)
+		if rate < 1 {
+			rate = 1
+		} else if rate > 10000 {
+			rate = 10000
+		}
+		cpuInfo := jobObjectCPURateControlInformation{
+			ControlFlags: jobObjectCPURateControlEnable | jobObjectCPURateControlHardCap,
+			CPURate:      rate,
+		}
+		if err := setJobInformation(job, jobObjectCPURateControlInformationClass, unsafe.Pointer(&cpuInfo), unsafe.Sizeof(cpuInfo)); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	process, err := syscall.OpenProcess(processSetQuota|processTerminate, false, uint32(cmd.Process.Pid))
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("could not open the command process: %s", err)
+	}
+	defer func() { _ = syscall.CloseHandle(process) }()
+
+	if ok, _, err := procAssignProcessToJobObject.Call(job, uintptr(process)); ok == 0 {
+		release()
+		return nil, fmt.Errorf("could not assign the command to its job object: %s", err)
+	}
+
+	return release, nil
+}
+
+func setJobInformation(job uintptr, class int, info unsafe.Pointer, size uintptr) error {
+	if ok, _, err := procSetInformationJobObject.Call(job, uintptr(class), uintptr(info), size); ok == 0 {
+		return fmt.Errorf("could not set the command job object limits: %s", err)
+	}
+	return nil
+}
+
+// priorityClass returns the Windows priority class closest to the given nice
+// level.
+func priorityClass(nice int) uint32 {
+	switch {
+	case nice <= -10:
+		return highPriorityClass
+	case nice < 0:
+		return aboveNormalPriorityClass
+	case nice >= 10:
+		return idlePriorityClass
+	default:
+		return belowNormalPriorityClass
+	}
+}
@@ -0,0 +1,58 @@
+package command
+
+import (
+	"bytes"
+)
+
+// outputWriter captures the output of a command up to a limit, and optionally
+// streams each line of the output as it's written.
+type outputWriter struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+
+	stream func(line string)
+	line   []byte
+}
+
+func (w *outputWriter) Write(p []byte) (int, error) {
+	if w.stream != nil {
+		w.streamLines(p)
+	}
+
+	if w.limit <= 0 {
+		return w.buf.Write(p)
+	}
+
+	// Discard the output beyond the limit, but report it as written so the
+	// command isn't interrupted
+	if remaining := w.limit - w.buf.Len(); remaining < len(p) {
+		w.truncated = true
+		if remaining > 0 {
+			w.buf.Write(p[:remaining])
+		}
+		return len(p), nil
+	}
+
+	return w.buf.Write(p)
+}
+
+func (w *outputWriter) streamLines(p []byte) {
+	w.line = append(w.line, p...)
+	for {
+		i := bytes.IndexByte(w.line, '\n')
+		if i < 0 {
+			return
+		}
+		w.stream(string(bytes.TrimRight(w.line[:i], "\r")))
+		w.line = w.line[i+1:]
+	}
+}
+
+// flush streams the last line of the output if it doesn't end with a newline.
+func (w *outputWriter) flush() {
+	if w.stream != nil && len(w.line) > 0 {
+		w.stream(string(w.line))
+		w.line = nil
+	}
+}
@@ -0,0 +1,38 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputWriter(t *testing.T) {
+	var lines []string
+	w := &outputWriter{
+		limit: 8,
+		stream: func(line string) {
+			lines = append(lines, line)
+		},
+	}
+
+	for _, p := range []string{"foo", "\r\nbar\nb", "az"} {
+		n, err := w.Write([]byte(p))
+		assert.NoError(t, err)
+		assert.Equal(t, len(p), n)
+	}
+	w.flush()
+
+	assert.Equal(t, "foo\r\nbar", w.buf.String())
+	assert.True(t, w.truncated)
+	assert.Equal(t, []string{"foo", "bar", "baz"}, lines)
+}
+
+func TestOutputWriterUnlimited(t *testing.T) {
+	w := &outputWriter{}
+	_, _ = w.Write([]byte("foo\n"))
+	_, _ = w.Write([]byte("bar\n"))
+	w.flush()
+
+	assert.Equal(t, "foo\nbar\n", w.buf.String())
+	assert.False(t, w.truncated)
+}
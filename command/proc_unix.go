@@ -4,21 +4,59 @@ package command
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"os/exec"
+	"runtime"
 	"syscall"
 )
 
+// defaultShell is the shell, and its arguments, through which commands are
+// executed by default.
+var defaultShell = []string{"sh", "-c"}
+
 // Command returns a command to execute a script through a shell.
 func Command(ctx context.Context, command string) *exec.Cmd {
-	return exec.CommandContext(ctx, "sh", "-c", command)
+	return exec.CommandContext(ctx, defaultShell[0], defaultShell[1], command)
+}
+
+// runAs returns the arguments to execute the given ones as another user, with
+// sudo. The sudo rules must allow it without a password.
+func runAs(user string, args []string) ([]string, error) {
+	return append([]string{"sudo", "-n", "-u", user, "--"}, args...), nil
+}
+
+// setNice sets the scheduling priority of the given process.
+func setNice(pid, nice int) error {
+	if err := syscall.Setpriority(syscall.PRIO_PROCESS, pid, nice); err != nil {
+		return fmt.Errorf("could not set the command nice level: %s", err)
+	}
+	return nil
 }
 
 // SetProcessGroup sets the process group of the command process
 func SetProcessGroup(cmd *exec.Cmd) {
-	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
 }
 
 // KillProcess kills the command process and any child processes
 func KillProcess(cmd *exec.Cmd) error {
 	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
 }
+
+// maxRSS returns the maximum resident set size, in bytes, of the exited
+// process.
+func maxRSS(state *os.ProcessState) uint64 {
+	rusage, ok := state.SysUsage().(*syscall.Rusage)
+	if !ok {
+		return 0
+	}
+	// The size is given in bytes on macOS, and in kilobytes elsewhere
+	if runtime.GOOS == "darwin" {
+		return uint64(rusage.Maxrss)
+	}
+	return uint64(rusage.Maxrss) * 1024
+}
@@ -4,13 +4,25 @@ package command
 
 import (
 	"context"
+	"errors"
+	"os"
 	"os/exec"
 	"syscall"
 )
 
+// defaultShell is the shell, and its arguments, through which commands are
+// executed by default.
+var defaultShell = []string{"cmd", "/c"}
+
 // Command returns a command to execute a script through a shell.
 func Command(ctx context.Context, command string) *exec.Cmd {
-	return exec.CommandContext(ctx, "cmd", "/c", command)
+	return exec.CommandContext(ctx, defaultShell[0], defaultShell[1], command)
+}
+
+// runAs returns an error, since executing commands as another user is not
+// supported on Windows.
+func runAs(user string, args []string) ([]string, error) {
+	return nil, errors.New("running commands as another user is not supported on windows")
 }
 
 // SetProcessGroup sets the process group of the command process
@@ -22,3 +34,9 @@ func SetProcessGroup(cmd *exec.Cmd) {
 func KillProcess(cmd *exec.Cmd) error {
 	return cmd.Process.Kill()
 }
+
+// maxRSS returns the maximum resident set size of the exited process, which
+// isn't measured on Windows.
+func maxRSS(state *os.ProcessState) uint64 {
+	return 0
+}
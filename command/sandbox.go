@@ -0,0 +1,28 @@
+package command
+
+// Sandbox constrains the execution of a command, which otherwise runs with the
+// privileges of the executing process.
+type Sandbox struct {
+	// User is the user the command runs as. Unlike RunAs, the credentials are
+	// switched directly, which requires the executing process to run as root
+	// or with the CAP_SETUID and CAP_SETGID capabilities.
+	User string
+
+	// ReadOnly restricts the command to a read-only view of the filesystem,
+	// with a private /tmp, except for the WritablePaths.
+	ReadOnly bool
+
+	// WritablePaths are the paths the command may write to, when ReadOnly is
+	// set.
+	WritablePaths []string
+
+	// SeccompProfile is the path of the compiled seccomp BPF program filtering
+	// the system calls of the command.
+	SeccompProfile string
+}
+
+// isolated returns whether the command needs to run in its own namespaces,
+// through bubblewrap.
+func (s *Sandbox) isolated() bool {
+	return s.ReadOnly || s.SeccompProfile != ""
+}
@@ -0,0 +1,103 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"strconv"
+	"syscall"
+)
+
+// Bubblewrap is the bubblewrap executable, which isolates the commands with a
+// read-only filesystem or a seccomp profile.
+var Bubblewrap = "bwrap"
+
+// applySandbox constrains the command before it's started: its credentials
+// are switched to the user of the sandbox, and it's wrapped by bubblewrap if
+// it's isolated. The returned function releases the resources of the sandbox
+// once the command exited.
+func applySandbox(cmd *exec.Cmd, s *Sandbox) (func(), error) {
+	if s.User != "" {
+		credential, err := lookupCredential(s.User)
+		if err != nil {
+			return nil, err
+		}
+		if cmd.SysProcAttr == nil {
+			cmd.SysProcAttr = &syscall.SysProcAttr{}
+		}
+		cmd.SysProcAttr.Credential = credential
+	}
+
+	if !s.isolated() {
+		return func() {}, nil
+	}
+
+	bwrap, err := exec.LookPath(Bubblewrap)
+	if err != nil {
+		return nil, fmt.Errorf("the command sandbox requires bubblewrap: %s", err)
+	}
+
+	args := []string{Bubblewrap, "--unshare-ipc", "--unshare-pid", "--die-with-parent"}
+	if s.ReadOnly {
+		args = append(args, "--ro-bind", "/", "/", "--dev", "/dev", "--proc", "/proc", "--tmpfs", "/tmp")
+		for _, path := range s.WritablePaths {
+			args = append(args, "--bind", path, path)
+		}
+	} else {
+		args = append(args, "--bind", "/", "/", "--dev", "/dev", "--proc", "/proc")
+	}
+
+	release := func() {}
+	if s.SeccompProfile != "" {
+		profile, err := os.Open(s.SeccompProfile)
+		if err != nil {
+			return nil, fmt.Errorf("could not open the seccomp profile: %s", err)
+		}
+		release = func() { _ = profile.Close() }
+
+		// The extra files follow stdin, stdout and stderr in the command
+		cmd.ExtraFiles = append(cmd.ExtraFiles, profile)
+		fd := 2 + len(cmd.ExtraFiles)
+		args = append(args, "--seccomp", strconv.Itoa(fd))
+	}
+
+	cmd.Path = bwrap
+	cmd.Args = append(append(args, "--"), cmd.Args...)
+
+	return release, nil
+}
+
+// lookupCredential returns the credential of the given user, with its primary
+// and supplementary groups.
+func lookupCredential(name string) (*syscall.Credential, error) {
+	u, err := user.Lookup(name)
+	if err != nil {
+		return nil, fmt.Errorf("could not find the command sandbox user: %s", err)
+	}
+
+	uid, err := strconv.ParseUint(u.Uid, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid uid of the command sandbox user: %s", err)
+	}
+	gid, err := strconv.ParseUint(u.Gid, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gid of the command sandbox user: %s", err)
+	}
+
+	credential := &syscall.Credential{Uid: uint32(uid), Gid: uint32(gid)}
+
+	groups, err := u.GroupIds()
+	if err != nil {
+		return nil, fmt.Errorf("could not find the groups of the command sandbox user: %s", err)
+	}
+	for _, group := range groups {
+		id, err := strconv.ParseUint(group, 10, 32)
+		if err != nil {
+			continue
+		}
+		credential.Groups = append(credential.Groups, uint32(id))
+	}
+
+	return credential, nil
+}
@@ -0,0 +1,81 @@
+package command
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withBubblewrap replaces bubblewrap with the given executable, e.g. echo to
+// capture its arguments.
+func withBubblewrap(executable string) func() {
+	previous := Bubblewrap
+	Bubblewrap = executable
+	return func() { Bubblewrap = previous }
+}
+
+func TestSandboxReadOnly(t *testing.T) {
+	defer withBubblewrap("echo")()
+
+	execution := &Execution{
+		Command: "true",
+		Sandbox: &Sandbox{ReadOnly: true, WritablePaths: []string{"/var/lib/sensu"}},
+	}
+	result, err := ExecuteCommand(context.Background(), execution)
+	require.NoError(t, err)
+
+	args := strings.TrimSpace(result.Output)
+	assert.Contains(t, args, "--ro-bind / / --dev /dev --proc /proc --tmpfs /tmp")
+	assert.Contains(t, args, "--bind /var/lib/sensu /var/lib/sensu")
+	assert.True(t, strings.HasSuffix(args, "-- sh -c true"), args)
+}
+
+func TestSandboxSeccompProfile(t *testing.T) {
+	defer withBubblewrap("echo")()
+
+	profile, err := ioutil.TempFile("", "seccomp")
+	require.NoError(t, err)
+	defer func() { _ = os.Remove(profile.Name()) }()
+	require.NoError(t, profile.Close())
+
+	execution := &Execution{
+		Command: "true",
+		Sandbox: &Sandbox{SeccompProfile: profile.Name()},
+	}
+	result, err := ExecuteCommand(context.Background(), execution)
+	require.NoError(t, err)
+
+	// The profile is the first file after stdin, stdout and stderr
+	assert.Contains(t, result.Output, "--bind / / --dev /dev --proc /proc --seccomp 3 --")
+
+	execution.Sandbox.SeccompProfile = profile.Name() + ".missing"
+	_, err = ExecuteCommand(context.Background(), execution)
+	assert.Error(t, err)
+}
+
+func TestSandboxWithoutBubblewrap(t *testing.T) {
+	defer withBubblewrap("sensu-missing-bwrap")()
+
+	execution := &Execution{Command: "true", Sandbox: &Sandbox{ReadOnly: true}}
+	_, err := ExecuteCommand(context.Background(), execution)
+	assert.Error(t, err)
+}
+
+func TestSandboxUser(t *testing.T) {
+	execution := &Execution{Command: "id -u", Sandbox: &Sandbox{User: "sensu-missing-user"}}
+	_, err := ExecuteCommand(context.Background(), execution)
+	assert.Error(t, err)
+
+	if os.Getuid() != 0 {
+		t.Skip("switching users requires root")
+	}
+	execution = &Execution{Command: "id -u", Timeout: 10, Sandbox: &Sandbox{User: "nobody"}}
+	result, err := ExecuteCommand(context.Background(), execution)
+	require.NoError(t, err)
+	assert.NotEqual(t, "0", strings.TrimSpace(result.Output))
+}
@@ -0,0 +1,18 @@
+// +build !linux
+
+package command
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// applySandbox constrains the command before it's started. Sandboxes are not
+// supported on this platform.
+func applySandbox(cmd *exec.Cmd, s *Sandbox) (func(), error) {
+	if s.User != "" || s.isolated() {
+		return nil, fmt.Errorf("command sandboxes are not supported on %s", runtime.GOOS)
+	}
+	return func() {}, nil
+}
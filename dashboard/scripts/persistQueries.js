@@ -0,0 +1,47 @@
+'use strict';
+
+// Writes the manifest of the queries compiled by relay-compiler, by the
+// SHA-256 hash of their text, which the dashboard sends instead of the text.
+// The backend only serves these queries when started with
+// --graphql-persisted-queries build/persisted-queries.json and
+// --graphql-persisted-queries-only.
+
+const crypto = require('crypto');
+const path = require('path');
+const fs = require('fs-extra');
+const paths = require('../config/paths');
+
+function findArtifacts(dir) {
+  return fs.readdirSync(dir).reduce((artifacts, name) => {
+    const file = path.join(dir, name);
+    if (fs.statSync(file).isDirectory()) {
+      return artifacts.concat(findArtifacts(file));
+    }
+    if (path.basename(dir) === '__generated__' && name.endsWith('.graphql.js')) {
+      artifacts.push(file);
+    }
+    return artifacts;
+  }, []);
+}
+
+const manifest = {};
+findArtifacts(paths.appSrc).forEach(file => {
+  // eslint-disable-next-line global-require, import/no-dynamic-require
+  const artifact = require(file);
+  if (artifact && typeof artifact.text === 'string') {
+    const hash = crypto
+      .createHash('sha256')
+      .update(artifact.text)
+      .digest('hex');
+    manifest[hash] = artifact.text;
+  }
+});
+
+fs.ensureDirSync(paths.appBuild);
+fs.writeFileSync(
+  path.join(paths.appBuild, 'persisted-queries.json'),
+  JSON.stringify(manifest, null, 2),
+);
+console.log(
+  `Persisted ${Object.keys(manifest).length} queries to build/persisted-queries.json.`,
+);
@@ -1,6 +1,43 @@
 import { Environment, Network, RecordSource, Store } from "relay-runtime";
 import { getAccessToken } from "./utils/authentication";
 
+// The queries are requested by the SHA-256 hash of their text, which the
+// backend parses once. The text is only sent when the backend doesn't know
+// the hash yet, or the hash can't be computed in an insecure context.
+const hashes = {};
+
+function toHex(buffer) {
+  return Array.from(new Uint8Array(buffer))
+    .map(byte => byte.toString(16).padStart(2, "0"))
+    .join("");
+}
+
+function hashQuery(text) {
+  const subtle = window.crypto && window.crypto.subtle;
+  if (!subtle || !window.TextEncoder) {
+    return Promise.resolve(null);
+  }
+  if (hashes[text]) {
+    return Promise.resolve(hashes[text]);
+  }
+  return subtle
+    .digest("SHA-256", new window.TextEncoder().encode(text))
+    .then(toHex)
+    .then(hash => {
+      hashes[text] = hash;
+      return hash;
+    })
+    .catch(() => null);
+}
+
+function isPersistedQueryMissing(response) {
+  return (response.errors || []).some(
+    error =>
+      error.message === "PersistedQueryNotFound" ||
+      error.message === "PersistedQueryNotSupported",
+  );
+}
+
 function fetchQuery(
   operation,
   variables,
@@ -8,23 +45,35 @@ function fetchQuery(
   // uploadables,
 ) {
   const parseJson = response => response.json();
-  const makeRequest = accessToken =>
-    fetch("/graphql", {
+  const makeRequest = (accessToken, hash, withText) => {
+    const body = { variables };
+    if (hash) {
+      body.extensions = { persistedQuery: { version: 1, sha256Hash: hash } };
+    }
+    if (withText || !hash) {
+      body.query = operation.text;
+    }
+
+    return fetch("/graphql", {
       method: "POST",
       headers: {
         Accept: "application/json",
         Authorization: `Bearer ${accessToken}`,
         "content-type": "application/json",
       },
-      body: JSON.stringify({
-        query: operation.text,
-        variables,
-      }),
-    });
+      body: JSON.stringify(body),
+    }).then(parseJson);
+  };
 
-  return getAccessToken()
-    .then(makeRequest)
-    .then(parseJson);
+  return Promise.all([getAccessToken(), hashQuery(operation.text)]).then(
+    ([accessToken, hash]) =>
+      makeRequest(accessToken, hash, false).then(response => {
+        if (hash && isPersistedQueryMissing(response)) {
+          return makeRequest(accessToken, hash, true);
+        }
+        return response;
+      }),
+  );
 }
 
 // Create a record source & instantiate store
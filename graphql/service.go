@@ -5,12 +5,19 @@ import (
 	"fmt"
 
 	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/kinds"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/graphql-go/graphql/language/visitor"
 )
 
 // Service ...TODO...
 type Service struct {
 	types  *typeRegister
 	schema graphql.Schema
+	rules  []graphql.ValidationRuleFn
 }
 
 // NewService returns new instance of Service
@@ -118,19 +125,86 @@ func (service *Service) Regenerate() error {
 	return err
 }
 
+// DisableIntrospection rejects the queries of the __schema and __type
+// fields, so that the schema can't be explored by the clients.
+func (service *Service) DisableIntrospection() {
+	rules := make([]graphql.ValidationRuleFn, 0, len(graphql.SpecifiedRules)+1)
+	rules = append(rules, graphql.SpecifiedRules...)
+	service.rules = append(rules, noIntrospectionRule)
+}
+
+// Parse parses and validates the given query string. The document returned
+// can be executed any number of times, as long as the schema isn't
+// regenerated.
+func (service *Service) Parse(q string) (*ast.Document, []gqlerrors.FormattedError) {
+	src := source.NewSource(&source.Source{
+		Body: []byte(q),
+		Name: "GraphQL request",
+	})
+	doc, err := parser.Parse(parser.ParseParams{Source: src})
+	if err != nil {
+		return nil, gqlerrors.FormatErrors(err)
+	}
+
+	result := graphql.ValidateDocument(&service.schema, doc, service.rules)
+	if !result.IsValid {
+		return nil, result.Errors
+	}
+	return doc, nil
+}
+
+// Execute executes the given document, as returned by Parse
+func (service *Service) Execute(
+	ctx context.Context,
+	doc *ast.Document,
+	vars map[string]interface{},
+) *graphql.Result {
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:  service.schema,
+		AST:     doc,
+		Args:    vars,
+		Context: ctx,
+	})
+}
+
 // Do executes request given query string
 func (service *Service) Do(
 	ctx context.Context,
 	q string,
 	vars map[string]interface{},
 ) *graphql.Result {
-	params := graphql.Params{
-		Schema:         service.schema,
-		VariableValues: vars,
-		Context:        ctx,
-		RequestString:  q,
+	doc, errs := service.Parse(q)
+	if len(errs) > 0 {
+		return &graphql.Result{Errors: errs}
+	}
+	return service.Execute(ctx, doc, vars)
+}
+
+// noIntrospectionRule reports the selections of the introspection fields,
+// except __typename which the clients rely on to resolve the unions and
+// interfaces.
+func noIntrospectionRule(context *graphql.ValidationContext) *graphql.ValidationRuleInstance {
+	return &graphql.ValidationRuleInstance{
+		VisitorOpts: &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.Field: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.Field)
+						if !ok || node.Name == nil {
+							return visitor.ActionNoChange, nil
+						}
+						if name := node.Name.Value; name == "__schema" || name == "__type" {
+							context.ReportError(gqlerrors.NewError(
+								fmt.Sprintf("introspection is disabled, %s cannot be queried", name),
+								[]ast.Node{node}, "", nil, []int{}, nil,
+							))
+						}
+						return visitor.ActionNoChange, nil
+					},
+				},
+			},
+		},
 	}
-	return graphql.Do(params)
 }
 
 type typeRegister struct {
@@ -194,6 +268,12 @@ func newSchema(reg *typeRegister) (graphql.Schema, error) {
 		schemaCfg.Subscription = subscriptionType.(*graphql.Object)
 	}
 
+	// Include every object type so that implementations of an interface which
+	// aren't referenced by any field are still possible types of it.
+	for name := range reg.types[ObjectKind] {
+		schemaCfg.Types = append(schemaCfg.Types, typeMap[name])
+	}
+
 	return graphql.NewSchema(schemaCfg)
 }
 
@@ -0,0 +1,314 @@
+// Package signing signs the check requests published by the backend and
+// verifies them on the agents, with ECDSA P-256 keys, so that check requests
+// injected by anyone without the key of their organization aren't executed.
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+var (
+	// ErrMissingSignature is returned when verifying an unsigned request
+	ErrMissingSignature = errors.New("the check request is not signed")
+
+	// ErrInvalidSignature is returned when the signature of a request doesn't
+	// match its content
+	ErrInvalidSignature = errors.New("the check request signature is invalid")
+
+	// ErrStaleRequest is returned when verifying a request issued too long
+	// ago, or in the future, so that the signed requests can't be replayed
+	ErrStaleRequest = errors.New("the check request is stale")
+)
+
+const (
+	// MaxRequestAge is how long after being issued a signed request is
+	// executed by the agents.
+	MaxRequestAge = 5 * time.Minute
+
+	// MaxClockSkew is how far in the future a signed request can be issued,
+	// according to the clock of the agents.
+	MaxClockSkew = time.Minute
+)
+
+// ecdsaSignature is the ASN.1 structure of an ECDSA signature.
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// signedHook holds the fields of a hook covered by the signature.
+type signedHook struct {
+	Name    string
+	Command string
+	Timeout uint32
+	Stdin   bool
+}
+
+// signedAsset holds the fields of an asset covered by the signature.
+type signedAsset struct {
+	Name   string
+	URL    string
+	Sha512 string
+}
+
+// signedOverride holds the fields of a check override covered by the
+// signature.
+type signedOverride struct {
+	Entity   string
+	Labels   map[string]string
+	Interval uint32
+	Timeout  uint32
+	Disabled bool
+}
+
+// signedRequest holds the fields of a check request covered by the
+// signature, i.e. what the agents execute and how.
+type signedRequest struct {
+	ID               string
+	Issued           int64
+	Name             string
+	Organization     string
+	Environment      string
+	Command          string
+	Timeout          uint32
+	Stdin            bool
+	ExecType         string
+	Shell            string
+	RunAs            string
+	WorkingDirectory string
+	CPULimit         float64
+	MemoryLimit      uint64
+	Nice             int32
+	Overrides        []signedOverride
+	Hooks            []signedHook
+	Assets           []signedAsset
+}
+
+// digest returns the SHA-256 digest of the fields of the request covered by
+// the signature. The encoding of the fields doesn't depend on how the request
+// is transported to the agents.
+func digest(request *types.CheckRequest) ([]byte, error) {
+	if request.Config == nil {
+		return nil, errors.New("the check request has no check")
+	}
+
+	cfg := request.Config
+	r := signedRequest{
+		ID:               request.ID,
+		Issued:           request.Issued,
+		Name:             cfg.Name,
+		Organization:     cfg.Organization,
+		Environment:      cfg.Environment,
+		Command:          cfg.Command,
+		Timeout:          cfg.Timeout,
+		Stdin:            cfg.Stdin,
+		ExecType:         cfg.ExecType,
+		Shell:            cfg.Shell,
+		RunAs:            cfg.RunAs,
+		WorkingDirectory: cfg.WorkingDirectory,
+		CPULimit:         cfg.CPULimit,
+		MemoryLimit:      cfg.MemoryLimit,
+		Nice:             cfg.Nice,
+	}
+	for _, o := range cfg.Overrides {
+		r.Overrides = append(r.Overrides, signedOverride{Entity: o.Entity, Labels: o.Labels, Interval: o.Interval, Timeout: o.Timeout, Disabled: o.Disabled})
+	}
+	for _, hook := range request.Hooks {
+		r.Hooks = append(r.Hooks, signedHook{Name: hook.Name, Command: hook.Command, Timeout: hook.Timeout, Stdin: hook.Stdin})
+	}
+	for _, asset := range request.Assets {
+		r.Assets = append(r.Assets, signedAsset{Name: asset.Name, URL: asset.URL, Sha512: asset.Sha512})
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(b)
+	return sum[:], nil
+}
+
+// A Keyring holds the private keys signing the check requests of each
+// organization.
+type Keyring struct {
+	keys map[string]*ecdsa.PrivateKey
+}
+
+// LoadKeyring reads the private keys of the organizations from the PEM files
+// of the directory, named after their organization, e.g. default.pem.
+func LoadKeyring(dir string) (*Keyring, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no signing keys found in %s", dir)
+	}
+
+	keyring := &Keyring{keys: make(map[string]*ecdsa.PrivateKey, len(paths))}
+	for _, path := range paths {
+		key, err := readPrivateKey(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read the signing key %s: %s", path, err)
+		}
+		org := strings.TrimSuffix(filepath.Base(path), ".pem")
+		keyring.keys[org] = key
+	}
+
+	return keyring, nil
+}
+
+// NewKeyring returns a keyring with the given keys, by organization.
+func NewKeyring(keys map[string]*ecdsa.PrivateKey) *Keyring {
+	return &Keyring{keys: keys}
+}
+
+// Sign signs the request with the key of the organization of its check. The
+// requests of the organizations without key, and all the requests if the
+// keyring is nil, are left unsigned.
+func (k *Keyring) Sign(request *types.CheckRequest) error {
+	if k == nil || request.Config == nil {
+		return nil
+	}
+	key, ok := k.keys[request.Config.Organization]
+	if !ok {
+		return nil
+	}
+
+	sum, err := digest(request)
+	if err != nil {
+		return err
+	}
+	r, s, err := ecdsa.Sign(rand.Reader, key, sum)
+	if err != nil {
+		return err
+	}
+	request.Signature, err = asn1.Marshal(ecdsaSignature{R: r, S: s})
+	return err
+}
+
+// A Verifier verifies the signature of the check requests with the public
+// key of the organization of the agent.
+type Verifier struct {
+	key *ecdsa.PublicKey
+}
+
+// LoadVerifier reads the public key of the PEM file at path.
+func LoadVerifier(path string) (*Verifier, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	ecKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("the key of %s is not an ECDSA public key", path)
+	}
+
+	return NewVerifier(ecKey), nil
+}
+
+// NewVerifier returns a verifier with the given public key.
+func NewVerifier(key *ecdsa.PublicKey) *Verifier {
+	return &Verifier{key: key}
+}
+
+// Verify returns an error unless the request is signed by the private key
+// matching the public key of the verifier, and was issued within
+// MaxRequestAge.
+func (v *Verifier) Verify(request *types.CheckRequest) error {
+	return v.verify(request, time.Now())
+}
+
+func (v *Verifier) verify(request *types.CheckRequest, now time.Time) error {
+	if len(request.Signature) == 0 {
+		return ErrMissingSignature
+	}
+
+	var sig ecdsaSignature
+	if rest, err := asn1.Unmarshal(request.Signature, &sig); err != nil || len(rest) > 0 || sig.R == nil || sig.S == nil {
+		return ErrInvalidSignature
+	}
+
+	sum, err := digest(request)
+	if err != nil {
+		return err
+	}
+	if !ecdsa.Verify(v.key, sum, sig.R, sig.S) {
+		return ErrInvalidSignature
+	}
+
+	// The issue time is covered by the signature, so the requests captured
+	// on the bus can't be executed again once they're stale
+	issued := time.Unix(0, request.Issued*int64(time.Millisecond))
+	if now.Sub(issued) > MaxRequestAge || issued.Sub(now) > MaxClockSkew {
+		return ErrStaleRequest
+	}
+
+	return nil
+}
+
+// GenerateKey returns a new P-256 private key.
+func GenerateKey() (*ecdsa.PrivateKey, error) {
+	return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	// The keys generated by openssl ecparam may be preceded by the parameters
+	// of their curve
+	for {
+		var block *pem.Block
+		block, content = pem.Decode(content)
+		if block == nil {
+			return nil, fmt.Errorf("no PEM key found in %s", path)
+		}
+		if block.Type != "EC PARAMETERS" {
+			return block, nil
+		}
+	}
+}
+
+// readPrivateKey reads an ECDSA private key, in the SEC 1 or PKCS #8 format,
+// from the PEM file at path.
+func readPrivateKey(path string) (*ecdsa.PrivateKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.New("not an ECDSA private key")
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("not an ECDSA private key")
+	}
+	return ecKey, nil
+}
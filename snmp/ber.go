@@ -0,0 +1,184 @@
+package snmp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// BER tags of the values found in traps
+const (
+	tagInteger     = 0x02
+	tagOctetString = 0x04
+	tagNull        = 0x05
+	tagOID         = 0x06
+	tagSequence    = 0x30
+	tagIPAddress   = 0x40
+	tagCounter32   = 0x41
+	tagGauge32     = 0x42
+	tagTimeTicks   = 0x43
+	tagOpaque      = 0x44
+	tagCounter64   = 0x46
+
+	tagNoSuchObject   = 0x80
+	tagNoSuchInstance = 0x81
+	tagEndOfMibView   = 0x82
+
+	tagResponseV2 = 0xa2
+	tagTrapV1     = 0xa4
+	tagInformV2   = 0xa6
+	tagTrapV2     = 0xa7
+)
+
+var errTruncated = errors.New("truncated packet")
+
+// element is a decoded BER type-length-value.
+type element struct {
+	tag  byte
+	data []byte
+}
+
+// decoder reads the BER elements of a buffer, one after the other.
+type decoder struct {
+	buf []byte
+}
+
+// more returns whether elements remain to be read.
+func (d *decoder) more() bool {
+	return len(d.buf) > 0
+}
+
+// next reads the next element.
+func (d *decoder) next() (element, error) {
+	if len(d.buf) < 2 {
+		return element{}, errTruncated
+	}
+	tag := d.buf[0]
+	length := int(d.buf[1])
+	offset := 2
+
+	// Long form, the length is encoded in the following bytes
+	if length&0x80 != 0 {
+		n := length & 0x7f
+		if n == 0 || n > 4 || len(d.buf) < offset+n {
+			return element{}, errTruncated
+		}
+		length = 0
+		for _, b := range d.buf[offset : offset+n] {
+			length = length<<8 | int(b)
+		}
+		offset += n
+	}
+
+	if length < 0 || len(d.buf) < offset+length {
+		return element{}, errTruncated
+	}
+
+	e := element{tag: tag, data: d.buf[offset : offset+length]}
+	d.buf = d.buf[offset+length:]
+	return e, nil
+}
+
+// expect reads the next element, which must have the given tag.
+func (d *decoder) expect(tag byte) (element, error) {
+	e, err := d.next()
+	if err != nil {
+		return e, err
+	}
+	if e.tag != tag {
+		return e, fmt.Errorf("unexpected tag 0x%02x, expected 0x%02x", e.tag, tag)
+	}
+	return e, nil
+}
+
+// children returns a decoder of the elements of a constructed element.
+func (e element) children() *decoder {
+	return &decoder{buf: e.data}
+}
+
+// integer decodes a signed integer.
+func (e element) integer() (int64, error) {
+	if len(e.data) == 0 || len(e.data) > 8 {
+		return 0, fmt.Errorf("invalid integer of %d bytes", len(e.data))
+	}
+	var n int64
+	if e.data[0]&0x80 != 0 {
+		n = -1
+	}
+	for _, b := range e.data {
+		n = n<<8 | int64(b)
+	}
+	return n, nil
+}
+
+// unsigned decodes an unsigned integer, e.g. a counter or time ticks.
+func (e element) unsigned() (uint64, error) {
+	if len(e.data) == 0 || len(e.data) > 9 {
+		return 0, fmt.Errorf("invalid unsigned integer of %d bytes", len(e.data))
+	}
+	if len(e.data) == 9 && e.data[0] != 0 {
+		return 0, errors.New("unsigned integer overflows 64 bits")
+	}
+	var n uint64
+	for _, b := range e.data {
+		n = n<<8 | uint64(b)
+	}
+	return n, nil
+}
+
+// oid decodes an object identifier in its dotted notation.
+func (e element) oid() (string, error) {
+	if len(e.data) == 0 {
+		return "", errors.New("empty object identifier")
+	}
+
+	var parts []string
+	var n uint64
+	for i, b := range e.data {
+		n = n<<7 | uint64(b&0x7f)
+		if b&0x80 != 0 {
+			if i == len(e.data)-1 {
+				return "", errors.New("truncated object identifier")
+			}
+			continue
+		}
+		if len(parts) == 0 {
+			// The first subidentifier encodes the first two arcs
+			first := n / 40
+			if first > 2 {
+				first = 2
+			}
+			parts = append(parts, strconv.FormatUint(first, 10), strconv.FormatUint(n-first*40, 10))
+		} else {
+			parts = append(parts, strconv.FormatUint(n, 10))
+		}
+		n = 0
+	}
+	return strings.Join(parts, "."), nil
+}
+
+// value decodes the value of a variable binding as a string.
+func (e element) value() (string, error) {
+	switch e.tag {
+	case tagInteger:
+		n, err := e.integer()
+		return strconv.FormatInt(n, 10), err
+	case tagOctetString, tagOpaque:
+		return string(e.data), nil
+	case tagNull, tagNoSuchObject, tagNoSuchInstance, tagEndOfMibView:
+		return "", nil
+	case tagOID:
+		return e.oid()
+	case tagIPAddress:
+		if len(e.data) != 4 {
+			return "", fmt.Errorf("invalid IP address of %d bytes", len(e.data))
+		}
+		return net.IP(e.data).String(), nil
+	case tagCounter32, tagGauge32, tagTimeTicks, tagCounter64:
+		n, err := e.unsigned()
+		return strconv.FormatUint(n, 10), err
+	}
+	return "", fmt.Errorf("unsupported value type 0x%02x", e.tag)
+}
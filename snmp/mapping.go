@@ -0,0 +1,213 @@
+package snmp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// DefaultCheck is the check name of the events translated from the traps,
+	// unless a mapping sets another one.
+	DefaultCheck = "snmp_trap"
+
+	// DefaultStatus is the check status of the events translated from the
+	// traps, unless a mapping sets another one. Traps usually report a
+	// problem, hence a warning.
+	DefaultStatus = 1
+)
+
+// Event fields which variable bindings can be mapped to. The labels and
+// annotations fields are prefixes, followed by the key to set, e.g.
+// annotations.interface.
+const (
+	FieldEntity      = "entity"
+	FieldCheck       = "check"
+	FieldStatus      = "status"
+	FieldOutput      = "output"
+	FieldLabels      = "labels."
+	FieldAnnotations = "annotations."
+)
+
+// Mapping translates the traps it selects into events.
+type Mapping struct {
+	// TrapOID selects the traps translated by the mapping, whose identifier is
+	// either equal to it or one of its descendants. All traps are selected if
+	// empty.
+	TrapOID string `json:"trap_oid"`
+
+	// Check is the check name of the events. Defaults to DefaultCheck.
+	Check string `json:"check"`
+
+	// Status is the check status of the events. Defaults to DefaultStatus.
+	Status *int32 `json:"status"`
+
+	// Varbinds maps the object identifiers of variables to the event fields
+	// their values are set to: entity, check, status, output, labels.KEY or
+	// annotations.KEY. The entity defaults to the address of the trap sender.
+	Varbinds map[string]string `json:"varbinds"`
+
+	// Organization and Environment are the namespace of the events received
+	// by the backend, "default" if empty. The events received by an agent
+	// always belong to the namespace of its entity.
+	Organization string `json:"organization"`
+	Environment  string `json:"environment"`
+}
+
+// Validate returns an error if the mapping is invalid.
+func (m *Mapping) Validate() error {
+	if m.Check != "" {
+		if err := types.ValidateName(m.Check); err != nil {
+			return fmt.Errorf("check name %s", err)
+		}
+	}
+
+	if m.Status != nil && *m.Status < 0 {
+		return errors.New("status must be greater than or equal to 0")
+	}
+
+	for oid, field := range m.Varbinds {
+		switch {
+		case field == FieldEntity, field == FieldCheck, field == FieldStatus, field == FieldOutput:
+		case strings.HasPrefix(field, FieldLabels) && len(field) > len(FieldLabels):
+		case strings.HasPrefix(field, FieldAnnotations) && len(field) > len(FieldAnnotations):
+		default:
+			return fmt.Errorf("variable %s is mapped to unknown field %q", oid, field)
+		}
+	}
+
+	return nil
+}
+
+// Matches returns whether the mapping selects the given trap.
+func (m *Mapping) Matches(trap *Trap) bool {
+	return m.TrapOID == "" || trap.TrapOID == m.TrapOID || strings.HasPrefix(trap.TrapOID, m.TrapOID+".")
+}
+
+// LoadMappings reads the mappings from a JSON file, which contains an array of
+// mappings.
+func LoadMappings(path string) ([]Mapping, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var mappings []Mapping
+	if err := json.Unmarshal(b, &mappings); err != nil {
+		return nil, fmt.Errorf("could not parse the SNMP trap mappings: %s", err)
+	}
+
+	for i := range mappings {
+		if err := mappings[i].Validate(); err != nil {
+			return nil, fmt.Errorf("invalid SNMP trap mapping %d: %s", i, err)
+		}
+	}
+
+	return mappings, nil
+}
+
+// Event translates the trap into an event, using the first of the mappings
+// selecting it. The event has a proxy entity, identified by the sender of
+// the trap unless a variable is mapped to the entity. Traps which aren't
+// selected by any mapping are translated with the default mapping.
+func (t *Trap) Event(mappings []Mapping) (*types.Event, error) {
+	mapping := Mapping{}
+	for _, m := range mappings {
+		if m.Matches(t) {
+			mapping = m
+			break
+		}
+	}
+
+	now := time.Now().Unix()
+	entity := &types.Entity{
+		ID:           t.Address,
+		Class:        types.EntityProxyClass,
+		Organization: valueOrDefault(mapping.Organization, "default"),
+		Environment:  valueOrDefault(mapping.Environment, "default"),
+	}
+	check := &types.Check{
+		Name:         valueOrDefault(mapping.Check, DefaultCheck),
+		Status:       DefaultStatus,
+		Output:       t.output(),
+		Interval:     1,
+		Executed:     now,
+		Organization: entity.Organization,
+		Environment:  entity.Environment,
+	}
+	if mapping.Status != nil {
+		check.Status = *mapping.Status
+	}
+	event := &types.Event{
+		Entity:    entity,
+		Check:     check,
+		Timestamp: now,
+	}
+
+	// Apply the variables in a stable order
+	oids := make([]string, 0, len(mapping.Varbinds))
+	for oid := range mapping.Varbinds {
+		oids = append(oids, oid)
+	}
+	sort.Strings(oids)
+
+	for _, oid := range oids {
+		value, ok := t.Lookup(oid)
+		if !ok {
+			continue
+		}
+
+		switch field := mapping.Varbinds[oid]; {
+		case field == FieldEntity:
+			entity.ID = value
+		case field == FieldCheck:
+			check.Name = value
+		case field == FieldStatus:
+			status, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("variable %s is not a valid status: %q", oid, value)
+			}
+			check.Status = int32(status)
+		case field == FieldOutput:
+			check.Output = value
+		case strings.HasPrefix(field, FieldLabels):
+			if event.Labels == nil {
+				event.Labels = map[string]string{}
+			}
+			event.Labels[strings.TrimPrefix(field, FieldLabels)] = value
+		case strings.HasPrefix(field, FieldAnnotations):
+			if event.Annotations == nil {
+				event.Annotations = map[string]string{}
+			}
+			event.Annotations[strings.TrimPrefix(field, FieldAnnotations)] = value
+		}
+	}
+
+	check.ProxyEntityID = entity.ID
+	return event, nil
+}
+
+// output returns the default check output of the trap, made of its
+// identifier and its variables.
+func (t *Trap) output() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "SNMP trap %s from %s\n", t.TrapOID, t.Address)
+	for _, v := range t.Varbinds {
+		fmt.Fprintf(&b, "%s = %s\n", v.OID, v.Value)
+	}
+	return b.String()
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
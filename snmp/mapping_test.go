@@ -0,0 +1,167 @@
+package snmp
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fixtureTrap() *Trap {
+	return &Trap{
+		Version:   Version2c,
+		Community: "public",
+		Address:   "10.0.0.1",
+		TrapOID:   "1.3.6.1.6.3.1.1.5.3",
+		Varbinds: []Varbind{
+			{OID: "1.3.6.1.2.1.2.2.1.1.2", Value: "2"},
+			{OID: "1.3.6.1.2.1.2.2.1.2.2", Value: "eth1"},
+			{OID: "1.3.6.1.2.1.1.5.0", Value: "switch01"},
+		},
+	}
+}
+
+func TestTrapEventDefault(t *testing.T) {
+	event, err := fixtureTrap().Event(nil)
+	require.NoError(t, err)
+	require.NoError(t, event.Validate())
+
+	assert.Equal(t, "10.0.0.1", event.Entity.ID)
+	assert.Equal(t, types.EntityProxyClass, event.Entity.Class)
+	assert.Equal(t, "default", event.Entity.Organization)
+	assert.Equal(t, DefaultCheck, event.Check.Name)
+	assert.Equal(t, int32(DefaultStatus), event.Check.Status)
+	assert.Equal(t, "10.0.0.1", event.Check.ProxyEntityID)
+	assert.Contains(t, event.Check.Output, "SNMP trap 1.3.6.1.6.3.1.1.5.3 from 10.0.0.1")
+	assert.Contains(t, event.Check.Output, "1.3.6.1.2.1.2.2.1.2.2 = eth1")
+}
+
+func TestTrapEventMapping(t *testing.T) {
+	critical := int32(2)
+	mappings := []Mapping{
+		{
+			TrapOID: "1.3.6.1.4.1",
+			Check:   "enterprise",
+		},
+		{
+			TrapOID:     "1.3.6.1.6.3.1.1.5.3",
+			Check:       "link_down",
+			Status:      &critical,
+			Environment: "network",
+			Varbinds: map[string]string{
+				"1.3.6.1.2.1.1.5.0":     FieldEntity,
+				"1.3.6.1.2.1.2.2.1.2.*": FieldOutput,
+				"1.3.6.1.2.1.2.2.1.1.*": "annotations.ifIndex",
+				"1.3.6.1.2.1.99":        "labels.missing",
+			},
+		},
+	}
+	for _, m := range mappings {
+		require.NoError(t, m.Validate())
+	}
+
+	event, err := fixtureTrap().Event(mappings)
+	require.NoError(t, err)
+	require.NoError(t, event.Validate())
+
+	assert.Equal(t, "switch01", event.Entity.ID)
+	assert.Equal(t, "switch01", event.Check.ProxyEntityID)
+	assert.Equal(t, "network", event.Entity.Environment)
+	assert.Equal(t, "link_down", event.Check.Name)
+	assert.Equal(t, int32(2), event.Check.Status)
+	assert.Equal(t, "eth1", event.Check.Output)
+	assert.Equal(t, map[string]string{"ifIndex": "2"}, event.Annotations)
+	assert.Nil(t, event.Labels)
+
+	// The status can be set by a variable, which must be an integer
+	mappings[1].Varbinds = map[string]string{"1.3.6.1.2.1.2.2.1.1.2": FieldStatus}
+	event, err = fixtureTrap().Event(mappings)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), event.Check.Status)
+
+	mappings[1].Varbinds = map[string]string{"1.3.6.1.2.1.2.2.1.2.2": FieldStatus}
+	_, err = fixtureTrap().Event(mappings)
+	assert.Error(t, err)
+}
+
+func TestMappingValidate(t *testing.T) {
+	m := Mapping{Varbinds: map[string]string{"1.3.6.1.2.1.1.5.0": "severity"}}
+	assert.Error(t, m.Validate())
+
+	m.Varbinds = map[string]string{"1.3.6.1.2.1.1.5.0": "labels."}
+	assert.Error(t, m.Validate())
+
+	m.Varbinds = nil
+	m.Check = "link down"
+	assert.Error(t, m.Validate())
+}
+
+func TestLoadMappings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snmp")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "mappings.json")
+	content := `[{"trap_oid": "1.3.6.1.6.3.1.1.5.3", "check": "link_down", "status": 2,
+		"varbinds": {"1.3.6.1.2.1.2.2.1.2.*": "output"}}]`
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+
+	mappings, err := LoadMappings(path)
+	require.NoError(t, err)
+	require.Len(t, mappings, 1)
+	assert.Equal(t, "link_down", mappings[0].Check)
+	assert.Equal(t, int32(2), *mappings[0].Status)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`[{"varbinds": {"1.3": "severity"}}]`), 0644))
+	_, err = LoadMappings(path)
+	assert.Error(t, err)
+}
+
+func TestReceiver(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	events := make(chan *types.Event, 1)
+	receiver := &Receiver{
+		Communities: []string{"private"},
+		Handler:     func(e *types.Event) { events <- e },
+	}
+	done := make(chan error, 1)
+	go func() { done <- receiver.Serve(conn) }()
+
+	sender, err := net.Dial("udp", conn.LocalAddr().String())
+	require.NoError(t, err)
+	defer func() { _ = sender.Close() }()
+
+	// Traps of unknown communities are discarded
+	_, err = sender.Write(trapV2(tagTrapV2, "public", "1.3.6.1.4.1.8072.2.3.0.1"))
+	require.NoError(t, err)
+
+	// Informs are acknowledged
+	_, err = sender.Write(trapV2(tagInformV2, "private", "1.3.6.1.4.1.8072.2.3.0.1"))
+	require.NoError(t, err)
+
+	require.NoError(t, sender.SetReadDeadline(time.Now().Add(5*time.Second)))
+	buf := make([]byte, maxPacketSize)
+	n, err := sender.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, trapV2(tagResponseV2, "private", "1.3.6.1.4.1.8072.2.3.0.1"), buf[:n])
+
+	select {
+	case event := <-events:
+		assert.Equal(t, "127.0.0.1", event.Entity.ID)
+		assert.Contains(t, event.Check.Output, "1.3.6.1.4.1.8072.2.3.0.1")
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event received")
+	}
+	assert.Empty(t, events)
+
+	require.NoError(t, conn.Close())
+	assert.Error(t, <-done)
+}
@@ -0,0 +1,96 @@
+package snmp
+
+import (
+	"errors"
+	"net"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/types"
+)
+
+// maxPacketSize is the maximum size of the UDP datagrams carrying traps.
+const maxPacketSize = 65507
+
+// Receiver receives the traps sent to a UDP socket and translates them into
+// events.
+type Receiver struct {
+	// Communities are the community strings of the traps accepted. All the
+	// traps are accepted if empty.
+	Communities []string
+
+	// Mappings translate the traps into events.
+	Mappings []Mapping
+
+	// Handler is called with the event translated from each trap.
+	Handler func(*types.Event)
+}
+
+// Serve receives the traps sent to the connection until it is closed.
+func (r *Receiver) Serve(conn net.PacketConn) error {
+	buf := make([]byte, maxPacketSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+
+		if err := r.receive(conn, buf[:n], addr); err != nil {
+			logger.WithError(err).WithField("address", addr.String()).Warn("discarding SNMP trap")
+		}
+	}
+}
+
+// receive handles a single packet sent by the given address.
+func (r *Receiver) receive(conn net.PacketConn, packet []byte, addr net.Addr) error {
+	trap, err := ParseTrap(packet)
+	if err != nil {
+		return err
+	}
+
+	if !r.accepts(trap.Community) {
+		return errors.New("unknown community")
+	}
+
+	if trap.Address == "" {
+		trap.Address = addr.String()
+		if host, _, err := net.SplitHostPort(trap.Address); err == nil {
+			trap.Address = host
+		}
+	}
+
+	if trap.Inform {
+		response, err := acknowledgement(packet)
+		if err != nil {
+			return err
+		}
+		if _, err := conn.WriteTo(response, addr); err != nil {
+			return err
+		}
+	}
+
+	event, err := trap.Event(r.Mappings)
+	if err != nil {
+		return err
+	}
+
+	logger.WithFields(logrus.Fields{
+		"trap":   trap.TrapOID,
+		"entity": event.Entity.ID,
+		"check":  event.Check.Name,
+	}).Debug("received SNMP trap")
+
+	r.Handler(event)
+	return nil
+}
+
+func (r *Receiver) accepts(community string) bool {
+	if len(r.Communities) == 0 {
+		return true
+	}
+	for _, c := range r.Communities {
+		if c == community {
+			return true
+		}
+	}
+	return false
+}
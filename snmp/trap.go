@@ -0,0 +1,285 @@
+// Package snmp receives SNMP traps and translates them into Sensu events, so
+// network gear can alert through the same pipelines as the checks.
+//
+// SNMPv1 and SNMPv2c traps and informs are supported. The variable bindings
+// of the traps are translated into the fields of the events according to
+// configurable mappings.
+package snmp
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Well-known object identifiers of SNMPv2 traps
+const (
+	// OIDSysUpTime is the uptime of the trap sender, sent as the first
+	// variable binding of SNMPv2 traps
+	OIDSysUpTime = "1.3.6.1.2.1.1.3.0"
+
+	// OIDSnmpTrapOID identifies the trap, sent as the second variable binding
+	// of SNMPv2 traps
+	OIDSnmpTrapOID = "1.3.6.1.6.3.1.1.4.1.0"
+
+	// oidGenericTraps prefixes the identifiers of the generic SNMPv1 traps,
+	// e.g. coldStart or linkDown
+	oidGenericTraps = "1.3.6.1.6.3.1.1.5"
+)
+
+// Versions of SNMP, as encoded in the messages
+const (
+	Version1  = 0
+	Version2c = 1
+)
+
+// Varbind is a variable binding of a trap.
+type Varbind struct {
+	// OID is the object identifier of the variable, in its dotted notation
+	OID string
+
+	// Value is the value of the variable, formatted as a string
+	Value string
+}
+
+// Trap is a decoded SNMP trap or inform.
+type Trap struct {
+	// Version is the SNMP version of the message, Version1 or Version2c
+	Version int
+
+	// Community is the community string of the message
+	Community string
+
+	// Address is the address of the trap sender. It is the agent address of
+	// SNMPv1 traps, and is set by the receiver for SNMPv2 traps
+	Address string
+
+	// TrapOID identifies the trap. The identifiers of SNMPv1 traps are
+	// translated as described by RFC 3584
+	TrapOID string
+
+	// Uptime is the uptime of the trap sender, in hundredths of seconds
+	Uptime uint64
+
+	// Varbinds are the variable bindings of the trap. The uptime and trap
+	// identifier of SNMPv2 traps are not included
+	Varbinds []Varbind
+
+	// Inform is whether the message is an inform, which must be acknowledged
+	Inform bool
+}
+
+// Lookup returns the value of the variable with the given object identifier,
+// and whether the trap has such a variable. A trailing ".*" matches any
+// instance of the variable, e.g. 1.3.6.1.2.1.2.2.1.1.* matches ifIndex.2.
+func (t *Trap) Lookup(oid string) (string, bool) {
+	if strings.HasSuffix(oid, ".*") {
+		prefix := strings.TrimSuffix(oid, "*")
+		for _, v := range t.Varbinds {
+			if strings.HasPrefix(v.OID, prefix) {
+				return v.Value, true
+			}
+		}
+		return "", false
+	}
+
+	for _, v := range t.Varbinds {
+		if v.OID == oid {
+			return v.Value, true
+		}
+	}
+	return "", false
+}
+
+// ParseTrap decodes an SNMPv1 or SNMPv2c trap or inform.
+func ParseTrap(packet []byte) (*Trap, error) {
+	d := &decoder{buf: packet}
+	message, err := d.expect(tagSequence)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := message.children()
+	version, err := fields.expect(tagInteger)
+	if err != nil {
+		return nil, err
+	}
+	v, err := version.integer()
+	if err != nil {
+		return nil, err
+	}
+
+	community, err := fields.expect(tagOctetString)
+	if err != nil {
+		return nil, err
+	}
+
+	pdu, err := fields.next()
+	if err != nil {
+		return nil, err
+	}
+
+	trap := &Trap{Version: int(v), Community: string(community.data)}
+	switch {
+	case v == Version1 && pdu.tag == tagTrapV1:
+		err = trap.parseV1(pdu.children())
+	case v == Version2c && (pdu.tag == tagTrapV2 || pdu.tag == tagInformV2):
+		trap.Inform = pdu.tag == tagInformV2
+		err = trap.parseV2(pdu.children())
+	default:
+		return nil, fmt.Errorf("unsupported SNMP version %d or PDU type 0x%02x", v, pdu.tag)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return trap, nil
+}
+
+// parseV1 decodes the fields of an SNMPv1 Trap-PDU.
+func (t *Trap) parseV1(d *decoder) error {
+	e, err := d.expect(tagOID)
+	if err != nil {
+		return err
+	}
+	enterprise, err := e.oid()
+	if err != nil {
+		return err
+	}
+
+	e, err = d.expect(tagIPAddress)
+	if err != nil {
+		return err
+	}
+	if t.Address, err = e.value(); err != nil {
+		return err
+	}
+
+	var generic, specific int64
+	for _, n := range []*int64{&generic, &specific} {
+		if e, err = d.expect(tagInteger); err != nil {
+			return err
+		}
+		if *n, err = e.integer(); err != nil {
+			return err
+		}
+	}
+
+	if e, err = d.expect(tagTimeTicks); err != nil {
+		return err
+	}
+	if t.Uptime, err = e.unsigned(); err != nil {
+		return err
+	}
+
+	// RFC 3584 section 3.1
+	if generic < 6 {
+		t.TrapOID = oidGenericTraps + "." + strconv.FormatInt(generic+1, 10)
+	} else {
+		t.TrapOID = enterprise + ".0." + strconv.FormatInt(specific, 10)
+	}
+
+	t.Varbinds, err = parseVarbinds(d)
+	return err
+}
+
+// parseV2 decodes the fields of an SNMPv2-Trap-PDU or InformRequest-PDU.
+func (t *Trap) parseV2(d *decoder) error {
+	// Skip the request ID, error status and error index
+	for i := 0; i < 3; i++ {
+		if _, err := d.expect(tagInteger); err != nil {
+			return err
+		}
+	}
+
+	varbinds, err := parseVarbinds(d)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range varbinds {
+		switch v.OID {
+		case OIDSysUpTime:
+			if t.Uptime, err = strconv.ParseUint(v.Value, 10, 64); err != nil {
+				return fmt.Errorf("invalid sysUpTime: %s", err)
+			}
+		case OIDSnmpTrapOID:
+			t.TrapOID = v.Value
+		default:
+			t.Varbinds = append(t.Varbinds, v)
+		}
+	}
+
+	if t.TrapOID == "" {
+		return errors.New("missing snmpTrapOID variable binding")
+	}
+	return nil
+}
+
+// parseVarbinds decodes a sequence of variable bindings.
+func parseVarbinds(d *decoder) ([]Varbind, error) {
+	list, err := d.expect(tagSequence)
+	if err != nil {
+		return nil, err
+	}
+
+	varbinds := []Varbind{}
+	for entries := list.children(); entries.more(); {
+		entry, err := entries.expect(tagSequence)
+		if err != nil {
+			return nil, err
+		}
+
+		fields := entry.children()
+		name, err := fields.expect(tagOID)
+		if err != nil {
+			return nil, err
+		}
+		oid, err := name.oid()
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := fields.next()
+		if err != nil {
+			return nil, err
+		}
+		value, err := e.value()
+		if err != nil {
+			return nil, fmt.Errorf("variable %s: %s", oid, err)
+		}
+
+		varbinds = append(varbinds, Varbind{OID: oid, Value: value})
+	}
+	return varbinds, nil
+}
+
+// acknowledgement returns the response to an inform, which is the inform
+// itself with its PDU type changed to Response-PDU.
+func acknowledgement(packet []byte) ([]byte, error) {
+	d := &decoder{buf: packet}
+	message, err := d.expect(tagSequence)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := message.children()
+	for i := 0; i < 2; i++ {
+		if _, err := fields.next(); err != nil {
+			return nil, err
+		}
+	}
+
+	// The PDU follows the version and community of the message
+	start := len(packet) - len(d.buf) - len(message.data)
+	offset := start + len(message.data) - len(fields.buf)
+	if offset >= len(packet) || packet[offset] != tagInformV2 {
+		return nil, errors.New("not an inform")
+	}
+
+	response := make([]byte, len(packet))
+	copy(response, packet)
+	response[offset] = tagResponseV2
+	return response, nil
+}
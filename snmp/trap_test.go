@@ -0,0 +1,182 @@
+package snmp
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tlv encodes a BER element.
+func tlv(tag byte, parts ...[]byte) []byte {
+	var value []byte
+	for _, p := range parts {
+		value = append(value, p...)
+	}
+
+	b := []byte{tag}
+	if len(value) < 0x80 {
+		b = append(b, byte(len(value)))
+	} else {
+		b = append(b, 0x82, byte(len(value)>>8), byte(len(value)))
+	}
+	return append(b, value...)
+}
+
+func integer(n int) []byte {
+	return tlv(tagInteger, []byte{byte(n)})
+}
+
+func octets(s string) []byte {
+	return tlv(tagOctetString, []byte(s))
+}
+
+func oid(s string) []byte {
+	var arcs []uint64
+	for _, arc := range strings.Split(s, ".") {
+		n, _ := strconv.ParseUint(arc, 10, 64)
+		arcs = append(arcs, n)
+	}
+
+	b := []byte{byte(arcs[0]*40 + arcs[1])}
+	for _, arc := range arcs[2:] {
+		var sub []byte
+		for {
+			sub = append([]byte{byte(arc & 0x7f)}, sub...)
+			arc >>= 7
+			if arc == 0 {
+				break
+			}
+		}
+		for i := 0; i < len(sub)-1; i++ {
+			sub[i] |= 0x80
+		}
+		b = append(b, sub...)
+	}
+	return tlv(tagOID, b)
+}
+
+func varbind(name string, value []byte) []byte {
+	return tlv(tagSequence, oid(name), value)
+}
+
+func trapV1(community string, generic, specific int, varbinds ...[]byte) []byte {
+	return tlv(tagSequence,
+		integer(Version1),
+		octets(community),
+		tlv(tagTrapV1,
+			oid("1.3.6.1.4.1.8072.2.3"),
+			tlv(tagIPAddress, []byte{192, 168, 1, 10}),
+			integer(generic),
+			integer(specific),
+			tlv(tagTimeTicks, []byte{0x01, 0x00}),
+			tlv(tagSequence, varbinds...),
+		),
+	)
+}
+
+func trapV2(pdu byte, community, trapOID string, varbinds ...[]byte) []byte {
+	all := append([][]byte{
+		varbind(OIDSysUpTime, tlv(tagTimeTicks, []byte{0x01, 0x00})),
+		varbind(OIDSnmpTrapOID, oid(trapOID)),
+	}, varbinds...)
+
+	return tlv(tagSequence,
+		integer(Version2c),
+		octets(community),
+		tlv(pdu,
+			integer(42),
+			integer(0),
+			integer(0),
+			tlv(tagSequence, all...),
+		),
+	)
+}
+
+func TestParseTrapV1(t *testing.T) {
+	packet := trapV1("public", 2, 0,
+		varbind("1.3.6.1.2.1.2.2.1.1.2", integer(2)),
+		varbind("1.3.6.1.2.1.2.2.1.2.2", octets("eth1")),
+	)
+
+	trap, err := ParseTrap(packet)
+	require.NoError(t, err)
+	assert.Equal(t, Version1, trap.Version)
+	assert.Equal(t, "public", trap.Community)
+	assert.Equal(t, "192.168.1.10", trap.Address)
+	assert.Equal(t, "1.3.6.1.6.3.1.1.5.3", trap.TrapOID) // linkDown
+	assert.Equal(t, uint64(256), trap.Uptime)
+	assert.Equal(t, []Varbind{
+		{OID: "1.3.6.1.2.1.2.2.1.1.2", Value: "2"},
+		{OID: "1.3.6.1.2.1.2.2.1.2.2", Value: "eth1"},
+	}, trap.Varbinds)
+	assert.False(t, trap.Inform)
+
+	// Enterprise specific traps
+	trap, err = ParseTrap(trapV1("public", 6, 17))
+	require.NoError(t, err)
+	assert.Equal(t, "1.3.6.1.4.1.8072.2.3.0.17", trap.TrapOID)
+}
+
+func TestParseTrapV2(t *testing.T) {
+	packet := trapV2(tagTrapV2, "private", "1.3.6.1.4.1.8072.2.3.0.1",
+		varbind("1.3.6.1.4.1.8072.2.3.2.1", octets("disk full")),
+		varbind("1.3.6.1.4.1.8072.2.3.2.2", tlv(tagCounter32, []byte{0x00, 0xff, 0xff, 0xff, 0xff})),
+		varbind("1.3.6.1.4.1.8072.2.3.2.3", tlv(tagIPAddress, []byte{10, 0, 0, 1})),
+	)
+
+	trap, err := ParseTrap(packet)
+	require.NoError(t, err)
+	assert.Equal(t, Version2c, trap.Version)
+	assert.Equal(t, "private", trap.Community)
+	assert.Empty(t, trap.Address)
+	assert.Equal(t, "1.3.6.1.4.1.8072.2.3.0.1", trap.TrapOID)
+	assert.Equal(t, uint64(256), trap.Uptime)
+	assert.Equal(t, []Varbind{
+		{OID: "1.3.6.1.4.1.8072.2.3.2.1", Value: "disk full"},
+		{OID: "1.3.6.1.4.1.8072.2.3.2.2", Value: "4294967295"},
+		{OID: "1.3.6.1.4.1.8072.2.3.2.3", Value: "10.0.0.1"},
+	}, trap.Varbinds)
+
+	value, ok := trap.Lookup("1.3.6.1.4.1.8072.2.3.2.*")
+	assert.True(t, ok)
+	assert.Equal(t, "disk full", value)
+	_, ok = trap.Lookup("1.3.6.1.4.1.8072.2.3.2")
+	assert.False(t, ok)
+}
+
+func TestParseTrapInvalid(t *testing.T) {
+	packet := trapV2(tagTrapV2, "public", "1.3.6.1.4.1.8072.2.3.0.1")
+
+	_, err := ParseTrap(packet[:len(packet)-3])
+	assert.Error(t, err)
+
+	// GetRequest PDUs are not traps
+	_, err = ParseTrap(trapV2(0xa0, "public", "1.3.6.1.4.1.8072.2.3.0.1"))
+	assert.Error(t, err)
+
+	// The trap identifier is required
+	_, err = ParseTrap(tlv(tagSequence,
+		integer(Version2c),
+		octets("public"),
+		tlv(tagTrapV2, integer(1), integer(0), integer(0), tlv(tagSequence)),
+	))
+	assert.Error(t, err)
+}
+
+func TestAcknowledgement(t *testing.T) {
+	inform := trapV2(tagInformV2, "public", "1.3.6.1.4.1.8072.2.3.0.1")
+
+	trap, err := ParseTrap(inform)
+	require.NoError(t, err)
+	assert.True(t, trap.Inform)
+
+	response, err := acknowledgement(inform)
+	require.NoError(t, err)
+	assert.Equal(t, trapV2(tagResponseV2, "public", "1.3.6.1.4.1.8072.2.3.0.1"), response)
+
+	_, err = acknowledgement(trapV2(tagTrapV2, "public", "1.3.6.1.4.1.8072.2.3.0.1"))
+	assert.Error(t, err)
+}
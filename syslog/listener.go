@@ -0,0 +1,133 @@
+package syslog
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/types"
+)
+
+// maxMessageSize is the maximum size of the messages received, larger
+// messages are discarded.
+const maxMessageSize = 65536
+
+// Listener receives syslog messages and translates the ones matching its
+// patterns into events.
+type Listener struct {
+	// Patterns translate the messages into events.
+	Patterns []Pattern
+
+	// Handler is called with the event translated from each message matching
+	// a pattern.
+	Handler func(*types.Event)
+}
+
+// Serve receives the messages sent to the UDP connection until it is closed,
+// one message per datagram.
+func (l *Listener) Serve(conn net.PacketConn) error {
+	buf := make([]byte, maxMessageSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+		l.receive(buf[:n], addr)
+	}
+}
+
+// ServeTCP accepts connections until the listener is closed, and receives
+// the messages sent over them.
+func (l *Listener) ServeTCP(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go l.handleConn(conn)
+	}
+}
+
+// handleConn receives the messages sent over a TCP connection, framed either
+// by octet counting or by newlines as described by RFC 6587.
+func (l *Listener) handleConn(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	r := bufio.NewReaderSize(conn, maxMessageSize)
+	for {
+		msg, err := readFrame(r)
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			logger.WithError(err).WithField("address", conn.RemoteAddr().String()).Warn("closing syslog connection")
+			return
+		}
+		l.receive(msg, conn.RemoteAddr())
+	}
+}
+
+// readFrame reads the next message of a TCP stream.
+func readFrame(r *bufio.Reader) ([]byte, error) {
+	first, err := r.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+
+	// Non-transparent framing, the messages are terminated by newlines
+	if first[0] < '0' || first[0] > '9' {
+		line, err := r.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			return nil, errors.New("message too large")
+		}
+		if err == io.EOF && len(line) > 0 {
+			err = nil
+		}
+		return line, err
+	}
+
+	// Octet counting, the messages are prefixed by their length
+	prefix, err := r.ReadString(' ')
+	if err != nil {
+		return nil, err
+	}
+	length, err := strconv.Atoi(prefix[:len(prefix)-1])
+	if err != nil || length <= 0 || length > maxMessageSize {
+		return nil, errors.New("invalid message length")
+	}
+	msg := make([]byte, length)
+	_, err = io.ReadFull(r, msg)
+	return msg, err
+}
+
+// receive handles a single message sent by the given address.
+func (l *Listener) receive(b []byte, addr net.Addr) {
+	msg, err := ParseMessage(b, time.Now())
+	if err != nil {
+		logger.WithError(err).WithField("address", addr.String()).Warn("discarding syslog message")
+		return
+	}
+
+	if msg.Hostname == "" {
+		msg.Hostname = addr.String()
+		if host, _, err := net.SplitHostPort(msg.Hostname); err == nil {
+			msg.Hostname = host
+		}
+	}
+
+	event := msg.Event(l.Patterns)
+	if event == nil {
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"entity": event.Entity.ID,
+		"check":  event.Check.Name,
+	}).Debug("received syslog message")
+
+	l.Handler(event)
+}
@@ -0,0 +1,200 @@
+// Package syslog receives syslog messages and translates the ones matching
+// configurable patterns into Sensu events, so legacy appliances can feed the
+// pipelines without a log forwarder.
+//
+// Both the BSD (RFC 3164) and the IETF (RFC 5424) formats are supported, over
+// UDP or TCP.
+package syslog
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Severities of the syslog messages
+const (
+	SeverityEmergency = iota
+	SeverityAlert
+	SeverityCritical
+	SeverityError
+	SeverityWarning
+	SeverityNotice
+	SeverityInformational
+	SeverityDebug
+)
+
+// nilValue is the placeholder of the missing fields of RFC 5424 messages.
+const nilValue = "-"
+
+// rfc3164Timestamp is the format of the timestamps of RFC 3164 messages,
+// which have neither a year nor a time zone.
+const rfc3164Timestamp = "Jan _2 15:04:05"
+
+// Message is a decoded syslog message.
+type Message struct {
+	// Facility and Severity are decoded from the priority of the message
+	Facility int
+	Severity int
+
+	// Timestamp is the time of the message, or the time it was received if
+	// it has none
+	Timestamp time.Time
+
+	// Hostname is the host which sent the message, empty if unknown
+	Hostname string
+
+	// AppName is the application which sent the message, i.e. the tag of
+	// RFC 3164 messages
+	AppName string
+
+	// ProcID is the process ID of the application, empty if unknown
+	ProcID string
+
+	// MsgID identifies the type of RFC 5424 messages
+	MsgID string
+
+	// Content is the free-form text of the message
+	Content string
+}
+
+// ParseMessage decodes a syslog message, in either the RFC 5424 or the RFC
+// 3164 format.
+func ParseMessage(b []byte, now time.Time) (*Message, error) {
+	s := strings.TrimRight(string(b), "\r\n\x00")
+	if !strings.HasPrefix(s, "<") {
+		return nil, errors.New("missing priority")
+	}
+	end := strings.IndexByte(s, '>')
+	if end < 2 || end > 4 {
+		return nil, errors.New("invalid priority")
+	}
+	priority, err := strconv.Atoi(s[1:end])
+	if err != nil || priority > 191 {
+		return nil, fmt.Errorf("invalid priority %q", s[1:end])
+	}
+
+	msg := &Message{
+		Facility:  priority / 8,
+		Severity:  priority % 8,
+		Timestamp: now,
+	}
+
+	s = s[end+1:]
+	if strings.HasPrefix(s, "1 ") {
+		err = msg.parseRFC5424(s[2:])
+	} else {
+		msg.parseRFC3164(s, now)
+	}
+	return msg, err
+}
+
+// parseRFC5424 decodes the header, structured data and message of an RFC
+// 5424 message, following its version.
+func (m *Message) parseRFC5424(s string) error {
+	fields := strings.SplitN(s, " ", 6)
+	if len(fields) < 6 {
+		return errors.New("truncated RFC 5424 header")
+	}
+
+	if fields[0] != nilValue {
+		t, err := time.Parse(time.RFC3339Nano, fields[0])
+		if err != nil {
+			return fmt.Errorf("invalid timestamp %q", fields[0])
+		}
+		m.Timestamp = t
+	}
+	m.Hostname = nilOrValue(fields[1])
+	m.AppName = nilOrValue(fields[2])
+	m.ProcID = nilOrValue(fields[3])
+	m.MsgID = nilOrValue(fields[4])
+
+	rest, err := skipStructuredData(fields[5])
+	if err != nil {
+		return err
+	}
+	m.Content = strings.TrimPrefix(strings.TrimPrefix(rest, " "), "\ufeff")
+	return nil
+}
+
+// parseRFC3164 decodes an RFC 3164 message. The format is loosely followed by
+// the senders, so the fields which can't be decoded are left empty and the
+// remainder of the message is its content.
+func (m *Message) parseRFC3164(s string, now time.Time) {
+	if len(s) > len(rfc3164Timestamp) {
+		t, err := time.ParseInLocation(rfc3164Timestamp, s[:len(rfc3164Timestamp)], now.Location())
+		if err == nil {
+			// The year is assumed to be the current one, unless the message
+			// would be from the future, e.g. sent on December 31st
+			t = t.AddDate(now.Year(), 0, 0)
+			if t.After(now.Add(24 * time.Hour)) {
+				t = t.AddDate(-1, 0, 0)
+			}
+			m.Timestamp = t
+			s = strings.TrimPrefix(s[len(rfc3164Timestamp):], " ")
+
+			// The hostname follows the timestamp
+			if i := strings.IndexByte(s, ' '); i > 0 && !strings.HasSuffix(s[:i], ":") {
+				m.Hostname = s[:i]
+				s = s[i+1:]
+			}
+		}
+	}
+
+	// The tag is made of the application name and optionally its process ID,
+	// e.g. sshd[1234]:
+	if i := strings.IndexByte(s, ':'); i > 0 && !strings.ContainsAny(s[:i], " \t") {
+		tag := s[:i]
+		if j := strings.IndexByte(tag, '['); j > 0 && strings.HasSuffix(tag, "]") {
+			m.ProcID = tag[j+1 : len(tag)-1]
+			tag = tag[:j]
+		}
+		m.AppName = tag
+		s = s[i+1:]
+	}
+
+	m.Content = strings.TrimSpace(s)
+}
+
+// skipStructuredData returns the remainder of an RFC 5424 message following
+// its structured data, which are not used.
+func skipStructuredData(s string) (string, error) {
+	if strings.HasPrefix(s, nilValue) {
+		return s[1:], nil
+	}
+
+	for strings.HasPrefix(s, "[") {
+		quoted, escaped := false, false
+		end := -1
+		for i := 1; i < len(s) && end < 0; i++ {
+			switch {
+			case escaped:
+				escaped = false
+			case s[i] == '\\':
+				escaped = true
+			case s[i] == '"':
+				quoted = !quoted
+			case s[i] == ']' && !quoted:
+				end = i
+			}
+		}
+		if end < 0 {
+			return "", errors.New("unterminated structured data element")
+		}
+		s = s[end+1:]
+	}
+
+	if s != "" && !strings.HasPrefix(s, " ") {
+		return "", errors.New("invalid structured data")
+	}
+	return s, nil
+}
+
+func nilOrValue(field string) string {
+	if field == nilValue {
+		return ""
+	}
+	return field
+}
@@ -0,0 +1,78 @@
+package syslog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2018, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+func TestParseRFC3164(t *testing.T) {
+	msg, err := ParseMessage([]byte("<34>Jun  5 22:14:15 mymachine su[42]: 'su root' failed for lonvick on /dev/pts/8\n"), now)
+	require.NoError(t, err)
+	assert.Equal(t, 4, msg.Facility)
+	assert.Equal(t, SeverityCritical, msg.Severity)
+	assert.Equal(t, time.Date(2018, time.June, 5, 22, 14, 15, 0, time.UTC), msg.Timestamp)
+	assert.Equal(t, "mymachine", msg.Hostname)
+	assert.Equal(t, "su", msg.AppName)
+	assert.Equal(t, "42", msg.ProcID)
+	assert.Equal(t, "'su root' failed for lonvick on /dev/pts/8", msg.Content)
+
+	// Messages from the end of the previous year
+	msg, err = ParseMessage([]byte("<13>Dec 31 23:59:59 host app: happy new year"), now)
+	require.NoError(t, err)
+	assert.Equal(t, 2017, msg.Timestamp.Year())
+
+	// Without hostname
+	msg, err = ParseMessage([]byte("<13>Jun 15 11:59:59 app: no hostname"), now)
+	require.NoError(t, err)
+	assert.Empty(t, msg.Hostname)
+	assert.Equal(t, "app", msg.AppName)
+	assert.Equal(t, "no hostname", msg.Content)
+
+	// Without any header
+	msg, err = ParseMessage([]byte("<13>link down on port 4"), now)
+	require.NoError(t, err)
+	assert.Equal(t, now, msg.Timestamp)
+	assert.Empty(t, msg.AppName)
+	assert.Equal(t, "link down on port 4", msg.Content)
+}
+
+func TestParseRFC5424(t *testing.T) {
+	msg, err := ParseMessage([]byte(`<165>1 2018-06-11T22:14:15.003Z mymachine.example.com evntslog - ID47 [exampleSDID@32473 iut="3" eventSource="App]lication"][other@1 a="\"b\""] `+"\ufeff"+`An application event`), now)
+	require.NoError(t, err)
+	assert.Equal(t, 20, msg.Facility)
+	assert.Equal(t, SeverityNotice, msg.Severity)
+	assert.Equal(t, time.Date(2018, time.June, 11, 22, 14, 15, 3000000, time.UTC), msg.Timestamp)
+	assert.Equal(t, "mymachine.example.com", msg.Hostname)
+	assert.Equal(t, "evntslog", msg.AppName)
+	assert.Empty(t, msg.ProcID)
+	assert.Equal(t, "ID47", msg.MsgID)
+	assert.Equal(t, "An application event", msg.Content)
+
+	msg, err = ParseMessage([]byte("<14>1 - - - - - -"), now)
+	require.NoError(t, err)
+	assert.Equal(t, now, msg.Timestamp)
+	assert.Empty(t, msg.Hostname)
+	assert.Empty(t, msg.Content)
+}
+
+func TestParseMessageInvalid(t *testing.T) {
+	tests := []string{
+		"no priority",
+		"<>empty priority",
+		"<192>out of range",
+		"<14>1 yesterday host app - - - content",
+		"<14>1 - host app",
+		`<14>1 - host app - - [id a="b" content`,
+	}
+	for _, tc := range tests {
+		t.Run(tc, func(t *testing.T) {
+			_, err := ParseMessage([]byte(tc), now)
+			assert.Error(t, err)
+		})
+	}
+}
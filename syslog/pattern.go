@@ -0,0 +1,217 @@
+package syslog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// GroupEntity is the name of the capture group of a pattern which identifies
+// the entity of the events, instead of the hostname of the messages.
+const GroupEntity = "entity"
+
+// Pattern translates the syslog messages it matches into events.
+type Pattern struct {
+	// Check is the check name of the events.
+	Check string `json:"check"`
+
+	// Match is the regular expression matched against the content of the
+	// messages. Its named capture groups can identify the entity, or be
+	// reported as metric points.
+	Match string `json:"match"`
+
+	// AppName restricts the pattern to the messages of an application, e.g.
+	// sshd. All the messages are matched if empty.
+	AppName string `json:"app_name"`
+
+	// MaxSeverity restricts the pattern to the messages at least as severe,
+	// i.e. whose severity is lower or equal. All the messages are matched if
+	// nil.
+	MaxSeverity *int `json:"max_severity"`
+
+	// Status is the check status of the events. Defaults to the status
+	// matching the severity of the message: critical for errors and above,
+	// warning for warnings and OK otherwise.
+	Status *int32 `json:"status"`
+
+	// Metrics are the capture groups whose numeric values are reported as
+	// metric points, named after the groups.
+	Metrics []string `json:"metrics"`
+
+	// Handlers are the handlers of the metric points.
+	Handlers []string `json:"handlers"`
+
+	regexp *regexp.Regexp
+}
+
+// Validate returns an error if the pattern is invalid, and compiles its
+// regular expression.
+func (p *Pattern) Validate() error {
+	if err := types.ValidateName(p.Check); err != nil {
+		return fmt.Errorf("check name %s", err)
+	}
+
+	if p.Match == "" {
+		return errors.New("match must not be empty")
+	}
+	re, err := regexp.Compile(p.Match)
+	if err != nil {
+		return err
+	}
+
+	if p.MaxSeverity != nil && (*p.MaxSeverity < SeverityEmergency || *p.MaxSeverity > SeverityDebug) {
+		return errors.New("max severity must be between 0 and 7")
+	}
+
+	if p.Status != nil && *p.Status < 0 {
+		return errors.New("status must be greater than or equal to 0")
+	}
+
+	for _, metric := range p.Metrics {
+		if !hasGroup(re, metric) {
+			return fmt.Errorf("metric %s is not a capture group of the pattern", metric)
+		}
+	}
+
+	p.regexp = re
+	return nil
+}
+
+// LoadPatterns reads the patterns from a JSON file, which contains an array of
+// patterns.
+func LoadPatterns(path string) ([]Pattern, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var patterns []Pattern
+	if err := json.Unmarshal(b, &patterns); err != nil {
+		return nil, fmt.Errorf("could not parse the syslog patterns: %s", err)
+	}
+
+	for i := range patterns {
+		if err := patterns[i].Validate(); err != nil {
+			return nil, fmt.Errorf("invalid syslog pattern %d: %s", i, err)
+		}
+	}
+
+	return patterns, nil
+}
+
+// Event translates the message into an event, using the first of the
+// patterns matching it, and returns nil if none does. The patterns must have
+// been validated. The entity of the event is the host which sent the message,
+// unless the pattern captures another one.
+func (m *Message) Event(patterns []Pattern) *types.Event {
+	for i := range patterns {
+		if event := patterns[i].event(m); event != nil {
+			return event
+		}
+	}
+	return nil
+}
+
+// event translates the message into an event if the pattern matches it.
+func (p *Pattern) event(m *Message) *types.Event {
+	if p.regexp == nil {
+		return nil
+	}
+	if p.AppName != "" && p.AppName != m.AppName {
+		return nil
+	}
+	if p.MaxSeverity != nil && m.Severity > *p.MaxSeverity {
+		return nil
+	}
+
+	match := p.regexp.FindStringSubmatch(m.Content)
+	if match == nil {
+		return nil
+	}
+	groups := map[string]string{}
+	for i, name := range p.regexp.SubexpNames() {
+		if name != "" && match[i] != "" {
+			groups[name] = match[i]
+		}
+	}
+
+	entity := &types.Entity{
+		ID:    m.Hostname,
+		Class: types.EntityProxyClass,
+	}
+	if id, ok := groups[GroupEntity]; ok {
+		entity.ID = id
+	}
+
+	check := &types.Check{
+		Name:          p.Check,
+		Status:        severityStatus(m.Severity),
+		Output:        m.Content,
+		Interval:      1,
+		Executed:      m.Timestamp.Unix(),
+		ProxyEntityID: entity.ID,
+	}
+	if p.Status != nil {
+		check.Status = *p.Status
+	}
+
+	event := &types.Event{
+		Entity:    entity,
+		Check:     check,
+		Timestamp: m.Timestamp.Unix(),
+	}
+
+	if points := p.points(groups, m.Timestamp); len(points) > 0 {
+		event.Metrics = &types.Metrics{
+			Handlers: p.Handlers,
+			Points:   points,
+		}
+	}
+
+	return event
+}
+
+// points returns the metric points of the captured groups. The values which
+// are not numbers are skipped.
+func (p *Pattern) points(groups map[string]string, timestamp time.Time) []*types.MetricPoint {
+	var points []*types.MetricPoint
+	for _, name := range p.Metrics {
+		value, err := strconv.ParseFloat(groups[name], 64)
+		if err != nil {
+			continue
+		}
+		points = append(points, &types.MetricPoint{
+			Name:      name,
+			Value:     value,
+			Timestamp: timestamp.UnixNano(),
+			Tags:      []*types.MetricTag{},
+		})
+	}
+	return points
+}
+
+// severityStatus maps the severity of a message to a check status.
+func severityStatus(severity int) int32 {
+	switch {
+	case severity <= SeverityError:
+		return 2
+	case severity == SeverityWarning:
+		return 1
+	}
+	return 0
+}
+
+func hasGroup(re *regexp.Regexp, name string) bool {
+	for _, n := range re.SubexpNames() {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+package syslog
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageEvent(t *testing.T) {
+	warning := SeverityWarning
+	patterns := []Pattern{
+		{
+			Check:   "sshd_auth",
+			Match:   `Failed password for (?P<user>\S+)`,
+			AppName: "sshd",
+		},
+		{
+			Check:       "link_flap",
+			Match:       `port (?P<port>\d+) on (?P<entity>\S+) flapped (?P<count>\d+) times`,
+			MaxSeverity: &warning,
+			Metrics:     []string{"count", "port"},
+			Handlers:    []string{"influxdb"},
+		},
+	}
+	for i := range patterns {
+		require.NoError(t, patterns[i].Validate())
+	}
+
+	msg := &Message{
+		Severity:  SeverityWarning,
+		Timestamp: now,
+		Hostname:  "switch01",
+		AppName:   "kernel",
+		Content:   "port 4 on switch02 flapped 12 times",
+	}
+	event := msg.Event(patterns)
+	require.NotNil(t, event)
+	assert.Equal(t, "switch02", event.Entity.ID)
+	assert.Equal(t, types.EntityProxyClass, event.Entity.Class)
+	assert.Equal(t, "link_flap", event.Check.Name)
+	assert.Equal(t, int32(1), event.Check.Status)
+	assert.Equal(t, "switch02", event.Check.ProxyEntityID)
+	assert.Equal(t, now.Unix(), event.Check.Executed)
+	assert.Equal(t, msg.Content, event.Check.Output)
+	require.NotNil(t, event.Metrics)
+	assert.Equal(t, []string{"influxdb"}, event.Metrics.Handlers)
+	require.Len(t, event.Metrics.Points, 2)
+	assert.Equal(t, "count", event.Metrics.Points[0].Name)
+	assert.Equal(t, float64(12), event.Metrics.Points[0].Value)
+	assert.Equal(t, now.UnixNano(), event.Metrics.Points[0].Timestamp)
+
+	// Less severe messages are not matched
+	msg.Severity = SeverityNotice
+	assert.Nil(t, msg.Event(patterns))
+
+	msg = &Message{
+		Severity:  SeverityError,
+		Timestamp: now,
+		Hostname:  "bastion",
+		AppName:   "sshd",
+		Content:   "Failed password for root from 10.0.0.1",
+	}
+	event = msg.Event(patterns)
+	require.NotNil(t, event)
+	assert.Equal(t, "bastion", event.Entity.ID)
+	assert.Equal(t, int32(2), event.Check.Status)
+	assert.Nil(t, event.Metrics)
+
+	msg.AppName = "login"
+	assert.Nil(t, msg.Event(patterns))
+}
+
+func TestPatternValidate(t *testing.T) {
+	severity := 8
+	tests := []Pattern{
+		{Match: "error"},
+		{Check: "errors"},
+		{Check: "errors", Match: "(error"},
+		{Check: "errors", Match: "error", MaxSeverity: &severity},
+		{Check: "errors", Match: "(?P<code>\\d+)", Metrics: []string{"count"}},
+	}
+	for _, p := range tests {
+		assert.Error(t, p.Validate())
+	}
+}
+
+func TestLoadPatterns(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syslog")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "patterns.json")
+	content := `[{"check": "disk_errors", "match": "I/O error", "status": 2}]`
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+
+	patterns, err := LoadPatterns(path)
+	require.NoError(t, err)
+	require.Len(t, patterns, 1)
+	msg := &Message{Severity: SeverityInformational, Hostname: "db01", Content: "sda: I/O error"}
+	event := msg.Event(patterns)
+	require.NotNil(t, event)
+	assert.Equal(t, int32(2), event.Check.Status)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`[{"check": "disk_errors"}]`), 0644))
+	_, err = LoadPatterns(path)
+	assert.Error(t, err)
+}
+
+func TestListener(t *testing.T) {
+	patterns := []Pattern{{Check: "disk_errors", Match: "I/O error"}}
+	require.NoError(t, patterns[0].Validate())
+
+	events := make(chan *types.Event, 3)
+	listener := &Listener{
+		Patterns: patterns,
+		Handler:  func(e *types.Event) { events <- e },
+	}
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	go func() { _ = listener.Serve(conn) }()
+
+	tcp, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = tcp.Close() }()
+	go func() { _ = listener.ServeTCP(tcp) }()
+
+	udpClient, err := net.Dial("udp", conn.LocalAddr().String())
+	require.NoError(t, err)
+	defer func() { _ = udpClient.Close() }()
+
+	// Messages without hostname are identified by their sender
+	_, err = udpClient.Write([]byte("<11>kernel: sda: I/O error"))
+	require.NoError(t, err)
+	select {
+	case event := <-events:
+		assert.Equal(t, "127.0.0.1", event.Entity.ID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event received")
+	}
+
+	// Both framings are supported over TCP
+	tcpClient, err := net.Dial("tcp", tcp.Addr().String())
+	require.NoError(t, err)
+	counted := "<11>1 - db02 kernel - - - I/O error"
+	_, err = fmt.Fprintf(tcpClient, "<11>1 - db01 kernel - - - sda: I/O error\n<14>1 - db01 cron - - - ignored\n%d %s", len(counted), counted)
+	require.NoError(t, err)
+	require.NoError(t, tcpClient.Close())
+
+	for _, entity := range []string{"db01", "db02"} {
+		select {
+		case event := <-events:
+			assert.Equal(t, entity, event.Entity.ID)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event received")
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/sensu/sensu-go/types"
 	"github.com/shirou/gopsutil/host"
 	"github.com/shirou/gopsutil/net"
+	"github.com/shirou/gopsutil/process"
 )
 
 // Info describes the local system, hostname, OS, platform, platform
@@ -64,3 +65,27 @@ func NetworkInfo() (types.Network, error) {
 
 	return network, nil
 }
+
+// ProcessNames returns the names of the running processes.
+func ProcessNames() ([]string, error) {
+	pids, err := process.Pids()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(pids))
+	for _, pid := range pids {
+		p, err := process.NewProcess(pid)
+		if err != nil {
+			// The process may have exited in the meantime
+			continue
+		}
+		name, err := p.Name()
+		if err != nil {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	return names, nil
+}
@@ -24,3 +24,9 @@ func TestInfo(t *testing.T) {
 	//assert.NotEmpty(t, nInterface.MAC) // can be empty
 	assert.NotEmpty(t, nInterface.Addresses)
 }
+
+func TestProcessNames(t *testing.T) {
+	names, err := ProcessNames()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, names)
+}
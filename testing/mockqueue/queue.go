@@ -23,3 +23,9 @@ func (m *MockQueue) Dequeue(ctx context.Context) (*queue.Item, error) {
 	args := m.Called(ctx)
 	return args.Get(0).(*queue.Item), args.Error(1)
 }
+
+// Empty ...
+func (m *MockQueue) Empty(ctx context.Context) (bool, error) {
+	args := m.Called(ctx)
+	return args.Bool(0), args.Error(1)
+}
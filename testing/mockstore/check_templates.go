@@ -0,0 +1,31 @@
+package mockstore
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// DeleteCheckTemplateByName ...
+func (s *MockStore) DeleteCheckTemplateByName(ctx context.Context, name string) error {
+	args := s.Called(ctx, name)
+	return args.Error(0)
+}
+
+// GetCheckTemplates ...
+func (s *MockStore) GetCheckTemplates(ctx context.Context) ([]*types.CheckTemplate, error) {
+	args := s.Called(ctx)
+	return args.Get(0).([]*types.CheckTemplate), args.Error(1)
+}
+
+// GetCheckTemplateByName ...
+func (s *MockStore) GetCheckTemplateByName(ctx context.Context, name string) (*types.CheckTemplate, error) {
+	args := s.Called(ctx, name)
+	return args.Get(0).(*types.CheckTemplate), args.Error(1)
+}
+
+// UpdateCheckTemplate ...
+func (s *MockStore) UpdateCheckTemplate(ctx context.Context, tmpl *types.CheckTemplate) error {
+	args := s.Called(tmpl)
+	return args.Error(0)
+}
@@ -0,0 +1,38 @@
+package mockstore
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// GetClusterConfig ...
+func (s *MockStore) GetClusterConfig(ctx context.Context) (*types.ClusterConfig, error) {
+	args := s.Called(ctx)
+	return args.Get(0).(*types.ClusterConfig), args.Error(1)
+}
+
+// UpdateClusterConfig ...
+func (s *MockStore) UpdateClusterConfig(ctx context.Context, config *types.ClusterConfig) error {
+	args := s.Called(ctx, config)
+	return args.Error(0)
+}
+
+// GetOrganizationConfig ...
+func (s *MockStore) GetOrganizationConfig(ctx context.Context, org string) (*types.ClusterConfig, error) {
+	args := s.Called(ctx, org)
+	return args.Get(0).(*types.ClusterConfig), args.Error(1)
+}
+
+// UpdateOrganizationConfig ...
+func (s *MockStore) UpdateOrganizationConfig(ctx context.Context, org string, config *types.ClusterConfig) error {
+	args := s.Called(ctx, org, config)
+	return args.Error(0)
+}
+
+// GetClusterConfigWatcher ...
+func (s *MockStore) GetClusterConfigWatcher(ctx context.Context) <-chan store.WatchEventClusterConfig {
+	args := s.Called(ctx)
+	return args.Get(0).(<-chan store.WatchEventClusterConfig)
+}
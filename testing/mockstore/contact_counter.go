@@ -0,0 +1,12 @@
+package mockstore
+
+import (
+	"context"
+	"time"
+)
+
+// IncrementContactCounter ...
+func (s *MockStore) IncrementContactCounter(ctx context.Context, handler, contact string, window time.Duration) (int64, error) {
+	args := s.Called(ctx, handler, contact, window)
+	return args.Get(0).(int64), args.Error(1)
+}
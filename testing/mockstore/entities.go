@@ -3,6 +3,7 @@ package mockstore
 import (
 	"context"
 
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -18,20 +19,44 @@ func (s *MockStore) DeleteEntityByID(ctx context.Context, id string) error {
 	return args.Error(0)
 }
 
+// DisconnectEntityStatus ...
+func (s *MockStore) DisconnectEntityStatus(ctx context.Context, status *types.EntityStatus) error {
+	args := s.Called(ctx, status)
+	return args.Error(0)
+}
+
 // GetEntities ...
 func (s *MockStore) GetEntities(ctx context.Context) ([]*types.Entity, error) {
 	args := s.Called(ctx)
 	return args.Get(0).([]*types.Entity), args.Error(1)
 }
 
+// GetEntitiesByIndex ...
+func (s *MockStore) GetEntitiesByIndex(ctx context.Context, index store.EntityIndex, value string) ([]*types.Entity, error) {
+	args := s.Called(ctx, index, value)
+	return args.Get(0).([]*types.Entity), args.Error(1)
+}
+
 // GetEntityByID ...
 func (s *MockStore) GetEntityByID(ctx context.Context, id string) (*types.Entity, error) {
 	args := s.Called(ctx, id)
 	return args.Get(0).(*types.Entity), args.Error(1)
 }
 
+// GetEntityStatus ...
+func (s *MockStore) GetEntityStatus(ctx context.Context, id string) (*types.EntityStatus, error) {
+	args := s.Called(ctx, id)
+	return args.Get(0).(*types.EntityStatus), args.Error(1)
+}
+
 // UpdateEntity ...
 func (s *MockStore) UpdateEntity(ctx context.Context, e *types.Entity) error {
 	args := s.Called(ctx, e)
 	return args.Error(0)
 }
+
+// UpdateEntityStatus ...
+func (s *MockStore) UpdateEntityStatus(ctx context.Context, status *types.EntityStatus) error {
+	args := s.Called(ctx, status)
+	return args.Error(0)
+}
@@ -0,0 +1,31 @@
+package mockstore
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// DeleteEntityGroupByName ...
+func (s *MockStore) DeleteEntityGroupByName(ctx context.Context, name string) error {
+	args := s.Called(ctx, name)
+	return args.Error(0)
+}
+
+// GetEntityGroups ...
+func (s *MockStore) GetEntityGroups(ctx context.Context) ([]*types.EntityGroup, error) {
+	args := s.Called(ctx)
+	return args.Get(0).([]*types.EntityGroup), args.Error(1)
+}
+
+// GetEntityGroupByName ...
+func (s *MockStore) GetEntityGroupByName(ctx context.Context, name string) (*types.EntityGroup, error) {
+	args := s.Called(ctx, name)
+	return args.Get(0).(*types.EntityGroup), args.Error(1)
+}
+
+// UpdateEntityGroup ...
+func (s *MockStore) UpdateEntityGroup(ctx context.Context, group *types.EntityGroup) error {
+	args := s.Called(group)
+	return args.Error(0)
+}
@@ -3,6 +3,7 @@ package mockstore
 import (
 	"context"
 
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -29,3 +30,9 @@ func (s *MockStore) UpdateEnvironment(ctx context.Context, env *types.Environmen
 	args := s.Called(ctx, env)
 	return args.Error(0)
 }
+
+// GetEnvironmentWatcher ...
+func (s *MockStore) GetEnvironmentWatcher(ctx context.Context) <-chan store.WatchEventEnvironment {
+	args := s.Called(ctx)
+	return args.Get(0).(<-chan store.WatchEventEnvironment)
+}
@@ -0,0 +1,17 @@
+package mockstore
+
+import (
+	"context"
+)
+
+// GetEventDigest ...
+func (s *MockStore) GetEventDigest(ctx context.Context, handler, entity, check string) (string, error) {
+	args := s.Called(ctx, handler, entity, check)
+	return args.String(0), args.Error(1)
+}
+
+// UpdateEventDigest ...
+func (s *MockStore) UpdateEventDigest(ctx context.Context, handler, entity, check, digest string) error {
+	args := s.Called(ctx, handler, entity, check, digest)
+	return args.Error(0)
+}
@@ -0,0 +1,43 @@
+package mockstore
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// DeleteIncidentByID ...
+func (s *MockStore) DeleteIncidentByID(ctx context.Context, id string) error {
+	args := s.Called(ctx, id)
+	return args.Error(0)
+}
+
+// GetIncidents ...
+func (s *MockStore) GetIncidents(ctx context.Context) ([]*types.Incident, error) {
+	args := s.Called(ctx)
+	return args.Get(0).([]*types.Incident), args.Error(1)
+}
+
+// GetIncidentByID ...
+func (s *MockStore) GetIncidentByID(ctx context.Context, id string) (*types.Incident, error) {
+	args := s.Called(ctx, id)
+	return args.Get(0).(*types.Incident), args.Error(1)
+}
+
+// GetUnresolvedIncidents ...
+func (s *MockStore) GetUnresolvedIncidents(ctx context.Context) ([]*types.Incident, error) {
+	args := s.Called(ctx)
+	return args.Get(0).([]*types.Incident), args.Error(1)
+}
+
+// UpdateIncident ...
+func (s *MockStore) UpdateIncident(ctx context.Context, incident *types.Incident) error {
+	args := s.Called(incident)
+	return args.Error(0)
+}
+
+// UpdateIncidentWith ...
+func (s *MockStore) UpdateIncidentWith(ctx context.Context, id string, update func(*types.Incident) error) error {
+	args := s.Called(ctx, id, update)
+	return args.Error(0)
+}
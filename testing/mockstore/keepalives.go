@@ -6,20 +6,26 @@ import (
 	"github.com/sensu/sensu-go/types"
 )
 
-// DeleteFailingKeepalive ...
-func (s *MockStore) DeleteFailingKeepalive(ctx context.Context, entity *types.Entity) error {
+// ClaimExpiredKeepalive ...
+func (s *MockStore) ClaimExpiredKeepalive(ctx context.Context, keepalive *types.KeepaliveRecord) (bool, error) {
+	args := s.Called(ctx, keepalive)
+	return args.Bool(0), args.Error(1)
+}
+
+// DeleteKeepalive ...
+func (s *MockStore) DeleteKeepalive(ctx context.Context, entity *types.Entity) error {
 	args := s.Called(ctx, entity)
 	return args.Error(0)
 }
 
-// GetFailingKeepalives ...
-func (s *MockStore) GetFailingKeepalives(ctx context.Context) ([]*types.KeepaliveRecord, error) {
-	args := s.Called(ctx)
+// GetExpiredKeepalives ...
+func (s *MockStore) GetExpiredKeepalives(ctx context.Context, now int64) ([]*types.KeepaliveRecord, error) {
+	args := s.Called(ctx, now)
 	return args.Get(0).([]*types.KeepaliveRecord), args.Error(1)
 }
 
-// UpdateFailingKeepalive ...
-func (s *MockStore) UpdateFailingKeepalive(ctx context.Context, entity *types.Entity, expiration int64) error {
+// UpdateKeepalive ...
+func (s *MockStore) UpdateKeepalive(ctx context.Context, entity *types.Entity, expiration int64) error {
 	args := s.Called(ctx, entity, expiration)
 	return args.Error(0)
 }
@@ -3,6 +3,7 @@ package mockstore
 import (
 	"context"
 
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -29,3 +30,9 @@ func (s *MockStore) UpdateOrganization(ctx context.Context, org *types.Organizat
 	args := s.Called(ctx, org)
 	return args.Error(0)
 }
+
+// GetOrganizationWatcher ...
+func (s *MockStore) GetOrganizationWatcher(ctx context.Context) <-chan store.WatchEventOrganization {
+	args := s.Called(ctx)
+	return args.Get(0).(<-chan store.WatchEventOrganization)
+}
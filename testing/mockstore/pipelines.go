@@ -0,0 +1,31 @@
+package mockstore
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// DeletePipelineByName ...
+func (s *MockStore) DeletePipelineByName(ctx context.Context, name string) error {
+	args := s.Called(ctx, name)
+	return args.Error(0)
+}
+
+// GetPipelines ...
+func (s *MockStore) GetPipelines(ctx context.Context) ([]*types.Pipeline, error) {
+	args := s.Called(ctx)
+	return args.Get(0).([]*types.Pipeline), args.Error(1)
+}
+
+// GetPipelineByName ...
+func (s *MockStore) GetPipelineByName(ctx context.Context, name string) (*types.Pipeline, error) {
+	args := s.Called(ctx, name)
+	return args.Get(0).(*types.Pipeline), args.Error(1)
+}
+
+// UpdatePipeline ...
+func (s *MockStore) UpdatePipeline(ctx context.Context, pipeline *types.Pipeline) error {
+	args := s.Called(pipeline)
+	return args.Error(0)
+}
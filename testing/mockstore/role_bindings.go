@@ -0,0 +1,31 @@
+package mockstore
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// DeleteRoleBindingByName ...
+func (s *MockStore) DeleteRoleBindingByName(ctx context.Context, name string) error {
+	args := s.Called(ctx, name)
+	return args.Error(0)
+}
+
+// GetRoleBindings ...
+func (s *MockStore) GetRoleBindings(ctx context.Context) ([]*types.RoleBinding, error) {
+	args := s.Called(ctx)
+	return args.Get(0).([]*types.RoleBinding), args.Error(1)
+}
+
+// GetRoleBindingByName ...
+func (s *MockStore) GetRoleBindingByName(ctx context.Context, name string) (*types.RoleBinding, error) {
+	args := s.Called(ctx, name)
+	return args.Get(0).(*types.RoleBinding), args.Error(1)
+}
+
+// UpdateRoleBinding ...
+func (s *MockStore) UpdateRoleBinding(ctx context.Context, binding *types.RoleBinding) error {
+	args := s.Called(ctx, binding)
+	return args.Error(0)
+}
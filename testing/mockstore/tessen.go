@@ -0,0 +1,25 @@
+package mockstore
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// GetClusterID ...
+func (s *MockStore) GetClusterID(ctx context.Context) (string, error) {
+	args := s.Called(ctx)
+	return args.String(0), args.Error(1)
+}
+
+// GetTessenConfig ...
+func (s *MockStore) GetTessenConfig(ctx context.Context) (*types.TessenConfig, error) {
+	args := s.Called(ctx)
+	return args.Get(0).(*types.TessenConfig), args.Error(1)
+}
+
+// UpdateTessenConfig ...
+func (s *MockStore) UpdateTessenConfig(ctx context.Context, config *types.TessenConfig) error {
+	args := s.Called(ctx, config)
+	return args.Error(0)
+}
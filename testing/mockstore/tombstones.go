@@ -0,0 +1,31 @@
+package mockstore
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// CreateTombstone ...
+func (s *MockStore) CreateTombstone(ctx context.Context, tombstone *types.Tombstone, ttl int64) error {
+	args := s.Called(ctx, tombstone, ttl)
+	return args.Error(0)
+}
+
+// DeleteTombstone ...
+func (s *MockStore) DeleteTombstone(ctx context.Context, kind, name string) error {
+	args := s.Called(ctx, kind, name)
+	return args.Error(0)
+}
+
+// GetTombstone ...
+func (s *MockStore) GetTombstone(ctx context.Context, kind, name string) (*types.Tombstone, error) {
+	args := s.Called(ctx, kind, name)
+	return args.Get(0).(*types.Tombstone), args.Error(1)
+}
+
+// GetTombstones ...
+func (s *MockStore) GetTombstones(ctx context.Context) ([]*types.Tombstone, error) {
+	args := s.Called(ctx)
+	return args.Get(0).([]*types.Tombstone), args.Error(1)
+}
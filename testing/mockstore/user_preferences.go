@@ -0,0 +1,25 @@
+package mockstore
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// DeleteUserPreferences ...
+func (s *MockStore) DeleteUserPreferences(ctx context.Context, username string) error {
+	args := s.Called(ctx, username)
+	return args.Error(0)
+}
+
+// GetUserPreferences ...
+func (s *MockStore) GetUserPreferences(ctx context.Context, username string) (*types.UserPreferences, error) {
+	args := s.Called(ctx, username)
+	return args.Get(0).(*types.UserPreferences), args.Error(1)
+}
+
+// UpdateUserPreferences ...
+func (s *MockStore) UpdateUserPreferences(ctx context.Context, preferences *types.UserPreferences) error {
+	args := s.Called(preferences)
+	return args.Error(0)
+}
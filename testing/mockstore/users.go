@@ -3,6 +3,7 @@ package mockstore
 import (
 	"context"
 
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -50,3 +51,9 @@ func (s *MockStore) UpdateUser(user *types.User) error {
 	args := s.Called(user)
 	return args.Error(0)
 }
+
+// GetUserWatcher ...
+func (s *MockStore) GetUserWatcher(ctx context.Context) <-chan store.WatchEventUser {
+	args := s.Called(ctx)
+	return args.Get(0).(<-chan store.WatchEventUser)
+}
@@ -11,15 +11,29 @@ import (
 
 // Connect causes the transport Client to connect to a given websocket backend.
 // This is a thin wrapper around a websocket connection that makes the
-// connection safe for concurrent use by multiple goroutines.
+// connection safe for concurrent use by multiple goroutines. The mqtt:// and
+// mqtts:// URLs connect through an MQTT broker instead. The connection goes
+// through the proxy of the HTTP_PROXY and HTTPS_PROXY environment variables,
+// if any.
 func Connect(wsServerURL string, tlsOpts *types.TLSOptions, requestHeader http.Header) (Transport, error) {
+	return ConnectWithProxy(wsServerURL, tlsOpts, requestHeader, http.ProxyFromEnvironment)
+}
+
+// ConnectWithProxy is like Connect, but tunnels the websocket connection
+// through the proxy returned by proxy, if any, with an HTTP CONNECT request.
+func ConnectWithProxy(wsServerURL string, tlsOpts *types.TLSOptions, requestHeader http.Header, proxy ProxyFunc) (Transport, error) {
 	// TODO(grep): configurable max sendq depth
 	u, err := url.Parse(wsServerURL)
 	if err != nil {
 		return nil, err
 	}
 
-	dialer := websocket.DefaultDialer
+	if u.Scheme == "mqtt" || u.Scheme == "mqtts" {
+		return ConnectMQTT(wsServerURL, tlsOpts, requestHeader)
+	}
+
+	dialer := *websocket.DefaultDialer
+	dialer.Proxy = proxy
 
 	if tlsOpts != nil {
 		dialer.TLSClientConfig, err = tlsOpts.ToTLSConfig()
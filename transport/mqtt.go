@@ -0,0 +1,537 @@
+package transport
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// The agents can exchange their messages with the backends through an MQTT
+// broker, which suits constrained edge devices better than websockets. An
+// agent publishes its messages to the topic
+// sensu/:organization/:environment/:agent/up/:type and receives the messages of
+// the backend on sensu/:organization/:environment/:agent/down/:type. The
+// retained presence message of the agent carries its transport headers while
+// it's connected, and is cleared when it disconnects, by its will if the
+// connection is lost. Only the QoS 0 is used, the messages are not
+// acknowledged.
+
+const (
+	// MQTTTopicPrefix is the first level of the topics of the agents
+	MQTTTopicPrefix = "sensu"
+
+	// MQTTUpstream is the level of the topics of the messages sent by the
+	// agents
+	MQTTUpstream = "up"
+
+	// MQTTDownstream is the level of the topics of the messages sent to the
+	// agents
+	MQTTDownstream = "down"
+
+	// MessageTypePresence is the type of the retained message of an agent,
+	// holding its transport headers while it's connected and empty otherwise
+	MessageTypePresence = "presence"
+
+	// MQTTKeepAlive is the keep alive interval of the MQTT connections
+	MQTTKeepAlive = 60 * time.Second
+
+	mqttConnect     = 1
+	mqttConnAck     = 2
+	mqttPublish     = 3
+	mqttPubAck      = 4
+	mqttSubscribe   = 8
+	mqttSubAck      = 9
+	mqttPingReq     = 12
+	mqttDisconnect  = 14
+	mqttMaxLength   = 268435455
+	mqttProtocolLvl = 4
+)
+
+// MQTTAgentTopic returns the topic of the messages of the given type sent by
+// (upstream) or to (downstream) an agent.
+func MQTTAgentTopic(org, env, agentID, stream, msgType string) string {
+	return strings.Join([]string{MQTTTopicPrefix, org, env, agentID, stream, msgType}, "/")
+}
+
+// ParseMQTTAgentTopic returns the organization, environment, agent ID, stream
+// and message type of a topic of an agent.
+func ParseMQTTAgentTopic(topic string) (org, env, agentID, stream, msgType string, err error) {
+	levels := strings.Split(topic, "/")
+	if len(levels) != 6 || levels[0] != MQTTTopicPrefix {
+		return "", "", "", "", "", fmt.Errorf("invalid agent topic %q", topic)
+	}
+	return levels[1], levels[2], levels[3], levels[4], levels[5], nil
+}
+
+// MQTTOptions are the options of an MQTT connection.
+type MQTTOptions struct {
+	ClientID string
+	Username string
+	Password string
+
+	// Will is the message published by the broker if the connection is lost,
+	// none if its topic is empty
+	WillTopic   string
+	WillPayload []byte
+	WillRetain  bool
+}
+
+// An MQTTClient is a minimal MQTT 3.1.1 client, which publishes and subscribes
+// with the QoS 0. It's safe for concurrent use, but its messages must be read
+// by a single goroutine.
+type MQTTClient struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	mutex  *sync.Mutex
+
+	// pending are the packets received while waiting for an acknowledgement
+	pending []mqttPacket
+
+	closeOnce *sync.Once
+	stopping  chan struct{}
+}
+
+type mqttPacket struct {
+	header byte
+	body   []byte
+}
+
+// DialMQTT connects to the MQTT broker at the given URL, mqtt:// or mqtts://
+// for TLS, the port defaulting to 1883 and 8883 respectively. The credentials
+// of the URL are used unless the options provide them.
+func DialMQTT(brokerURL string, tlsOpts *types.TLSOptions, opts MQTTOptions) (*MQTTClient, error) {
+	u, err := url.Parse(brokerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Username == "" && u.User != nil {
+		opts.Username = u.User.Username()
+		opts.Password, _ = u.User.Password()
+	}
+
+	var conn net.Conn
+	switch u.Scheme {
+	case "mqtt", "tcp":
+		conn, err = net.Dial("tcp", mqttHost(u, "1883"))
+	case "mqtts", "ssl", "tls":
+		tlsConfig := &tls.Config{}
+		if tlsOpts != nil {
+			if tlsConfig, err = tlsOpts.ToTLSConfig(); err != nil {
+				return nil, err
+			}
+		}
+		conn, err = tls.Dial("tcp", mqttHost(u, "8883"), tlsConfig)
+	default:
+		return nil, fmt.Errorf("unsupported MQTT scheme %q", u.Scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c := &MQTTClient{
+		conn:      conn,
+		reader:    bufio.NewReader(conn),
+		mutex:     &sync.Mutex{},
+		closeOnce: &sync.Once{},
+		stopping:  make(chan struct{}),
+	}
+	if err := c.connect(opts); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	go c.ping()
+
+	return c, nil
+}
+
+func mqttHost(u *url.URL, defaultPort string) string {
+	if u.Port() == "" {
+		return net.JoinHostPort(u.Hostname(), defaultPort)
+	}
+	return u.Host
+}
+
+// connect sends the CONNECT packet and waits for its acknowledgement.
+func (c *MQTTClient) connect(opts MQTTOptions) error {
+	var flags byte = 0x02 // clean session
+	body := appendMQTTString(nil, "MQTT")
+	body = append(body, mqttProtocolLvl, 0)
+	flagsIndex := len(body) - 1
+	keepAlive := make([]byte, 2)
+	binary.BigEndian.PutUint16(keepAlive, uint16(MQTTKeepAlive/time.Second))
+	body = append(body, keepAlive...)
+	body = appendMQTTString(body, opts.ClientID)
+	if opts.WillTopic != "" {
+		flags |= 0x04
+		if opts.WillRetain {
+			flags |= 0x20
+		}
+		body = appendMQTTString(body, opts.WillTopic)
+		body = appendMQTTBytes(body, opts.WillPayload)
+	}
+	if opts.Username != "" {
+		flags |= 0x80
+		body = appendMQTTString(body, opts.Username)
+		if opts.Password != "" {
+			flags |= 0x40
+			body = appendMQTTString(body, opts.Password)
+		}
+	}
+	body[flagsIndex] = flags
+
+	if err := c.write(mqttConnect<<4, body); err != nil {
+		return err
+	}
+
+	header, ack, err := c.readPacket()
+	if err != nil {
+		return err
+	}
+	if header>>4 != mqttConnAck || len(ack) != 2 {
+		return errors.New("invalid MQTT connection acknowledgement")
+	}
+	if ack[1] != 0 {
+		return fmt.Errorf("MQTT connection refused with code %d", ack[1])
+	}
+	return nil
+}
+
+// Publish publishes a message with the QoS 0.
+func (c *MQTTClient) Publish(topic string, payload []byte, retain bool) error {
+	var header byte = mqttPublish << 4
+	if retain {
+		header |= 0x01
+	}
+	body := appendMQTTString(nil, topic)
+	body = append(body, payload...)
+	return c.write(header, body)
+}
+
+// Subscribe subscribes to the topics matching the filter with the QoS 0. The
+// acknowledgement is read with the messages, it must be called before the
+// messages are read.
+func (c *MQTTClient) Subscribe(filter string) error {
+	body := []byte{0, 1} // packet identifier
+	body = appendMQTTString(body, filter)
+	body = append(body, 0)
+	if err := c.write(mqttSubscribe<<4|0x02, body); err != nil {
+		return err
+	}
+
+	for {
+		header, ack, err := c.readPacket()
+		if err != nil {
+			return err
+		}
+		if header>>4 != mqttSubAck {
+			// The retained messages may be received first
+			c.pending = append(c.pending, mqttPacket{header, ack})
+			continue
+		}
+		if len(ack) != 3 || ack[2] == 0x80 {
+			return fmt.Errorf("MQTT subscription to %q refused", filter)
+		}
+		return nil
+	}
+}
+
+// ReadMessage blocks until a message is received, and returns its topic and
+// payload.
+func (c *MQTTClient) ReadMessage() (string, []byte, error) {
+	for {
+		var header byte
+		var body []byte
+		if len(c.pending) > 0 {
+			header, body = c.pending[0].header, c.pending[0].body
+			c.pending = c.pending[1:]
+		} else {
+			var err error
+			if header, body, err = c.readPacket(); err != nil {
+				return "", nil, err
+			}
+		}
+		if header>>4 != mqttPublish {
+			continue
+		}
+
+		topic, rest, err := readMQTTString(body)
+		if err != nil {
+			return "", nil, err
+		}
+		// The messages published with a higher QoS are acknowledged
+		if qos := (header >> 1) & 0x03; qos > 0 {
+			if len(rest) < 2 {
+				return "", nil, errors.New("invalid MQTT publish packet")
+			}
+			if qos == 1 {
+				if err := c.write(mqttPubAck<<4, rest[:2]); err != nil {
+					return "", nil, err
+				}
+			}
+			rest = rest[2:]
+		}
+		return topic, rest, nil
+	}
+}
+
+// Close disconnects gracefully from the broker, so the will is not published.
+func (c *MQTTClient) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.stopping)
+		_ = c.write(mqttDisconnect<<4, nil)
+		err = c.conn.Close()
+	})
+	return err
+}
+
+// ping keeps the connection alive while it's idle.
+func (c *MQTTClient) ping() {
+	ticker := time.NewTicker(MQTTKeepAlive / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.write(mqttPingReq<<4, nil); err != nil {
+				return
+			}
+		case <-c.stopping:
+			return
+		}
+	}
+}
+
+// write writes a packet with the given fixed header byte and body.
+func (c *MQTTClient) write(header byte, body []byte) error {
+	if len(body) > mqttMaxLength {
+		return errors.New("MQTT packet too large")
+	}
+	packet := append([]byte{header}, encodeMQTTLength(len(body))...)
+	packet = append(packet, body...)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	_, err := c.conn.Write(packet)
+	return err
+}
+
+// readPacket reads a packet, returning its fixed header byte and its body.
+func (c *MQTTClient) readPacket() (byte, []byte, error) {
+	header, err := c.reader.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	length, err := decodeMQTTLength(c.reader)
+	if err != nil {
+		return 0, nil, err
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(c.reader, body); err != nil {
+		return 0, nil, err
+	}
+	return header, body, nil
+}
+
+func encodeMQTTLength(length int) []byte {
+	encoded := []byte{}
+	for {
+		b := byte(length % 128)
+		length /= 128
+		if length > 0 {
+			b |= 0x80
+		}
+		encoded = append(encoded, b)
+		if length == 0 {
+			return encoded
+		}
+	}
+}
+
+func decodeMQTTLength(r io.ByteReader) (int, error) {
+	length, multiplier := 0, 1
+	for i := 0; i < 4; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		length += int(b&0x7f) * multiplier
+		if b&0x80 == 0 {
+			return length, nil
+		}
+		multiplier *= 128
+	}
+	return 0, errors.New("invalid MQTT remaining length")
+}
+
+func appendMQTTString(b []byte, s string) []byte {
+	return appendMQTTBytes(b, []byte(s))
+}
+
+func appendMQTTBytes(b []byte, s []byte) []byte {
+	length := make([]byte, 2)
+	binary.BigEndian.PutUint16(length, uint16(len(s)))
+	return append(append(b, length...), s...)
+}
+
+func readMQTTString(b []byte) (string, []byte, error) {
+	if len(b) < 2 {
+		return "", nil, errors.New("invalid MQTT string")
+	}
+	length := int(binary.BigEndian.Uint16(b))
+	if len(b) < 2+length {
+		return "", nil, errors.New("invalid MQTT string")
+	}
+	return string(b[2 : 2+length]), b[2+length:], nil
+}
+
+// An MQTTTransport is a connection between a Sensu agent and the backends
+// through an MQTT broker.
+type MQTTTransport struct {
+	client *MQTTClient
+	org    string
+	env    string
+	agent  string
+	closed bool
+	mutex  *sync.RWMutex
+}
+
+// ConnectMQTT connects an agent to the MQTT broker at the given URL, with its
+// transport headers. The credentials of the Authorization header are used to
+// authenticate to the broker.
+func ConnectMQTT(brokerURL string, tlsOpts *types.TLSOptions, header http.Header) (Transport, error) {
+	t := &MQTTTransport{
+		org:   header.Get(HeaderKeyOrganization),
+		env:   header.Get(HeaderKeyEnvironment),
+		agent: header.Get(HeaderKeyAgentID),
+		mutex: &sync.RWMutex{},
+	}
+	presence := t.topic(MQTTUpstream, MessageTypePresence)
+
+	opts := MQTTOptions{
+		ClientID:   "sensu-agent-" + t.agent,
+		WillTopic:  presence,
+		WillRetain: true,
+	}
+	opts.Username, opts.Password = basicAuth(header.Get("Authorization"))
+
+	client, err := DialMQTT(brokerURL, tlsOpts, opts)
+	if err != nil {
+		return nil, err
+	}
+	t.client = client
+
+	if err := client.Subscribe(t.topic(MQTTDownstream, "+")); err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+
+	// The credentials are only given to the broker
+	headers := http.Header{}
+	for key, values := range header {
+		if key != "Authorization" {
+			headers[key] = values
+		}
+	}
+	payload, err := json.Marshal(headers)
+	if err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+	if err := client.Publish(presence, payload, true); err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func basicAuth(authorization string) (string, string) {
+	const prefix = "Basic "
+	if !strings.HasPrefix(authorization, prefix) {
+		return "", ""
+	}
+	credentials, err := base64.StdEncoding.DecodeString(authorization[len(prefix):])
+	if err != nil {
+		return "", ""
+	}
+	parts := strings.SplitN(string(credentials), ":", 2)
+	if len(parts) != 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+func (t *MQTTTransport) topic(stream, msgType string) string {
+	return MQTTAgentTopic(t.org, t.env, t.agent, stream, msgType)
+}
+
+// Closed returns true if the underlying connection has been closed.
+func (t *MQTTTransport) Closed() bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.closed
+}
+
+// Send publishes a message to the backends.
+func (t *MQTTTransport) Send(m *Message) error {
+	if t.Closed() {
+		return ClosedError{"the MQTT connection is no longer open"}
+	}
+	if err := t.client.Publish(t.topic(MQTTUpstream, m.Type), m.Payload, false); err != nil {
+		t.setClosed()
+		return ConnectionError{err.Error()}
+	}
+	return nil
+}
+
+// Receive blocks until a message is received from the backends.
+func (t *MQTTTransport) Receive() (*Message, error) {
+	if t.Closed() {
+		return nil, ClosedError{"the MQTT connection is no longer open"}
+	}
+	for {
+		topic, payload, err := t.client.ReadMessage()
+		if err != nil {
+			if t.Closed() {
+				return nil, ClosedError{err.Error()}
+			}
+			t.setClosed()
+			return nil, ConnectionError{err.Error()}
+		}
+		_, _, _, stream, msgType, err := ParseMQTTAgentTopic(topic)
+		if err != nil || stream != MQTTDownstream {
+			continue
+		}
+		return &Message{Type: msgType, Payload: payload}, nil
+	}
+}
+
+// Close clears the presence of the agent and disconnects from the broker.
+func (t *MQTTTransport) Close() error {
+	if t.Closed() {
+		return nil
+	}
+	t.setClosed()
+	_ = t.client.Publish(t.topic(MQTTUpstream, MessageTypePresence), nil, true)
+	return t.client.Close()
+}
+
+func (t *MQTTTransport) setClosed() {
+	t.mutex.Lock()
+	t.closed = true
+	t.mutex.Unlock()
+}
@@ -0,0 +1,131 @@
+package transport
+
+import (
+	"encoding/base64"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProxyFunc(t *testing.T) {
+	_, err := NewProxyFunc("proxy:3128", nil)
+	assert.Error(t, err)
+
+	proxy, err := NewProxyFunc("http://proxy:3128", []string{"backend.local", ".example.com", "10.0.0.0/8", "192.168.1.1"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		url     string
+		proxied bool
+	}{
+		{"https://sensu.io/assets/check.tar.gz", true},
+		{"http://backend.local:8081", false},
+		{"http://other.backend.local:8081", false},
+		{"http://assets.example.com", false},
+		{"http://example.com", false},
+		{"http://notexample.com", true},
+		{"http://10.1.2.3:8081", false},
+		{"http://192.168.1.1:8081", false},
+		{"http://192.168.1.2:8081", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+			proxyURL, err := proxy(req)
+			require.NoError(t, err)
+			if tc.proxied {
+				require.NotNil(t, proxyURL)
+				assert.Equal(t, "proxy:3128", proxyURL.Host)
+			} else {
+				assert.Nil(t, proxyURL)
+			}
+		})
+	}
+}
+
+// newConnectProxy returns an HTTP proxy tunneling the CONNECT requests
+// authenticated with the credentials of user, and the number of tunnels
+// it opened.
+func newConnectProxy(t *testing.T, user *url.Userinfo) (*httptest.Server, *int) {
+	password, _ := user.Password()
+	credentials := "Basic " + base64.StdEncoding.EncodeToString([]byte(user.Username()+":"+password))
+	tunnels := 0
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if r.Header.Get("Proxy-Authorization") != credentials {
+			w.WriteHeader(http.StatusProxyAuthRequired)
+			return
+		}
+
+		target, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		conn, _, err := w.(http.Hijacker).Hijack()
+		require.NoError(t, err)
+		tunnels++
+		_, _ = conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+
+		go func() {
+			_, _ = io.Copy(target, conn)
+			_ = target.Close()
+		}()
+		go func() {
+			_, _ = io.Copy(conn, target)
+			_ = conn.Close()
+		}()
+	})), &tunnels
+}
+
+func TestConnectWithProxy(t *testing.T) {
+	server := NewServer()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		transport, err := server.Serve(w, r)
+		require.NoError(t, err)
+		_ = transport.Close()
+	}))
+	defer ts.Close()
+	wsURL := strings.Replace(ts.URL, "http", "ws", 1)
+
+	user := url.UserPassword("sensu", "secret")
+	proxyServer, tunnels := newConnectProxy(t, user)
+	defer proxyServer.Close()
+	proxyURL, err := url.Parse(proxyServer.URL)
+	require.NoError(t, err)
+	proxyURL.User = user
+
+	proxy, err := NewProxyFunc(proxyURL.String(), nil)
+	require.NoError(t, err)
+	conn, err := ConnectWithProxy(wsURL, nil, nil, proxy)
+	require.NoError(t, err)
+	_ = conn.Close()
+	assert.Equal(t, 1, *tunnels)
+
+	// The proxy rejects the connections without credentials
+	proxyURL.User = nil
+	proxy, err = NewProxyFunc(proxyURL.String(), nil)
+	require.NoError(t, err)
+	_, err = ConnectWithProxy(wsURL, nil, nil, proxy)
+	assert.Error(t, err)
+
+	// The hosts of the no proxy list are reached directly
+	proxy, err = NewProxyFunc(proxyURL.String(), []string{"127.0.0.1"})
+	require.NoError(t, err)
+	conn, err = ConnectWithProxy(wsURL, nil, nil, proxy)
+	require.NoError(t, err)
+	_ = conn.Close()
+	assert.Equal(t, 1, *tunnels)
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"sync"
 
 	"github.com/gorilla/websocket"
@@ -21,6 +23,16 @@ const (
 	// MessageTypeEvent is the message type string for events.
 	MessageTypeEvent = "event"
 
+	// MessageTypeDeregister is the message type sent by the agents of
+	// ephemeral entities when they are gracefully shut down. Like keepalives,
+	// it's an event without a Check or Metrics section.
+	MessageTypeDeregister = "deregister"
+
+	// MessageTypeStandaloneChecks is the message type sent by the agents with
+	// the definitions of the standalone checks they schedule, a JSON array of
+	// check configurations.
+	MessageTypeStandaloneChecks = "standalone_checks"
+
 	// HeaderKeyAgentID is the HTTP request header specifying the Agent ID
 	HeaderKeyAgentID = "Sensu-AgentID"
 
@@ -35,6 +47,9 @@ const (
 
 	// HeaderKeySubscriptions is the HTTP request header specifying the Agent Subscriptions
 	HeaderKeySubscriptions = "Sensu-Subscriptions"
+
+	// HeaderKeyAgentVersion is the HTTP request header specifying the Agent Version
+	HeaderKeyAgentVersion = "Sensu-Agent-Version"
 )
 
 // A ClosedError is returned when Receive or Send is called on a closed
@@ -57,6 +72,18 @@ func (e ConnectionError) Error() string {
 	return fmt.Sprintf("Connection error: %s", e.Message)
 }
 
+// A MessageTooLargeError is returned by Receive when a message is larger than
+// the maximum message size of the Transport. The message is discarded, but the
+// connection remains open.
+type MessageTooLargeError struct {
+	Size  int64
+	Limit int64
+}
+
+func (e MessageTooLargeError) Error() string {
+	return fmt.Sprintf("message of %d bytes exceeds the limit of %d bytes", e.Size, e.Limit)
+}
+
 // Encode a message to be sent over a websocket channel
 func Encode(msgType string, payload []byte) []byte {
 	buf := []byte(msgType + "\n")
@@ -109,6 +136,10 @@ type WebSocketTransport struct {
 	Connection *websocket.Conn
 	closed     bool
 	mutex      *sync.RWMutex
+
+	// maxMessageSize is the maximum size of the messages received, in bytes,
+	// unlimited if 0
+	maxMessageSize int64
 }
 
 // NewTransport creates an initialized Transport and return its pointer.
@@ -120,6 +151,18 @@ func NewTransport(conn *websocket.Conn) Transport {
 	}
 }
 
+// NewLimitedTransport creates an initialized Transport which rejects the
+// messages larger than maxMessageSize bytes, without buffering them, and
+// returns its pointer.
+func NewLimitedTransport(conn *websocket.Conn, maxMessageSize int64) Transport {
+	return &WebSocketTransport{
+		Connection:     conn,
+		closed:         false,
+		mutex:          &sync.RWMutex{},
+		maxMessageSize: maxMessageSize,
+	}
+}
+
 // Closed returns true if the underlying websocket connection has been closed.
 func (t *WebSocketTransport) Closed() bool {
 	t.mutex.RLock()
@@ -158,7 +201,8 @@ func (t *WebSocketTransport) Send(m *Message) error {
 }
 
 // Receive a message over the websocket connection. Like Send, returns either
-// a ClosedError or a ConnectionError if unable to receive a message. Receive
+// a ClosedError or a ConnectionError if unable to receive a message, and a
+// MessageTooLargeError if the message exceeds the maximum message size. Receive
 // blocks until the connection has a message ready or a timeout is reached.
 func (t *WebSocketTransport) Receive() (*Message, error) {
 	t.mutex.RLock()
@@ -168,8 +212,12 @@ func (t *WebSocketTransport) Receive() (*Message, error) {
 	}
 	t.mutex.RUnlock()
 
-	_, p, err := t.Connection.ReadMessage()
+	p, err := t.readMessage()
 	if err != nil {
+		if tooLarge, ok := err.(MessageTooLargeError); ok {
+			return nil, tooLarge
+		}
+
 		t.mutex.Lock()
 		t.closed = true
 		t.mutex.Unlock()
@@ -188,6 +236,38 @@ func (t *WebSocketTransport) Receive() (*Message, error) {
 	return &Message{msgType, payload}, nil
 }
 
+// readMessage reads the next message of the connection. The messages larger
+// than the maximum message size are discarded as they're read, so they're
+// never held in memory.
+func (t *WebSocketTransport) readMessage() ([]byte, error) {
+	if t.maxMessageSize <= 0 {
+		_, p, err := t.Connection.ReadMessage()
+		return p, err
+	}
+
+	_, r, err := t.Connection.NextReader()
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := ioutil.ReadAll(io.LimitReader(r, t.maxMessageSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(p)) <= t.maxMessageSize {
+		return p, nil
+	}
+
+	discarded, err := io.Copy(ioutil.Discard, r)
+	if err != nil {
+		return nil, err
+	}
+	return nil, MessageTooLargeError{
+		Size:  int64(len(p)) + discarded,
+		Limit: t.maxMessageSize,
+	}
+}
+
 // Close attempts to send a "going away" message over the websocket connection.
 // This will cause a Write over the websocket transport, which can cause a
 // panic. We rescue potential panics and consider the connection closed,
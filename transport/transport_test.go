@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -71,6 +72,33 @@ func TestClosedWebsocket(t *testing.T) {
 	assert.IsType(t, ClosedError{}, err)
 }
 
+func TestTransportMessageTooLarge(t *testing.T) {
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		require.NoError(t, err)
+		transport := NewLimitedTransport(conn, 64)
+
+		// The oversized message is rejected, but the connection remains open
+		_, err = transport.Receive()
+		assert.Equal(t, MessageTooLargeError{Size: 1030, Limit: 64}, err)
+		assert.False(t, transport.Closed())
+
+		msg, err := transport.Receive()
+		require.NoError(t, err)
+		assert.Equal(t, "small", string(msg.Payload))
+		close(done)
+	}))
+	defer ts.Close()
+
+	clientTransport, err := Connect(strings.Replace(ts.URL, "http", "ws", 1), nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, clientTransport.Send(&Message{"event", makeMessage(1024)}))
+	require.NoError(t, clientTransport.Send(&Message{"event", []byte("small")}))
+
+	<-done
+}
+
 // This was all mostly to prove that performance of encoding/decoding was
 // not super-linear.
 
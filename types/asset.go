@@ -17,34 +17,36 @@ var AssetNameRegexStr = `[a-z0-9\/\_\.\-]+`
 // AssetNameRegex used to validate name of asset
 var AssetNameRegex = regexp.MustCompile("^" + AssetNameRegexStr + "$")
 
-// Validate returns an error if the asset contains invalid values.
+// Validate returns an error if the asset contains invalid values. The error
+// is a ValidationErrors reporting all the invalid fields.
 func (a *Asset) Validate() error {
+	var errs ValidationErrors
+
 	if err := ValidateAssetName(a.Name); err != nil {
-		return err
+		errs.Add("name", requiredOrInvalid(a.Name), err.Error())
 	}
 
 	if a.Organization == "" {
-		return errors.New("organization cannot be empty")
+		errs.Add("organization", ValidationRequired, "organization cannot be empty")
 	}
 
 	if a.Sha512 == "" {
-		return errors.New("SHA-512 checksum cannot be empty")
+		errs.Add("sha512", ValidationRequired, "SHA-512 checksum cannot be empty")
 	}
 
 	if a.URL == "" {
-		return errors.New("URL cannot be empty")
-	}
-
-	u, err := url.Parse(a.URL)
-	if err != nil {
-		return errors.New("invalid URL provided")
+		errs.Add("url", ValidationRequired, "URL cannot be empty")
+	} else if u, err := url.Parse(a.URL); err != nil {
+		errs.Add("url", ValidationInvalid, "invalid URL provided")
+	} else if u.Scheme != "https" && u.Scheme != "http" {
+		errs.Add("url", ValidationInvalid, "URL must be HTTP or HTTPS")
 	}
 
-	if u.Scheme != "https" && u.Scheme != "http" {
-		return errors.New("URL must be HTTP or HTTPS")
+	if err := eval.ValidateStatements(a.Filters); err != nil {
+		errs.Add("filters", ValidationInvalid, err.Error())
 	}
 
-	return eval.ValidateStatements(a.Filters)
+	return errs.Err()
 }
 
 // GetEnvironment refers to the organization the check belongs to
@@ -78,6 +80,17 @@ func (a *Asset) Filename() string {
 	return file
 }
 
+// SetNamespace sets the organization of the asset. Assets are shared by all
+// the environments of their organization, so env is ignored.
+func (a *Asset) SetNamespace(org, env string) {
+	a.Organization = org
+}
+
+// URIPath returns the path of the asset, relative to the API root.
+func (a *Asset) URIPath() string {
+	return path.Join("/assets", url.PathEscape(a.Name))
+}
+
 // FixtureAsset given a name returns a valid asset for use in tests
 func FixtureAsset(name string) *Asset {
 	bytes := make([]byte, 10)
@@ -2,9 +2,20 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
+const (
+	// DefaultScopedTokenTTL is the number of seconds a scoped token is valid
+	// for, when its request doesn't set it
+	DefaultScopedTokenTTL = 3600
+
+	// MaxScopedTokenTTL is the maximum number of seconds a scoped token is
+	// valid for
+	MaxScopedTokenTTL = 7 * 24 * 3600
+)
+
 // Validate returns an error if the tokens contain invalid values.
 func (t *Tokens) Validate() error {
 	if t.Access == "" {
@@ -22,6 +33,60 @@ func (t *Tokens) Validate() error {
 	return nil
 }
 
+// Session is returned by the dashboard session endpoints. Unlike Tokens, it
+// does not hold the refresh token, which is kept in an HttpOnly cookie instead.
+type Session struct {
+	// Access token is used by client to make request
+	Access string `json:"access_token"`
+
+	// ExpiresAt unix timestamp describing when the access token is no longer
+	// valid
+	ExpiresAt int64 `json:"expires_at"`
+
+	// CSRFToken must be provided in the X-CSRF-Token header of the requests
+	// authenticated by the refresh token cookie
+	CSRFToken string `json:"csrf_token"`
+}
+
+// ScopedTokenRequest is the request of a short-lived access token, restricted
+// to a subset of the permissions of the user who requests it, e.g. to embed a
+// dashboard or to hand out temporary access.
+type ScopedTokenRequest struct {
+	// Rules are the permissions of the token, which must all be granted to
+	// the user
+	Rules []Rule `json:"rules"`
+
+	// TTL is the number of seconds the token is valid for, 3600 by default
+	TTL int64 `json:"ttl,omitempty"`
+}
+
+// Validate returns an error if the request of a scoped token is invalid.
+func (r *ScopedTokenRequest) Validate() error {
+	if len(r.Rules) == 0 {
+		return errors.New("scoped tokens must have at least one rule")
+	}
+
+	for i := range r.Rules {
+		if err := r.Rules[i].Validate(); err != nil {
+			return fmt.Errorf("rule #%d: %s", i+1, err)
+		}
+	}
+
+	if r.TTL < 0 || r.TTL > MaxScopedTokenTTL {
+		return fmt.Errorf("the ttl of scoped tokens must be between 0 and %d seconds", MaxScopedTokenTTL)
+	}
+
+	return nil
+}
+
+// Duration returns how long the requested token is valid for.
+func (r *ScopedTokenRequest) Duration() time.Duration {
+	if r.TTL == 0 {
+		return DefaultScopedTokenTTL * time.Second
+	}
+	return time.Duration(r.TTL) * time.Second
+}
+
 // FixtureTokens given an access and refresh tokens returns valid tokens for use
 // in tests
 func FixtureTokens(accessToken, refreshToken string) *Tokens {
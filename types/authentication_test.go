@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -30,3 +31,28 @@ func TestTokensValidator(t *testing.T) {
 	tokens.ExpiresAt = 0
 	assert.Error(t, tokens.Validate())
 }
+
+func TestScopedTokenRequestValidate(t *testing.T) {
+	req := ScopedTokenRequest{
+		Rules: []Rule{FixtureRuleWithPerms(RuleTypeEvent, RulePermRead)},
+	}
+	assert.NoError(t, req.Validate())
+	assert.Equal(t, time.Hour, req.Duration())
+
+	req.TTL = 600
+	assert.NoError(t, req.Validate())
+	assert.Equal(t, 10*time.Minute, req.Duration())
+
+	req.TTL = MaxScopedTokenTTL + 1
+	assert.Error(t, req.Validate())
+
+	req.TTL = -1
+	assert.Error(t, req.Validate())
+
+	req.TTL = 0
+	req.Rules[0].Permissions = []string{"foo"}
+	assert.Error(t, req.Validate())
+
+	req.Rules = nil
+	assert.Error(t, req.Validate())
+}
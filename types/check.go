@@ -3,8 +3,13 @@ package types
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"path"
 	"sort"
+	"strings"
+	"text/template"
 	"time"
+	"unicode/utf8"
 
 	"github.com/robfig/cron"
 	"github.com/sensu/sensu-go/types/dynamic"
@@ -17,6 +22,77 @@ const CheckRequestType = "check_request"
 // DefaultSplayCoverage is the default splay coverage for proxy check requests
 const DefaultSplayCoverage = 90.0
 
+const (
+	// CheckExecTypeShell executes the check command through a shell
+	CheckExecTypeShell = "shell"
+
+	// CheckExecTypeDirect executes the check command without any shell, its
+	// arguments being split on whitespace while honoring quotes
+	CheckExecTypeDirect = "direct"
+)
+
+const (
+	// CheckExecutorAgent executes the check on the agents of its
+	// subscriptions
+	CheckExecutorAgent = "agent"
+
+	// CheckExecutorBackend executes the check on the backend, for its proxy
+	// entities
+	CheckExecutorBackend = "backend"
+)
+
+const (
+	// CheckCatchUpSkip skips the missed executions of a check, which is next
+	// executed as scheduled
+	CheckCatchUpSkip = "skip"
+
+	// CheckCatchUpRunOnce executes a check once right away for all its missed
+	// executions
+	CheckCatchUpRunOnce = "run-once"
+)
+
+const (
+	// DefaultHistoryRetention is the number of executions kept in the history
+	// of the events of a check which doesn't set its history retention. It's
+	// the number of executions required by flap detection.
+	DefaultHistoryRetention = 21
+
+	// MaxHistoryRetention is the maximum number of executions kept in the
+	// history of the events of a check
+	MaxHistoryRetention = 1000
+
+	// MaxHistoryOutputSize is the maximum size, in bytes, of the output of
+	// each execution kept in the history of the events of a check, so that
+	// a long history keeps the events within the value size limit of the store
+	MaxHistoryOutputSize = 512
+)
+
+const (
+	// AnomalyDetectionZScore flags the metric points which deviate from the
+	// mean of the last samples of the metric
+	AnomalyDetectionZScore = "zscore"
+
+	// AnomalyDetectionEWMA flags the metric points which deviate from the
+	// exponentially weighted moving average of the metric, which favors the
+	// recent samples
+	AnomalyDetectionEWMA = "ewma"
+
+	// DefaultAnomalyThreshold is the number of standard deviations from the
+	// mean beyond which a metric point is anomalous
+	DefaultAnomalyThreshold = 3.0
+
+	// DefaultAnomalyWindow is the number of samples the rolling statistics
+	// of the metric points are computed over
+	DefaultAnomalyWindow = 30
+
+	// MaxAnomalyWindow is the maximum number of samples the rolling
+	// statistics of the metric points are computed over
+	MaxAnomalyWindow = 10000
+)
+
+// backendCheckCommands are the builtin checks the backend can execute.
+var backendCheckCommands = []string{"http", "tcp", "icmp"}
+
 // NewCheck creates a new Check. It copies the fields from CheckConfig that
 // match with Check's fields.
 //
@@ -46,6 +122,29 @@ func NewCheck(c *CheckConfig) *Check {
 		Timeout:            c.Timeout,
 		ProxyRequests:      c.ProxyRequests,
 		RoundRobin:         c.RoundRobin,
+		Splay:              c.Splay,
+		SplayCoverage:      c.SplayCoverage,
+		Nagios:             c.Nagios,
+		OutputAnnotations:  c.OutputAnnotations,
+		MetricRetention:    c.MetricRetention,
+		ExecType:           c.ExecType,
+		Shell:              c.Shell,
+		RunAs:              c.RunAs,
+		WorkingDirectory:   c.WorkingDirectory,
+		CPULimit:           c.CPULimit,
+		MemoryLimit:        c.MemoryLimit,
+		Nice:               c.Nice,
+		Executor:           c.Executor,
+		HistoryRetention:   c.HistoryRetention,
+		HistoryOutput:      c.HistoryOutput,
+		Pipelines:          c.Pipelines,
+		Standalone:         c.Standalone,
+		RunbookURL:         c.RunbookURL,
+		DashboardURL:       c.DashboardURL,
+		AnomalyDetection:   c.AnomalyDetection,
+		AnomalyThreshold:   c.AnomalyThreshold,
+		AnomalyWindow:      c.AnomalyWindow,
+		Contacts:           c.Contacts,
 	}
 	return check
 }
@@ -90,12 +189,36 @@ func (c *Check) Validate() error {
 		}
 	}
 
+	if err := validateSplay(c.Splay, c.SplayCoverage); err != nil {
+		return err
+	}
+
 	if c.ProxyRequests != nil {
 		if err := c.ProxyRequests.Validate(); err != nil {
 			return err
 		}
 	}
 
+	if err := ValidateMetricRetention(c.MetricRetention); err != nil {
+		return err
+	}
+
+	if err := validateExecType(c.ExecType, c.Shell); err != nil {
+		return err
+	}
+
+	if err := validateResourceLimits(c.CPULimit, c.Nice); err != nil {
+		return err
+	}
+
+	if err := validateExecutor(c.Executor, c.Command, c.ProxyEntityID, c.ProxyRequests); err != nil {
+		return err
+	}
+
+	if err := validateHistoryRetention(c.HistoryRetention, c.HighFlapThreshold, c.LowFlapThreshold); err != nil {
+		return err
+	}
+
 	return c.Subdue.Validate()
 }
 
@@ -139,41 +262,42 @@ func (c *CheckConfig) Get(name string) (interface{}, error) {
 	return dynamic.GetField(c, name)
 }
 
-// Validate returns an error if the check does not pass validation tests.
+// Validate returns an error if the check does not pass validation tests. The
+// error is a ValidationErrors reporting all the invalid fields.
 func (c *CheckConfig) Validate() error {
+	var errs ValidationErrors
+
 	if err := ValidateName(c.Name); err != nil {
-		return errors.New("check name " + err.Error())
+		errs.Add("name", requiredOrInvalid(c.Name), "check name "+err.Error())
 	}
 
 	if c.Cron != "" {
 		if c.Interval > 0 {
-			return errors.New("must only specify either an interval or a cron schedule")
-		}
-
-		if _, err := cron.ParseStandard(c.Cron); err != nil {
-			return errors.New("check cron string is invalid")
+			errs.Add("cron", ValidationInvalid, "must only specify either an interval or a cron schedule")
+		} else if _, err := cron.ParseStandard(c.Cron); err != nil {
+			errs.Add("cron", ValidationInvalid, "check cron string is invalid")
 		}
 	}
 
 	if c.Interval == 0 && c.Cron == "" {
-		return errors.New("check interval must be greater than 0 or a valid cron schedule must be provided")
+		errs.Add("interval", ValidationRequired, "check interval must be greater than 0 or a valid cron schedule must be provided")
 	}
 
 	if c.Environment == "" {
-		return errors.New("environment cannot be empty")
+		errs.Add("environment", ValidationRequired, "environment cannot be empty")
 	}
 
 	if c.Organization == "" {
-		return errors.New("organization must be set")
+		errs.Add("organization", ValidationRequired, "organization must be set")
 	}
 
 	if c.Ttl > 0 && c.Ttl <= int64(c.Interval) {
-		return errors.New("ttl must be greater than check interval")
+		errs.Add("ttl", ValidationInvalid, "ttl must be greater than check interval")
 	}
 
-	for _, assetName := range c.RuntimeAssets {
+	for i, assetName := range c.RuntimeAssets {
 		if err := ValidateAssetName(assetName); err != nil {
-			return fmt.Errorf("asset's %s", err)
+			errs.Addf(fmt.Sprintf("runtime_assets[%d]", i), requiredOrInvalid(assetName), "asset's %s", err)
 		}
 	}
 
@@ -181,17 +305,83 @@ func (c *CheckConfig) Validate() error {
 	// alphanumeric string)
 	if c.ProxyEntityID != "" {
 		if err := ValidateName(c.ProxyEntityID); err != nil {
-			return errors.New("proxy entity id " + err.Error())
+			errs.Add("proxy_entity_id", ValidationInvalid, "proxy entity id "+err.Error())
 		}
 	}
 
+	if err := validateSplay(c.Splay, c.SplayCoverage); err != nil {
+		errs.Add("splay_coverage", ValidationInvalid, err.Error())
+	}
+
 	if c.ProxyRequests != nil {
 		if err := c.ProxyRequests.Validate(); err != nil {
-			return err
+			errs.Add("proxy_requests", ValidationInvalid, err.Error())
 		}
 	}
 
-	return c.Subdue.Validate()
+	if err := ValidateMetricRetention(c.MetricRetention); err != nil {
+		errs.Add("metric_retention", ValidationInvalid, err.Error())
+	}
+
+	if err := validateExecType(c.ExecType, c.Shell); err != nil {
+		errs.Add("exec_type", ValidationInvalid, err.Error())
+	}
+
+	if err := validateResourceLimits(c.CPULimit, c.Nice); err != nil {
+		errs.Add("resource_limits", ValidationInvalid, err.Error())
+	}
+
+	if err := validateExecutor(c.Executor, c.Command, c.ProxyEntityID, c.ProxyRequests); err != nil {
+		errs.Add("executor", ValidationInvalid, err.Error())
+	}
+
+	if err := validateCatchUp(c.CatchUp); err != nil {
+		errs.Add("catch_up", ValidationInvalid, err.Error())
+	}
+
+	if err := validateHistoryRetention(c.HistoryRetention, c.HighFlapThreshold, c.LowFlapThreshold); err != nil {
+		errs.Add("history_retention", ValidationInvalid, err.Error())
+	}
+
+	if err := validateLink(c.RunbookURL); err != nil {
+		errs.Add("runbook_url", ValidationInvalid, err.Error())
+	}
+
+	if err := validateLink(c.DashboardURL); err != nil {
+		errs.Add("dashboard_url", ValidationInvalid, err.Error())
+	}
+
+	if err := validateAnomalyDetection(c.AnomalyDetection, c.AnomalyThreshold, c.AnomalyWindow); err != nil {
+		errs.Add("anomaly_detection", ValidationInvalid, err.Error())
+	}
+
+	for i, contact := range c.Contacts {
+		if strings.TrimSpace(contact) == "" {
+			errs.Add(fmt.Sprintf("contacts[%d]", i), ValidationRequired, "contact cannot be empty")
+		}
+	}
+
+	for i, pipeline := range c.Pipelines {
+		if err := ValidateName(pipeline); err != nil {
+			errs.Add(fmt.Sprintf("pipelines[%d]", i), requiredOrInvalid(pipeline), "pipeline name "+err.Error())
+		}
+	}
+
+	if c.Standalone {
+		if err := c.validateStandalone(); err != nil {
+			errs.Add("standalone", ValidationInvalid, err.Error())
+		}
+	}
+
+	if err := c.Subdue.Validate(); err != nil {
+		errs.Add("subdue", ValidationInvalid, err.Error())
+	}
+
+	if err := c.validateOverrides(); err != nil {
+		errs.Add("overrides", ValidationInvalid, err.Error())
+	}
+
+	return errs.Err()
 }
 
 // Validate returns an error if the ProxyRequests does not pass validation tests
@@ -207,6 +397,152 @@ func (p *ProxyRequests) Validate() error {
 	return eval.ValidateStatements(p.EntityAttributes)
 }
 
+// validateSplay returns an error if the splay settings of a check are invalid
+func validateSplay(splay bool, coverage uint32) error {
+	if coverage > 100 {
+		return errors.New("check splay coverage must be between 0 and 100")
+	}
+
+	if splay && coverage == 0 {
+		return errors.New("check splay coverage must be greater than 0 if splay is enabled")
+	}
+
+	return nil
+}
+
+func validateExecType(execType, shell string) error {
+	switch execType {
+	case "", CheckExecTypeShell:
+		return nil
+	case CheckExecTypeDirect:
+		if shell != "" {
+			return errors.New("check shell cannot be set with the direct exec type")
+		}
+		return nil
+	}
+
+	return fmt.Errorf(
+		"check exec type must be either %q or %q",
+		CheckExecTypeShell, CheckExecTypeDirect,
+	)
+}
+
+func validateResourceLimits(cpuLimit float64, nice int32) error {
+	if cpuLimit < 0 {
+		return errors.New("check cpu limit cannot be negative")
+	}
+
+	if nice < -20 || nice > 19 {
+		return errors.New("check nice level must be between -20 and 19")
+	}
+
+	return nil
+}
+
+func validateCatchUp(catchUp string) error {
+	switch catchUp {
+	case "", CheckCatchUpSkip, CheckCatchUpRunOnce:
+		return nil
+	}
+
+	return fmt.Errorf(
+		"check catch up must be either %q or %q",
+		CheckCatchUpSkip, CheckCatchUpRunOnce,
+	)
+}
+
+// validateStandalone returns an error if the check relies on the backend,
+// which standalone checks are scheduled without.
+func (c *CheckConfig) validateStandalone() error {
+	switch {
+	case c.Executor == CheckExecutorBackend:
+		return errors.New("standalone checks are executed by the agent")
+	case c.ProxyRequests != nil || c.RoundRobin:
+		return errors.New("standalone checks cannot have proxy requests or be round robin")
+	case len(c.CheckHooks) > 0 || len(c.RuntimeAssets) > 0:
+		return errors.New("standalone checks cannot have hooks or runtime assets")
+	}
+	return nil
+}
+
+// validateHistoryRetention returns an error if the history retention of a
+// check is out of bounds, or too short for its flap detection.
+func validateHistoryRetention(retention, highFlap, lowFlap uint32) error {
+	if retention > MaxHistoryRetention {
+		return fmt.Errorf("check history retention cannot be greater than %d", MaxHistoryRetention)
+	}
+
+	if retention > 0 && retention < DefaultHistoryRetention && (highFlap > 0 || lowFlap > 0) {
+		return fmt.Errorf("check history retention must be at least %d for flap detection", DefaultHistoryRetention)
+	}
+
+	return nil
+}
+
+// validateAnomalyDetection returns an error if the anomaly detection
+// algorithm of a check is unknown, or its parameters out of bounds.
+func validateAnomalyDetection(detection string, threshold float64, window uint32) error {
+	switch detection {
+	case "", AnomalyDetectionZScore, AnomalyDetectionEWMA:
+	default:
+		return fmt.Errorf(
+			"check anomaly detection must be either %q or %q",
+			AnomalyDetectionZScore, AnomalyDetectionEWMA,
+		)
+	}
+
+	if threshold < 0 {
+		return errors.New("check anomaly threshold must be greater than or equal to 0")
+	}
+
+	if window == 1 || window > MaxAnomalyWindow {
+		return fmt.Errorf("check anomaly window must be between 2 and %d", MaxAnomalyWindow)
+	}
+
+	return nil
+}
+
+// validateLink returns an error if a link of a check is not a valid
+// template.
+func validateLink(link string) error {
+	if _, err := template.New("").Parse(link); err != nil {
+		return fmt.Errorf("invalid link template: %s", err)
+	}
+	return nil
+}
+
+func validateExecutor(executor, command, proxyEntityID string, proxyRequests *ProxyRequests) error {
+	switch executor {
+	case "", CheckExecutorAgent:
+		return nil
+	case CheckExecutorBackend:
+	default:
+		return fmt.Errorf(
+			"check executor must be either %q or %q",
+			CheckExecutorAgent, CheckExecutorBackend,
+		)
+	}
+
+	if proxyEntityID == "" && proxyRequests == nil {
+		return errors.New("checks executed by the backend require a proxy entity id or proxy requests")
+	}
+
+	// The backend only executes the builtin checks of remote services
+	u, err := url.Parse(command)
+	if err == nil && u.Scheme == "builtin" {
+		for _, name := range backendCheckCommands {
+			if u.Host == name {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf(
+		"checks executed by the backend must use one of the builtin://%s commands",
+		strings.Join(backendCheckCommands, ", builtin://"),
+	)
+}
+
 // ByExecuted implements the sort.Interface for []CheckHistory based on the
 // Executed field.
 //
@@ -227,15 +563,66 @@ func (c *Check) MergeWith(chk *Check) {
 		Status:   chk.Status,
 		Executed: chk.Executed,
 	}
+	if c.HistoryOutput {
+		histEntry.Output = chk.Output
+	}
 
 	history = append([]CheckHistory{histEntry}, history...)
 	sort.Sort(ByExecuted(history))
-	if len(history) > 21 {
-		history = history[1:]
-	}
 
 	c.History = history
 	c.LastOK = chk.LastOK
+	c.TrimHistory()
+}
+
+// HistoryLimit returns the number of executions kept in the history of the
+// check.
+func (c *Check) HistoryLimit() int {
+	if c.HistoryRetention == 0 {
+		return DefaultHistoryRetention
+	}
+	return int(c.HistoryRetention)
+}
+
+// TrimHistory drops the oldest executions of the history of the check beyond
+// its history limit, and their output unless the check keeps it, in which
+// case it is truncated to MaxHistoryOutputSize. The history must be sorted by
+// execution time.
+func (c *Check) TrimHistory() {
+	if limit := c.HistoryLimit(); len(c.History) > limit {
+		c.History = c.History[len(c.History)-limit:]
+	}
+	for i := range c.History {
+		if c.HistoryOutput {
+			c.History[i].Output = truncateHistoryOutput(c.History[i].Output)
+		} else {
+			c.History[i].Output = ""
+		}
+	}
+}
+
+// truncateHistoryOutput truncates the output to MaxHistoryOutputSize bytes,
+// without splitting its last character.
+func truncateHistoryOutput(output string) string {
+	if len(output) <= MaxHistoryOutputSize {
+		return output
+	}
+	i := MaxHistoryOutputSize
+	for i > 0 && !utf8.RuneStart(output[i]) {
+		i--
+	}
+	return output[:i]
+}
+
+// SetNamespace sets the organization and environment of the check.
+func (c *CheckConfig) SetNamespace(org, env string) {
+	c.Organization = org
+	c.Environment = env
+}
+
+// URIPath returns the path of the check, relative to the API root.
+func (c *CheckConfig) URIPath() string {
+	return path.Join("/checks", url.PathEscape(c.Name))
 }
 
 // FixtureCheckRequest returns a fixture for a CheckRequest object.
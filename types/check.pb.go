@@ -27,6 +27,23 @@ type CheckRequest struct {
 	Assets []Asset `protobuf:"bytes,2,rep,name=assets" json:"assets"`
 	// Hooks are a list of hooks to be executed after a check.
 	Hooks []HookConfig `protobuf:"bytes,3,rep,name=hooks" json:"hooks"`
+	// SplayWindow is the window of time, in milliseconds, over which the
+	// execution of the check is spread by its subscribers. Zero means the check
+	// is executed right away.
+	SplayWindow uint32 `protobuf:"varint,4,opt,name=splay_window,json=splayWindow,proto3" json:"splay_window,omitempty"`
+	// ID uniquely identifies the request, so the results of a request which
+	// are published more than once can be deduplicated.
+	ID string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// Issued is the time, in milliseconds since the Epoch, at which the request
+	// was issued.
+	Issued int64 `protobuf:"varint,6,opt,name=issued,proto3" json:"issued,omitempty"`
+	// Signature is the ECDSA signature of the request by the key of its
+	// organization, verified by the agents before executing the check.
+	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Adhoc indicates that the request was issued on demand rather than
+	// scheduled, in which case the check is executed regardless of the
+	// interval overrides of the entities.
+	Adhoc bool `protobuf:"varint,8,opt,name=adhoc,proto3" json:"adhoc,omitempty"`
 }
 
 func (m *CheckRequest) Reset()                    { *m = CheckRequest{} }
@@ -55,6 +72,41 @@ func (m *CheckRequest) GetHooks() []HookConfig {
 	return nil
 }
 
+func (m *CheckRequest) GetSplayWindow() uint32 {
+	if m != nil {
+		return m.SplayWindow
+	}
+	return 0
+}
+
+func (m *CheckRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *CheckRequest) GetIssued() int64 {
+	if m != nil {
+		return m.Issued
+	}
+	return 0
+}
+
+func (m *CheckRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *CheckRequest) GetAdhoc() bool {
+	if m != nil {
+		return m.Adhoc
+	}
+	return false
+}
+
 // A ProxyRequests represents a request to execute a proxy check
 type ProxyRequests struct {
 	// EntityAttributes store serialized arbitrary JSON-encoded data to match
@@ -140,6 +192,97 @@ type CheckConfig struct {
 	ProxyRequests *ProxyRequests `protobuf:"bytes,20,opt,name=proxy_requests,json=proxyRequests" json:"proxy_requests,omitempty"`
 	// RoundRobin enables round-robin scheduling if set true.
 	RoundRobin bool `protobuf:"varint,21,opt,name=round_robin,json=roundRobin,proto3" json:"round_robin,omitempty"`
+	// Splay indicates if the execution of the check by its subscribers should be
+	// spread over a window of time, rather than happen all at once.
+	Splay bool `protobuf:"varint,22,opt,name=splay,proto3" json:"splay,omitempty"`
+	// SplayCoverage is the percentage of the check interval over which
+	// executions are spread when splay is enabled.
+	SplayCoverage uint32 `protobuf:"varint,23,opt,name=splay_coverage,json=splayCoverage,proto3" json:"splay_coverage"`
+	// Nagios indicates that the command follows the Nagios plugin conventions:
+	// its output is split into the output, the long output and the perfdata,
+	// which is extracted as metrics, and unexpected exit codes are unknown.
+	Nagios bool `protobuf:"varint,24,opt,name=nagios,proto3" json:"nagios,omitempty"`
+	// OutputAnnotations indicates that the output of the command can end with
+	// a JSON object, which is removed from the output and provides the
+	// annotations of the events (e.g. links or runbook URLs).
+	OutputAnnotations bool `protobuf:"varint,25,opt,name=output_annotations,json=outputAnnotations,proto3" json:"output_annotations,omitempty"`
+	// MetricRetention is the policy applied to the metric points of the events
+	// before they are persisted: "all" (default) keeps every point,
+	// "downsample" keeps the latest point of each series and "none" keeps no
+	// points. The handlers always receive every metric point.
+	MetricRetention string `protobuf:"bytes,26,opt,name=metric_retention,json=metricRetention,proto3" json:"metric_retention,omitempty"`
+	// ExecType is how the command is executed: "shell" (default) runs it
+	// through a shell, while "direct" splits it into arguments and executes it
+	// without any shell interpretation.
+	ExecType string `protobuf:"bytes,27,opt,name=exec_type,json=execType,proto3" json:"exec_type,omitempty"`
+	// Shell is the shell through which the command is executed, e.g. bash or
+	// powershell, instead of the platform default. It's ignored by the "direct"
+	// exec type.
+	Shell string `protobuf:"bytes,28,opt,name=shell,proto3" json:"shell,omitempty"`
+	// RunAs is the user as which the command is executed, through sudo. The
+	// sudo rules of the agent's host must allow it without a password.
+	RunAs string `protobuf:"bytes,29,opt,name=run_as,json=runAs,proto3" json:"run_as,omitempty"`
+	// WorkingDirectory is the directory in which the command is executed,
+	// instead of the one of the agent.
+	WorkingDirectory string `protobuf:"bytes,30,opt,name=working_directory,json=workingDirectory,proto3" json:"working_directory,omitempty"`
+	// CPULimit is the maximum number of CPUs the command may use, e.g. 0.5 for
+	// half of one CPU. It's enforced with cgroups on Linux and job objects on
+	// Windows, and not limited if zero.
+	CPULimit float64 `protobuf:"fixed64,31,opt,name=cpu_limit,json=cpuLimit,proto3" json:"cpu_limit,omitempty"`
+	// MemoryLimit is the maximum memory, in bytes, the command may use. It's
+	// enforced with cgroups on Linux and job objects on Windows, and not
+	// limited if zero.
+	MemoryLimit uint64 `protobuf:"varint,32,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	// Nice is the scheduling priority of the command, from -20 (highest) to 19
+	// (lowest). It's mapped to a priority class on Windows.
+	Nice int32 `protobuf:"varint,33,opt,name=nice,proto3" json:"nice,omitempty"`
+	// Executor is what executes the check: "agent" (default) executes it on
+	// the agents of its subscriptions, while "backend" executes its builtin
+	// http, tcp or icmp command on the backend, for its proxy entities.
+	Executor string `protobuf:"bytes,34,opt,name=executor,proto3" json:"executor,omitempty"`
+	// CatchUp is what the scheduler does when it missed executions of the check,
+	// e.g. because the backend was paused or its clock jumped forward: "skip"
+	// (default) waits for the next scheduled execution, while "run-once"
+	// executes the check once right away.
+	CatchUp string `protobuf:"bytes,35,opt,name=catch_up,json=catchUp,proto3" json:"catch_up,omitempty"`
+	// HistoryRetention is the number of executions kept in the history of the
+	// events of the check, 21 by default. Flap detection requires at least 21
+	// executions.
+	HistoryRetention uint32 `protobuf:"varint,36,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
+	// HistoryOutput indicates that the output of each execution is kept in the
+	// history of the events of the check, instead of only its status.
+	HistoryOutput bool `protobuf:"varint,37,opt,name=history_output,json=historyOutput,proto3" json:"history_output,omitempty"`
+	// Pipelines are the names of the pipelines processing the events of the
+	// check, along with its handlers.
+	Pipelines []string `protobuf:"bytes,38,rep,name=pipelines" json:"pipelines,omitempty"`
+	// Standalone indicates that the check is defined locally on an agent and
+	// scheduled by that agent, rather than by the backend.
+	Standalone bool `protobuf:"varint,39,opt,name=standalone,proto3" json:"standalone,omitempty"`
+	// Overrides change the interval, the timeout or the disabled flag of the
+	// check for the entities they match. The first override matching an
+	// entity applies.
+	Overrides []CheckOverride `protobuf:"bytes,40,rep,name=overrides" json:"overrides,omitempty"`
+	// RunbookURL is the URL of the runbook of the check, linked from its
+	// events. It's a template evaluated with the event, e.g.
+	// https://wiki/runbooks/{{ .Check.Name }}
+	RunbookURL string `protobuf:"bytes,41,opt,name=runbook_url,json=runbookUrl,proto3" json:"runbook_url,omitempty"`
+	// DashboardURL is the URL of the dashboard of the check, linked from its
+	// events. It's a template evaluated with the event, e.g.
+	// https://grafana/d/hosts?var-host={{ .Entity.ID }}
+	DashboardURL string `protobuf:"bytes,42,opt,name=dashboard_url,json=dashboardUrl,proto3" json:"dashboard_url,omitempty"`
+	// AnomalyDetection is the algorithm eventd uses to flag the anomalous
+	// metric points of the events of the check, compared to their rolling
+	// statistics: "zscore" or "ewma". Empty disables the detection.
+	AnomalyDetection string `protobuf:"bytes,43,opt,name=anomaly_detection,json=anomalyDetection,proto3" json:"anomaly_detection,omitempty"`
+	// AnomalyThreshold is the number of standard deviations from the mean
+	// beyond which a metric point is anomalous, 3 by default.
+	AnomalyThreshold float64 `protobuf:"fixed64,44,opt,name=anomaly_threshold,json=anomalyThreshold,proto3" json:"anomaly_threshold,omitempty"`
+	// AnomalyWindow is the number of samples the rolling statistics of the
+	// metric points are computed over, 30 by default.
+	AnomalyWindow uint32 `protobuf:"varint,45,opt,name=anomaly_window,json=anomalyWindow,proto3" json:"anomaly_window,omitempty"`
+	// Contacts are the people or teams notified of the events of the check,
+	// e.g. by the handlers which rate limit their notifications per contact.
+	Contacts []string `protobuf:"bytes,46,rep,name=contacts" json:"contacts,omitempty"`
 }
 
 func (m *CheckConfig) Reset()                    { *m = CheckConfig{} }
@@ -294,6 +437,238 @@ func (m *CheckConfig) GetRoundRobin() bool {
 	return false
 }
 
+func (m *CheckConfig) GetSplay() bool {
+	if m != nil {
+		return m.Splay
+	}
+	return false
+}
+
+func (m *CheckConfig) GetSplayCoverage() uint32 {
+	if m != nil {
+		return m.SplayCoverage
+	}
+	return 0
+}
+
+func (m *CheckConfig) GetNagios() bool {
+	if m != nil {
+		return m.Nagios
+	}
+	return false
+}
+
+func (m *CheckConfig) GetOutputAnnotations() bool {
+	if m != nil {
+		return m.OutputAnnotations
+	}
+	return false
+}
+
+func (m *CheckConfig) GetMetricRetention() string {
+	if m != nil {
+		return m.MetricRetention
+	}
+	return ""
+}
+
+func (m *CheckConfig) GetExecType() string {
+	if m != nil {
+		return m.ExecType
+	}
+	return ""
+}
+
+func (m *CheckConfig) GetShell() string {
+	if m != nil {
+		return m.Shell
+	}
+	return ""
+}
+
+func (m *CheckConfig) GetRunAs() string {
+	if m != nil {
+		return m.RunAs
+	}
+	return ""
+}
+
+func (m *CheckConfig) GetWorkingDirectory() string {
+	if m != nil {
+		return m.WorkingDirectory
+	}
+	return ""
+}
+
+func (m *CheckConfig) GetCPULimit() float64 {
+	if m != nil {
+		return m.CPULimit
+	}
+	return 0
+}
+
+func (m *CheckConfig) GetMemoryLimit() uint64 {
+	if m != nil {
+		return m.MemoryLimit
+	}
+	return 0
+}
+
+func (m *CheckConfig) GetNice() int32 {
+	if m != nil {
+		return m.Nice
+	}
+	return 0
+}
+
+func (m *CheckConfig) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
+func (m *CheckConfig) GetCatchUp() string {
+	if m != nil {
+		return m.CatchUp
+	}
+	return ""
+}
+
+func (m *CheckConfig) GetHistoryRetention() uint32 {
+	if m != nil {
+		return m.HistoryRetention
+	}
+	return 0
+}
+
+func (m *CheckConfig) GetHistoryOutput() bool {
+	if m != nil {
+		return m.HistoryOutput
+	}
+	return false
+}
+
+func (m *CheckConfig) GetPipelines() []string {
+	if m != nil {
+		return m.Pipelines
+	}
+	return nil
+}
+
+func (m *CheckConfig) GetStandalone() bool {
+	if m != nil {
+		return m.Standalone
+	}
+	return false
+}
+
+func (m *CheckConfig) GetOverrides() []CheckOverride {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *CheckConfig) GetRunbookURL() string {
+	if m != nil {
+		return m.RunbookURL
+	}
+	return ""
+}
+
+func (m *CheckConfig) GetDashboardURL() string {
+	if m != nil {
+		return m.DashboardURL
+	}
+	return ""
+}
+
+func (m *CheckConfig) GetAnomalyDetection() string {
+	if m != nil {
+		return m.AnomalyDetection
+	}
+	return ""
+}
+
+func (m *CheckConfig) GetAnomalyThreshold() float64 {
+	if m != nil {
+		return m.AnomalyThreshold
+	}
+	return 0
+}
+
+func (m *CheckConfig) GetAnomalyWindow() uint32 {
+	if m != nil {
+		return m.AnomalyWindow
+	}
+	return 0
+}
+
+func (m *CheckConfig) GetContacts() []string {
+	if m != nil {
+		return m.Contacts
+	}
+	return nil
+}
+
+// A CheckOverride changes some fields of a check for the entities it matches,
+// by ID or by labels, when they execute the check.
+type CheckOverride struct {
+	// Entity is the ID of the entity matched.
+	Entity string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// Labels are the labels the entities matched must all have.
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Interval is the interval, in seconds, at which the entities execute the
+	// check, unless it's zero.
+	Interval uint32 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Timeout is the timeout, in seconds, of the check for the entities, unless
+	// it's zero.
+	Timeout uint32 `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Disabled indicates that the entities don't execute the check.
+	Disabled bool `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (m *CheckOverride) Reset()                    { *m = CheckOverride{} }
+func (m *CheckOverride) String() string            { return proto.CompactTextString(m) }
+func (*CheckOverride) ProtoMessage()               {}
+func (*CheckOverride) Descriptor() ([]byte, []int) { return fileDescriptorCheck, []int{3} }
+
+func (m *CheckOverride) GetEntity() string {
+	if m != nil {
+		return m.Entity
+	}
+	return ""
+}
+
+func (m *CheckOverride) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *CheckOverride) GetInterval() uint32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *CheckOverride) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *CheckOverride) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
 // A Check is a check specification and optionally the results of the check's
 // execution.
 type Check struct {
@@ -358,6 +733,103 @@ type Check struct {
 	TotalStateChange uint32 `protobuf:"varint,29,opt,name=total_state_change,json=totalStateChange,proto3" json:"total_state_change,omitempty"`
 	// LastOK displays last time this check was ok; if event status is 0 this is set to timestamp
 	LastOK int64 `protobuf:"varint,30,opt,name=last_ok,json=lastOk,proto3" json:"last_ok,omitempty"`
+	// Splay indicates if the execution of the check by its subscribers should be
+	// spread over a window of time, rather than happen all at once.
+	Splay bool `protobuf:"varint,31,opt,name=splay,proto3" json:"splay,omitempty"`
+	// SplayCoverage is the percentage of the check interval over which
+	// executions are spread when splay is enabled.
+	SplayCoverage uint32 `protobuf:"varint,32,opt,name=splay_coverage,json=splayCoverage,proto3" json:"splay_coverage"`
+	// Nagios indicates that the command follows the Nagios plugin conventions:
+	// its output is split into the output, the long output and the perfdata,
+	// which is extracted as metrics, and unexpected exit codes are unknown.
+	Nagios bool `protobuf:"varint,33,opt,name=nagios,proto3" json:"nagios,omitempty"`
+	// LongOutput is the output of a Nagios plugin following its first line
+	LongOutput string `protobuf:"bytes,34,opt,name=long_output,json=longOutput,proto3" json:"long_output,omitempty"`
+	// OutputAnnotations indicates that the output of the command can end with
+	// a JSON object, which is removed from the output and provides the
+	// annotations of the events (e.g. links or runbook URLs).
+	OutputAnnotations bool `protobuf:"varint,35,opt,name=output_annotations,json=outputAnnotations,proto3" json:"output_annotations,omitempty"`
+	// Annotations are the annotations provided by the output of the command
+	Annotations map[string]string `protobuf:"bytes,36,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// MetricRetention is the policy applied to the metric points of the events
+	// before they are persisted: "all" (default) keeps every point,
+	// "downsample" keeps the latest point of each series and "none" keeps no
+	// points. The handlers always receive every metric point.
+	MetricRetention string `protobuf:"bytes,37,opt,name=metric_retention,json=metricRetention,proto3" json:"metric_retention,omitempty"`
+	// RequestID is the ID of the check request which produced the result
+	RequestID string `protobuf:"bytes,38,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// ExecType is how the command is executed: "shell" (default) runs it
+	// through a shell, while "direct" splits it into arguments and executes it
+	// without any shell interpretation.
+	ExecType string `protobuf:"bytes,39,opt,name=exec_type,json=execType,proto3" json:"exec_type,omitempty"`
+	// Shell is the shell through which the command is executed, e.g. bash or
+	// powershell, instead of the platform default. It's ignored by the "direct"
+	// exec type.
+	Shell string `protobuf:"bytes,40,opt,name=shell,proto3" json:"shell,omitempty"`
+	// RunAs is the user as which the command is executed, through sudo. The
+	// sudo rules of the agent's host must allow it without a password.
+	RunAs string `protobuf:"bytes,41,opt,name=run_as,json=runAs,proto3" json:"run_as,omitempty"`
+	// WorkingDirectory is the directory in which the command is executed,
+	// instead of the one of the agent.
+	WorkingDirectory string `protobuf:"bytes,42,opt,name=working_directory,json=workingDirectory,proto3" json:"working_directory,omitempty"`
+	// CPULimit is the maximum number of CPUs the command may use, e.g. 0.5 for
+	// half of one CPU. It's enforced with cgroups on Linux and job objects on
+	// Windows, and not limited if zero.
+	CPULimit float64 `protobuf:"fixed64,43,opt,name=cpu_limit,json=cpuLimit,proto3" json:"cpu_limit,omitempty"`
+	// MemoryLimit is the maximum memory, in bytes, the command may use. It's
+	// enforced with cgroups on Linux and job objects on Windows, and not
+	// limited if zero.
+	MemoryLimit uint64 `protobuf:"varint,44,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	// Nice is the scheduling priority of the command, from -20 (highest) to 19
+	// (lowest). It's mapped to a priority class on Windows.
+	Nice int32 `protobuf:"varint,45,opt,name=nice,proto3" json:"nice,omitempty"`
+	// Executor is what executes the check: "agent" (default) executes it on
+	// the agents of its subscriptions, while "backend" executes its builtin
+	// http, tcp or icmp command on the backend, for its proxy entities.
+	Executor string `protobuf:"bytes,46,opt,name=executor,proto3" json:"executor,omitempty"`
+	// HistoryRetention is the number of executions kept in the history of the
+	// events of the check, 21 by default. Flap detection requires at least 21
+	// executions.
+	HistoryRetention uint32 `protobuf:"varint,47,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
+	// HistoryOutput indicates that the output of each execution is kept in the
+	// history of the events of the check, instead of only its status.
+	HistoryOutput bool `protobuf:"varint,48,opt,name=history_output,json=historyOutput,proto3" json:"history_output,omitempty"`
+	// Pipelines are the names of the pipelines processing the events of the
+	// check, along with its handlers.
+	Pipelines []string `protobuf:"bytes,49,rep,name=pipelines" json:"pipelines,omitempty"`
+	// Standalone indicates that the check is defined locally on an agent and
+	// scheduled by that agent, rather than by the backend.
+	Standalone bool `protobuf:"varint,50,opt,name=standalone,proto3" json:"standalone,omitempty"`
+	// CPUTime is the CPU time, in seconds, used by the execution of Command,
+	// in user and system mode.
+	CPUTime float64 `protobuf:"fixed64,51,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	// MaxRSS is the maximum resident set size, in bytes, of the execution of
+	// Command. It's not measured on Windows.
+	MaxRSS uint64 `protobuf:"varint,52,opt,name=max_rss,json=maxRss,proto3" json:"max_rss,omitempty"`
+	// RunbookURL is the URL of the runbook of the check, linked from its
+	// events. It's a template evaluated with the event, e.g.
+	// https://wiki/runbooks/{{ .Check.Name }}
+	RunbookURL string `protobuf:"bytes,53,opt,name=runbook_url,json=runbookUrl,proto3" json:"runbook_url,omitempty"`
+	// DashboardURL is the URL of the dashboard of the check, linked from its
+	// events. It's a template evaluated with the event, e.g.
+	// https://grafana/d/hosts?var-host={{ .Entity.ID }}
+	DashboardURL string `protobuf:"bytes,54,opt,name=dashboard_url,json=dashboardUrl,proto3" json:"dashboard_url,omitempty"`
+	// AnomalyDetection is the algorithm eventd uses to flag the anomalous
+	// metric points of the events of the check, compared to their rolling
+	// statistics: "zscore" or "ewma". Empty disables the detection.
+	AnomalyDetection string `protobuf:"bytes,55,opt,name=anomaly_detection,json=anomalyDetection,proto3" json:"anomaly_detection,omitempty"`
+	// AnomalyThreshold is the number of standard deviations from the mean
+	// beyond which a metric point is anomalous, 3 by default.
+	AnomalyThreshold float64 `protobuf:"fixed64,56,opt,name=anomaly_threshold,json=anomalyThreshold,proto3" json:"anomaly_threshold,omitempty"`
+	// AnomalyWindow is the number of samples the rolling statistics of the
+	// metric points are computed over, 30 by default.
+	AnomalyWindow uint32 `protobuf:"varint,57,opt,name=anomaly_window,json=anomalyWindow,proto3" json:"anomaly_window,omitempty"`
+	// Baselines are the rolling statistics of the metric points of the events
+	// of the check, kept by eventd for anomaly detection.
+	Baselines []MetricBaseline `protobuf:"bytes,58,rep,name=baselines" json:"baselines,omitempty"`
+	// Contacts are the people or teams notified of the events of the check,
+	// e.g. by the handlers which rate limit their notifications per contact.
+	Contacts []string `protobuf:"bytes,59,rep,name=contacts" json:"contacts,omitempty"`
 	// ExtendedAttributes store serialized arbitrary JSON-encoded data
 	ExtendedAttributes []byte `protobuf:"bytes,99,opt,name=ExtendedAttributes,proto3" json:"-"`
 }
@@ -365,7 +837,7 @@ type Check struct {
 func (m *Check) Reset()                    { *m = Check{} }
 func (m *Check) String() string            { return proto.CompactTextString(m) }
 func (*Check) ProtoMessage()               {}
-func (*Check) Descriptor() ([]byte, []int) { return fileDescriptorCheck, []int{3} }
+func (*Check) Descriptor() ([]byte, []int) { return fileDescriptorCheck, []int{4} }
 
 func (m *Check) GetCommand() string {
 	if m != nil {
@@ -570,76 +1042,338 @@ func (m *Check) GetLastOK() int64 {
 	return 0
 }
 
-func (m *Check) GetExtendedAttributes() []byte {
+func (m *Check) GetSplay() bool {
 	if m != nil {
-		return m.ExtendedAttributes
+		return m.Splay
 	}
-	return nil
+	return false
 }
 
-// CheckHistory is a record of a check execution and its status
-type CheckHistory struct {
-	// Status is the exit status code produced by the check.
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	// Executed describes the time in which the check request was executed
-	Executed int64 `protobuf:"varint,2,opt,name=executed,proto3" json:"executed,omitempty"`
+func (m *Check) GetSplayCoverage() uint32 {
+	if m != nil {
+		return m.SplayCoverage
+	}
+	return 0
 }
 
-func (m *CheckHistory) Reset()                    { *m = CheckHistory{} }
-func (m *CheckHistory) String() string            { return proto.CompactTextString(m) }
-func (*CheckHistory) ProtoMessage()               {}
-func (*CheckHistory) Descriptor() ([]byte, []int) { return fileDescriptorCheck, []int{4} }
-
-func (m *CheckHistory) GetStatus() int32 {
+func (m *Check) GetNagios() bool {
 	if m != nil {
-		return m.Status
+		return m.Nagios
 	}
-	return 0
+	return false
 }
 
-func (m *CheckHistory) GetExecuted() int64 {
+func (m *Check) GetLongOutput() string {
 	if m != nil {
-		return m.Executed
+		return m.LongOutput
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*CheckRequest)(nil), "sensu.types.CheckRequest")
-	proto.RegisterType((*ProxyRequests)(nil), "sensu.types.ProxyRequests")
-	proto.RegisterType((*CheckConfig)(nil), "sensu.types.CheckConfig")
-	proto.RegisterType((*Check)(nil), "sensu.types.Check")
-	proto.RegisterType((*CheckHistory)(nil), "sensu.types.CheckHistory")
+func (m *Check) GetOutputAnnotations() bool {
+	if m != nil {
+		return m.OutputAnnotations
+	}
+	return false
 }
-func (this *CheckRequest) Equal(that interface{}) bool {
-	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+
+func (m *Check) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
 	}
+	return nil
+}
 
-	that1, ok := that.(*CheckRequest)
-	if !ok {
-		that2, ok := that.(CheckRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Check) GetMetricRetention() string {
+	if m != nil {
+		return m.MetricRetention
 	}
-	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *Check) GetRequestID() string {
+	if m != nil {
+		return m.RequestID
 	}
-	if !this.Config.Equal(that1.Config) {
-		return false
+	return ""
+}
+
+func (m *Check) GetExecType() string {
+	if m != nil {
+		return m.ExecType
 	}
-	if len(this.Assets) != len(that1.Assets) {
+	return ""
+}
+
+func (m *Check) GetShell() string {
+	if m != nil {
+		return m.Shell
+	}
+	return ""
+}
+
+func (m *Check) GetRunAs() string {
+	if m != nil {
+		return m.RunAs
+	}
+	return ""
+}
+
+func (m *Check) GetWorkingDirectory() string {
+	if m != nil {
+		return m.WorkingDirectory
+	}
+	return ""
+}
+
+func (m *Check) GetCPULimit() float64 {
+	if m != nil {
+		return m.CPULimit
+	}
+	return 0
+}
+
+func (m *Check) GetMemoryLimit() uint64 {
+	if m != nil {
+		return m.MemoryLimit
+	}
+	return 0
+}
+
+func (m *Check) GetNice() int32 {
+	if m != nil {
+		return m.Nice
+	}
+	return 0
+}
+
+func (m *Check) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
+func (m *Check) GetHistoryRetention() uint32 {
+	if m != nil {
+		return m.HistoryRetention
+	}
+	return 0
+}
+
+func (m *Check) GetHistoryOutput() bool {
+	if m != nil {
+		return m.HistoryOutput
+	}
+	return false
+}
+
+func (m *Check) GetPipelines() []string {
+	if m != nil {
+		return m.Pipelines
+	}
+	return nil
+}
+
+func (m *Check) GetStandalone() bool {
+	if m != nil {
+		return m.Standalone
+	}
+	return false
+}
+
+func (m *Check) GetCPUTime() float64 {
+	if m != nil {
+		return m.CPUTime
+	}
+	return 0
+}
+
+func (m *Check) GetMaxRSS() uint64 {
+	if m != nil {
+		return m.MaxRSS
+	}
+	return 0
+}
+
+func (m *Check) GetRunbookURL() string {
+	if m != nil {
+		return m.RunbookURL
+	}
+	return ""
+}
+
+func (m *Check) GetDashboardURL() string {
+	if m != nil {
+		return m.DashboardURL
+	}
+	return ""
+}
+
+func (m *Check) GetAnomalyDetection() string {
+	if m != nil {
+		return m.AnomalyDetection
+	}
+	return ""
+}
+
+func (m *Check) GetAnomalyThreshold() float64 {
+	if m != nil {
+		return m.AnomalyThreshold
+	}
+	return 0
+}
+
+func (m *Check) GetAnomalyWindow() uint32 {
+	if m != nil {
+		return m.AnomalyWindow
+	}
+	return 0
+}
+
+func (m *Check) GetBaselines() []MetricBaseline {
+	if m != nil {
+		return m.Baselines
+	}
+	return nil
+}
+
+func (m *Check) GetContacts() []string {
+	if m != nil {
+		return m.Contacts
+	}
+	return nil
+}
+
+func (m *Check) GetExtendedAttributes() []byte {
+	if m != nil {
+		return m.ExtendedAttributes
+	}
+	return nil
+}
+
+// MetricBaseline is the rolling mean and variance of a metric, identified by
+// its name and tags.
+type MetricBaseline struct {
+	// Key is the name of the metric, followed by its sorted tags, if any.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Mean is the rolling mean of the metric.
+	Mean float64 `protobuf:"fixed64,2,opt,name=mean,proto3" json:"mean,omitempty"`
+	// Variance is the rolling variance of the metric.
+	Variance float64 `protobuf:"fixed64,3,opt,name=variance,proto3" json:"variance,omitempty"`
+	// Samples is the number of samples in the statistics, up to the anomaly
+	// window of the check.
+	Samples uint32 `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (m *MetricBaseline) Reset()                    { *m = MetricBaseline{} }
+func (m *MetricBaseline) String() string            { return proto.CompactTextString(m) }
+func (*MetricBaseline) ProtoMessage()               {}
+func (*MetricBaseline) Descriptor() ([]byte, []int) { return fileDescriptorCheck, []int{5} }
+
+func (m *MetricBaseline) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *MetricBaseline) GetMean() float64 {
+	if m != nil {
+		return m.Mean
+	}
+	return 0
+}
+
+func (m *MetricBaseline) GetVariance() float64 {
+	if m != nil {
+		return m.Variance
+	}
+	return 0
+}
+
+func (m *MetricBaseline) GetSamples() uint32 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
+// CheckHistory is a record of a check execution and its status
+type CheckHistory struct {
+	// Status is the exit status code produced by the check.
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Executed describes the time in which the check request was executed
+	Executed int64 `protobuf:"varint,2,opt,name=executed,proto3" json:"executed,omitempty"`
+	// Output is the output produced by the check, only kept if the check's
+	// HistoryOutput is set.
+	Output string `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (m *CheckHistory) Reset()                    { *m = CheckHistory{} }
+func (m *CheckHistory) String() string            { return proto.CompactTextString(m) }
+func (*CheckHistory) ProtoMessage()               {}
+func (*CheckHistory) Descriptor() ([]byte, []int) { return fileDescriptorCheck, []int{6} }
+
+func (m *CheckHistory) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *CheckHistory) GetExecuted() int64 {
+	if m != nil {
+		return m.Executed
+	}
+	return 0
+}
+
+func (m *CheckHistory) GetOutput() string {
+	if m != nil {
+		return m.Output
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CheckRequest)(nil), "sensu.types.CheckRequest")
+	proto.RegisterType((*ProxyRequests)(nil), "sensu.types.ProxyRequests")
+	proto.RegisterType((*CheckConfig)(nil), "sensu.types.CheckConfig")
+	proto.RegisterType((*CheckOverride)(nil), "sensu.types.CheckOverride")
+	proto.RegisterType((*Check)(nil), "sensu.types.Check")
+	proto.RegisterType((*MetricBaseline)(nil), "sensu.types.MetricBaseline")
+	proto.RegisterType((*CheckHistory)(nil), "sensu.types.CheckHistory")
+}
+func (this *CheckRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*CheckRequest)
+	if !ok {
+		that2, ok := that.(CheckRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Config.Equal(that1.Config) {
+		return false
+	}
+	if len(this.Assets) != len(that1.Assets) {
 		return false
 	}
 	for i := range this.Assets {
@@ -655,6 +1389,21 @@ func (this *CheckRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.SplayWindow != that1.SplayWindow {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Issued != that1.Issued {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	if this.Adhoc != that1.Adhoc {
+		return false
+	}
 	return true
 }
 func (this *ProxyRequests) Equal(that interface{}) bool {
@@ -806,72 +1555,209 @@ func (this *CheckConfig) Equal(that interface{}) bool {
 	if this.RoundRobin != that1.RoundRobin {
 		return false
 	}
-	return true
-}
-func (this *Check) Equal(that interface{}) bool {
-	if that == nil {
-		if this == nil {
-			return true
-		}
+	if this.Splay != that1.Splay {
 		return false
 	}
-
-	that1, ok := that.(*Check)
-	if !ok {
-		that2, ok := that.(Check)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+	if this.SplayCoverage != that1.SplayCoverage {
+		return false
 	}
-	if that1 == nil {
-		if this == nil {
-			return true
-		}
+	if this.Nagios != that1.Nagios {
 		return false
-	} else if this == nil {
+	}
+	if this.OutputAnnotations != that1.OutputAnnotations {
 		return false
 	}
-	if this.Command != that1.Command {
+	if this.MetricRetention != that1.MetricRetention {
 		return false
 	}
-	if this.Environment != that1.Environment {
+	if this.ExecType != that1.ExecType {
 		return false
 	}
-	if len(this.Handlers) != len(that1.Handlers) {
+	if this.Shell != that1.Shell {
 		return false
 	}
-	for i := range this.Handlers {
-		if this.Handlers[i] != that1.Handlers[i] {
-			return false
-		}
+	if this.RunAs != that1.RunAs {
+		return false
 	}
-	if this.HighFlapThreshold != that1.HighFlapThreshold {
+	if this.WorkingDirectory != that1.WorkingDirectory {
 		return false
 	}
-	if this.Interval != that1.Interval {
+	if this.CPULimit != that1.CPULimit {
 		return false
 	}
-	if this.LowFlapThreshold != that1.LowFlapThreshold {
+	if this.MemoryLimit != that1.MemoryLimit {
 		return false
 	}
-	if this.Name != that1.Name {
+	if this.Nice != that1.Nice {
 		return false
 	}
-	if this.Organization != that1.Organization {
+	if this.Executor != that1.Executor {
 		return false
 	}
-	if this.Publish != that1.Publish {
+	if this.CatchUp != that1.CatchUp {
 		return false
 	}
-	if len(this.RuntimeAssets) != len(that1.RuntimeAssets) {
+	if this.HistoryRetention != that1.HistoryRetention {
 		return false
 	}
-	for i := range this.RuntimeAssets {
-		if this.RuntimeAssets[i] != that1.RuntimeAssets[i] {
-			return false
-		}
+	if this.HistoryOutput != that1.HistoryOutput {
+		return false
+	}
+	if len(this.Pipelines) != len(that1.Pipelines) {
+		return false
+	}
+	for i := range this.Pipelines {
+		if this.Pipelines[i] != that1.Pipelines[i] {
+			return false
+		}
+	}
+	if this.Standalone != that1.Standalone {
+		return false
+	}
+	if len(this.Overrides) != len(that1.Overrides) {
+		return false
+	}
+	for i := range this.Overrides {
+		if !this.Overrides[i].Equal(&that1.Overrides[i]) {
+			return false
+		}
+	}
+	if this.RunbookURL != that1.RunbookURL {
+		return false
+	}
+	if this.DashboardURL != that1.DashboardURL {
+		return false
+	}
+	if this.AnomalyDetection != that1.AnomalyDetection {
+		return false
+	}
+	if this.AnomalyThreshold != that1.AnomalyThreshold {
+		return false
+	}
+	if this.AnomalyWindow != that1.AnomalyWindow {
+		return false
+	}
+	if len(this.Contacts) != len(that1.Contacts) {
+		return false
+	}
+	for i := range this.Contacts {
+		if this.Contacts[i] != that1.Contacts[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *CheckOverride) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*CheckOverride)
+	if !ok {
+		that2, ok := that.(CheckOverride)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Entity != that1.Entity {
+		return false
+	}
+	if len(this.Labels) != len(that1.Labels) {
+		return false
+	}
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.Timeout != that1.Timeout {
+		return false
+	}
+	if this.Disabled != that1.Disabled {
+		return false
+	}
+	return true
+}
+func (this *Check) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Check)
+	if !ok {
+		that2, ok := that.(Check)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Command != that1.Command {
+		return false
+	}
+	if this.Environment != that1.Environment {
+		return false
+	}
+	if len(this.Handlers) != len(that1.Handlers) {
+		return false
+	}
+	for i := range this.Handlers {
+		if this.Handlers[i] != that1.Handlers[i] {
+			return false
+		}
+	}
+	if this.HighFlapThreshold != that1.HighFlapThreshold {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.LowFlapThreshold != that1.LowFlapThreshold {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Organization != that1.Organization {
+		return false
+	}
+	if this.Publish != that1.Publish {
+		return false
+	}
+	if len(this.RuntimeAssets) != len(that1.RuntimeAssets) {
+		return false
+	}
+	for i := range this.RuntimeAssets {
+		if this.RuntimeAssets[i] != that1.RuntimeAssets[i] {
+			return false
+		}
 	}
 	if len(this.Subscriptions) != len(that1.Subscriptions) {
 		return false
@@ -945,11 +1831,157 @@ func (this *Check) Equal(that interface{}) bool {
 	if this.LastOK != that1.LastOK {
 		return false
 	}
+	if this.Splay != that1.Splay {
+		return false
+	}
+	if this.SplayCoverage != that1.SplayCoverage {
+		return false
+	}
+	if this.Nagios != that1.Nagios {
+		return false
+	}
+	if this.LongOutput != that1.LongOutput {
+		return false
+	}
+	if this.OutputAnnotations != that1.OutputAnnotations {
+		return false
+	}
+	if len(this.Annotations) != len(that1.Annotations) {
+		return false
+	}
+	for i := range this.Annotations {
+		if this.Annotations[i] != that1.Annotations[i] {
+			return false
+		}
+	}
+	if this.MetricRetention != that1.MetricRetention {
+		return false
+	}
+	if this.RequestID != that1.RequestID {
+		return false
+	}
+	if this.ExecType != that1.ExecType {
+		return false
+	}
+	if this.Shell != that1.Shell {
+		return false
+	}
+	if this.RunAs != that1.RunAs {
+		return false
+	}
+	if this.WorkingDirectory != that1.WorkingDirectory {
+		return false
+	}
+	if this.CPULimit != that1.CPULimit {
+		return false
+	}
+	if this.MemoryLimit != that1.MemoryLimit {
+		return false
+	}
+	if this.Nice != that1.Nice {
+		return false
+	}
+	if this.Executor != that1.Executor {
+		return false
+	}
+	if this.HistoryRetention != that1.HistoryRetention {
+		return false
+	}
+	if this.HistoryOutput != that1.HistoryOutput {
+		return false
+	}
+	if len(this.Pipelines) != len(that1.Pipelines) {
+		return false
+	}
+	for i := range this.Pipelines {
+		if this.Pipelines[i] != that1.Pipelines[i] {
+			return false
+		}
+	}
+	if this.Standalone != that1.Standalone {
+		return false
+	}
+	if this.CPUTime != that1.CPUTime {
+		return false
+	}
+	if this.MaxRSS != that1.MaxRSS {
+		return false
+	}
+	if this.RunbookURL != that1.RunbookURL {
+		return false
+	}
+	if this.DashboardURL != that1.DashboardURL {
+		return false
+	}
+	if this.AnomalyDetection != that1.AnomalyDetection {
+		return false
+	}
+	if this.AnomalyThreshold != that1.AnomalyThreshold {
+		return false
+	}
+	if this.AnomalyWindow != that1.AnomalyWindow {
+		return false
+	}
+	if len(this.Baselines) != len(that1.Baselines) {
+		return false
+	}
+	for i := range this.Baselines {
+		if !this.Baselines[i].Equal(&that1.Baselines[i]) {
+			return false
+		}
+	}
+	if len(this.Contacts) != len(that1.Contacts) {
+		return false
+	}
+	for i := range this.Contacts {
+		if this.Contacts[i] != that1.Contacts[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.ExtendedAttributes, that1.ExtendedAttributes) {
 		return false
 	}
 	return true
 }
+func (this *MetricBaseline) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*MetricBaseline)
+	if !ok {
+		that2, ok := that.(MetricBaseline)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Mean != that1.Mean {
+		return false
+	}
+	if this.Variance != that1.Variance {
+		return false
+	}
+	if this.Samples != that1.Samples {
+		return false
+	}
+	return true
+}
 func (this *CheckHistory) Equal(that interface{}) bool {
 	if that == nil {
 		if this == nil {
@@ -981,6 +2013,9 @@ func (this *CheckHistory) Equal(that interface{}) bool {
 	if this.Executed != that1.Executed {
 		return false
 	}
+	if this.Output != that1.Output {
+		return false
+	}
 	return true
 }
 func (m *CheckRequest) Marshal() (dAtA []byte, err error) {
@@ -1032,20 +2067,52 @@ func (m *CheckRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	return i, nil
-}
-
-func (m *ProxyRequests) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.SplayWindow != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.SplayWindow))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ProxyRequests) MarshalTo(dAtA []byte) (int, error) {
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Issued != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.Issued))
+	}
+	if len(m.Signature) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Signature)))
+		i += copy(dAtA[i:], m.Signature)
+	}
+	if m.Adhoc {
+		dAtA[i] = 0x40
+		i++
+		if m.Adhoc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ProxyRequests) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProxyRequests) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -1284,6 +2351,306 @@ func (m *CheckConfig) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Splay {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Splay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.SplayCoverage != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.SplayCoverage))
+	}
+	if m.Nagios {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Nagios {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.OutputAnnotations {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.OutputAnnotations {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.MetricRetention) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.MetricRetention)))
+		i += copy(dAtA[i:], m.MetricRetention)
+	}
+	if len(m.ExecType) > 0 {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.ExecType)))
+		i += copy(dAtA[i:], m.ExecType)
+	}
+	if len(m.Shell) > 0 {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Shell)))
+		i += copy(dAtA[i:], m.Shell)
+	}
+	if len(m.RunAs) > 0 {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.RunAs)))
+		i += copy(dAtA[i:], m.RunAs)
+	}
+	if len(m.WorkingDirectory) > 0 {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.WorkingDirectory)))
+		i += copy(dAtA[i:], m.WorkingDirectory)
+	}
+	if m.CPULimit != 0 {
+		dAtA[i] = 0xf9
+		i++
+		dAtA[i] = 0x1
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CPULimit))))
+		i += 8
+	}
+	if m.MemoryLimit != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.MemoryLimit))
+	}
+	if m.Nice != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.Nice))
+	}
+	if len(m.Executor) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Executor)))
+		i += copy(dAtA[i:], m.Executor)
+	}
+	if len(m.CatchUp) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.CatchUp)))
+		i += copy(dAtA[i:], m.CatchUp)
+	}
+	if m.HistoryRetention != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.HistoryRetention))
+	}
+	if m.HistoryOutput {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.HistoryOutput {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Pipelines) > 0 {
+		for _, s := range m.Pipelines {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x2
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Standalone {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.Standalone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Overrides) > 0 {
+		for _, msg := range m.Overrides {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x2
+			i++
+			i = encodeVarintCheck(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.RunbookURL) > 0 {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.RunbookURL)))
+		i += copy(dAtA[i:], m.RunbookURL)
+	}
+	if len(m.DashboardURL) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.DashboardURL)))
+		i += copy(dAtA[i:], m.DashboardURL)
+	}
+	if len(m.AnomalyDetection) > 0 {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.AnomalyDetection)))
+		i += copy(dAtA[i:], m.AnomalyDetection)
+	}
+	if m.AnomalyThreshold != 0 {
+		dAtA[i] = 0xe1
+		i++
+		dAtA[i] = 0x2
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AnomalyThreshold))))
+		i += 8
+	}
+	if m.AnomalyWindow != 0 {
+		dAtA[i] = 0xe8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.AnomalyWindow))
+	}
+	if len(m.Contacts) > 0 {
+		for _, s := range m.Contacts {
+			dAtA[i] = 0xf2
+			i++
+			dAtA[i] = 0x2
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *CheckOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckOverride) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entity) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Entity)))
+		i += copy(dAtA[i:], m.Entity)
+	}
+	if len(m.Labels) > 0 {
+		for k, _ := range m.Labels {
+			dAtA[i] = 0x12
+			i++
+			v := m.Labels[k]
+			mapSize := 1 + len(k) + sovCheck(uint64(len(k))) + 1 + len(v) + sovCheck(uint64(len(v)))
+			i = encodeVarintCheck(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCheck(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCheck(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.Interval != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.Interval))
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.Timeout))
+	}
+	if m.Disabled {
+		dAtA[i] = 0x28
+		i++
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1555,23 +2922,345 @@ func (m *Check) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCheck(dAtA, i, uint64(m.LastOK))
 	}
-	if len(m.ExtendedAttributes) > 0 {
-		dAtA[i] = 0x9a
+	if m.Splay {
+		dAtA[i] = 0xf8
 		i++
-		dAtA[i] = 0x6
+		dAtA[i] = 0x1
+		i++
+		if m.Splay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintCheck(dAtA, i, uint64(len(m.ExtendedAttributes)))
-		i += copy(dAtA[i:], m.ExtendedAttributes)
 	}
-	return i, nil
-}
-
-func (m *CheckHistory) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.SplayCoverage != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.SplayCoverage))
+	}
+	if m.Nagios {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.Nagios {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.LongOutput) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.LongOutput)))
+		i += copy(dAtA[i:], m.LongOutput)
+	}
+	if m.OutputAnnotations {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.OutputAnnotations {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Annotations) > 0 {
+		for k, _ := range m.Annotations {
+			dAtA[i] = 0xa2
+			i++
+			dAtA[i] = 0x2
+			i++
+			v := m.Annotations[k]
+			mapSize := 1 + len(k) + sovCheck(uint64(len(k))) + 1 + len(v) + sovCheck(uint64(len(v)))
+			i = encodeVarintCheck(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCheck(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCheck(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.MetricRetention) > 0 {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.MetricRetention)))
+		i += copy(dAtA[i:], m.MetricRetention)
+	}
+	if len(m.RequestID) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.RequestID)))
+		i += copy(dAtA[i:], m.RequestID)
+	}
+	if len(m.ExecType) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.ExecType)))
+		i += copy(dAtA[i:], m.ExecType)
+	}
+	if len(m.Shell) > 0 {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Shell)))
+		i += copy(dAtA[i:], m.Shell)
+	}
+	if len(m.RunAs) > 0 {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.RunAs)))
+		i += copy(dAtA[i:], m.RunAs)
+	}
+	if len(m.WorkingDirectory) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.WorkingDirectory)))
+		i += copy(dAtA[i:], m.WorkingDirectory)
+	}
+	if m.CPULimit != 0 {
+		dAtA[i] = 0xd9
+		i++
+		dAtA[i] = 0x2
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CPULimit))))
+		i += 8
+	}
+	if m.MemoryLimit != 0 {
+		dAtA[i] = 0xe0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.MemoryLimit))
+	}
+	if m.Nice != 0 {
+		dAtA[i] = 0xe8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.Nice))
+	}
+	if len(m.Executor) > 0 {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Executor)))
+		i += copy(dAtA[i:], m.Executor)
+	}
+	if m.HistoryRetention != 0 {
+		dAtA[i] = 0xf8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.HistoryRetention))
+	}
+	if m.HistoryOutput {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x3
+		i++
+		if m.HistoryOutput {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Pipelines) > 0 {
+		for _, s := range m.Pipelines {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x3
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Standalone {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x3
+		i++
+		if m.Standalone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.CPUTime != 0 {
+		dAtA[i] = 0x99
+		i++
+		dAtA[i] = 0x3
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CPUTime))))
+		i += 8
+	}
+	if m.MaxRSS != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.MaxRSS))
+	}
+	if len(m.RunbookURL) > 0 {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.RunbookURL)))
+		i += copy(dAtA[i:], m.RunbookURL)
+	}
+	if len(m.DashboardURL) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.DashboardURL)))
+		i += copy(dAtA[i:], m.DashboardURL)
+	}
+	if len(m.AnomalyDetection) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.AnomalyDetection)))
+		i += copy(dAtA[i:], m.AnomalyDetection)
+	}
+	if m.AnomalyThreshold != 0 {
+		dAtA[i] = 0xc1
+		i++
+		dAtA[i] = 0x3
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AnomalyThreshold))))
+		i += 8
+	}
+	if m.AnomalyWindow != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.AnomalyWindow))
+	}
+	if len(m.Baselines) > 0 {
+		for _, msg := range m.Baselines {
+			dAtA[i] = 0xd2
+			i++
+			dAtA[i] = 0x3
+			i++
+			i = encodeVarintCheck(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Contacts) > 0 {
+		for _, s := range m.Contacts {
+			dAtA[i] = 0xda
+			i++
+			dAtA[i] = 0x3
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ExtendedAttributes) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.ExtendedAttributes)))
+		i += copy(dAtA[i:], m.ExtendedAttributes)
+	}
+	return i, nil
+}
+
+func (m *MetricBaseline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricBaseline) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Mean != 0 {
+		dAtA[i] = 0x11
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Mean))))
+		i += 8
+	}
+	if m.Variance != 0 {
+		dAtA[i] = 0x19
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Variance))))
+		i += 8
+	}
+	if m.Samples != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.Samples))
+	}
+	return i, nil
+}
+
+func (m *CheckHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -1591,6 +3280,12 @@ func (m *CheckHistory) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCheck(dAtA, i, uint64(m.Executed))
 	}
+	if len(m.Output) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Output)))
+		i += copy(dAtA[i:], m.Output)
+	}
 	return i, nil
 }
 
@@ -1624,6 +3319,18 @@ func NewPopulatedCheckRequest(r randyCheck, easy bool) *CheckRequest {
 			this.Hooks[i] = *v4
 		}
 	}
+	this.SplayWindow = uint32(r.Uint32())
+	this.ID = string(randStringCheck(r))
+	this.Issued = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Issued *= -1
+	}
+	v5 := r.Intn(100)
+	this.Signature = make([]byte, v5)
+	for i := 0; i < v5; i++ {
+		this.Signature[i] = byte(r.Intn(256))
+	}
+	this.Adhoc = bool(bool(r.Intn(2) == 0))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1631,9 +3338,9 @@ func NewPopulatedCheckRequest(r randyCheck, easy bool) *CheckRequest {
 
 func NewPopulatedProxyRequests(r randyCheck, easy bool) *ProxyRequests {
 	this := &ProxyRequests{}
-	v5 := r.Intn(10)
-	this.EntityAttributes = make([]string, v5)
-	for i := 0; i < v5; i++ {
+	v6 := r.Intn(10)
+	this.EntityAttributes = make([]string, v6)
+	for i := 0; i < v6; i++ {
 		this.EntityAttributes[i] = string(randStringCheck(r))
 	}
 	this.Splay = bool(bool(r.Intn(2) == 0))
@@ -1647,9 +3354,9 @@ func NewPopulatedCheckConfig(r randyCheck, easy bool) *CheckConfig {
 	this := &CheckConfig{}
 	this.Command = string(randStringCheck(r))
 	this.Environment = string(randStringCheck(r))
-	v6 := r.Intn(10)
-	this.Handlers = make([]string, v6)
-	for i := 0; i < v6; i++ {
+	v7 := r.Intn(10)
+	this.Handlers = make([]string, v7)
+	for i := 0; i < v7; i++ {
 		this.Handlers[i] = string(randStringCheck(r))
 	}
 	this.HighFlapThreshold = uint32(r.Uint32())
@@ -1658,28 +3365,28 @@ func NewPopulatedCheckConfig(r randyCheck, easy bool) *CheckConfig {
 	this.Name = string(randStringCheck(r))
 	this.Organization = string(randStringCheck(r))
 	this.Publish = bool(bool(r.Intn(2) == 0))
-	v7 := r.Intn(10)
-	this.RuntimeAssets = make([]string, v7)
-	for i := 0; i < v7; i++ {
-		this.RuntimeAssets[i] = string(randStringCheck(r))
-	}
 	v8 := r.Intn(10)
-	this.Subscriptions = make([]string, v8)
+	this.RuntimeAssets = make([]string, v8)
 	for i := 0; i < v8; i++ {
-		this.Subscriptions[i] = string(randStringCheck(r))
+		this.RuntimeAssets[i] = string(randStringCheck(r))
 	}
-	v9 := r.Intn(100)
-	this.ExtendedAttributes = make([]byte, v9)
+	v9 := r.Intn(10)
+	this.Subscriptions = make([]string, v9)
 	for i := 0; i < v9; i++ {
+		this.Subscriptions[i] = string(randStringCheck(r))
+	}
+	v10 := r.Intn(100)
+	this.ExtendedAttributes = make([]byte, v10)
+	for i := 0; i < v10; i++ {
 		this.ExtendedAttributes[i] = byte(r.Intn(256))
 	}
 	this.ProxyEntityID = string(randStringCheck(r))
 	if r.Intn(10) != 0 {
-		v10 := r.Intn(5)
-		this.CheckHooks = make([]HookList, v10)
-		for i := 0; i < v10; i++ {
-			v11 := NewPopulatedHookList(r, easy)
-			this.CheckHooks[i] = *v11
+		v11 := r.Intn(5)
+		this.CheckHooks = make([]HookList, v11)
+		for i := 0; i < v11; i++ {
+			v12 := NewPopulatedHookList(r, easy)
+			this.CheckHooks[i] = *v12
 		}
 	}
 	this.Stdin = bool(bool(r.Intn(2) == 0))
@@ -1696,6 +3403,73 @@ func NewPopulatedCheckConfig(r randyCheck, easy bool) *CheckConfig {
 		this.ProxyRequests = NewPopulatedProxyRequests(r, easy)
 	}
 	this.RoundRobin = bool(bool(r.Intn(2) == 0))
+	this.Splay = bool(bool(r.Intn(2) == 0))
+	this.SplayCoverage = uint32(r.Uint32())
+	this.Nagios = bool(bool(r.Intn(2) == 0))
+	this.OutputAnnotations = bool(bool(r.Intn(2) == 0))
+	this.MetricRetention = string(randStringCheck(r))
+	this.ExecType = string(randStringCheck(r))
+	this.Shell = string(randStringCheck(r))
+	this.RunAs = string(randStringCheck(r))
+	this.WorkingDirectory = string(randStringCheck(r))
+	this.CPULimit = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.CPULimit *= -1
+	}
+	this.MemoryLimit = uint64(uint64(r.Uint32()))
+	this.Nice = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Nice *= -1
+	}
+	this.Executor = string(randStringCheck(r))
+	this.CatchUp = string(randStringCheck(r))
+	this.HistoryRetention = uint32(r.Uint32())
+	this.HistoryOutput = bool(bool(r.Intn(2) == 0))
+	v13 := r.Intn(10)
+	this.Pipelines = make([]string, v13)
+	for i := 0; i < v13; i++ {
+		this.Pipelines[i] = string(randStringCheck(r))
+	}
+	this.Standalone = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) != 0 {
+		v14 := r.Intn(5)
+		this.Overrides = make([]CheckOverride, v14)
+		for i := 0; i < v14; i++ {
+			v15 := NewPopulatedCheckOverride(r, easy)
+			this.Overrides[i] = *v15
+		}
+	}
+	this.RunbookURL = string(randStringCheck(r))
+	this.DashboardURL = string(randStringCheck(r))
+	this.AnomalyDetection = string(randStringCheck(r))
+	this.AnomalyThreshold = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.AnomalyThreshold *= -1
+	}
+	this.AnomalyWindow = uint32(r.Uint32())
+	v16 := r.Intn(10)
+	this.Contacts = make([]string, v16)
+	for i := 0; i < v16; i++ {
+		this.Contacts[i] = string(randStringCheck(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCheckOverride(r randyCheck, easy bool) *CheckOverride {
+	this := &CheckOverride{}
+	this.Entity = string(randStringCheck(r))
+	if r.Intn(10) != 0 {
+		v17 := r.Intn(10)
+		this.Labels = make(map[string]string)
+		for i := 0; i < v17; i++ {
+			this.Labels[randStringCheck(r)] = randStringCheck(r)
+		}
+	}
+	this.Interval = uint32(r.Uint32())
+	this.Timeout = uint32(r.Uint32())
+	this.Disabled = bool(bool(r.Intn(2) == 0))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1705,9 +3479,9 @@ func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 	this := &Check{}
 	this.Command = string(randStringCheck(r))
 	this.Environment = string(randStringCheck(r))
-	v12 := r.Intn(10)
-	this.Handlers = make([]string, v12)
-	for i := 0; i < v12; i++ {
+	v18 := r.Intn(10)
+	this.Handlers = make([]string, v18)
+	for i := 0; i < v18; i++ {
 		this.Handlers[i] = string(randStringCheck(r))
 	}
 	this.HighFlapThreshold = uint32(r.Uint32())
@@ -1716,23 +3490,23 @@ func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 	this.Name = string(randStringCheck(r))
 	this.Organization = string(randStringCheck(r))
 	this.Publish = bool(bool(r.Intn(2) == 0))
-	v13 := r.Intn(10)
-	this.RuntimeAssets = make([]string, v13)
-	for i := 0; i < v13; i++ {
+	v19 := r.Intn(10)
+	this.RuntimeAssets = make([]string, v19)
+	for i := 0; i < v19; i++ {
 		this.RuntimeAssets[i] = string(randStringCheck(r))
 	}
-	v14 := r.Intn(10)
-	this.Subscriptions = make([]string, v14)
-	for i := 0; i < v14; i++ {
+	v20 := r.Intn(10)
+	this.Subscriptions = make([]string, v20)
+	for i := 0; i < v20; i++ {
 		this.Subscriptions[i] = string(randStringCheck(r))
 	}
 	this.ProxyEntityID = string(randStringCheck(r))
 	if r.Intn(10) != 0 {
-		v15 := r.Intn(5)
-		this.CheckHooks = make([]HookList, v15)
-		for i := 0; i < v15; i++ {
-			v16 := NewPopulatedHookList(r, easy)
-			this.CheckHooks[i] = *v16
+		v21 := r.Intn(5)
+		this.CheckHooks = make([]HookList, v21)
+		for i := 0; i < v21; i++ {
+			v22 := NewPopulatedHookList(r, easy)
+			this.CheckHooks[i] = *v22
 		}
 	}
 	this.Stdin = bool(bool(r.Intn(2) == 0))
@@ -1758,11 +3532,11 @@ func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 		this.Executed *= -1
 	}
 	if r.Intn(10) != 0 {
-		v17 := r.Intn(5)
-		this.History = make([]CheckHistory, v17)
-		for i := 0; i < v17; i++ {
-			v18 := NewPopulatedCheckHistory(r, easy)
-			this.History[i] = *v18
+		v23 := r.Intn(5)
+		this.History = make([]CheckHistory, v23)
+		for i := 0; i < v23; i++ {
+			v24 := NewPopulatedCheckHistory(r, easy)
+			this.History[i] = *v24
 		}
 	}
 	this.Issued = int64(r.Int63())
@@ -1780,16 +3554,95 @@ func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 	if r.Intn(2) == 0 {
 		this.LastOK *= -1
 	}
-	v19 := r.Intn(100)
-	this.ExtendedAttributes = make([]byte, v19)
-	for i := 0; i < v19; i++ {
-		this.ExtendedAttributes[i] = byte(r.Intn(256))
+	this.Splay = bool(bool(r.Intn(2) == 0))
+	this.SplayCoverage = uint32(r.Uint32())
+	this.Nagios = bool(bool(r.Intn(2) == 0))
+	this.LongOutput = string(randStringCheck(r))
+	this.OutputAnnotations = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) != 0 {
+		v25 := r.Intn(10)
+		this.Annotations = make(map[string]string)
+		for i := 0; i < v25; i++ {
+			this.Annotations[randStringCheck(r)] = randStringCheck(r)
+		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	this.MetricRetention = string(randStringCheck(r))
+	this.RequestID = string(randStringCheck(r))
+	this.ExecType = string(randStringCheck(r))
+	this.Shell = string(randStringCheck(r))
+	this.RunAs = string(randStringCheck(r))
+	this.WorkingDirectory = string(randStringCheck(r))
+	this.CPULimit = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.CPULimit *= -1
 	}
-	return this
-}
-
+	this.MemoryLimit = uint64(uint64(r.Uint32()))
+	this.Nice = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Nice *= -1
+	}
+	this.Executor = string(randStringCheck(r))
+	this.HistoryRetention = uint32(r.Uint32())
+	this.HistoryOutput = bool(bool(r.Intn(2) == 0))
+	v26 := r.Intn(10)
+	this.Pipelines = make([]string, v26)
+	for i := 0; i < v26; i++ {
+		this.Pipelines[i] = string(randStringCheck(r))
+	}
+	this.Standalone = bool(bool(r.Intn(2) == 0))
+	this.CPUTime = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.CPUTime *= -1
+	}
+	this.MaxRSS = uint64(uint64(r.Uint32()))
+	this.RunbookURL = string(randStringCheck(r))
+	this.DashboardURL = string(randStringCheck(r))
+	this.AnomalyDetection = string(randStringCheck(r))
+	this.AnomalyThreshold = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.AnomalyThreshold *= -1
+	}
+	this.AnomalyWindow = uint32(r.Uint32())
+	if r.Intn(10) != 0 {
+		v27 := r.Intn(5)
+		this.Baselines = make([]MetricBaseline, v27)
+		for i := 0; i < v27; i++ {
+			v28 := NewPopulatedMetricBaseline(r, easy)
+			this.Baselines[i] = *v28
+		}
+	}
+	v29 := r.Intn(10)
+	this.Contacts = make([]string, v29)
+	for i := 0; i < v29; i++ {
+		this.Contacts[i] = string(randStringCheck(r))
+	}
+	v30 := r.Intn(100)
+	this.ExtendedAttributes = make([]byte, v30)
+	for i := 0; i < v30; i++ {
+		this.ExtendedAttributes[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMetricBaseline(r randyCheck, easy bool) *MetricBaseline {
+	this := &MetricBaseline{}
+	this.Key = string(randStringCheck(r))
+	this.Mean = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Mean *= -1
+	}
+	this.Variance = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Variance *= -1
+	}
+	this.Samples = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedCheckHistory(r randyCheck, easy bool) *CheckHistory {
 	this := &CheckHistory{}
 	this.Status = int32(r.Int31())
@@ -1800,6 +3653,7 @@ func NewPopulatedCheckHistory(r randyCheck, easy bool) *CheckHistory {
 	if r.Intn(2) == 0 {
 		this.Executed *= -1
 	}
+	this.Output = string(randStringCheck(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1824,9 +3678,9 @@ func randUTF8RuneCheck(r randyCheck) rune {
 	return rune(ru + 61)
 }
 func randStringCheck(r randyCheck) string {
-	v20 := r.Intn(100)
-	tmps := make([]rune, v20)
-	for i := 0; i < v20; i++ {
+	v31 := r.Intn(100)
+	tmps := make([]rune, v31)
+	for i := 0; i < v31; i++ {
 		tmps[i] = randUTF8RuneCheck(r)
 	}
 	return string(tmps)
@@ -1848,11 +3702,11 @@ func randFieldCheck(dAtA []byte, r randyCheck, fieldNumber int, wire int) []byte
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateCheck(dAtA, uint64(key))
-		v21 := r.Int63()
+		v32 := r.Int63()
 		if r.Intn(2) == 0 {
-			v21 *= -1
+			v32 *= -1
 		}
-		dAtA = encodeVarintPopulateCheck(dAtA, uint64(v21))
+		dAtA = encodeVarintPopulateCheck(dAtA, uint64(v32))
 	case 1:
 		dAtA = encodeVarintPopulateCheck(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1896,6 +3750,23 @@ func (m *CheckRequest) Size() (n int) {
 			n += 1 + l + sovCheck(uint64(l))
 		}
 	}
+	if m.SplayWindow != 0 {
+		n += 1 + sovCheck(uint64(m.SplayWindow))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovCheck(uint64(l))
+	}
+	if m.Issued != 0 {
+		n += 1 + sovCheck(uint64(m.Issued))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovCheck(uint64(l))
+	}
+	if m.Adhoc {
+		n += 2
+	}
 	return n
 }
 
@@ -2004,6 +3875,127 @@ func (m *CheckConfig) Size() (n int) {
 	if m.RoundRobin {
 		n += 3
 	}
+	if m.Splay {
+		n += 3
+	}
+	if m.SplayCoverage != 0 {
+		n += 2 + sovCheck(uint64(m.SplayCoverage))
+	}
+	if m.Nagios {
+		n += 3
+	}
+	if m.OutputAnnotations {
+		n += 3
+	}
+	l = len(m.MetricRetention)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.ExecType)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.Shell)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.RunAs)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.WorkingDirectory)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.CPULimit != 0 {
+		n += 10
+	}
+	if m.MemoryLimit != 0 {
+		n += 2 + sovCheck(uint64(m.MemoryLimit))
+	}
+	if m.Nice != 0 {
+		n += 2 + sovCheck(uint64(m.Nice))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.CatchUp)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.HistoryRetention != 0 {
+		n += 2 + sovCheck(uint64(m.HistoryRetention))
+	}
+	if m.HistoryOutput {
+		n += 3
+	}
+	if len(m.Pipelines) > 0 {
+		for _, s := range m.Pipelines {
+			l = len(s)
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	if m.Standalone {
+		n += 3
+	}
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	l = len(m.RunbookURL)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.DashboardURL)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.AnomalyDetection)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.AnomalyThreshold != 0 {
+		n += 10
+	}
+	if m.AnomalyWindow != 0 {
+		n += 2 + sovCheck(uint64(m.AnomalyWindow))
+	}
+	if len(m.Contacts) > 0 {
+		for _, s := range m.Contacts {
+			l = len(s)
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckOverride) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Entity)
+	if l > 0 {
+		n += 1 + l + sovCheck(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCheck(uint64(len(k))) + 1 + len(v) + sovCheck(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCheck(uint64(mapEntrySize))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovCheck(uint64(m.Interval))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovCheck(uint64(m.Timeout))
+	}
+	if m.Disabled {
+		n += 2
+	}
 	return n
 }
 
@@ -2122,6 +4114,118 @@ func (m *Check) Size() (n int) {
 	if m.LastOK != 0 {
 		n += 2 + sovCheck(uint64(m.LastOK))
 	}
+	if m.Splay {
+		n += 3
+	}
+	if m.SplayCoverage != 0 {
+		n += 2 + sovCheck(uint64(m.SplayCoverage))
+	}
+	if m.Nagios {
+		n += 3
+	}
+	l = len(m.LongOutput)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.OutputAnnotations {
+		n += 3
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCheck(uint64(len(k))) + 1 + len(v) + sovCheck(uint64(len(v)))
+			n += mapEntrySize + 2 + sovCheck(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.MetricRetention)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.RequestID)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.ExecType)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.Shell)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.RunAs)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.WorkingDirectory)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.CPULimit != 0 {
+		n += 10
+	}
+	if m.MemoryLimit != 0 {
+		n += 2 + sovCheck(uint64(m.MemoryLimit))
+	}
+	if m.Nice != 0 {
+		n += 2 + sovCheck(uint64(m.Nice))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.HistoryRetention != 0 {
+		n += 2 + sovCheck(uint64(m.HistoryRetention))
+	}
+	if m.HistoryOutput {
+		n += 3
+	}
+	if len(m.Pipelines) > 0 {
+		for _, s := range m.Pipelines {
+			l = len(s)
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	if m.Standalone {
+		n += 3
+	}
+	if m.CPUTime != 0 {
+		n += 10
+	}
+	if m.MaxRSS != 0 {
+		n += 2 + sovCheck(uint64(m.MaxRSS))
+	}
+	l = len(m.RunbookURL)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.DashboardURL)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.AnomalyDetection)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.AnomalyThreshold != 0 {
+		n += 10
+	}
+	if m.AnomalyWindow != 0 {
+		n += 2 + sovCheck(uint64(m.AnomalyWindow))
+	}
+	if len(m.Baselines) > 0 {
+		for _, e := range m.Baselines {
+			l = e.Size()
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	if len(m.Contacts) > 0 {
+		for _, s := range m.Contacts {
+			l = len(s)
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
 	l = len(m.ExtendedAttributes)
 	if l > 0 {
 		n += 2 + l + sovCheck(uint64(l))
@@ -2129,6 +4233,25 @@ func (m *Check) Size() (n int) {
 	return n
 }
 
+func (m *MetricBaseline) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCheck(uint64(l))
+	}
+	if m.Mean != 0 {
+		n += 9
+	}
+	if m.Variance != 0 {
+		n += 9
+	}
+	if m.Samples != 0 {
+		n += 1 + sovCheck(uint64(m.Samples))
+	}
+	return n
+}
+
 func (m *CheckHistory) Size() (n int) {
 	var l int
 	_ = l
@@ -2138,6 +4261,10 @@ func (m *CheckHistory) Size() (n int) {
 	if m.Executed != 0 {
 		n += 1 + sovCheck(uint64(m.Executed))
 	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 1 + l + sovCheck(uint64(l))
+	}
 	return n
 }
 
@@ -2220,7 +4347,1447 @@ func (m *CheckRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, Asset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, HookConfig{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplayWindow", wireType)
+			}
+			m.SplayWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplayWindow |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
+			}
+			m.Issued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Issued |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adhoc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Adhoc = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheck(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProxyRequests) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheck
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProxyRequests: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProxyRequests: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityAttributes = append(m.EntityAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splay", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Splay = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplayCoverage", wireType)
+			}
+			m.SplayCoverage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplayCoverage |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheck(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheck
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Environment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handlers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handlers = append(m.Handlers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighFlapThreshold", wireType)
+			}
+			m.HighFlapThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighFlapThreshold |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowFlapThreshold", wireType)
+			}
+			m.LowFlapThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowFlapThreshold |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Publish", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Publish = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeAssets = append(m.RuntimeAssets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedAttributes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedAttributes = append(m.ExtendedAttributes[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtendedAttributes == nil {
+				m.ExtendedAttributes = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyEntityID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyEntityID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckHooks = append(m.CheckHooks, HookList{})
+			if err := m.CheckHooks[len(m.CheckHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stdin = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Subdue == nil {
+				m.Subdue = &TimeWindowWhen{}
+			}
+			if err := m.Subdue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cron = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProxyRequests == nil {
+				m.ProxyRequests = &ProxyRequests{}
+			}
+			if err := m.ProxyRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundRobin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RoundRobin = bool(v != 0)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splay", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Splay = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplayCoverage", wireType)
+			}
+			m.SplayCoverage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplayCoverage |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nagios", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nagios = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputAnnotations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OutputAnnotations = bool(v != 0)
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricRetention", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricRetention = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shell = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunAs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkingDirectory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkingDirectory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPULimit", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CPULimit = float64(math.Float64frombits(v))
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimit", wireType)
+			}
+			m.MemoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryLimit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nice", wireType)
+			}
+			m.Nice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nice |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CatchUp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
+			}
+			m.HistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetention |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryOutput", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HistoryOutput = bool(v != 0)
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pipelines = append(m.Pipelines, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Standalone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Standalone = bool(v != 0)
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides, CheckOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunbookURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunbookURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DashboardURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DashboardURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnomalyDetection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnomalyDetection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 44:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnomalyThreshold", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AnomalyThreshold = float64(math.Float64frombits(v))
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnomalyWindow", wireType)
+			}
+			m.AnomalyWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -2230,28 +5797,16 @@ func (m *CheckRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.AnomalyWindow |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCheck
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Assets = append(m.Assets, Asset{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 46:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contacts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -2261,22 +5816,20 @@ func (m *CheckRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCheck
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hooks = append(m.Hooks, HookConfig{})
-			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contacts = append(m.Contacts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2299,7 +5852,7 @@ func (m *CheckRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProxyRequests) Unmarshal(dAtA []byte) error {
+func (m *CheckOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2322,15 +5875,15 @@ func (m *ProxyRequests) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProxyRequests: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProxyRequests: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntityAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2355,13 +5908,13 @@ func (m *ProxyRequests) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EntityAttributes = append(m.EntityAttributes, string(dAtA[iNdEx:postIndex]))
+			m.Entity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Splay", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -2371,17 +5924,115 @@ func (m *ProxyRequests) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Splay = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCheck
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCheck
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCheck
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCheck
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCheck
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCheck(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCheck
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplayCoverage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.SplayCoverage = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -2391,11 +6042,50 @@ func (m *ProxyRequests) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SplayCoverage |= (uint32(b) & 0x7F) << shift
+				m.Interval |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Disabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCheck(dAtA[iNdEx:])
@@ -2417,7 +6107,7 @@ func (m *ProxyRequests) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckConfig) Unmarshal(dAtA []byte) error {
+func (m *Check) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2440,10 +6130,10 @@ func (m *CheckConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Check: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Check: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2726,37 +6416,6 @@ func (m *CheckConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Subscriptions = append(m.Subscriptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedAttributes", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCheck
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCheck
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExtendedAttributes = append(m.ExtendedAttributes[:0], dAtA[iNdEx:postIndex]...)
-			if m.ExtendedAttributes == nil {
-				m.ExtendedAttributes = []byte{}
-			}
-			iNdEx = postIndex
 		case 13:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProxyEntityID", wireType)
@@ -2990,59 +6649,89 @@ func (m *CheckConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RoundRobin = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCheck(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 22:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			if skippy < 0 {
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Duration = float64(math.Float64frombits(v))
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			m.Executed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthCheck
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Check) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCheck
+			m.History = append(m.History, CheckHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Issued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Issued |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Check: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Check: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3067,11 +6756,11 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = string(dAtA[iNdEx:postIndex])
+			m.Output = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3096,13 +6785,13 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Environment = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Handlers", wireType)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3112,26 +6801,35 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Status |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCheck
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStateChange", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TotalStateChange = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalStateChange |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Handlers = append(m.Handlers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
+		case 30:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HighFlapThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOK", wireType)
 			}
-			m.HighFlapThreshold = 0
+			m.LastOK = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3141,16 +6839,16 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HighFlapThreshold |= (uint32(b) & 0x7F) << shift
+				m.LastOK |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 31:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Splay", wireType)
 			}
-			m.Interval = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3160,16 +6858,17 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			m.Splay = bool(v != 0)
+		case 32:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowFlapThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SplayCoverage", wireType)
 			}
-			m.LowFlapThreshold = 0
+			m.SplayCoverage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3179,14 +6878,34 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LowFlapThreshold |= (uint32(b) & 0x7F) << shift
+				m.SplayCoverage |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nagios", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nagios = bool(v != 0)
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LongOutput", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3211,11 +6930,149 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.LongOutput = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputAnnotations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OutputAnnotations = bool(v != 0)
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCheck
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCheck
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCheck
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCheck
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCheck
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCheck(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCheck
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricRetention", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3240,13 +7097,13 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Organization = string(dAtA[iNdEx:postIndex])
+			m.MetricRetention = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Publish", wireType)
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3256,15 +7113,24 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Publish = bool(v != 0)
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeAssets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3289,11 +7155,11 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuntimeAssets = append(m.RuntimeAssets, string(dAtA[iNdEx:postIndex]))
+			m.ExecType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3318,11 +7184,11 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subscriptions = append(m.Subscriptions, string(dAtA[iNdEx:postIndex]))
+			m.Shell = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyEntityID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3347,13 +7213,13 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProxyEntityID = string(dAtA[iNdEx:postIndex])
+			m.RunAs = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckHooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkingDirectory", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3363,28 +7229,37 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCheck
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CheckHooks = append(m.CheckHooks, HookList{})
-			if err := m.CheckHooks[len(m.CheckHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkingDirectory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 43:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPULimit", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CPULimit = float64(math.Float64frombits(v))
+		case 44:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimit", wireType)
 			}
-			var v int
+			m.MemoryLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3394,17 +7269,16 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.MemoryLimit |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stdin = bool(v != 0)
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subdue", wireType)
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nice", wireType)
 			}
-			var msglen int
+			m.Nice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3414,28 +7288,14 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Nice |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCheck
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Subdue == nil {
-				m.Subdue = &TimeWindowWhen{}
-			}
-			if err := m.Subdue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 17:
+		case 46:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3460,13 +7320,13 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cron = string(dAtA[iNdEx:postIndex])
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 47:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
 			}
-			m.Ttl = 0
+			m.HistoryRetention = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3476,16 +7336,16 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ttl |= (int64(b) & 0x7F) << shift
+				m.HistoryRetention |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
+		case 48:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryOutput", wireType)
 			}
-			m.Timeout = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3495,16 +7355,17 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+			m.HistoryOutput = bool(v != 0)
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3514,28 +7375,24 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCheck
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProxyRequests == nil {
-				m.ProxyRequests = &ProxyRequests{}
-			}
-			if err := m.ProxyRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pipelines = append(m.Pipelines, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 21:
+		case 50:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoundRobin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Standalone", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -3552,10 +7409,10 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.RoundRobin = bool(v != 0)
-		case 22:
+			m.Standalone = bool(v != 0)
+		case 51:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUTime", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -3563,12 +7420,12 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.Duration = float64(math.Float64frombits(v))
-		case 23:
+			m.CPUTime = float64(math.Float64frombits(v))
+		case 52:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRSS", wireType)
 			}
-			m.Executed = 0
+			m.MaxRSS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3578,16 +7435,16 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Executed |= (int64(b) & 0x7F) << shift
+				m.MaxRSS |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 24:
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunbookURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3597,45 +7454,24 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCheck
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.History = append(m.History, CheckHistory{})
-			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RunbookURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
-			}
-			m.Issued = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCheck
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Issued |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 26:
+		case 54:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DashboardURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3660,11 +7496,11 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Output = string(dAtA[iNdEx:postIndex])
+			m.DashboardURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 27:
+		case 55:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnomalyDetection", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3689,13 +7525,24 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.AnomalyDetection = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 28:
+		case 56:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnomalyThreshold", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AnomalyThreshold = float64(math.Float64frombits(v))
+		case 57:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnomalyWindow", wireType)
 			}
-			m.Status = 0
+			m.AnomalyWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3705,16 +7552,16 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (int32(b) & 0x7F) << shift
+				m.AnomalyWindow |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 29:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStateChange", wireType)
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Baselines", wireType)
 			}
-			m.TotalStateChange = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3724,16 +7571,28 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalStateChange |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 30:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOK", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
 			}
-			m.LastOK = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Baselines = append(m.Baselines, MetricBaseline{})
+			if err := m.Baselines[len(m.Baselines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contacts", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -3743,11 +7602,21 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastOK |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contacts = append(m.Contacts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedAttributes", wireType)
@@ -3800,6 +7669,126 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MetricBaseline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheck
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricBaseline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricBaseline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Mean = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variance", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Variance = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheck(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CheckHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3867,6 +7856,35 @@ func (m *CheckHistory) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCheck(dAtA[iNdEx:])
@@ -3996,66 +8014,138 @@ var (
 func init() { proto.RegisterFile("check.proto", fileDescriptorCheck) }
 
 var fileDescriptorCheck = []byte{
-	// 966 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x8f, 0x1b, 0x35,
-	0x14, 0xaf, 0x37, 0x9b, 0xec, 0xc6, 0xd9, 0xec, 0x1f, 0x6f, 0xb7, 0x75, 0x53, 0xc8, 0x44, 0x29,
-	0x48, 0x39, 0xd0, 0x14, 0xb5, 0x02, 0xc4, 0x09, 0xed, 0x6c, 0x8b, 0x16, 0x75, 0xa5, 0x22, 0x53,
-	0xa9, 0x12, 0x97, 0xd1, 0x64, 0xc6, 0x9b, 0xb1, 0x76, 0x62, 0x87, 0xb1, 0x67, 0xff, 0x70, 0xe2,
-	0x23, 0x70, 0xe4, 0x23, 0xc0, 0x81, 0x3b, 0x1f, 0xa1, 0x47, 0x3e, 0xc1, 0x08, 0xc2, 0x2d, 0x9f,
-	0x80, 0x23, 0xf2, 0x1b, 0x27, 0xcd, 0xec, 0x82, 0xb8, 0x82, 0xd4, 0x53, 0xde, 0xef, 0xbd, 0xdf,
-	0xb3, 0x9f, 0x9f, 0xdf, 0x2f, 0x63, 0xdc, 0x8a, 0x12, 0x1e, 0x9d, 0x0d, 0xa7, 0x99, 0x32, 0x8a,
-	0xb4, 0x34, 0x97, 0x3a, 0x1f, 0x9a, 0xab, 0x29, 0xd7, 0x9d, 0x87, 0x63, 0x61, 0x92, 0x7c, 0x34,
-	0x8c, 0xd4, 0xe4, 0xd1, 0x58, 0x8d, 0xd5, 0x23, 0xe0, 0x8c, 0xf2, 0x53, 0x40, 0x00, 0xc0, 0x2a,
-	0x73, 0x3b, 0xad, 0x50, 0x6b, 0x6e, 0x1c, 0xc0, 0x89, 0x52, 0x6e, 0xd1, 0xce, 0x9e, 0x11, 0x13,
-	0x1e, 0x5c, 0x08, 0x19, 0xab, 0x8b, 0xd2, 0xd5, 0xff, 0x19, 0xe1, 0xad, 0x23, 0xbb, 0x2f, 0xe3,
-	0xdf, 0xe4, 0x5c, 0x1b, 0xf2, 0x31, 0x6e, 0x44, 0x4a, 0x9e, 0x8a, 0x31, 0x45, 0x3d, 0x34, 0x68,
-	0x3d, 0xa6, 0xc3, 0x95, 0x4a, 0x86, 0x40, 0x3d, 0x82, 0xb8, 0xbf, 0xfe, 0xba, 0xf0, 0x10, 0x73,
-	0x6c, 0xf2, 0x21, 0x6e, 0xc0, 0xb6, 0x9a, 0xae, 0xf5, 0x6a, 0x83, 0xd6, 0x63, 0x52, 0xc9, 0x3b,
-	0xb4, 0x21, 0xc8, 0xb8, 0xc5, 0x1c, 0x8f, 0x3c, 0xc1, 0x75, 0x5b, 0x9b, 0xa6, 0x35, 0x48, 0xb8,
-	0x5b, 0x49, 0x38, 0x56, 0x6a, 0x75, 0x9f, 0x5b, 0xac, 0xe4, 0xf6, 0xbf, 0x47, 0xb8, 0xfd, 0x65,
-	0xa6, 0x2e, 0xaf, 0x5c, 0xbd, 0x9a, 0xf8, 0x78, 0x8f, 0x4b, 0x23, 0xcc, 0x55, 0x10, 0x1a, 0x93,
-	0x89, 0x51, 0x6e, 0xb8, 0xa6, 0xa8, 0x57, 0x1b, 0x34, 0xfd, 0x83, 0x79, 0xe1, 0xdd, 0x0c, 0xb2,
-	0xdd, 0xd2, 0x75, 0xb8, 0xf4, 0x90, 0xdb, 0xb8, 0xae, 0xa7, 0x69, 0x78, 0x45, 0xd7, 0x7a, 0x68,
-	0xb0, 0xc9, 0x4a, 0x40, 0xde, 0xc7, 0xdb, 0x60, 0x04, 0x91, 0x3a, 0xe7, 0x59, 0x38, 0xe6, 0xb4,
-	0xd6, 0x43, 0x83, 0x36, 0x6b, 0x83, 0xf7, 0xc8, 0x39, 0xfb, 0xdf, 0x6d, 0xe0, 0xd6, 0x4a, 0x5f,
-	0x08, 0xc5, 0x1b, 0x91, 0x9a, 0x4c, 0x42, 0x19, 0x43, 0x0b, 0x9b, 0x6c, 0x01, 0x49, 0x0f, 0xb7,
-	0xb8, 0x3c, 0x17, 0x99, 0x92, 0x13, 0x2e, 0x0d, 0x6c, 0xd6, 0x64, 0xab, 0x2e, 0x32, 0xc0, 0x9b,
-	0x49, 0x28, 0xe3, 0x94, 0x67, 0x65, 0x5b, 0x9a, 0xfe, 0xd6, 0xbc, 0xf0, 0x96, 0x3e, 0xb6, 0xb4,
-	0xc8, 0x10, 0xef, 0x27, 0x62, 0x9c, 0x04, 0xa7, 0x69, 0x38, 0x0d, 0x4c, 0x92, 0x71, 0x9d, 0xa8,
-	0x34, 0xa6, 0xeb, 0x50, 0xe1, 0x9e, 0x0d, 0x7d, 0x9e, 0x86, 0xd3, 0x97, 0x8b, 0x00, 0xe9, 0xe0,
-	0x4d, 0x21, 0x0d, 0xcf, 0xce, 0xc3, 0x94, 0xd6, 0x81, 0xb4, 0xc4, 0xe4, 0x03, 0x4c, 0x52, 0x75,
-	0x71, 0x7d, 0xa9, 0x06, 0xb0, 0x76, 0x53, 0x75, 0x51, 0x5d, 0x89, 0xe0, 0x75, 0x19, 0x4e, 0x38,
-	0xdd, 0x80, 0xf2, 0xc1, 0x26, 0x7d, 0xbc, 0xa5, 0xb2, 0x71, 0x28, 0xc5, 0xb7, 0xa1, 0x11, 0x4a,
-	0xd2, 0x4d, 0x88, 0x55, 0x7c, 0xb6, 0x2f, 0xd3, 0x7c, 0x94, 0x0a, 0x9d, 0xd0, 0x26, 0xb4, 0x79,
-	0x01, 0xc9, 0xa7, 0x78, 0x3b, 0xcb, 0x25, 0x0c, 0xa7, 0x9b, 0x21, 0x0c, 0x67, 0x27, 0xf3, 0xc2,
-	0xbb, 0x16, 0x61, 0x6d, 0x87, 0x0f, 0xcb, 0x21, 0xfa, 0x04, 0xb7, 0x75, 0x3e, 0xd2, 0x51, 0x26,
-	0xa6, 0x76, 0x13, 0x4d, 0x5b, 0x90, 0xb9, 0x37, 0x2f, 0xbc, 0x6a, 0x80, 0x55, 0x21, 0xf9, 0x08,
-	0x93, 0x67, 0x97, 0x86, 0xcb, 0x98, 0xc7, 0x6f, 0x06, 0x81, 0x6e, 0xf5, 0xd0, 0x60, 0xcb, 0xaf,
-	0xcf, 0x0b, 0x0f, 0x3d, 0x64, 0x7f, 0x43, 0x20, 0x27, 0x78, 0x67, 0x6a, 0xc7, 0x2f, 0x70, 0x63,
-	0x25, 0x62, 0xda, 0xb6, 0x67, 0xf5, 0xdf, 0x9b, 0x15, 0x5e, 0x39, 0x99, 0xcf, 0x20, 0xf2, 0xc5,
-	0xd3, 0x79, 0xe1, 0x5d, 0xe7, 0xb2, 0xf6, 0x74, 0x85, 0x11, 0x93, 0xe7, 0x4e, 0xf4, 0x41, 0x29,
-	0x84, 0x6d, 0x10, 0xc2, 0xc1, 0x0d, 0x21, 0x9c, 0x08, 0x6d, 0xfc, 0x7d, 0x2b, 0x83, 0x79, 0xe1,
-	0xad, 0x66, 0x30, 0x0c, 0xc0, 0x72, 0xca, 0x21, 0x36, 0xb1, 0x90, 0x74, 0xc7, 0x0d, 0xb1, 0x05,
-	0xe4, 0x33, 0xdc, 0xd0, 0xf9, 0x28, 0xce, 0x39, 0xdd, 0x05, 0x3d, 0xdf, 0xaf, 0xac, 0xfe, 0x52,
-	0x4c, 0xf8, 0x2b, 0xf8, 0x3f, 0x78, 0x95, 0x70, 0xe9, 0xe3, 0x79, 0xe1, 0x39, 0x3a, 0x73, 0xbf,
-	0xf6, 0xba, 0xa3, 0x4c, 0x49, 0xba, 0x57, 0x5e, 0xb7, 0xb5, 0xc9, 0x2e, 0xae, 0x19, 0x93, 0x52,
-	0xd2, 0x43, 0x83, 0x1a, 0xb3, 0xa6, 0xbd, 0x5c, 0x7b, 0x2b, 0x2a, 0x37, 0x74, 0x1f, 0xe6, 0x66,
-	0x01, 0xc9, 0x21, 0xde, 0x2e, 0xbb, 0x90, 0x39, 0xc5, 0xd2, 0xdb, 0x50, 0x48, 0xa7, 0x52, 0x48,
-	0x45, 0xd3, 0xae, 0x4d, 0x4b, 0x89, 0x7b, 0xb8, 0x95, 0xa9, 0x5c, 0xc6, 0x41, 0xa6, 0x46, 0x42,
-	0xd2, 0x03, 0x38, 0x1f, 0x06, 0x17, 0xb3, 0x9e, 0xfe, 0x4f, 0x4d, 0x5c, 0x07, 0x09, 0xbe, 0x15,
-	0xdf, 0xff, 0x42, 0x7c, 0x6f, 0x55, 0xf4, 0x1f, 0x54, 0x91, 0x9d, 0xd2, 0x38, 0xcf, 0xca, 0x19,
-	0xba, 0xd3, 0x43, 0x03, 0xc4, 0x96, 0xd8, 0xc6, 0xf8, 0x25, 0x8f, 0x72, 0xc3, 0x63, 0x7a, 0x17,
-	0x0a, 0x5e, 0x62, 0xf2, 0x14, 0x6f, 0x24, 0x42, 0x1b, 0x95, 0x5d, 0x51, 0x0a, 0xbd, 0xbf, 0x77,
-	0xf3, 0xcd, 0x70, 0x5c, 0x12, 0xfc, 0x1d, 0xd7, 0xff, 0x45, 0x06, 0x5b, 0x18, 0xe4, 0x0e, 0x6e,
-	0x08, 0xad, 0x73, 0x1e, 0xd3, 0x7b, 0xb0, 0xbe, 0x43, 0xd6, 0xaf, 0x72, 0x33, 0xcd, 0x0d, 0xed,
-	0x40, 0xef, 0x1c, 0x2a, 0x2f, 0x2a, 0x34, 0x9c, 0xde, 0x07, 0x77, 0x09, 0x2c, 0xdb, 0x1a, 0xb9,
-	0xa6, 0xef, 0xf4, 0xd0, 0xa0, 0xce, 0x1c, 0xb2, 0x2a, 0x33, 0xca, 0x84, 0x69, 0x00, 0xb4, 0x20,
-	0x4a, 0x42, 0x39, 0xe6, 0xf4, 0xdd, 0x52, 0x65, 0x10, 0xf9, 0xca, 0x06, 0x8e, 0xc0, 0x4f, 0x1e,
-	0xe0, 0x8d, 0x34, 0xd4, 0x26, 0x50, 0x67, 0xb4, 0x6b, 0x8b, 0xf1, 0xf1, 0xac, 0xf0, 0x1a, 0x27,
-	0xa1, 0x36, 0x2f, 0x9e, 0xb3, 0x86, 0x0d, 0xbd, 0x38, 0xfb, 0x87, 0x2f, 0x48, 0xf4, 0x2f, 0x5f,
-	0x90, 0xbe, 0xef, 0x1e, 0x5c, 0xc7, 0x6f, 0xce, 0xed, 0x2a, 0x46, 0x95, 0x8a, 0x57, 0x3b, 0xbe,
-	0x56, 0xed, 0xb8, 0xff, 0xe0, 0xcf, 0xdf, 0xbb, 0xe8, 0xc7, 0x59, 0x17, 0xfd, 0x32, 0xeb, 0xa2,
-	0xd7, 0xb3, 0x2e, 0xfa, 0x75, 0xd6, 0x45, 0xbf, 0xcd, 0xba, 0xe8, 0x87, 0x3f, 0xba, 0xb7, 0xbe,
-	0xae, 0x43, 0xdf, 0x47, 0x0d, 0x78, 0xe1, 0x3d, 0xf9, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x8b, 0x6c,
-	0xb2, 0x1e, 0x58, 0x0a, 0x00, 0x00,
+	// 2124 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcb, 0x72, 0xdb, 0xc8,
+	0xd5, 0x36, 0x44, 0x89, 0x12, 0x9b, 0xa4, 0x2c, 0xb5, 0x7c, 0x69, 0xd3, 0x33, 0x02, 0x2d, 0xf9,
+	0x42, 0x8f, 0x65, 0x79, 0xc6, 0x1e, 0xcf, 0xd8, 0xfe, 0xff, 0x5c, 0x4c, 0xc9, 0x29, 0xbb, 0x46,
+	0x53, 0x76, 0xc1, 0x56, 0x26, 0x95, 0x0d, 0xab, 0x09, 0xb4, 0x49, 0x94, 0x40, 0x34, 0x83, 0x6e,
+	0xd8, 0x52, 0x9e, 0x20, 0xcb, 0x2c, 0xf3, 0x08, 0x79, 0x84, 0x3c, 0xc2, 0x2c, 0xb3, 0x4f, 0x15,
+	0x2a, 0x61, 0x76, 0xcc, 0x2e, 0xab, 0x2c, 0x53, 0x7d, 0xba, 0x41, 0x36, 0x44, 0x3b, 0x33, 0x72,
+	0x94, 0xaa, 0xa4, 0x6a, 0x56, 0xc2, 0xf9, 0xce, 0x39, 0x0d, 0x74, 0x9f, 0x4b, 0x7f, 0x87, 0x42,
+	0x55, 0xbf, 0xcf, 0xfc, 0x83, 0xed, 0x61, 0xc2, 0x25, 0xc7, 0x55, 0xc1, 0x62, 0x91, 0x6e, 0xcb,
+	0xa3, 0x21, 0x13, 0x8d, 0xdb, 0xbd, 0x50, 0xf6, 0xd3, 0xee, 0xb6, 0xcf, 0x07, 0x77, 0x7a, 0xbc,
+	0xc7, 0xef, 0x80, 0x4d, 0x37, 0x7d, 0x0d, 0x12, 0x08, 0xf0, 0xa4, 0x7d, 0x1b, 0x55, 0x2a, 0x04,
+	0x93, 0x46, 0x40, 0x7d, 0xce, 0xcd, 0xa2, 0x8d, 0x55, 0x19, 0x0e, 0x58, 0xe7, 0x6d, 0x18, 0x07,
+	0xfc, 0xad, 0x86, 0x36, 0x7e, 0x53, 0x42, 0xb5, 0x1d, 0xf5, 0x5e, 0x8f, 0xfd, 0x2a, 0x65, 0x42,
+	0xe2, 0x2f, 0x50, 0xd9, 0xe7, 0xf1, 0xeb, 0xb0, 0x47, 0x9c, 0xa6, 0xd3, 0xaa, 0xde, 0x25, 0xdb,
+	0xd6, 0x97, 0x6c, 0x83, 0xe9, 0x0e, 0xe8, 0xdb, 0xf3, 0xdf, 0x66, 0xae, 0xe3, 0x19, 0x6b, 0xfc,
+	0x29, 0x2a, 0xc3, 0x6b, 0x05, 0x99, 0x6b, 0x96, 0x5a, 0xd5, 0xbb, 0xb8, 0xe0, 0xf7, 0x58, 0xa9,
+	0xc0, 0xe3, 0x8c, 0x67, 0xec, 0xf0, 0x3d, 0xb4, 0xa0, 0xbe, 0x4d, 0x90, 0x12, 0x38, 0x5c, 0x2c,
+	0x38, 0x3c, 0xe5, 0xdc, 0x7e, 0xcf, 0x19, 0x4f, 0xdb, 0xe2, 0x2b, 0xa8, 0x26, 0x86, 0x11, 0x3d,
+	0x32, 0xbb, 0x20, 0xf3, 0x4d, 0xa7, 0x55, 0xf7, 0xaa, 0x80, 0x7d, 0x03, 0x10, 0xbe, 0x8e, 0xe6,
+	0xc2, 0x80, 0x2c, 0x34, 0x9d, 0x56, 0xa5, 0x7d, 0x61, 0x94, 0xb9, 0x73, 0xcf, 0x76, 0xc7, 0x99,
+	0x5b, 0x0b, 0x83, 0x2d, 0x3e, 0x08, 0x25, 0x1b, 0x0c, 0xe5, 0x91, 0x37, 0x17, 0x06, 0x78, 0x0b,
+	0x95, 0x43, 0x21, 0x52, 0x16, 0x90, 0x72, 0xd3, 0x69, 0x95, 0xda, 0xe7, 0xc6, 0x99, 0xbb, 0xa2,
+	0x11, 0xcb, 0xd2, 0xd8, 0xe0, 0xfb, 0xa8, 0x22, 0xc2, 0x5e, 0x4c, 0x65, 0x9a, 0x30, 0xb2, 0xd8,
+	0x74, 0x5a, 0xb5, 0xf6, 0xc5, 0x71, 0xe6, 0xae, 0x4d, 0x40, 0xcb, 0x67, 0x6a, 0x89, 0x6f, 0xa2,
+	0x05, 0x1a, 0xf4, 0xb9, 0x4f, 0x96, 0x9a, 0x4e, 0x6b, 0xa9, 0xbd, 0x36, 0xce, 0xdc, 0xb3, 0x00,
+	0x58, 0xe6, 0xda, 0x62, 0xe3, 0xb7, 0x0e, 0xaa, 0xbf, 0x48, 0xf8, 0xe1, 0x91, 0x09, 0x85, 0xc0,
+	0x6d, 0xb4, 0xca, 0x62, 0x19, 0xca, 0xa3, 0x0e, 0x95, 0x32, 0x09, 0xbb, 0xa9, 0x64, 0x82, 0x38,
+	0xcd, 0x52, 0xab, 0xd2, 0x3e, 0x3f, 0xce, 0xdc, 0x59, 0xa5, 0xb7, 0xa2, 0xa1, 0xc7, 0x13, 0x04,
+	0x9f, 0x43, 0x0b, 0x70, 0x38, 0x64, 0x4e, 0x7d, 0x80, 0xa7, 0x05, 0x7c, 0x0d, 0x2d, 0xeb, 0x63,
+	0xf4, 0xf9, 0x1b, 0x96, 0xd0, 0x1e, 0x23, 0x25, 0x38, 0xc8, 0x3a, 0xa0, 0x3b, 0x06, 0xdc, 0xf8,
+	0x13, 0x46, 0x55, 0x2b, 0xe4, 0x98, 0xa0, 0x45, 0x9f, 0x0f, 0x06, 0x34, 0x0e, 0x20, 0x3b, 0x2a,
+	0x5e, 0x2e, 0xe2, 0x26, 0xaa, 0xb2, 0xf8, 0x4d, 0x98, 0xf0, 0x78, 0xc0, 0x62, 0x09, 0x2f, 0xab,
+	0x78, 0x36, 0x84, 0x5b, 0x68, 0xa9, 0x4f, 0xe3, 0x20, 0x62, 0x89, 0x8e, 0x78, 0xa5, 0x5d, 0x1b,
+	0x67, 0xee, 0x04, 0xf3, 0x26, 0x4f, 0x78, 0x1b, 0xad, 0xf5, 0xc3, 0x5e, 0xbf, 0xf3, 0x3a, 0xa2,
+	0xc3, 0x8e, 0xec, 0x27, 0x4c, 0xf4, 0x79, 0x14, 0x98, 0x50, 0xaf, 0x2a, 0xd5, 0xcf, 0x22, 0x3a,
+	0x7c, 0x95, 0x2b, 0x70, 0x03, 0x2d, 0x85, 0xb1, 0x64, 0xc9, 0x1b, 0x1a, 0x41, 0xd8, 0xeb, 0xde,
+	0x44, 0xc6, 0x5b, 0x08, 0x47, 0xfc, 0xed, 0xf1, 0xa5, 0xca, 0x60, 0xb5, 0x12, 0xf1, 0xb7, 0xc5,
+	0x95, 0x30, 0x9a, 0x8f, 0xe9, 0x40, 0xc7, 0xb7, 0xe2, 0xc1, 0x33, 0xde, 0x40, 0x35, 0x9e, 0xf4,
+	0x68, 0x1c, 0xfe, 0x9a, 0xca, 0x90, 0xc7, 0x10, 0xc8, 0x8a, 0x57, 0xc0, 0xd4, 0xb9, 0x0c, 0xd3,
+	0x6e, 0x14, 0x8a, 0x3e, 0xa9, 0xc0, 0x31, 0xe7, 0x22, 0x7e, 0x88, 0x96, 0x93, 0x34, 0x86, 0xba,
+	0x33, 0xe5, 0x81, 0x60, 0xef, 0x78, 0x9c, 0xb9, 0xc7, 0x34, 0x5e, 0xdd, 0xc8, 0x50, 0x2c, 0x02,
+	0x7f, 0x89, 0xea, 0x22, 0xed, 0x0a, 0x3f, 0x09, 0x87, 0xea, 0x25, 0x82, 0x54, 0xc1, 0x73, 0x75,
+	0x9c, 0xb9, 0x45, 0x85, 0x57, 0x14, 0xf1, 0x7d, 0x84, 0x9f, 0x1c, 0x4a, 0x16, 0x07, 0x2c, 0x98,
+	0x26, 0x02, 0xa9, 0x41, 0xce, 0x2e, 0x8c, 0x33, 0xd7, 0xb9, 0xed, 0xbd, 0xc3, 0x00, 0xef, 0xa1,
+	0xb3, 0x43, 0x95, 0x7e, 0x1d, 0x93, 0x56, 0x61, 0x40, 0xea, 0x50, 0x44, 0x57, 0x47, 0x99, 0xab,
+	0x33, 0xf3, 0x09, 0x68, 0xa0, 0x9e, 0x8e, 0xdb, 0x7a, 0xf5, 0xa1, 0x65, 0x11, 0xe0, 0xaf, 0x4c,
+	0x3f, 0xeb, 0xe8, 0x1a, 0x5f, 0x86, 0x1a, 0x3f, 0x3f, 0x53, 0xe3, 0x7b, 0xa1, 0x90, 0xed, 0x35,
+	0x55, 0xe1, 0xe3, 0xcc, 0xb5, 0x3d, 0x3c, 0x04, 0x82, 0xb2, 0xd1, 0x49, 0x2c, 0x83, 0x30, 0x26,
+	0x67, 0x4d, 0x12, 0x2b, 0x01, 0xff, 0x04, 0x95, 0x45, 0xda, 0x0d, 0x52, 0x46, 0x56, 0xa0, 0x55,
+	0x5d, 0x2e, 0xac, 0xfe, 0x2a, 0x1c, 0x30, 0xdd, 0x11, 0xbe, 0xe9, 0xb3, 0xb8, 0x8d, 0xc6, 0x99,
+	0x6b, 0xcc, 0x3d, 0xf3, 0x57, 0x85, 0xdb, 0x4f, 0x78, 0x4c, 0x56, 0x75, 0xb8, 0xd5, 0x33, 0x5e,
+	0x41, 0x25, 0x29, 0x23, 0x82, 0x55, 0x4b, 0xf0, 0xd4, 0xa3, 0x0a, 0xae, 0x8a, 0x0a, 0x4f, 0x25,
+	0x59, 0x83, 0xbc, 0xc9, 0x45, 0xfc, 0x18, 0x2d, 0xeb, 0x53, 0x48, 0x4c, 0xc5, 0x92, 0x73, 0xf0,
+	0x21, 0x8d, 0xc2, 0x87, 0x14, 0x6a, 0xda, 0x1c, 0x53, 0x2e, 0x62, 0x17, 0x55, 0x13, 0x9e, 0xc6,
+	0x41, 0x27, 0xe1, 0xdd, 0x30, 0x26, 0xe7, 0x61, 0x7f, 0x08, 0x20, 0x4f, 0x21, 0xd3, 0xfa, 0xbd,
+	0x60, 0xd7, 0xef, 0xc3, 0x99, 0xfa, 0xbd, 0xa8, 0x3e, 0x4d, 0xa7, 0x55, 0x51, 0x73, 0xac, 0xa6,
+	0xf1, 0x05, 0x54, 0x8e, 0x69, 0x2f, 0xe4, 0x82, 0x10, 0x58, 0xd1, 0x48, 0xf8, 0x36, 0xc2, 0x3c,
+	0x95, 0xc3, 0x54, 0x76, 0x68, 0x1c, 0x73, 0x49, 0x75, 0xce, 0x5d, 0x02, 0x9b, 0x55, 0xad, 0x79,
+	0x3c, 0x55, 0xe0, 0x67, 0x68, 0x65, 0xc0, 0x64, 0x12, 0xfa, 0x9d, 0x84, 0x49, 0x95, 0x05, 0x3c,
+	0x26, 0x0d, 0x48, 0x97, 0xf5, 0x71, 0xe6, 0x36, 0x8e, 0xeb, 0xac, 0x76, 0x77, 0x56, 0xeb, 0xbc,
+	0x5c, 0x85, 0x3f, 0x47, 0x15, 0x76, 0xc8, 0xfc, 0x8e, 0x3a, 0x2e, 0x72, 0x19, 0xd6, 0x80, 0xd6,
+	0x3a, 0x01, 0x2d, 0xe7, 0x25, 0x05, 0xbe, 0x3a, 0x1a, 0x42, 0x67, 0x15, 0x7d, 0x16, 0x45, 0xe4,
+	0x23, 0xf0, 0x80, 0xce, 0x0a, 0x80, 0xdd, 0x59, 0x01, 0xc0, 0xb7, 0x50, 0x39, 0x49, 0xe3, 0x0e,
+	0x15, 0xe4, 0x63, 0xb0, 0x85, 0x4e, 0xaf, 0x11, 0xdb, 0x38, 0x49, 0xe3, 0xc7, 0xaa, 0x0c, 0x56,
+	0xdf, 0xf2, 0xe4, 0x20, 0x8c, 0x7b, 0x9d, 0x20, 0x4c, 0x98, 0x2f, 0x79, 0x72, 0x44, 0xd6, 0xc1,
+	0xcf, 0x1d, 0x67, 0xee, 0xe5, 0x19, 0xa5, 0xb5, 0xc4, 0x8a, 0x51, 0xee, 0xe6, 0x3a, 0xfc, 0x53,
+	0x54, 0xf1, 0x87, 0x69, 0x27, 0x0a, 0x07, 0xa1, 0x24, 0x6e, 0xd3, 0x69, 0x39, 0xed, 0xcd, 0x51,
+	0xe6, 0x2e, 0xed, 0xbc, 0xd8, 0xdf, 0x53, 0x98, 0xda, 0xe7, 0xc4, 0xc0, 0xde, 0xa7, 0x3f, 0x4c,
+	0xc1, 0x00, 0xff, 0x08, 0xd5, 0x06, 0x6c, 0xc0, 0x93, 0x23, 0xb3, 0x48, 0xb3, 0xe9, 0xb4, 0xe6,
+	0xdb, 0x8d, 0x71, 0xe6, 0x5e, 0xb0, 0x71, 0xcb, 0xb7, 0xaa, 0x71, 0xed, 0x7e, 0x1d, 0xcd, 0xc7,
+	0xa1, 0xcf, 0xc8, 0x95, 0xa6, 0xd3, 0x5a, 0xd0, 0xf9, 0xa1, 0x64, 0xcb, 0x1c, 0xf4, 0xf8, 0x2e,
+	0x82, 0xa3, 0x4d, 0x25, 0x4f, 0xc8, 0x86, 0xbe, 0x3b, 0xc7, 0x99, 0x8b, 0x73, 0xec, 0x78, 0x08,
+	0x14, 0x86, 0x3f, 0x43, 0x4b, 0x3e, 0x95, 0x7e, 0xbf, 0x93, 0x0e, 0xc9, 0xe6, 0xd4, 0x27, 0xc7,
+	0x2c, 0x9f, 0x45, 0xc0, 0xf6, 0x87, 0xea, 0x74, 0xfb, 0xa1, 0x50, 0x47, 0x63, 0xe5, 0xcd, 0x55,
+	0xc8, 0x5d, 0x38, 0xdd, 0x19, 0xa5, 0x7d, 0xba, 0x46, 0x39, 0xcd, 0x9c, 0x1d, 0xb4, 0x9c, 0x3b,
+	0xe8, 0x0c, 0x25, 0xd7, 0xe0, 0x9a, 0xfd, 0x68, 0x9c, 0xb9, 0xa4, 0xa8, 0xb1, 0xd6, 0xa9, 0x1b,
+	0xcd, 0x73, 0x50, 0xa8, 0x9b, 0x7d, 0x18, 0x0e, 0x59, 0x14, 0xc6, 0x4c, 0x90, 0xeb, 0xcd, 0x52,
+	0x9e, 0x7e, 0x13, 0xd0, 0xbe, 0xd9, 0x27, 0x20, 0x7e, 0x80, 0x90, 0x90, 0x34, 0x0e, 0x68, 0xc4,
+	0x63, 0x46, 0x6e, 0xc0, 0x7b, 0xc9, 0x38, 0x73, 0xcf, 0x4d, 0x51, 0xcb, 0xd1, 0xb2, 0xc5, 0xfb,
+	0xa8, 0xa2, 0x8a, 0x31, 0x09, 0x03, 0x26, 0x48, 0xab, 0x59, 0x9a, 0xe9, 0x18, 0x70, 0xe5, 0x3e,
+	0x37, 0x26, 0xed, 0xcb, 0xa6, 0x3b, 0xae, 0x4d, 0x9c, 0xec, 0x0f, 0x9a, 0x80, 0xf8, 0x29, 0xaa,
+	0x26, 0x69, 0xdc, 0xe5, 0xfc, 0xa0, 0x93, 0x26, 0x11, 0xb9, 0x09, 0x01, 0xb9, 0x31, 0xca, 0x5c,
+	0xe4, 0x69, 0x78, 0xdf, 0xdb, 0x1b, 0x67, 0xee, 0x79, 0xcb, 0xc8, 0xfe, 0x40, 0x03, 0xef, 0x27,
+	0x11, 0x7e, 0x81, 0xea, 0x01, 0x15, 0xfd, 0x2e, 0xa7, 0x49, 0x00, 0x6b, 0x7d, 0x02, 0x6b, 0xdd,
+	0x1a, 0x65, 0x6e, 0x6d, 0x37, 0x57, 0xe8, 0xd5, 0x2e, 0x16, 0x0c, 0xad, 0xf5, 0x6a, 0x13, 0x85,
+	0x5a, 0x71, 0x0f, 0xad, 0xd2, 0x98, 0x0f, 0x68, 0x74, 0xd4, 0x09, 0x98, 0x64, 0x3e, 0x84, 0xfd,
+	0xd6, 0xb4, 0xa8, 0x66, 0x94, 0x76, 0xd8, 0x8d, 0x72, 0x37, 0xd7, 0xd9, 0xab, 0x4d, 0xef, 0xf4,
+	0x2d, 0x28, 0xae, 0xc2, 0x6a, 0x13, 0xe5, 0x3b, 0x56, 0x9b, 0x5e, 0xfa, 0x3b, 0x68, 0x39, 0x77,
+	0x30, 0xa4, 0xf2, 0x36, 0xe4, 0x23, 0x24, 0x51, 0x51, 0x63, 0x27, 0x91, 0xd1, 0x18, 0xd2, 0x79,
+	0x17, 0x2d, 0xf9, 0x3c, 0x96, 0xd4, 0x97, 0x82, 0x6c, 0x37, 0x4b, 0x93, 0x52, 0x30, 0x58, 0xa1,
+	0xb2, 0x0d, 0xb6, 0xf1, 0xb7, 0x39, 0x54, 0x2f, 0x84, 0x5a, 0x51, 0x52, 0x7d, 0xa1, 0x12, 0x67,
+	0xda, 0xa8, 0x34, 0x62, 0x53, 0x52, 0x8d, 0xe0, 0x5f, 0xa0, 0x72, 0x44, 0xbb, 0x2c, 0xca, 0x29,
+	0xf7, 0xf5, 0xf7, 0x27, 0xd1, 0xf6, 0x1e, 0x18, 0x3e, 0x89, 0x65, 0x72, 0xd4, 0x26, 0x26, 0xa1,
+	0x56, 0xb4, 0xb7, 0xbd, 0xb2, 0x46, 0xd4, 0x6e, 0x26, 0x8c, 0x0a, 0x88, 0xa1, 0xde, 0x4d, 0x8e,
+	0xd9, 0xbb, 0xc9, 0x31, 0x7c, 0x67, 0x7a, 0x4d, 0x02, 0x53, 0xd3, 0x14, 0xd5, 0x40, 0x76, 0x2b,
+	0x30, 0x90, 0x7a, 0x49, 0x10, 0x0a, 0xda, 0x8d, 0x98, 0x66, 0xeb, 0x4b, 0xfa, 0x25, 0x39, 0x66,
+	0xbf, 0x24, 0xc7, 0x1a, 0x0f, 0x51, 0xd5, 0xda, 0x89, 0xba, 0xac, 0x0f, 0x98, 0x39, 0x2c, 0x4f,
+	0x3d, 0xaa, 0xeb, 0xf2, 0x0d, 0x8d, 0x52, 0x66, 0x18, 0xa8, 0x16, 0x1e, 0xcd, 0x3d, 0x70, 0x36,
+	0xfe, 0x7e, 0x11, 0x2d, 0xc0, 0x99, 0xfc, 0xc0, 0x62, 0xff, 0x27, 0x58, 0xec, 0x0f, 0x74, 0xf4,
+	0xbf, 0x91, 0x8e, 0x36, 0xd0, 0x52, 0x90, 0x26, 0x3a, 0x87, 0x14, 0x23, 0x75, 0xbc, 0x89, 0xac,
+	0x74, 0x9a, 0x1a, 0xb0, 0x00, 0xe8, 0x68, 0xc9, 0x9b, 0xc8, 0x78, 0x17, 0x2d, 0x9a, 0x5b, 0x97,
+	0x10, 0x38, 0xfb, 0x4b, 0xb3, 0xcd, 0xea, 0xa9, 0x36, 0x68, 0x9f, 0x35, 0xe7, 0x9f, 0x7b, 0x78,
+	0xf9, 0x83, 0xe2, 0xae, 0x66, 0x64, 0xbf, 0x04, 0xeb, 0x1b, 0x49, 0xe1, 0xe6, 0xfe, 0x07, 0x0a,
+	0xea, 0x19, 0x49, 0x07, 0x8a, 0x4a, 0xc3, 0x2a, 0x3d, 0x2d, 0x28, 0x6b, 0xf5, 0x90, 0x0a, 0xa0,
+	0x8e, 0x0b, 0x9e, 0x91, 0x54, 0x95, 0x49, 0x2e, 0x69, 0xd4, 0x01, 0xb3, 0x8e, 0xdf, 0xa7, 0x71,
+	0x8f, 0x01, 0x65, 0xac, 0x7b, 0x2b, 0xa0, 0x79, 0xa9, 0x14, 0x3b, 0x80, 0xe3, 0x4d, 0xb4, 0x18,
+	0x51, 0x21, 0x3b, 0xfc, 0x00, 0xd8, 0x61, 0xa9, 0x8d, 0x46, 0x99, 0x5b, 0xde, 0xa3, 0x42, 0x3e,
+	0xff, 0x4a, 0x35, 0x52, 0x21, 0x9f, 0x1f, 0x4c, 0xd9, 0xbb, 0xfb, 0xaf, 0xd9, 0x7b, 0xf3, 0xe4,
+	0xec, 0xfd, 0x4a, 0x81, 0xbd, 0x3f, 0x42, 0xd5, 0x88, 0xc7, 0xbd, 0x9c, 0x06, 0x69, 0x06, 0x77,
+	0x49, 0x5d, 0xf7, 0x16, 0x6c, 0x5f, 0xf7, 0x0a, 0x36, 0x04, 0xe8, 0xdd, 0xcc, 0x7f, 0xf3, 0x7d,
+	0xcc, 0x3f, 0x40, 0x55, 0xdb, 0xee, 0x2a, 0x84, 0x73, 0x73, 0x36, 0x9c, 0xdb, 0x96, 0x93, 0xbe,
+	0x78, 0x3e, 0x36, 0x81, 0x3d, 0x6f, 0xf9, 0xdb, 0xbc, 0x95, 0x7e, 0xc7, 0x7c, 0x71, 0xed, 0xc3,
+	0xe6, 0x8b, 0x5d, 0x84, 0x4c, 0x45, 0xa8, 0x26, 0x72, 0x1d, 0x16, 0xb9, 0x36, 0xca, 0xdc, 0x8a,
+	0x49, 0x7b, 0x68, 0x20, 0xe7, 0xa6, 0x26, 0x36, 0xbd, 0x32, 0xe8, 0xb3, 0xa0, 0x38, 0xa5, 0xdc,
+	0x38, 0xf1, 0x94, 0xd2, 0x3a, 0xc1, 0x94, 0x72, 0xf3, 0x03, 0xa7, 0x94, 0x4f, 0x4e, 0x65, 0x4a,
+	0xb9, 0x75, 0x1a, 0x53, 0xca, 0xd6, 0x87, 0x4d, 0x29, 0xb7, 0x4f, 0x30, 0xa5, 0x6c, 0x7f, 0xcf,
+	0x29, 0xe5, 0x9d, 0x23, 0xc7, 0x9d, 0xd3, 0x1b, 0x39, 0x3e, 0xfd, 0x37, 0x47, 0x8e, 0xcf, 0x3e,
+	0x70, 0xe4, 0xb8, 0x7b, 0x82, 0x91, 0xe3, 0xff, 0x91, 0x0a, 0x55, 0x47, 0xdd, 0x14, 0xe4, 0x1e,
+	0xc4, 0xf7, 0xca, 0x28, 0x73, 0x17, 0x77, 0x5e, 0xec, 0xab, 0x7b, 0x09, 0x98, 0xaa, 0x51, 0x17,
+	0x86, 0xb6, 0x61, 0xaa, 0xd4, 0xf8, 0x01, 0x5a, 0x1c, 0xd0, 0xc3, 0x4e, 0x22, 0x04, 0xf9, 0x1c,
+	0xe2, 0xea, 0xaa, 0x56, 0xf7, 0x35, 0x3d, 0xf4, 0x5e, 0xbe, 0x54, 0x24, 0xcf, 0x28, 0x6d, 0x22,
+	0x39, 0xa0, 0x87, 0x9e, 0x98, 0x99, 0x49, 0xee, 0x9f, 0xe2, 0x4c, 0xf2, 0xc5, 0x7f, 0x64, 0x26,
+	0xf9, 0xf2, 0x54, 0x67, 0x92, 0x07, 0xa7, 0x37, 0x93, 0x3c, 0x3c, 0xf9, 0x4c, 0xf2, 0x73, 0x54,
+	0xe9, 0x52, 0x61, 0xb2, 0xec, 0x51, 0xb3, 0x34, 0xc3, 0x49, 0xbe, 0x86, 0x46, 0xd9, 0x36, 0x36,
+	0xd3, 0x41, 0x73, 0xe2, 0x65, 0xa7, 0xe1, 0x04, 0x2c, 0xcc, 0x3a, 0xff, 0xf7, 0xfd, 0x66, 0x9d,
+	0xf7, 0xfc, 0x26, 0xe9, 0x7f, 0xc7, 0x6f, 0x92, 0x8d, 0x1f, 0xa3, 0x95, 0xe3, 0xb7, 0xc8, 0x89,
+	0x48, 0x7f, 0x84, 0x96, 0x8b, 0x9b, 0x7c, 0x87, 0x37, 0x46, 0xf3, 0x03, 0x46, 0x63, 0x70, 0x76,
+	0x3c, 0x78, 0x56, 0x54, 0xe6, 0x0d, 0x4d, 0x42, 0x1a, 0xfb, 0xfa, 0x97, 0x71, 0xc7, 0x9b, 0xc8,
+	0x8a, 0x80, 0x09, 0x3a, 0x18, 0x46, 0x4c, 0x18, 0x32, 0x9f, 0x8b, 0x1b, 0x43, 0x54, 0xb3, 0x89,
+	0x8c, 0x45, 0x34, 0x9c, 0x02, 0xd1, 0xb0, 0x89, 0xd2, 0xdc, 0x31, 0xa2, 0xb4, 0x35, 0xa1, 0x32,
+	0xa5, 0xe9, 0x2d, 0x30, 0xd3, 0x4f, 0x8c, 0x4d, 0x7b, 0xf3, 0x1f, 0x7f, 0x59, 0x77, 0x7e, 0x3f,
+	0x5a, 0x77, 0xfe, 0x30, 0x5a, 0x77, 0xbe, 0x1d, 0xad, 0x3b, 0x7f, 0x1c, 0xad, 0x3b, 0x7f, 0x1e,
+	0xad, 0x3b, 0xbf, 0xfb, 0xeb, 0xfa, 0x99, 0x5f, 0x2e, 0x40, 0x98, 0xbb, 0x65, 0xf8, 0x57, 0xcf,
+	0xbd, 0x7f, 0x0e, 0x00, 0x4f, 0x82, 0x7c, 0xaf, 0x61, 0x1a, 0x00, 0x00,
 }
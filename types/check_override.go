@@ -0,0 +1,113 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Validate returns an error if the override does not match any entity, or
+// doesn't change the check.
+func (o *CheckOverride) Validate() error {
+	if o.Entity == "" && len(o.Labels) == 0 {
+		return errors.New("the entity or the labels must be set")
+	}
+	if o.Entity != "" {
+		if err := ValidateName(o.Entity); err != nil {
+			return errors.New("entity " + err.Error())
+		}
+	}
+	if o.Interval == 0 && o.Timeout == 0 && !o.Disabled {
+		return errors.New("the interval, the timeout or the disabled flag must be set")
+	}
+	return nil
+}
+
+// Matches determines if the override applies to the given entity.
+func (o *CheckOverride) Matches(entity *Entity) bool {
+	if entity == nil {
+		return false
+	}
+	if o.Entity != "" && o.Entity != entity.ID {
+		return false
+	}
+	for key, value := range o.Labels {
+		if v, ok := entity.Labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// OverrideFor returns the first override of the check matching the given
+// entity, if any.
+func (c *CheckConfig) OverrideFor(entity *Entity) *CheckOverride {
+	for i := range c.Overrides {
+		if c.Overrides[i].Matches(entity) {
+			return &c.Overrides[i]
+		}
+	}
+	return nil
+}
+
+// WithOverride returns a copy of the check with the interval and timeout of
+// the given override, without its overrides.
+func (c *CheckConfig) WithOverride(o *CheckOverride) *CheckConfig {
+	check := *c
+	check.Overrides = nil
+	if o.Interval > 0 && check.Cron == "" {
+		check.Interval = o.Interval
+	}
+	if o.Timeout > 0 {
+		check.Timeout = o.Timeout
+	}
+	return &check
+}
+
+// SchedulingInterval returns the interval at which the check is scheduled,
+// the shortest of its interval and the intervals of its overrides, so that
+// the entities can execute it at their own interval.
+func (c *CheckConfig) SchedulingInterval() uint32 {
+	interval := c.Interval
+	if c.Cron != "" {
+		return interval
+	}
+	for _, o := range c.Overrides {
+		if o.Interval > 0 && o.Interval < interval {
+			interval = o.Interval
+		}
+	}
+	return interval
+}
+
+// IntervalDue determines if an entity executing the check every interval
+// seconds is due to execute the request issued at the given time, in
+// milliseconds, given the issue time of the request it last executed. The
+// requests are issued every SchedulingInterval, so a request issued within
+// half of it of the due time is executed.
+func (c *CheckConfig) IntervalDue(interval uint32, last, issued int64) bool {
+	if last == 0 || issued < last {
+		return true
+	}
+	slack := int64(c.SchedulingInterval()) * 1000 / 2
+	return issued-last >= int64(interval)*1000-slack
+}
+
+// validateOverrides returns an error if an override of the check is invalid,
+// or its interval isn't supported by the check.
+func (c *CheckConfig) validateOverrides() error {
+	for i, o := range c.Overrides {
+		if err := o.Validate(); err != nil {
+			return fmt.Errorf("override %d: %s", i, err)
+		}
+		if o.Interval == 0 {
+			continue
+		}
+		if c.Cron != "" || c.RoundRobin {
+			return fmt.Errorf("override %d: the interval of cron or round-robin checks cannot be overridden", i)
+		}
+		if c.Ttl > 0 && c.Ttl <= int64(o.Interval) {
+			return fmt.Errorf("override %d: the ttl must be greater than the interval", i)
+		}
+	}
+	return nil
+}
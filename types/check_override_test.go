@@ -0,0 +1,120 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckOverrideValidate(t *testing.T) {
+	o := CheckOverride{}
+	assert.Error(t, o.Validate())
+
+	o.Entity = "web01"
+	assert.Error(t, o.Validate())
+
+	o.Timeout = 30
+	assert.NoError(t, o.Validate())
+
+	o = CheckOverride{Labels: map[string]string{"region": "us-west"}, Disabled: true}
+	assert.NoError(t, o.Validate())
+
+	o = CheckOverride{Entity: "web 01", Interval: 60}
+	assert.Error(t, o.Validate())
+}
+
+func TestCheckOverrideMatches(t *testing.T) {
+	entity := FixtureEntity("web01")
+	entity.Labels = map[string]string{"region": "us-west", "tier": "web"}
+
+	assert.True(t, (&CheckOverride{Entity: "web01"}).Matches(entity))
+	assert.False(t, (&CheckOverride{Entity: "web02"}).Matches(entity))
+	assert.True(t, (&CheckOverride{Labels: map[string]string{"region": "us-west"}}).Matches(entity))
+	assert.False(t, (&CheckOverride{Labels: map[string]string{"region": "us-east"}}).Matches(entity))
+	assert.False(t, (&CheckOverride{Labels: map[string]string{"os": "linux"}}).Matches(entity))
+	assert.False(t, (&CheckOverride{Entity: "web02", Labels: map[string]string{"tier": "web"}}).Matches(entity))
+	assert.False(t, (&CheckOverride{Entity: "web01"}).Matches(nil))
+}
+
+func TestCheckConfigOverrideFor(t *testing.T) {
+	check := FixtureCheckConfig("check")
+	check.Overrides = []CheckOverride{
+		{Entity: "web01", Timeout: 10},
+		{Labels: map[string]string{"tier": "web"}, Timeout: 20},
+	}
+
+	web01 := FixtureEntity("web01")
+	web01.Labels = map[string]string{"tier": "web"}
+	web02 := FixtureEntity("web02")
+	web02.Labels = map[string]string{"tier": "web"}
+
+	require.NotNil(t, check.OverrideFor(web01))
+	assert.Equal(t, uint32(10), check.OverrideFor(web01).Timeout)
+	require.NotNil(t, check.OverrideFor(web02))
+	assert.Equal(t, uint32(20), check.OverrideFor(web02).Timeout)
+	assert.Nil(t, check.OverrideFor(FixtureEntity("db01")))
+}
+
+func TestCheckConfigWithOverride(t *testing.T) {
+	check := FixtureCheckConfig("check")
+	check.Timeout = 30
+	check.Overrides = []CheckOverride{{Entity: "web01", Interval: 300}}
+
+	merged := check.WithOverride(&check.Overrides[0])
+	assert.Equal(t, uint32(300), merged.Interval)
+	assert.Equal(t, uint32(30), merged.Timeout)
+	assert.Empty(t, merged.Overrides)
+	assert.Equal(t, uint32(60), check.Interval)
+	assert.Len(t, check.Overrides, 1)
+
+	merged = check.WithOverride(&CheckOverride{Timeout: 5})
+	assert.Equal(t, uint32(60), merged.Interval)
+	assert.Equal(t, uint32(5), merged.Timeout)
+}
+
+func TestCheckConfigSchedulingInterval(t *testing.T) {
+	check := FixtureCheckConfig("check")
+	assert.Equal(t, uint32(60), check.SchedulingInterval())
+
+	check.Overrides = []CheckOverride{
+		{Entity: "web01", Interval: 300},
+		{Entity: "web02", Interval: 30},
+		{Entity: "web03", Timeout: 5},
+	}
+	assert.Equal(t, uint32(30), check.SchedulingInterval())
+}
+
+func TestCheckConfigIntervalDue(t *testing.T) {
+	check := FixtureCheckConfig("check")
+	check.Overrides = []CheckOverride{{Entity: "web01", Interval: 30}}
+
+	// The check is scheduled every 30 seconds and executed every 60 seconds
+	assert.True(t, check.IntervalDue(60, 0, 1000000))
+	assert.False(t, check.IntervalDue(60, 1000000, 1030000))
+	assert.True(t, check.IntervalDue(60, 1000000, 1060000))
+
+	// The requests aren't issued exactly on time
+	assert.True(t, check.IntervalDue(60, 1000000, 1059000))
+	assert.False(t, check.IntervalDue(60, 1000000, 1031000))
+}
+
+func TestCheckConfigValidateOverrides(t *testing.T) {
+	check := FixtureCheckConfig("check")
+	check.Overrides = []CheckOverride{{Entity: "web01", Interval: 300, Timeout: 10}}
+	assert.NoError(t, check.Validate())
+
+	check.Overrides = []CheckOverride{{Entity: "web01"}}
+	assert.Error(t, check.Validate())
+
+	check.Overrides = []CheckOverride{{Entity: "web01", Interval: 300}}
+	check.Ttl = 120
+	assert.Error(t, check.Validate())
+
+	check.Ttl = 0
+	check.RoundRobin = true
+	assert.Error(t, check.Validate())
+
+	check.Overrides = []CheckOverride{{Entity: "web01", Disabled: true}}
+	assert.NoError(t, check.Validate())
+}
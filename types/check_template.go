@@ -0,0 +1,202 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"text/template"
+)
+
+const (
+	// TemplateLeftDelim and TemplateRightDelim delimit the actions of the
+	// check templates, which differ from the delimiters of the token
+	// substitution performed by the agents so both can be used in a check.
+	TemplateLeftDelim  = "{{{"
+	TemplateRightDelim = "}}}"
+)
+
+// Validate returns an error if the check template does not pass validation
+// tests, including the validation of the checks it generates.
+func (t *CheckTemplate) Validate() error {
+	if err := ValidateName(t.Name); err != nil {
+		return errors.New("check template name " + err.Error())
+	}
+
+	if t.Environment == "" {
+		return errors.New("check template environment must be set")
+	}
+
+	if t.Organization == "" {
+		return errors.New("check template organization must be set")
+	}
+
+	checks, err := t.Render()
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]bool, len(checks))
+	for i, check := range checks {
+		if err := check.Validate(); err != nil {
+			return fmt.Errorf("check template parameter set %d: %s", i, err)
+		}
+		if names[check.Name] {
+			return fmt.Errorf("check template parameter set %d: duplicate check name %q", i, check.Name)
+		}
+		names[check.Name] = true
+	}
+
+	return nil
+}
+
+// Render returns the checks generated by the check template, one for each of
+// its parameter sets, in order. The variables of each set override the shared
+// variables of the template, and referencing an undefined variable is an
+// error.
+func (t *CheckTemplate) Render() ([]*CheckConfig, error) {
+	// Render the JSON representation of the check, so that every string
+	// attribute, including nested ones, is rendered
+	b, err := json.Marshal(&t.Check)
+	if err != nil {
+		return nil, err
+	}
+	var attrs interface{}
+	if err := json.Unmarshal(b, &attrs); err != nil {
+		return nil, err
+	}
+
+	checks := make([]*CheckConfig, 0, len(t.Parameters))
+	for i, params := range t.Parameters {
+		vars := make(map[string]string, len(t.Variables)+len(params.Values))
+		for k, v := range t.Variables {
+			vars[k] = v
+		}
+		for k, v := range params.Values {
+			vars[k] = v
+		}
+
+		rendered, err := renderValue(attrs, vars)
+		if err != nil {
+			return nil, fmt.Errorf("check template parameter set %d: %s", i, err)
+		}
+		b, err := json.Marshal(rendered)
+		if err != nil {
+			return nil, err
+		}
+		check := &CheckConfig{}
+		if err := json.Unmarshal(b, check); err != nil {
+			return nil, fmt.Errorf("check template parameter set %d: %s", i, err)
+		}
+
+		check.Organization = t.Organization
+		check.Environment = t.Environment
+		checks = append(checks, check)
+	}
+
+	return checks, nil
+}
+
+// renderValue renders the strings found in a decoded JSON value.
+func renderValue(value interface{}, vars map[string]string) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return renderString(v, vars)
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i := range v {
+			rendered, err := renderValue(v[i], vars)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = rendered
+		}
+		return result, nil
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key := range v {
+			rendered, err := renderValue(v[key], vars)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = rendered
+		}
+		return result, nil
+	default:
+		return value, nil
+	}
+}
+
+// renderString renders a single string with the variables.
+func renderString(s string, vars map[string]string) (string, error) {
+	if !strings.Contains(s, TemplateLeftDelim) {
+		return s, nil
+	}
+
+	tmpl, err := template.New("").
+		Delims(TemplateLeftDelim, TemplateRightDelim).
+		Option("missingkey=error").
+		Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, vars); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Update updates t with selected fields. Returns non-nil error if any of the
+// selected fields are unsupported.
+func (t *CheckTemplate) Update(from *CheckTemplate, fields ...string) error {
+	for _, f := range fields {
+		switch f {
+		case "Description":
+			t.Description = from.Description
+		case "Check":
+			t.Check = from.Check
+		case "Variables":
+			t.Variables = from.Variables
+		case "Parameters":
+			t.Parameters = append(t.Parameters[0:0], from.Parameters...)
+		default:
+			return fmt.Errorf("unsupported field: %q", f)
+		}
+	}
+	return nil
+}
+
+// SetNamespace sets the organization and environment of the check template.
+func (t *CheckTemplate) SetNamespace(org, env string) {
+	t.Organization = org
+	t.Environment = env
+}
+
+// URIPath returns the path of the check template, relative to the API root.
+func (t *CheckTemplate) URIPath() string {
+	return path.Join("/checktemplates", url.PathEscape(t.Name))
+}
+
+// FixtureCheckTemplate returns a CheckTemplate fixture for testing, which
+// generates a check for the web01 and web02 hosts.
+func FixtureCheckTemplate(name string) *CheckTemplate {
+	check := FixtureCheckConfig("check-http-{{{ .host }}}")
+	check.Command = "check-http.rb -u http://{{{ .host }}}:{{{ .port }}}"
+
+	return &CheckTemplate{
+		Name:      name,
+		Check:     *check,
+		Variables: map[string]string{"port": "80"},
+		Parameters: []ParameterSet{
+			{Values: map[string]string{"host": "web01"}},
+			{Values: map[string]string{"host": "web02", "port": "8080"}},
+		},
+		Environment:  "default",
+		Organization: "default",
+	}
+}
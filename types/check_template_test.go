@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTemplateRender(t *testing.T) {
+	tmpl := FixtureCheckTemplate("http")
+	tmpl.Check.Subscriptions = []string{"{{{ .host }}}"}
+	tmpl.Check.ExtendedAttributes = []byte(`{"team":"{{{ .host }}}-ops"}`)
+	tmpl.Organization = "acme"
+
+	checks, err := tmpl.Render()
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+
+	assert.Equal(t, "check-http-web01", checks[0].Name)
+	assert.Equal(t, "check-http.rb -u http://web01:80", checks[0].Command)
+	assert.Equal(t, []string{"web01"}, checks[0].Subscriptions)
+	assert.Equal(t, "acme", checks[0].Organization)
+	assert.Equal(t, "check-http-web02", checks[1].Name)
+	assert.Equal(t, "check-http.rb -u http://web02:8080", checks[1].Command)
+	assert.Equal(t, tmpl.Check.Interval, checks[1].Interval)
+
+	team, err := checks[1].Get("team")
+	require.NoError(t, err)
+	assert.Equal(t, "web02-ops", team)
+
+	// The template is left untouched
+	assert.Equal(t, "check-http-{{{ .host }}}", tmpl.Check.Name)
+
+	// The token substitution of the agents is preserved
+	tmpl.Check.Command = "check-http.rb -u {{{ .host }}} -t {{ .Timeout }}"
+	checks, err = tmpl.Render()
+	require.NoError(t, err)
+	assert.Equal(t, "check-http.rb -u web01 -t {{ .Timeout }}", checks[0].Command)
+
+	tmpl.Parameters = append(tmpl.Parameters, ParameterSet{Values: map[string]string{"port": "81"}})
+	_, err = tmpl.Render()
+	assert.Error(t, err)
+}
+
+func TestCheckTemplateValidate(t *testing.T) {
+	tmpl := FixtureCheckTemplate("http")
+	assert.NoError(t, tmpl.Validate())
+
+	tmpl.Name = "http checks"
+	assert.Error(t, tmpl.Validate())
+	tmpl.Name = "http"
+
+	tmpl.Parameters[1].Values["host"] = "web01"
+	assert.Error(t, tmpl.Validate())
+
+	tmpl.Parameters[1].Values["host"] = "web 02"
+	assert.Error(t, tmpl.Validate())
+	tmpl.Parameters[1].Values["host"] = "web02"
+
+	tmpl.Environment = ""
+	assert.Error(t, tmpl.Validate())
+}
+
+func TestCheckTemplateJSONRoundTrip(t *testing.T) {
+	tmpl := FixtureCheckTemplate("http")
+	b, err := json.Marshal(tmpl)
+	require.NoError(t, err)
+
+	decoded := &CheckTemplate{}
+	require.NoError(t, json.Unmarshal(b, decoded))
+	assert.Equal(t, "web02", decoded.Parameters[1].Values["host"])
+	assert.Equal(t, tmpl.Check.Command, decoded.Check.Command)
+}
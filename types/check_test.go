@@ -1,7 +1,9 @@
 package types
 
 import (
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -52,9 +54,128 @@ func TestCheckConfig(t *testing.T) {
 	c.Ttl = 10
 	assert.Error(t, c.Validate())
 
-	// Valid check
+	// Invalid splay coverage
 	c.Ttl = 90
+	c.SplayCoverage = 150
+	assert.Error(t, c.Validate())
+	c.SplayCoverage = 0
+
+	// Invalid splay and splay coverage
+	c.Splay = true
+	assert.Error(t, c.Validate())
+	c.SplayCoverage = 90
+
+	// Invalid subdue time zone
+	c.Subdue = &TimeWindowWhen{TimeZone: "Mars/Olympus_Mons"}
+	assert.Error(t, c.Validate())
+	c.Subdue.TimeZone = "America/Vancouver"
+
+	// Invalid metric retention
+	c.MetricRetention = "some"
+	assert.Error(t, c.Validate())
+	c.MetricRetention = MetricRetentionDownsample
+
+	// Invalid exec type
+	c.ExecType = "exec"
+	assert.Error(t, c.Validate())
+	c.ExecType = CheckExecTypeDirect
+
+	// Invalid shell with the direct exec type
+	c.Shell = "bash"
+	assert.Error(t, c.Validate())
+	c.ExecType = CheckExecTypeShell
+
+	// Invalid cpu limit
+	c.CPULimit = -1
+	assert.Error(t, c.Validate())
+	c.CPULimit = 0.5
+
+	// Invalid nice level
+	c.Nice = 20
+	assert.Error(t, c.Validate())
+	c.Nice = 10
+
+	// Invalid executor
+	c.Executor = "server"
+	assert.Error(t, c.Validate())
+	c.Executor = CheckExecutorBackend
+
+	// Missing proxy entity with the backend executor
+	assert.Error(t, c.Validate())
+	c.ProxyEntityID = "website"
+
+	// Invalid command with the backend executor
+	assert.Error(t, c.Validate())
+	c.Command = "builtin://http?url=https://sensu.io"
+
+	// Invalid catch up
+	c.CatchUp = "all"
+	assert.Error(t, c.Validate())
+	c.CatchUp = CheckCatchUpRunOnce
+
+	// Invalid history retention
+	c.HistoryRetention = MaxHistoryRetention + 1
+	assert.Error(t, c.Validate())
+	c.HistoryRetention = 5
+	c.HighFlapThreshold = 30
+	assert.Error(t, c.Validate())
+	c.HighFlapThreshold = 0
+
+	// Invalid pipeline name
+	c.Pipelines = []string{"alert pipeline"}
+	assert.Error(t, c.Validate())
+	c.Pipelines = []string{"alerts"}
+
+	// Invalid link templates
+	c.RunbookURL = "https://wiki/{{ .Check.Name"
+	assert.Error(t, c.Validate())
+	c.RunbookURL = "https://wiki/{{ .Check.Name }}"
+	c.DashboardURL = "https://grafana/{{ end }}"
+	assert.Error(t, c.Validate())
+	c.DashboardURL = "https://grafana/d/hosts?var-host={{ .Entity.ID }}"
+
+	// Invalid anomaly detection
+	c.AnomalyDetection = "holt-winters"
+	assert.Error(t, c.Validate())
+	c.AnomalyDetection = AnomalyDetectionEWMA
+	c.AnomalyThreshold = -1
+	assert.Error(t, c.Validate())
+	c.AnomalyThreshold = 2.5
+	c.AnomalyWindow = 1
+	assert.Error(t, c.Validate())
+	c.AnomalyWindow = MaxAnomalyWindow + 1
+	assert.Error(t, c.Validate())
+	c.AnomalyWindow = 60
+
+	// Invalid contact
+	c.Contacts = []string{"alice", " "}
+	assert.Error(t, c.Validate())
+	c.Contacts = []string{"alice", "ops"}
+
+	// Valid check
+	assert.NoError(t, c.Validate())
+}
+
+func TestStandaloneCheckConfigValidate(t *testing.T) {
+	c := FixtureCheckConfig("check")
+	c.Standalone = true
+	assert.Error(t, c.Validate())
+
+	// Hooks and runtime assets are provided by the backend
+	c.CheckHooks = nil
+	c.RuntimeAssets = nil
 	assert.NoError(t, c.Validate())
+
+	c.Executor = CheckExecutorBackend
+	assert.Error(t, c.Validate())
+	c.Executor = CheckExecutorAgent
+
+	c.RoundRobin = true
+	assert.Error(t, c.Validate())
+	c.RoundRobin = false
+
+	c.RuntimeAssets = []string{"ruby"}
+	assert.Error(t, c.Validate())
 }
 
 func TestScheduleValidation(t *testing.T) {
@@ -100,6 +221,45 @@ func TestMergeWith(t *testing.T) {
 	assert.Equal(t, int32(1), newCheck.History[20].Status)
 }
 
+func TestMergeWithHistoryRetention(t *testing.T) {
+	originalCheck := FixtureCheck("check")
+	originalCheck.Output = "CRITICAL"
+	originalCheck.History[0].Output = "WARNING"
+
+	// The default retention drops the oldest execution and the outputs
+	newCheck := FixtureCheck("check")
+	newCheck.MergeWith(originalCheck)
+	require.Len(t, newCheck.History, DefaultHistoryRetention)
+	assert.Equal(t, originalCheck.Executed, newCheck.History[20].Executed)
+	assert.Empty(t, newCheck.History[20].Output)
+	assert.Empty(t, newCheck.History[19].Output)
+
+	// A longer retention keeps every execution, with their output
+	newCheck = FixtureCheck("check")
+	newCheck.HistoryRetention = 50
+	newCheck.HistoryOutput = true
+	newCheck.MergeWith(originalCheck)
+	require.Len(t, newCheck.History, 22)
+	assert.Equal(t, "CRITICAL", newCheck.History[21].Output)
+	assert.Equal(t, "WARNING", newCheck.History[20].Output)
+
+	// A shorter retention keeps the latest executions
+	newCheck = FixtureCheck("check")
+	newCheck.HistoryRetention = 5
+	newCheck.MergeWith(originalCheck)
+	require.Len(t, newCheck.History, 5)
+	assert.Equal(t, originalCheck.Executed, newCheck.History[4].Executed)
+
+	// The outputs kept are truncated
+	originalCheck.Output = strings.Repeat("é", MaxHistoryOutputSize)
+	newCheck = FixtureCheck("check")
+	newCheck.HistoryOutput = true
+	newCheck.MergeWith(originalCheck)
+	output := newCheck.History[len(newCheck.History)-1].Output
+	assert.Len(t, output, MaxHistoryOutputSize)
+	assert.True(t, utf8.ValidString(output))
+}
+
 func TestExtendedAttributes(t *testing.T) {
 	type getter interface {
 		Get(string) (interface{}, error)
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// CloudEventsSpecVersion is the version of the CloudEvents specification
+	// implemented
+	CloudEventsSpecVersion = "1.0"
+
+	// CloudEventType is the type of the CloudEvents carrying Sensu events
+	CloudEventType = "io.sensu.event"
+
+	// CloudEventsContentType is the media type of the CloudEvents in the
+	// structured content mode
+	CloudEventsContentType = "application/cloudevents+json"
+)
+
+// CloudEvent is a CloudEvents 1.0 envelope, in its JSON format.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+	DataBase64      []byte          `json:"data_base64,omitempty"`
+}
+
+// NewCloudEvent wraps the data of the event, as provided to a handler, in a
+// CloudEvent. The source of the CloudEvent is the entity of the event, and
+// its subject the check. The data is carried as is if it's valid JSON, and
+// encoded in base64 otherwise, e.g. for the only_check_output mutator.
+func NewCloudEvent(event *Event, data []byte) *CloudEvent {
+	c := &CloudEvent{
+		SpecVersion: CloudEventsSpecVersion,
+		ID:          uuid.New().String(),
+		Source:      path.Join("/sensu", event.Entity.Organization, event.Entity.Environment, event.Entity.URIPath()),
+		Type:        CloudEventType,
+		Time:        time.Unix(event.Timestamp, 0).UTC().Format(time.RFC3339),
+	}
+	if event.HasCheck() {
+		c.Subject = event.Check.Name
+	}
+
+	if json.Valid(data) {
+		c.DataContentType = "application/json"
+		c.Data = data
+	} else {
+		c.DataContentType = "application/octet-stream"
+		c.DataBase64 = data
+	}
+
+	return c
+}
+
+// Validate returns an error if the CloudEvent lacks a required attribute.
+func (c *CloudEvent) Validate() error {
+	if c.SpecVersion != CloudEventsSpecVersion {
+		return fmt.Errorf("unsupported specversion %q", c.SpecVersion)
+	}
+	if c.ID == "" {
+		return errors.New("id must not be empty")
+	}
+	if c.Source == "" {
+		return errors.New("source must not be empty")
+	}
+	if c.Type == "" {
+		return errors.New("type must not be empty")
+	}
+	return nil
+}
+
+// Event decodes the Sensu event carried by the CloudEvent. The check name
+// defaults to the subject, and the timestamp to the time of the CloudEvent.
+func (c *CloudEvent) Event() (*Event, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	data := []byte(c.Data)
+	if len(c.DataBase64) > 0 {
+		data = c.DataBase64
+	}
+	if len(data) == 0 {
+		return nil, errors.New("the cloudevent has no data")
+	}
+
+	event := &Event{}
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, fmt.Errorf("the cloudevent data is not a sensu event: %s", err)
+	}
+
+	if event.Check != nil && event.Check.Name == "" {
+		event.Check.Name = c.Subject
+	}
+
+	if event.Timestamp == 0 && c.Time != "" {
+		t, err := time.Parse(time.RFC3339Nano, c.Time)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cloudevent time: %s", err)
+		}
+		event.Timestamp = t.Unix()
+	}
+
+	return event, nil
+}
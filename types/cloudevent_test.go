@@ -0,0 +1,70 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCloudEvent(t *testing.T) {
+	event := FixtureEvent("web01", "check_cpu")
+	event.Timestamp = 1528848000
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	c := NewCloudEvent(event, data)
+	require.NoError(t, c.Validate())
+	assert.Equal(t, "/sensu/default/default/entities/web01", c.Source)
+	assert.Equal(t, CloudEventType, c.Type)
+	assert.Equal(t, "check_cpu", c.Subject)
+	assert.Equal(t, "2018-06-13T00:00:00Z", c.Time)
+	assert.Equal(t, "application/json", c.DataContentType)
+	assert.Empty(t, c.DataBase64)
+
+	// The event is decoded back from the CloudEvent
+	decoded, err := c.Event()
+	require.NoError(t, err)
+	assert.Equal(t, event.Entity.ID, decoded.Entity.ID)
+	assert.Equal(t, event.Check.Name, decoded.Check.Name)
+
+	// Other data are encoded in base64
+	c = NewCloudEvent(event, []byte("output"))
+	assert.Equal(t, "application/octet-stream", c.DataContentType)
+	assert.Empty(t, c.Data)
+	b, err := json.Marshal(c)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"data_base64":"b3V0cHV0"`)
+}
+
+func TestCloudEventEvent(t *testing.T) {
+	c := &CloudEvent{
+		SpecVersion: CloudEventsSpecVersion,
+		ID:          "42",
+		Source:      "/producer",
+		Type:        "com.example.alert",
+		Subject:     "disk_full",
+		Time:        "2018-06-13T00:00:00Z",
+		Data:        json.RawMessage(`{"entity": {"id": "db01"}, "check": {"status": 2}}`),
+	}
+
+	event, err := c.Event()
+	require.NoError(t, err)
+	assert.Equal(t, "db01", event.Entity.ID)
+	assert.Equal(t, "disk_full", event.Check.Name)
+	assert.Equal(t, int32(2), event.Check.Status)
+	assert.Equal(t, int64(1528848000), event.Timestamp)
+
+	c.Data = json.RawMessage(`"not an event"`)
+	_, err = c.Event()
+	assert.Error(t, err)
+
+	c.Data = nil
+	_, err = c.Event()
+	assert.Error(t, err)
+
+	c.SpecVersion = "0.3"
+	_, err = c.Event()
+	assert.Error(t, err)
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ClusterConfig holds the cluster-wide defaults, applied to the resources
+// which omit the corresponding fields.
+type ClusterConfig struct {
+	// DefaultHandlers are the handlers of the check events whose check has no
+	// handlers
+	DefaultHandlers []string `json:"default_handlers"`
+
+	// DefaultCheckTimeout is the timeout, in seconds, of the checks created
+	// without a timeout. Zero means no timeout.
+	DefaultCheckTimeout uint32 `json:"default_check_timeout"`
+
+	// KeepaliveTimeout is the keepalive timeout, in seconds, of the entities
+	// without one. Zero means the backend default.
+	KeepaliveTimeout uint32 `json:"keepalive_timeout"`
+
+	// OutputNormalization are regular expressions whose matches are removed
+	// from the check output before comparing it to the output of the previous
+	// event, by the output_changed filter (e.g. timestamps or durations)
+	OutputNormalization []string `json:"output_normalization"`
+}
+
+// Validate returns an error if the cluster-wide defaults are invalid.
+func (c *ClusterConfig) Validate() error {
+	var errs ValidationErrors
+
+	for i, expr := range c.OutputNormalization {
+		if _, err := regexp.Compile(expr); err != nil {
+			errs.Addf(fmt.Sprintf("output_normalization[%d]", i), ValidationInvalid, "invalid regular expression: %s", err)
+		}
+	}
+
+	return errs.Err()
+}
+
+// Override returns the defaults of an organization, which are these defaults
+// overridden by the fields set in o.
+func (c *ClusterConfig) Override(o *ClusterConfig) *ClusterConfig {
+	config := *c
+	if o == nil {
+		return &config
+	}
+
+	if len(o.DefaultHandlers) > 0 {
+		config.DefaultHandlers = o.DefaultHandlers
+	}
+	if o.DefaultCheckTimeout != 0 {
+		config.DefaultCheckTimeout = o.DefaultCheckTimeout
+	}
+	if o.KeepaliveTimeout != 0 {
+		config.KeepaliveTimeout = o.KeepaliveTimeout
+	}
+	if len(o.OutputNormalization) > 0 {
+		config.OutputNormalization = o.OutputNormalization
+	}
+	return &config
+}
+
+// OutputNormalizer returns a function removing the matches of the output
+// normalization regular expressions from the check output, which are compiled
+// once. The invalid expressions are ignored.
+func (c *ClusterConfig) OutputNormalizer() func(string) string {
+	var exprs []*regexp.Regexp
+	for _, expr := range c.OutputNormalization {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			continue
+		}
+		exprs = append(exprs, re)
+	}
+
+	return func(output string) string {
+		for _, re := range exprs {
+			output = re.ReplaceAllString(output, "")
+		}
+		return output
+	}
+}
+
+// FixtureClusterConfig returns a ClusterConfig for use in testing.
+func FixtureClusterConfig() *ClusterConfig {
+	return &ClusterConfig{
+		DefaultHandlers:     []string{"default"},
+		DefaultCheckTimeout: 30,
+		KeepaliveTimeout:    60,
+	}
+}
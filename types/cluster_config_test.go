@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterConfigValidate(t *testing.T) {
+	config := FixtureClusterConfig()
+	assert.NoError(t, config.Validate())
+
+	config.OutputNormalization = []string{`\d+ms`, "("}
+	assert.Error(t, config.Validate())
+}
+
+func TestClusterConfigOutputNormalizer(t *testing.T) {
+	config := &ClusterConfig{}
+	assert.Equal(t, "OK: 12ms", config.OutputNormalizer()("OK: 12ms"))
+
+	config.OutputNormalization = []string{`\d+ms`, "("}
+	assert.Equal(t, "OK: ", config.OutputNormalizer()("OK: 12ms"))
+}
+
+func TestClusterConfigOverride(t *testing.T) {
+	config := FixtureClusterConfig()
+	assert.Equal(t, config, config.Override(nil))
+	assert.Equal(t, config, config.Override(&ClusterConfig{}))
+
+	// The fields set by the organization override the cluster-wide defaults
+	org := config.Override(&ClusterConfig{DefaultHandlers: []string{"slack"}, KeepaliveTimeout: 30})
+	assert.Equal(t, []string{"slack"}, org.DefaultHandlers)
+	assert.Equal(t, uint32(30), org.KeepaliveTimeout)
+	assert.Equal(t, config.DefaultCheckTimeout, org.DefaultCheckTimeout)
+	assert.Equal(t, []string{"default"}, config.DefaultHandlers)
+}
@@ -1,5 +1,7 @@
 package types
 
+import "context"
+
 // Define the key type to avoid key collisions in context
 type key int
 
@@ -24,4 +26,23 @@ const (
 	RefreshTokenString
 	// StoreKey contains the key name to retrieve the etcd store from within a context
 	StoreKey
+	// RequestIDKey contains the key name to retrieve the ID of the API request
+	// being served from a context
+	RequestIDKey
+	// StaleReadsKey contains the key name used to allow the store to serve
+	// reads that may be stale, when it has no quorum
+	StaleReadsKey
+	// ReadOnlyKey contains the key name used to forbid the GraphQL mutations
+	// of requests served by a read-only API replica
+	ReadOnlyKey
 )
+
+// RequestIDFromContext returns the ID of the API request stored in the given
+// context, or an empty string if there is none.
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(RequestIDKey).(string)
+	return id
+}
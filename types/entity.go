@@ -3,6 +3,8 @@ package types
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"path"
 
 	"github.com/sensu/sensu-go/types/dynamic"
 )
@@ -13,6 +15,11 @@ const (
 
 	// EntityProxyClass is the name of the class given to proxy entities.
 	EntityProxyClass = "proxy"
+
+	// EntityBackendClass is the name of the class given to the entity of the
+	// backend, used to evaluate the filters of the runtime assets of handlers
+	// and mutators.
+	EntityBackendClass = "backend"
 )
 
 // Validate returns an error if the entity is invalid.
@@ -69,6 +76,17 @@ func GetEntitySubscription(entityID string) string {
 	return fmt.Sprintf("entity:%s", entityID)
 }
 
+// SetNamespace sets the organization and environment of the entity.
+func (e *Entity) SetNamespace(org, env string) {
+	e.Organization = org
+	e.Environment = env
+}
+
+// URIPath returns the path of the entity, relative to the API root.
+func (e *Entity) URIPath() string {
+	return path.Join("/entities", url.PathEscape(e.ID))
+}
+
 // FixtureEntity returns a testing fixture for an Entity object.
 func FixtureEntity(id string) *Entity {
 	return &Entity{
@@ -80,3 +98,53 @@ func FixtureEntity(id string) *Entity {
 		KeepaliveTimeout: 120,
 	}
 }
+
+// The transports of the agent connections
+const (
+	// EntityStatusTransportWebSocket is the transport of the agents connected
+	// to the backends directly
+	EntityStatusTransportWebSocket = "websocket"
+
+	// EntityStatusTransportMQTT is the transport of the agents connected to
+	// the backends through an MQTT broker
+	EntityStatusTransportMQTT = "mqtt"
+)
+
+// Validate returns an error if the entity status does not pass validation
+// tests.
+func (s *EntityStatus) Validate() error {
+	if err := ValidateName(s.EntityID); err != nil {
+		return errors.New("entity id " + err.Error())
+	}
+
+	if s.Environment == "" {
+		return errors.New("environment must be set")
+	}
+
+	if s.Organization == "" {
+		return errors.New("organization must be set")
+	}
+
+	return nil
+}
+
+// URIPath returns the path of the entity status, relative to the API root.
+func (s *EntityStatus) URIPath() string {
+	return path.Join("/entities", url.PathEscape(s.EntityID), "status")
+}
+
+// FixtureEntityStatus returns a testing fixture for the status of a connected
+// agent.
+func FixtureEntityStatus(id string) *EntityStatus {
+	return &EntityStatus{
+		EntityID:      id,
+		Environment:   "default",
+		Organization:  "default",
+		Connected:     true,
+		Backend:       "default",
+		Session:       "5a1e6c2c-3ee6-4e54-b1d1-4d5c0b0d2f1a",
+		Transport:     EntityStatusTransportWebSocket,
+		ConnectedAt:   1522799096,
+		LastKeepalive: 1522799116,
+	}
+}
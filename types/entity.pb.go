@@ -35,6 +35,16 @@ type Entity struct {
 	ExtendedAttributes []byte `protobuf:"bytes,12,opt,name=extended_attributes,json=extendedAttributes,proto3" json:"-"`
 	// Redact contains the fields to redact on the agent
 	Redact []string `protobuf:"bytes,13,rep,name=redact" json:"redact,omitempty"`
+	// Partial indicates that the entity only provides a subset of its system
+	// facts and extended attributes, as sent by the agents between two full
+	// entity synchronizations. The backend merges it with the stored entity.
+	Partial bool `protobuf:"varint,14,opt,name=partial,proto3" json:"partial,omitempty"`
+	// Labels are key-value pairs identifying the entity, e.g. to select the
+	// entity groups it belongs to.
+	Labels map[string]string `protobuf:"bytes,15,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Annotations are key-value pairs describing the entity, e.g. its owner or
+	// its team, which aren't used to select it.
+	Annotations map[string]string `protobuf:"bytes,16,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *Entity) Reset()                    { *m = Entity{} }
@@ -133,6 +143,27 @@ func (m *Entity) GetRedact() []string {
 	return nil
 }
 
+func (m *Entity) GetPartial() bool {
+	if m != nil {
+		return m.Partial
+	}
+	return false
+}
+
+func (m *Entity) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Entity) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
 // System contains information about the system that the Agent process
 // is running on, used for additional Entity context.
 type System struct {
@@ -269,12 +300,127 @@ func (m *Deregistration) GetHandler() string {
 	return ""
 }
 
+// EntityStatus is the status of the connection of the agent of an entity to
+// the backends, telling an agent which is down from an agent disconnected
+// from a given backend.
+type EntityStatus struct {
+	// EntityID is the ID of the entity of the agent.
+	EntityID string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id"`
+	// Organization indicates to which org the entity belongs to.
+	Organization string `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Environment indicates to which env the entity belongs to.
+	Environment string `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	// Connected indicates whether the agent is connected to a backend.
+	Connected bool `protobuf:"varint,4,opt,name=connected,proto3" json:"connected"`
+	// Backend is the name of the backend the agent is, or was last, connected
+	// to.
+	Backend string `protobuf:"bytes,5,opt,name=backend,proto3" json:"backend,omitempty"`
+	// Session is the ID of the session of the agent on its backend.
+	Session string `protobuf:"bytes,6,opt,name=session,proto3" json:"session,omitempty"`
+	// Transport is the transport of the agent connection, websocket or mqtt.
+	Transport string `protobuf:"bytes,7,opt,name=transport,proto3" json:"transport,omitempty"`
+	// AgentVersion is the version of the agent.
+	AgentVersion string `protobuf:"bytes,8,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
+	// ConnectedAt is the time in seconds since the Epoch at which the agent
+	// connected.
+	ConnectedAt int64 `protobuf:"varint,9,opt,name=connected_at,json=connectedAt,proto3" json:"connected_at"`
+	// DisconnectedAt is the time in seconds since the Epoch at which the agent
+	// disconnected, 0 while it's connected.
+	DisconnectedAt int64 `protobuf:"varint,10,opt,name=disconnected_at,json=disconnectedAt,proto3" json:"disconnected_at"`
+	// LastKeepalive is the time in seconds since the Epoch of the last
+	// keepalive received from the agent, recorded once it disconnected.
+	LastKeepalive int64 `protobuf:"varint,11,opt,name=last_keepalive,json=lastKeepalive,proto3" json:"last_keepalive"`
+}
+
+func (m *EntityStatus) Reset()                    { *m = EntityStatus{} }
+func (m *EntityStatus) String() string            { return proto.CompactTextString(m) }
+func (*EntityStatus) ProtoMessage()               {}
+func (*EntityStatus) Descriptor() ([]byte, []int) { return fileDescriptorEntity, []int{5} }
+
+func (m *EntityStatus) GetEntityID() string {
+	if m != nil {
+		return m.EntityID
+	}
+	return ""
+}
+
+func (m *EntityStatus) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *EntityStatus) GetEnvironment() string {
+	if m != nil {
+		return m.Environment
+	}
+	return ""
+}
+
+func (m *EntityStatus) GetConnected() bool {
+	if m != nil {
+		return m.Connected
+	}
+	return false
+}
+
+func (m *EntityStatus) GetBackend() string {
+	if m != nil {
+		return m.Backend
+	}
+	return ""
+}
+
+func (m *EntityStatus) GetSession() string {
+	if m != nil {
+		return m.Session
+	}
+	return ""
+}
+
+func (m *EntityStatus) GetTransport() string {
+	if m != nil {
+		return m.Transport
+	}
+	return ""
+}
+
+func (m *EntityStatus) GetAgentVersion() string {
+	if m != nil {
+		return m.AgentVersion
+	}
+	return ""
+}
+
+func (m *EntityStatus) GetConnectedAt() int64 {
+	if m != nil {
+		return m.ConnectedAt
+	}
+	return 0
+}
+
+func (m *EntityStatus) GetDisconnectedAt() int64 {
+	if m != nil {
+		return m.DisconnectedAt
+	}
+	return 0
+}
+
+func (m *EntityStatus) GetLastKeepalive() int64 {
+	if m != nil {
+		return m.LastKeepalive
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Entity)(nil), "sensu.types.Entity")
 	proto.RegisterType((*System)(nil), "sensu.types.System")
 	proto.RegisterType((*Network)(nil), "sensu.types.Network")
 	proto.RegisterType((*NetworkInterface)(nil), "sensu.types.NetworkInterface")
 	proto.RegisterType((*Deregistration)(nil), "sensu.types.Deregistration")
+	proto.RegisterType((*EntityStatus)(nil), "sensu.types.EntityStatus")
 }
 func (this *Entity) Equal(that interface{}) bool {
 	if that == nil {
@@ -350,6 +496,25 @@ func (this *Entity) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Partial != that1.Partial {
+		return false
+	}
+	if len(this.Labels) != len(that1.Labels) {
+		return false
+	}
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
+	}
+	if len(this.Annotations) != len(that1.Annotations) {
+		return false
+	}
+	for i := range this.Annotations {
+		if this.Annotations[i] != that1.Annotations[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *System) Equal(that interface{}) bool {
@@ -506,6 +671,66 @@ func (this *Deregistration) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *EntityStatus) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*EntityStatus)
+	if !ok {
+		that2, ok := that.(EntityStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.EntityID != that1.EntityID {
+		return false
+	}
+	if this.Organization != that1.Organization {
+		return false
+	}
+	if this.Environment != that1.Environment {
+		return false
+	}
+	if this.Connected != that1.Connected {
+		return false
+	}
+	if this.Backend != that1.Backend {
+		return false
+	}
+	if this.Session != that1.Session {
+		return false
+	}
+	if this.Transport != that1.Transport {
+		return false
+	}
+	if this.AgentVersion != that1.AgentVersion {
+		return false
+	}
+	if this.ConnectedAt != that1.ConnectedAt {
+		return false
+	}
+	if this.DisconnectedAt != that1.DisconnectedAt {
+		return false
+	}
+	if this.LastKeepalive != that1.LastKeepalive {
+		return false
+	}
+	return true
+}
 func (m *Entity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -623,6 +848,52 @@ func (m *Entity) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.Partial {
+		dAtA[i] = 0x70
+		i++
+		if m.Partial {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Labels) > 0 {
+		for k, _ := range m.Labels {
+			dAtA[i] = 0x7a
+			i++
+			v := m.Labels[k]
+			mapSize := 1 + len(k) + sovEntity(uint64(len(k))) + 1 + len(v) + sovEntity(uint64(len(v)))
+			i = encodeVarintEntity(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintEntity(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintEntity(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, _ := range m.Annotations {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			v := m.Annotations[k]
+			mapSize := 1 + len(k) + sovEntity(uint64(len(k))) + 1 + len(v) + sovEntity(uint64(len(v)))
+			i = encodeVarintEntity(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintEntity(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintEntity(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
 	return i, nil
 }
 
@@ -787,6 +1058,91 @@ func (m *Deregistration) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *EntityStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntityStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.EntityID)))
+		i += copy(dAtA[i:], m.EntityID)
+	}
+	if len(m.Organization) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Organization)))
+		i += copy(dAtA[i:], m.Organization)
+	}
+	if len(m.Environment) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Environment)))
+		i += copy(dAtA[i:], m.Environment)
+	}
+	if m.Connected {
+		dAtA[i] = 0x20
+		i++
+		if m.Connected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Backend) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Backend)))
+		i += copy(dAtA[i:], m.Backend)
+	}
+	if len(m.Session) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Session)))
+		i += copy(dAtA[i:], m.Session)
+	}
+	if len(m.Transport) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Transport)))
+		i += copy(dAtA[i:], m.Transport)
+	}
+	if len(m.AgentVersion) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.AgentVersion)))
+		i += copy(dAtA[i:], m.AgentVersion)
+	}
+	if m.ConnectedAt != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(m.ConnectedAt))
+	}
+	if m.DisconnectedAt != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(m.DisconnectedAt))
+	}
+	if m.LastKeepalive != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(m.LastKeepalive))
+	}
+	return i, nil
+}
+
 func encodeVarintEntity(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -828,6 +1184,21 @@ func NewPopulatedEntity(r randyEntity, easy bool) *Entity {
 	for i := 0; i < v5; i++ {
 		this.Redact[i] = string(randStringEntity(r))
 	}
+	this.Partial = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) != 0 {
+		v6 := r.Intn(10)
+		this.Labels = make(map[string]string)
+		for i := 0; i < v6; i++ {
+			this.Labels[randStringEntity(r)] = randStringEntity(r)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v7 := r.Intn(10)
+		this.Annotations = make(map[string]string)
+		for i := 0; i < v7; i++ {
+			this.Annotations[randStringEntity(r)] = randStringEntity(r)
+		}
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -840,8 +1211,8 @@ func NewPopulatedSystem(r randyEntity, easy bool) *System {
 	this.Platform = string(randStringEntity(r))
 	this.PlatformFamily = string(randStringEntity(r))
 	this.PlatformVersion = string(randStringEntity(r))
-	v6 := NewPopulatedNetwork(r, easy)
-	this.Network = *v6
+	v8 := NewPopulatedNetwork(r, easy)
+	this.Network = *v8
 	this.Arch = string(randStringEntity(r))
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -851,11 +1222,11 @@ func NewPopulatedSystem(r randyEntity, easy bool) *System {
 func NewPopulatedNetwork(r randyEntity, easy bool) *Network {
 	this := &Network{}
 	if r.Intn(10) != 0 {
-		v7 := r.Intn(5)
-		this.Interfaces = make([]NetworkInterface, v7)
-		for i := 0; i < v7; i++ {
-			v8 := NewPopulatedNetworkInterface(r, easy)
-			this.Interfaces[i] = *v8
+		v9 := r.Intn(5)
+		this.Interfaces = make([]NetworkInterface, v9)
+		for i := 0; i < v9; i++ {
+			v10 := NewPopulatedNetworkInterface(r, easy)
+			this.Interfaces[i] = *v10
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -867,9 +1238,9 @@ func NewPopulatedNetworkInterface(r randyEntity, easy bool) *NetworkInterface {
 	this := &NetworkInterface{}
 	this.Name = string(randStringEntity(r))
 	this.MAC = string(randStringEntity(r))
-	v9 := r.Intn(10)
-	this.Addresses = make([]string, v9)
-	for i := 0; i < v9; i++ {
+	v11 := r.Intn(10)
+	this.Addresses = make([]string, v11)
+	for i := 0; i < v11; i++ {
 		this.Addresses[i] = string(randStringEntity(r))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -885,6 +1256,33 @@ func NewPopulatedDeregistration(r randyEntity, easy bool) *Deregistration {
 	return this
 }
 
+func NewPopulatedEntityStatus(r randyEntity, easy bool) *EntityStatus {
+	this := &EntityStatus{}
+	this.EntityID = string(randStringEntity(r))
+	this.Organization = string(randStringEntity(r))
+	this.Environment = string(randStringEntity(r))
+	this.Connected = bool(bool(r.Intn(2) == 0))
+	this.Backend = string(randStringEntity(r))
+	this.Session = string(randStringEntity(r))
+	this.Transport = string(randStringEntity(r))
+	this.AgentVersion = string(randStringEntity(r))
+	this.ConnectedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.ConnectedAt *= -1
+	}
+	this.DisconnectedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.DisconnectedAt *= -1
+	}
+	this.LastKeepalive = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.LastKeepalive *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyEntity interface {
 	Float32() float32
 	Float64() float64
@@ -904,9 +1302,9 @@ func randUTF8RuneEntity(r randyEntity) rune {
 	return rune(ru + 61)
 }
 func randStringEntity(r randyEntity) string {
-	v10 := r.Intn(100)
-	tmps := make([]rune, v10)
-	for i := 0; i < v10; i++ {
+	v12 := r.Intn(100)
+	tmps := make([]rune, v12)
+	for i := 0; i < v12; i++ {
 		tmps[i] = randUTF8RuneEntity(r)
 	}
 	return string(tmps)
@@ -928,11 +1326,11 @@ func randFieldEntity(dAtA []byte, r randyEntity, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateEntity(dAtA, uint64(key))
-		v11 := r.Int63()
+		v13 := r.Int63()
 		if r.Intn(2) == 0 {
-			v11 *= -1
+			v13 *= -1
 		}
-		dAtA = encodeVarintPopulateEntity(dAtA, uint64(v11))
+		dAtA = encodeVarintPopulateEntity(dAtA, uint64(v13))
 	case 1:
 		dAtA = encodeVarintPopulateEntity(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1009,6 +1407,25 @@ func (m *Entity) Size() (n int) {
 			n += 1 + l + sovEntity(uint64(l))
 		}
 	}
+	if m.Partial {
+		n += 2
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEntity(uint64(len(k))) + 1 + len(v) + sovEntity(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEntity(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEntity(uint64(len(k))) + 1 + len(v) + sovEntity(uint64(len(v)))
+			n += mapEntrySize + 2 + sovEntity(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -1086,26 +1503,72 @@ func (m *Deregistration) Size() (n int) {
 	return n
 }
 
-func sovEntity(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *EntityStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.EntityID)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
 	}
-	return n
-}
-func sozEntity(x uint64) (n int) {
-	return sovEntity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Entity) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+	l = len(m.Organization)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.Environment)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	if m.Connected {
+		n += 2
+	}
+	l = len(m.Backend)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.Session)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.Transport)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.AgentVersion)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	if m.ConnectedAt != 0 {
+		n += 1 + sovEntity(uint64(m.ConnectedAt))
+	}
+	if m.DisconnectedAt != 0 {
+		n += 1 + sovEntity(uint64(m.DisconnectedAt))
+	}
+	if m.LastKeepalive != 0 {
+		n += 1 + sovEntity(uint64(m.LastKeepalive))
+	}
+	return n
+}
+
+func sovEntity(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozEntity(x uint64) (n int) {
+	return sovEntity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Entity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowEntity
 			}
@@ -1480,6 +1943,262 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 			}
 			m.Redact = append(m.Redact, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partial", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Partial = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEntity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEntity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEntity
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEntity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEntity
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEntity(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEntity
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEntity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEntity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEntity
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEntity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEntity
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEntity(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEntity
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEntity(dAtA[iNdEx:])
@@ -2052,6 +2771,336 @@ func (m *Deregistration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EntityStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Environment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Connected = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backend = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Session = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transport", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transport = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectedAt", wireType)
+			}
+			m.ConnectedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisconnectedAt", wireType)
+			}
+			m.DisconnectedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisconnectedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastKeepalive", wireType)
+			}
+			m.LastKeepalive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastKeepalive |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEntity(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2160,46 +3209,67 @@ var (
 func init() { proto.RegisterFile("entity.proto", fileDescriptorEntity) }
 
 var fileDescriptorEntity = []byte{
-	// 656 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x4d, 0x6e, 0x13, 0x4b,
-	0x10, 0x4e, 0xfb, 0xdf, 0x65, 0x3b, 0x2f, 0xe9, 0x44, 0x51, 0xbf, 0x44, 0x6f, 0x3c, 0xf2, 0x5b,
-	0x30, 0x10, 0xc5, 0x11, 0x01, 0xc1, 0x3a, 0x26, 0x20, 0x65, 0x01, 0x88, 0x0e, 0x62, 0x81, 0x90,
-	0xac, 0xb6, 0xa7, 0x6c, 0x8f, 0x62, 0xf7, 0x58, 0xdd, 0xed, 0x80, 0xd9, 0x71, 0x0b, 0x8e, 0xc0,
-	0x11, 0x38, 0x42, 0x96, 0x9c, 0xc0, 0x02, 0xb3, 0xf3, 0x01, 0x10, 0x4b, 0x34, 0x3d, 0x63, 0xc7,
-	0x13, 0xb1, 0xfb, 0xbe, 0xaf, 0xbe, 0xaa, 0x2e, 0x57, 0x95, 0x07, 0xaa, 0x28, 0x4d, 0x60, 0xa6,
-	0xcd, 0xb1, 0x0a, 0x4d, 0x48, 0x2b, 0x1a, 0xa5, 0x9e, 0x34, 0xcd, 0x74, 0x8c, 0x7a, 0xff, 0xa8,
-	0x1f, 0x98, 0xc1, 0xa4, 0xd3, 0xec, 0x86, 0xa3, 0xe3, 0x7e, 0xd8, 0x0f, 0x8f, 0xad, 0xa7, 0x33,
-	0xe9, 0x59, 0x66, 0x89, 0x45, 0x71, 0x6e, 0xe3, 0x53, 0x0e, 0x0a, 0x4f, 0x6d, 0x31, 0xba, 0x07,
-	0x99, 0xc0, 0x67, 0xc4, 0x25, 0x5e, 0xb9, 0x55, 0x98, 0xcf, 0xea, 0x99, 0xf3, 0x33, 0x9e, 0x09,
-	0x7c, 0xba, 0x0b, 0xf9, 0xee, 0x50, 0x68, 0xcd, 0x32, 0x51, 0x88, 0xc7, 0x84, 0xde, 0x87, 0x82,
-	0x9e, 0x6a, 0x83, 0x23, 0x96, 0x75, 0x89, 0x57, 0x39, 0xd9, 0x69, 0xae, 0x75, 0xd1, 0xbc, 0xb0,
-	0xa1, 0x56, 0xee, 0x7a, 0x56, 0xdf, 0xe0, 0x89, 0x91, 0x3e, 0x86, 0x9a, 0x9e, 0x74, 0x74, 0x57,
-	0x05, 0x63, 0x13, 0x84, 0x52, 0xb3, 0x9c, 0x9b, 0xf5, 0xca, 0xad, 0xed, 0xc5, 0xac, 0x9e, 0x0e,
-	0xf0, 0x34, 0xa5, 0x07, 0x50, 0x1e, 0x0a, 0x6d, 0xda, 0x1a, 0x51, 0xb2, 0xbc, 0x4b, 0xbc, 0x2c,
-	0x2f, 0x45, 0xc2, 0x05, 0xa2, 0xa4, 0x0e, 0x80, 0x8f, 0x0a, 0xfb, 0x81, 0x36, 0xa8, 0x58, 0xc1,
-	0x25, 0x5e, 0x89, 0xaf, 0x29, 0xf4, 0x1c, 0x36, 0x97, 0x4c, 0x89, 0xa8, 0x1e, 0x2b, 0xda, 0x86,
-	0x0f, 0x52, 0x0d, 0x9f, 0xa5, 0x2c, 0x49, 0xe3, 0xb7, 0x12, 0xe9, 0x21, 0x6c, 0x5f, 0x22, 0x8e,
-	0xc5, 0x30, 0xb8, 0xc2, 0xb6, 0x09, 0x46, 0x18, 0x4e, 0x0c, 0x2b, 0xb9, 0xc4, 0xab, 0xf1, 0xad,
-	0x55, 0xe0, 0x75, 0xac, 0x53, 0x17, 0x2a, 0x28, 0xaf, 0x02, 0x15, 0xca, 0x11, 0x4a, 0xc3, 0xca,
-	0x76, 0x78, 0xeb, 0x12, 0x6d, 0x40, 0x35, 0x54, 0x7d, 0x21, 0x83, 0x8f, 0x71, 0x5f, 0x60, 0x2d,
-	0x29, 0x8d, 0x52, 0xc8, 0x4d, 0x34, 0x2a, 0x56, 0xb1, 0x31, 0x8b, 0xe9, 0x23, 0xd8, 0xc1, 0x0f,
-	0x06, 0xa5, 0x8f, 0x7e, 0x5b, 0x18, 0xa3, 0x82, 0xce, 0xc4, 0xa0, 0x66, 0x55, 0x97, 0x78, 0xd5,
-	0x56, 0x7e, 0x31, 0xab, 0x93, 0x23, 0x4e, 0x97, 0x8e, 0xd3, 0x95, 0x81, 0xee, 0x41, 0x41, 0xa1,
-	0x2f, 0xba, 0x86, 0xd5, 0xa2, 0xc1, 0xf3, 0x84, 0x35, 0x7e, 0x11, 0x28, 0xc4, 0x0b, 0xa3, 0xfb,
-	0x50, 0x1a, 0x84, 0xda, 0x48, 0x31, 0xc2, 0xf8, 0x12, 0xf8, 0x8a, 0x47, 0xf7, 0x11, 0x26, 0x47,
-	0x10, 0xdf, 0xc7, 0xcb, 0x0b, 0x9e, 0x09, 0x75, 0x94, 0x33, 0x1e, 0x0a, 0xd3, 0x0b, 0x55, 0x7c,
-	0x0b, 0x65, 0xbe, 0xe2, 0xf4, 0x0e, 0xfc, 0xb3, 0xc4, 0xed, 0x9e, 0x18, 0x05, 0xc3, 0x29, 0xcb,
-	0x59, 0xcb, 0xe6, 0x52, 0x7e, 0x66, 0x55, 0x7a, 0x17, 0xb6, 0x56, 0xc6, 0x2b, 0x54, 0x3a, 0x9a,
-	0x47, 0xde, 0x3a, 0x57, 0x05, 0xde, 0xc4, 0x32, 0x7d, 0x08, 0x45, 0x89, 0xe6, 0x7d, 0xa8, 0x2e,
-	0xed, 0xb6, 0x2b, 0x27, 0xbb, 0xa9, 0x4d, 0xbe, 0x88, 0x63, 0xc9, 0x0a, 0x97, 0xd6, 0x68, 0x90,
-	0x42, 0x75, 0x07, 0x76, 0xf9, 0x65, 0x6e, 0x71, 0xe3, 0x1d, 0x14, 0x13, 0x37, 0x7d, 0x05, 0x10,
-	0x48, 0x83, 0xaa, 0x27, 0xba, 0xa8, 0x19, 0x71, 0xb3, 0x5e, 0xe5, 0xe4, 0xbf, 0xbf, 0xd5, 0x3d,
-	0x5f, 0xba, 0x5a, 0x34, 0x7a, 0x60, 0x31, 0xab, 0xaf, 0x25, 0xf2, 0x35, 0xdc, 0x90, 0xb0, 0x75,
-	0x3b, 0x27, 0xea, 0x62, 0x6d, 0xb6, 0x16, 0xd3, 0x7f, 0x21, 0x3b, 0x12, 0xdd, 0x64, 0xb0, 0xc5,
-	0xf9, 0xac, 0x9e, 0x7d, 0x7e, 0xfa, 0x84, 0x47, 0x1a, 0x3d, 0x84, 0xb2, 0xf0, 0x7d, 0x85, 0x5a,
-	0xa3, 0x66, 0x59, 0xfb, 0x6f, 0xa9, 0x2d, 0x66, 0xf5, 0x1b, 0x91, 0xdf, 0xc0, 0xc6, 0x3d, 0xd8,
-	0x4c, 0x5f, 0x31, 0x65, 0x50, 0x1c, 0x08, 0xe9, 0x0f, 0x51, 0x25, 0x0f, 0x2e, 0x69, 0xeb, 0xff,
-	0xdf, 0x3f, 0x1c, 0xf2, 0x65, 0xee, 0x90, 0xaf, 0x73, 0x87, 0x5c, 0xcf, 0x1d, 0xf2, 0x6d, 0xee,
-	0x90, 0xef, 0x73, 0x87, 0x7c, 0xfe, 0xe9, 0x6c, 0xbc, 0xcd, 0xdb, 0x5f, 0xdc, 0x29, 0xd8, 0x4f,
-	0xc4, 0x83, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x10, 0xf5, 0x07, 0xd9, 0x6e, 0x04, 0x00, 0x00,
+	// 983 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6e, 0xe3, 0x54,
+	0x10, 0x5e, 0xc7, 0x6d, 0x12, 0x4f, 0x7e, 0x9a, 0x9e, 0xee, 0x2e, 0xde, 0x96, 0x8d, 0xa3, 0x80,
+	0x44, 0xa0, 0x6c, 0x2a, 0xba, 0x68, 0x61, 0x11, 0x42, 0x34, 0x74, 0x91, 0x2a, 0xfe, 0xb4, 0xa7,
+	0x88, 0x0b, 0x84, 0x14, 0x9d, 0xc4, 0xd3, 0xd4, 0x6a, 0x72, 0x1c, 0x9d, 0x73, 0x52, 0x08, 0x4f,
+	0xc2, 0x23, 0xf0, 0x08, 0x3c, 0xc2, 0x5e, 0xf2, 0x04, 0x16, 0x84, 0x0b, 0xa4, 0x3c, 0x00, 0x42,
+	0xe2, 0x06, 0xf9, 0x1c, 0xdb, 0xb5, 0xb3, 0xbd, 0xd9, 0xbb, 0xf9, 0xbe, 0xf9, 0x66, 0xce, 0x64,
+	0x3c, 0x33, 0x81, 0x3a, 0x72, 0x15, 0xa8, 0x65, 0x7f, 0x2e, 0x42, 0x15, 0x92, 0x9a, 0x44, 0x2e,
+	0x17, 0x7d, 0xb5, 0x9c, 0xa3, 0xdc, 0x7f, 0x34, 0x09, 0xd4, 0xe5, 0x62, 0xd4, 0x1f, 0x87, 0xb3,
+	0xa3, 0x49, 0x38, 0x09, 0x8f, 0xb4, 0x66, 0xb4, 0xb8, 0xd0, 0x48, 0x03, 0x6d, 0x99, 0xd8, 0xee,
+	0xdf, 0x65, 0x28, 0x3f, 0xd3, 0xc9, 0xc8, 0x7d, 0x28, 0x05, 0xbe, 0x6b, 0x75, 0xac, 0x9e, 0x33,
+	0x28, 0xaf, 0x22, 0xaf, 0x74, 0x76, 0x4a, 0x4b, 0x81, 0x4f, 0xee, 0xc2, 0xf6, 0x78, 0xca, 0xa4,
+	0x74, 0x4b, 0xb1, 0x8b, 0x1a, 0x40, 0xde, 0x83, 0xb2, 0x5c, 0x4a, 0x85, 0x33, 0xd7, 0xee, 0x58,
+	0xbd, 0xda, 0xf1, 0x5e, 0x3f, 0x57, 0x45, 0xff, 0x5c, 0xbb, 0x06, 0x5b, 0x2f, 0x22, 0xef, 0x0e,
+	0x4d, 0x84, 0xe4, 0x03, 0x68, 0xc8, 0xc5, 0x48, 0x8e, 0x45, 0x30, 0x57, 0x41, 0xc8, 0xa5, 0xbb,
+	0xd5, 0xb1, 0x7b, 0xce, 0x60, 0x77, 0x1d, 0x79, 0x45, 0x07, 0x2d, 0x42, 0x72, 0x00, 0xce, 0x94,
+	0x49, 0x35, 0x94, 0x88, 0xdc, 0xdd, 0xee, 0x58, 0x3d, 0x9b, 0x56, 0x63, 0xe2, 0x1c, 0x91, 0x93,
+	0x36, 0x80, 0x8f, 0x02, 0x27, 0x81, 0x54, 0x28, 0xdc, 0x72, 0xc7, 0xea, 0x55, 0x69, 0x8e, 0x21,
+	0x67, 0xd0, 0x4c, 0x91, 0x60, 0x71, 0x3e, 0xb7, 0xa2, 0x0b, 0x3e, 0x28, 0x14, 0x7c, 0x5a, 0x90,
+	0x24, 0x85, 0x6f, 0x04, 0x92, 0x43, 0xd8, 0xbd, 0x42, 0x9c, 0xb3, 0x69, 0x70, 0x8d, 0x43, 0x15,
+	0xcc, 0x30, 0x5c, 0x28, 0xb7, 0xda, 0xb1, 0x7a, 0x0d, 0xda, 0xca, 0x1c, 0xdf, 0x1a, 0x9e, 0x74,
+	0xa0, 0x86, 0xfc, 0x3a, 0x10, 0x21, 0x9f, 0x21, 0x57, 0xae, 0xa3, 0x9b, 0x97, 0xa7, 0x48, 0x17,
+	0xea, 0xa1, 0x98, 0x30, 0x1e, 0xfc, 0x6c, 0xea, 0x02, 0x2d, 0x29, 0x70, 0x84, 0xc0, 0xd6, 0x42,
+	0xa2, 0x70, 0x6b, 0xda, 0xa7, 0x6d, 0xf2, 0x04, 0xf6, 0xf0, 0x27, 0x85, 0xdc, 0x47, 0x7f, 0xc8,
+	0x94, 0x12, 0xc1, 0x68, 0xa1, 0x50, 0xba, 0xf5, 0x8e, 0xd5, 0xab, 0x0f, 0xb6, 0xd7, 0x91, 0x67,
+	0x3d, 0xa2, 0x24, 0x55, 0x9c, 0x64, 0x02, 0x72, 0x1f, 0xca, 0x02, 0x7d, 0x36, 0x56, 0x6e, 0x23,
+	0x6e, 0x3c, 0x4d, 0x10, 0x39, 0x82, 0xca, 0x9c, 0x09, 0x15, 0xb0, 0xa9, 0xdb, 0x8c, 0xdb, 0x37,
+	0xb8, 0xb7, 0x8e, 0xbc, 0xdd, 0x84, 0x7a, 0x37, 0x9c, 0x05, 0x0a, 0x67, 0x73, 0xb5, 0xa4, 0xa9,
+	0x8a, 0x3c, 0x87, 0xf2, 0x94, 0x8d, 0x70, 0x2a, 0xdd, 0x9d, 0x8e, 0xdd, 0xab, 0x1d, 0x7b, 0x85,
+	0x56, 0x9a, 0x71, 0xea, 0x7f, 0xa9, 0x15, 0xcf, 0xb8, 0x12, 0xcb, 0x81, 0x1b, 0xb7, 0x73, 0x1d,
+	0x79, 0x2d, 0x13, 0x96, 0xcb, 0x99, 0x24, 0x22, 0x08, 0x35, 0xc6, 0x79, 0xa8, 0x98, 0x99, 0x8c,
+	0x96, 0xce, 0xfb, 0xe6, 0x6d, 0x79, 0x4f, 0x6e, 0x64, 0x26, 0xf9, 0xc3, 0x24, 0xf9, 0xbd, 0x5c,
+	0x82, 0xdc, 0x0b, 0xf9, 0xbc, 0xfb, 0x4f, 0xa1, 0x96, 0xab, 0x8b, 0xb4, 0xc0, 0xbe, 0xc2, 0xa5,
+	0x99, 0x79, 0x1a, 0x9b, 0xf1, 0xb0, 0x5f, 0xb3, 0xe9, 0x02, 0xd3, 0x61, 0xd7, 0xe0, 0xa3, 0xd2,
+	0x87, 0xd6, 0xfe, 0x27, 0xd0, 0xda, 0x7c, 0xfa, 0x55, 0xe2, 0xbb, 0xff, 0x58, 0x50, 0x36, 0x6b,
+	0x41, 0xf6, 0xa1, 0x7a, 0x19, 0x4a, 0xc5, 0xd9, 0x0c, 0x93, 0xd8, 0x0c, 0xc7, 0x5b, 0x18, 0x26,
+	0xab, 0x66, 0xb6, 0xf0, 0x9b, 0x73, 0x5a, 0x0a, 0x65, 0x1c, 0x33, 0x9f, 0x32, 0x75, 0x11, 0x0a,
+	0xb3, 0x71, 0x0e, 0xcd, 0x30, 0x79, 0x0b, 0x76, 0x52, 0x7b, 0x78, 0xc1, 0x66, 0xc1, 0x74, 0xe9,
+	0x6e, 0x69, 0x49, 0x33, 0xa5, 0x3f, 0xd7, 0x2c, 0x79, 0x1b, 0x5a, 0x99, 0xf0, 0x1a, 0x85, 0x0c,
+	0x42, 0xb3, 0x4f, 0x0e, 0xcd, 0x12, 0x7c, 0x67, 0x68, 0xf2, 0x3e, 0x54, 0x38, 0xaa, 0x1f, 0x43,
+	0x71, 0xa5, 0x77, 0xaa, 0x76, 0x7c, 0xb7, 0xf0, 0x31, 0xbe, 0x36, 0xbe, 0x64, 0x51, 0x52, 0x69,
+	0x3c, 0xae, 0x4c, 0x8c, 0x2f, 0xf5, 0x8a, 0x39, 0x54, 0xdb, 0xdd, 0x1f, 0xa0, 0x92, 0xa8, 0xc9,
+	0x73, 0x80, 0x80, 0x2b, 0x14, 0x17, 0x6c, 0x8c, 0xd2, 0xb5, 0xf4, 0x47, 0x7e, 0x78, 0x5b, 0xde,
+	0xb3, 0x54, 0x35, 0x20, 0xc9, 0xd7, 0xcd, 0x05, 0xd2, 0x9c, 0xdd, 0xe5, 0xd0, 0xda, 0x8c, 0x89,
+	0xab, 0xc8, 0xf5, 0x56, 0xdb, 0xe4, 0x01, 0xd8, 0x33, 0x36, 0x4e, 0x1a, 0x5b, 0x59, 0x45, 0x9e,
+	0xfd, 0xd5, 0xc9, 0x67, 0x34, 0xe6, 0xc8, 0x21, 0x38, 0xcc, 0xf7, 0x05, 0x4a, 0x89, 0xd2, 0xb5,
+	0xf5, 0x4d, 0x6a, 0xac, 0x23, 0xef, 0x86, 0xa4, 0x37, 0x66, 0xf7, 0x1d, 0x68, 0x16, 0x6f, 0x05,
+	0x71, 0xa1, 0x72, 0xc9, 0xb8, 0x3f, 0x45, 0x91, 0x3c, 0x98, 0xc2, 0xee, 0x7f, 0x36, 0xd4, 0xcd,
+	0xd4, 0x9e, 0x2b, 0xa6, 0x16, 0x92, 0x3c, 0x01, 0xc7, 0x5c, 0xee, 0x61, 0x76, 0x69, 0x1f, 0xac,
+	0x22, 0xaf, 0x6a, 0x44, 0x67, 0xa7, 0xf1, 0xab, 0x99, 0x80, 0x56, 0x8d, 0x79, 0xe6, 0xbf, 0x74,
+	0x29, 0x4a, 0xb7, 0x5c, 0x8a, 0x8d, 0x7b, 0x63, 0xbf, 0x7c, 0x6f, 0x0e, 0xc1, 0x19, 0x87, 0x9c,
+	0xe3, 0x58, 0xa1, 0xaf, 0x07, 0xa4, 0x6a, 0x7e, 0x67, 0x46, 0xd2, 0x1b, 0x33, 0xfe, 0x55, 0x23,
+	0x36, 0xbe, 0x42, 0xee, 0x27, 0x13, 0x92, 0xc2, 0xd8, 0x23, 0x51, 0xea, 0xd9, 0x29, 0x1b, 0x4f,
+	0x02, 0xc9, 0xeb, 0xe0, 0x28, 0xc1, 0xb8, 0x9c, 0x87, 0x42, 0x25, 0x23, 0x70, 0x43, 0x90, 0x4f,
+	0xa1, 0xc1, 0x26, 0xc8, 0x55, 0x36, 0x79, 0x55, 0xdd, 0x80, 0x83, 0x75, 0xe4, 0xbd, 0x56, 0x70,
+	0xe4, 0x96, 0xb7, 0xae, 0x1d, 0xe9, 0x4c, 0x3e, 0x86, 0x7a, 0x56, 0xe0, 0x90, 0x99, 0x9b, 0x6a,
+	0x0f, 0x5a, 0xeb, 0xc8, 0x2b, 0xf0, 0xb4, 0x96, 0xa1, 0x13, 0x45, 0x3e, 0x86, 0x1d, 0x3f, 0x90,
+	0x85, 0x38, 0xd0, 0x71, 0x7b, 0xeb, 0xc8, 0xdb, 0x74, 0xd1, 0x66, 0x9e, 0x38, 0x51, 0xe4, 0x29,
+	0x34, 0xf5, 0x5f, 0x4f, 0x76, 0xde, 0xf5, 0x25, 0xb6, 0x07, 0x64, 0x1d, 0x79, 0x1b, 0x1e, 0xda,
+	0x88, 0xf1, 0x17, 0x29, 0x1c, 0xbc, 0xf1, 0xef, 0x9f, 0x6d, 0xeb, 0xd7, 0x55, 0xdb, 0xfa, 0x6d,
+	0xd5, 0xb6, 0x5e, 0xac, 0xda, 0xd6, 0xef, 0xab, 0xb6, 0xf5, 0xc7, 0xaa, 0x6d, 0xfd, 0xf2, 0x57,
+	0xfb, 0xce, 0xf7, 0xdb, 0x7a, 0xde, 0x47, 0x65, 0xfd, 0x37, 0xfc, 0xf8, 0xff, 0x01, 0x00, 0x00,
+	0x02, 0x42, 0x6d, 0xd2, 0x07, 0x00, 0x00,
 }
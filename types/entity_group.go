@@ -0,0 +1,114 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+// Validate returns an error if the entity group does not pass validation
+// tests.
+func (g *EntityGroup) Validate() error {
+	if err := ValidateName(g.Name); err != nil {
+		return errors.New("entity group name " + err.Error())
+	}
+
+	if g.Environment == "" {
+		return errors.New("entity group environment must be set")
+	}
+
+	if g.Organization == "" {
+		return errors.New("entity group organization must be set")
+	}
+
+	for _, member := range g.Members {
+		if err := ValidateName(member); err != nil {
+			return fmt.Errorf("entity group member %q %s", member, err)
+		}
+	}
+
+	return nil
+}
+
+// Update updates g with selected fields. Returns non-nil error if any of the
+// selected fields are unsupported.
+func (g *EntityGroup) Update(from *EntityGroup, fields ...string) error {
+	for _, f := range fields {
+		switch f {
+		case "Description":
+			g.Description = from.Description
+		case "Selector":
+			g.Selector = from.Selector
+		case "Members":
+			g.Members = append(g.Members[0:0], from.Members...)
+		default:
+			return fmt.Errorf("unsupported field: %q", f)
+		}
+	}
+	return nil
+}
+
+// Matches returns whether the entity belongs to the entity group, either as
+// one of its members or because its labels satisfy the selector.
+func (g *EntityGroup) Matches(entity *Entity) bool {
+	if entity.Organization != g.Organization || entity.Environment != g.Environment {
+		return false
+	}
+
+	for _, member := range g.Members {
+		if member == entity.ID {
+			return true
+		}
+	}
+
+	if len(g.Selector) == 0 {
+		return false
+	}
+	for key, value := range g.Selector {
+		if v, ok := entity.Labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns the entities belonging to the entity group.
+func (g *EntityGroup) Filter(entities []*Entity) []*Entity {
+	result := []*Entity{}
+	for _, entity := range entities {
+		if g.Matches(entity) {
+			result = append(result, entity)
+		}
+	}
+	return result
+}
+
+// GetEntityGroupSubscription returns the subscription of the entities of an
+// entity group, using the format "group:name", which silenced entries can
+// target.
+func GetEntityGroupSubscription(name string) string {
+	return fmt.Sprintf("group:%s", name)
+}
+
+// SetNamespace sets the organization and environment of the entity group.
+func (g *EntityGroup) SetNamespace(org, env string) {
+	g.Organization = org
+	g.Environment = env
+}
+
+// URIPath returns the path of the entity group, relative to the API root.
+func (g *EntityGroup) URIPath() string {
+	return path.Join("/entitygroups", url.PathEscape(g.Name))
+}
+
+// FixtureEntityGroup returns an EntityGroup fixture for testing.
+func FixtureEntityGroup(name string) *EntityGroup {
+	return &EntityGroup{
+		Name:         name,
+		Selector:     map[string]string{"role": "web"},
+		Members:      []string{},
+		Environment:  "default",
+		Organization: "default",
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityGroupValidate(t *testing.T) {
+	g := FixtureEntityGroup("webservers")
+	assert.NoError(t, g.Validate())
+
+	g.Name = "web servers"
+	assert.Error(t, g.Validate())
+	g.Name = "webservers"
+
+	g.Members = []string{"foo bar"}
+	assert.Error(t, g.Validate())
+	g.Members = []string{"foo"}
+
+	g.Environment = ""
+	assert.Error(t, g.Validate())
+}
+
+func TestEntityGroupMatches(t *testing.T) {
+	g := FixtureEntityGroup("webservers")
+
+	web := FixtureEntity("web")
+	web.Labels = map[string]string{"role": "web", "dc": "east"}
+	db := FixtureEntity("db")
+	db.Labels = map[string]string{"role": "db"}
+	none := FixtureEntity("none")
+
+	assert.True(t, g.Matches(web))
+	assert.False(t, g.Matches(db))
+	assert.False(t, g.Matches(none))
+
+	g.Members = []string{"db"}
+	assert.True(t, g.Matches(db))
+	assert.Equal(t, []*Entity{web, db}, g.Filter([]*Entity{web, db, none}))
+
+	// An empty selector only matches the members
+	g.Selector = nil
+	assert.False(t, g.Matches(web))
+
+	// The entities of other namespaces never match
+	db.Environment = "dev"
+	assert.False(t, g.Matches(db))
+}
+
+func TestEntityLabelsJSON(t *testing.T) {
+	entity := FixtureEntity("web")
+	entity.Labels = map[string]string{"role": "web"}
+
+	b, err := json.Marshal(entity)
+	require.NoError(t, err)
+
+	var decoded Entity
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, entity.Labels, decoded.Labels)
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"errors"
+	"sort"
+)
+
+// EntityMetadataPatchType is the message type of the changes to the labels and
+// annotations of an entity, pushed by the backend to the agent of the entity.
+const EntityMetadataPatchType = "entity_metadata_patch"
+
+// EntityMetadataPatch changes the labels and annotations of an entity: the
+// given labels and annotations are added or replaced, and the removed ones are
+// deleted.
+type EntityMetadataPatch struct {
+	// Labels are the labels to add or replace
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are the annotations to add or replace
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// RemoveLabels are the keys of the labels to delete
+	RemoveLabels []string `json:"remove_labels,omitempty"`
+
+	// RemoveAnnotations are the keys of the annotations to delete
+	RemoveAnnotations []string `json:"remove_annotations,omitempty"`
+}
+
+// Validate returns an error if the patch doesn't change anything or has an
+// empty key.
+func (p *EntityMetadataPatch) Validate() error {
+	if len(p.Labels) == 0 && len(p.Annotations) == 0 && len(p.RemoveLabels) == 0 && len(p.RemoveAnnotations) == 0 {
+		return errors.New("the patch must change at least one label or annotation")
+	}
+
+	for _, m := range []map[string]string{p.Labels, p.Annotations} {
+		for key := range m {
+			if key == "" {
+				return errors.New("the keys of the labels and annotations can't be empty")
+			}
+		}
+	}
+
+	for _, keys := range [][]string{p.RemoveLabels, p.RemoveAnnotations} {
+		for _, key := range keys {
+			if key == "" {
+				return errors.New("the keys of the labels and annotations can't be empty")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Apply changes the labels and annotations of the entity. The maps of the
+// entity are replaced rather than modified.
+func (p *EntityMetadataPatch) Apply(entity *Entity) {
+	entity.Labels = patchMetadata(entity.Labels, p.Labels, p.RemoveLabels)
+	entity.Annotations = patchMetadata(entity.Annotations, p.Annotations, p.RemoveAnnotations)
+}
+
+// Merge adds the changes of the next patch to the patch, so that applying the
+// merged patch is the same as applying both patches in order.
+func (p *EntityMetadataPatch) Merge(next *EntityMetadataPatch) {
+	p.Labels = patchMetadata(p.Labels, next.Labels, next.RemoveLabels)
+	p.Annotations = patchMetadata(p.Annotations, next.Annotations, next.RemoveAnnotations)
+	p.RemoveLabels = mergeRemovedKeys(p.RemoveLabels, next.RemoveLabels, next.Labels)
+	p.RemoveAnnotations = mergeRemovedKeys(p.RemoveAnnotations, next.RemoveAnnotations, next.Annotations)
+}
+
+// patchMetadata returns a copy of the key-value pairs with the given pairs
+// added and the removed keys deleted. The result is nil if there's no pair
+// left.
+func patchMetadata(current, set map[string]string, remove []string) map[string]string {
+	result := make(map[string]string, len(current)+len(set))
+	for key, value := range current {
+		result[key] = value
+	}
+	for _, key := range remove {
+		delete(result, key)
+	}
+	for key, value := range set {
+		result[key] = value
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// mergeRemovedKeys returns the sorted union of the removed keys, except the
+// ones set again.
+func mergeRemovedKeys(current, remove []string, set map[string]string) []string {
+	keys := make(map[string]bool, len(current)+len(remove))
+	for _, key := range current {
+		keys[key] = true
+	}
+	for _, key := range remove {
+		keys[key] = true
+	}
+	for key := range set {
+		delete(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+	result := make([]string, 0, len(keys))
+	for key := range keys {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
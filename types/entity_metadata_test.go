@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntityMetadataPatchValidate(t *testing.T) {
+	p := &EntityMetadataPatch{}
+	assert.Error(t, p.Validate())
+
+	p = &EntityMetadataPatch{Labels: map[string]string{"": "ops"}}
+	assert.Error(t, p.Validate())
+
+	p = &EntityMetadataPatch{RemoveAnnotations: []string{""}}
+	assert.Error(t, p.Validate())
+
+	p = &EntityMetadataPatch{Labels: map[string]string{"team": "ops"}, RemoveAnnotations: []string{"owner"}}
+	assert.NoError(t, p.Validate())
+}
+
+func TestEntityMetadataPatchApply(t *testing.T) {
+	labels := map[string]string{"team": "dev", "region": "us-west-2"}
+	entity := FixtureEntity("entity1")
+	entity.Labels = labels
+	entity.Annotations = map[string]string{"owner": "alice"}
+
+	p := &EntityMetadataPatch{
+		Labels:            map[string]string{"team": "ops"},
+		RemoveLabels:      []string{"region"},
+		RemoveAnnotations: []string{"owner"},
+	}
+	p.Apply(entity)
+
+	assert.Equal(t, map[string]string{"team": "ops"}, entity.Labels)
+	assert.Nil(t, entity.Annotations)
+
+	// The original maps aren't modified
+	assert.Equal(t, map[string]string{"team": "dev", "region": "us-west-2"}, labels)
+}
+
+func TestEntityMetadataPatchMerge(t *testing.T) {
+	p := &EntityMetadataPatch{
+		Labels:       map[string]string{"team": "ops"},
+		RemoveLabels: []string{"region"},
+	}
+	p.Merge(&EntityMetadataPatch{
+		Labels:            map[string]string{"region": "eu-west-1"},
+		Annotations:       map[string]string{"owner": "bob"},
+		RemoveLabels:      []string{"team"},
+		RemoveAnnotations: []string{"runbook"},
+	})
+
+	assert.Equal(t, map[string]string{"region": "eu-west-1"}, p.Labels)
+	assert.Equal(t, map[string]string{"owner": "bob"}, p.Annotations)
+	assert.Equal(t, []string{"team"}, p.RemoveLabels)
+	assert.Equal(t, []string{"runbook"}, p.RemoveAnnotations)
+
+	entity := FixtureEntity("entity1")
+	entity.Labels = map[string]string{"team": "dev", "region": "us-west-2", "tier": "web"}
+	p.Apply(entity)
+	assert.Equal(t, map[string]string{"region": "eu-west-1", "tier": "web"}, entity.Labels)
+}
@@ -78,3 +78,19 @@ func TestEntityMarshal(t *testing.T) {
 	assert.Contains(t, string(bytes), "foo")
 	assert.Contains(t, string(bytes), "bar")
 }
+
+func TestEntityStatusValidate(t *testing.T) {
+	status := FixtureEntityStatus("entity1")
+	assert.NoError(t, status.Validate())
+
+	status.EntityID = ""
+	assert.Error(t, status.Validate())
+	status.EntityID = "entity1"
+
+	status.Organization = ""
+	assert.Error(t, status.Validate())
+	status.Organization = "default"
+
+	status.Environment = ""
+	assert.Error(t, status.Validate())
+}
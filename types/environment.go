@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	fmt "fmt"
+	"time"
 )
 
 // Validate returns an error if the environment does not pass validation tests.
@@ -15,9 +16,48 @@ func (e *Environment) Validate() error {
 		return errors.New("environment organization must be set")
 	}
 
+	if _, err := time.LoadLocation(e.TimeZone); err != nil {
+		return fmt.Errorf("environment time zone %s", err)
+	}
+
+	if e.BusinessHours != nil {
+		if e.BusinessHours.BusinessHours {
+			return errors.New("environment business hours can't refer to the business hours")
+		}
+		if err := e.BusinessHours.Validate(); err != nil {
+			return fmt.Errorf("environment business hours %s", err)
+		}
+	}
+
 	return nil
 }
 
+// TimeWindows returns the time windows as evaluated in the environment, which
+// are the business hours of the environment if when refers to them. The time
+// windows are expressed in the time zone of the environment, unless they
+// specify their own. The environment can be nil, in which case the time
+// windows are returned as they are.
+func (e *Environment) TimeWindows(when *TimeWindowWhen) (*TimeWindowWhen, error) {
+	if when.BusinessHours {
+		if e == nil || e.BusinessHours == nil {
+			return nil, errors.New("no business hours defined for the environment")
+		}
+		resolved := *e.BusinessHours
+		if when.TimeZone != "" {
+			resolved.TimeZone = when.TimeZone
+		}
+		when = &resolved
+	}
+
+	if e == nil || when.TimeZone != "" || e.TimeZone == "" {
+		return when, nil
+	}
+
+	resolved := *when
+	resolved.TimeZone = e.TimeZone
+	return &resolved, nil
+}
+
 // FixtureEnvironment returns a mocked environment.
 func FixtureEnvironment(name string) *Environment {
 	return &Environment{
@@ -37,6 +77,14 @@ func (e *Environment) Update(from *Environment, fields ...string) error {
 		switch f {
 		case "Description":
 			e.Description = from.Description
+		case "Labels":
+			e.Labels = from.Labels
+		case "Annotations":
+			e.Annotations = from.Annotations
+		case "TimeZone":
+			e.TimeZone = from.TimeZone
+		case "BusinessHours":
+			e.BusinessHours = from.BusinessHours
 		default:
 			return fmt.Errorf("unsupported update field: %q", f)
 		}
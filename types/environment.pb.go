@@ -20,6 +20,18 @@ type Environment struct {
 	Description  string `protobuf:"bytes,1,opt,name=description,proto3" json:"description"`
 	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Organization string `protobuf:"bytes,3,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Labels are key-value pairs inherited by the events of the environment.
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Annotations are key-value pairs inherited by the events of the
+	// environment, which aren't meant to be used for routing (e.g. runbooks).
+	Annotations map[string]string `protobuf:"bytes,5,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// TimeZone is the IANA time zone name (e.g. Europe/Paris) of the
+	// environment, in which the time windows of its resources not specifying a
+	// time zone are expressed. The time windows are in UTC if it is empty.
+	TimeZone string `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// BusinessHours are the business hours of the environment, used by the time
+	// windows of its resources which refer to them.
+	BusinessHours *TimeWindowWhen `protobuf:"bytes,7,opt,name=business_hours,json=businessHours" json:"business_hours,omitempty"`
 }
 
 func (m *Environment) Reset()                    { *m = Environment{} }
@@ -48,6 +60,34 @@ func (m *Environment) GetOrganization() string {
 	return ""
 }
 
+func (m *Environment) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Environment) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *Environment) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
+func (m *Environment) GetBusinessHours() *TimeWindowWhen {
+	if m != nil {
+		return m.BusinessHours
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Environment)(nil), "sensu.types.Environment")
 }
@@ -85,6 +125,28 @@ func (this *Environment) Equal(that interface{}) bool {
 	if this.Organization != that1.Organization {
 		return false
 	}
+	if len(this.Labels) != len(that1.Labels) {
+		return false
+	}
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
+	}
+	if len(this.Annotations) != len(that1.Annotations) {
+		return false
+	}
+	for i := range this.Annotations {
+		if this.Annotations[i] != that1.Annotations[i] {
+			return false
+		}
+	}
+	if this.TimeZone != that1.TimeZone {
+		return false
+	}
+	if !this.BusinessHours.Equal(that1.BusinessHours) {
+		return false
+	}
 	return true
 }
 func (m *Environment) Marshal() (dAtA []byte, err error) {
@@ -120,6 +182,56 @@ func (m *Environment) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintEnvironment(dAtA, i, uint64(len(m.Organization)))
 		i += copy(dAtA[i:], m.Organization)
 	}
+	if len(m.Labels) > 0 {
+		for k, _ := range m.Labels {
+			dAtA[i] = 0x22
+			i++
+			v := m.Labels[k]
+			mapSize := 1 + len(k) + sovEnvironment(uint64(len(k))) + 1 + len(v) + sovEnvironment(uint64(len(v)))
+			i = encodeVarintEnvironment(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintEnvironment(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintEnvironment(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, _ := range m.Annotations {
+			dAtA[i] = 0x2a
+			i++
+			v := m.Annotations[k]
+			mapSize := 1 + len(k) + sovEnvironment(uint64(len(k))) + 1 + len(v) + sovEnvironment(uint64(len(v)))
+			i = encodeVarintEnvironment(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintEnvironment(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintEnvironment(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.TimeZone) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintEnvironment(dAtA, i, uint64(len(m.TimeZone)))
+		i += copy(dAtA[i:], m.TimeZone)
+	}
+	if m.BusinessHours != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintEnvironment(dAtA, i, uint64(m.BusinessHours.Size()))
+		n1, err := m.BusinessHours.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
 	return i, nil
 }
 
@@ -137,6 +249,24 @@ func NewPopulatedEnvironment(r randyEnvironment, easy bool) *Environment {
 	this.Description = string(randStringEnvironment(r))
 	this.Name = string(randStringEnvironment(r))
 	this.Organization = string(randStringEnvironment(r))
+	if r.Intn(10) != 0 {
+		v1 := r.Intn(10)
+		this.Labels = make(map[string]string)
+		for i := 0; i < v1; i++ {
+			this.Labels[randStringEnvironment(r)] = randStringEnvironment(r)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v2 := r.Intn(10)
+		this.Annotations = make(map[string]string)
+		for i := 0; i < v2; i++ {
+			this.Annotations[randStringEnvironment(r)] = randStringEnvironment(r)
+		}
+	}
+	this.TimeZone = string(randStringEnvironment(r))
+	if r.Intn(10) != 0 {
+		this.BusinessHours = NewPopulatedTimeWindowWhen(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -161,9 +291,9 @@ func randUTF8RuneEnvironment(r randyEnvironment) rune {
 	return rune(ru + 61)
 }
 func randStringEnvironment(r randyEnvironment) string {
-	v1 := r.Intn(100)
-	tmps := make([]rune, v1)
-	for i := 0; i < v1; i++ {
+	v3 := r.Intn(100)
+	tmps := make([]rune, v3)
+	for i := 0; i < v3; i++ {
 		tmps[i] = randUTF8RuneEnvironment(r)
 	}
 	return string(tmps)
@@ -185,11 +315,11 @@ func randFieldEnvironment(dAtA []byte, r randyEnvironment, fieldNumber int, wire
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateEnvironment(dAtA, uint64(key))
-		v2 := r.Int63()
+		v4 := r.Int63()
 		if r.Intn(2) == 0 {
-			v2 *= -1
+			v4 *= -1
 		}
-		dAtA = encodeVarintPopulateEnvironment(dAtA, uint64(v2))
+		dAtA = encodeVarintPopulateEnvironment(dAtA, uint64(v4))
 	case 1:
 		dAtA = encodeVarintPopulateEnvironment(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -229,6 +359,30 @@ func (m *Environment) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEnvironment(uint64(l))
 	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEnvironment(uint64(len(k))) + 1 + len(v) + sovEnvironment(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEnvironment(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEnvironment(uint64(len(k))) + 1 + len(v) + sovEnvironment(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEnvironment(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovEnvironment(uint64(l))
+	}
+	if m.BusinessHours != nil {
+		l = m.BusinessHours.Size()
+		n += 1 + l + sovEnvironment(uint64(l))
+	}
 	return n
 }
 
@@ -361,6 +515,304 @@ func (m *Environment) Unmarshal(dAtA []byte) error {
 			}
 			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnvironment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnvironment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEnvironment
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEnvironment
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEnvironment
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEnvironment
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEnvironment
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEnvironment(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEnvironment
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnvironment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnvironment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEnvironment
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEnvironment
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEnvironment
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEnvironment
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEnvironment
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEnvironment(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEnvironment
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnvironment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnvironment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BusinessHours", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnvironment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnvironment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BusinessHours == nil {
+				m.BusinessHours = &TimeWindowWhen{}
+			}
+			if err := m.BusinessHours.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEnvironment(dAtA[iNdEx:])
@@ -490,18 +942,32 @@ var (
 func init() { proto.RegisterFile("environment.proto", fileDescriptorEnvironment) }
 
 var fileDescriptorEnvironment = []byte{
-	// 200 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4c, 0xcd, 0x2b, 0xcb,
-	0x2c, 0xca, 0xcf, 0xcb, 0x4d, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2e,
-	0x4e, 0xcd, 0x2b, 0x2e, 0xd5, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x49, 0x2a,
-	0x4d, 0x03, 0xf3, 0xc0, 0x1c, 0x30, 0x0b, 0xa2, 0x57, 0xa9, 0x82, 0x8b, 0xdb, 0x15, 0x61, 0xa0,
-	0x90, 0x21, 0x17, 0x77, 0x4a, 0x6a, 0x71, 0x72, 0x51, 0x66, 0x41, 0x49, 0x66, 0x7e, 0x9e, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x13, 0xff, 0xab, 0x7b, 0xf2, 0xc8, 0xc2, 0x41, 0xc8, 0x1c, 0x21,
-	0x21, 0x2e, 0x96, 0xbc, 0xc4, 0xdc, 0x54, 0x09, 0x26, 0x90, 0xda, 0x20, 0x30, 0x5b, 0x48, 0x89,
-	0x8b, 0x27, 0xbf, 0x28, 0x3d, 0x31, 0x2f, 0xb3, 0x2a, 0x11, 0x6c, 0x0e, 0x33, 0x58, 0x0e, 0x45,
-	0xcc, 0x49, 0xf9, 0xc7, 0x43, 0x39, 0xc6, 0x15, 0x8f, 0xe4, 0x18, 0x77, 0x3c, 0x92, 0x63, 0x3c,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x67, 0x3c, 0x96, 0x63,
-	0x88, 0x62, 0x05, 0xfb, 0x26, 0x89, 0x0d, 0xec, 0x4a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xfa, 0x34, 0x46, 0xc0, 0xf6, 0x00, 0x00, 0x00,
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0xae, 0xd3, 0x30,
+	0x10, 0x7e, 0x7e, 0xfd, 0x81, 0xe7, 0xf0, 0xd3, 0x67, 0x40, 0x58, 0x01, 0x92, 0xaa, 0xb0, 0x28,
+	0x12, 0xa4, 0xa2, 0xb0, 0x00, 0x16, 0x48, 0x44, 0xaa, 0xc4, 0x82, 0x55, 0x84, 0xa8, 0xd4, 0x4d,
+	0x49, 0x5a, 0x93, 0x1a, 0x1a, 0x3b, 0x8a, 0x9d, 0x56, 0xe9, 0x49, 0x38, 0x02, 0x47, 0xe0, 0x08,
+	0xdd, 0xc1, 0x09, 0x22, 0x08, 0xbb, 0x9c, 0x80, 0x25, 0x8a, 0xd3, 0x52, 0x17, 0x89, 0x05, 0xbb,
+	0x99, 0x6f, 0xe6, 0xfb, 0x3e, 0xcf, 0x8c, 0xe1, 0x39, 0x61, 0x2b, 0x9a, 0x70, 0x16, 0x11, 0x26,
+	0x9d, 0x38, 0xe1, 0x92, 0x23, 0x43, 0x10, 0x26, 0x52, 0x47, 0x66, 0x31, 0x11, 0xe6, 0xc3, 0x90,
+	0xca, 0x45, 0x1a, 0x38, 0x33, 0x1e, 0x0d, 0x42, 0x1e, 0xf2, 0x81, 0xea, 0x09, 0xd2, 0xf7, 0x2a,
+	0x53, 0x89, 0x8a, 0x6a, 0xae, 0x79, 0x2e, 0x69, 0x44, 0xa6, 0x6b, 0xca, 0xe6, 0x7c, 0x5d, 0x43,
+	0xbd, 0xaf, 0x4d, 0x68, 0x8c, 0x0e, 0x26, 0xe8, 0x11, 0x34, 0xe6, 0x44, 0xcc, 0x12, 0x1a, 0x4b,
+	0xca, 0x19, 0x06, 0x5d, 0xd0, 0x3f, 0x73, 0xaf, 0x96, 0xb9, 0xad, 0xc3, 0x9e, 0x9e, 0x20, 0x04,
+	0x9b, 0xcc, 0x8f, 0x08, 0x3e, 0xad, 0x7a, 0x3d, 0x15, 0xa3, 0x1e, 0xbc, 0xc4, 0x93, 0xd0, 0x67,
+	0x74, 0xe3, 0x2b, 0x9d, 0x86, 0xaa, 0x1d, 0x61, 0xe8, 0x2d, 0x6c, 0x2f, 0xfd, 0x80, 0x2c, 0x05,
+	0x6e, 0x76, 0x1b, 0x7d, 0x63, 0x78, 0xcf, 0xd1, 0x46, 0x73, 0xb4, 0x47, 0x39, 0xaf, 0x55, 0xdb,
+	0x88, 0xc9, 0x24, 0x73, 0xf1, 0x36, 0xb7, 0x4f, 0xca, 0xdc, 0xee, 0xd4, 0xdc, 0x07, 0x3c, 0xa2,
+	0x92, 0x44, 0xb1, 0xcc, 0xbc, 0x9d, 0x1a, 0xfa, 0x00, 0x0d, 0x9f, 0x31, 0x2e, 0x95, 0x8b, 0xc0,
+	0x2d, 0x25, 0x7e, 0xff, 0x9f, 0xe2, 0x2f, 0x0f, 0xbd, 0xb5, 0xc3, 0x9d, 0x9d, 0xc3, 0x0d, 0x4d,
+	0x45, 0xb3, 0xd1, 0xc5, 0xd1, 0x13, 0x78, 0xa6, 0x76, 0xba, 0xe1, 0x8c, 0xe0, 0xb6, 0x5a, 0xd6,
+	0xcd, 0x32, 0xb7, 0xaf, 0xfd, 0x01, 0x35, 0xe2, 0xc5, 0x0a, 0x9c, 0x70, 0x46, 0xd0, 0x3b, 0x78,
+	0x25, 0x48, 0x05, 0x65, 0x44, 0x88, 0xe9, 0x82, 0xa7, 0x89, 0xc0, 0x17, 0xba, 0xa0, 0x6f, 0x0c,
+	0x6f, 0x1d, 0x3d, 0xf2, 0x0d, 0x8d, 0xc8, 0x58, 0xdd, 0x6a, 0xbc, 0x20, 0xcc, 0xbd, 0x5d, 0xe6,
+	0x36, 0x3e, 0xa6, 0x69, 0xe2, 0x97, 0xf7, 0x95, 0x57, 0x55, 0xc1, 0x7c, 0x06, 0x0d, 0x6d, 0x69,
+	0xa8, 0x03, 0x1b, 0x1f, 0x49, 0x56, 0x5f, 0xd3, 0xab, 0x42, 0x74, 0x1d, 0xb6, 0x56, 0xfe, 0x32,
+	0xdd, 0x5f, 0xad, 0x4e, 0x9e, 0x9f, 0x3e, 0x05, 0xe6, 0x0b, 0xd8, 0xf9, 0x7b, 0x25, 0xff, 0xc3,
+	0x77, 0xef, 0xfe, 0xfa, 0x61, 0x81, 0xcf, 0x85, 0x05, 0xbe, 0x14, 0x16, 0xd8, 0x16, 0x16, 0xf8,
+	0x56, 0x58, 0xe0, 0x7b, 0x61, 0x81, 0x4f, 0x3f, 0xad, 0x93, 0x49, 0x4b, 0xcd, 0x16, 0xb4, 0xd5,
+	0xef, 0x7b, 0xfc, 0x7b, 0x00, 0xf7, 0xcf, 0x70, 0xa9, 0xe1, 0x02, 0x00, 0x00,
 }
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fixtureBusinessHours() *TimeWindowWhen {
+	return &TimeWindowWhen{
+		Days: TimeWindowDays{
+			Monday: []*TimeWindowTimeRange{{Begin: "9:00AM", End: "5:00PM"}},
+		},
+	}
+}
+
+func TestEnvironmentValidate(t *testing.T) {
+	env := FixtureEnvironment("prod")
+	assert.NoError(t, env.Validate())
+
+	env.TimeZone = "Europe/Paris"
+	env.BusinessHours = fixtureBusinessHours()
+	assert.NoError(t, env.Validate())
+
+	env.TimeZone = "Europe/Atlantis"
+	assert.Error(t, env.Validate())
+	env.TimeZone = ""
+
+	env.BusinessHours.Days.Monday[0].End = "17:00"
+	assert.Error(t, env.Validate())
+
+	env.BusinessHours = &TimeWindowWhen{BusinessHours: true}
+	assert.Error(t, env.Validate())
+}
+
+func TestEnvironmentTimeWindows(t *testing.T) {
+	env := FixtureEnvironment("prod")
+	env.TimeZone = "Asia/Tokyo"
+	env.BusinessHours = fixtureBusinessHours()
+
+	// The time windows are expressed in the time zone of the environment
+	subdue := &TimeWindowWhen{Days: TimeWindowDays{All: []*TimeWindowTimeRange{{Begin: "1:00AM", End: "2:00AM"}}}}
+	when, err := env.TimeWindows(subdue)
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Tokyo", when.TimeZone)
+	assert.Equal(t, "", subdue.TimeZone)
+
+	// ...unless they specify their own
+	subdue.TimeZone = "America/Vancouver"
+	when, err = env.TimeWindows(subdue)
+	require.NoError(t, err)
+	assert.Equal(t, "America/Vancouver", when.TimeZone)
+
+	// The business hours of the environment are referred to
+	when, err = env.TimeWindows(&TimeWindowWhen{BusinessHours: true})
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Tokyo", when.TimeZone)
+	assert.Equal(t, env.BusinessHours.Days, when.Days)
+
+	_, err = FixtureEnvironment("dev").TimeWindows(&TimeWindowWhen{BusinessHours: true})
+	assert.Error(t, err)
+
+	var none *Environment
+	when, err = none.TimeWindows(subdue)
+	require.NoError(t, err)
+	assert.Equal(t, subdue, when)
+}
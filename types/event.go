@@ -1,7 +1,11 @@
 package types
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"strings"
+	"text/template"
 	"time"
 )
 
@@ -14,6 +18,21 @@ const EventFlappingState = "flapping"
 // EventPassingState indicates successful check result status
 const EventPassingState = "passing"
 
+const (
+	// AnomalyAnnotation is the annotation of the events with anomalous metric
+	// points, set to "true"
+	AnomalyAnnotation = "anomaly"
+
+	// AnomalyMetricsAnnotation is the annotation listing the keys of the
+	// anomalous metric points of an event, separated by commas
+	AnomalyMetricsAnnotation = "anomaly_metrics"
+
+	// AnomalyScoreAnnotation is the annotation holding the greatest number of
+	// standard deviations between an anomalous metric point of an event and
+	// the mean of its metric
+	AnomalyScoreAnnotation = "anomaly_score"
+)
+
 // FixtureEvent returns a testing fixutre for an Event object.
 func FixtureEvent(entityID, checkID string) *Event {
 	return &Event{
@@ -76,3 +95,64 @@ func (e *Event) IsResolution() bool {
 func (e *Event) IsSilenced() bool {
 	return len(e.Silenced) > 0
 }
+
+// IsAnomalous determines if eventd flagged some metric points of the event as
+// anomalous.
+func (e *Event) IsAnomalous() bool {
+	return e.Annotations[AnomalyAnnotation] == "true"
+}
+
+// UnixMilli returns t as the number of milliseconds elapsed since the Epoch,
+// the precision of the event timestamps.
+func UnixMilli(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// SetSentTimestamp records the current time as the time the event is sent.
+func (e *Event) SetSentTimestamp() {
+	if e.Timestamps == nil {
+		e.Timestamps = &EventTimestamps{}
+	}
+	e.Timestamps.Sent = UnixMilli(time.Now())
+}
+
+// RenderLinks evaluates the runbook and dashboard URL templates of the check
+// of the event with the event, e.g. {{ .Entity.ID }} or {{ .Check.Name }}.
+// The links are left untouched if one of them can't be evaluated.
+func (e *Event) RenderLinks() error {
+	if !e.HasCheck() {
+		return nil
+	}
+
+	runbook, err := renderLink(e.Check.RunbookURL, e)
+	if err != nil {
+		return fmt.Errorf("could not render the runbook url: %s", err)
+	}
+	dashboard, err := renderLink(e.Check.DashboardURL, e)
+	if err != nil {
+		return fmt.Errorf("could not render the dashboard url: %s", err)
+	}
+
+	e.Check.RunbookURL = runbook
+	e.Check.DashboardURL = dashboard
+	return nil
+}
+
+// renderLink evaluates the link template with the event. A missing label or
+// annotation is an error, rather than an empty value in the link.
+func renderLink(link string, event *Event) (string, error) {
+	if !strings.Contains(link, "{{") {
+		return link, nil
+	}
+
+	tmpl, err := template.New("").Option("missingkey=error").Parse(link)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, event); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
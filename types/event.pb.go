@@ -29,6 +29,22 @@ type Event struct {
 	Silenced []string `protobuf:"bytes,5,rep,name=silenced" json:"silenced,omitempty"`
 	// Hooks describes the results of multiple hooks; if event is associated to hook execution.
 	Hooks []*Hook `protobuf:"bytes,6,rep,name=hooks" json:"hooks,omitempty"`
+	// Labels are key-value pairs inherited from the organization and the
+	// environment of the event.
+	Labels map[string]string `protobuf:"bytes,7,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Annotations are key-value pairs inherited from the organization and the
+	// environment of the event.
+	Annotations map[string]string `protobuf:"bytes,8,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Timestamps are the times, in milliseconds, at which the event went
+	// through each step from its check request to the backend.
+	Timestamps *EventTimestamps `protobuf:"bytes,9,opt,name=timestamps" json:"timestamps,omitempty"`
+	// ClockSkew is the difference, in milliseconds, between the clock of the
+	// backend and the one of the agent when the event was received, including
+	// its transit time. It's positive when the clock of the agent is behind.
+	ClockSkew int64 `protobuf:"varint,10,opt,name=clock_skew,json=clockSkew,proto3" json:"clock_skew,omitempty"`
+	// Contacts are the people or teams notified of the event, the ones of its
+	// check along with any given when the event was created.
+	Contacts []string `protobuf:"bytes,11,rep,name=contacts" json:"contacts,omitempty"`
 }
 
 func (m *Event) Reset()                    { *m = Event{} }
@@ -78,8 +94,90 @@ func (m *Event) GetHooks() []*Hook {
 	return nil
 }
 
+func (m *Event) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Event) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *Event) GetTimestamps() *EventTimestamps {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *Event) GetClockSkew() int64 {
+	if m != nil {
+		return m.ClockSkew
+	}
+	return 0
+}
+
+func (m *Event) GetContacts() []string {
+	if m != nil {
+		return m.Contacts
+	}
+	return nil
+}
+
+// EventTimestamps are the times, in milliseconds since the Epoch, at which an
+// event went through each step. The steps not taken by the event are zero.
+type EventTimestamps struct {
+	// Issued is the time the check request was issued by the backend.
+	Issued int64 `protobuf:"varint,1,opt,name=issued,proto3" json:"issued,omitempty"`
+	// Executed is the time the check was executed by the agent.
+	Executed int64 `protobuf:"varint,2,opt,name=executed,proto3" json:"executed,omitempty"`
+	// Sent is the time the event was sent by the agent.
+	Sent int64 `protobuf:"varint,3,opt,name=sent,proto3" json:"sent,omitempty"`
+	// Processed is the time the event was processed by the backend.
+	Processed int64 `protobuf:"varint,4,opt,name=processed,proto3" json:"processed,omitempty"`
+}
+
+func (m *EventTimestamps) Reset()                    { *m = EventTimestamps{} }
+func (m *EventTimestamps) String() string            { return proto.CompactTextString(m) }
+func (*EventTimestamps) ProtoMessage()               {}
+func (*EventTimestamps) Descriptor() ([]byte, []int) { return fileDescriptorEvent, []int{1} }
+
+func (m *EventTimestamps) GetIssued() int64 {
+	if m != nil {
+		return m.Issued
+	}
+	return 0
+}
+
+func (m *EventTimestamps) GetExecuted() int64 {
+	if m != nil {
+		return m.Executed
+	}
+	return 0
+}
+
+func (m *EventTimestamps) GetSent() int64 {
+	if m != nil {
+		return m.Sent
+	}
+	return 0
+}
+
+func (m *EventTimestamps) GetProcessed() int64 {
+	if m != nil {
+		return m.Processed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Event)(nil), "sensu.types.Event")
+	proto.RegisterType((*EventTimestamps)(nil), "sensu.types.EventTimestamps")
 }
 func (this *Event) Equal(that interface{}) bool {
 	if that == nil {
@@ -134,6 +232,75 @@ func (this *Event) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.Labels) != len(that1.Labels) {
+		return false
+	}
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
+	}
+	if len(this.Annotations) != len(that1.Annotations) {
+		return false
+	}
+	for i := range this.Annotations {
+		if this.Annotations[i] != that1.Annotations[i] {
+			return false
+		}
+	}
+	if !this.Timestamps.Equal(that1.Timestamps) {
+		return false
+	}
+	if this.ClockSkew != that1.ClockSkew {
+		return false
+	}
+	if len(this.Contacts) != len(that1.Contacts) {
+		return false
+	}
+	for i := range this.Contacts {
+		if this.Contacts[i] != that1.Contacts[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *EventTimestamps) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*EventTimestamps)
+	if !ok {
+		that2, ok := that.(EventTimestamps)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Issued != that1.Issued {
+		return false
+	}
+	if this.Executed != that1.Executed {
+		return false
+	}
+	if this.Sent != that1.Sent {
+		return false
+	}
+	if this.Processed != that1.Processed {
+		return false
+	}
 	return true
 }
 func (m *Event) Marshal() (dAtA []byte, err error) {
@@ -213,6 +380,108 @@ func (m *Event) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Labels) > 0 {
+		for k, _ := range m.Labels {
+			dAtA[i] = 0x3a
+			i++
+			v := m.Labels[k]
+			mapSize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + len(v) + sovEvent(uint64(len(v)))
+			i = encodeVarintEvent(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintEvent(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, _ := range m.Annotations {
+			dAtA[i] = 0x42
+			i++
+			v := m.Annotations[k]
+			mapSize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + len(v) + sovEvent(uint64(len(v)))
+			i = encodeVarintEvent(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintEvent(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.Timestamps != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintEvent(dAtA, i, uint64(m.Timestamps.Size()))
+		n4, err := m.Timestamps.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.ClockSkew != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintEvent(dAtA, i, uint64(m.ClockSkew))
+	}
+	if len(m.Contacts) > 0 {
+		for _, s := range m.Contacts {
+			dAtA[i] = 0x5a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *EventTimestamps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTimestamps) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Issued != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEvent(dAtA, i, uint64(m.Issued))
+	}
+	if m.Executed != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEvent(dAtA, i, uint64(m.Executed))
+	}
+	if m.Sent != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sent))
+	}
+	if m.Processed != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintEvent(dAtA, i, uint64(m.Processed))
+	}
 	return i, nil
 }
 
@@ -252,6 +521,55 @@ func NewPopulatedEvent(r randyEvent, easy bool) *Event {
 			this.Hooks[i] = NewPopulatedHook(r, easy)
 		}
 	}
+	if r.Intn(10) != 0 {
+		v3 := r.Intn(10)
+		this.Labels = make(map[string]string)
+		for i := 0; i < v3; i++ {
+			this.Labels[randStringEvent(r)] = randStringEvent(r)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v4 := r.Intn(10)
+		this.Annotations = make(map[string]string)
+		for i := 0; i < v4; i++ {
+			this.Annotations[randStringEvent(r)] = randStringEvent(r)
+		}
+	}
+	if r.Intn(10) != 0 {
+		this.Timestamps = NewPopulatedEventTimestamps(r, easy)
+	}
+	this.ClockSkew = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.ClockSkew *= -1
+	}
+	v5 := r.Intn(10)
+	this.Contacts = make([]string, v5)
+	for i := 0; i < v5; i++ {
+		this.Contacts[i] = string(randStringEvent(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEventTimestamps(r randyEvent, easy bool) *EventTimestamps {
+	this := &EventTimestamps{}
+	this.Issued = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Issued *= -1
+	}
+	this.Executed = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Executed *= -1
+	}
+	this.Sent = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Sent *= -1
+	}
+	this.Processed = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Processed *= -1
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -276,9 +594,9 @@ func randUTF8RuneEvent(r randyEvent) rune {
 	return rune(ru + 61)
 }
 func randStringEvent(r randyEvent) string {
-	v3 := r.Intn(100)
-	tmps := make([]rune, v3)
-	for i := 0; i < v3; i++ {
+	v6 := r.Intn(100)
+	tmps := make([]rune, v6)
+	for i := 0; i < v6; i++ {
 		tmps[i] = randUTF8RuneEvent(r)
 	}
 	return string(tmps)
@@ -300,11 +618,11 @@ func randFieldEvent(dAtA []byte, r randyEvent, fieldNumber int, wire int) []byte
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateEvent(dAtA, uint64(key))
-		v4 := r.Int63()
+		v7 := r.Int63()
 		if r.Intn(2) == 0 {
-			v4 *= -1
+			v7 *= -1
 		}
-		dAtA = encodeVarintPopulateEvent(dAtA, uint64(v4))
+		dAtA = encodeVarintPopulateEvent(dAtA, uint64(v7))
 	case 1:
 		dAtA = encodeVarintPopulateEvent(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -359,6 +677,53 @@ func (m *Event) Size() (n int) {
 			n += 1 + l + sovEvent(uint64(l))
 		}
 	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + len(v) + sovEvent(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + len(v) + sovEvent(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	if m.Timestamps != nil {
+		l = m.Timestamps.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ClockSkew != 0 {
+		n += 1 + sovEvent(uint64(m.ClockSkew))
+	}
+	if len(m.Contacts) > 0 {
+		for _, s := range m.Contacts {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventTimestamps) Size() (n int) {
+	var l int
+	_ = l
+	if m.Issued != 0 {
+		n += 1 + sovEvent(uint64(m.Issued))
+	}
+	if m.Executed != 0 {
+		n += 1 + sovEvent(uint64(m.Executed))
+	}
+	if m.Sent != 0 {
+		n += 1 + sovEvent(uint64(m.Sent))
+	}
+	if m.Processed != 0 {
+		n += 1 + sovEvent(uint64(m.Processed))
+	}
 	return n
 }
 
@@ -582,6 +947,449 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamps == nil {
+				m.Timestamps = &EventTimestamps{}
+			}
+			if err := m.Timestamps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockSkew", wireType)
+			}
+			m.ClockSkew = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClockSkew |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contacts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contacts = append(m.Contacts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTimestamps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTimestamps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
+			}
+			m.Issued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Issued |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			m.Executed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			m.Sent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sent |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processed", wireType)
+			}
+			m.Processed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Processed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -711,24 +1519,43 @@ var (
 func init() { proto.RegisterFile("event.proto", fileDescriptorEvent) }
 
 var fileDescriptorEvent = []byte{
-	// 299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x71, 0xd3, 0x04, 0xea, 0xc0, 0x80, 0x61, 0xb0, 0x2a, 0x64, 0x22, 0x58, 0xb2, 0xd4,
-	0x15, 0x85, 0x13, 0x14, 0x55, 0x62, 0x61, 0xe9, 0xc8, 0x46, 0xc2, 0x23, 0x89, 0x20, 0x71, 0x54,
-	0x3b, 0x48, 0x3d, 0x05, 0x2b, 0x47, 0xe0, 0x08, 0x1c, 0xa1, 0x23, 0x27, 0x40, 0x10, 0x2e, 0xc1,
-	0x88, 0xf2, 0x62, 0x4a, 0xb3, 0xf9, 0x7f, 0xff, 0xff, 0x3d, 0xfd, 0x7e, 0xd4, 0x87, 0x27, 0x28,
-	0x8c, 0x2c, 0x17, 0xca, 0x28, 0xe6, 0x6b, 0x28, 0x74, 0x25, 0xcd, 0xb2, 0x04, 0x3d, 0x1c, 0x25,
-	0x99, 0x49, 0xab, 0x48, 0xc6, 0x2a, 0x1f, 0x27, 0x2a, 0x51, 0x63, 0xcc, 0x44, 0xd5, 0x3d, 0x2a,
-	0x14, 0xf8, 0x6a, 0xd9, 0xe1, 0x2e, 0x14, 0x26, 0x33, 0x4b, 0xab, 0xfc, 0x38, 0x85, 0xf8, 0xc1,
-	0x8a, 0xbd, 0x1c, 0xcc, 0x22, 0x8b, 0xb5, 0x95, 0x34, 0x55, 0xca, 0x5a, 0x27, 0xcf, 0x3d, 0xea,
-	0xce, 0x9a, 0x06, 0xec, 0x88, 0x0e, 0x4c, 0x96, 0x83, 0x36, 0xb7, 0x79, 0xc9, 0x49, 0x40, 0x42,
-	0x67, 0xfe, 0x3f, 0x60, 0x67, 0xd4, 0x6b, 0xf7, 0xf3, 0x5e, 0x40, 0x42, 0x7f, 0x72, 0x20, 0x37,
-	0xaa, 0xca, 0x19, 0x5a, 0xd3, 0xfe, 0xea, 0xe3, 0x98, 0xcc, 0x6d, 0x90, 0x49, 0xea, 0x62, 0x09,
-	0xee, 0x20, 0xc1, 0x3a, 0xc4, 0x65, 0xe3, 0x58, 0xa0, 0x8d, 0xb1, 0x0b, 0xba, 0x6d, 0x7b, 0xf2,
-	0x3e, 0x12, 0x87, 0x1d, 0xe2, 0xba, 0xf5, 0x2c, 0xf3, 0x17, 0x65, 0x01, 0xdd, 0xd1, 0xd9, 0x23,
-	0x14, 0x31, 0xdc, 0x71, 0x37, 0x70, 0xc2, 0x81, 0x0d, 0xac, 0xa7, 0x6c, 0x44, 0xdd, 0xe6, 0xc3,
-	0x9a, 0x7b, 0x81, 0x13, 0xfa, 0x93, 0xfd, 0xce, 0xd6, 0x2b, 0xa5, 0xd6, 0x35, 0x30, 0x35, 0x3d,
-	0xfd, 0xf9, 0x12, 0xe4, 0xb5, 0x16, 0xe4, 0xad, 0x16, 0x64, 0x55, 0x0b, 0xf2, 0x5e, 0x0b, 0xf2,
-	0x59, 0x0b, 0xf2, 0xf2, 0x2d, 0xb6, 0x6e, 0x5c, 0xc4, 0x22, 0x0f, 0xaf, 0x77, 0xfe, 0x1b, 0x00,
-	0x00, 0xff, 0xff, 0xa9, 0xd8, 0xbf, 0x58, 0xbe, 0x01, 0x00, 0x00,
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xee, 0xd6, 0xb5, 0x5b, 0x8f, 0xf9, 0x29, 0xdb, 0x00, 0xab, 0xa8, 0xd8, 0x51, 0x2b, 0xa1,
+	0x1c, 0x5a, 0x57, 0x14, 0x10, 0x3f, 0x07, 0x24, 0x8c, 0x2a, 0x71, 0x00, 0x09, 0x05, 0x4e, 0xbd,
+	0x20, 0xc7, 0x59, 0x1a, 0x2b, 0xb1, 0x37, 0xca, 0xae, 0x5b, 0xf2, 0x0c, 0xbc, 0x00, 0x8f, 0xc0,
+	0x23, 0xf0, 0x08, 0x39, 0x22, 0x1e, 0xc0, 0x82, 0x70, 0xcb, 0x13, 0x70, 0x44, 0x1e, 0x3b, 0xce,
+	0xa6, 0x70, 0xe1, 0xe6, 0xf9, 0xe6, 0xfb, 0xbe, 0x9d, 0xd9, 0x99, 0x35, 0x38, 0xfc, 0x9c, 0xa7,
+	0xca, 0x1f, 0x8d, 0x85, 0x12, 0xd4, 0x91, 0x3c, 0x95, 0x99, 0xaf, 0x26, 0x23, 0x2e, 0x9b, 0x87,
+	0x67, 0xb1, 0xea, 0x67, 0x5d, 0x3f, 0x12, 0xc9, 0xd1, 0x99, 0x38, 0x13, 0x47, 0xc8, 0xe9, 0x66,
+	0x1f, 0x30, 0xc2, 0x00, 0xbf, 0x4a, 0x6d, 0xf3, 0x0a, 0x4f, 0x55, 0xac, 0x26, 0x55, 0xe4, 0x44,
+	0x7d, 0x1e, 0x0d, 0xaa, 0xe0, 0x6a, 0xc2, 0xd5, 0x38, 0x8e, 0x64, 0x15, 0x42, 0x5f, 0x88, 0x2a,
+	0xb5, 0xf7, 0xc9, 0x02, 0xf3, 0xa4, 0xa8, 0x80, 0xee, 0x82, 0xad, 0xe2, 0x84, 0x4b, 0x15, 0x26,
+	0x23, 0x46, 0x5a, 0xa4, 0x6d, 0x74, 0x96, 0x00, 0xbd, 0x07, 0x56, 0xe9, 0xcf, 0xd6, 0x5b, 0xa4,
+	0xed, 0x1c, 0xef, 0xf8, 0x5a, 0xa9, 0xfe, 0x09, 0xa6, 0x82, 0x8d, 0x69, 0xee, 0x91, 0x4e, 0x45,
+	0xa4, 0x3e, 0x98, 0x58, 0x04, 0x33, 0x50, 0x41, 0x57, 0x14, 0x2f, 0x8a, 0x4c, 0x25, 0x28, 0x69,
+	0xf4, 0x01, 0x6c, 0x56, 0x75, 0xb2, 0x0d, 0x54, 0x34, 0x56, 0x14, 0xaf, 0xcb, 0x5c, 0xa5, 0x59,
+	0x50, 0x69, 0x0b, 0xb6, 0x64, 0x3c, 0xe4, 0x69, 0xc4, 0x7b, 0xcc, 0x6c, 0x19, 0x6d, 0xbb, 0x22,
+	0xd4, 0x28, 0x3d, 0x04, 0xb3, 0x68, 0x58, 0x32, 0xab, 0x65, 0xb4, 0x9d, 0xe3, 0x1b, 0x2b, 0xae,
+	0x2f, 0x85, 0xa8, 0xcb, 0x40, 0x16, 0x7d, 0x03, 0xd6, 0x30, 0xec, 0xf2, 0xa1, 0x64, 0x9b, 0xc8,
+	0x77, 0x57, 0x3b, 0xc5, 0x69, 0xbd, 0x42, 0xc2, 0x49, 0xaa, 0xc6, 0x93, 0x80, 0x4d, 0x73, 0x6f,
+	0x6d, 0x9e, 0x7b, 0xdb, 0xa5, 0xea, 0x40, 0x24, 0xb1, 0xe2, 0xc9, 0x48, 0x4d, 0x3a, 0x95, 0x0f,
+	0xed, 0x81, 0x13, 0xa6, 0xa9, 0x50, 0xa1, 0x8a, 0x45, 0x2a, 0xd9, 0x16, 0xda, 0xee, 0xff, 0xc3,
+	0xf6, 0xf9, 0x92, 0x55, 0x7a, 0xdf, 0xa9, 0xbc, 0x6f, 0x6a, 0x7a, 0xed, 0x00, 0xdd, 0x96, 0x9e,
+	0x02, 0xd4, 0xe3, 0x92, 0xcc, 0xc6, 0x1b, 0xdc, 0xfd, 0xfb, 0x90, 0x77, 0x35, 0x27, 0xd8, 0x2d,
+	0xda, 0x9e, 0xe7, 0x5e, 0x63, 0xa9, 0xd3, 0xcc, 0x35, 0x37, 0xfa, 0x08, 0x20, 0x1a, 0x8a, 0x68,
+	0xf0, 0x5e, 0x0e, 0xf8, 0x05, 0x83, 0x62, 0x39, 0x02, 0x56, 0x28, 0x97, 0xa8, 0xa6, 0xb4, 0x11,
+	0x7d, 0x3b, 0xe0, 0x17, 0xf4, 0x18, 0xb6, 0x22, 0x91, 0xaa, 0x30, 0x52, 0x92, 0x39, 0x38, 0x9d,
+	0x5b, 0xf3, 0xdc, 0xa3, 0x0b, 0x4c, 0x13, 0xd5, 0xbc, 0xe6, 0x13, 0x70, 0xb4, 0xfb, 0xa5, 0xdb,
+	0x60, 0x0c, 0xf8, 0x04, 0x37, 0xd2, 0xee, 0x14, 0x9f, 0xb4, 0x01, 0xe6, 0x79, 0x38, 0xcc, 0x38,
+	0xae, 0xa2, 0xdd, 0x29, 0x83, 0xa7, 0xeb, 0x8f, 0x49, 0xf3, 0x19, 0x6c, 0x5f, 0xbe, 0xc3, 0xff,
+	0xd1, 0xef, 0x7d, 0x27, 0x70, 0xfd, 0xd2, 0x2d, 0xd1, 0x03, 0xb0, 0x62, 0x29, 0x33, 0xde, 0x2b,
+	0x1f, 0x45, 0xd0, 0x28, 0x66, 0x5d, 0x22, 0xfa, 0xac, 0x4b, 0xa4, 0x68, 0x98, 0x7f, 0xe4, 0x51,
+	0xa6, 0x78, 0x0f, 0xed, 0x8d, 0xb2, 0xe1, 0x05, 0xa6, 0x37, 0xbc, 0xc0, 0xe8, 0x5d, 0xd8, 0x90,
+	0x3c, 0x55, 0xf8, 0x4e, 0x8c, 0x80, 0xce, 0x73, 0xef, 0x5a, 0x11, 0x6b, 0x5c, 0xcc, 0xd3, 0x87,
+	0x60, 0x8f, 0xc6, 0x22, 0xe2, 0x52, 0xf2, 0x1e, 0x3e, 0x11, 0x23, 0xb8, 0x3d, 0xcf, 0xbd, 0x9d,
+	0x1a, 0xd4, 0x67, 0x50, 0x83, 0xc1, 0xfe, 0xef, 0x9f, 0x2e, 0xf9, 0x32, 0x73, 0xc9, 0xd7, 0x99,
+	0x4b, 0xa6, 0x33, 0x97, 0x7c, 0x9b, 0xb9, 0xe4, 0xc7, 0xcc, 0x25, 0x9f, 0x7f, 0xb9, 0x6b, 0xa7,
+	0x26, 0xee, 0x46, 0xd7, 0xc2, 0xdf, 0xc1, 0xfd, 0x3f, 0x03, 0x00, 0x1c, 0x01, 0xdb, 0x2c, 0x8f,
+	0x04, 0x00, 0x00,
 }
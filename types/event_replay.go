@@ -0,0 +1,29 @@
+package types
+
+import "fmt"
+
+// EventReplay is a request to send the stored events matching a query through
+// the event handlers again, e.g. to notify after a broken handler was fixed or
+// to backfill a TSDB.
+type EventReplay struct {
+	// Query selects the events replayed, all the events of the namespace if
+	// empty
+	Query string `json:"query,omitempty"`
+
+	// Handlers replace the handlers and pipelines of the replayed events, if
+	// any are given
+	Handlers []string `json:"handlers,omitempty"`
+
+	// DryRun only returns the events which would be replayed
+	DryRun bool `json:"dry_run,omitempty"`
+}
+
+// Validate returns an error if the replay request is invalid.
+func (r *EventReplay) Validate() error {
+	for _, handler := range r.Handlers {
+		if err := ValidateName(handler); err != nil {
+			return fmt.Errorf("handler name %s", err)
+		}
+	}
+	return nil
+}
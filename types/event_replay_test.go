@@ -0,0 +1,21 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventReplayValidate(t *testing.T) {
+	replay := &EventReplay{Query: "check.status != 0"}
+	assert.NoError(t, replay.Validate())
+
+	replay.Handlers = []string{"influxdb"}
+	assert.NoError(t, replay.Validate())
+
+	replay.Handlers = []string{"influxdb", ""}
+	assert.Error(t, replay.Validate())
+
+	replay.Handlers = []string{"influx db"}
+	assert.Error(t, replay.Validate())
+}
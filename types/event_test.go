@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -189,3 +190,50 @@ func TestEventIsSilenced(t *testing.T) {
 		})
 	}
 }
+
+func TestEventIsAnomalous(t *testing.T) {
+	event := FixtureEvent("entity1", "check1")
+	assert.False(t, event.IsAnomalous())
+
+	event.Annotations = map[string]string{AnomalyAnnotation: "false"}
+	assert.False(t, event.IsAnomalous())
+
+	event.Annotations[AnomalyAnnotation] = "true"
+	assert.True(t, event.IsAnomalous())
+}
+
+func TestUnixMilli(t *testing.T) {
+	now := time.Unix(1500000000, 123456789)
+	assert.Equal(t, int64(1500000000123), UnixMilli(now))
+}
+
+func TestEventSetSentTimestamp(t *testing.T) {
+	event := FixtureEvent("entity", "check")
+	before := UnixMilli(time.Now())
+	event.SetSentTimestamp()
+	require.NotNil(t, event.Timestamps)
+	assert.True(t, event.Timestamps.Sent >= before)
+
+	event.Timestamps.Issued = 42
+	event.SetSentTimestamp()
+	assert.Equal(t, int64(42), event.Timestamps.Issued)
+}
+
+func TestEventRenderLinks(t *testing.T) {
+	event := FixtureEvent("entity1", "check1")
+	event.Labels = map[string]string{"team": "ops"}
+	event.Check.RunbookURL = "https://wiki/{{ .Labels.team }}/{{ .Check.Name }}"
+	event.Check.DashboardURL = "https://grafana/d/hosts?var-host={{ .Entity.ID }}"
+	require.NoError(t, event.RenderLinks())
+	assert.Equal(t, "https://wiki/ops/check1", event.Check.RunbookURL)
+	assert.Equal(t, "https://grafana/d/hosts?var-host=entity1", event.Check.DashboardURL)
+
+	// The links are left untouched if one of them can't be rendered
+	event.Check.RunbookURL = "https://wiki/{{ .Check.Name }}"
+	event.Check.DashboardURL = "https://grafana/{{ .Labels.region }}"
+	assert.Error(t, event.RenderLinks())
+	assert.Equal(t, "https://wiki/{{ .Check.Name }}", event.Check.RunbookURL)
+
+	event.Check = nil
+	assert.NoError(t, event.RenderLinks())
+}
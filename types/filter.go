@@ -3,6 +3,8 @@ package types
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"path"
 
 	"github.com/sensu/sensu-go/util/eval"
 	utilstrings "github.com/sensu/sensu-go/util/strings"
@@ -72,6 +74,17 @@ func (f *EventFilter) Update(from *EventFilter, fields ...string) error {
 	return nil
 }
 
+// SetNamespace sets the organization and environment of the eventfilter.
+func (f *EventFilter) SetNamespace(org, env string) {
+	f.Organization = org
+	f.Environment = env
+}
+
+// URIPath returns the path of the filter, relative to the API root.
+func (f *EventFilter) URIPath() string {
+	return path.Join("/filters", url.PathEscape(f.Name))
+}
+
 // FixtureEventFilter returns a Filter fixture for testing.
 func FixtureEventFilter(name string) *EventFilter {
 	return &EventFilter{
@@ -1,6 +1,11 @@
 package types
 
-import "errors"
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+)
 
 const (
 	// HandlerPipeType represents handlers that pipes event data // into arbitrary
@@ -19,27 +24,163 @@ const (
 	// HandlerUDPType represents handlers that send event data to a remote UDP
 	// socket
 	HandlerUDPType = "udp"
+
+	// HandlerWebhookType represents handlers that post event data to an HTTP
+	// endpoint
+	HandlerWebhookType = "webhook"
 )
 
+const (
+	// HandlerFormatJSON is the default format of the event data posted by
+	// webhook handlers, the JSON encoding of the event
+	HandlerFormatJSON = "json"
+
+	// HandlerFormatCloudEvents is the format of the event data posted by
+	// webhook handlers as CloudEvents 1.0, in the structured content mode
+	HandlerFormatCloudEvents = "cloudevents"
+)
+
+const (
+	// HandlerSeverityOK is the severity of the events with a zero check status
+	HandlerSeverityOK = "ok"
+
+	// HandlerSeverityWarning is the severity of the events with a check status
+	// of 1
+	HandlerSeverityWarning = "warning"
+
+	// HandlerSeverityCritical is the severity of the events with a check status
+	// of 2
+	HandlerSeverityCritical = "critical"
+
+	// HandlerSeverityUnknown is the severity of the events with any other check
+	// status
+	HandlerSeverityUnknown = "unknown"
+)
+
+// MaxHandlerRetries is the maximum number of times a handler can be executed
+// again when it fails.
+const MaxHandlerRetries = 10
+
+// DefaultContactRateWindow is the number of seconds over which the executions
+// of a handler are counted per contact, when the handler doesn't set it.
+const DefaultContactRateWindow = 3600
+
 // Validate returns an error if the handler does not pass validation tests.
 func (h *Handler) Validate() error {
+	var errs ValidationErrors
+
 	if err := ValidateName(h.Name); err != nil {
-		return errors.New("handler name " + err.Error())
+		errs.Add("name", requiredOrInvalid(h.Name), "handler name "+err.Error())
 	}
 
 	if err := validateHandlerType(h.Type); err != nil {
-		return errors.New("handler type " + err.Error())
+		errs.Add("type", requiredOrInvalid(h.Type), "handler type "+err.Error())
 	}
 
 	if h.Environment == "" {
-		return errors.New("environment must be set")
+		errs.Add("environment", ValidationRequired, "environment must be set")
 	}
 
 	if h.Organization == "" {
-		return errors.New("organization must be set")
+		errs.Add("organization", ValidationRequired, "organization must be set")
+	}
+
+	if h.Type == HandlerWebhookType {
+		if u, err := url.Parse(h.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs.Add("url", requiredOrInvalid(h.URL), "webhook handler url must be an http or https URL")
+		}
+	}
+
+	switch h.Format {
+	case "", HandlerFormatJSON, HandlerFormatCloudEvents:
+		if h.Format != "" && h.Type != HandlerWebhookType {
+			errs.Add("format", ValidationInvalid, "format is only supported by webhook handlers")
+		}
+	default:
+		errs.Add("format", ValidationInvalid, "handler format is unknown")
+	}
+
+	if h.Retries > MaxHandlerRetries {
+		errs.Addf("retries", ValidationInvalid, "handler retries must be at most %d", MaxHandlerRetries)
+	} else if h.Retries > 0 && h.Type == HandlerSetType {
+		errs.Add("retries", ValidationInvalid, "retries are not supported by handler sets")
+	}
+
+	if (h.ContactRateLimit > 0 || h.ContactRateWindow > 0) && h.Type == HandlerSetType {
+		errs.Add("contact_rate_limit", ValidationInvalid, "contact rate limits are not supported by handler sets")
+	}
+
+	for i, severity := range h.Severities {
+		if err := validateHandlerSeverity(severity); err != nil {
+			errs.Addf(fmt.Sprintf("severities[%d]", i), ValidationInvalid, "handler severity %s", err)
+		}
 	}
 
-	return nil
+	for i, assetName := range h.RuntimeAssets {
+		if err := ValidateAssetName(assetName); err != nil {
+			errs.Addf(fmt.Sprintf("runtime_assets[%d]", i), requiredOrInvalid(assetName), "asset's %s", err)
+		}
+	}
+
+	return errs.Err()
+}
+
+// ContactWindow returns the duration over which the executions of the handler
+// are counted per contact.
+func (h *Handler) ContactWindow() time.Duration {
+	if h.ContactRateWindow == 0 {
+		return DefaultContactRateWindow * time.Second
+	}
+	return time.Duration(h.ContactRateWindow) * time.Second
+}
+
+// HandlesSeverity determines if the handler handles the event, given its
+// severities. Events without a check, and all events when the handler has no
+// severities, are handled. Resolution events are handled if the severity of the
+// status they resolve is handled.
+func (h *Handler) HandlesSeverity(event *Event) bool {
+	if len(h.Severities) == 0 || !event.HasCheck() {
+		return true
+	}
+
+	status := event.Check.Status
+	if event.IsResolution() {
+		status = event.Check.History[len(event.Check.History)-1].Status
+	}
+
+	severity := Severity(status)
+	for _, s := range h.Severities {
+		if s == severity {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Severity returns the severity of a check status.
+func Severity(status int32) string {
+	switch status {
+	case 0:
+		return HandlerSeverityOK
+	case 1:
+		return HandlerSeverityWarning
+	case 2:
+		return HandlerSeverityCritical
+	default:
+		return HandlerSeverityUnknown
+	}
+}
+
+// SetNamespace sets the organization and environment of the handler.
+func (h *Handler) SetNamespace(org, env string) {
+	h.Organization = org
+	h.Environment = env
+}
+
+// URIPath returns the path of the handler, relative to the API root.
+func (h *Handler) URIPath() string {
+	return path.Join("/handlers", url.PathEscape(h.Name))
 }
 
 // FixtureHandler returns a Handler fixture for testing.
@@ -64,6 +205,15 @@ func FixtureSocketHandler(name string, proto string) *Handler {
 	return handler
 }
 
+// FixtureWebhookHandler returns a Handler fixture for testing.
+func FixtureWebhookHandler(name string, url string) *Handler {
+	handler := FixtureHandler(name)
+	handler.Type = HandlerWebhookType
+	handler.Command = ""
+	handler.URL = url
+	return handler
+}
+
 // FixtureSetHandler returns a Handler fixture for testing.
 func FixtureSetHandler(name string, handlers ...string) *Handler {
 	handler := FixtureHandler(name)
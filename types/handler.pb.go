@@ -25,7 +25,9 @@ type Handler struct {
 	Mutator string `protobuf:"bytes,3,opt,name=mutator,proto3" json:"mutator,omitempty"`
 	// Command is the command to be executed for a pipe handler.
 	Command string `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
-	// Timeout is the handler timeout in seconds.
+	// Timeout is the handler timeout in seconds, for each execution of a pipe
+	// handler or attempt to send the event data to a tcp, udp or webhook
+	// handler.
 	Timeout uint32 `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	// Socket contains configuration for a TCP or UDP handler.
 	Socket *HandlerSocket `protobuf:"bytes,6,opt,name=socket" json:"socket,omitempty"`
@@ -39,6 +41,46 @@ type Handler struct {
 	Environment string `protobuf:"bytes,10,opt,name=environment,proto3" json:"environment,omitempty"`
 	// Organization indicates to which org a handler belongs to
 	Organization string `protobuf:"bytes,11,opt,name=organization,proto3" json:"organization,omitempty"`
+	// RateLimit is the maximum number of times the handler is executed per
+	// minute, events beyond that limit are not handled. Zero means unlimited.
+	RateLimit uint32 `protobuf:"varint,12,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// DedupWindow is the number of seconds during which identical events, i.e.
+	// with the same entity, check and status, are only handled once. Zero
+	// disables deduplication.
+	DedupWindow uint32 `protobuf:"varint,13,opt,name=dedup_window,json=dedupWindow,proto3" json:"dedup_window,omitempty"`
+	// Legacy indicates that the handler expects events in the Sensu 1.x
+	// format, i.e. with client and check keys, rather than entity and check.
+	Legacy bool `protobuf:"varint,14,opt,name=legacy,proto3" json:"legacy,omitempty"`
+	// Severities is the list of check severities, i.e. ok, warning, critical or
+	// unknown, of the events to handle. Resolution events are handled if the
+	// severity they resolve is in the list. All events are handled if empty.
+	Severities []string `protobuf:"bytes,15,rep,name=severities" json:"severities"`
+	// RuntimeAssets are a list of assets required to execute the handler
+	// command on the backend.
+	RuntimeAssets []string `protobuf:"bytes,16,rep,name=runtime_assets,json=runtimeAssets" json:"runtime_assets"`
+	// URL is the HTTP endpoint the event data is posted to by a webhook
+	// handler.
+	URL string `protobuf:"bytes,17,opt,name=url,proto3" json:"url,omitempty"`
+	// Format is the format of the event data posted by a webhook handler, i.e.
+	// json, the default, or cloudevents to wrap it in a CloudEvents 1.0
+	// envelope.
+	Format string `protobuf:"bytes,18,opt,name=format,proto3" json:"format,omitempty"`
+	// Retries is the number of times the handler is executed again when it
+	// fails, i.e. a pipe handler exits with a non-zero status, or the event
+	// data can't be sent by a tcp, udp or webhook handler. Zero disables
+	// retries.
+	Retries uint32 `protobuf:"varint,19,opt,name=retries,proto3" json:"retries,omitempty"`
+	// RetryBackoff is the number of seconds waited before the first retry, and
+	// doubled before each subsequent retry. Zero retries right away.
+	RetryBackoff uint32 `protobuf:"varint,20,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff,omitempty"`
+	// ContactRateLimit is the maximum number of times the handler is executed
+	// for each contact of the events per contact rate window, the contacts
+	// beyond that limit being removed from the events. The events whose
+	// contacts all exceeded the limit are not handled. Zero means unlimited.
+	ContactRateLimit uint32 `protobuf:"varint,21,opt,name=contact_rate_limit,json=contactRateLimit,proto3" json:"contact_rate_limit,omitempty"`
+	// ContactRateWindow is the number of seconds over which the executions of
+	// the handler are counted per contact, 3600 by default.
+	ContactRateWindow uint32 `protobuf:"varint,22,opt,name=contact_rate_window,json=contactRateWindow,proto3" json:"contact_rate_window,omitempty"`
 }
 
 func (m *Handler) Reset()                    { *m = Handler{} }
@@ -123,6 +165,83 @@ func (m *Handler) GetOrganization() string {
 	return ""
 }
 
+func (m *Handler) GetRateLimit() uint32 {
+	if m != nil {
+		return m.RateLimit
+	}
+	return 0
+}
+
+func (m *Handler) GetDedupWindow() uint32 {
+	if m != nil {
+		return m.DedupWindow
+	}
+	return 0
+}
+
+func (m *Handler) GetLegacy() bool {
+	if m != nil {
+		return m.Legacy
+	}
+	return false
+}
+
+func (m *Handler) GetSeverities() []string {
+	if m != nil {
+		return m.Severities
+	}
+	return nil
+}
+
+func (m *Handler) GetRuntimeAssets() []string {
+	if m != nil {
+		return m.RuntimeAssets
+	}
+	return nil
+}
+
+func (m *Handler) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *Handler) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *Handler) GetRetries() uint32 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *Handler) GetRetryBackoff() uint32 {
+	if m != nil {
+		return m.RetryBackoff
+	}
+	return 0
+}
+
+func (m *Handler) GetContactRateLimit() uint32 {
+	if m != nil {
+		return m.ContactRateLimit
+	}
+	return 0
+}
+
+func (m *Handler) GetContactRateWindow() uint32 {
+	if m != nil {
+		return m.ContactRateWindow
+	}
+	return 0
+}
+
 // HandlerSocket contains configuration for a TCP or UDP handler.
 type HandlerSocket struct {
 	// Host is the socket peer address.
@@ -227,6 +346,49 @@ func (this *Handler) Equal(that interface{}) bool {
 	if this.Organization != that1.Organization {
 		return false
 	}
+	if this.RateLimit != that1.RateLimit {
+		return false
+	}
+	if this.DedupWindow != that1.DedupWindow {
+		return false
+	}
+	if this.Legacy != that1.Legacy {
+		return false
+	}
+	if len(this.Severities) != len(that1.Severities) {
+		return false
+	}
+	for i := range this.Severities {
+		if this.Severities[i] != that1.Severities[i] {
+			return false
+		}
+	}
+	if len(this.RuntimeAssets) != len(that1.RuntimeAssets) {
+		return false
+	}
+	for i := range this.RuntimeAssets {
+		if this.RuntimeAssets[i] != that1.RuntimeAssets[i] {
+			return false
+		}
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if this.Format != that1.Format {
+		return false
+	}
+	if this.Retries != that1.Retries {
+		return false
+	}
+	if this.RetryBackoff != that1.RetryBackoff {
+		return false
+	}
+	if this.ContactRateLimit != that1.ContactRateLimit {
+		return false
+	}
+	if this.ContactRateWindow != that1.ContactRateWindow {
+		return false
+	}
 	return true
 }
 func (this *HandlerSocket) Equal(that interface{}) bool {
@@ -373,6 +535,102 @@ func (m *Handler) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintHandler(dAtA, i, uint64(len(m.Organization)))
 		i += copy(dAtA[i:], m.Organization)
 	}
+	if m.RateLimit != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.RateLimit))
+	}
+	if m.DedupWindow != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.DedupWindow))
+	}
+	if m.Legacy {
+		dAtA[i] = 0x70
+		i++
+		if m.Legacy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Severities) > 0 {
+		for _, s := range m.Severities {
+			dAtA[i] = 0x7a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.RuntimeAssets) > 0 {
+		for _, s := range m.RuntimeAssets {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.URL) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
+	}
+	if len(m.Format) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
+	}
+	if m.Retries != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Retries))
+	}
+	if m.RetryBackoff != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.RetryBackoff))
+	}
+	if m.ContactRateLimit != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.ContactRateLimit))
+	}
+	if m.ContactRateWindow != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.ContactRateWindow))
+	}
 	return i, nil
 }
 
@@ -441,6 +699,25 @@ func NewPopulatedHandler(r randyHandler, easy bool) *Handler {
 	}
 	this.Environment = string(randStringHandler(r))
 	this.Organization = string(randStringHandler(r))
+	this.RateLimit = uint32(r.Uint32())
+	this.DedupWindow = uint32(r.Uint32())
+	this.Legacy = bool(bool(r.Intn(2) == 0))
+	v4 := r.Intn(10)
+	this.Severities = make([]string, v4)
+	for i := 0; i < v4; i++ {
+		this.Severities[i] = string(randStringHandler(r))
+	}
+	v5 := r.Intn(10)
+	this.RuntimeAssets = make([]string, v5)
+	for i := 0; i < v5; i++ {
+		this.RuntimeAssets[i] = string(randStringHandler(r))
+	}
+	this.URL = string(randStringHandler(r))
+	this.Format = string(randStringHandler(r))
+	this.Retries = uint32(r.Uint32())
+	this.RetryBackoff = uint32(r.Uint32())
+	this.ContactRateLimit = uint32(r.Uint32())
+	this.ContactRateWindow = uint32(r.Uint32())
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -474,9 +751,9 @@ func randUTF8RuneHandler(r randyHandler) rune {
 	return rune(ru + 61)
 }
 func randStringHandler(r randyHandler) string {
-	v4 := r.Intn(100)
-	tmps := make([]rune, v4)
-	for i := 0; i < v4; i++ {
+	v6 := r.Intn(100)
+	tmps := make([]rune, v6)
+	for i := 0; i < v6; i++ {
 		tmps[i] = randUTF8RuneHandler(r)
 	}
 	return string(tmps)
@@ -498,11 +775,11 @@ func randFieldHandler(dAtA []byte, r randyHandler, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateHandler(dAtA, uint64(key))
-		v5 := r.Int63()
+		v7 := r.Int63()
 		if r.Intn(2) == 0 {
-			v5 *= -1
+			v7 *= -1
 		}
-		dAtA = encodeVarintPopulateHandler(dAtA, uint64(v5))
+		dAtA = encodeVarintPopulateHandler(dAtA, uint64(v7))
 	case 1:
 		dAtA = encodeVarintPopulateHandler(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -579,6 +856,47 @@ func (m *Handler) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovHandler(uint64(l))
 	}
+	if m.RateLimit != 0 {
+		n += 1 + sovHandler(uint64(m.RateLimit))
+	}
+	if m.DedupWindow != 0 {
+		n += 1 + sovHandler(uint64(m.DedupWindow))
+	}
+	if m.Legacy {
+		n += 2
+	}
+	if len(m.Severities) > 0 {
+		for _, s := range m.Severities {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	if len(m.RuntimeAssets) > 0 {
+		for _, s := range m.RuntimeAssets {
+			l = len(s)
+			n += 2 + l + sovHandler(uint64(l))
+		}
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if m.Retries != 0 {
+		n += 2 + sovHandler(uint64(m.Retries))
+	}
+	if m.RetryBackoff != 0 {
+		n += 2 + sovHandler(uint64(m.RetryBackoff))
+	}
+	if m.ContactRateLimit != 0 {
+		n += 2 + sovHandler(uint64(m.ContactRateLimit))
+	}
+	if m.ContactRateWindow != 0 {
+		n += 2 + sovHandler(uint64(m.ContactRateWindow))
+	}
 	return n
 }
 
@@ -950,6 +1268,256 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			}
 			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			m.RateLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DedupWindow", wireType)
+			}
+			m.DedupWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DedupWindow |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Legacy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Legacy = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Severities = append(m.Severities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeAssets = append(m.RuntimeAssets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoff", wireType)
+			}
+			m.RetryBackoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryBackoff |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactRateLimit", wireType)
+			}
+			m.ContactRateLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContactRateLimit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactRateWindow", wireType)
+			}
+			m.ContactRateWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContactRateWindow |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHandler(dAtA[iNdEx:])
@@ -1177,28 +1745,41 @@ var (
 func init() { proto.RegisterFile("handler.proto", fileDescriptorHandler) }
 
 var fileDescriptorHandler = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x3f, 0x4e, 0xc3, 0x30,
-	0x14, 0xc6, 0x71, 0xff, 0xa5, 0x75, 0x9a, 0xc5, 0x93, 0xd5, 0x21, 0x8d, 0x8a, 0x10, 0x59, 0x48,
-	0x25, 0x18, 0x60, 0xee, 0xc4, 0x1c, 0x24, 0x06, 0x16, 0xe4, 0xb6, 0x6e, 0x1a, 0x51, 0xdb, 0x95,
-	0xed, 0x44, 0x82, 0x93, 0x70, 0x04, 0x8e, 0xc0, 0x11, 0x3a, 0x72, 0x82, 0x0a, 0xc2, 0xd6, 0x13,
-	0xc0, 0x86, 0xec, 0x24, 0xa5, 0xdd, 0xbe, 0xef, 0xf7, 0x3e, 0x3f, 0xf9, 0xbd, 0x07, 0xbd, 0x25,
-	0xe1, 0xf3, 0x15, 0x95, 0xd1, 0x5a, 0x0a, 0x2d, 0x90, 0xab, 0x28, 0x57, 0x59, 0xa4, 0x9f, 0xd7,
-	0x54, 0x0d, 0x2e, 0x92, 0x54, 0x2f, 0xb3, 0x69, 0x34, 0x13, 0x6c, 0x9c, 0x88, 0x44, 0x8c, 0x6d,
-	0x66, 0x9a, 0x2d, 0xac, 0xb3, 0xc6, 0xaa, 0xf2, 0xed, 0xe8, 0xb7, 0x01, 0x9d, 0xdb, 0xb2, 0x1b,
-	0x42, 0xb0, 0xc5, 0x09, 0xa3, 0x18, 0x04, 0x20, 0xec, 0xc5, 0x56, 0x1b, 0x66, 0xfa, 0xe2, 0x46,
-	0xc9, 0x8c, 0x46, 0x18, 0x3a, 0x2c, 0xd3, 0x44, 0x0b, 0x89, 0x9b, 0x16, 0xd7, 0xd6, 0x54, 0x66,
-	0x82, 0x31, 0xc2, 0xe7, 0xb8, 0x55, 0x56, 0x2a, 0x6b, 0x2a, 0x3a, 0x65, 0x54, 0x64, 0x1a, 0xb7,
-	0x03, 0x10, 0x7a, 0x71, 0x6d, 0xd1, 0x0d, 0xec, 0x28, 0x31, 0x7b, 0xa2, 0x1a, 0x77, 0x02, 0x10,
-	0xba, 0x97, 0x83, 0xe8, 0x60, 0x9c, 0xa8, 0xfa, 0xdb, 0x9d, 0x4d, 0x4c, 0x5a, 0x9b, 0xed, 0x10,
-	0xc4, 0x55, 0x1e, 0x85, 0xb0, 0x5b, 0x2d, 0x42, 0x61, 0x27, 0x68, 0x86, 0xbd, 0x49, 0x7f, 0xb7,
-	0x1d, 0xee, 0x59, 0xbc, 0x57, 0xe8, 0x0c, 0x3a, 0x8b, 0x74, 0xa5, 0x4d, 0xb0, 0x6b, 0x83, 0xee,
-	0x6e, 0x3b, 0xac, 0x51, 0x5c, 0x0b, 0x74, 0x0e, 0xbb, 0x94, 0xe7, 0x8f, 0x39, 0x91, 0x0a, 0xf7,
-	0xfe, 0x1b, 0xd6, 0x2c, 0x76, 0x28, 0xcf, 0xef, 0x89, 0x54, 0x28, 0x80, 0x2e, 0xe5, 0x79, 0x2a,
-	0x05, 0x67, 0x94, 0x6b, 0x0c, 0xed, 0xac, 0x87, 0x08, 0x8d, 0x60, 0x5f, 0xc8, 0x84, 0xf0, 0xf4,
-	0x85, 0xe8, 0x54, 0x70, 0xec, 0xda, 0xc8, 0x11, 0x1b, 0x5d, 0x43, 0xef, 0x68, 0x3c, 0xb3, 0xec,
-	0xa5, 0x50, 0xba, 0x3e, 0x80, 0xd1, 0x86, 0xad, 0x85, 0xd4, 0xf6, 0x00, 0x5e, 0x6c, 0xf5, 0xe4,
-	0xf4, 0xe7, 0xcb, 0x07, 0x6f, 0x85, 0x0f, 0xde, 0x0b, 0x1f, 0x6c, 0x0a, 0x1f, 0x7c, 0x14, 0x3e,
-	0xf8, 0x2c, 0x7c, 0xf0, 0xfa, 0xed, 0x9f, 0x3c, 0xb4, 0xed, 0xe6, 0xa6, 0x1d, 0x7b, 0xe0, 0xab,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x67, 0x62, 0x55, 0xbc, 0x2d, 0x02, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0xcf, 0x6e, 0xd4, 0x3c,
+	0x14, 0xc5, 0x3f, 0x7f, 0xd3, 0xce, 0x1f, 0xcf, 0xa4, 0xb4, 0x2e, 0x54, 0xa6, 0x12, 0x99, 0xd0,
+	0x0a, 0x91, 0x05, 0xa4, 0x12, 0x2c, 0x80, 0x25, 0xb3, 0x62, 0xd1, 0x95, 0x11, 0x20, 0xb1, 0x89,
+	0x3c, 0x19, 0xcf, 0x34, 0xea, 0xc4, 0x1e, 0xd9, 0xce, 0x54, 0xe5, 0x21, 0x58, 0xf3, 0x08, 0x3c,
+	0x02, 0x8f, 0xd0, 0x25, 0x4f, 0x10, 0x41, 0xd8, 0xcd, 0x13, 0xb0, 0x44, 0xbe, 0x49, 0x4a, 0x86,
+	0xdd, 0x39, 0xbf, 0x7b, 0x62, 0xf9, 0xde, 0xf8, 0x62, 0xef, 0x82, 0xcb, 0xd9, 0x52, 0xe8, 0x68,
+	0xa5, 0x95, 0x55, 0x64, 0x68, 0x84, 0x34, 0x79, 0x64, 0xaf, 0x57, 0xc2, 0x1c, 0x3f, 0x5d, 0xa4,
+	0xf6, 0x22, 0x9f, 0x46, 0x89, 0xca, 0xce, 0x16, 0x6a, 0xa1, 0xce, 0x20, 0x33, 0xcd, 0xe7, 0xe0,
+	0xc0, 0x80, 0xaa, 0xbe, 0x3d, 0xf9, 0xdc, 0xc5, 0xbd, 0x37, 0xd5, 0x69, 0x84, 0xe0, 0x1d, 0xc9,
+	0x33, 0x41, 0x51, 0x80, 0xc2, 0x01, 0x03, 0xed, 0x98, 0x3b, 0x97, 0xfe, 0x5f, 0x31, 0xa7, 0x09,
+	0xc5, 0xbd, 0x2c, 0xb7, 0xdc, 0x2a, 0x4d, 0x3b, 0x80, 0x1b, 0xeb, 0x2a, 0x89, 0xca, 0x32, 0x2e,
+	0x67, 0x74, 0xa7, 0xaa, 0xd4, 0xd6, 0x55, 0x6c, 0x9a, 0x09, 0x95, 0x5b, 0xba, 0x1b, 0xa0, 0xd0,
+	0x63, 0x8d, 0x25, 0x2f, 0x71, 0xd7, 0xa8, 0xe4, 0x52, 0x58, 0xda, 0x0d, 0x50, 0x38, 0x7c, 0x76,
+	0x1c, 0xb5, 0xda, 0x89, 0xea, 0xbb, 0xbd, 0x85, 0xc4, 0x64, 0xe7, 0xa6, 0x18, 0x23, 0x56, 0xe7,
+	0x49, 0x88, 0xfb, 0xf5, 0x20, 0x0c, 0xed, 0x05, 0x9d, 0x70, 0x30, 0x19, 0x6d, 0x8a, 0xf1, 0x2d,
+	0x63, 0xb7, 0x8a, 0x3c, 0xc2, 0xbd, 0x79, 0xba, 0xb4, 0x2e, 0xd8, 0x87, 0xe0, 0x70, 0x53, 0x8c,
+	0x1b, 0xc4, 0x1a, 0x41, 0x1e, 0xe3, 0xbe, 0x90, 0xeb, 0x78, 0xcd, 0xb5, 0xa1, 0x83, 0xbf, 0x07,
+	0x36, 0x8c, 0xf5, 0x84, 0x5c, 0xbf, 0xe7, 0xda, 0x90, 0x00, 0x0f, 0x85, 0x5c, 0xa7, 0x5a, 0xc9,
+	0x4c, 0x48, 0x4b, 0x31, 0xf4, 0xda, 0x46, 0xe4, 0x04, 0x8f, 0x94, 0x5e, 0x70, 0x99, 0x7e, 0xe2,
+	0x36, 0x55, 0x92, 0x0e, 0x21, 0xb2, 0xc5, 0xc8, 0x03, 0x8c, 0x35, 0xb7, 0x22, 0x5e, 0xa6, 0x59,
+	0x6a, 0xe9, 0x08, 0xc6, 0x32, 0x70, 0xe4, 0xdc, 0x01, 0xf2, 0x10, 0x8f, 0x66, 0x62, 0x96, 0xaf,
+	0xe2, 0xab, 0x54, 0xce, 0xd4, 0x15, 0xf5, 0x20, 0x30, 0x04, 0xf6, 0x01, 0x10, 0x39, 0xc2, 0xdd,
+	0xa5, 0x58, 0xf0, 0xe4, 0x9a, 0xee, 0x05, 0x28, 0xec, 0xb3, 0xda, 0x91, 0x08, 0x63, 0x23, 0xd6,
+	0x42, 0xa7, 0x36, 0x15, 0x86, 0xde, 0x81, 0x56, 0xf6, 0x36, 0xc5, 0xb8, 0x45, 0x59, 0x4b, 0x93,
+	0x57, 0x78, 0x4f, 0xe7, 0xd2, 0xfd, 0x91, 0x98, 0x1b, 0x23, 0xac, 0xa1, 0xfb, 0xf0, 0x0d, 0xd9,
+	0x14, 0xe3, 0x7f, 0x2a, 0xcc, 0xab, 0xfd, 0x6b, 0xb0, 0xe4, 0x3e, 0xee, 0xe4, 0x7a, 0x49, 0x0f,
+	0x5c, 0x7f, 0x93, 0x5e, 0x59, 0x8c, 0x3b, 0xef, 0xd8, 0x39, 0x73, 0xcc, 0xdd, 0x6e, 0xae, 0x74,
+	0xc6, 0x2d, 0x25, 0xd0, 0x7d, 0xed, 0xdc, 0x5b, 0xd0, 0xc2, 0x6a, 0x77, 0xb5, 0xc3, 0xea, 0x2d,
+	0xd4, 0x96, 0x9c, 0x62, 0xcf, 0xc9, 0xeb, 0x78, 0xca, 0x93, 0x4b, 0x35, 0x9f, 0xd3, 0xbb, 0x50,
+	0x1f, 0x01, 0x9c, 0x54, 0x8c, 0x3c, 0xc1, 0x24, 0x51, 0xd2, 0xf2, 0xc4, 0xc6, 0xad, 0xf1, 0xdd,
+	0x83, 0xe4, 0x7e, 0x5d, 0x61, 0xb7, 0x53, 0x8c, 0xf0, 0xe1, 0x56, 0xba, 0x1e, 0xe6, 0x11, 0xc4,
+	0x0f, 0x5a, 0xf1, 0x6a, 0xa4, 0x27, 0x2f, 0xb0, 0xb7, 0xf5, 0xe6, 0xdc, 0x06, 0x5c, 0x28, 0x63,
+	0x9b, 0xad, 0x70, 0xda, 0xb1, 0x95, 0xd2, 0x16, 0xb6, 0xc2, 0x63, 0xa0, 0x27, 0xa7, 0xbf, 0x7f,
+	0xfa, 0xe8, 0x6b, 0xe9, 0xa3, 0x6f, 0xa5, 0x8f, 0x6e, 0x4a, 0x1f, 0x7d, 0x2f, 0x7d, 0xf4, 0xa3,
+	0xf4, 0xd1, 0x97, 0x5f, 0xfe, 0x7f, 0x1f, 0x77, 0xe1, 0x39, 0x4f, 0xbb, 0xb0, 0x75, 0xcf, 0xff,
+	0x0c, 0x00, 0x0a, 0xab, 0x96, 0x4f, 0xc2, 0x03, 0x00, 0x00,
 }
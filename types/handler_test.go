@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -46,6 +47,72 @@ func TestHandlerValidate(t *testing.T) {
 	assert.Error(t, h.Validate())
 	h.Environment = "default"
 
+	// Invalid severity
+	h.Severities = []string{"critical", "fatal"}
+	assert.Error(t, h.Validate())
+	h.Severities = []string{"critical", "unknown"}
+
+	// Invalid runtime asset
+	h.RuntimeAssets = []string{"ruby", ""}
+	assert.Error(t, h.Validate())
+	h.RuntimeAssets = []string{"ruby"}
+
+	// Invalid format
+	h.Format = HandlerFormatCloudEvents
+	assert.Error(t, h.Validate())
+	h.Format = ""
+
+	// Invalid retries
+	h.Retries = MaxHandlerRetries + 1
+	assert.Error(t, h.Validate())
+	h.Retries = 3
+
 	// Valid handler
 	assert.NoError(t, h.Validate())
 }
+
+func TestWebhookHandlerValidate(t *testing.T) {
+	h := FixtureWebhookHandler("webhook", "ftp://example.com")
+	assert.Error(t, h.Validate())
+
+	h.URL = "https://example.com/events"
+	assert.NoError(t, h.Validate())
+
+	h.Format = "xml"
+	assert.Error(t, h.Validate())
+
+	h.Format = HandlerFormatCloudEvents
+	assert.NoError(t, h.Validate())
+}
+
+func TestSetHandlerValidateRetries(t *testing.T) {
+	h := FixtureSetHandler("set", "slack")
+	h.Type = HandlerSetType
+	assert.NoError(t, h.Validate())
+
+	h.Retries = 1
+	assert.Error(t, h.Validate())
+}
+
+func TestSetHandlerValidateContactRateLimit(t *testing.T) {
+	h := FixtureSetHandler("set", "slack")
+	h.Type = HandlerSetType
+	h.ContactRateLimit = 5
+	assert.Error(t, h.Validate())
+}
+
+func TestHandlerContactWindow(t *testing.T) {
+	h := FixtureHandler("pagerduty")
+	assert.Equal(t, time.Hour, h.ContactWindow())
+
+	h.ContactRateWindow = 600
+	assert.Equal(t, 10*time.Minute, h.ContactWindow())
+}
+
+func TestSeverity(t *testing.T) {
+	assert.Equal(t, "ok", Severity(0))
+	assert.Equal(t, "warning", Severity(1))
+	assert.Equal(t, "critical", Severity(2))
+	assert.Equal(t, "unknown", Severity(3))
+	assert.Equal(t, "unknown", Severity(127))
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"net/url"
+	"path"
 	"regexp"
 	"time"
 )
@@ -102,6 +104,17 @@ func (h *HookList) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// SetNamespace sets the organization and environment of the hookconfig.
+func (c *HookConfig) SetNamespace(org, env string) {
+	c.Organization = org
+	c.Environment = env
+}
+
+// URIPath returns the path of the hook, relative to the API root.
+func (c *HookConfig) URIPath() string {
+	return path.Join("/hooks", url.PathEscape(c.Name))
+}
+
 // FixtureHookConfig returns a fixture for a HookConfig object.
 func FixtureHookConfig(id string) *HookConfig {
 	timeout := uint32(10)
@@ -0,0 +1,179 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+const (
+	// IncidentCorrelationCheck groups the events of the same check across
+	// entities
+	IncidentCorrelationCheck = "check"
+
+	// IncidentCorrelationEntity groups the events of the same entity across
+	// checks
+	IncidentCorrelationEntity = "entity"
+
+	// IncidentOpen is the state of an incident with failing events
+	IncidentOpen = "open"
+
+	// IncidentAcknowledged is the state of an incident with failing events,
+	// which an operator is taking care of
+	IncidentAcknowledged = "acknowledged"
+
+	// IncidentResolved is the state of an incident whose events all passed
+	IncidentResolved = "resolved"
+)
+
+// Validate returns an error if the incident does not pass validation tests.
+func (i *Incident) Validate() error {
+	if i.ID == "" {
+		return errors.New("incident ID must be set")
+	}
+
+	switch i.Correlation {
+	case IncidentCorrelationCheck, IncidentCorrelationEntity:
+	default:
+		return fmt.Errorf("incident correlation %q is invalid", i.Correlation)
+	}
+
+	if i.Key == "" {
+		return errors.New("incident key must be set")
+	}
+
+	switch i.State {
+	case IncidentOpen, IncidentAcknowledged, IncidentResolved:
+	default:
+		return fmt.Errorf("incident state %q is invalid", i.State)
+	}
+
+	if i.Environment == "" {
+		return errors.New("incident environment must be set")
+	}
+
+	if i.Organization == "" {
+		return errors.New("incident organization must be set")
+	}
+
+	return nil
+}
+
+// Update updates i with selected fields. Returns non-nil error if any of the
+// selected fields are unsupported.
+func (i *Incident) Update(from *Incident, fields ...string) error {
+	for _, f := range fields {
+		switch f {
+		case "State":
+			i.State = from.State
+		default:
+			return fmt.Errorf("unsupported field: %q", f)
+		}
+	}
+	return nil
+}
+
+// IsResolved returns whether the incident was resolved.
+func (i *Incident) IsResolved() bool {
+	return i.State == IncidentResolved
+}
+
+// Contains returns whether the event of the given entity and check is grouped
+// into the incident.
+func (i *Incident) Contains(entity, check string) bool {
+	return i.indexOf(entity, check) >= 0
+}
+
+// Correlates returns whether the given event is related to the events of the
+// incident, according to its correlation.
+func (i *Incident) Correlates(event *Event) bool {
+	if event.Entity == nil || event.Check == nil {
+		return false
+	}
+	if event.Entity.Organization != i.Organization || event.Entity.Environment != i.Environment {
+		return false
+	}
+
+	switch i.Correlation {
+	case IncidentCorrelationCheck:
+		return event.Check.Name == i.Key
+	case IncidentCorrelationEntity:
+		return event.Entity.ID == i.Key
+	}
+	return false
+}
+
+// Record adds the event to the incident, or updates its last known state if
+// it was already grouped into the incident. The incident is resolved once all
+// its events passed.
+func (i *Incident) Record(event *Event) {
+	e := IncidentEvent{
+		Entity:    event.Entity.ID,
+		Check:     event.Check.Name,
+		Status:    event.Check.Status,
+		Timestamp: event.Timestamp,
+	}
+	if idx := i.indexOf(e.Entity, e.Check); idx >= 0 {
+		i.Events[idx] = e
+	} else {
+		i.Events = append(i.Events, e)
+	}
+
+	if event.Timestamp > i.UpdatedAt {
+		i.UpdatedAt = event.Timestamp
+	}
+
+	i.Status = 0
+	for _, e := range i.Events {
+		if e.Status > i.Status {
+			i.Status = e.Status
+		}
+	}
+
+	if i.Status == 0 && !i.IsResolved() {
+		i.State = IncidentResolved
+		i.ResolvedAt = i.UpdatedAt
+	}
+}
+
+func (i *Incident) indexOf(entity, check string) int {
+	for idx, e := range i.Events {
+		if e.Entity == entity && e.Check == check {
+			return idx
+		}
+	}
+	return -1
+}
+
+// SetNamespace sets the organization and environment of the incident.
+func (i *Incident) SetNamespace(org, env string) {
+	i.Organization = org
+	i.Environment = env
+}
+
+// URIPath returns the path of the incident, relative to the API root.
+func (i *Incident) URIPath() string {
+	return path.Join("/incidents", url.PathEscape(i.ID))
+}
+
+// FixtureIncident returns an open Incident fixture for testing, grouping the
+// events of the given check on two entities.
+func FixtureIncident(id, check string) *Incident {
+	incident := &Incident{
+		ID:           id,
+		Correlation:  IncidentCorrelationCheck,
+		Key:          check,
+		State:        IncidentOpen,
+		Events:       []IncidentEvent{},
+		Environment:  "default",
+		Organization: "default",
+	}
+	for _, entity := range []string{"entity1", "entity2"} {
+		event := FixtureEvent(entity, check)
+		event.Check.Status = 2
+		incident.Record(event)
+	}
+	incident.StartedAt = incident.UpdatedAt
+	return incident
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncidentValidate(t *testing.T) {
+	i := FixtureIncident("abc", "check_cpu")
+	assert.NoError(t, i.Validate())
+
+	i.Correlation = "subscription"
+	assert.Error(t, i.Validate())
+	i.Correlation = IncidentCorrelationEntity
+
+	i.State = "closed"
+	assert.Error(t, i.Validate())
+	i.State = IncidentAcknowledged
+
+	i.ID = ""
+	assert.Error(t, i.Validate())
+}
+
+func TestIncidentCorrelates(t *testing.T) {
+	i := FixtureIncident("abc", "check_cpu")
+
+	assert.True(t, i.Correlates(FixtureEvent("entity3", "check_cpu")))
+	assert.False(t, i.Correlates(FixtureEvent("entity1", "check_disk")))
+
+	i.Correlation = IncidentCorrelationEntity
+	i.Key = "entity1"
+	assert.True(t, i.Correlates(FixtureEvent("entity1", "check_disk")))
+	assert.False(t, i.Correlates(FixtureEvent("entity3", "check_cpu")))
+
+	// The events of other namespaces never correlate
+	event := FixtureEvent("entity1", "check_disk")
+	event.Entity.Environment = "dev"
+	assert.False(t, i.Correlates(event))
+}
+
+func TestIncidentRecord(t *testing.T) {
+	i := FixtureIncident("abc", "check_cpu")
+	assert.Equal(t, int32(2), i.Status)
+	assert.True(t, i.Contains("entity1", "check_cpu"))
+	assert.False(t, i.Contains("entity3", "check_cpu"))
+
+	warning := FixtureEvent("entity3", "check_cpu")
+	warning.Check.Status = 1
+	i.Record(warning)
+	assert.Len(t, i.Events, 3)
+
+	// The incident remains open until all its events passed
+	for _, entity := range []string{"entity1", "entity2"} {
+		i.Record(FixtureEvent(entity, "check_cpu"))
+	}
+	assert.Len(t, i.Events, 3)
+	assert.Equal(t, int32(1), i.Status)
+	assert.Equal(t, IncidentOpen, i.State)
+
+	i.Record(FixtureEvent("entity3", "check_cpu"))
+	assert.Equal(t, int32(0), i.Status)
+	assert.Equal(t, IncidentResolved, i.State)
+	assert.NotZero(t, i.ResolvedAt)
+}
@@ -0,0 +1,162 @@
+package types
+
+import (
+	"errors"
+	"net"
+	"strconv"
+)
+
+const (
+	// LegacyActionCreate is the action of the legacy events of an incident
+	LegacyActionCreate = "create"
+
+	// LegacyActionResolve is the action of the legacy events resolving an
+	// incident
+	LegacyActionResolve = "resolve"
+
+	// LegacyActionFlapping is the action of the legacy events of a flapping
+	// check
+	LegacyActionFlapping = "flapping"
+)
+
+// LegacyEvent is an event in the Sensu 1.x format, with client and check keys.
+// It is provided to the handlers flagged as legacy, so the handler plugins
+// written for Sensu 1.x keep working unmodified.
+type LegacyEvent struct {
+	// Client is the entity of the event
+	Client *LegacyClient `json:"client"`
+
+	// Check is the check result of the event
+	Check *LegacyCheck `json:"check"`
+
+	// Occurrences is the number of consecutive results with the current
+	// status
+	Occurrences int `json:"occurrences"`
+
+	// Action is create, resolve or flapping
+	Action string `json:"action"`
+
+	// Timestamp is the unix time of the event
+	Timestamp int64 `json:"timestamp"`
+
+	// LastOK is the unix time of the last successful result
+	LastOK int64 `json:"last_ok"`
+
+	// Silenced indicates whether the event is silenced
+	Silenced bool `json:"silenced"`
+
+	// SilencedBy is the list of silenced entries matching the event
+	SilencedBy []string `json:"silenced_by"`
+}
+
+// LegacyClient is an entity in the Sensu 1.x format.
+type LegacyClient struct {
+	Name          string   `json:"name"`
+	Address       string   `json:"address"`
+	Subscriptions []string `json:"subscriptions"`
+	Environment   string   `json:"environment"`
+	Organization  string   `json:"organization"`
+	Timestamp     int64    `json:"timestamp"`
+}
+
+// LegacyCheck is a check result in the Sensu 1.x format.
+type LegacyCheck struct {
+	Name             string   `json:"name"`
+	Command          string   `json:"command"`
+	Subscribers      []string `json:"subscribers"`
+	Handlers         []string `json:"handlers"`
+	Interval         uint32   `json:"interval"`
+	Timeout          uint32   `json:"timeout,omitempty"`
+	TTL              int64    `json:"ttl,omitempty"`
+	Source           string   `json:"source,omitempty"`
+	Issued           int64    `json:"issued"`
+	Executed         int64    `json:"executed"`
+	Duration         float64  `json:"duration"`
+	Output           string   `json:"output"`
+	Status           int32    `json:"status"`
+	History          []string `json:"history"`
+	TotalStateChange uint32   `json:"total_state_change"`
+}
+
+// LegacyEvent translates the event to the Sensu 1.x format. An error is
+// returned if the event has no check result, since Sensu 1.x events always
+// have one.
+func (e *Event) LegacyEvent() (*LegacyEvent, error) {
+	if !e.HasCheck() || e.Entity == nil {
+		return nil, errors.New("only check events can be translated to the legacy format")
+	}
+
+	check := e.Check
+	history := make([]string, 0, len(check.History)+1)
+	occurrences := 1
+	for i := len(check.History) - 1; i >= 0 && check.History[i].Status == check.Status; i-- {
+		occurrences++
+	}
+	for _, h := range check.History {
+		history = append(history, strconv.FormatInt(int64(h.Status), 10))
+	}
+	history = append(history, strconv.FormatInt(int64(check.Status), 10))
+
+	action := LegacyActionCreate
+	if check.State == EventFlappingState {
+		action = LegacyActionFlapping
+	} else if e.IsResolution() {
+		action = LegacyActionResolve
+	}
+
+	silencedBy := e.Silenced
+	if silencedBy == nil {
+		silencedBy = []string{}
+	}
+
+	return &LegacyEvent{
+		Client: &LegacyClient{
+			Name:          e.Entity.ID,
+			Address:       legacyAddress(e.Entity),
+			Subscriptions: e.Entity.Subscriptions,
+			Environment:   e.Entity.Environment,
+			Organization:  e.Entity.Organization,
+			Timestamp:     e.Entity.LastSeen,
+		},
+		Check: &LegacyCheck{
+			Name:             check.Name,
+			Command:          check.Command,
+			Subscribers:      check.Subscriptions,
+			Handlers:         check.Handlers,
+			Interval:         check.Interval,
+			Timeout:          check.Timeout,
+			TTL:              check.Ttl,
+			Source:           check.ProxyEntityID,
+			Issued:           check.Issued,
+			Executed:         check.Executed,
+			Duration:         check.Duration,
+			Output:           check.Output,
+			Status:           check.Status,
+			History:          history,
+			TotalStateChange: check.TotalStateChange,
+		},
+		Occurrences: occurrences,
+		Action:      action,
+		Timestamp:   e.Timestamp,
+		LastOK:      check.LastOK,
+		Silenced:    e.IsSilenced(),
+		SilencedBy:  silencedBy,
+	}, nil
+}
+
+// legacyAddress returns the first non-loopback IP address of the entity
+// network interfaces, or its hostname if it has none.
+func legacyAddress(entity *Entity) string {
+	for _, iface := range entity.System.Network.Interfaces {
+		for _, addr := range iface.Addresses {
+			ip, _, err := net.ParseCIDR(addr)
+			if err != nil {
+				ip = net.ParseIP(addr)
+			}
+			if ip != nil && !ip.IsLoopback() {
+				return ip.String()
+			}
+		}
+	}
+	return entity.System.Hostname
+}
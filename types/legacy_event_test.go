@@ -0,0 +1,67 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLegacyEvent(t *testing.T) {
+	event := FixtureEvent("entity", "check")
+	event.Entity.System.Hostname = "localhost"
+	event.Entity.System.Network.Interfaces = []NetworkInterface{
+		{Name: "lo", Addresses: []string{"127.0.0.1/8"}},
+		{Name: "eth0", Addresses: []string{"10.0.0.1/24"}},
+	}
+	event.Check.Status = 2
+	event.Check.History = []CheckHistory{
+		{Status: 0, Executed: 1},
+		{Status: 2, Executed: 2},
+		{Status: 2, Executed: 3},
+	}
+	event.Silenced = []string{"entity:entity:*"}
+
+	legacy, err := event.LegacyEvent()
+	require.NoError(t, err)
+	assert.Equal(t, "entity", legacy.Client.Name)
+	assert.Equal(t, "10.0.0.1", legacy.Client.Address)
+	assert.Equal(t, "check", legacy.Check.Name)
+	assert.Equal(t, []string{"0", "2", "2", "2"}, legacy.Check.History)
+	assert.Equal(t, 3, legacy.Occurrences)
+	assert.Equal(t, LegacyActionCreate, legacy.Action)
+	assert.True(t, legacy.Silenced)
+
+	// The legacy format has client and check keys
+	b, err := json.Marshal(legacy)
+	require.NoError(t, err)
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &fields))
+	assert.Contains(t, fields, "client")
+	assert.Contains(t, fields, "check")
+
+	// Resolution
+	event.Check.Status = 0
+	legacy, err = event.LegacyEvent()
+	require.NoError(t, err)
+	assert.Equal(t, LegacyActionResolve, legacy.Action)
+	assert.Equal(t, 1, legacy.Occurrences)
+
+	// Flapping
+	event.Check.State = EventFlappingState
+	legacy, err = event.LegacyEvent()
+	require.NoError(t, err)
+	assert.Equal(t, LegacyActionFlapping, legacy.Action)
+
+	// The address falls back to the hostname
+	event.Entity.System.Network.Interfaces = nil
+	legacy, err = event.LegacyEvent()
+	require.NoError(t, err)
+	assert.Equal(t, "localhost", legacy.Client.Address)
+
+	// Metrics events can't be translated
+	event.Check = nil
+	_, err = event.LegacyEvent()
+	assert.Error(t, err)
+}
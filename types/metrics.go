@@ -1,14 +1,94 @@
 package types
 
 import (
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 )
 
+const (
+	// MetricRetentionAll persists every metric point of the events
+	MetricRetentionAll = "all"
+
+	// MetricRetentionDownsample persists the latest metric point of each
+	// series, identified by its name and tags
+	MetricRetentionDownsample = "downsample"
+
+	// MetricRetentionNone persists no metric points
+	MetricRetentionNone = "none"
+)
+
+// ValidateMetricRetention returns an error if the metric retention policy is
+// unknown. An empty policy is valid and means MetricRetentionAll.
+func ValidateMetricRetention(policy string) error {
+	switch policy {
+	case "", MetricRetentionAll, MetricRetentionDownsample, MetricRetentionNone:
+		return nil
+	}
+
+	return fmt.Errorf(
+		"metric retention must be one of %q, %q or %q",
+		MetricRetentionAll, MetricRetentionDownsample, MetricRetentionNone,
+	)
+}
+
 // Validate returns an error if metrics does not pass validation tests.
 func (m *Metrics) Validate() error {
 	return nil
 }
 
+// Retain returns the metrics to persist according to the metric retention
+// policy. The metrics themselves are left untouched; a copy is returned if
+// any point is dropped.
+func (m *Metrics) Retain(policy string) *Metrics {
+	if m == nil {
+		return nil
+	}
+
+	switch policy {
+	case MetricRetentionNone:
+		return &Metrics{Handlers: m.Handlers}
+	case MetricRetentionDownsample:
+		return &Metrics{Handlers: m.Handlers, Points: latestPoints(m.Points)}
+	}
+
+	return m
+}
+
+// latestPoints returns the most recent point of each series, preserving the
+// order in which the series first appear.
+func latestPoints(points []*MetricPoint) []*MetricPoint {
+	latest := make([]*MetricPoint, 0, len(points))
+	index := make(map[string]int, len(points))
+
+	for _, point := range points {
+		key := point.seriesKey()
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(latest)
+			latest = append(latest, point)
+			continue
+		}
+		if point.Timestamp >= latest[i].Timestamp {
+			latest[i] = point
+		}
+	}
+
+	return latest
+}
+
+// seriesKey identifies the series of the point by its name and sorted tags.
+func (p *MetricPoint) seriesKey() string {
+	tags := make([]string, 0, len(p.Tags))
+	for _, tag := range p.Tags {
+		tags = append(tags, tag.Name+"="+tag.Value)
+	}
+	sort.Strings(tags)
+
+	return p.Name + "," + strings.Join(tags, ",")
+}
+
 // FixtureMetrics returns a testing fixture for a Metrics object.
 func FixtureMetrics() *Metrics {
 	return &Metrics{
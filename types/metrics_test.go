@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMetricRetention(t *testing.T) {
+	assert.NoError(t, ValidateMetricRetention(""))
+	assert.NoError(t, ValidateMetricRetention(MetricRetentionAll))
+	assert.NoError(t, ValidateMetricRetention(MetricRetentionDownsample))
+	assert.NoError(t, ValidateMetricRetention(MetricRetentionNone))
+	assert.Error(t, ValidateMetricRetention("latest"))
+}
+
+func TestMetricsRetain(t *testing.T) {
+	tag := func(name, value string) []*MetricTag {
+		return []*MetricTag{{Name: name, Value: value}}
+	}
+	metrics := &Metrics{
+		Handlers: []string{"influxdb"},
+		Points: []*MetricPoint{
+			{Name: "cpu", Value: 1, Timestamp: 1, Tags: tag("core", "0")},
+			{Name: "cpu", Value: 2, Timestamp: 1, Tags: tag("core", "1")},
+			{Name: "cpu", Value: 3, Timestamp: 2, Tags: tag("core", "0")},
+			{Name: "mem", Value: 4, Timestamp: 1},
+			{Name: "mem", Value: 5, Timestamp: 0},
+		},
+	}
+
+	var nilMetrics *Metrics
+	assert.Nil(t, nilMetrics.Retain(MetricRetentionNone))
+
+	assert.Equal(t, metrics, metrics.Retain(""))
+	assert.Equal(t, metrics, metrics.Retain(MetricRetentionAll))
+
+	none := metrics.Retain(MetricRetentionNone)
+	assert.Equal(t, []string{"influxdb"}, none.Handlers)
+	assert.Empty(t, none.Points)
+
+	downsampled := metrics.Retain(MetricRetentionDownsample)
+	assert.Equal(t, []string{"influxdb"}, downsampled.Handlers)
+	assert.Equal(t, []*MetricPoint{
+		metrics.Points[2], metrics.Points[1], metrics.Points[3],
+	}, downsampled.Points)
+
+	// The metrics aren't modified
+	assert.Len(t, metrics.Points, 5)
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"errors"
 	fmt "fmt"
+	"net/url"
+	"path"
 )
 
 // Validate returns an error if the mutator does not pass validation tests.
@@ -23,6 +25,12 @@ func (m *Mutator) Validate() error {
 		return errors.New("mutator organization must be set")
 	}
 
+	for _, assetName := range m.RuntimeAssets {
+		if err := ValidateAssetName(assetName); err != nil {
+			return fmt.Errorf("asset's %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -37,6 +45,8 @@ func (m *Mutator) Update(from *Mutator, fields ...string) error {
 			m.Timeout = from.Timeout
 		case "EnvVars":
 			m.EnvVars = append(m.EnvVars[0:0], from.EnvVars...)
+		case "RuntimeAssets":
+			m.RuntimeAssets = append(m.RuntimeAssets[0:0], from.RuntimeAssets...)
 		default:
 			return fmt.Errorf("unsupported field: %q", f)
 		}
@@ -44,6 +54,17 @@ func (m *Mutator) Update(from *Mutator, fields ...string) error {
 	return nil
 }
 
+// SetNamespace sets the organization and environment of the mutator.
+func (m *Mutator) SetNamespace(org, env string) {
+	m.Organization = org
+	m.Environment = env
+}
+
+// URIPath returns the path of the mutator, relative to the API root.
+func (m *Mutator) URIPath() string {
+	return path.Join("/mutators", url.PathEscape(m.Name))
+}
+
 // FixtureMutator returns a Mutator fixture for testing.
 func FixtureMutator(name string) *Mutator {
 	return &Mutator{
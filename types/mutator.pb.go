@@ -29,6 +29,9 @@ type Mutator struct {
 	Environment string `protobuf:"bytes,5,opt,name=environment,proto3" json:"environment,omitempty"`
 	// Organization specifies the organization to which the mutator belongs.
 	Organization string `protobuf:"bytes,6,opt,name=organization,proto3" json:"organization,omitempty"`
+	// RuntimeAssets are a list of assets required to execute the mutator
+	// command on the backend.
+	RuntimeAssets []string `protobuf:"bytes,7,rep,name=runtime_assets,json=runtimeAssets" json:"runtime_assets"`
 }
 
 func (m *Mutator) Reset()                    { *m = Mutator{} }
@@ -78,6 +81,13 @@ func (m *Mutator) GetOrganization() string {
 	return ""
 }
 
+func (m *Mutator) GetRuntimeAssets() []string {
+	if m != nil {
+		return m.RuntimeAssets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Mutator)(nil), "sensu.types.Mutator")
 }
@@ -129,6 +139,14 @@ func (this *Mutator) Equal(that interface{}) bool {
 	if this.Organization != that1.Organization {
 		return false
 	}
+	if len(this.RuntimeAssets) != len(that1.RuntimeAssets) {
+		return false
+	}
+	for i := range this.RuntimeAssets {
+		if this.RuntimeAssets[i] != that1.RuntimeAssets[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *Mutator) Marshal() (dAtA []byte, err error) {
@@ -190,6 +208,21 @@ func (m *Mutator) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintMutator(dAtA, i, uint64(len(m.Organization)))
 		i += copy(dAtA[i:], m.Organization)
 	}
+	if len(m.RuntimeAssets) > 0 {
+		for _, s := range m.RuntimeAssets {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -214,6 +247,11 @@ func NewPopulatedMutator(r randyMutator, easy bool) *Mutator {
 	}
 	this.Environment = string(randStringMutator(r))
 	this.Organization = string(randStringMutator(r))
+	v2 := r.Intn(10)
+	this.RuntimeAssets = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.RuntimeAssets[i] = string(randStringMutator(r))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -238,9 +276,9 @@ func randUTF8RuneMutator(r randyMutator) rune {
 	return rune(ru + 61)
 }
 func randStringMutator(r randyMutator) string {
-	v2 := r.Intn(100)
-	tmps := make([]rune, v2)
-	for i := 0; i < v2; i++ {
+	v3 := r.Intn(100)
+	tmps := make([]rune, v3)
+	for i := 0; i < v3; i++ {
 		tmps[i] = randUTF8RuneMutator(r)
 	}
 	return string(tmps)
@@ -262,11 +300,11 @@ func randFieldMutator(dAtA []byte, r randyMutator, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateMutator(dAtA, uint64(key))
-		v3 := r.Int63()
+		v4 := r.Int63()
 		if r.Intn(2) == 0 {
-			v3 *= -1
+			v4 *= -1
 		}
-		dAtA = encodeVarintPopulateMutator(dAtA, uint64(v3))
+		dAtA = encodeVarintPopulateMutator(dAtA, uint64(v4))
 	case 1:
 		dAtA = encodeVarintPopulateMutator(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -319,6 +357,12 @@ func (m *Mutator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMutator(uint64(l))
 	}
+	if len(m.RuntimeAssets) > 0 {
+		for _, s := range m.RuntimeAssets {
+			l = len(s)
+			n += 1 + l + sovMutator(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -528,6 +572,35 @@ func (m *Mutator) Unmarshal(dAtA []byte) error {
 			}
 			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMutator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMutator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeAssets = append(m.RuntimeAssets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMutator(dAtA[iNdEx:])
@@ -657,22 +730,23 @@ var (
 func init() { proto.RegisterFile("mutator.proto", fileDescriptorMutator) }
 
 var fileDescriptorMutator = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xcd, 0x2d, 0x2d, 0x49,
-	0x2c, 0xc9, 0x2f, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2e, 0x4e, 0xcd, 0x2b, 0x2e,
-	0xd5, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x49, 0x2a, 0x4d, 0x03, 0xf3, 0xc0,
-	0x1c, 0x30, 0x0b, 0xa2, 0x57, 0xe9, 0x00, 0x23, 0x17, 0xbb, 0x2f, 0xc4, 0x34, 0x21, 0x21, 0x2e,
-	0x96, 0xbc, 0xc4, 0xdc, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x30, 0x5b, 0x48, 0x82,
-	0x8b, 0x3d, 0x39, 0x3f, 0x37, 0x37, 0x31, 0x2f, 0x45, 0x82, 0x09, 0x2c, 0x0c, 0xe3, 0x82, 0x64,
-	0x4a, 0x32, 0x73, 0x53, 0xf3, 0x4b, 0x4b, 0x24, 0x98, 0x15, 0x18, 0x35, 0x78, 0x83, 0x60, 0x5c,
-	0x21, 0x75, 0x2e, 0x8e, 0xd4, 0xbc, 0xb2, 0xf8, 0xb2, 0xc4, 0xa2, 0x62, 0x09, 0x16, 0x05, 0x66,
-	0x0d, 0x4e, 0x27, 0x9e, 0x57, 0xf7, 0xe4, 0xe1, 0x62, 0x41, 0xec, 0xa9, 0x79, 0x65, 0x61, 0x89,
-	0x45, 0xc5, 0x42, 0x0a, 0x5c, 0xdc, 0xa9, 0x79, 0x65, 0x99, 0x45, 0xf9, 0x79, 0xb9, 0xa9, 0x79,
-	0x25, 0x12, 0xac, 0x60, 0x0b, 0x90, 0x85, 0x84, 0x94, 0xb8, 0x78, 0xf2, 0x8b, 0xd2, 0x13, 0xf3,
-	0x32, 0xab, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x24, 0xd8, 0xc0, 0x4a, 0x50, 0xc4, 0x9c, 0x94, 0x7f,
-	0x3c, 0x94, 0x63, 0x5c, 0xf1, 0x48, 0x8e, 0x71, 0xc7, 0x23, 0x39, 0xc6, 0x13, 0x8f, 0xe4, 0x18,
-	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x56, 0x70,
-	0xb0, 0x24, 0xb1, 0x81, 0xbd, 0x6b, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x65, 0xb5, 0x8e,
-	0x3b, 0x01, 0x00, 0x00,
+	// 285 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x3d, 0x4e, 0x33, 0x31,
+	0x10, 0x86, 0x3f, 0x7f, 0xf9, 0x59, 0xe2, 0x24, 0x14, 0xae, 0x2c, 0x0a, 0x67, 0x15, 0x0a, 0xd2,
+	0xb0, 0x29, 0xa8, 0x28, 0x49, 0x4f, 0xb3, 0x05, 0x05, 0x4d, 0xe4, 0x0d, 0x66, 0xd9, 0xc2, 0x33,
+	0x91, 0x7f, 0x56, 0x82, 0x93, 0x70, 0x04, 0x8e, 0xc0, 0x11, 0x28, 0x39, 0x41, 0x04, 0x4b, 0x97,
+	0x13, 0x20, 0xd1, 0xa0, 0x4c, 0x08, 0x02, 0xba, 0x79, 0x9e, 0x19, 0xfb, 0x95, 0x5e, 0x3e, 0xb4,
+	0x31, 0xe8, 0x80, 0x2e, 0x5b, 0x3a, 0x0c, 0x28, 0xfa, 0xde, 0x80, 0x8f, 0x59, 0xb8, 0x5d, 0x1a,
+	0x7f, 0x70, 0x5c, 0x56, 0xe1, 0x26, 0x16, 0xd9, 0x02, 0xed, 0xb4, 0xc4, 0x12, 0xa7, 0x74, 0x53,
+	0xc4, 0x6b, 0x22, 0x02, 0x9a, 0xb6, 0x6f, 0xc7, 0x1f, 0x8c, 0x27, 0xe7, 0xdb, 0xdf, 0x84, 0xe0,
+	0x6d, 0xd0, 0xd6, 0x48, 0x96, 0xb2, 0x49, 0x2f, 0xa7, 0x59, 0x48, 0x9e, 0x2c, 0xd0, 0x5a, 0x0d,
+	0x57, 0xf2, 0x3f, 0xe9, 0x1d, 0x6e, 0x36, 0xa1, 0xb2, 0x06, 0x63, 0x90, 0xad, 0x94, 0x4d, 0x86,
+	0xf9, 0x0e, 0xc5, 0x11, 0xdf, 0x33, 0x50, 0xcf, 0x6b, 0xed, 0xbc, 0x6c, 0xa7, 0xad, 0x49, 0x6f,
+	0x36, 0x58, 0xaf, 0x46, 0xdf, 0x2e, 0x4f, 0x0c, 0xd4, 0x17, 0xda, 0x79, 0x91, 0xf2, 0xbe, 0x81,
+	0xba, 0x72, 0x08, 0xd6, 0x40, 0x90, 0x1d, 0x0a, 0xf8, 0xa9, 0xc4, 0x98, 0x0f, 0xd0, 0x95, 0x1a,
+	0xaa, 0x3b, 0x1d, 0x2a, 0x04, 0xd9, 0xa5, 0x93, 0x5f, 0x4e, 0x9c, 0xf2, 0x7d, 0x17, 0x61, 0x13,
+	0x3e, 0xd7, 0xde, 0x9b, 0xe0, 0x65, 0x42, 0xa1, 0x62, 0xbd, 0x1a, 0xfd, 0xd9, 0xe4, 0xc3, 0x2f,
+	0x3e, 0x23, 0x9c, 0x1d, 0xbe, 0xbf, 0x2a, 0xf6, 0xd0, 0x28, 0xf6, 0xd8, 0x28, 0xf6, 0xd4, 0x28,
+	0xf6, 0xdc, 0x28, 0xf6, 0xd2, 0x28, 0x76, 0xff, 0xa6, 0xfe, 0x5d, 0x76, 0xa8, 0xd1, 0xa2, 0x4b,
+	0x4d, 0x9d, 0x7c, 0x0e, 0x00, 0xfb, 0x3a, 0xad, 0x0f, 0x76, 0x01, 0x00, 0x00,
 }
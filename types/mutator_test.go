@@ -31,6 +31,11 @@ func TestMutatorValidate(t *testing.T) {
 	assert.Error(t, m.Validate())
 	m.Environment = "default"
 
+	// Invalid runtime asset
+	m.RuntimeAssets = []string{""}
+	assert.Error(t, m.Validate())
+	m.RuntimeAssets = []string{"jq"}
+
 	// Valid mutator
 	assert.NoError(t, m.Validate())
 }
@@ -1,6 +1,9 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // Validate returns an error if the organization does not pass validation tests
 func (o *Organization) Validate() error {
@@ -17,3 +20,20 @@ func FixtureOrganization(name string) *Organization {
 		Name: name,
 	}
 }
+
+// Update updates an Organization with selected fields.
+func (o *Organization) Update(from *Organization, fields ...string) error {
+	for _, f := range fields {
+		switch f {
+		case "Description":
+			o.Description = from.Description
+		case "Labels":
+			o.Labels = from.Labels
+		case "Annotations":
+			o.Annotations = from.Annotations
+		default:
+			return fmt.Errorf("unsupported update field: %q", f)
+		}
+	}
+	return nil
+}
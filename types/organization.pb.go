@@ -21,6 +21,11 @@ type Organization struct {
 	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description"`
 	// Name is the unique identifier for an organization.
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	// Labels are key-value pairs inherited by the events of the organization.
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Annotations are key-value pairs inherited by the events of the
+	// organization, which aren't meant to be used for routing (e.g. runbooks).
+	Annotations map[string]string `protobuf:"bytes,4,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *Organization) Reset()                    { *m = Organization{} }
@@ -42,6 +47,20 @@ func (m *Organization) GetName() string {
 	return ""
 }
 
+func (m *Organization) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Organization) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Organization)(nil), "sensu.types.Organization")
 }
@@ -76,6 +95,22 @@ func (this *Organization) Equal(that interface{}) bool {
 	if this.Name != that1.Name {
 		return false
 	}
+	if len(this.Labels) != len(that1.Labels) {
+		return false
+	}
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
+	}
+	if len(this.Annotations) != len(that1.Annotations) {
+		return false
+	}
+	for i := range this.Annotations {
+		if this.Annotations[i] != that1.Annotations[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *Organization) Marshal() (dAtA []byte, err error) {
@@ -105,6 +140,40 @@ func (m *Organization) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintOrganization(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
+	if len(m.Labels) > 0 {
+		for k, _ := range m.Labels {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Labels[k]
+			mapSize := 1 + len(k) + sovOrganization(uint64(len(k))) + 1 + len(v) + sovOrganization(uint64(len(v)))
+			i = encodeVarintOrganization(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintOrganization(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintOrganization(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, _ := range m.Annotations {
+			dAtA[i] = 0x22
+			i++
+			v := m.Annotations[k]
+			mapSize := 1 + len(k) + sovOrganization(uint64(len(k))) + 1 + len(v) + sovOrganization(uint64(len(v)))
+			i = encodeVarintOrganization(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintOrganization(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintOrganization(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
 	return i, nil
 }
 
@@ -121,6 +190,20 @@ func NewPopulatedOrganization(r randyOrganization, easy bool) *Organization {
 	this := &Organization{}
 	this.Description = string(randStringOrganization(r))
 	this.Name = string(randStringOrganization(r))
+	if r.Intn(10) != 0 {
+		v1 := r.Intn(10)
+		this.Labels = make(map[string]string)
+		for i := 0; i < v1; i++ {
+			this.Labels[randStringOrganization(r)] = randStringOrganization(r)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v2 := r.Intn(10)
+		this.Annotations = make(map[string]string)
+		for i := 0; i < v2; i++ {
+			this.Annotations[randStringOrganization(r)] = randStringOrganization(r)
+		}
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -145,9 +228,9 @@ func randUTF8RuneOrganization(r randyOrganization) rune {
 	return rune(ru + 61)
 }
 func randStringOrganization(r randyOrganization) string {
-	v1 := r.Intn(100)
-	tmps := make([]rune, v1)
-	for i := 0; i < v1; i++ {
+	v3 := r.Intn(100)
+	tmps := make([]rune, v3)
+	for i := 0; i < v3; i++ {
 		tmps[i] = randUTF8RuneOrganization(r)
 	}
 	return string(tmps)
@@ -169,11 +252,11 @@ func randFieldOrganization(dAtA []byte, r randyOrganization, fieldNumber int, wi
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(key))
-		v2 := r.Int63()
+		v4 := r.Int63()
 		if r.Intn(2) == 0 {
-			v2 *= -1
+			v4 *= -1
 		}
-		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(v2))
+		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(v4))
 	case 1:
 		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -209,6 +292,22 @@ func (m *Organization) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOrganization(uint64(l))
 	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOrganization(uint64(len(k))) + 1 + len(v) + sovOrganization(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOrganization(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOrganization(uint64(len(k))) + 1 + len(v) + sovOrganization(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOrganization(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -312,6 +411,242 @@ func (m *Organization) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOrganization
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOrganization
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOrganization
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOrganization
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOrganization
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOrganization(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthOrganization
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOrganization
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOrganization
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOrganization
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOrganization
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOrganization
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOrganization(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthOrganization
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
@@ -441,17 +776,26 @@ var (
 func init() { proto.RegisterFile("organization.proto", fileDescriptorOrganization) }
 
 var fileDescriptorOrganization = []byte{
-	// 185 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xca, 0x2f, 0x4a, 0x4f,
-	0xcc, 0xcb, 0xac, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
-	0x2e, 0x4e, 0xcd, 0x2b, 0x2e, 0xd5, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x96, 0xd2, 0x4d, 0xcf, 0x2c,
-	0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x49,
-	0x2a, 0x4d, 0x03, 0xf3, 0xc0, 0x1c, 0x30, 0x0b, 0xa2, 0x57, 0x29, 0x9e, 0x8b, 0xc7, 0x1f, 0xc9,
-	0x44, 0x21, 0x43, 0x2e, 0xee, 0x94, 0xd4, 0xe2, 0xe4, 0xa2, 0xcc, 0x02, 0x10, 0x57, 0x82, 0x51,
-	0x81, 0x51, 0x83, 0xd3, 0x89, 0xff, 0xd5, 0x3d, 0x79, 0x64, 0xe1, 0x20, 0x64, 0x8e, 0x90, 0x0c,
-	0x17, 0x4b, 0x5e, 0x62, 0x6e, 0xaa, 0x04, 0x13, 0x58, 0x2d, 0xc7, 0xab, 0x7b, 0xf2, 0x60, 0x7e,
-	0x10, 0x98, 0x74, 0x52, 0xfe, 0xf1, 0x50, 0x8e, 0x71, 0xc5, 0x23, 0x39, 0xc6, 0x1d, 0x8f, 0xe4,
-	0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x19, 0x8f,
-	0xe5, 0x18, 0xa2, 0x58, 0xc1, 0x8e, 0x4e, 0x62, 0x03, 0x3b, 0xc6, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0xd8, 0xe6, 0x7e, 0x1d, 0xde, 0x00, 0x00, 0x00,
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x41, 0x4a, 0xc3, 0x40,
+	0x14, 0x86, 0x3b, 0x4d, 0x5b, 0x74, 0x22, 0x58, 0x06, 0x85, 0x50, 0x74, 0x52, 0x14, 0xa1, 0x88,
+	0xa6, 0xa8, 0x1b, 0x75, 0x21, 0x58, 0x70, 0x27, 0x08, 0xd9, 0x08, 0xee, 0x26, 0x75, 0x8c, 0xa1,
+	0xcd, 0x4c, 0xc8, 0x4c, 0x84, 0x78, 0x12, 0x97, 0x2e, 0x3d, 0x82, 0x47, 0xe8, 0xd2, 0x13, 0x04,
+	0x8d, 0xbb, 0x9e, 0xc0, 0xa5, 0xf4, 0x25, 0xe0, 0x20, 0xb8, 0x70, 0x13, 0xde, 0x1f, 0xfe, 0xff,
+	0xfb, 0x5f, 0x5e, 0x30, 0x91, 0x69, 0xc8, 0x44, 0xf4, 0xc8, 0x74, 0x24, 0x85, 0x97, 0xa4, 0x52,
+	0x4b, 0x62, 0x2b, 0x2e, 0x54, 0xe6, 0xe9, 0x3c, 0xe1, 0xaa, 0xb7, 0x1f, 0x46, 0xfa, 0x3e, 0x0b,
+	0xbc, 0xb1, 0x8c, 0x87, 0xa1, 0x0c, 0xe5, 0x10, 0x3c, 0x41, 0x76, 0x07, 0x0a, 0x04, 0x4c, 0x55,
+	0x76, 0xeb, 0xd9, 0xc2, 0x2b, 0x57, 0x06, 0x92, 0x1c, 0x60, 0xfb, 0x96, 0xab, 0x71, 0x1a, 0x25,
+	0x0b, 0xe9, 0xa0, 0x3e, 0x1a, 0x2c, 0x8f, 0x56, 0xe7, 0x85, 0x6b, 0xbe, 0xf6, 0x4d, 0x41, 0x36,
+	0x70, 0x4b, 0xb0, 0x98, 0x3b, 0x4d, 0xf0, 0x2e, 0xcd, 0x0b, 0x17, 0xb4, 0x0f, 0x4f, 0x72, 0x8d,
+	0x3b, 0x53, 0x16, 0xf0, 0xa9, 0x72, 0xac, 0xbe, 0x35, 0xb0, 0x0f, 0x77, 0x3c, 0x63, 0x5d, 0xcf,
+	0xec, 0xf6, 0x2e, 0xc1, 0x77, 0x21, 0x74, 0x9a, 0x8f, 0x9c, 0x59, 0xe1, 0x36, 0xe6, 0x85, 0xdb,
+	0xad, 0xc2, 0x7b, 0x32, 0x8e, 0x34, 0x8f, 0x13, 0x9d, 0xfb, 0x35, 0x8e, 0x4c, 0xb0, 0xcd, 0x84,
+	0x90, 0x1a, 0xb2, 0xca, 0x69, 0x01, 0x7d, 0xf7, 0x6f, 0xfa, 0xf9, 0x8f, 0xb9, 0xaa, 0xd8, 0xac,
+	0x2b, 0xd6, 0x0d, 0x8c, 0xd1, 0x63, 0xd2, 0x7b, 0x27, 0xd8, 0x36, 0xb6, 0x23, 0x5d, 0x6c, 0x4d,
+	0x78, 0x5e, 0x5d, 0xc7, 0x5f, 0x8c, 0x64, 0x0d, 0xb7, 0x1f, 0xd8, 0x34, 0xab, 0xaf, 0xe0, 0x57,
+	0xe2, 0xb4, 0x79, 0x8c, 0x7a, 0x67, 0xb8, 0xfb, 0xbb, 0xfa, 0x3f, 0xf9, 0xd1, 0xf6, 0xd7, 0x07,
+	0x45, 0x2f, 0x25, 0x45, 0xaf, 0x25, 0x45, 0xb3, 0x92, 0xa2, 0xb7, 0x92, 0xa2, 0xf7, 0x92, 0xa2,
+	0xa7, 0x4f, 0xda, 0xb8, 0x69, 0xc3, 0x97, 0x06, 0x1d, 0xf8, 0x9d, 0x47, 0xdf, 0x03, 0x00, 0xb3,
+	0x17, 0xaa, 0x73, 0x20, 0x02, 0x00, 0x00,
 }
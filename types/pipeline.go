@@ -0,0 +1,107 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+// Validate returns an error if the pipeline does not pass validation tests.
+func (p *Pipeline) Validate() error {
+	if err := ValidateName(p.Name); err != nil {
+		return errors.New("pipeline name " + err.Error())
+	}
+
+	if p.Environment == "" {
+		return errors.New("pipeline environment must be set")
+	}
+
+	if p.Organization == "" {
+		return errors.New("pipeline organization must be set")
+	}
+
+	if len(p.Workflows) == 0 {
+		return errors.New("pipeline must have at least one workflow")
+	}
+
+	names := make(map[string]bool, len(p.Workflows))
+	for i, workflow := range p.Workflows {
+		if err := workflow.Validate(); err != nil {
+			return fmt.Errorf("pipeline workflow %d: %s", i, err)
+		}
+		if names[workflow.Name] {
+			return fmt.Errorf("pipeline workflow %d: duplicate name %q", i, workflow.Name)
+		}
+		names[workflow.Name] = true
+	}
+
+	return nil
+}
+
+// Validate returns an error if the workflow does not pass validation tests.
+func (w *PipelineWorkflow) Validate() error {
+	if err := ValidateName(w.Name); err != nil {
+		return errors.New("name " + err.Error())
+	}
+
+	if err := ValidateName(w.Handler); err != nil {
+		return errors.New("handler " + err.Error())
+	}
+
+	for _, filter := range w.Filters {
+		if err := ValidateName(filter); err != nil {
+			return errors.New("filter " + err.Error())
+		}
+	}
+
+	if w.Mutator != "" {
+		if err := ValidateName(w.Mutator); err != nil {
+			return errors.New("mutator " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Update updates p with selected fields. Returns non-nil error if any of the
+// selected fields are unsupported.
+func (p *Pipeline) Update(from *Pipeline, fields ...string) error {
+	for _, f := range fields {
+		switch f {
+		case "Workflows":
+			p.Workflows = append(p.Workflows[0:0], from.Workflows...)
+		default:
+			return fmt.Errorf("unsupported field: %q", f)
+		}
+	}
+	return nil
+}
+
+// SetNamespace sets the organization and environment of the pipeline.
+func (p *Pipeline) SetNamespace(org, env string) {
+	p.Organization = org
+	p.Environment = env
+}
+
+// URIPath returns the path of the pipeline, relative to the API root.
+func (p *Pipeline) URIPath() string {
+	return path.Join("/pipelines", url.PathEscape(p.Name))
+}
+
+// FixturePipeline returns a Pipeline fixture for testing, with a single
+// workflow sending the incidents to the slack handler.
+func FixturePipeline(name string) *Pipeline {
+	return &Pipeline{
+		Name: name,
+		Workflows: []PipelineWorkflow{
+			{
+				Name:    "incidents",
+				Filters: []string{"is_incident"},
+				Handler: "slack",
+			},
+		},
+		Environment:  "default",
+		Organization: "default",
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineValidate(t *testing.T) {
+	p := FixturePipeline("alerts")
+	assert.NoError(t, p.Validate())
+
+	p.Name = "alert pipeline"
+	assert.Error(t, p.Validate())
+	p.Name = "alerts"
+
+	p.Workflows[0].Handler = ""
+	assert.Error(t, p.Validate())
+	p.Workflows[0].Handler = "slack"
+
+	p.Workflows[0].Filters = []string{"is incident"}
+	assert.Error(t, p.Validate())
+	p.Workflows[0].Filters = []string{"is_incident"}
+
+	p.Workflows = append(p.Workflows, PipelineWorkflow{Name: "incidents", Handler: "pagerduty"})
+	assert.Error(t, p.Validate())
+	p.Workflows[1].Name = "paging"
+	assert.NoError(t, p.Validate())
+
+	p.Workflows = nil
+	assert.Error(t, p.Validate())
+	p.Workflows = FixturePipeline("alerts").Workflows
+
+	p.Environment = ""
+	assert.Error(t, p.Validate())
+}
+
+func TestPipelineJSONRoundTrip(t *testing.T) {
+	p := FixturePipeline("alerts")
+	p.Workflows[0].Mutator = "only_check_output"
+	b, err := json.Marshal(p)
+	require.NoError(t, err)
+
+	decoded := &Pipeline{}
+	require.NoError(t, json.Unmarshal(b, decoded))
+	assert.Equal(t, p, decoded)
+}
@@ -21,15 +21,27 @@ const (
 	// RulePermDelete delete action
 	RulePermDelete = "delete"
 
+	// RulePermImpersonate impersonate action, which only applies to users
+	RulePermImpersonate = "impersonate"
+
 	// RuleTypeAsset access control for asset objects
 	RuleTypeAsset = "assets"
 
 	// RuleTypeCheck access control for check objects
 	RuleTypeCheck = "checks"
 
+	// RuleTypeCheckTemplate access control for check template objects
+	RuleTypeCheckTemplate = "checktemplates"
+
+	// RuleTypeClusterConfig access control for the cluster-wide defaults
+	RuleTypeClusterConfig = "config"
+
 	// RuleTypeEntity access control for entity objects
 	RuleTypeEntity = "entities"
 
+	// RuleTypeEntityGroup access control for entity group objects
+	RuleTypeEntityGroup = "entitygroups"
+
 	// RuleTypeEnvironment access control for organization objects
 	RuleTypeEnvironment = "environments"
 
@@ -45,18 +57,30 @@ const (
 	// RuleTypeHook access control for hook objects
 	RuleTypeHook = "hooks"
 
+	// RuleTypeIncident access control for incident objects
+	RuleTypeIncident = "incidents"
+
 	// RuleTypeMutator access control for mutator objects
 	RuleTypeMutator = "mutators"
 
 	// RuleTypeOrganization access control for organization objects
 	RuleTypeOrganization = "organizations"
 
+	// RuleTypePipeline access control for pipeline objects
+	RuleTypePipeline = "pipelines"
+
 	// RuleTypeRole access control for role objects
 	RuleTypeRole = "roles"
 
+	// RuleTypeRoleBinding access control for role binding objects
+	RuleTypeRoleBinding = "rolebindings"
+
 	// RuleTypeSilenced access control for silenced objects
 	RuleTypeSilenced = "silenced"
 
+	// RuleTypeTessen access control for the Tessen configuration
+	RuleTypeTessen = "tessen"
+
 	// RuleTypeUser access control for user objects
 	RuleTypeUser = "users"
 )
@@ -98,15 +122,16 @@ func (r *Rule) Validate() error {
 
 	for _, p := range r.Permissions {
 		switch p {
-		case RulePermCreate, RulePermRead, RulePermUpdate, RulePermDelete:
+		case RulePermCreate, RulePermRead, RulePermUpdate, RulePermDelete, RulePermImpersonate:
 		default:
 			return fmt.Errorf(
-				"permission '%s' is not valid - must be one of ['%s', '%s', '%s', '%s']",
+				"permission '%s' is not valid - must be one of ['%s', '%s', '%s', '%s', '%s']",
 				p,
 				RulePermCreate,
 				RulePermRead,
 				RulePermUpdate,
 				RulePermDelete,
+				RulePermImpersonate,
 			)
 		}
 	}
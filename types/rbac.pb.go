@@ -81,9 +81,76 @@ func (m *Role) GetRules() []Rule {
 	return nil
 }
 
+// RoleBinding grants the rules of a role to users within the organization and
+// environment of the binding, so that a single role can be bound to different
+// users in each environment.
+type RoleBinding struct {
+	// Name is the unique identifier of the role binding.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Role is the name of the role bound.
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// Users are the usernames of the users granted the role.
+	Users []string `protobuf:"bytes,3,rep,name=users" json:"users,omitempty"`
+	// Organization indicates to which org the role binding belongs to.
+	Organization string `protobuf:"bytes,4,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Environment indicates to which env the role binding belongs to.
+	Environment string `protobuf:"bytes,5,opt,name=environment,proto3" json:"environment,omitempty"`
+	// Groups are the groups of users granted the role, as given by the
+	// authentication provider.
+	Groups []string `protobuf:"bytes,6,rep,name=groups" json:"groups,omitempty"`
+}
+
+func (m *RoleBinding) Reset()                    { *m = RoleBinding{} }
+func (m *RoleBinding) String() string            { return proto.CompactTextString(m) }
+func (*RoleBinding) ProtoMessage()               {}
+func (*RoleBinding) Descriptor() ([]byte, []int) { return fileDescriptorRbac, []int{2} }
+
+func (m *RoleBinding) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RoleBinding) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *RoleBinding) GetUsers() []string {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+func (m *RoleBinding) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *RoleBinding) GetEnvironment() string {
+	if m != nil {
+		return m.Environment
+	}
+	return ""
+}
+
+func (m *RoleBinding) GetGroups() []string {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Rule)(nil), "sensu.types.Rule")
 	proto.RegisterType((*Role)(nil), "sensu.types.Role")
+	proto.RegisterType((*RoleBinding)(nil), "sensu.types.RoleBinding")
 }
 func (this *Rule) Equal(that interface{}) bool {
 	if that == nil {
@@ -167,6 +234,61 @@ func (this *Role) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RoleBinding) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*RoleBinding)
+	if !ok {
+		that2, ok := that.(RoleBinding)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if len(this.Users) != len(that1.Users) {
+		return false
+	}
+	for i := range this.Users {
+		if this.Users[i] != that1.Users[i] {
+			return false
+		}
+	}
+	if this.Organization != that1.Organization {
+		return false
+	}
+	if this.Environment != that1.Environment {
+		return false
+	}
+	if len(this.Groups) != len(that1.Groups) {
+		return false
+	}
+	for i := range this.Groups {
+		if this.Groups[i] != that1.Groups[i] {
+			return false
+		}
+	}
+	return true
+}
 func (m *Rule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -254,6 +376,78 @@ func (m *Role) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RoleBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleBinding) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRbac(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Role) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRbac(dAtA, i, uint64(len(m.Role)))
+		i += copy(dAtA[i:], m.Role)
+	}
+	if len(m.Users) > 0 {
+		for _, s := range m.Users {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Organization) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRbac(dAtA, i, uint64(len(m.Organization)))
+		i += copy(dAtA[i:], m.Organization)
+	}
+	if len(m.Environment) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRbac(dAtA, i, uint64(len(m.Environment)))
+		i += copy(dAtA[i:], m.Environment)
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
 func encodeVarintRbac(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -294,6 +488,27 @@ func NewPopulatedRole(r randyRbac, easy bool) *Role {
 	return this
 }
 
+func NewPopulatedRoleBinding(r randyRbac, easy bool) *RoleBinding {
+	this := &RoleBinding{}
+	this.Name = string(randStringRbac(r))
+	this.Role = string(randStringRbac(r))
+	v4 := r.Intn(10)
+	this.Users = make([]string, v4)
+	for i := 0; i < v4; i++ {
+		this.Users[i] = string(randStringRbac(r))
+	}
+	this.Organization = string(randStringRbac(r))
+	this.Environment = string(randStringRbac(r))
+	v5 := r.Intn(10)
+	this.Groups = make([]string, v5)
+	for i := 0; i < v5; i++ {
+		this.Groups[i] = string(randStringRbac(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyRbac interface {
 	Float32() float32
 	Float64() float64
@@ -313,9 +528,9 @@ func randUTF8RuneRbac(r randyRbac) rune {
 	return rune(ru + 61)
 }
 func randStringRbac(r randyRbac) string {
-	v4 := r.Intn(100)
-	tmps := make([]rune, v4)
-	for i := 0; i < v4; i++ {
+	v6 := r.Intn(100)
+	tmps := make([]rune, v6)
+	for i := 0; i < v6; i++ {
 		tmps[i] = randUTF8RuneRbac(r)
 	}
 	return string(tmps)
@@ -337,11 +552,11 @@ func randFieldRbac(dAtA []byte, r randyRbac, fieldNumber int, wire int) []byte {
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateRbac(dAtA, uint64(key))
-		v5 := r.Int63()
+		v7 := r.Int63()
 		if r.Intn(2) == 0 {
-			v5 *= -1
+			v7 *= -1
 		}
-		dAtA = encodeVarintPopulateRbac(dAtA, uint64(v5))
+		dAtA = encodeVarintPopulateRbac(dAtA, uint64(v7))
 	case 1:
 		dAtA = encodeVarintPopulateRbac(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -406,6 +621,40 @@ func (m *Role) Size() (n int) {
 	return n
 }
 
+func (m *RoleBinding) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRbac(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovRbac(uint64(l))
+	}
+	if len(m.Users) > 0 {
+		for _, s := range m.Users {
+			l = len(s)
+			n += 1 + l + sovRbac(uint64(l))
+		}
+	}
+	l = len(m.Organization)
+	if l > 0 {
+		n += 1 + l + sovRbac(uint64(l))
+	}
+	l = len(m.Environment)
+	if l > 0 {
+		n += 1 + l + sovRbac(uint64(l))
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovRbac(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovRbac(x uint64) (n int) {
 	for {
 		n++
@@ -695,6 +944,230 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RoleBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRbac
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRbac
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRbac
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRbac
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRbac
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRbac
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRbac
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRbac
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRbac
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRbac
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRbac
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Environment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRbac
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRbac
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRbac(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRbac
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRbac(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -803,22 +1276,27 @@ var (
 func init() { proto.RegisterFile("rbac.proto", fileDescriptorRbac) }
 
 var fileDescriptorRbac = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x50, 0x4d, 0x4a, 0xc4, 0x30,
-	0x18, 0x9d, 0x4c, 0x3b, 0xc2, 0xa4, 0x8a, 0x98, 0x55, 0x71, 0x91, 0x96, 0xba, 0xe9, 0xc6, 0x0c,
-	0x2a, 0x78, 0x80, 0x1e, 0x21, 0x4b, 0x77, 0xed, 0x10, 0x6b, 0x60, 0x9a, 0xaf, 0xe4, 0x47, 0xd0,
-	0x6b, 0xb8, 0xf1, 0x08, 0x1e, 0xc1, 0x23, 0xcc, 0xd2, 0x13, 0x14, 0x8d, 0xbb, 0x39, 0x81, 0x4b,
-	0x69, 0x0a, 0x52, 0x57, 0x79, 0xef, 0xe5, 0xbd, 0xf0, 0x5e, 0x30, 0xd6, 0x4d, 0xbd, 0x65, 0xbd,
-	0x06, 0x0b, 0x24, 0x31, 0x42, 0x19, 0xc7, 0xec, 0x53, 0x2f, 0xcc, 0xf9, 0x65, 0x2b, 0xed, 0x83,
-	0x6b, 0xd8, 0x16, 0xba, 0x4d, 0x0b, 0x2d, 0x6c, 0x82, 0xa7, 0x71, 0xf7, 0x81, 0x05, 0x12, 0xd0,
-	0x94, 0x2d, 0x5e, 0x10, 0x8e, 0xb9, 0xdb, 0x09, 0x42, 0x70, 0x3c, 0x3e, 0x90, 0xa2, 0x1c, 0x95,
-	0x6b, 0x1e, 0x30, 0xc9, 0x71, 0x22, 0xd4, 0xa3, 0xd4, 0xa0, 0x3a, 0xa1, 0x6c, 0xba, 0x0c, 0x57,
-	0x73, 0x89, 0x14, 0xf8, 0x18, 0x74, 0x5b, 0x2b, 0xf9, 0x5c, 0x5b, 0x09, 0x2a, 0x8d, 0x82, 0xe5,
-	0x9f, 0x46, 0xae, 0x70, 0xd2, 0x0b, 0xdd, 0x49, 0x63, 0x24, 0x28, 0x93, 0xc6, 0x79, 0x54, 0xae,
-	0xab, 0xd3, 0xc3, 0x90, 0xcd, 0x65, 0x3e, 0x27, 0x05, 0xc7, 0x31, 0x87, 0xa9, 0x94, 0xaa, 0xbb,
-	0xbf, 0x52, 0x23, 0x26, 0xb7, 0x78, 0xa5, 0xdd, 0x4e, 0x98, 0x74, 0x99, 0x47, 0x65, 0x72, 0x7d,
-	0xc6, 0x66, 0xeb, 0xd9, 0x38, 0xa5, 0x3a, 0xd9, 0x0f, 0xd9, 0xe2, 0x30, 0x64, 0x93, 0x8f, 0x4f,
-	0x47, 0x75, 0xf1, 0xf3, 0x45, 0xd1, 0x9b, 0xa7, 0xe8, 0xdd, 0x53, 0xb4, 0xf7, 0x14, 0x7d, 0x78,
-	0x8a, 0x3e, 0x3d, 0x45, 0xaf, 0xdf, 0x74, 0x71, 0xb7, 0x0a, 0xf9, 0xe6, 0x28, 0xfc, 0xca, 0xcd,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x54, 0xcb, 0xf0, 0xfe, 0x5f, 0x01, 0x00, 0x00,
+	// 349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0x3d, 0x4e, 0xc3, 0x30,
+	0x18, 0xad, 0x9b, 0xa4, 0x52, 0x1d, 0x50, 0xc1, 0x30, 0x44, 0x0c, 0x49, 0x14, 0x96, 0x22, 0x95,
+	0x54, 0x80, 0xc4, 0x01, 0x72, 0x04, 0x8f, 0x6c, 0x49, 0x31, 0xc1, 0x52, 0x63, 0x47, 0xfe, 0x41,
+	0x2a, 0xd7, 0x60, 0xe1, 0x08, 0x1c, 0x81, 0x23, 0x74, 0x64, 0x60, 0x8e, 0x20, 0x6c, 0x39, 0x01,
+	0x23, 0x8a, 0x53, 0xa1, 0x40, 0x3b, 0xf9, 0x7d, 0xcf, 0xcf, 0xdf, 0xf7, 0xbd, 0x67, 0x08, 0x45,
+	0x96, 0x2e, 0xe2, 0x52, 0x70, 0xc5, 0x91, 0x2b, 0x09, 0x93, 0x3a, 0x56, 0xab, 0x92, 0xc8, 0x93,
+	0xf3, 0x9c, 0xaa, 0x7b, 0x9d, 0xc5, 0x0b, 0x5e, 0xcc, 0x73, 0x9e, 0xf3, 0xb9, 0xd1, 0x64, 0xfa,
+	0xce, 0x54, 0xa6, 0x30, 0xa8, 0x7b, 0x1b, 0x3d, 0x01, 0x68, 0x63, 0xbd, 0x24, 0x08, 0x41, 0xbb,
+	0x6d, 0xe0, 0x81, 0x10, 0x4c, 0xc7, 0xd8, 0x60, 0x14, 0x42, 0x97, 0xb0, 0x07, 0x2a, 0x38, 0x2b,
+	0x08, 0x53, 0xde, 0xd0, 0x5c, 0xf5, 0x29, 0x14, 0xc1, 0x3d, 0x2e, 0xf2, 0x94, 0xd1, 0xc7, 0x54,
+	0x51, 0xce, 0x3c, 0xcb, 0x48, 0xfe, 0x70, 0xe8, 0x02, 0xba, 0x25, 0x11, 0x05, 0x95, 0x92, 0x72,
+	0x26, 0x3d, 0x3b, 0xb4, 0xa6, 0xe3, 0x64, 0xd2, 0x54, 0x41, 0x9f, 0xc6, 0xfd, 0x22, 0xc2, 0xd0,
+	0xc6, 0xbc, 0x5b, 0x8a, 0xa5, 0xc5, 0xef, 0x52, 0x2d, 0x46, 0xd7, 0xd0, 0x11, 0x7a, 0x49, 0xa4,
+	0x37, 0x0c, 0xad, 0xa9, 0x7b, 0x79, 0x18, 0xf7, 0xdc, 0xc7, 0xad, 0x95, 0x64, 0x7f, 0x5d, 0x05,
+	0x83, 0xa6, 0x0a, 0x3a, 0x1d, 0xee, 0x8e, 0xe8, 0x1d, 0x40, 0xb7, 0x6d, 0x9a, 0x50, 0x76, 0x4b,
+	0x59, 0xbe, 0xb3, 0x37, 0x82, 0xb6, 0xe0, 0x4b, 0xb2, 0x71, 0x6a, 0x30, 0x3a, 0x83, 0x8e, 0x96,
+	0x44, 0x48, 0xcf, 0x32, 0x8b, 0x1f, 0x35, 0x55, 0x30, 0x31, 0xc4, 0x8c, 0x17, 0x54, 0x91, 0xa2,
+	0x54, 0x2b, 0xdc, 0x29, 0xb6, 0xd2, 0xb0, 0x77, 0xa4, 0xf1, 0x2f, 0x53, 0x67, 0x3b, 0xd3, 0x19,
+	0x1c, 0xe5, 0x82, 0xeb, 0x52, 0x7a, 0x23, 0x33, 0xf1, 0xb8, 0xa9, 0x82, 0x83, 0x8e, 0xe9, 0x8d,
+	0xdc, 0x68, 0x92, 0xd3, 0xef, 0x4f, 0x1f, 0xbc, 0xd4, 0x3e, 0x78, 0xad, 0x7d, 0xb0, 0xae, 0x7d,
+	0xf0, 0x56, 0xfb, 0xe0, 0xa3, 0xf6, 0xc1, 0xf3, 0x97, 0x3f, 0xb8, 0x71, 0x4c, 0x2c, 0xd9, 0xc8,
+	0x7c, 0xf6, 0xd5, 0xcf, 0x00, 0x85, 0x2c, 0x5b, 0xcb, 0x36, 0x02, 0x00, 0x00,
 }
@@ -49,6 +49,10 @@ func TestRuleValidate(t *testing.T) {
 	// Wildcard org
 	r.Organization = "*"
 	assert.NoError(t, r.Validate())
+
+	// Impersonation
+	r.Permissions = []string{RulePermImpersonate}
+	assert.NoError(t, r.Validate())
 }
 
 func TestRoleValidate(t *testing.T) {
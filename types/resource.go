@@ -0,0 +1,16 @@
+package types
+
+// Resource is a multi-tenant object managed by the API, which can be
+// validated and addressed by its URI path.
+type Resource interface {
+	MultitenantResource
+
+	// SetNamespace sets the organization and environment of the resource
+	SetNamespace(org, env string)
+
+	// URIPath is the path of the resource, relative to the API root
+	URIPath() string
+
+	// Validate returns an error if the resource is invalid
+	Validate() error
+}
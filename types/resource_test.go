@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResources(t *testing.T) {
+	testCases := []struct {
+		resource Resource
+		uriPath  string
+		env      string
+	}{
+		{FixtureAsset("ruby24"), "/assets/ruby24", ""},
+		{FixtureCheckConfig("check-cpu"), "/checks/check-cpu", "prod"},
+		{FixtureEntity("entity"), "/entities/entity", "prod"},
+		{FixtureEventFilter("filter"), "/filters/filter", "prod"},
+		{FixtureHandler("handler"), "/handlers/handler", "prod"},
+		{FixtureHookConfig("hook"), "/hooks/hook", "prod"},
+		{FixtureMutator("mutator"), "/mutators/mutator", "prod"},
+		{FixtureSilenced("linux:check"), "/silenced/linux:check", "prod"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.uriPath, func(t *testing.T) {
+			assert.Equal(t, tc.uriPath, tc.resource.URIPath())
+			assert.NoError(t, tc.resource.Validate())
+
+			tc.resource.SetNamespace("acme", "prod")
+			assert.Equal(t, "acme", tc.resource.GetOrganization())
+			assert.Equal(t, tc.env, tc.resource.GetEnvironment())
+		})
+	}
+}
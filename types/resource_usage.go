@@ -0,0 +1,45 @@
+package types
+
+// ResourceUsage is the resources used by the executions of a check, on the
+// agents, or of a pipe handler, on the backend, in an organization and
+// environment.
+type ResourceUsage struct {
+	// Organization is the organization of the check or handler
+	Organization string `json:"organization"`
+
+	// Environment is the environment of the check or handler
+	Environment string `json:"environment"`
+
+	// Kind is what was executed, "check" or "handler"
+	Kind string `json:"kind"`
+
+	// Name is the name of the check or handler
+	Name string `json:"name"`
+
+	// Executions is the number of executions
+	Executions uint64 `json:"executions"`
+
+	// CPUTime is the total CPU time, in seconds, used by the executions
+	CPUTime float64 `json:"cpu_time"`
+
+	// WallTime is the total duration, in seconds, of the executions
+	WallTime float64 `json:"wall_time"`
+
+	// MaxRSS is the highest maximum resident set size, in bytes, of the
+	// executions
+	MaxRSS uint64 `json:"max_rss"`
+}
+
+// FixtureResourceUsage returns a ResourceUsage for use in testing.
+func FixtureResourceUsage(kind, name string, executions uint64) *ResourceUsage {
+	return &ResourceUsage{
+		Organization: "default",
+		Environment:  "default",
+		Kind:         kind,
+		Name:         name,
+		Executions:   executions,
+		CPUTime:      float64(executions) / 10,
+		WallTime:     float64(executions),
+		MaxRSS:       1 << 20,
+	}
+}
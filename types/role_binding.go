@@ -0,0 +1,117 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+// Validate returns an error if the role binding does not pass validation
+// tests.
+func (b *RoleBinding) Validate() error {
+	if err := ValidateNameStrict(b.Name); err != nil {
+		return errors.New("role binding name " + err.Error())
+	}
+
+	if err := ValidateNameStrict(b.Role); err != nil {
+		return errors.New("role binding role " + err.Error())
+	}
+
+	if b.Environment == "" {
+		return errors.New("role binding environment must be set")
+	}
+
+	if b.Organization == "" {
+		return errors.New("role binding organization must be set")
+	}
+
+	if len(b.Users) == 0 && len(b.Groups) == 0 {
+		return errors.New("role binding must have at least one user or group")
+	}
+
+	for _, user := range b.Users {
+		if err := ValidateName(user); err != nil {
+			return fmt.Errorf("role binding user %q %s", user, err)
+		}
+	}
+
+	for _, group := range b.Groups {
+		if group == "" {
+			return errors.New("role binding group cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// Update updates b with selected fields. Returns non-nil error if any of the
+// selected fields are unsupported.
+func (b *RoleBinding) Update(from *RoleBinding, fields ...string) error {
+	for _, f := range fields {
+		switch f {
+		case "Role":
+			b.Role = from.Role
+		case "Users":
+			b.Users = append(b.Users[0:0], from.Users...)
+		case "Groups":
+			b.Groups = append(b.Groups[0:0], from.Groups...)
+		default:
+			return fmt.Errorf("unsupported field: %q", f)
+		}
+	}
+	return nil
+}
+
+// Binds returns whether the role binding grants its role to the user, either
+// directly or through one of the given groups of the user.
+func (b *RoleBinding) Binds(username string, groups []string) bool {
+	for _, user := range b.Users {
+		if user == username {
+			return true
+		}
+	}
+	for _, group := range b.Groups {
+		for _, userGroup := range groups {
+			if group == userGroup {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Grant returns the rules granted by the role binding, which are the rules of
+// its role scoped to the organization and environment of the binding,
+// whatever the role rules apply to.
+func (b *RoleBinding) Grant(role *Role) []Rule {
+	rules := make([]Rule, len(role.Rules))
+	for i, rule := range role.Rules {
+		rule.Organization = b.Organization
+		rule.Environment = b.Environment
+		rules[i] = rule
+	}
+	return rules
+}
+
+// SetNamespace sets the organization and environment of the role binding.
+func (b *RoleBinding) SetNamespace(org, env string) {
+	b.Organization = org
+	b.Environment = env
+}
+
+// URIPath returns the path of the role binding, relative to the API root.
+func (b *RoleBinding) URIPath() string {
+	return path.Join("/rolebindings", url.PathEscape(b.Name))
+}
+
+// FixtureRoleBinding returns a RoleBinding fixture for testing.
+func FixtureRoleBinding(name, role string) *RoleBinding {
+	return &RoleBinding{
+		Name:         name,
+		Role:         role,
+		Users:        []string{"foo"},
+		Environment:  "default",
+		Organization: "default",
+	}
+}
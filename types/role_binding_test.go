@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleBindingValidate(t *testing.T) {
+	b := FixtureRoleBinding("dev-operators", "operator")
+	assert.NoError(t, b.Validate())
+
+	b.Name = "dev operators"
+	assert.Error(t, b.Validate())
+	b.Name = "dev-operators"
+
+	b.Role = ""
+	assert.Error(t, b.Validate())
+	b.Role = "operator"
+
+	b.Users = []string{}
+	assert.Error(t, b.Validate())
+	b.Groups = []string{"ops"}
+	assert.NoError(t, b.Validate())
+	b.Groups = []string{""}
+	assert.Error(t, b.Validate())
+	b.Groups = nil
+	b.Users = []string{"foo bar"}
+	assert.Error(t, b.Validate())
+	b.Users = []string{"foo"}
+
+	b.Environment = ""
+	assert.Error(t, b.Validate())
+}
+
+func TestRoleBindingGrant(t *testing.T) {
+	role := FixtureRole("operator", "*", "*")
+	role.Rules = append(role.Rules, FixtureRuleWithPerms(RuleTypeCheck, RulePermRead))
+	role.Rules[1].Organization = "acme"
+	role.Rules[1].Environment = "prod"
+
+	b := FixtureRoleBinding("dev-operators", "operator")
+	b.Organization = "acme"
+	b.Environment = "dev"
+	assert.True(t, b.Binds("foo", nil))
+	assert.False(t, b.Binds("bar", nil))
+
+	rules := b.Grant(role)
+	require.Len(t, rules, 2)
+	for _, rule := range rules {
+		assert.Equal(t, "acme", rule.Organization)
+		assert.Equal(t, "dev", rule.Environment)
+	}
+	assert.Equal(t, RuleTypeCheck, rules[1].Type)
+
+	// The role is left untouched
+	assert.Equal(t, "*", role.Rules[0].Organization)
+	assert.Equal(t, "prod", role.Rules[1].Environment)
+}
+
+func TestRoleBindingBindsGroups(t *testing.T) {
+	b := FixtureRoleBinding("dev-operators", "operator")
+	b.Users = nil
+	b.Groups = []string{"ops", "sre"}
+
+	assert.True(t, b.Binds("bar", []string{"dev", "sre"}))
+	assert.False(t, b.Binds("bar", []string{"dev"}))
+	assert.False(t, b.Binds("foo", nil))
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"path"
 	"strings"
 )
 
@@ -35,19 +37,21 @@ func (s *Silenced) StartSilence(currentTime int64) bool {
 	return currentTime > s.Begin
 }
 
-// FixtureSilenced returns a testing fixutre for a Silenced event struct.
-func FixtureSilenced(id string) *Silenced {
-	var check, subscription string
+// SetNamespace sets the organization and environment of the silenced.
+func (s *Silenced) SetNamespace(org, env string) {
+	s.Organization = org
+	s.Environment = env
+}
 
-	parts := strings.Split(id, ":")
+// URIPath returns the path of the silenced entry, relative to the API root.
+func (s *Silenced) URIPath() string {
+	return path.Join("/silenced", url.PathEscape(s.ID))
+}
 
-	if len(parts) == 2 {
-		check = parts[1]
-		subscription = parts[0]
-	} else if len(parts) == 3 {
-		check = parts[2]
-		subscription = strings.Join(parts[0:2], ":")
-	} else {
+// FixtureSilenced returns a testing fixutre for a Silenced event struct.
+func FixtureSilenced(id string) *Silenced {
+	subscription, check, err := ParseSilencedID(id)
+	if err != nil {
 		panic("invalid silenced ID")
 	}
 
@@ -72,3 +76,25 @@ func SilencedID(subscription, check string) (string, error) {
 	}
 	return fmt.Sprintf("%s:%s", subscription, check), nil
 }
+
+// ParseSilencedID splits a silenced entry ID into its subscription and check
+// components. Entity subscriptions (entity:ID) are supported, so the ID may
+// contain either one or two separators.
+func ParseSilencedID(id string) (subscription, check string, err error) {
+	parts := strings.Split(id, ":")
+
+	switch len(parts) {
+	case 2:
+		subscription, check = parts[0], parts[1]
+	case 3:
+		subscription, check = strings.Join(parts[0:2], ":"), parts[2]
+	default:
+		return "", "", fmt.Errorf("invalid silenced ID %q, expected subscription:check", id)
+	}
+
+	if (subscription == "" || subscription == "*") && (check == "" || check == "*") {
+		return "", "", errors.New("no subscription or check specified")
+	}
+
+	return subscription, check, nil
+}
@@ -37,3 +37,33 @@ func TestSilencedValidate(t *testing.T) {
 	var s Silenced
 	assert.Error(t, s.Validate())
 }
+
+func TestParseSilencedID(t *testing.T) {
+	testCases := []struct {
+		id           string
+		subscription string
+		check        string
+		expectErr    bool
+	}{
+		{"linux:check_cpu", "linux", "check_cpu", false},
+		{"entity:web01:check_cpu", "entity:web01", "check_cpu", false},
+		{"*:check_cpu", "*", "check_cpu", false},
+		{"linux:*", "linux", "*", false},
+		{"*:*", "", "", true},
+		{"check_cpu", "", "", true},
+		{"a:b:c:d", "", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.id, func(t *testing.T) {
+			subscription, check, err := ParseSilencedID(tc.id)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.subscription, subscription)
+			assert.Equal(t, tc.check, check)
+		})
+	}
+}
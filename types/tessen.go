@@ -0,0 +1,29 @@
+package types
+
+// TessenConfig is the configuration of Tessen, the anonymous usage reporting
+// of a Sensu cluster. Reporting is disabled unless the cluster opts in.
+type TessenConfig struct {
+	// OptIn indicates whether the cluster reports anonymous usage data
+	OptIn bool `json:"opt_in"`
+}
+
+// TessenData is the anonymous usage data reported by Tessen.
+type TessenData struct {
+	// ClusterID is the unique identifier of the cluster
+	ClusterID string `json:"cluster_id"`
+
+	// Version is the version of the reporting backend
+	Version string `json:"version"`
+
+	// Resources is the number of resources of each type, across all
+	// organizations and environments
+	Resources map[string]int `json:"resources"`
+
+	// Timestamp is the unix time at which the data was collected
+	Timestamp int64 `json:"timestamp"`
+}
+
+// FixtureTessenConfig returns a TessenConfig for use in testing.
+func FixtureTessenConfig(optIn bool) *TessenConfig {
+	return &TessenConfig{OptIn: optIn}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"strings"
 	"time"
 )
@@ -10,7 +11,13 @@ func (t *TimeWindowWhen) Validate() error {
 	if t == nil {
 		return nil
 	}
+	if _, err := t.Location(); err != nil {
+		return err
+	}
 	for _, windows := range t.MapTimeWindows() {
+		if t.BusinessHours && len(windows) > 0 {
+			return errors.New("time windows referring to the business hours can't define days")
+		}
 		for _, window := range windows {
 			if err := window.Validate(); err != nil {
 				return err
@@ -26,6 +33,15 @@ func (t *TimeWindowTimeRange) Validate() error {
 	return err
 }
 
+// Location returns the time zone in which the time windows are expressed,
+// which is UTC unless a time zone is specified.
+func (t *TimeWindowWhen) Location() (*time.Location, error) {
+	if t.TimeZone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(t.TimeZone)
+}
+
 // MapTimeWindows returns a map of all the time windows in t.
 func (t *TimeWindowWhen) MapTimeWindows() map[string][]*TimeWindowTimeRange {
 	d := t.Days
@@ -44,11 +60,13 @@ func (t *TimeWindowWhen) MapTimeWindows() map[string][]*TimeWindowTimeRange {
 // InWindow determines if the current time falls between the provided time
 // window. Current should typically be time.Now() but to allow easier tests, it
 // must be provided as a parameter. Begin and end parameters must be strings
-// representing an hour of the day in the time.Kitchen format (e.g. "3:04PM")
+// representing an hour of the day in the time.Kitchen format (e.g. "3:04PM"),
+// in the time zone of current.
 func (t *TimeWindowTimeRange) InWindow(current time.Time) (bool, error) {
 	// Get the year, month and day of the provided current time (e.g. 2016, 01 &
 	// 02)
 	year, month, day := current.Date()
+	loc := current.Location()
 
 	// Remove any whitespaces in the begin and end times, for backward
 	// compatibility with Sensu v1 so "3:00 PM" becomes "3:00PM" and satisfies the
@@ -64,7 +82,7 @@ func (t *TimeWindowTimeRange) InWindow(current time.Time) (bool, error) {
 		return false, err
 	}
 	beginHour, beginMin, _ := beginTime.Clock()
-	beginTime = time.Date(year, month, day, beginHour, beginMin, 0, 0, time.UTC)
+	beginTime = time.Date(year, month, day, beginHour, beginMin, 0, 0, loc)
 
 	// Parse the ending of the provided time window in order to retrieve the
 	// hour and minute and apply it to current year, month and day so we end up
@@ -74,7 +92,7 @@ func (t *TimeWindowTimeRange) InWindow(current time.Time) (bool, error) {
 		return false, err
 	}
 	endHour, endMin, _ := endTime.Clock()
-	endTime = time.Date(year, month, day, endHour, endMin, 0, 0, time.UTC)
+	endTime = time.Date(year, month, day, endHour, endMin, 0, 0, loc)
 
 	// Verify if the end of the time window is actually before the beginning of
 	// it, which means that the window ends the next day (e.g. 3:00PM to 8:00AM)
@@ -85,12 +103,12 @@ func (t *TimeWindowTimeRange) InWindow(current time.Time) (bool, error) {
 		// of this second day (e.g. 3:00PM to 8:00AM, it's currently 5:00AM so let's
 		// move the beginning to 0:00AM)
 		if current.Before(endTime) {
-			beginTime = time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+			beginTime = time.Date(year, month, day, 0, 0, 0, 0, loc)
 		} else {
 			// We are currently on the first day of the window so we just need to move
 			// the end of this window to the end of the first day (e.g. 3:00PM to
 			// 8:00AM, it's currently 5:00PM so let's move the ending to 11:59PM)
-			endTime = time.Date(year, month, day, 23, 59, 59, 999999999, time.UTC)
+			endTime = time.Date(year, month, day, 23, 59, 59, 999999999, loc)
 		}
 	}
 
@@ -101,8 +119,15 @@ func (t *TimeWindowTimeRange) InWindow(current time.Time) (bool, error) {
 // InWindows determines if the current time falls between the provided time
 // windows. Current should typically be time.Now() but to allow easier tests, it
 // must be provided as a parameter. The function returns a positive value as
-// soon the current time falls within a time window
+// soon the current time, in the time zone of the windows, falls within a time
+// window
 func (t *TimeWindowWhen) InWindows(current time.Time) (bool, error) {
+	loc, err := t.Location()
+	if err != nil {
+		return false, err
+	}
+	current = current.In(loc)
+
 	windowsByDay := t.MapTimeWindows()
 
 	var windows []*TimeWindowTimeRange
@@ -19,6 +19,12 @@ var _ = math.Inf
 type TimeWindowWhen struct {
 	// Days is a hash of days
 	Days TimeWindowDays `protobuf:"bytes,1,opt,name=days" json:"days"`
+	// TimeZone is the IANA time zone name (e.g. America/Vancouver) in which the
+	// time windows are expressed. The time windows are in UTC if it is empty.
+	TimeZone string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// BusinessHours indicates the time windows are the business hours of the
+	// environment of the resource, in which case the days must be empty.
+	BusinessHours bool `protobuf:"varint,3,opt,name=business_hours,json=businessHours,proto3" json:"business_hours,omitempty"`
 }
 
 func (m *TimeWindowWhen) Reset()                    { *m = TimeWindowWhen{} }
@@ -33,6 +39,20 @@ func (m *TimeWindowWhen) GetDays() TimeWindowDays {
 	return TimeWindowDays{}
 }
 
+func (m *TimeWindowWhen) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
+func (m *TimeWindowWhen) GetBusinessHours() bool {
+	if m != nil {
+		return m.BusinessHours
+	}
+	return false
+}
+
 // TimeWindowDays defines the days of a time window
 type TimeWindowDays struct {
 	All       []*TimeWindowTimeRange `protobuf:"bytes,1,rep,name=all" json:"all,omitempty"`
@@ -168,6 +188,12 @@ func (this *TimeWindowWhen) Equal(that interface{}) bool {
 	if !this.Days.Equal(&that1.Days) {
 		return false
 	}
+	if this.TimeZone != that1.TimeZone {
+		return false
+	}
+	if this.BusinessHours != that1.BusinessHours {
+		return false
+	}
 	return true
 }
 func (this *TimeWindowDays) Equal(that interface{}) bool {
@@ -317,6 +343,22 @@ func (m *TimeWindowWhen) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n1
+	if len(m.TimeZone) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTimeWindow(dAtA, i, uint64(len(m.TimeZone)))
+		i += copy(dAtA[i:], m.TimeZone)
+	}
+	if m.BusinessHours {
+		dAtA[i] = 0x18
+		i++
+		if m.BusinessHours {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -477,6 +519,8 @@ func NewPopulatedTimeWindowWhen(r randyTimeWindow, easy bool) *TimeWindowWhen {
 	this := &TimeWindowWhen{}
 	v1 := NewPopulatedTimeWindowDays(r, easy)
 	this.Days = *v1
+	this.TimeZone = string(randStringTimeWindow(r))
+	this.BusinessHours = bool(bool(r.Intn(2) == 0))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -631,6 +675,13 @@ func (m *TimeWindowWhen) Size() (n int) {
 	_ = l
 	l = m.Days.Size()
 	n += 1 + l + sovTimeWindow(uint64(l))
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovTimeWindow(uint64(l))
+	}
+	if m.BusinessHours {
+		n += 2
+	}
 	return n
 }
 
@@ -774,6 +825,55 @@ func (m *TimeWindowWhen) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeWindow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimeWindow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BusinessHours", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeWindow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BusinessHours = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTimeWindow(dAtA[iNdEx:])
@@ -1309,28 +1409,33 @@ var (
 func init() { proto.RegisterFile("time_window.proto", fileDescriptorTimeWindow) }
 
 var fileDescriptorTimeWindow = []byte{
-	// 367 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x4a, 0xc3, 0x40,
-	0x14, 0x87, 0x3b, 0x4d, 0xfa, 0x6f, 0x2a, 0x82, 0xe3, 0x26, 0x2a, 0x24, 0xa5, 0x6e, 0xba, 0x31,
-	0x85, 0xba, 0x71, 0xa3, 0x48, 0xe8, 0x5e, 0x0c, 0x42, 0xc1, 0x8d, 0x24, 0x66, 0x9a, 0x06, 0x9a,
-	0x99, 0x92, 0x99, 0xa1, 0xe4, 0x26, 0xe2, 0x09, 0x3c, 0x82, 0x47, 0xe8, 0xd2, 0x13, 0x04, 0x8d,
-	0xbb, 0x9e, 0xc0, 0xa5, 0xcc, 0xc4, 0xda, 0x2e, 0x74, 0x31, 0x9b, 0xc9, 0xbc, 0xcc, 0xef, 0xfb,
-	0x78, 0x3c, 0x1e, 0x3c, 0xe0, 0x49, 0x8a, 0x1f, 0x96, 0x09, 0x89, 0xe8, 0xd2, 0x5d, 0x64, 0x94,
-	0x53, 0xd4, 0x65, 0x98, 0x30, 0xe1, 0xf2, 0x7c, 0x81, 0xd9, 0xf1, 0x59, 0x9c, 0xf0, 0x99, 0x08,
-	0xdd, 0x47, 0x9a, 0x0e, 0x63, 0x1a, 0xd3, 0xa1, 0xca, 0x84, 0x62, 0xaa, 0x2a, 0x55, 0xa8, 0x5b,
-	0xc5, 0xf6, 0x6f, 0xe0, 0xfe, 0x5d, 0x92, 0xe2, 0x89, 0xf2, 0x4d, 0x66, 0x98, 0xa0, 0x4b, 0x68,
-	0x46, 0x41, 0xce, 0x2c, 0xd0, 0x03, 0x83, 0xee, 0xe8, 0xc4, 0xdd, 0x91, 0xbb, 0xdb, 0xe8, 0x38,
-	0xc8, 0x99, 0xb7, 0xb7, 0x2a, 0x9c, 0xda, 0xba, 0x70, 0x14, 0xe0, 0xab, 0xb3, 0xff, 0x6c, 0xee,
-	0x1a, 0x65, 0x0c, 0x5d, 0x40, 0x23, 0x98, 0xcf, 0x2d, 0xd0, 0x33, 0x06, 0xdd, 0x51, 0xef, 0x1f,
-	0xa1, 0xbc, 0xf9, 0x01, 0x89, 0xb1, 0x67, 0xae, 0x0a, 0x07, 0xf8, 0x12, 0x41, 0x57, 0xb0, 0xc9,
-	0x04, 0x89, 0x82, 0xdc, 0xaa, 0x6b, 0xc1, 0x3f, 0x94, 0xe4, 0x53, 0xaa, 0x78, 0x43, 0x8f, 0xaf,
-	0x28, 0x74, 0x0d, 0x5b, 0x5c, 0x60, 0x26, 0x05, 0xa6, 0x96, 0x60, 0x83, 0xa1, 0x31, 0xec, 0x2c,
-	0x71, 0x44, 0x2a, 0x47, 0x43, 0xcb, 0xb1, 0x05, 0x91, 0x07, 0xdb, 0x7c, 0x26, 0x32, 0x25, 0x69,
-	0x6a, 0x49, 0x7e, 0x39, 0x39, 0x8b, 0x69, 0x96, 0x48, 0x43, 0x4b, 0x6f, 0x16, 0x15, 0x25, 0x7b,
-	0x60, 0x01, 0x17, 0x99, 0x34, 0xb4, 0xf5, 0x7a, 0xd8, 0x70, 0xfd, 0x5b, 0x78, 0xf8, 0x47, 0x0c,
-	0x39, 0xb0, 0x11, 0xe2, 0x38, 0x21, 0x6a, 0xe7, 0x3a, 0x5e, 0x67, 0x5d, 0x38, 0xd5, 0x0f, 0xbf,
-	0xfa, 0xa0, 0x23, 0x68, 0x60, 0x12, 0x59, 0x75, 0xf5, 0xdc, 0x5a, 0x17, 0x8e, 0x2c, 0x7d, 0x79,
-	0x78, 0xa7, 0x5f, 0x1f, 0x36, 0x78, 0x29, 0x6d, 0xf0, 0x5a, 0xda, 0x60, 0x55, 0xda, 0xe0, 0xad,
-	0xb4, 0xc1, 0x7b, 0x69, 0x83, 0xa7, 0x4f, 0xbb, 0x76, 0xdf, 0x50, 0xbd, 0x85, 0x4d, 0xb5, 0xec,
-	0xe7, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x36, 0xff, 0x53, 0x21, 0x3d, 0x03, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x8a, 0xd4, 0x30,
+	0x1c, 0xc0, 0x37, 0xdb, 0xce, 0x4c, 0x9b, 0xd1, 0x01, 0xb3, 0x07, 0xeb, 0x07, 0x4d, 0x19, 0x2f,
+	0x3d, 0x68, 0x17, 0x56, 0x0f, 0x5e, 0x14, 0xa9, 0x7b, 0xf0, 0x6a, 0x11, 0x16, 0xf6, 0xb2, 0xb4,
+	0x36, 0xdb, 0x06, 0xb6, 0xc9, 0xd0, 0x24, 0x0c, 0xf5, 0x49, 0xc4, 0x27, 0xf0, 0x11, 0x7c, 0x84,
+	0xc1, 0x93, 0x4f, 0x50, 0xb4, 0xde, 0xfa, 0x04, 0x1e, 0x25, 0xe9, 0xce, 0xee, 0x0c, 0xe8, 0xa1,
+	0x97, 0x34, 0xff, 0x8f, 0xdf, 0x8f, 0x7f, 0x43, 0x02, 0xef, 0x49, 0x5a, 0x91, 0x8b, 0x35, 0x65,
+	0x39, 0x5f, 0x47, 0xab, 0x9a, 0x4b, 0x8e, 0xe6, 0x82, 0x30, 0xa1, 0x22, 0xd9, 0xac, 0x88, 0x78,
+	0xf8, 0xac, 0xa0, 0xb2, 0x54, 0x59, 0xf4, 0x91, 0x57, 0xc7, 0x05, 0x2f, 0xf8, 0xb1, 0xe9, 0xc9,
+	0xd4, 0xa5, 0x89, 0x4c, 0x60, 0x76, 0x03, 0xbb, 0xfc, 0x0e, 0xe0, 0xe2, 0x03, 0xad, 0xc8, 0x99,
+	0x11, 0x9e, 0x95, 0x84, 0xa1, 0x57, 0xd0, 0xce, 0xd3, 0x46, 0x78, 0x20, 0x00, 0xe1, 0xfc, 0xe4,
+	0x51, 0xb4, 0x63, 0x8f, 0x6e, 0x5b, 0x4f, 0xd3, 0x46, 0xc4, 0x77, 0x36, 0x2d, 0x3e, 0xe8, 0x5b,
+	0x6c, 0x80, 0xc4, 0xac, 0xe8, 0x05, 0x74, 0xcd, 0x88, 0x9f, 0x38, 0x23, 0xde, 0x61, 0x00, 0x42,
+	0x37, 0xbe, 0xdf, 0xb7, 0xf8, 0xe8, 0x26, 0xf9, 0x94, 0x57, 0x54, 0x92, 0x6a, 0x25, 0x9b, 0xc4,
+	0xd1, 0xc9, 0x73, 0xce, 0x08, 0x7a, 0x0b, 0x17, 0x99, 0x12, 0x94, 0x11, 0x21, 0x2e, 0x4a, 0xae,
+	0x6a, 0xe1, 0x59, 0x01, 0x08, 0x9d, 0xf8, 0x71, 0xdf, 0x62, 0x6f, 0xbf, 0xb2, 0xc3, 0xdf, 0xdd,
+	0x56, 0xde, 0xe9, 0xc2, 0xf2, 0x8b, 0x0d, 0x17, 0xfb, 0x13, 0xa2, 0x97, 0xd0, 0x4a, 0xaf, 0xae,
+	0x3c, 0x10, 0x58, 0xe1, 0xfc, 0x24, 0xf8, 0xcf, 0xbf, 0xe8, 0x5d, 0x92, 0xb2, 0x82, 0xc4, 0xf6,
+	0xa6, 0xc5, 0x20, 0xd1, 0x08, 0x7a, 0x0d, 0xa7, 0x42, 0xb1, 0x3c, 0x6d, 0xbc, 0xc3, 0x51, 0xf0,
+	0x35, 0xa5, 0xf9, 0x8a, 0x1b, 0xde, 0x1a, 0xc7, 0x0f, 0x14, 0x7a, 0x03, 0x67, 0x52, 0x11, 0xa1,
+	0x05, 0xf6, 0x28, 0xc1, 0x16, 0x43, 0xa7, 0xd0, 0x5d, 0x93, 0x9c, 0x0d, 0x8e, 0xc9, 0x28, 0xc7,
+	0x2d, 0x88, 0x62, 0xe8, 0xc8, 0x52, 0xd5, 0x46, 0x32, 0x1d, 0x25, 0xb9, 0xe1, 0xf4, 0x59, 0x5c,
+	0xd6, 0x54, 0x1b, 0x66, 0xe3, 0xce, 0x62, 0xa0, 0xf4, 0x0c, 0x22, 0x95, 0xaa, 0xd6, 0x06, 0x67,
+	0xdc, 0x0c, 0x5b, 0x6e, 0xf9, 0x1e, 0x1e, 0xfd, 0xa3, 0x0d, 0x61, 0x38, 0xc9, 0x48, 0x41, 0x99,
+	0xb9, 0xee, 0x6e, 0xec, 0xf6, 0x2d, 0x1e, 0x12, 0xc9, 0xf0, 0x41, 0x0f, 0xa0, 0x45, 0x58, 0x7e,
+	0x7d, 0x93, 0x67, 0x7d, 0x8b, 0x75, 0x98, 0xe8, 0x25, 0x7e, 0xf2, 0xe7, 0x97, 0x0f, 0xbe, 0x76,
+	0x3e, 0xf8, 0xd6, 0xf9, 0x60, 0xd3, 0xf9, 0xe0, 0x47, 0xe7, 0x83, 0x9f, 0x9d, 0x0f, 0x3e, 0xff,
+	0xf6, 0x0f, 0xce, 0x27, 0x66, 0xb6, 0x6c, 0x6a, 0x1e, 0xda, 0xf3, 0xbf, 0x03, 0x00, 0x47, 0x20,
+	0x9b, 0xab, 0xb9, 0x03, 0x00, 0x00,
 }
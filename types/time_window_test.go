@@ -233,6 +233,33 @@ func TestInWindows(t *testing.T) {
 			expected:      false,
 			expectedError: false,
 		},
+		{
+			name: "is within the time window of its time zone",
+			now:  mustParse(t, "2006-01-02T23:30:00Z"), // 3:30PM on Monday in Vancouver
+			windows: TimeWindowWhen{
+				Days: TimeWindowDays{
+					Monday: []*TimeWindowTimeRange{
+						&TimeWindowTimeRange{
+							Begin: "3:00PM",
+							End:   "4:00PM",
+						},
+					},
+				},
+				TimeZone: "America/Vancouver",
+			},
+			expected:      true,
+			expectedError: false,
+		},
+		{
+			name: "invalid time zone",
+			now:  mustParse(t, "2006-01-02T15:30:00Z"),
+			windows: TimeWindowWhen{
+				Days:     TimeWindowDays{},
+				TimeZone: "Mars/Olympus_Mons",
+			},
+			expected:      false,
+			expectedError: true,
+		},
 		{
 			name: "invalid time format",
 			now:  mustParse(t, "2006-01-02T17:04:05Z"),
@@ -261,3 +288,11 @@ func TestInWindows(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeWindowWhenValidateBusinessHours(t *testing.T) {
+	when := &TimeWindowWhen{BusinessHours: true}
+	assert.NoError(t, when.Validate())
+
+	when.Days.Friday = []*TimeWindowTimeRange{{Begin: "9:00AM", End: "5:00PM"}}
+	assert.Error(t, when.Validate())
+}
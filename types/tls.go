@@ -28,8 +28,67 @@ var defaultCipherSuites = []uint16{
 	tls.TLS_RSA_WITH_AES_256_CBC_SHA,
 }
 
+// cipherSuites are the cipher suites which can be enabled, by name
+var cipherSuites = map[string]uint16{
+	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305":    tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+	"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305":  tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
+	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256":   tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256": tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384":   tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384": tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256":   tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256,
+	"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA":      tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+	"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256": tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256,
+	"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA":    tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,
+	"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA":      tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA,
+	"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA":    tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA,
+	"TLS_RSA_WITH_AES_128_GCM_SHA256":         tls.TLS_RSA_WITH_AES_128_GCM_SHA256,
+	"TLS_RSA_WITH_AES_256_GCM_SHA384":         tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
+	"TLS_RSA_WITH_AES_128_CBC_SHA256":         tls.TLS_RSA_WITH_AES_128_CBC_SHA256,
+	"TLS_RSA_WITH_AES_128_CBC_SHA":            tls.TLS_RSA_WITH_AES_128_CBC_SHA,
+	"TLS_RSA_WITH_AES_256_CBC_SHA":            tls.TLS_RSA_WITH_AES_256_CBC_SHA,
+}
+
+// tlsVersions are the TLS versions which can be required, by name
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+}
+
+// TLSMinVersion returns the minimum TLS version of the options, or an error if
+// it is unknown.
+func (t *TLSOptions) TLSMinVersion() (uint16, error) {
+	if t.MinVersion == "" {
+		return tls.VersionTLS10, nil
+	}
+	version, ok := tlsVersions[t.MinVersion]
+	if !ok {
+		return 0, fmt.Errorf("unknown TLS version %q, must be 1.0, 1.1 or 1.2", t.MinVersion)
+	}
+	return version, nil
+}
+
+// TLSCipherSuites returns the cipher suites of the options, or an error if one
+// of them is unknown.
+func (t *TLSOptions) TLSCipherSuites() ([]uint16, error) {
+	if len(t.CipherSuites) == 0 {
+		return defaultCipherSuites, nil
+	}
+	suites := make([]uint16, 0, len(t.CipherSuites))
+	for _, name := range t.CipherSuites {
+		suite, ok := cipherSuites[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown cipher suite %q", name)
+		}
+		suites = append(suites, suite)
+	}
+	return suites, nil
+}
+
 // ToTLSConfig outputs a tls.Config from TLSOptions
 func (t *TLSOptions) ToTLSConfig() (*tls.Config, error) {
+	var err error
 	tlsConfig := tls.Config{}
 	tlsConfig.InsecureSkipVerify = t.InsecureSkipVerify
 
@@ -58,7 +117,14 @@ func (t *TLSOptions) ToTLSConfig() (*tls.Config, error) {
 
 	tlsConfig.BuildNameToCertificate()
 
-	tlsConfig.CipherSuites = defaultCipherSuites
+	tlsConfig.MinVersion, err = t.TLSMinVersion()
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.CipherSuites, err = t.TLSCipherSuites()
+	if err != nil {
+		return nil, err
+	}
 
 	return &tlsConfig, nil
 }
@@ -22,6 +22,13 @@ type TLSOptions struct {
 	KeyFile            string `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
 	TrustedCAFile      string `protobuf:"bytes,3,opt,name=trusted_ca_file,json=trustedCaFile,proto3" json:"trusted_ca_file,omitempty"`
 	InsecureSkipVerify bool   `protobuf:"varint,4,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+	// MinVersion is the minimum TLS version accepted, 1.0, 1.1 or 1.2. It
+	// defaults to 1.0 when empty
+	MinVersion string `protobuf:"bytes,5,opt,name=min_version,json=minVersion,proto3" json:"min_version,omitempty"`
+	// CipherSuites are the names of the cipher suites enabled, e.g.
+	// TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256. A set of default cipher suites
+	// excluding 3DES is used when empty
+	CipherSuites []string `protobuf:"bytes,6,rep,name=cipher_suites,json=cipherSuites" json:"cipher_suites,omitempty"`
 }
 
 func (m *TLSOptions) Reset()                    { *m = TLSOptions{} }
@@ -57,6 +64,20 @@ func (m *TLSOptions) GetInsecureSkipVerify() bool {
 	return false
 }
 
+func (m *TLSOptions) GetMinVersion() string {
+	if m != nil {
+		return m.MinVersion
+	}
+	return ""
+}
+
+func (m *TLSOptions) GetCipherSuites() []string {
+	if m != nil {
+		return m.CipherSuites
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TLSOptions)(nil), "sensu.types.TLSOptions")
 }
@@ -97,6 +118,17 @@ func (this *TLSOptions) Equal(that interface{}) bool {
 	if this.InsecureSkipVerify != that1.InsecureSkipVerify {
 		return false
 	}
+	if this.MinVersion != that1.MinVersion {
+		return false
+	}
+	if len(this.CipherSuites) != len(that1.CipherSuites) {
+		return false
+	}
+	for i := range this.CipherSuites {
+		if this.CipherSuites[i] != that1.CipherSuites[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *TLSOptions) Marshal() (dAtA []byte, err error) {
@@ -142,6 +174,27 @@ func (m *TLSOptions) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.MinVersion) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTls(dAtA, i, uint64(len(m.MinVersion)))
+		i += copy(dAtA[i:], m.MinVersion)
+	}
+	if len(m.CipherSuites) > 0 {
+		for _, s := range m.CipherSuites {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -160,6 +213,12 @@ func NewPopulatedTLSOptions(r randyTls, easy bool) *TLSOptions {
 	this.KeyFile = string(randStringTls(r))
 	this.TrustedCAFile = string(randStringTls(r))
 	this.InsecureSkipVerify = bool(bool(r.Intn(2) == 0))
+	this.MinVersion = string(randStringTls(r))
+	v1 := r.Intn(10)
+	this.CipherSuites = make([]string, v1)
+	for i := 0; i < v1; i++ {
+		this.CipherSuites[i] = string(randStringTls(r))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -184,9 +243,9 @@ func randUTF8RuneTls(r randyTls) rune {
 	return rune(ru + 61)
 }
 func randStringTls(r randyTls) string {
-	v1 := r.Intn(100)
-	tmps := make([]rune, v1)
-	for i := 0; i < v1; i++ {
+	v2 := r.Intn(100)
+	tmps := make([]rune, v2)
+	for i := 0; i < v2; i++ {
 		tmps[i] = randUTF8RuneTls(r)
 	}
 	return string(tmps)
@@ -208,11 +267,11 @@ func randFieldTls(dAtA []byte, r randyTls, fieldNumber int, wire int) []byte {
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateTls(dAtA, uint64(key))
-		v2 := r.Int63()
+		v3 := r.Int63()
 		if r.Intn(2) == 0 {
-			v2 *= -1
+			v3 *= -1
 		}
-		dAtA = encodeVarintPopulateTls(dAtA, uint64(v2))
+		dAtA = encodeVarintPopulateTls(dAtA, uint64(v3))
 	case 1:
 		dAtA = encodeVarintPopulateTls(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -255,6 +314,16 @@ func (m *TLSOptions) Size() (n int) {
 	if m.InsecureSkipVerify {
 		n += 2
 	}
+	l = len(m.MinVersion)
+	if l > 0 {
+		n += 1 + l + sovTls(uint64(l))
+	}
+	if len(m.CipherSuites) > 0 {
+		for _, s := range m.CipherSuites {
+			l = len(s)
+			n += 1 + l + sovTls(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -407,6 +476,64 @@ func (m *TLSOptions) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.InsecureSkipVerify = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTls
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTls
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CipherSuites", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTls
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTls
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CipherSuites = append(m.CipherSuites, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTls(dAtA[iNdEx:])
@@ -536,22 +663,24 @@ var (
 func init() { proto.RegisterFile("tls.proto", fileDescriptorTls) }
 
 var fileDescriptorTls = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2c, 0xc9, 0x29, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2e, 0x4e, 0xcd, 0x2b, 0x2e, 0xd5, 0x2b, 0xa9, 0x2c,
-	0x48, 0x2d, 0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f,
-	0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x49, 0x2a, 0x4d, 0x03, 0xf3, 0xc0, 0x1c, 0x30, 0x0b, 0xa2,
-	0x57, 0x69, 0x23, 0x23, 0x17, 0x57, 0x88, 0x4f, 0xb0, 0x7f, 0x41, 0x49, 0x66, 0x7e, 0x5e, 0xb1,
-	0x90, 0x34, 0x17, 0x67, 0x72, 0x6a, 0x51, 0x49, 0x7c, 0x5a, 0x66, 0x4e, 0xaa, 0x04, 0xa3, 0x02,
-	0xa3, 0x06, 0x67, 0x10, 0x07, 0x48, 0xc0, 0x2d, 0x33, 0x27, 0x55, 0x48, 0x92, 0x8b, 0x23, 0x3b,
-	0xb5, 0x12, 0x22, 0xc7, 0x04, 0x96, 0x63, 0xcf, 0x4e, 0xad, 0x04, 0x4b, 0x59, 0x72, 0xf1, 0x97,
-	0x14, 0x95, 0x16, 0x97, 0xa4, 0xa6, 0xc4, 0x27, 0x27, 0x42, 0x54, 0x30, 0x83, 0x54, 0x38, 0x09,
-	0x3e, 0xba, 0x27, 0xcf, 0x1b, 0x02, 0x91, 0x72, 0x76, 0x04, 0xa9, 0x0d, 0xe2, 0x85, 0xaa, 0x74,
-	0x4e, 0x04, 0x6b, 0x35, 0xe0, 0x12, 0xc9, 0xcc, 0x2b, 0x4e, 0x4d, 0x2e, 0x2d, 0x4a, 0x8d, 0x2f,
-	0xce, 0xce, 0x2c, 0x88, 0x2f, 0x4b, 0x2d, 0xca, 0x4c, 0xab, 0x94, 0x60, 0x51, 0x60, 0xd4, 0xe0,
-	0x08, 0x12, 0x82, 0xc9, 0x05, 0x67, 0x67, 0x16, 0x84, 0x81, 0x65, 0x9c, 0x94, 0x7f, 0x3c, 0x94,
-	0x63, 0x5c, 0xf1, 0x48, 0x8e, 0x71, 0xc7, 0x23, 0x39, 0xc6, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x56, 0x70, 0x38, 0x24,
-	0xb1, 0x81, 0xfd, 0x67, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xa4, 0xa9, 0xd0, 0x41, 0x28, 0x01,
-	0x00, 0x00,
+	// 302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x90, 0x4d, 0x4a, 0x33, 0x41,
+	0x10, 0x40, 0xbf, 0xfe, 0x62, 0x62, 0xa6, 0x63, 0x10, 0x07, 0x17, 0xa3, 0x42, 0x27, 0x98, 0x4d,
+	0x36, 0x26, 0x82, 0x2b, 0x97, 0x26, 0xe0, 0x4a, 0x10, 0x26, 0x21, 0x0b, 0x37, 0x43, 0x32, 0x56,
+	0x92, 0x62, 0x92, 0xe9, 0xa1, 0x7f, 0x84, 0xb9, 0x89, 0x47, 0xf0, 0x08, 0x1e, 0xc1, 0xa5, 0x27,
+	0x10, 0x6d, 0x0f, 0xa1, 0x4b, 0x99, 0x6a, 0xdd, 0x75, 0xbd, 0xf7, 0x0a, 0x9a, 0xe2, 0x81, 0xd9,
+	0xe8, 0x41, 0xa1, 0xa4, 0x91, 0x61, 0x4b, 0x43, 0xae, 0xed, 0xc0, 0x94, 0x05, 0xe8, 0xe3, 0xb3,
+	0x15, 0x9a, 0xb5, 0x5d, 0x0c, 0x52, 0xb9, 0x1d, 0xae, 0xe4, 0x4a, 0x0e, 0xa9, 0x59, 0xd8, 0x25,
+	0x4d, 0x34, 0xd0, 0xcb, 0xef, 0x9e, 0x7e, 0x31, 0xce, 0xa7, 0x37, 0x93, 0xdb, 0xc2, 0xa0, 0xcc,
+	0x75, 0x78, 0xc2, 0x83, 0x14, 0x94, 0x49, 0x96, 0xb8, 0x81, 0x88, 0x75, 0x59, 0x3f, 0x88, 0x9b,
+	0x15, 0xb8, 0xc6, 0x0d, 0x84, 0x47, 0xbc, 0x99, 0x41, 0xe9, 0xdd, 0x7f, 0x72, 0xbb, 0x19, 0x94,
+	0xa4, 0x2e, 0xf9, 0xbe, 0x51, 0x56, 0x1b, 0xb8, 0x4f, 0xd2, 0xb9, 0x2f, 0x6a, 0x55, 0x31, 0x3a,
+	0x70, 0x6f, 0x9d, 0xf6, 0xd4, 0xab, 0xf1, 0x55, 0xd5, 0xc6, 0xed, 0xdf, 0x72, 0x3c, 0xa7, 0xd5,
+	0x73, 0x7e, 0x88, 0xb9, 0x86, 0xd4, 0x2a, 0x48, 0x74, 0x86, 0x45, 0xf2, 0x00, 0x0a, 0x97, 0x65,
+	0xb4, 0xd3, 0x65, 0xfd, 0x66, 0x1c, 0xfe, 0xb9, 0x49, 0x86, 0xc5, 0x8c, 0x4c, 0xd8, 0xe1, 0xad,
+	0x2d, 0xe6, 0x55, 0xa7, 0x51, 0xe6, 0x51, 0x9d, 0xbe, 0xc2, 0xb7, 0x98, 0xcf, 0x3c, 0x09, 0x7b,
+	0xbc, 0x9d, 0x62, 0xb1, 0x06, 0x95, 0x68, 0x8b, 0x06, 0x74, 0xd4, 0xe8, 0xd6, 0xfa, 0x41, 0xbc,
+	0xe7, 0xe1, 0x84, 0xd8, 0xa8, 0xf7, 0xfd, 0x21, 0xd8, 0x93, 0x13, 0xec, 0xd9, 0x09, 0xf6, 0xe2,
+	0x04, 0x7b, 0x75, 0x82, 0xbd, 0x3b, 0xc1, 0x1e, 0x3f, 0xc5, 0xbf, 0xbb, 0x3a, 0x5d, 0x73, 0xd1,
+	0xa0, 0x2b, 0x5d, 0xfc, 0x0c, 0x00, 0xfc, 0xe9, 0x66, 0xea, 0x6e, 0x01, 0x00, 0x00,
 }
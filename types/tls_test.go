@@ -0,0 +1,30 @@
+package types
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSOptionsToTLSConfig(t *testing.T) {
+	config, err := (&TLSOptions{}).ToTLSConfig()
+	require.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS10), config.MinVersion)
+	assert.Equal(t, defaultCipherSuites, config.CipherSuites)
+
+	config, err = (&TLSOptions{
+		MinVersion:   "1.2",
+		CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384", "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+	}).ToTLSConfig()
+	require.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS12), config.MinVersion)
+	assert.Equal(t, []uint16{tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384, tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256}, config.CipherSuites)
+
+	_, err = (&TLSOptions{MinVersion: "1.4"}).ToTLSConfig()
+	assert.EqualError(t, err, `unknown TLS version "1.4", must be 1.0, 1.1 or 1.2`)
+
+	_, err = (&TLSOptions{CipherSuites: []string{"TLS_RSA_WITH_3DES_EDE_CBC_SHA"}}).ToTLSConfig()
+	assert.EqualError(t, err, `unknown cipher suite "TLS_RSA_WITH_3DES_EDE_CBC_SHA"`)
+}
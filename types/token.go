@@ -5,4 +5,13 @@ import jwt "github.com/dgrijalva/jwt-go"
 // Claims represents the JWT claims
 type Claims struct {
 	jwt.StandardClaims
+
+	// Groups are the groups of the user, as given by the authentication
+	// provider when the user logged in
+	Groups []string `json:"groups,omitempty"`
+
+	// Scope restricts the permissions of the user to the ones granted by
+	// these rules, for the short-lived tokens minted by the user. The token
+	// has all the permissions of the user when it's empty
+	Scope []Rule `json:"scope,omitempty"`
 }
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+const (
+	// TombstoneKindCheck is the kind of the tombstones of the checks
+	TombstoneKindCheck = "check"
+
+	// TombstoneKindHandler is the kind of the tombstones of the handlers
+	TombstoneKindHandler = "handler"
+
+	// TombstoneKindSilenced is the kind of the tombstones of the silenced
+	// entries
+	TombstoneKindSilenced = "silenced"
+)
+
+// TombstoneKinds are the kinds of the resources kept as tombstones once
+// deleted.
+var TombstoneKinds = []string{TombstoneKindCheck, TombstoneKindHandler, TombstoneKindSilenced}
+
+// Tombstone keeps a deleted resource during the undo window of the backend,
+// so that it can be restored.
+type Tombstone struct {
+	// Kind is the kind of the deleted resource, i.e. check, handler or
+	// silenced
+	Kind string `json:"kind"`
+
+	// Name is the name of the deleted resource, or its ID for the silenced
+	// entries
+	Name string `json:"name"`
+
+	// Organization is the organization of the deleted resource
+	Organization string `json:"organization"`
+
+	// Environment is the environment of the deleted resource
+	Environment string `json:"environment"`
+
+	// DeletedAt is the unix time at which the resource was deleted
+	DeletedAt int64 `json:"deleted_at"`
+
+	// DeletedBy is the user who deleted the resource
+	DeletedBy string `json:"deleted_by,omitempty"`
+
+	// ExpireAt is the unix time after which the resource can't be restored
+	ExpireAt int64 `json:"expire_at"`
+
+	// Resource is the deleted resource
+	Resource json.RawMessage `json:"resource"`
+}
+
+// Validate returns an error if the tombstone is invalid.
+func (t *Tombstone) Validate() error {
+	if err := ValidateTombstoneKind(t.Kind); err != nil {
+		return err
+	}
+
+	if t.Name == "" {
+		return errors.New("name must be set")
+	}
+
+	if t.Environment == "" {
+		return errors.New("environment must be set")
+	}
+
+	if t.Organization == "" {
+		return errors.New("organization must be set")
+	}
+
+	if len(t.Resource) == 0 {
+		return errors.New("resource must be set")
+	}
+
+	return nil
+}
+
+// ValidateTombstoneKind returns an error if the deleted resources of the
+// given kind aren't kept as tombstones.
+func ValidateTombstoneKind(kind string) error {
+	for _, k := range TombstoneKinds {
+		if kind == k {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid kind %q, must be one of %v", kind, TombstoneKinds)
+}
+
+// GetOrganization returns the organization of the deleted resource.
+func (t *Tombstone) GetOrganization() string {
+	return t.Organization
+}
+
+// GetEnvironment returns the environment of the deleted resource.
+func (t *Tombstone) GetEnvironment() string {
+	return t.Environment
+}
+
+// SetNamespace sets the organization and environment of the tombstone.
+func (t *Tombstone) SetNamespace(org, env string) {
+	t.Organization = org
+	t.Environment = env
+}
+
+// URIPath returns the path of the tombstone, relative to the API root.
+func (t *Tombstone) URIPath() string {
+	return path.Join("/tombstones", url.PathEscape(t.Kind), url.PathEscape(t.Name))
+}
+
+// FixtureTombstone returns a Tombstone of a check for use in testing.
+func FixtureTombstone(name string) *Tombstone {
+	resource, _ := json.Marshal(FixtureCheckConfig(name))
+	return &Tombstone{
+		Kind:         TombstoneKindCheck,
+		Name:         name,
+		Organization: "default",
+		Environment:  "default",
+		DeletedAt:    1522101000,
+		ExpireAt:     1522104600,
+		Resource:     resource,
+	}
+}
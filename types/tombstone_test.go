@@ -0,0 +1,27 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixtureTombstoneIsValid(t *testing.T) {
+	tombstone := FixtureTombstone("check-cpu")
+	assert.NoError(t, tombstone.Validate())
+	assert.Equal(t, "/tombstones/check/check-cpu", tombstone.URIPath())
+}
+
+func TestTombstoneValidate(t *testing.T) {
+	tombstone := FixtureTombstone("check-cpu")
+	tombstone.Kind = "entity"
+	assert.Error(t, tombstone.Validate())
+
+	tombstone = FixtureTombstone("check-cpu")
+	tombstone.Name = ""
+	assert.Error(t, tombstone.Validate())
+
+	tombstone = FixtureTombstone("check-cpu")
+	tombstone.Resource = nil
+	assert.Error(t, tombstone.Validate())
+}
@@ -0,0 +1,35 @@
+package types
+
+// Usage is the event throughput of a check in an organization and
+// environment.
+type Usage struct {
+	// Organization is the organization of the events
+	Organization string `json:"organization"`
+
+	// Environment is the environment of the events
+	Environment string `json:"environment"`
+
+	// Check is the name of the check of the events
+	Check string `json:"check"`
+
+	// Events is the number of events processed
+	Events uint64 `json:"events"`
+
+	// Bytes is the size, in bytes, of the events processed
+	Bytes uint64 `json:"bytes"`
+
+	// EventsPerSecond is the rate of events processed over the last minute
+	EventsPerSecond float64 `json:"events_per_second"`
+}
+
+// FixtureUsage returns a Usage for use in testing.
+func FixtureUsage(check string, events uint64) *Usage {
+	return &Usage{
+		Organization:    "default",
+		Environment:     "default",
+		Check:           check,
+		Events:          events,
+		Bytes:           events * 100,
+		EventsPerSecond: float64(events) / 60,
+	}
+}
@@ -21,6 +21,7 @@ type User struct {
 	Password string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Roles    []string `protobuf:"bytes,3,rep,name=roles" json:"roles,omitempty"`
 	Disabled bool     `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Groups   []string `protobuf:"bytes,5,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (m *User) Reset()                    { *m = User{} }
@@ -56,6 +57,13 @@ func (m *User) GetDisabled() bool {
 	return false
 }
 
+func (m *User) GetGroups() []string {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*User)(nil), "sensu.types.User")
 }
@@ -101,6 +109,14 @@ func (this *User) Equal(that interface{}) bool {
 	if this.Disabled != that1.Disabled {
 		return false
 	}
+	if len(this.Groups) != len(that1.Groups) {
+		return false
+	}
+	for i := range this.Groups {
+		if this.Groups[i] != that1.Groups[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *User) Marshal() (dAtA []byte, err error) {
@@ -155,6 +171,21 @@ func (m *User) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -177,6 +208,11 @@ func NewPopulatedUser(r randyUser, easy bool) *User {
 		this.Roles[i] = string(randStringUser(r))
 	}
 	this.Disabled = bool(bool(r.Intn(2) == 0))
+	v2 := r.Intn(10)
+	this.Groups = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.Groups[i] = string(randStringUser(r))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -201,9 +237,9 @@ func randUTF8RuneUser(r randyUser) rune {
 	return rune(ru + 61)
 }
 func randStringUser(r randyUser) string {
-	v2 := r.Intn(100)
-	tmps := make([]rune, v2)
-	for i := 0; i < v2; i++ {
+	v3 := r.Intn(100)
+	tmps := make([]rune, v3)
+	for i := 0; i < v3; i++ {
 		tmps[i] = randUTF8RuneUser(r)
 	}
 	return string(tmps)
@@ -225,11 +261,11 @@ func randFieldUser(dAtA []byte, r randyUser, fieldNumber int, wire int) []byte {
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
-		v3 := r.Int63()
+		v4 := r.Int63()
 		if r.Intn(2) == 0 {
-			v3 *= -1
+			v4 *= -1
 		}
-		dAtA = encodeVarintPopulateUser(dAtA, uint64(v3))
+		dAtA = encodeVarintPopulateUser(dAtA, uint64(v4))
 	case 1:
 		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -274,6 +310,12 @@ func (m *User) Size() (n int) {
 	if m.Disabled {
 		n += 2
 	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -426,6 +468,35 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Disabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -555,18 +626,20 @@ var (
 func init() { proto.RegisterFile("user.proto", fileDescriptorUser) }
 
 var fileDescriptorUser = []byte{
-	// 199 bytes of a gzipped FileDescriptorProto
+	// 233 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2a, 0x2d, 0x4e, 0x2d,
 	0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2e, 0x4e, 0xcd, 0x2b, 0x2e, 0xd5, 0x2b, 0xa9,
 	0x2c, 0x48, 0x2d, 0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
 	0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x49, 0x2a, 0x4d, 0x03, 0xf3, 0xc0, 0x1c, 0x30, 0x0b,
-	0xa2, 0x57, 0xa9, 0x80, 0x8b, 0x25, 0xb4, 0x38, 0xb5, 0x48, 0x48, 0x8a, 0x8b, 0x03, 0x64, 0x62,
-	0x5e, 0x62, 0x6e, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x9c, 0x0f, 0x92, 0x2b, 0x48,
-	0x2c, 0x2e, 0x2e, 0xcf, 0x2f, 0x4a, 0x91, 0x60, 0x82, 0xc8, 0xc1, 0xf8, 0x42, 0x22, 0x5c, 0xac,
-	0x45, 0xf9, 0x39, 0xa9, 0xc5, 0x12, 0xcc, 0x0a, 0xcc, 0x1a, 0x9c, 0x41, 0x10, 0x0e, 0x48, 0x47,
-	0x4a, 0x66, 0x71, 0x62, 0x52, 0x4e, 0x6a, 0x8a, 0x04, 0x8b, 0x02, 0xa3, 0x06, 0x47, 0x10, 0x9c,
-	0xef, 0xa4, 0xfc, 0xe3, 0xa1, 0x1c, 0xe3, 0x8a, 0x47, 0x72, 0x8c, 0x3b, 0x1e, 0xc9, 0x31, 0x9e,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x33, 0x1e, 0xcb, 0x31,
-	0x44, 0xb1, 0x82, 0x7d, 0x91, 0xc4, 0x06, 0x76, 0x9d, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x95,
-	0xcd, 0x48, 0xde, 0xe7, 0x00, 0x00, 0x00,
+	0xa2, 0x57, 0x69, 0x1e, 0x23, 0x17, 0x4b, 0x68, 0x71, 0x6a, 0x91, 0x90, 0x14, 0x17, 0x07, 0xc8,
+	0xc8, 0xbc, 0xc4, 0xdc, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x38, 0x1f, 0x24, 0x57,
+	0x90, 0x58, 0x5c, 0x5c, 0x9e, 0x5f, 0x94, 0x22, 0xc1, 0x04, 0x91, 0x83, 0xf1, 0x85, 0x44, 0xb8,
+	0x58, 0x8b, 0xf2, 0x73, 0x52, 0x8b, 0x25, 0x98, 0x15, 0x98, 0x35, 0x38, 0x83, 0x20, 0x1c, 0x90,
+	0x8e, 0x94, 0xcc, 0xe2, 0xc4, 0xa4, 0x9c, 0xd4, 0x14, 0x09, 0x16, 0x05, 0x46, 0x0d, 0x8e, 0x20,
+	0x38, 0x5f, 0x48, 0x87, 0x8b, 0x2d, 0xbd, 0x28, 0xbf, 0xb4, 0xa0, 0x58, 0x82, 0x15, 0xa4, 0xc5,
+	0x49, 0xe4, 0xd5, 0x3d, 0x79, 0x01, 0x88, 0x88, 0x4e, 0x7e, 0x6e, 0x66, 0x49, 0x6a, 0x6e, 0x41,
+	0x49, 0x65, 0x10, 0x54, 0x8d, 0x93, 0xf2, 0x8f, 0x87, 0x72, 0x8c, 0x2b, 0x1e, 0xc9, 0x31, 0xee,
+	0x78, 0x24, 0xc7, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
+	0xce, 0x78, 0x2c, 0xc7, 0x10, 0xc5, 0x0a, 0xf6, 0x74, 0x12, 0x1b, 0xd8, 0x33, 0xc6, 0x80, 0x01,
+	0x00, 0x50, 0xfd, 0x38, 0xc9, 0x16, 0x01, 0x00, 0x00,
 }
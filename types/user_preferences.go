@@ -0,0 +1,94 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/sensu/sensu-go/util/eval"
+)
+
+// Validate returns an error if the preferences do not pass validation tests.
+func (p *UserPreferences) Validate() error {
+	if err := ValidateNameStrict(p.Username); err != nil {
+		return fmt.Errorf("username %s", err)
+	}
+
+	if p.DefaultEnvironment != "" && p.DefaultOrganization == "" {
+		return errors.New("default environment requires a default organization")
+	}
+
+	searches := make(map[string]bool, len(p.SavedSearches))
+	for i, search := range p.SavedSearches {
+		if err := search.Validate(); err != nil {
+			return fmt.Errorf("saved search %d: %s", i, err)
+		}
+		if searches[search.Name] {
+			return fmt.Errorf("saved search %d: duplicate name %q", i, search.Name)
+		}
+		searches[search.Name] = true
+	}
+
+	tables := make(map[string]bool, len(p.TableLayouts))
+	for i, layout := range p.TableLayouts {
+		if err := ValidateName(layout.Table); err != nil {
+			return fmt.Errorf("table layout %d: table %s", i, err)
+		}
+		if tables[layout.Table] {
+			return fmt.Errorf("table layout %d: duplicate table %q", i, layout.Table)
+		}
+		tables[layout.Table] = true
+	}
+
+	return nil
+}
+
+// Validate returns an error if the saved search does not pass validation
+// tests.
+func (s *SavedSearch) Validate() error {
+	if err := ValidateName(s.Name); err != nil {
+		return errors.New("name " + err.Error())
+	}
+
+	if s.Filter == "" {
+		return errors.New("filter must be set")
+	}
+
+	if s.Environment != "" && s.Organization == "" {
+		return errors.New("environment requires an organization")
+	}
+
+	return eval.ValidateStatements([]string{s.Filter})
+}
+
+// SavedSearch returns the saved search with the given name, or nil.
+func (p *UserPreferences) SavedSearch(name string) *SavedSearch {
+	for i := range p.SavedSearches {
+		if p.SavedSearches[i].Name == name {
+			return &p.SavedSearches[i]
+		}
+	}
+	return nil
+}
+
+// URIPath returns the path of the preferences, relative to the API root.
+func (p *UserPreferences) URIPath() string {
+	return path.Join("/rbac/users", url.PathEscape(p.Username), "preferences")
+}
+
+// FixtureUserPreferences returns UserPreferences fixture for testing, with a
+// saved search of the failing events.
+func FixtureUserPreferences(username string) *UserPreferences {
+	return &UserPreferences{
+		Username:            username,
+		DefaultOrganization: "default",
+		DefaultEnvironment:  "default",
+		SavedSearches: []SavedSearch{
+			{Name: "failing", Filter: "event.Check.Status != 0"},
+		},
+		TableLayouts: []TableLayout{
+			{Table: "events", Columns: []string{"entity", "check", "status"}},
+		},
+	}
+}
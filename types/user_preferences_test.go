@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserPreferencesValidate(t *testing.T) {
+	p := FixtureUserPreferences("foo")
+	assert.NoError(t, p.Validate())
+
+	p.Username = ""
+	assert.Error(t, p.Validate())
+	p.Username = "foo"
+
+	p.DefaultOrganization = ""
+	assert.Error(t, p.Validate())
+	p.DefaultOrganization = "default"
+
+	p.SavedSearches[0].Filter = ""
+	assert.Error(t, p.Validate())
+	p.SavedSearches[0].Filter = "event.Check.Status >"
+	assert.Error(t, p.Validate())
+	p.SavedSearches[0].Filter = "event.Check.Status != 0"
+
+	p.SavedSearches = append(p.SavedSearches, SavedSearch{Name: "failing", Filter: "true"})
+	assert.Error(t, p.Validate())
+	p.SavedSearches[1].Name = "all"
+	p.SavedSearches[1].Environment = "dev"
+	assert.Error(t, p.Validate())
+	p.SavedSearches[1].Organization = "acme"
+
+	p.TableLayouts = append(p.TableLayouts, TableLayout{Table: "events"})
+	assert.Error(t, p.Validate())
+	p.TableLayouts[1].Table = "entities"
+	assert.NoError(t, p.Validate())
+}
+
+func TestUserPreferencesSavedSearch(t *testing.T) {
+	p := FixtureUserPreferences("foo")
+	require.NotNil(t, p.SavedSearch("failing"))
+	assert.Equal(t, "event.Check.Status != 0", p.SavedSearch("failing").Filter)
+	assert.Nil(t, p.SavedSearch("all"))
+}
+
+func TestUserPreferencesMarshalJSON(t *testing.T) {
+	p := FixtureUserPreferences("foo")
+	b, err := json.Marshal(p)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"saved_searches":[{"name":"failing"`)
+
+	var decoded UserPreferences
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, p, &decoded)
+	assert.Equal(t, "/rbac/users/foo/preferences", p.URIPath())
+}
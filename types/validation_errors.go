@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ValidationRequired is the code of the errors of fields which must be set
+	ValidationRequired = "required"
+
+	// ValidationInvalid is the code of the errors of fields with an invalid
+	// value
+	ValidationInvalid = "invalid"
+)
+
+// FieldError is a validation error of a single field of a resource.
+type FieldError struct {
+	// Field is the path of the field, using the JSON field names of the
+	// resource (e.g. proxy_requests.splay_coverage or runtime_assets[1])
+	Field string `json:"field"`
+
+	// Code is the kind of validation error, i.e. required or invalid
+	Code string `json:"code"`
+
+	// Message describes the validation error
+	Message string `json:"message"`
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	return e.Message
+}
+
+// ValidationErrors are the validation errors of the fields of a resource,
+// so all of them can be reported at once.
+type ValidationErrors []*FieldError
+
+// Error implements the error interface.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Add records a validation error of the given field.
+func (e *ValidationErrors) Add(field, code, message string) {
+	*e = append(*e, &FieldError{Field: field, Code: code, Message: message})
+}
+
+// Addf records a validation error of the given field, formatted according to
+// a format specifier.
+func (e *ValidationErrors) Addf(field, code, format string, a ...interface{}) {
+	e.Add(field, code, fmt.Sprintf(format, a...))
+}
+
+// Err returns the validation errors as an error, or nil if there are none.
+func (e ValidationErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// requiredOrInvalid returns the code of the validation error of a field,
+// depending on whether it is set.
+func requiredOrInvalid(value string) string {
+	if value == "" {
+		return ValidationRequired
+	}
+	return ValidationInvalid
+}
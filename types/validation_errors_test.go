@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationErrors(t *testing.T) {
+	var errs ValidationErrors
+	assert.NoError(t, errs.Err())
+
+	errs.Add("url", ValidationRequired, "URL cannot be empty")
+	errs.Addf("runtime_assets[1]", ValidationInvalid, "asset's %s", "name cannot be empty")
+	require.Error(t, errs.Err())
+	assert.Equal(t, "URL cannot be empty; asset's name cannot be empty", errs.Error())
+}
+
+func TestAssetValidationErrors(t *testing.T) {
+	asset := FixtureAsset("name")
+	asset.Organization = ""
+	asset.URL = "file:///root/my_script.sh"
+
+	err := asset.Validate()
+	require.IsType(t, ValidationErrors{}, err)
+	errs := err.(ValidationErrors)
+	require.Len(t, errs, 2)
+	assert.Equal(t, &FieldError{"organization", ValidationRequired, "organization cannot be empty"}, errs[0])
+	assert.Equal(t, &FieldError{"url", ValidationInvalid, "URL must be HTTP or HTTPS"}, errs[1])
+}
+
+func TestCheckConfigValidationErrors(t *testing.T) {
+	check := FixtureCheckConfig("check")
+	check.RuntimeAssets = []string{"good", "BAD"}
+	check.Ttl = 10
+
+	err := check.Validate()
+	require.IsType(t, ValidationErrors{}, err)
+	errs := err.(ValidationErrors)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "ttl", errs[0].Field)
+	assert.Equal(t, "runtime_assets[1]", errs[1].Field)
+	assert.Equal(t, ValidationInvalid, errs[1].Code)
+}
@@ -32,6 +32,7 @@ func validateHandlerType(t string) error {
 		"pipe",
 		"tcp",
 		"udp",
+		"webhook",
 		"transport",
 		"set":
 		return nil
@@ -72,3 +73,16 @@ func validateNameWithPattern(name string, rexp *regexp.Regexp) error {
 
 	return nil
 }
+
+func validateHandlerSeverity(s string) error {
+	switch s {
+	case
+		HandlerSeverityOK,
+		HandlerSeverityWarning,
+		HandlerSeverityCritical,
+		HandlerSeverityUnknown:
+		return nil
+	}
+
+	return errors.New("is unknown")
+}
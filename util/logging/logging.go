@@ -0,0 +1,159 @@
+// Package logging configures the logrus logger shared by the components of
+// the Sensu agent and backend.
+package logging
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Sirupsen/logrus"
+)
+
+const (
+	// FormatJSON formats log entries as JSON objects
+	FormatJSON = "json"
+
+	// FormatText formats log entries as human readable text
+	FormatText = "text"
+
+	// ComponentField is the field used by components to identify their log
+	// entries
+	ComponentField = "component"
+)
+
+// Config describes how log entries are filtered and formatted.
+type Config struct {
+	// Level is the default logging level.
+	Level string
+
+	// Format is the output format, either json or text.
+	Format string
+
+	// ComponentLevels overrides the logging level of specific components,
+	// keyed by component name.
+	ComponentLevels map[string]string
+}
+
+// ParseComponentLevels parses a list of component=level pairs, each of which
+// can itself be a comma-delimited list, as read from a flag or a config file.
+func ParseComponentLevels(pairs []string) (map[string]string, error) {
+	levels := make(map[string]string, len(pairs))
+	for _, pair := range strings.Split(strings.Join(pairs, ","), ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid component log level %q, expected component=level", pair)
+		}
+		component, level := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if component == "" || level == "" {
+			return nil, fmt.Errorf("invalid component log level %q, expected component=level", pair)
+		}
+		levels[component] = level
+	}
+	return levels, nil
+}
+
+// configureMu serializes the configurations of the loggers.
+var configureMu sync.Mutex
+
+// Configure applies the given configuration to the standard logrus logger.
+// It can be called again at runtime to change the configuration, while
+// entries are logged.
+func Configure(cfg Config) error {
+	return configure(logrus.StandardLogger(), cfg)
+}
+
+func configure(logger *logrus.Logger, cfg Config) error {
+	level, err := logrus.ParseLevel(cfg.Level)
+	if err != nil {
+		return err
+	}
+
+	var base logrus.Formatter
+	switch cfg.Format {
+	case FormatJSON, "":
+		base = &logrus.JSONFormatter{}
+	case FormatText:
+		base = &logrus.TextFormatter{FullTimestamp: true}
+	default:
+		return fmt.Errorf("unknown log format %q, expected %s or %s", cfg.Format, FormatJSON, FormatText)
+	}
+
+	formatter := &componentFormatter{
+		Formatter:    base,
+		defaultLevel: level,
+		levels:       make(map[string]logrus.Level, len(cfg.ComponentLevels)),
+	}
+
+	// The logger discards entries before they reach the formatter, so it must
+	// be set to the most verbose level in use.
+	maxLevel := level
+	for component, l := range cfg.ComponentLevels {
+		componentLevel, err := logrus.ParseLevel(l)
+		if err != nil {
+			return fmt.Errorf("component %s: %s", component, err)
+		}
+		formatter.levels[component] = componentLevel
+		if componentLevel > maxLevel {
+			maxLevel = componentLevel
+		}
+	}
+
+	configureMu.Lock()
+	defer configureMu.Unlock()
+
+	// The formatter of the logger is read without locking by the goroutines
+	// logging entries, so it's only set once and the configurations are
+	// swapped atomically within it afterwards
+	swappable, ok := logger.Formatter.(*swappableFormatter)
+	if !ok {
+		swappable = &swappableFormatter{}
+		swappable.formatter.Store(formatter)
+		logger.Formatter = swappable
+	} else {
+		swappable.formatter.Store(formatter)
+	}
+	logger.SetLevel(maxLevel)
+	return nil
+}
+
+// swappableFormatter formats the entries with the formatter of the current
+// configuration, which can be swapped while entries are logged.
+type swappableFormatter struct {
+	formatter atomic.Value // *componentFormatter
+}
+
+// Format implements logrus.Formatter
+func (f *swappableFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	return f.formatter.Load().(*componentFormatter).Format(entry)
+}
+
+// componentFormatter drops the entries of components that are more verbose
+// than the level configured for them, and formats the remaining entries with
+// the wrapped formatter.
+type componentFormatter struct {
+	logrus.Formatter
+
+	defaultLevel logrus.Level
+	levels       map[string]logrus.Level
+}
+
+// Format implements logrus.Formatter
+func (f *componentFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	level := f.defaultLevel
+	if component, ok := entry.Data[ComponentField].(string); ok {
+		if l, ok := f.levels[component]; ok {
+			level = l
+		}
+	}
+
+	if entry.Level > level {
+		return nil, nil
+	}
+	return f.Formatter.Format(entry)
+}
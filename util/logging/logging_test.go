@@ -0,0 +1,98 @@
+package logging
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseComponentLevels(t *testing.T) {
+	levels, err := ParseComponentLevels([]string{"agent=debug", " store = warn ", ""})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"agent": "debug", "store": "warn"}, levels)
+
+	levels, err = ParseComponentLevels([]string{"agent=debug, store=warn", "pipelined=info"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"agent": "debug", "store": "warn", "pipelined": "info"}, levels)
+
+	_, err = ParseComponentLevels([]string{"agent"})
+	assert.Error(t, err)
+
+	_, err = ParseComponentLevels([]string{"=debug"})
+	assert.Error(t, err)
+}
+
+func TestConfigure(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{"defaults", Config{Level: "info"}, false},
+		{"text format", Config{Level: "info", Format: FormatText}, false},
+		{"invalid level", Config{Level: "loud"}, true},
+		{"invalid format", Config{Level: "info", Format: "xml"}, true},
+		{"invalid component level", Config{Level: "info", ComponentLevels: map[string]string{"agent": "loud"}}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := configure(logrus.New(), tc.cfg)
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestComponentLevels(t *testing.T) {
+	logger := logrus.New()
+	buf := new(bytes.Buffer)
+	logger.Out = buf
+
+	require.NoError(t, configure(logger, Config{
+		Level:           "warn",
+		Format:          FormatText,
+		ComponentLevels: map[string]string{"pipelined": "debug"},
+	}))
+	assert.Equal(t, logrus.DebugLevel, logger.Level)
+
+	logger.WithField(ComponentField, "pipelined").Debug("pipelined debug")
+	logger.WithField(ComponentField, "store").Info("store info")
+	logger.WithField(ComponentField, "store").Warn("store warn")
+	logger.Info("default info")
+
+	out := buf.String()
+	assert.Contains(t, out, "pipelined debug")
+	assert.Contains(t, out, "store warn")
+	assert.NotContains(t, out, "store info")
+	assert.NotContains(t, out, "default info")
+}
+
+func TestConfigureWhileLogging(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = ioutil.Discard
+	require.NoError(t, configure(logger, Config{Level: "info"}))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			logger.WithField(ComponentField, "store").Info("store info")
+		}
+	}()
+
+	// The configuration is swapped while entries are logged, which the race
+	// detector checks
+	for i := 0; i < 100; i++ {
+		require.NoError(t, configure(logger, Config{Level: "debug", Format: FormatText}))
+	}
+	<-done
+
+	buf := new(bytes.Buffer)
+	logger.Out = buf
+	logger.Debug("default debug")
+	assert.Contains(t, buf.String(), "default debug")
+}
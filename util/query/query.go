@@ -0,0 +1,330 @@
+// Package query provides the expression language used to select events and
+// entities, e.g. check.status != 0 && entity.system.os == "linux".
+//
+// The fields of a resource are referred to by their JSON name, and the custom
+// attributes of a resource are available like any other field, unless they
+// are redacted. Missing fields are null, and the resources for which the expression can't be evaluated,
+// e.g. because a field is compared to a value of a different type, are not
+// selected.
+package query
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/sensu/govaluate"
+)
+
+// Query is a parsed query expression.
+type Query struct {
+	expr    *govaluate.EvaluableExpression
+	aliases map[string][]string
+}
+
+// Parse parses a query expression. The aliases map variable names to the path
+// of the field they stand for, e.g. "status" to {"check", "status"}.
+func Parse(expression string, aliases map[string][]string) (*Query, error) {
+	expr, err := govaluate.NewEvaluableExpression(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query: %s", err)
+	}
+
+	// The queries only select resources, they don't compute values
+	for _, token := range expr.Tokens() {
+		if token.Kind == govaluate.MODIFIER {
+			return nil, fmt.Errorf("invalid query: forbidden modifier %v", token.Value)
+		}
+	}
+
+	return &Query{expr: expr, aliases: aliases}, nil
+}
+
+// String returns the query expression.
+func (q *Query) String() string {
+	return q.expr.String()
+}
+
+// Match returns true if v satisfies the query.
+func (q *Query) Match(v interface{}) bool {
+	result, err := q.expr.Eval(&parameters{value: reflect.ValueOf(v), aliases: q.aliases})
+	if err != nil {
+		return false
+	}
+
+	match, ok := result.(bool)
+	return ok && match
+}
+
+// Equals returns the string the given field must be equal to for a resource to
+// satisfy the query, if the query requires it. It allows the query to be
+// pushed down to the store, by only retrieving the resources with this field
+// value. It's conservative and only recognizes the queries made of
+// comparisons joined by &&, e.g. entity.id == "foo" && check.status != 0.
+func (q *Query) Equals(field ...string) (string, bool) {
+	var value string
+	ok := q.comparison("==", func(left, right govaluate.ExpressionToken) bool {
+		if q.isField(left, field) && right.Kind == govaluate.STRING {
+			value = right.Value.(string)
+			return true
+		}
+		if q.isField(right, field) && left.Kind == govaluate.STRING {
+			value = left.Value.(string)
+			return true
+		}
+		return false
+	})
+	return value, ok
+}
+
+// Contains returns the string the given list field must contain for a
+// resource to satisfy the query, if the query requires it, e.g. "linux" in
+// entity.subscriptions. Like Equals, it's conservative.
+func (q *Query) Contains(field ...string) (string, bool) {
+	var value string
+	ok := q.comparison("in", func(left, right govaluate.ExpressionToken) bool {
+		if q.isField(right, field) && left.Kind == govaluate.STRING {
+			value = left.Value.(string)
+			return true
+		}
+		return false
+	})
+	return value, ok
+}
+
+// EqualsKey returns the key and the string the value of the given map field
+// at this key must be equal to for a resource to satisfy the query, if the
+// query requires it, e.g. entity.labels.region == "us-west-2". Like Equals,
+// it's conservative.
+func (q *Query) EqualsKey(field ...string) (string, string, bool) {
+	var key, value string
+	isKey := func(token govaluate.ExpressionToken) bool {
+		path := q.path(token)
+		if len(path) != len(field)+1 {
+			return false
+		}
+		for i := range field {
+			if path[i] != field[i] {
+				return false
+			}
+		}
+		key = path[len(field)]
+		return true
+	}
+	ok := q.comparison("==", func(left, right govaluate.ExpressionToken) bool {
+		if right.Kind == govaluate.STRING && isKey(left) {
+			value = right.Value.(string)
+			return true
+		}
+		if left.Kind == govaluate.STRING && isKey(right) {
+			value = left.Value.(string)
+			return true
+		}
+		return false
+	})
+	return key, value, ok
+}
+
+// comparison returns true if the query is made of comparisons joined by &&,
+// and one of them, using the given comparator, satisfies match.
+func (q *Query) comparison(comparator string, match func(left, right govaluate.ExpressionToken) bool) bool {
+	tokens := q.expr.Tokens()
+	for _, token := range tokens {
+		switch token.Kind {
+		case govaluate.PREFIX, govaluate.TERNARY:
+			return false
+		case govaluate.LOGICALOP:
+			if token.Value != "&&" {
+				return false
+			}
+		}
+	}
+
+	for i := 0; i+2 < len(tokens); i++ {
+		left, op, right := tokens[i], tokens[i+1], tokens[i+2]
+		if op.Kind != govaluate.COMPARATOR || op.Value != comparator {
+			continue
+		}
+		if !boundary(tokens, i-1) || !boundary(tokens, i+3) {
+			continue
+		}
+		if match(left, right) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// boundary returns true if the token at index i, if any, delimits a
+// comparison, so that the comparison isn't part of a larger operation.
+func boundary(tokens []govaluate.ExpressionToken, i int) bool {
+	if i < 0 || i >= len(tokens) {
+		return true
+	}
+	switch tokens[i].Kind {
+	case govaluate.LOGICALOP, govaluate.CLAUSE, govaluate.CLAUSE_CLOSE:
+		return true
+	}
+	return false
+}
+
+func (q *Query) isField(token govaluate.ExpressionToken, field []string) bool {
+	path := q.path(token)
+	if len(path) != len(field) {
+		return false
+	}
+	for i := range path {
+		if path[i] != field[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// path returns the path of the field a token refers to, or nil if it's not a
+// field.
+func (q *Query) path(token govaluate.ExpressionToken) []string {
+	switch token.Kind {
+	case govaluate.VARIABLE:
+		name := token.Value.(string)
+		if alias, ok := q.aliases[name]; ok {
+			return alias
+		}
+		return []string{name}
+	case govaluate.ACCESSOR:
+		return token.Value.([]string)
+	}
+	return nil
+}
+
+// parameters resolves the variables of a query against a struct, by the JSON
+// name of its fields. The names which aren't struct fields, like the custom
+// attributes, are resolved against its JSON representation.
+type parameters struct {
+	value   reflect.Value
+	aliases map[string][]string
+
+	// any is the JSON representation of the struct, lazily marshaled
+	any jsoniter.Any
+}
+
+// Get implements govaluate.Parameters
+func (p *parameters) Get(name string) (interface{}, error) {
+	if alias, ok := p.aliases[name]; ok {
+		var value interface{} = &parameters{value: p.value}
+		for _, field := range alias {
+			params, ok := value.(govaluate.Parameters)
+			if !ok {
+				return nil, nil
+			}
+			var err error
+			if value, err = params.Get(field); err != nil {
+				return nil, err
+			}
+		}
+		return value, nil
+	}
+
+	v := reflect.Indirect(p.value)
+	if v.Kind() != reflect.Struct {
+		return nil, errors.New("not an object")
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		if tag := strings.Split(field.Tag.Get("json"), ",")[0]; tag == name {
+			return wrap(v.Field(i)), nil
+		}
+	}
+
+	if p.any == nil {
+		b, err := json.Marshal(p.value.Interface())
+		if err != nil {
+			return nil, err
+		}
+		p.any = jsoniter.Get(b)
+	}
+
+	return jsonParameters{any: p.any}.Get(name)
+}
+
+// wrap converts a struct field to a value govaluate can operate on.
+func wrap(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return wrap(v.Elem())
+	case reflect.Struct:
+		if v.CanAddr() {
+			v = v.Addr()
+		}
+		return &parameters{value: v}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return string(v.Bytes())
+		}
+		values := make([]interface{}, v.Len())
+		for i := range values {
+			values[i] = wrap(v.Index(i))
+		}
+		return values
+	case reflect.Map:
+		return mapParameters(v)
+	default:
+		return v.Interface()
+	}
+}
+
+// mapParameters resolves the variables of a query against a map.
+type mapParameters reflect.Value
+
+// Get implements govaluate.Parameters
+func (m mapParameters) Get(name string) (interface{}, error) {
+	v := reflect.Value(m)
+	if v.Type().Key().Kind() != reflect.String {
+		return nil, nil
+	}
+	value := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+	if !value.IsValid() {
+		return nil, nil
+	}
+	return wrap(value), nil
+}
+
+// jsonParameters resolves the variables of a query against a JSON object.
+type jsonParameters struct {
+	any jsoniter.Any
+}
+
+// Get implements govaluate.Parameters
+func (p jsonParameters) Get(name string) (interface{}, error) {
+	if p.any.ValueType() != jsoniter.ObjectValue {
+		return nil, errors.New("not an object")
+	}
+
+	any := p.any.Get(name)
+	switch any.ValueType() {
+	case jsoniter.InvalidValue, jsoniter.NilValue:
+		return nil, nil
+	case jsoniter.ObjectValue:
+		return jsonParameters{any: any}, nil
+	default:
+		return any.GetInterface(), nil
+	}
+}
@@ -0,0 +1,147 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	_, err := Parse(`check.status != 0 && entity.system.os == "linux"`, nil)
+	assert.NoError(t, err)
+
+	_, err = Parse("check.status !=", nil)
+	assert.Error(t, err)
+
+	_, err = Parse("check.status + 1 > 2", nil)
+	assert.Error(t, err)
+}
+
+func TestMatch(t *testing.T) {
+	event := types.FixtureEvent("foo", "check-cpu")
+	event.Check.Status = 2
+	event.Entity.System.OS = "linux"
+	event.Entity.Subscriptions = []string{"linux", "web"}
+	event.Entity.SetExtendedAttributes([]byte(`{"region": "us-east-1", "rack": {"row": 4}}`))
+
+	aliases := map[string][]string{"status": {"check", "status"}}
+
+	testCases := []struct {
+		expression string
+		match      bool
+	}{
+		{`status != 0`, true},
+		{`status == 0`, false},
+		{`check.issued > 0 && check.total_state_change == 0`, true},
+		{`check.proxy_requests == nil && check.round_robin == false`, true},
+		{`check.annotations.team == nil`, true},
+		{`check.status == 2 && entity.system.os == "linux"`, true},
+		{`entity.region == "us-east-1"`, true},
+		{`entity.rack.row >= 4`, true},
+		{`entity.region == "eu-west-1" || check.name == 'check-cpu'`, true},
+		{`"web" in entity.subscriptions`, true},
+		{`entity.id =~ "^f"`, true},
+		{`entity.missing == "foo"`, false},
+		{`entity.missing == nil`, true},
+		{`entity.region > 2`, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			q, err := Parse(tc.expression, aliases)
+			require.NoError(t, err)
+			assert.Equal(t, tc.match, q.Match(event))
+		})
+	}
+}
+
+func TestEquals(t *testing.T) {
+	aliases := map[string][]string{"entity": {"entity", "id"}}
+
+	testCases := []struct {
+		expression string
+		value      string
+		ok         bool
+	}{
+		{`entity.id == "foo"`, "foo", true},
+		{`"foo" == entity.id`, "foo", true},
+		{`entity == "foo"`, "foo", true},
+		{`check.status != 0 && (entity.id == "foo")`, "foo", true},
+		{`entity.id != "foo"`, "", false},
+		{`entity.id == "foo" || check.status != 0`, "", false},
+		{`!(entity.id == "foo")`, "", false},
+		{`entity.id == "foo" == true`, "", false},
+		{`check.status == 0`, "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			q, err := Parse(tc.expression, aliases)
+			require.NoError(t, err)
+			value, ok := q.Equals("entity", "id")
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.value, value)
+		})
+	}
+}
+
+func TestContains(t *testing.T) {
+	testCases := []struct {
+		expression string
+		value      string
+		ok         bool
+	}{
+		{`"linux" in entity.subscriptions`, "linux", true},
+		{`check.status != 0 && "linux" in entity.subscriptions`, "linux", true},
+		{`"linux" in entity.subscriptions || check.status != 0`, "", false},
+		{`entity.subscriptions == "linux"`, "", false},
+		{`"linux" in check.subscriptions`, "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			q, err := Parse(tc.expression, nil)
+			require.NoError(t, err)
+			value, ok := q.Contains("entity", "subscriptions")
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.value, value)
+		})
+	}
+}
+
+func TestEqualsKey(t *testing.T) {
+	testCases := []struct {
+		expression string
+		key        string
+		value      string
+		ok         bool
+	}{
+		{`entity.labels.region == "us-west-2"`, "region", "us-west-2", true},
+		{`"us-west-2" == entity.labels.region && check.status != 0`, "region", "us-west-2", true},
+		{`entity.labels == "us-west-2"`, "", "", false},
+		{`entity.labels.region != "us-west-2"`, "", "", false},
+		{`entity.labels.region == "us-west-2" || check.status != 0`, "", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			q, err := Parse(tc.expression, nil)
+			require.NoError(t, err)
+			key, value, ok := q.EqualsKey("entity", "labels")
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.key, key)
+			assert.Equal(t, tc.value, value)
+		})
+	}
+}
+
+func TestMatchRedacted(t *testing.T) {
+	entity := types.FixtureEntity("foo")
+	entity.SetExtendedAttributes([]byte(`{"password": "secret"}`))
+
+	q, err := Parse(`password == "secret"`, nil)
+	require.NoError(t, err)
+	assert.False(t, q.Match(entity))
+}
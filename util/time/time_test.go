@@ -216,6 +216,50 @@ func TestInWindows(t *testing.T) {
 			expected:      false,
 			expectedError: false,
 		},
+		{
+			name: "is within the time window of its time zone",
+			now:  "2006-01-02T23:30:00Z", // 3:30PM on Monday in Vancouver
+			windows: types.TimeWindowWhen{
+				Days: types.TimeWindowDays{
+					Monday: []*types.TimeWindowTimeRange{
+						&types.TimeWindowTimeRange{
+							Begin: "3:00PM",
+							End:   "4:00PM",
+						},
+					},
+				},
+				TimeZone: "America/Vancouver",
+			},
+			expected:      true,
+			expectedError: false,
+		},
+		{
+			name: "is outside the time window of its time zone",
+			now:  "2006-01-02T15:30:00Z", // 7:30AM on Monday in Vancouver
+			windows: types.TimeWindowWhen{
+				Days: types.TimeWindowDays{
+					Monday: []*types.TimeWindowTimeRange{
+						&types.TimeWindowTimeRange{
+							Begin: "3:00PM",
+							End:   "4:00PM",
+						},
+					},
+				},
+				TimeZone: "America/Vancouver",
+			},
+			expected:      false,
+			expectedError: false,
+		},
+		{
+			name: "invalid time zone",
+			now:  "2006-01-02T15:30:00Z",
+			windows: types.TimeWindowWhen{
+				Days:     types.TimeWindowDays{},
+				TimeZone: "Mars/Olympus_Mons",
+			},
+			expected:      false,
+			expectedError: true,
+		},
 		{
 			name: "invalid time format",
 			now:  "2006-01-02T17:04:05Z",
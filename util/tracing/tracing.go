@@ -0,0 +1,59 @@
+// Package tracing configures the export of the trace spans recorded by the
+// Sensu agent and backend.
+package tracing
+
+import (
+	"fmt"
+
+	"github.com/openzipkin/zipkin-go/model"
+	httpreporter "github.com/openzipkin/zipkin-go/reporter/http"
+	"go.opencensus.io/exporter/zipkin"
+	"go.opencensus.io/trace"
+)
+
+// Config describes where trace spans are exported and how many are sampled.
+type Config struct {
+	// ServiceName is the name under which the spans are reported.
+	ServiceName string
+
+	// ZipkinURL is the URL of the Zipkin compatible collector the spans are
+	// reported to, e.g. http://localhost:9411/api/v2/spans. Jaeger accepts
+	// spans in this format when its Zipkin collector is enabled. Tracing is
+	// disabled when empty.
+	ZipkinURL string
+
+	// SampleRate is the fraction of traces that are sampled, between 0 and 1.
+	SampleRate float64
+}
+
+// Configure registers the span exporter described by cfg. The returned
+// function flushes and unregisters the exporter, and must be called before
+// the process exits.
+func Configure(cfg Config) (func(), error) {
+	if cfg.ZipkinURL == "" {
+		trace.ApplyConfig(trace.Config{DefaultSampler: trace.NeverSample()})
+		return func() {}, nil
+	}
+
+	if cfg.SampleRate < 0 || cfg.SampleRate > 1 {
+		return nil, fmt.Errorf("trace sample rate must be between 0 and 1, got %v", cfg.SampleRate)
+	}
+
+	reporter := httpreporter.NewReporter(cfg.ZipkinURL)
+	exporter := zipkin.NewExporter(reporter, &model.Endpoint{ServiceName: cfg.ServiceName})
+	trace.RegisterExporter(exporter)
+	trace.ApplyConfig(trace.Config{DefaultSampler: trace.ProbabilitySampler(cfg.SampleRate)})
+
+	return func() {
+		trace.UnregisterExporter(exporter)
+		_ = reporter.Close()
+	}, nil
+}
+
+// SetError marks the given span as failed with err, if any.
+func SetError(span *trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.SetStatus(trace.Status{Code: trace.StatusCodeUnknown, Message: err.Error()})
+}
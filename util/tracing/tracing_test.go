@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/trace"
+)
+
+func TestConfigure(t *testing.T) {
+	stop, err := Configure(Config{ServiceName: "test"})
+	require.NoError(t, err)
+	stop()
+
+	_, err = Configure(Config{ZipkinURL: "http://127.0.0.1:9411/api/v2/spans", SampleRate: 2})
+	assert.Error(t, err)
+
+	stop, err = Configure(Config{
+		ServiceName: "test",
+		ZipkinURL:   "http://127.0.0.1:9411/api/v2/spans",
+		SampleRate:  1,
+	})
+	require.NoError(t, err)
+	stop()
+}
+
+func TestSetError(t *testing.T) {
+	_, span := trace.StartSpan(context.Background(), "test", trace.WithSampler(trace.AlwaysSample()))
+	defer span.End()
+
+	SetError(span, nil)
+	SetError(span, errors.New("boom"))
+	assert.True(t, span.IsRecordingEvents())
+}